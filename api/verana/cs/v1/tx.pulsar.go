@@ -883,6 +883,8 @@ var (
 	fd_MsgCreateCredentialSchema_holder_validation_validity_period           protoreflect.FieldDescriptor
 	fd_MsgCreateCredentialSchema_issuer_perm_management_mode                 protoreflect.FieldDescriptor
 	fd_MsgCreateCredentialSchema_verifier_perm_management_mode               protoreflect.FieldDescriptor
+	fd_MsgCreateCredentialSchema_issuer_validation_quorum                    protoreflect.FieldDescriptor
+	fd_MsgCreateCredentialSchema_verifier_validation_quorum                  protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -898,6 +900,8 @@ func init() {
 	fd_MsgCreateCredentialSchema_holder_validation_validity_period = md_MsgCreateCredentialSchema.Fields().ByName("holder_validation_validity_period")
 	fd_MsgCreateCredentialSchema_issuer_perm_management_mode = md_MsgCreateCredentialSchema.Fields().ByName("issuer_perm_management_mode")
 	fd_MsgCreateCredentialSchema_verifier_perm_management_mode = md_MsgCreateCredentialSchema.Fields().ByName("verifier_perm_management_mode")
+	fd_MsgCreateCredentialSchema_issuer_validation_quorum = md_MsgCreateCredentialSchema.Fields().ByName("issuer_validation_quorum")
+	fd_MsgCreateCredentialSchema_verifier_validation_quorum = md_MsgCreateCredentialSchema.Fields().ByName("verifier_validation_quorum")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgCreateCredentialSchema)(nil)
@@ -1025,6 +1029,18 @@ func (x *fastReflection_MsgCreateCredentialSchema) Range(f func(protoreflect.Fie
 			return
 		}
 	}
+	if x.IssuerValidationQuorum != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.IssuerValidationQuorum)
+		if !f(fd_MsgCreateCredentialSchema_issuer_validation_quorum, value) {
+			return
+		}
+	}
+	if x.VerifierValidationQuorum != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.VerifierValidationQuorum)
+		if !f(fd_MsgCreateCredentialSchema_verifier_validation_quorum, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1060,6 +1076,10 @@ func (x *fastReflection_MsgCreateCredentialSchema) Has(fd protoreflect.FieldDesc
 		return x.IssuerPermManagementMode != uint32(0)
 	case "verana.cs.v1.MsgCreateCredentialSchema.verifier_perm_management_mode":
 		return x.VerifierPermManagementMode != uint32(0)
+	case "verana.cs.v1.MsgCreateCredentialSchema.issuer_validation_quorum":
+		return x.IssuerValidationQuorum != uint32(0)
+	case "verana.cs.v1.MsgCreateCredentialSchema.verifier_validation_quorum":
+		return x.VerifierValidationQuorum != uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.cs.v1.MsgCreateCredentialSchema"))
@@ -1096,6 +1116,10 @@ func (x *fastReflection_MsgCreateCredentialSchema) Clear(fd protoreflect.FieldDe
 		x.IssuerPermManagementMode = uint32(0)
 	case "verana.cs.v1.MsgCreateCredentialSchema.verifier_perm_management_mode":
 		x.VerifierPermManagementMode = uint32(0)
+	case "verana.cs.v1.MsgCreateCredentialSchema.issuer_validation_quorum":
+		x.IssuerValidationQuorum = uint32(0)
+	case "verana.cs.v1.MsgCreateCredentialSchema.verifier_validation_quorum":
+		x.VerifierValidationQuorum = uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.cs.v1.MsgCreateCredentialSchema"))
@@ -1142,6 +1166,12 @@ func (x *fastReflection_MsgCreateCredentialSchema) Get(descriptor protoreflect.F
 	case "verana.cs.v1.MsgCreateCredentialSchema.verifier_perm_management_mode":
 		value := x.VerifierPermManagementMode
 		return protoreflect.ValueOfUint32(value)
+	case "verana.cs.v1.MsgCreateCredentialSchema.issuer_validation_quorum":
+		value := x.IssuerValidationQuorum
+		return protoreflect.ValueOfUint32(value)
+	case "verana.cs.v1.MsgCreateCredentialSchema.verifier_validation_quorum":
+		value := x.VerifierValidationQuorum
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.cs.v1.MsgCreateCredentialSchema"))
@@ -1182,6 +1212,10 @@ func (x *fastReflection_MsgCreateCredentialSchema) Set(fd protoreflect.FieldDesc
 		x.IssuerPermManagementMode = uint32(value.Uint())
 	case "verana.cs.v1.MsgCreateCredentialSchema.verifier_perm_management_mode":
 		x.VerifierPermManagementMode = uint32(value.Uint())
+	case "verana.cs.v1.MsgCreateCredentialSchema.issuer_validation_quorum":
+		x.IssuerValidationQuorum = uint32(value.Uint())
+	case "verana.cs.v1.MsgCreateCredentialSchema.verifier_validation_quorum":
+		x.VerifierValidationQuorum = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.cs.v1.MsgCreateCredentialSchema"))
@@ -1222,6 +1256,10 @@ func (x *fastReflection_MsgCreateCredentialSchema) Mutable(fd protoreflect.Field
 		panic(fmt.Errorf("field issuer_perm_management_mode of message verana.cs.v1.MsgCreateCredentialSchema is not mutable"))
 	case "verana.cs.v1.MsgCreateCredentialSchema.verifier_perm_management_mode":
 		panic(fmt.Errorf("field verifier_perm_management_mode of message verana.cs.v1.MsgCreateCredentialSchema is not mutable"))
+	case "verana.cs.v1.MsgCreateCredentialSchema.issuer_validation_quorum":
+		panic(fmt.Errorf("field issuer_validation_quorum of message verana.cs.v1.MsgCreateCredentialSchema is not mutable"))
+	case "verana.cs.v1.MsgCreateCredentialSchema.verifier_validation_quorum":
+		panic(fmt.Errorf("field verifier_validation_quorum of message verana.cs.v1.MsgCreateCredentialSchema is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.cs.v1.MsgCreateCredentialSchema"))
@@ -1255,6 +1293,10 @@ func (x *fastReflection_MsgCreateCredentialSchema) NewField(fd protoreflect.Fiel
 		return protoreflect.ValueOfUint32(uint32(0))
 	case "verana.cs.v1.MsgCreateCredentialSchema.verifier_perm_management_mode":
 		return protoreflect.ValueOfUint32(uint32(0))
+	case "verana.cs.v1.MsgCreateCredentialSchema.issuer_validation_quorum":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "verana.cs.v1.MsgCreateCredentialSchema.verifier_validation_quorum":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.cs.v1.MsgCreateCredentialSchema"))
@@ -1356,6 +1398,12 @@ func (x *fastReflection_MsgCreateCredentialSchema) ProtoMethods() *protoiface.Me
 		if x.VerifierPermManagementMode != 0 {
 			n += 1 + runtime.Sov(uint64(x.VerifierPermManagementMode))
 		}
+		if x.IssuerValidationQuorum != 0 {
+			n += 1 + runtime.Sov(uint64(x.IssuerValidationQuorum))
+		}
+		if x.VerifierValidationQuorum != 0 {
+			n += 1 + runtime.Sov(uint64(x.VerifierValidationQuorum))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1385,6 +1433,16 @@ func (x *fastReflection_MsgCreateCredentialSchema) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.VerifierValidationQuorum != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VerifierValidationQuorum))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.IssuerValidationQuorum != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IssuerValidationQuorum))
+			i--
+			dAtA[i] = 0x58
+		}
 		if x.VerifierPermManagementMode != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.VerifierPermManagementMode))
 			i--
@@ -1704,6 +1762,44 @@ func (x *fastReflection_MsgCreateCredentialSchema) ProtoMethods() *protoiface.Me
 						break
 					}
 				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IssuerValidationQuorum", wireType)
+				}
+				x.IssuerValidationQuorum = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IssuerValidationQuorum |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerifierValidationQuorum", wireType)
+				}
+				x.VerifierValidationQuorum = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.VerifierValidationQuorum |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2152,6 +2248,8 @@ var (
 	fd_MsgUpdateCredentialSchema_issuer_validation_validity_period           protoreflect.FieldDescriptor
 	fd_MsgUpdateCredentialSchema_verifier_validation_validity_period         protoreflect.FieldDescriptor
 	fd_MsgUpdateCredentialSchema_holder_validation_validity_period           protoreflect.FieldDescriptor
+	fd_MsgUpdateCredentialSchema_issuer_validation_quorum                    protoreflect.FieldDescriptor
+	fd_MsgUpdateCredentialSchema_verifier_validation_quorum                  protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -2164,6 +2262,8 @@ func init() {
 	fd_MsgUpdateCredentialSchema_issuer_validation_validity_period = md_MsgUpdateCredentialSchema.Fields().ByName("issuer_validation_validity_period")
 	fd_MsgUpdateCredentialSchema_verifier_validation_validity_period = md_MsgUpdateCredentialSchema.Fields().ByName("verifier_validation_validity_period")
 	fd_MsgUpdateCredentialSchema_holder_validation_validity_period = md_MsgUpdateCredentialSchema.Fields().ByName("holder_validation_validity_period")
+	fd_MsgUpdateCredentialSchema_issuer_validation_quorum = md_MsgUpdateCredentialSchema.Fields().ByName("issuer_validation_quorum")
+	fd_MsgUpdateCredentialSchema_verifier_validation_quorum = md_MsgUpdateCredentialSchema.Fields().ByName("verifier_validation_quorum")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgUpdateCredentialSchema)(nil)
@@ -2273,6 +2373,18 @@ func (x *fastReflection_MsgUpdateCredentialSchema) Range(f func(protoreflect.Fie
 			return
 		}
 	}
+	if x.IssuerValidationQuorum != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.IssuerValidationQuorum)
+		if !f(fd_MsgUpdateCredentialSchema_issuer_validation_quorum, value) {
+			return
+		}
+	}
+	if x.VerifierValidationQuorum != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.VerifierValidationQuorum)
+		if !f(fd_MsgUpdateCredentialSchema_verifier_validation_quorum, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2302,6 +2414,10 @@ func (x *fastReflection_MsgUpdateCredentialSchema) Has(fd protoreflect.FieldDesc
 		return x.VerifierValidationValidityPeriod != uint32(0)
 	case "verana.cs.v1.MsgUpdateCredentialSchema.holder_validation_validity_period":
 		return x.HolderValidationValidityPeriod != uint32(0)
+	case "verana.cs.v1.MsgUpdateCredentialSchema.issuer_validation_quorum":
+		return x.IssuerValidationQuorum != uint32(0)
+	case "verana.cs.v1.MsgUpdateCredentialSchema.verifier_validation_quorum":
+		return x.VerifierValidationQuorum != uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.cs.v1.MsgUpdateCredentialSchema"))
@@ -2332,6 +2448,10 @@ func (x *fastReflection_MsgUpdateCredentialSchema) Clear(fd protoreflect.FieldDe
 		x.VerifierValidationValidityPeriod = uint32(0)
 	case "verana.cs.v1.MsgUpdateCredentialSchema.holder_validation_validity_period":
 		x.HolderValidationValidityPeriod = uint32(0)
+	case "verana.cs.v1.MsgUpdateCredentialSchema.issuer_validation_quorum":
+		x.IssuerValidationQuorum = uint32(0)
+	case "verana.cs.v1.MsgUpdateCredentialSchema.verifier_validation_quorum":
+		x.VerifierValidationQuorum = uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.cs.v1.MsgUpdateCredentialSchema"))
@@ -2369,6 +2489,12 @@ func (x *fastReflection_MsgUpdateCredentialSchema) Get(descriptor protoreflect.F
 	case "verana.cs.v1.MsgUpdateCredentialSchema.holder_validation_validity_period":
 		value := x.HolderValidationValidityPeriod
 		return protoreflect.ValueOfUint32(value)
+	case "verana.cs.v1.MsgUpdateCredentialSchema.issuer_validation_quorum":
+		value := x.IssuerValidationQuorum
+		return protoreflect.ValueOfUint32(value)
+	case "verana.cs.v1.MsgUpdateCredentialSchema.verifier_validation_quorum":
+		value := x.VerifierValidationQuorum
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.cs.v1.MsgUpdateCredentialSchema"))
@@ -2403,6 +2529,10 @@ func (x *fastReflection_MsgUpdateCredentialSchema) Set(fd protoreflect.FieldDesc
 		x.VerifierValidationValidityPeriod = uint32(value.Uint())
 	case "verana.cs.v1.MsgUpdateCredentialSchema.holder_validation_validity_period":
 		x.HolderValidationValidityPeriod = uint32(value.Uint())
+	case "verana.cs.v1.MsgUpdateCredentialSchema.issuer_validation_quorum":
+		x.IssuerValidationQuorum = uint32(value.Uint())
+	case "verana.cs.v1.MsgUpdateCredentialSchema.verifier_validation_quorum":
+		x.VerifierValidationQuorum = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.cs.v1.MsgUpdateCredentialSchema"))
@@ -2437,6 +2567,10 @@ func (x *fastReflection_MsgUpdateCredentialSchema) Mutable(fd protoreflect.Field
 		panic(fmt.Errorf("field verifier_validation_validity_period of message verana.cs.v1.MsgUpdateCredentialSchema is not mutable"))
 	case "verana.cs.v1.MsgUpdateCredentialSchema.holder_validation_validity_period":
 		panic(fmt.Errorf("field holder_validation_validity_period of message verana.cs.v1.MsgUpdateCredentialSchema is not mutable"))
+	case "verana.cs.v1.MsgUpdateCredentialSchema.issuer_validation_quorum":
+		panic(fmt.Errorf("field issuer_validation_quorum of message verana.cs.v1.MsgUpdateCredentialSchema is not mutable"))
+	case "verana.cs.v1.MsgUpdateCredentialSchema.verifier_validation_quorum":
+		panic(fmt.Errorf("field verifier_validation_quorum of message verana.cs.v1.MsgUpdateCredentialSchema is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.cs.v1.MsgUpdateCredentialSchema"))
@@ -2464,6 +2598,10 @@ func (x *fastReflection_MsgUpdateCredentialSchema) NewField(fd protoreflect.Fiel
 		return protoreflect.ValueOfUint32(uint32(0))
 	case "verana.cs.v1.MsgUpdateCredentialSchema.holder_validation_validity_period":
 		return protoreflect.ValueOfUint32(uint32(0))
+	case "verana.cs.v1.MsgUpdateCredentialSchema.issuer_validation_quorum":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "verana.cs.v1.MsgUpdateCredentialSchema.verifier_validation_quorum":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.cs.v1.MsgUpdateCredentialSchema"))
@@ -2555,6 +2693,12 @@ func (x *fastReflection_MsgUpdateCredentialSchema) ProtoMethods() *protoiface.Me
 		if x.HolderValidationValidityPeriod != 0 {
 			n += 1 + runtime.Sov(uint64(x.HolderValidationValidityPeriod))
 		}
+		if x.IssuerValidationQuorum != 0 {
+			n += 1 + runtime.Sov(uint64(x.IssuerValidationQuorum))
+		}
+		if x.VerifierValidationQuorum != 0 {
+			n += 1 + runtime.Sov(uint64(x.VerifierValidationQuorum))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2584,6 +2728,16 @@ func (x *fastReflection_MsgUpdateCredentialSchema) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.VerifierValidationQuorum != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VerifierValidationQuorum))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.IssuerValidationQuorum != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IssuerValidationQuorum))
+			i--
+			dAtA[i] = 0x40
+		}
 		if x.HolderValidationValidityPeriod != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.HolderValidationValidityPeriod))
 			i--
@@ -2816,6 +2970,44 @@ func (x *fastReflection_MsgUpdateCredentialSchema) ProtoMethods() *protoiface.Me
 						break
 					}
 				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IssuerValidationQuorum", wireType)
+				}
+				x.IssuerValidationQuorum = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IssuerValidationQuorum |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerifierValidationQuorum", wireType)
+				}
+				x.VerifierValidationQuorum = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.VerifierValidationQuorum |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4189,6 +4381,8 @@ type MsgCreateCredentialSchema struct {
 	HolderValidationValidityPeriod          uint32 `protobuf:"varint,8,opt,name=holder_validation_validity_period,json=holderValidationValidityPeriod,proto3" json:"holder_validation_validity_period,omitempty"`
 	IssuerPermManagementMode                uint32 `protobuf:"varint,9,opt,name=issuer_perm_management_mode,json=issuerPermManagementMode,proto3" json:"issuer_perm_management_mode,omitempty"`
 	VerifierPermManagementMode              uint32 `protobuf:"varint,10,opt,name=verifier_perm_management_mode,json=verifierPermManagementMode,proto3" json:"verifier_perm_management_mode,omitempty"`
+	IssuerValidationQuorum                  uint32 `protobuf:"varint,11,opt,name=issuer_validation_quorum,json=issuerValidationQuorum,proto3" json:"issuer_validation_quorum,omitempty"`
+	VerifierValidationQuorum                uint32 `protobuf:"varint,12,opt,name=verifier_validation_quorum,json=verifierValidationQuorum,proto3" json:"verifier_validation_quorum,omitempty"`
 }
 
 func (x *MsgCreateCredentialSchema) Reset() {
@@ -4281,6 +4475,20 @@ func (x *MsgCreateCredentialSchema) GetVerifierPermManagementMode() uint32 {
 	return 0
 }
 
+func (x *MsgCreateCredentialSchema) GetIssuerValidationQuorum() uint32 {
+	if x != nil {
+		return x.IssuerValidationQuorum
+	}
+	return 0
+}
+
+func (x *MsgCreateCredentialSchema) GetVerifierValidationQuorum() uint32 {
+	if x != nil {
+		return x.VerifierValidationQuorum
+	}
+	return 0
+}
+
 type MsgCreateCredentialSchemaResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -4328,6 +4536,8 @@ type MsgUpdateCredentialSchema struct {
 	IssuerValidationValidityPeriod          uint32 `protobuf:"varint,5,opt,name=issuer_validation_validity_period,json=issuerValidationValidityPeriod,proto3" json:"issuer_validation_validity_period,omitempty"`
 	VerifierValidationValidityPeriod        uint32 `protobuf:"varint,6,opt,name=verifier_validation_validity_period,json=verifierValidationValidityPeriod,proto3" json:"verifier_validation_validity_period,omitempty"`
 	HolderValidationValidityPeriod          uint32 `protobuf:"varint,7,opt,name=holder_validation_validity_period,json=holderValidationValidityPeriod,proto3" json:"holder_validation_validity_period,omitempty"`
+	IssuerValidationQuorum                  uint32 `protobuf:"varint,8,opt,name=issuer_validation_quorum,json=issuerValidationQuorum,proto3" json:"issuer_validation_quorum,omitempty"`
+	VerifierValidationQuorum                uint32 `protobuf:"varint,9,opt,name=verifier_validation_quorum,json=verifierValidationQuorum,proto3" json:"verifier_validation_quorum,omitempty"`
 }
 
 func (x *MsgUpdateCredentialSchema) Reset() {
@@ -4399,6 +4609,20 @@ func (x *MsgUpdateCredentialSchema) GetHolderValidationValidityPeriod() uint32 {
 	return 0
 }
 
+func (x *MsgUpdateCredentialSchema) GetIssuerValidationQuorum() uint32 {
+	if x != nil {
+		return x.IssuerValidationQuorum
+	}
+	return 0
+}
+
+func (x *MsgUpdateCredentialSchema) GetVerifierValidationQuorum() uint32 {
+	if x != nil {
+		return x.VerifierValidationQuorum
+	}
+	return 0
+}
+
 type MsgUpdateCredentialSchemaResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -4530,7 +4754,7 @@ var file_verana_cs_v1_tx_proto_rawDesc = []byte{
 	0x74, 0x69, 0x61, 0x6c, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70,
 	0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73,
 	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb2, 0x05, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xaa, 0x06, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65,
 	0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x63, 0x68,
 	0x65, 0x6d, 0x61, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
@@ -4572,43 +4796,58 @@ var file_verana_cs_v1_tx_proto_rawDesc = []byte{
 	0x69, 0x66, 0x69, 0x65, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
 	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d,
 	0x52, 0x1a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x4d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x3a, 0x0c, 0x82, 0xe7,
-	0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x33, 0x0a, 0x21, 0x4d, 0x73,
-	0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
-	0x6c, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0x8a, 0x04, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x32, 0x0a,
-	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
-	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
-	0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x58, 0x0a, 0x29, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x5f, 0x67, 0x72, 0x61, 0x6e,
-	0x74, 0x6f, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x25, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x47, 0x72, 0x61, 0x6e,
-	0x74, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x5c, 0x0a, 0x2b, 0x76,
-	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x6f, 0x72, 0x5f,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x69, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x27, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x6f,
-	0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x69, 0x74, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x49, 0x0a, 0x21, 0x69, 0x73, 0x73,
-	0x75, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x1e, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x65,
-	0x72, 0x69, 0x6f, 0x64, 0x12, 0x4d, 0x0a, 0x23, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72,
-	0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x20, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x38, 0x0a, 0x18,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x3c, 0x0a, 0x1a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x71, 0x75,
+	0x6f, 0x72, 0x75, 0x6d, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75,
+	0x6f, 0x72, 0x75, 0x6d, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x22, 0x33, 0x0a, 0x21, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x82, 0x05, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x58, 0x0a, 0x29, 0x69, 0x73, 0x73,
+	0x75, 0x65, 0x72, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x6f, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x25, 0x69, 0x73,
+	0x73, 0x75, 0x65, 0x72, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64,
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x65, 0x72,
-	0x69, 0x6f, 0x64, 0x12, 0x49, 0x0a, 0x21, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74,
-	0x79, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1e,
-	0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x3a, 0x0c,
+	0x69, 0x6f, 0x64, 0x12, 0x5c, 0x0a, 0x2b, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5f,
+	0x67, 0x72, 0x61, 0x6e, 0x74, 0x6f, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x27, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x12, 0x49, 0x0a, 0x21, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1e, 0x69, 0x73,
+	0x73, 0x75, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x4d, 0x0a, 0x23,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x20, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x49, 0x0a, 0x21, 0x68,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1e, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72,
+	0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x71, 0x75, 0x6f, 0x72,
+	0x75, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d,
+	0x12, 0x3c, 0x0a, 0x1a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x3a, 0x0c,
 	0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x23, 0x0a, 0x21,
 	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
 	0x69, 0x61, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
@@ -32,6 +32,8 @@ var (
 	fd_CredentialSchema_holder_validation_validity_period           protoreflect.FieldDescriptor
 	fd_CredentialSchema_issuer_perm_management_mode                 protoreflect.FieldDescriptor
 	fd_CredentialSchema_verifier_perm_management_mode               protoreflect.FieldDescriptor
+	fd_CredentialSchema_issuer_validation_quorum                    protoreflect.FieldDescriptor
+	fd_CredentialSchema_verifier_validation_quorum                  protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -51,6 +53,8 @@ func init() {
 	fd_CredentialSchema_holder_validation_validity_period = md_CredentialSchema.Fields().ByName("holder_validation_validity_period")
 	fd_CredentialSchema_issuer_perm_management_mode = md_CredentialSchema.Fields().ByName("issuer_perm_management_mode")
 	fd_CredentialSchema_verifier_perm_management_mode = md_CredentialSchema.Fields().ByName("verifier_perm_management_mode")
+	fd_CredentialSchema_issuer_validation_quorum = md_CredentialSchema.Fields().ByName("issuer_validation_quorum")
+	fd_CredentialSchema_verifier_validation_quorum = md_CredentialSchema.Fields().ByName("verifier_validation_quorum")
 }
 
 var _ protoreflect.Message = (*fastReflection_CredentialSchema)(nil)
@@ -202,6 +206,18 @@ func (x *fastReflection_CredentialSchema) Range(f func(protoreflect.FieldDescrip
 			return
 		}
 	}
+	if x.IssuerValidationQuorum != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.IssuerValidationQuorum)
+		if !f(fd_CredentialSchema_issuer_validation_quorum, value) {
+			return
+		}
+	}
+	if x.VerifierValidationQuorum != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.VerifierValidationQuorum)
+		if !f(fd_CredentialSchema_verifier_validation_quorum, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -245,6 +261,10 @@ func (x *fastReflection_CredentialSchema) Has(fd protoreflect.FieldDescriptor) b
 		return x.IssuerPermManagementMode != 0
 	case "verana.cs.v1.CredentialSchema.verifier_perm_management_mode":
 		return x.VerifierPermManagementMode != 0
+	case "verana.cs.v1.CredentialSchema.issuer_validation_quorum":
+		return x.IssuerValidationQuorum != uint32(0)
+	case "verana.cs.v1.CredentialSchema.verifier_validation_quorum":
+		return x.VerifierValidationQuorum != uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.cs.v1.CredentialSchema"))
@@ -289,6 +309,10 @@ func (x *fastReflection_CredentialSchema) Clear(fd protoreflect.FieldDescriptor)
 		x.IssuerPermManagementMode = 0
 	case "verana.cs.v1.CredentialSchema.verifier_perm_management_mode":
 		x.VerifierPermManagementMode = 0
+	case "verana.cs.v1.CredentialSchema.issuer_validation_quorum":
+		x.IssuerValidationQuorum = uint32(0)
+	case "verana.cs.v1.CredentialSchema.verifier_validation_quorum":
+		x.VerifierValidationQuorum = uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.cs.v1.CredentialSchema"))
@@ -347,6 +371,12 @@ func (x *fastReflection_CredentialSchema) Get(descriptor protoreflect.FieldDescr
 	case "verana.cs.v1.CredentialSchema.verifier_perm_management_mode":
 		value := x.VerifierPermManagementMode
 		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "verana.cs.v1.CredentialSchema.issuer_validation_quorum":
+		value := x.IssuerValidationQuorum
+		return protoreflect.ValueOfUint32(value)
+	case "verana.cs.v1.CredentialSchema.verifier_validation_quorum":
+		value := x.VerifierValidationQuorum
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.cs.v1.CredentialSchema"))
@@ -395,6 +425,10 @@ func (x *fastReflection_CredentialSchema) Set(fd protoreflect.FieldDescriptor, v
 		x.IssuerPermManagementMode = (CredentialSchemaPermManagementMode)(value.Enum())
 	case "verana.cs.v1.CredentialSchema.verifier_perm_management_mode":
 		x.VerifierPermManagementMode = (CredentialSchemaPermManagementMode)(value.Enum())
+	case "verana.cs.v1.CredentialSchema.issuer_validation_quorum":
+		x.IssuerValidationQuorum = uint32(value.Uint())
+	case "verana.cs.v1.CredentialSchema.verifier_validation_quorum":
+		x.VerifierValidationQuorum = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.cs.v1.CredentialSchema"))
@@ -452,6 +486,10 @@ func (x *fastReflection_CredentialSchema) Mutable(fd protoreflect.FieldDescripto
 		panic(fmt.Errorf("field issuer_perm_management_mode of message verana.cs.v1.CredentialSchema is not mutable"))
 	case "verana.cs.v1.CredentialSchema.verifier_perm_management_mode":
 		panic(fmt.Errorf("field verifier_perm_management_mode of message verana.cs.v1.CredentialSchema is not mutable"))
+	case "verana.cs.v1.CredentialSchema.issuer_validation_quorum":
+		panic(fmt.Errorf("field issuer_validation_quorum of message verana.cs.v1.CredentialSchema is not mutable"))
+	case "verana.cs.v1.CredentialSchema.verifier_validation_quorum":
+		panic(fmt.Errorf("field verifier_validation_quorum of message verana.cs.v1.CredentialSchema is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.cs.v1.CredentialSchema"))
@@ -496,6 +534,10 @@ func (x *fastReflection_CredentialSchema) NewField(fd protoreflect.FieldDescript
 		return protoreflect.ValueOfEnum(0)
 	case "verana.cs.v1.CredentialSchema.verifier_perm_management_mode":
 		return protoreflect.ValueOfEnum(0)
+	case "verana.cs.v1.CredentialSchema.issuer_validation_quorum":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "verana.cs.v1.CredentialSchema.verifier_validation_quorum":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.cs.v1.CredentialSchema"))
@@ -611,6 +653,12 @@ func (x *fastReflection_CredentialSchema) ProtoMethods() *protoiface.Methods {
 		if x.VerifierPermManagementMode != 0 {
 			n += 1 + runtime.Sov(uint64(x.VerifierPermManagementMode))
 		}
+		if x.IssuerValidationQuorum != 0 {
+			n += 1 + runtime.Sov(uint64(x.IssuerValidationQuorum))
+		}
+		if x.VerifierValidationQuorum != 0 {
+			n += 2 + runtime.Sov(uint64(x.VerifierValidationQuorum))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -640,6 +688,18 @@ func (x *fastReflection_CredentialSchema) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.VerifierValidationQuorum != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VerifierValidationQuorum))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if x.IssuerValidationQuorum != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IssuerValidationQuorum))
+			i--
+			dAtA[i] = 0x78
+		}
 		if x.VerifierPermManagementMode != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.VerifierPermManagementMode))
 			i--
@@ -1118,6 +1178,44 @@ func (x *fastReflection_CredentialSchema) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IssuerValidationQuorum", wireType)
+				}
+				x.IssuerValidationQuorum = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IssuerValidationQuorum |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerifierValidationQuorum", wireType)
+				}
+				x.VerifierValidationQuorum = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.VerifierValidationQuorum |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1243,6 +1341,12 @@ type CredentialSchema struct {
 	HolderValidationValidityPeriod          uint32                             `protobuf:"varint,12,opt,name=holder_validation_validity_period,json=holderValidationValidityPeriod,proto3" json:"holder_validation_validity_period,omitempty"`
 	IssuerPermManagementMode                CredentialSchemaPermManagementMode `protobuf:"varint,13,opt,name=issuer_perm_management_mode,json=issuerPermManagementMode,proto3,enum=verana.cs.v1.CredentialSchemaPermManagementMode" json:"issuer_perm_management_mode,omitempty"`
 	VerifierPermManagementMode              CredentialSchemaPermManagementMode `protobuf:"varint,14,opt,name=verifier_perm_management_mode,json=verifierPermManagementMode,proto3,enum=verana.cs.v1.CredentialSchemaPermManagementMode" json:"verifier_perm_management_mode,omitempty"`
+	// issuer_validation_quorum is the number of validator approvals an ISSUER
+	// validation process requires. 0 or 1 means a single validator.
+	IssuerValidationQuorum uint32 `protobuf:"varint,15,opt,name=issuer_validation_quorum,json=issuerValidationQuorum,proto3" json:"issuer_validation_quorum,omitempty"`
+	// verifier_validation_quorum is the number of validator approvals a
+	// VERIFIER validation process requires. 0 or 1 means a single validator.
+	VerifierValidationQuorum uint32 `protobuf:"varint,16,opt,name=verifier_validation_quorum,json=verifierValidationQuorum,proto3" json:"verifier_validation_quorum,omitempty"`
 }
 
 func (x *CredentialSchema) Reset() {
@@ -1363,6 +1467,20 @@ func (x *CredentialSchema) GetVerifierPermManagementMode() CredentialSchemaPermM
 	return CredentialSchemaPermManagementMode_MODE_UNSPECIFIED
 }
 
+func (x *CredentialSchema) GetIssuerValidationQuorum() uint32 {
+	if x != nil {
+		return x.IssuerValidationQuorum
+	}
+	return 0
+}
+
+func (x *CredentialSchema) GetVerifierValidationQuorum() uint32 {
+	if x != nil {
+		return x.VerifierValidationQuorum
+	}
+	return 0
+}
+
 var File_verana_cs_v1_types_proto protoreflect.FileDescriptor
 
 var file_verana_cs_v1_types_proto_rawDesc = []byte{
@@ -1374,7 +1492,7 @@ var file_verana_cs_v1_types_proto_rawDesc = []byte{
 	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
 	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb9, 0x07,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb1, 0x08,
 	0x0a, 0x10, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x63, 0x68, 0x65,
 	0x6d, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
 	0x69, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
@@ -1434,26 +1552,33 @@ var file_verana_cs_v1_types_proto_rawDesc = []byte{
 	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x50, 0x65, 0x72, 0x6d, 0x4d,
 	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x1a, 0x76,
 	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x4d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x2a, 0x6b, 0x0a, 0x22, 0x43, 0x72, 0x65,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x50, 0x65, 0x72,
-	0x6d, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12,
-	0x14, 0x0a, 0x10, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01, 0x12,
-	0x16, 0x0a, 0x12, 0x47, 0x52, 0x41, 0x4e, 0x54, 0x4f, 0x52, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44,
-	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x45, 0x43, 0x4f, 0x53, 0x59,
-	0x53, 0x54, 0x45, 0x4d, 0x10, 0x03, 0x42, 0xb0, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x76,
-	0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x63, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x54, 0x79, 0x70,
-	0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2d, 0x6c, 0x61, 0x62,
-	0x73, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x63,
-	0x73, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x56, 0x43, 0x58, 0xaa,
-	0x02, 0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x43, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02,
-	0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x43, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18,
-	0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x43, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x56, 0x65, 0x72, 0x61, 0x6e,
-	0x61, 0x3a, 0x3a, 0x43, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x69, 0x73, 0x73,
+	0x75, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x71,
+	0x75, 0x6f, 0x72, 0x75, 0x6d, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x69, 0x73, 0x73,
+	0x75, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x6f,
+	0x72, 0x75, 0x6d, 0x12, 0x3c, 0x0a, 0x1a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x71, 0x75, 0x6f, 0x72, 0x75,
+	0x6d, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x6f, 0x72, 0x75,
+	0x6d, 0x2a, 0x6b, 0x0a, 0x22, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x50, 0x65, 0x72, 0x6d, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a,
+	0x04, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x52, 0x41, 0x4e, 0x54,
+	0x4f, 0x52, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12,
+	0x0d, 0x0a, 0x09, 0x45, 0x43, 0x4f, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x10, 0x03, 0x42, 0xb0,
+	0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x63, 0x73,
+	0x2e, 0x76, 0x31, 0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x65,
+	0x72, 0x61, 0x6e, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61,
+	0x2d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x63, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x73, 0x76,
+	0x31, 0xa2, 0x02, 0x03, 0x56, 0x43, 0x58, 0xaa, 0x02, 0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61,
+	0x2e, 0x43, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c,
+	0x43, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x43,
+	0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x0e, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x3a, 0x3a, 0x43, 0x73, 0x3a, 0x3a, 0x56,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
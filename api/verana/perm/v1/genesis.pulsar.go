@@ -116,508 +116,1830 @@ func (x *_GenesisState_3_list) IsValid() bool {
 	return x.list != nil
 }
 
-var (
-	md_GenesisState                     protoreflect.MessageDescriptor
-	fd_GenesisState_params              protoreflect.FieldDescriptor
-	fd_GenesisState_permissions         protoreflect.FieldDescriptor
-	fd_GenesisState_permission_sessions protoreflect.FieldDescriptor
-	fd_GenesisState_next_permission_id  protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
 
-func init() {
-	file_verana_perm_v1_genesis_proto_init()
-	md_GenesisState = File_verana_perm_v1_genesis_proto.Messages().ByName("GenesisState")
-	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
-	fd_GenesisState_permissions = md_GenesisState.Fields().ByName("permissions")
-	fd_GenesisState_permission_sessions = md_GenesisState.Fields().ByName("permission_sessions")
-	fd_GenesisState_next_permission_id = md_GenesisState.Fields().ByName("next_permission_id")
+type _GenesisState_5_list struct {
+	list *[]*SessionAllowance
 }
 
-var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-type fastReflection_GenesisState GenesisState
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
 
-func (x *GenesisState) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GenesisState)(x)
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SessionAllowance)
+	(*x.list)[i] = concreteValue
 }
 
-func (x *GenesisState) slowProtoReflect() protoreflect.Message {
-	mi := &file_verana_perm_v1_genesis_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SessionAllowance)
+	*x.list = append(*x.list, concreteValue)
 }
 
-var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
-var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(SessionAllowance)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
 
-type fastReflection_GenesisState_messageType struct{}
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
 
-func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GenesisState)(nil)
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(SessionAllowance)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
-func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
 }
-func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*SlashRecord
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
-	return _fastReflection_GenesisState_messageType
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GenesisState) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SlashRecord)
+	(*x.list)[i] = concreteValue
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
-	return (*GenesisState)(x)
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SlashRecord)
+	*x.list = append(*x.list, concreteValue)
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Params != nil {
-		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-		if !f(fd_GenesisState_params, value) {
-			return
-		}
-	}
-	if len(x.Permissions) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_2_list{list: &x.Permissions})
-		if !f(fd_GenesisState_permissions, value) {
-			return
-		}
-	}
-	if len(x.PermissionSessions) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_3_list{list: &x.PermissionSessions})
-		if !f(fd_GenesisState_permission_sessions, value) {
-			return
-		}
-	}
-	if x.NextPermissionId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.NextPermissionId)
-		if !f(fd_GenesisState_next_permission_id, value) {
-			return
-		}
-	}
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(SlashRecord)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "verana.perm.v1.GenesisState.params":
-		return x.Params != nil
-	case "verana.perm.v1.GenesisState.permissions":
-		return len(x.Permissions) != 0
-	case "verana.perm.v1.GenesisState.permission_sessions":
-		return len(x.PermissionSessions) != 0
-	case "verana.perm.v1.GenesisState.next_permission_id":
-		return x.NextPermissionId != uint64(0)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message verana.perm.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "verana.perm.v1.GenesisState.params":
-		x.Params = nil
-	case "verana.perm.v1.GenesisState.permissions":
-		x.Permissions = nil
-	case "verana.perm.v1.GenesisState.permission_sessions":
-		x.PermissionSessions = nil
-	case "verana.perm.v1.GenesisState.next_permission_id":
-		x.NextPermissionId = uint64(0)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message verana.perm.v1.GenesisState does not contain field %s", fd.FullName()))
-	}
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(SlashRecord)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "verana.perm.v1.GenesisState.params":
-		value := x.Params
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "verana.perm.v1.GenesisState.permissions":
-		if len(x.Permissions) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_2_list{})
-		}
-		listValue := &_GenesisState_2_list{list: &x.Permissions}
-		return protoreflect.ValueOfList(listValue)
-	case "verana.perm.v1.GenesisState.permission_sessions":
-		if len(x.PermissionSessions) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_3_list{})
-		}
-		listValue := &_GenesisState_3_list{list: &x.PermissionSessions}
-		return protoreflect.ValueOfList(listValue)
-	case "verana.perm.v1.GenesisState.next_permission_id":
-		value := x.NextPermissionId
-		return protoreflect.ValueOfUint64(value)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message verana.perm.v1.GenesisState does not contain field %s", descriptor.FullName()))
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_9_list)(nil)
+
+type _GenesisState_9_list struct {
+	list *[]*TrustRegistrySlashDistribution
+}
+
+func (x *_GenesisState_9_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "verana.perm.v1.GenesisState.params":
-		x.Params = value.Message().Interface().(*Params)
-	case "verana.perm.v1.GenesisState.permissions":
-		lv := value.List()
-		clv := lv.(*_GenesisState_2_list)
-		x.Permissions = *clv.list
-	case "verana.perm.v1.GenesisState.permission_sessions":
-		lv := value.List()
-		clv := lv.(*_GenesisState_3_list)
-		x.PermissionSessions = *clv.list
-	case "verana.perm.v1.GenesisState.next_permission_id":
-		x.NextPermissionId = value.Uint()
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message verana.perm.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TrustRegistrySlashDistribution)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TrustRegistrySlashDistribution)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_9_list) AppendMutable() protoreflect.Value {
+	v := new(TrustRegistrySlashDistribution)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "verana.perm.v1.GenesisState.params":
-		if x.Params == nil {
-			x.Params = new(Params)
-		}
-		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-	case "verana.perm.v1.GenesisState.permissions":
-		if x.Permissions == nil {
-			x.Permissions = []*Permission{}
-		}
-		value := &_GenesisState_2_list{list: &x.Permissions}
-		return protoreflect.ValueOfList(value)
-	case "verana.perm.v1.GenesisState.permission_sessions":
-		if x.PermissionSessions == nil {
-			x.PermissionSessions = []*PermissionSession{}
-		}
-		value := &_GenesisState_3_list{list: &x.PermissionSessions}
-		return protoreflect.ValueOfList(value)
-	case "verana.perm.v1.GenesisState.next_permission_id":
-		panic(fmt.Errorf("field next_permission_id of message verana.perm.v1.GenesisState is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message verana.perm.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_9_list) NewElement() protoreflect.Value {
+	v := new(TrustRegistrySlashDistribution)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*RevocationCascade
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "verana.perm.v1.GenesisState.params":
-		m := new(Params)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "verana.perm.v1.GenesisState.permissions":
-		list := []*Permission{}
-		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
-	case "verana.perm.v1.GenesisState.permission_sessions":
-		list := []*PermissionSession{}
-		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
-	case "verana.perm.v1.GenesisState.next_permission_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message verana.perm.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RevocationCascade)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RevocationCascade)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(RevocationCascade)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.GenesisState", d.FullName()))
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(RevocationCascade)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_11_list)(nil)
+
+type _GenesisState_11_list struct {
+	list *[]*AgentReward
+}
+
+func (x *_GenesisState_11_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	panic("unreachable")
+	return len(*x.list)
 }
 
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
+func (x *_GenesisState_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
+func (x *_GenesisState_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AgentReward)
+	(*x.list)[i] = concreteValue
 }
 
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_GenesisState) IsValid() bool {
-	return x != nil
+func (x *_GenesisState_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AgentReward)
+	*x.list = append(*x.list, concreteValue)
 }
 
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		if x.Params != nil {
-			l = options.Size(x.Params)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.Permissions) > 0 {
-			for _, e := range x.Permissions {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.PermissionSessions) > 0 {
-			for _, e := range x.PermissionSessions {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.NextPermissionId != 0 {
-			n += 1 + runtime.Sov(uint64(x.NextPermissionId))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
+func (x *_GenesisState_11_list) AppendMutable() protoreflect.Value {
+	v := new(AgentReward)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
+}
 
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if x.NextPermissionId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextPermissionId))
-			i--
-			dAtA[i] = 0x20
-		}
-		if len(x.PermissionSessions) > 0 {
-			for iNdEx := len(x.PermissionSessions) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.PermissionSessions[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+func (x *_GenesisState_11_list) NewElement() protoreflect.Value {
+	v := new(AgentReward)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_12_list)(nil)
+
+type _GenesisState_12_list struct {
+	list *[]*VerificationCredit
+}
+
+func (x *_GenesisState_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VerificationCredit)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VerificationCredit)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_12_list) AppendMutable() protoreflect.Value {
+	v := new(VerificationCredit)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_12_list) NewElement() protoreflect.Value {
+	v := new(VerificationCredit)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_14_list)(nil)
+
+type _GenesisState_14_list struct {
+	list *[]*VoucherNonce
+}
+
+func (x *_GenesisState_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VoucherNonce)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VoucherNonce)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_14_list) AppendMutable() protoreflect.Value {
+	v := new(VoucherNonce)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_14_list) NewElement() protoreflect.Value {
+	v := new(VoucherNonce)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_15_list)(nil)
+
+type _GenesisState_15_list struct {
+	list *[]*VPMessage
+}
+
+func (x *_GenesisState_15_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_15_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VPMessage)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_15_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VPMessage)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_15_list) AppendMutable() protoreflect.Value {
+	v := new(VPMessage)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_15_list) NewElement() protoreflect.Value {
+	v := new(VPMessage)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_16_list)(nil)
+
+type _GenesisState_16_list struct {
+	list *[]*PermissionFeeRecord
+}
+
+func (x *_GenesisState_16_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_16_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PermissionFeeRecord)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_16_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PermissionFeeRecord)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_16_list) AppendMutable() protoreflect.Value {
+	v := new(PermissionFeeRecord)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_16_list) NewElement() protoreflect.Value {
+	v := new(PermissionFeeRecord)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_GenesisState                             protoreflect.MessageDescriptor
+	fd_GenesisState_params                      protoreflect.FieldDescriptor
+	fd_GenesisState_permissions                 protoreflect.FieldDescriptor
+	fd_GenesisState_permission_sessions         protoreflect.FieldDescriptor
+	fd_GenesisState_next_permission_id          protoreflect.FieldDescriptor
+	fd_GenesisState_session_allowances          protoreflect.FieldDescriptor
+	fd_GenesisState_next_session_allowance_id   protoreflect.FieldDescriptor
+	fd_GenesisState_slash_records               protoreflect.FieldDescriptor
+	fd_GenesisState_next_slash_record_id        protoreflect.FieldDescriptor
+	fd_GenesisState_slash_distributions         protoreflect.FieldDescriptor
+	fd_GenesisState_revocation_cascades         protoreflect.FieldDescriptor
+	fd_GenesisState_agent_rewards               protoreflect.FieldDescriptor
+	fd_GenesisState_verification_credits        protoreflect.FieldDescriptor
+	fd_GenesisState_next_verification_credit_id protoreflect.FieldDescriptor
+	fd_GenesisState_voucher_nonces              protoreflect.FieldDescriptor
+	fd_GenesisState_vp_messages                 protoreflect.FieldDescriptor
+	fd_GenesisState_fee_records                 protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_genesis_proto_init()
+	md_GenesisState = File_verana_perm_v1_genesis_proto.Messages().ByName("GenesisState")
+	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_permissions = md_GenesisState.Fields().ByName("permissions")
+	fd_GenesisState_permission_sessions = md_GenesisState.Fields().ByName("permission_sessions")
+	fd_GenesisState_next_permission_id = md_GenesisState.Fields().ByName("next_permission_id")
+	fd_GenesisState_session_allowances = md_GenesisState.Fields().ByName("session_allowances")
+	fd_GenesisState_next_session_allowance_id = md_GenesisState.Fields().ByName("next_session_allowance_id")
+	fd_GenesisState_slash_records = md_GenesisState.Fields().ByName("slash_records")
+	fd_GenesisState_next_slash_record_id = md_GenesisState.Fields().ByName("next_slash_record_id")
+	fd_GenesisState_slash_distributions = md_GenesisState.Fields().ByName("slash_distributions")
+	fd_GenesisState_revocation_cascades = md_GenesisState.Fields().ByName("revocation_cascades")
+	fd_GenesisState_agent_rewards = md_GenesisState.Fields().ByName("agent_rewards")
+	fd_GenesisState_verification_credits = md_GenesisState.Fields().ByName("verification_credits")
+	fd_GenesisState_next_verification_credit_id = md_GenesisState.Fields().ByName("next_verification_credit_id")
+	fd_GenesisState_voucher_nonces = md_GenesisState.Fields().ByName("voucher_nonces")
+	fd_GenesisState_vp_messages = md_GenesisState.Fields().ByName("vp_messages")
+	fd_GenesisState_fee_records = md_GenesisState.Fields().ByName("fee_records")
+}
+
+var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+
+type fastReflection_GenesisState GenesisState
+
+func (x *GenesisState) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GenesisState)(x)
+}
+
+func (x *GenesisState) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_genesis_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
+var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+
+type fastReflection_GenesisState_messageType struct{}
+
+func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GenesisState)(nil)
+}
+func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
+	return _fastReflection_GenesisState_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GenesisState) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
+	return (*GenesisState)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_GenesisState_params, value) {
+			return
+		}
+	}
+	if len(x.Permissions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_2_list{list: &x.Permissions})
+		if !f(fd_GenesisState_permissions, value) {
+			return
+		}
+	}
+	if len(x.PermissionSessions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_3_list{list: &x.PermissionSessions})
+		if !f(fd_GenesisState_permission_sessions, value) {
+			return
+		}
+	}
+	if x.NextPermissionId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextPermissionId)
+		if !f(fd_GenesisState_next_permission_id, value) {
+			return
+		}
+	}
+	if len(x.SessionAllowances) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.SessionAllowances})
+		if !f(fd_GenesisState_session_allowances, value) {
+			return
+		}
+	}
+	if x.NextSessionAllowanceId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextSessionAllowanceId)
+		if !f(fd_GenesisState_next_session_allowance_id, value) {
+			return
+		}
+	}
+	if len(x.SlashRecords) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.SlashRecords})
+		if !f(fd_GenesisState_slash_records, value) {
+			return
+		}
+	}
+	if x.NextSlashRecordId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextSlashRecordId)
+		if !f(fd_GenesisState_next_slash_record_id, value) {
+			return
+		}
+	}
+	if len(x.SlashDistributions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.SlashDistributions})
+		if !f(fd_GenesisState_slash_distributions, value) {
+			return
+		}
+	}
+	if len(x.RevocationCascades) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.RevocationCascades})
+		if !f(fd_GenesisState_revocation_cascades, value) {
+			return
+		}
+	}
+	if len(x.AgentRewards) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_11_list{list: &x.AgentRewards})
+		if !f(fd_GenesisState_agent_rewards, value) {
+			return
+		}
+	}
+	if len(x.VerificationCredits) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_12_list{list: &x.VerificationCredits})
+		if !f(fd_GenesisState_verification_credits, value) {
+			return
+		}
+	}
+	if x.NextVerificationCreditId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextVerificationCreditId)
+		if !f(fd_GenesisState_next_verification_credit_id, value) {
+			return
+		}
+	}
+	if len(x.VoucherNonces) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_14_list{list: &x.VoucherNonces})
+		if !f(fd_GenesisState_voucher_nonces, value) {
+			return
+		}
+	}
+	if len(x.VpMessages) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_15_list{list: &x.VpMessages})
+		if !f(fd_GenesisState_vp_messages, value) {
+			return
+		}
+	}
+	if len(x.FeeRecords) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_16_list{list: &x.FeeRecords})
+		if !f(fd_GenesisState_fee_records, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.perm.v1.GenesisState.params":
+		return x.Params != nil
+	case "verana.perm.v1.GenesisState.permissions":
+		return len(x.Permissions) != 0
+	case "verana.perm.v1.GenesisState.permission_sessions":
+		return len(x.PermissionSessions) != 0
+	case "verana.perm.v1.GenesisState.next_permission_id":
+		return x.NextPermissionId != uint64(0)
+	case "verana.perm.v1.GenesisState.session_allowances":
+		return len(x.SessionAllowances) != 0
+	case "verana.perm.v1.GenesisState.next_session_allowance_id":
+		return x.NextSessionAllowanceId != uint64(0)
+	case "verana.perm.v1.GenesisState.slash_records":
+		return len(x.SlashRecords) != 0
+	case "verana.perm.v1.GenesisState.next_slash_record_id":
+		return x.NextSlashRecordId != uint64(0)
+	case "verana.perm.v1.GenesisState.slash_distributions":
+		return len(x.SlashDistributions) != 0
+	case "verana.perm.v1.GenesisState.revocation_cascades":
+		return len(x.RevocationCascades) != 0
+	case "verana.perm.v1.GenesisState.agent_rewards":
+		return len(x.AgentRewards) != 0
+	case "verana.perm.v1.GenesisState.verification_credits":
+		return len(x.VerificationCredits) != 0
+	case "verana.perm.v1.GenesisState.next_verification_credit_id":
+		return x.NextVerificationCreditId != uint64(0)
+	case "verana.perm.v1.GenesisState.voucher_nonces":
+		return len(x.VoucherNonces) != 0
+	case "verana.perm.v1.GenesisState.vp_messages":
+		return len(x.VpMessages) != 0
+	case "verana.perm.v1.GenesisState.fee_records":
+		return len(x.FeeRecords) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.perm.v1.GenesisState.params":
+		x.Params = nil
+	case "verana.perm.v1.GenesisState.permissions":
+		x.Permissions = nil
+	case "verana.perm.v1.GenesisState.permission_sessions":
+		x.PermissionSessions = nil
+	case "verana.perm.v1.GenesisState.next_permission_id":
+		x.NextPermissionId = uint64(0)
+	case "verana.perm.v1.GenesisState.session_allowances":
+		x.SessionAllowances = nil
+	case "verana.perm.v1.GenesisState.next_session_allowance_id":
+		x.NextSessionAllowanceId = uint64(0)
+	case "verana.perm.v1.GenesisState.slash_records":
+		x.SlashRecords = nil
+	case "verana.perm.v1.GenesisState.next_slash_record_id":
+		x.NextSlashRecordId = uint64(0)
+	case "verana.perm.v1.GenesisState.slash_distributions":
+		x.SlashDistributions = nil
+	case "verana.perm.v1.GenesisState.revocation_cascades":
+		x.RevocationCascades = nil
+	case "verana.perm.v1.GenesisState.agent_rewards":
+		x.AgentRewards = nil
+	case "verana.perm.v1.GenesisState.verification_credits":
+		x.VerificationCredits = nil
+	case "verana.perm.v1.GenesisState.next_verification_credit_id":
+		x.NextVerificationCreditId = uint64(0)
+	case "verana.perm.v1.GenesisState.voucher_nonces":
+		x.VoucherNonces = nil
+	case "verana.perm.v1.GenesisState.vp_messages":
+		x.VpMessages = nil
+	case "verana.perm.v1.GenesisState.fee_records":
+		x.FeeRecords = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.perm.v1.GenesisState.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.perm.v1.GenesisState.permissions":
+		if len(x.Permissions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_2_list{})
+		}
+		listValue := &_GenesisState_2_list{list: &x.Permissions}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.perm.v1.GenesisState.permission_sessions":
+		if len(x.PermissionSessions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_3_list{})
+		}
+		listValue := &_GenesisState_3_list{list: &x.PermissionSessions}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.perm.v1.GenesisState.next_permission_id":
+		value := x.NextPermissionId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.GenesisState.session_allowances":
+		if len(x.SessionAllowances) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.SessionAllowances}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.perm.v1.GenesisState.next_session_allowance_id":
+		value := x.NextSessionAllowanceId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.GenesisState.slash_records":
+		if len(x.SlashRecords) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.SlashRecords}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.perm.v1.GenesisState.next_slash_record_id":
+		value := x.NextSlashRecordId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.GenesisState.slash_distributions":
+		if len(x.SlashDistributions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+		}
+		listValue := &_GenesisState_9_list{list: &x.SlashDistributions}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.perm.v1.GenesisState.revocation_cascades":
+		if len(x.RevocationCascades) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.RevocationCascades}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.perm.v1.GenesisState.agent_rewards":
+		if len(x.AgentRewards) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_11_list{})
+		}
+		listValue := &_GenesisState_11_list{list: &x.AgentRewards}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.perm.v1.GenesisState.verification_credits":
+		if len(x.VerificationCredits) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_12_list{})
+		}
+		listValue := &_GenesisState_12_list{list: &x.VerificationCredits}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.perm.v1.GenesisState.next_verification_credit_id":
+		value := x.NextVerificationCreditId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.GenesisState.voucher_nonces":
+		if len(x.VoucherNonces) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_14_list{})
+		}
+		listValue := &_GenesisState_14_list{list: &x.VoucherNonces}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.perm.v1.GenesisState.vp_messages":
+		if len(x.VpMessages) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_15_list{})
+		}
+		listValue := &_GenesisState_15_list{list: &x.VpMessages}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.perm.v1.GenesisState.fee_records":
+		if len(x.FeeRecords) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_16_list{})
+		}
+		listValue := &_GenesisState_16_list{list: &x.FeeRecords}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.GenesisState does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.perm.v1.GenesisState.params":
+		x.Params = value.Message().Interface().(*Params)
+	case "verana.perm.v1.GenesisState.permissions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_2_list)
+		x.Permissions = *clv.list
+	case "verana.perm.v1.GenesisState.permission_sessions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_3_list)
+		x.PermissionSessions = *clv.list
+	case "verana.perm.v1.GenesisState.next_permission_id":
+		x.NextPermissionId = value.Uint()
+	case "verana.perm.v1.GenesisState.session_allowances":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.SessionAllowances = *clv.list
+	case "verana.perm.v1.GenesisState.next_session_allowance_id":
+		x.NextSessionAllowanceId = value.Uint()
+	case "verana.perm.v1.GenesisState.slash_records":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.SlashRecords = *clv.list
+	case "verana.perm.v1.GenesisState.next_slash_record_id":
+		x.NextSlashRecordId = value.Uint()
+	case "verana.perm.v1.GenesisState.slash_distributions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_9_list)
+		x.SlashDistributions = *clv.list
+	case "verana.perm.v1.GenesisState.revocation_cascades":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.RevocationCascades = *clv.list
+	case "verana.perm.v1.GenesisState.agent_rewards":
+		lv := value.List()
+		clv := lv.(*_GenesisState_11_list)
+		x.AgentRewards = *clv.list
+	case "verana.perm.v1.GenesisState.verification_credits":
+		lv := value.List()
+		clv := lv.(*_GenesisState_12_list)
+		x.VerificationCredits = *clv.list
+	case "verana.perm.v1.GenesisState.next_verification_credit_id":
+		x.NextVerificationCreditId = value.Uint()
+	case "verana.perm.v1.GenesisState.voucher_nonces":
+		lv := value.List()
+		clv := lv.(*_GenesisState_14_list)
+		x.VoucherNonces = *clv.list
+	case "verana.perm.v1.GenesisState.vp_messages":
+		lv := value.List()
+		clv := lv.(*_GenesisState_15_list)
+		x.VpMessages = *clv.list
+	case "verana.perm.v1.GenesisState.fee_records":
+		lv := value.List()
+		clv := lv.(*_GenesisState_16_list)
+		x.FeeRecords = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.GenesisState.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "verana.perm.v1.GenesisState.permissions":
+		if x.Permissions == nil {
+			x.Permissions = []*Permission{}
+		}
+		value := &_GenesisState_2_list{list: &x.Permissions}
+		return protoreflect.ValueOfList(value)
+	case "verana.perm.v1.GenesisState.permission_sessions":
+		if x.PermissionSessions == nil {
+			x.PermissionSessions = []*PermissionSession{}
+		}
+		value := &_GenesisState_3_list{list: &x.PermissionSessions}
+		return protoreflect.ValueOfList(value)
+	case "verana.perm.v1.GenesisState.session_allowances":
+		if x.SessionAllowances == nil {
+			x.SessionAllowances = []*SessionAllowance{}
+		}
+		value := &_GenesisState_5_list{list: &x.SessionAllowances}
+		return protoreflect.ValueOfList(value)
+	case "verana.perm.v1.GenesisState.slash_records":
+		if x.SlashRecords == nil {
+			x.SlashRecords = []*SlashRecord{}
+		}
+		value := &_GenesisState_7_list{list: &x.SlashRecords}
+		return protoreflect.ValueOfList(value)
+	case "verana.perm.v1.GenesisState.slash_distributions":
+		if x.SlashDistributions == nil {
+			x.SlashDistributions = []*TrustRegistrySlashDistribution{}
+		}
+		value := &_GenesisState_9_list{list: &x.SlashDistributions}
+		return protoreflect.ValueOfList(value)
+	case "verana.perm.v1.GenesisState.revocation_cascades":
+		if x.RevocationCascades == nil {
+			x.RevocationCascades = []*RevocationCascade{}
+		}
+		value := &_GenesisState_10_list{list: &x.RevocationCascades}
+		return protoreflect.ValueOfList(value)
+	case "verana.perm.v1.GenesisState.agent_rewards":
+		if x.AgentRewards == nil {
+			x.AgentRewards = []*AgentReward{}
+		}
+		value := &_GenesisState_11_list{list: &x.AgentRewards}
+		return protoreflect.ValueOfList(value)
+	case "verana.perm.v1.GenesisState.verification_credits":
+		if x.VerificationCredits == nil {
+			x.VerificationCredits = []*VerificationCredit{}
+		}
+		value := &_GenesisState_12_list{list: &x.VerificationCredits}
+		return protoreflect.ValueOfList(value)
+	case "verana.perm.v1.GenesisState.voucher_nonces":
+		if x.VoucherNonces == nil {
+			x.VoucherNonces = []*VoucherNonce{}
+		}
+		value := &_GenesisState_14_list{list: &x.VoucherNonces}
+		return protoreflect.ValueOfList(value)
+	case "verana.perm.v1.GenesisState.vp_messages":
+		if x.VpMessages == nil {
+			x.VpMessages = []*VPMessage{}
+		}
+		value := &_GenesisState_15_list{list: &x.VpMessages}
+		return protoreflect.ValueOfList(value)
+	case "verana.perm.v1.GenesisState.fee_records":
+		if x.FeeRecords == nil {
+			x.FeeRecords = []*PermissionFeeRecord{}
+		}
+		value := &_GenesisState_16_list{list: &x.FeeRecords}
+		return protoreflect.ValueOfList(value)
+	case "verana.perm.v1.GenesisState.next_permission_id":
+		panic(fmt.Errorf("field next_permission_id of message verana.perm.v1.GenesisState is not mutable"))
+	case "verana.perm.v1.GenesisState.next_session_allowance_id":
+		panic(fmt.Errorf("field next_session_allowance_id of message verana.perm.v1.GenesisState is not mutable"))
+	case "verana.perm.v1.GenesisState.next_slash_record_id":
+		panic(fmt.Errorf("field next_slash_record_id of message verana.perm.v1.GenesisState is not mutable"))
+	case "verana.perm.v1.GenesisState.next_verification_credit_id":
+		panic(fmt.Errorf("field next_verification_credit_id of message verana.perm.v1.GenesisState is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.GenesisState.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.perm.v1.GenesisState.permissions":
+		list := []*Permission{}
+		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
+	case "verana.perm.v1.GenesisState.permission_sessions":
+		list := []*PermissionSession{}
+		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
+	case "verana.perm.v1.GenesisState.next_permission_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.GenesisState.session_allowances":
+		list := []*SessionAllowance{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "verana.perm.v1.GenesisState.next_session_allowance_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.GenesisState.slash_records":
+		list := []*SlashRecord{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+	case "verana.perm.v1.GenesisState.next_slash_record_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.GenesisState.slash_distributions":
+		list := []*TrustRegistrySlashDistribution{}
+		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
+	case "verana.perm.v1.GenesisState.revocation_cascades":
+		list := []*RevocationCascade{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "verana.perm.v1.GenesisState.agent_rewards":
+		list := []*AgentReward{}
+		return protoreflect.ValueOfList(&_GenesisState_11_list{list: &list})
+	case "verana.perm.v1.GenesisState.verification_credits":
+		list := []*VerificationCredit{}
+		return protoreflect.ValueOfList(&_GenesisState_12_list{list: &list})
+	case "verana.perm.v1.GenesisState.next_verification_credit_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.GenesisState.voucher_nonces":
+		list := []*VoucherNonce{}
+		return protoreflect.ValueOfList(&_GenesisState_14_list{list: &list})
+	case "verana.perm.v1.GenesisState.vp_messages":
+		list := []*VPMessage{}
+		return protoreflect.ValueOfList(&_GenesisState_15_list{list: &list})
+	case "verana.perm.v1.GenesisState.fee_records":
+		list := []*PermissionFeeRecord{}
+		return protoreflect.ValueOfList(&_GenesisState_16_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.GenesisState", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GenesisState) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Permissions) > 0 {
+			for _, e := range x.Permissions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PermissionSessions) > 0 {
+			for _, e := range x.PermissionSessions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextPermissionId != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextPermissionId))
+		}
+		if len(x.SessionAllowances) > 0 {
+			for _, e := range x.SessionAllowances {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextSessionAllowanceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextSessionAllowanceId))
+		}
+		if len(x.SlashRecords) > 0 {
+			for _, e := range x.SlashRecords {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextSlashRecordId != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextSlashRecordId))
+		}
+		if len(x.SlashDistributions) > 0 {
+			for _, e := range x.SlashDistributions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RevocationCascades) > 0 {
+			for _, e := range x.RevocationCascades {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AgentRewards) > 0 {
+			for _, e := range x.AgentRewards {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.VerificationCredits) > 0 {
+			for _, e := range x.VerificationCredits {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextVerificationCreditId != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextVerificationCreditId))
+		}
+		if len(x.VoucherNonces) > 0 {
+			for _, e := range x.VoucherNonces {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.VpMessages) > 0 {
+			for _, e := range x.VpMessages {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.FeeRecords) > 0 {
+			for _, e := range x.FeeRecords {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.FeeRecords) > 0 {
+			for iNdEx := len(x.FeeRecords) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeeRecords[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if len(x.VpMessages) > 0 {
+			for iNdEx := len(x.VpMessages) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.VpMessages[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x7a
+			}
+		}
+		if len(x.VoucherNonces) > 0 {
+			for iNdEx := len(x.VoucherNonces) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.VoucherNonces[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if x.NextVerificationCreditId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextVerificationCreditId))
+			i--
+			dAtA[i] = 0x68
+		}
+		if len(x.VerificationCredits) > 0 {
+			for iNdEx := len(x.VerificationCredits) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.VerificationCredits[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if len(x.AgentRewards) > 0 {
+			for iNdEx := len(x.AgentRewards) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AgentRewards[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if len(x.RevocationCascades) > 0 {
+			for iNdEx := len(x.RevocationCascades) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RevocationCascades[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.SlashDistributions) > 0 {
+			for iNdEx := len(x.SlashDistributions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SlashDistributions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if x.NextSlashRecordId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextSlashRecordId))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.SlashRecords) > 0 {
+			for iNdEx := len(x.SlashRecords) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SlashRecords[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if x.NextSessionAllowanceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextSessionAllowanceId))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.SessionAllowances) > 0 {
+			for iNdEx := len(x.SessionAllowances) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SessionAllowances[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.NextPermissionId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextPermissionId))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.PermissionSessions) > 0 {
+			for iNdEx := len(x.PermissionSessions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PermissionSessions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Permissions) > 0 {
+			for iNdEx := len(x.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Permissions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Permissions = append(x.Permissions, &Permission{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Permissions[len(x.Permissions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PermissionSessions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PermissionSessions = append(x.PermissionSessions, &PermissionSession{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PermissionSessions[len(x.PermissionSessions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextPermissionId", wireType)
+				}
+				x.NextPermissionId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextPermissionId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SessionAllowances", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SessionAllowances = append(x.SessionAllowances, &SessionAllowance{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SessionAllowances[len(x.SessionAllowances)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextSessionAllowanceId", wireType)
+				}
+				x.NextSessionAllowanceId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextSessionAllowanceId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashRecords", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SlashRecords = append(x.SlashRecords, &SlashRecord{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SlashRecords[len(x.SlashRecords)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextSlashRecordId", wireType)
+				}
+				x.NextSlashRecordId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextSlashRecordId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashDistributions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1a
-			}
-		}
-		if len(x.Permissions) > 0 {
-			for iNdEx := len(x.Permissions) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Permissions[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-			}
-		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if iNdEx >= l {
+				x.SlashDistributions = append(x.SlashDistributions, &TrustRegistrySlashDistribution{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SlashDistributions[len(x.SlashDistributions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RevocationCascades", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				x.RevocationCascades = append(x.RevocationCascades, &RevocationCascade{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RevocationCascades[len(x.RevocationCascades)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				iNdEx = postIndex
+			case 11:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AgentRewards", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -644,16 +1966,67 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &Params{}
+				x.AgentRewards = append(x.AgentRewards, &AgentReward{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AgentRewards[len(x.AgentRewards)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerificationCredits", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VerificationCredits = append(x.VerificationCredits, &VerificationCredit{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VerificationCredits[len(x.VerificationCredits)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 2:
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextVerificationCreditId", wireType)
+				}
+				x.NextVerificationCreditId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextVerificationCreditId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VoucherNonces", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -680,14 +2053,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Permissions = append(x.Permissions, &Permission{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Permissions[len(x.Permissions)-1]); err != nil {
+				x.VoucherNonces = append(x.VoucherNonces, &VoucherNonce{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VoucherNonces[len(x.VoucherNonces)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 3:
+			case 15:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PermissionSessions", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VpMessages", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -714,16 +2087,16 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PermissionSessions = append(x.PermissionSessions, &PermissionSession{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PermissionSessions[len(x.PermissionSessions)-1]); err != nil {
+				x.VpMessages = append(x.VpMessages, &VPMessage{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VpMessages[len(x.VpMessages)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextPermissionId", wireType)
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeRecords", wireType)
 				}
-				x.NextPermissionId = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -733,11 +2106,26 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.NextPermissionId |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeRecords = append(x.FeeRecords, &PermissionFeeRecord{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeRecords[len(x.FeeRecords)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -800,6 +2188,30 @@ type GenesisState struct {
 	PermissionSessions []*PermissionSession `protobuf:"bytes,3,rep,name=permission_sessions,json=permissionSessions,proto3" json:"permission_sessions,omitempty"`
 	// next_permission_id is the next auto-increment ID to be assigned to a new permission
 	NextPermissionId uint64 `protobuf:"varint,4,opt,name=next_permission_id,json=nextPermissionId,proto3" json:"next_permission_id,omitempty"`
+	// session_allowances is a list of all SessionAllowance objects
+	SessionAllowances []*SessionAllowance `protobuf:"bytes,5,rep,name=session_allowances,json=sessionAllowances,proto3" json:"session_allowances,omitempty"`
+	// next_session_allowance_id is the next auto-increment ID to be assigned to a new session allowance
+	NextSessionAllowanceId uint64 `protobuf:"varint,6,opt,name=next_session_allowance_id,json=nextSessionAllowanceId,proto3" json:"next_session_allowance_id,omitempty"`
+	// slash_records is a list of all SlashRecord objects
+	SlashRecords []*SlashRecord `protobuf:"bytes,7,rep,name=slash_records,json=slashRecords,proto3" json:"slash_records,omitempty"`
+	// next_slash_record_id is the next auto-increment ID to be assigned to a new slash record
+	NextSlashRecordId uint64 `protobuf:"varint,8,opt,name=next_slash_record_id,json=nextSlashRecordId,proto3" json:"next_slash_record_id,omitempty"`
+	// slash_distributions is a list of the trust registry slash distributions
+	SlashDistributions []*TrustRegistrySlashDistribution `protobuf:"bytes,9,rep,name=slash_distributions,json=slashDistributions,proto3" json:"slash_distributions,omitempty"`
+	// revocation_cascades is a list of the cascading revocations in progress
+	RevocationCascades []*RevocationCascade `protobuf:"bytes,10,rep,name=revocation_cascades,json=revocationCascades,proto3" json:"revocation_cascades,omitempty"`
+	// agent_rewards is a list of the rewards accrued by agent perms
+	AgentRewards []*AgentReward `protobuf:"bytes,11,rep,name=agent_rewards,json=agentRewards,proto3" json:"agent_rewards,omitempty"`
+	// verification_credits is a list of all VerificationCredit objects
+	VerificationCredits []*VerificationCredit `protobuf:"bytes,12,rep,name=verification_credits,json=verificationCredits,proto3" json:"verification_credits,omitempty"`
+	// next_verification_credit_id is the next verification credit ID to be assigned
+	NextVerificationCreditId uint64 `protobuf:"varint,13,opt,name=next_verification_credit_id,json=nextVerificationCreditId,proto3" json:"next_verification_credit_id,omitempty"`
+	// voucher_nonces is a list of the voucher nonces settled per signer
+	VoucherNonces []*VoucherNonce `protobuf:"bytes,14,rep,name=voucher_nonces,json=voucherNonces,proto3" json:"voucher_nonces,omitempty"`
+	// vp_messages is a list of the messages anchored for validation processes
+	VpMessages []*VPMessage `protobuf:"bytes,15,rep,name=vp_messages,json=vpMessages,proto3" json:"vp_messages,omitempty"`
+	// fee_records is the fee history of the perms
+	FeeRecords []*PermissionFeeRecord `protobuf:"bytes,16,rep,name=fee_records,json=feeRecords,proto3" json:"fee_records,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -850,6 +2262,90 @@ func (x *GenesisState) GetNextPermissionId() uint64 {
 	return 0
 }
 
+func (x *GenesisState) GetSessionAllowances() []*SessionAllowance {
+	if x != nil {
+		return x.SessionAllowances
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNextSessionAllowanceId() uint64 {
+	if x != nil {
+		return x.NextSessionAllowanceId
+	}
+	return 0
+}
+
+func (x *GenesisState) GetSlashRecords() []*SlashRecord {
+	if x != nil {
+		return x.SlashRecords
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNextSlashRecordId() uint64 {
+	if x != nil {
+		return x.NextSlashRecordId
+	}
+	return 0
+}
+
+func (x *GenesisState) GetSlashDistributions() []*TrustRegistrySlashDistribution {
+	if x != nil {
+		return x.SlashDistributions
+	}
+	return nil
+}
+
+func (x *GenesisState) GetRevocationCascades() []*RevocationCascade {
+	if x != nil {
+		return x.RevocationCascades
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAgentRewards() []*AgentReward {
+	if x != nil {
+		return x.AgentRewards
+	}
+	return nil
+}
+
+func (x *GenesisState) GetVerificationCredits() []*VerificationCredit {
+	if x != nil {
+		return x.VerificationCredits
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNextVerificationCreditId() uint64 {
+	if x != nil {
+		return x.NextVerificationCreditId
+	}
+	return 0
+}
+
+func (x *GenesisState) GetVoucherNonces() []*VoucherNonce {
+	if x != nil {
+		return x.VoucherNonces
+	}
+	return nil
+}
+
+func (x *GenesisState) GetVpMessages() []*VPMessage {
+	if x != nil {
+		return x.VpMessages
+	}
+	return nil
+}
+
+func (x *GenesisState) GetFeeRecords() []*PermissionFeeRecord {
+	if x != nil {
+		return x.FeeRecords
+	}
+	return nil
+}
+
 var File_verana_perm_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_verana_perm_v1_genesis_proto_rawDesc = []byte{
@@ -862,7 +2358,7 @@ var file_verana_perm_v1_genesis_proto_rawDesc = []byte{
 	0x70, 0x65, 0x72, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x70, 0x65, 0x72,
 	0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x95, 0x02, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74,
+	0x22, 0x9e, 0x09, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74,
 	0x65, 0x12, 0x39, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x16, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e,
 	0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8,
@@ -879,20 +2375,76 @@ var file_verana_perm_v1_genesis_proto_rawDesc = []byte{
 	0x6f, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6e, 0x65,
 	0x78, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
 	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x65, 0x72, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x42, 0xc0, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d,
-	0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x42,
-	0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x42, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x65, 0x72, 0x61,
-	0x6e, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2d, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x65,
-	0x72, 0x61, 0x6e, 0x61, 0x2f, 0x70, 0x65, 0x72, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x65, 0x72,
-	0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x56, 0x50, 0x58, 0xaa, 0x02, 0x0e, 0x56, 0x65, 0x72, 0x61,
-	0x6e, 0x61, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x56, 0x65, 0x72,
-	0x61, 0x6e, 0x61, 0x5c, 0x50, 0x65, 0x72, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x56, 0x65,
-	0x72, 0x61, 0x6e, 0x61, 0x5c, 0x50, 0x65, 0x72, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x56, 0x65, 0x72, 0x61, 0x6e,
-	0x61, 0x3a, 0x3a, 0x50, 0x65, 0x72, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x55, 0x0a, 0x12, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65,
+	0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c,
+	0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x11, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12,
+	0x39, 0x0a, 0x19, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x16, 0x6e, 0x65, 0x78, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41,
+	0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0d, 0x73, 0x6c,
+	0x61, 0x73, 0x68, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68,
+	0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x11, 0x6e, 0x65, 0x78, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x49, 0x64, 0x12, 0x65, 0x0a, 0x13, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x64, 0x69, 0x73,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2e, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x53,
+	0x6c, 0x61, 0x73, 0x68, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x12, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x44, 0x69, 0x73,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x58, 0x0a, 0x13, 0x72, 0x65,
+	0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x61, 0x73, 0x63, 0x61, 0x64, 0x65,
+	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61,
+	0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x61, 0x73, 0x63, 0x61, 0x64, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x12, 0x72, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x73, 0x63,
+	0x61, 0x64, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x0d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x65,
+	0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x5b, 0x0a, 0x14,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x76, 0x65, 0x72,
+	0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x1b, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x18,
+	0x6e, 0x65, 0x78, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x0e, 0x76, 0x6f, 0x75, 0x63,
+	0x68, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x4e, 0x6f, 0x6e,
+	0x63, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x0b, 0x76, 0x70, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e,
+	0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x50, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x76, 0x70, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x0b, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x76, 0x65, 0x72,
+	0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x66, 0x65, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x42, 0xc0, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61,
+	0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
+	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x42, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73,
+	0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x70, 0x65,
+	0x72, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x65, 0x72, 0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x56,
+	0x50, 0x58, 0xaa, 0x02, 0x0e, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x50, 0x65, 0x72, 0x6d,
+	0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x50, 0x65, 0x72,
+	0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x50, 0x65,
+	0x72, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x10, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x3a, 0x3a, 0x50, 0x65, 0x72, 0x6d,
+	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -909,20 +2461,38 @@ func file_verana_perm_v1_genesis_proto_rawDescGZIP() []byte {
 
 var file_verana_perm_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_verana_perm_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),      // 0: verana.perm.v1.GenesisState
-	(*Params)(nil),            // 1: verana.perm.v1.Params
-	(*Permission)(nil),        // 2: verana.perm.v1.Permission
-	(*PermissionSession)(nil), // 3: verana.perm.v1.PermissionSession
+	(*GenesisState)(nil),                   // 0: verana.perm.v1.GenesisState
+	(*Params)(nil),                         // 1: verana.perm.v1.Params
+	(*Permission)(nil),                     // 2: verana.perm.v1.Permission
+	(*PermissionSession)(nil),              // 3: verana.perm.v1.PermissionSession
+	(*SessionAllowance)(nil),               // 4: verana.perm.v1.SessionAllowance
+	(*SlashRecord)(nil),                    // 5: verana.perm.v1.SlashRecord
+	(*TrustRegistrySlashDistribution)(nil), // 6: verana.perm.v1.TrustRegistrySlashDistribution
+	(*RevocationCascade)(nil),              // 7: verana.perm.v1.RevocationCascade
+	(*AgentReward)(nil),                    // 8: verana.perm.v1.AgentReward
+	(*VerificationCredit)(nil),             // 9: verana.perm.v1.VerificationCredit
+	(*VoucherNonce)(nil),                   // 10: verana.perm.v1.VoucherNonce
+	(*VPMessage)(nil),                      // 11: verana.perm.v1.VPMessage
+	(*PermissionFeeRecord)(nil),            // 12: verana.perm.v1.PermissionFeeRecord
 }
 var file_verana_perm_v1_genesis_proto_depIdxs = []int32{
-	1, // 0: verana.perm.v1.GenesisState.params:type_name -> verana.perm.v1.Params
-	2, // 1: verana.perm.v1.GenesisState.permissions:type_name -> verana.perm.v1.Permission
-	3, // 2: verana.perm.v1.GenesisState.permission_sessions:type_name -> verana.perm.v1.PermissionSession
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	1,  // 0: verana.perm.v1.GenesisState.params:type_name -> verana.perm.v1.Params
+	2,  // 1: verana.perm.v1.GenesisState.permissions:type_name -> verana.perm.v1.Permission
+	3,  // 2: verana.perm.v1.GenesisState.permission_sessions:type_name -> verana.perm.v1.PermissionSession
+	4,  // 3: verana.perm.v1.GenesisState.session_allowances:type_name -> verana.perm.v1.SessionAllowance
+	5,  // 4: verana.perm.v1.GenesisState.slash_records:type_name -> verana.perm.v1.SlashRecord
+	6,  // 5: verana.perm.v1.GenesisState.slash_distributions:type_name -> verana.perm.v1.TrustRegistrySlashDistribution
+	7,  // 6: verana.perm.v1.GenesisState.revocation_cascades:type_name -> verana.perm.v1.RevocationCascade
+	8,  // 7: verana.perm.v1.GenesisState.agent_rewards:type_name -> verana.perm.v1.AgentReward
+	9,  // 8: verana.perm.v1.GenesisState.verification_credits:type_name -> verana.perm.v1.VerificationCredit
+	10, // 9: verana.perm.v1.GenesisState.voucher_nonces:type_name -> verana.perm.v1.VoucherNonce
+	11, // 10: verana.perm.v1.GenesisState.vp_messages:type_name -> verana.perm.v1.VPMessage
+	12, // 11: verana.perm.v1.GenesisState.fee_records:type_name -> verana.perm.v1.PermissionFeeRecord
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_verana_perm_v1_genesis_proto_init() }
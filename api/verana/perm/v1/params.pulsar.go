@@ -17,12 +17,22 @@ import (
 var (
 	md_Params                                        protoreflect.MessageDescriptor
 	fd_Params_validation_term_requested_timeout_days protoreflect.FieldDescriptor
+	fd_Params_slash_appeal_period_days               protoreflect.FieldDescriptor
+	fd_Params_cascade_batch_size                     protoreflect.FieldDescriptor
+	fd_Params_session_max_duration_days              protoreflect.FieldDescriptor
+	fd_Params_session_max_authz                      protoreflect.FieldDescriptor
+	fd_Params_fee_update_notice_period_days          protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_verana_perm_v1_params_proto_init()
 	md_Params = File_verana_perm_v1_params_proto.Messages().ByName("Params")
 	fd_Params_validation_term_requested_timeout_days = md_Params.Fields().ByName("validation_term_requested_timeout_days")
+	fd_Params_slash_appeal_period_days = md_Params.Fields().ByName("slash_appeal_period_days")
+	fd_Params_cascade_batch_size = md_Params.Fields().ByName("cascade_batch_size")
+	fd_Params_session_max_duration_days = md_Params.Fields().ByName("session_max_duration_days")
+	fd_Params_session_max_authz = md_Params.Fields().ByName("session_max_authz")
+	fd_Params_fee_update_notice_period_days = md_Params.Fields().ByName("fee_update_notice_period_days")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -96,6 +106,36 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.SlashAppealPeriodDays != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SlashAppealPeriodDays)
+		if !f(fd_Params_slash_appeal_period_days, value) {
+			return
+		}
+	}
+	if x.CascadeBatchSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CascadeBatchSize)
+		if !f(fd_Params_cascade_batch_size, value) {
+			return
+		}
+	}
+	if x.SessionMaxDurationDays != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SessionMaxDurationDays)
+		if !f(fd_Params_session_max_duration_days, value) {
+			return
+		}
+	}
+	if x.SessionMaxAuthz != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SessionMaxAuthz)
+		if !f(fd_Params_session_max_authz, value) {
+			return
+		}
+	}
+	if x.FeeUpdateNoticePeriodDays != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.FeeUpdateNoticePeriodDays)
+		if !f(fd_Params_fee_update_notice_period_days, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -113,6 +153,16 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	case "verana.perm.v1.Params.validation_term_requested_timeout_days":
 		return x.ValidationTermRequestedTimeoutDays != uint64(0)
+	case "verana.perm.v1.Params.slash_appeal_period_days":
+		return x.SlashAppealPeriodDays != uint64(0)
+	case "verana.perm.v1.Params.cascade_batch_size":
+		return x.CascadeBatchSize != uint64(0)
+	case "verana.perm.v1.Params.session_max_duration_days":
+		return x.SessionMaxDurationDays != uint64(0)
+	case "verana.perm.v1.Params.session_max_authz":
+		return x.SessionMaxAuthz != uint64(0)
+	case "verana.perm.v1.Params.fee_update_notice_period_days":
+		return x.FeeUpdateNoticePeriodDays != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.Params"))
@@ -131,6 +181,16 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "verana.perm.v1.Params.validation_term_requested_timeout_days":
 		x.ValidationTermRequestedTimeoutDays = uint64(0)
+	case "verana.perm.v1.Params.slash_appeal_period_days":
+		x.SlashAppealPeriodDays = uint64(0)
+	case "verana.perm.v1.Params.cascade_batch_size":
+		x.CascadeBatchSize = uint64(0)
+	case "verana.perm.v1.Params.session_max_duration_days":
+		x.SessionMaxDurationDays = uint64(0)
+	case "verana.perm.v1.Params.session_max_authz":
+		x.SessionMaxAuthz = uint64(0)
+	case "verana.perm.v1.Params.fee_update_notice_period_days":
+		x.FeeUpdateNoticePeriodDays = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.Params"))
@@ -150,6 +210,21 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "verana.perm.v1.Params.validation_term_requested_timeout_days":
 		value := x.ValidationTermRequestedTimeoutDays
 		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.Params.slash_appeal_period_days":
+		value := x.SlashAppealPeriodDays
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.Params.cascade_batch_size":
+		value := x.CascadeBatchSize
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.Params.session_max_duration_days":
+		value := x.SessionMaxDurationDays
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.Params.session_max_authz":
+		value := x.SessionMaxAuthz
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.Params.fee_update_notice_period_days":
+		value := x.FeeUpdateNoticePeriodDays
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.Params"))
@@ -172,6 +247,16 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 	switch fd.FullName() {
 	case "verana.perm.v1.Params.validation_term_requested_timeout_days":
 		x.ValidationTermRequestedTimeoutDays = value.Uint()
+	case "verana.perm.v1.Params.slash_appeal_period_days":
+		x.SlashAppealPeriodDays = value.Uint()
+	case "verana.perm.v1.Params.cascade_batch_size":
+		x.CascadeBatchSize = value.Uint()
+	case "verana.perm.v1.Params.session_max_duration_days":
+		x.SessionMaxDurationDays = value.Uint()
+	case "verana.perm.v1.Params.session_max_authz":
+		x.SessionMaxAuthz = value.Uint()
+	case "verana.perm.v1.Params.fee_update_notice_period_days":
+		x.FeeUpdateNoticePeriodDays = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.Params"))
@@ -194,6 +279,16 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 	switch fd.FullName() {
 	case "verana.perm.v1.Params.validation_term_requested_timeout_days":
 		panic(fmt.Errorf("field validation_term_requested_timeout_days of message verana.perm.v1.Params is not mutable"))
+	case "verana.perm.v1.Params.slash_appeal_period_days":
+		panic(fmt.Errorf("field slash_appeal_period_days of message verana.perm.v1.Params is not mutable"))
+	case "verana.perm.v1.Params.cascade_batch_size":
+		panic(fmt.Errorf("field cascade_batch_size of message verana.perm.v1.Params is not mutable"))
+	case "verana.perm.v1.Params.session_max_duration_days":
+		panic(fmt.Errorf("field session_max_duration_days of message verana.perm.v1.Params is not mutable"))
+	case "verana.perm.v1.Params.session_max_authz":
+		panic(fmt.Errorf("field session_max_authz of message verana.perm.v1.Params is not mutable"))
+	case "verana.perm.v1.Params.fee_update_notice_period_days":
+		panic(fmt.Errorf("field fee_update_notice_period_days of message verana.perm.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.Params"))
@@ -209,6 +304,16 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 	switch fd.FullName() {
 	case "verana.perm.v1.Params.validation_term_requested_timeout_days":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.Params.slash_appeal_period_days":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.Params.cascade_batch_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.Params.session_max_duration_days":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.Params.session_max_authz":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.Params.fee_update_notice_period_days":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.Params"))
@@ -281,6 +386,21 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.ValidationTermRequestedTimeoutDays != 0 {
 			n += 1 + runtime.Sov(uint64(x.ValidationTermRequestedTimeoutDays))
 		}
+		if x.SlashAppealPeriodDays != 0 {
+			n += 1 + runtime.Sov(uint64(x.SlashAppealPeriodDays))
+		}
+		if x.CascadeBatchSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.CascadeBatchSize))
+		}
+		if x.SessionMaxDurationDays != 0 {
+			n += 1 + runtime.Sov(uint64(x.SessionMaxDurationDays))
+		}
+		if x.SessionMaxAuthz != 0 {
+			n += 1 + runtime.Sov(uint64(x.SessionMaxAuthz))
+		}
+		if x.FeeUpdateNoticePeriodDays != 0 {
+			n += 1 + runtime.Sov(uint64(x.FeeUpdateNoticePeriodDays))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -310,6 +430,31 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.FeeUpdateNoticePeriodDays != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FeeUpdateNoticePeriodDays))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.SessionMaxAuthz != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SessionMaxAuthz))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.SessionMaxDurationDays != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SessionMaxDurationDays))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.CascadeBatchSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CascadeBatchSize))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.SlashAppealPeriodDays != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SlashAppealPeriodDays))
+			i--
+			dAtA[i] = 0x10
+		}
 		if x.ValidationTermRequestedTimeoutDays != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidationTermRequestedTimeoutDays))
 			i--
@@ -383,6 +528,101 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashAppealPeriodDays", wireType)
+				}
+				x.SlashAppealPeriodDays = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SlashAppealPeriodDays |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CascadeBatchSize", wireType)
+				}
+				x.CascadeBatchSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CascadeBatchSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SessionMaxDurationDays", wireType)
+				}
+				x.SessionMaxDurationDays = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SessionMaxDurationDays |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SessionMaxAuthz", wireType)
+				}
+				x.SessionMaxAuthz = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SessionMaxAuthz |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeUpdateNoticePeriodDays", wireType)
+				}
+				x.FeeUpdateNoticePeriodDays = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FeeUpdateNoticePeriodDays |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -438,6 +678,19 @@ type Params struct {
 	unknownFields protoimpl.UnknownFields
 
 	ValidationTermRequestedTimeoutDays uint64 `protobuf:"varint,1,opt,name=validation_term_requested_timeout_days,json=validationTermRequestedTimeoutDays,proto3" json:"validation_term_requested_timeout_days,omitempty"`
+	// slash_appeal_period_days is the number of days a slashed grantee has to
+	// appeal a slash before it is executed
+	SlashAppealPeriodDays uint64 `protobuf:"varint,2,opt,name=slash_appeal_period_days,json=slashAppealPeriodDays,proto3" json:"slash_appeal_period_days,omitempty"`
+	// cascade_batch_size is the maximum number of descendant perms a cascading
+	// revocation processes in the revocation transaction, and then in each block
+	CascadeBatchSize uint64 `protobuf:"varint,3,opt,name=cascade_batch_size,json=cascadeBatchSize,proto3" json:"cascade_batch_size,omitempty"`
+	// session_max_duration_days is the maximum lifetime of a perm session
+	SessionMaxDurationDays uint64 `protobuf:"varint,4,opt,name=session_max_duration_days,json=sessionMaxDurationDays,proto3" json:"session_max_duration_days,omitempty"`
+	// session_max_authz is the maximum number of authz entries of a perm session
+	SessionMaxAuthz uint64 `protobuf:"varint,5,opt,name=session_max_authz,json=sessionMaxAuthz,proto3" json:"session_max_authz,omitempty"`
+	// fee_update_notice_period_days is the number of days after which the fees
+	// set with MsgUpdatePermissionFees take effect
+	FeeUpdateNoticePeriodDays uint64 `protobuf:"varint,6,opt,name=fee_update_notice_period_days,json=feeUpdateNoticePeriodDays,proto3" json:"fee_update_notice_period_days,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -467,6 +720,41 @@ func (x *Params) GetValidationTermRequestedTimeoutDays() uint64 {
 	return 0
 }
 
+func (x *Params) GetSlashAppealPeriodDays() uint64 {
+	if x != nil {
+		return x.SlashAppealPeriodDays
+	}
+	return 0
+}
+
+func (x *Params) GetCascadeBatchSize() uint64 {
+	if x != nil {
+		return x.CascadeBatchSize
+	}
+	return 0
+}
+
+func (x *Params) GetSessionMaxDurationDays() uint64 {
+	if x != nil {
+		return x.SessionMaxDurationDays
+	}
+	return 0
+}
+
+func (x *Params) GetSessionMaxAuthz() uint64 {
+	if x != nil {
+		return x.SessionMaxAuthz
+	}
+	return 0
+}
+
+func (x *Params) GetFeeUpdateNoticePeriodDays() uint64 {
+	if x != nil {
+		return x.FeeUpdateNoticePeriodDays
+	}
+	return 0
+}
+
 var File_verana_perm_v1_params_proto protoreflect.FileDescriptor
 
 var file_verana_perm_v1_params_proto_rawDesc = []byte{
@@ -475,13 +763,30 @@ var file_verana_perm_v1_params_proto_rawDesc = []byte{
 	0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x1a, 0x11, 0x61,
 	0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x81, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x91, 0x03, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d,
 	0x73, 0x12, 0x52, 0x0a, 0x26, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
 	0x74, 0x65, 0x72, 0x6d, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x74,
 	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x04, 0x52, 0x22, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x72,
 	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
-	0x74, 0x44, 0x61, 0x79, 0x73, 0x3a, 0x23, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x1a,
+	0x74, 0x44, 0x61, 0x79, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x61,
+	0x70, 0x70, 0x65, 0x61, 0x6c, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x64, 0x61, 0x79,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x41, 0x70,
+	0x70, 0x65, 0x61, 0x6c, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x44, 0x61, 0x79, 0x73, 0x12, 0x2c,
+	0x0a, 0x12, 0x63, 0x61, 0x73, 0x63, 0x61, 0x64, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x63, 0x61, 0x73, 0x63,
+	0x61, 0x64, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x39, 0x0a, 0x19,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x16, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x78, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x61, 0x79, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x78, 0x41, 0x75,
+	0x74, 0x68, 0x7a, 0x12, 0x40, 0x0a, 0x1d, 0x66, 0x65, 0x65, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f,
+	0x64, 0x61, 0x79, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x19, 0x66, 0x65, 0x65, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x44, 0x61, 0x79, 0x73, 0x3a, 0x23, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x1a,
 	0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x78, 0x2f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
 	0x69, 0x6f, 0x6e, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xbf, 0x01, 0x0a, 0x12, 0x63,
 	0x6f, 0x6d, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76,
@@ -19,13 +19,32 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName                 = "/verana.perm.v1.Query/Params"
-	Query_ListPermissions_FullMethodName        = "/verana.perm.v1.Query/ListPermissions"
-	Query_GetPermission_FullMethodName          = "/verana.perm.v1.Query/GetPermission"
-	Query_GetPermissionSession_FullMethodName   = "/verana.perm.v1.Query/GetPermissionSession"
-	Query_ListPermissionSessions_FullMethodName = "/verana.perm.v1.Query/ListPermissionSessions"
-	Query_FindPermissionsWithDID_FullMethodName = "/verana.perm.v1.Query/FindPermissionsWithDID"
-	Query_FindBeneficiaries_FullMethodName      = "/verana.perm.v1.Query/FindBeneficiaries"
+	Query_Params_FullMethodName                     = "/verana.perm.v1.Query/Params"
+	Query_ListPermissions_FullMethodName            = "/verana.perm.v1.Query/ListPermissions"
+	Query_GetPermission_FullMethodName              = "/verana.perm.v1.Query/GetPermission"
+	Query_GetPermissionSession_FullMethodName       = "/verana.perm.v1.Query/GetPermissionSession"
+	Query_ListPermissionSessions_FullMethodName     = "/verana.perm.v1.Query/ListPermissionSessions"
+	Query_FindPermissionsWithDID_FullMethodName     = "/verana.perm.v1.Query/FindPermissionsWithDID"
+	Query_ListPermissionsByCountry_FullMethodName   = "/verana.perm.v1.Query/ListPermissionsByCountry"
+	Query_FindBeneficiaries_FullMethodName          = "/verana.perm.v1.Query/FindBeneficiaries"
+	Query_GetSessionAllowance_FullMethodName        = "/verana.perm.v1.Query/GetSessionAllowance"
+	Query_ListSessionAllowances_FullMethodName      = "/verana.perm.v1.Query/ListSessionAllowances"
+	Query_GetSlashRecord_FullMethodName             = "/verana.perm.v1.Query/GetSlashRecord"
+	Query_ListSlashRecords_FullMethodName           = "/verana.perm.v1.Query/ListSlashRecords"
+	Query_GetSlashDistribution_FullMethodName       = "/verana.perm.v1.Query/GetSlashDistribution"
+	Query_GetPermissionTree_FullMethodName          = "/verana.perm.v1.Query/GetPermissionTree"
+	Query_GetPermissionAncestry_FullMethodName      = "/verana.perm.v1.Query/GetPermissionAncestry"
+	Query_SimulateRevocationCascade_FullMethodName  = "/verana.perm.v1.Query/SimulateRevocationCascade"
+	Query_ListRevocationCascades_FullMethodName     = "/verana.perm.v1.Query/ListRevocationCascades"
+	Query_VerifySessionAuthorization_FullMethodName = "/verana.perm.v1.Query/VerifySessionAuthorization"
+	Query_QuoteSessionFees_FullMethodName           = "/verana.perm.v1.Query/QuoteSessionFees"
+	Query_QuoteValidationFees_FullMethodName        = "/verana.perm.v1.Query/QuoteValidationFees"
+	Query_GetVerificationCredit_FullMethodName      = "/verana.perm.v1.Query/GetVerificationCredit"
+	Query_ListVerificationCredits_FullMethodName    = "/verana.perm.v1.Query/ListVerificationCredits"
+	Query_GetAgentRewards_FullMethodName            = "/verana.perm.v1.Query/GetAgentRewards"
+	Query_GetVoucherNonce_FullMethodName            = "/verana.perm.v1.Query/GetVoucherNonce"
+	Query_ListVPMessages_FullMethodName             = "/verana.perm.v1.Query/ListVPMessages"
+	Query_GetPermissionFees_FullMethodName          = "/verana.perm.v1.Query/GetPermissionFees"
 )
 
 // QueryClient is the client API for Query service.
@@ -39,7 +58,48 @@ type QueryClient interface {
 	GetPermissionSession(ctx context.Context, in *QueryGetPermissionSessionRequest, opts ...grpc.CallOption) (*QueryGetPermissionSessionResponse, error)
 	ListPermissionSessions(ctx context.Context, in *QueryListPermissionSessionsRequest, opts ...grpc.CallOption) (*QueryListPermissionSessionsResponse, error)
 	FindPermissionsWithDID(ctx context.Context, in *QueryFindPermissionsWithDIDRequest, opts ...grpc.CallOption) (*QueryFindPermissionsWithDIDResponse, error)
+	// ListPermissionsByCountry returns the perms whose country scope lists the
+	// country, or its country if it is a subdivision. Worldwide perms are not
+	// listed.
+	ListPermissionsByCountry(ctx context.Context, in *QueryListPermissionsByCountryRequest, opts ...grpc.CallOption) (*QueryListPermissionsByCountryResponse, error)
 	FindBeneficiaries(ctx context.Context, in *QueryFindBeneficiariesRequest, opts ...grpc.CallOption) (*QueryFindBeneficiariesResponse, error)
+	GetSessionAllowance(ctx context.Context, in *QueryGetSessionAllowanceRequest, opts ...grpc.CallOption) (*QueryGetSessionAllowanceResponse, error)
+	ListSessionAllowances(ctx context.Context, in *QueryListSessionAllowancesRequest, opts ...grpc.CallOption) (*QueryListSessionAllowancesResponse, error)
+	GetSlashRecord(ctx context.Context, in *QueryGetSlashRecordRequest, opts ...grpc.CallOption) (*QueryGetSlashRecordResponse, error)
+	// ListSlashRecords lists slash records, filtered by perm, grantee and/or slasher.
+	ListSlashRecords(ctx context.Context, in *QueryListSlashRecordsRequest, opts ...grpc.CallOption) (*QueryListSlashRecordsResponse, error)
+	GetSlashDistribution(ctx context.Context, in *QueryGetSlashDistributionRequest, opts ...grpc.CallOption) (*QueryGetSlashDistributionResponse, error)
+	// GetPermissionTree returns the perms validated, directly or not, by a root
+	// perm, walking the tree breadth first.
+	GetPermissionTree(ctx context.Context, in *QueryGetPermissionTreeRequest, opts ...grpc.CallOption) (*QueryGetPermissionTreeResponse, error)
+	// GetPermissionAncestry returns the chain of validators of a perm, up to
+	// its root perm.
+	GetPermissionAncestry(ctx context.Context, in *QueryGetPermissionAncestryRequest, opts ...grpc.CallOption) (*QueryGetPermissionAncestryResponse, error)
+	// SimulateRevocationCascade lists the descendant perms a cascading
+	// revocation of a perm would affect, without revoking anything.
+	SimulateRevocationCascade(ctx context.Context, in *QuerySimulateRevocationCascadeRequest, opts ...grpc.CallOption) (*QuerySimulateRevocationCascadeResponse, error)
+	// ListRevocationCascades lists the cascading revocations in progress.
+	ListRevocationCascades(ctx context.Context, in *QueryListRevocationCascadesRequest, opts ...grpc.CallOption) (*QueryListRevocationCascadesResponse, error)
+	// VerifySessionAuthorization checks that a perm session authorizes a
+	// credential exchange between an executor and a beneficiary perm.
+	VerifySessionAuthorization(ctx context.Context, in *QueryVerifySessionAuthorizationRequest, opts ...grpc.CallOption) (*QueryVerifySessionAuthorizationResponse, error)
+	// QuoteSessionFees returns the itemized funds a perm session creation costs.
+	QuoteSessionFees(ctx context.Context, in *QueryQuoteSessionFeesRequest, opts ...grpc.CallOption) (*QueryQuoteSessionFeesResponse, error)
+	// QuoteValidationFees returns the funds a validation process with a
+	// validator perm costs.
+	QuoteValidationFees(ctx context.Context, in *QueryQuoteValidationFeesRequest, opts ...grpc.CallOption) (*QueryQuoteValidationFeesResponse, error)
+	GetVerificationCredit(ctx context.Context, in *QueryGetVerificationCreditRequest, opts ...grpc.CallOption) (*QueryGetVerificationCreditResponse, error)
+	ListVerificationCredits(ctx context.Context, in *QueryListVerificationCreditsRequest, opts ...grpc.CallOption) (*QueryListVerificationCreditsResponse, error)
+	// GetAgentRewards returns the rewards accrued by an agent perm.
+	GetAgentRewards(ctx context.Context, in *QueryGetAgentRewardsRequest, opts ...grpc.CallOption) (*QueryGetAgentRewardsResponse, error)
+	// GetVoucherNonce returns the session voucher nonces settled for a signer.
+	GetVoucherNonce(ctx context.Context, in *QueryGetVoucherNonceRequest, opts ...grpc.CallOption) (*QueryGetVoucherNonceResponse, error)
+	// ListVPMessages lists, in order, the messages anchored for the validation
+	// process of a perm.
+	ListVPMessages(ctx context.Context, in *QueryListVPMessagesRequest, opts ...grpc.CallOption) (*QueryListVPMessagesResponse, error)
+	// GetPermissionFees returns the current fees of a perm, its scheduled fee
+	// update if any, and its fee history.
+	GetPermissionFees(ctx context.Context, in *QueryGetPermissionFeesRequest, opts ...grpc.CallOption) (*QueryGetPermissionFeesResponse, error)
 }
 
 type queryClient struct {
@@ -104,6 +164,15 @@ func (c *queryClient) FindPermissionsWithDID(ctx context.Context, in *QueryFindP
 	return out, nil
 }
 
+func (c *queryClient) ListPermissionsByCountry(ctx context.Context, in *QueryListPermissionsByCountryRequest, opts ...grpc.CallOption) (*QueryListPermissionsByCountryResponse, error) {
+	out := new(QueryListPermissionsByCountryResponse)
+	err := c.cc.Invoke(ctx, Query_ListPermissionsByCountry_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) FindBeneficiaries(ctx context.Context, in *QueryFindBeneficiariesRequest, opts ...grpc.CallOption) (*QueryFindBeneficiariesResponse, error) {
 	out := new(QueryFindBeneficiariesResponse)
 	err := c.cc.Invoke(ctx, Query_FindBeneficiaries_FullMethodName, in, out, opts...)
@@ -113,6 +182,168 @@ func (c *queryClient) FindBeneficiaries(ctx context.Context, in *QueryFindBenefi
 	return out, nil
 }
 
+func (c *queryClient) GetSessionAllowance(ctx context.Context, in *QueryGetSessionAllowanceRequest, opts ...grpc.CallOption) (*QueryGetSessionAllowanceResponse, error) {
+	out := new(QueryGetSessionAllowanceResponse)
+	err := c.cc.Invoke(ctx, Query_GetSessionAllowance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListSessionAllowances(ctx context.Context, in *QueryListSessionAllowancesRequest, opts ...grpc.CallOption) (*QueryListSessionAllowancesResponse, error) {
+	out := new(QueryListSessionAllowancesResponse)
+	err := c.cc.Invoke(ctx, Query_ListSessionAllowances_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetSlashRecord(ctx context.Context, in *QueryGetSlashRecordRequest, opts ...grpc.CallOption) (*QueryGetSlashRecordResponse, error) {
+	out := new(QueryGetSlashRecordResponse)
+	err := c.cc.Invoke(ctx, Query_GetSlashRecord_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListSlashRecords(ctx context.Context, in *QueryListSlashRecordsRequest, opts ...grpc.CallOption) (*QueryListSlashRecordsResponse, error) {
+	out := new(QueryListSlashRecordsResponse)
+	err := c.cc.Invoke(ctx, Query_ListSlashRecords_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetSlashDistribution(ctx context.Context, in *QueryGetSlashDistributionRequest, opts ...grpc.CallOption) (*QueryGetSlashDistributionResponse, error) {
+	out := new(QueryGetSlashDistributionResponse)
+	err := c.cc.Invoke(ctx, Query_GetSlashDistribution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetPermissionTree(ctx context.Context, in *QueryGetPermissionTreeRequest, opts ...grpc.CallOption) (*QueryGetPermissionTreeResponse, error) {
+	out := new(QueryGetPermissionTreeResponse)
+	err := c.cc.Invoke(ctx, Query_GetPermissionTree_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetPermissionAncestry(ctx context.Context, in *QueryGetPermissionAncestryRequest, opts ...grpc.CallOption) (*QueryGetPermissionAncestryResponse, error) {
+	out := new(QueryGetPermissionAncestryResponse)
+	err := c.cc.Invoke(ctx, Query_GetPermissionAncestry_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateRevocationCascade(ctx context.Context, in *QuerySimulateRevocationCascadeRequest, opts ...grpc.CallOption) (*QuerySimulateRevocationCascadeResponse, error) {
+	out := new(QuerySimulateRevocationCascadeResponse)
+	err := c.cc.Invoke(ctx, Query_SimulateRevocationCascade_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListRevocationCascades(ctx context.Context, in *QueryListRevocationCascadesRequest, opts ...grpc.CallOption) (*QueryListRevocationCascadesResponse, error) {
+	out := new(QueryListRevocationCascadesResponse)
+	err := c.cc.Invoke(ctx, Query_ListRevocationCascades_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifySessionAuthorization(ctx context.Context, in *QueryVerifySessionAuthorizationRequest, opts ...grpc.CallOption) (*QueryVerifySessionAuthorizationResponse, error) {
+	out := new(QueryVerifySessionAuthorizationResponse)
+	err := c.cc.Invoke(ctx, Query_VerifySessionAuthorization_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuoteSessionFees(ctx context.Context, in *QueryQuoteSessionFeesRequest, opts ...grpc.CallOption) (*QueryQuoteSessionFeesResponse, error) {
+	out := new(QueryQuoteSessionFeesResponse)
+	err := c.cc.Invoke(ctx, Query_QuoteSessionFees_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuoteValidationFees(ctx context.Context, in *QueryQuoteValidationFeesRequest, opts ...grpc.CallOption) (*QueryQuoteValidationFeesResponse, error) {
+	out := new(QueryQuoteValidationFeesResponse)
+	err := c.cc.Invoke(ctx, Query_QuoteValidationFees_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetVerificationCredit(ctx context.Context, in *QueryGetVerificationCreditRequest, opts ...grpc.CallOption) (*QueryGetVerificationCreditResponse, error) {
+	out := new(QueryGetVerificationCreditResponse)
+	err := c.cc.Invoke(ctx, Query_GetVerificationCredit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListVerificationCredits(ctx context.Context, in *QueryListVerificationCreditsRequest, opts ...grpc.CallOption) (*QueryListVerificationCreditsResponse, error) {
+	out := new(QueryListVerificationCreditsResponse)
+	err := c.cc.Invoke(ctx, Query_ListVerificationCredits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetAgentRewards(ctx context.Context, in *QueryGetAgentRewardsRequest, opts ...grpc.CallOption) (*QueryGetAgentRewardsResponse, error) {
+	out := new(QueryGetAgentRewardsResponse)
+	err := c.cc.Invoke(ctx, Query_GetAgentRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetVoucherNonce(ctx context.Context, in *QueryGetVoucherNonceRequest, opts ...grpc.CallOption) (*QueryGetVoucherNonceResponse, error) {
+	out := new(QueryGetVoucherNonceResponse)
+	err := c.cc.Invoke(ctx, Query_GetVoucherNonce_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListVPMessages(ctx context.Context, in *QueryListVPMessagesRequest, opts ...grpc.CallOption) (*QueryListVPMessagesResponse, error) {
+	out := new(QueryListVPMessagesResponse)
+	err := c.cc.Invoke(ctx, Query_ListVPMessages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetPermissionFees(ctx context.Context, in *QueryGetPermissionFeesRequest, opts ...grpc.CallOption) (*QueryGetPermissionFeesResponse, error) {
+	out := new(QueryGetPermissionFeesResponse)
+	err := c.cc.Invoke(ctx, Query_GetPermissionFees_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -124,7 +355,48 @@ type QueryServer interface {
 	GetPermissionSession(context.Context, *QueryGetPermissionSessionRequest) (*QueryGetPermissionSessionResponse, error)
 	ListPermissionSessions(context.Context, *QueryListPermissionSessionsRequest) (*QueryListPermissionSessionsResponse, error)
 	FindPermissionsWithDID(context.Context, *QueryFindPermissionsWithDIDRequest) (*QueryFindPermissionsWithDIDResponse, error)
+	// ListPermissionsByCountry returns the perms whose country scope lists the
+	// country, or its country if it is a subdivision. Worldwide perms are not
+	// listed.
+	ListPermissionsByCountry(context.Context, *QueryListPermissionsByCountryRequest) (*QueryListPermissionsByCountryResponse, error)
 	FindBeneficiaries(context.Context, *QueryFindBeneficiariesRequest) (*QueryFindBeneficiariesResponse, error)
+	GetSessionAllowance(context.Context, *QueryGetSessionAllowanceRequest) (*QueryGetSessionAllowanceResponse, error)
+	ListSessionAllowances(context.Context, *QueryListSessionAllowancesRequest) (*QueryListSessionAllowancesResponse, error)
+	GetSlashRecord(context.Context, *QueryGetSlashRecordRequest) (*QueryGetSlashRecordResponse, error)
+	// ListSlashRecords lists slash records, filtered by perm, grantee and/or slasher.
+	ListSlashRecords(context.Context, *QueryListSlashRecordsRequest) (*QueryListSlashRecordsResponse, error)
+	GetSlashDistribution(context.Context, *QueryGetSlashDistributionRequest) (*QueryGetSlashDistributionResponse, error)
+	// GetPermissionTree returns the perms validated, directly or not, by a root
+	// perm, walking the tree breadth first.
+	GetPermissionTree(context.Context, *QueryGetPermissionTreeRequest) (*QueryGetPermissionTreeResponse, error)
+	// GetPermissionAncestry returns the chain of validators of a perm, up to
+	// its root perm.
+	GetPermissionAncestry(context.Context, *QueryGetPermissionAncestryRequest) (*QueryGetPermissionAncestryResponse, error)
+	// SimulateRevocationCascade lists the descendant perms a cascading
+	// revocation of a perm would affect, without revoking anything.
+	SimulateRevocationCascade(context.Context, *QuerySimulateRevocationCascadeRequest) (*QuerySimulateRevocationCascadeResponse, error)
+	// ListRevocationCascades lists the cascading revocations in progress.
+	ListRevocationCascades(context.Context, *QueryListRevocationCascadesRequest) (*QueryListRevocationCascadesResponse, error)
+	// VerifySessionAuthorization checks that a perm session authorizes a
+	// credential exchange between an executor and a beneficiary perm.
+	VerifySessionAuthorization(context.Context, *QueryVerifySessionAuthorizationRequest) (*QueryVerifySessionAuthorizationResponse, error)
+	// QuoteSessionFees returns the itemized funds a perm session creation costs.
+	QuoteSessionFees(context.Context, *QueryQuoteSessionFeesRequest) (*QueryQuoteSessionFeesResponse, error)
+	// QuoteValidationFees returns the funds a validation process with a
+	// validator perm costs.
+	QuoteValidationFees(context.Context, *QueryQuoteValidationFeesRequest) (*QueryQuoteValidationFeesResponse, error)
+	GetVerificationCredit(context.Context, *QueryGetVerificationCreditRequest) (*QueryGetVerificationCreditResponse, error)
+	ListVerificationCredits(context.Context, *QueryListVerificationCreditsRequest) (*QueryListVerificationCreditsResponse, error)
+	// GetAgentRewards returns the rewards accrued by an agent perm.
+	GetAgentRewards(context.Context, *QueryGetAgentRewardsRequest) (*QueryGetAgentRewardsResponse, error)
+	// GetVoucherNonce returns the session voucher nonces settled for a signer.
+	GetVoucherNonce(context.Context, *QueryGetVoucherNonceRequest) (*QueryGetVoucherNonceResponse, error)
+	// ListVPMessages lists, in order, the messages anchored for the validation
+	// process of a perm.
+	ListVPMessages(context.Context, *QueryListVPMessagesRequest) (*QueryListVPMessagesResponse, error)
+	// GetPermissionFees returns the current fees of a perm, its scheduled fee
+	// update if any, and its fee history.
+	GetPermissionFees(context.Context, *QueryGetPermissionFeesRequest) (*QueryGetPermissionFeesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -150,9 +422,66 @@ func (UnimplementedQueryServer) ListPermissionSessions(context.Context, *QueryLi
 func (UnimplementedQueryServer) FindPermissionsWithDID(context.Context, *QueryFindPermissionsWithDIDRequest) (*QueryFindPermissionsWithDIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindPermissionsWithDID not implemented")
 }
+func (UnimplementedQueryServer) ListPermissionsByCountry(context.Context, *QueryListPermissionsByCountryRequest) (*QueryListPermissionsByCountryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPermissionsByCountry not implemented")
+}
 func (UnimplementedQueryServer) FindBeneficiaries(context.Context, *QueryFindBeneficiariesRequest) (*QueryFindBeneficiariesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindBeneficiaries not implemented")
 }
+func (UnimplementedQueryServer) GetSessionAllowance(context.Context, *QueryGetSessionAllowanceRequest) (*QueryGetSessionAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessionAllowance not implemented")
+}
+func (UnimplementedQueryServer) ListSessionAllowances(context.Context, *QueryListSessionAllowancesRequest) (*QueryListSessionAllowancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessionAllowances not implemented")
+}
+func (UnimplementedQueryServer) GetSlashRecord(context.Context, *QueryGetSlashRecordRequest) (*QueryGetSlashRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSlashRecord not implemented")
+}
+func (UnimplementedQueryServer) ListSlashRecords(context.Context, *QueryListSlashRecordsRequest) (*QueryListSlashRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSlashRecords not implemented")
+}
+func (UnimplementedQueryServer) GetSlashDistribution(context.Context, *QueryGetSlashDistributionRequest) (*QueryGetSlashDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSlashDistribution not implemented")
+}
+func (UnimplementedQueryServer) GetPermissionTree(context.Context, *QueryGetPermissionTreeRequest) (*QueryGetPermissionTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPermissionTree not implemented")
+}
+func (UnimplementedQueryServer) GetPermissionAncestry(context.Context, *QueryGetPermissionAncestryRequest) (*QueryGetPermissionAncestryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPermissionAncestry not implemented")
+}
+func (UnimplementedQueryServer) SimulateRevocationCascade(context.Context, *QuerySimulateRevocationCascadeRequest) (*QuerySimulateRevocationCascadeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateRevocationCascade not implemented")
+}
+func (UnimplementedQueryServer) ListRevocationCascades(context.Context, *QueryListRevocationCascadesRequest) (*QueryListRevocationCascadesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRevocationCascades not implemented")
+}
+func (UnimplementedQueryServer) VerifySessionAuthorization(context.Context, *QueryVerifySessionAuthorizationRequest) (*QueryVerifySessionAuthorizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifySessionAuthorization not implemented")
+}
+func (UnimplementedQueryServer) QuoteSessionFees(context.Context, *QueryQuoteSessionFeesRequest) (*QueryQuoteSessionFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteSessionFees not implemented")
+}
+func (UnimplementedQueryServer) QuoteValidationFees(context.Context, *QueryQuoteValidationFeesRequest) (*QueryQuoteValidationFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteValidationFees not implemented")
+}
+func (UnimplementedQueryServer) GetVerificationCredit(context.Context, *QueryGetVerificationCreditRequest) (*QueryGetVerificationCreditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVerificationCredit not implemented")
+}
+func (UnimplementedQueryServer) ListVerificationCredits(context.Context, *QueryListVerificationCreditsRequest) (*QueryListVerificationCreditsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVerificationCredits not implemented")
+}
+func (UnimplementedQueryServer) GetAgentRewards(context.Context, *QueryGetAgentRewardsRequest) (*QueryGetAgentRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAgentRewards not implemented")
+}
+func (UnimplementedQueryServer) GetVoucherNonce(context.Context, *QueryGetVoucherNonceRequest) (*QueryGetVoucherNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVoucherNonce not implemented")
+}
+func (UnimplementedQueryServer) ListVPMessages(context.Context, *QueryListVPMessagesRequest) (*QueryListVPMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVPMessages not implemented")
+}
+func (UnimplementedQueryServer) GetPermissionFees(context.Context, *QueryGetPermissionFeesRequest) (*QueryGetPermissionFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPermissionFees not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -274,6 +603,24 @@ func _Query_FindPermissionsWithDID_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ListPermissionsByCountry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListPermissionsByCountryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListPermissionsByCountry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListPermissionsByCountry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListPermissionsByCountry(ctx, req.(*QueryListPermissionsByCountryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_FindBeneficiaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryFindBeneficiariesRequest)
 	if err := dec(in); err != nil {
@@ -292,6 +639,330 @@ func _Query_FindBeneficiaries_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetSessionAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetSessionAllowanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetSessionAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetSessionAllowance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetSessionAllowance(ctx, req.(*QueryGetSessionAllowanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListSessionAllowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListSessionAllowancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListSessionAllowances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListSessionAllowances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListSessionAllowances(ctx, req.(*QueryListSessionAllowancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetSlashRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetSlashRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetSlashRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetSlashRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetSlashRecord(ctx, req.(*QueryGetSlashRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListSlashRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListSlashRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListSlashRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListSlashRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListSlashRecords(ctx, req.(*QueryListSlashRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetSlashDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetSlashDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetSlashDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetSlashDistribution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetSlashDistribution(ctx, req.(*QueryGetSlashDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetPermissionTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPermissionTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPermissionTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetPermissionTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPermissionTree(ctx, req.(*QueryGetPermissionTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetPermissionAncestry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPermissionAncestryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPermissionAncestry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetPermissionAncestry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPermissionAncestry(ctx, req.(*QueryGetPermissionAncestryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateRevocationCascade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateRevocationCascadeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateRevocationCascade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateRevocationCascade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateRevocationCascade(ctx, req.(*QuerySimulateRevocationCascadeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListRevocationCascades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListRevocationCascadesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListRevocationCascades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListRevocationCascades_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListRevocationCascades(ctx, req.(*QueryListRevocationCascadesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifySessionAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifySessionAuthorizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifySessionAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VerifySessionAuthorization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifySessionAuthorization(ctx, req.(*QueryVerifySessionAuthorizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuoteSessionFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuoteSessionFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuoteSessionFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QuoteSessionFees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuoteSessionFees(ctx, req.(*QueryQuoteSessionFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuoteValidationFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuoteValidationFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuoteValidationFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QuoteValidationFees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuoteValidationFees(ctx, req.(*QueryQuoteValidationFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetVerificationCredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetVerificationCreditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetVerificationCredit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetVerificationCredit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetVerificationCredit(ctx, req.(*QueryGetVerificationCreditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListVerificationCredits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListVerificationCreditsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListVerificationCredits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListVerificationCredits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListVerificationCredits(ctx, req.(*QueryListVerificationCreditsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetAgentRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAgentRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAgentRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetAgentRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAgentRewards(ctx, req.(*QueryGetAgentRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetVoucherNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetVoucherNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetVoucherNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetVoucherNonce_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetVoucherNonce(ctx, req.(*QueryGetVoucherNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListVPMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListVPMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListVPMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListVPMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListVPMessages(ctx, req.(*QueryListVPMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetPermissionFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPermissionFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPermissionFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetPermissionFees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPermissionFees(ctx, req.(*QueryGetPermissionFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -323,10 +994,86 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FindPermissionsWithDID",
 			Handler:    _Query_FindPermissionsWithDID_Handler,
 		},
+		{
+			MethodName: "ListPermissionsByCountry",
+			Handler:    _Query_ListPermissionsByCountry_Handler,
+		},
 		{
 			MethodName: "FindBeneficiaries",
 			Handler:    _Query_FindBeneficiaries_Handler,
 		},
+		{
+			MethodName: "GetSessionAllowance",
+			Handler:    _Query_GetSessionAllowance_Handler,
+		},
+		{
+			MethodName: "ListSessionAllowances",
+			Handler:    _Query_ListSessionAllowances_Handler,
+		},
+		{
+			MethodName: "GetSlashRecord",
+			Handler:    _Query_GetSlashRecord_Handler,
+		},
+		{
+			MethodName: "ListSlashRecords",
+			Handler:    _Query_ListSlashRecords_Handler,
+		},
+		{
+			MethodName: "GetSlashDistribution",
+			Handler:    _Query_GetSlashDistribution_Handler,
+		},
+		{
+			MethodName: "GetPermissionTree",
+			Handler:    _Query_GetPermissionTree_Handler,
+		},
+		{
+			MethodName: "GetPermissionAncestry",
+			Handler:    _Query_GetPermissionAncestry_Handler,
+		},
+		{
+			MethodName: "SimulateRevocationCascade",
+			Handler:    _Query_SimulateRevocationCascade_Handler,
+		},
+		{
+			MethodName: "ListRevocationCascades",
+			Handler:    _Query_ListRevocationCascades_Handler,
+		},
+		{
+			MethodName: "VerifySessionAuthorization",
+			Handler:    _Query_VerifySessionAuthorization_Handler,
+		},
+		{
+			MethodName: "QuoteSessionFees",
+			Handler:    _Query_QuoteSessionFees_Handler,
+		},
+		{
+			MethodName: "QuoteValidationFees",
+			Handler:    _Query_QuoteValidationFees_Handler,
+		},
+		{
+			MethodName: "GetVerificationCredit",
+			Handler:    _Query_GetVerificationCredit_Handler,
+		},
+		{
+			MethodName: "ListVerificationCredits",
+			Handler:    _Query_ListVerificationCredits_Handler,
+		},
+		{
+			MethodName: "GetAgentRewards",
+			Handler:    _Query_GetAgentRewards_Handler,
+		},
+		{
+			MethodName: "GetVoucherNonce",
+			Handler:    _Query_GetVoucherNonce_Handler,
+		},
+		{
+			MethodName: "ListVPMessages",
+			Handler:    _Query_ListVPMessages_Handler,
+		},
+		{
+			MethodName: "GetPermissionFees",
+			Handler:    _Query_GetPermissionFees_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "verana/perm/v1/query.proto",
@@ -8,6 +8,7 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
+	v1 "github.com/verana-labs/verana-blockchain/api/verana/td/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -872,13 +873,106 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 	}
 }
 
+var _ protoreflect.List = (*_MsgStartPermissionVP_4_list)(nil)
+
+type _MsgStartPermissionVP_4_list struct {
+	list *[]string
+}
+
+func (x *_MsgStartPermissionVP_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgStartPermissionVP_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgStartPermissionVP_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgStartPermissionVP_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgStartPermissionVP_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgStartPermissionVP at list field Countries as it is not of Message kind"))
+}
+
+func (x *_MsgStartPermissionVP_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgStartPermissionVP_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgStartPermissionVP_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgStartPermissionVP_6_list)(nil)
+
+type _MsgStartPermissionVP_6_list struct {
+	list *[]uint64
+}
+
+func (x *_MsgStartPermissionVP_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgStartPermissionVP_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_MsgStartPermissionVP_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgStartPermissionVP_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgStartPermissionVP_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgStartPermissionVP at list field CoValidatorPermIds as it is not of Message kind"))
+}
+
+func (x *_MsgStartPermissionVP_6_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgStartPermissionVP_6_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_MsgStartPermissionVP_6_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_MsgStartPermissionVP                   protoreflect.MessageDescriptor
-	fd_MsgStartPermissionVP_creator           protoreflect.FieldDescriptor
-	fd_MsgStartPermissionVP_type              protoreflect.FieldDescriptor
-	fd_MsgStartPermissionVP_validator_perm_id protoreflect.FieldDescriptor
-	fd_MsgStartPermissionVP_country           protoreflect.FieldDescriptor
-	fd_MsgStartPermissionVP_did               protoreflect.FieldDescriptor
+	md_MsgStartPermissionVP                       protoreflect.MessageDescriptor
+	fd_MsgStartPermissionVP_creator               protoreflect.FieldDescriptor
+	fd_MsgStartPermissionVP_type                  protoreflect.FieldDescriptor
+	fd_MsgStartPermissionVP_validator_perm_id     protoreflect.FieldDescriptor
+	fd_MsgStartPermissionVP_countries             protoreflect.FieldDescriptor
+	fd_MsgStartPermissionVP_did                   protoreflect.FieldDescriptor
+	fd_MsgStartPermissionVP_co_validator_perm_ids protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -887,8 +981,9 @@ func init() {
 	fd_MsgStartPermissionVP_creator = md_MsgStartPermissionVP.Fields().ByName("creator")
 	fd_MsgStartPermissionVP_type = md_MsgStartPermissionVP.Fields().ByName("type")
 	fd_MsgStartPermissionVP_validator_perm_id = md_MsgStartPermissionVP.Fields().ByName("validator_perm_id")
-	fd_MsgStartPermissionVP_country = md_MsgStartPermissionVP.Fields().ByName("country")
+	fd_MsgStartPermissionVP_countries = md_MsgStartPermissionVP.Fields().ByName("countries")
 	fd_MsgStartPermissionVP_did = md_MsgStartPermissionVP.Fields().ByName("did")
+	fd_MsgStartPermissionVP_co_validator_perm_ids = md_MsgStartPermissionVP.Fields().ByName("co_validator_perm_ids")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgStartPermissionVP)(nil)
@@ -974,9 +1069,9 @@ func (x *fastReflection_MsgStartPermissionVP) Range(f func(protoreflect.FieldDes
 			return
 		}
 	}
-	if x.Country != "" {
-		value := protoreflect.ValueOfString(x.Country)
-		if !f(fd_MsgStartPermissionVP_country, value) {
+	if len(x.Countries) != 0 {
+		value := protoreflect.ValueOfList(&_MsgStartPermissionVP_4_list{list: &x.Countries})
+		if !f(fd_MsgStartPermissionVP_countries, value) {
 			return
 		}
 	}
@@ -986,6 +1081,12 @@ func (x *fastReflection_MsgStartPermissionVP) Range(f func(protoreflect.FieldDes
 			return
 		}
 	}
+	if len(x.CoValidatorPermIds) != 0 {
+		value := protoreflect.ValueOfList(&_MsgStartPermissionVP_6_list{list: &x.CoValidatorPermIds})
+		if !f(fd_MsgStartPermissionVP_co_validator_perm_ids, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1007,10 +1108,12 @@ func (x *fastReflection_MsgStartPermissionVP) Has(fd protoreflect.FieldDescripto
 		return x.Type_ != uint32(0)
 	case "verana.perm.v1.MsgStartPermissionVP.validator_perm_id":
 		return x.ValidatorPermId != uint64(0)
-	case "verana.perm.v1.MsgStartPermissionVP.country":
-		return x.Country != ""
+	case "verana.perm.v1.MsgStartPermissionVP.countries":
+		return len(x.Countries) != 0
 	case "verana.perm.v1.MsgStartPermissionVP.did":
 		return x.Did != ""
+	case "verana.perm.v1.MsgStartPermissionVP.co_validator_perm_ids":
+		return len(x.CoValidatorPermIds) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgStartPermissionVP"))
@@ -1033,10 +1136,12 @@ func (x *fastReflection_MsgStartPermissionVP) Clear(fd protoreflect.FieldDescrip
 		x.Type_ = uint32(0)
 	case "verana.perm.v1.MsgStartPermissionVP.validator_perm_id":
 		x.ValidatorPermId = uint64(0)
-	case "verana.perm.v1.MsgStartPermissionVP.country":
-		x.Country = ""
+	case "verana.perm.v1.MsgStartPermissionVP.countries":
+		x.Countries = nil
 	case "verana.perm.v1.MsgStartPermissionVP.did":
 		x.Did = ""
+	case "verana.perm.v1.MsgStartPermissionVP.co_validator_perm_ids":
+		x.CoValidatorPermIds = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgStartPermissionVP"))
@@ -1062,12 +1167,21 @@ func (x *fastReflection_MsgStartPermissionVP) Get(descriptor protoreflect.FieldD
 	case "verana.perm.v1.MsgStartPermissionVP.validator_perm_id":
 		value := x.ValidatorPermId
 		return protoreflect.ValueOfUint64(value)
-	case "verana.perm.v1.MsgStartPermissionVP.country":
-		value := x.Country
-		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.MsgStartPermissionVP.countries":
+		if len(x.Countries) == 0 {
+			return protoreflect.ValueOfList(&_MsgStartPermissionVP_4_list{})
+		}
+		listValue := &_MsgStartPermissionVP_4_list{list: &x.Countries}
+		return protoreflect.ValueOfList(listValue)
 	case "verana.perm.v1.MsgStartPermissionVP.did":
 		value := x.Did
 		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.MsgStartPermissionVP.co_validator_perm_ids":
+		if len(x.CoValidatorPermIds) == 0 {
+			return protoreflect.ValueOfList(&_MsgStartPermissionVP_6_list{})
+		}
+		listValue := &_MsgStartPermissionVP_6_list{list: &x.CoValidatorPermIds}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgStartPermissionVP"))
@@ -1094,10 +1208,16 @@ func (x *fastReflection_MsgStartPermissionVP) Set(fd protoreflect.FieldDescripto
 		x.Type_ = uint32(value.Uint())
 	case "verana.perm.v1.MsgStartPermissionVP.validator_perm_id":
 		x.ValidatorPermId = value.Uint()
-	case "verana.perm.v1.MsgStartPermissionVP.country":
-		x.Country = value.Interface().(string)
+	case "verana.perm.v1.MsgStartPermissionVP.countries":
+		lv := value.List()
+		clv := lv.(*_MsgStartPermissionVP_4_list)
+		x.Countries = *clv.list
 	case "verana.perm.v1.MsgStartPermissionVP.did":
 		x.Did = value.Interface().(string)
+	case "verana.perm.v1.MsgStartPermissionVP.co_validator_perm_ids":
+		lv := value.List()
+		clv := lv.(*_MsgStartPermissionVP_6_list)
+		x.CoValidatorPermIds = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgStartPermissionVP"))
@@ -1118,14 +1238,24 @@ func (x *fastReflection_MsgStartPermissionVP) Set(fd protoreflect.FieldDescripto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MsgStartPermissionVP) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "verana.perm.v1.MsgStartPermissionVP.countries":
+		if x.Countries == nil {
+			x.Countries = []string{}
+		}
+		value := &_MsgStartPermissionVP_4_list{list: &x.Countries}
+		return protoreflect.ValueOfList(value)
+	case "verana.perm.v1.MsgStartPermissionVP.co_validator_perm_ids":
+		if x.CoValidatorPermIds == nil {
+			x.CoValidatorPermIds = []uint64{}
+		}
+		value := &_MsgStartPermissionVP_6_list{list: &x.CoValidatorPermIds}
+		return protoreflect.ValueOfList(value)
 	case "verana.perm.v1.MsgStartPermissionVP.creator":
 		panic(fmt.Errorf("field creator of message verana.perm.v1.MsgStartPermissionVP is not mutable"))
 	case "verana.perm.v1.MsgStartPermissionVP.type":
 		panic(fmt.Errorf("field type of message verana.perm.v1.MsgStartPermissionVP is not mutable"))
 	case "verana.perm.v1.MsgStartPermissionVP.validator_perm_id":
 		panic(fmt.Errorf("field validator_perm_id of message verana.perm.v1.MsgStartPermissionVP is not mutable"))
-	case "verana.perm.v1.MsgStartPermissionVP.country":
-		panic(fmt.Errorf("field country of message verana.perm.v1.MsgStartPermissionVP is not mutable"))
 	case "verana.perm.v1.MsgStartPermissionVP.did":
 		panic(fmt.Errorf("field did of message verana.perm.v1.MsgStartPermissionVP is not mutable"))
 	default:
@@ -1147,10 +1277,14 @@ func (x *fastReflection_MsgStartPermissionVP) NewField(fd protoreflect.FieldDesc
 		return protoreflect.ValueOfUint32(uint32(0))
 	case "verana.perm.v1.MsgStartPermissionVP.validator_perm_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "verana.perm.v1.MsgStartPermissionVP.country":
-		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.MsgStartPermissionVP.countries":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgStartPermissionVP_4_list{list: &list})
 	case "verana.perm.v1.MsgStartPermissionVP.did":
 		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.MsgStartPermissionVP.co_validator_perm_ids":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_MsgStartPermissionVP_6_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgStartPermissionVP"))
@@ -1230,14 +1364,23 @@ func (x *fastReflection_MsgStartPermissionVP) ProtoMethods() *protoiface.Methods
 		if x.ValidatorPermId != 0 {
 			n += 1 + runtime.Sov(uint64(x.ValidatorPermId))
 		}
-		l = len(x.Country)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Countries) > 0 {
+			for _, s := range x.Countries {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		l = len(x.Did)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.CoValidatorPermIds) > 0 {
+			l = 0
+			for _, e := range x.CoValidatorPermIds {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1267,6 +1410,26 @@ func (x *fastReflection_MsgStartPermissionVP) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.CoValidatorPermIds) > 0 {
+			var pksize2 int
+			for _, num := range x.CoValidatorPermIds {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.CoValidatorPermIds {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x32
+		}
 		if len(x.Did) > 0 {
 			i -= len(x.Did)
 			copy(dAtA[i:], x.Did)
@@ -1274,12 +1437,14 @@ func (x *fastReflection_MsgStartPermissionVP) ProtoMethods() *protoiface.Methods
 			i--
 			dAtA[i] = 0x2a
 		}
-		if len(x.Country) > 0 {
-			i -= len(x.Country)
-			copy(dAtA[i:], x.Country)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Country)))
-			i--
-			dAtA[i] = 0x22
+		if len(x.Countries) > 0 {
+			for iNdEx := len(x.Countries) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Countries[iNdEx])
+				copy(dAtA[i:], x.Countries[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Countries[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
 		}
 		if x.ValidatorPermId != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidatorPermId))
@@ -1419,7 +1584,7 @@ func (x *fastReflection_MsgStartPermissionVP) ProtoMethods() *protoiface.Methods
 				}
 			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Country", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Countries", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1447,7 +1612,7 @@ func (x *fastReflection_MsgStartPermissionVP) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Country = string(dAtA[iNdEx:postIndex])
+				x.Countries = append(x.Countries, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
 			case 5:
 				if wireType != 2 {
@@ -1481,6 +1646,82 @@ func (x *fastReflection_MsgStartPermissionVP) ProtoMethods() *protoiface.Methods
 				}
 				x.Did = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 6:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.CoValidatorPermIds = append(x.CoValidatorPermIds, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.CoValidatorPermIds) == 0 {
+						x.CoValidatorPermIds = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.CoValidatorPermIds = append(x.CoValidatorPermIds, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CoValidatorPermIds", wireType)
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2744,6 +2985,52 @@ func (x *fastReflection_MsgRenewPermissionVPResponse) ProtoMethods() *protoiface
 	}
 }
 
+var _ protoreflect.List = (*_MsgSetPermissionVPToValidated_7_list)(nil)
+
+type _MsgSetPermissionVPToValidated_7_list struct {
+	list *[]string
+}
+
+func (x *_MsgSetPermissionVPToValidated_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgSetPermissionVPToValidated_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgSetPermissionVPToValidated_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgSetPermissionVPToValidated_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgSetPermissionVPToValidated_7_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgSetPermissionVPToValidated at list field Countries as it is not of Message kind"))
+}
+
+func (x *_MsgSetPermissionVPToValidated_7_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgSetPermissionVPToValidated_7_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgSetPermissionVPToValidated_7_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_MsgSetPermissionVPToValidated                       protoreflect.MessageDescriptor
 	fd_MsgSetPermissionVPToValidated_creator               protoreflect.FieldDescriptor
@@ -2752,7 +3039,7 @@ var (
 	fd_MsgSetPermissionVPToValidated_validation_fees       protoreflect.FieldDescriptor
 	fd_MsgSetPermissionVPToValidated_issuance_fees         protoreflect.FieldDescriptor
 	fd_MsgSetPermissionVPToValidated_verification_fees     protoreflect.FieldDescriptor
-	fd_MsgSetPermissionVPToValidated_country               protoreflect.FieldDescriptor
+	fd_MsgSetPermissionVPToValidated_countries             protoreflect.FieldDescriptor
 	fd_MsgSetPermissionVPToValidated_vp_summary_digest_sri protoreflect.FieldDescriptor
 )
 
@@ -2765,7 +3052,7 @@ func init() {
 	fd_MsgSetPermissionVPToValidated_validation_fees = md_MsgSetPermissionVPToValidated.Fields().ByName("validation_fees")
 	fd_MsgSetPermissionVPToValidated_issuance_fees = md_MsgSetPermissionVPToValidated.Fields().ByName("issuance_fees")
 	fd_MsgSetPermissionVPToValidated_verification_fees = md_MsgSetPermissionVPToValidated.Fields().ByName("verification_fees")
-	fd_MsgSetPermissionVPToValidated_country = md_MsgSetPermissionVPToValidated.Fields().ByName("country")
+	fd_MsgSetPermissionVPToValidated_countries = md_MsgSetPermissionVPToValidated.Fields().ByName("countries")
 	fd_MsgSetPermissionVPToValidated_vp_summary_digest_sri = md_MsgSetPermissionVPToValidated.Fields().ByName("vp_summary_digest_sri")
 }
 
@@ -2870,9 +3157,9 @@ func (x *fastReflection_MsgSetPermissionVPToValidated) Range(f func(protoreflect
 			return
 		}
 	}
-	if x.Country != "" {
-		value := protoreflect.ValueOfString(x.Country)
-		if !f(fd_MsgSetPermissionVPToValidated_country, value) {
+	if len(x.Countries) != 0 {
+		value := protoreflect.ValueOfList(&_MsgSetPermissionVPToValidated_7_list{list: &x.Countries})
+		if !f(fd_MsgSetPermissionVPToValidated_countries, value) {
 			return
 		}
 	}
@@ -2909,8 +3196,8 @@ func (x *fastReflection_MsgSetPermissionVPToValidated) Has(fd protoreflect.Field
 		return x.IssuanceFees != uint64(0)
 	case "verana.perm.v1.MsgSetPermissionVPToValidated.verification_fees":
 		return x.VerificationFees != uint64(0)
-	case "verana.perm.v1.MsgSetPermissionVPToValidated.country":
-		return x.Country != ""
+	case "verana.perm.v1.MsgSetPermissionVPToValidated.countries":
+		return len(x.Countries) != 0
 	case "verana.perm.v1.MsgSetPermissionVPToValidated.vp_summary_digest_sri":
 		return x.VpSummaryDigestSri != ""
 	default:
@@ -2941,8 +3228,8 @@ func (x *fastReflection_MsgSetPermissionVPToValidated) Clear(fd protoreflect.Fie
 		x.IssuanceFees = uint64(0)
 	case "verana.perm.v1.MsgSetPermissionVPToValidated.verification_fees":
 		x.VerificationFees = uint64(0)
-	case "verana.perm.v1.MsgSetPermissionVPToValidated.country":
-		x.Country = ""
+	case "verana.perm.v1.MsgSetPermissionVPToValidated.countries":
+		x.Countries = nil
 	case "verana.perm.v1.MsgSetPermissionVPToValidated.vp_summary_digest_sri":
 		x.VpSummaryDigestSri = ""
 	default:
@@ -2979,9 +3266,12 @@ func (x *fastReflection_MsgSetPermissionVPToValidated) Get(descriptor protorefle
 	case "verana.perm.v1.MsgSetPermissionVPToValidated.verification_fees":
 		value := x.VerificationFees
 		return protoreflect.ValueOfUint64(value)
-	case "verana.perm.v1.MsgSetPermissionVPToValidated.country":
-		value := x.Country
-		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.MsgSetPermissionVPToValidated.countries":
+		if len(x.Countries) == 0 {
+			return protoreflect.ValueOfList(&_MsgSetPermissionVPToValidated_7_list{})
+		}
+		listValue := &_MsgSetPermissionVPToValidated_7_list{list: &x.Countries}
+		return protoreflect.ValueOfList(listValue)
 	case "verana.perm.v1.MsgSetPermissionVPToValidated.vp_summary_digest_sri":
 		value := x.VpSummaryDigestSri
 		return protoreflect.ValueOfString(value)
@@ -3017,8 +3307,10 @@ func (x *fastReflection_MsgSetPermissionVPToValidated) Set(fd protoreflect.Field
 		x.IssuanceFees = value.Uint()
 	case "verana.perm.v1.MsgSetPermissionVPToValidated.verification_fees":
 		x.VerificationFees = value.Uint()
-	case "verana.perm.v1.MsgSetPermissionVPToValidated.country":
-		x.Country = value.Interface().(string)
+	case "verana.perm.v1.MsgSetPermissionVPToValidated.countries":
+		lv := value.List()
+		clv := lv.(*_MsgSetPermissionVPToValidated_7_list)
+		x.Countries = *clv.list
 	case "verana.perm.v1.MsgSetPermissionVPToValidated.vp_summary_digest_sri":
 		x.VpSummaryDigestSri = value.Interface().(string)
 	default:
@@ -3046,6 +3338,12 @@ func (x *fastReflection_MsgSetPermissionVPToValidated) Mutable(fd protoreflect.F
 			x.EffectiveUntil = new(timestamppb.Timestamp)
 		}
 		return protoreflect.ValueOfMessage(x.EffectiveUntil.ProtoReflect())
+	case "verana.perm.v1.MsgSetPermissionVPToValidated.countries":
+		if x.Countries == nil {
+			x.Countries = []string{}
+		}
+		value := &_MsgSetPermissionVPToValidated_7_list{list: &x.Countries}
+		return protoreflect.ValueOfList(value)
 	case "verana.perm.v1.MsgSetPermissionVPToValidated.creator":
 		panic(fmt.Errorf("field creator of message verana.perm.v1.MsgSetPermissionVPToValidated is not mutable"))
 	case "verana.perm.v1.MsgSetPermissionVPToValidated.id":
@@ -3056,8 +3354,6 @@ func (x *fastReflection_MsgSetPermissionVPToValidated) Mutable(fd protoreflect.F
 		panic(fmt.Errorf("field issuance_fees of message verana.perm.v1.MsgSetPermissionVPToValidated is not mutable"))
 	case "verana.perm.v1.MsgSetPermissionVPToValidated.verification_fees":
 		panic(fmt.Errorf("field verification_fees of message verana.perm.v1.MsgSetPermissionVPToValidated is not mutable"))
-	case "verana.perm.v1.MsgSetPermissionVPToValidated.country":
-		panic(fmt.Errorf("field country of message verana.perm.v1.MsgSetPermissionVPToValidated is not mutable"))
 	case "verana.perm.v1.MsgSetPermissionVPToValidated.vp_summary_digest_sri":
 		panic(fmt.Errorf("field vp_summary_digest_sri of message verana.perm.v1.MsgSetPermissionVPToValidated is not mutable"))
 	default:
@@ -3086,8 +3382,9 @@ func (x *fastReflection_MsgSetPermissionVPToValidated) NewField(fd protoreflect.
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "verana.perm.v1.MsgSetPermissionVPToValidated.verification_fees":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "verana.perm.v1.MsgSetPermissionVPToValidated.country":
-		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.MsgSetPermissionVPToValidated.countries":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgSetPermissionVPToValidated_7_list{list: &list})
 	case "verana.perm.v1.MsgSetPermissionVPToValidated.vp_summary_digest_sri":
 		return protoreflect.ValueOfString("")
 	default:
@@ -3179,9 +3476,11 @@ func (x *fastReflection_MsgSetPermissionVPToValidated) ProtoMethods() *protoifac
 		if x.VerificationFees != 0 {
 			n += 1 + runtime.Sov(uint64(x.VerificationFees))
 		}
-		l = len(x.Country)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Countries) > 0 {
+			for _, s := range x.Countries {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		l = len(x.VpSummaryDigestSri)
 		if l > 0 {
@@ -3223,12 +3522,14 @@ func (x *fastReflection_MsgSetPermissionVPToValidated) ProtoMethods() *protoifac
 			i--
 			dAtA[i] = 0x42
 		}
-		if len(x.Country) > 0 {
-			i -= len(x.Country)
-			copy(dAtA[i:], x.Country)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Country)))
-			i--
-			dAtA[i] = 0x3a
+		if len(x.Countries) > 0 {
+			for iNdEx := len(x.Countries) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Countries[iNdEx])
+				copy(dAtA[i:], x.Countries[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Countries[iNdEx])))
+				i--
+				dAtA[i] = 0x3a
+			}
 		}
 		if x.VerificationFees != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.VerificationFees))
@@ -3466,7 +3767,7 @@ func (x *fastReflection_MsgSetPermissionVPToValidated) ProtoMethods() *protoifac
 				}
 			case 7:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Country", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Countries", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3494,7 +3795,7 @@ func (x *fastReflection_MsgSetPermissionVPToValidated) ProtoMethods() *protoifac
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Country = string(dAtA[iNdEx:postIndex])
+				x.Countries = append(x.Countries, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
 			case 8:
 				if wireType != 2 {
@@ -6391,12 +6692,58 @@ func (x *fastReflection_MsgCancelPermissionVPLastRequestResponse) ProtoMethods()
 	}
 }
 
+var _ protoreflect.List = (*_MsgCreateRootPermission_4_list)(nil)
+
+type _MsgCreateRootPermission_4_list struct {
+	list *[]string
+}
+
+func (x *_MsgCreateRootPermission_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgCreateRootPermission_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgCreateRootPermission_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgCreateRootPermission_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgCreateRootPermission_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgCreateRootPermission at list field Countries as it is not of Message kind"))
+}
+
+func (x *_MsgCreateRootPermission_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgCreateRootPermission_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgCreateRootPermission_4_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_MsgCreateRootPermission                   protoreflect.MessageDescriptor
 	fd_MsgCreateRootPermission_creator           protoreflect.FieldDescriptor
 	fd_MsgCreateRootPermission_schema_id         protoreflect.FieldDescriptor
 	fd_MsgCreateRootPermission_did               protoreflect.FieldDescriptor
-	fd_MsgCreateRootPermission_country           protoreflect.FieldDescriptor
+	fd_MsgCreateRootPermission_countries         protoreflect.FieldDescriptor
 	fd_MsgCreateRootPermission_effective_from    protoreflect.FieldDescriptor
 	fd_MsgCreateRootPermission_effective_until   protoreflect.FieldDescriptor
 	fd_MsgCreateRootPermission_validation_fees   protoreflect.FieldDescriptor
@@ -6410,7 +6757,7 @@ func init() {
 	fd_MsgCreateRootPermission_creator = md_MsgCreateRootPermission.Fields().ByName("creator")
 	fd_MsgCreateRootPermission_schema_id = md_MsgCreateRootPermission.Fields().ByName("schema_id")
 	fd_MsgCreateRootPermission_did = md_MsgCreateRootPermission.Fields().ByName("did")
-	fd_MsgCreateRootPermission_country = md_MsgCreateRootPermission.Fields().ByName("country")
+	fd_MsgCreateRootPermission_countries = md_MsgCreateRootPermission.Fields().ByName("countries")
 	fd_MsgCreateRootPermission_effective_from = md_MsgCreateRootPermission.Fields().ByName("effective_from")
 	fd_MsgCreateRootPermission_effective_until = md_MsgCreateRootPermission.Fields().ByName("effective_until")
 	fd_MsgCreateRootPermission_validation_fees = md_MsgCreateRootPermission.Fields().ByName("validation_fees")
@@ -6501,9 +6848,9 @@ func (x *fastReflection_MsgCreateRootPermission) Range(f func(protoreflect.Field
 			return
 		}
 	}
-	if x.Country != "" {
-		value := protoreflect.ValueOfString(x.Country)
-		if !f(fd_MsgCreateRootPermission_country, value) {
+	if len(x.Countries) != 0 {
+		value := protoreflect.ValueOfList(&_MsgCreateRootPermission_4_list{list: &x.Countries})
+		if !f(fd_MsgCreateRootPermission_countries, value) {
 			return
 		}
 	}
@@ -6558,8 +6905,8 @@ func (x *fastReflection_MsgCreateRootPermission) Has(fd protoreflect.FieldDescri
 		return x.SchemaId != uint64(0)
 	case "verana.perm.v1.MsgCreateRootPermission.did":
 		return x.Did != ""
-	case "verana.perm.v1.MsgCreateRootPermission.country":
-		return x.Country != ""
+	case "verana.perm.v1.MsgCreateRootPermission.countries":
+		return len(x.Countries) != 0
 	case "verana.perm.v1.MsgCreateRootPermission.effective_from":
 		return x.EffectiveFrom != nil
 	case "verana.perm.v1.MsgCreateRootPermission.effective_until":
@@ -6592,8 +6939,8 @@ func (x *fastReflection_MsgCreateRootPermission) Clear(fd protoreflect.FieldDesc
 		x.SchemaId = uint64(0)
 	case "verana.perm.v1.MsgCreateRootPermission.did":
 		x.Did = ""
-	case "verana.perm.v1.MsgCreateRootPermission.country":
-		x.Country = ""
+	case "verana.perm.v1.MsgCreateRootPermission.countries":
+		x.Countries = nil
 	case "verana.perm.v1.MsgCreateRootPermission.effective_from":
 		x.EffectiveFrom = nil
 	case "verana.perm.v1.MsgCreateRootPermission.effective_until":
@@ -6629,9 +6976,12 @@ func (x *fastReflection_MsgCreateRootPermission) Get(descriptor protoreflect.Fie
 	case "verana.perm.v1.MsgCreateRootPermission.did":
 		value := x.Did
 		return protoreflect.ValueOfString(value)
-	case "verana.perm.v1.MsgCreateRootPermission.country":
-		value := x.Country
-		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.MsgCreateRootPermission.countries":
+		if len(x.Countries) == 0 {
+			return protoreflect.ValueOfList(&_MsgCreateRootPermission_4_list{})
+		}
+		listValue := &_MsgCreateRootPermission_4_list{list: &x.Countries}
+		return protoreflect.ValueOfList(listValue)
 	case "verana.perm.v1.MsgCreateRootPermission.effective_from":
 		value := x.EffectiveFrom
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
@@ -6673,8 +7023,10 @@ func (x *fastReflection_MsgCreateRootPermission) Set(fd protoreflect.FieldDescri
 		x.SchemaId = value.Uint()
 	case "verana.perm.v1.MsgCreateRootPermission.did":
 		x.Did = value.Interface().(string)
-	case "verana.perm.v1.MsgCreateRootPermission.country":
-		x.Country = value.Interface().(string)
+	case "verana.perm.v1.MsgCreateRootPermission.countries":
+		lv := value.List()
+		clv := lv.(*_MsgCreateRootPermission_4_list)
+		x.Countries = *clv.list
 	case "verana.perm.v1.MsgCreateRootPermission.effective_from":
 		x.EffectiveFrom = value.Message().Interface().(*timestamppb.Timestamp)
 	case "verana.perm.v1.MsgCreateRootPermission.effective_until":
@@ -6705,6 +7057,12 @@ func (x *fastReflection_MsgCreateRootPermission) Set(fd protoreflect.FieldDescri
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MsgCreateRootPermission) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "verana.perm.v1.MsgCreateRootPermission.countries":
+		if x.Countries == nil {
+			x.Countries = []string{}
+		}
+		value := &_MsgCreateRootPermission_4_list{list: &x.Countries}
+		return protoreflect.ValueOfList(value)
 	case "verana.perm.v1.MsgCreateRootPermission.effective_from":
 		if x.EffectiveFrom == nil {
 			x.EffectiveFrom = new(timestamppb.Timestamp)
@@ -6721,8 +7079,6 @@ func (x *fastReflection_MsgCreateRootPermission) Mutable(fd protoreflect.FieldDe
 		panic(fmt.Errorf("field schema_id of message verana.perm.v1.MsgCreateRootPermission is not mutable"))
 	case "verana.perm.v1.MsgCreateRootPermission.did":
 		panic(fmt.Errorf("field did of message verana.perm.v1.MsgCreateRootPermission is not mutable"))
-	case "verana.perm.v1.MsgCreateRootPermission.country":
-		panic(fmt.Errorf("field country of message verana.perm.v1.MsgCreateRootPermission is not mutable"))
 	case "verana.perm.v1.MsgCreateRootPermission.validation_fees":
 		panic(fmt.Errorf("field validation_fees of message verana.perm.v1.MsgCreateRootPermission is not mutable"))
 	case "verana.perm.v1.MsgCreateRootPermission.issuance_fees":
@@ -6748,8 +7104,9 @@ func (x *fastReflection_MsgCreateRootPermission) NewField(fd protoreflect.FieldD
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "verana.perm.v1.MsgCreateRootPermission.did":
 		return protoreflect.ValueOfString("")
-	case "verana.perm.v1.MsgCreateRootPermission.country":
-		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.MsgCreateRootPermission.countries":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgCreateRootPermission_4_list{list: &list})
 	case "verana.perm.v1.MsgCreateRootPermission.effective_from":
 		m := new(timestamppb.Timestamp)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
@@ -6842,9 +7199,11 @@ func (x *fastReflection_MsgCreateRootPermission) ProtoMethods() *protoiface.Meth
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Country)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Countries) > 0 {
+			for _, s := range x.Countries {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.EffectiveFrom != nil {
 			l = options.Size(x.EffectiveFrom)
@@ -6935,12 +7294,14 @@ func (x *fastReflection_MsgCreateRootPermission) ProtoMethods() *protoiface.Meth
 			i--
 			dAtA[i] = 0x2a
 		}
-		if len(x.Country) > 0 {
-			i -= len(x.Country)
-			copy(dAtA[i:], x.Country)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Country)))
-			i--
-			dAtA[i] = 0x22
+		if len(x.Countries) > 0 {
+			for iNdEx := len(x.Countries) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Countries[iNdEx])
+				copy(dAtA[i:], x.Countries[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Countries[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
 		}
 		if len(x.Did) > 0 {
 			i -= len(x.Did)
@@ -7095,7 +7456,7 @@ func (x *fastReflection_MsgCreateRootPermission) ProtoMethods() *protoiface.Meth
 				iNdEx = postIndex
 			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Country", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Countries", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7123,7 +7484,7 @@ func (x *fastReflection_MsgCreateRootPermission) ProtoMethods() *protoiface.Meth
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Country = string(dAtA[iNdEx:postIndex])
+				x.Countries = append(x.Countries, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
 			case 5:
 				if wireType != 2 {
@@ -8600,6 +8961,7 @@ var (
 	md_MsgRevokePermission         protoreflect.MessageDescriptor
 	fd_MsgRevokePermission_creator protoreflect.FieldDescriptor
 	fd_MsgRevokePermission_id      protoreflect.FieldDescriptor
+	fd_MsgRevokePermission_cascade protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -8607,6 +8969,7 @@ func init() {
 	md_MsgRevokePermission = File_verana_perm_v1_tx_proto.Messages().ByName("MsgRevokePermission")
 	fd_MsgRevokePermission_creator = md_MsgRevokePermission.Fields().ByName("creator")
 	fd_MsgRevokePermission_id = md_MsgRevokePermission.Fields().ByName("id")
+	fd_MsgRevokePermission_cascade = md_MsgRevokePermission.Fields().ByName("cascade")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgRevokePermission)(nil)
@@ -8686,6 +9049,12 @@ func (x *fastReflection_MsgRevokePermission) Range(f func(protoreflect.FieldDesc
 			return
 		}
 	}
+	if x.Cascade != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Cascade))
+		if !f(fd_MsgRevokePermission_cascade, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -8705,6 +9074,8 @@ func (x *fastReflection_MsgRevokePermission) Has(fd protoreflect.FieldDescriptor
 		return x.Creator != ""
 	case "verana.perm.v1.MsgRevokePermission.id":
 		return x.Id != uint64(0)
+	case "verana.perm.v1.MsgRevokePermission.cascade":
+		return x.Cascade != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgRevokePermission"))
@@ -8725,6 +9096,8 @@ func (x *fastReflection_MsgRevokePermission) Clear(fd protoreflect.FieldDescript
 		x.Creator = ""
 	case "verana.perm.v1.MsgRevokePermission.id":
 		x.Id = uint64(0)
+	case "verana.perm.v1.MsgRevokePermission.cascade":
+		x.Cascade = 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgRevokePermission"))
@@ -8747,6 +9120,9 @@ func (x *fastReflection_MsgRevokePermission) Get(descriptor protoreflect.FieldDe
 	case "verana.perm.v1.MsgRevokePermission.id":
 		value := x.Id
 		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.MsgRevokePermission.cascade":
+		value := x.Cascade
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgRevokePermission"))
@@ -8771,6 +9147,8 @@ func (x *fastReflection_MsgRevokePermission) Set(fd protoreflect.FieldDescriptor
 		x.Creator = value.Interface().(string)
 	case "verana.perm.v1.MsgRevokePermission.id":
 		x.Id = value.Uint()
+	case "verana.perm.v1.MsgRevokePermission.cascade":
+		x.Cascade = (CascadeMode)(value.Enum())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgRevokePermission"))
@@ -8795,6 +9173,8 @@ func (x *fastReflection_MsgRevokePermission) Mutable(fd protoreflect.FieldDescri
 		panic(fmt.Errorf("field creator of message verana.perm.v1.MsgRevokePermission is not mutable"))
 	case "verana.perm.v1.MsgRevokePermission.id":
 		panic(fmt.Errorf("field id of message verana.perm.v1.MsgRevokePermission is not mutable"))
+	case "verana.perm.v1.MsgRevokePermission.cascade":
+		panic(fmt.Errorf("field cascade of message verana.perm.v1.MsgRevokePermission is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgRevokePermission"))
@@ -8812,6 +9192,8 @@ func (x *fastReflection_MsgRevokePermission) NewField(fd protoreflect.FieldDescr
 		return protoreflect.ValueOfString("")
 	case "verana.perm.v1.MsgRevokePermission.id":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.MsgRevokePermission.cascade":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgRevokePermission"))
@@ -8888,6 +9270,9 @@ func (x *fastReflection_MsgRevokePermission) ProtoMethods() *protoiface.Methods
 		if x.Id != 0 {
 			n += 1 + runtime.Sov(uint64(x.Id))
 		}
+		if x.Cascade != 0 {
+			n += 1 + runtime.Sov(uint64(x.Cascade))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -8917,6 +9302,11 @@ func (x *fastReflection_MsgRevokePermission) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Cascade != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Cascade))
+			i--
+			dAtA[i] = 0x18
+		}
 		if x.Id != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
 			i--
@@ -9029,6 +9419,25 @@ func (x *fastReflection_MsgRevokePermission) ProtoMethods() *protoiface.Methods
 						break
 					}
 				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Cascade", wireType)
+				}
+				x.Cascade = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Cascade |= CascadeMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -9065,12 +9474,16 @@ func (x *fastReflection_MsgRevokePermission) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_MsgRevokePermissionResponse protoreflect.MessageDescriptor
+	md_MsgRevokePermissionResponse                 protoreflect.MessageDescriptor
+	fd_MsgRevokePermissionResponse_cascaded        protoreflect.FieldDescriptor
+	fd_MsgRevokePermissionResponse_cascade_pending protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_verana_perm_v1_tx_proto_init()
 	md_MsgRevokePermissionResponse = File_verana_perm_v1_tx_proto.Messages().ByName("MsgRevokePermissionResponse")
+	fd_MsgRevokePermissionResponse_cascaded = md_MsgRevokePermissionResponse.Fields().ByName("cascaded")
+	fd_MsgRevokePermissionResponse_cascade_pending = md_MsgRevokePermissionResponse.Fields().ByName("cascade_pending")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgRevokePermissionResponse)(nil)
@@ -9138,6 +9551,18 @@ func (x *fastReflection_MsgRevokePermissionResponse) Interface() protoreflect.Pr
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
 func (x *fastReflection_MsgRevokePermissionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Cascaded != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Cascaded)
+		if !f(fd_MsgRevokePermissionResponse_cascaded, value) {
+			return
+		}
+	}
+	if x.CascadePending != false {
+		value := protoreflect.ValueOfBool(x.CascadePending)
+		if !f(fd_MsgRevokePermissionResponse_cascade_pending, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -9153,6 +9578,10 @@ func (x *fastReflection_MsgRevokePermissionResponse) Range(f func(protoreflect.F
 // a repeated field is populated if it is non-empty.
 func (x *fastReflection_MsgRevokePermissionResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "verana.perm.v1.MsgRevokePermissionResponse.cascaded":
+		return x.Cascaded != uint64(0)
+	case "verana.perm.v1.MsgRevokePermissionResponse.cascade_pending":
+		return x.CascadePending != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgRevokePermissionResponse"))
@@ -9169,6 +9598,10 @@ func (x *fastReflection_MsgRevokePermissionResponse) Has(fd protoreflect.FieldDe
 // Clear is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MsgRevokePermissionResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "verana.perm.v1.MsgRevokePermissionResponse.cascaded":
+		x.Cascaded = uint64(0)
+	case "verana.perm.v1.MsgRevokePermissionResponse.cascade_pending":
+		x.CascadePending = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgRevokePermissionResponse"))
@@ -9185,6 +9618,12 @@ func (x *fastReflection_MsgRevokePermissionResponse) Clear(fd protoreflect.Field
 // of the value; to obtain a mutable reference, use Mutable.
 func (x *fastReflection_MsgRevokePermissionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "verana.perm.v1.MsgRevokePermissionResponse.cascaded":
+		value := x.Cascaded
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.MsgRevokePermissionResponse.cascade_pending":
+		value := x.CascadePending
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgRevokePermissionResponse"))
@@ -9205,6 +9644,10 @@ func (x *fastReflection_MsgRevokePermissionResponse) Get(descriptor protoreflect
 // Set is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MsgRevokePermissionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "verana.perm.v1.MsgRevokePermissionResponse.cascaded":
+		x.Cascaded = value.Uint()
+	case "verana.perm.v1.MsgRevokePermissionResponse.cascade_pending":
+		x.CascadePending = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgRevokePermissionResponse"))
@@ -9225,6 +9668,10 @@ func (x *fastReflection_MsgRevokePermissionResponse) Set(fd protoreflect.FieldDe
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MsgRevokePermissionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "verana.perm.v1.MsgRevokePermissionResponse.cascaded":
+		panic(fmt.Errorf("field cascaded of message verana.perm.v1.MsgRevokePermissionResponse is not mutable"))
+	case "verana.perm.v1.MsgRevokePermissionResponse.cascade_pending":
+		panic(fmt.Errorf("field cascade_pending of message verana.perm.v1.MsgRevokePermissionResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgRevokePermissionResponse"))
@@ -9238,6 +9685,10 @@ func (x *fastReflection_MsgRevokePermissionResponse) Mutable(fd protoreflect.Fie
 // For lists, maps, and messages, this returns a new, empty, mutable value.
 func (x *fastReflection_MsgRevokePermissionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "verana.perm.v1.MsgRevokePermissionResponse.cascaded":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.MsgRevokePermissionResponse.cascade_pending":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgRevokePermissionResponse"))
@@ -9307,6 +9758,12 @@ func (x *fastReflection_MsgRevokePermissionResponse) ProtoMethods() *protoiface.
 		var n int
 		var l int
 		_ = l
+		if x.Cascaded != 0 {
+			n += 1 + runtime.Sov(uint64(x.Cascaded))
+		}
+		if x.CascadePending {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -9336,6 +9793,21 @@ func (x *fastReflection_MsgRevokePermissionResponse) ProtoMethods() *protoiface.
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.CascadePending {
+			i--
+			if x.CascadePending {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Cascaded != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Cascaded))
+			i--
+			dAtA[i] = 0x8
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -9385,6 +9857,45 @@ func (x *fastReflection_MsgRevokePermissionResponse) ProtoMethods() *protoiface.
 				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevokePermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Cascaded", wireType)
+				}
+				x.Cascaded = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Cascaded |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CascadePending", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.CascadePending = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -9428,6 +9939,9 @@ var (
 	fd_MsgCreateOrUpdatePermissionSession_verifier_perm_id     protoreflect.FieldDescriptor
 	fd_MsgCreateOrUpdatePermissionSession_agent_perm_id        protoreflect.FieldDescriptor
 	fd_MsgCreateOrUpdatePermissionSession_wallet_agent_perm_id protoreflect.FieldDescriptor
+	fd_MsgCreateOrUpdatePermissionSession_allowance_id         protoreflect.FieldDescriptor
+	fd_MsgCreateOrUpdatePermissionSession_expires              protoreflect.FieldDescriptor
+	fd_MsgCreateOrUpdatePermissionSession_credit_id            protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -9439,6 +9953,9 @@ func init() {
 	fd_MsgCreateOrUpdatePermissionSession_verifier_perm_id = md_MsgCreateOrUpdatePermissionSession.Fields().ByName("verifier_perm_id")
 	fd_MsgCreateOrUpdatePermissionSession_agent_perm_id = md_MsgCreateOrUpdatePermissionSession.Fields().ByName("agent_perm_id")
 	fd_MsgCreateOrUpdatePermissionSession_wallet_agent_perm_id = md_MsgCreateOrUpdatePermissionSession.Fields().ByName("wallet_agent_perm_id")
+	fd_MsgCreateOrUpdatePermissionSession_allowance_id = md_MsgCreateOrUpdatePermissionSession.Fields().ByName("allowance_id")
+	fd_MsgCreateOrUpdatePermissionSession_expires = md_MsgCreateOrUpdatePermissionSession.Fields().ByName("expires")
+	fd_MsgCreateOrUpdatePermissionSession_credit_id = md_MsgCreateOrUpdatePermissionSession.Fields().ByName("credit_id")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgCreateOrUpdatePermissionSession)(nil)
@@ -9542,6 +10059,24 @@ func (x *fastReflection_MsgCreateOrUpdatePermissionSession) Range(f func(protore
 			return
 		}
 	}
+	if x.AllowanceId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AllowanceId)
+		if !f(fd_MsgCreateOrUpdatePermissionSession_allowance_id, value) {
+			return
+		}
+	}
+	if x.Expires != nil {
+		value := protoreflect.ValueOfMessage(x.Expires.ProtoReflect())
+		if !f(fd_MsgCreateOrUpdatePermissionSession_expires, value) {
+			return
+		}
+	}
+	if x.CreditId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CreditId)
+		if !f(fd_MsgCreateOrUpdatePermissionSession_credit_id, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -9569,6 +10104,12 @@ func (x *fastReflection_MsgCreateOrUpdatePermissionSession) Has(fd protoreflect.
 		return x.AgentPermId != uint64(0)
 	case "verana.perm.v1.MsgCreateOrUpdatePermissionSession.wallet_agent_perm_id":
 		return x.WalletAgentPermId != uint64(0)
+	case "verana.perm.v1.MsgCreateOrUpdatePermissionSession.allowance_id":
+		return x.AllowanceId != uint64(0)
+	case "verana.perm.v1.MsgCreateOrUpdatePermissionSession.expires":
+		return x.Expires != nil
+	case "verana.perm.v1.MsgCreateOrUpdatePermissionSession.credit_id":
+		return x.CreditId != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgCreateOrUpdatePermissionSession"))
@@ -9597,6 +10138,12 @@ func (x *fastReflection_MsgCreateOrUpdatePermissionSession) Clear(fd protoreflec
 		x.AgentPermId = uint64(0)
 	case "verana.perm.v1.MsgCreateOrUpdatePermissionSession.wallet_agent_perm_id":
 		x.WalletAgentPermId = uint64(0)
+	case "verana.perm.v1.MsgCreateOrUpdatePermissionSession.allowance_id":
+		x.AllowanceId = uint64(0)
+	case "verana.perm.v1.MsgCreateOrUpdatePermissionSession.expires":
+		x.Expires = nil
+	case "verana.perm.v1.MsgCreateOrUpdatePermissionSession.credit_id":
+		x.CreditId = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgCreateOrUpdatePermissionSession"))
@@ -9631,6 +10178,15 @@ func (x *fastReflection_MsgCreateOrUpdatePermissionSession) Get(descriptor proto
 	case "verana.perm.v1.MsgCreateOrUpdatePermissionSession.wallet_agent_perm_id":
 		value := x.WalletAgentPermId
 		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.MsgCreateOrUpdatePermissionSession.allowance_id":
+		value := x.AllowanceId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.MsgCreateOrUpdatePermissionSession.expires":
+		value := x.Expires
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.perm.v1.MsgCreateOrUpdatePermissionSession.credit_id":
+		value := x.CreditId
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgCreateOrUpdatePermissionSession"))
@@ -9663,6 +10219,12 @@ func (x *fastReflection_MsgCreateOrUpdatePermissionSession) Set(fd protoreflect.
 		x.AgentPermId = value.Uint()
 	case "verana.perm.v1.MsgCreateOrUpdatePermissionSession.wallet_agent_perm_id":
 		x.WalletAgentPermId = value.Uint()
+	case "verana.perm.v1.MsgCreateOrUpdatePermissionSession.allowance_id":
+		x.AllowanceId = value.Uint()
+	case "verana.perm.v1.MsgCreateOrUpdatePermissionSession.expires":
+		x.Expires = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.perm.v1.MsgCreateOrUpdatePermissionSession.credit_id":
+		x.CreditId = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgCreateOrUpdatePermissionSession"))
@@ -9683,6 +10245,11 @@ func (x *fastReflection_MsgCreateOrUpdatePermissionSession) Set(fd protoreflect.
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MsgCreateOrUpdatePermissionSession) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "verana.perm.v1.MsgCreateOrUpdatePermissionSession.expires":
+		if x.Expires == nil {
+			x.Expires = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Expires.ProtoReflect())
 	case "verana.perm.v1.MsgCreateOrUpdatePermissionSession.creator":
 		panic(fmt.Errorf("field creator of message verana.perm.v1.MsgCreateOrUpdatePermissionSession is not mutable"))
 	case "verana.perm.v1.MsgCreateOrUpdatePermissionSession.id":
@@ -9695,6 +10262,10 @@ func (x *fastReflection_MsgCreateOrUpdatePermissionSession) Mutable(fd protorefl
 		panic(fmt.Errorf("field agent_perm_id of message verana.perm.v1.MsgCreateOrUpdatePermissionSession is not mutable"))
 	case "verana.perm.v1.MsgCreateOrUpdatePermissionSession.wallet_agent_perm_id":
 		panic(fmt.Errorf("field wallet_agent_perm_id of message verana.perm.v1.MsgCreateOrUpdatePermissionSession is not mutable"))
+	case "verana.perm.v1.MsgCreateOrUpdatePermissionSession.allowance_id":
+		panic(fmt.Errorf("field allowance_id of message verana.perm.v1.MsgCreateOrUpdatePermissionSession is not mutable"))
+	case "verana.perm.v1.MsgCreateOrUpdatePermissionSession.credit_id":
+		panic(fmt.Errorf("field credit_id of message verana.perm.v1.MsgCreateOrUpdatePermissionSession is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgCreateOrUpdatePermissionSession"))
@@ -9720,6 +10291,13 @@ func (x *fastReflection_MsgCreateOrUpdatePermissionSession) NewField(fd protoref
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "verana.perm.v1.MsgCreateOrUpdatePermissionSession.wallet_agent_perm_id":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.MsgCreateOrUpdatePermissionSession.allowance_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.MsgCreateOrUpdatePermissionSession.expires":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.perm.v1.MsgCreateOrUpdatePermissionSession.credit_id":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgCreateOrUpdatePermissionSession"))
@@ -9809,6 +10387,16 @@ func (x *fastReflection_MsgCreateOrUpdatePermissionSession) ProtoMethods() *prot
 		if x.WalletAgentPermId != 0 {
 			n += 1 + runtime.Sov(uint64(x.WalletAgentPermId))
 		}
+		if x.AllowanceId != 0 {
+			n += 1 + runtime.Sov(uint64(x.AllowanceId))
+		}
+		if x.Expires != nil {
+			l = options.Size(x.Expires)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CreditId != 0 {
+			n += 1 + runtime.Sov(uint64(x.CreditId))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -9838,6 +10426,30 @@ func (x *fastReflection_MsgCreateOrUpdatePermissionSession) ProtoMethods() *prot
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.CreditId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreditId))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.Expires != nil {
+			encoded, err := options.Marshal(x.Expires)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.AllowanceId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AllowanceId))
+			i--
+			dAtA[i] = 0x38
+		}
 		if x.WalletAgentPermId != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.WalletAgentPermId))
 			i--
@@ -10061,16 +10673,90 @@ func (x *fastReflection_MsgCreateOrUpdatePermissionSession) ProtoMethods() *prot
 						break
 					}
 				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowanceId", wireType)
 				}
-				if (iNdEx + skippy) > l {
+				x.AllowanceId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AllowanceId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expires", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Expires == nil {
+					x.Expires = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expires); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreditId", wireType)
+				}
+				x.CreditId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CreditId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
 				if !options.DiscardUnknown {
@@ -10516,11 +11202,67 @@ func (x *fastReflection_MsgCreateOrUpdatePermissionSessionResponse) ProtoMethods
 	}
 }
 
+var _ protoreflect.List = (*_MsgSlashPermissionTrustDeposit_8_list)(nil)
+
+type _MsgSlashPermissionTrustDeposit_8_list struct {
+	list *[]*v1.SlashShare
+}
+
+func (x *_MsgSlashPermissionTrustDeposit_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgSlashPermissionTrustDeposit_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgSlashPermissionTrustDeposit_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1.SlashShare)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgSlashPermissionTrustDeposit_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1.SlashShare)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgSlashPermissionTrustDeposit_8_list) AppendMutable() protoreflect.Value {
+	v := new(v1.SlashShare)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgSlashPermissionTrustDeposit_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgSlashPermissionTrustDeposit_8_list) NewElement() protoreflect.Value {
+	v := new(v1.SlashShare)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgSlashPermissionTrustDeposit_8_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_MsgSlashPermissionTrustDeposit         protoreflect.MessageDescriptor
-	fd_MsgSlashPermissionTrustDeposit_creator protoreflect.FieldDescriptor
-	fd_MsgSlashPermissionTrustDeposit_id      protoreflect.FieldDescriptor
-	fd_MsgSlashPermissionTrustDeposit_amount  protoreflect.FieldDescriptor
+	md_MsgSlashPermissionTrustDeposit                     protoreflect.MessageDescriptor
+	fd_MsgSlashPermissionTrustDeposit_creator             protoreflect.FieldDescriptor
+	fd_MsgSlashPermissionTrustDeposit_id                  protoreflect.FieldDescriptor
+	fd_MsgSlashPermissionTrustDeposit_amount              protoreflect.FieldDescriptor
+	fd_MsgSlashPermissionTrustDeposit_reason_code         protoreflect.FieldDescriptor
+	fd_MsgSlashPermissionTrustDeposit_evidence_url        protoreflect.FieldDescriptor
+	fd_MsgSlashPermissionTrustDeposit_evidence_digest_sri protoreflect.FieldDescriptor
+	fd_MsgSlashPermissionTrustDeposit_gf_version          protoreflect.FieldDescriptor
+	fd_MsgSlashPermissionTrustDeposit_distribution        protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -10529,6 +11271,11 @@ func init() {
 	fd_MsgSlashPermissionTrustDeposit_creator = md_MsgSlashPermissionTrustDeposit.Fields().ByName("creator")
 	fd_MsgSlashPermissionTrustDeposit_id = md_MsgSlashPermissionTrustDeposit.Fields().ByName("id")
 	fd_MsgSlashPermissionTrustDeposit_amount = md_MsgSlashPermissionTrustDeposit.Fields().ByName("amount")
+	fd_MsgSlashPermissionTrustDeposit_reason_code = md_MsgSlashPermissionTrustDeposit.Fields().ByName("reason_code")
+	fd_MsgSlashPermissionTrustDeposit_evidence_url = md_MsgSlashPermissionTrustDeposit.Fields().ByName("evidence_url")
+	fd_MsgSlashPermissionTrustDeposit_evidence_digest_sri = md_MsgSlashPermissionTrustDeposit.Fields().ByName("evidence_digest_sri")
+	fd_MsgSlashPermissionTrustDeposit_gf_version = md_MsgSlashPermissionTrustDeposit.Fields().ByName("gf_version")
+	fd_MsgSlashPermissionTrustDeposit_distribution = md_MsgSlashPermissionTrustDeposit.Fields().ByName("distribution")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgSlashPermissionTrustDeposit)(nil)
@@ -10614,6 +11361,36 @@ func (x *fastReflection_MsgSlashPermissionTrustDeposit) Range(f func(protoreflec
 			return
 		}
 	}
+	if x.ReasonCode != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ReasonCode)
+		if !f(fd_MsgSlashPermissionTrustDeposit_reason_code, value) {
+			return
+		}
+	}
+	if x.EvidenceUrl != "" {
+		value := protoreflect.ValueOfString(x.EvidenceUrl)
+		if !f(fd_MsgSlashPermissionTrustDeposit_evidence_url, value) {
+			return
+		}
+	}
+	if x.EvidenceDigestSri != "" {
+		value := protoreflect.ValueOfString(x.EvidenceDigestSri)
+		if !f(fd_MsgSlashPermissionTrustDeposit_evidence_digest_sri, value) {
+			return
+		}
+	}
+	if x.GfVersion != int32(0) {
+		value := protoreflect.ValueOfInt32(x.GfVersion)
+		if !f(fd_MsgSlashPermissionTrustDeposit_gf_version, value) {
+			return
+		}
+	}
+	if len(x.Distribution) != 0 {
+		value := protoreflect.ValueOfList(&_MsgSlashPermissionTrustDeposit_8_list{list: &x.Distribution})
+		if !f(fd_MsgSlashPermissionTrustDeposit_distribution, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -10635,6 +11412,16 @@ func (x *fastReflection_MsgSlashPermissionTrustDeposit) Has(fd protoreflect.Fiel
 		return x.Id != uint64(0)
 	case "verana.perm.v1.MsgSlashPermissionTrustDeposit.amount":
 		return x.Amount != uint64(0)
+	case "verana.perm.v1.MsgSlashPermissionTrustDeposit.reason_code":
+		return x.ReasonCode != uint32(0)
+	case "verana.perm.v1.MsgSlashPermissionTrustDeposit.evidence_url":
+		return x.EvidenceUrl != ""
+	case "verana.perm.v1.MsgSlashPermissionTrustDeposit.evidence_digest_sri":
+		return x.EvidenceDigestSri != ""
+	case "verana.perm.v1.MsgSlashPermissionTrustDeposit.gf_version":
+		return x.GfVersion != int32(0)
+	case "verana.perm.v1.MsgSlashPermissionTrustDeposit.distribution":
+		return len(x.Distribution) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSlashPermissionTrustDeposit"))
@@ -10657,6 +11444,16 @@ func (x *fastReflection_MsgSlashPermissionTrustDeposit) Clear(fd protoreflect.Fi
 		x.Id = uint64(0)
 	case "verana.perm.v1.MsgSlashPermissionTrustDeposit.amount":
 		x.Amount = uint64(0)
+	case "verana.perm.v1.MsgSlashPermissionTrustDeposit.reason_code":
+		x.ReasonCode = uint32(0)
+	case "verana.perm.v1.MsgSlashPermissionTrustDeposit.evidence_url":
+		x.EvidenceUrl = ""
+	case "verana.perm.v1.MsgSlashPermissionTrustDeposit.evidence_digest_sri":
+		x.EvidenceDigestSri = ""
+	case "verana.perm.v1.MsgSlashPermissionTrustDeposit.gf_version":
+		x.GfVersion = int32(0)
+	case "verana.perm.v1.MsgSlashPermissionTrustDeposit.distribution":
+		x.Distribution = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSlashPermissionTrustDeposit"))
@@ -10682,6 +11479,24 @@ func (x *fastReflection_MsgSlashPermissionTrustDeposit) Get(descriptor protorefl
 	case "verana.perm.v1.MsgSlashPermissionTrustDeposit.amount":
 		value := x.Amount
 		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.MsgSlashPermissionTrustDeposit.reason_code":
+		value := x.ReasonCode
+		return protoreflect.ValueOfUint32(value)
+	case "verana.perm.v1.MsgSlashPermissionTrustDeposit.evidence_url":
+		value := x.EvidenceUrl
+		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.MsgSlashPermissionTrustDeposit.evidence_digest_sri":
+		value := x.EvidenceDigestSri
+		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.MsgSlashPermissionTrustDeposit.gf_version":
+		value := x.GfVersion
+		return protoreflect.ValueOfInt32(value)
+	case "verana.perm.v1.MsgSlashPermissionTrustDeposit.distribution":
+		if len(x.Distribution) == 0 {
+			return protoreflect.ValueOfList(&_MsgSlashPermissionTrustDeposit_8_list{})
+		}
+		listValue := &_MsgSlashPermissionTrustDeposit_8_list{list: &x.Distribution}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSlashPermissionTrustDeposit"))
@@ -10708,6 +11523,18 @@ func (x *fastReflection_MsgSlashPermissionTrustDeposit) Set(fd protoreflect.Fiel
 		x.Id = value.Uint()
 	case "verana.perm.v1.MsgSlashPermissionTrustDeposit.amount":
 		x.Amount = value.Uint()
+	case "verana.perm.v1.MsgSlashPermissionTrustDeposit.reason_code":
+		x.ReasonCode = uint32(value.Uint())
+	case "verana.perm.v1.MsgSlashPermissionTrustDeposit.evidence_url":
+		x.EvidenceUrl = value.Interface().(string)
+	case "verana.perm.v1.MsgSlashPermissionTrustDeposit.evidence_digest_sri":
+		x.EvidenceDigestSri = value.Interface().(string)
+	case "verana.perm.v1.MsgSlashPermissionTrustDeposit.gf_version":
+		x.GfVersion = int32(value.Int())
+	case "verana.perm.v1.MsgSlashPermissionTrustDeposit.distribution":
+		lv := value.List()
+		clv := lv.(*_MsgSlashPermissionTrustDeposit_8_list)
+		x.Distribution = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSlashPermissionTrustDeposit"))
@@ -10728,12 +11555,26 @@ func (x *fastReflection_MsgSlashPermissionTrustDeposit) Set(fd protoreflect.Fiel
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MsgSlashPermissionTrustDeposit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "verana.perm.v1.MsgSlashPermissionTrustDeposit.distribution":
+		if x.Distribution == nil {
+			x.Distribution = []*v1.SlashShare{}
+		}
+		value := &_MsgSlashPermissionTrustDeposit_8_list{list: &x.Distribution}
+		return protoreflect.ValueOfList(value)
 	case "verana.perm.v1.MsgSlashPermissionTrustDeposit.creator":
 		panic(fmt.Errorf("field creator of message verana.perm.v1.MsgSlashPermissionTrustDeposit is not mutable"))
 	case "verana.perm.v1.MsgSlashPermissionTrustDeposit.id":
 		panic(fmt.Errorf("field id of message verana.perm.v1.MsgSlashPermissionTrustDeposit is not mutable"))
 	case "verana.perm.v1.MsgSlashPermissionTrustDeposit.amount":
 		panic(fmt.Errorf("field amount of message verana.perm.v1.MsgSlashPermissionTrustDeposit is not mutable"))
+	case "verana.perm.v1.MsgSlashPermissionTrustDeposit.reason_code":
+		panic(fmt.Errorf("field reason_code of message verana.perm.v1.MsgSlashPermissionTrustDeposit is not mutable"))
+	case "verana.perm.v1.MsgSlashPermissionTrustDeposit.evidence_url":
+		panic(fmt.Errorf("field evidence_url of message verana.perm.v1.MsgSlashPermissionTrustDeposit is not mutable"))
+	case "verana.perm.v1.MsgSlashPermissionTrustDeposit.evidence_digest_sri":
+		panic(fmt.Errorf("field evidence_digest_sri of message verana.perm.v1.MsgSlashPermissionTrustDeposit is not mutable"))
+	case "verana.perm.v1.MsgSlashPermissionTrustDeposit.gf_version":
+		panic(fmt.Errorf("field gf_version of message verana.perm.v1.MsgSlashPermissionTrustDeposit is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSlashPermissionTrustDeposit"))
@@ -10753,6 +11594,17 @@ func (x *fastReflection_MsgSlashPermissionTrustDeposit) NewField(fd protoreflect
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "verana.perm.v1.MsgSlashPermissionTrustDeposit.amount":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.MsgSlashPermissionTrustDeposit.reason_code":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "verana.perm.v1.MsgSlashPermissionTrustDeposit.evidence_url":
+		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.MsgSlashPermissionTrustDeposit.evidence_digest_sri":
+		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.MsgSlashPermissionTrustDeposit.gf_version":
+		return protoreflect.ValueOfInt32(int32(0))
+	case "verana.perm.v1.MsgSlashPermissionTrustDeposit.distribution":
+		list := []*v1.SlashShare{}
+		return protoreflect.ValueOfList(&_MsgSlashPermissionTrustDeposit_8_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSlashPermissionTrustDeposit"))
@@ -10832,6 +11684,26 @@ func (x *fastReflection_MsgSlashPermissionTrustDeposit) ProtoMethods() *protoifa
 		if x.Amount != 0 {
 			n += 1 + runtime.Sov(uint64(x.Amount))
 		}
+		if x.ReasonCode != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReasonCode))
+		}
+		l = len(x.EvidenceUrl)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.EvidenceDigestSri)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.GfVersion != 0 {
+			n += 1 + runtime.Sov(uint64(x.GfVersion))
+		}
+		if len(x.Distribution) > 0 {
+			for _, e := range x.Distribution {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -10861,6 +11733,46 @@ func (x *fastReflection_MsgSlashPermissionTrustDeposit) ProtoMethods() *protoifa
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Distribution) > 0 {
+			for iNdEx := len(x.Distribution) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Distribution[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if x.GfVersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GfVersion))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.EvidenceDigestSri) > 0 {
+			i -= len(x.EvidenceDigestSri)
+			copy(dAtA[i:], x.EvidenceDigestSri)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EvidenceDigestSri)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.EvidenceUrl) > 0 {
+			i -= len(x.EvidenceUrl)
+			copy(dAtA[i:], x.EvidenceUrl)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EvidenceUrl)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.ReasonCode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReasonCode))
+			i--
+			dAtA[i] = 0x20
+		}
 		if x.Amount != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.Amount))
 			i--
@@ -10997,87 +11909,225 @@ func (x *fastReflection_MsgSlashPermissionTrustDeposit) ProtoMethods() *protoifa
 						break
 					}
 				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReasonCode", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				x.ReasonCode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReasonCode |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EvidenceUrl", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.EvidenceUrl = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EvidenceDigestSri", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_MsgSlashPermissionTrustDepositResponse protoreflect.MessageDescriptor
-)
-
-func init() {
-	file_verana_perm_v1_tx_proto_init()
-	md_MsgSlashPermissionTrustDepositResponse = File_verana_perm_v1_tx_proto.Messages().ByName("MsgSlashPermissionTrustDepositResponse")
-}
-
-var _ protoreflect.Message = (*fastReflection_MsgSlashPermissionTrustDepositResponse)(nil)
-
-type fastReflection_MsgSlashPermissionTrustDepositResponse MsgSlashPermissionTrustDepositResponse
-
-func (x *MsgSlashPermissionTrustDepositResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgSlashPermissionTrustDepositResponse)(x)
-}
-
-func (x *MsgSlashPermissionTrustDepositResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_verana_perm_v1_tx_proto_msgTypes[23]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_MsgSlashPermissionTrustDepositResponse_messageType fastReflection_MsgSlashPermissionTrustDepositResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgSlashPermissionTrustDepositResponse_messageType{}
-
-type fastReflection_MsgSlashPermissionTrustDepositResponse_messageType struct{}
-
-func (x fastReflection_MsgSlashPermissionTrustDepositResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgSlashPermissionTrustDepositResponse)(nil)
-}
-func (x fastReflection_MsgSlashPermissionTrustDepositResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgSlashPermissionTrustDepositResponse)
-}
-func (x fastReflection_MsgSlashPermissionTrustDepositResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSlashPermissionTrustDepositResponse
-}
-
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EvidenceDigestSri = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GfVersion", wireType)
+				}
+				x.GfVersion = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GfVersion |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Distribution = append(x.Distribution, &v1.SlashShare{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Distribution[len(x.Distribution)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSlashPermissionTrustDepositResponse          protoreflect.MessageDescriptor
+	fd_MsgSlashPermissionTrustDepositResponse_slash_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_tx_proto_init()
+	md_MsgSlashPermissionTrustDepositResponse = File_verana_perm_v1_tx_proto.Messages().ByName("MsgSlashPermissionTrustDepositResponse")
+	fd_MsgSlashPermissionTrustDepositResponse_slash_id = md_MsgSlashPermissionTrustDepositResponse.Fields().ByName("slash_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSlashPermissionTrustDepositResponse)(nil)
+
+type fastReflection_MsgSlashPermissionTrustDepositResponse MsgSlashPermissionTrustDepositResponse
+
+func (x *MsgSlashPermissionTrustDepositResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSlashPermissionTrustDepositResponse)(x)
+}
+
+func (x *MsgSlashPermissionTrustDepositResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_tx_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSlashPermissionTrustDepositResponse_messageType fastReflection_MsgSlashPermissionTrustDepositResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSlashPermissionTrustDepositResponse_messageType{}
+
+type fastReflection_MsgSlashPermissionTrustDepositResponse_messageType struct{}
+
+func (x fastReflection_MsgSlashPermissionTrustDepositResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSlashPermissionTrustDepositResponse)(nil)
+}
+func (x fastReflection_MsgSlashPermissionTrustDepositResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSlashPermissionTrustDepositResponse)
+}
+func (x fastReflection_MsgSlashPermissionTrustDepositResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSlashPermissionTrustDepositResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
 func (x *fastReflection_MsgSlashPermissionTrustDepositResponse) Descriptor() protoreflect.MessageDescriptor {
 	return md_MsgSlashPermissionTrustDepositResponse
 }
@@ -11106,6 +12156,12 @@ func (x *fastReflection_MsgSlashPermissionTrustDepositResponse) Interface() prot
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
 func (x *fastReflection_MsgSlashPermissionTrustDepositResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SlashId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SlashId)
+		if !f(fd_MsgSlashPermissionTrustDepositResponse_slash_id, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -11121,6 +12177,8 @@ func (x *fastReflection_MsgSlashPermissionTrustDepositResponse) Range(f func(pro
 // a repeated field is populated if it is non-empty.
 func (x *fastReflection_MsgSlashPermissionTrustDepositResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "verana.perm.v1.MsgSlashPermissionTrustDepositResponse.slash_id":
+		return x.SlashId != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSlashPermissionTrustDepositResponse"))
@@ -11137,6 +12195,8 @@ func (x *fastReflection_MsgSlashPermissionTrustDepositResponse) Has(fd protorefl
 // Clear is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MsgSlashPermissionTrustDepositResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "verana.perm.v1.MsgSlashPermissionTrustDepositResponse.slash_id":
+		x.SlashId = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSlashPermissionTrustDepositResponse"))
@@ -11153,6 +12213,9 @@ func (x *fastReflection_MsgSlashPermissionTrustDepositResponse) Clear(fd protore
 // of the value; to obtain a mutable reference, use Mutable.
 func (x *fastReflection_MsgSlashPermissionTrustDepositResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "verana.perm.v1.MsgSlashPermissionTrustDepositResponse.slash_id":
+		value := x.SlashId
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSlashPermissionTrustDepositResponse"))
@@ -11173,6 +12236,8 @@ func (x *fastReflection_MsgSlashPermissionTrustDepositResponse) Get(descriptor p
 // Set is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MsgSlashPermissionTrustDepositResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "verana.perm.v1.MsgSlashPermissionTrustDepositResponse.slash_id":
+		x.SlashId = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSlashPermissionTrustDepositResponse"))
@@ -11193,6 +12258,8 @@ func (x *fastReflection_MsgSlashPermissionTrustDepositResponse) Set(fd protorefl
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MsgSlashPermissionTrustDepositResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "verana.perm.v1.MsgSlashPermissionTrustDepositResponse.slash_id":
+		panic(fmt.Errorf("field slash_id of message verana.perm.v1.MsgSlashPermissionTrustDepositResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSlashPermissionTrustDepositResponse"))
@@ -11206,6 +12273,8 @@ func (x *fastReflection_MsgSlashPermissionTrustDepositResponse) Mutable(fd proto
 // For lists, maps, and messages, this returns a new, empty, mutable value.
 func (x *fastReflection_MsgSlashPermissionTrustDepositResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "verana.perm.v1.MsgSlashPermissionTrustDepositResponse.slash_id":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSlashPermissionTrustDepositResponse"))
@@ -11275,6 +12344,9 @@ func (x *fastReflection_MsgSlashPermissionTrustDepositResponse) ProtoMethods() *
 		var n int
 		var l int
 		_ = l
+		if x.SlashId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SlashId))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -11304,6 +12376,11 @@ func (x *fastReflection_MsgSlashPermissionTrustDepositResponse) ProtoMethods() *
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.SlashId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SlashId))
+			i--
+			dAtA[i] = 0x8
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -11353,6 +12430,25 @@ func (x *fastReflection_MsgSlashPermissionTrustDepositResponse) ProtoMethods() *
 				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSlashPermissionTrustDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashId", wireType)
+				}
+				x.SlashId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SlashId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -12212,13 +13308,59 @@ func (x *fastReflection_MsgRepayPermissionSlashedTrustDepositResponse) ProtoMeth
 	}
 }
 
+var _ protoreflect.List = (*_MsgCreatePermission_5_list)(nil)
+
+type _MsgCreatePermission_5_list struct {
+	list *[]string
+}
+
+func (x *_MsgCreatePermission_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgCreatePermission_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgCreatePermission_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgCreatePermission_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgCreatePermission_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgCreatePermission at list field Countries as it is not of Message kind"))
+}
+
+func (x *_MsgCreatePermission_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgCreatePermission_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgCreatePermission_5_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_MsgCreatePermission                   protoreflect.MessageDescriptor
 	fd_MsgCreatePermission_creator           protoreflect.FieldDescriptor
 	fd_MsgCreatePermission_schema_id         protoreflect.FieldDescriptor
 	fd_MsgCreatePermission_type              protoreflect.FieldDescriptor
 	fd_MsgCreatePermission_did               protoreflect.FieldDescriptor
-	fd_MsgCreatePermission_country           protoreflect.FieldDescriptor
+	fd_MsgCreatePermission_countries         protoreflect.FieldDescriptor
 	fd_MsgCreatePermission_effective_from    protoreflect.FieldDescriptor
 	fd_MsgCreatePermission_effective_until   protoreflect.FieldDescriptor
 	fd_MsgCreatePermission_verification_fees protoreflect.FieldDescriptor
@@ -12231,7 +13373,7 @@ func init() {
 	fd_MsgCreatePermission_schema_id = md_MsgCreatePermission.Fields().ByName("schema_id")
 	fd_MsgCreatePermission_type = md_MsgCreatePermission.Fields().ByName("type")
 	fd_MsgCreatePermission_did = md_MsgCreatePermission.Fields().ByName("did")
-	fd_MsgCreatePermission_country = md_MsgCreatePermission.Fields().ByName("country")
+	fd_MsgCreatePermission_countries = md_MsgCreatePermission.Fields().ByName("countries")
 	fd_MsgCreatePermission_effective_from = md_MsgCreatePermission.Fields().ByName("effective_from")
 	fd_MsgCreatePermission_effective_until = md_MsgCreatePermission.Fields().ByName("effective_until")
 	fd_MsgCreatePermission_verification_fees = md_MsgCreatePermission.Fields().ByName("verification_fees")
@@ -12326,9 +13468,9 @@ func (x *fastReflection_MsgCreatePermission) Range(f func(protoreflect.FieldDesc
 			return
 		}
 	}
-	if x.Country != "" {
-		value := protoreflect.ValueOfString(x.Country)
-		if !f(fd_MsgCreatePermission_country, value) {
+	if len(x.Countries) != 0 {
+		value := protoreflect.ValueOfList(&_MsgCreatePermission_5_list{list: &x.Countries})
+		if !f(fd_MsgCreatePermission_countries, value) {
 			return
 		}
 	}
@@ -12373,8 +13515,8 @@ func (x *fastReflection_MsgCreatePermission) Has(fd protoreflect.FieldDescriptor
 		return x.Type_ != 0
 	case "verana.perm.v1.MsgCreatePermission.did":
 		return x.Did != ""
-	case "verana.perm.v1.MsgCreatePermission.country":
-		return x.Country != ""
+	case "verana.perm.v1.MsgCreatePermission.countries":
+		return len(x.Countries) != 0
 	case "verana.perm.v1.MsgCreatePermission.effective_from":
 		return x.EffectiveFrom != nil
 	case "verana.perm.v1.MsgCreatePermission.effective_until":
@@ -12405,8 +13547,8 @@ func (x *fastReflection_MsgCreatePermission) Clear(fd protoreflect.FieldDescript
 		x.Type_ = 0
 	case "verana.perm.v1.MsgCreatePermission.did":
 		x.Did = ""
-	case "verana.perm.v1.MsgCreatePermission.country":
-		x.Country = ""
+	case "verana.perm.v1.MsgCreatePermission.countries":
+		x.Countries = nil
 	case "verana.perm.v1.MsgCreatePermission.effective_from":
 		x.EffectiveFrom = nil
 	case "verana.perm.v1.MsgCreatePermission.effective_until":
@@ -12441,9 +13583,12 @@ func (x *fastReflection_MsgCreatePermission) Get(descriptor protoreflect.FieldDe
 	case "verana.perm.v1.MsgCreatePermission.did":
 		value := x.Did
 		return protoreflect.ValueOfString(value)
-	case "verana.perm.v1.MsgCreatePermission.country":
-		value := x.Country
-		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.MsgCreatePermission.countries":
+		if len(x.Countries) == 0 {
+			return protoreflect.ValueOfList(&_MsgCreatePermission_5_list{})
+		}
+		listValue := &_MsgCreatePermission_5_list{list: &x.Countries}
+		return protoreflect.ValueOfList(listValue)
 	case "verana.perm.v1.MsgCreatePermission.effective_from":
 		value := x.EffectiveFrom
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
@@ -12481,8 +13626,10 @@ func (x *fastReflection_MsgCreatePermission) Set(fd protoreflect.FieldDescriptor
 		x.Type_ = (PermissionType)(value.Enum())
 	case "verana.perm.v1.MsgCreatePermission.did":
 		x.Did = value.Interface().(string)
-	case "verana.perm.v1.MsgCreatePermission.country":
-		x.Country = value.Interface().(string)
+	case "verana.perm.v1.MsgCreatePermission.countries":
+		lv := value.List()
+		clv := lv.(*_MsgCreatePermission_5_list)
+		x.Countries = *clv.list
 	case "verana.perm.v1.MsgCreatePermission.effective_from":
 		x.EffectiveFrom = value.Message().Interface().(*timestamppb.Timestamp)
 	case "verana.perm.v1.MsgCreatePermission.effective_until":
@@ -12509,6 +13656,12 @@ func (x *fastReflection_MsgCreatePermission) Set(fd protoreflect.FieldDescriptor
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MsgCreatePermission) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "verana.perm.v1.MsgCreatePermission.countries":
+		if x.Countries == nil {
+			x.Countries = []string{}
+		}
+		value := &_MsgCreatePermission_5_list{list: &x.Countries}
+		return protoreflect.ValueOfList(value)
 	case "verana.perm.v1.MsgCreatePermission.effective_from":
 		if x.EffectiveFrom == nil {
 			x.EffectiveFrom = new(timestamppb.Timestamp)
@@ -12527,8 +13680,6 @@ func (x *fastReflection_MsgCreatePermission) Mutable(fd protoreflect.FieldDescri
 		panic(fmt.Errorf("field type of message verana.perm.v1.MsgCreatePermission is not mutable"))
 	case "verana.perm.v1.MsgCreatePermission.did":
 		panic(fmt.Errorf("field did of message verana.perm.v1.MsgCreatePermission is not mutable"))
-	case "verana.perm.v1.MsgCreatePermission.country":
-		panic(fmt.Errorf("field country of message verana.perm.v1.MsgCreatePermission is not mutable"))
 	case "verana.perm.v1.MsgCreatePermission.verification_fees":
 		panic(fmt.Errorf("field verification_fees of message verana.perm.v1.MsgCreatePermission is not mutable"))
 	default:
@@ -12552,8 +13703,9 @@ func (x *fastReflection_MsgCreatePermission) NewField(fd protoreflect.FieldDescr
 		return protoreflect.ValueOfEnum(0)
 	case "verana.perm.v1.MsgCreatePermission.did":
 		return protoreflect.ValueOfString("")
-	case "verana.perm.v1.MsgCreatePermission.country":
-		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.MsgCreatePermission.countries":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgCreatePermission_5_list{list: &list})
 	case "verana.perm.v1.MsgCreatePermission.effective_from":
 		m := new(timestamppb.Timestamp)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
@@ -12645,9 +13797,11 @@ func (x *fastReflection_MsgCreatePermission) ProtoMethods() *protoiface.Methods
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Country)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Countries) > 0 {
+			for _, s := range x.Countries {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.EffectiveFrom != nil {
 			l = options.Size(x.EffectiveFrom)
@@ -12722,12 +13876,14 @@ func (x *fastReflection_MsgCreatePermission) ProtoMethods() *protoiface.Methods
 			i--
 			dAtA[i] = 0x32
 		}
-		if len(x.Country) > 0 {
-			i -= len(x.Country)
-			copy(dAtA[i:], x.Country)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Country)))
-			i--
-			dAtA[i] = 0x2a
+		if len(x.Countries) > 0 {
+			for iNdEx := len(x.Countries) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Countries[iNdEx])
+				copy(dAtA[i:], x.Countries[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Countries[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
 		}
 		if len(x.Did) > 0 {
 			i -= len(x.Did)
@@ -12906,7 +14062,7 @@ func (x *fastReflection_MsgCreatePermission) ProtoMethods() *protoiface.Methods
 				iNdEx = postIndex
 			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Country", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Countries", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -12934,7 +14090,7 @@ func (x *fastReflection_MsgCreatePermission) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Country = string(dAtA[iNdEx:postIndex])
+				x.Countries = append(x.Countries, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
 			case 6:
 				if wireType != 2 {
@@ -13466,65 +14622,14060 @@ func (x *fastReflection_MsgCreatePermissionResponse) ProtoMethods() *protoiface.
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: verana/perm/v1/tx.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// MsgUpdateParams is the Msg/UpdateParams request type.
-type MsgUpdateParams struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+var _ protoreflect.List = (*_MsgCreateSessionAllowance_6_list)(nil)
 
-	// authority is the address that controls the module (defaults to x/gov unless overwritten).
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// params defines the module parameters to update.
-	//
-	// NOTE: All parameters must be supplied.
-	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+type _MsgCreateSessionAllowance_6_list struct {
+	list *[]string
 }
 
-func (x *MsgUpdateParams) Reset() {
-	*x = MsgUpdateParams{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_perm_v1_tx_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *_MsgCreateSessionAllowance_6_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-func (x *MsgUpdateParams) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *_MsgCreateSessionAllowance_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
 }
 
-func (*MsgUpdateParams) ProtoMessage() {}
+func (x *_MsgCreateSessionAllowance_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
 
-// Deprecated: Use MsgUpdateParams.ProtoReflect.Descriptor instead.
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{0}
+func (x *_MsgCreateSessionAllowance_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *MsgUpdateParams) GetAuthority() string {
-	if x != nil {
-		return x.Authority
-	}
-	return ""
+func (x *_MsgCreateSessionAllowance_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgCreateSessionAllowance at list field AllowedCreators as it is not of Message kind"))
 }
 
-func (x *MsgUpdateParams) GetParams() *Params {
-	if x != nil {
-		return x.Params
-	}
-	return nil
+func (x *_MsgCreateSessionAllowance_6_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgCreateSessionAllowance_6_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgCreateSessionAllowance_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgCreateSessionAllowance                  protoreflect.MessageDescriptor
+	fd_MsgCreateSessionAllowance_creator          protoreflect.FieldDescriptor
+	fd_MsgCreateSessionAllowance_schema_id        protoreflect.FieldDescriptor
+	fd_MsgCreateSessionAllowance_agent_perm_id    protoreflect.FieldDescriptor
+	fd_MsgCreateSessionAllowance_verifier_perm_id protoreflect.FieldDescriptor
+	fd_MsgCreateSessionAllowance_amount           protoreflect.FieldDescriptor
+	fd_MsgCreateSessionAllowance_allowed_creators protoreflect.FieldDescriptor
+	fd_MsgCreateSessionAllowance_max_per_session  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_tx_proto_init()
+	md_MsgCreateSessionAllowance = File_verana_perm_v1_tx_proto.Messages().ByName("MsgCreateSessionAllowance")
+	fd_MsgCreateSessionAllowance_creator = md_MsgCreateSessionAllowance.Fields().ByName("creator")
+	fd_MsgCreateSessionAllowance_schema_id = md_MsgCreateSessionAllowance.Fields().ByName("schema_id")
+	fd_MsgCreateSessionAllowance_agent_perm_id = md_MsgCreateSessionAllowance.Fields().ByName("agent_perm_id")
+	fd_MsgCreateSessionAllowance_verifier_perm_id = md_MsgCreateSessionAllowance.Fields().ByName("verifier_perm_id")
+	fd_MsgCreateSessionAllowance_amount = md_MsgCreateSessionAllowance.Fields().ByName("amount")
+	fd_MsgCreateSessionAllowance_allowed_creators = md_MsgCreateSessionAllowance.Fields().ByName("allowed_creators")
+	fd_MsgCreateSessionAllowance_max_per_session = md_MsgCreateSessionAllowance.Fields().ByName("max_per_session")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCreateSessionAllowance)(nil)
+
+type fastReflection_MsgCreateSessionAllowance MsgCreateSessionAllowance
+
+func (x *MsgCreateSessionAllowance) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCreateSessionAllowance)(x)
+}
+
+func (x *MsgCreateSessionAllowance) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_tx_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCreateSessionAllowance_messageType fastReflection_MsgCreateSessionAllowance_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCreateSessionAllowance_messageType{}
+
+type fastReflection_MsgCreateSessionAllowance_messageType struct{}
+
+func (x fastReflection_MsgCreateSessionAllowance_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCreateSessionAllowance)(nil)
+}
+func (x fastReflection_MsgCreateSessionAllowance_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateSessionAllowance)
+}
+func (x fastReflection_MsgCreateSessionAllowance_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateSessionAllowance
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgCreateSessionAllowance) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateSessionAllowance
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgCreateSessionAllowance) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCreateSessionAllowance_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgCreateSessionAllowance) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateSessionAllowance)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgCreateSessionAllowance) Interface() protoreflect.ProtoMessage {
+	return (*MsgCreateSessionAllowance)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgCreateSessionAllowance) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgCreateSessionAllowance_creator, value) {
+			return
+		}
+	}
+	if x.SchemaId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SchemaId)
+		if !f(fd_MsgCreateSessionAllowance_schema_id, value) {
+			return
+		}
+	}
+	if x.AgentPermId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AgentPermId)
+		if !f(fd_MsgCreateSessionAllowance_agent_perm_id, value) {
+			return
+		}
+	}
+	if x.VerifierPermId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.VerifierPermId)
+		if !f(fd_MsgCreateSessionAllowance_verifier_perm_id, value) {
+			return
+		}
+	}
+	if x.Amount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Amount)
+		if !f(fd_MsgCreateSessionAllowance_amount, value) {
+			return
+		}
+	}
+	if len(x.AllowedCreators) != 0 {
+		value := protoreflect.ValueOfList(&_MsgCreateSessionAllowance_6_list{list: &x.AllowedCreators})
+		if !f(fd_MsgCreateSessionAllowance_allowed_creators, value) {
+			return
+		}
+	}
+	if x.MaxPerSession != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxPerSession)
+		if !f(fd_MsgCreateSessionAllowance_max_per_session, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgCreateSessionAllowance) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgCreateSessionAllowance.creator":
+		return x.Creator != ""
+	case "verana.perm.v1.MsgCreateSessionAllowance.schema_id":
+		return x.SchemaId != uint64(0)
+	case "verana.perm.v1.MsgCreateSessionAllowance.agent_perm_id":
+		return x.AgentPermId != uint64(0)
+	case "verana.perm.v1.MsgCreateSessionAllowance.verifier_perm_id":
+		return x.VerifierPermId != uint64(0)
+	case "verana.perm.v1.MsgCreateSessionAllowance.amount":
+		return x.Amount != uint64(0)
+	case "verana.perm.v1.MsgCreateSessionAllowance.allowed_creators":
+		return len(x.AllowedCreators) != 0
+	case "verana.perm.v1.MsgCreateSessionAllowance.max_per_session":
+		return x.MaxPerSession != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgCreateSessionAllowance"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgCreateSessionAllowance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateSessionAllowance) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgCreateSessionAllowance.creator":
+		x.Creator = ""
+	case "verana.perm.v1.MsgCreateSessionAllowance.schema_id":
+		x.SchemaId = uint64(0)
+	case "verana.perm.v1.MsgCreateSessionAllowance.agent_perm_id":
+		x.AgentPermId = uint64(0)
+	case "verana.perm.v1.MsgCreateSessionAllowance.verifier_perm_id":
+		x.VerifierPermId = uint64(0)
+	case "verana.perm.v1.MsgCreateSessionAllowance.amount":
+		x.Amount = uint64(0)
+	case "verana.perm.v1.MsgCreateSessionAllowance.allowed_creators":
+		x.AllowedCreators = nil
+	case "verana.perm.v1.MsgCreateSessionAllowance.max_per_session":
+		x.MaxPerSession = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgCreateSessionAllowance"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgCreateSessionAllowance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgCreateSessionAllowance) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.perm.v1.MsgCreateSessionAllowance.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.MsgCreateSessionAllowance.schema_id":
+		value := x.SchemaId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.MsgCreateSessionAllowance.agent_perm_id":
+		value := x.AgentPermId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.MsgCreateSessionAllowance.verifier_perm_id":
+		value := x.VerifierPermId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.MsgCreateSessionAllowance.amount":
+		value := x.Amount
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.MsgCreateSessionAllowance.allowed_creators":
+		if len(x.AllowedCreators) == 0 {
+			return protoreflect.ValueOfList(&_MsgCreateSessionAllowance_6_list{})
+		}
+		listValue := &_MsgCreateSessionAllowance_6_list{list: &x.AllowedCreators}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.perm.v1.MsgCreateSessionAllowance.max_per_session":
+		value := x.MaxPerSession
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgCreateSessionAllowance"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgCreateSessionAllowance does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateSessionAllowance) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgCreateSessionAllowance.creator":
+		x.Creator = value.Interface().(string)
+	case "verana.perm.v1.MsgCreateSessionAllowance.schema_id":
+		x.SchemaId = value.Uint()
+	case "verana.perm.v1.MsgCreateSessionAllowance.agent_perm_id":
+		x.AgentPermId = value.Uint()
+	case "verana.perm.v1.MsgCreateSessionAllowance.verifier_perm_id":
+		x.VerifierPermId = value.Uint()
+	case "verana.perm.v1.MsgCreateSessionAllowance.amount":
+		x.Amount = value.Uint()
+	case "verana.perm.v1.MsgCreateSessionAllowance.allowed_creators":
+		lv := value.List()
+		clv := lv.(*_MsgCreateSessionAllowance_6_list)
+		x.AllowedCreators = *clv.list
+	case "verana.perm.v1.MsgCreateSessionAllowance.max_per_session":
+		x.MaxPerSession = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgCreateSessionAllowance"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgCreateSessionAllowance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateSessionAllowance) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgCreateSessionAllowance.allowed_creators":
+		if x.AllowedCreators == nil {
+			x.AllowedCreators = []string{}
+		}
+		value := &_MsgCreateSessionAllowance_6_list{list: &x.AllowedCreators}
+		return protoreflect.ValueOfList(value)
+	case "verana.perm.v1.MsgCreateSessionAllowance.creator":
+		panic(fmt.Errorf("field creator of message verana.perm.v1.MsgCreateSessionAllowance is not mutable"))
+	case "verana.perm.v1.MsgCreateSessionAllowance.schema_id":
+		panic(fmt.Errorf("field schema_id of message verana.perm.v1.MsgCreateSessionAllowance is not mutable"))
+	case "verana.perm.v1.MsgCreateSessionAllowance.agent_perm_id":
+		panic(fmt.Errorf("field agent_perm_id of message verana.perm.v1.MsgCreateSessionAllowance is not mutable"))
+	case "verana.perm.v1.MsgCreateSessionAllowance.verifier_perm_id":
+		panic(fmt.Errorf("field verifier_perm_id of message verana.perm.v1.MsgCreateSessionAllowance is not mutable"))
+	case "verana.perm.v1.MsgCreateSessionAllowance.amount":
+		panic(fmt.Errorf("field amount of message verana.perm.v1.MsgCreateSessionAllowance is not mutable"))
+	case "verana.perm.v1.MsgCreateSessionAllowance.max_per_session":
+		panic(fmt.Errorf("field max_per_session of message verana.perm.v1.MsgCreateSessionAllowance is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgCreateSessionAllowance"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgCreateSessionAllowance does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgCreateSessionAllowance) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgCreateSessionAllowance.creator":
+		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.MsgCreateSessionAllowance.schema_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.MsgCreateSessionAllowance.agent_perm_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.MsgCreateSessionAllowance.verifier_perm_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.MsgCreateSessionAllowance.amount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.MsgCreateSessionAllowance.allowed_creators":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgCreateSessionAllowance_6_list{list: &list})
+	case "verana.perm.v1.MsgCreateSessionAllowance.max_per_session":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgCreateSessionAllowance"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgCreateSessionAllowance does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgCreateSessionAllowance) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.MsgCreateSessionAllowance", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgCreateSessionAllowance) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateSessionAllowance) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgCreateSessionAllowance) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgCreateSessionAllowance) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgCreateSessionAllowance)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SchemaId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SchemaId))
+		}
+		if x.AgentPermId != 0 {
+			n += 1 + runtime.Sov(uint64(x.AgentPermId))
+		}
+		if x.VerifierPermId != 0 {
+			n += 1 + runtime.Sov(uint64(x.VerifierPermId))
+		}
+		if x.Amount != 0 {
+			n += 1 + runtime.Sov(uint64(x.Amount))
+		}
+		if len(x.AllowedCreators) > 0 {
+			for _, s := range x.AllowedCreators {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxPerSession != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxPerSession))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCreateSessionAllowance)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MaxPerSession != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPerSession))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.AllowedCreators) > 0 {
+			for iNdEx := len(x.AllowedCreators) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedCreators[iNdEx])
+				copy(dAtA[i:], x.AllowedCreators[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedCreators[iNdEx])))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.Amount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Amount))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.VerifierPermId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VerifierPermId))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.AgentPermId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AgentPermId))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.SchemaId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SchemaId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCreateSessionAllowance)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateSessionAllowance: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateSessionAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SchemaId", wireType)
+				}
+				x.SchemaId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SchemaId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AgentPermId", wireType)
+				}
+				x.AgentPermId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AgentPermId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerifierPermId", wireType)
+				}
+				x.VerifierPermId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.VerifierPermId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				x.Amount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Amount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedCreators", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedCreators = append(x.AllowedCreators, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPerSession", wireType)
+				}
+				x.MaxPerSession = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPerSession |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgCreateSessionAllowanceResponse    protoreflect.MessageDescriptor
+	fd_MsgCreateSessionAllowanceResponse_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_tx_proto_init()
+	md_MsgCreateSessionAllowanceResponse = File_verana_perm_v1_tx_proto.Messages().ByName("MsgCreateSessionAllowanceResponse")
+	fd_MsgCreateSessionAllowanceResponse_id = md_MsgCreateSessionAllowanceResponse.Fields().ByName("id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCreateSessionAllowanceResponse)(nil)
+
+type fastReflection_MsgCreateSessionAllowanceResponse MsgCreateSessionAllowanceResponse
+
+func (x *MsgCreateSessionAllowanceResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCreateSessionAllowanceResponse)(x)
+}
+
+func (x *MsgCreateSessionAllowanceResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_tx_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCreateSessionAllowanceResponse_messageType fastReflection_MsgCreateSessionAllowanceResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCreateSessionAllowanceResponse_messageType{}
+
+type fastReflection_MsgCreateSessionAllowanceResponse_messageType struct{}
+
+func (x fastReflection_MsgCreateSessionAllowanceResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCreateSessionAllowanceResponse)(nil)
+}
+func (x fastReflection_MsgCreateSessionAllowanceResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateSessionAllowanceResponse)
+}
+func (x fastReflection_MsgCreateSessionAllowanceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateSessionAllowanceResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgCreateSessionAllowanceResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateSessionAllowanceResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgCreateSessionAllowanceResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCreateSessionAllowanceResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgCreateSessionAllowanceResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateSessionAllowanceResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgCreateSessionAllowanceResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgCreateSessionAllowanceResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgCreateSessionAllowanceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_MsgCreateSessionAllowanceResponse_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgCreateSessionAllowanceResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgCreateSessionAllowanceResponse.id":
+		return x.Id != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgCreateSessionAllowanceResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgCreateSessionAllowanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateSessionAllowanceResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgCreateSessionAllowanceResponse.id":
+		x.Id = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgCreateSessionAllowanceResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgCreateSessionAllowanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgCreateSessionAllowanceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.perm.v1.MsgCreateSessionAllowanceResponse.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgCreateSessionAllowanceResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgCreateSessionAllowanceResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateSessionAllowanceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgCreateSessionAllowanceResponse.id":
+		x.Id = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgCreateSessionAllowanceResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgCreateSessionAllowanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateSessionAllowanceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgCreateSessionAllowanceResponse.id":
+		panic(fmt.Errorf("field id of message verana.perm.v1.MsgCreateSessionAllowanceResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgCreateSessionAllowanceResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgCreateSessionAllowanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgCreateSessionAllowanceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgCreateSessionAllowanceResponse.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgCreateSessionAllowanceResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgCreateSessionAllowanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgCreateSessionAllowanceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.MsgCreateSessionAllowanceResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgCreateSessionAllowanceResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCreateSessionAllowanceResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgCreateSessionAllowanceResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgCreateSessionAllowanceResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgCreateSessionAllowanceResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCreateSessionAllowanceResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCreateSessionAllowanceResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateSessionAllowanceResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateSessionAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgFundSessionAllowance         protoreflect.MessageDescriptor
+	fd_MsgFundSessionAllowance_creator protoreflect.FieldDescriptor
+	fd_MsgFundSessionAllowance_id      protoreflect.FieldDescriptor
+	fd_MsgFundSessionAllowance_amount  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_tx_proto_init()
+	md_MsgFundSessionAllowance = File_verana_perm_v1_tx_proto.Messages().ByName("MsgFundSessionAllowance")
+	fd_MsgFundSessionAllowance_creator = md_MsgFundSessionAllowance.Fields().ByName("creator")
+	fd_MsgFundSessionAllowance_id = md_MsgFundSessionAllowance.Fields().ByName("id")
+	fd_MsgFundSessionAllowance_amount = md_MsgFundSessionAllowance.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgFundSessionAllowance)(nil)
+
+type fastReflection_MsgFundSessionAllowance MsgFundSessionAllowance
+
+func (x *MsgFundSessionAllowance) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgFundSessionAllowance)(x)
+}
+
+func (x *MsgFundSessionAllowance) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_tx_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgFundSessionAllowance_messageType fastReflection_MsgFundSessionAllowance_messageType
+var _ protoreflect.MessageType = fastReflection_MsgFundSessionAllowance_messageType{}
+
+type fastReflection_MsgFundSessionAllowance_messageType struct{}
+
+func (x fastReflection_MsgFundSessionAllowance_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgFundSessionAllowance)(nil)
+}
+func (x fastReflection_MsgFundSessionAllowance_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgFundSessionAllowance)
+}
+func (x fastReflection_MsgFundSessionAllowance_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgFundSessionAllowance
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgFundSessionAllowance) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgFundSessionAllowance
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgFundSessionAllowance) Type() protoreflect.MessageType {
+	return _fastReflection_MsgFundSessionAllowance_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgFundSessionAllowance) New() protoreflect.Message {
+	return new(fastReflection_MsgFundSessionAllowance)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgFundSessionAllowance) Interface() protoreflect.ProtoMessage {
+	return (*MsgFundSessionAllowance)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgFundSessionAllowance) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgFundSessionAllowance_creator, value) {
+			return
+		}
+	}
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_MsgFundSessionAllowance_id, value) {
+			return
+		}
+	}
+	if x.Amount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Amount)
+		if !f(fd_MsgFundSessionAllowance_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgFundSessionAllowance) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgFundSessionAllowance.creator":
+		return x.Creator != ""
+	case "verana.perm.v1.MsgFundSessionAllowance.id":
+		return x.Id != uint64(0)
+	case "verana.perm.v1.MsgFundSessionAllowance.amount":
+		return x.Amount != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgFundSessionAllowance"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgFundSessionAllowance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFundSessionAllowance) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgFundSessionAllowance.creator":
+		x.Creator = ""
+	case "verana.perm.v1.MsgFundSessionAllowance.id":
+		x.Id = uint64(0)
+	case "verana.perm.v1.MsgFundSessionAllowance.amount":
+		x.Amount = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgFundSessionAllowance"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgFundSessionAllowance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgFundSessionAllowance) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.perm.v1.MsgFundSessionAllowance.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.MsgFundSessionAllowance.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.MsgFundSessionAllowance.amount":
+		value := x.Amount
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgFundSessionAllowance"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgFundSessionAllowance does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFundSessionAllowance) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgFundSessionAllowance.creator":
+		x.Creator = value.Interface().(string)
+	case "verana.perm.v1.MsgFundSessionAllowance.id":
+		x.Id = value.Uint()
+	case "verana.perm.v1.MsgFundSessionAllowance.amount":
+		x.Amount = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgFundSessionAllowance"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgFundSessionAllowance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFundSessionAllowance) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgFundSessionAllowance.creator":
+		panic(fmt.Errorf("field creator of message verana.perm.v1.MsgFundSessionAllowance is not mutable"))
+	case "verana.perm.v1.MsgFundSessionAllowance.id":
+		panic(fmt.Errorf("field id of message verana.perm.v1.MsgFundSessionAllowance is not mutable"))
+	case "verana.perm.v1.MsgFundSessionAllowance.amount":
+		panic(fmt.Errorf("field amount of message verana.perm.v1.MsgFundSessionAllowance is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgFundSessionAllowance"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgFundSessionAllowance does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgFundSessionAllowance) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgFundSessionAllowance.creator":
+		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.MsgFundSessionAllowance.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.MsgFundSessionAllowance.amount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgFundSessionAllowance"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgFundSessionAllowance does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgFundSessionAllowance) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.MsgFundSessionAllowance", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgFundSessionAllowance) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFundSessionAllowance) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgFundSessionAllowance) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgFundSessionAllowance) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgFundSessionAllowance)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.Amount != 0 {
+			n += 1 + runtime.Sov(uint64(x.Amount))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgFundSessionAllowance)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Amount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Amount))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgFundSessionAllowance)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgFundSessionAllowance: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgFundSessionAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				x.Amount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Amount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgFundSessionAllowanceResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_tx_proto_init()
+	md_MsgFundSessionAllowanceResponse = File_verana_perm_v1_tx_proto.Messages().ByName("MsgFundSessionAllowanceResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgFundSessionAllowanceResponse)(nil)
+
+type fastReflection_MsgFundSessionAllowanceResponse MsgFundSessionAllowanceResponse
+
+func (x *MsgFundSessionAllowanceResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgFundSessionAllowanceResponse)(x)
+}
+
+func (x *MsgFundSessionAllowanceResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_tx_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgFundSessionAllowanceResponse_messageType fastReflection_MsgFundSessionAllowanceResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgFundSessionAllowanceResponse_messageType{}
+
+type fastReflection_MsgFundSessionAllowanceResponse_messageType struct{}
+
+func (x fastReflection_MsgFundSessionAllowanceResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgFundSessionAllowanceResponse)(nil)
+}
+func (x fastReflection_MsgFundSessionAllowanceResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgFundSessionAllowanceResponse)
+}
+func (x fastReflection_MsgFundSessionAllowanceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgFundSessionAllowanceResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgFundSessionAllowanceResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgFundSessionAllowanceResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgFundSessionAllowanceResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgFundSessionAllowanceResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgFundSessionAllowanceResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgFundSessionAllowanceResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgFundSessionAllowanceResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgFundSessionAllowanceResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgFundSessionAllowanceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgFundSessionAllowanceResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgFundSessionAllowanceResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgFundSessionAllowanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFundSessionAllowanceResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgFundSessionAllowanceResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgFundSessionAllowanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgFundSessionAllowanceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgFundSessionAllowanceResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgFundSessionAllowanceResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFundSessionAllowanceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgFundSessionAllowanceResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgFundSessionAllowanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFundSessionAllowanceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgFundSessionAllowanceResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgFundSessionAllowanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgFundSessionAllowanceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgFundSessionAllowanceResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgFundSessionAllowanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgFundSessionAllowanceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.MsgFundSessionAllowanceResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgFundSessionAllowanceResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFundSessionAllowanceResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgFundSessionAllowanceResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgFundSessionAllowanceResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgFundSessionAllowanceResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgFundSessionAllowanceResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgFundSessionAllowanceResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgFundSessionAllowanceResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgFundSessionAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgWithdrawSessionAllowance         protoreflect.MessageDescriptor
+	fd_MsgWithdrawSessionAllowance_creator protoreflect.FieldDescriptor
+	fd_MsgWithdrawSessionAllowance_id      protoreflect.FieldDescriptor
+	fd_MsgWithdrawSessionAllowance_amount  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_tx_proto_init()
+	md_MsgWithdrawSessionAllowance = File_verana_perm_v1_tx_proto.Messages().ByName("MsgWithdrawSessionAllowance")
+	fd_MsgWithdrawSessionAllowance_creator = md_MsgWithdrawSessionAllowance.Fields().ByName("creator")
+	fd_MsgWithdrawSessionAllowance_id = md_MsgWithdrawSessionAllowance.Fields().ByName("id")
+	fd_MsgWithdrawSessionAllowance_amount = md_MsgWithdrawSessionAllowance.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgWithdrawSessionAllowance)(nil)
+
+type fastReflection_MsgWithdrawSessionAllowance MsgWithdrawSessionAllowance
+
+func (x *MsgWithdrawSessionAllowance) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgWithdrawSessionAllowance)(x)
+}
+
+func (x *MsgWithdrawSessionAllowance) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_tx_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgWithdrawSessionAllowance_messageType fastReflection_MsgWithdrawSessionAllowance_messageType
+var _ protoreflect.MessageType = fastReflection_MsgWithdrawSessionAllowance_messageType{}
+
+type fastReflection_MsgWithdrawSessionAllowance_messageType struct{}
+
+func (x fastReflection_MsgWithdrawSessionAllowance_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgWithdrawSessionAllowance)(nil)
+}
+func (x fastReflection_MsgWithdrawSessionAllowance_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgWithdrawSessionAllowance)
+}
+func (x fastReflection_MsgWithdrawSessionAllowance_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgWithdrawSessionAllowance
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgWithdrawSessionAllowance) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgWithdrawSessionAllowance
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgWithdrawSessionAllowance) Type() protoreflect.MessageType {
+	return _fastReflection_MsgWithdrawSessionAllowance_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgWithdrawSessionAllowance) New() protoreflect.Message {
+	return new(fastReflection_MsgWithdrawSessionAllowance)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgWithdrawSessionAllowance) Interface() protoreflect.ProtoMessage {
+	return (*MsgWithdrawSessionAllowance)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgWithdrawSessionAllowance) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgWithdrawSessionAllowance_creator, value) {
+			return
+		}
+	}
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_MsgWithdrawSessionAllowance_id, value) {
+			return
+		}
+	}
+	if x.Amount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Amount)
+		if !f(fd_MsgWithdrawSessionAllowance_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgWithdrawSessionAllowance) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgWithdrawSessionAllowance.creator":
+		return x.Creator != ""
+	case "verana.perm.v1.MsgWithdrawSessionAllowance.id":
+		return x.Id != uint64(0)
+	case "verana.perm.v1.MsgWithdrawSessionAllowance.amount":
+		return x.Amount != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgWithdrawSessionAllowance"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgWithdrawSessionAllowance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgWithdrawSessionAllowance) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgWithdrawSessionAllowance.creator":
+		x.Creator = ""
+	case "verana.perm.v1.MsgWithdrawSessionAllowance.id":
+		x.Id = uint64(0)
+	case "verana.perm.v1.MsgWithdrawSessionAllowance.amount":
+		x.Amount = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgWithdrawSessionAllowance"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgWithdrawSessionAllowance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgWithdrawSessionAllowance) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.perm.v1.MsgWithdrawSessionAllowance.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.MsgWithdrawSessionAllowance.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.MsgWithdrawSessionAllowance.amount":
+		value := x.Amount
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgWithdrawSessionAllowance"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgWithdrawSessionAllowance does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgWithdrawSessionAllowance) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgWithdrawSessionAllowance.creator":
+		x.Creator = value.Interface().(string)
+	case "verana.perm.v1.MsgWithdrawSessionAllowance.id":
+		x.Id = value.Uint()
+	case "verana.perm.v1.MsgWithdrawSessionAllowance.amount":
+		x.Amount = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgWithdrawSessionAllowance"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgWithdrawSessionAllowance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgWithdrawSessionAllowance) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgWithdrawSessionAllowance.creator":
+		panic(fmt.Errorf("field creator of message verana.perm.v1.MsgWithdrawSessionAllowance is not mutable"))
+	case "verana.perm.v1.MsgWithdrawSessionAllowance.id":
+		panic(fmt.Errorf("field id of message verana.perm.v1.MsgWithdrawSessionAllowance is not mutable"))
+	case "verana.perm.v1.MsgWithdrawSessionAllowance.amount":
+		panic(fmt.Errorf("field amount of message verana.perm.v1.MsgWithdrawSessionAllowance is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgWithdrawSessionAllowance"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgWithdrawSessionAllowance does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgWithdrawSessionAllowance) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgWithdrawSessionAllowance.creator":
+		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.MsgWithdrawSessionAllowance.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.MsgWithdrawSessionAllowance.amount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgWithdrawSessionAllowance"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgWithdrawSessionAllowance does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgWithdrawSessionAllowance) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.MsgWithdrawSessionAllowance", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgWithdrawSessionAllowance) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgWithdrawSessionAllowance) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgWithdrawSessionAllowance) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgWithdrawSessionAllowance) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgWithdrawSessionAllowance)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.Amount != 0 {
+			n += 1 + runtime.Sov(uint64(x.Amount))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgWithdrawSessionAllowance)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Amount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Amount))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgWithdrawSessionAllowance)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgWithdrawSessionAllowance: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgWithdrawSessionAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				x.Amount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Amount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgWithdrawSessionAllowanceResponse        protoreflect.MessageDescriptor
+	fd_MsgWithdrawSessionAllowanceResponse_amount protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_tx_proto_init()
+	md_MsgWithdrawSessionAllowanceResponse = File_verana_perm_v1_tx_proto.Messages().ByName("MsgWithdrawSessionAllowanceResponse")
+	fd_MsgWithdrawSessionAllowanceResponse_amount = md_MsgWithdrawSessionAllowanceResponse.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgWithdrawSessionAllowanceResponse)(nil)
+
+type fastReflection_MsgWithdrawSessionAllowanceResponse MsgWithdrawSessionAllowanceResponse
+
+func (x *MsgWithdrawSessionAllowanceResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgWithdrawSessionAllowanceResponse)(x)
+}
+
+func (x *MsgWithdrawSessionAllowanceResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_tx_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgWithdrawSessionAllowanceResponse_messageType fastReflection_MsgWithdrawSessionAllowanceResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgWithdrawSessionAllowanceResponse_messageType{}
+
+type fastReflection_MsgWithdrawSessionAllowanceResponse_messageType struct{}
+
+func (x fastReflection_MsgWithdrawSessionAllowanceResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgWithdrawSessionAllowanceResponse)(nil)
+}
+func (x fastReflection_MsgWithdrawSessionAllowanceResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgWithdrawSessionAllowanceResponse)
+}
+func (x fastReflection_MsgWithdrawSessionAllowanceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgWithdrawSessionAllowanceResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgWithdrawSessionAllowanceResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgWithdrawSessionAllowanceResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgWithdrawSessionAllowanceResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgWithdrawSessionAllowanceResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgWithdrawSessionAllowanceResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgWithdrawSessionAllowanceResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgWithdrawSessionAllowanceResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgWithdrawSessionAllowanceResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgWithdrawSessionAllowanceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Amount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Amount)
+		if !f(fd_MsgWithdrawSessionAllowanceResponse_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgWithdrawSessionAllowanceResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgWithdrawSessionAllowanceResponse.amount":
+		return x.Amount != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgWithdrawSessionAllowanceResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgWithdrawSessionAllowanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgWithdrawSessionAllowanceResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgWithdrawSessionAllowanceResponse.amount":
+		x.Amount = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgWithdrawSessionAllowanceResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgWithdrawSessionAllowanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgWithdrawSessionAllowanceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.perm.v1.MsgWithdrawSessionAllowanceResponse.amount":
+		value := x.Amount
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgWithdrawSessionAllowanceResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgWithdrawSessionAllowanceResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgWithdrawSessionAllowanceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgWithdrawSessionAllowanceResponse.amount":
+		x.Amount = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgWithdrawSessionAllowanceResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgWithdrawSessionAllowanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgWithdrawSessionAllowanceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgWithdrawSessionAllowanceResponse.amount":
+		panic(fmt.Errorf("field amount of message verana.perm.v1.MsgWithdrawSessionAllowanceResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgWithdrawSessionAllowanceResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgWithdrawSessionAllowanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgWithdrawSessionAllowanceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgWithdrawSessionAllowanceResponse.amount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgWithdrawSessionAllowanceResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgWithdrawSessionAllowanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgWithdrawSessionAllowanceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.MsgWithdrawSessionAllowanceResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgWithdrawSessionAllowanceResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgWithdrawSessionAllowanceResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgWithdrawSessionAllowanceResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgWithdrawSessionAllowanceResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgWithdrawSessionAllowanceResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Amount != 0 {
+			n += 1 + runtime.Sov(uint64(x.Amount))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgWithdrawSessionAllowanceResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Amount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Amount))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgWithdrawSessionAllowanceResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgWithdrawSessionAllowanceResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgWithdrawSessionAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				x.Amount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Amount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgAppealSlash                     protoreflect.MessageDescriptor
+	fd_MsgAppealSlash_creator             protoreflect.FieldDescriptor
+	fd_MsgAppealSlash_id                  protoreflect.FieldDescriptor
+	fd_MsgAppealSlash_evidence_digest_sri protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_tx_proto_init()
+	md_MsgAppealSlash = File_verana_perm_v1_tx_proto.Messages().ByName("MsgAppealSlash")
+	fd_MsgAppealSlash_creator = md_MsgAppealSlash.Fields().ByName("creator")
+	fd_MsgAppealSlash_id = md_MsgAppealSlash.Fields().ByName("id")
+	fd_MsgAppealSlash_evidence_digest_sri = md_MsgAppealSlash.Fields().ByName("evidence_digest_sri")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgAppealSlash)(nil)
+
+type fastReflection_MsgAppealSlash MsgAppealSlash
+
+func (x *MsgAppealSlash) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAppealSlash)(x)
+}
+
+func (x *MsgAppealSlash) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_tx_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgAppealSlash_messageType fastReflection_MsgAppealSlash_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAppealSlash_messageType{}
+
+type fastReflection_MsgAppealSlash_messageType struct{}
+
+func (x fastReflection_MsgAppealSlash_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAppealSlash)(nil)
+}
+func (x fastReflection_MsgAppealSlash_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAppealSlash)
+}
+func (x fastReflection_MsgAppealSlash_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAppealSlash
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgAppealSlash) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAppealSlash
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgAppealSlash) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAppealSlash_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgAppealSlash) New() protoreflect.Message {
+	return new(fastReflection_MsgAppealSlash)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgAppealSlash) Interface() protoreflect.ProtoMessage {
+	return (*MsgAppealSlash)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgAppealSlash) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgAppealSlash_creator, value) {
+			return
+		}
+	}
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_MsgAppealSlash_id, value) {
+			return
+		}
+	}
+	if x.EvidenceDigestSri != "" {
+		value := protoreflect.ValueOfString(x.EvidenceDigestSri)
+		if !f(fd_MsgAppealSlash_evidence_digest_sri, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgAppealSlash) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgAppealSlash.creator":
+		return x.Creator != ""
+	case "verana.perm.v1.MsgAppealSlash.id":
+		return x.Id != uint64(0)
+	case "verana.perm.v1.MsgAppealSlash.evidence_digest_sri":
+		return x.EvidenceDigestSri != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgAppealSlash"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgAppealSlash does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAppealSlash) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgAppealSlash.creator":
+		x.Creator = ""
+	case "verana.perm.v1.MsgAppealSlash.id":
+		x.Id = uint64(0)
+	case "verana.perm.v1.MsgAppealSlash.evidence_digest_sri":
+		x.EvidenceDigestSri = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgAppealSlash"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgAppealSlash does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgAppealSlash) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.perm.v1.MsgAppealSlash.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.MsgAppealSlash.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.MsgAppealSlash.evidence_digest_sri":
+		value := x.EvidenceDigestSri
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgAppealSlash"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgAppealSlash does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAppealSlash) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgAppealSlash.creator":
+		x.Creator = value.Interface().(string)
+	case "verana.perm.v1.MsgAppealSlash.id":
+		x.Id = value.Uint()
+	case "verana.perm.v1.MsgAppealSlash.evidence_digest_sri":
+		x.EvidenceDigestSri = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgAppealSlash"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgAppealSlash does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAppealSlash) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgAppealSlash.creator":
+		panic(fmt.Errorf("field creator of message verana.perm.v1.MsgAppealSlash is not mutable"))
+	case "verana.perm.v1.MsgAppealSlash.id":
+		panic(fmt.Errorf("field id of message verana.perm.v1.MsgAppealSlash is not mutable"))
+	case "verana.perm.v1.MsgAppealSlash.evidence_digest_sri":
+		panic(fmt.Errorf("field evidence_digest_sri of message verana.perm.v1.MsgAppealSlash is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgAppealSlash"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgAppealSlash does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgAppealSlash) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgAppealSlash.creator":
+		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.MsgAppealSlash.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.MsgAppealSlash.evidence_digest_sri":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgAppealSlash"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgAppealSlash does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgAppealSlash) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.MsgAppealSlash", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgAppealSlash) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAppealSlash) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgAppealSlash) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgAppealSlash) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgAppealSlash)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.EvidenceDigestSri)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAppealSlash)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.EvidenceDigestSri) > 0 {
+			i -= len(x.EvidenceDigestSri)
+			copy(dAtA[i:], x.EvidenceDigestSri)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EvidenceDigestSri)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAppealSlash)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAppealSlash: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAppealSlash: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EvidenceDigestSri", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EvidenceDigestSri = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgAppealSlashResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_tx_proto_init()
+	md_MsgAppealSlashResponse = File_verana_perm_v1_tx_proto.Messages().ByName("MsgAppealSlashResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgAppealSlashResponse)(nil)
+
+type fastReflection_MsgAppealSlashResponse MsgAppealSlashResponse
+
+func (x *MsgAppealSlashResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAppealSlashResponse)(x)
+}
+
+func (x *MsgAppealSlashResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_tx_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgAppealSlashResponse_messageType fastReflection_MsgAppealSlashResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAppealSlashResponse_messageType{}
+
+type fastReflection_MsgAppealSlashResponse_messageType struct{}
+
+func (x fastReflection_MsgAppealSlashResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAppealSlashResponse)(nil)
+}
+func (x fastReflection_MsgAppealSlashResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAppealSlashResponse)
+}
+func (x fastReflection_MsgAppealSlashResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAppealSlashResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgAppealSlashResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAppealSlashResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgAppealSlashResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAppealSlashResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgAppealSlashResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgAppealSlashResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgAppealSlashResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgAppealSlashResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgAppealSlashResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgAppealSlashResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgAppealSlashResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgAppealSlashResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAppealSlashResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgAppealSlashResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgAppealSlashResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgAppealSlashResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgAppealSlashResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgAppealSlashResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAppealSlashResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgAppealSlashResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgAppealSlashResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAppealSlashResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgAppealSlashResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgAppealSlashResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgAppealSlashResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgAppealSlashResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgAppealSlashResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgAppealSlashResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.MsgAppealSlashResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgAppealSlashResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAppealSlashResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgAppealSlashResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgAppealSlashResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgAppealSlashResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAppealSlashResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAppealSlashResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAppealSlashResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAppealSlashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgResolveSlashAppeal         protoreflect.MessageDescriptor
+	fd_MsgResolveSlashAppeal_creator protoreflect.FieldDescriptor
+	fd_MsgResolveSlashAppeal_id      protoreflect.FieldDescriptor
+	fd_MsgResolveSlashAppeal_uphold  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_tx_proto_init()
+	md_MsgResolveSlashAppeal = File_verana_perm_v1_tx_proto.Messages().ByName("MsgResolveSlashAppeal")
+	fd_MsgResolveSlashAppeal_creator = md_MsgResolveSlashAppeal.Fields().ByName("creator")
+	fd_MsgResolveSlashAppeal_id = md_MsgResolveSlashAppeal.Fields().ByName("id")
+	fd_MsgResolveSlashAppeal_uphold = md_MsgResolveSlashAppeal.Fields().ByName("uphold")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgResolveSlashAppeal)(nil)
+
+type fastReflection_MsgResolveSlashAppeal MsgResolveSlashAppeal
+
+func (x *MsgResolveSlashAppeal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgResolveSlashAppeal)(x)
+}
+
+func (x *MsgResolveSlashAppeal) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_tx_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgResolveSlashAppeal_messageType fastReflection_MsgResolveSlashAppeal_messageType
+var _ protoreflect.MessageType = fastReflection_MsgResolveSlashAppeal_messageType{}
+
+type fastReflection_MsgResolveSlashAppeal_messageType struct{}
+
+func (x fastReflection_MsgResolveSlashAppeal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgResolveSlashAppeal)(nil)
+}
+func (x fastReflection_MsgResolveSlashAppeal_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgResolveSlashAppeal)
+}
+func (x fastReflection_MsgResolveSlashAppeal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgResolveSlashAppeal
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgResolveSlashAppeal) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgResolveSlashAppeal
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgResolveSlashAppeal) Type() protoreflect.MessageType {
+	return _fastReflection_MsgResolveSlashAppeal_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgResolveSlashAppeal) New() protoreflect.Message {
+	return new(fastReflection_MsgResolveSlashAppeal)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgResolveSlashAppeal) Interface() protoreflect.ProtoMessage {
+	return (*MsgResolveSlashAppeal)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgResolveSlashAppeal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgResolveSlashAppeal_creator, value) {
+			return
+		}
+	}
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_MsgResolveSlashAppeal_id, value) {
+			return
+		}
+	}
+	if x.Uphold != false {
+		value := protoreflect.ValueOfBool(x.Uphold)
+		if !f(fd_MsgResolveSlashAppeal_uphold, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgResolveSlashAppeal) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgResolveSlashAppeal.creator":
+		return x.Creator != ""
+	case "verana.perm.v1.MsgResolveSlashAppeal.id":
+		return x.Id != uint64(0)
+	case "verana.perm.v1.MsgResolveSlashAppeal.uphold":
+		return x.Uphold != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgResolveSlashAppeal"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgResolveSlashAppeal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgResolveSlashAppeal) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgResolveSlashAppeal.creator":
+		x.Creator = ""
+	case "verana.perm.v1.MsgResolveSlashAppeal.id":
+		x.Id = uint64(0)
+	case "verana.perm.v1.MsgResolveSlashAppeal.uphold":
+		x.Uphold = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgResolveSlashAppeal"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgResolveSlashAppeal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgResolveSlashAppeal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.perm.v1.MsgResolveSlashAppeal.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.MsgResolveSlashAppeal.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.MsgResolveSlashAppeal.uphold":
+		value := x.Uphold
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgResolveSlashAppeal"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgResolveSlashAppeal does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgResolveSlashAppeal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgResolveSlashAppeal.creator":
+		x.Creator = value.Interface().(string)
+	case "verana.perm.v1.MsgResolveSlashAppeal.id":
+		x.Id = value.Uint()
+	case "verana.perm.v1.MsgResolveSlashAppeal.uphold":
+		x.Uphold = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgResolveSlashAppeal"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgResolveSlashAppeal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgResolveSlashAppeal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgResolveSlashAppeal.creator":
+		panic(fmt.Errorf("field creator of message verana.perm.v1.MsgResolveSlashAppeal is not mutable"))
+	case "verana.perm.v1.MsgResolveSlashAppeal.id":
+		panic(fmt.Errorf("field id of message verana.perm.v1.MsgResolveSlashAppeal is not mutable"))
+	case "verana.perm.v1.MsgResolveSlashAppeal.uphold":
+		panic(fmt.Errorf("field uphold of message verana.perm.v1.MsgResolveSlashAppeal is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgResolveSlashAppeal"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgResolveSlashAppeal does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgResolveSlashAppeal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgResolveSlashAppeal.creator":
+		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.MsgResolveSlashAppeal.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.MsgResolveSlashAppeal.uphold":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgResolveSlashAppeal"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgResolveSlashAppeal does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgResolveSlashAppeal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.MsgResolveSlashAppeal", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgResolveSlashAppeal) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgResolveSlashAppeal) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgResolveSlashAppeal) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgResolveSlashAppeal) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgResolveSlashAppeal)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.Uphold {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgResolveSlashAppeal)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Uphold {
+			i--
+			if x.Uphold {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgResolveSlashAppeal)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgResolveSlashAppeal: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgResolveSlashAppeal: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Uphold", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Uphold = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgResolveSlashAppealResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_tx_proto_init()
+	md_MsgResolveSlashAppealResponse = File_verana_perm_v1_tx_proto.Messages().ByName("MsgResolveSlashAppealResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgResolveSlashAppealResponse)(nil)
+
+type fastReflection_MsgResolveSlashAppealResponse MsgResolveSlashAppealResponse
+
+func (x *MsgResolveSlashAppealResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgResolveSlashAppealResponse)(x)
+}
+
+func (x *MsgResolveSlashAppealResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_tx_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgResolveSlashAppealResponse_messageType fastReflection_MsgResolveSlashAppealResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgResolveSlashAppealResponse_messageType{}
+
+type fastReflection_MsgResolveSlashAppealResponse_messageType struct{}
+
+func (x fastReflection_MsgResolveSlashAppealResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgResolveSlashAppealResponse)(nil)
+}
+func (x fastReflection_MsgResolveSlashAppealResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgResolveSlashAppealResponse)
+}
+func (x fastReflection_MsgResolveSlashAppealResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgResolveSlashAppealResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgResolveSlashAppealResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgResolveSlashAppealResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgResolveSlashAppealResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgResolveSlashAppealResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgResolveSlashAppealResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgResolveSlashAppealResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgResolveSlashAppealResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgResolveSlashAppealResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgResolveSlashAppealResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgResolveSlashAppealResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgResolveSlashAppealResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgResolveSlashAppealResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgResolveSlashAppealResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgResolveSlashAppealResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgResolveSlashAppealResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgResolveSlashAppealResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgResolveSlashAppealResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgResolveSlashAppealResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgResolveSlashAppealResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgResolveSlashAppealResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgResolveSlashAppealResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgResolveSlashAppealResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgResolveSlashAppealResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgResolveSlashAppealResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgResolveSlashAppealResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgResolveSlashAppealResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgResolveSlashAppealResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgResolveSlashAppealResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.MsgResolveSlashAppealResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgResolveSlashAppealResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgResolveSlashAppealResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgResolveSlashAppealResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgResolveSlashAppealResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgResolveSlashAppealResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgResolveSlashAppealResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgResolveSlashAppealResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgResolveSlashAppealResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgResolveSlashAppealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgSetSlashDistribution_3_list)(nil)
+
+type _MsgSetSlashDistribution_3_list struct {
+	list *[]*v1.SlashShare
+}
+
+func (x *_MsgSetSlashDistribution_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgSetSlashDistribution_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgSetSlashDistribution_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1.SlashShare)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgSetSlashDistribution_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1.SlashShare)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgSetSlashDistribution_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1.SlashShare)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgSetSlashDistribution_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgSetSlashDistribution_3_list) NewElement() protoreflect.Value {
+	v := new(v1.SlashShare)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgSetSlashDistribution_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgSetSlashDistribution              protoreflect.MessageDescriptor
+	fd_MsgSetSlashDistribution_creator      protoreflect.FieldDescriptor
+	fd_MsgSetSlashDistribution_tr_id        protoreflect.FieldDescriptor
+	fd_MsgSetSlashDistribution_distribution protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_tx_proto_init()
+	md_MsgSetSlashDistribution = File_verana_perm_v1_tx_proto.Messages().ByName("MsgSetSlashDistribution")
+	fd_MsgSetSlashDistribution_creator = md_MsgSetSlashDistribution.Fields().ByName("creator")
+	fd_MsgSetSlashDistribution_tr_id = md_MsgSetSlashDistribution.Fields().ByName("tr_id")
+	fd_MsgSetSlashDistribution_distribution = md_MsgSetSlashDistribution.Fields().ByName("distribution")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetSlashDistribution)(nil)
+
+type fastReflection_MsgSetSlashDistribution MsgSetSlashDistribution
+
+func (x *MsgSetSlashDistribution) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetSlashDistribution)(x)
+}
+
+func (x *MsgSetSlashDistribution) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_tx_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetSlashDistribution_messageType fastReflection_MsgSetSlashDistribution_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetSlashDistribution_messageType{}
+
+type fastReflection_MsgSetSlashDistribution_messageType struct{}
+
+func (x fastReflection_MsgSetSlashDistribution_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetSlashDistribution)(nil)
+}
+func (x fastReflection_MsgSetSlashDistribution_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetSlashDistribution)
+}
+func (x fastReflection_MsgSetSlashDistribution_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetSlashDistribution
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetSlashDistribution) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetSlashDistribution
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetSlashDistribution) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetSlashDistribution_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetSlashDistribution) New() protoreflect.Message {
+	return new(fastReflection_MsgSetSlashDistribution)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetSlashDistribution) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetSlashDistribution)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetSlashDistribution) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgSetSlashDistribution_creator, value) {
+			return
+		}
+	}
+	if x.TrId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TrId)
+		if !f(fd_MsgSetSlashDistribution_tr_id, value) {
+			return
+		}
+	}
+	if len(x.Distribution) != 0 {
+		value := protoreflect.ValueOfList(&_MsgSetSlashDistribution_3_list{list: &x.Distribution})
+		if !f(fd_MsgSetSlashDistribution_distribution, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetSlashDistribution) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgSetSlashDistribution.creator":
+		return x.Creator != ""
+	case "verana.perm.v1.MsgSetSlashDistribution.tr_id":
+		return x.TrId != uint64(0)
+	case "verana.perm.v1.MsgSetSlashDistribution.distribution":
+		return len(x.Distribution) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSetSlashDistribution"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgSetSlashDistribution does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetSlashDistribution) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgSetSlashDistribution.creator":
+		x.Creator = ""
+	case "verana.perm.v1.MsgSetSlashDistribution.tr_id":
+		x.TrId = uint64(0)
+	case "verana.perm.v1.MsgSetSlashDistribution.distribution":
+		x.Distribution = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSetSlashDistribution"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgSetSlashDistribution does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetSlashDistribution) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.perm.v1.MsgSetSlashDistribution.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.MsgSetSlashDistribution.tr_id":
+		value := x.TrId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.MsgSetSlashDistribution.distribution":
+		if len(x.Distribution) == 0 {
+			return protoreflect.ValueOfList(&_MsgSetSlashDistribution_3_list{})
+		}
+		listValue := &_MsgSetSlashDistribution_3_list{list: &x.Distribution}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSetSlashDistribution"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgSetSlashDistribution does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetSlashDistribution) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgSetSlashDistribution.creator":
+		x.Creator = value.Interface().(string)
+	case "verana.perm.v1.MsgSetSlashDistribution.tr_id":
+		x.TrId = value.Uint()
+	case "verana.perm.v1.MsgSetSlashDistribution.distribution":
+		lv := value.List()
+		clv := lv.(*_MsgSetSlashDistribution_3_list)
+		x.Distribution = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSetSlashDistribution"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgSetSlashDistribution does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetSlashDistribution) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgSetSlashDistribution.distribution":
+		if x.Distribution == nil {
+			x.Distribution = []*v1.SlashShare{}
+		}
+		value := &_MsgSetSlashDistribution_3_list{list: &x.Distribution}
+		return protoreflect.ValueOfList(value)
+	case "verana.perm.v1.MsgSetSlashDistribution.creator":
+		panic(fmt.Errorf("field creator of message verana.perm.v1.MsgSetSlashDistribution is not mutable"))
+	case "verana.perm.v1.MsgSetSlashDistribution.tr_id":
+		panic(fmt.Errorf("field tr_id of message verana.perm.v1.MsgSetSlashDistribution is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSetSlashDistribution"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgSetSlashDistribution does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetSlashDistribution) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgSetSlashDistribution.creator":
+		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.MsgSetSlashDistribution.tr_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.MsgSetSlashDistribution.distribution":
+		list := []*v1.SlashShare{}
+		return protoreflect.ValueOfList(&_MsgSetSlashDistribution_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSetSlashDistribution"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgSetSlashDistribution does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetSlashDistribution) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.MsgSetSlashDistribution", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetSlashDistribution) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetSlashDistribution) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetSlashDistribution) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetSlashDistribution) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetSlashDistribution)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TrId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TrId))
+		}
+		if len(x.Distribution) > 0 {
+			for _, e := range x.Distribution {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetSlashDistribution)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Distribution) > 0 {
+			for iNdEx := len(x.Distribution) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Distribution[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.TrId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TrId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetSlashDistribution)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetSlashDistribution: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetSlashDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TrId", wireType)
+				}
+				x.TrId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TrId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Distribution = append(x.Distribution, &v1.SlashShare{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Distribution[len(x.Distribution)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSetSlashDistributionResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_tx_proto_init()
+	md_MsgSetSlashDistributionResponse = File_verana_perm_v1_tx_proto.Messages().ByName("MsgSetSlashDistributionResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetSlashDistributionResponse)(nil)
+
+type fastReflection_MsgSetSlashDistributionResponse MsgSetSlashDistributionResponse
+
+func (x *MsgSetSlashDistributionResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetSlashDistributionResponse)(x)
+}
+
+func (x *MsgSetSlashDistributionResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_tx_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetSlashDistributionResponse_messageType fastReflection_MsgSetSlashDistributionResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetSlashDistributionResponse_messageType{}
+
+type fastReflection_MsgSetSlashDistributionResponse_messageType struct{}
+
+func (x fastReflection_MsgSetSlashDistributionResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetSlashDistributionResponse)(nil)
+}
+func (x fastReflection_MsgSetSlashDistributionResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetSlashDistributionResponse)
+}
+func (x fastReflection_MsgSetSlashDistributionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetSlashDistributionResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetSlashDistributionResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetSlashDistributionResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetSlashDistributionResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetSlashDistributionResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetSlashDistributionResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSetSlashDistributionResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetSlashDistributionResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetSlashDistributionResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetSlashDistributionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetSlashDistributionResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSetSlashDistributionResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgSetSlashDistributionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetSlashDistributionResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSetSlashDistributionResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgSetSlashDistributionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetSlashDistributionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSetSlashDistributionResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgSetSlashDistributionResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetSlashDistributionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSetSlashDistributionResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgSetSlashDistributionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetSlashDistributionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSetSlashDistributionResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgSetSlashDistributionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetSlashDistributionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSetSlashDistributionResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgSetSlashDistributionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetSlashDistributionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.MsgSetSlashDistributionResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetSlashDistributionResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetSlashDistributionResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetSlashDistributionResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetSlashDistributionResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetSlashDistributionResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetSlashDistributionResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetSlashDistributionResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetSlashDistributionResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetSlashDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSuspendPermission                 protoreflect.MessageDescriptor
+	fd_MsgSuspendPermission_creator         protoreflect.FieldDescriptor
+	fd_MsgSuspendPermission_id              protoreflect.FieldDescriptor
+	fd_MsgSuspendPermission_reason          protoreflect.FieldDescriptor
+	fd_MsgSuspendPermission_suspended_until protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_tx_proto_init()
+	md_MsgSuspendPermission = File_verana_perm_v1_tx_proto.Messages().ByName("MsgSuspendPermission")
+	fd_MsgSuspendPermission_creator = md_MsgSuspendPermission.Fields().ByName("creator")
+	fd_MsgSuspendPermission_id = md_MsgSuspendPermission.Fields().ByName("id")
+	fd_MsgSuspendPermission_reason = md_MsgSuspendPermission.Fields().ByName("reason")
+	fd_MsgSuspendPermission_suspended_until = md_MsgSuspendPermission.Fields().ByName("suspended_until")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSuspendPermission)(nil)
+
+type fastReflection_MsgSuspendPermission MsgSuspendPermission
+
+func (x *MsgSuspendPermission) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSuspendPermission)(x)
+}
+
+func (x *MsgSuspendPermission) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_tx_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSuspendPermission_messageType fastReflection_MsgSuspendPermission_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSuspendPermission_messageType{}
+
+type fastReflection_MsgSuspendPermission_messageType struct{}
+
+func (x fastReflection_MsgSuspendPermission_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSuspendPermission)(nil)
+}
+func (x fastReflection_MsgSuspendPermission_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSuspendPermission)
+}
+func (x fastReflection_MsgSuspendPermission_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSuspendPermission
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSuspendPermission) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSuspendPermission
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSuspendPermission) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSuspendPermission_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSuspendPermission) New() protoreflect.Message {
+	return new(fastReflection_MsgSuspendPermission)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSuspendPermission) Interface() protoreflect.ProtoMessage {
+	return (*MsgSuspendPermission)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSuspendPermission) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgSuspendPermission_creator, value) {
+			return
+		}
+	}
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_MsgSuspendPermission_id, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_MsgSuspendPermission_reason, value) {
+			return
+		}
+	}
+	if x.SuspendedUntil != nil {
+		value := protoreflect.ValueOfMessage(x.SuspendedUntil.ProtoReflect())
+		if !f(fd_MsgSuspendPermission_suspended_until, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSuspendPermission) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgSuspendPermission.creator":
+		return x.Creator != ""
+	case "verana.perm.v1.MsgSuspendPermission.id":
+		return x.Id != uint64(0)
+	case "verana.perm.v1.MsgSuspendPermission.reason":
+		return x.Reason != ""
+	case "verana.perm.v1.MsgSuspendPermission.suspended_until":
+		return x.SuspendedUntil != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSuspendPermission"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgSuspendPermission does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSuspendPermission) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgSuspendPermission.creator":
+		x.Creator = ""
+	case "verana.perm.v1.MsgSuspendPermission.id":
+		x.Id = uint64(0)
+	case "verana.perm.v1.MsgSuspendPermission.reason":
+		x.Reason = ""
+	case "verana.perm.v1.MsgSuspendPermission.suspended_until":
+		x.SuspendedUntil = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSuspendPermission"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgSuspendPermission does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSuspendPermission) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.perm.v1.MsgSuspendPermission.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.MsgSuspendPermission.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.MsgSuspendPermission.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.MsgSuspendPermission.suspended_until":
+		value := x.SuspendedUntil
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSuspendPermission"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgSuspendPermission does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSuspendPermission) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgSuspendPermission.creator":
+		x.Creator = value.Interface().(string)
+	case "verana.perm.v1.MsgSuspendPermission.id":
+		x.Id = value.Uint()
+	case "verana.perm.v1.MsgSuspendPermission.reason":
+		x.Reason = value.Interface().(string)
+	case "verana.perm.v1.MsgSuspendPermission.suspended_until":
+		x.SuspendedUntil = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSuspendPermission"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgSuspendPermission does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSuspendPermission) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgSuspendPermission.suspended_until":
+		if x.SuspendedUntil == nil {
+			x.SuspendedUntil = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.SuspendedUntil.ProtoReflect())
+	case "verana.perm.v1.MsgSuspendPermission.creator":
+		panic(fmt.Errorf("field creator of message verana.perm.v1.MsgSuspendPermission is not mutable"))
+	case "verana.perm.v1.MsgSuspendPermission.id":
+		panic(fmt.Errorf("field id of message verana.perm.v1.MsgSuspendPermission is not mutable"))
+	case "verana.perm.v1.MsgSuspendPermission.reason":
+		panic(fmt.Errorf("field reason of message verana.perm.v1.MsgSuspendPermission is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSuspendPermission"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgSuspendPermission does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSuspendPermission) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgSuspendPermission.creator":
+		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.MsgSuspendPermission.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.MsgSuspendPermission.reason":
+		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.MsgSuspendPermission.suspended_until":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSuspendPermission"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgSuspendPermission does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSuspendPermission) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.MsgSuspendPermission", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSuspendPermission) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSuspendPermission) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSuspendPermission) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSuspendPermission) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSuspendPermission)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SuspendedUntil != nil {
+			l = options.Size(x.SuspendedUntil)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSuspendPermission)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.SuspendedUntil != nil {
+			encoded, err := options.Marshal(x.SuspendedUntil)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSuspendPermission)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSuspendPermission: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSuspendPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SuspendedUntil", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.SuspendedUntil == nil {
+					x.SuspendedUntil = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SuspendedUntil); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSuspendPermissionResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_tx_proto_init()
+	md_MsgSuspendPermissionResponse = File_verana_perm_v1_tx_proto.Messages().ByName("MsgSuspendPermissionResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSuspendPermissionResponse)(nil)
+
+type fastReflection_MsgSuspendPermissionResponse MsgSuspendPermissionResponse
+
+func (x *MsgSuspendPermissionResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSuspendPermissionResponse)(x)
+}
+
+func (x *MsgSuspendPermissionResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_tx_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSuspendPermissionResponse_messageType fastReflection_MsgSuspendPermissionResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSuspendPermissionResponse_messageType{}
+
+type fastReflection_MsgSuspendPermissionResponse_messageType struct{}
+
+func (x fastReflection_MsgSuspendPermissionResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSuspendPermissionResponse)(nil)
+}
+func (x fastReflection_MsgSuspendPermissionResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSuspendPermissionResponse)
+}
+func (x fastReflection_MsgSuspendPermissionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSuspendPermissionResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSuspendPermissionResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSuspendPermissionResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSuspendPermissionResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSuspendPermissionResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSuspendPermissionResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSuspendPermissionResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSuspendPermissionResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSuspendPermissionResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSuspendPermissionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSuspendPermissionResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSuspendPermissionResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgSuspendPermissionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSuspendPermissionResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSuspendPermissionResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgSuspendPermissionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSuspendPermissionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSuspendPermissionResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgSuspendPermissionResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSuspendPermissionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSuspendPermissionResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgSuspendPermissionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSuspendPermissionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSuspendPermissionResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgSuspendPermissionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSuspendPermissionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSuspendPermissionResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgSuspendPermissionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSuspendPermissionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.MsgSuspendPermissionResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSuspendPermissionResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSuspendPermissionResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSuspendPermissionResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSuspendPermissionResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSuspendPermissionResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSuspendPermissionResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSuspendPermissionResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSuspendPermissionResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSuspendPermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUnsuspendPermission         protoreflect.MessageDescriptor
+	fd_MsgUnsuspendPermission_creator protoreflect.FieldDescriptor
+	fd_MsgUnsuspendPermission_id      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_tx_proto_init()
+	md_MsgUnsuspendPermission = File_verana_perm_v1_tx_proto.Messages().ByName("MsgUnsuspendPermission")
+	fd_MsgUnsuspendPermission_creator = md_MsgUnsuspendPermission.Fields().ByName("creator")
+	fd_MsgUnsuspendPermission_id = md_MsgUnsuspendPermission.Fields().ByName("id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUnsuspendPermission)(nil)
+
+type fastReflection_MsgUnsuspendPermission MsgUnsuspendPermission
+
+func (x *MsgUnsuspendPermission) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUnsuspendPermission)(x)
+}
+
+func (x *MsgUnsuspendPermission) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_tx_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUnsuspendPermission_messageType fastReflection_MsgUnsuspendPermission_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUnsuspendPermission_messageType{}
+
+type fastReflection_MsgUnsuspendPermission_messageType struct{}
+
+func (x fastReflection_MsgUnsuspendPermission_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUnsuspendPermission)(nil)
+}
+func (x fastReflection_MsgUnsuspendPermission_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUnsuspendPermission)
+}
+func (x fastReflection_MsgUnsuspendPermission_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUnsuspendPermission
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUnsuspendPermission) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUnsuspendPermission
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUnsuspendPermission) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUnsuspendPermission_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUnsuspendPermission) New() protoreflect.Message {
+	return new(fastReflection_MsgUnsuspendPermission)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUnsuspendPermission) Interface() protoreflect.ProtoMessage {
+	return (*MsgUnsuspendPermission)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUnsuspendPermission) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgUnsuspendPermission_creator, value) {
+			return
+		}
+	}
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_MsgUnsuspendPermission_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUnsuspendPermission) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgUnsuspendPermission.creator":
+		return x.Creator != ""
+	case "verana.perm.v1.MsgUnsuspendPermission.id":
+		return x.Id != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgUnsuspendPermission"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgUnsuspendPermission does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUnsuspendPermission) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgUnsuspendPermission.creator":
+		x.Creator = ""
+	case "verana.perm.v1.MsgUnsuspendPermission.id":
+		x.Id = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgUnsuspendPermission"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgUnsuspendPermission does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUnsuspendPermission) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.perm.v1.MsgUnsuspendPermission.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.MsgUnsuspendPermission.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgUnsuspendPermission"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgUnsuspendPermission does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUnsuspendPermission) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgUnsuspendPermission.creator":
+		x.Creator = value.Interface().(string)
+	case "verana.perm.v1.MsgUnsuspendPermission.id":
+		x.Id = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgUnsuspendPermission"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgUnsuspendPermission does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUnsuspendPermission) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgUnsuspendPermission.creator":
+		panic(fmt.Errorf("field creator of message verana.perm.v1.MsgUnsuspendPermission is not mutable"))
+	case "verana.perm.v1.MsgUnsuspendPermission.id":
+		panic(fmt.Errorf("field id of message verana.perm.v1.MsgUnsuspendPermission is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgUnsuspendPermission"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgUnsuspendPermission does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUnsuspendPermission) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgUnsuspendPermission.creator":
+		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.MsgUnsuspendPermission.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgUnsuspendPermission"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgUnsuspendPermission does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUnsuspendPermission) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.MsgUnsuspendPermission", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUnsuspendPermission) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUnsuspendPermission) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUnsuspendPermission) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUnsuspendPermission) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUnsuspendPermission)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUnsuspendPermission)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUnsuspendPermission)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUnsuspendPermission: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUnsuspendPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUnsuspendPermissionResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_tx_proto_init()
+	md_MsgUnsuspendPermissionResponse = File_verana_perm_v1_tx_proto.Messages().ByName("MsgUnsuspendPermissionResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUnsuspendPermissionResponse)(nil)
+
+type fastReflection_MsgUnsuspendPermissionResponse MsgUnsuspendPermissionResponse
+
+func (x *MsgUnsuspendPermissionResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUnsuspendPermissionResponse)(x)
+}
+
+func (x *MsgUnsuspendPermissionResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_tx_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUnsuspendPermissionResponse_messageType fastReflection_MsgUnsuspendPermissionResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUnsuspendPermissionResponse_messageType{}
+
+type fastReflection_MsgUnsuspendPermissionResponse_messageType struct{}
+
+func (x fastReflection_MsgUnsuspendPermissionResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUnsuspendPermissionResponse)(nil)
+}
+func (x fastReflection_MsgUnsuspendPermissionResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUnsuspendPermissionResponse)
+}
+func (x fastReflection_MsgUnsuspendPermissionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUnsuspendPermissionResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUnsuspendPermissionResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUnsuspendPermissionResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUnsuspendPermissionResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUnsuspendPermissionResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUnsuspendPermissionResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUnsuspendPermissionResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUnsuspendPermissionResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUnsuspendPermissionResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUnsuspendPermissionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUnsuspendPermissionResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgUnsuspendPermissionResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgUnsuspendPermissionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUnsuspendPermissionResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgUnsuspendPermissionResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgUnsuspendPermissionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUnsuspendPermissionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgUnsuspendPermissionResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgUnsuspendPermissionResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUnsuspendPermissionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgUnsuspendPermissionResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgUnsuspendPermissionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUnsuspendPermissionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgUnsuspendPermissionResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgUnsuspendPermissionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUnsuspendPermissionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgUnsuspendPermissionResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgUnsuspendPermissionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUnsuspendPermissionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.MsgUnsuspendPermissionResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUnsuspendPermissionResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUnsuspendPermissionResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUnsuspendPermissionResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUnsuspendPermissionResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUnsuspendPermissionResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUnsuspendPermissionResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUnsuspendPermissionResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUnsuspendPermissionResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUnsuspendPermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgClosePermissionSession         protoreflect.MessageDescriptor
+	fd_MsgClosePermissionSession_creator protoreflect.FieldDescriptor
+	fd_MsgClosePermissionSession_id      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_tx_proto_init()
+	md_MsgClosePermissionSession = File_verana_perm_v1_tx_proto.Messages().ByName("MsgClosePermissionSession")
+	fd_MsgClosePermissionSession_creator = md_MsgClosePermissionSession.Fields().ByName("creator")
+	fd_MsgClosePermissionSession_id = md_MsgClosePermissionSession.Fields().ByName("id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgClosePermissionSession)(nil)
+
+type fastReflection_MsgClosePermissionSession MsgClosePermissionSession
+
+func (x *MsgClosePermissionSession) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgClosePermissionSession)(x)
+}
+
+func (x *MsgClosePermissionSession) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_tx_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgClosePermissionSession_messageType fastReflection_MsgClosePermissionSession_messageType
+var _ protoreflect.MessageType = fastReflection_MsgClosePermissionSession_messageType{}
+
+type fastReflection_MsgClosePermissionSession_messageType struct{}
+
+func (x fastReflection_MsgClosePermissionSession_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgClosePermissionSession)(nil)
+}
+func (x fastReflection_MsgClosePermissionSession_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgClosePermissionSession)
+}
+func (x fastReflection_MsgClosePermissionSession_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgClosePermissionSession
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgClosePermissionSession) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgClosePermissionSession
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgClosePermissionSession) Type() protoreflect.MessageType {
+	return _fastReflection_MsgClosePermissionSession_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgClosePermissionSession) New() protoreflect.Message {
+	return new(fastReflection_MsgClosePermissionSession)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgClosePermissionSession) Interface() protoreflect.ProtoMessage {
+	return (*MsgClosePermissionSession)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgClosePermissionSession) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgClosePermissionSession_creator, value) {
+			return
+		}
+	}
+	if x.Id != "" {
+		value := protoreflect.ValueOfString(x.Id)
+		if !f(fd_MsgClosePermissionSession_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgClosePermissionSession) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgClosePermissionSession.creator":
+		return x.Creator != ""
+	case "verana.perm.v1.MsgClosePermissionSession.id":
+		return x.Id != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgClosePermissionSession"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgClosePermissionSession does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgClosePermissionSession) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgClosePermissionSession.creator":
+		x.Creator = ""
+	case "verana.perm.v1.MsgClosePermissionSession.id":
+		x.Id = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgClosePermissionSession"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgClosePermissionSession does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgClosePermissionSession) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.perm.v1.MsgClosePermissionSession.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.MsgClosePermissionSession.id":
+		value := x.Id
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgClosePermissionSession"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgClosePermissionSession does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgClosePermissionSession) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgClosePermissionSession.creator":
+		x.Creator = value.Interface().(string)
+	case "verana.perm.v1.MsgClosePermissionSession.id":
+		x.Id = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgClosePermissionSession"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgClosePermissionSession does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgClosePermissionSession) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgClosePermissionSession.creator":
+		panic(fmt.Errorf("field creator of message verana.perm.v1.MsgClosePermissionSession is not mutable"))
+	case "verana.perm.v1.MsgClosePermissionSession.id":
+		panic(fmt.Errorf("field id of message verana.perm.v1.MsgClosePermissionSession is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgClosePermissionSession"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgClosePermissionSession does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgClosePermissionSession) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgClosePermissionSession.creator":
+		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.MsgClosePermissionSession.id":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgClosePermissionSession"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgClosePermissionSession does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgClosePermissionSession) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.MsgClosePermissionSession", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgClosePermissionSession) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgClosePermissionSession) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgClosePermissionSession) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgClosePermissionSession) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgClosePermissionSession)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Id)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgClosePermissionSession)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Id) > 0 {
+			i -= len(x.Id)
+			copy(dAtA[i:], x.Id)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Id)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgClosePermissionSession)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgClosePermissionSession: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgClosePermissionSession: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Id = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgClosePermissionSessionResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_tx_proto_init()
+	md_MsgClosePermissionSessionResponse = File_verana_perm_v1_tx_proto.Messages().ByName("MsgClosePermissionSessionResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgClosePermissionSessionResponse)(nil)
+
+type fastReflection_MsgClosePermissionSessionResponse MsgClosePermissionSessionResponse
+
+func (x *MsgClosePermissionSessionResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgClosePermissionSessionResponse)(x)
+}
+
+func (x *MsgClosePermissionSessionResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_tx_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgClosePermissionSessionResponse_messageType fastReflection_MsgClosePermissionSessionResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgClosePermissionSessionResponse_messageType{}
+
+type fastReflection_MsgClosePermissionSessionResponse_messageType struct{}
+
+func (x fastReflection_MsgClosePermissionSessionResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgClosePermissionSessionResponse)(nil)
+}
+func (x fastReflection_MsgClosePermissionSessionResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgClosePermissionSessionResponse)
+}
+func (x fastReflection_MsgClosePermissionSessionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgClosePermissionSessionResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgClosePermissionSessionResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgClosePermissionSessionResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgClosePermissionSessionResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgClosePermissionSessionResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgClosePermissionSessionResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgClosePermissionSessionResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgClosePermissionSessionResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgClosePermissionSessionResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgClosePermissionSessionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgClosePermissionSessionResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgClosePermissionSessionResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgClosePermissionSessionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgClosePermissionSessionResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgClosePermissionSessionResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgClosePermissionSessionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgClosePermissionSessionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgClosePermissionSessionResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgClosePermissionSessionResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgClosePermissionSessionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgClosePermissionSessionResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgClosePermissionSessionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgClosePermissionSessionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgClosePermissionSessionResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgClosePermissionSessionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgClosePermissionSessionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgClosePermissionSessionResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgClosePermissionSessionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgClosePermissionSessionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.MsgClosePermissionSessionResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgClosePermissionSessionResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgClosePermissionSessionResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgClosePermissionSessionResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgClosePermissionSessionResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgClosePermissionSessionResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgClosePermissionSessionResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgClosePermissionSessionResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgClosePermissionSessionResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgClosePermissionSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgPurchaseVerificationCredits                  protoreflect.MessageDescriptor
+	fd_MsgPurchaseVerificationCredits_creator          protoreflect.FieldDescriptor
+	fd_MsgPurchaseVerificationCredits_verifier_perm_id protoreflect.FieldDescriptor
+	fd_MsgPurchaseVerificationCredits_issuer_perm_id   protoreflect.FieldDescriptor
+	fd_MsgPurchaseVerificationCredits_count            protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_tx_proto_init()
+	md_MsgPurchaseVerificationCredits = File_verana_perm_v1_tx_proto.Messages().ByName("MsgPurchaseVerificationCredits")
+	fd_MsgPurchaseVerificationCredits_creator = md_MsgPurchaseVerificationCredits.Fields().ByName("creator")
+	fd_MsgPurchaseVerificationCredits_verifier_perm_id = md_MsgPurchaseVerificationCredits.Fields().ByName("verifier_perm_id")
+	fd_MsgPurchaseVerificationCredits_issuer_perm_id = md_MsgPurchaseVerificationCredits.Fields().ByName("issuer_perm_id")
+	fd_MsgPurchaseVerificationCredits_count = md_MsgPurchaseVerificationCredits.Fields().ByName("count")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgPurchaseVerificationCredits)(nil)
+
+type fastReflection_MsgPurchaseVerificationCredits MsgPurchaseVerificationCredits
+
+func (x *MsgPurchaseVerificationCredits) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgPurchaseVerificationCredits)(x)
+}
+
+func (x *MsgPurchaseVerificationCredits) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_tx_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgPurchaseVerificationCredits_messageType fastReflection_MsgPurchaseVerificationCredits_messageType
+var _ protoreflect.MessageType = fastReflection_MsgPurchaseVerificationCredits_messageType{}
+
+type fastReflection_MsgPurchaseVerificationCredits_messageType struct{}
+
+func (x fastReflection_MsgPurchaseVerificationCredits_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgPurchaseVerificationCredits)(nil)
+}
+func (x fastReflection_MsgPurchaseVerificationCredits_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgPurchaseVerificationCredits)
+}
+func (x fastReflection_MsgPurchaseVerificationCredits_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgPurchaseVerificationCredits
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgPurchaseVerificationCredits) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgPurchaseVerificationCredits
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgPurchaseVerificationCredits) Type() protoreflect.MessageType {
+	return _fastReflection_MsgPurchaseVerificationCredits_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgPurchaseVerificationCredits) New() protoreflect.Message {
+	return new(fastReflection_MsgPurchaseVerificationCredits)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgPurchaseVerificationCredits) Interface() protoreflect.ProtoMessage {
+	return (*MsgPurchaseVerificationCredits)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgPurchaseVerificationCredits) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgPurchaseVerificationCredits_creator, value) {
+			return
+		}
+	}
+	if x.VerifierPermId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.VerifierPermId)
+		if !f(fd_MsgPurchaseVerificationCredits_verifier_perm_id, value) {
+			return
+		}
+	}
+	if x.IssuerPermId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.IssuerPermId)
+		if !f(fd_MsgPurchaseVerificationCredits_issuer_perm_id, value) {
+			return
+		}
+	}
+	if x.Count != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Count)
+		if !f(fd_MsgPurchaseVerificationCredits_count, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgPurchaseVerificationCredits) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgPurchaseVerificationCredits.creator":
+		return x.Creator != ""
+	case "verana.perm.v1.MsgPurchaseVerificationCredits.verifier_perm_id":
+		return x.VerifierPermId != uint64(0)
+	case "verana.perm.v1.MsgPurchaseVerificationCredits.issuer_perm_id":
+		return x.IssuerPermId != uint64(0)
+	case "verana.perm.v1.MsgPurchaseVerificationCredits.count":
+		return x.Count != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgPurchaseVerificationCredits"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgPurchaseVerificationCredits does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPurchaseVerificationCredits) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgPurchaseVerificationCredits.creator":
+		x.Creator = ""
+	case "verana.perm.v1.MsgPurchaseVerificationCredits.verifier_perm_id":
+		x.VerifierPermId = uint64(0)
+	case "verana.perm.v1.MsgPurchaseVerificationCredits.issuer_perm_id":
+		x.IssuerPermId = uint64(0)
+	case "verana.perm.v1.MsgPurchaseVerificationCredits.count":
+		x.Count = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgPurchaseVerificationCredits"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgPurchaseVerificationCredits does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgPurchaseVerificationCredits) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.perm.v1.MsgPurchaseVerificationCredits.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.MsgPurchaseVerificationCredits.verifier_perm_id":
+		value := x.VerifierPermId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.MsgPurchaseVerificationCredits.issuer_perm_id":
+		value := x.IssuerPermId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.MsgPurchaseVerificationCredits.count":
+		value := x.Count
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgPurchaseVerificationCredits"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgPurchaseVerificationCredits does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPurchaseVerificationCredits) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgPurchaseVerificationCredits.creator":
+		x.Creator = value.Interface().(string)
+	case "verana.perm.v1.MsgPurchaseVerificationCredits.verifier_perm_id":
+		x.VerifierPermId = value.Uint()
+	case "verana.perm.v1.MsgPurchaseVerificationCredits.issuer_perm_id":
+		x.IssuerPermId = value.Uint()
+	case "verana.perm.v1.MsgPurchaseVerificationCredits.count":
+		x.Count = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgPurchaseVerificationCredits"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgPurchaseVerificationCredits does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPurchaseVerificationCredits) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgPurchaseVerificationCredits.creator":
+		panic(fmt.Errorf("field creator of message verana.perm.v1.MsgPurchaseVerificationCredits is not mutable"))
+	case "verana.perm.v1.MsgPurchaseVerificationCredits.verifier_perm_id":
+		panic(fmt.Errorf("field verifier_perm_id of message verana.perm.v1.MsgPurchaseVerificationCredits is not mutable"))
+	case "verana.perm.v1.MsgPurchaseVerificationCredits.issuer_perm_id":
+		panic(fmt.Errorf("field issuer_perm_id of message verana.perm.v1.MsgPurchaseVerificationCredits is not mutable"))
+	case "verana.perm.v1.MsgPurchaseVerificationCredits.count":
+		panic(fmt.Errorf("field count of message verana.perm.v1.MsgPurchaseVerificationCredits is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgPurchaseVerificationCredits"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgPurchaseVerificationCredits does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgPurchaseVerificationCredits) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgPurchaseVerificationCredits.creator":
+		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.MsgPurchaseVerificationCredits.verifier_perm_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.MsgPurchaseVerificationCredits.issuer_perm_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.MsgPurchaseVerificationCredits.count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgPurchaseVerificationCredits"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgPurchaseVerificationCredits does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgPurchaseVerificationCredits) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.MsgPurchaseVerificationCredits", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgPurchaseVerificationCredits) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPurchaseVerificationCredits) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgPurchaseVerificationCredits) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgPurchaseVerificationCredits) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgPurchaseVerificationCredits)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.VerifierPermId != 0 {
+			n += 1 + runtime.Sov(uint64(x.VerifierPermId))
+		}
+		if x.IssuerPermId != 0 {
+			n += 1 + runtime.Sov(uint64(x.IssuerPermId))
+		}
+		if x.Count != 0 {
+			n += 1 + runtime.Sov(uint64(x.Count))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgPurchaseVerificationCredits)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Count != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Count))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.IssuerPermId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IssuerPermId))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.VerifierPermId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VerifierPermId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgPurchaseVerificationCredits)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgPurchaseVerificationCredits: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgPurchaseVerificationCredits: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerifierPermId", wireType)
+				}
+				x.VerifierPermId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.VerifierPermId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IssuerPermId", wireType)
+				}
+				x.IssuerPermId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IssuerPermId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				}
+				x.Count = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Count |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgPurchaseVerificationCreditsResponse               protoreflect.MessageDescriptor
+	fd_MsgPurchaseVerificationCreditsResponse_id            protoreflect.FieldDescriptor
+	fd_MsgPurchaseVerificationCreditsResponse_cost          protoreflect.FieldDescriptor
+	fd_MsgPurchaseVerificationCreditsResponse_fees          protoreflect.FieldDescriptor
+	fd_MsgPurchaseVerificationCreditsResponse_trust_deposit protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_tx_proto_init()
+	md_MsgPurchaseVerificationCreditsResponse = File_verana_perm_v1_tx_proto.Messages().ByName("MsgPurchaseVerificationCreditsResponse")
+	fd_MsgPurchaseVerificationCreditsResponse_id = md_MsgPurchaseVerificationCreditsResponse.Fields().ByName("id")
+	fd_MsgPurchaseVerificationCreditsResponse_cost = md_MsgPurchaseVerificationCreditsResponse.Fields().ByName("cost")
+	fd_MsgPurchaseVerificationCreditsResponse_fees = md_MsgPurchaseVerificationCreditsResponse.Fields().ByName("fees")
+	fd_MsgPurchaseVerificationCreditsResponse_trust_deposit = md_MsgPurchaseVerificationCreditsResponse.Fields().ByName("trust_deposit")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgPurchaseVerificationCreditsResponse)(nil)
+
+type fastReflection_MsgPurchaseVerificationCreditsResponse MsgPurchaseVerificationCreditsResponse
+
+func (x *MsgPurchaseVerificationCreditsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgPurchaseVerificationCreditsResponse)(x)
+}
+
+func (x *MsgPurchaseVerificationCreditsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_tx_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgPurchaseVerificationCreditsResponse_messageType fastReflection_MsgPurchaseVerificationCreditsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgPurchaseVerificationCreditsResponse_messageType{}
+
+type fastReflection_MsgPurchaseVerificationCreditsResponse_messageType struct{}
+
+func (x fastReflection_MsgPurchaseVerificationCreditsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgPurchaseVerificationCreditsResponse)(nil)
+}
+func (x fastReflection_MsgPurchaseVerificationCreditsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgPurchaseVerificationCreditsResponse)
+}
+func (x fastReflection_MsgPurchaseVerificationCreditsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgPurchaseVerificationCreditsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgPurchaseVerificationCreditsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgPurchaseVerificationCreditsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgPurchaseVerificationCreditsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgPurchaseVerificationCreditsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgPurchaseVerificationCreditsResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgPurchaseVerificationCreditsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgPurchaseVerificationCreditsResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgPurchaseVerificationCreditsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgPurchaseVerificationCreditsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_MsgPurchaseVerificationCreditsResponse_id, value) {
+			return
+		}
+	}
+	if x.Cost != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Cost)
+		if !f(fd_MsgPurchaseVerificationCreditsResponse_cost, value) {
+			return
+		}
+	}
+	if x.Fees != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Fees)
+		if !f(fd_MsgPurchaseVerificationCreditsResponse_fees, value) {
+			return
+		}
+	}
+	if x.TrustDeposit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TrustDeposit)
+		if !f(fd_MsgPurchaseVerificationCreditsResponse_trust_deposit, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgPurchaseVerificationCreditsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgPurchaseVerificationCreditsResponse.id":
+		return x.Id != uint64(0)
+	case "verana.perm.v1.MsgPurchaseVerificationCreditsResponse.cost":
+		return x.Cost != uint64(0)
+	case "verana.perm.v1.MsgPurchaseVerificationCreditsResponse.fees":
+		return x.Fees != uint64(0)
+	case "verana.perm.v1.MsgPurchaseVerificationCreditsResponse.trust_deposit":
+		return x.TrustDeposit != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgPurchaseVerificationCreditsResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgPurchaseVerificationCreditsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPurchaseVerificationCreditsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgPurchaseVerificationCreditsResponse.id":
+		x.Id = uint64(0)
+	case "verana.perm.v1.MsgPurchaseVerificationCreditsResponse.cost":
+		x.Cost = uint64(0)
+	case "verana.perm.v1.MsgPurchaseVerificationCreditsResponse.fees":
+		x.Fees = uint64(0)
+	case "verana.perm.v1.MsgPurchaseVerificationCreditsResponse.trust_deposit":
+		x.TrustDeposit = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgPurchaseVerificationCreditsResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgPurchaseVerificationCreditsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgPurchaseVerificationCreditsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.perm.v1.MsgPurchaseVerificationCreditsResponse.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.MsgPurchaseVerificationCreditsResponse.cost":
+		value := x.Cost
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.MsgPurchaseVerificationCreditsResponse.fees":
+		value := x.Fees
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.MsgPurchaseVerificationCreditsResponse.trust_deposit":
+		value := x.TrustDeposit
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgPurchaseVerificationCreditsResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgPurchaseVerificationCreditsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPurchaseVerificationCreditsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgPurchaseVerificationCreditsResponse.id":
+		x.Id = value.Uint()
+	case "verana.perm.v1.MsgPurchaseVerificationCreditsResponse.cost":
+		x.Cost = value.Uint()
+	case "verana.perm.v1.MsgPurchaseVerificationCreditsResponse.fees":
+		x.Fees = value.Uint()
+	case "verana.perm.v1.MsgPurchaseVerificationCreditsResponse.trust_deposit":
+		x.TrustDeposit = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgPurchaseVerificationCreditsResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgPurchaseVerificationCreditsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPurchaseVerificationCreditsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgPurchaseVerificationCreditsResponse.id":
+		panic(fmt.Errorf("field id of message verana.perm.v1.MsgPurchaseVerificationCreditsResponse is not mutable"))
+	case "verana.perm.v1.MsgPurchaseVerificationCreditsResponse.cost":
+		panic(fmt.Errorf("field cost of message verana.perm.v1.MsgPurchaseVerificationCreditsResponse is not mutable"))
+	case "verana.perm.v1.MsgPurchaseVerificationCreditsResponse.fees":
+		panic(fmt.Errorf("field fees of message verana.perm.v1.MsgPurchaseVerificationCreditsResponse is not mutable"))
+	case "verana.perm.v1.MsgPurchaseVerificationCreditsResponse.trust_deposit":
+		panic(fmt.Errorf("field trust_deposit of message verana.perm.v1.MsgPurchaseVerificationCreditsResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgPurchaseVerificationCreditsResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgPurchaseVerificationCreditsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgPurchaseVerificationCreditsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgPurchaseVerificationCreditsResponse.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.MsgPurchaseVerificationCreditsResponse.cost":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.MsgPurchaseVerificationCreditsResponse.fees":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.MsgPurchaseVerificationCreditsResponse.trust_deposit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgPurchaseVerificationCreditsResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgPurchaseVerificationCreditsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgPurchaseVerificationCreditsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.MsgPurchaseVerificationCreditsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgPurchaseVerificationCreditsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPurchaseVerificationCreditsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgPurchaseVerificationCreditsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgPurchaseVerificationCreditsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgPurchaseVerificationCreditsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.Cost != 0 {
+			n += 1 + runtime.Sov(uint64(x.Cost))
+		}
+		if x.Fees != 0 {
+			n += 1 + runtime.Sov(uint64(x.Fees))
+		}
+		if x.TrustDeposit != 0 {
+			n += 1 + runtime.Sov(uint64(x.TrustDeposit))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgPurchaseVerificationCreditsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.TrustDeposit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TrustDeposit))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Fees != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Fees))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Cost != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Cost))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgPurchaseVerificationCreditsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgPurchaseVerificationCreditsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgPurchaseVerificationCreditsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
+				}
+				x.Cost = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Cost |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+				}
+				x.Fees = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Fees |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TrustDeposit", wireType)
+				}
+				x.TrustDeposit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TrustDeposit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRefundVerificationCredits         protoreflect.MessageDescriptor
+	fd_MsgRefundVerificationCredits_creator protoreflect.FieldDescriptor
+	fd_MsgRefundVerificationCredits_id      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_tx_proto_init()
+	md_MsgRefundVerificationCredits = File_verana_perm_v1_tx_proto.Messages().ByName("MsgRefundVerificationCredits")
+	fd_MsgRefundVerificationCredits_creator = md_MsgRefundVerificationCredits.Fields().ByName("creator")
+	fd_MsgRefundVerificationCredits_id = md_MsgRefundVerificationCredits.Fields().ByName("id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRefundVerificationCredits)(nil)
+
+type fastReflection_MsgRefundVerificationCredits MsgRefundVerificationCredits
+
+func (x *MsgRefundVerificationCredits) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRefundVerificationCredits)(x)
+}
+
+func (x *MsgRefundVerificationCredits) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_tx_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRefundVerificationCredits_messageType fastReflection_MsgRefundVerificationCredits_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRefundVerificationCredits_messageType{}
+
+type fastReflection_MsgRefundVerificationCredits_messageType struct{}
+
+func (x fastReflection_MsgRefundVerificationCredits_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRefundVerificationCredits)(nil)
+}
+func (x fastReflection_MsgRefundVerificationCredits_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRefundVerificationCredits)
+}
+func (x fastReflection_MsgRefundVerificationCredits_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRefundVerificationCredits
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRefundVerificationCredits) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRefundVerificationCredits
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRefundVerificationCredits) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRefundVerificationCredits_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRefundVerificationCredits) New() protoreflect.Message {
+	return new(fastReflection_MsgRefundVerificationCredits)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRefundVerificationCredits) Interface() protoreflect.ProtoMessage {
+	return (*MsgRefundVerificationCredits)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRefundVerificationCredits) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgRefundVerificationCredits_creator, value) {
+			return
+		}
+	}
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_MsgRefundVerificationCredits_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRefundVerificationCredits) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgRefundVerificationCredits.creator":
+		return x.Creator != ""
+	case "verana.perm.v1.MsgRefundVerificationCredits.id":
+		return x.Id != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgRefundVerificationCredits"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgRefundVerificationCredits does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRefundVerificationCredits) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgRefundVerificationCredits.creator":
+		x.Creator = ""
+	case "verana.perm.v1.MsgRefundVerificationCredits.id":
+		x.Id = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgRefundVerificationCredits"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgRefundVerificationCredits does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRefundVerificationCredits) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.perm.v1.MsgRefundVerificationCredits.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.MsgRefundVerificationCredits.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgRefundVerificationCredits"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgRefundVerificationCredits does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRefundVerificationCredits) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgRefundVerificationCredits.creator":
+		x.Creator = value.Interface().(string)
+	case "verana.perm.v1.MsgRefundVerificationCredits.id":
+		x.Id = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgRefundVerificationCredits"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgRefundVerificationCredits does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRefundVerificationCredits) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgRefundVerificationCredits.creator":
+		panic(fmt.Errorf("field creator of message verana.perm.v1.MsgRefundVerificationCredits is not mutable"))
+	case "verana.perm.v1.MsgRefundVerificationCredits.id":
+		panic(fmt.Errorf("field id of message verana.perm.v1.MsgRefundVerificationCredits is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgRefundVerificationCredits"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgRefundVerificationCredits does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRefundVerificationCredits) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgRefundVerificationCredits.creator":
+		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.MsgRefundVerificationCredits.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgRefundVerificationCredits"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgRefundVerificationCredits does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRefundVerificationCredits) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.MsgRefundVerificationCredits", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRefundVerificationCredits) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRefundVerificationCredits) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRefundVerificationCredits) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRefundVerificationCredits) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRefundVerificationCredits)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRefundVerificationCredits)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRefundVerificationCredits)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRefundVerificationCredits: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRefundVerificationCredits: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRefundVerificationCreditsResponse                        protoreflect.MessageDescriptor
+	fd_MsgRefundVerificationCreditsResponse_refunded_credits       protoreflect.FieldDescriptor
+	fd_MsgRefundVerificationCreditsResponse_released_trust_deposit protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_tx_proto_init()
+	md_MsgRefundVerificationCreditsResponse = File_verana_perm_v1_tx_proto.Messages().ByName("MsgRefundVerificationCreditsResponse")
+	fd_MsgRefundVerificationCreditsResponse_refunded_credits = md_MsgRefundVerificationCreditsResponse.Fields().ByName("refunded_credits")
+	fd_MsgRefundVerificationCreditsResponse_released_trust_deposit = md_MsgRefundVerificationCreditsResponse.Fields().ByName("released_trust_deposit")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRefundVerificationCreditsResponse)(nil)
+
+type fastReflection_MsgRefundVerificationCreditsResponse MsgRefundVerificationCreditsResponse
+
+func (x *MsgRefundVerificationCreditsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRefundVerificationCreditsResponse)(x)
+}
+
+func (x *MsgRefundVerificationCreditsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_tx_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRefundVerificationCreditsResponse_messageType fastReflection_MsgRefundVerificationCreditsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRefundVerificationCreditsResponse_messageType{}
+
+type fastReflection_MsgRefundVerificationCreditsResponse_messageType struct{}
+
+func (x fastReflection_MsgRefundVerificationCreditsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRefundVerificationCreditsResponse)(nil)
+}
+func (x fastReflection_MsgRefundVerificationCreditsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRefundVerificationCreditsResponse)
+}
+func (x fastReflection_MsgRefundVerificationCreditsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRefundVerificationCreditsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRefundVerificationCreditsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRefundVerificationCreditsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRefundVerificationCreditsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRefundVerificationCreditsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRefundVerificationCreditsResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRefundVerificationCreditsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRefundVerificationCreditsResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRefundVerificationCreditsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRefundVerificationCreditsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RefundedCredits != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RefundedCredits)
+		if !f(fd_MsgRefundVerificationCreditsResponse_refunded_credits, value) {
+			return
+		}
+	}
+	if x.ReleasedTrustDeposit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReleasedTrustDeposit)
+		if !f(fd_MsgRefundVerificationCreditsResponse_released_trust_deposit, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRefundVerificationCreditsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgRefundVerificationCreditsResponse.refunded_credits":
+		return x.RefundedCredits != uint64(0)
+	case "verana.perm.v1.MsgRefundVerificationCreditsResponse.released_trust_deposit":
+		return x.ReleasedTrustDeposit != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgRefundVerificationCreditsResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgRefundVerificationCreditsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRefundVerificationCreditsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgRefundVerificationCreditsResponse.refunded_credits":
+		x.RefundedCredits = uint64(0)
+	case "verana.perm.v1.MsgRefundVerificationCreditsResponse.released_trust_deposit":
+		x.ReleasedTrustDeposit = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgRefundVerificationCreditsResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgRefundVerificationCreditsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRefundVerificationCreditsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.perm.v1.MsgRefundVerificationCreditsResponse.refunded_credits":
+		value := x.RefundedCredits
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.MsgRefundVerificationCreditsResponse.released_trust_deposit":
+		value := x.ReleasedTrustDeposit
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgRefundVerificationCreditsResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgRefundVerificationCreditsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRefundVerificationCreditsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgRefundVerificationCreditsResponse.refunded_credits":
+		x.RefundedCredits = value.Uint()
+	case "verana.perm.v1.MsgRefundVerificationCreditsResponse.released_trust_deposit":
+		x.ReleasedTrustDeposit = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgRefundVerificationCreditsResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgRefundVerificationCreditsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRefundVerificationCreditsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgRefundVerificationCreditsResponse.refunded_credits":
+		panic(fmt.Errorf("field refunded_credits of message verana.perm.v1.MsgRefundVerificationCreditsResponse is not mutable"))
+	case "verana.perm.v1.MsgRefundVerificationCreditsResponse.released_trust_deposit":
+		panic(fmt.Errorf("field released_trust_deposit of message verana.perm.v1.MsgRefundVerificationCreditsResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgRefundVerificationCreditsResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgRefundVerificationCreditsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRefundVerificationCreditsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgRefundVerificationCreditsResponse.refunded_credits":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.MsgRefundVerificationCreditsResponse.released_trust_deposit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgRefundVerificationCreditsResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgRefundVerificationCreditsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRefundVerificationCreditsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.MsgRefundVerificationCreditsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRefundVerificationCreditsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRefundVerificationCreditsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRefundVerificationCreditsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRefundVerificationCreditsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRefundVerificationCreditsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.RefundedCredits != 0 {
+			n += 1 + runtime.Sov(uint64(x.RefundedCredits))
+		}
+		if x.ReleasedTrustDeposit != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReleasedTrustDeposit))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRefundVerificationCreditsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ReleasedTrustDeposit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReleasedTrustDeposit))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.RefundedCredits != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RefundedCredits))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRefundVerificationCreditsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRefundVerificationCreditsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRefundVerificationCreditsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RefundedCredits", wireType)
+				}
+				x.RefundedCredits = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RefundedCredits |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReleasedTrustDeposit", wireType)
+				}
+				x.ReleasedTrustDeposit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReleasedTrustDeposit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SignedSessionVoucher           protoreflect.MessageDescriptor
+	fd_SignedSessionVoucher_voucher   protoreflect.FieldDescriptor
+	fd_SignedSessionVoucher_pub_key   protoreflect.FieldDescriptor
+	fd_SignedSessionVoucher_signature protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_tx_proto_init()
+	md_SignedSessionVoucher = File_verana_perm_v1_tx_proto.Messages().ByName("SignedSessionVoucher")
+	fd_SignedSessionVoucher_voucher = md_SignedSessionVoucher.Fields().ByName("voucher")
+	fd_SignedSessionVoucher_pub_key = md_SignedSessionVoucher.Fields().ByName("pub_key")
+	fd_SignedSessionVoucher_signature = md_SignedSessionVoucher.Fields().ByName("signature")
+}
+
+var _ protoreflect.Message = (*fastReflection_SignedSessionVoucher)(nil)
+
+type fastReflection_SignedSessionVoucher SignedSessionVoucher
+
+func (x *SignedSessionVoucher) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SignedSessionVoucher)(x)
+}
+
+func (x *SignedSessionVoucher) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_tx_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SignedSessionVoucher_messageType fastReflection_SignedSessionVoucher_messageType
+var _ protoreflect.MessageType = fastReflection_SignedSessionVoucher_messageType{}
+
+type fastReflection_SignedSessionVoucher_messageType struct{}
+
+func (x fastReflection_SignedSessionVoucher_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SignedSessionVoucher)(nil)
+}
+func (x fastReflection_SignedSessionVoucher_messageType) New() protoreflect.Message {
+	return new(fastReflection_SignedSessionVoucher)
+}
+func (x fastReflection_SignedSessionVoucher_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignedSessionVoucher
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SignedSessionVoucher) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignedSessionVoucher
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SignedSessionVoucher) Type() protoreflect.MessageType {
+	return _fastReflection_SignedSessionVoucher_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SignedSessionVoucher) New() protoreflect.Message {
+	return new(fastReflection_SignedSessionVoucher)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SignedSessionVoucher) Interface() protoreflect.ProtoMessage {
+	return (*SignedSessionVoucher)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SignedSessionVoucher) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Voucher != nil {
+		value := protoreflect.ValueOfMessage(x.Voucher.ProtoReflect())
+		if !f(fd_SignedSessionVoucher_voucher, value) {
+			return
+		}
+	}
+	if len(x.PubKey) != 0 {
+		value := protoreflect.ValueOfBytes(x.PubKey)
+		if !f(fd_SignedSessionVoucher_pub_key, value) {
+			return
+		}
+	}
+	if len(x.Signature) != 0 {
+		value := protoreflect.ValueOfBytes(x.Signature)
+		if !f(fd_SignedSessionVoucher_signature, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SignedSessionVoucher) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.perm.v1.SignedSessionVoucher.voucher":
+		return x.Voucher != nil
+	case "verana.perm.v1.SignedSessionVoucher.pub_key":
+		return len(x.PubKey) != 0
+	case "verana.perm.v1.SignedSessionVoucher.signature":
+		return len(x.Signature) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SignedSessionVoucher"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.SignedSessionVoucher does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignedSessionVoucher) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.perm.v1.SignedSessionVoucher.voucher":
+		x.Voucher = nil
+	case "verana.perm.v1.SignedSessionVoucher.pub_key":
+		x.PubKey = nil
+	case "verana.perm.v1.SignedSessionVoucher.signature":
+		x.Signature = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SignedSessionVoucher"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.SignedSessionVoucher does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SignedSessionVoucher) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.perm.v1.SignedSessionVoucher.voucher":
+		value := x.Voucher
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.perm.v1.SignedSessionVoucher.pub_key":
+		value := x.PubKey
+		return protoreflect.ValueOfBytes(value)
+	case "verana.perm.v1.SignedSessionVoucher.signature":
+		value := x.Signature
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SignedSessionVoucher"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.SignedSessionVoucher does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignedSessionVoucher) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.perm.v1.SignedSessionVoucher.voucher":
+		x.Voucher = value.Message().Interface().(*SessionVoucher)
+	case "verana.perm.v1.SignedSessionVoucher.pub_key":
+		x.PubKey = value.Bytes()
+	case "verana.perm.v1.SignedSessionVoucher.signature":
+		x.Signature = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SignedSessionVoucher"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.SignedSessionVoucher does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignedSessionVoucher) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.SignedSessionVoucher.voucher":
+		if x.Voucher == nil {
+			x.Voucher = new(SessionVoucher)
+		}
+		return protoreflect.ValueOfMessage(x.Voucher.ProtoReflect())
+	case "verana.perm.v1.SignedSessionVoucher.pub_key":
+		panic(fmt.Errorf("field pub_key of message verana.perm.v1.SignedSessionVoucher is not mutable"))
+	case "verana.perm.v1.SignedSessionVoucher.signature":
+		panic(fmt.Errorf("field signature of message verana.perm.v1.SignedSessionVoucher is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SignedSessionVoucher"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.SignedSessionVoucher does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SignedSessionVoucher) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.SignedSessionVoucher.voucher":
+		m := new(SessionVoucher)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.perm.v1.SignedSessionVoucher.pub_key":
+		return protoreflect.ValueOfBytes(nil)
+	case "verana.perm.v1.SignedSessionVoucher.signature":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SignedSessionVoucher"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.SignedSessionVoucher does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SignedSessionVoucher) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.SignedSessionVoucher", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SignedSessionVoucher) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignedSessionVoucher) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SignedSessionVoucher) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SignedSessionVoucher) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SignedSessionVoucher)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Voucher != nil {
+			l = options.Size(x.Voucher)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PubKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Signature)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SignedSessionVoucher)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Signature) > 0 {
+			i -= len(x.Signature)
+			copy(dAtA[i:], x.Signature)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signature)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.PubKey) > 0 {
+			i -= len(x.PubKey)
+			copy(dAtA[i:], x.PubKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PubKey)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Voucher != nil {
+			encoded, err := options.Marshal(x.Voucher)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SignedSessionVoucher)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignedSessionVoucher: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignedSessionVoucher: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Voucher", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Voucher == nil {
+					x.Voucher = &SessionVoucher{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Voucher); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PubKey = append(x.PubKey[:0], dAtA[iNdEx:postIndex]...)
+				if x.PubKey == nil {
+					x.PubKey = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signature = append(x.Signature[:0], dAtA[iNdEx:postIndex]...)
+				if x.Signature == nil {
+					x.Signature = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgSettleSessionVouchers_2_list)(nil)
+
+type _MsgSettleSessionVouchers_2_list struct {
+	list *[]*SignedSessionVoucher
+}
+
+func (x *_MsgSettleSessionVouchers_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgSettleSessionVouchers_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgSettleSessionVouchers_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SignedSessionVoucher)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgSettleSessionVouchers_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SignedSessionVoucher)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgSettleSessionVouchers_2_list) AppendMutable() protoreflect.Value {
+	v := new(SignedSessionVoucher)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgSettleSessionVouchers_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgSettleSessionVouchers_2_list) NewElement() protoreflect.Value {
+	v := new(SignedSessionVoucher)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgSettleSessionVouchers_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgSettleSessionVouchers          protoreflect.MessageDescriptor
+	fd_MsgSettleSessionVouchers_creator  protoreflect.FieldDescriptor
+	fd_MsgSettleSessionVouchers_vouchers protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_tx_proto_init()
+	md_MsgSettleSessionVouchers = File_verana_perm_v1_tx_proto.Messages().ByName("MsgSettleSessionVouchers")
+	fd_MsgSettleSessionVouchers_creator = md_MsgSettleSessionVouchers.Fields().ByName("creator")
+	fd_MsgSettleSessionVouchers_vouchers = md_MsgSettleSessionVouchers.Fields().ByName("vouchers")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSettleSessionVouchers)(nil)
+
+type fastReflection_MsgSettleSessionVouchers MsgSettleSessionVouchers
+
+func (x *MsgSettleSessionVouchers) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSettleSessionVouchers)(x)
+}
+
+func (x *MsgSettleSessionVouchers) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_tx_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSettleSessionVouchers_messageType fastReflection_MsgSettleSessionVouchers_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSettleSessionVouchers_messageType{}
+
+type fastReflection_MsgSettleSessionVouchers_messageType struct{}
+
+func (x fastReflection_MsgSettleSessionVouchers_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSettleSessionVouchers)(nil)
+}
+func (x fastReflection_MsgSettleSessionVouchers_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSettleSessionVouchers)
+}
+func (x fastReflection_MsgSettleSessionVouchers_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSettleSessionVouchers
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSettleSessionVouchers) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSettleSessionVouchers
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSettleSessionVouchers) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSettleSessionVouchers_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSettleSessionVouchers) New() protoreflect.Message {
+	return new(fastReflection_MsgSettleSessionVouchers)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSettleSessionVouchers) Interface() protoreflect.ProtoMessage {
+	return (*MsgSettleSessionVouchers)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSettleSessionVouchers) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgSettleSessionVouchers_creator, value) {
+			return
+		}
+	}
+	if len(x.Vouchers) != 0 {
+		value := protoreflect.ValueOfList(&_MsgSettleSessionVouchers_2_list{list: &x.Vouchers})
+		if !f(fd_MsgSettleSessionVouchers_vouchers, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSettleSessionVouchers) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgSettleSessionVouchers.creator":
+		return x.Creator != ""
+	case "verana.perm.v1.MsgSettleSessionVouchers.vouchers":
+		return len(x.Vouchers) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSettleSessionVouchers"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgSettleSessionVouchers does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSettleSessionVouchers) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgSettleSessionVouchers.creator":
+		x.Creator = ""
+	case "verana.perm.v1.MsgSettleSessionVouchers.vouchers":
+		x.Vouchers = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSettleSessionVouchers"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgSettleSessionVouchers does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSettleSessionVouchers) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.perm.v1.MsgSettleSessionVouchers.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.MsgSettleSessionVouchers.vouchers":
+		if len(x.Vouchers) == 0 {
+			return protoreflect.ValueOfList(&_MsgSettleSessionVouchers_2_list{})
+		}
+		listValue := &_MsgSettleSessionVouchers_2_list{list: &x.Vouchers}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSettleSessionVouchers"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgSettleSessionVouchers does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSettleSessionVouchers) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgSettleSessionVouchers.creator":
+		x.Creator = value.Interface().(string)
+	case "verana.perm.v1.MsgSettleSessionVouchers.vouchers":
+		lv := value.List()
+		clv := lv.(*_MsgSettleSessionVouchers_2_list)
+		x.Vouchers = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSettleSessionVouchers"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgSettleSessionVouchers does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSettleSessionVouchers) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgSettleSessionVouchers.vouchers":
+		if x.Vouchers == nil {
+			x.Vouchers = []*SignedSessionVoucher{}
+		}
+		value := &_MsgSettleSessionVouchers_2_list{list: &x.Vouchers}
+		return protoreflect.ValueOfList(value)
+	case "verana.perm.v1.MsgSettleSessionVouchers.creator":
+		panic(fmt.Errorf("field creator of message verana.perm.v1.MsgSettleSessionVouchers is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSettleSessionVouchers"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgSettleSessionVouchers does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSettleSessionVouchers) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgSettleSessionVouchers.creator":
+		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.MsgSettleSessionVouchers.vouchers":
+		list := []*SignedSessionVoucher{}
+		return protoreflect.ValueOfList(&_MsgSettleSessionVouchers_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSettleSessionVouchers"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgSettleSessionVouchers does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSettleSessionVouchers) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.MsgSettleSessionVouchers", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSettleSessionVouchers) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSettleSessionVouchers) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSettleSessionVouchers) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSettleSessionVouchers) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSettleSessionVouchers)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Vouchers) > 0 {
+			for _, e := range x.Vouchers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSettleSessionVouchers)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Vouchers) > 0 {
+			for iNdEx := len(x.Vouchers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Vouchers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSettleSessionVouchers)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSettleSessionVouchers: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSettleSessionVouchers: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Vouchers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Vouchers = append(x.Vouchers, &SignedSessionVoucher{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Vouchers[len(x.Vouchers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSettleSessionVouchersResponse         protoreflect.MessageDescriptor
+	fd_MsgSettleSessionVouchersResponse_settled protoreflect.FieldDescriptor
+	fd_MsgSettleSessionVouchersResponse_total   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_tx_proto_init()
+	md_MsgSettleSessionVouchersResponse = File_verana_perm_v1_tx_proto.Messages().ByName("MsgSettleSessionVouchersResponse")
+	fd_MsgSettleSessionVouchersResponse_settled = md_MsgSettleSessionVouchersResponse.Fields().ByName("settled")
+	fd_MsgSettleSessionVouchersResponse_total = md_MsgSettleSessionVouchersResponse.Fields().ByName("total")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSettleSessionVouchersResponse)(nil)
+
+type fastReflection_MsgSettleSessionVouchersResponse MsgSettleSessionVouchersResponse
+
+func (x *MsgSettleSessionVouchersResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSettleSessionVouchersResponse)(x)
+}
+
+func (x *MsgSettleSessionVouchersResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_tx_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSettleSessionVouchersResponse_messageType fastReflection_MsgSettleSessionVouchersResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSettleSessionVouchersResponse_messageType{}
+
+type fastReflection_MsgSettleSessionVouchersResponse_messageType struct{}
+
+func (x fastReflection_MsgSettleSessionVouchersResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSettleSessionVouchersResponse)(nil)
+}
+func (x fastReflection_MsgSettleSessionVouchersResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSettleSessionVouchersResponse)
+}
+func (x fastReflection_MsgSettleSessionVouchersResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSettleSessionVouchersResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSettleSessionVouchersResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSettleSessionVouchersResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSettleSessionVouchersResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSettleSessionVouchersResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSettleSessionVouchersResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSettleSessionVouchersResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSettleSessionVouchersResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSettleSessionVouchersResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSettleSessionVouchersResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Settled != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Settled)
+		if !f(fd_MsgSettleSessionVouchersResponse_settled, value) {
+			return
+		}
+	}
+	if x.Total != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Total)
+		if !f(fd_MsgSettleSessionVouchersResponse_total, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSettleSessionVouchersResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgSettleSessionVouchersResponse.settled":
+		return x.Settled != uint64(0)
+	case "verana.perm.v1.MsgSettleSessionVouchersResponse.total":
+		return x.Total != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSettleSessionVouchersResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgSettleSessionVouchersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSettleSessionVouchersResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgSettleSessionVouchersResponse.settled":
+		x.Settled = uint64(0)
+	case "verana.perm.v1.MsgSettleSessionVouchersResponse.total":
+		x.Total = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSettleSessionVouchersResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgSettleSessionVouchersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSettleSessionVouchersResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.perm.v1.MsgSettleSessionVouchersResponse.settled":
+		value := x.Settled
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.MsgSettleSessionVouchersResponse.total":
+		value := x.Total
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSettleSessionVouchersResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgSettleSessionVouchersResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSettleSessionVouchersResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgSettleSessionVouchersResponse.settled":
+		x.Settled = value.Uint()
+	case "verana.perm.v1.MsgSettleSessionVouchersResponse.total":
+		x.Total = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSettleSessionVouchersResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgSettleSessionVouchersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSettleSessionVouchersResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgSettleSessionVouchersResponse.settled":
+		panic(fmt.Errorf("field settled of message verana.perm.v1.MsgSettleSessionVouchersResponse is not mutable"))
+	case "verana.perm.v1.MsgSettleSessionVouchersResponse.total":
+		panic(fmt.Errorf("field total of message verana.perm.v1.MsgSettleSessionVouchersResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSettleSessionVouchersResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgSettleSessionVouchersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSettleSessionVouchersResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgSettleSessionVouchersResponse.settled":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.MsgSettleSessionVouchersResponse.total":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgSettleSessionVouchersResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgSettleSessionVouchersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSettleSessionVouchersResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.MsgSettleSessionVouchersResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSettleSessionVouchersResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSettleSessionVouchersResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSettleSessionVouchersResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSettleSessionVouchersResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSettleSessionVouchersResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Settled != 0 {
+			n += 1 + runtime.Sov(uint64(x.Settled))
+		}
+		if x.Total != 0 {
+			n += 1 + runtime.Sov(uint64(x.Total))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSettleSessionVouchersResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Total != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Total))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Settled != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Settled))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSettleSessionVouchersResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSettleSessionVouchersResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSettleSessionVouchersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Settled", wireType)
+				}
+				x.Settled = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Settled |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				}
+				x.Total = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Total |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgAnchorVPMessage               protoreflect.MessageDescriptor
+	fd_MsgAnchorVPMessage_creator       protoreflect.FieldDescriptor
+	fd_MsgAnchorVPMessage_perm_id       protoreflect.FieldDescriptor
+	fd_MsgAnchorVPMessage_digest_sri    protoreflect.FieldDescriptor
+	fd_MsgAnchorVPMessage_encrypted_uri protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_tx_proto_init()
+	md_MsgAnchorVPMessage = File_verana_perm_v1_tx_proto.Messages().ByName("MsgAnchorVPMessage")
+	fd_MsgAnchorVPMessage_creator = md_MsgAnchorVPMessage.Fields().ByName("creator")
+	fd_MsgAnchorVPMessage_perm_id = md_MsgAnchorVPMessage.Fields().ByName("perm_id")
+	fd_MsgAnchorVPMessage_digest_sri = md_MsgAnchorVPMessage.Fields().ByName("digest_sri")
+	fd_MsgAnchorVPMessage_encrypted_uri = md_MsgAnchorVPMessage.Fields().ByName("encrypted_uri")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgAnchorVPMessage)(nil)
+
+type fastReflection_MsgAnchorVPMessage MsgAnchorVPMessage
+
+func (x *MsgAnchorVPMessage) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAnchorVPMessage)(x)
+}
+
+func (x *MsgAnchorVPMessage) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_tx_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgAnchorVPMessage_messageType fastReflection_MsgAnchorVPMessage_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAnchorVPMessage_messageType{}
+
+type fastReflection_MsgAnchorVPMessage_messageType struct{}
+
+func (x fastReflection_MsgAnchorVPMessage_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAnchorVPMessage)(nil)
+}
+func (x fastReflection_MsgAnchorVPMessage_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAnchorVPMessage)
+}
+func (x fastReflection_MsgAnchorVPMessage_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAnchorVPMessage
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgAnchorVPMessage) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAnchorVPMessage
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgAnchorVPMessage) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAnchorVPMessage_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgAnchorVPMessage) New() protoreflect.Message {
+	return new(fastReflection_MsgAnchorVPMessage)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgAnchorVPMessage) Interface() protoreflect.ProtoMessage {
+	return (*MsgAnchorVPMessage)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgAnchorVPMessage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgAnchorVPMessage_creator, value) {
+			return
+		}
+	}
+	if x.PermId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PermId)
+		if !f(fd_MsgAnchorVPMessage_perm_id, value) {
+			return
+		}
+	}
+	if x.DigestSri != "" {
+		value := protoreflect.ValueOfString(x.DigestSri)
+		if !f(fd_MsgAnchorVPMessage_digest_sri, value) {
+			return
+		}
+	}
+	if x.EncryptedUri != "" {
+		value := protoreflect.ValueOfString(x.EncryptedUri)
+		if !f(fd_MsgAnchorVPMessage_encrypted_uri, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgAnchorVPMessage) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgAnchorVPMessage.creator":
+		return x.Creator != ""
+	case "verana.perm.v1.MsgAnchorVPMessage.perm_id":
+		return x.PermId != uint64(0)
+	case "verana.perm.v1.MsgAnchorVPMessage.digest_sri":
+		return x.DigestSri != ""
+	case "verana.perm.v1.MsgAnchorVPMessage.encrypted_uri":
+		return x.EncryptedUri != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgAnchorVPMessage"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgAnchorVPMessage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAnchorVPMessage) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgAnchorVPMessage.creator":
+		x.Creator = ""
+	case "verana.perm.v1.MsgAnchorVPMessage.perm_id":
+		x.PermId = uint64(0)
+	case "verana.perm.v1.MsgAnchorVPMessage.digest_sri":
+		x.DigestSri = ""
+	case "verana.perm.v1.MsgAnchorVPMessage.encrypted_uri":
+		x.EncryptedUri = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgAnchorVPMessage"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgAnchorVPMessage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgAnchorVPMessage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.perm.v1.MsgAnchorVPMessage.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.MsgAnchorVPMessage.perm_id":
+		value := x.PermId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.MsgAnchorVPMessage.digest_sri":
+		value := x.DigestSri
+		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.MsgAnchorVPMessage.encrypted_uri":
+		value := x.EncryptedUri
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgAnchorVPMessage"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgAnchorVPMessage does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAnchorVPMessage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgAnchorVPMessage.creator":
+		x.Creator = value.Interface().(string)
+	case "verana.perm.v1.MsgAnchorVPMessage.perm_id":
+		x.PermId = value.Uint()
+	case "verana.perm.v1.MsgAnchorVPMessage.digest_sri":
+		x.DigestSri = value.Interface().(string)
+	case "verana.perm.v1.MsgAnchorVPMessage.encrypted_uri":
+		x.EncryptedUri = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgAnchorVPMessage"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgAnchorVPMessage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAnchorVPMessage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgAnchorVPMessage.creator":
+		panic(fmt.Errorf("field creator of message verana.perm.v1.MsgAnchorVPMessage is not mutable"))
+	case "verana.perm.v1.MsgAnchorVPMessage.perm_id":
+		panic(fmt.Errorf("field perm_id of message verana.perm.v1.MsgAnchorVPMessage is not mutable"))
+	case "verana.perm.v1.MsgAnchorVPMessage.digest_sri":
+		panic(fmt.Errorf("field digest_sri of message verana.perm.v1.MsgAnchorVPMessage is not mutable"))
+	case "verana.perm.v1.MsgAnchorVPMessage.encrypted_uri":
+		panic(fmt.Errorf("field encrypted_uri of message verana.perm.v1.MsgAnchorVPMessage is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgAnchorVPMessage"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgAnchorVPMessage does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgAnchorVPMessage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgAnchorVPMessage.creator":
+		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.MsgAnchorVPMessage.perm_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.MsgAnchorVPMessage.digest_sri":
+		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.MsgAnchorVPMessage.encrypted_uri":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgAnchorVPMessage"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgAnchorVPMessage does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgAnchorVPMessage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.MsgAnchorVPMessage", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgAnchorVPMessage) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAnchorVPMessage) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgAnchorVPMessage) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgAnchorVPMessage) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgAnchorVPMessage)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PermId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PermId))
+		}
+		l = len(x.DigestSri)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.EncryptedUri)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAnchorVPMessage)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.EncryptedUri) > 0 {
+			i -= len(x.EncryptedUri)
+			copy(dAtA[i:], x.EncryptedUri)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EncryptedUri)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.DigestSri) > 0 {
+			i -= len(x.DigestSri)
+			copy(dAtA[i:], x.DigestSri)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DigestSri)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.PermId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PermId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAnchorVPMessage)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAnchorVPMessage: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAnchorVPMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PermId", wireType)
+				}
+				x.PermId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PermId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DigestSri", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DigestSri = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EncryptedUri", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EncryptedUri = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgAnchorVPMessageResponse       protoreflect.MessageDescriptor
+	fd_MsgAnchorVPMessageResponse_index protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_tx_proto_init()
+	md_MsgAnchorVPMessageResponse = File_verana_perm_v1_tx_proto.Messages().ByName("MsgAnchorVPMessageResponse")
+	fd_MsgAnchorVPMessageResponse_index = md_MsgAnchorVPMessageResponse.Fields().ByName("index")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgAnchorVPMessageResponse)(nil)
+
+type fastReflection_MsgAnchorVPMessageResponse MsgAnchorVPMessageResponse
+
+func (x *MsgAnchorVPMessageResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAnchorVPMessageResponse)(x)
+}
+
+func (x *MsgAnchorVPMessageResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_tx_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgAnchorVPMessageResponse_messageType fastReflection_MsgAnchorVPMessageResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAnchorVPMessageResponse_messageType{}
+
+type fastReflection_MsgAnchorVPMessageResponse_messageType struct{}
+
+func (x fastReflection_MsgAnchorVPMessageResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAnchorVPMessageResponse)(nil)
+}
+func (x fastReflection_MsgAnchorVPMessageResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAnchorVPMessageResponse)
+}
+func (x fastReflection_MsgAnchorVPMessageResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAnchorVPMessageResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgAnchorVPMessageResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAnchorVPMessageResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgAnchorVPMessageResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAnchorVPMessageResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgAnchorVPMessageResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgAnchorVPMessageResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgAnchorVPMessageResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgAnchorVPMessageResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgAnchorVPMessageResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Index != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Index)
+		if !f(fd_MsgAnchorVPMessageResponse_index, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgAnchorVPMessageResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgAnchorVPMessageResponse.index":
+		return x.Index != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgAnchorVPMessageResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgAnchorVPMessageResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAnchorVPMessageResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgAnchorVPMessageResponse.index":
+		x.Index = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgAnchorVPMessageResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgAnchorVPMessageResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgAnchorVPMessageResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.perm.v1.MsgAnchorVPMessageResponse.index":
+		value := x.Index
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgAnchorVPMessageResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgAnchorVPMessageResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAnchorVPMessageResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgAnchorVPMessageResponse.index":
+		x.Index = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgAnchorVPMessageResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgAnchorVPMessageResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAnchorVPMessageResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgAnchorVPMessageResponse.index":
+		panic(fmt.Errorf("field index of message verana.perm.v1.MsgAnchorVPMessageResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgAnchorVPMessageResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgAnchorVPMessageResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgAnchorVPMessageResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgAnchorVPMessageResponse.index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgAnchorVPMessageResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgAnchorVPMessageResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgAnchorVPMessageResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.MsgAnchorVPMessageResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgAnchorVPMessageResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAnchorVPMessageResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgAnchorVPMessageResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgAnchorVPMessageResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgAnchorVPMessageResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Index != 0 {
+			n += 1 + runtime.Sov(uint64(x.Index))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAnchorVPMessageResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Index != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Index))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAnchorVPMessageResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAnchorVPMessageResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAnchorVPMessageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				x.Index = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Index |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdatePermissionFees                   protoreflect.MessageDescriptor
+	fd_MsgUpdatePermissionFees_creator           protoreflect.FieldDescriptor
+	fd_MsgUpdatePermissionFees_id                protoreflect.FieldDescriptor
+	fd_MsgUpdatePermissionFees_validation_fees   protoreflect.FieldDescriptor
+	fd_MsgUpdatePermissionFees_issuance_fees     protoreflect.FieldDescriptor
+	fd_MsgUpdatePermissionFees_verification_fees protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_tx_proto_init()
+	md_MsgUpdatePermissionFees = File_verana_perm_v1_tx_proto.Messages().ByName("MsgUpdatePermissionFees")
+	fd_MsgUpdatePermissionFees_creator = md_MsgUpdatePermissionFees.Fields().ByName("creator")
+	fd_MsgUpdatePermissionFees_id = md_MsgUpdatePermissionFees.Fields().ByName("id")
+	fd_MsgUpdatePermissionFees_validation_fees = md_MsgUpdatePermissionFees.Fields().ByName("validation_fees")
+	fd_MsgUpdatePermissionFees_issuance_fees = md_MsgUpdatePermissionFees.Fields().ByName("issuance_fees")
+	fd_MsgUpdatePermissionFees_verification_fees = md_MsgUpdatePermissionFees.Fields().ByName("verification_fees")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdatePermissionFees)(nil)
+
+type fastReflection_MsgUpdatePermissionFees MsgUpdatePermissionFees
+
+func (x *MsgUpdatePermissionFees) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdatePermissionFees)(x)
+}
+
+func (x *MsgUpdatePermissionFees) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_tx_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdatePermissionFees_messageType fastReflection_MsgUpdatePermissionFees_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdatePermissionFees_messageType{}
+
+type fastReflection_MsgUpdatePermissionFees_messageType struct{}
+
+func (x fastReflection_MsgUpdatePermissionFees_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdatePermissionFees)(nil)
+}
+func (x fastReflection_MsgUpdatePermissionFees_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdatePermissionFees)
+}
+func (x fastReflection_MsgUpdatePermissionFees_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdatePermissionFees
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdatePermissionFees) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdatePermissionFees
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdatePermissionFees) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdatePermissionFees_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdatePermissionFees) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdatePermissionFees)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdatePermissionFees) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdatePermissionFees)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdatePermissionFees) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgUpdatePermissionFees_creator, value) {
+			return
+		}
+	}
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_MsgUpdatePermissionFees_id, value) {
+			return
+		}
+	}
+	if x.ValidationFees != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ValidationFees)
+		if !f(fd_MsgUpdatePermissionFees_validation_fees, value) {
+			return
+		}
+	}
+	if x.IssuanceFees != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.IssuanceFees)
+		if !f(fd_MsgUpdatePermissionFees_issuance_fees, value) {
+			return
+		}
+	}
+	if x.VerificationFees != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.VerificationFees)
+		if !f(fd_MsgUpdatePermissionFees_verification_fees, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdatePermissionFees) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgUpdatePermissionFees.creator":
+		return x.Creator != ""
+	case "verana.perm.v1.MsgUpdatePermissionFees.id":
+		return x.Id != uint64(0)
+	case "verana.perm.v1.MsgUpdatePermissionFees.validation_fees":
+		return x.ValidationFees != uint64(0)
+	case "verana.perm.v1.MsgUpdatePermissionFees.issuance_fees":
+		return x.IssuanceFees != uint64(0)
+	case "verana.perm.v1.MsgUpdatePermissionFees.verification_fees":
+		return x.VerificationFees != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgUpdatePermissionFees"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgUpdatePermissionFees does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdatePermissionFees) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgUpdatePermissionFees.creator":
+		x.Creator = ""
+	case "verana.perm.v1.MsgUpdatePermissionFees.id":
+		x.Id = uint64(0)
+	case "verana.perm.v1.MsgUpdatePermissionFees.validation_fees":
+		x.ValidationFees = uint64(0)
+	case "verana.perm.v1.MsgUpdatePermissionFees.issuance_fees":
+		x.IssuanceFees = uint64(0)
+	case "verana.perm.v1.MsgUpdatePermissionFees.verification_fees":
+		x.VerificationFees = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgUpdatePermissionFees"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgUpdatePermissionFees does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdatePermissionFees) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.perm.v1.MsgUpdatePermissionFees.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.MsgUpdatePermissionFees.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.MsgUpdatePermissionFees.validation_fees":
+		value := x.ValidationFees
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.MsgUpdatePermissionFees.issuance_fees":
+		value := x.IssuanceFees
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.MsgUpdatePermissionFees.verification_fees":
+		value := x.VerificationFees
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgUpdatePermissionFees"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgUpdatePermissionFees does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdatePermissionFees) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgUpdatePermissionFees.creator":
+		x.Creator = value.Interface().(string)
+	case "verana.perm.v1.MsgUpdatePermissionFees.id":
+		x.Id = value.Uint()
+	case "verana.perm.v1.MsgUpdatePermissionFees.validation_fees":
+		x.ValidationFees = value.Uint()
+	case "verana.perm.v1.MsgUpdatePermissionFees.issuance_fees":
+		x.IssuanceFees = value.Uint()
+	case "verana.perm.v1.MsgUpdatePermissionFees.verification_fees":
+		x.VerificationFees = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgUpdatePermissionFees"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgUpdatePermissionFees does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdatePermissionFees) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgUpdatePermissionFees.creator":
+		panic(fmt.Errorf("field creator of message verana.perm.v1.MsgUpdatePermissionFees is not mutable"))
+	case "verana.perm.v1.MsgUpdatePermissionFees.id":
+		panic(fmt.Errorf("field id of message verana.perm.v1.MsgUpdatePermissionFees is not mutable"))
+	case "verana.perm.v1.MsgUpdatePermissionFees.validation_fees":
+		panic(fmt.Errorf("field validation_fees of message verana.perm.v1.MsgUpdatePermissionFees is not mutable"))
+	case "verana.perm.v1.MsgUpdatePermissionFees.issuance_fees":
+		panic(fmt.Errorf("field issuance_fees of message verana.perm.v1.MsgUpdatePermissionFees is not mutable"))
+	case "verana.perm.v1.MsgUpdatePermissionFees.verification_fees":
+		panic(fmt.Errorf("field verification_fees of message verana.perm.v1.MsgUpdatePermissionFees is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgUpdatePermissionFees"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgUpdatePermissionFees does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdatePermissionFees) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgUpdatePermissionFees.creator":
+		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.MsgUpdatePermissionFees.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.MsgUpdatePermissionFees.validation_fees":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.MsgUpdatePermissionFees.issuance_fees":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.MsgUpdatePermissionFees.verification_fees":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgUpdatePermissionFees"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgUpdatePermissionFees does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdatePermissionFees) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.MsgUpdatePermissionFees", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdatePermissionFees) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdatePermissionFees) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdatePermissionFees) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdatePermissionFees) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdatePermissionFees)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.ValidationFees != 0 {
+			n += 1 + runtime.Sov(uint64(x.ValidationFees))
+		}
+		if x.IssuanceFees != 0 {
+			n += 1 + runtime.Sov(uint64(x.IssuanceFees))
+		}
+		if x.VerificationFees != 0 {
+			n += 1 + runtime.Sov(uint64(x.VerificationFees))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdatePermissionFees)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.VerificationFees != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VerificationFees))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.IssuanceFees != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IssuanceFees))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.ValidationFees != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidationFees))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdatePermissionFees)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdatePermissionFees: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdatePermissionFees: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidationFees", wireType)
+				}
+				x.ValidationFees = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ValidationFees |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IssuanceFees", wireType)
+				}
+				x.IssuanceFees = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IssuanceFees |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerificationFees", wireType)
+				}
+				x.VerificationFees = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.VerificationFees |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdatePermissionFeesResponse                protoreflect.MessageDescriptor
+	fd_MsgUpdatePermissionFeesResponse_effective_from protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_tx_proto_init()
+	md_MsgUpdatePermissionFeesResponse = File_verana_perm_v1_tx_proto.Messages().ByName("MsgUpdatePermissionFeesResponse")
+	fd_MsgUpdatePermissionFeesResponse_effective_from = md_MsgUpdatePermissionFeesResponse.Fields().ByName("effective_from")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdatePermissionFeesResponse)(nil)
+
+type fastReflection_MsgUpdatePermissionFeesResponse MsgUpdatePermissionFeesResponse
+
+func (x *MsgUpdatePermissionFeesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdatePermissionFeesResponse)(x)
+}
+
+func (x *MsgUpdatePermissionFeesResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_tx_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdatePermissionFeesResponse_messageType fastReflection_MsgUpdatePermissionFeesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdatePermissionFeesResponse_messageType{}
+
+type fastReflection_MsgUpdatePermissionFeesResponse_messageType struct{}
+
+func (x fastReflection_MsgUpdatePermissionFeesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdatePermissionFeesResponse)(nil)
+}
+func (x fastReflection_MsgUpdatePermissionFeesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdatePermissionFeesResponse)
+}
+func (x fastReflection_MsgUpdatePermissionFeesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdatePermissionFeesResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdatePermissionFeesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdatePermissionFeesResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdatePermissionFeesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdatePermissionFeesResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdatePermissionFeesResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdatePermissionFeesResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdatePermissionFeesResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdatePermissionFeesResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdatePermissionFeesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.EffectiveFrom != nil {
+		value := protoreflect.ValueOfMessage(x.EffectiveFrom.ProtoReflect())
+		if !f(fd_MsgUpdatePermissionFeesResponse_effective_from, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdatePermissionFeesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgUpdatePermissionFeesResponse.effective_from":
+		return x.EffectiveFrom != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgUpdatePermissionFeesResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgUpdatePermissionFeesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdatePermissionFeesResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgUpdatePermissionFeesResponse.effective_from":
+		x.EffectiveFrom = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgUpdatePermissionFeesResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgUpdatePermissionFeesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdatePermissionFeesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.perm.v1.MsgUpdatePermissionFeesResponse.effective_from":
+		value := x.EffectiveFrom
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgUpdatePermissionFeesResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgUpdatePermissionFeesResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdatePermissionFeesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgUpdatePermissionFeesResponse.effective_from":
+		x.EffectiveFrom = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgUpdatePermissionFeesResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgUpdatePermissionFeesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdatePermissionFeesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgUpdatePermissionFeesResponse.effective_from":
+		if x.EffectiveFrom == nil {
+			x.EffectiveFrom = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.EffectiveFrom.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgUpdatePermissionFeesResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgUpdatePermissionFeesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdatePermissionFeesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.MsgUpdatePermissionFeesResponse.effective_from":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.MsgUpdatePermissionFeesResponse"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.MsgUpdatePermissionFeesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdatePermissionFeesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.MsgUpdatePermissionFeesResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdatePermissionFeesResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdatePermissionFeesResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdatePermissionFeesResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdatePermissionFeesResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdatePermissionFeesResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.EffectiveFrom != nil {
+			l = options.Size(x.EffectiveFrom)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdatePermissionFeesResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.EffectiveFrom != nil {
+			encoded, err := options.Marshal(x.EffectiveFrom)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdatePermissionFeesResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdatePermissionFeesResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdatePermissionFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EffectiveFrom", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EffectiveFrom == nil {
+					x.EffectiveFrom = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EffectiveFrom); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: verana/perm/v1/tx.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the module parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *MsgUpdateParams) Reset() {
+	*x = MsgUpdateParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateParams) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateParams.ProtoReflect.Descriptor instead.
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MsgUpdateParams) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgUpdateParams) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
 }
 
 // MsgUpdateParamsResponse defines the response structure for executing a
@@ -13535,1189 +28686,2636 @@ type MsgUpdateParamsResponse struct {
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgUpdateParamsResponse) Reset() {
-	*x = MsgUpdateParamsResponse{}
+func (x *MsgUpdateParamsResponse) Reset() {
+	*x = MsgUpdateParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateParamsResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateParamsResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{1}
+}
+
+// MsgStartPermissionVP represents a message to start a permission validation process
+type MsgStartPermissionVP struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator         string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Type_           uint32 `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	ValidatorPermId uint64 `protobuf:"varint,3,opt,name=validator_perm_id,json=validatorPermId,proto3" json:"validator_perm_id,omitempty"`
+	// countries must be within the country scope of the validator perm
+	Countries []string `protobuf:"bytes,4,rep,name=countries,proto3" json:"countries,omitempty"`
+	Did       string   `protobuf:"bytes,5,opt,name=did,proto3" json:"did,omitempty"` // optional
+	// co_validator_perm_ids are the other validator perms of the validation
+	// process, required when the credential schema sets a validation quorum
+	CoValidatorPermIds []uint64 `protobuf:"varint,6,rep,packed,name=co_validator_perm_ids,json=coValidatorPermIds,proto3" json:"co_validator_perm_ids,omitempty"`
+}
+
+func (x *MsgStartPermissionVP) Reset() {
+	*x = MsgStartPermissionVP{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgStartPermissionVP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgStartPermissionVP) ProtoMessage() {}
+
+// Deprecated: Use MsgStartPermissionVP.ProtoReflect.Descriptor instead.
+func (*MsgStartPermissionVP) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MsgStartPermissionVP) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgStartPermissionVP) GetType_() uint32 {
+	if x != nil {
+		return x.Type_
+	}
+	return 0
+}
+
+func (x *MsgStartPermissionVP) GetValidatorPermId() uint64 {
+	if x != nil {
+		return x.ValidatorPermId
+	}
+	return 0
+}
+
+func (x *MsgStartPermissionVP) GetCountries() []string {
+	if x != nil {
+		return x.Countries
+	}
+	return nil
+}
+
+func (x *MsgStartPermissionVP) GetDid() string {
+	if x != nil {
+		return x.Did
+	}
+	return ""
+}
+
+func (x *MsgStartPermissionVP) GetCoValidatorPermIds() []uint64 {
+	if x != nil {
+		return x.CoValidatorPermIds
+	}
+	return nil
+}
+
+// MsgStartPermissionVPResponse defines the Msg/StartPermissionVP response type
+type MsgStartPermissionVPResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PermissionId uint64 `protobuf:"varint,1,opt,name=permission_id,json=permissionId,proto3" json:"permission_id,omitempty"`
+}
+
+func (x *MsgStartPermissionVPResponse) Reset() {
+	*x = MsgStartPermissionVPResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgStartPermissionVPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgStartPermissionVPResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgStartPermissionVPResponse.ProtoReflect.Descriptor instead.
+func (*MsgStartPermissionVPResponse) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MsgStartPermissionVPResponse) GetPermissionId() uint64 {
+	if x != nil {
+		return x.PermissionId
+	}
+	return 0
+}
+
+// MsgRenewPermissionVP represents a message to renew a permission validation process
+type MsgRenewPermissionVP struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"` // ID of the permission to renew
+}
+
+func (x *MsgRenewPermissionVP) Reset() {
+	*x = MsgRenewPermissionVP{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRenewPermissionVP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRenewPermissionVP) ProtoMessage() {}
+
+// Deprecated: Use MsgRenewPermissionVP.ProtoReflect.Descriptor instead.
+func (*MsgRenewPermissionVP) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MsgRenewPermissionVP) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgRenewPermissionVP) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// MsgRenewPermissionVPResponse defines the Msg/RenewPermissionVP response type
+type MsgRenewPermissionVPResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgRenewPermissionVPResponse) Reset() {
+	*x = MsgRenewPermissionVPResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRenewPermissionVPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRenewPermissionVPResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgRenewPermissionVPResponse.ProtoReflect.Descriptor instead.
+func (*MsgRenewPermissionVPResponse) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{5}
+}
+
+// MsgSetPermissionVPToValidated represents a message to set a permission validation process to validated state
+type MsgSetPermissionVPToValidated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator            string                 `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id                 uint64                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	EffectiveUntil     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=effective_until,json=effectiveUntil,proto3" json:"effective_until,omitempty"`
+	ValidationFees     uint64                 `protobuf:"varint,4,opt,name=validation_fees,json=validationFees,proto3" json:"validation_fees,omitempty"`
+	IssuanceFees       uint64                 `protobuf:"varint,5,opt,name=issuance_fees,json=issuanceFees,proto3" json:"issuance_fees,omitempty"`
+	VerificationFees   uint64                 `protobuf:"varint,6,opt,name=verification_fees,json=verificationFees,proto3" json:"verification_fees,omitempty"`
+	Countries          []string               `protobuf:"bytes,7,rep,name=countries,proto3" json:"countries,omitempty"`
+	VpSummaryDigestSri string                 `protobuf:"bytes,8,opt,name=vp_summary_digest_sri,json=vpSummaryDigestSri,proto3" json:"vp_summary_digest_sri,omitempty"`
+}
+
+func (x *MsgSetPermissionVPToValidated) Reset() {
+	*x = MsgSetPermissionVPToValidated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSetPermissionVPToValidated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetPermissionVPToValidated) ProtoMessage() {}
+
+// Deprecated: Use MsgSetPermissionVPToValidated.ProtoReflect.Descriptor instead.
+func (*MsgSetPermissionVPToValidated) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MsgSetPermissionVPToValidated) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgSetPermissionVPToValidated) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MsgSetPermissionVPToValidated) GetEffectiveUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveUntil
+	}
+	return nil
+}
+
+func (x *MsgSetPermissionVPToValidated) GetValidationFees() uint64 {
+	if x != nil {
+		return x.ValidationFees
+	}
+	return 0
+}
+
+func (x *MsgSetPermissionVPToValidated) GetIssuanceFees() uint64 {
+	if x != nil {
+		return x.IssuanceFees
+	}
+	return 0
+}
+
+func (x *MsgSetPermissionVPToValidated) GetVerificationFees() uint64 {
+	if x != nil {
+		return x.VerificationFees
+	}
+	return 0
+}
+
+func (x *MsgSetPermissionVPToValidated) GetCountries() []string {
+	if x != nil {
+		return x.Countries
+	}
+	return nil
+}
+
+func (x *MsgSetPermissionVPToValidated) GetVpSummaryDigestSri() string {
+	if x != nil {
+		return x.VpSummaryDigestSri
+	}
+	return ""
+}
+
+// MsgSetPermissionVPToValidatedResponse defines the Msg/SetPermissionVPToValidated response type
+type MsgSetPermissionVPToValidatedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgSetPermissionVPToValidatedResponse) Reset() {
+	*x = MsgSetPermissionVPToValidatedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSetPermissionVPToValidatedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetPermissionVPToValidatedResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgSetPermissionVPToValidatedResponse.ProtoReflect.Descriptor instead.
+func (*MsgSetPermissionVPToValidatedResponse) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{7}
+}
+
+// MsgRequestPermissionVPTermination represents a message to request termination of a permission validation process
+type MsgRequestPermissionVPTermination struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"` // id of the validation process
+}
+
+func (x *MsgRequestPermissionVPTermination) Reset() {
+	*x = MsgRequestPermissionVPTermination{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRequestPermissionVPTermination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRequestPermissionVPTermination) ProtoMessage() {}
+
+// Deprecated: Use MsgRequestPermissionVPTermination.ProtoReflect.Descriptor instead.
+func (*MsgRequestPermissionVPTermination) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MsgRequestPermissionVPTermination) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgRequestPermissionVPTermination) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// MsgRequestPermissionVPTerminationResponse defines the Msg/RequestPermissionVPTermination response type
+type MsgRequestPermissionVPTerminationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgRequestPermissionVPTerminationResponse) Reset() {
+	*x = MsgRequestPermissionVPTerminationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRequestPermissionVPTerminationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRequestPermissionVPTerminationResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgRequestPermissionVPTerminationResponse.ProtoReflect.Descriptor instead.
+func (*MsgRequestPermissionVPTerminationResponse) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{9}
+}
+
+type MsgConfirmPermissionVPTermination struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"` // Permission ID
+}
+
+func (x *MsgConfirmPermissionVPTermination) Reset() {
+	*x = MsgConfirmPermissionVPTermination{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgConfirmPermissionVPTermination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgConfirmPermissionVPTermination) ProtoMessage() {}
+
+// Deprecated: Use MsgConfirmPermissionVPTermination.ProtoReflect.Descriptor instead.
+func (*MsgConfirmPermissionVPTermination) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MsgConfirmPermissionVPTermination) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgConfirmPermissionVPTermination) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type MsgConfirmPermissionVPTerminationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgConfirmPermissionVPTerminationResponse) Reset() {
+	*x = MsgConfirmPermissionVPTerminationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgConfirmPermissionVPTerminationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgConfirmPermissionVPTerminationResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgConfirmPermissionVPTerminationResponse.ProtoReflect.Descriptor instead.
+func (*MsgConfirmPermissionVPTerminationResponse) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{11}
+}
+
+type MsgCancelPermissionVPLastRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"` // Permission ID
+}
+
+func (x *MsgCancelPermissionVPLastRequest) Reset() {
+	*x = MsgCancelPermissionVPLastRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCancelPermissionVPLastRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCancelPermissionVPLastRequest) ProtoMessage() {}
+
+// Deprecated: Use MsgCancelPermissionVPLastRequest.ProtoReflect.Descriptor instead.
+func (*MsgCancelPermissionVPLastRequest) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MsgCancelPermissionVPLastRequest) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgCancelPermissionVPLastRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type MsgCancelPermissionVPLastRequestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgCancelPermissionVPLastRequestResponse) Reset() {
+	*x = MsgCancelPermissionVPLastRequestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCancelPermissionVPLastRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCancelPermissionVPLastRequestResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgCancelPermissionVPLastRequestResponse.ProtoReflect.Descriptor instead.
+func (*MsgCancelPermissionVPLastRequestResponse) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{13}
+}
+
+type MsgCreateRootPermission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator          string                 `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	SchemaId         uint64                 `protobuf:"varint,2,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
+	Did              string                 `protobuf:"bytes,3,opt,name=did,proto3" json:"did,omitempty"`
+	Countries        []string               `protobuf:"bytes,4,rep,name=countries,proto3" json:"countries,omitempty"`
+	EffectiveFrom    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	EffectiveUntil   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=effective_until,json=effectiveUntil,proto3" json:"effective_until,omitempty"`
+	ValidationFees   uint64                 `protobuf:"varint,7,opt,name=validation_fees,json=validationFees,proto3" json:"validation_fees,omitempty"`
+	IssuanceFees     uint64                 `protobuf:"varint,8,opt,name=issuance_fees,json=issuanceFees,proto3" json:"issuance_fees,omitempty"`
+	VerificationFees uint64                 `protobuf:"varint,9,opt,name=verification_fees,json=verificationFees,proto3" json:"verification_fees,omitempty"`
+}
+
+func (x *MsgCreateRootPermission) Reset() {
+	*x = MsgCreateRootPermission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCreateRootPermission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCreateRootPermission) ProtoMessage() {}
+
+// Deprecated: Use MsgCreateRootPermission.ProtoReflect.Descriptor instead.
+func (*MsgCreateRootPermission) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MsgCreateRootPermission) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgCreateRootPermission) GetSchemaId() uint64 {
+	if x != nil {
+		return x.SchemaId
+	}
+	return 0
+}
+
+func (x *MsgCreateRootPermission) GetDid() string {
+	if x != nil {
+		return x.Did
+	}
+	return ""
+}
+
+func (x *MsgCreateRootPermission) GetCountries() []string {
+	if x != nil {
+		return x.Countries
+	}
+	return nil
+}
+
+func (x *MsgCreateRootPermission) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+func (x *MsgCreateRootPermission) GetEffectiveUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveUntil
+	}
+	return nil
+}
+
+func (x *MsgCreateRootPermission) GetValidationFees() uint64 {
+	if x != nil {
+		return x.ValidationFees
+	}
+	return 0
+}
+
+func (x *MsgCreateRootPermission) GetIssuanceFees() uint64 {
+	if x != nil {
+		return x.IssuanceFees
+	}
+	return 0
+}
+
+func (x *MsgCreateRootPermission) GetVerificationFees() uint64 {
+	if x != nil {
+		return x.VerificationFees
+	}
+	return 0
+}
+
+type MsgCreateRootPermissionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the created permission
+}
+
+func (x *MsgCreateRootPermissionResponse) Reset() {
+	*x = MsgCreateRootPermissionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCreateRootPermissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCreateRootPermissionResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgCreateRootPermissionResponse.ProtoReflect.Descriptor instead.
+func (*MsgCreateRootPermissionResponse) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MsgCreateRootPermissionResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type MsgExtendPermission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator        string                 `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id             uint64                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"` // Permission ID
+	EffectiveUntil *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=effective_until,json=effectiveUntil,proto3" json:"effective_until,omitempty"`
+}
+
+func (x *MsgExtendPermission) Reset() {
+	*x = MsgExtendPermission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgExtendPermission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgExtendPermission) ProtoMessage() {}
+
+// Deprecated: Use MsgExtendPermission.ProtoReflect.Descriptor instead.
+func (*MsgExtendPermission) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MsgExtendPermission) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgExtendPermission) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MsgExtendPermission) GetEffectiveUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveUntil
+	}
+	return nil
+}
+
+type MsgExtendPermissionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgExtendPermissionResponse) Reset() {
+	*x = MsgExtendPermissionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgExtendPermissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgExtendPermissionResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgExtendPermissionResponse.ProtoReflect.Descriptor instead.
+func (*MsgExtendPermissionResponse) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{17}
+}
+
+type MsgRevokePermission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"` // Permission ID
+	// cascade, when set, also revokes or suspends every descendant perm
+	Cascade CascadeMode `protobuf:"varint,3,opt,name=cascade,proto3,enum=verana.perm.v1.CascadeMode" json:"cascade,omitempty"`
+}
+
+func (x *MsgRevokePermission) Reset() {
+	*x = MsgRevokePermission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRevokePermission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRevokePermission) ProtoMessage() {}
+
+// Deprecated: Use MsgRevokePermission.ProtoReflect.Descriptor instead.
+func (*MsgRevokePermission) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *MsgRevokePermission) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgRevokePermission) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MsgRevokePermission) GetCascade() CascadeMode {
+	if x != nil {
+		return x.Cascade
+	}
+	return CascadeMode_CASCADE_MODE_NONE
+}
+
+type MsgRevokePermissionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cascaded is the number of descendant perms processed by the transaction
+	Cascaded uint64 `protobuf:"varint,1,opt,name=cascaded,proto3" json:"cascaded,omitempty"`
+	// cascade_pending is set when the cascade continues in the next blocks
+	CascadePending bool `protobuf:"varint,2,opt,name=cascade_pending,json=cascadePending,proto3" json:"cascade_pending,omitempty"`
+}
+
+func (x *MsgRevokePermissionResponse) Reset() {
+	*x = MsgRevokePermissionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRevokePermissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRevokePermissionResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgRevokePermissionResponse.ProtoReflect.Descriptor instead.
+func (*MsgRevokePermissionResponse) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *MsgRevokePermissionResponse) GetCascaded() uint64 {
+	if x != nil {
+		return x.Cascaded
+	}
+	return 0
+}
+
+func (x *MsgRevokePermissionResponse) GetCascadePending() bool {
+	if x != nil {
+		return x.CascadePending
+	}
+	return false
+}
+
+type MsgCreateOrUpdatePermissionSession struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator           string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id                string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"` // UUID
+	IssuerPermId      uint64 `protobuf:"varint,3,opt,name=issuer_perm_id,json=issuerPermId,proto3" json:"issuer_perm_id,omitempty"`
+	VerifierPermId    uint64 `protobuf:"varint,4,opt,name=verifier_perm_id,json=verifierPermId,proto3" json:"verifier_perm_id,omitempty"`
+	AgentPermId       uint64 `protobuf:"varint,5,opt,name=agent_perm_id,json=agentPermId,proto3" json:"agent_perm_id,omitempty"`
+	WalletAgentPermId uint64 `protobuf:"varint,6,opt,name=wallet_agent_perm_id,json=walletAgentPermId,proto3" json:"wallet_agent_perm_id,omitempty"`
+	// allowance_id, if set, is the session allowance the fees are drawn from
+	AllowanceId uint64 `protobuf:"varint,7,opt,name=allowance_id,json=allowanceId,proto3" json:"allowance_id,omitempty"`
+	// expires, if set, is the expiration of a new session. It defaults to, and
+	// cannot be later than, the maximum session duration.
+	Expires *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires,proto3" json:"expires,omitempty"`
+	// credit_id, if set, is the verification credit bundle the session consumes
+	// a credit of instead of paying the fees
+	CreditId uint64 `protobuf:"varint,9,opt,name=credit_id,json=creditId,proto3" json:"credit_id,omitempty"`
+}
+
+func (x *MsgCreateOrUpdatePermissionSession) Reset() {
+	*x = MsgCreateOrUpdatePermissionSession{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCreateOrUpdatePermissionSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCreateOrUpdatePermissionSession) ProtoMessage() {}
+
+// Deprecated: Use MsgCreateOrUpdatePermissionSession.ProtoReflect.Descriptor instead.
+func (*MsgCreateOrUpdatePermissionSession) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MsgCreateOrUpdatePermissionSession) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgCreateOrUpdatePermissionSession) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MsgCreateOrUpdatePermissionSession) GetIssuerPermId() uint64 {
+	if x != nil {
+		return x.IssuerPermId
+	}
+	return 0
+}
+
+func (x *MsgCreateOrUpdatePermissionSession) GetVerifierPermId() uint64 {
+	if x != nil {
+		return x.VerifierPermId
+	}
+	return 0
+}
+
+func (x *MsgCreateOrUpdatePermissionSession) GetAgentPermId() uint64 {
+	if x != nil {
+		return x.AgentPermId
+	}
+	return 0
+}
+
+func (x *MsgCreateOrUpdatePermissionSession) GetWalletAgentPermId() uint64 {
+	if x != nil {
+		return x.WalletAgentPermId
+	}
+	return 0
+}
+
+func (x *MsgCreateOrUpdatePermissionSession) GetAllowanceId() uint64 {
+	if x != nil {
+		return x.AllowanceId
+	}
+	return 0
+}
+
+func (x *MsgCreateOrUpdatePermissionSession) GetExpires() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expires
+	}
+	return nil
+}
+
+func (x *MsgCreateOrUpdatePermissionSession) GetCreditId() uint64 {
+	if x != nil {
+		return x.CreditId
+	}
+	return 0
+}
+
+type MsgCreateOrUpdatePermissionSessionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *MsgCreateOrUpdatePermissionSessionResponse) Reset() {
+	*x = MsgCreateOrUpdatePermissionSessionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCreateOrUpdatePermissionSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCreateOrUpdatePermissionSessionResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgCreateOrUpdatePermissionSessionResponse.ProtoReflect.Descriptor instead.
+func (*MsgCreateOrUpdatePermissionSessionResponse) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MsgCreateOrUpdatePermissionSessionResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type MsgSlashPermissionTrustDeposit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Amount  uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// reason_code identifies the violated rule, as listed by the governance
+	// framework of the trust registry
+	ReasonCode        uint32 `protobuf:"varint,4,opt,name=reason_code,json=reasonCode,proto3" json:"reason_code,omitempty"`
+	EvidenceUrl       string `protobuf:"bytes,5,opt,name=evidence_url,json=evidenceUrl,proto3" json:"evidence_url,omitempty"`
+	EvidenceDigestSri string `protobuf:"bytes,6,opt,name=evidence_digest_sri,json=evidenceDigestSri,proto3" json:"evidence_digest_sri,omitempty"`
+	// gf_version is the governance framework version that was violated,
+	// the active version of the trust registry if 0
+	GfVersion int32 `protobuf:"varint,7,opt,name=gf_version,json=gfVersion,proto3" json:"gf_version,omitempty"`
+	// distribution overrides the slash distribution of the trust registry
+	Distribution []*v1.SlashShare `protobuf:"bytes,8,rep,name=distribution,proto3" json:"distribution,omitempty"`
+}
+
+func (x *MsgSlashPermissionTrustDeposit) Reset() {
+	*x = MsgSlashPermissionTrustDeposit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSlashPermissionTrustDeposit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSlashPermissionTrustDeposit) ProtoMessage() {}
+
+// Deprecated: Use MsgSlashPermissionTrustDeposit.ProtoReflect.Descriptor instead.
+func (*MsgSlashPermissionTrustDeposit) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *MsgSlashPermissionTrustDeposit) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgSlashPermissionTrustDeposit) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MsgSlashPermissionTrustDeposit) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *MsgSlashPermissionTrustDeposit) GetReasonCode() uint32 {
+	if x != nil {
+		return x.ReasonCode
+	}
+	return 0
+}
+
+func (x *MsgSlashPermissionTrustDeposit) GetEvidenceUrl() string {
+	if x != nil {
+		return x.EvidenceUrl
+	}
+	return ""
+}
+
+func (x *MsgSlashPermissionTrustDeposit) GetEvidenceDigestSri() string {
+	if x != nil {
+		return x.EvidenceDigestSri
+	}
+	return ""
+}
+
+func (x *MsgSlashPermissionTrustDeposit) GetGfVersion() int32 {
+	if x != nil {
+		return x.GfVersion
+	}
+	return 0
+}
+
+func (x *MsgSlashPermissionTrustDeposit) GetDistribution() []*v1.SlashShare {
+	if x != nil {
+		return x.Distribution
+	}
+	return nil
+}
+
+type MsgSlashPermissionTrustDepositResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// slash_id is the id of the pending slash record
+	SlashId uint64 `protobuf:"varint,1,opt,name=slash_id,json=slashId,proto3" json:"slash_id,omitempty"`
+}
+
+func (x *MsgSlashPermissionTrustDepositResponse) Reset() {
+	*x = MsgSlashPermissionTrustDepositResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSlashPermissionTrustDepositResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSlashPermissionTrustDepositResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgSlashPermissionTrustDepositResponse.ProtoReflect.Descriptor instead.
+func (*MsgSlashPermissionTrustDepositResponse) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *MsgSlashPermissionTrustDepositResponse) GetSlashId() uint64 {
+	if x != nil {
+		return x.SlashId
+	}
+	return 0
+}
+
+type MsgRepayPermissionSlashedTrustDeposit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *MsgRepayPermissionSlashedTrustDeposit) Reset() {
+	*x = MsgRepayPermissionSlashedTrustDeposit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_perm_v1_tx_proto_msgTypes[1]
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateParamsResponse) String() string {
+func (x *MsgRepayPermissionSlashedTrustDeposit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateParamsResponse) ProtoMessage() {}
+func (*MsgRepayPermissionSlashedTrustDeposit) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateParamsResponse.ProtoReflect.Descriptor instead.
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use MsgRepayPermissionSlashedTrustDeposit.ProtoReflect.Descriptor instead.
+func (*MsgRepayPermissionSlashedTrustDeposit) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{24}
 }
 
-// MsgStartPermissionVP represents a message to start a permission validation process
-type MsgStartPermissionVP struct {
+func (x *MsgRepayPermissionSlashedTrustDeposit) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgRepayPermissionSlashedTrustDeposit) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type MsgRepayPermissionSlashedTrustDepositResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	Creator         string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Type_           uint32 `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
-	ValidatorPermId uint64 `protobuf:"varint,3,opt,name=validator_perm_id,json=validatorPermId,proto3" json:"validator_perm_id,omitempty"`
-	Country         string `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`
-	Did             string `protobuf:"bytes,5,opt,name=did,proto3" json:"did,omitempty"` // optional
+func (x *MsgRepayPermissionSlashedTrustDepositResponse) Reset() {
+	*x = MsgRepayPermissionSlashedTrustDepositResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *MsgStartPermissionVP) Reset() {
-	*x = MsgStartPermissionVP{}
+func (x *MsgRepayPermissionSlashedTrustDepositResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRepayPermissionSlashedTrustDepositResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgRepayPermissionSlashedTrustDepositResponse.ProtoReflect.Descriptor instead.
+func (*MsgRepayPermissionSlashedTrustDepositResponse) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{25}
+}
+
+type MsgCreatePermission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator  string         `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	SchemaId uint64         `protobuf:"varint,2,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
+	Type_    PermissionType `protobuf:"varint,3,opt,name=type,proto3,enum=verana.perm.v1.PermissionType" json:"type,omitempty"`
+	Did      string         `protobuf:"bytes,4,opt,name=did,proto3" json:"did,omitempty"`
+	// countries must be within the country scope of the ecosystem perm
+	Countries        []string               `protobuf:"bytes,5,rep,name=countries,proto3" json:"countries,omitempty"`
+	EffectiveFrom    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	EffectiveUntil   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=effective_until,json=effectiveUntil,proto3" json:"effective_until,omitempty"`
+	VerificationFees uint64                 `protobuf:"varint,8,opt,name=verification_fees,json=verificationFees,proto3" json:"verification_fees,omitempty"`
+}
+
+func (x *MsgCreatePermission) Reset() {
+	*x = MsgCreatePermission{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_perm_v1_tx_proto_msgTypes[2]
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgStartPermissionVP) String() string {
+func (x *MsgCreatePermission) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgStartPermissionVP) ProtoMessage() {}
+func (*MsgCreatePermission) ProtoMessage() {}
 
-// Deprecated: Use MsgStartPermissionVP.ProtoReflect.Descriptor instead.
-func (*MsgStartPermissionVP) Descriptor() ([]byte, []int) {
-	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use MsgCreatePermission.ProtoReflect.Descriptor instead.
+func (*MsgCreatePermission) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *MsgStartPermissionVP) GetCreator() string {
+func (x *MsgCreatePermission) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgStartPermissionVP) GetType_() uint32 {
+func (x *MsgCreatePermission) GetSchemaId() uint64 {
 	if x != nil {
-		return x.Type_
+		return x.SchemaId
 	}
 	return 0
 }
 
-func (x *MsgStartPermissionVP) GetValidatorPermId() uint64 {
+func (x *MsgCreatePermission) GetType_() PermissionType {
 	if x != nil {
-		return x.ValidatorPermId
+		return x.Type_
 	}
-	return 0
+	return PermissionType_PERMISSION_TYPE_UNSPECIFIED
 }
 
-func (x *MsgStartPermissionVP) GetCountry() string {
+func (x *MsgCreatePermission) GetDid() string {
 	if x != nil {
-		return x.Country
+		return x.Did
 	}
 	return ""
 }
 
-func (x *MsgStartPermissionVP) GetDid() string {
+func (x *MsgCreatePermission) GetCountries() []string {
 	if x != nil {
-		return x.Did
+		return x.Countries
 	}
-	return ""
+	return nil
 }
 
-// MsgStartPermissionVPResponse defines the Msg/StartPermissionVP response type
-type MsgStartPermissionVPResponse struct {
+func (x *MsgCreatePermission) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+func (x *MsgCreatePermission) GetEffectiveUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveUntil
+	}
+	return nil
+}
+
+func (x *MsgCreatePermission) GetVerificationFees() uint64 {
+	if x != nil {
+		return x.VerificationFees
+	}
+	return 0
+}
+
+type MsgCreatePermissionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PermissionId uint64 `protobuf:"varint,1,opt,name=permission_id,json=permissionId,proto3" json:"permission_id,omitempty"`
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *MsgStartPermissionVPResponse) Reset() {
-	*x = MsgStartPermissionVPResponse{}
+func (x *MsgCreatePermissionResponse) Reset() {
+	*x = MsgCreatePermissionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_perm_v1_tx_proto_msgTypes[3]
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgStartPermissionVPResponse) String() string {
+func (x *MsgCreatePermissionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgStartPermissionVPResponse) ProtoMessage() {}
+func (*MsgCreatePermissionResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgStartPermissionVPResponse.ProtoReflect.Descriptor instead.
-func (*MsgStartPermissionVPResponse) Descriptor() ([]byte, []int) {
-	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use MsgCreatePermissionResponse.ProtoReflect.Descriptor instead.
+func (*MsgCreatePermissionResponse) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *MsgStartPermissionVPResponse) GetPermissionId() uint64 {
+func (x *MsgCreatePermissionResponse) GetId() uint64 {
 	if x != nil {
-		return x.PermissionId
+		return x.Id
 	}
 	return 0
 }
 
-// MsgRenewPermissionVP represents a message to renew a permission validation process
-type MsgRenewPermissionVP struct {
+// MsgCreateSessionAllowance escrows amount to pay the session fees of the
+// scope it is created for. Exactly one of schema_id, agent_perm_id and
+// verifier_perm_id must be set.
+type MsgCreateSessionAllowance struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"` // ID of the permission to renew
+	Creator        string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	SchemaId       uint64 `protobuf:"varint,2,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
+	AgentPermId    uint64 `protobuf:"varint,3,opt,name=agent_perm_id,json=agentPermId,proto3" json:"agent_perm_id,omitempty"`
+	VerifierPermId uint64 `protobuf:"varint,4,opt,name=verifier_perm_id,json=verifierPermId,proto3" json:"verifier_perm_id,omitempty"`
+	Amount         uint64 `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// allowed_creators restricts the accounts that can create sessions paid by
+	// the allowance, it is required for a schema scope
+	AllowedCreators []string `protobuf:"bytes,6,rep,name=allowed_creators,json=allowedCreators,proto3" json:"allowed_creators,omitempty"`
+	// max_per_session caps the amount drawn for a single session, 0 for no cap
+	MaxPerSession uint64 `protobuf:"varint,7,opt,name=max_per_session,json=maxPerSession,proto3" json:"max_per_session,omitempty"`
 }
 
-func (x *MsgRenewPermissionVP) Reset() {
-	*x = MsgRenewPermissionVP{}
+func (x *MsgCreateSessionAllowance) Reset() {
+	*x = MsgCreateSessionAllowance{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_perm_v1_tx_proto_msgTypes[4]
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRenewPermissionVP) String() string {
+func (x *MsgCreateSessionAllowance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRenewPermissionVP) ProtoMessage() {}
+func (*MsgCreateSessionAllowance) ProtoMessage() {}
 
-// Deprecated: Use MsgRenewPermissionVP.ProtoReflect.Descriptor instead.
-func (*MsgRenewPermissionVP) Descriptor() ([]byte, []int) {
-	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use MsgCreateSessionAllowance.ProtoReflect.Descriptor instead.
+func (*MsgCreateSessionAllowance) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *MsgRenewPermissionVP) GetCreator() string {
+func (x *MsgCreateSessionAllowance) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgRenewPermissionVP) GetId() uint64 {
+func (x *MsgCreateSessionAllowance) GetSchemaId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.SchemaId
 	}
 	return 0
 }
 
-// MsgRenewPermissionVPResponse defines the Msg/RenewPermissionVP response type
-type MsgRenewPermissionVPResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *MsgCreateSessionAllowance) GetAgentPermId() uint64 {
+	if x != nil {
+		return x.AgentPermId
+	}
+	return 0
 }
 
-func (x *MsgRenewPermissionVPResponse) Reset() {
-	*x = MsgRenewPermissionVPResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_perm_v1_tx_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *MsgCreateSessionAllowance) GetVerifierPermId() uint64 {
+	if x != nil {
+		return x.VerifierPermId
 	}
+	return 0
 }
 
-func (x *MsgRenewPermissionVPResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *MsgCreateSessionAllowance) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
 }
 
-func (*MsgRenewPermissionVPResponse) ProtoMessage() {}
+func (x *MsgCreateSessionAllowance) GetAllowedCreators() []string {
+	if x != nil {
+		return x.AllowedCreators
+	}
+	return nil
+}
 
-// Deprecated: Use MsgRenewPermissionVPResponse.ProtoReflect.Descriptor instead.
-func (*MsgRenewPermissionVPResponse) Descriptor() ([]byte, []int) {
-	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{5}
+func (x *MsgCreateSessionAllowance) GetMaxPerSession() uint64 {
+	if x != nil {
+		return x.MaxPerSession
+	}
+	return 0
 }
 
-// MsgSetPermissionVPToValidated represents a message to set a permission validation process to validated state
-type MsgSetPermissionVPToValidated struct {
+type MsgCreateSessionAllowanceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator            string                 `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Id                 uint64                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	EffectiveUntil     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=effective_until,json=effectiveUntil,proto3" json:"effective_until,omitempty"`
-	ValidationFees     uint64                 `protobuf:"varint,4,opt,name=validation_fees,json=validationFees,proto3" json:"validation_fees,omitempty"`
-	IssuanceFees       uint64                 `protobuf:"varint,5,opt,name=issuance_fees,json=issuanceFees,proto3" json:"issuance_fees,omitempty"`
-	VerificationFees   uint64                 `protobuf:"varint,6,opt,name=verification_fees,json=verificationFees,proto3" json:"verification_fees,omitempty"`
-	Country            string                 `protobuf:"bytes,7,opt,name=country,proto3" json:"country,omitempty"`
-	VpSummaryDigestSri string                 `protobuf:"bytes,8,opt,name=vp_summary_digest_sri,json=vpSummaryDigestSri,proto3" json:"vp_summary_digest_sri,omitempty"`
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *MsgSetPermissionVPToValidated) Reset() {
-	*x = MsgSetPermissionVPToValidated{}
+func (x *MsgCreateSessionAllowanceResponse) Reset() {
+	*x = MsgCreateSessionAllowanceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_perm_v1_tx_proto_msgTypes[6]
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSetPermissionVPToValidated) String() string {
+func (x *MsgCreateSessionAllowanceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSetPermissionVPToValidated) ProtoMessage() {}
-
-// Deprecated: Use MsgSetPermissionVPToValidated.ProtoReflect.Descriptor instead.
-func (*MsgSetPermissionVPToValidated) Descriptor() ([]byte, []int) {
-	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{6}
-}
+func (*MsgCreateSessionAllowanceResponse) ProtoMessage() {}
 
-func (x *MsgSetPermissionVPToValidated) GetCreator() string {
-	if x != nil {
-		return x.Creator
-	}
-	return ""
+// Deprecated: Use MsgCreateSessionAllowanceResponse.ProtoReflect.Descriptor instead.
+func (*MsgCreateSessionAllowanceResponse) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *MsgSetPermissionVPToValidated) GetId() uint64 {
+func (x *MsgCreateSessionAllowanceResponse) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *MsgSetPermissionVPToValidated) GetEffectiveUntil() *timestamppb.Timestamp {
-	if x != nil {
-		return x.EffectiveUntil
+// MsgFundSessionAllowance adds amount to an existing session allowance.
+type MsgFundSessionAllowance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Amount  uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *MsgFundSessionAllowance) Reset() {
+	*x = MsgFundSessionAllowance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *MsgSetPermissionVPToValidated) GetValidationFees() uint64 {
-	if x != nil {
-		return x.ValidationFees
-	}
-	return 0
+func (x *MsgFundSessionAllowance) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *MsgSetPermissionVPToValidated) GetIssuanceFees() uint64 {
-	if x != nil {
-		return x.IssuanceFees
-	}
-	return 0
+func (*MsgFundSessionAllowance) ProtoMessage() {}
+
+// Deprecated: Use MsgFundSessionAllowance.ProtoReflect.Descriptor instead.
+func (*MsgFundSessionAllowance) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *MsgSetPermissionVPToValidated) GetVerificationFees() uint64 {
+func (x *MsgFundSessionAllowance) GetCreator() string {
 	if x != nil {
-		return x.VerificationFees
+		return x.Creator
 	}
-	return 0
+	return ""
 }
 
-func (x *MsgSetPermissionVPToValidated) GetCountry() string {
+func (x *MsgFundSessionAllowance) GetId() uint64 {
 	if x != nil {
-		return x.Country
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *MsgSetPermissionVPToValidated) GetVpSummaryDigestSri() string {
+func (x *MsgFundSessionAllowance) GetAmount() uint64 {
 	if x != nil {
-		return x.VpSummaryDigestSri
+		return x.Amount
 	}
-	return ""
+	return 0
 }
 
-// MsgSetPermissionVPToValidatedResponse defines the Msg/SetPermissionVPToValidated response type
-type MsgSetPermissionVPToValidatedResponse struct {
+type MsgFundSessionAllowanceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgSetPermissionVPToValidatedResponse) Reset() {
-	*x = MsgSetPermissionVPToValidatedResponse{}
+func (x *MsgFundSessionAllowanceResponse) Reset() {
+	*x = MsgFundSessionAllowanceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_perm_v1_tx_proto_msgTypes[7]
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSetPermissionVPToValidatedResponse) String() string {
+func (x *MsgFundSessionAllowanceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSetPermissionVPToValidatedResponse) ProtoMessage() {}
+func (*MsgFundSessionAllowanceResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgSetPermissionVPToValidatedResponse.ProtoReflect.Descriptor instead.
-func (*MsgSetPermissionVPToValidatedResponse) Descriptor() ([]byte, []int) {
-	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use MsgFundSessionAllowanceResponse.ProtoReflect.Descriptor instead.
+func (*MsgFundSessionAllowanceResponse) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{31}
 }
 
-// MsgRequestPermissionVPTermination represents a message to request termination of a permission validation process
-type MsgRequestPermissionVPTermination struct {
+// MsgWithdrawSessionAllowance returns amount of the remaining allowance to its
+// sponsor. An amount of 0 withdraws everything that remains.
+type MsgWithdrawSessionAllowance struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"` // id of the validation process
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Amount  uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *MsgRequestPermissionVPTermination) Reset() {
-	*x = MsgRequestPermissionVPTermination{}
+func (x *MsgWithdrawSessionAllowance) Reset() {
+	*x = MsgWithdrawSessionAllowance{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_perm_v1_tx_proto_msgTypes[8]
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRequestPermissionVPTermination) String() string {
+func (x *MsgWithdrawSessionAllowance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRequestPermissionVPTermination) ProtoMessage() {}
+func (*MsgWithdrawSessionAllowance) ProtoMessage() {}
 
-// Deprecated: Use MsgRequestPermissionVPTermination.ProtoReflect.Descriptor instead.
-func (*MsgRequestPermissionVPTermination) Descriptor() ([]byte, []int) {
-	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use MsgWithdrawSessionAllowance.ProtoReflect.Descriptor instead.
+func (*MsgWithdrawSessionAllowance) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *MsgRequestPermissionVPTermination) GetCreator() string {
+func (x *MsgWithdrawSessionAllowance) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgRequestPermissionVPTermination) GetId() uint64 {
+func (x *MsgWithdrawSessionAllowance) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-// MsgRequestPermissionVPTerminationResponse defines the Msg/RequestPermissionVPTermination response type
-type MsgRequestPermissionVPTerminationResponse struct {
+func (x *MsgWithdrawSessionAllowance) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type MsgWithdrawSessionAllowanceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Amount uint64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *MsgRequestPermissionVPTerminationResponse) Reset() {
-	*x = MsgRequestPermissionVPTerminationResponse{}
+func (x *MsgWithdrawSessionAllowanceResponse) Reset() {
+	*x = MsgWithdrawSessionAllowanceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_perm_v1_tx_proto_msgTypes[9]
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRequestPermissionVPTerminationResponse) String() string {
+func (x *MsgWithdrawSessionAllowanceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRequestPermissionVPTerminationResponse) ProtoMessage() {}
+func (*MsgWithdrawSessionAllowanceResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgRequestPermissionVPTerminationResponse.ProtoReflect.Descriptor instead.
-func (*MsgRequestPermissionVPTerminationResponse) Descriptor() ([]byte, []int) {
-	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use MsgWithdrawSessionAllowanceResponse.ProtoReflect.Descriptor instead.
+func (*MsgWithdrawSessionAllowanceResponse) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{33}
 }
 
-type MsgConfirmPermissionVPTermination struct {
+func (x *MsgWithdrawSessionAllowanceResponse) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// MsgAppealSlash lets the grantee of a slashed perm dispute a pending slash
+// before its appeal deadline.
+type MsgAppealSlash struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"` // Permission ID
+	Creator           string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id                uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	EvidenceDigestSri string `protobuf:"bytes,3,opt,name=evidence_digest_sri,json=evidenceDigestSri,proto3" json:"evidence_digest_sri,omitempty"`
 }
 
-func (x *MsgConfirmPermissionVPTermination) Reset() {
-	*x = MsgConfirmPermissionVPTermination{}
+func (x *MsgAppealSlash) Reset() {
+	*x = MsgAppealSlash{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_perm_v1_tx_proto_msgTypes[10]
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgConfirmPermissionVPTermination) String() string {
+func (x *MsgAppealSlash) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgConfirmPermissionVPTermination) ProtoMessage() {}
+func (*MsgAppealSlash) ProtoMessage() {}
 
-// Deprecated: Use MsgConfirmPermissionVPTermination.ProtoReflect.Descriptor instead.
-func (*MsgConfirmPermissionVPTermination) Descriptor() ([]byte, []int) {
-	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use MsgAppealSlash.ProtoReflect.Descriptor instead.
+func (*MsgAppealSlash) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *MsgConfirmPermissionVPTermination) GetCreator() string {
+func (x *MsgAppealSlash) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgConfirmPermissionVPTermination) GetId() uint64 {
+func (x *MsgAppealSlash) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type MsgConfirmPermissionVPTerminationResponse struct {
+func (x *MsgAppealSlash) GetEvidenceDigestSri() string {
+	if x != nil {
+		return x.EvidenceDigestSri
+	}
+	return ""
+}
+
+type MsgAppealSlashResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgConfirmPermissionVPTerminationResponse) Reset() {
-	*x = MsgConfirmPermissionVPTerminationResponse{}
+func (x *MsgAppealSlashResponse) Reset() {
+	*x = MsgAppealSlashResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_perm_v1_tx_proto_msgTypes[11]
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgConfirmPermissionVPTerminationResponse) String() string {
+func (x *MsgAppealSlashResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgConfirmPermissionVPTerminationResponse) ProtoMessage() {}
+func (*MsgAppealSlashResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgConfirmPermissionVPTerminationResponse.ProtoReflect.Descriptor instead.
-func (*MsgConfirmPermissionVPTerminationResponse) Descriptor() ([]byte, []int) {
-	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use MsgAppealSlashResponse.ProtoReflect.Descriptor instead.
+func (*MsgAppealSlashResponse) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{35}
 }
 
-type MsgCancelPermissionVPLastRequest struct {
+// MsgResolveSlashAppeal resolves an appealed slash. It can be executed by the
+// controller of the trust registry of the perm schema or by governance.
+type MsgResolveSlashAppeal struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"` // Permission ID
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// uphold executes the slash when true and reverses it when false
+	Uphold bool `protobuf:"varint,3,opt,name=uphold,proto3" json:"uphold,omitempty"`
 }
 
-func (x *MsgCancelPermissionVPLastRequest) Reset() {
-	*x = MsgCancelPermissionVPLastRequest{}
+func (x *MsgResolveSlashAppeal) Reset() {
+	*x = MsgResolveSlashAppeal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_perm_v1_tx_proto_msgTypes[12]
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCancelPermissionVPLastRequest) String() string {
+func (x *MsgResolveSlashAppeal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCancelPermissionVPLastRequest) ProtoMessage() {}
+func (*MsgResolveSlashAppeal) ProtoMessage() {}
 
-// Deprecated: Use MsgCancelPermissionVPLastRequest.ProtoReflect.Descriptor instead.
-func (*MsgCancelPermissionVPLastRequest) Descriptor() ([]byte, []int) {
-	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use MsgResolveSlashAppeal.ProtoReflect.Descriptor instead.
+func (*MsgResolveSlashAppeal) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *MsgCancelPermissionVPLastRequest) GetCreator() string {
+func (x *MsgResolveSlashAppeal) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgCancelPermissionVPLastRequest) GetId() uint64 {
+func (x *MsgResolveSlashAppeal) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type MsgCancelPermissionVPLastRequestResponse struct {
+func (x *MsgResolveSlashAppeal) GetUphold() bool {
+	if x != nil {
+		return x.Uphold
+	}
+	return false
+}
+
+type MsgResolveSlashAppealResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgCancelPermissionVPLastRequestResponse) Reset() {
-	*x = MsgCancelPermissionVPLastRequestResponse{}
+func (x *MsgResolveSlashAppealResponse) Reset() {
+	*x = MsgResolveSlashAppealResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_perm_v1_tx_proto_msgTypes[13]
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCancelPermissionVPLastRequestResponse) String() string {
+func (x *MsgResolveSlashAppealResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCancelPermissionVPLastRequestResponse) ProtoMessage() {}
+func (*MsgResolveSlashAppealResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgCancelPermissionVPLastRequestResponse.ProtoReflect.Descriptor instead.
-func (*MsgCancelPermissionVPLastRequestResponse) Descriptor() ([]byte, []int) {
-	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use MsgResolveSlashAppealResponse.ProtoReflect.Descriptor instead.
+func (*MsgResolveSlashAppealResponse) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{37}
 }
 
-type MsgCreateRootPermission struct {
+// MsgSetSlashDistribution sets where the slashed deposits of the perms of a
+// trust registry go. It can only be executed by the trust registry controller.
+// An empty distribution restores burning.
+type MsgSetSlashDistribution struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator          string                 `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	SchemaId         uint64                 `protobuf:"varint,2,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
-	Did              string                 `protobuf:"bytes,3,opt,name=did,proto3" json:"did,omitempty"`
-	Country          string                 `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`
-	EffectiveFrom    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
-	EffectiveUntil   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=effective_until,json=effectiveUntil,proto3" json:"effective_until,omitempty"`
-	ValidationFees   uint64                 `protobuf:"varint,7,opt,name=validation_fees,json=validationFees,proto3" json:"validation_fees,omitempty"`
-	IssuanceFees     uint64                 `protobuf:"varint,8,opt,name=issuance_fees,json=issuanceFees,proto3" json:"issuance_fees,omitempty"`
-	VerificationFees uint64                 `protobuf:"varint,9,opt,name=verification_fees,json=verificationFees,proto3" json:"verification_fees,omitempty"`
+	Creator      string           `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TrId         uint64           `protobuf:"varint,2,opt,name=tr_id,json=trId,proto3" json:"tr_id,omitempty"`
+	Distribution []*v1.SlashShare `protobuf:"bytes,3,rep,name=distribution,proto3" json:"distribution,omitempty"`
 }
 
-func (x *MsgCreateRootPermission) Reset() {
-	*x = MsgCreateRootPermission{}
+func (x *MsgSetSlashDistribution) Reset() {
+	*x = MsgSetSlashDistribution{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_perm_v1_tx_proto_msgTypes[14]
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCreateRootPermission) String() string {
+func (x *MsgSetSlashDistribution) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCreateRootPermission) ProtoMessage() {}
+func (*MsgSetSlashDistribution) ProtoMessage() {}
 
-// Deprecated: Use MsgCreateRootPermission.ProtoReflect.Descriptor instead.
-func (*MsgCreateRootPermission) Descriptor() ([]byte, []int) {
-	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use MsgSetSlashDistribution.ProtoReflect.Descriptor instead.
+func (*MsgSetSlashDistribution) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *MsgCreateRootPermission) GetCreator() string {
+func (x *MsgSetSlashDistribution) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgCreateRootPermission) GetSchemaId() uint64 {
+func (x *MsgSetSlashDistribution) GetTrId() uint64 {
 	if x != nil {
-		return x.SchemaId
+		return x.TrId
 	}
 	return 0
 }
 
-func (x *MsgCreateRootPermission) GetDid() string {
+func (x *MsgSetSlashDistribution) GetDistribution() []*v1.SlashShare {
 	if x != nil {
-		return x.Did
+		return x.Distribution
 	}
-	return ""
+	return nil
 }
 
-func (x *MsgCreateRootPermission) GetCountry() string {
-	if x != nil {
-		return x.Country
+type MsgSetSlashDistributionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgSetSlashDistributionResponse) Reset() {
+	*x = MsgSetSlashDistributionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *MsgCreateRootPermission) GetEffectiveFrom() *timestamppb.Timestamp {
-	if x != nil {
-		return x.EffectiveFrom
+func (x *MsgSetSlashDistributionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetSlashDistributionResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgSetSlashDistributionResponse.ProtoReflect.Descriptor instead.
+func (*MsgSetSlashDistributionResponse) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{39}
+}
+
+// MsgSuspendPermission temporarily suspends a perm. A suspended perm is not
+// valid until it is unsuspended or its suspension expires.
+type MsgSuspendPermission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"` // Permission ID
+	Reason  string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// suspended_until, if set, is the time the suspension ends by itself
+	SuspendedUntil *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=suspended_until,json=suspendedUntil,proto3" json:"suspended_until,omitempty"`
+}
+
+func (x *MsgSuspendPermission) Reset() {
+	*x = MsgSuspendPermission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *MsgCreateRootPermission) GetEffectiveUntil() *timestamppb.Timestamp {
+func (x *MsgSuspendPermission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSuspendPermission) ProtoMessage() {}
+
+// Deprecated: Use MsgSuspendPermission.ProtoReflect.Descriptor instead.
+func (*MsgSuspendPermission) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *MsgSuspendPermission) GetCreator() string {
 	if x != nil {
-		return x.EffectiveUntil
+		return x.Creator
 	}
-	return nil
+	return ""
 }
 
-func (x *MsgCreateRootPermission) GetValidationFees() uint64 {
+func (x *MsgSuspendPermission) GetId() uint64 {
 	if x != nil {
-		return x.ValidationFees
+		return x.Id
 	}
 	return 0
 }
 
-func (x *MsgCreateRootPermission) GetIssuanceFees() uint64 {
+func (x *MsgSuspendPermission) GetReason() string {
 	if x != nil {
-		return x.IssuanceFees
+		return x.Reason
 	}
-	return 0
+	return ""
 }
 
-func (x *MsgCreateRootPermission) GetVerificationFees() uint64 {
+func (x *MsgSuspendPermission) GetSuspendedUntil() *timestamppb.Timestamp {
 	if x != nil {
-		return x.VerificationFees
+		return x.SuspendedUntil
 	}
-	return 0
+	return nil
 }
 
-type MsgCreateRootPermissionResponse struct {
+type MsgSuspendPermissionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the created permission
 }
 
-func (x *MsgCreateRootPermissionResponse) Reset() {
-	*x = MsgCreateRootPermissionResponse{}
+func (x *MsgSuspendPermissionResponse) Reset() {
+	*x = MsgSuspendPermissionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_perm_v1_tx_proto_msgTypes[15]
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCreateRootPermissionResponse) String() string {
+func (x *MsgSuspendPermissionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCreateRootPermissionResponse) ProtoMessage() {}
-
-// Deprecated: Use MsgCreateRootPermissionResponse.ProtoReflect.Descriptor instead.
-func (*MsgCreateRootPermissionResponse) Descriptor() ([]byte, []int) {
-	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{15}
-}
+func (*MsgSuspendPermissionResponse) ProtoMessage() {}
 
-func (x *MsgCreateRootPermissionResponse) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use MsgSuspendPermissionResponse.ProtoReflect.Descriptor instead.
+func (*MsgSuspendPermissionResponse) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{41}
 }
 
-type MsgExtendPermission struct {
+type MsgUnsuspendPermission struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator        string                 `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Id             uint64                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"` // Permission ID
-	EffectiveUntil *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=effective_until,json=effectiveUntil,proto3" json:"effective_until,omitempty"`
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"` // Permission ID
 }
 
-func (x *MsgExtendPermission) Reset() {
-	*x = MsgExtendPermission{}
+func (x *MsgUnsuspendPermission) Reset() {
+	*x = MsgUnsuspendPermission{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_perm_v1_tx_proto_msgTypes[16]
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgExtendPermission) String() string {
+func (x *MsgUnsuspendPermission) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgExtendPermission) ProtoMessage() {}
+func (*MsgUnsuspendPermission) ProtoMessage() {}
 
-// Deprecated: Use MsgExtendPermission.ProtoReflect.Descriptor instead.
-func (*MsgExtendPermission) Descriptor() ([]byte, []int) {
-	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use MsgUnsuspendPermission.ProtoReflect.Descriptor instead.
+func (*MsgUnsuspendPermission) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *MsgExtendPermission) GetCreator() string {
+func (x *MsgUnsuspendPermission) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgExtendPermission) GetId() uint64 {
+func (x *MsgUnsuspendPermission) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *MsgExtendPermission) GetEffectiveUntil() *timestamppb.Timestamp {
-	if x != nil {
-		return x.EffectiveUntil
-	}
-	return nil
-}
-
-type MsgExtendPermissionResponse struct {
+type MsgUnsuspendPermissionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgExtendPermissionResponse) Reset() {
-	*x = MsgExtendPermissionResponse{}
+func (x *MsgUnsuspendPermissionResponse) Reset() {
+	*x = MsgUnsuspendPermissionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_perm_v1_tx_proto_msgTypes[17]
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgExtendPermissionResponse) String() string {
+func (x *MsgUnsuspendPermissionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
-
-func (*MsgExtendPermissionResponse) ProtoMessage() {}
-
-// Deprecated: Use MsgExtendPermissionResponse.ProtoReflect.Descriptor instead.
-func (*MsgExtendPermissionResponse) Descriptor() ([]byte, []int) {
-	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{17}
+
+func (*MsgUnsuspendPermissionResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgUnsuspendPermissionResponse.ProtoReflect.Descriptor instead.
+func (*MsgUnsuspendPermissionResponse) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{43}
 }
 
-type MsgRevokePermission struct {
+type MsgClosePermissionSession struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"` // Permission ID
+	Id      string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"` // Session UUID
 }
 
-func (x *MsgRevokePermission) Reset() {
-	*x = MsgRevokePermission{}
+func (x *MsgClosePermissionSession) Reset() {
+	*x = MsgClosePermissionSession{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_perm_v1_tx_proto_msgTypes[18]
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRevokePermission) String() string {
+func (x *MsgClosePermissionSession) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRevokePermission) ProtoMessage() {}
+func (*MsgClosePermissionSession) ProtoMessage() {}
 
-// Deprecated: Use MsgRevokePermission.ProtoReflect.Descriptor instead.
-func (*MsgRevokePermission) Descriptor() ([]byte, []int) {
-	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use MsgClosePermissionSession.ProtoReflect.Descriptor instead.
+func (*MsgClosePermissionSession) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *MsgRevokePermission) GetCreator() string {
+func (x *MsgClosePermissionSession) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgRevokePermission) GetId() uint64 {
+func (x *MsgClosePermissionSession) GetId() string {
 	if x != nil {
 		return x.Id
 	}
-	return 0
+	return ""
 }
 
-type MsgRevokePermissionResponse struct {
+type MsgClosePermissionSessionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgRevokePermissionResponse) Reset() {
-	*x = MsgRevokePermissionResponse{}
+func (x *MsgClosePermissionSessionResponse) Reset() {
+	*x = MsgClosePermissionSessionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_perm_v1_tx_proto_msgTypes[19]
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRevokePermissionResponse) String() string {
+func (x *MsgClosePermissionSessionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRevokePermissionResponse) ProtoMessage() {}
+func (*MsgClosePermissionSessionResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgRevokePermissionResponse.ProtoReflect.Descriptor instead.
-func (*MsgRevokePermissionResponse) Descriptor() ([]byte, []int) {
-	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use MsgClosePermissionSessionResponse.ProtoReflect.Descriptor instead.
+func (*MsgClosePermissionSessionResponse) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{45}
 }
 
-type MsgCreateOrUpdatePermissionSession struct {
+// MsgPurchaseVerificationCredits buys count verification sessions of the
+// verifier perm, with the issuer perm if set. The session fees of all credits
+// are paid at once: the beneficiary fees are distributed at purchase and are
+// not refunded for unused credits, only the buyer trust deposit funded for
+// unused credits is released by MsgRefundVerificationCredits.
+type MsgPurchaseVerificationCredits struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator           string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Id                string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"` // UUID
-	IssuerPermId      uint64 `protobuf:"varint,3,opt,name=issuer_perm_id,json=issuerPermId,proto3" json:"issuer_perm_id,omitempty"`
-	VerifierPermId    uint64 `protobuf:"varint,4,opt,name=verifier_perm_id,json=verifierPermId,proto3" json:"verifier_perm_id,omitempty"`
-	AgentPermId       uint64 `protobuf:"varint,5,opt,name=agent_perm_id,json=agentPermId,proto3" json:"agent_perm_id,omitempty"`
-	WalletAgentPermId uint64 `protobuf:"varint,6,opt,name=wallet_agent_perm_id,json=walletAgentPermId,proto3" json:"wallet_agent_perm_id,omitempty"`
+	Creator        string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	VerifierPermId uint64 `protobuf:"varint,2,opt,name=verifier_perm_id,json=verifierPermId,proto3" json:"verifier_perm_id,omitempty"`
+	IssuerPermId   uint64 `protobuf:"varint,3,opt,name=issuer_perm_id,json=issuerPermId,proto3" json:"issuer_perm_id,omitempty"`
+	Count          uint64 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *MsgCreateOrUpdatePermissionSession) Reset() {
-	*x = MsgCreateOrUpdatePermissionSession{}
+func (x *MsgPurchaseVerificationCredits) Reset() {
+	*x = MsgPurchaseVerificationCredits{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_perm_v1_tx_proto_msgTypes[20]
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCreateOrUpdatePermissionSession) String() string {
+func (x *MsgPurchaseVerificationCredits) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCreateOrUpdatePermissionSession) ProtoMessage() {}
+func (*MsgPurchaseVerificationCredits) ProtoMessage() {}
 
-// Deprecated: Use MsgCreateOrUpdatePermissionSession.ProtoReflect.Descriptor instead.
-func (*MsgCreateOrUpdatePermissionSession) Descriptor() ([]byte, []int) {
-	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use MsgPurchaseVerificationCredits.ProtoReflect.Descriptor instead.
+func (*MsgPurchaseVerificationCredits) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *MsgCreateOrUpdatePermissionSession) GetCreator() string {
+func (x *MsgPurchaseVerificationCredits) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgCreateOrUpdatePermissionSession) GetId() string {
+func (x *MsgPurchaseVerificationCredits) GetVerifierPermId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.VerifierPermId
 	}
-	return ""
+	return 0
 }
 
-func (x *MsgCreateOrUpdatePermissionSession) GetIssuerPermId() uint64 {
+func (x *MsgPurchaseVerificationCredits) GetIssuerPermId() uint64 {
 	if x != nil {
 		return x.IssuerPermId
 	}
 	return 0
 }
 
-func (x *MsgCreateOrUpdatePermissionSession) GetVerifierPermId() uint64 {
+func (x *MsgPurchaseVerificationCredits) GetCount() uint64 {
 	if x != nil {
-		return x.VerifierPermId
+		return x.Count
 	}
 	return 0
 }
 
-func (x *MsgCreateOrUpdatePermissionSession) GetAgentPermId() uint64 {
+type MsgPurchaseVerificationCreditsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// cost is the amount, in uvna, paid for the credits, fees plus
+	// trust_deposit
+	Cost uint64 `protobuf:"varint,2,opt,name=cost,proto3" json:"cost,omitempty"`
+	// fees is the amount, in uvna, distributed to the beneficiaries. It is not
+	// refunded for unused credits.
+	Fees uint64 `protobuf:"varint,3,opt,name=fees,proto3" json:"fees,omitempty"`
+	// trust_deposit is the amount, in uvna, added to the buyer trust deposit.
+	// The part funded for unused credits is released on refund.
+	TrustDeposit uint64 `protobuf:"varint,4,opt,name=trust_deposit,json=trustDeposit,proto3" json:"trust_deposit,omitempty"`
+}
+
+func (x *MsgPurchaseVerificationCreditsResponse) Reset() {
+	*x = MsgPurchaseVerificationCreditsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgPurchaseVerificationCreditsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgPurchaseVerificationCreditsResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgPurchaseVerificationCreditsResponse.ProtoReflect.Descriptor instead.
+func (*MsgPurchaseVerificationCreditsResponse) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *MsgPurchaseVerificationCreditsResponse) GetId() uint64 {
 	if x != nil {
-		return x.AgentPermId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *MsgCreateOrUpdatePermissionSession) GetWalletAgentPermId() uint64 {
+func (x *MsgPurchaseVerificationCreditsResponse) GetCost() uint64 {
 	if x != nil {
-		return x.WalletAgentPermId
+		return x.Cost
 	}
 	return 0
 }
 
-type MsgCreateOrUpdatePermissionSessionResponse struct {
+func (x *MsgPurchaseVerificationCreditsResponse) GetFees() uint64 {
+	if x != nil {
+		return x.Fees
+	}
+	return 0
+}
+
+func (x *MsgPurchaseVerificationCreditsResponse) GetTrustDeposit() uint64 {
+	if x != nil {
+		return x.TrustDeposit
+	}
+	return 0
+}
+
+// MsgRefundVerificationCredits closes a verification credit bundle. The fees
+// of the unused credits are not refunded: they were distributed to the
+// beneficiaries at purchase and stay with them. Only the buyer trust deposit
+// funded for the unused credits is released and becomes claimable.
+type MsgRefundVerificationCredits struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *MsgCreateOrUpdatePermissionSessionResponse) Reset() {
-	*x = MsgCreateOrUpdatePermissionSessionResponse{}
+func (x *MsgRefundVerificationCredits) Reset() {
+	*x = MsgRefundVerificationCredits{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_perm_v1_tx_proto_msgTypes[21]
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCreateOrUpdatePermissionSessionResponse) String() string {
+func (x *MsgRefundVerificationCredits) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCreateOrUpdatePermissionSessionResponse) ProtoMessage() {}
+func (*MsgRefundVerificationCredits) ProtoMessage() {}
 
-// Deprecated: Use MsgCreateOrUpdatePermissionSessionResponse.ProtoReflect.Descriptor instead.
-func (*MsgCreateOrUpdatePermissionSessionResponse) Descriptor() ([]byte, []int) {
-	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use MsgRefundVerificationCredits.ProtoReflect.Descriptor instead.
+func (*MsgRefundVerificationCredits) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *MsgCreateOrUpdatePermissionSessionResponse) GetId() string {
+func (x *MsgRefundVerificationCredits) GetCreator() string {
 	if x != nil {
-		return x.Id
+		return x.Creator
 	}
 	return ""
 }
 
-type MsgSlashPermissionTrustDeposit struct {
+func (x *MsgRefundVerificationCredits) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type MsgRefundVerificationCreditsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	Amount  uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	RefundedCredits uint64 `protobuf:"varint,1,opt,name=refunded_credits,json=refundedCredits,proto3" json:"refunded_credits,omitempty"`
+	// released_trust_deposit is the amount, in uvna, of buyer trust deposit
+	// made claimable
+	ReleasedTrustDeposit uint64 `protobuf:"varint,2,opt,name=released_trust_deposit,json=releasedTrustDeposit,proto3" json:"released_trust_deposit,omitempty"`
 }
 
-func (x *MsgSlashPermissionTrustDeposit) Reset() {
-	*x = MsgSlashPermissionTrustDeposit{}
+func (x *MsgRefundVerificationCreditsResponse) Reset() {
+	*x = MsgRefundVerificationCreditsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_perm_v1_tx_proto_msgTypes[22]
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSlashPermissionTrustDeposit) String() string {
+func (x *MsgRefundVerificationCreditsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSlashPermissionTrustDeposit) ProtoMessage() {}
-
-// Deprecated: Use MsgSlashPermissionTrustDeposit.ProtoReflect.Descriptor instead.
-func (*MsgSlashPermissionTrustDeposit) Descriptor() ([]byte, []int) {
-	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{22}
-}
+func (*MsgRefundVerificationCreditsResponse) ProtoMessage() {}
 
-func (x *MsgSlashPermissionTrustDeposit) GetCreator() string {
-	if x != nil {
-		return x.Creator
-	}
-	return ""
+// Deprecated: Use MsgRefundVerificationCreditsResponse.ProtoReflect.Descriptor instead.
+func (*MsgRefundVerificationCreditsResponse) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *MsgSlashPermissionTrustDeposit) GetId() uint64 {
+func (x *MsgRefundVerificationCreditsResponse) GetRefundedCredits() uint64 {
 	if x != nil {
-		return x.Id
+		return x.RefundedCredits
 	}
 	return 0
 }
 
-func (x *MsgSlashPermissionTrustDeposit) GetAmount() uint64 {
+func (x *MsgRefundVerificationCreditsResponse) GetReleasedTrustDeposit() uint64 {
 	if x != nil {
-		return x.Amount
+		return x.ReleasedTrustDeposit
 	}
 	return 0
 }
 
-type MsgSlashPermissionTrustDepositResponse struct {
+// SignedSessionVoucher is a session voucher with the signature of its signer.
+type SignedSessionVoucher struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Voucher *SessionVoucher `protobuf:"bytes,1,opt,name=voucher,proto3" json:"voucher,omitempty"`
+	// pub_key is the compressed secp256k1 public key of the signer
+	PubKey []byte `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// signature is the signature of the SessionVoucherSignDoc of the voucher
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (x *MsgSlashPermissionTrustDepositResponse) Reset() {
-	*x = MsgSlashPermissionTrustDepositResponse{}
+func (x *SignedSessionVoucher) Reset() {
+	*x = SignedSessionVoucher{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_perm_v1_tx_proto_msgTypes[23]
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSlashPermissionTrustDepositResponse) String() string {
+func (x *SignedSessionVoucher) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSlashPermissionTrustDepositResponse) ProtoMessage() {}
+func (*SignedSessionVoucher) ProtoMessage() {}
 
-// Deprecated: Use MsgSlashPermissionTrustDepositResponse.ProtoReflect.Descriptor instead.
-func (*MsgSlashPermissionTrustDepositResponse) Descriptor() ([]byte, []int) {
-	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use SignedSessionVoucher.ProtoReflect.Descriptor instead.
+func (*SignedSessionVoucher) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{50}
 }
 
-type MsgRepayPermissionSlashedTrustDeposit struct {
+func (x *SignedSessionVoucher) GetVoucher() *SessionVoucher {
+	if x != nil {
+		return x.Voucher
+	}
+	return nil
+}
+
+func (x *SignedSessionVoucher) GetPubKey() []byte {
+	if x != nil {
+		return x.PubKey
+	}
+	return nil
+}
+
+func (x *SignedSessionVoucher) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// MsgSettleSessionVouchers settles a batch of signed session vouchers. The
+// session fees are distributed per signer and perms in aggregate.
+type MsgSettleSessionVouchers struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Creator  string                  `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Vouchers []*SignedSessionVoucher `protobuf:"bytes,2,rep,name=vouchers,proto3" json:"vouchers,omitempty"`
 }
 
-func (x *MsgRepayPermissionSlashedTrustDeposit) Reset() {
-	*x = MsgRepayPermissionSlashedTrustDeposit{}
+func (x *MsgSettleSessionVouchers) Reset() {
+	*x = MsgSettleSessionVouchers{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_perm_v1_tx_proto_msgTypes[24]
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRepayPermissionSlashedTrustDeposit) String() string {
+func (x *MsgSettleSessionVouchers) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRepayPermissionSlashedTrustDeposit) ProtoMessage() {}
+func (*MsgSettleSessionVouchers) ProtoMessage() {}
 
-// Deprecated: Use MsgRepayPermissionSlashedTrustDeposit.ProtoReflect.Descriptor instead.
-func (*MsgRepayPermissionSlashedTrustDeposit) Descriptor() ([]byte, []int) {
-	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use MsgSettleSessionVouchers.ProtoReflect.Descriptor instead.
+func (*MsgSettleSessionVouchers) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *MsgRepayPermissionSlashedTrustDeposit) GetCreator() string {
+func (x *MsgSettleSessionVouchers) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgRepayPermissionSlashedTrustDeposit) GetId() uint64 {
+func (x *MsgSettleSessionVouchers) GetVouchers() []*SignedSessionVoucher {
 	if x != nil {
-		return x.Id
+		return x.Vouchers
 	}
-	return 0
+	return nil
 }
 
-type MsgRepayPermissionSlashedTrustDepositResponse struct {
+type MsgSettleSessionVouchersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Settled uint64 `protobuf:"varint,1,opt,name=settled,proto3" json:"settled,omitempty"`
+	// total is the amount, in uvna, paid by the signers of the vouchers
+	Total uint64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 }
 
-func (x *MsgRepayPermissionSlashedTrustDepositResponse) Reset() {
-	*x = MsgRepayPermissionSlashedTrustDepositResponse{}
+func (x *MsgSettleSessionVouchersResponse) Reset() {
+	*x = MsgSettleSessionVouchersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_perm_v1_tx_proto_msgTypes[25]
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRepayPermissionSlashedTrustDepositResponse) String() string {
+func (x *MsgSettleSessionVouchersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRepayPermissionSlashedTrustDepositResponse) ProtoMessage() {}
+func (*MsgSettleSessionVouchersResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgRepayPermissionSlashedTrustDepositResponse.ProtoReflect.Descriptor instead.
-func (*MsgRepayPermissionSlashedTrustDepositResponse) Descriptor() ([]byte, []int) {
-	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use MsgSettleSessionVouchersResponse.ProtoReflect.Descriptor instead.
+func (*MsgSettleSessionVouchersResponse) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{52}
 }
 
-type MsgCreatePermission struct {
+func (x *MsgSettleSessionVouchersResponse) GetSettled() uint64 {
+	if x != nil {
+		return x.Settled
+	}
+	return 0
+}
+
+func (x *MsgSettleSessionVouchersResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// MsgAnchorVPMessage anchors a message of the validation process of a perm.
+// The message is exchanged off-chain and encrypted, only its digest and
+// location are recorded.
+type MsgAnchorVPMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator          string                 `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	SchemaId         uint64                 `protobuf:"varint,2,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
-	Type_            PermissionType         `protobuf:"varint,3,opt,name=type,proto3,enum=verana.perm.v1.PermissionType" json:"type,omitempty"`
-	Did              string                 `protobuf:"bytes,4,opt,name=did,proto3" json:"did,omitempty"`
-	Country          string                 `protobuf:"bytes,5,opt,name=country,proto3" json:"country,omitempty"`
-	EffectiveFrom    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
-	EffectiveUntil   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=effective_until,json=effectiveUntil,proto3" json:"effective_until,omitempty"`
-	VerificationFees uint64                 `protobuf:"varint,8,opt,name=verification_fees,json=verificationFees,proto3" json:"verification_fees,omitempty"`
+	// creator must be the applicant or the validator grantee
+	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	PermId       uint64 `protobuf:"varint,2,opt,name=perm_id,json=permId,proto3" json:"perm_id,omitempty"`
+	DigestSri    string `protobuf:"bytes,3,opt,name=digest_sri,json=digestSri,proto3" json:"digest_sri,omitempty"`
+	EncryptedUri string `protobuf:"bytes,4,opt,name=encrypted_uri,json=encryptedUri,proto3" json:"encrypted_uri,omitempty"`
 }
 
-func (x *MsgCreatePermission) Reset() {
-	*x = MsgCreatePermission{}
+func (x *MsgAnchorVPMessage) Reset() {
+	*x = MsgAnchorVPMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_perm_v1_tx_proto_msgTypes[26]
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCreatePermission) String() string {
+func (x *MsgAnchorVPMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCreatePermission) ProtoMessage() {}
+func (*MsgAnchorVPMessage) ProtoMessage() {}
 
-// Deprecated: Use MsgCreatePermission.ProtoReflect.Descriptor instead.
-func (*MsgCreatePermission) Descriptor() ([]byte, []int) {
-	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use MsgAnchorVPMessage.ProtoReflect.Descriptor instead.
+func (*MsgAnchorVPMessage) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *MsgCreatePermission) GetCreator() string {
+func (x *MsgAnchorVPMessage) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgCreatePermission) GetSchemaId() uint64 {
+func (x *MsgAnchorVPMessage) GetPermId() uint64 {
 	if x != nil {
-		return x.SchemaId
+		return x.PermId
 	}
 	return 0
 }
 
-func (x *MsgCreatePermission) GetType_() PermissionType {
+func (x *MsgAnchorVPMessage) GetDigestSri() string {
 	if x != nil {
-		return x.Type_
+		return x.DigestSri
 	}
-	return PermissionType_PERMISSION_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *MsgCreatePermission) GetDid() string {
+func (x *MsgAnchorVPMessage) GetEncryptedUri() string {
 	if x != nil {
-		return x.Did
+		return x.EncryptedUri
+	}
+	return ""
+}
+
+type MsgAnchorVPMessageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *MsgAnchorVPMessageResponse) Reset() {
+	*x = MsgAnchorVPMessageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgAnchorVPMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgAnchorVPMessageResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgAnchorVPMessageResponse.ProtoReflect.Descriptor instead.
+func (*MsgAnchorVPMessageResponse) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *MsgAnchorVPMessageResponse) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+// MsgUpdatePermissionFees schedules new fees for a perm. The fees take effect
+// after the fee update notice period, a pending update is replaced.
+type MsgUpdatePermissionFees struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// creator must be the grantee of the perm
+	Creator          string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id               uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	ValidationFees   uint64 `protobuf:"varint,3,opt,name=validation_fees,json=validationFees,proto3" json:"validation_fees,omitempty"`
+	IssuanceFees     uint64 `protobuf:"varint,4,opt,name=issuance_fees,json=issuanceFees,proto3" json:"issuance_fees,omitempty"`
+	VerificationFees uint64 `protobuf:"varint,5,opt,name=verification_fees,json=verificationFees,proto3" json:"verification_fees,omitempty"`
+}
+
+func (x *MsgUpdatePermissionFees) Reset() {
+	*x = MsgUpdatePermissionFees{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdatePermissionFees) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdatePermissionFees) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdatePermissionFees.ProtoReflect.Descriptor instead.
+func (*MsgUpdatePermissionFees) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *MsgUpdatePermissionFees) GetCreator() string {
+	if x != nil {
+		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgCreatePermission) GetCountry() string {
+func (x *MsgUpdatePermissionFees) GetId() uint64 {
 	if x != nil {
-		return x.Country
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *MsgCreatePermission) GetEffectiveFrom() *timestamppb.Timestamp {
+func (x *MsgUpdatePermissionFees) GetValidationFees() uint64 {
 	if x != nil {
-		return x.EffectiveFrom
+		return x.ValidationFees
 	}
-	return nil
+	return 0
 }
 
-func (x *MsgCreatePermission) GetEffectiveUntil() *timestamppb.Timestamp {
+func (x *MsgUpdatePermissionFees) GetIssuanceFees() uint64 {
 	if x != nil {
-		return x.EffectiveUntil
+		return x.IssuanceFees
 	}
-	return nil
+	return 0
 }
 
-func (x *MsgCreatePermission) GetVerificationFees() uint64 {
+func (x *MsgUpdatePermissionFees) GetVerificationFees() uint64 {
 	if x != nil {
 		return x.VerificationFees
 	}
 	return 0
 }
 
-type MsgCreatePermissionResponse struct {
+type MsgUpdatePermissionFeesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// effective_from is the time the new fees apply from
+	EffectiveFrom *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
 }
 
-func (x *MsgCreatePermissionResponse) Reset() {
-	*x = MsgCreatePermissionResponse{}
+func (x *MsgUpdatePermissionFeesResponse) Reset() {
+	*x = MsgUpdatePermissionFeesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_perm_v1_tx_proto_msgTypes[27]
+		mi := &file_verana_perm_v1_tx_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCreatePermissionResponse) String() string {
+func (x *MsgUpdatePermissionFeesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCreatePermissionResponse) ProtoMessage() {}
+func (*MsgUpdatePermissionFeesResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgCreatePermissionResponse.ProtoReflect.Descriptor instead.
-func (*MsgCreatePermissionResponse) Descriptor() ([]byte, []int) {
-	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use MsgUpdatePermissionFeesResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdatePermissionFeesResponse) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_tx_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *MsgCreatePermissionResponse) GetId() uint64 {
+func (x *MsgUpdatePermissionFeesResponse) GetEffectiveFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Id
+		return x.EffectiveFrom
 	}
-	return 0
+	return nil
 }
 
 var File_verana_perm_v1_tx_proto protoreflect.FileDescriptor
@@ -14737,351 +31335,701 @@ var file_verana_perm_v1_tx_proto_rawDesc = []byte{
 	0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
 	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0xbc, 0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x06,
-	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76,
-	0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
-	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x3a, 0x36, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x23, 0x76, 0x65, 0x72, 0x61,
-	0x6e, 0x61, 0x2f, 0x78, 0x2f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2f,
-	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22,
-	0x19, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xca, 0x01, 0x0a, 0x14, 0x4d,
-	0x73, 0x67, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x56, 0x50, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x5f, 0x69, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x50, 0x65, 0x72, 0x6d, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x16, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04,
-	0xc8, 0xde, 0x1f, 0x01, 0x52, 0x03, 0x64, 0x69, 0x64, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x43, 0x0a, 0x1c, 0x4d, 0x73, 0x67, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x50, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x65, 0x72, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
-	0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x68, 0x0a, 0x14,
-	0x4d, 0x73, 0x67, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x56, 0x50, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x1a, 0x18, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x74, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbc, 0x01, 0x0a, 0x0f, 0x4d,
+	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x36,
+	0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e,
+	0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09,
+	0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x3a, 0x36, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x23, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x78, 0x2f, 0x70,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73, 0x67,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x81, 0x02, 0x0a, 0x14, 0x4d, 0x73, 0x67, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x50, 0x12, 0x32, 0x0a,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x49,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x16, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x01, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x31, 0x0a, 0x15, 0x63, 0x6f, 0x5f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x04, 0x52, 0x12, 0x63, 0x6f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x49, 0x64, 0x73, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x43, 0x0a, 0x1c, 0x4d, 0x73, 0x67, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x50,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x68, 0x0a,
+	0x14, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x56, 0x50, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x1e, 0x0a, 0x1c, 0x4d, 0x73, 0x67, 0x52, 0x65,
+	0x6e, 0x65, 0x77, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x50, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8c, 0x03, 0x0a, 0x1d, 0x4d, 0x73, 0x67, 0x53,
+	0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x50, 0x54, 0x6f,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4d, 0x0a,
+	0x0f, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x01, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0e, 0x65, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x27, 0x0a, 0x0f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x46, 0x65, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63,
+	0x65, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x69, 0x73,
+	0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x65, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x76, 0x70, 0x5f, 0x73, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x72, 0x69, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x76, 0x70, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x44,
+	0x69, 0x67, 0x65, 0x73, 0x74, 0x53, 0x72, 0x69, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x27, 0x0a, 0x25, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74,
+	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x50, 0x54, 0x6f, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x75, 0x0a, 0x21, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x50, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
 	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x1e, 0x0a, 0x1c, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6e,
-	0x65, 0x77, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x50, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x88, 0x03, 0x0a, 0x1d, 0x4d, 0x73, 0x67, 0x53, 0x65,
-	0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x50, 0x54, 0x6f, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x2b, 0x0a, 0x29, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x50,
+	0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x75, 0x0a, 0x21, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x50, 0x54, 0x65, 0x72,
+	0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61,
 	0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
 	0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4d, 0x0a, 0x0f,
-	0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x01, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0e, 0x65, 0x66, 0x66,
-	0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x46, 0x65, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65,
-	0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x69, 0x73, 0x73,
-	0x75, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x65, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x65, 0x72,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72,
-	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x31, 0x0a, 0x15, 0x76, 0x70, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x64,
-	0x69, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x72, 0x69, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x12, 0x76, 0x70, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74,
-	0x53, 0x72, 0x69, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
-	0x72, 0x22, 0x27, 0x0a, 0x25, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x50, 0x54, 0x6f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x75, 0x0a, 0x21, 0x4d, 0x73,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x56, 0x50, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x02, 0x69, 0x64, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
-	0x72, 0x22, 0x2b, 0x0a, 0x29, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50,
-	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x50, 0x54, 0x65, 0x72, 0x6d, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x75,
-	0x0a, 0x21, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x65, 0x72, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x50, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x2b, 0x0a, 0x29, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x72, 0x6d, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x50, 0x54,
-	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x74, 0x0a, 0x20, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50,
-	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x50, 0x4c, 0x61, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x3a, 0x0c, 0x82, 0xe7,
+	0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x2b, 0x0a, 0x29, 0x4d, 0x73,
+	0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x56, 0x50, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x74, 0x0a, 0x20, 0x4d, 0x73, 0x67, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x50,
+	0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x3a,
+	0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x2a, 0x0a,
+	0x28, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x56, 0x50, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbf, 0x03, 0x0a, 0x17, 0x4d, 0x73,
+	0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x4b, 0x0a, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x01,
+	0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46,
+	0x72, 0x6f, 0x6d, 0x12, 0x4d, 0x0a, 0x0f, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x01, 0x90, 0xdf,
+	0x1f, 0x01, 0x52, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x6e, 0x74,
+	0x69, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69,
+	0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x65, 0x65, 0x73,
+	0x12, 0x2b, 0x0a, 0x11, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x76, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x3a, 0x0c, 0x82,
+	0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x31, 0x0a, 0x1f, 0x4d,
+	0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x50, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0xb6,
+	0x01, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x50, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
 	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
 	0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a,
-	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x2a, 0x0a, 0x28, 0x4d, 0x73, 0x67, 0x43,
-	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56,
-	0x50, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbb, 0x03, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x49,
-	0x64, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x64, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x4b, 0x0a,
-	0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x01, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0d, 0x65, 0x66, 0x66,
-	0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x4d, 0x0a, 0x0f, 0x65, 0x66,
-	0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x06, 0x20,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4d, 0x0a, 0x0f, 0x65, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x03, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
 	0x08, 0xc8, 0xde, 0x1f, 0x01, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65,
-	0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66,
-	0x65, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x69, 0x73, 0x73, 0x75, 0x61,
-	0x6e, 0x63, 0x65, 0x46, 0x65, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x65, 0x72, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x46, 0x65, 0x65, 0x73, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x6f, 0x72, 0x22, 0x31, 0x0a, 0x1f, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
-	0x6f, 0x6f, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0xb6, 0x01, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x74,
-	0x65, 0x6e, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a,
+	0x74, 0x69, 0x76, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x1d, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9e, 0x01, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x32,
+	0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x35, 0x0a, 0x07, 0x63, 0x61, 0x73, 0x63, 0x61, 0x64, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x73, 0x63, 0x61, 0x64, 0x65, 0x4d, 0x6f, 0x64, 0x65,
+	0x52, 0x07, 0x63, 0x61, 0x73, 0x63, 0x61, 0x64, 0x65, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x62, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x73, 0x63, 0x61, 0x64,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x61, 0x73, 0x63, 0x61, 0x64,
+	0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x61, 0x73, 0x63, 0x61, 0x64, 0x65, 0x5f, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x63, 0x61, 0x73,
+	0x63, 0x61, 0x64, 0x65, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0xa1, 0x03, 0x0a, 0x22,
+	0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72,
+	0x5f, 0x70, 0x65, 0x72, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x50, 0x65, 0x72, 0x6d, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f,
+	0x70, 0x65, 0x72, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x14, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x5f,
+	0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x01, 0x52, 0x11,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x49,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e,
+	0x63, 0x65, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x01, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x07, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x49,
+	0x64, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22,
+	0x3c, 0x0a, 0x2a, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xe1, 0x02,
+	0x0a, 0x1e, 0x4d, 0x73, 0x67, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0a, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x55, 0x72, 0x6c,
+	0x12, 0x2e, 0x0a, 0x13, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x69, 0x67,
+	0x65, 0x73, 0x74, 0x5f, 0x73, 0x72, 0x69, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65,
+	0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x53, 0x72, 0x69,
+	0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x66, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x67, 0x66, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x42, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x74,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x53, 0x68, 0x61, 0x72, 0x65, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x22, 0x43, 0x0a, 0x26, 0x4d, 0x73, 0x67, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x50, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73,
+	0x6c, 0x61, 0x73, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73,
+	0x6c, 0x61, 0x73, 0x68, 0x49, 0x64, 0x22, 0x79, 0x0a, 0x25, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x70,
+	0x61, 0x79, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x61, 0x73,
+	0x68, 0x65, 0x64, 0x54, 0x72, 0x75, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12,
+	0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x22, 0x2f, 0x0a, 0x2d, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x70, 0x61, 0x79, 0x50, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x54, 0x72,
+	0x75, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x99, 0x03, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1b,
+	0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x76, 0x65, 0x72, 0x61,
+	0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x47, 0x0a, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x49, 0x0a, 0x0f, 0x65, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90,
+	0xdf, 0x1f, 0x01, 0x52, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x6e,
+	0x74, 0x69, 0x6c, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73,
+	0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x2d,
+	0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0xcd, 0x02,
+	0x0a, 0x19, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x1b, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x49, 0x64,
+	0x12, 0x28, 0x0a, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5f, 0x70, 0x65, 0x72,
+	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x43, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0d, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x3a,
+	0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x33, 0x0a,
+	0x21, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x83, 0x01, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x46, 0x75, 0x6e, 0x64, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x32,
+	0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x21, 0x0a, 0x1f, 0x4d, 0x73, 0x67, 0x46,
+	0x75, 0x6e, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x87, 0x01, 0x0a, 0x1b,
+	0x4d, 0x73, 0x67, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x3d, 0x0a, 0x23, 0x4d, 0x73, 0x67, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x92, 0x01, 0x0a, 0x0e, 0x4d, 0x73, 0x67, 0x41, 0x70, 0x70, 0x65,
+	0x61, 0x6c, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x65,
+	0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x73,
+	0x72, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e,
+	0x63, 0x65, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x53, 0x72, 0x69, 0x3a, 0x0c, 0x82, 0xe7, 0xb0,
+	0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x18, 0x0a, 0x16, 0x4d, 0x73, 0x67,
+	0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x12, 0x32, 0x0a,
 	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
 	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
 	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
 	0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x4d, 0x0a, 0x0f, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x75,
-	0x6e, 0x74, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x01, 0x90, 0xdf, 0x1f, 0x01,
-	0x52, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c,
-	0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x1d,
-	0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x67, 0x0a,
-	0x13, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x75, 0x70, 0x68, 0x6f, 0x6c, 0x64, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x1f, 0x0a, 0x1d, 0x4d, 0x73, 0x67, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb4, 0x01, 0x0a, 0x17, 0x4d, 0x73, 0x67,
+	0x53, 0x65, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
-	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x1d, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76,
-	0x6f, 0x6b, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa1, 0x02, 0x0a, 0x22, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x07,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
-	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x24, 0x0a, 0x0e, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x5f,
-	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72,
-	0x50, 0x65, 0x72, 0x6d, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x49, 0x64,
-	0x12, 0x22, 0x0a, 0x0d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x5f, 0x69,
-	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x50, 0x65,
-	0x72, 0x6d, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x14, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61,
-	0x67, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x04, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x01, 0x52, 0x11, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x41, 0x67, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x49, 0x64, 0x3a, 0x0c, 0x82, 0xe7, 0xb0,
-	0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x3c, 0x0a, 0x2a, 0x4d, 0x73, 0x67,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65,
-	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x8a, 0x01, 0x0a, 0x1e, 0x4d, 0x73, 0x67, 0x53,
-	0x6c, 0x61, 0x73, 0x68, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x72,
-	0x75, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
-	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16,
-	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x6f, 0x72, 0x22, 0x28, 0x0a, 0x26, 0x4d, 0x73, 0x67, 0x53, 0x6c, 0x61, 0x73, 0x68,
-	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x44,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x79,
-	0x0a, 0x25, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x70, 0x61, 0x79, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x54, 0x72, 0x75, 0x73, 0x74,
-	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x3a, 0x0c, 0x82, 0xe7, 0xb0,
-	0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x2f, 0x0a, 0x2d, 0x4d, 0x73, 0x67,
-	0x52, 0x65, 0x70, 0x61, 0x79, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53,
-	0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x54, 0x72, 0x75, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x95, 0x03, 0x0a, 0x13, 0x4d,
-	0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x72, 0x49, 0x64, 0x12, 0x42, 0x0a,
+	0x0c, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x74, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x53, 0x68, 0x61, 0x72, 0x65, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22,
+	0x21, 0x0a, 0x1f, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x44, 0x69,
+	0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xcf, 0x01, 0x0a, 0x14, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e,
+	0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x0f, 0x73, 0x75, 0x73, 0x70, 0x65,
+	0x6e, 0x64, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde,
+	0x1f, 0x01, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0e, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65,
+	0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x22, 0x1e, 0x0a, 0x1c, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x73, 0x70, 0x65,
+	0x6e, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6a, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x55, 0x6e, 0x73, 0x75, 0x73,
+	0x70, 0x65, 0x6e, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x32,
+	0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x22, 0x20, 0x0a, 0x1e, 0x4d, 0x73, 0x67, 0x55, 0x6e, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64,
+	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x6d, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x22, 0x23, 0x0a, 0x21, 0x4d, 0x73, 0x67, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc8, 0x01, 0x0a, 0x1e, 0x4d, 0x73, 0x67, 0x50, 0x75,
+	0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x28, 0x0a,
+	0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x50, 0x65, 0x72, 0x6d, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x73, 0x73, 0x75, 0x65,
+	0x72, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x22, 0x85, 0x01, 0x0a, 0x26, 0x4d, 0x73, 0x67, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73,
+	0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x63, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
+	0x66, 0x65, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x72, 0x75,
+	0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x22, 0x70, 0x0a, 0x1c, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x3a, 0x0c, 0x82,
+	0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x87, 0x01, 0x0a, 0x24,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64,
+	0x5f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
+	0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12,
+	0x34, 0x0a, 0x16, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x75, 0x73,
+	0x74, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x14, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x54, 0x72, 0x75, 0x73, 0x74, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x22, 0x8d, 0x01, 0x0a, 0x14, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x12, 0x3e,
+	0x0a, 0x07, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x12, 0x17,
+	0x0a, 0x07, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xa4, 0x01, 0x0a, 0x18, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65,
+	0x72, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
 	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x1e, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x47, 0x0a, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
-	0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0d, 0x65,
-	0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x49, 0x0a, 0x0f,
-	0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x65, 0x72, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x46, 0x65, 0x65, 0x73, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x6f, 0x72, 0x22, 0x2d, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
-	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
-	0x64, 0x32, 0xca, 0x0d, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x58, 0x0a, 0x0c, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1f, 0x2e, 0x76, 0x65, 0x72, 0x61,
-	0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x27, 0x2e, 0x76, 0x65, 0x72,
-	0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x65, 0x72, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x50, 0x12, 0x24, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e,
-	0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x50, 0x1a, 0x2c,
-	0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
-	0x4d, 0x73, 0x67, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x56, 0x50, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x11,
-	0x52, 0x65, 0x6e, 0x65, 0x77, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56,
-	0x50, 0x12, 0x24, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e,
-	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x50, 0x65, 0x72, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x50, 0x1a, 0x2c, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61,
-	0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6e, 0x65,
-	0x77, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x50, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x50, 0x65, 0x72,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x50, 0x54, 0x6f, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x12, 0x2d, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65,
-	0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x50, 0x54, 0x6f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x1a, 0x35, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72,
-	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x46, 0x0a, 0x08, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65,
+	0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e,
+	0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x73, 0x3a, 0x0c,
+	0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x52, 0x0a, 0x20,
+	0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x22, 0xb3, 0x01, 0x0a, 0x12, 0x4d, 0x73, 0x67, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x56, 0x50,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x70,
+	0x65, 0x72, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x65,
+	0x72, 0x6d, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x73,
+	0x72, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x53, 0x72, 0x69, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64,
+	0x5f, 0x75, 0x72, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x65, 0x64, 0x55, 0x72, 0x69, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x32, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x41, 0x6e, 0x63,
+	0x68, 0x6f, 0x72, 0x56, 0x50, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xe6, 0x01, 0x0a, 0x17, 0x4d,
+	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46,
+	0x65, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x66, 0x65, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x69, 0x73, 0x73, 0x75,
+	0x61, 0x6e, 0x63, 0x65, 0x46, 0x65, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x46, 0x65, 0x65, 0x73, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x6f, 0x72, 0x22, 0x6a, 0x0a, 0x1f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01,
+	0x52, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x32,
+	0x8a, 0x1a, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x58, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1f, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61,
+	0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x27, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e,
+	0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x67, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x56, 0x50, 0x12, 0x24, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e,
+	0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x50, 0x1a, 0x2c, 0x2e, 0x76,
+	0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x56, 0x50, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x11, 0x52, 0x65,
+	0x6e, 0x65, 0x77, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x50, 0x12,
+	0x24, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x56, 0x50, 0x1a, 0x2c, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70,
+	0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x50,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x50, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69,
 	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x50, 0x54, 0x6f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8e, 0x01, 0x0a, 0x1e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x56, 0x50, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x2e,
-	0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x56, 0x50, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x1a, 0x39, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x65, 0x72, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x50, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8e, 0x01, 0x0a, 0x1e,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x56, 0x50, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31,
+	0x65, 0x64, 0x12, 0x2d, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x56, 0x50, 0x54, 0x6f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x1a, 0x35, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x56, 0x50, 0x54, 0x6f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8e, 0x01, 0x0a, 0x1e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x50,
+	0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x2e, 0x76, 0x65,
+	0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x56, 0x50, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x39,
 	0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
-	0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
 	0x73, 0x69, 0x6f, 0x6e, 0x56, 0x50, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x1a, 0x39, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e,
-	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x65, 0x72,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x50, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8b, 0x01, 0x0a,
-	0x1d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x56, 0x50, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8e, 0x01, 0x0a, 0x1e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56,
+	0x50, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x2e, 0x76,
+	0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x56, 0x50, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
+	0x39, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x50, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8b, 0x01, 0x0a, 0x1d, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56,
+	0x50, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x2e, 0x76,
+	0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x56, 0x50, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38,
 	0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
 	0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
 	0x69, 0x6f, 0x6e, 0x56, 0x50, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x38, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x72, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x50, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x14, 0x43, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x27, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x50,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x2f, 0x2e, 0x76, 0x65, 0x72, 0x61,
+	0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72,
 	0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x27, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d,
-	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f,
-	0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x2f, 0x2e, 0x76, 0x65,
-	0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x10,
-	0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x23, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x2b, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70,
-	0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64,
-	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x64, 0x0a, 0x10, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x50, 0x65, 0x72, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e,
-	0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x6f, 0x6b,
-	0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x2b, 0x2e, 0x76, 0x65,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x10, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23,
+	0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x1a, 0x2b, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x64, 0x0a, 0x10, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65,
+	0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x50,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x2b, 0x2e, 0x76, 0x65, 0x72, 0x61,
+	0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x91, 0x01, 0x0a, 0x1f, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x2e, 0x76, 0x65, 0x72,
+	0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x3a,
+	0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x1b, 0x53,
+	0x6c, 0x61, 0x73, 0x68, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x72,
+	0x75, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x2e, 0x2e, 0x76, 0x65, 0x72,
+	0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53,
+	0x6c, 0x61, 0x73, 0x68, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x72,
+	0x75, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x1a, 0x36, 0x2e, 0x76, 0x65, 0x72,
+	0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53,
+	0x6c, 0x61, 0x73, 0x68, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x72,
+	0x75, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x9a, 0x01, 0x0a, 0x22, 0x52, 0x65, 0x70, 0x61, 0x79, 0x50, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x54, 0x72, 0x75,
+	0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x35, 0x2e, 0x76, 0x65, 0x72, 0x61,
+	0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65,
+	0x70, 0x61, 0x79, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x61,
+	0x73, 0x68, 0x65, 0x64, 0x54, 0x72, 0x75, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x1a, 0x3d, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x70, 0x61, 0x79, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x54, 0x72, 0x75, 0x73, 0x74,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x64, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x2b, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e,
+	0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x29, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x31, 0x2e, 0x76, 0x65, 0x72,
+	0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x6f,
+	0x77, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a,
+	0x14, 0x46, 0x75, 0x6e, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x6f,
+	0x77, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x27, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70,
+	0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x46, 0x75, 0x6e, 0x64, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x2f,
+	0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x46, 0x75, 0x6e, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6c,
+	0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x7c, 0x0a, 0x18, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2b, 0x2e, 0x76, 0x65,
 	0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
-	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x91, 0x01, 0x0a, 0x1f, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x2e, 0x76,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41,
+	0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x33, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e,
+	0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x6f,
+	0x77, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a,
+	0x0b, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x12, 0x1e, 0x2e, 0x76,
 	0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
-	0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
-	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x1a, 0x3a, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x85, 0x01, 0x0a,
-	0x1b, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x54, 0x72, 0x75, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x2e, 0x2e, 0x76,
+	0x67, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x1a, 0x26, 0x2e, 0x76,
 	0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
-	0x67, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x54, 0x72, 0x75, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x1a, 0x36, 0x2e, 0x76,
-	0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
-	0x67, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x54, 0x72, 0x75, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x9a, 0x01, 0x0a, 0x22, 0x52, 0x65, 0x70, 0x61, 0x79, 0x50, 0x65,
-	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x54,
-	0x72, 0x75, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x35, 0x2e, 0x76, 0x65,
+	0x67, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53,
+	0x6c, 0x61, 0x73, 0x68, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x12, 0x25, 0x2e, 0x76, 0x65, 0x72,
+	0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x41, 0x70, 0x70, 0x65, 0x61,
+	0x6c, 0x1a, 0x2d, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x53, 0x6c, 0x61,
+	0x73, 0x68, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x70, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x44, 0x69, 0x73, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e,
+	0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74,
+	0x53, 0x6c, 0x61, 0x73, 0x68, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x1a, 0x2f, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x44, 0x69,
+	0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x67, 0x0a, 0x11, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x50, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61,
+	0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x73, 0x70,
+	0x65, 0x6e, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x2c, 0x2e,
+	0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x73, 0x67, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x13, 0x55,
+	0x6e, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x6e, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64,
+	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x2e, 0x2e, 0x76, 0x65, 0x72,
+	0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55,
+	0x6e, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x16, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65,
+	0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a,
+	0x31, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x1b, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x73, 0x12, 0x2e, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x73, 0x1a, 0x36, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x19, 0x52, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x2c, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61,
+	0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x73, 0x1a, 0x34, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70,
+	0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x15, 0x53,
+	0x65, 0x74, 0x74, 0x6c, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x75, 0x63,
+	0x68, 0x65, 0x72, 0x73, 0x12, 0x28, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65,
+	0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x73, 0x1a, 0x30,
+	0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x61, 0x0a, 0x0f, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x56, 0x50, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x22, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x56, 0x50,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x2a, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61,
+	0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x56, 0x50, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x76, 0x65,
 	0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
-	0x52, 0x65, 0x70, 0x61, 0x79, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53,
-	0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x54, 0x72, 0x75, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x1a, 0x3d, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d,
-	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x70, 0x61, 0x79, 0x50, 0x65, 0x72, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x54, 0x72, 0x75,
-	0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x64, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70,
-	0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x2b, 0x2e, 0x76, 0x65, 0x72,
-	0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xbb,
-	0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65,
-	0x72, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x42, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x65, 0x72,
-	0x61, 0x6e, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2d,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
-	0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x70, 0x65, 0x72, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x65,
-	0x72, 0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x56, 0x50, 0x58, 0xaa, 0x02, 0x0e, 0x56, 0x65, 0x72,
-	0x61, 0x6e, 0x61, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x56, 0x65,
-	0x72, 0x61, 0x6e, 0x61, 0x5c, 0x50, 0x65, 0x72, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x56,
-	0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x50, 0x65, 0x72, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x56, 0x65, 0x72, 0x61,
-	0x6e, 0x61, 0x3a, 0x3a, 0x50, 0x65, 0x72, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x46, 0x65, 0x65, 0x73, 0x1a, 0x2f, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65,
+	0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xbb, 0x01, 0x0a,
+	0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d,
+	0x2e, 0x76, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x42,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e,
+	0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2d, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x65, 0x72,
+	0x61, 0x6e, 0x61, 0x2f, 0x70, 0x65, 0x72, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x65, 0x72, 0x6d,
+	0x76, 0x31, 0xa2, 0x02, 0x03, 0x56, 0x50, 0x58, 0xaa, 0x02, 0x0e, 0x56, 0x65, 0x72, 0x61, 0x6e,
+	0x61, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x56, 0x65, 0x72, 0x61,
+	0x6e, 0x61, 0x5c, 0x50, 0x65, 0x72, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x56, 0x65, 0x72,
+	0x61, 0x6e, 0x61, 0x5c, 0x50, 0x65, 0x72, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61,
+	0x3a, 0x3a, 0x50, 0x65, 0x72, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -15096,7 +32044,7 @@ func file_verana_perm_v1_tx_proto_rawDescGZIP() []byte {
 	return file_verana_perm_v1_tx_proto_rawDescData
 }
 
-var file_verana_perm_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_verana_perm_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 57)
 var file_verana_perm_v1_tx_proto_goTypes = []interface{}{
 	(*MsgUpdateParams)(nil),                               // 0: verana.perm.v1.MsgUpdateParams
 	(*MsgUpdateParamsResponse)(nil),                       // 1: verana.perm.v1.MsgUpdateParamsResponse
@@ -15126,52 +32074,120 @@ var file_verana_perm_v1_tx_proto_goTypes = []interface{}{
 	(*MsgRepayPermissionSlashedTrustDepositResponse)(nil), // 25: verana.perm.v1.MsgRepayPermissionSlashedTrustDepositResponse
 	(*MsgCreatePermission)(nil),                           // 26: verana.perm.v1.MsgCreatePermission
 	(*MsgCreatePermissionResponse)(nil),                   // 27: verana.perm.v1.MsgCreatePermissionResponse
-	(*Params)(nil),                                        // 28: verana.perm.v1.Params
-	(*timestamppb.Timestamp)(nil),                         // 29: google.protobuf.Timestamp
-	(PermissionType)(0),                                   // 30: verana.perm.v1.PermissionType
+	(*MsgCreateSessionAllowance)(nil),                     // 28: verana.perm.v1.MsgCreateSessionAllowance
+	(*MsgCreateSessionAllowanceResponse)(nil),             // 29: verana.perm.v1.MsgCreateSessionAllowanceResponse
+	(*MsgFundSessionAllowance)(nil),                       // 30: verana.perm.v1.MsgFundSessionAllowance
+	(*MsgFundSessionAllowanceResponse)(nil),               // 31: verana.perm.v1.MsgFundSessionAllowanceResponse
+	(*MsgWithdrawSessionAllowance)(nil),                   // 32: verana.perm.v1.MsgWithdrawSessionAllowance
+	(*MsgWithdrawSessionAllowanceResponse)(nil),           // 33: verana.perm.v1.MsgWithdrawSessionAllowanceResponse
+	(*MsgAppealSlash)(nil),                                // 34: verana.perm.v1.MsgAppealSlash
+	(*MsgAppealSlashResponse)(nil),                        // 35: verana.perm.v1.MsgAppealSlashResponse
+	(*MsgResolveSlashAppeal)(nil),                         // 36: verana.perm.v1.MsgResolveSlashAppeal
+	(*MsgResolveSlashAppealResponse)(nil),                 // 37: verana.perm.v1.MsgResolveSlashAppealResponse
+	(*MsgSetSlashDistribution)(nil),                       // 38: verana.perm.v1.MsgSetSlashDistribution
+	(*MsgSetSlashDistributionResponse)(nil),               // 39: verana.perm.v1.MsgSetSlashDistributionResponse
+	(*MsgSuspendPermission)(nil),                          // 40: verana.perm.v1.MsgSuspendPermission
+	(*MsgSuspendPermissionResponse)(nil),                  // 41: verana.perm.v1.MsgSuspendPermissionResponse
+	(*MsgUnsuspendPermission)(nil),                        // 42: verana.perm.v1.MsgUnsuspendPermission
+	(*MsgUnsuspendPermissionResponse)(nil),                // 43: verana.perm.v1.MsgUnsuspendPermissionResponse
+	(*MsgClosePermissionSession)(nil),                     // 44: verana.perm.v1.MsgClosePermissionSession
+	(*MsgClosePermissionSessionResponse)(nil),             // 45: verana.perm.v1.MsgClosePermissionSessionResponse
+	(*MsgPurchaseVerificationCredits)(nil),                // 46: verana.perm.v1.MsgPurchaseVerificationCredits
+	(*MsgPurchaseVerificationCreditsResponse)(nil),        // 47: verana.perm.v1.MsgPurchaseVerificationCreditsResponse
+	(*MsgRefundVerificationCredits)(nil),                  // 48: verana.perm.v1.MsgRefundVerificationCredits
+	(*MsgRefundVerificationCreditsResponse)(nil),          // 49: verana.perm.v1.MsgRefundVerificationCreditsResponse
+	(*SignedSessionVoucher)(nil),                          // 50: verana.perm.v1.SignedSessionVoucher
+	(*MsgSettleSessionVouchers)(nil),                      // 51: verana.perm.v1.MsgSettleSessionVouchers
+	(*MsgSettleSessionVouchersResponse)(nil),              // 52: verana.perm.v1.MsgSettleSessionVouchersResponse
+	(*MsgAnchorVPMessage)(nil),                            // 53: verana.perm.v1.MsgAnchorVPMessage
+	(*MsgAnchorVPMessageResponse)(nil),                    // 54: verana.perm.v1.MsgAnchorVPMessageResponse
+	(*MsgUpdatePermissionFees)(nil),                       // 55: verana.perm.v1.MsgUpdatePermissionFees
+	(*MsgUpdatePermissionFeesResponse)(nil),               // 56: verana.perm.v1.MsgUpdatePermissionFeesResponse
+	(*Params)(nil),                                        // 57: verana.perm.v1.Params
+	(*timestamppb.Timestamp)(nil),                         // 58: google.protobuf.Timestamp
+	(CascadeMode)(0),                                      // 59: verana.perm.v1.CascadeMode
+	(*v1.SlashShare)(nil),                                 // 60: verana.td.v1.SlashShare
+	(PermissionType)(0),                                   // 61: verana.perm.v1.PermissionType
+	(*SessionVoucher)(nil),                                // 62: verana.perm.v1.SessionVoucher
 }
 var file_verana_perm_v1_tx_proto_depIdxs = []int32{
-	28, // 0: verana.perm.v1.MsgUpdateParams.params:type_name -> verana.perm.v1.Params
-	29, // 1: verana.perm.v1.MsgSetPermissionVPToValidated.effective_until:type_name -> google.protobuf.Timestamp
-	29, // 2: verana.perm.v1.MsgCreateRootPermission.effective_from:type_name -> google.protobuf.Timestamp
-	29, // 3: verana.perm.v1.MsgCreateRootPermission.effective_until:type_name -> google.protobuf.Timestamp
-	29, // 4: verana.perm.v1.MsgExtendPermission.effective_until:type_name -> google.protobuf.Timestamp
-	30, // 5: verana.perm.v1.MsgCreatePermission.type:type_name -> verana.perm.v1.PermissionType
-	29, // 6: verana.perm.v1.MsgCreatePermission.effective_from:type_name -> google.protobuf.Timestamp
-	29, // 7: verana.perm.v1.MsgCreatePermission.effective_until:type_name -> google.protobuf.Timestamp
-	0,  // 8: verana.perm.v1.Msg.UpdateParams:input_type -> verana.perm.v1.MsgUpdateParams
-	2,  // 9: verana.perm.v1.Msg.StartPermissionVP:input_type -> verana.perm.v1.MsgStartPermissionVP
-	4,  // 10: verana.perm.v1.Msg.RenewPermissionVP:input_type -> verana.perm.v1.MsgRenewPermissionVP
-	6,  // 11: verana.perm.v1.Msg.SetPermissionVPToValidated:input_type -> verana.perm.v1.MsgSetPermissionVPToValidated
-	8,  // 12: verana.perm.v1.Msg.RequestPermissionVPTermination:input_type -> verana.perm.v1.MsgRequestPermissionVPTermination
-	10, // 13: verana.perm.v1.Msg.ConfirmPermissionVPTermination:input_type -> verana.perm.v1.MsgConfirmPermissionVPTermination
-	12, // 14: verana.perm.v1.Msg.CancelPermissionVPLastRequest:input_type -> verana.perm.v1.MsgCancelPermissionVPLastRequest
-	14, // 15: verana.perm.v1.Msg.CreateRootPermission:input_type -> verana.perm.v1.MsgCreateRootPermission
-	16, // 16: verana.perm.v1.Msg.ExtendPermission:input_type -> verana.perm.v1.MsgExtendPermission
-	18, // 17: verana.perm.v1.Msg.RevokePermission:input_type -> verana.perm.v1.MsgRevokePermission
-	20, // 18: verana.perm.v1.Msg.CreateOrUpdatePermissionSession:input_type -> verana.perm.v1.MsgCreateOrUpdatePermissionSession
-	22, // 19: verana.perm.v1.Msg.SlashPermissionTrustDeposit:input_type -> verana.perm.v1.MsgSlashPermissionTrustDeposit
-	24, // 20: verana.perm.v1.Msg.RepayPermissionSlashedTrustDeposit:input_type -> verana.perm.v1.MsgRepayPermissionSlashedTrustDeposit
-	26, // 21: verana.perm.v1.Msg.CreatePermission:input_type -> verana.perm.v1.MsgCreatePermission
-	1,  // 22: verana.perm.v1.Msg.UpdateParams:output_type -> verana.perm.v1.MsgUpdateParamsResponse
-	3,  // 23: verana.perm.v1.Msg.StartPermissionVP:output_type -> verana.perm.v1.MsgStartPermissionVPResponse
-	5,  // 24: verana.perm.v1.Msg.RenewPermissionVP:output_type -> verana.perm.v1.MsgRenewPermissionVPResponse
-	7,  // 25: verana.perm.v1.Msg.SetPermissionVPToValidated:output_type -> verana.perm.v1.MsgSetPermissionVPToValidatedResponse
-	9,  // 26: verana.perm.v1.Msg.RequestPermissionVPTermination:output_type -> verana.perm.v1.MsgRequestPermissionVPTerminationResponse
-	11, // 27: verana.perm.v1.Msg.ConfirmPermissionVPTermination:output_type -> verana.perm.v1.MsgConfirmPermissionVPTerminationResponse
-	13, // 28: verana.perm.v1.Msg.CancelPermissionVPLastRequest:output_type -> verana.perm.v1.MsgCancelPermissionVPLastRequestResponse
-	15, // 29: verana.perm.v1.Msg.CreateRootPermission:output_type -> verana.perm.v1.MsgCreateRootPermissionResponse
-	17, // 30: verana.perm.v1.Msg.ExtendPermission:output_type -> verana.perm.v1.MsgExtendPermissionResponse
-	19, // 31: verana.perm.v1.Msg.RevokePermission:output_type -> verana.perm.v1.MsgRevokePermissionResponse
-	21, // 32: verana.perm.v1.Msg.CreateOrUpdatePermissionSession:output_type -> verana.perm.v1.MsgCreateOrUpdatePermissionSessionResponse
-	23, // 33: verana.perm.v1.Msg.SlashPermissionTrustDeposit:output_type -> verana.perm.v1.MsgSlashPermissionTrustDepositResponse
-	25, // 34: verana.perm.v1.Msg.RepayPermissionSlashedTrustDeposit:output_type -> verana.perm.v1.MsgRepayPermissionSlashedTrustDepositResponse
-	27, // 35: verana.perm.v1.Msg.CreatePermission:output_type -> verana.perm.v1.MsgCreatePermissionResponse
-	22, // [22:36] is the sub-list for method output_type
-	8,  // [8:22] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	57, // 0: verana.perm.v1.MsgUpdateParams.params:type_name -> verana.perm.v1.Params
+	58, // 1: verana.perm.v1.MsgSetPermissionVPToValidated.effective_until:type_name -> google.protobuf.Timestamp
+	58, // 2: verana.perm.v1.MsgCreateRootPermission.effective_from:type_name -> google.protobuf.Timestamp
+	58, // 3: verana.perm.v1.MsgCreateRootPermission.effective_until:type_name -> google.protobuf.Timestamp
+	58, // 4: verana.perm.v1.MsgExtendPermission.effective_until:type_name -> google.protobuf.Timestamp
+	59, // 5: verana.perm.v1.MsgRevokePermission.cascade:type_name -> verana.perm.v1.CascadeMode
+	58, // 6: verana.perm.v1.MsgCreateOrUpdatePermissionSession.expires:type_name -> google.protobuf.Timestamp
+	60, // 7: verana.perm.v1.MsgSlashPermissionTrustDeposit.distribution:type_name -> verana.td.v1.SlashShare
+	61, // 8: verana.perm.v1.MsgCreatePermission.type:type_name -> verana.perm.v1.PermissionType
+	58, // 9: verana.perm.v1.MsgCreatePermission.effective_from:type_name -> google.protobuf.Timestamp
+	58, // 10: verana.perm.v1.MsgCreatePermission.effective_until:type_name -> google.protobuf.Timestamp
+	60, // 11: verana.perm.v1.MsgSetSlashDistribution.distribution:type_name -> verana.td.v1.SlashShare
+	58, // 12: verana.perm.v1.MsgSuspendPermission.suspended_until:type_name -> google.protobuf.Timestamp
+	62, // 13: verana.perm.v1.SignedSessionVoucher.voucher:type_name -> verana.perm.v1.SessionVoucher
+	50, // 14: verana.perm.v1.MsgSettleSessionVouchers.vouchers:type_name -> verana.perm.v1.SignedSessionVoucher
+	58, // 15: verana.perm.v1.MsgUpdatePermissionFeesResponse.effective_from:type_name -> google.protobuf.Timestamp
+	0,  // 16: verana.perm.v1.Msg.UpdateParams:input_type -> verana.perm.v1.MsgUpdateParams
+	2,  // 17: verana.perm.v1.Msg.StartPermissionVP:input_type -> verana.perm.v1.MsgStartPermissionVP
+	4,  // 18: verana.perm.v1.Msg.RenewPermissionVP:input_type -> verana.perm.v1.MsgRenewPermissionVP
+	6,  // 19: verana.perm.v1.Msg.SetPermissionVPToValidated:input_type -> verana.perm.v1.MsgSetPermissionVPToValidated
+	8,  // 20: verana.perm.v1.Msg.RequestPermissionVPTermination:input_type -> verana.perm.v1.MsgRequestPermissionVPTermination
+	10, // 21: verana.perm.v1.Msg.ConfirmPermissionVPTermination:input_type -> verana.perm.v1.MsgConfirmPermissionVPTermination
+	12, // 22: verana.perm.v1.Msg.CancelPermissionVPLastRequest:input_type -> verana.perm.v1.MsgCancelPermissionVPLastRequest
+	14, // 23: verana.perm.v1.Msg.CreateRootPermission:input_type -> verana.perm.v1.MsgCreateRootPermission
+	16, // 24: verana.perm.v1.Msg.ExtendPermission:input_type -> verana.perm.v1.MsgExtendPermission
+	18, // 25: verana.perm.v1.Msg.RevokePermission:input_type -> verana.perm.v1.MsgRevokePermission
+	20, // 26: verana.perm.v1.Msg.CreateOrUpdatePermissionSession:input_type -> verana.perm.v1.MsgCreateOrUpdatePermissionSession
+	22, // 27: verana.perm.v1.Msg.SlashPermissionTrustDeposit:input_type -> verana.perm.v1.MsgSlashPermissionTrustDeposit
+	24, // 28: verana.perm.v1.Msg.RepayPermissionSlashedTrustDeposit:input_type -> verana.perm.v1.MsgRepayPermissionSlashedTrustDeposit
+	26, // 29: verana.perm.v1.Msg.CreatePermission:input_type -> verana.perm.v1.MsgCreatePermission
+	28, // 30: verana.perm.v1.Msg.CreateSessionAllowance:input_type -> verana.perm.v1.MsgCreateSessionAllowance
+	30, // 31: verana.perm.v1.Msg.FundSessionAllowance:input_type -> verana.perm.v1.MsgFundSessionAllowance
+	32, // 32: verana.perm.v1.Msg.WithdrawSessionAllowance:input_type -> verana.perm.v1.MsgWithdrawSessionAllowance
+	34, // 33: verana.perm.v1.Msg.AppealSlash:input_type -> verana.perm.v1.MsgAppealSlash
+	36, // 34: verana.perm.v1.Msg.ResolveSlashAppeal:input_type -> verana.perm.v1.MsgResolveSlashAppeal
+	38, // 35: verana.perm.v1.Msg.SetSlashDistribution:input_type -> verana.perm.v1.MsgSetSlashDistribution
+	40, // 36: verana.perm.v1.Msg.SuspendPermission:input_type -> verana.perm.v1.MsgSuspendPermission
+	42, // 37: verana.perm.v1.Msg.UnsuspendPermission:input_type -> verana.perm.v1.MsgUnsuspendPermission
+	44, // 38: verana.perm.v1.Msg.ClosePermissionSession:input_type -> verana.perm.v1.MsgClosePermissionSession
+	46, // 39: verana.perm.v1.Msg.PurchaseVerificationCredits:input_type -> verana.perm.v1.MsgPurchaseVerificationCredits
+	48, // 40: verana.perm.v1.Msg.RefundVerificationCredits:input_type -> verana.perm.v1.MsgRefundVerificationCredits
+	51, // 41: verana.perm.v1.Msg.SettleSessionVouchers:input_type -> verana.perm.v1.MsgSettleSessionVouchers
+	53, // 42: verana.perm.v1.Msg.AnchorVPMessage:input_type -> verana.perm.v1.MsgAnchorVPMessage
+	55, // 43: verana.perm.v1.Msg.UpdatePermissionFees:input_type -> verana.perm.v1.MsgUpdatePermissionFees
+	1,  // 44: verana.perm.v1.Msg.UpdateParams:output_type -> verana.perm.v1.MsgUpdateParamsResponse
+	3,  // 45: verana.perm.v1.Msg.StartPermissionVP:output_type -> verana.perm.v1.MsgStartPermissionVPResponse
+	5,  // 46: verana.perm.v1.Msg.RenewPermissionVP:output_type -> verana.perm.v1.MsgRenewPermissionVPResponse
+	7,  // 47: verana.perm.v1.Msg.SetPermissionVPToValidated:output_type -> verana.perm.v1.MsgSetPermissionVPToValidatedResponse
+	9,  // 48: verana.perm.v1.Msg.RequestPermissionVPTermination:output_type -> verana.perm.v1.MsgRequestPermissionVPTerminationResponse
+	11, // 49: verana.perm.v1.Msg.ConfirmPermissionVPTermination:output_type -> verana.perm.v1.MsgConfirmPermissionVPTerminationResponse
+	13, // 50: verana.perm.v1.Msg.CancelPermissionVPLastRequest:output_type -> verana.perm.v1.MsgCancelPermissionVPLastRequestResponse
+	15, // 51: verana.perm.v1.Msg.CreateRootPermission:output_type -> verana.perm.v1.MsgCreateRootPermissionResponse
+	17, // 52: verana.perm.v1.Msg.ExtendPermission:output_type -> verana.perm.v1.MsgExtendPermissionResponse
+	19, // 53: verana.perm.v1.Msg.RevokePermission:output_type -> verana.perm.v1.MsgRevokePermissionResponse
+	21, // 54: verana.perm.v1.Msg.CreateOrUpdatePermissionSession:output_type -> verana.perm.v1.MsgCreateOrUpdatePermissionSessionResponse
+	23, // 55: verana.perm.v1.Msg.SlashPermissionTrustDeposit:output_type -> verana.perm.v1.MsgSlashPermissionTrustDepositResponse
+	25, // 56: verana.perm.v1.Msg.RepayPermissionSlashedTrustDeposit:output_type -> verana.perm.v1.MsgRepayPermissionSlashedTrustDepositResponse
+	27, // 57: verana.perm.v1.Msg.CreatePermission:output_type -> verana.perm.v1.MsgCreatePermissionResponse
+	29, // 58: verana.perm.v1.Msg.CreateSessionAllowance:output_type -> verana.perm.v1.MsgCreateSessionAllowanceResponse
+	31, // 59: verana.perm.v1.Msg.FundSessionAllowance:output_type -> verana.perm.v1.MsgFundSessionAllowanceResponse
+	33, // 60: verana.perm.v1.Msg.WithdrawSessionAllowance:output_type -> verana.perm.v1.MsgWithdrawSessionAllowanceResponse
+	35, // 61: verana.perm.v1.Msg.AppealSlash:output_type -> verana.perm.v1.MsgAppealSlashResponse
+	37, // 62: verana.perm.v1.Msg.ResolveSlashAppeal:output_type -> verana.perm.v1.MsgResolveSlashAppealResponse
+	39, // 63: verana.perm.v1.Msg.SetSlashDistribution:output_type -> verana.perm.v1.MsgSetSlashDistributionResponse
+	41, // 64: verana.perm.v1.Msg.SuspendPermission:output_type -> verana.perm.v1.MsgSuspendPermissionResponse
+	43, // 65: verana.perm.v1.Msg.UnsuspendPermission:output_type -> verana.perm.v1.MsgUnsuspendPermissionResponse
+	45, // 66: verana.perm.v1.Msg.ClosePermissionSession:output_type -> verana.perm.v1.MsgClosePermissionSessionResponse
+	47, // 67: verana.perm.v1.Msg.PurchaseVerificationCredits:output_type -> verana.perm.v1.MsgPurchaseVerificationCreditsResponse
+	49, // 68: verana.perm.v1.Msg.RefundVerificationCredits:output_type -> verana.perm.v1.MsgRefundVerificationCreditsResponse
+	52, // 69: verana.perm.v1.Msg.SettleSessionVouchers:output_type -> verana.perm.v1.MsgSettleSessionVouchersResponse
+	54, // 70: verana.perm.v1.Msg.AnchorVPMessage:output_type -> verana.perm.v1.MsgAnchorVPMessageResponse
+	56, // 71: verana.perm.v1.Msg.UpdatePermissionFees:output_type -> verana.perm.v1.MsgUpdatePermissionFeesResponse
+	44, // [44:72] is the sub-list for method output_type
+	16, // [16:44] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_verana_perm_v1_tx_proto_init() }
@@ -15194,8 +32210,152 @@ func file_verana_perm_v1_tx_proto_init() {
 				return nil
 			}
 		}
-		file_verana_perm_v1_tx_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateParamsResponse); i {
+		file_verana_perm_v1_tx_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateParamsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_tx_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgStartPermissionVP); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_tx_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgStartPermissionVPResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_tx_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRenewPermissionVP); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_tx_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRenewPermissionVPResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_tx_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetPermissionVPToValidated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_tx_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetPermissionVPToValidatedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_tx_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRequestPermissionVPTermination); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_tx_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRequestPermissionVPTerminationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_tx_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgConfirmPermissionVPTermination); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_tx_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgConfirmPermissionVPTerminationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_tx_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCancelPermissionVPLastRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_tx_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCancelPermissionVPLastRequestResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15206,8 +32366,8 @@ func file_verana_perm_v1_tx_proto_init() {
 				return nil
 			}
 		}
-		file_verana_perm_v1_tx_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgStartPermissionVP); i {
+		file_verana_perm_v1_tx_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCreateRootPermission); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15218,8 +32378,8 @@ func file_verana_perm_v1_tx_proto_init() {
 				return nil
 			}
 		}
-		file_verana_perm_v1_tx_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgStartPermissionVPResponse); i {
+		file_verana_perm_v1_tx_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCreateRootPermissionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15230,8 +32390,8 @@ func file_verana_perm_v1_tx_proto_init() {
 				return nil
 			}
 		}
-		file_verana_perm_v1_tx_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgRenewPermissionVP); i {
+		file_verana_perm_v1_tx_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgExtendPermission); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15242,8 +32402,8 @@ func file_verana_perm_v1_tx_proto_init() {
 				return nil
 			}
 		}
-		file_verana_perm_v1_tx_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgRenewPermissionVPResponse); i {
+		file_verana_perm_v1_tx_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgExtendPermissionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15254,8 +32414,8 @@ func file_verana_perm_v1_tx_proto_init() {
 				return nil
 			}
 		}
-		file_verana_perm_v1_tx_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgSetPermissionVPToValidated); i {
+		file_verana_perm_v1_tx_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRevokePermission); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15266,8 +32426,8 @@ func file_verana_perm_v1_tx_proto_init() {
 				return nil
 			}
 		}
-		file_verana_perm_v1_tx_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgSetPermissionVPToValidatedResponse); i {
+		file_verana_perm_v1_tx_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRevokePermissionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15278,8 +32438,8 @@ func file_verana_perm_v1_tx_proto_init() {
 				return nil
 			}
 		}
-		file_verana_perm_v1_tx_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgRequestPermissionVPTermination); i {
+		file_verana_perm_v1_tx_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCreateOrUpdatePermissionSession); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15290,8 +32450,8 @@ func file_verana_perm_v1_tx_proto_init() {
 				return nil
 			}
 		}
-		file_verana_perm_v1_tx_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgRequestPermissionVPTerminationResponse); i {
+		file_verana_perm_v1_tx_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCreateOrUpdatePermissionSessionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15302,8 +32462,8 @@ func file_verana_perm_v1_tx_proto_init() {
 				return nil
 			}
 		}
-		file_verana_perm_v1_tx_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgConfirmPermissionVPTermination); i {
+		file_verana_perm_v1_tx_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSlashPermissionTrustDeposit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15314,8 +32474,8 @@ func file_verana_perm_v1_tx_proto_init() {
 				return nil
 			}
 		}
-		file_verana_perm_v1_tx_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgConfirmPermissionVPTerminationResponse); i {
+		file_verana_perm_v1_tx_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSlashPermissionTrustDepositResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15326,8 +32486,8 @@ func file_verana_perm_v1_tx_proto_init() {
 				return nil
 			}
 		}
-		file_verana_perm_v1_tx_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgCancelPermissionVPLastRequest); i {
+		file_verana_perm_v1_tx_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRepayPermissionSlashedTrustDeposit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15338,8 +32498,8 @@ func file_verana_perm_v1_tx_proto_init() {
 				return nil
 			}
 		}
-		file_verana_perm_v1_tx_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgCancelPermissionVPLastRequestResponse); i {
+		file_verana_perm_v1_tx_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRepayPermissionSlashedTrustDepositResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15350,8 +32510,8 @@ func file_verana_perm_v1_tx_proto_init() {
 				return nil
 			}
 		}
-		file_verana_perm_v1_tx_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgCreateRootPermission); i {
+		file_verana_perm_v1_tx_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCreatePermission); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15362,8 +32522,8 @@ func file_verana_perm_v1_tx_proto_init() {
 				return nil
 			}
 		}
-		file_verana_perm_v1_tx_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgCreateRootPermissionResponse); i {
+		file_verana_perm_v1_tx_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCreatePermissionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15374,8 +32534,8 @@ func file_verana_perm_v1_tx_proto_init() {
 				return nil
 			}
 		}
-		file_verana_perm_v1_tx_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgExtendPermission); i {
+		file_verana_perm_v1_tx_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCreateSessionAllowance); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15386,8 +32546,8 @@ func file_verana_perm_v1_tx_proto_init() {
 				return nil
 			}
 		}
-		file_verana_perm_v1_tx_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgExtendPermissionResponse); i {
+		file_verana_perm_v1_tx_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCreateSessionAllowanceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15398,8 +32558,8 @@ func file_verana_perm_v1_tx_proto_init() {
 				return nil
 			}
 		}
-		file_verana_perm_v1_tx_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgRevokePermission); i {
+		file_verana_perm_v1_tx_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgFundSessionAllowance); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15410,8 +32570,8 @@ func file_verana_perm_v1_tx_proto_init() {
 				return nil
 			}
 		}
-		file_verana_perm_v1_tx_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgRevokePermissionResponse); i {
+		file_verana_perm_v1_tx_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgFundSessionAllowanceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15422,8 +32582,8 @@ func file_verana_perm_v1_tx_proto_init() {
 				return nil
 			}
 		}
-		file_verana_perm_v1_tx_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgCreateOrUpdatePermissionSession); i {
+		file_verana_perm_v1_tx_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgWithdrawSessionAllowance); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15434,8 +32594,8 @@ func file_verana_perm_v1_tx_proto_init() {
 				return nil
 			}
 		}
-		file_verana_perm_v1_tx_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgCreateOrUpdatePermissionSessionResponse); i {
+		file_verana_perm_v1_tx_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgWithdrawSessionAllowanceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15446,8 +32606,8 @@ func file_verana_perm_v1_tx_proto_init() {
 				return nil
 			}
 		}
-		file_verana_perm_v1_tx_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgSlashPermissionTrustDeposit); i {
+		file_verana_perm_v1_tx_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgAppealSlash); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15458,8 +32618,8 @@ func file_verana_perm_v1_tx_proto_init() {
 				return nil
 			}
 		}
-		file_verana_perm_v1_tx_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgSlashPermissionTrustDepositResponse); i {
+		file_verana_perm_v1_tx_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgAppealSlashResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15470,8 +32630,8 @@ func file_verana_perm_v1_tx_proto_init() {
 				return nil
 			}
 		}
-		file_verana_perm_v1_tx_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgRepayPermissionSlashedTrustDeposit); i {
+		file_verana_perm_v1_tx_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgResolveSlashAppeal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15482,8 +32642,8 @@ func file_verana_perm_v1_tx_proto_init() {
 				return nil
 			}
 		}
-		file_verana_perm_v1_tx_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgRepayPermissionSlashedTrustDepositResponse); i {
+		file_verana_perm_v1_tx_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgResolveSlashAppealResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15494,8 +32654,8 @@ func file_verana_perm_v1_tx_proto_init() {
 				return nil
 			}
 		}
-		file_verana_perm_v1_tx_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgCreatePermission); i {
+		file_verana_perm_v1_tx_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetSlashDistribution); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15506,8 +32666,212 @@ func file_verana_perm_v1_tx_proto_init() {
 				return nil
 			}
 		}
-		file_verana_perm_v1_tx_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgCreatePermissionResponse); i {
+		file_verana_perm_v1_tx_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetSlashDistributionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_tx_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSuspendPermission); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_tx_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSuspendPermissionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_tx_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUnsuspendPermission); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_tx_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUnsuspendPermissionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_tx_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgClosePermissionSession); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_tx_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgClosePermissionSessionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_tx_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgPurchaseVerificationCredits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_tx_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgPurchaseVerificationCreditsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_tx_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRefundVerificationCredits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_tx_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRefundVerificationCreditsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_tx_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignedSessionVoucher); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_tx_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSettleSessionVouchers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_tx_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSettleSessionVouchersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_tx_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgAnchorVPMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_tx_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgAnchorVPMessageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_tx_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdatePermissionFees); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_tx_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdatePermissionFeesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15525,7 +32889,7 @@ func file_verana_perm_v1_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_verana_perm_v1_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   28,
+			NumMessages:   57,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -33,6 +33,20 @@ const (
 	Msg_SlashPermissionTrustDeposit_FullMethodName        = "/verana.perm.v1.Msg/SlashPermissionTrustDeposit"
 	Msg_RepayPermissionSlashedTrustDeposit_FullMethodName = "/verana.perm.v1.Msg/RepayPermissionSlashedTrustDeposit"
 	Msg_CreatePermission_FullMethodName                   = "/verana.perm.v1.Msg/CreatePermission"
+	Msg_CreateSessionAllowance_FullMethodName             = "/verana.perm.v1.Msg/CreateSessionAllowance"
+	Msg_FundSessionAllowance_FullMethodName               = "/verana.perm.v1.Msg/FundSessionAllowance"
+	Msg_WithdrawSessionAllowance_FullMethodName           = "/verana.perm.v1.Msg/WithdrawSessionAllowance"
+	Msg_AppealSlash_FullMethodName                        = "/verana.perm.v1.Msg/AppealSlash"
+	Msg_ResolveSlashAppeal_FullMethodName                 = "/verana.perm.v1.Msg/ResolveSlashAppeal"
+	Msg_SetSlashDistribution_FullMethodName               = "/verana.perm.v1.Msg/SetSlashDistribution"
+	Msg_SuspendPermission_FullMethodName                  = "/verana.perm.v1.Msg/SuspendPermission"
+	Msg_UnsuspendPermission_FullMethodName                = "/verana.perm.v1.Msg/UnsuspendPermission"
+	Msg_ClosePermissionSession_FullMethodName             = "/verana.perm.v1.Msg/ClosePermissionSession"
+	Msg_PurchaseVerificationCredits_FullMethodName        = "/verana.perm.v1.Msg/PurchaseVerificationCredits"
+	Msg_RefundVerificationCredits_FullMethodName          = "/verana.perm.v1.Msg/RefundVerificationCredits"
+	Msg_SettleSessionVouchers_FullMethodName              = "/verana.perm.v1.Msg/SettleSessionVouchers"
+	Msg_AnchorVPMessage_FullMethodName                    = "/verana.perm.v1.Msg/AnchorVPMessage"
+	Msg_UpdatePermissionFees_FullMethodName               = "/verana.perm.v1.Msg/UpdatePermissionFees"
 )
 
 // MsgClient is the client API for Msg service.
@@ -55,6 +69,20 @@ type MsgClient interface {
 	SlashPermissionTrustDeposit(ctx context.Context, in *MsgSlashPermissionTrustDeposit, opts ...grpc.CallOption) (*MsgSlashPermissionTrustDepositResponse, error)
 	RepayPermissionSlashedTrustDeposit(ctx context.Context, in *MsgRepayPermissionSlashedTrustDeposit, opts ...grpc.CallOption) (*MsgRepayPermissionSlashedTrustDepositResponse, error)
 	CreatePermission(ctx context.Context, in *MsgCreatePermission, opts ...grpc.CallOption) (*MsgCreatePermissionResponse, error)
+	CreateSessionAllowance(ctx context.Context, in *MsgCreateSessionAllowance, opts ...grpc.CallOption) (*MsgCreateSessionAllowanceResponse, error)
+	FundSessionAllowance(ctx context.Context, in *MsgFundSessionAllowance, opts ...grpc.CallOption) (*MsgFundSessionAllowanceResponse, error)
+	WithdrawSessionAllowance(ctx context.Context, in *MsgWithdrawSessionAllowance, opts ...grpc.CallOption) (*MsgWithdrawSessionAllowanceResponse, error)
+	AppealSlash(ctx context.Context, in *MsgAppealSlash, opts ...grpc.CallOption) (*MsgAppealSlashResponse, error)
+	ResolveSlashAppeal(ctx context.Context, in *MsgResolveSlashAppeal, opts ...grpc.CallOption) (*MsgResolveSlashAppealResponse, error)
+	SetSlashDistribution(ctx context.Context, in *MsgSetSlashDistribution, opts ...grpc.CallOption) (*MsgSetSlashDistributionResponse, error)
+	SuspendPermission(ctx context.Context, in *MsgSuspendPermission, opts ...grpc.CallOption) (*MsgSuspendPermissionResponse, error)
+	UnsuspendPermission(ctx context.Context, in *MsgUnsuspendPermission, opts ...grpc.CallOption) (*MsgUnsuspendPermissionResponse, error)
+	ClosePermissionSession(ctx context.Context, in *MsgClosePermissionSession, opts ...grpc.CallOption) (*MsgClosePermissionSessionResponse, error)
+	PurchaseVerificationCredits(ctx context.Context, in *MsgPurchaseVerificationCredits, opts ...grpc.CallOption) (*MsgPurchaseVerificationCreditsResponse, error)
+	RefundVerificationCredits(ctx context.Context, in *MsgRefundVerificationCredits, opts ...grpc.CallOption) (*MsgRefundVerificationCreditsResponse, error)
+	SettleSessionVouchers(ctx context.Context, in *MsgSettleSessionVouchers, opts ...grpc.CallOption) (*MsgSettleSessionVouchersResponse, error)
+	AnchorVPMessage(ctx context.Context, in *MsgAnchorVPMessage, opts ...grpc.CallOption) (*MsgAnchorVPMessageResponse, error)
+	UpdatePermissionFees(ctx context.Context, in *MsgUpdatePermissionFees, opts ...grpc.CallOption) (*MsgUpdatePermissionFeesResponse, error)
 }
 
 type msgClient struct {
@@ -191,6 +219,132 @@ func (c *msgClient) CreatePermission(ctx context.Context, in *MsgCreatePermissio
 	return out, nil
 }
 
+func (c *msgClient) CreateSessionAllowance(ctx context.Context, in *MsgCreateSessionAllowance, opts ...grpc.CallOption) (*MsgCreateSessionAllowanceResponse, error) {
+	out := new(MsgCreateSessionAllowanceResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateSessionAllowance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundSessionAllowance(ctx context.Context, in *MsgFundSessionAllowance, opts ...grpc.CallOption) (*MsgFundSessionAllowanceResponse, error) {
+	out := new(MsgFundSessionAllowanceResponse)
+	err := c.cc.Invoke(ctx, Msg_FundSessionAllowance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawSessionAllowance(ctx context.Context, in *MsgWithdrawSessionAllowance, opts ...grpc.CallOption) (*MsgWithdrawSessionAllowanceResponse, error) {
+	out := new(MsgWithdrawSessionAllowanceResponse)
+	err := c.cc.Invoke(ctx, Msg_WithdrawSessionAllowance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AppealSlash(ctx context.Context, in *MsgAppealSlash, opts ...grpc.CallOption) (*MsgAppealSlashResponse, error) {
+	out := new(MsgAppealSlashResponse)
+	err := c.cc.Invoke(ctx, Msg_AppealSlash_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResolveSlashAppeal(ctx context.Context, in *MsgResolveSlashAppeal, opts ...grpc.CallOption) (*MsgResolveSlashAppealResponse, error) {
+	out := new(MsgResolveSlashAppealResponse)
+	err := c.cc.Invoke(ctx, Msg_ResolveSlashAppeal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetSlashDistribution(ctx context.Context, in *MsgSetSlashDistribution, opts ...grpc.CallOption) (*MsgSetSlashDistributionResponse, error) {
+	out := new(MsgSetSlashDistributionResponse)
+	err := c.cc.Invoke(ctx, Msg_SetSlashDistribution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SuspendPermission(ctx context.Context, in *MsgSuspendPermission, opts ...grpc.CallOption) (*MsgSuspendPermissionResponse, error) {
+	out := new(MsgSuspendPermissionResponse)
+	err := c.cc.Invoke(ctx, Msg_SuspendPermission_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnsuspendPermission(ctx context.Context, in *MsgUnsuspendPermission, opts ...grpc.CallOption) (*MsgUnsuspendPermissionResponse, error) {
+	out := new(MsgUnsuspendPermissionResponse)
+	err := c.cc.Invoke(ctx, Msg_UnsuspendPermission_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClosePermissionSession(ctx context.Context, in *MsgClosePermissionSession, opts ...grpc.CallOption) (*MsgClosePermissionSessionResponse, error) {
+	out := new(MsgClosePermissionSessionResponse)
+	err := c.cc.Invoke(ctx, Msg_ClosePermissionSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PurchaseVerificationCredits(ctx context.Context, in *MsgPurchaseVerificationCredits, opts ...grpc.CallOption) (*MsgPurchaseVerificationCreditsResponse, error) {
+	out := new(MsgPurchaseVerificationCreditsResponse)
+	err := c.cc.Invoke(ctx, Msg_PurchaseVerificationCredits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RefundVerificationCredits(ctx context.Context, in *MsgRefundVerificationCredits, opts ...grpc.CallOption) (*MsgRefundVerificationCreditsResponse, error) {
+	out := new(MsgRefundVerificationCreditsResponse)
+	err := c.cc.Invoke(ctx, Msg_RefundVerificationCredits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SettleSessionVouchers(ctx context.Context, in *MsgSettleSessionVouchers, opts ...grpc.CallOption) (*MsgSettleSessionVouchersResponse, error) {
+	out := new(MsgSettleSessionVouchersResponse)
+	err := c.cc.Invoke(ctx, Msg_SettleSessionVouchers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AnchorVPMessage(ctx context.Context, in *MsgAnchorVPMessage, opts ...grpc.CallOption) (*MsgAnchorVPMessageResponse, error) {
+	out := new(MsgAnchorVPMessageResponse)
+	err := c.cc.Invoke(ctx, Msg_AnchorVPMessage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdatePermissionFees(ctx context.Context, in *MsgUpdatePermissionFees, opts ...grpc.CallOption) (*MsgUpdatePermissionFeesResponse, error) {
+	out := new(MsgUpdatePermissionFeesResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdatePermissionFees_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -211,6 +365,20 @@ type MsgServer interface {
 	SlashPermissionTrustDeposit(context.Context, *MsgSlashPermissionTrustDeposit) (*MsgSlashPermissionTrustDepositResponse, error)
 	RepayPermissionSlashedTrustDeposit(context.Context, *MsgRepayPermissionSlashedTrustDeposit) (*MsgRepayPermissionSlashedTrustDepositResponse, error)
 	CreatePermission(context.Context, *MsgCreatePermission) (*MsgCreatePermissionResponse, error)
+	CreateSessionAllowance(context.Context, *MsgCreateSessionAllowance) (*MsgCreateSessionAllowanceResponse, error)
+	FundSessionAllowance(context.Context, *MsgFundSessionAllowance) (*MsgFundSessionAllowanceResponse, error)
+	WithdrawSessionAllowance(context.Context, *MsgWithdrawSessionAllowance) (*MsgWithdrawSessionAllowanceResponse, error)
+	AppealSlash(context.Context, *MsgAppealSlash) (*MsgAppealSlashResponse, error)
+	ResolveSlashAppeal(context.Context, *MsgResolveSlashAppeal) (*MsgResolveSlashAppealResponse, error)
+	SetSlashDistribution(context.Context, *MsgSetSlashDistribution) (*MsgSetSlashDistributionResponse, error)
+	SuspendPermission(context.Context, *MsgSuspendPermission) (*MsgSuspendPermissionResponse, error)
+	UnsuspendPermission(context.Context, *MsgUnsuspendPermission) (*MsgUnsuspendPermissionResponse, error)
+	ClosePermissionSession(context.Context, *MsgClosePermissionSession) (*MsgClosePermissionSessionResponse, error)
+	PurchaseVerificationCredits(context.Context, *MsgPurchaseVerificationCredits) (*MsgPurchaseVerificationCreditsResponse, error)
+	RefundVerificationCredits(context.Context, *MsgRefundVerificationCredits) (*MsgRefundVerificationCreditsResponse, error)
+	SettleSessionVouchers(context.Context, *MsgSettleSessionVouchers) (*MsgSettleSessionVouchersResponse, error)
+	AnchorVPMessage(context.Context, *MsgAnchorVPMessage) (*MsgAnchorVPMessageResponse, error)
+	UpdatePermissionFees(context.Context, *MsgUpdatePermissionFees) (*MsgUpdatePermissionFeesResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -260,6 +428,48 @@ func (UnimplementedMsgServer) RepayPermissionSlashedTrustDeposit(context.Context
 func (UnimplementedMsgServer) CreatePermission(context.Context, *MsgCreatePermission) (*MsgCreatePermissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePermission not implemented")
 }
+func (UnimplementedMsgServer) CreateSessionAllowance(context.Context, *MsgCreateSessionAllowance) (*MsgCreateSessionAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSessionAllowance not implemented")
+}
+func (UnimplementedMsgServer) FundSessionAllowance(context.Context, *MsgFundSessionAllowance) (*MsgFundSessionAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundSessionAllowance not implemented")
+}
+func (UnimplementedMsgServer) WithdrawSessionAllowance(context.Context, *MsgWithdrawSessionAllowance) (*MsgWithdrawSessionAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawSessionAllowance not implemented")
+}
+func (UnimplementedMsgServer) AppealSlash(context.Context, *MsgAppealSlash) (*MsgAppealSlashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppealSlash not implemented")
+}
+func (UnimplementedMsgServer) ResolveSlashAppeal(context.Context, *MsgResolveSlashAppeal) (*MsgResolveSlashAppealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveSlashAppeal not implemented")
+}
+func (UnimplementedMsgServer) SetSlashDistribution(context.Context, *MsgSetSlashDistribution) (*MsgSetSlashDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSlashDistribution not implemented")
+}
+func (UnimplementedMsgServer) SuspendPermission(context.Context, *MsgSuspendPermission) (*MsgSuspendPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendPermission not implemented")
+}
+func (UnimplementedMsgServer) UnsuspendPermission(context.Context, *MsgUnsuspendPermission) (*MsgUnsuspendPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsuspendPermission not implemented")
+}
+func (UnimplementedMsgServer) ClosePermissionSession(context.Context, *MsgClosePermissionSession) (*MsgClosePermissionSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClosePermissionSession not implemented")
+}
+func (UnimplementedMsgServer) PurchaseVerificationCredits(context.Context, *MsgPurchaseVerificationCredits) (*MsgPurchaseVerificationCreditsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchaseVerificationCredits not implemented")
+}
+func (UnimplementedMsgServer) RefundVerificationCredits(context.Context, *MsgRefundVerificationCredits) (*MsgRefundVerificationCreditsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundVerificationCredits not implemented")
+}
+func (UnimplementedMsgServer) SettleSessionVouchers(context.Context, *MsgSettleSessionVouchers) (*MsgSettleSessionVouchersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleSessionVouchers not implemented")
+}
+func (UnimplementedMsgServer) AnchorVPMessage(context.Context, *MsgAnchorVPMessage) (*MsgAnchorVPMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnchorVPMessage not implemented")
+}
+func (UnimplementedMsgServer) UpdatePermissionFees(context.Context, *MsgUpdatePermissionFees) (*MsgUpdatePermissionFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePermissionFees not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -525,6 +735,258 @@ func _Msg_CreatePermission_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateSessionAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateSessionAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateSessionAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateSessionAllowance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateSessionAllowance(ctx, req.(*MsgCreateSessionAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundSessionAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundSessionAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundSessionAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_FundSessionAllowance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundSessionAllowance(ctx, req.(*MsgFundSessionAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawSessionAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawSessionAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawSessionAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_WithdrawSessionAllowance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawSessionAllowance(ctx, req.(*MsgWithdrawSessionAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AppealSlash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAppealSlash)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AppealSlash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AppealSlash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AppealSlash(ctx, req.(*MsgAppealSlash))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResolveSlashAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResolveSlashAppeal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResolveSlashAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ResolveSlashAppeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResolveSlashAppeal(ctx, req.(*MsgResolveSlashAppeal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSlashDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSlashDistribution)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSlashDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetSlashDistribution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSlashDistribution(ctx, req.(*MsgSetSlashDistribution))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SuspendPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSuspendPermission)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SuspendPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SuspendPermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SuspendPermission(ctx, req.(*MsgSuspendPermission))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnsuspendPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnsuspendPermission)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnsuspendPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnsuspendPermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnsuspendPermission(ctx, req.(*MsgUnsuspendPermission))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClosePermissionSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClosePermissionSession)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClosePermissionSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClosePermissionSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClosePermissionSession(ctx, req.(*MsgClosePermissionSession))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PurchaseVerificationCredits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPurchaseVerificationCredits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PurchaseVerificationCredits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PurchaseVerificationCredits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PurchaseVerificationCredits(ctx, req.(*MsgPurchaseVerificationCredits))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RefundVerificationCredits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefundVerificationCredits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RefundVerificationCredits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RefundVerificationCredits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RefundVerificationCredits(ctx, req.(*MsgRefundVerificationCredits))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SettleSessionVouchers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSettleSessionVouchers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SettleSessionVouchers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SettleSessionVouchers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SettleSessionVouchers(ctx, req.(*MsgSettleSessionVouchers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AnchorVPMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAnchorVPMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AnchorVPMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AnchorVPMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AnchorVPMessage(ctx, req.(*MsgAnchorVPMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdatePermissionFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePermissionFees)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePermissionFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdatePermissionFees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePermissionFees(ctx, req.(*MsgUpdatePermissionFees))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -588,6 +1050,62 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreatePermission",
 			Handler:    _Msg_CreatePermission_Handler,
 		},
+		{
+			MethodName: "CreateSessionAllowance",
+			Handler:    _Msg_CreateSessionAllowance_Handler,
+		},
+		{
+			MethodName: "FundSessionAllowance",
+			Handler:    _Msg_FundSessionAllowance_Handler,
+		},
+		{
+			MethodName: "WithdrawSessionAllowance",
+			Handler:    _Msg_WithdrawSessionAllowance_Handler,
+		},
+		{
+			MethodName: "AppealSlash",
+			Handler:    _Msg_AppealSlash_Handler,
+		},
+		{
+			MethodName: "ResolveSlashAppeal",
+			Handler:    _Msg_ResolveSlashAppeal_Handler,
+		},
+		{
+			MethodName: "SetSlashDistribution",
+			Handler:    _Msg_SetSlashDistribution_Handler,
+		},
+		{
+			MethodName: "SuspendPermission",
+			Handler:    _Msg_SuspendPermission_Handler,
+		},
+		{
+			MethodName: "UnsuspendPermission",
+			Handler:    _Msg_UnsuspendPermission_Handler,
+		},
+		{
+			MethodName: "ClosePermissionSession",
+			Handler:    _Msg_ClosePermissionSession_Handler,
+		},
+		{
+			MethodName: "PurchaseVerificationCredits",
+			Handler:    _Msg_PurchaseVerificationCredits_Handler,
+		},
+		{
+			MethodName: "RefundVerificationCredits",
+			Handler:    _Msg_RefundVerificationCredits_Handler,
+		},
+		{
+			MethodName: "SettleSessionVouchers",
+			Handler:    _Msg_SettleSessionVouchers_Handler,
+		},
+		{
+			MethodName: "AnchorVPMessage",
+			Handler:    _Msg_AnchorVPMessage_Handler,
+		},
+		{
+			MethodName: "UpdatePermissionFees",
+			Handler:    _Msg_UpdatePermissionFees_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "verana/perm/v1/tx.proto",
@@ -7,6 +7,7 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
+	v1 "github.com/verana-labs/verana-blockchain/api/verana/td/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -16,44 +17,247 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Permission_27_list)(nil)
+
+type _Permission_27_list struct {
+	list *[]string
+}
+
+func (x *_Permission_27_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Permission_27_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Permission_27_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Permission_27_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Permission_27_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Permission at list field Countries as it is not of Message kind"))
+}
+
+func (x *_Permission_27_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Permission_27_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Permission_27_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Permission_41_list)(nil)
+
+type _Permission_41_list struct {
+	list *[]uint64
+}
+
+func (x *_Permission_41_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Permission_41_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_Permission_41_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Permission_41_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Permission_41_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Permission at list field CoValidatorPermIds as it is not of Message kind"))
+}
+
+func (x *_Permission_41_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Permission_41_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_Permission_41_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Permission_43_list)(nil)
+
+type _Permission_43_list struct {
+	list *[]*VPApproval
+}
+
+func (x *_Permission_43_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Permission_43_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Permission_43_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VPApproval)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Permission_43_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VPApproval)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Permission_43_list) AppendMutable() protoreflect.Value {
+	v := new(VPApproval)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Permission_43_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Permission_43_list) NewElement() protoreflect.Value {
+	v := new(VPApproval)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Permission_43_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Permission_44_list)(nil)
+
+type _Permission_44_list struct {
+	list *[]*CoValidatorDeposit
+}
+
+func (x *_Permission_44_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Permission_44_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Permission_44_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CoValidatorDeposit)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Permission_44_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CoValidatorDeposit)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Permission_44_list) AppendMutable() protoreflect.Value {
+	v := new(CoValidatorDeposit)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Permission_44_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Permission_44_list) NewElement() protoreflect.Value {
+	v := new(CoValidatorDeposit)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Permission_44_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Permission                       protoreflect.MessageDescriptor
-	fd_Permission_id                    protoreflect.FieldDescriptor
-	fd_Permission_schema_id             protoreflect.FieldDescriptor
-	fd_Permission_type                  protoreflect.FieldDescriptor
-	fd_Permission_did                   protoreflect.FieldDescriptor
-	fd_Permission_grantee               protoreflect.FieldDescriptor
-	fd_Permission_created               protoreflect.FieldDescriptor
-	fd_Permission_created_by            protoreflect.FieldDescriptor
-	fd_Permission_extended              protoreflect.FieldDescriptor
-	fd_Permission_extended_by           protoreflect.FieldDescriptor
-	fd_Permission_slashed               protoreflect.FieldDescriptor
-	fd_Permission_slashed_by            protoreflect.FieldDescriptor
-	fd_Permission_repaid                protoreflect.FieldDescriptor
-	fd_Permission_repaid_by             protoreflect.FieldDescriptor
-	fd_Permission_effective_from        protoreflect.FieldDescriptor
-	fd_Permission_effective_until       protoreflect.FieldDescriptor
-	fd_Permission_modified              protoreflect.FieldDescriptor
-	fd_Permission_validation_fees       protoreflect.FieldDescriptor
-	fd_Permission_issuance_fees         protoreflect.FieldDescriptor
-	fd_Permission_verification_fees     protoreflect.FieldDescriptor
-	fd_Permission_deposit               protoreflect.FieldDescriptor
-	fd_Permission_slashed_deposit       protoreflect.FieldDescriptor
-	fd_Permission_repaid_deposit        protoreflect.FieldDescriptor
-	fd_Permission_revoked               protoreflect.FieldDescriptor
-	fd_Permission_revoked_by            protoreflect.FieldDescriptor
-	fd_Permission_terminated            protoreflect.FieldDescriptor
-	fd_Permission_terminated_by         protoreflect.FieldDescriptor
-	fd_Permission_country               protoreflect.FieldDescriptor
-	fd_Permission_validator_perm_id     protoreflect.FieldDescriptor
-	fd_Permission_vp_state              protoreflect.FieldDescriptor
-	fd_Permission_vp_exp                protoreflect.FieldDescriptor
-	fd_Permission_vp_last_state_change  protoreflect.FieldDescriptor
-	fd_Permission_vp_validator_deposit  protoreflect.FieldDescriptor
-	fd_Permission_vp_current_fees       protoreflect.FieldDescriptor
-	fd_Permission_vp_current_deposit    protoreflect.FieldDescriptor
-	fd_Permission_vp_summary_digest_sri protoreflect.FieldDescriptor
-	fd_Permission_vp_term_requested     protoreflect.FieldDescriptor
+	md_Permission                          protoreflect.MessageDescriptor
+	fd_Permission_id                       protoreflect.FieldDescriptor
+	fd_Permission_schema_id                protoreflect.FieldDescriptor
+	fd_Permission_type                     protoreflect.FieldDescriptor
+	fd_Permission_did                      protoreflect.FieldDescriptor
+	fd_Permission_grantee                  protoreflect.FieldDescriptor
+	fd_Permission_created                  protoreflect.FieldDescriptor
+	fd_Permission_created_by               protoreflect.FieldDescriptor
+	fd_Permission_extended                 protoreflect.FieldDescriptor
+	fd_Permission_extended_by              protoreflect.FieldDescriptor
+	fd_Permission_slashed                  protoreflect.FieldDescriptor
+	fd_Permission_slashed_by               protoreflect.FieldDescriptor
+	fd_Permission_repaid                   protoreflect.FieldDescriptor
+	fd_Permission_repaid_by                protoreflect.FieldDescriptor
+	fd_Permission_effective_from           protoreflect.FieldDescriptor
+	fd_Permission_effective_until          protoreflect.FieldDescriptor
+	fd_Permission_modified                 protoreflect.FieldDescriptor
+	fd_Permission_validation_fees          protoreflect.FieldDescriptor
+	fd_Permission_issuance_fees            protoreflect.FieldDescriptor
+	fd_Permission_verification_fees        protoreflect.FieldDescriptor
+	fd_Permission_deposit                  protoreflect.FieldDescriptor
+	fd_Permission_slashed_deposit          protoreflect.FieldDescriptor
+	fd_Permission_repaid_deposit           protoreflect.FieldDescriptor
+	fd_Permission_revoked                  protoreflect.FieldDescriptor
+	fd_Permission_revoked_by               protoreflect.FieldDescriptor
+	fd_Permission_terminated               protoreflect.FieldDescriptor
+	fd_Permission_terminated_by            protoreflect.FieldDescriptor
+	fd_Permission_countries                protoreflect.FieldDescriptor
+	fd_Permission_validator_perm_id        protoreflect.FieldDescriptor
+	fd_Permission_vp_state                 protoreflect.FieldDescriptor
+	fd_Permission_vp_exp                   protoreflect.FieldDescriptor
+	fd_Permission_vp_last_state_change     protoreflect.FieldDescriptor
+	fd_Permission_vp_validator_deposit     protoreflect.FieldDescriptor
+	fd_Permission_vp_current_fees          protoreflect.FieldDescriptor
+	fd_Permission_vp_current_deposit       protoreflect.FieldDescriptor
+	fd_Permission_vp_summary_digest_sri    protoreflect.FieldDescriptor
+	fd_Permission_vp_term_requested        protoreflect.FieldDescriptor
+	fd_Permission_suspended                protoreflect.FieldDescriptor
+	fd_Permission_suspended_by             protoreflect.FieldDescriptor
+	fd_Permission_suspension_reason        protoreflect.FieldDescriptor
+	fd_Permission_suspended_until          protoreflect.FieldDescriptor
+	fd_Permission_co_validator_perm_ids    protoreflect.FieldDescriptor
+	fd_Permission_vp_quorum                protoreflect.FieldDescriptor
+	fd_Permission_vp_approvals             protoreflect.FieldDescriptor
+	fd_Permission_vp_co_validator_deposits protoreflect.FieldDescriptor
+	fd_Permission_scheduled_fees           protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -85,7 +289,7 @@ func init() {
 	fd_Permission_revoked_by = md_Permission.Fields().ByName("revoked_by")
 	fd_Permission_terminated = md_Permission.Fields().ByName("terminated")
 	fd_Permission_terminated_by = md_Permission.Fields().ByName("terminated_by")
-	fd_Permission_country = md_Permission.Fields().ByName("country")
+	fd_Permission_countries = md_Permission.Fields().ByName("countries")
 	fd_Permission_validator_perm_id = md_Permission.Fields().ByName("validator_perm_id")
 	fd_Permission_vp_state = md_Permission.Fields().ByName("vp_state")
 	fd_Permission_vp_exp = md_Permission.Fields().ByName("vp_exp")
@@ -95,6 +299,15 @@ func init() {
 	fd_Permission_vp_current_deposit = md_Permission.Fields().ByName("vp_current_deposit")
 	fd_Permission_vp_summary_digest_sri = md_Permission.Fields().ByName("vp_summary_digest_sri")
 	fd_Permission_vp_term_requested = md_Permission.Fields().ByName("vp_term_requested")
+	fd_Permission_suspended = md_Permission.Fields().ByName("suspended")
+	fd_Permission_suspended_by = md_Permission.Fields().ByName("suspended_by")
+	fd_Permission_suspension_reason = md_Permission.Fields().ByName("suspension_reason")
+	fd_Permission_suspended_until = md_Permission.Fields().ByName("suspended_until")
+	fd_Permission_co_validator_perm_ids = md_Permission.Fields().ByName("co_validator_perm_ids")
+	fd_Permission_vp_quorum = md_Permission.Fields().ByName("vp_quorum")
+	fd_Permission_vp_approvals = md_Permission.Fields().ByName("vp_approvals")
+	fd_Permission_vp_co_validator_deposits = md_Permission.Fields().ByName("vp_co_validator_deposits")
+	fd_Permission_scheduled_fees = md_Permission.Fields().ByName("scheduled_fees")
 }
 
 var _ protoreflect.Message = (*fastReflection_Permission)(nil)
@@ -318,9 +531,9 @@ func (x *fastReflection_Permission) Range(f func(protoreflect.FieldDescriptor, p
 			return
 		}
 	}
-	if x.Country != "" {
-		value := protoreflect.ValueOfString(x.Country)
-		if !f(fd_Permission_country, value) {
+	if len(x.Countries) != 0 {
+		value := protoreflect.ValueOfList(&_Permission_27_list{list: &x.Countries})
+		if !f(fd_Permission_countries, value) {
 			return
 		}
 	}
@@ -378,6 +591,60 @@ func (x *fastReflection_Permission) Range(f func(protoreflect.FieldDescriptor, p
 			return
 		}
 	}
+	if x.Suspended != nil {
+		value := protoreflect.ValueOfMessage(x.Suspended.ProtoReflect())
+		if !f(fd_Permission_suspended, value) {
+			return
+		}
+	}
+	if x.SuspendedBy != "" {
+		value := protoreflect.ValueOfString(x.SuspendedBy)
+		if !f(fd_Permission_suspended_by, value) {
+			return
+		}
+	}
+	if x.SuspensionReason != "" {
+		value := protoreflect.ValueOfString(x.SuspensionReason)
+		if !f(fd_Permission_suspension_reason, value) {
+			return
+		}
+	}
+	if x.SuspendedUntil != nil {
+		value := protoreflect.ValueOfMessage(x.SuspendedUntil.ProtoReflect())
+		if !f(fd_Permission_suspended_until, value) {
+			return
+		}
+	}
+	if len(x.CoValidatorPermIds) != 0 {
+		value := protoreflect.ValueOfList(&_Permission_41_list{list: &x.CoValidatorPermIds})
+		if !f(fd_Permission_co_validator_perm_ids, value) {
+			return
+		}
+	}
+	if x.VpQuorum != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.VpQuorum)
+		if !f(fd_Permission_vp_quorum, value) {
+			return
+		}
+	}
+	if len(x.VpApprovals) != 0 {
+		value := protoreflect.ValueOfList(&_Permission_43_list{list: &x.VpApprovals})
+		if !f(fd_Permission_vp_approvals, value) {
+			return
+		}
+	}
+	if len(x.VpCoValidatorDeposits) != 0 {
+		value := protoreflect.ValueOfList(&_Permission_44_list{list: &x.VpCoValidatorDeposits})
+		if !f(fd_Permission_vp_co_validator_deposits, value) {
+			return
+		}
+	}
+	if x.ScheduledFees != nil {
+		value := protoreflect.ValueOfMessage(x.ScheduledFees.ProtoReflect())
+		if !f(fd_Permission_scheduled_fees, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -445,8 +712,8 @@ func (x *fastReflection_Permission) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Terminated != nil
 	case "verana.perm.v1.Permission.terminated_by":
 		return x.TerminatedBy != ""
-	case "verana.perm.v1.Permission.country":
-		return x.Country != ""
+	case "verana.perm.v1.Permission.countries":
+		return len(x.Countries) != 0
 	case "verana.perm.v1.Permission.validator_perm_id":
 		return x.ValidatorPermId != uint64(0)
 	case "verana.perm.v1.Permission.vp_state":
@@ -465,6 +732,24 @@ func (x *fastReflection_Permission) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.VpSummaryDigestSri != ""
 	case "verana.perm.v1.Permission.vp_term_requested":
 		return x.VpTermRequested != nil
+	case "verana.perm.v1.Permission.suspended":
+		return x.Suspended != nil
+	case "verana.perm.v1.Permission.suspended_by":
+		return x.SuspendedBy != ""
+	case "verana.perm.v1.Permission.suspension_reason":
+		return x.SuspensionReason != ""
+	case "verana.perm.v1.Permission.suspended_until":
+		return x.SuspendedUntil != nil
+	case "verana.perm.v1.Permission.co_validator_perm_ids":
+		return len(x.CoValidatorPermIds) != 0
+	case "verana.perm.v1.Permission.vp_quorum":
+		return x.VpQuorum != uint32(0)
+	case "verana.perm.v1.Permission.vp_approvals":
+		return len(x.VpApprovals) != 0
+	case "verana.perm.v1.Permission.vp_co_validator_deposits":
+		return len(x.VpCoValidatorDeposits) != 0
+	case "verana.perm.v1.Permission.scheduled_fees":
+		return x.ScheduledFees != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.Permission"))
@@ -533,8 +818,8 @@ func (x *fastReflection_Permission) Clear(fd protoreflect.FieldDescriptor) {
 		x.Terminated = nil
 	case "verana.perm.v1.Permission.terminated_by":
 		x.TerminatedBy = ""
-	case "verana.perm.v1.Permission.country":
-		x.Country = ""
+	case "verana.perm.v1.Permission.countries":
+		x.Countries = nil
 	case "verana.perm.v1.Permission.validator_perm_id":
 		x.ValidatorPermId = uint64(0)
 	case "verana.perm.v1.Permission.vp_state":
@@ -553,6 +838,24 @@ func (x *fastReflection_Permission) Clear(fd protoreflect.FieldDescriptor) {
 		x.VpSummaryDigestSri = ""
 	case "verana.perm.v1.Permission.vp_term_requested":
 		x.VpTermRequested = nil
+	case "verana.perm.v1.Permission.suspended":
+		x.Suspended = nil
+	case "verana.perm.v1.Permission.suspended_by":
+		x.SuspendedBy = ""
+	case "verana.perm.v1.Permission.suspension_reason":
+		x.SuspensionReason = ""
+	case "verana.perm.v1.Permission.suspended_until":
+		x.SuspendedUntil = nil
+	case "verana.perm.v1.Permission.co_validator_perm_ids":
+		x.CoValidatorPermIds = nil
+	case "verana.perm.v1.Permission.vp_quorum":
+		x.VpQuorum = uint32(0)
+	case "verana.perm.v1.Permission.vp_approvals":
+		x.VpApprovals = nil
+	case "verana.perm.v1.Permission.vp_co_validator_deposits":
+		x.VpCoValidatorDeposits = nil
+	case "verana.perm.v1.Permission.scheduled_fees":
+		x.ScheduledFees = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.Permission"))
@@ -647,9 +950,12 @@ func (x *fastReflection_Permission) Get(descriptor protoreflect.FieldDescriptor)
 	case "verana.perm.v1.Permission.terminated_by":
 		value := x.TerminatedBy
 		return protoreflect.ValueOfString(value)
-	case "verana.perm.v1.Permission.country":
-		value := x.Country
-		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.Permission.countries":
+		if len(x.Countries) == 0 {
+			return protoreflect.ValueOfList(&_Permission_27_list{})
+		}
+		listValue := &_Permission_27_list{list: &x.Countries}
+		return protoreflect.ValueOfList(listValue)
 	case "verana.perm.v1.Permission.validator_perm_id":
 		value := x.ValidatorPermId
 		return protoreflect.ValueOfUint64(value)
@@ -677,6 +983,42 @@ func (x *fastReflection_Permission) Get(descriptor protoreflect.FieldDescriptor)
 	case "verana.perm.v1.Permission.vp_term_requested":
 		value := x.VpTermRequested
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.perm.v1.Permission.suspended":
+		value := x.Suspended
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.perm.v1.Permission.suspended_by":
+		value := x.SuspendedBy
+		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.Permission.suspension_reason":
+		value := x.SuspensionReason
+		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.Permission.suspended_until":
+		value := x.SuspendedUntil
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.perm.v1.Permission.co_validator_perm_ids":
+		if len(x.CoValidatorPermIds) == 0 {
+			return protoreflect.ValueOfList(&_Permission_41_list{})
+		}
+		listValue := &_Permission_41_list{list: &x.CoValidatorPermIds}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.perm.v1.Permission.vp_quorum":
+		value := x.VpQuorum
+		return protoreflect.ValueOfUint32(value)
+	case "verana.perm.v1.Permission.vp_approvals":
+		if len(x.VpApprovals) == 0 {
+			return protoreflect.ValueOfList(&_Permission_43_list{})
+		}
+		listValue := &_Permission_43_list{list: &x.VpApprovals}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.perm.v1.Permission.vp_co_validator_deposits":
+		if len(x.VpCoValidatorDeposits) == 0 {
+			return protoreflect.ValueOfList(&_Permission_44_list{})
+		}
+		listValue := &_Permission_44_list{list: &x.VpCoValidatorDeposits}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.perm.v1.Permission.scheduled_fees":
+		value := x.ScheduledFees
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.Permission"))
@@ -749,8 +1091,10 @@ func (x *fastReflection_Permission) Set(fd protoreflect.FieldDescriptor, value p
 		x.Terminated = value.Message().Interface().(*timestamppb.Timestamp)
 	case "verana.perm.v1.Permission.terminated_by":
 		x.TerminatedBy = value.Interface().(string)
-	case "verana.perm.v1.Permission.country":
-		x.Country = value.Interface().(string)
+	case "verana.perm.v1.Permission.countries":
+		lv := value.List()
+		clv := lv.(*_Permission_27_list)
+		x.Countries = *clv.list
 	case "verana.perm.v1.Permission.validator_perm_id":
 		x.ValidatorPermId = value.Uint()
 	case "verana.perm.v1.Permission.vp_state":
@@ -769,6 +1113,30 @@ func (x *fastReflection_Permission) Set(fd protoreflect.FieldDescriptor, value p
 		x.VpSummaryDigestSri = value.Interface().(string)
 	case "verana.perm.v1.Permission.vp_term_requested":
 		x.VpTermRequested = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.perm.v1.Permission.suspended":
+		x.Suspended = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.perm.v1.Permission.suspended_by":
+		x.SuspendedBy = value.Interface().(string)
+	case "verana.perm.v1.Permission.suspension_reason":
+		x.SuspensionReason = value.Interface().(string)
+	case "verana.perm.v1.Permission.suspended_until":
+		x.SuspendedUntil = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.perm.v1.Permission.co_validator_perm_ids":
+		lv := value.List()
+		clv := lv.(*_Permission_41_list)
+		x.CoValidatorPermIds = *clv.list
+	case "verana.perm.v1.Permission.vp_quorum":
+		x.VpQuorum = uint32(value.Uint())
+	case "verana.perm.v1.Permission.vp_approvals":
+		lv := value.List()
+		clv := lv.(*_Permission_43_list)
+		x.VpApprovals = *clv.list
+	case "verana.perm.v1.Permission.vp_co_validator_deposits":
+		lv := value.List()
+		clv := lv.(*_Permission_44_list)
+		x.VpCoValidatorDeposits = *clv.list
+	case "verana.perm.v1.Permission.scheduled_fees":
+		x.ScheduledFees = value.Message().Interface().(*PermissionFees)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.Permission"))
@@ -834,6 +1202,12 @@ func (x *fastReflection_Permission) Mutable(fd protoreflect.FieldDescriptor) pro
 			x.Terminated = new(timestamppb.Timestamp)
 		}
 		return protoreflect.ValueOfMessage(x.Terminated.ProtoReflect())
+	case "verana.perm.v1.Permission.countries":
+		if x.Countries == nil {
+			x.Countries = []string{}
+		}
+		value := &_Permission_27_list{list: &x.Countries}
+		return protoreflect.ValueOfList(value)
 	case "verana.perm.v1.Permission.vp_exp":
 		if x.VpExp == nil {
 			x.VpExp = new(timestamppb.Timestamp)
@@ -849,6 +1223,39 @@ func (x *fastReflection_Permission) Mutable(fd protoreflect.FieldDescriptor) pro
 			x.VpTermRequested = new(timestamppb.Timestamp)
 		}
 		return protoreflect.ValueOfMessage(x.VpTermRequested.ProtoReflect())
+	case "verana.perm.v1.Permission.suspended":
+		if x.Suspended == nil {
+			x.Suspended = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Suspended.ProtoReflect())
+	case "verana.perm.v1.Permission.suspended_until":
+		if x.SuspendedUntil == nil {
+			x.SuspendedUntil = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.SuspendedUntil.ProtoReflect())
+	case "verana.perm.v1.Permission.co_validator_perm_ids":
+		if x.CoValidatorPermIds == nil {
+			x.CoValidatorPermIds = []uint64{}
+		}
+		value := &_Permission_41_list{list: &x.CoValidatorPermIds}
+		return protoreflect.ValueOfList(value)
+	case "verana.perm.v1.Permission.vp_approvals":
+		if x.VpApprovals == nil {
+			x.VpApprovals = []*VPApproval{}
+		}
+		value := &_Permission_43_list{list: &x.VpApprovals}
+		return protoreflect.ValueOfList(value)
+	case "verana.perm.v1.Permission.vp_co_validator_deposits":
+		if x.VpCoValidatorDeposits == nil {
+			x.VpCoValidatorDeposits = []*CoValidatorDeposit{}
+		}
+		value := &_Permission_44_list{list: &x.VpCoValidatorDeposits}
+		return protoreflect.ValueOfList(value)
+	case "verana.perm.v1.Permission.scheduled_fees":
+		if x.ScheduledFees == nil {
+			x.ScheduledFees = new(PermissionFees)
+		}
+		return protoreflect.ValueOfMessage(x.ScheduledFees.ProtoReflect())
 	case "verana.perm.v1.Permission.id":
 		panic(fmt.Errorf("field id of message verana.perm.v1.Permission is not mutable"))
 	case "verana.perm.v1.Permission.schema_id":
@@ -883,8 +1290,6 @@ func (x *fastReflection_Permission) Mutable(fd protoreflect.FieldDescriptor) pro
 		panic(fmt.Errorf("field revoked_by of message verana.perm.v1.Permission is not mutable"))
 	case "verana.perm.v1.Permission.terminated_by":
 		panic(fmt.Errorf("field terminated_by of message verana.perm.v1.Permission is not mutable"))
-	case "verana.perm.v1.Permission.country":
-		panic(fmt.Errorf("field country of message verana.perm.v1.Permission is not mutable"))
 	case "verana.perm.v1.Permission.validator_perm_id":
 		panic(fmt.Errorf("field validator_perm_id of message verana.perm.v1.Permission is not mutable"))
 	case "verana.perm.v1.Permission.vp_state":
@@ -897,6 +1302,12 @@ func (x *fastReflection_Permission) Mutable(fd protoreflect.FieldDescriptor) pro
 		panic(fmt.Errorf("field vp_current_deposit of message verana.perm.v1.Permission is not mutable"))
 	case "verana.perm.v1.Permission.vp_summary_digest_sri":
 		panic(fmt.Errorf("field vp_summary_digest_sri of message verana.perm.v1.Permission is not mutable"))
+	case "verana.perm.v1.Permission.suspended_by":
+		panic(fmt.Errorf("field suspended_by of message verana.perm.v1.Permission is not mutable"))
+	case "verana.perm.v1.Permission.suspension_reason":
+		panic(fmt.Errorf("field suspension_reason of message verana.perm.v1.Permission is not mutable"))
+	case "verana.perm.v1.Permission.vp_quorum":
+		panic(fmt.Errorf("field vp_quorum of message verana.perm.v1.Permission is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.Permission"))
@@ -971,8 +1382,9 @@ func (x *fastReflection_Permission) NewField(fd protoreflect.FieldDescriptor) pr
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	case "verana.perm.v1.Permission.terminated_by":
 		return protoreflect.ValueOfString("")
-	case "verana.perm.v1.Permission.country":
-		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.Permission.countries":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Permission_27_list{list: &list})
 	case "verana.perm.v1.Permission.validator_perm_id":
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "verana.perm.v1.Permission.vp_state":
@@ -994,6 +1406,30 @@ func (x *fastReflection_Permission) NewField(fd protoreflect.FieldDescriptor) pr
 	case "verana.perm.v1.Permission.vp_term_requested":
 		m := new(timestamppb.Timestamp)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.perm.v1.Permission.suspended":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.perm.v1.Permission.suspended_by":
+		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.Permission.suspension_reason":
+		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.Permission.suspended_until":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.perm.v1.Permission.co_validator_perm_ids":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_Permission_41_list{list: &list})
+	case "verana.perm.v1.Permission.vp_quorum":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "verana.perm.v1.Permission.vp_approvals":
+		list := []*VPApproval{}
+		return protoreflect.ValueOfList(&_Permission_43_list{list: &list})
+	case "verana.perm.v1.Permission.vp_co_validator_deposits":
+		list := []*CoValidatorDeposit{}
+		return protoreflect.ValueOfList(&_Permission_44_list{list: &list})
+	case "verana.perm.v1.Permission.scheduled_fees":
+		m := new(PermissionFees)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.Permission"))
@@ -1158,9 +1594,11 @@ func (x *fastReflection_Permission) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 2 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Country)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
+		if len(x.Countries) > 0 {
+			for _, s := range x.Countries {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.ValidatorPermId != 0 {
 			n += 2 + runtime.Sov(uint64(x.ValidatorPermId))
@@ -1193,6 +1631,48 @@ func (x *fastReflection_Permission) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.VpTermRequested)
 			n += 2 + l + runtime.Sov(uint64(l))
 		}
+		if x.Suspended != nil {
+			l = options.Size(x.Suspended)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SuspendedBy)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SuspensionReason)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.SuspendedUntil != nil {
+			l = options.Size(x.SuspendedUntil)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.CoValidatorPermIds) > 0 {
+			l = 0
+			for _, e := range x.CoValidatorPermIds {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 2 + runtime.Sov(uint64(l)) + l
+		}
+		if x.VpQuorum != 0 {
+			n += 2 + runtime.Sov(uint64(x.VpQuorum))
+		}
+		if len(x.VpApprovals) > 0 {
+			for _, e := range x.VpApprovals {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.VpCoValidatorDeposits) > 0 {
+			for _, e := range x.VpCoValidatorDeposits {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ScheduledFees != nil {
+			l = options.Size(x.ScheduledFees)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1222,8 +1702,8 @@ func (x *fastReflection_Permission) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.VpTermRequested != nil {
-			encoded, err := options.Marshal(x.VpTermRequested)
+		if x.ScheduledFees != nil {
+			encoded, err := options.Marshal(x.ScheduledFees)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1236,25 +1716,156 @@ func (x *fastReflection_Permission) ProtoMethods() *protoiface.Methods {
 			i--
 			dAtA[i] = 0x2
 			i--
-			dAtA[i] = 0xa2
+			dAtA[i] = 0xea
 		}
-		if len(x.VpSummaryDigestSri) > 0 {
-			i -= len(x.VpSummaryDigestSri)
-			copy(dAtA[i:], x.VpSummaryDigestSri)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VpSummaryDigestSri)))
+		if len(x.VpCoValidatorDeposits) > 0 {
+			for iNdEx := len(x.VpCoValidatorDeposits) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.VpCoValidatorDeposits[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2
+				i--
+				dAtA[i] = 0xe2
+			}
+		}
+		if len(x.VpApprovals) > 0 {
+			for iNdEx := len(x.VpApprovals) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.VpApprovals[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2
+				i--
+				dAtA[i] = 0xda
+			}
+		}
+		if x.VpQuorum != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VpQuorum))
 			i--
 			dAtA[i] = 0x2
 			i--
-			dAtA[i] = 0x9a
+			dAtA[i] = 0xd0
 		}
-		if x.VpCurrentDeposit != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.VpCurrentDeposit))
+		if len(x.CoValidatorPermIds) > 0 {
+			var pksize2 int
+			for _, num := range x.CoValidatorPermIds {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.CoValidatorPermIds {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
 			i--
 			dAtA[i] = 0x2
 			i--
-			dAtA[i] = 0x90
+			dAtA[i] = 0xca
 		}
-		if x.VpCurrentFees != 0 {
+		if x.SuspendedUntil != nil {
+			encoded, err := options.Marshal(x.SuspendedUntil)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xc2
+		}
+		if len(x.SuspensionReason) > 0 {
+			i -= len(x.SuspensionReason)
+			copy(dAtA[i:], x.SuspensionReason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SuspensionReason)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xba
+		}
+		if len(x.SuspendedBy) > 0 {
+			i -= len(x.SuspendedBy)
+			copy(dAtA[i:], x.SuspendedBy)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SuspendedBy)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xb2
+		}
+		if x.Suspended != nil {
+			encoded, err := options.Marshal(x.Suspended)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xaa
+		}
+		if x.VpTermRequested != nil {
+			encoded, err := options.Marshal(x.VpTermRequested)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xa2
+		}
+		if len(x.VpSummaryDigestSri) > 0 {
+			i -= len(x.VpSummaryDigestSri)
+			copy(dAtA[i:], x.VpSummaryDigestSri)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VpSummaryDigestSri)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x9a
+		}
+		if x.VpCurrentDeposit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VpCurrentDeposit))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x90
+		}
+		if x.VpCurrentFees != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.VpCurrentFees))
 			i--
 			dAtA[i] = 0x2
@@ -1314,14 +1925,16 @@ func (x *fastReflection_Permission) ProtoMethods() *protoiface.Methods {
 			i--
 			dAtA[i] = 0xe0
 		}
-		if len(x.Country) > 0 {
-			i -= len(x.Country)
-			copy(dAtA[i:], x.Country)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Country)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xda
+		if len(x.Countries) > 0 {
+			for iNdEx := len(x.Countries) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Countries[iNdEx])
+				copy(dAtA[i:], x.Countries[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Countries[iNdEx])))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xda
+			}
 		}
 		if len(x.TerminatedBy) > 0 {
 			i -= len(x.TerminatedBy)
@@ -2374,7 +2987,7 @@ func (x *fastReflection_Permission) ProtoMethods() *protoiface.Methods {
 				iNdEx = postIndex
 			case 27:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Country", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Countries", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2402,7 +3015,7 @@ func (x *fastReflection_Permission) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Country = string(dAtA[iNdEx:postIndex])
+				x.Countries = append(x.Countries, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
 			case 28:
 				if wireType != 0 {
@@ -2639,175 +3252,512 @@ func (x *fastReflection_Permission) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 37:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Suspended", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Suspended == nil {
+					x.Suspended = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Suspended); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				iNdEx = postIndex
+			case 38:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SuspendedBy", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.SuspendedBy = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 39:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SuspensionReason", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var _ protoreflect.List = (*_PermissionSession_4_list)(nil)
-
-type _PermissionSession_4_list struct {
-	list *[]*SessionAuthz
-}
-
-func (x *_PermissionSession_4_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_PermissionSession_4_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_PermissionSession_4_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*SessionAuthz)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_PermissionSession_4_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*SessionAuthz)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_PermissionSession_4_list) AppendMutable() protoreflect.Value {
-	v := new(SessionAuthz)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_PermissionSession_4_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_PermissionSession_4_list) NewElement() protoreflect.Value {
-	v := new(SessionAuthz)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_PermissionSession_4_list) IsValid() bool {
-	return x.list != nil
-}
-
-var (
-	md_PermissionSession               protoreflect.MessageDescriptor
-	fd_PermissionSession_id            protoreflect.FieldDescriptor
-	fd_PermissionSession_controller    protoreflect.FieldDescriptor
-	fd_PermissionSession_agent_perm_id protoreflect.FieldDescriptor
-	fd_PermissionSession_authz         protoreflect.FieldDescriptor
-	fd_PermissionSession_created       protoreflect.FieldDescriptor
-	fd_PermissionSession_modified      protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_verana_perm_v1_types_proto_init()
-	md_PermissionSession = File_verana_perm_v1_types_proto.Messages().ByName("PermissionSession")
-	fd_PermissionSession_id = md_PermissionSession.Fields().ByName("id")
-	fd_PermissionSession_controller = md_PermissionSession.Fields().ByName("controller")
-	fd_PermissionSession_agent_perm_id = md_PermissionSession.Fields().ByName("agent_perm_id")
-	fd_PermissionSession_authz = md_PermissionSession.Fields().ByName("authz")
-	fd_PermissionSession_created = md_PermissionSession.Fields().ByName("created")
-	fd_PermissionSession_modified = md_PermissionSession.Fields().ByName("modified")
-}
-
-var _ protoreflect.Message = (*fastReflection_PermissionSession)(nil)
-
-type fastReflection_PermissionSession PermissionSession
-
-func (x *PermissionSession) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_PermissionSession)(x)
-}
-
-func (x *PermissionSession) slowProtoReflect() protoreflect.Message {
-	mi := &file_verana_perm_v1_types_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_PermissionSession_messageType fastReflection_PermissionSession_messageType
-var _ protoreflect.MessageType = fastReflection_PermissionSession_messageType{}
-
-type fastReflection_PermissionSession_messageType struct{}
-
-func (x fastReflection_PermissionSession_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_PermissionSession)(nil)
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SuspensionReason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 40:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SuspendedUntil", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.SuspendedUntil == nil {
+					x.SuspendedUntil = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SuspendedUntil); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 41:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.CoValidatorPermIds = append(x.CoValidatorPermIds, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.CoValidatorPermIds) == 0 {
+						x.CoValidatorPermIds = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.CoValidatorPermIds = append(x.CoValidatorPermIds, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CoValidatorPermIds", wireType)
+				}
+			case 42:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VpQuorum", wireType)
+				}
+				x.VpQuorum = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.VpQuorum |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 43:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VpApprovals", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VpApprovals = append(x.VpApprovals, &VPApproval{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VpApprovals[len(x.VpApprovals)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 44:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VpCoValidatorDeposits", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VpCoValidatorDeposits = append(x.VpCoValidatorDeposits, &CoValidatorDeposit{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VpCoValidatorDeposits[len(x.VpCoValidatorDeposits)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 45:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScheduledFees", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ScheduledFees == nil {
+					x.ScheduledFees = &PermissionFees{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ScheduledFees); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
 }
-func (x fastReflection_PermissionSession_messageType) New() protoreflect.Message {
-	return new(fastReflection_PermissionSession)
+
+var _ protoreflect.List = (*_PermissionSession_4_list)(nil)
+
+type _PermissionSession_4_list struct {
+	list *[]*SessionAuthz
 }
-func (x fastReflection_PermissionSession_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_PermissionSession
+
+func (x *_PermissionSession_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_PermissionSession) Descriptor() protoreflect.MessageDescriptor {
-	return md_PermissionSession
+func (x *_PermissionSession_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_PermissionSession) Type() protoreflect.MessageType {
-	return _fastReflection_PermissionSession_messageType
+func (x *_PermissionSession_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SessionAuthz)
+	(*x.list)[i] = concreteValue
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_PermissionSession) New() protoreflect.Message {
-	return new(fastReflection_PermissionSession)
+func (x *_PermissionSession_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SessionAuthz)
+	*x.list = append(*x.list, concreteValue)
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_PermissionSession) Interface() protoreflect.ProtoMessage {
-	return (*PermissionSession)(x)
+func (x *_PermissionSession_4_list) AppendMutable() protoreflect.Value {
+	v := new(SessionAuthz)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
+func (x *_PermissionSession_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PermissionSession_4_list) NewElement() protoreflect.Value {
+	v := new(SessionAuthz)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PermissionSession_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_PermissionSession               protoreflect.MessageDescriptor
+	fd_PermissionSession_id            protoreflect.FieldDescriptor
+	fd_PermissionSession_controller    protoreflect.FieldDescriptor
+	fd_PermissionSession_agent_perm_id protoreflect.FieldDescriptor
+	fd_PermissionSession_authz         protoreflect.FieldDescriptor
+	fd_PermissionSession_created       protoreflect.FieldDescriptor
+	fd_PermissionSession_modified      protoreflect.FieldDescriptor
+	fd_PermissionSession_expires       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_types_proto_init()
+	md_PermissionSession = File_verana_perm_v1_types_proto.Messages().ByName("PermissionSession")
+	fd_PermissionSession_id = md_PermissionSession.Fields().ByName("id")
+	fd_PermissionSession_controller = md_PermissionSession.Fields().ByName("controller")
+	fd_PermissionSession_agent_perm_id = md_PermissionSession.Fields().ByName("agent_perm_id")
+	fd_PermissionSession_authz = md_PermissionSession.Fields().ByName("authz")
+	fd_PermissionSession_created = md_PermissionSession.Fields().ByName("created")
+	fd_PermissionSession_modified = md_PermissionSession.Fields().ByName("modified")
+	fd_PermissionSession_expires = md_PermissionSession.Fields().ByName("expires")
+}
+
+var _ protoreflect.Message = (*fastReflection_PermissionSession)(nil)
+
+type fastReflection_PermissionSession PermissionSession
+
+func (x *PermissionSession) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PermissionSession)(x)
+}
+
+func (x *PermissionSession) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_types_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PermissionSession_messageType fastReflection_PermissionSession_messageType
+var _ protoreflect.MessageType = fastReflection_PermissionSession_messageType{}
+
+type fastReflection_PermissionSession_messageType struct{}
+
+func (x fastReflection_PermissionSession_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PermissionSession)(nil)
+}
+func (x fastReflection_PermissionSession_messageType) New() protoreflect.Message {
+	return new(fastReflection_PermissionSession)
+}
+func (x fastReflection_PermissionSession_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PermissionSession
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PermissionSession) Descriptor() protoreflect.MessageDescriptor {
+	return md_PermissionSession
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PermissionSession) Type() protoreflect.MessageType {
+	return _fastReflection_PermissionSession_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PermissionSession) New() protoreflect.Message {
+	return new(fastReflection_PermissionSession)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PermissionSession) Interface() protoreflect.ProtoMessage {
+	return (*PermissionSession)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
 func (x *fastReflection_PermissionSession) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
@@ -2847,6 +3797,12 @@ func (x *fastReflection_PermissionSession) Range(f func(protoreflect.FieldDescri
 			return
 		}
 	}
+	if x.Expires != nil {
+		value := protoreflect.ValueOfMessage(x.Expires.ProtoReflect())
+		if !f(fd_PermissionSession_expires, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2874,6 +3830,8 @@ func (x *fastReflection_PermissionSession) Has(fd protoreflect.FieldDescriptor)
 		return x.Created != nil
 	case "verana.perm.v1.PermissionSession.modified":
 		return x.Modified != nil
+	case "verana.perm.v1.PermissionSession.expires":
+		return x.Expires != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.PermissionSession"))
@@ -2902,6 +3860,8 @@ func (x *fastReflection_PermissionSession) Clear(fd protoreflect.FieldDescriptor
 		x.Created = nil
 	case "verana.perm.v1.PermissionSession.modified":
 		x.Modified = nil
+	case "verana.perm.v1.PermissionSession.expires":
+		x.Expires = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.PermissionSession"))
@@ -2939,6 +3899,9 @@ func (x *fastReflection_PermissionSession) Get(descriptor protoreflect.FieldDesc
 	case "verana.perm.v1.PermissionSession.modified":
 		value := x.Modified
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.perm.v1.PermissionSession.expires":
+		value := x.Expires
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.PermissionSession"))
@@ -2973,6 +3936,8 @@ func (x *fastReflection_PermissionSession) Set(fd protoreflect.FieldDescriptor,
 		x.Created = value.Message().Interface().(*timestamppb.Timestamp)
 	case "verana.perm.v1.PermissionSession.modified":
 		x.Modified = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.perm.v1.PermissionSession.expires":
+		x.Expires = value.Message().Interface().(*timestamppb.Timestamp)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.PermissionSession"))
@@ -3009,6 +3974,11 @@ func (x *fastReflection_PermissionSession) Mutable(fd protoreflect.FieldDescript
 			x.Modified = new(timestamppb.Timestamp)
 		}
 		return protoreflect.ValueOfMessage(x.Modified.ProtoReflect())
+	case "verana.perm.v1.PermissionSession.expires":
+		if x.Expires == nil {
+			x.Expires = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Expires.ProtoReflect())
 	case "verana.perm.v1.PermissionSession.id":
 		panic(fmt.Errorf("field id of message verana.perm.v1.PermissionSession is not mutable"))
 	case "verana.perm.v1.PermissionSession.controller":
@@ -3043,6 +4013,9 @@ func (x *fastReflection_PermissionSession) NewField(fd protoreflect.FieldDescrip
 	case "verana.perm.v1.PermissionSession.modified":
 		m := new(timestamppb.Timestamp)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.perm.v1.PermissionSession.expires":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.PermissionSession"))
@@ -3137,6 +4110,10 @@ func (x *fastReflection_PermissionSession) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.Modified)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Expires != nil {
+			l = options.Size(x.Expires)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3166,6 +4143,20 @@ func (x *fastReflection_PermissionSession) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Expires != nil {
+			encoded, err := options.Marshal(x.Expires)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
 		if x.Modified != nil {
 			encoded, err := options.Marshal(x.Modified)
 			if err != nil {
@@ -3467,6 +4458,42 @@ func (x *fastReflection_PermissionSession) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expires", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Expires == nil {
+					x.Expires = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expires); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3521,1061 +4548,12974 @@ var _ protoreflect.Message = (*fastReflection_SessionAuthz)(nil)
 
 type fastReflection_SessionAuthz SessionAuthz
 
-func (x *SessionAuthz) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SessionAuthz)(x)
+func (x *SessionAuthz) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SessionAuthz)(x)
+}
+
+func (x *SessionAuthz) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_types_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SessionAuthz_messageType fastReflection_SessionAuthz_messageType
+var _ protoreflect.MessageType = fastReflection_SessionAuthz_messageType{}
+
+type fastReflection_SessionAuthz_messageType struct{}
+
+func (x fastReflection_SessionAuthz_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SessionAuthz)(nil)
+}
+func (x fastReflection_SessionAuthz_messageType) New() protoreflect.Message {
+	return new(fastReflection_SessionAuthz)
+}
+func (x fastReflection_SessionAuthz_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SessionAuthz
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SessionAuthz) Descriptor() protoreflect.MessageDescriptor {
+	return md_SessionAuthz
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SessionAuthz) Type() protoreflect.MessageType {
+	return _fastReflection_SessionAuthz_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SessionAuthz) New() protoreflect.Message {
+	return new(fastReflection_SessionAuthz)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SessionAuthz) Interface() protoreflect.ProtoMessage {
+	return (*SessionAuthz)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SessionAuthz) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ExecutorPermId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExecutorPermId)
+		if !f(fd_SessionAuthz_executor_perm_id, value) {
+			return
+		}
+	}
+	if x.BeneficiaryPermId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BeneficiaryPermId)
+		if !f(fd_SessionAuthz_beneficiary_perm_id, value) {
+			return
+		}
+	}
+	if x.WalletAgentPermId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.WalletAgentPermId)
+		if !f(fd_SessionAuthz_wallet_agent_perm_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SessionAuthz) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.perm.v1.SessionAuthz.executor_perm_id":
+		return x.ExecutorPermId != uint64(0)
+	case "verana.perm.v1.SessionAuthz.beneficiary_perm_id":
+		return x.BeneficiaryPermId != uint64(0)
+	case "verana.perm.v1.SessionAuthz.wallet_agent_perm_id":
+		return x.WalletAgentPermId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SessionAuthz"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.SessionAuthz does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SessionAuthz) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.perm.v1.SessionAuthz.executor_perm_id":
+		x.ExecutorPermId = uint64(0)
+	case "verana.perm.v1.SessionAuthz.beneficiary_perm_id":
+		x.BeneficiaryPermId = uint64(0)
+	case "verana.perm.v1.SessionAuthz.wallet_agent_perm_id":
+		x.WalletAgentPermId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SessionAuthz"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.SessionAuthz does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SessionAuthz) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.perm.v1.SessionAuthz.executor_perm_id":
+		value := x.ExecutorPermId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.SessionAuthz.beneficiary_perm_id":
+		value := x.BeneficiaryPermId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.SessionAuthz.wallet_agent_perm_id":
+		value := x.WalletAgentPermId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SessionAuthz"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.SessionAuthz does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SessionAuthz) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.perm.v1.SessionAuthz.executor_perm_id":
+		x.ExecutorPermId = value.Uint()
+	case "verana.perm.v1.SessionAuthz.beneficiary_perm_id":
+		x.BeneficiaryPermId = value.Uint()
+	case "verana.perm.v1.SessionAuthz.wallet_agent_perm_id":
+		x.WalletAgentPermId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SessionAuthz"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.SessionAuthz does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SessionAuthz) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.SessionAuthz.executor_perm_id":
+		panic(fmt.Errorf("field executor_perm_id of message verana.perm.v1.SessionAuthz is not mutable"))
+	case "verana.perm.v1.SessionAuthz.beneficiary_perm_id":
+		panic(fmt.Errorf("field beneficiary_perm_id of message verana.perm.v1.SessionAuthz is not mutable"))
+	case "verana.perm.v1.SessionAuthz.wallet_agent_perm_id":
+		panic(fmt.Errorf("field wallet_agent_perm_id of message verana.perm.v1.SessionAuthz is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SessionAuthz"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.SessionAuthz does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SessionAuthz) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.SessionAuthz.executor_perm_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.SessionAuthz.beneficiary_perm_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.SessionAuthz.wallet_agent_perm_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SessionAuthz"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.SessionAuthz does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SessionAuthz) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.SessionAuthz", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SessionAuthz) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SessionAuthz) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SessionAuthz) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SessionAuthz) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SessionAuthz)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ExecutorPermId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecutorPermId))
+		}
+		if x.BeneficiaryPermId != 0 {
+			n += 1 + runtime.Sov(uint64(x.BeneficiaryPermId))
+		}
+		if x.WalletAgentPermId != 0 {
+			n += 1 + runtime.Sov(uint64(x.WalletAgentPermId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SessionAuthz)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.WalletAgentPermId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WalletAgentPermId))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.BeneficiaryPermId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BeneficiaryPermId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.ExecutorPermId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecutorPermId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SessionAuthz)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SessionAuthz: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SessionAuthz: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutorPermId", wireType)
+				}
+				x.ExecutorPermId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecutorPermId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BeneficiaryPermId", wireType)
+				}
+				x.BeneficiaryPermId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BeneficiaryPermId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletAgentPermId", wireType)
+				}
+				x.WalletAgentPermId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WalletAgentPermId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_SessionAllowance_10_list)(nil)
+
+type _SessionAllowance_10_list struct {
+	list *[]string
+}
+
+func (x *_SessionAllowance_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SessionAllowance_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_SessionAllowance_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SessionAllowance_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SessionAllowance_10_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message SessionAllowance at list field AllowedCreators as it is not of Message kind"))
+}
+
+func (x *_SessionAllowance_10_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SessionAllowance_10_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_SessionAllowance_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_SessionAllowance                  protoreflect.MessageDescriptor
+	fd_SessionAllowance_id               protoreflect.FieldDescriptor
+	fd_SessionAllowance_sponsor          protoreflect.FieldDescriptor
+	fd_SessionAllowance_schema_id        protoreflect.FieldDescriptor
+	fd_SessionAllowance_agent_perm_id    protoreflect.FieldDescriptor
+	fd_SessionAllowance_verifier_perm_id protoreflect.FieldDescriptor
+	fd_SessionAllowance_remaining        protoreflect.FieldDescriptor
+	fd_SessionAllowance_spent            protoreflect.FieldDescriptor
+	fd_SessionAllowance_created          protoreflect.FieldDescriptor
+	fd_SessionAllowance_modified         protoreflect.FieldDescriptor
+	fd_SessionAllowance_allowed_creators protoreflect.FieldDescriptor
+	fd_SessionAllowance_max_per_session  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_types_proto_init()
+	md_SessionAllowance = File_verana_perm_v1_types_proto.Messages().ByName("SessionAllowance")
+	fd_SessionAllowance_id = md_SessionAllowance.Fields().ByName("id")
+	fd_SessionAllowance_sponsor = md_SessionAllowance.Fields().ByName("sponsor")
+	fd_SessionAllowance_schema_id = md_SessionAllowance.Fields().ByName("schema_id")
+	fd_SessionAllowance_agent_perm_id = md_SessionAllowance.Fields().ByName("agent_perm_id")
+	fd_SessionAllowance_verifier_perm_id = md_SessionAllowance.Fields().ByName("verifier_perm_id")
+	fd_SessionAllowance_remaining = md_SessionAllowance.Fields().ByName("remaining")
+	fd_SessionAllowance_spent = md_SessionAllowance.Fields().ByName("spent")
+	fd_SessionAllowance_created = md_SessionAllowance.Fields().ByName("created")
+	fd_SessionAllowance_modified = md_SessionAllowance.Fields().ByName("modified")
+	fd_SessionAllowance_allowed_creators = md_SessionAllowance.Fields().ByName("allowed_creators")
+	fd_SessionAllowance_max_per_session = md_SessionAllowance.Fields().ByName("max_per_session")
+}
+
+var _ protoreflect.Message = (*fastReflection_SessionAllowance)(nil)
+
+type fastReflection_SessionAllowance SessionAllowance
+
+func (x *SessionAllowance) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SessionAllowance)(x)
+}
+
+func (x *SessionAllowance) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_types_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SessionAllowance_messageType fastReflection_SessionAllowance_messageType
+var _ protoreflect.MessageType = fastReflection_SessionAllowance_messageType{}
+
+type fastReflection_SessionAllowance_messageType struct{}
+
+func (x fastReflection_SessionAllowance_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SessionAllowance)(nil)
+}
+func (x fastReflection_SessionAllowance_messageType) New() protoreflect.Message {
+	return new(fastReflection_SessionAllowance)
+}
+func (x fastReflection_SessionAllowance_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SessionAllowance
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SessionAllowance) Descriptor() protoreflect.MessageDescriptor {
+	return md_SessionAllowance
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SessionAllowance) Type() protoreflect.MessageType {
+	return _fastReflection_SessionAllowance_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SessionAllowance) New() protoreflect.Message {
+	return new(fastReflection_SessionAllowance)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SessionAllowance) Interface() protoreflect.ProtoMessage {
+	return (*SessionAllowance)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SessionAllowance) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_SessionAllowance_id, value) {
+			return
+		}
+	}
+	if x.Sponsor != "" {
+		value := protoreflect.ValueOfString(x.Sponsor)
+		if !f(fd_SessionAllowance_sponsor, value) {
+			return
+		}
+	}
+	if x.SchemaId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SchemaId)
+		if !f(fd_SessionAllowance_schema_id, value) {
+			return
+		}
+	}
+	if x.AgentPermId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AgentPermId)
+		if !f(fd_SessionAllowance_agent_perm_id, value) {
+			return
+		}
+	}
+	if x.VerifierPermId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.VerifierPermId)
+		if !f(fd_SessionAllowance_verifier_perm_id, value) {
+			return
+		}
+	}
+	if x.Remaining != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Remaining)
+		if !f(fd_SessionAllowance_remaining, value) {
+			return
+		}
+	}
+	if x.Spent != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Spent)
+		if !f(fd_SessionAllowance_spent, value) {
+			return
+		}
+	}
+	if x.Created != nil {
+		value := protoreflect.ValueOfMessage(x.Created.ProtoReflect())
+		if !f(fd_SessionAllowance_created, value) {
+			return
+		}
+	}
+	if x.Modified != nil {
+		value := protoreflect.ValueOfMessage(x.Modified.ProtoReflect())
+		if !f(fd_SessionAllowance_modified, value) {
+			return
+		}
+	}
+	if len(x.AllowedCreators) != 0 {
+		value := protoreflect.ValueOfList(&_SessionAllowance_10_list{list: &x.AllowedCreators})
+		if !f(fd_SessionAllowance_allowed_creators, value) {
+			return
+		}
+	}
+	if x.MaxPerSession != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxPerSession)
+		if !f(fd_SessionAllowance_max_per_session, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SessionAllowance) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.perm.v1.SessionAllowance.id":
+		return x.Id != uint64(0)
+	case "verana.perm.v1.SessionAllowance.sponsor":
+		return x.Sponsor != ""
+	case "verana.perm.v1.SessionAllowance.schema_id":
+		return x.SchemaId != uint64(0)
+	case "verana.perm.v1.SessionAllowance.agent_perm_id":
+		return x.AgentPermId != uint64(0)
+	case "verana.perm.v1.SessionAllowance.verifier_perm_id":
+		return x.VerifierPermId != uint64(0)
+	case "verana.perm.v1.SessionAllowance.remaining":
+		return x.Remaining != uint64(0)
+	case "verana.perm.v1.SessionAllowance.spent":
+		return x.Spent != uint64(0)
+	case "verana.perm.v1.SessionAllowance.created":
+		return x.Created != nil
+	case "verana.perm.v1.SessionAllowance.modified":
+		return x.Modified != nil
+	case "verana.perm.v1.SessionAllowance.allowed_creators":
+		return len(x.AllowedCreators) != 0
+	case "verana.perm.v1.SessionAllowance.max_per_session":
+		return x.MaxPerSession != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SessionAllowance"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.SessionAllowance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SessionAllowance) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.perm.v1.SessionAllowance.id":
+		x.Id = uint64(0)
+	case "verana.perm.v1.SessionAllowance.sponsor":
+		x.Sponsor = ""
+	case "verana.perm.v1.SessionAllowance.schema_id":
+		x.SchemaId = uint64(0)
+	case "verana.perm.v1.SessionAllowance.agent_perm_id":
+		x.AgentPermId = uint64(0)
+	case "verana.perm.v1.SessionAllowance.verifier_perm_id":
+		x.VerifierPermId = uint64(0)
+	case "verana.perm.v1.SessionAllowance.remaining":
+		x.Remaining = uint64(0)
+	case "verana.perm.v1.SessionAllowance.spent":
+		x.Spent = uint64(0)
+	case "verana.perm.v1.SessionAllowance.created":
+		x.Created = nil
+	case "verana.perm.v1.SessionAllowance.modified":
+		x.Modified = nil
+	case "verana.perm.v1.SessionAllowance.allowed_creators":
+		x.AllowedCreators = nil
+	case "verana.perm.v1.SessionAllowance.max_per_session":
+		x.MaxPerSession = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SessionAllowance"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.SessionAllowance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SessionAllowance) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.perm.v1.SessionAllowance.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.SessionAllowance.sponsor":
+		value := x.Sponsor
+		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.SessionAllowance.schema_id":
+		value := x.SchemaId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.SessionAllowance.agent_perm_id":
+		value := x.AgentPermId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.SessionAllowance.verifier_perm_id":
+		value := x.VerifierPermId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.SessionAllowance.remaining":
+		value := x.Remaining
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.SessionAllowance.spent":
+		value := x.Spent
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.SessionAllowance.created":
+		value := x.Created
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.perm.v1.SessionAllowance.modified":
+		value := x.Modified
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.perm.v1.SessionAllowance.allowed_creators":
+		if len(x.AllowedCreators) == 0 {
+			return protoreflect.ValueOfList(&_SessionAllowance_10_list{})
+		}
+		listValue := &_SessionAllowance_10_list{list: &x.AllowedCreators}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.perm.v1.SessionAllowance.max_per_session":
+		value := x.MaxPerSession
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SessionAllowance"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.SessionAllowance does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SessionAllowance) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.perm.v1.SessionAllowance.id":
+		x.Id = value.Uint()
+	case "verana.perm.v1.SessionAllowance.sponsor":
+		x.Sponsor = value.Interface().(string)
+	case "verana.perm.v1.SessionAllowance.schema_id":
+		x.SchemaId = value.Uint()
+	case "verana.perm.v1.SessionAllowance.agent_perm_id":
+		x.AgentPermId = value.Uint()
+	case "verana.perm.v1.SessionAllowance.verifier_perm_id":
+		x.VerifierPermId = value.Uint()
+	case "verana.perm.v1.SessionAllowance.remaining":
+		x.Remaining = value.Uint()
+	case "verana.perm.v1.SessionAllowance.spent":
+		x.Spent = value.Uint()
+	case "verana.perm.v1.SessionAllowance.created":
+		x.Created = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.perm.v1.SessionAllowance.modified":
+		x.Modified = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.perm.v1.SessionAllowance.allowed_creators":
+		lv := value.List()
+		clv := lv.(*_SessionAllowance_10_list)
+		x.AllowedCreators = *clv.list
+	case "verana.perm.v1.SessionAllowance.max_per_session":
+		x.MaxPerSession = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SessionAllowance"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.SessionAllowance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SessionAllowance) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.SessionAllowance.created":
+		if x.Created == nil {
+			x.Created = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Created.ProtoReflect())
+	case "verana.perm.v1.SessionAllowance.modified":
+		if x.Modified == nil {
+			x.Modified = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Modified.ProtoReflect())
+	case "verana.perm.v1.SessionAllowance.allowed_creators":
+		if x.AllowedCreators == nil {
+			x.AllowedCreators = []string{}
+		}
+		value := &_SessionAllowance_10_list{list: &x.AllowedCreators}
+		return protoreflect.ValueOfList(value)
+	case "verana.perm.v1.SessionAllowance.id":
+		panic(fmt.Errorf("field id of message verana.perm.v1.SessionAllowance is not mutable"))
+	case "verana.perm.v1.SessionAllowance.sponsor":
+		panic(fmt.Errorf("field sponsor of message verana.perm.v1.SessionAllowance is not mutable"))
+	case "verana.perm.v1.SessionAllowance.schema_id":
+		panic(fmt.Errorf("field schema_id of message verana.perm.v1.SessionAllowance is not mutable"))
+	case "verana.perm.v1.SessionAllowance.agent_perm_id":
+		panic(fmt.Errorf("field agent_perm_id of message verana.perm.v1.SessionAllowance is not mutable"))
+	case "verana.perm.v1.SessionAllowance.verifier_perm_id":
+		panic(fmt.Errorf("field verifier_perm_id of message verana.perm.v1.SessionAllowance is not mutable"))
+	case "verana.perm.v1.SessionAllowance.remaining":
+		panic(fmt.Errorf("field remaining of message verana.perm.v1.SessionAllowance is not mutable"))
+	case "verana.perm.v1.SessionAllowance.spent":
+		panic(fmt.Errorf("field spent of message verana.perm.v1.SessionAllowance is not mutable"))
+	case "verana.perm.v1.SessionAllowance.max_per_session":
+		panic(fmt.Errorf("field max_per_session of message verana.perm.v1.SessionAllowance is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SessionAllowance"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.SessionAllowance does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SessionAllowance) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.SessionAllowance.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.SessionAllowance.sponsor":
+		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.SessionAllowance.schema_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.SessionAllowance.agent_perm_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.SessionAllowance.verifier_perm_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.SessionAllowance.remaining":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.SessionAllowance.spent":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.SessionAllowance.created":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.perm.v1.SessionAllowance.modified":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.perm.v1.SessionAllowance.allowed_creators":
+		list := []string{}
+		return protoreflect.ValueOfList(&_SessionAllowance_10_list{list: &list})
+	case "verana.perm.v1.SessionAllowance.max_per_session":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SessionAllowance"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.SessionAllowance does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SessionAllowance) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.SessionAllowance", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SessionAllowance) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SessionAllowance) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SessionAllowance) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SessionAllowance) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SessionAllowance)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Sponsor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SchemaId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SchemaId))
+		}
+		if x.AgentPermId != 0 {
+			n += 1 + runtime.Sov(uint64(x.AgentPermId))
+		}
+		if x.VerifierPermId != 0 {
+			n += 1 + runtime.Sov(uint64(x.VerifierPermId))
+		}
+		if x.Remaining != 0 {
+			n += 1 + runtime.Sov(uint64(x.Remaining))
+		}
+		if x.Spent != 0 {
+			n += 1 + runtime.Sov(uint64(x.Spent))
+		}
+		if x.Created != nil {
+			l = options.Size(x.Created)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Modified != nil {
+			l = options.Size(x.Modified)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.AllowedCreators) > 0 {
+			for _, s := range x.AllowedCreators {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxPerSession != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxPerSession))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SessionAllowance)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MaxPerSession != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPerSession))
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.AllowedCreators) > 0 {
+			for iNdEx := len(x.AllowedCreators) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedCreators[iNdEx])
+				copy(dAtA[i:], x.AllowedCreators[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedCreators[iNdEx])))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if x.Modified != nil {
+			encoded, err := options.Marshal(x.Modified)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.Created != nil {
+			encoded, err := options.Marshal(x.Created)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.Spent != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Spent))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.Remaining != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Remaining))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.VerifierPermId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VerifierPermId))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.AgentPermId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AgentPermId))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.SchemaId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SchemaId))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Sponsor) > 0 {
+			i -= len(x.Sponsor)
+			copy(dAtA[i:], x.Sponsor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sponsor)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SessionAllowance)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SessionAllowance: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SessionAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sponsor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SchemaId", wireType)
+				}
+				x.SchemaId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SchemaId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AgentPermId", wireType)
+				}
+				x.AgentPermId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AgentPermId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerifierPermId", wireType)
+				}
+				x.VerifierPermId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.VerifierPermId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+				}
+				x.Remaining = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Remaining |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+				}
+				x.Spent = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Spent |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Created == nil {
+					x.Created = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Created); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Modified", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Modified == nil {
+					x.Modified = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Modified); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedCreators", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedCreators = append(x.AllowedCreators, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPerSession", wireType)
+				}
+				x.MaxPerSession = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPerSession |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_SlashRecord_17_list)(nil)
+
+type _SlashRecord_17_list struct {
+	list *[]*v1.SlashShare
+}
+
+func (x *_SlashRecord_17_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SlashRecord_17_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_SlashRecord_17_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1.SlashShare)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SlashRecord_17_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1.SlashShare)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SlashRecord_17_list) AppendMutable() protoreflect.Value {
+	v := new(v1.SlashShare)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SlashRecord_17_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SlashRecord_17_list) NewElement() protoreflect.Value {
+	v := new(v1.SlashShare)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SlashRecord_17_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_SlashRecord                     protoreflect.MessageDescriptor
+	fd_SlashRecord_id                  protoreflect.FieldDescriptor
+	fd_SlashRecord_perm_id             protoreflect.FieldDescriptor
+	fd_SlashRecord_grantee             protoreflect.FieldDescriptor
+	fd_SlashRecord_amount              protoreflect.FieldDescriptor
+	fd_SlashRecord_slashed_by          protoreflect.FieldDescriptor
+	fd_SlashRecord_status              protoreflect.FieldDescriptor
+	fd_SlashRecord_created             protoreflect.FieldDescriptor
+	fd_SlashRecord_appeal_deadline     protoreflect.FieldDescriptor
+	fd_SlashRecord_appealed            protoreflect.FieldDescriptor
+	fd_SlashRecord_appeal_digest_sri   protoreflect.FieldDescriptor
+	fd_SlashRecord_resolved            protoreflect.FieldDescriptor
+	fd_SlashRecord_resolved_by         protoreflect.FieldDescriptor
+	fd_SlashRecord_reason_code         protoreflect.FieldDescriptor
+	fd_SlashRecord_evidence_url        protoreflect.FieldDescriptor
+	fd_SlashRecord_evidence_digest_sri protoreflect.FieldDescriptor
+	fd_SlashRecord_gf_version          protoreflect.FieldDescriptor
+	fd_SlashRecord_distribution        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_types_proto_init()
+	md_SlashRecord = File_verana_perm_v1_types_proto.Messages().ByName("SlashRecord")
+	fd_SlashRecord_id = md_SlashRecord.Fields().ByName("id")
+	fd_SlashRecord_perm_id = md_SlashRecord.Fields().ByName("perm_id")
+	fd_SlashRecord_grantee = md_SlashRecord.Fields().ByName("grantee")
+	fd_SlashRecord_amount = md_SlashRecord.Fields().ByName("amount")
+	fd_SlashRecord_slashed_by = md_SlashRecord.Fields().ByName("slashed_by")
+	fd_SlashRecord_status = md_SlashRecord.Fields().ByName("status")
+	fd_SlashRecord_created = md_SlashRecord.Fields().ByName("created")
+	fd_SlashRecord_appeal_deadline = md_SlashRecord.Fields().ByName("appeal_deadline")
+	fd_SlashRecord_appealed = md_SlashRecord.Fields().ByName("appealed")
+	fd_SlashRecord_appeal_digest_sri = md_SlashRecord.Fields().ByName("appeal_digest_sri")
+	fd_SlashRecord_resolved = md_SlashRecord.Fields().ByName("resolved")
+	fd_SlashRecord_resolved_by = md_SlashRecord.Fields().ByName("resolved_by")
+	fd_SlashRecord_reason_code = md_SlashRecord.Fields().ByName("reason_code")
+	fd_SlashRecord_evidence_url = md_SlashRecord.Fields().ByName("evidence_url")
+	fd_SlashRecord_evidence_digest_sri = md_SlashRecord.Fields().ByName("evidence_digest_sri")
+	fd_SlashRecord_gf_version = md_SlashRecord.Fields().ByName("gf_version")
+	fd_SlashRecord_distribution = md_SlashRecord.Fields().ByName("distribution")
+}
+
+var _ protoreflect.Message = (*fastReflection_SlashRecord)(nil)
+
+type fastReflection_SlashRecord SlashRecord
+
+func (x *SlashRecord) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SlashRecord)(x)
+}
+
+func (x *SlashRecord) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_types_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SlashRecord_messageType fastReflection_SlashRecord_messageType
+var _ protoreflect.MessageType = fastReflection_SlashRecord_messageType{}
+
+type fastReflection_SlashRecord_messageType struct{}
+
+func (x fastReflection_SlashRecord_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SlashRecord)(nil)
+}
+func (x fastReflection_SlashRecord_messageType) New() protoreflect.Message {
+	return new(fastReflection_SlashRecord)
+}
+func (x fastReflection_SlashRecord_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SlashRecord
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SlashRecord) Descriptor() protoreflect.MessageDescriptor {
+	return md_SlashRecord
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SlashRecord) Type() protoreflect.MessageType {
+	return _fastReflection_SlashRecord_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SlashRecord) New() protoreflect.Message {
+	return new(fastReflection_SlashRecord)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SlashRecord) Interface() protoreflect.ProtoMessage {
+	return (*SlashRecord)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SlashRecord) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_SlashRecord_id, value) {
+			return
+		}
+	}
+	if x.PermId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PermId)
+		if !f(fd_SlashRecord_perm_id, value) {
+			return
+		}
+	}
+	if x.Grantee != "" {
+		value := protoreflect.ValueOfString(x.Grantee)
+		if !f(fd_SlashRecord_grantee, value) {
+			return
+		}
+	}
+	if x.Amount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Amount)
+		if !f(fd_SlashRecord_amount, value) {
+			return
+		}
+	}
+	if x.SlashedBy != "" {
+		value := protoreflect.ValueOfString(x.SlashedBy)
+		if !f(fd_SlashRecord_slashed_by, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_SlashRecord_status, value) {
+			return
+		}
+	}
+	if x.Created != nil {
+		value := protoreflect.ValueOfMessage(x.Created.ProtoReflect())
+		if !f(fd_SlashRecord_created, value) {
+			return
+		}
+	}
+	if x.AppealDeadline != nil {
+		value := protoreflect.ValueOfMessage(x.AppealDeadline.ProtoReflect())
+		if !f(fd_SlashRecord_appeal_deadline, value) {
+			return
+		}
+	}
+	if x.Appealed != nil {
+		value := protoreflect.ValueOfMessage(x.Appealed.ProtoReflect())
+		if !f(fd_SlashRecord_appealed, value) {
+			return
+		}
+	}
+	if x.AppealDigestSri != "" {
+		value := protoreflect.ValueOfString(x.AppealDigestSri)
+		if !f(fd_SlashRecord_appeal_digest_sri, value) {
+			return
+		}
+	}
+	if x.Resolved != nil {
+		value := protoreflect.ValueOfMessage(x.Resolved.ProtoReflect())
+		if !f(fd_SlashRecord_resolved, value) {
+			return
+		}
+	}
+	if x.ResolvedBy != "" {
+		value := protoreflect.ValueOfString(x.ResolvedBy)
+		if !f(fd_SlashRecord_resolved_by, value) {
+			return
+		}
+	}
+	if x.ReasonCode != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ReasonCode)
+		if !f(fd_SlashRecord_reason_code, value) {
+			return
+		}
+	}
+	if x.EvidenceUrl != "" {
+		value := protoreflect.ValueOfString(x.EvidenceUrl)
+		if !f(fd_SlashRecord_evidence_url, value) {
+			return
+		}
+	}
+	if x.EvidenceDigestSri != "" {
+		value := protoreflect.ValueOfString(x.EvidenceDigestSri)
+		if !f(fd_SlashRecord_evidence_digest_sri, value) {
+			return
+		}
+	}
+	if x.GfVersion != int32(0) {
+		value := protoreflect.ValueOfInt32(x.GfVersion)
+		if !f(fd_SlashRecord_gf_version, value) {
+			return
+		}
+	}
+	if len(x.Distribution) != 0 {
+		value := protoreflect.ValueOfList(&_SlashRecord_17_list{list: &x.Distribution})
+		if !f(fd_SlashRecord_distribution, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SlashRecord) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.perm.v1.SlashRecord.id":
+		return x.Id != uint64(0)
+	case "verana.perm.v1.SlashRecord.perm_id":
+		return x.PermId != uint64(0)
+	case "verana.perm.v1.SlashRecord.grantee":
+		return x.Grantee != ""
+	case "verana.perm.v1.SlashRecord.amount":
+		return x.Amount != uint64(0)
+	case "verana.perm.v1.SlashRecord.slashed_by":
+		return x.SlashedBy != ""
+	case "verana.perm.v1.SlashRecord.status":
+		return x.Status != 0
+	case "verana.perm.v1.SlashRecord.created":
+		return x.Created != nil
+	case "verana.perm.v1.SlashRecord.appeal_deadline":
+		return x.AppealDeadline != nil
+	case "verana.perm.v1.SlashRecord.appealed":
+		return x.Appealed != nil
+	case "verana.perm.v1.SlashRecord.appeal_digest_sri":
+		return x.AppealDigestSri != ""
+	case "verana.perm.v1.SlashRecord.resolved":
+		return x.Resolved != nil
+	case "verana.perm.v1.SlashRecord.resolved_by":
+		return x.ResolvedBy != ""
+	case "verana.perm.v1.SlashRecord.reason_code":
+		return x.ReasonCode != uint32(0)
+	case "verana.perm.v1.SlashRecord.evidence_url":
+		return x.EvidenceUrl != ""
+	case "verana.perm.v1.SlashRecord.evidence_digest_sri":
+		return x.EvidenceDigestSri != ""
+	case "verana.perm.v1.SlashRecord.gf_version":
+		return x.GfVersion != int32(0)
+	case "verana.perm.v1.SlashRecord.distribution":
+		return len(x.Distribution) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SlashRecord"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.SlashRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SlashRecord) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.perm.v1.SlashRecord.id":
+		x.Id = uint64(0)
+	case "verana.perm.v1.SlashRecord.perm_id":
+		x.PermId = uint64(0)
+	case "verana.perm.v1.SlashRecord.grantee":
+		x.Grantee = ""
+	case "verana.perm.v1.SlashRecord.amount":
+		x.Amount = uint64(0)
+	case "verana.perm.v1.SlashRecord.slashed_by":
+		x.SlashedBy = ""
+	case "verana.perm.v1.SlashRecord.status":
+		x.Status = 0
+	case "verana.perm.v1.SlashRecord.created":
+		x.Created = nil
+	case "verana.perm.v1.SlashRecord.appeal_deadline":
+		x.AppealDeadline = nil
+	case "verana.perm.v1.SlashRecord.appealed":
+		x.Appealed = nil
+	case "verana.perm.v1.SlashRecord.appeal_digest_sri":
+		x.AppealDigestSri = ""
+	case "verana.perm.v1.SlashRecord.resolved":
+		x.Resolved = nil
+	case "verana.perm.v1.SlashRecord.resolved_by":
+		x.ResolvedBy = ""
+	case "verana.perm.v1.SlashRecord.reason_code":
+		x.ReasonCode = uint32(0)
+	case "verana.perm.v1.SlashRecord.evidence_url":
+		x.EvidenceUrl = ""
+	case "verana.perm.v1.SlashRecord.evidence_digest_sri":
+		x.EvidenceDigestSri = ""
+	case "verana.perm.v1.SlashRecord.gf_version":
+		x.GfVersion = int32(0)
+	case "verana.perm.v1.SlashRecord.distribution":
+		x.Distribution = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SlashRecord"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.SlashRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SlashRecord) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.perm.v1.SlashRecord.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.SlashRecord.perm_id":
+		value := x.PermId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.SlashRecord.grantee":
+		value := x.Grantee
+		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.SlashRecord.amount":
+		value := x.Amount
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.SlashRecord.slashed_by":
+		value := x.SlashedBy
+		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.SlashRecord.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "verana.perm.v1.SlashRecord.created":
+		value := x.Created
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.perm.v1.SlashRecord.appeal_deadline":
+		value := x.AppealDeadline
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.perm.v1.SlashRecord.appealed":
+		value := x.Appealed
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.perm.v1.SlashRecord.appeal_digest_sri":
+		value := x.AppealDigestSri
+		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.SlashRecord.resolved":
+		value := x.Resolved
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.perm.v1.SlashRecord.resolved_by":
+		value := x.ResolvedBy
+		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.SlashRecord.reason_code":
+		value := x.ReasonCode
+		return protoreflect.ValueOfUint32(value)
+	case "verana.perm.v1.SlashRecord.evidence_url":
+		value := x.EvidenceUrl
+		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.SlashRecord.evidence_digest_sri":
+		value := x.EvidenceDigestSri
+		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.SlashRecord.gf_version":
+		value := x.GfVersion
+		return protoreflect.ValueOfInt32(value)
+	case "verana.perm.v1.SlashRecord.distribution":
+		if len(x.Distribution) == 0 {
+			return protoreflect.ValueOfList(&_SlashRecord_17_list{})
+		}
+		listValue := &_SlashRecord_17_list{list: &x.Distribution}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SlashRecord"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.SlashRecord does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SlashRecord) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.perm.v1.SlashRecord.id":
+		x.Id = value.Uint()
+	case "verana.perm.v1.SlashRecord.perm_id":
+		x.PermId = value.Uint()
+	case "verana.perm.v1.SlashRecord.grantee":
+		x.Grantee = value.Interface().(string)
+	case "verana.perm.v1.SlashRecord.amount":
+		x.Amount = value.Uint()
+	case "verana.perm.v1.SlashRecord.slashed_by":
+		x.SlashedBy = value.Interface().(string)
+	case "verana.perm.v1.SlashRecord.status":
+		x.Status = (SlashStatus)(value.Enum())
+	case "verana.perm.v1.SlashRecord.created":
+		x.Created = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.perm.v1.SlashRecord.appeal_deadline":
+		x.AppealDeadline = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.perm.v1.SlashRecord.appealed":
+		x.Appealed = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.perm.v1.SlashRecord.appeal_digest_sri":
+		x.AppealDigestSri = value.Interface().(string)
+	case "verana.perm.v1.SlashRecord.resolved":
+		x.Resolved = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.perm.v1.SlashRecord.resolved_by":
+		x.ResolvedBy = value.Interface().(string)
+	case "verana.perm.v1.SlashRecord.reason_code":
+		x.ReasonCode = uint32(value.Uint())
+	case "verana.perm.v1.SlashRecord.evidence_url":
+		x.EvidenceUrl = value.Interface().(string)
+	case "verana.perm.v1.SlashRecord.evidence_digest_sri":
+		x.EvidenceDigestSri = value.Interface().(string)
+	case "verana.perm.v1.SlashRecord.gf_version":
+		x.GfVersion = int32(value.Int())
+	case "verana.perm.v1.SlashRecord.distribution":
+		lv := value.List()
+		clv := lv.(*_SlashRecord_17_list)
+		x.Distribution = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SlashRecord"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.SlashRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SlashRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.SlashRecord.created":
+		if x.Created == nil {
+			x.Created = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Created.ProtoReflect())
+	case "verana.perm.v1.SlashRecord.appeal_deadline":
+		if x.AppealDeadline == nil {
+			x.AppealDeadline = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.AppealDeadline.ProtoReflect())
+	case "verana.perm.v1.SlashRecord.appealed":
+		if x.Appealed == nil {
+			x.Appealed = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Appealed.ProtoReflect())
+	case "verana.perm.v1.SlashRecord.resolved":
+		if x.Resolved == nil {
+			x.Resolved = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Resolved.ProtoReflect())
+	case "verana.perm.v1.SlashRecord.distribution":
+		if x.Distribution == nil {
+			x.Distribution = []*v1.SlashShare{}
+		}
+		value := &_SlashRecord_17_list{list: &x.Distribution}
+		return protoreflect.ValueOfList(value)
+	case "verana.perm.v1.SlashRecord.id":
+		panic(fmt.Errorf("field id of message verana.perm.v1.SlashRecord is not mutable"))
+	case "verana.perm.v1.SlashRecord.perm_id":
+		panic(fmt.Errorf("field perm_id of message verana.perm.v1.SlashRecord is not mutable"))
+	case "verana.perm.v1.SlashRecord.grantee":
+		panic(fmt.Errorf("field grantee of message verana.perm.v1.SlashRecord is not mutable"))
+	case "verana.perm.v1.SlashRecord.amount":
+		panic(fmt.Errorf("field amount of message verana.perm.v1.SlashRecord is not mutable"))
+	case "verana.perm.v1.SlashRecord.slashed_by":
+		panic(fmt.Errorf("field slashed_by of message verana.perm.v1.SlashRecord is not mutable"))
+	case "verana.perm.v1.SlashRecord.status":
+		panic(fmt.Errorf("field status of message verana.perm.v1.SlashRecord is not mutable"))
+	case "verana.perm.v1.SlashRecord.appeal_digest_sri":
+		panic(fmt.Errorf("field appeal_digest_sri of message verana.perm.v1.SlashRecord is not mutable"))
+	case "verana.perm.v1.SlashRecord.resolved_by":
+		panic(fmt.Errorf("field resolved_by of message verana.perm.v1.SlashRecord is not mutable"))
+	case "verana.perm.v1.SlashRecord.reason_code":
+		panic(fmt.Errorf("field reason_code of message verana.perm.v1.SlashRecord is not mutable"))
+	case "verana.perm.v1.SlashRecord.evidence_url":
+		panic(fmt.Errorf("field evidence_url of message verana.perm.v1.SlashRecord is not mutable"))
+	case "verana.perm.v1.SlashRecord.evidence_digest_sri":
+		panic(fmt.Errorf("field evidence_digest_sri of message verana.perm.v1.SlashRecord is not mutable"))
+	case "verana.perm.v1.SlashRecord.gf_version":
+		panic(fmt.Errorf("field gf_version of message verana.perm.v1.SlashRecord is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SlashRecord"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.SlashRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SlashRecord) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.SlashRecord.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.SlashRecord.perm_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.SlashRecord.grantee":
+		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.SlashRecord.amount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.SlashRecord.slashed_by":
+		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.SlashRecord.status":
+		return protoreflect.ValueOfEnum(0)
+	case "verana.perm.v1.SlashRecord.created":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.perm.v1.SlashRecord.appeal_deadline":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.perm.v1.SlashRecord.appealed":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.perm.v1.SlashRecord.appeal_digest_sri":
+		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.SlashRecord.resolved":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.perm.v1.SlashRecord.resolved_by":
+		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.SlashRecord.reason_code":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "verana.perm.v1.SlashRecord.evidence_url":
+		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.SlashRecord.evidence_digest_sri":
+		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.SlashRecord.gf_version":
+		return protoreflect.ValueOfInt32(int32(0))
+	case "verana.perm.v1.SlashRecord.distribution":
+		list := []*v1.SlashShare{}
+		return protoreflect.ValueOfList(&_SlashRecord_17_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SlashRecord"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.SlashRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SlashRecord) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.SlashRecord", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SlashRecord) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SlashRecord) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SlashRecord) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SlashRecord) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SlashRecord)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.PermId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PermId))
+		}
+		l = len(x.Grantee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Amount != 0 {
+			n += 1 + runtime.Sov(uint64(x.Amount))
+		}
+		l = len(x.SlashedBy)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if x.Created != nil {
+			l = options.Size(x.Created)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AppealDeadline != nil {
+			l = options.Size(x.AppealDeadline)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Appealed != nil {
+			l = options.Size(x.Appealed)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AppealDigestSri)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Resolved != nil {
+			l = options.Size(x.Resolved)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ResolvedBy)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ReasonCode != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReasonCode))
+		}
+		l = len(x.EvidenceUrl)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.EvidenceDigestSri)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.GfVersion != 0 {
+			n += 2 + runtime.Sov(uint64(x.GfVersion))
+		}
+		if len(x.Distribution) > 0 {
+			for _, e := range x.Distribution {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SlashRecord)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Distribution) > 0 {
+			for iNdEx := len(x.Distribution) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Distribution[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x8a
+			}
+		}
+		if x.GfVersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GfVersion))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if len(x.EvidenceDigestSri) > 0 {
+			i -= len(x.EvidenceDigestSri)
+			copy(dAtA[i:], x.EvidenceDigestSri)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EvidenceDigestSri)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if len(x.EvidenceUrl) > 0 {
+			i -= len(x.EvidenceUrl)
+			copy(dAtA[i:], x.EvidenceUrl)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EvidenceUrl)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if x.ReasonCode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReasonCode))
+			i--
+			dAtA[i] = 0x68
+		}
+		if len(x.ResolvedBy) > 0 {
+			i -= len(x.ResolvedBy)
+			copy(dAtA[i:], x.ResolvedBy)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ResolvedBy)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.Resolved != nil {
+			encoded, err := options.Marshal(x.Resolved)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.AppealDigestSri) > 0 {
+			i -= len(x.AppealDigestSri)
+			copy(dAtA[i:], x.AppealDigestSri)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AppealDigestSri)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.Appealed != nil {
+			encoded, err := options.Marshal(x.Appealed)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.AppealDeadline != nil {
+			encoded, err := options.Marshal(x.AppealDeadline)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.Created != nil {
+			encoded, err := options.Marshal(x.Created)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.SlashedBy) > 0 {
+			i -= len(x.SlashedBy)
+			copy(dAtA[i:], x.SlashedBy)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SlashedBy)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Amount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Amount))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Grantee) > 0 {
+			i -= len(x.Grantee)
+			copy(dAtA[i:], x.Grantee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Grantee)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.PermId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PermId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SlashRecord)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SlashRecord: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SlashRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PermId", wireType)
+				}
+				x.PermId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PermId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Grantee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				x.Amount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Amount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashedBy", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SlashedBy = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= SlashStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Created == nil {
+					x.Created = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Created); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AppealDeadline", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.AppealDeadline == nil {
+					x.AppealDeadline = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AppealDeadline); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Appealed", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Appealed == nil {
+					x.Appealed = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Appealed); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AppealDigestSri", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AppealDigestSri = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Resolved", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Resolved == nil {
+					x.Resolved = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Resolved); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResolvedBy", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ResolvedBy = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReasonCode", wireType)
+				}
+				x.ReasonCode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReasonCode |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EvidenceUrl", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EvidenceUrl = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EvidenceDigestSri", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EvidenceDigestSri = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GfVersion", wireType)
+				}
+				x.GfVersion = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GfVersion |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Distribution = append(x.Distribution, &v1.SlashShare{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Distribution[len(x.Distribution)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_TrustRegistrySlashDistribution_2_list)(nil)
+
+type _TrustRegistrySlashDistribution_2_list struct {
+	list *[]*v1.SlashShare
+}
+
+func (x *_TrustRegistrySlashDistribution_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TrustRegistrySlashDistribution_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_TrustRegistrySlashDistribution_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1.SlashShare)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TrustRegistrySlashDistribution_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1.SlashShare)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TrustRegistrySlashDistribution_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1.SlashShare)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TrustRegistrySlashDistribution_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TrustRegistrySlashDistribution_2_list) NewElement() protoreflect.Value {
+	v := new(v1.SlashShare)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TrustRegistrySlashDistribution_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_TrustRegistrySlashDistribution              protoreflect.MessageDescriptor
+	fd_TrustRegistrySlashDistribution_tr_id        protoreflect.FieldDescriptor
+	fd_TrustRegistrySlashDistribution_distribution protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_types_proto_init()
+	md_TrustRegistrySlashDistribution = File_verana_perm_v1_types_proto.Messages().ByName("TrustRegistrySlashDistribution")
+	fd_TrustRegistrySlashDistribution_tr_id = md_TrustRegistrySlashDistribution.Fields().ByName("tr_id")
+	fd_TrustRegistrySlashDistribution_distribution = md_TrustRegistrySlashDistribution.Fields().ByName("distribution")
+}
+
+var _ protoreflect.Message = (*fastReflection_TrustRegistrySlashDistribution)(nil)
+
+type fastReflection_TrustRegistrySlashDistribution TrustRegistrySlashDistribution
+
+func (x *TrustRegistrySlashDistribution) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TrustRegistrySlashDistribution)(x)
+}
+
+func (x *TrustRegistrySlashDistribution) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_types_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TrustRegistrySlashDistribution_messageType fastReflection_TrustRegistrySlashDistribution_messageType
+var _ protoreflect.MessageType = fastReflection_TrustRegistrySlashDistribution_messageType{}
+
+type fastReflection_TrustRegistrySlashDistribution_messageType struct{}
+
+func (x fastReflection_TrustRegistrySlashDistribution_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TrustRegistrySlashDistribution)(nil)
+}
+func (x fastReflection_TrustRegistrySlashDistribution_messageType) New() protoreflect.Message {
+	return new(fastReflection_TrustRegistrySlashDistribution)
+}
+func (x fastReflection_TrustRegistrySlashDistribution_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TrustRegistrySlashDistribution
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TrustRegistrySlashDistribution) Descriptor() protoreflect.MessageDescriptor {
+	return md_TrustRegistrySlashDistribution
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TrustRegistrySlashDistribution) Type() protoreflect.MessageType {
+	return _fastReflection_TrustRegistrySlashDistribution_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TrustRegistrySlashDistribution) New() protoreflect.Message {
+	return new(fastReflection_TrustRegistrySlashDistribution)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TrustRegistrySlashDistribution) Interface() protoreflect.ProtoMessage {
+	return (*TrustRegistrySlashDistribution)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TrustRegistrySlashDistribution) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TrId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TrId)
+		if !f(fd_TrustRegistrySlashDistribution_tr_id, value) {
+			return
+		}
+	}
+	if len(x.Distribution) != 0 {
+		value := protoreflect.ValueOfList(&_TrustRegistrySlashDistribution_2_list{list: &x.Distribution})
+		if !f(fd_TrustRegistrySlashDistribution_distribution, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TrustRegistrySlashDistribution) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.perm.v1.TrustRegistrySlashDistribution.tr_id":
+		return x.TrId != uint64(0)
+	case "verana.perm.v1.TrustRegistrySlashDistribution.distribution":
+		return len(x.Distribution) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.TrustRegistrySlashDistribution"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.TrustRegistrySlashDistribution does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TrustRegistrySlashDistribution) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.perm.v1.TrustRegistrySlashDistribution.tr_id":
+		x.TrId = uint64(0)
+	case "verana.perm.v1.TrustRegistrySlashDistribution.distribution":
+		x.Distribution = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.TrustRegistrySlashDistribution"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.TrustRegistrySlashDistribution does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TrustRegistrySlashDistribution) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.perm.v1.TrustRegistrySlashDistribution.tr_id":
+		value := x.TrId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.TrustRegistrySlashDistribution.distribution":
+		if len(x.Distribution) == 0 {
+			return protoreflect.ValueOfList(&_TrustRegistrySlashDistribution_2_list{})
+		}
+		listValue := &_TrustRegistrySlashDistribution_2_list{list: &x.Distribution}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.TrustRegistrySlashDistribution"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.TrustRegistrySlashDistribution does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TrustRegistrySlashDistribution) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.perm.v1.TrustRegistrySlashDistribution.tr_id":
+		x.TrId = value.Uint()
+	case "verana.perm.v1.TrustRegistrySlashDistribution.distribution":
+		lv := value.List()
+		clv := lv.(*_TrustRegistrySlashDistribution_2_list)
+		x.Distribution = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.TrustRegistrySlashDistribution"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.TrustRegistrySlashDistribution does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TrustRegistrySlashDistribution) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.TrustRegistrySlashDistribution.distribution":
+		if x.Distribution == nil {
+			x.Distribution = []*v1.SlashShare{}
+		}
+		value := &_TrustRegistrySlashDistribution_2_list{list: &x.Distribution}
+		return protoreflect.ValueOfList(value)
+	case "verana.perm.v1.TrustRegistrySlashDistribution.tr_id":
+		panic(fmt.Errorf("field tr_id of message verana.perm.v1.TrustRegistrySlashDistribution is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.TrustRegistrySlashDistribution"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.TrustRegistrySlashDistribution does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TrustRegistrySlashDistribution) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.TrustRegistrySlashDistribution.tr_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.TrustRegistrySlashDistribution.distribution":
+		list := []*v1.SlashShare{}
+		return protoreflect.ValueOfList(&_TrustRegistrySlashDistribution_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.TrustRegistrySlashDistribution"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.TrustRegistrySlashDistribution does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TrustRegistrySlashDistribution) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.TrustRegistrySlashDistribution", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TrustRegistrySlashDistribution) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TrustRegistrySlashDistribution) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TrustRegistrySlashDistribution) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TrustRegistrySlashDistribution) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TrustRegistrySlashDistribution)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TrId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TrId))
+		}
+		if len(x.Distribution) > 0 {
+			for _, e := range x.Distribution {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TrustRegistrySlashDistribution)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Distribution) > 0 {
+			for iNdEx := len(x.Distribution) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Distribution[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.TrId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TrId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TrustRegistrySlashDistribution)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TrustRegistrySlashDistribution: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TrustRegistrySlashDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TrId", wireType)
+				}
+				x.TrId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TrId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Distribution = append(x.Distribution, &v1.SlashShare{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Distribution[len(x.Distribution)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_RevocationCascade                protoreflect.MessageDescriptor
+	fd_RevocationCascade_perm_id        protoreflect.FieldDescriptor
+	fd_RevocationCascade_origin_perm_id protoreflect.FieldDescriptor
+	fd_RevocationCascade_mode           protoreflect.FieldDescriptor
+	fd_RevocationCascade_executed_by    protoreflect.FieldDescriptor
+	fd_RevocationCascade_created        protoreflect.FieldDescriptor
+	fd_RevocationCascade_last_child_id  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_types_proto_init()
+	md_RevocationCascade = File_verana_perm_v1_types_proto.Messages().ByName("RevocationCascade")
+	fd_RevocationCascade_perm_id = md_RevocationCascade.Fields().ByName("perm_id")
+	fd_RevocationCascade_origin_perm_id = md_RevocationCascade.Fields().ByName("origin_perm_id")
+	fd_RevocationCascade_mode = md_RevocationCascade.Fields().ByName("mode")
+	fd_RevocationCascade_executed_by = md_RevocationCascade.Fields().ByName("executed_by")
+	fd_RevocationCascade_created = md_RevocationCascade.Fields().ByName("created")
+	fd_RevocationCascade_last_child_id = md_RevocationCascade.Fields().ByName("last_child_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_RevocationCascade)(nil)
+
+type fastReflection_RevocationCascade RevocationCascade
+
+func (x *RevocationCascade) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RevocationCascade)(x)
+}
+
+func (x *RevocationCascade) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_types_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RevocationCascade_messageType fastReflection_RevocationCascade_messageType
+var _ protoreflect.MessageType = fastReflection_RevocationCascade_messageType{}
+
+type fastReflection_RevocationCascade_messageType struct{}
+
+func (x fastReflection_RevocationCascade_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RevocationCascade)(nil)
+}
+func (x fastReflection_RevocationCascade_messageType) New() protoreflect.Message {
+	return new(fastReflection_RevocationCascade)
+}
+func (x fastReflection_RevocationCascade_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RevocationCascade
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RevocationCascade) Descriptor() protoreflect.MessageDescriptor {
+	return md_RevocationCascade
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RevocationCascade) Type() protoreflect.MessageType {
+	return _fastReflection_RevocationCascade_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RevocationCascade) New() protoreflect.Message {
+	return new(fastReflection_RevocationCascade)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RevocationCascade) Interface() protoreflect.ProtoMessage {
+	return (*RevocationCascade)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RevocationCascade) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PermId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PermId)
+		if !f(fd_RevocationCascade_perm_id, value) {
+			return
+		}
+	}
+	if x.OriginPermId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OriginPermId)
+		if !f(fd_RevocationCascade_origin_perm_id, value) {
+			return
+		}
+	}
+	if x.Mode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Mode))
+		if !f(fd_RevocationCascade_mode, value) {
+			return
+		}
+	}
+	if x.ExecutedBy != "" {
+		value := protoreflect.ValueOfString(x.ExecutedBy)
+		if !f(fd_RevocationCascade_executed_by, value) {
+			return
+		}
+	}
+	if x.Created != nil {
+		value := protoreflect.ValueOfMessage(x.Created.ProtoReflect())
+		if !f(fd_RevocationCascade_created, value) {
+			return
+		}
+	}
+	if x.LastChildId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LastChildId)
+		if !f(fd_RevocationCascade_last_child_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RevocationCascade) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.perm.v1.RevocationCascade.perm_id":
+		return x.PermId != uint64(0)
+	case "verana.perm.v1.RevocationCascade.origin_perm_id":
+		return x.OriginPermId != uint64(0)
+	case "verana.perm.v1.RevocationCascade.mode":
+		return x.Mode != 0
+	case "verana.perm.v1.RevocationCascade.executed_by":
+		return x.ExecutedBy != ""
+	case "verana.perm.v1.RevocationCascade.created":
+		return x.Created != nil
+	case "verana.perm.v1.RevocationCascade.last_child_id":
+		return x.LastChildId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.RevocationCascade"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.RevocationCascade does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RevocationCascade) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.perm.v1.RevocationCascade.perm_id":
+		x.PermId = uint64(0)
+	case "verana.perm.v1.RevocationCascade.origin_perm_id":
+		x.OriginPermId = uint64(0)
+	case "verana.perm.v1.RevocationCascade.mode":
+		x.Mode = 0
+	case "verana.perm.v1.RevocationCascade.executed_by":
+		x.ExecutedBy = ""
+	case "verana.perm.v1.RevocationCascade.created":
+		x.Created = nil
+	case "verana.perm.v1.RevocationCascade.last_child_id":
+		x.LastChildId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.RevocationCascade"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.RevocationCascade does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RevocationCascade) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.perm.v1.RevocationCascade.perm_id":
+		value := x.PermId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.RevocationCascade.origin_perm_id":
+		value := x.OriginPermId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.RevocationCascade.mode":
+		value := x.Mode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "verana.perm.v1.RevocationCascade.executed_by":
+		value := x.ExecutedBy
+		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.RevocationCascade.created":
+		value := x.Created
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.perm.v1.RevocationCascade.last_child_id":
+		value := x.LastChildId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.RevocationCascade"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.RevocationCascade does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RevocationCascade) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.perm.v1.RevocationCascade.perm_id":
+		x.PermId = value.Uint()
+	case "verana.perm.v1.RevocationCascade.origin_perm_id":
+		x.OriginPermId = value.Uint()
+	case "verana.perm.v1.RevocationCascade.mode":
+		x.Mode = (CascadeMode)(value.Enum())
+	case "verana.perm.v1.RevocationCascade.executed_by":
+		x.ExecutedBy = value.Interface().(string)
+	case "verana.perm.v1.RevocationCascade.created":
+		x.Created = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.perm.v1.RevocationCascade.last_child_id":
+		x.LastChildId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.RevocationCascade"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.RevocationCascade does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RevocationCascade) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.RevocationCascade.created":
+		if x.Created == nil {
+			x.Created = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Created.ProtoReflect())
+	case "verana.perm.v1.RevocationCascade.perm_id":
+		panic(fmt.Errorf("field perm_id of message verana.perm.v1.RevocationCascade is not mutable"))
+	case "verana.perm.v1.RevocationCascade.origin_perm_id":
+		panic(fmt.Errorf("field origin_perm_id of message verana.perm.v1.RevocationCascade is not mutable"))
+	case "verana.perm.v1.RevocationCascade.mode":
+		panic(fmt.Errorf("field mode of message verana.perm.v1.RevocationCascade is not mutable"))
+	case "verana.perm.v1.RevocationCascade.executed_by":
+		panic(fmt.Errorf("field executed_by of message verana.perm.v1.RevocationCascade is not mutable"))
+	case "verana.perm.v1.RevocationCascade.last_child_id":
+		panic(fmt.Errorf("field last_child_id of message verana.perm.v1.RevocationCascade is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.RevocationCascade"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.RevocationCascade does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RevocationCascade) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.RevocationCascade.perm_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.RevocationCascade.origin_perm_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.RevocationCascade.mode":
+		return protoreflect.ValueOfEnum(0)
+	case "verana.perm.v1.RevocationCascade.executed_by":
+		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.RevocationCascade.created":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.perm.v1.RevocationCascade.last_child_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.RevocationCascade"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.RevocationCascade does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RevocationCascade) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.RevocationCascade", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RevocationCascade) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RevocationCascade) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RevocationCascade) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RevocationCascade) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RevocationCascade)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.PermId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PermId))
+		}
+		if x.OriginPermId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OriginPermId))
+		}
+		if x.Mode != 0 {
+			n += 1 + runtime.Sov(uint64(x.Mode))
+		}
+		l = len(x.ExecutedBy)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Created != nil {
+			l = options.Size(x.Created)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LastChildId != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastChildId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RevocationCascade)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.LastChildId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastChildId))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.Created != nil {
+			encoded, err := options.Marshal(x.Created)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.ExecutedBy) > 0 {
+			i -= len(x.ExecutedBy)
+			copy(dAtA[i:], x.ExecutedBy)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExecutedBy)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Mode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Mode))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.OriginPermId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OriginPermId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.PermId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PermId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RevocationCascade)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RevocationCascade: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RevocationCascade: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PermId", wireType)
+				}
+				x.PermId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PermId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OriginPermId", wireType)
+				}
+				x.OriginPermId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OriginPermId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+				}
+				x.Mode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Mode |= CascadeMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutedBy", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExecutedBy = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Created == nil {
+					x.Created = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Created); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastChildId", wireType)
+				}
+				x.LastChildId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastChildId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_AgentReward                      protoreflect.MessageDescriptor
+	fd_AgentReward_perm_id              protoreflect.FieldDescriptor
+	fd_AgentReward_user_agent_rewards   protoreflect.FieldDescriptor
+	fd_AgentReward_wallet_agent_rewards protoreflect.FieldDescriptor
+	fd_AgentReward_trust_deposit        protoreflect.FieldDescriptor
+	fd_AgentReward_last_reward          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_types_proto_init()
+	md_AgentReward = File_verana_perm_v1_types_proto.Messages().ByName("AgentReward")
+	fd_AgentReward_perm_id = md_AgentReward.Fields().ByName("perm_id")
+	fd_AgentReward_user_agent_rewards = md_AgentReward.Fields().ByName("user_agent_rewards")
+	fd_AgentReward_wallet_agent_rewards = md_AgentReward.Fields().ByName("wallet_agent_rewards")
+	fd_AgentReward_trust_deposit = md_AgentReward.Fields().ByName("trust_deposit")
+	fd_AgentReward_last_reward = md_AgentReward.Fields().ByName("last_reward")
+}
+
+var _ protoreflect.Message = (*fastReflection_AgentReward)(nil)
+
+type fastReflection_AgentReward AgentReward
+
+func (x *AgentReward) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AgentReward)(x)
+}
+
+func (x *AgentReward) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_types_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AgentReward_messageType fastReflection_AgentReward_messageType
+var _ protoreflect.MessageType = fastReflection_AgentReward_messageType{}
+
+type fastReflection_AgentReward_messageType struct{}
+
+func (x fastReflection_AgentReward_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AgentReward)(nil)
+}
+func (x fastReflection_AgentReward_messageType) New() protoreflect.Message {
+	return new(fastReflection_AgentReward)
+}
+func (x fastReflection_AgentReward_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AgentReward
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AgentReward) Descriptor() protoreflect.MessageDescriptor {
+	return md_AgentReward
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AgentReward) Type() protoreflect.MessageType {
+	return _fastReflection_AgentReward_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AgentReward) New() protoreflect.Message {
+	return new(fastReflection_AgentReward)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AgentReward) Interface() protoreflect.ProtoMessage {
+	return (*AgentReward)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AgentReward) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PermId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PermId)
+		if !f(fd_AgentReward_perm_id, value) {
+			return
+		}
+	}
+	if x.UserAgentRewards != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.UserAgentRewards)
+		if !f(fd_AgentReward_user_agent_rewards, value) {
+			return
+		}
+	}
+	if x.WalletAgentRewards != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.WalletAgentRewards)
+		if !f(fd_AgentReward_wallet_agent_rewards, value) {
+			return
+		}
+	}
+	if x.TrustDeposit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TrustDeposit)
+		if !f(fd_AgentReward_trust_deposit, value) {
+			return
+		}
+	}
+	if x.LastReward != nil {
+		value := protoreflect.ValueOfMessage(x.LastReward.ProtoReflect())
+		if !f(fd_AgentReward_last_reward, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AgentReward) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.perm.v1.AgentReward.perm_id":
+		return x.PermId != uint64(0)
+	case "verana.perm.v1.AgentReward.user_agent_rewards":
+		return x.UserAgentRewards != uint64(0)
+	case "verana.perm.v1.AgentReward.wallet_agent_rewards":
+		return x.WalletAgentRewards != uint64(0)
+	case "verana.perm.v1.AgentReward.trust_deposit":
+		return x.TrustDeposit != uint64(0)
+	case "verana.perm.v1.AgentReward.last_reward":
+		return x.LastReward != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.AgentReward"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.AgentReward does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AgentReward) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.perm.v1.AgentReward.perm_id":
+		x.PermId = uint64(0)
+	case "verana.perm.v1.AgentReward.user_agent_rewards":
+		x.UserAgentRewards = uint64(0)
+	case "verana.perm.v1.AgentReward.wallet_agent_rewards":
+		x.WalletAgentRewards = uint64(0)
+	case "verana.perm.v1.AgentReward.trust_deposit":
+		x.TrustDeposit = uint64(0)
+	case "verana.perm.v1.AgentReward.last_reward":
+		x.LastReward = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.AgentReward"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.AgentReward does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AgentReward) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.perm.v1.AgentReward.perm_id":
+		value := x.PermId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.AgentReward.user_agent_rewards":
+		value := x.UserAgentRewards
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.AgentReward.wallet_agent_rewards":
+		value := x.WalletAgentRewards
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.AgentReward.trust_deposit":
+		value := x.TrustDeposit
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.AgentReward.last_reward":
+		value := x.LastReward
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.AgentReward"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.AgentReward does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AgentReward) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.perm.v1.AgentReward.perm_id":
+		x.PermId = value.Uint()
+	case "verana.perm.v1.AgentReward.user_agent_rewards":
+		x.UserAgentRewards = value.Uint()
+	case "verana.perm.v1.AgentReward.wallet_agent_rewards":
+		x.WalletAgentRewards = value.Uint()
+	case "verana.perm.v1.AgentReward.trust_deposit":
+		x.TrustDeposit = value.Uint()
+	case "verana.perm.v1.AgentReward.last_reward":
+		x.LastReward = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.AgentReward"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.AgentReward does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AgentReward) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.AgentReward.last_reward":
+		if x.LastReward == nil {
+			x.LastReward = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.LastReward.ProtoReflect())
+	case "verana.perm.v1.AgentReward.perm_id":
+		panic(fmt.Errorf("field perm_id of message verana.perm.v1.AgentReward is not mutable"))
+	case "verana.perm.v1.AgentReward.user_agent_rewards":
+		panic(fmt.Errorf("field user_agent_rewards of message verana.perm.v1.AgentReward is not mutable"))
+	case "verana.perm.v1.AgentReward.wallet_agent_rewards":
+		panic(fmt.Errorf("field wallet_agent_rewards of message verana.perm.v1.AgentReward is not mutable"))
+	case "verana.perm.v1.AgentReward.trust_deposit":
+		panic(fmt.Errorf("field trust_deposit of message verana.perm.v1.AgentReward is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.AgentReward"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.AgentReward does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AgentReward) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.AgentReward.perm_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.AgentReward.user_agent_rewards":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.AgentReward.wallet_agent_rewards":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.AgentReward.trust_deposit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.AgentReward.last_reward":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.AgentReward"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.AgentReward does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AgentReward) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.AgentReward", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AgentReward) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AgentReward) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AgentReward) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AgentReward) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AgentReward)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.PermId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PermId))
+		}
+		if x.UserAgentRewards != 0 {
+			n += 1 + runtime.Sov(uint64(x.UserAgentRewards))
+		}
+		if x.WalletAgentRewards != 0 {
+			n += 1 + runtime.Sov(uint64(x.WalletAgentRewards))
+		}
+		if x.TrustDeposit != 0 {
+			n += 1 + runtime.Sov(uint64(x.TrustDeposit))
+		}
+		if x.LastReward != nil {
+			l = options.Size(x.LastReward)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AgentReward)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.LastReward != nil {
+			encoded, err := options.Marshal(x.LastReward)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.TrustDeposit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TrustDeposit))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.WalletAgentRewards != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WalletAgentRewards))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.UserAgentRewards != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UserAgentRewards))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.PermId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PermId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AgentReward)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AgentReward: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AgentReward: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PermId", wireType)
+				}
+				x.PermId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PermId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UserAgentRewards", wireType)
+				}
+				x.UserAgentRewards = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UserAgentRewards |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletAgentRewards", wireType)
+				}
+				x.WalletAgentRewards = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WalletAgentRewards |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TrustDeposit", wireType)
+				}
+				x.TrustDeposit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TrustDeposit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastReward", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.LastReward == nil {
+					x.LastReward = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LastReward); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_VerificationCredit                    protoreflect.MessageDescriptor
+	fd_VerificationCredit_id                 protoreflect.FieldDescriptor
+	fd_VerificationCredit_buyer              protoreflect.FieldDescriptor
+	fd_VerificationCredit_verifier_perm_id   protoreflect.FieldDescriptor
+	fd_VerificationCredit_issuer_perm_id     protoreflect.FieldDescriptor
+	fd_VerificationCredit_executor_perm_id   protoreflect.FieldDescriptor
+	fd_VerificationCredit_purchased          protoreflect.FieldDescriptor
+	fd_VerificationCredit_remaining          protoreflect.FieldDescriptor
+	fd_VerificationCredit_unit_fees          protoreflect.FieldDescriptor
+	fd_VerificationCredit_unit_trust_deposit protoreflect.FieldDescriptor
+	fd_VerificationCredit_created            protoreflect.FieldDescriptor
+	fd_VerificationCredit_modified           protoreflect.FieldDescriptor
+	fd_VerificationCredit_refunded           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_types_proto_init()
+	md_VerificationCredit = File_verana_perm_v1_types_proto.Messages().ByName("VerificationCredit")
+	fd_VerificationCredit_id = md_VerificationCredit.Fields().ByName("id")
+	fd_VerificationCredit_buyer = md_VerificationCredit.Fields().ByName("buyer")
+	fd_VerificationCredit_verifier_perm_id = md_VerificationCredit.Fields().ByName("verifier_perm_id")
+	fd_VerificationCredit_issuer_perm_id = md_VerificationCredit.Fields().ByName("issuer_perm_id")
+	fd_VerificationCredit_executor_perm_id = md_VerificationCredit.Fields().ByName("executor_perm_id")
+	fd_VerificationCredit_purchased = md_VerificationCredit.Fields().ByName("purchased")
+	fd_VerificationCredit_remaining = md_VerificationCredit.Fields().ByName("remaining")
+	fd_VerificationCredit_unit_fees = md_VerificationCredit.Fields().ByName("unit_fees")
+	fd_VerificationCredit_unit_trust_deposit = md_VerificationCredit.Fields().ByName("unit_trust_deposit")
+	fd_VerificationCredit_created = md_VerificationCredit.Fields().ByName("created")
+	fd_VerificationCredit_modified = md_VerificationCredit.Fields().ByName("modified")
+	fd_VerificationCredit_refunded = md_VerificationCredit.Fields().ByName("refunded")
+}
+
+var _ protoreflect.Message = (*fastReflection_VerificationCredit)(nil)
+
+type fastReflection_VerificationCredit VerificationCredit
+
+func (x *VerificationCredit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VerificationCredit)(x)
+}
+
+func (x *VerificationCredit) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_types_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_VerificationCredit_messageType fastReflection_VerificationCredit_messageType
+var _ protoreflect.MessageType = fastReflection_VerificationCredit_messageType{}
+
+type fastReflection_VerificationCredit_messageType struct{}
+
+func (x fastReflection_VerificationCredit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VerificationCredit)(nil)
+}
+func (x fastReflection_VerificationCredit_messageType) New() protoreflect.Message {
+	return new(fastReflection_VerificationCredit)
+}
+func (x fastReflection_VerificationCredit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VerificationCredit
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VerificationCredit) Descriptor() protoreflect.MessageDescriptor {
+	return md_VerificationCredit
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_VerificationCredit) Type() protoreflect.MessageType {
+	return _fastReflection_VerificationCredit_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_VerificationCredit) New() protoreflect.Message {
+	return new(fastReflection_VerificationCredit)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_VerificationCredit) Interface() protoreflect.ProtoMessage {
+	return (*VerificationCredit)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_VerificationCredit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_VerificationCredit_id, value) {
+			return
+		}
+	}
+	if x.Buyer != "" {
+		value := protoreflect.ValueOfString(x.Buyer)
+		if !f(fd_VerificationCredit_buyer, value) {
+			return
+		}
+	}
+	if x.VerifierPermId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.VerifierPermId)
+		if !f(fd_VerificationCredit_verifier_perm_id, value) {
+			return
+		}
+	}
+	if x.IssuerPermId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.IssuerPermId)
+		if !f(fd_VerificationCredit_issuer_perm_id, value) {
+			return
+		}
+	}
+	if x.ExecutorPermId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExecutorPermId)
+		if !f(fd_VerificationCredit_executor_perm_id, value) {
+			return
+		}
+	}
+	if x.Purchased != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Purchased)
+		if !f(fd_VerificationCredit_purchased, value) {
+			return
+		}
+	}
+	if x.Remaining != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Remaining)
+		if !f(fd_VerificationCredit_remaining, value) {
+			return
+		}
+	}
+	if x.UnitFees != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.UnitFees)
+		if !f(fd_VerificationCredit_unit_fees, value) {
+			return
+		}
+	}
+	if x.UnitTrustDeposit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.UnitTrustDeposit)
+		if !f(fd_VerificationCredit_unit_trust_deposit, value) {
+			return
+		}
+	}
+	if x.Created != nil {
+		value := protoreflect.ValueOfMessage(x.Created.ProtoReflect())
+		if !f(fd_VerificationCredit_created, value) {
+			return
+		}
+	}
+	if x.Modified != nil {
+		value := protoreflect.ValueOfMessage(x.Modified.ProtoReflect())
+		if !f(fd_VerificationCredit_modified, value) {
+			return
+		}
+	}
+	if x.Refunded != nil {
+		value := protoreflect.ValueOfMessage(x.Refunded.ProtoReflect())
+		if !f(fd_VerificationCredit_refunded, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_VerificationCredit) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.perm.v1.VerificationCredit.id":
+		return x.Id != uint64(0)
+	case "verana.perm.v1.VerificationCredit.buyer":
+		return x.Buyer != ""
+	case "verana.perm.v1.VerificationCredit.verifier_perm_id":
+		return x.VerifierPermId != uint64(0)
+	case "verana.perm.v1.VerificationCredit.issuer_perm_id":
+		return x.IssuerPermId != uint64(0)
+	case "verana.perm.v1.VerificationCredit.executor_perm_id":
+		return x.ExecutorPermId != uint64(0)
+	case "verana.perm.v1.VerificationCredit.purchased":
+		return x.Purchased != uint64(0)
+	case "verana.perm.v1.VerificationCredit.remaining":
+		return x.Remaining != uint64(0)
+	case "verana.perm.v1.VerificationCredit.unit_fees":
+		return x.UnitFees != uint64(0)
+	case "verana.perm.v1.VerificationCredit.unit_trust_deposit":
+		return x.UnitTrustDeposit != uint64(0)
+	case "verana.perm.v1.VerificationCredit.created":
+		return x.Created != nil
+	case "verana.perm.v1.VerificationCredit.modified":
+		return x.Modified != nil
+	case "verana.perm.v1.VerificationCredit.refunded":
+		return x.Refunded != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.VerificationCredit"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.VerificationCredit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VerificationCredit) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.perm.v1.VerificationCredit.id":
+		x.Id = uint64(0)
+	case "verana.perm.v1.VerificationCredit.buyer":
+		x.Buyer = ""
+	case "verana.perm.v1.VerificationCredit.verifier_perm_id":
+		x.VerifierPermId = uint64(0)
+	case "verana.perm.v1.VerificationCredit.issuer_perm_id":
+		x.IssuerPermId = uint64(0)
+	case "verana.perm.v1.VerificationCredit.executor_perm_id":
+		x.ExecutorPermId = uint64(0)
+	case "verana.perm.v1.VerificationCredit.purchased":
+		x.Purchased = uint64(0)
+	case "verana.perm.v1.VerificationCredit.remaining":
+		x.Remaining = uint64(0)
+	case "verana.perm.v1.VerificationCredit.unit_fees":
+		x.UnitFees = uint64(0)
+	case "verana.perm.v1.VerificationCredit.unit_trust_deposit":
+		x.UnitTrustDeposit = uint64(0)
+	case "verana.perm.v1.VerificationCredit.created":
+		x.Created = nil
+	case "verana.perm.v1.VerificationCredit.modified":
+		x.Modified = nil
+	case "verana.perm.v1.VerificationCredit.refunded":
+		x.Refunded = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.VerificationCredit"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.VerificationCredit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_VerificationCredit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.perm.v1.VerificationCredit.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.VerificationCredit.buyer":
+		value := x.Buyer
+		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.VerificationCredit.verifier_perm_id":
+		value := x.VerifierPermId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.VerificationCredit.issuer_perm_id":
+		value := x.IssuerPermId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.VerificationCredit.executor_perm_id":
+		value := x.ExecutorPermId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.VerificationCredit.purchased":
+		value := x.Purchased
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.VerificationCredit.remaining":
+		value := x.Remaining
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.VerificationCredit.unit_fees":
+		value := x.UnitFees
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.VerificationCredit.unit_trust_deposit":
+		value := x.UnitTrustDeposit
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.VerificationCredit.created":
+		value := x.Created
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.perm.v1.VerificationCredit.modified":
+		value := x.Modified
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.perm.v1.VerificationCredit.refunded":
+		value := x.Refunded
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.VerificationCredit"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.VerificationCredit does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VerificationCredit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.perm.v1.VerificationCredit.id":
+		x.Id = value.Uint()
+	case "verana.perm.v1.VerificationCredit.buyer":
+		x.Buyer = value.Interface().(string)
+	case "verana.perm.v1.VerificationCredit.verifier_perm_id":
+		x.VerifierPermId = value.Uint()
+	case "verana.perm.v1.VerificationCredit.issuer_perm_id":
+		x.IssuerPermId = value.Uint()
+	case "verana.perm.v1.VerificationCredit.executor_perm_id":
+		x.ExecutorPermId = value.Uint()
+	case "verana.perm.v1.VerificationCredit.purchased":
+		x.Purchased = value.Uint()
+	case "verana.perm.v1.VerificationCredit.remaining":
+		x.Remaining = value.Uint()
+	case "verana.perm.v1.VerificationCredit.unit_fees":
+		x.UnitFees = value.Uint()
+	case "verana.perm.v1.VerificationCredit.unit_trust_deposit":
+		x.UnitTrustDeposit = value.Uint()
+	case "verana.perm.v1.VerificationCredit.created":
+		x.Created = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.perm.v1.VerificationCredit.modified":
+		x.Modified = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.perm.v1.VerificationCredit.refunded":
+		x.Refunded = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.VerificationCredit"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.VerificationCredit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VerificationCredit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.VerificationCredit.created":
+		if x.Created == nil {
+			x.Created = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Created.ProtoReflect())
+	case "verana.perm.v1.VerificationCredit.modified":
+		if x.Modified == nil {
+			x.Modified = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Modified.ProtoReflect())
+	case "verana.perm.v1.VerificationCredit.refunded":
+		if x.Refunded == nil {
+			x.Refunded = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Refunded.ProtoReflect())
+	case "verana.perm.v1.VerificationCredit.id":
+		panic(fmt.Errorf("field id of message verana.perm.v1.VerificationCredit is not mutable"))
+	case "verana.perm.v1.VerificationCredit.buyer":
+		panic(fmt.Errorf("field buyer of message verana.perm.v1.VerificationCredit is not mutable"))
+	case "verana.perm.v1.VerificationCredit.verifier_perm_id":
+		panic(fmt.Errorf("field verifier_perm_id of message verana.perm.v1.VerificationCredit is not mutable"))
+	case "verana.perm.v1.VerificationCredit.issuer_perm_id":
+		panic(fmt.Errorf("field issuer_perm_id of message verana.perm.v1.VerificationCredit is not mutable"))
+	case "verana.perm.v1.VerificationCredit.executor_perm_id":
+		panic(fmt.Errorf("field executor_perm_id of message verana.perm.v1.VerificationCredit is not mutable"))
+	case "verana.perm.v1.VerificationCredit.purchased":
+		panic(fmt.Errorf("field purchased of message verana.perm.v1.VerificationCredit is not mutable"))
+	case "verana.perm.v1.VerificationCredit.remaining":
+		panic(fmt.Errorf("field remaining of message verana.perm.v1.VerificationCredit is not mutable"))
+	case "verana.perm.v1.VerificationCredit.unit_fees":
+		panic(fmt.Errorf("field unit_fees of message verana.perm.v1.VerificationCredit is not mutable"))
+	case "verana.perm.v1.VerificationCredit.unit_trust_deposit":
+		panic(fmt.Errorf("field unit_trust_deposit of message verana.perm.v1.VerificationCredit is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.VerificationCredit"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.VerificationCredit does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_VerificationCredit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.VerificationCredit.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.VerificationCredit.buyer":
+		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.VerificationCredit.verifier_perm_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.VerificationCredit.issuer_perm_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.VerificationCredit.executor_perm_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.VerificationCredit.purchased":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.VerificationCredit.remaining":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.VerificationCredit.unit_fees":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.VerificationCredit.unit_trust_deposit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.VerificationCredit.created":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.perm.v1.VerificationCredit.modified":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.perm.v1.VerificationCredit.refunded":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.VerificationCredit"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.VerificationCredit does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_VerificationCredit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.VerificationCredit", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_VerificationCredit) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VerificationCredit) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_VerificationCredit) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_VerificationCredit) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*VerificationCredit)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Buyer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.VerifierPermId != 0 {
+			n += 1 + runtime.Sov(uint64(x.VerifierPermId))
+		}
+		if x.IssuerPermId != 0 {
+			n += 1 + runtime.Sov(uint64(x.IssuerPermId))
+		}
+		if x.ExecutorPermId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecutorPermId))
+		}
+		if x.Purchased != 0 {
+			n += 1 + runtime.Sov(uint64(x.Purchased))
+		}
+		if x.Remaining != 0 {
+			n += 1 + runtime.Sov(uint64(x.Remaining))
+		}
+		if x.UnitFees != 0 {
+			n += 1 + runtime.Sov(uint64(x.UnitFees))
+		}
+		if x.UnitTrustDeposit != 0 {
+			n += 1 + runtime.Sov(uint64(x.UnitTrustDeposit))
+		}
+		if x.Created != nil {
+			l = options.Size(x.Created)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Modified != nil {
+			l = options.Size(x.Modified)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Refunded != nil {
+			l = options.Size(x.Refunded)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*VerificationCredit)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Refunded != nil {
+			encoded, err := options.Marshal(x.Refunded)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.Modified != nil {
+			encoded, err := options.Marshal(x.Modified)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.Created != nil {
+			encoded, err := options.Marshal(x.Created)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.UnitTrustDeposit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UnitTrustDeposit))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.UnitFees != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UnitFees))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.Remaining != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Remaining))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.Purchased != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Purchased))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.ExecutorPermId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecutorPermId))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.IssuerPermId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IssuerPermId))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.VerifierPermId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VerifierPermId))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Buyer) > 0 {
+			i -= len(x.Buyer)
+			copy(dAtA[i:], x.Buyer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Buyer)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*VerificationCredit)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VerificationCredit: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VerificationCredit: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Buyer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerifierPermId", wireType)
+				}
+				x.VerifierPermId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.VerifierPermId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IssuerPermId", wireType)
+				}
+				x.IssuerPermId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IssuerPermId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutorPermId", wireType)
+				}
+				x.ExecutorPermId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecutorPermId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Purchased", wireType)
+				}
+				x.Purchased = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Purchased |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+				}
+				x.Remaining = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Remaining |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnitFees", wireType)
+				}
+				x.UnitFees = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UnitFees |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnitTrustDeposit", wireType)
+				}
+				x.UnitTrustDeposit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UnitTrustDeposit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Created == nil {
+					x.Created = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Created); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Modified", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Modified == nil {
+					x.Modified = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Modified); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Refunded == nil {
+					x.Refunded = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Refunded); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SessionVoucher                     protoreflect.MessageDescriptor
+	fd_SessionVoucher_signer              protoreflect.FieldDescriptor
+	fd_SessionVoucher_executor_perm_id    protoreflect.FieldDescriptor
+	fd_SessionVoucher_beneficiary_perm_id protoreflect.FieldDescriptor
+	fd_SessionVoucher_agent_perm_id       protoreflect.FieldDescriptor
+	fd_SessionVoucher_nonce               protoreflect.FieldDescriptor
+	fd_SessionVoucher_amount              protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_types_proto_init()
+	md_SessionVoucher = File_verana_perm_v1_types_proto.Messages().ByName("SessionVoucher")
+	fd_SessionVoucher_signer = md_SessionVoucher.Fields().ByName("signer")
+	fd_SessionVoucher_executor_perm_id = md_SessionVoucher.Fields().ByName("executor_perm_id")
+	fd_SessionVoucher_beneficiary_perm_id = md_SessionVoucher.Fields().ByName("beneficiary_perm_id")
+	fd_SessionVoucher_agent_perm_id = md_SessionVoucher.Fields().ByName("agent_perm_id")
+	fd_SessionVoucher_nonce = md_SessionVoucher.Fields().ByName("nonce")
+	fd_SessionVoucher_amount = md_SessionVoucher.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_SessionVoucher)(nil)
+
+type fastReflection_SessionVoucher SessionVoucher
+
+func (x *SessionVoucher) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SessionVoucher)(x)
+}
+
+func (x *SessionVoucher) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_types_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SessionVoucher_messageType fastReflection_SessionVoucher_messageType
+var _ protoreflect.MessageType = fastReflection_SessionVoucher_messageType{}
+
+type fastReflection_SessionVoucher_messageType struct{}
+
+func (x fastReflection_SessionVoucher_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SessionVoucher)(nil)
+}
+func (x fastReflection_SessionVoucher_messageType) New() protoreflect.Message {
+	return new(fastReflection_SessionVoucher)
+}
+func (x fastReflection_SessionVoucher_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SessionVoucher
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SessionVoucher) Descriptor() protoreflect.MessageDescriptor {
+	return md_SessionVoucher
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SessionVoucher) Type() protoreflect.MessageType {
+	return _fastReflection_SessionVoucher_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SessionVoucher) New() protoreflect.Message {
+	return new(fastReflection_SessionVoucher)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SessionVoucher) Interface() protoreflect.ProtoMessage {
+	return (*SessionVoucher)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SessionVoucher) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_SessionVoucher_signer, value) {
+			return
+		}
+	}
+	if x.ExecutorPermId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExecutorPermId)
+		if !f(fd_SessionVoucher_executor_perm_id, value) {
+			return
+		}
+	}
+	if x.BeneficiaryPermId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BeneficiaryPermId)
+		if !f(fd_SessionVoucher_beneficiary_perm_id, value) {
+			return
+		}
+	}
+	if x.AgentPermId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AgentPermId)
+		if !f(fd_SessionVoucher_agent_perm_id, value) {
+			return
+		}
+	}
+	if x.Nonce != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Nonce)
+		if !f(fd_SessionVoucher_nonce, value) {
+			return
+		}
+	}
+	if x.Amount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Amount)
+		if !f(fd_SessionVoucher_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SessionVoucher) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.perm.v1.SessionVoucher.signer":
+		return x.Signer != ""
+	case "verana.perm.v1.SessionVoucher.executor_perm_id":
+		return x.ExecutorPermId != uint64(0)
+	case "verana.perm.v1.SessionVoucher.beneficiary_perm_id":
+		return x.BeneficiaryPermId != uint64(0)
+	case "verana.perm.v1.SessionVoucher.agent_perm_id":
+		return x.AgentPermId != uint64(0)
+	case "verana.perm.v1.SessionVoucher.nonce":
+		return x.Nonce != uint64(0)
+	case "verana.perm.v1.SessionVoucher.amount":
+		return x.Amount != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SessionVoucher"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.SessionVoucher does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SessionVoucher) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.perm.v1.SessionVoucher.signer":
+		x.Signer = ""
+	case "verana.perm.v1.SessionVoucher.executor_perm_id":
+		x.ExecutorPermId = uint64(0)
+	case "verana.perm.v1.SessionVoucher.beneficiary_perm_id":
+		x.BeneficiaryPermId = uint64(0)
+	case "verana.perm.v1.SessionVoucher.agent_perm_id":
+		x.AgentPermId = uint64(0)
+	case "verana.perm.v1.SessionVoucher.nonce":
+		x.Nonce = uint64(0)
+	case "verana.perm.v1.SessionVoucher.amount":
+		x.Amount = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SessionVoucher"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.SessionVoucher does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SessionVoucher) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.perm.v1.SessionVoucher.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.SessionVoucher.executor_perm_id":
+		value := x.ExecutorPermId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.SessionVoucher.beneficiary_perm_id":
+		value := x.BeneficiaryPermId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.SessionVoucher.agent_perm_id":
+		value := x.AgentPermId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.SessionVoucher.nonce":
+		value := x.Nonce
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.SessionVoucher.amount":
+		value := x.Amount
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SessionVoucher"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.SessionVoucher does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SessionVoucher) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.perm.v1.SessionVoucher.signer":
+		x.Signer = value.Interface().(string)
+	case "verana.perm.v1.SessionVoucher.executor_perm_id":
+		x.ExecutorPermId = value.Uint()
+	case "verana.perm.v1.SessionVoucher.beneficiary_perm_id":
+		x.BeneficiaryPermId = value.Uint()
+	case "verana.perm.v1.SessionVoucher.agent_perm_id":
+		x.AgentPermId = value.Uint()
+	case "verana.perm.v1.SessionVoucher.nonce":
+		x.Nonce = value.Uint()
+	case "verana.perm.v1.SessionVoucher.amount":
+		x.Amount = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SessionVoucher"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.SessionVoucher does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SessionVoucher) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.SessionVoucher.signer":
+		panic(fmt.Errorf("field signer of message verana.perm.v1.SessionVoucher is not mutable"))
+	case "verana.perm.v1.SessionVoucher.executor_perm_id":
+		panic(fmt.Errorf("field executor_perm_id of message verana.perm.v1.SessionVoucher is not mutable"))
+	case "verana.perm.v1.SessionVoucher.beneficiary_perm_id":
+		panic(fmt.Errorf("field beneficiary_perm_id of message verana.perm.v1.SessionVoucher is not mutable"))
+	case "verana.perm.v1.SessionVoucher.agent_perm_id":
+		panic(fmt.Errorf("field agent_perm_id of message verana.perm.v1.SessionVoucher is not mutable"))
+	case "verana.perm.v1.SessionVoucher.nonce":
+		panic(fmt.Errorf("field nonce of message verana.perm.v1.SessionVoucher is not mutable"))
+	case "verana.perm.v1.SessionVoucher.amount":
+		panic(fmt.Errorf("field amount of message verana.perm.v1.SessionVoucher is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SessionVoucher"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.SessionVoucher does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SessionVoucher) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.SessionVoucher.signer":
+		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.SessionVoucher.executor_perm_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.SessionVoucher.beneficiary_perm_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.SessionVoucher.agent_perm_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.SessionVoucher.nonce":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.SessionVoucher.amount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SessionVoucher"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.SessionVoucher does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SessionVoucher) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.SessionVoucher", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SessionVoucher) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SessionVoucher) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SessionVoucher) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SessionVoucher) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SessionVoucher)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExecutorPermId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecutorPermId))
+		}
+		if x.BeneficiaryPermId != 0 {
+			n += 1 + runtime.Sov(uint64(x.BeneficiaryPermId))
+		}
+		if x.AgentPermId != 0 {
+			n += 1 + runtime.Sov(uint64(x.AgentPermId))
+		}
+		if x.Nonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.Nonce))
+		}
+		if x.Amount != 0 {
+			n += 1 + runtime.Sov(uint64(x.Amount))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SessionVoucher)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Amount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Amount))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.Nonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.AgentPermId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AgentPermId))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.BeneficiaryPermId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BeneficiaryPermId))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.ExecutorPermId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecutorPermId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SessionVoucher)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SessionVoucher: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SessionVoucher: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutorPermId", wireType)
+				}
+				x.ExecutorPermId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecutorPermId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BeneficiaryPermId", wireType)
+				}
+				x.BeneficiaryPermId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BeneficiaryPermId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AgentPermId", wireType)
+				}
+				x.AgentPermId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AgentPermId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				}
+				x.Nonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Nonce |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				x.Amount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Amount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SessionVoucherSignDoc          protoreflect.MessageDescriptor
+	fd_SessionVoucherSignDoc_chain_id protoreflect.FieldDescriptor
+	fd_SessionVoucherSignDoc_voucher  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_types_proto_init()
+	md_SessionVoucherSignDoc = File_verana_perm_v1_types_proto.Messages().ByName("SessionVoucherSignDoc")
+	fd_SessionVoucherSignDoc_chain_id = md_SessionVoucherSignDoc.Fields().ByName("chain_id")
+	fd_SessionVoucherSignDoc_voucher = md_SessionVoucherSignDoc.Fields().ByName("voucher")
+}
+
+var _ protoreflect.Message = (*fastReflection_SessionVoucherSignDoc)(nil)
+
+type fastReflection_SessionVoucherSignDoc SessionVoucherSignDoc
+
+func (x *SessionVoucherSignDoc) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SessionVoucherSignDoc)(x)
+}
+
+func (x *SessionVoucherSignDoc) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_types_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SessionVoucherSignDoc_messageType fastReflection_SessionVoucherSignDoc_messageType
+var _ protoreflect.MessageType = fastReflection_SessionVoucherSignDoc_messageType{}
+
+type fastReflection_SessionVoucherSignDoc_messageType struct{}
+
+func (x fastReflection_SessionVoucherSignDoc_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SessionVoucherSignDoc)(nil)
+}
+func (x fastReflection_SessionVoucherSignDoc_messageType) New() protoreflect.Message {
+	return new(fastReflection_SessionVoucherSignDoc)
+}
+func (x fastReflection_SessionVoucherSignDoc_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SessionVoucherSignDoc
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SessionVoucherSignDoc) Descriptor() protoreflect.MessageDescriptor {
+	return md_SessionVoucherSignDoc
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SessionVoucherSignDoc) Type() protoreflect.MessageType {
+	return _fastReflection_SessionVoucherSignDoc_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SessionVoucherSignDoc) New() protoreflect.Message {
+	return new(fastReflection_SessionVoucherSignDoc)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SessionVoucherSignDoc) Interface() protoreflect.ProtoMessage {
+	return (*SessionVoucherSignDoc)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SessionVoucherSignDoc) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ChainId != "" {
+		value := protoreflect.ValueOfString(x.ChainId)
+		if !f(fd_SessionVoucherSignDoc_chain_id, value) {
+			return
+		}
+	}
+	if x.Voucher != nil {
+		value := protoreflect.ValueOfMessage(x.Voucher.ProtoReflect())
+		if !f(fd_SessionVoucherSignDoc_voucher, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SessionVoucherSignDoc) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.perm.v1.SessionVoucherSignDoc.chain_id":
+		return x.ChainId != ""
+	case "verana.perm.v1.SessionVoucherSignDoc.voucher":
+		return x.Voucher != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SessionVoucherSignDoc"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.SessionVoucherSignDoc does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SessionVoucherSignDoc) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.perm.v1.SessionVoucherSignDoc.chain_id":
+		x.ChainId = ""
+	case "verana.perm.v1.SessionVoucherSignDoc.voucher":
+		x.Voucher = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SessionVoucherSignDoc"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.SessionVoucherSignDoc does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SessionVoucherSignDoc) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.perm.v1.SessionVoucherSignDoc.chain_id":
+		value := x.ChainId
+		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.SessionVoucherSignDoc.voucher":
+		value := x.Voucher
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SessionVoucherSignDoc"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.SessionVoucherSignDoc does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SessionVoucherSignDoc) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.perm.v1.SessionVoucherSignDoc.chain_id":
+		x.ChainId = value.Interface().(string)
+	case "verana.perm.v1.SessionVoucherSignDoc.voucher":
+		x.Voucher = value.Message().Interface().(*SessionVoucher)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SessionVoucherSignDoc"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.SessionVoucherSignDoc does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SessionVoucherSignDoc) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.SessionVoucherSignDoc.voucher":
+		if x.Voucher == nil {
+			x.Voucher = new(SessionVoucher)
+		}
+		return protoreflect.ValueOfMessage(x.Voucher.ProtoReflect())
+	case "verana.perm.v1.SessionVoucherSignDoc.chain_id":
+		panic(fmt.Errorf("field chain_id of message verana.perm.v1.SessionVoucherSignDoc is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SessionVoucherSignDoc"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.SessionVoucherSignDoc does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SessionVoucherSignDoc) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.SessionVoucherSignDoc.chain_id":
+		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.SessionVoucherSignDoc.voucher":
+		m := new(SessionVoucher)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SessionVoucherSignDoc"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.SessionVoucherSignDoc does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SessionVoucherSignDoc) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.SessionVoucherSignDoc", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SessionVoucherSignDoc) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SessionVoucherSignDoc) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SessionVoucherSignDoc) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SessionVoucherSignDoc) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SessionVoucherSignDoc)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ChainId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Voucher != nil {
+			l = options.Size(x.Voucher)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SessionVoucherSignDoc)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Voucher != nil {
+			encoded, err := options.Marshal(x.Voucher)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ChainId) > 0 {
+			i -= len(x.ChainId)
+			copy(dAtA[i:], x.ChainId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChainId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SessionVoucherSignDoc)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SessionVoucherSignDoc: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SessionVoucherSignDoc: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChainId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Voucher", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Voucher == nil {
+					x.Voucher = &SessionVoucher{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Voucher); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_VoucherNonce_3_list)(nil)
+
+type _VoucherNonce_3_list struct {
+	list *[]uint64
+}
+
+func (x *_VoucherNonce_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_VoucherNonce_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_VoucherNonce_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_VoucherNonce_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_VoucherNonce_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message VoucherNonce at list field UsedNonces as it is not of Message kind"))
+}
+
+func (x *_VoucherNonce_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_VoucherNonce_3_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_VoucherNonce_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_VoucherNonce             protoreflect.MessageDescriptor
+	fd_VoucherNonce_signer      protoreflect.FieldDescriptor
+	fd_VoucherNonce_nonce       protoreflect.FieldDescriptor
+	fd_VoucherNonce_used_nonces protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_types_proto_init()
+	md_VoucherNonce = File_verana_perm_v1_types_proto.Messages().ByName("VoucherNonce")
+	fd_VoucherNonce_signer = md_VoucherNonce.Fields().ByName("signer")
+	fd_VoucherNonce_nonce = md_VoucherNonce.Fields().ByName("nonce")
+	fd_VoucherNonce_used_nonces = md_VoucherNonce.Fields().ByName("used_nonces")
+}
+
+var _ protoreflect.Message = (*fastReflection_VoucherNonce)(nil)
+
+type fastReflection_VoucherNonce VoucherNonce
+
+func (x *VoucherNonce) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VoucherNonce)(x)
+}
+
+func (x *VoucherNonce) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_types_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_VoucherNonce_messageType fastReflection_VoucherNonce_messageType
+var _ protoreflect.MessageType = fastReflection_VoucherNonce_messageType{}
+
+type fastReflection_VoucherNonce_messageType struct{}
+
+func (x fastReflection_VoucherNonce_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VoucherNonce)(nil)
+}
+func (x fastReflection_VoucherNonce_messageType) New() protoreflect.Message {
+	return new(fastReflection_VoucherNonce)
+}
+func (x fastReflection_VoucherNonce_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VoucherNonce
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VoucherNonce) Descriptor() protoreflect.MessageDescriptor {
+	return md_VoucherNonce
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_VoucherNonce) Type() protoreflect.MessageType {
+	return _fastReflection_VoucherNonce_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_VoucherNonce) New() protoreflect.Message {
+	return new(fastReflection_VoucherNonce)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_VoucherNonce) Interface() protoreflect.ProtoMessage {
+	return (*VoucherNonce)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_VoucherNonce) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_VoucherNonce_signer, value) {
+			return
+		}
+	}
+	if x.Nonce != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Nonce)
+		if !f(fd_VoucherNonce_nonce, value) {
+			return
+		}
+	}
+	if len(x.UsedNonces) != 0 {
+		value := protoreflect.ValueOfList(&_VoucherNonce_3_list{list: &x.UsedNonces})
+		if !f(fd_VoucherNonce_used_nonces, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_VoucherNonce) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.perm.v1.VoucherNonce.signer":
+		return x.Signer != ""
+	case "verana.perm.v1.VoucherNonce.nonce":
+		return x.Nonce != uint64(0)
+	case "verana.perm.v1.VoucherNonce.used_nonces":
+		return len(x.UsedNonces) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.VoucherNonce"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.VoucherNonce does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VoucherNonce) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.perm.v1.VoucherNonce.signer":
+		x.Signer = ""
+	case "verana.perm.v1.VoucherNonce.nonce":
+		x.Nonce = uint64(0)
+	case "verana.perm.v1.VoucherNonce.used_nonces":
+		x.UsedNonces = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.VoucherNonce"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.VoucherNonce does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_VoucherNonce) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.perm.v1.VoucherNonce.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.VoucherNonce.nonce":
+		value := x.Nonce
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.VoucherNonce.used_nonces":
+		if len(x.UsedNonces) == 0 {
+			return protoreflect.ValueOfList(&_VoucherNonce_3_list{})
+		}
+		listValue := &_VoucherNonce_3_list{list: &x.UsedNonces}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.VoucherNonce"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.VoucherNonce does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VoucherNonce) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.perm.v1.VoucherNonce.signer":
+		x.Signer = value.Interface().(string)
+	case "verana.perm.v1.VoucherNonce.nonce":
+		x.Nonce = value.Uint()
+	case "verana.perm.v1.VoucherNonce.used_nonces":
+		lv := value.List()
+		clv := lv.(*_VoucherNonce_3_list)
+		x.UsedNonces = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.VoucherNonce"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.VoucherNonce does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VoucherNonce) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.VoucherNonce.used_nonces":
+		if x.UsedNonces == nil {
+			x.UsedNonces = []uint64{}
+		}
+		value := &_VoucherNonce_3_list{list: &x.UsedNonces}
+		return protoreflect.ValueOfList(value)
+	case "verana.perm.v1.VoucherNonce.signer":
+		panic(fmt.Errorf("field signer of message verana.perm.v1.VoucherNonce is not mutable"))
+	case "verana.perm.v1.VoucherNonce.nonce":
+		panic(fmt.Errorf("field nonce of message verana.perm.v1.VoucherNonce is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.VoucherNonce"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.VoucherNonce does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_VoucherNonce) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.VoucherNonce.signer":
+		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.VoucherNonce.nonce":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.VoucherNonce.used_nonces":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_VoucherNonce_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.VoucherNonce"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.VoucherNonce does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_VoucherNonce) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.VoucherNonce", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_VoucherNonce) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VoucherNonce) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_VoucherNonce) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_VoucherNonce) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*VoucherNonce)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Nonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.Nonce))
+		}
+		if len(x.UsedNonces) > 0 {
+			l = 0
+			for _, e := range x.UsedNonces {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*VoucherNonce)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.UsedNonces) > 0 {
+			var pksize2 int
+			for _, num := range x.UsedNonces {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.UsedNonces {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Nonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*VoucherNonce)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VoucherNonce: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VoucherNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				}
+				x.Nonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Nonce |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.UsedNonces = append(x.UsedNonces, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.UsedNonces) == 0 {
+						x.UsedNonces = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.UsedNonces = append(x.UsedNonces, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UsedNonces", wireType)
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_VPMessage               protoreflect.MessageDescriptor
+	fd_VPMessage_perm_id       protoreflect.FieldDescriptor
+	fd_VPMessage_index         protoreflect.FieldDescriptor
+	fd_VPMessage_sender        protoreflect.FieldDescriptor
+	fd_VPMessage_digest_sri    protoreflect.FieldDescriptor
+	fd_VPMessage_encrypted_uri protoreflect.FieldDescriptor
+	fd_VPMessage_created       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_types_proto_init()
+	md_VPMessage = File_verana_perm_v1_types_proto.Messages().ByName("VPMessage")
+	fd_VPMessage_perm_id = md_VPMessage.Fields().ByName("perm_id")
+	fd_VPMessage_index = md_VPMessage.Fields().ByName("index")
+	fd_VPMessage_sender = md_VPMessage.Fields().ByName("sender")
+	fd_VPMessage_digest_sri = md_VPMessage.Fields().ByName("digest_sri")
+	fd_VPMessage_encrypted_uri = md_VPMessage.Fields().ByName("encrypted_uri")
+	fd_VPMessage_created = md_VPMessage.Fields().ByName("created")
+}
+
+var _ protoreflect.Message = (*fastReflection_VPMessage)(nil)
+
+type fastReflection_VPMessage VPMessage
+
+func (x *VPMessage) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VPMessage)(x)
+}
+
+func (x *VPMessage) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_types_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_VPMessage_messageType fastReflection_VPMessage_messageType
+var _ protoreflect.MessageType = fastReflection_VPMessage_messageType{}
+
+type fastReflection_VPMessage_messageType struct{}
+
+func (x fastReflection_VPMessage_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VPMessage)(nil)
+}
+func (x fastReflection_VPMessage_messageType) New() protoreflect.Message {
+	return new(fastReflection_VPMessage)
+}
+func (x fastReflection_VPMessage_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VPMessage
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VPMessage) Descriptor() protoreflect.MessageDescriptor {
+	return md_VPMessage
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_VPMessage) Type() protoreflect.MessageType {
+	return _fastReflection_VPMessage_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_VPMessage) New() protoreflect.Message {
+	return new(fastReflection_VPMessage)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_VPMessage) Interface() protoreflect.ProtoMessage {
+	return (*VPMessage)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_VPMessage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PermId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PermId)
+		if !f(fd_VPMessage_perm_id, value) {
+			return
+		}
+	}
+	if x.Index != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Index)
+		if !f(fd_VPMessage_index, value) {
+			return
+		}
+	}
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_VPMessage_sender, value) {
+			return
+		}
+	}
+	if x.DigestSri != "" {
+		value := protoreflect.ValueOfString(x.DigestSri)
+		if !f(fd_VPMessage_digest_sri, value) {
+			return
+		}
+	}
+	if x.EncryptedUri != "" {
+		value := protoreflect.ValueOfString(x.EncryptedUri)
+		if !f(fd_VPMessage_encrypted_uri, value) {
+			return
+		}
+	}
+	if x.Created != nil {
+		value := protoreflect.ValueOfMessage(x.Created.ProtoReflect())
+		if !f(fd_VPMessage_created, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_VPMessage) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.perm.v1.VPMessage.perm_id":
+		return x.PermId != uint64(0)
+	case "verana.perm.v1.VPMessage.index":
+		return x.Index != uint64(0)
+	case "verana.perm.v1.VPMessage.sender":
+		return x.Sender != ""
+	case "verana.perm.v1.VPMessage.digest_sri":
+		return x.DigestSri != ""
+	case "verana.perm.v1.VPMessage.encrypted_uri":
+		return x.EncryptedUri != ""
+	case "verana.perm.v1.VPMessage.created":
+		return x.Created != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.VPMessage"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.VPMessage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VPMessage) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.perm.v1.VPMessage.perm_id":
+		x.PermId = uint64(0)
+	case "verana.perm.v1.VPMessage.index":
+		x.Index = uint64(0)
+	case "verana.perm.v1.VPMessage.sender":
+		x.Sender = ""
+	case "verana.perm.v1.VPMessage.digest_sri":
+		x.DigestSri = ""
+	case "verana.perm.v1.VPMessage.encrypted_uri":
+		x.EncryptedUri = ""
+	case "verana.perm.v1.VPMessage.created":
+		x.Created = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.VPMessage"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.VPMessage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_VPMessage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.perm.v1.VPMessage.perm_id":
+		value := x.PermId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.VPMessage.index":
+		value := x.Index
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.VPMessage.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.VPMessage.digest_sri":
+		value := x.DigestSri
+		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.VPMessage.encrypted_uri":
+		value := x.EncryptedUri
+		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.VPMessage.created":
+		value := x.Created
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.VPMessage"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.VPMessage does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VPMessage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.perm.v1.VPMessage.perm_id":
+		x.PermId = value.Uint()
+	case "verana.perm.v1.VPMessage.index":
+		x.Index = value.Uint()
+	case "verana.perm.v1.VPMessage.sender":
+		x.Sender = value.Interface().(string)
+	case "verana.perm.v1.VPMessage.digest_sri":
+		x.DigestSri = value.Interface().(string)
+	case "verana.perm.v1.VPMessage.encrypted_uri":
+		x.EncryptedUri = value.Interface().(string)
+	case "verana.perm.v1.VPMessage.created":
+		x.Created = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.VPMessage"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.VPMessage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VPMessage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.VPMessage.created":
+		if x.Created == nil {
+			x.Created = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Created.ProtoReflect())
+	case "verana.perm.v1.VPMessage.perm_id":
+		panic(fmt.Errorf("field perm_id of message verana.perm.v1.VPMessage is not mutable"))
+	case "verana.perm.v1.VPMessage.index":
+		panic(fmt.Errorf("field index of message verana.perm.v1.VPMessage is not mutable"))
+	case "verana.perm.v1.VPMessage.sender":
+		panic(fmt.Errorf("field sender of message verana.perm.v1.VPMessage is not mutable"))
+	case "verana.perm.v1.VPMessage.digest_sri":
+		panic(fmt.Errorf("field digest_sri of message verana.perm.v1.VPMessage is not mutable"))
+	case "verana.perm.v1.VPMessage.encrypted_uri":
+		panic(fmt.Errorf("field encrypted_uri of message verana.perm.v1.VPMessage is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.VPMessage"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.VPMessage does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_VPMessage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.VPMessage.perm_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.VPMessage.index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.VPMessage.sender":
+		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.VPMessage.digest_sri":
+		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.VPMessage.encrypted_uri":
+		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.VPMessage.created":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.VPMessage"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.VPMessage does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_VPMessage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.VPMessage", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_VPMessage) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VPMessage) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_VPMessage) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_VPMessage) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*VPMessage)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.PermId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PermId))
+		}
+		if x.Index != 0 {
+			n += 1 + runtime.Sov(uint64(x.Index))
+		}
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DigestSri)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.EncryptedUri)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Created != nil {
+			l = options.Size(x.Created)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*VPMessage)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Created != nil {
+			encoded, err := options.Marshal(x.Created)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.EncryptedUri) > 0 {
+			i -= len(x.EncryptedUri)
+			copy(dAtA[i:], x.EncryptedUri)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EncryptedUri)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.DigestSri) > 0 {
+			i -= len(x.DigestSri)
+			copy(dAtA[i:], x.DigestSri)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DigestSri)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Index != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Index))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.PermId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PermId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*VPMessage)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VPMessage: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VPMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PermId", wireType)
+				}
+				x.PermId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PermId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				x.Index = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Index |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DigestSri", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DigestSri = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EncryptedUri", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EncryptedUri = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Created == nil {
+					x.Created = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Created); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_VPApproval_7_list)(nil)
+
+type _VPApproval_7_list struct {
+	list *[]string
+}
+
+func (x *_VPApproval_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_VPApproval_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_VPApproval_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_VPApproval_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_VPApproval_7_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message VPApproval at list field Countries as it is not of Message kind"))
+}
+
+func (x *_VPApproval_7_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_VPApproval_7_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_VPApproval_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_VPApproval                       protoreflect.MessageDescriptor
+	fd_VPApproval_validator_perm_id     protoreflect.FieldDescriptor
+	fd_VPApproval_approver              protoreflect.FieldDescriptor
+	fd_VPApproval_effective_until       protoreflect.FieldDescriptor
+	fd_VPApproval_validation_fees       protoreflect.FieldDescriptor
+	fd_VPApproval_issuance_fees         protoreflect.FieldDescriptor
+	fd_VPApproval_verification_fees     protoreflect.FieldDescriptor
+	fd_VPApproval_countries             protoreflect.FieldDescriptor
+	fd_VPApproval_vp_summary_digest_sri protoreflect.FieldDescriptor
+	fd_VPApproval_created               protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_types_proto_init()
+	md_VPApproval = File_verana_perm_v1_types_proto.Messages().ByName("VPApproval")
+	fd_VPApproval_validator_perm_id = md_VPApproval.Fields().ByName("validator_perm_id")
+	fd_VPApproval_approver = md_VPApproval.Fields().ByName("approver")
+	fd_VPApproval_effective_until = md_VPApproval.Fields().ByName("effective_until")
+	fd_VPApproval_validation_fees = md_VPApproval.Fields().ByName("validation_fees")
+	fd_VPApproval_issuance_fees = md_VPApproval.Fields().ByName("issuance_fees")
+	fd_VPApproval_verification_fees = md_VPApproval.Fields().ByName("verification_fees")
+	fd_VPApproval_countries = md_VPApproval.Fields().ByName("countries")
+	fd_VPApproval_vp_summary_digest_sri = md_VPApproval.Fields().ByName("vp_summary_digest_sri")
+	fd_VPApproval_created = md_VPApproval.Fields().ByName("created")
+}
+
+var _ protoreflect.Message = (*fastReflection_VPApproval)(nil)
+
+type fastReflection_VPApproval VPApproval
+
+func (x *VPApproval) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VPApproval)(x)
+}
+
+func (x *VPApproval) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_types_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_VPApproval_messageType fastReflection_VPApproval_messageType
+var _ protoreflect.MessageType = fastReflection_VPApproval_messageType{}
+
+type fastReflection_VPApproval_messageType struct{}
+
+func (x fastReflection_VPApproval_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VPApproval)(nil)
+}
+func (x fastReflection_VPApproval_messageType) New() protoreflect.Message {
+	return new(fastReflection_VPApproval)
+}
+func (x fastReflection_VPApproval_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VPApproval
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VPApproval) Descriptor() protoreflect.MessageDescriptor {
+	return md_VPApproval
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_VPApproval) Type() protoreflect.MessageType {
+	return _fastReflection_VPApproval_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_VPApproval) New() protoreflect.Message {
+	return new(fastReflection_VPApproval)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_VPApproval) Interface() protoreflect.ProtoMessage {
+	return (*VPApproval)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_VPApproval) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ValidatorPermId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ValidatorPermId)
+		if !f(fd_VPApproval_validator_perm_id, value) {
+			return
+		}
+	}
+	if x.Approver != "" {
+		value := protoreflect.ValueOfString(x.Approver)
+		if !f(fd_VPApproval_approver, value) {
+			return
+		}
+	}
+	if x.EffectiveUntil != nil {
+		value := protoreflect.ValueOfMessage(x.EffectiveUntil.ProtoReflect())
+		if !f(fd_VPApproval_effective_until, value) {
+			return
+		}
+	}
+	if x.ValidationFees != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ValidationFees)
+		if !f(fd_VPApproval_validation_fees, value) {
+			return
+		}
+	}
+	if x.IssuanceFees != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.IssuanceFees)
+		if !f(fd_VPApproval_issuance_fees, value) {
+			return
+		}
+	}
+	if x.VerificationFees != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.VerificationFees)
+		if !f(fd_VPApproval_verification_fees, value) {
+			return
+		}
+	}
+	if len(x.Countries) != 0 {
+		value := protoreflect.ValueOfList(&_VPApproval_7_list{list: &x.Countries})
+		if !f(fd_VPApproval_countries, value) {
+			return
+		}
+	}
+	if x.VpSummaryDigestSri != "" {
+		value := protoreflect.ValueOfString(x.VpSummaryDigestSri)
+		if !f(fd_VPApproval_vp_summary_digest_sri, value) {
+			return
+		}
+	}
+	if x.Created != nil {
+		value := protoreflect.ValueOfMessage(x.Created.ProtoReflect())
+		if !f(fd_VPApproval_created, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_VPApproval) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.perm.v1.VPApproval.validator_perm_id":
+		return x.ValidatorPermId != uint64(0)
+	case "verana.perm.v1.VPApproval.approver":
+		return x.Approver != ""
+	case "verana.perm.v1.VPApproval.effective_until":
+		return x.EffectiveUntil != nil
+	case "verana.perm.v1.VPApproval.validation_fees":
+		return x.ValidationFees != uint64(0)
+	case "verana.perm.v1.VPApproval.issuance_fees":
+		return x.IssuanceFees != uint64(0)
+	case "verana.perm.v1.VPApproval.verification_fees":
+		return x.VerificationFees != uint64(0)
+	case "verana.perm.v1.VPApproval.countries":
+		return len(x.Countries) != 0
+	case "verana.perm.v1.VPApproval.vp_summary_digest_sri":
+		return x.VpSummaryDigestSri != ""
+	case "verana.perm.v1.VPApproval.created":
+		return x.Created != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.VPApproval"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.VPApproval does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VPApproval) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.perm.v1.VPApproval.validator_perm_id":
+		x.ValidatorPermId = uint64(0)
+	case "verana.perm.v1.VPApproval.approver":
+		x.Approver = ""
+	case "verana.perm.v1.VPApproval.effective_until":
+		x.EffectiveUntil = nil
+	case "verana.perm.v1.VPApproval.validation_fees":
+		x.ValidationFees = uint64(0)
+	case "verana.perm.v1.VPApproval.issuance_fees":
+		x.IssuanceFees = uint64(0)
+	case "verana.perm.v1.VPApproval.verification_fees":
+		x.VerificationFees = uint64(0)
+	case "verana.perm.v1.VPApproval.countries":
+		x.Countries = nil
+	case "verana.perm.v1.VPApproval.vp_summary_digest_sri":
+		x.VpSummaryDigestSri = ""
+	case "verana.perm.v1.VPApproval.created":
+		x.Created = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.VPApproval"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.VPApproval does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_VPApproval) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.perm.v1.VPApproval.validator_perm_id":
+		value := x.ValidatorPermId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.VPApproval.approver":
+		value := x.Approver
+		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.VPApproval.effective_until":
+		value := x.EffectiveUntil
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.perm.v1.VPApproval.validation_fees":
+		value := x.ValidationFees
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.VPApproval.issuance_fees":
+		value := x.IssuanceFees
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.VPApproval.verification_fees":
+		value := x.VerificationFees
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.VPApproval.countries":
+		if len(x.Countries) == 0 {
+			return protoreflect.ValueOfList(&_VPApproval_7_list{})
+		}
+		listValue := &_VPApproval_7_list{list: &x.Countries}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.perm.v1.VPApproval.vp_summary_digest_sri":
+		value := x.VpSummaryDigestSri
+		return protoreflect.ValueOfString(value)
+	case "verana.perm.v1.VPApproval.created":
+		value := x.Created
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.VPApproval"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.VPApproval does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VPApproval) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.perm.v1.VPApproval.validator_perm_id":
+		x.ValidatorPermId = value.Uint()
+	case "verana.perm.v1.VPApproval.approver":
+		x.Approver = value.Interface().(string)
+	case "verana.perm.v1.VPApproval.effective_until":
+		x.EffectiveUntil = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.perm.v1.VPApproval.validation_fees":
+		x.ValidationFees = value.Uint()
+	case "verana.perm.v1.VPApproval.issuance_fees":
+		x.IssuanceFees = value.Uint()
+	case "verana.perm.v1.VPApproval.verification_fees":
+		x.VerificationFees = value.Uint()
+	case "verana.perm.v1.VPApproval.countries":
+		lv := value.List()
+		clv := lv.(*_VPApproval_7_list)
+		x.Countries = *clv.list
+	case "verana.perm.v1.VPApproval.vp_summary_digest_sri":
+		x.VpSummaryDigestSri = value.Interface().(string)
+	case "verana.perm.v1.VPApproval.created":
+		x.Created = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.VPApproval"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.VPApproval does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VPApproval) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.VPApproval.effective_until":
+		if x.EffectiveUntil == nil {
+			x.EffectiveUntil = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.EffectiveUntil.ProtoReflect())
+	case "verana.perm.v1.VPApproval.countries":
+		if x.Countries == nil {
+			x.Countries = []string{}
+		}
+		value := &_VPApproval_7_list{list: &x.Countries}
+		return protoreflect.ValueOfList(value)
+	case "verana.perm.v1.VPApproval.created":
+		if x.Created == nil {
+			x.Created = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Created.ProtoReflect())
+	case "verana.perm.v1.VPApproval.validator_perm_id":
+		panic(fmt.Errorf("field validator_perm_id of message verana.perm.v1.VPApproval is not mutable"))
+	case "verana.perm.v1.VPApproval.approver":
+		panic(fmt.Errorf("field approver of message verana.perm.v1.VPApproval is not mutable"))
+	case "verana.perm.v1.VPApproval.validation_fees":
+		panic(fmt.Errorf("field validation_fees of message verana.perm.v1.VPApproval is not mutable"))
+	case "verana.perm.v1.VPApproval.issuance_fees":
+		panic(fmt.Errorf("field issuance_fees of message verana.perm.v1.VPApproval is not mutable"))
+	case "verana.perm.v1.VPApproval.verification_fees":
+		panic(fmt.Errorf("field verification_fees of message verana.perm.v1.VPApproval is not mutable"))
+	case "verana.perm.v1.VPApproval.vp_summary_digest_sri":
+		panic(fmt.Errorf("field vp_summary_digest_sri of message verana.perm.v1.VPApproval is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.VPApproval"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.VPApproval does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_VPApproval) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.VPApproval.validator_perm_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.VPApproval.approver":
+		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.VPApproval.effective_until":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.perm.v1.VPApproval.validation_fees":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.VPApproval.issuance_fees":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.VPApproval.verification_fees":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.VPApproval.countries":
+		list := []string{}
+		return protoreflect.ValueOfList(&_VPApproval_7_list{list: &list})
+	case "verana.perm.v1.VPApproval.vp_summary_digest_sri":
+		return protoreflect.ValueOfString("")
+	case "verana.perm.v1.VPApproval.created":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.VPApproval"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.VPApproval does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_VPApproval) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.VPApproval", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_VPApproval) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VPApproval) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_VPApproval) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_VPApproval) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*VPApproval)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ValidatorPermId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ValidatorPermId))
+		}
+		l = len(x.Approver)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EffectiveUntil != nil {
+			l = options.Size(x.EffectiveUntil)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ValidationFees != 0 {
+			n += 1 + runtime.Sov(uint64(x.ValidationFees))
+		}
+		if x.IssuanceFees != 0 {
+			n += 1 + runtime.Sov(uint64(x.IssuanceFees))
+		}
+		if x.VerificationFees != 0 {
+			n += 1 + runtime.Sov(uint64(x.VerificationFees))
+		}
+		if len(x.Countries) > 0 {
+			for _, s := range x.Countries {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.VpSummaryDigestSri)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Created != nil {
+			l = options.Size(x.Created)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*VPApproval)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Created != nil {
+			encoded, err := options.Marshal(x.Created)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.VpSummaryDigestSri) > 0 {
+			i -= len(x.VpSummaryDigestSri)
+			copy(dAtA[i:], x.VpSummaryDigestSri)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VpSummaryDigestSri)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.Countries) > 0 {
+			for iNdEx := len(x.Countries) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Countries[iNdEx])
+				copy(dAtA[i:], x.Countries[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Countries[iNdEx])))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if x.VerificationFees != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VerificationFees))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.IssuanceFees != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IssuanceFees))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.ValidationFees != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidationFees))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.EffectiveUntil != nil {
+			encoded, err := options.Marshal(x.EffectiveUntil)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Approver) > 0 {
+			i -= len(x.Approver)
+			copy(dAtA[i:], x.Approver)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Approver)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.ValidatorPermId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidatorPermId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*VPApproval)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VPApproval: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VPApproval: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorPermId", wireType)
+				}
+				x.ValidatorPermId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ValidatorPermId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Approver", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Approver = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EffectiveUntil", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EffectiveUntil == nil {
+					x.EffectiveUntil = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EffectiveUntil); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidationFees", wireType)
+				}
+				x.ValidationFees = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ValidationFees |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IssuanceFees", wireType)
+				}
+				x.IssuanceFees = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IssuanceFees |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerificationFees", wireType)
+				}
+				x.VerificationFees = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.VerificationFees |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Countries", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Countries = append(x.Countries, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VpSummaryDigestSri", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VpSummaryDigestSri = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Created == nil {
+					x.Created = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Created); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_CoValidatorDeposit                   protoreflect.MessageDescriptor
+	fd_CoValidatorDeposit_validator_perm_id protoreflect.FieldDescriptor
+	fd_CoValidatorDeposit_deposit           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_types_proto_init()
+	md_CoValidatorDeposit = File_verana_perm_v1_types_proto.Messages().ByName("CoValidatorDeposit")
+	fd_CoValidatorDeposit_validator_perm_id = md_CoValidatorDeposit.Fields().ByName("validator_perm_id")
+	fd_CoValidatorDeposit_deposit = md_CoValidatorDeposit.Fields().ByName("deposit")
+}
+
+var _ protoreflect.Message = (*fastReflection_CoValidatorDeposit)(nil)
+
+type fastReflection_CoValidatorDeposit CoValidatorDeposit
+
+func (x *CoValidatorDeposit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CoValidatorDeposit)(x)
+}
+
+func (x *CoValidatorDeposit) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_types_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CoValidatorDeposit_messageType fastReflection_CoValidatorDeposit_messageType
+var _ protoreflect.MessageType = fastReflection_CoValidatorDeposit_messageType{}
+
+type fastReflection_CoValidatorDeposit_messageType struct{}
+
+func (x fastReflection_CoValidatorDeposit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CoValidatorDeposit)(nil)
+}
+func (x fastReflection_CoValidatorDeposit_messageType) New() protoreflect.Message {
+	return new(fastReflection_CoValidatorDeposit)
+}
+func (x fastReflection_CoValidatorDeposit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CoValidatorDeposit
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CoValidatorDeposit) Descriptor() protoreflect.MessageDescriptor {
+	return md_CoValidatorDeposit
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CoValidatorDeposit) Type() protoreflect.MessageType {
+	return _fastReflection_CoValidatorDeposit_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CoValidatorDeposit) New() protoreflect.Message {
+	return new(fastReflection_CoValidatorDeposit)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CoValidatorDeposit) Interface() protoreflect.ProtoMessage {
+	return (*CoValidatorDeposit)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CoValidatorDeposit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ValidatorPermId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ValidatorPermId)
+		if !f(fd_CoValidatorDeposit_validator_perm_id, value) {
+			return
+		}
+	}
+	if x.Deposit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Deposit)
+		if !f(fd_CoValidatorDeposit_deposit, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CoValidatorDeposit) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.perm.v1.CoValidatorDeposit.validator_perm_id":
+		return x.ValidatorPermId != uint64(0)
+	case "verana.perm.v1.CoValidatorDeposit.deposit":
+		return x.Deposit != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.CoValidatorDeposit"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.CoValidatorDeposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CoValidatorDeposit) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.perm.v1.CoValidatorDeposit.validator_perm_id":
+		x.ValidatorPermId = uint64(0)
+	case "verana.perm.v1.CoValidatorDeposit.deposit":
+		x.Deposit = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.CoValidatorDeposit"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.CoValidatorDeposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CoValidatorDeposit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.perm.v1.CoValidatorDeposit.validator_perm_id":
+		value := x.ValidatorPermId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.CoValidatorDeposit.deposit":
+		value := x.Deposit
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.CoValidatorDeposit"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.CoValidatorDeposit does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CoValidatorDeposit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.perm.v1.CoValidatorDeposit.validator_perm_id":
+		x.ValidatorPermId = value.Uint()
+	case "verana.perm.v1.CoValidatorDeposit.deposit":
+		x.Deposit = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.CoValidatorDeposit"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.CoValidatorDeposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CoValidatorDeposit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.CoValidatorDeposit.validator_perm_id":
+		panic(fmt.Errorf("field validator_perm_id of message verana.perm.v1.CoValidatorDeposit is not mutable"))
+	case "verana.perm.v1.CoValidatorDeposit.deposit":
+		panic(fmt.Errorf("field deposit of message verana.perm.v1.CoValidatorDeposit is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.CoValidatorDeposit"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.CoValidatorDeposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CoValidatorDeposit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.CoValidatorDeposit.validator_perm_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.CoValidatorDeposit.deposit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.CoValidatorDeposit"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.CoValidatorDeposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CoValidatorDeposit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.CoValidatorDeposit", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CoValidatorDeposit) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CoValidatorDeposit) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CoValidatorDeposit) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CoValidatorDeposit) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CoValidatorDeposit)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ValidatorPermId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ValidatorPermId))
+		}
+		if x.Deposit != 0 {
+			n += 1 + runtime.Sov(uint64(x.Deposit))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CoValidatorDeposit)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Deposit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Deposit))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.ValidatorPermId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidatorPermId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CoValidatorDeposit)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CoValidatorDeposit: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CoValidatorDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorPermId", wireType)
+				}
+				x.ValidatorPermId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ValidatorPermId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				}
+				x.Deposit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Deposit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PermissionFees                   protoreflect.MessageDescriptor
+	fd_PermissionFees_validation_fees   protoreflect.FieldDescriptor
+	fd_PermissionFees_issuance_fees     protoreflect.FieldDescriptor
+	fd_PermissionFees_verification_fees protoreflect.FieldDescriptor
+	fd_PermissionFees_effective_from    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_types_proto_init()
+	md_PermissionFees = File_verana_perm_v1_types_proto.Messages().ByName("PermissionFees")
+	fd_PermissionFees_validation_fees = md_PermissionFees.Fields().ByName("validation_fees")
+	fd_PermissionFees_issuance_fees = md_PermissionFees.Fields().ByName("issuance_fees")
+	fd_PermissionFees_verification_fees = md_PermissionFees.Fields().ByName("verification_fees")
+	fd_PermissionFees_effective_from = md_PermissionFees.Fields().ByName("effective_from")
+}
+
+var _ protoreflect.Message = (*fastReflection_PermissionFees)(nil)
+
+type fastReflection_PermissionFees PermissionFees
+
+func (x *PermissionFees) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PermissionFees)(x)
+}
+
+func (x *PermissionFees) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_types_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PermissionFees_messageType fastReflection_PermissionFees_messageType
+var _ protoreflect.MessageType = fastReflection_PermissionFees_messageType{}
+
+type fastReflection_PermissionFees_messageType struct{}
+
+func (x fastReflection_PermissionFees_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PermissionFees)(nil)
+}
+func (x fastReflection_PermissionFees_messageType) New() protoreflect.Message {
+	return new(fastReflection_PermissionFees)
+}
+func (x fastReflection_PermissionFees_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PermissionFees
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PermissionFees) Descriptor() protoreflect.MessageDescriptor {
+	return md_PermissionFees
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PermissionFees) Type() protoreflect.MessageType {
+	return _fastReflection_PermissionFees_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PermissionFees) New() protoreflect.Message {
+	return new(fastReflection_PermissionFees)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PermissionFees) Interface() protoreflect.ProtoMessage {
+	return (*PermissionFees)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PermissionFees) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ValidationFees != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ValidationFees)
+		if !f(fd_PermissionFees_validation_fees, value) {
+			return
+		}
+	}
+	if x.IssuanceFees != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.IssuanceFees)
+		if !f(fd_PermissionFees_issuance_fees, value) {
+			return
+		}
+	}
+	if x.VerificationFees != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.VerificationFees)
+		if !f(fd_PermissionFees_verification_fees, value) {
+			return
+		}
+	}
+	if x.EffectiveFrom != nil {
+		value := protoreflect.ValueOfMessage(x.EffectiveFrom.ProtoReflect())
+		if !f(fd_PermissionFees_effective_from, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PermissionFees) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.perm.v1.PermissionFees.validation_fees":
+		return x.ValidationFees != uint64(0)
+	case "verana.perm.v1.PermissionFees.issuance_fees":
+		return x.IssuanceFees != uint64(0)
+	case "verana.perm.v1.PermissionFees.verification_fees":
+		return x.VerificationFees != uint64(0)
+	case "verana.perm.v1.PermissionFees.effective_from":
+		return x.EffectiveFrom != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.PermissionFees"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.PermissionFees does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PermissionFees) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.perm.v1.PermissionFees.validation_fees":
+		x.ValidationFees = uint64(0)
+	case "verana.perm.v1.PermissionFees.issuance_fees":
+		x.IssuanceFees = uint64(0)
+	case "verana.perm.v1.PermissionFees.verification_fees":
+		x.VerificationFees = uint64(0)
+	case "verana.perm.v1.PermissionFees.effective_from":
+		x.EffectiveFrom = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.PermissionFees"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.PermissionFees does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PermissionFees) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.perm.v1.PermissionFees.validation_fees":
+		value := x.ValidationFees
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.PermissionFees.issuance_fees":
+		value := x.IssuanceFees
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.PermissionFees.verification_fees":
+		value := x.VerificationFees
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.PermissionFees.effective_from":
+		value := x.EffectiveFrom
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.PermissionFees"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.PermissionFees does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PermissionFees) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.perm.v1.PermissionFees.validation_fees":
+		x.ValidationFees = value.Uint()
+	case "verana.perm.v1.PermissionFees.issuance_fees":
+		x.IssuanceFees = value.Uint()
+	case "verana.perm.v1.PermissionFees.verification_fees":
+		x.VerificationFees = value.Uint()
+	case "verana.perm.v1.PermissionFees.effective_from":
+		x.EffectiveFrom = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.PermissionFees"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.PermissionFees does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PermissionFees) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.PermissionFees.effective_from":
+		if x.EffectiveFrom == nil {
+			x.EffectiveFrom = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.EffectiveFrom.ProtoReflect())
+	case "verana.perm.v1.PermissionFees.validation_fees":
+		panic(fmt.Errorf("field validation_fees of message verana.perm.v1.PermissionFees is not mutable"))
+	case "verana.perm.v1.PermissionFees.issuance_fees":
+		panic(fmt.Errorf("field issuance_fees of message verana.perm.v1.PermissionFees is not mutable"))
+	case "verana.perm.v1.PermissionFees.verification_fees":
+		panic(fmt.Errorf("field verification_fees of message verana.perm.v1.PermissionFees is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.PermissionFees"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.PermissionFees does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PermissionFees) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.PermissionFees.validation_fees":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.PermissionFees.issuance_fees":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.PermissionFees.verification_fees":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.PermissionFees.effective_from":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.PermissionFees"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.PermissionFees does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PermissionFees) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.PermissionFees", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PermissionFees) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PermissionFees) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PermissionFees) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PermissionFees) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PermissionFees)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ValidationFees != 0 {
+			n += 1 + runtime.Sov(uint64(x.ValidationFees))
+		}
+		if x.IssuanceFees != 0 {
+			n += 1 + runtime.Sov(uint64(x.IssuanceFees))
+		}
+		if x.VerificationFees != 0 {
+			n += 1 + runtime.Sov(uint64(x.VerificationFees))
+		}
+		if x.EffectiveFrom != nil {
+			l = options.Size(x.EffectiveFrom)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PermissionFees)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.EffectiveFrom != nil {
+			encoded, err := options.Marshal(x.EffectiveFrom)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.VerificationFees != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VerificationFees))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.IssuanceFees != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IssuanceFees))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.ValidationFees != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ValidationFees))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PermissionFees)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PermissionFees: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PermissionFees: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidationFees", wireType)
+				}
+				x.ValidationFees = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ValidationFees |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IssuanceFees", wireType)
+				}
+				x.IssuanceFees = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IssuanceFees |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerificationFees", wireType)
+				}
+				x.VerificationFees = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.VerificationFees |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EffectiveFrom", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EffectiveFrom == nil {
+					x.EffectiveFrom = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EffectiveFrom); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PermissionFeeRecord            protoreflect.MessageDescriptor
+	fd_PermissionFeeRecord_perm_id    protoreflect.FieldDescriptor
+	fd_PermissionFeeRecord_index      protoreflect.FieldDescriptor
+	fd_PermissionFeeRecord_fees       protoreflect.FieldDescriptor
+	fd_PermissionFeeRecord_updated_by protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_verana_perm_v1_types_proto_init()
+	md_PermissionFeeRecord = File_verana_perm_v1_types_proto.Messages().ByName("PermissionFeeRecord")
+	fd_PermissionFeeRecord_perm_id = md_PermissionFeeRecord.Fields().ByName("perm_id")
+	fd_PermissionFeeRecord_index = md_PermissionFeeRecord.Fields().ByName("index")
+	fd_PermissionFeeRecord_fees = md_PermissionFeeRecord.Fields().ByName("fees")
+	fd_PermissionFeeRecord_updated_by = md_PermissionFeeRecord.Fields().ByName("updated_by")
+}
+
+var _ protoreflect.Message = (*fastReflection_PermissionFeeRecord)(nil)
+
+type fastReflection_PermissionFeeRecord PermissionFeeRecord
+
+func (x *PermissionFeeRecord) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PermissionFeeRecord)(x)
+}
+
+func (x *PermissionFeeRecord) slowProtoReflect() protoreflect.Message {
+	mi := &file_verana_perm_v1_types_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PermissionFeeRecord_messageType fastReflection_PermissionFeeRecord_messageType
+var _ protoreflect.MessageType = fastReflection_PermissionFeeRecord_messageType{}
+
+type fastReflection_PermissionFeeRecord_messageType struct{}
+
+func (x fastReflection_PermissionFeeRecord_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PermissionFeeRecord)(nil)
+}
+func (x fastReflection_PermissionFeeRecord_messageType) New() protoreflect.Message {
+	return new(fastReflection_PermissionFeeRecord)
+}
+func (x fastReflection_PermissionFeeRecord_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PermissionFeeRecord
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PermissionFeeRecord) Descriptor() protoreflect.MessageDescriptor {
+	return md_PermissionFeeRecord
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PermissionFeeRecord) Type() protoreflect.MessageType {
+	return _fastReflection_PermissionFeeRecord_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PermissionFeeRecord) New() protoreflect.Message {
+	return new(fastReflection_PermissionFeeRecord)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PermissionFeeRecord) Interface() protoreflect.ProtoMessage {
+	return (*PermissionFeeRecord)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PermissionFeeRecord) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PermId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PermId)
+		if !f(fd_PermissionFeeRecord_perm_id, value) {
+			return
+		}
+	}
+	if x.Index != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Index)
+		if !f(fd_PermissionFeeRecord_index, value) {
+			return
+		}
+	}
+	if x.Fees != nil {
+		value := protoreflect.ValueOfMessage(x.Fees.ProtoReflect())
+		if !f(fd_PermissionFeeRecord_fees, value) {
+			return
+		}
+	}
+	if x.UpdatedBy != "" {
+		value := protoreflect.ValueOfString(x.UpdatedBy)
+		if !f(fd_PermissionFeeRecord_updated_by, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PermissionFeeRecord) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "verana.perm.v1.PermissionFeeRecord.perm_id":
+		return x.PermId != uint64(0)
+	case "verana.perm.v1.PermissionFeeRecord.index":
+		return x.Index != uint64(0)
+	case "verana.perm.v1.PermissionFeeRecord.fees":
+		return x.Fees != nil
+	case "verana.perm.v1.PermissionFeeRecord.updated_by":
+		return x.UpdatedBy != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.PermissionFeeRecord"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.PermissionFeeRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PermissionFeeRecord) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "verana.perm.v1.PermissionFeeRecord.perm_id":
+		x.PermId = uint64(0)
+	case "verana.perm.v1.PermissionFeeRecord.index":
+		x.Index = uint64(0)
+	case "verana.perm.v1.PermissionFeeRecord.fees":
+		x.Fees = nil
+	case "verana.perm.v1.PermissionFeeRecord.updated_by":
+		x.UpdatedBy = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.PermissionFeeRecord"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.PermissionFeeRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PermissionFeeRecord) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "verana.perm.v1.PermissionFeeRecord.perm_id":
+		value := x.PermId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.PermissionFeeRecord.index":
+		value := x.Index
+		return protoreflect.ValueOfUint64(value)
+	case "verana.perm.v1.PermissionFeeRecord.fees":
+		value := x.Fees
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.perm.v1.PermissionFeeRecord.updated_by":
+		value := x.UpdatedBy
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.PermissionFeeRecord"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.PermissionFeeRecord does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PermissionFeeRecord) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "verana.perm.v1.PermissionFeeRecord.perm_id":
+		x.PermId = value.Uint()
+	case "verana.perm.v1.PermissionFeeRecord.index":
+		x.Index = value.Uint()
+	case "verana.perm.v1.PermissionFeeRecord.fees":
+		x.Fees = value.Message().Interface().(*PermissionFees)
+	case "verana.perm.v1.PermissionFeeRecord.updated_by":
+		x.UpdatedBy = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.PermissionFeeRecord"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.PermissionFeeRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PermissionFeeRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.PermissionFeeRecord.fees":
+		if x.Fees == nil {
+			x.Fees = new(PermissionFees)
+		}
+		return protoreflect.ValueOfMessage(x.Fees.ProtoReflect())
+	case "verana.perm.v1.PermissionFeeRecord.perm_id":
+		panic(fmt.Errorf("field perm_id of message verana.perm.v1.PermissionFeeRecord is not mutable"))
+	case "verana.perm.v1.PermissionFeeRecord.index":
+		panic(fmt.Errorf("field index of message verana.perm.v1.PermissionFeeRecord is not mutable"))
+	case "verana.perm.v1.PermissionFeeRecord.updated_by":
+		panic(fmt.Errorf("field updated_by of message verana.perm.v1.PermissionFeeRecord is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.PermissionFeeRecord"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.PermissionFeeRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PermissionFeeRecord) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "verana.perm.v1.PermissionFeeRecord.perm_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.PermissionFeeRecord.index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.perm.v1.PermissionFeeRecord.fees":
+		m := new(PermissionFees)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.perm.v1.PermissionFeeRecord.updated_by":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.PermissionFeeRecord"))
+		}
+		panic(fmt.Errorf("message verana.perm.v1.PermissionFeeRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PermissionFeeRecord) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.PermissionFeeRecord", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PermissionFeeRecord) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PermissionFeeRecord) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PermissionFeeRecord) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PermissionFeeRecord) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PermissionFeeRecord)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.PermId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PermId))
+		}
+		if x.Index != 0 {
+			n += 1 + runtime.Sov(uint64(x.Index))
+		}
+		if x.Fees != nil {
+			l = options.Size(x.Fees)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.UpdatedBy)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PermissionFeeRecord)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.UpdatedBy) > 0 {
+			i -= len(x.UpdatedBy)
+			copy(dAtA[i:], x.UpdatedBy)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UpdatedBy)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Fees != nil {
+			encoded, err := options.Marshal(x.Fees)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Index != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Index))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.PermId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PermId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PermissionFeeRecord)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PermissionFeeRecord: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PermissionFeeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PermId", wireType)
+				}
+				x.PermId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PermId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				x.Index = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Index |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Fees == nil {
+					x.Fees = &PermissionFees{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Fees); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UpdatedBy = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: verana/perm/v1/types.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Permission types
+type PermissionType int32
+
+const (
+	PermissionType_PERMISSION_TYPE_UNSPECIFIED      PermissionType = 0
+	PermissionType_PERMISSION_TYPE_ISSUER           PermissionType = 1
+	PermissionType_PERMISSION_TYPE_VERIFIER         PermissionType = 2
+	PermissionType_PERMISSION_TYPE_ISSUER_GRANTOR   PermissionType = 3
+	PermissionType_PERMISSION_TYPE_VERIFIER_GRANTOR PermissionType = 4
+	PermissionType_PERMISSION_TYPE_ECOSYSTEM        PermissionType = 5 // Changed from PERMISSION_TYPE_TRUST_REGISTRY
+	PermissionType_PERMISSION_TYPE_HOLDER           PermissionType = 6
+)
+
+// Enum value maps for PermissionType.
+var (
+	PermissionType_name = map[int32]string{
+		0: "PERMISSION_TYPE_UNSPECIFIED",
+		1: "PERMISSION_TYPE_ISSUER",
+		2: "PERMISSION_TYPE_VERIFIER",
+		3: "PERMISSION_TYPE_ISSUER_GRANTOR",
+		4: "PERMISSION_TYPE_VERIFIER_GRANTOR",
+		5: "PERMISSION_TYPE_ECOSYSTEM",
+		6: "PERMISSION_TYPE_HOLDER",
+	}
+	PermissionType_value = map[string]int32{
+		"PERMISSION_TYPE_UNSPECIFIED":      0,
+		"PERMISSION_TYPE_ISSUER":           1,
+		"PERMISSION_TYPE_VERIFIER":         2,
+		"PERMISSION_TYPE_ISSUER_GRANTOR":   3,
+		"PERMISSION_TYPE_VERIFIER_GRANTOR": 4,
+		"PERMISSION_TYPE_ECOSYSTEM":        5,
+		"PERMISSION_TYPE_HOLDER":           6,
+	}
+)
+
+func (x PermissionType) Enum() *PermissionType {
+	p := new(PermissionType)
+	*p = x
+	return p
+}
+
+func (x PermissionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PermissionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_verana_perm_v1_types_proto_enumTypes[0].Descriptor()
+}
+
+func (PermissionType) Type() protoreflect.EnumType {
+	return &file_verana_perm_v1_types_proto_enumTypes[0]
+}
+
+func (x PermissionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PermissionType.Descriptor instead.
+func (PermissionType) EnumDescriptor() ([]byte, []int) {
+	return file_verana_perm_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
+// Validation process states
+type ValidationState int32
+
+const (
+	ValidationState_VALIDATION_STATE_UNSPECIFIED           ValidationState = 0
+	ValidationState_VALIDATION_STATE_PENDING               ValidationState = 1
+	ValidationState_VALIDATION_STATE_VALIDATED             ValidationState = 2
+	ValidationState_VALIDATION_STATE_TERMINATED            ValidationState = 3
+	ValidationState_VALIDATION_STATE_TERMINATION_REQUESTED ValidationState = 4
+)
+
+// Enum value maps for ValidationState.
+var (
+	ValidationState_name = map[int32]string{
+		0: "VALIDATION_STATE_UNSPECIFIED",
+		1: "VALIDATION_STATE_PENDING",
+		2: "VALIDATION_STATE_VALIDATED",
+		3: "VALIDATION_STATE_TERMINATED",
+		4: "VALIDATION_STATE_TERMINATION_REQUESTED",
+	}
+	ValidationState_value = map[string]int32{
+		"VALIDATION_STATE_UNSPECIFIED":           0,
+		"VALIDATION_STATE_PENDING":               1,
+		"VALIDATION_STATE_VALIDATED":             2,
+		"VALIDATION_STATE_TERMINATED":            3,
+		"VALIDATION_STATE_TERMINATION_REQUESTED": 4,
+	}
+)
+
+func (x ValidationState) Enum() *ValidationState {
+	p := new(ValidationState)
+	*p = x
+	return p
+}
+
+func (x ValidationState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ValidationState) Descriptor() protoreflect.EnumDescriptor {
+	return file_verana_perm_v1_types_proto_enumTypes[1].Descriptor()
+}
+
+func (ValidationState) Type() protoreflect.EnumType {
+	return &file_verana_perm_v1_types_proto_enumTypes[1]
+}
+
+func (x ValidationState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ValidationState.Descriptor instead.
+func (ValidationState) EnumDescriptor() ([]byte, []int) {
+	return file_verana_perm_v1_types_proto_rawDescGZIP(), []int{1}
+}
+
+// Slash record states
+type SlashStatus int32
+
+const (
+	SlashStatus_SLASH_STATUS_UNSPECIFIED SlashStatus = 0
+	// the slashed amount is escrowed and the grantee can still appeal
+	SlashStatus_SLASH_STATUS_PENDING SlashStatus = 1
+	// the grantee appealed, the slash awaits resolution
+	SlashStatus_SLASH_STATUS_APPEALED SlashStatus = 2
+	// the slash was executed and the escrowed amount burned
+	SlashStatus_SLASH_STATUS_EXECUTED SlashStatus = 3
+	// the slash was reversed and the escrowed amount returned to the deposit
+	SlashStatus_SLASH_STATUS_REVERSED SlashStatus = 4
+)
+
+// Enum value maps for SlashStatus.
+var (
+	SlashStatus_name = map[int32]string{
+		0: "SLASH_STATUS_UNSPECIFIED",
+		1: "SLASH_STATUS_PENDING",
+		2: "SLASH_STATUS_APPEALED",
+		3: "SLASH_STATUS_EXECUTED",
+		4: "SLASH_STATUS_REVERSED",
+	}
+	SlashStatus_value = map[string]int32{
+		"SLASH_STATUS_UNSPECIFIED": 0,
+		"SLASH_STATUS_PENDING":     1,
+		"SLASH_STATUS_APPEALED":    2,
+		"SLASH_STATUS_EXECUTED":    3,
+		"SLASH_STATUS_REVERSED":    4,
+	}
+)
+
+func (x SlashStatus) Enum() *SlashStatus {
+	p := new(SlashStatus)
+	*p = x
+	return p
+}
+
+func (x SlashStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SlashStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_verana_perm_v1_types_proto_enumTypes[2].Descriptor()
+}
+
+func (SlashStatus) Type() protoreflect.EnumType {
+	return &file_verana_perm_v1_types_proto_enumTypes[2]
+}
+
+func (x SlashStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SlashStatus.Descriptor instead.
+func (SlashStatus) EnumDescriptor() ([]byte, []int) {
+	return file_verana_perm_v1_types_proto_rawDescGZIP(), []int{2}
+}
+
+// What a revocation does to the perms validated, directly or not, by the
+// revoked perm
+type CascadeMode int32
+
+const (
+	// only the revoked perm is affected
+	CascadeMode_CASCADE_MODE_NONE CascadeMode = 0
+	// every descendant perm is revoked
+	CascadeMode_CASCADE_MODE_REVOKE CascadeMode = 1
+	// every descendant perm is suspended
+	CascadeMode_CASCADE_MODE_SUSPEND CascadeMode = 2
+)
+
+// Enum value maps for CascadeMode.
+var (
+	CascadeMode_name = map[int32]string{
+		0: "CASCADE_MODE_NONE",
+		1: "CASCADE_MODE_REVOKE",
+		2: "CASCADE_MODE_SUSPEND",
+	}
+	CascadeMode_value = map[string]int32{
+		"CASCADE_MODE_NONE":    0,
+		"CASCADE_MODE_REVOKE":  1,
+		"CASCADE_MODE_SUSPEND": 2,
+	}
+)
+
+func (x CascadeMode) Enum() *CascadeMode {
+	p := new(CascadeMode)
+	*p = x
+	return p
+}
+
+func (x CascadeMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CascadeMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_verana_perm_v1_types_proto_enumTypes[3].Descriptor()
+}
+
+func (CascadeMode) Type() protoreflect.EnumType {
+	return &file_verana_perm_v1_types_proto_enumTypes[3]
+}
+
+func (x CascadeMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CascadeMode.Descriptor instead.
+func (CascadeMode) EnumDescriptor() ([]byte, []int) {
+	return file_verana_perm_v1_types_proto_rawDescGZIP(), []int{3}
+}
+
+type Permission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SchemaId   uint64                 `protobuf:"varint,2,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
+	Type_      PermissionType         `protobuf:"varint,3,opt,name=type,proto3,enum=verana.perm.v1.PermissionType" json:"type,omitempty"`
+	Did        string                 `protobuf:"bytes,4,opt,name=did,proto3" json:"did,omitempty"`
+	Grantee    string                 `protobuf:"bytes,5,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	Created    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created,proto3" json:"created,omitempty"`
+	CreatedBy  string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	Extended   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=extended,proto3" json:"extended,omitempty"`
+	ExtendedBy string                 `protobuf:"bytes,9,opt,name=extended_by,json=extendedBy,proto3" json:"extended_by,omitempty"`
+	// NEW: Slashing related fields
+	Slashed   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=slashed,proto3" json:"slashed,omitempty"`
+	SlashedBy string                 `protobuf:"bytes,11,opt,name=slashed_by,json=slashedBy,proto3" json:"slashed_by,omitempty"`
+	Repaid    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=repaid,proto3" json:"repaid,omitempty"`
+	RepaidBy  string                 `protobuf:"bytes,13,opt,name=repaid_by,json=repaidBy,proto3" json:"repaid_by,omitempty"`
+	// END NEW
+	EffectiveFrom    *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	EffectiveUntil   *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=effective_until,json=effectiveUntil,proto3" json:"effective_until,omitempty"`
+	Modified         *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=modified,proto3" json:"modified,omitempty"`
+	ValidationFees   uint64                 `protobuf:"varint,17,opt,name=validation_fees,json=validationFees,proto3" json:"validation_fees,omitempty"`
+	IssuanceFees     uint64                 `protobuf:"varint,18,opt,name=issuance_fees,json=issuanceFees,proto3" json:"issuance_fees,omitempty"`
+	VerificationFees uint64                 `protobuf:"varint,19,opt,name=verification_fees,json=verificationFees,proto3" json:"verification_fees,omitempty"`
+	Deposit          uint64                 `protobuf:"varint,20,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	// NEW: Slashing deposit fields
+	SlashedDeposit uint64 `protobuf:"varint,21,opt,name=slashed_deposit,json=slashedDeposit,proto3" json:"slashed_deposit,omitempty"`
+	RepaidDeposit  uint64 `protobuf:"varint,22,opt,name=repaid_deposit,json=repaidDeposit,proto3" json:"repaid_deposit,omitempty"`
+	// END NEW
+	Revoked      *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	RevokedBy    string                 `protobuf:"bytes,24,opt,name=revoked_by,json=revokedBy,proto3" json:"revoked_by,omitempty"`
+	Terminated   *timestamppb.Timestamp `protobuf:"bytes,25,opt,name=terminated,proto3" json:"terminated,omitempty"`
+	TerminatedBy string                 `protobuf:"bytes,26,opt,name=terminated_by,json=terminatedBy,proto3" json:"terminated_by,omitempty"`
+	// countries is the ISO 3166 scope of the perm: ISO 3166-1 alpha-2 country
+	// codes or ISO 3166-2 subdivision codes. An empty scope is worldwide.
+	Countries          []string               `protobuf:"bytes,27,rep,name=countries,proto3" json:"countries,omitempty"`
+	ValidatorPermId    uint64                 `protobuf:"varint,28,opt,name=validator_perm_id,json=validatorPermId,proto3" json:"validator_perm_id,omitempty"`
+	VpState            ValidationState        `protobuf:"varint,29,opt,name=vp_state,json=vpState,proto3,enum=verana.perm.v1.ValidationState" json:"vp_state,omitempty"`
+	VpExp              *timestamppb.Timestamp `protobuf:"bytes,30,opt,name=vp_exp,json=vpExp,proto3" json:"vp_exp,omitempty"`
+	VpLastStateChange  *timestamppb.Timestamp `protobuf:"bytes,31,opt,name=vp_last_state_change,json=vpLastStateChange,proto3" json:"vp_last_state_change,omitempty"`
+	VpValidatorDeposit uint64                 `protobuf:"varint,32,opt,name=vp_validator_deposit,json=vpValidatorDeposit,proto3" json:"vp_validator_deposit,omitempty"`
+	VpCurrentFees      uint64                 `protobuf:"varint,33,opt,name=vp_current_fees,json=vpCurrentFees,proto3" json:"vp_current_fees,omitempty"`
+	VpCurrentDeposit   uint64                 `protobuf:"varint,34,opt,name=vp_current_deposit,json=vpCurrentDeposit,proto3" json:"vp_current_deposit,omitempty"`
+	VpSummaryDigestSri string                 `protobuf:"bytes,35,opt,name=vp_summary_digest_sri,json=vpSummaryDigestSri,proto3" json:"vp_summary_digest_sri,omitempty"`
+	VpTermRequested    *timestamppb.Timestamp `protobuf:"bytes,36,opt,name=vp_term_requested,json=vpTermRequested,proto3" json:"vp_term_requested,omitempty"`
+	Suspended          *timestamppb.Timestamp `protobuf:"bytes,37,opt,name=suspended,proto3" json:"suspended,omitempty"`
+	SuspendedBy        string                 `protobuf:"bytes,38,opt,name=suspended_by,json=suspendedBy,proto3" json:"suspended_by,omitempty"`
+	SuspensionReason   string                 `protobuf:"bytes,39,opt,name=suspension_reason,json=suspensionReason,proto3" json:"suspension_reason,omitempty"`
+	// suspended_until, if set, is the time the suspension ends by itself
+	SuspendedUntil *timestamppb.Timestamp `protobuf:"bytes,40,opt,name=suspended_until,json=suspendedUntil,proto3" json:"suspended_until,omitempty"`
+	// co_validator_perm_ids are the validator perms that, with validator_perm_id,
+	// validate the perm when the credential schema sets a validation quorum
+	CoValidatorPermIds []uint64 `protobuf:"varint,41,rep,packed,name=co_validator_perm_ids,json=coValidatorPermIds,proto3" json:"co_validator_perm_ids,omitempty"`
+	// vp_quorum is the number of validator approvals the validation process
+	// requires, 0 or 1 for a single validator
+	VpQuorum uint32 `protobuf:"varint,42,opt,name=vp_quorum,json=vpQuorum,proto3" json:"vp_quorum,omitempty"`
+	// vp_approvals are the approvals recorded for the pending validation
+	VpApprovals []*VPApproval `protobuf:"bytes,43,rep,name=vp_approvals,json=vpApprovals,proto3" json:"vp_approvals,omitempty"`
+	// vp_co_validator_deposits are the trust deposits of the co-validators, the
+	// share of validator_perm_id is vp_validator_deposit
+	VpCoValidatorDeposits []*CoValidatorDeposit `protobuf:"bytes,44,rep,name=vp_co_validator_deposits,json=vpCoValidatorDeposits,proto3" json:"vp_co_validator_deposits,omitempty"`
+	// scheduled_fees are the fees set by the grantee, which replace the current
+	// fees at the end of the notice period
+	ScheduledFees *PermissionFees `protobuf:"bytes,45,opt,name=scheduled_fees,json=scheduledFees,proto3" json:"scheduled_fees,omitempty"`
+}
+
+func (x *Permission) Reset() {
+	*x = Permission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_types_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Permission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Permission) ProtoMessage() {}
+
+// Deprecated: Use Permission.ProtoReflect.Descriptor instead.
+func (*Permission) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Permission) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Permission) GetSchemaId() uint64 {
+	if x != nil {
+		return x.SchemaId
+	}
+	return 0
+}
+
+func (x *Permission) GetType_() PermissionType {
+	if x != nil {
+		return x.Type_
+	}
+	return PermissionType_PERMISSION_TYPE_UNSPECIFIED
+}
+
+func (x *Permission) GetDid() string {
+	if x != nil {
+		return x.Did
+	}
+	return ""
+}
+
+func (x *Permission) GetGrantee() string {
+	if x != nil {
+		return x.Grantee
+	}
+	return ""
+}
+
+func (x *Permission) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *Permission) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Permission) GetExtended() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Extended
+	}
+	return nil
+}
+
+func (x *Permission) GetExtendedBy() string {
+	if x != nil {
+		return x.ExtendedBy
+	}
+	return ""
+}
+
+func (x *Permission) GetSlashed() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Slashed
+	}
+	return nil
+}
+
+func (x *Permission) GetSlashedBy() string {
+	if x != nil {
+		return x.SlashedBy
+	}
+	return ""
+}
+
+func (x *Permission) GetRepaid() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Repaid
+	}
+	return nil
+}
+
+func (x *Permission) GetRepaidBy() string {
+	if x != nil {
+		return x.RepaidBy
+	}
+	return ""
+}
+
+func (x *Permission) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+func (x *Permission) GetEffectiveUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveUntil
+	}
+	return nil
+}
+
+func (x *Permission) GetModified() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Modified
+	}
+	return nil
+}
+
+func (x *Permission) GetValidationFees() uint64 {
+	if x != nil {
+		return x.ValidationFees
+	}
+	return 0
+}
+
+func (x *Permission) GetIssuanceFees() uint64 {
+	if x != nil {
+		return x.IssuanceFees
+	}
+	return 0
+}
+
+func (x *Permission) GetVerificationFees() uint64 {
+	if x != nil {
+		return x.VerificationFees
+	}
+	return 0
+}
+
+func (x *Permission) GetDeposit() uint64 {
+	if x != nil {
+		return x.Deposit
+	}
+	return 0
+}
+
+func (x *Permission) GetSlashedDeposit() uint64 {
+	if x != nil {
+		return x.SlashedDeposit
+	}
+	return 0
+}
+
+func (x *Permission) GetRepaidDeposit() uint64 {
+	if x != nil {
+		return x.RepaidDeposit
+	}
+	return 0
+}
+
+func (x *Permission) GetRevoked() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Revoked
+	}
+	return nil
+}
+
+func (x *Permission) GetRevokedBy() string {
+	if x != nil {
+		return x.RevokedBy
+	}
+	return ""
+}
+
+func (x *Permission) GetTerminated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Terminated
+	}
+	return nil
+}
+
+func (x *Permission) GetTerminatedBy() string {
+	if x != nil {
+		return x.TerminatedBy
+	}
+	return ""
+}
+
+func (x *Permission) GetCountries() []string {
+	if x != nil {
+		return x.Countries
+	}
+	return nil
+}
+
+func (x *Permission) GetValidatorPermId() uint64 {
+	if x != nil {
+		return x.ValidatorPermId
+	}
+	return 0
+}
+
+func (x *Permission) GetVpState() ValidationState {
+	if x != nil {
+		return x.VpState
+	}
+	return ValidationState_VALIDATION_STATE_UNSPECIFIED
+}
+
+func (x *Permission) GetVpExp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VpExp
+	}
+	return nil
+}
+
+func (x *Permission) GetVpLastStateChange() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VpLastStateChange
+	}
+	return nil
+}
+
+func (x *Permission) GetVpValidatorDeposit() uint64 {
+	if x != nil {
+		return x.VpValidatorDeposit
+	}
+	return 0
+}
+
+func (x *Permission) GetVpCurrentFees() uint64 {
+	if x != nil {
+		return x.VpCurrentFees
+	}
+	return 0
+}
+
+func (x *Permission) GetVpCurrentDeposit() uint64 {
+	if x != nil {
+		return x.VpCurrentDeposit
+	}
+	return 0
+}
+
+func (x *Permission) GetVpSummaryDigestSri() string {
+	if x != nil {
+		return x.VpSummaryDigestSri
+	}
+	return ""
+}
+
+func (x *Permission) GetVpTermRequested() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VpTermRequested
+	}
+	return nil
+}
+
+func (x *Permission) GetSuspended() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Suspended
+	}
+	return nil
+}
+
+func (x *Permission) GetSuspendedBy() string {
+	if x != nil {
+		return x.SuspendedBy
+	}
+	return ""
+}
+
+func (x *Permission) GetSuspensionReason() string {
+	if x != nil {
+		return x.SuspensionReason
+	}
+	return ""
+}
+
+func (x *Permission) GetSuspendedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SuspendedUntil
+	}
+	return nil
+}
+
+func (x *Permission) GetCoValidatorPermIds() []uint64 {
+	if x != nil {
+		return x.CoValidatorPermIds
+	}
+	return nil
+}
+
+func (x *Permission) GetVpQuorum() uint32 {
+	if x != nil {
+		return x.VpQuorum
+	}
+	return 0
+}
+
+func (x *Permission) GetVpApprovals() []*VPApproval {
+	if x != nil {
+		return x.VpApprovals
+	}
+	return nil
+}
+
+func (x *Permission) GetVpCoValidatorDeposits() []*CoValidatorDeposit {
+	if x != nil {
+		return x.VpCoValidatorDeposits
+	}
+	return nil
+}
+
+func (x *Permission) GetScheduledFees() *PermissionFees {
+	if x != nil {
+		return x.ScheduledFees
+	}
+	return nil
+}
+
+type PermissionSession struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Controller  string                 `protobuf:"bytes,2,opt,name=controller,proto3" json:"controller,omitempty"`
+	AgentPermId uint64                 `protobuf:"varint,3,opt,name=agent_perm_id,json=agentPermId,proto3" json:"agent_perm_id,omitempty"`
+	Authz       []*SessionAuthz        `protobuf:"bytes,4,rep,name=authz,proto3" json:"authz,omitempty"`
+	Created     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created,proto3" json:"created,omitempty"`
+	Modified    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=modified,proto3" json:"modified,omitempty"`
+	// expires is set when the session is created, the session can no longer be
+	// updated after it and is pruned
+	Expires *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires,proto3" json:"expires,omitempty"`
+}
+
+func (x *PermissionSession) Reset() {
+	*x = PermissionSession{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_types_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PermissionSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermissionSession) ProtoMessage() {}
+
+// Deprecated: Use PermissionSession.ProtoReflect.Descriptor instead.
+func (*PermissionSession) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PermissionSession) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PermissionSession) GetController() string {
+	if x != nil {
+		return x.Controller
+	}
+	return ""
+}
+
+func (x *PermissionSession) GetAgentPermId() uint64 {
+	if x != nil {
+		return x.AgentPermId
+	}
+	return 0
+}
+
+func (x *PermissionSession) GetAuthz() []*SessionAuthz {
+	if x != nil {
+		return x.Authz
+	}
+	return nil
+}
+
+func (x *PermissionSession) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *PermissionSession) GetModified() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Modified
+	}
+	return nil
+}
+
+func (x *PermissionSession) GetExpires() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expires
+	}
+	return nil
+}
+
+type SessionAuthz struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExecutorPermId    uint64 `protobuf:"varint,1,opt,name=executor_perm_id,json=executorPermId,proto3" json:"executor_perm_id,omitempty"`
+	BeneficiaryPermId uint64 `protobuf:"varint,2,opt,name=beneficiary_perm_id,json=beneficiaryPermId,proto3" json:"beneficiary_perm_id,omitempty"`
+	WalletAgentPermId uint64 `protobuf:"varint,3,opt,name=wallet_agent_perm_id,json=walletAgentPermId,proto3" json:"wallet_agent_perm_id,omitempty"`
 }
 
-func (x *SessionAuthz) slowProtoReflect() protoreflect.Message {
-	mi := &file_verana_perm_v1_types_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
+func (x *SessionAuthz) Reset() {
+	*x = SessionAuthz{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_types_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		ms.StoreMessageInfo(mi)
 	}
-	return mi.MessageOf(x)
 }
 
-var _fastReflection_SessionAuthz_messageType fastReflection_SessionAuthz_messageType
-var _ protoreflect.MessageType = fastReflection_SessionAuthz_messageType{}
+func (x *SessionAuthz) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-type fastReflection_SessionAuthz_messageType struct{}
+func (*SessionAuthz) ProtoMessage() {}
 
-func (x fastReflection_SessionAuthz_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SessionAuthz)(nil)
+// Deprecated: Use SessionAuthz.ProtoReflect.Descriptor instead.
+func (*SessionAuthz) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_types_proto_rawDescGZIP(), []int{2}
 }
-func (x fastReflection_SessionAuthz_messageType) New() protoreflect.Message {
-	return new(fastReflection_SessionAuthz)
+
+func (x *SessionAuthz) GetExecutorPermId() uint64 {
+	if x != nil {
+		return x.ExecutorPermId
+	}
+	return 0
 }
-func (x fastReflection_SessionAuthz_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SessionAuthz
+
+func (x *SessionAuthz) GetBeneficiaryPermId() uint64 {
+	if x != nil {
+		return x.BeneficiaryPermId
+	}
+	return 0
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_SessionAuthz) Descriptor() protoreflect.MessageDescriptor {
-	return md_SessionAuthz
+func (x *SessionAuthz) GetWalletAgentPermId() uint64 {
+	if x != nil {
+		return x.WalletAgentPermId
+	}
+	return 0
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SessionAuthz) Type() protoreflect.MessageType {
-	return _fastReflection_SessionAuthz_messageType
+// SessionAllowance is a pre-funded balance a sponsor sets aside to pay the
+// fees of perm sessions. It is scoped to exactly one of a credential schema,
+// an agent perm or a verifier perm, and can further be restricted to some
+// session creators and to a maximum amount per session.
+type SessionAllowance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sponsor        string `protobuf:"bytes,2,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	SchemaId       uint64 `protobuf:"varint,3,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
+	AgentPermId    uint64 `protobuf:"varint,4,opt,name=agent_perm_id,json=agentPermId,proto3" json:"agent_perm_id,omitempty"`
+	VerifierPermId uint64 `protobuf:"varint,5,opt,name=verifier_perm_id,json=verifierPermId,proto3" json:"verifier_perm_id,omitempty"`
+	// remaining is the amount, in uvna, still available to pay session fees
+	Remaining uint64 `protobuf:"varint,6,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	// spent is the amount, in uvna, already drawn to pay session fees
+	Spent    uint64                 `protobuf:"varint,7,opt,name=spent,proto3" json:"spent,omitempty"`
+	Created  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created,proto3" json:"created,omitempty"`
+	Modified *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=modified,proto3" json:"modified,omitempty"`
+	// allowed_creators are the accounts that can create sessions paid by the
+	// allowance, any account if empty
+	AllowedCreators []string `protobuf:"bytes,10,rep,name=allowed_creators,json=allowedCreators,proto3" json:"allowed_creators,omitempty"`
+	// max_per_session is the maximum amount, in uvna, drawn for a single
+	// session, no maximum if 0
+	MaxPerSession uint64 `protobuf:"varint,11,opt,name=max_per_session,json=maxPerSession,proto3" json:"max_per_session,omitempty"`
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SessionAuthz) New() protoreflect.Message {
-	return new(fastReflection_SessionAuthz)
+func (x *SessionAllowance) Reset() {
+	*x = SessionAllowance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_types_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_SessionAuthz) Interface() protoreflect.ProtoMessage {
-	return (*SessionAuthz)(x)
+func (x *SessionAllowance) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_SessionAuthz) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ExecutorPermId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ExecutorPermId)
-		if !f(fd_SessionAuthz_executor_perm_id, value) {
-			return
-		}
+func (*SessionAllowance) ProtoMessage() {}
+
+// Deprecated: Use SessionAllowance.ProtoReflect.Descriptor instead.
+func (*SessionAllowance) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_types_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SessionAllowance) GetId() uint64 {
+	if x != nil {
+		return x.Id
 	}
-	if x.BeneficiaryPermId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.BeneficiaryPermId)
-		if !f(fd_SessionAuthz_beneficiary_perm_id, value) {
-			return
-		}
+	return 0
+}
+
+func (x *SessionAllowance) GetSponsor() string {
+	if x != nil {
+		return x.Sponsor
 	}
-	if x.WalletAgentPermId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.WalletAgentPermId)
-		if !f(fd_SessionAuthz_wallet_agent_perm_id, value) {
-			return
-		}
+	return ""
+}
+
+func (x *SessionAllowance) GetSchemaId() uint64 {
+	if x != nil {
+		return x.SchemaId
 	}
+	return 0
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_SessionAuthz) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "verana.perm.v1.SessionAuthz.executor_perm_id":
-		return x.ExecutorPermId != uint64(0)
-	case "verana.perm.v1.SessionAuthz.beneficiary_perm_id":
-		return x.BeneficiaryPermId != uint64(0)
-	case "verana.perm.v1.SessionAuthz.wallet_agent_perm_id":
-		return x.WalletAgentPermId != uint64(0)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SessionAuthz"))
-		}
-		panic(fmt.Errorf("message verana.perm.v1.SessionAuthz does not contain field %s", fd.FullName()))
+func (x *SessionAllowance) GetAgentPermId() uint64 {
+	if x != nil {
+		return x.AgentPermId
 	}
+	return 0
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SessionAuthz) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "verana.perm.v1.SessionAuthz.executor_perm_id":
-		x.ExecutorPermId = uint64(0)
-	case "verana.perm.v1.SessionAuthz.beneficiary_perm_id":
-		x.BeneficiaryPermId = uint64(0)
-	case "verana.perm.v1.SessionAuthz.wallet_agent_perm_id":
-		x.WalletAgentPermId = uint64(0)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SessionAuthz"))
-		}
-		panic(fmt.Errorf("message verana.perm.v1.SessionAuthz does not contain field %s", fd.FullName()))
+func (x *SessionAllowance) GetVerifierPermId() uint64 {
+	if x != nil {
+		return x.VerifierPermId
 	}
+	return 0
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SessionAuthz) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "verana.perm.v1.SessionAuthz.executor_perm_id":
-		value := x.ExecutorPermId
-		return protoreflect.ValueOfUint64(value)
-	case "verana.perm.v1.SessionAuthz.beneficiary_perm_id":
-		value := x.BeneficiaryPermId
-		return protoreflect.ValueOfUint64(value)
-	case "verana.perm.v1.SessionAuthz.wallet_agent_perm_id":
-		value := x.WalletAgentPermId
-		return protoreflect.ValueOfUint64(value)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SessionAuthz"))
-		}
-		panic(fmt.Errorf("message verana.perm.v1.SessionAuthz does not contain field %s", descriptor.FullName()))
+func (x *SessionAllowance) GetRemaining() uint64 {
+	if x != nil {
+		return x.Remaining
 	}
+	return 0
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SessionAuthz) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "verana.perm.v1.SessionAuthz.executor_perm_id":
-		x.ExecutorPermId = value.Uint()
-	case "verana.perm.v1.SessionAuthz.beneficiary_perm_id":
-		x.BeneficiaryPermId = value.Uint()
-	case "verana.perm.v1.SessionAuthz.wallet_agent_perm_id":
-		x.WalletAgentPermId = value.Uint()
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SessionAuthz"))
-		}
-		panic(fmt.Errorf("message verana.perm.v1.SessionAuthz does not contain field %s", fd.FullName()))
+func (x *SessionAllowance) GetSpent() uint64 {
+	if x != nil {
+		return x.Spent
 	}
+	return 0
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SessionAuthz) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "verana.perm.v1.SessionAuthz.executor_perm_id":
-		panic(fmt.Errorf("field executor_perm_id of message verana.perm.v1.SessionAuthz is not mutable"))
-	case "verana.perm.v1.SessionAuthz.beneficiary_perm_id":
-		panic(fmt.Errorf("field beneficiary_perm_id of message verana.perm.v1.SessionAuthz is not mutable"))
-	case "verana.perm.v1.SessionAuthz.wallet_agent_perm_id":
-		panic(fmt.Errorf("field wallet_agent_perm_id of message verana.perm.v1.SessionAuthz is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SessionAuthz"))
-		}
-		panic(fmt.Errorf("message verana.perm.v1.SessionAuthz does not contain field %s", fd.FullName()))
+func (x *SessionAllowance) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
 	}
+	return nil
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SessionAuthz) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "verana.perm.v1.SessionAuthz.executor_perm_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "verana.perm.v1.SessionAuthz.beneficiary_perm_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "verana.perm.v1.SessionAuthz.wallet_agent_perm_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.perm.v1.SessionAuthz"))
-		}
-		panic(fmt.Errorf("message verana.perm.v1.SessionAuthz does not contain field %s", fd.FullName()))
+func (x *SessionAllowance) GetModified() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Modified
 	}
+	return nil
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SessionAuthz) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in verana.perm.v1.SessionAuthz", d.FullName()))
+func (x *SessionAllowance) GetAllowedCreators() []string {
+	if x != nil {
+		return x.AllowedCreators
 	}
-	panic("unreachable")
+	return nil
 }
 
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SessionAuthz) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
+func (x *SessionAllowance) GetMaxPerSession() uint64 {
+	if x != nil {
+		return x.MaxPerSession
+	}
+	return 0
 }
 
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SessionAuthz) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
+// SlashRecord tracks a slash of a perm trust deposit from the moment it is
+// requested until it is executed or reversed.
+type SlashRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PermId  uint64 `protobuf:"varint,2,opt,name=perm_id,json=permId,proto3" json:"perm_id,omitempty"`
+	Grantee string `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// amount is the slashed amount, in uvna, escrowed while the slash is open
+	Amount    uint64                 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	SlashedBy string                 `protobuf:"bytes,5,opt,name=slashed_by,json=slashedBy,proto3" json:"slashed_by,omitempty"`
+	Status    SlashStatus            `protobuf:"varint,6,opt,name=status,proto3,enum=verana.perm.v1.SlashStatus" json:"status,omitempty"`
+	Created   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created,proto3" json:"created,omitempty"`
+	// appeal_deadline is the time after which an unappealed slash is executed
+	AppealDeadline *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=appeal_deadline,json=appealDeadline,proto3" json:"appeal_deadline,omitempty"`
+	Appealed       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=appealed,proto3" json:"appealed,omitempty"`
+	// appeal_digest_sri is the digest SRI of the evidence backing the appeal
+	AppealDigestSri string                 `protobuf:"bytes,10,opt,name=appeal_digest_sri,json=appealDigestSri,proto3" json:"appeal_digest_sri,omitempty"`
+	Resolved        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=resolved,proto3" json:"resolved,omitempty"`
+	ResolvedBy      string                 `protobuf:"bytes,12,opt,name=resolved_by,json=resolvedBy,proto3" json:"resolved_by,omitempty"`
+	// reason_code identifies the violated rule, as listed by the governance
+	// framework of the trust registry
+	ReasonCode  uint32 `protobuf:"varint,13,opt,name=reason_code,json=reasonCode,proto3" json:"reason_code,omitempty"`
+	EvidenceUrl string `protobuf:"bytes,14,opt,name=evidence_url,json=evidenceUrl,proto3" json:"evidence_url,omitempty"`
+	// evidence_digest_sri is the digest SRI of the document at evidence_url
+	EvidenceDigestSri string `protobuf:"bytes,15,opt,name=evidence_digest_sri,json=evidenceDigestSri,proto3" json:"evidence_digest_sri,omitempty"`
+	// gf_version is the governance framework version that was violated
+	GfVersion int32 `protobuf:"varint,16,opt,name=gf_version,json=gfVersion,proto3" json:"gf_version,omitempty"`
+	// distribution defines where the amount goes once the slash is executed,
+	// it is burned if empty
+	Distribution []*v1.SlashShare `protobuf:"bytes,17,rep,name=distribution,proto3" json:"distribution,omitempty"`
 }
 
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_SessionAuthz) IsValid() bool {
-	return x != nil
+func (x *SlashRecord) Reset() {
+	*x = SlashRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_types_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_SessionAuthz) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SessionAuthz)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		if x.ExecutorPermId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ExecutorPermId))
-		}
-		if x.BeneficiaryPermId != 0 {
-			n += 1 + runtime.Sov(uint64(x.BeneficiaryPermId))
-		}
-		if x.WalletAgentPermId != 0 {
-			n += 1 + runtime.Sov(uint64(x.WalletAgentPermId))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
+func (x *SlashRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlashRecord) ProtoMessage() {}
+
+// Deprecated: Use SlashRecord.ProtoReflect.Descriptor instead.
+func (*SlashRecord) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_types_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SlashRecord) GetId() uint64 {
+	if x != nil {
+		return x.Id
 	}
+	return 0
+}
 
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SessionAuthz)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if x.WalletAgentPermId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.WalletAgentPermId))
-			i--
-			dAtA[i] = 0x18
-		}
-		if x.BeneficiaryPermId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BeneficiaryPermId))
-			i--
-			dAtA[i] = 0x10
-		}
-		if x.ExecutorPermId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecutorPermId))
-			i--
-			dAtA[i] = 0x8
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
+func (x *SlashRecord) GetPermId() uint64 {
+	if x != nil {
+		return x.PermId
 	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SessionAuthz)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SessionAuthz: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SessionAuthz: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutorPermId", wireType)
-				}
-				x.ExecutorPermId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ExecutorPermId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BeneficiaryPermId", wireType)
-				}
-				x.BeneficiaryPermId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.BeneficiaryPermId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletAgentPermId", wireType)
-				}
-				x.WalletAgentPermId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.WalletAgentPermId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
-				}
-				iNdEx += skippy
-			}
-		}
+	return 0
+}
+
+func (x *SlashRecord) GetGrantee() string {
+	if x != nil {
+		return x.Grantee
+	}
+	return ""
+}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+func (x *SlashRecord) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	return 0
+}
+
+func (x *SlashRecord) GetSlashedBy() string {
+	if x != nil {
+		return x.SlashedBy
 	}
+	return ""
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: verana/perm/v1/types.proto
+func (x *SlashRecord) GetStatus() SlashStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SlashStatus_SLASH_STATUS_UNSPECIFIED
+}
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+func (x *SlashRecord) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
 
-// Permission types
-type PermissionType int32
+func (x *SlashRecord) GetAppealDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AppealDeadline
+	}
+	return nil
+}
 
-const (
-	PermissionType_PERMISSION_TYPE_UNSPECIFIED      PermissionType = 0
-	PermissionType_PERMISSION_TYPE_ISSUER           PermissionType = 1
-	PermissionType_PERMISSION_TYPE_VERIFIER         PermissionType = 2
-	PermissionType_PERMISSION_TYPE_ISSUER_GRANTOR   PermissionType = 3
-	PermissionType_PERMISSION_TYPE_VERIFIER_GRANTOR PermissionType = 4
-	PermissionType_PERMISSION_TYPE_ECOSYSTEM        PermissionType = 5 // Changed from PERMISSION_TYPE_TRUST_REGISTRY
-	PermissionType_PERMISSION_TYPE_HOLDER           PermissionType = 6
-)
+func (x *SlashRecord) GetAppealed() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Appealed
+	}
+	return nil
+}
 
-// Enum value maps for PermissionType.
-var (
-	PermissionType_name = map[int32]string{
-		0: "PERMISSION_TYPE_UNSPECIFIED",
-		1: "PERMISSION_TYPE_ISSUER",
-		2: "PERMISSION_TYPE_VERIFIER",
-		3: "PERMISSION_TYPE_ISSUER_GRANTOR",
-		4: "PERMISSION_TYPE_VERIFIER_GRANTOR",
-		5: "PERMISSION_TYPE_ECOSYSTEM",
-		6: "PERMISSION_TYPE_HOLDER",
+func (x *SlashRecord) GetAppealDigestSri() string {
+	if x != nil {
+		return x.AppealDigestSri
 	}
-	PermissionType_value = map[string]int32{
-		"PERMISSION_TYPE_UNSPECIFIED":      0,
-		"PERMISSION_TYPE_ISSUER":           1,
-		"PERMISSION_TYPE_VERIFIER":         2,
-		"PERMISSION_TYPE_ISSUER_GRANTOR":   3,
-		"PERMISSION_TYPE_VERIFIER_GRANTOR": 4,
-		"PERMISSION_TYPE_ECOSYSTEM":        5,
-		"PERMISSION_TYPE_HOLDER":           6,
+	return ""
+}
+
+func (x *SlashRecord) GetResolved() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Resolved
 	}
-)
+	return nil
+}
 
-func (x PermissionType) Enum() *PermissionType {
-	p := new(PermissionType)
-	*p = x
-	return p
+func (x *SlashRecord) GetResolvedBy() string {
+	if x != nil {
+		return x.ResolvedBy
+	}
+	return ""
 }
 
-func (x PermissionType) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *SlashRecord) GetReasonCode() uint32 {
+	if x != nil {
+		return x.ReasonCode
+	}
+	return 0
 }
 
-func (PermissionType) Descriptor() protoreflect.EnumDescriptor {
-	return file_verana_perm_v1_types_proto_enumTypes[0].Descriptor()
+func (x *SlashRecord) GetEvidenceUrl() string {
+	if x != nil {
+		return x.EvidenceUrl
+	}
+	return ""
 }
 
-func (PermissionType) Type() protoreflect.EnumType {
-	return &file_verana_perm_v1_types_proto_enumTypes[0]
+func (x *SlashRecord) GetEvidenceDigestSri() string {
+	if x != nil {
+		return x.EvidenceDigestSri
+	}
+	return ""
 }
 
-func (x PermissionType) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *SlashRecord) GetGfVersion() int32 {
+	if x != nil {
+		return x.GfVersion
+	}
+	return 0
 }
 
-// Deprecated: Use PermissionType.Descriptor instead.
-func (PermissionType) EnumDescriptor() ([]byte, []int) {
-	return file_verana_perm_v1_types_proto_rawDescGZIP(), []int{0}
+func (x *SlashRecord) GetDistribution() []*v1.SlashShare {
+	if x != nil {
+		return x.Distribution
+	}
+	return nil
 }
 
-// Validation process states
-type ValidationState int32
+// TrustRegistrySlashDistribution is the default distribution of the slashed
+// deposits of the perms of a trust registry.
+type TrustRegistrySlashDistribution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-const (
-	ValidationState_VALIDATION_STATE_UNSPECIFIED           ValidationState = 0
-	ValidationState_VALIDATION_STATE_PENDING               ValidationState = 1
-	ValidationState_VALIDATION_STATE_VALIDATED             ValidationState = 2
-	ValidationState_VALIDATION_STATE_TERMINATED            ValidationState = 3
-	ValidationState_VALIDATION_STATE_TERMINATION_REQUESTED ValidationState = 4
-)
+	TrId         uint64           `protobuf:"varint,1,opt,name=tr_id,json=trId,proto3" json:"tr_id,omitempty"`
+	Distribution []*v1.SlashShare `protobuf:"bytes,2,rep,name=distribution,proto3" json:"distribution,omitempty"`
+}
 
-// Enum value maps for ValidationState.
-var (
-	ValidationState_name = map[int32]string{
-		0: "VALIDATION_STATE_UNSPECIFIED",
-		1: "VALIDATION_STATE_PENDING",
-		2: "VALIDATION_STATE_VALIDATED",
-		3: "VALIDATION_STATE_TERMINATED",
-		4: "VALIDATION_STATE_TERMINATION_REQUESTED",
+func (x *TrustRegistrySlashDistribution) Reset() {
+	*x = TrustRegistrySlashDistribution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_types_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	ValidationState_value = map[string]int32{
-		"VALIDATION_STATE_UNSPECIFIED":           0,
-		"VALIDATION_STATE_PENDING":               1,
-		"VALIDATION_STATE_VALIDATED":             2,
-		"VALIDATION_STATE_TERMINATED":            3,
-		"VALIDATION_STATE_TERMINATION_REQUESTED": 4,
+}
+
+func (x *TrustRegistrySlashDistribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrustRegistrySlashDistribution) ProtoMessage() {}
+
+// Deprecated: Use TrustRegistrySlashDistribution.ProtoReflect.Descriptor instead.
+func (*TrustRegistrySlashDistribution) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_types_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TrustRegistrySlashDistribution) GetTrId() uint64 {
+	if x != nil {
+		return x.TrId
 	}
-)
+	return 0
+}
 
-func (x ValidationState) Enum() *ValidationState {
-	p := new(ValidationState)
-	*p = x
-	return p
+func (x *TrustRegistrySlashDistribution) GetDistribution() []*v1.SlashShare {
+	if x != nil {
+		return x.Distribution
+	}
+	return nil
 }
 
-func (x ValidationState) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+// RevocationCascade is a cascading revocation that still has to go through
+// the perms validated by perm_id. Cascades that do not fit in the revocation
+// transaction are continued by the EndBlocker.
+type RevocationCascade struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// perm_id is the perm whose validated perms are still to be processed
+	PermId uint64 `protobuf:"varint,1,opt,name=perm_id,json=permId,proto3" json:"perm_id,omitempty"`
+	// origin_perm_id is the revoked perm the cascade started from
+	OriginPermId uint64                 `protobuf:"varint,2,opt,name=origin_perm_id,json=originPermId,proto3" json:"origin_perm_id,omitempty"`
+	Mode         CascadeMode            `protobuf:"varint,3,opt,name=mode,proto3,enum=verana.perm.v1.CascadeMode" json:"mode,omitempty"`
+	ExecutedBy   string                 `protobuf:"bytes,4,opt,name=executed_by,json=executedBy,proto3" json:"executed_by,omitempty"`
+	Created      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created,proto3" json:"created,omitempty"`
+	// last_child_id is the last validated perm of perm_id already processed
+	LastChildId uint64 `protobuf:"varint,6,opt,name=last_child_id,json=lastChildId,proto3" json:"last_child_id,omitempty"`
 }
 
-func (ValidationState) Descriptor() protoreflect.EnumDescriptor {
-	return file_verana_perm_v1_types_proto_enumTypes[1].Descriptor()
+func (x *RevocationCascade) Reset() {
+	*x = RevocationCascade{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_types_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (ValidationState) Type() protoreflect.EnumType {
-	return &file_verana_perm_v1_types_proto_enumTypes[1]
+func (x *RevocationCascade) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x ValidationState) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (*RevocationCascade) ProtoMessage() {}
+
+// Deprecated: Use RevocationCascade.ProtoReflect.Descriptor instead.
+func (*RevocationCascade) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_types_proto_rawDescGZIP(), []int{6}
 }
 
-// Deprecated: Use ValidationState.Descriptor instead.
-func (ValidationState) EnumDescriptor() ([]byte, []int) {
-	return file_verana_perm_v1_types_proto_rawDescGZIP(), []int{1}
+func (x *RevocationCascade) GetPermId() uint64 {
+	if x != nil {
+		return x.PermId
+	}
+	return 0
 }
 
-type Permission struct {
+func (x *RevocationCascade) GetOriginPermId() uint64 {
+	if x != nil {
+		return x.OriginPermId
+	}
+	return 0
+}
+
+func (x *RevocationCascade) GetMode() CascadeMode {
+	if x != nil {
+		return x.Mode
+	}
+	return CascadeMode_CASCADE_MODE_NONE
+}
+
+func (x *RevocationCascade) GetExecutedBy() string {
+	if x != nil {
+		return x.ExecutedBy
+	}
+	return ""
+}
+
+func (x *RevocationCascade) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *RevocationCascade) GetLastChildId() uint64 {
+	if x != nil {
+		return x.LastChildId
+	}
+	return 0
+}
+
+// AgentReward holds the rewards accrued by a HOLDER perm acting as user agent
+// or wallet agent of perm sessions.
+type AgentReward struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id         uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	SchemaId   uint64                 `protobuf:"varint,2,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
-	Type_      PermissionType         `protobuf:"varint,3,opt,name=type,proto3,enum=verana.perm.v1.PermissionType" json:"type,omitempty"`
-	Did        string                 `protobuf:"bytes,4,opt,name=did,proto3" json:"did,omitempty"`
-	Grantee    string                 `protobuf:"bytes,5,opt,name=grantee,proto3" json:"grantee,omitempty"`
-	Created    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created,proto3" json:"created,omitempty"`
-	CreatedBy  string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	Extended   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=extended,proto3" json:"extended,omitempty"`
-	ExtendedBy string                 `protobuf:"bytes,9,opt,name=extended_by,json=extendedBy,proto3" json:"extended_by,omitempty"`
-	// NEW: Slashing related fields
-	Slashed   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=slashed,proto3" json:"slashed,omitempty"`
-	SlashedBy string                 `protobuf:"bytes,11,opt,name=slashed_by,json=slashedBy,proto3" json:"slashed_by,omitempty"`
-	Repaid    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=repaid,proto3" json:"repaid,omitempty"`
-	RepaidBy  string                 `protobuf:"bytes,13,opt,name=repaid_by,json=repaidBy,proto3" json:"repaid_by,omitempty"`
-	// END NEW
-	EffectiveFrom    *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
-	EffectiveUntil   *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=effective_until,json=effectiveUntil,proto3" json:"effective_until,omitempty"`
-	Modified         *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=modified,proto3" json:"modified,omitempty"`
-	ValidationFees   uint64                 `protobuf:"varint,17,opt,name=validation_fees,json=validationFees,proto3" json:"validation_fees,omitempty"`
-	IssuanceFees     uint64                 `protobuf:"varint,18,opt,name=issuance_fees,json=issuanceFees,proto3" json:"issuance_fees,omitempty"`
-	VerificationFees uint64                 `protobuf:"varint,19,opt,name=verification_fees,json=verificationFees,proto3" json:"verification_fees,omitempty"`
-	Deposit          uint64                 `protobuf:"varint,20,opt,name=deposit,proto3" json:"deposit,omitempty"`
-	// NEW: Slashing deposit fields
-	SlashedDeposit uint64 `protobuf:"varint,21,opt,name=slashed_deposit,json=slashedDeposit,proto3" json:"slashed_deposit,omitempty"`
-	RepaidDeposit  uint64 `protobuf:"varint,22,opt,name=repaid_deposit,json=repaidDeposit,proto3" json:"repaid_deposit,omitempty"`
-	// END NEW
-	Revoked            *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=revoked,proto3" json:"revoked,omitempty"`
-	RevokedBy          string                 `protobuf:"bytes,24,opt,name=revoked_by,json=revokedBy,proto3" json:"revoked_by,omitempty"`
-	Terminated         *timestamppb.Timestamp `protobuf:"bytes,25,opt,name=terminated,proto3" json:"terminated,omitempty"`
-	TerminatedBy       string                 `protobuf:"bytes,26,opt,name=terminated_by,json=terminatedBy,proto3" json:"terminated_by,omitempty"`
-	Country            string                 `protobuf:"bytes,27,opt,name=country,proto3" json:"country,omitempty"`
-	ValidatorPermId    uint64                 `protobuf:"varint,28,opt,name=validator_perm_id,json=validatorPermId,proto3" json:"validator_perm_id,omitempty"`
-	VpState            ValidationState        `protobuf:"varint,29,opt,name=vp_state,json=vpState,proto3,enum=verana.perm.v1.ValidationState" json:"vp_state,omitempty"`
-	VpExp              *timestamppb.Timestamp `protobuf:"bytes,30,opt,name=vp_exp,json=vpExp,proto3" json:"vp_exp,omitempty"`
-	VpLastStateChange  *timestamppb.Timestamp `protobuf:"bytes,31,opt,name=vp_last_state_change,json=vpLastStateChange,proto3" json:"vp_last_state_change,omitempty"`
-	VpValidatorDeposit uint64                 `protobuf:"varint,32,opt,name=vp_validator_deposit,json=vpValidatorDeposit,proto3" json:"vp_validator_deposit,omitempty"`
-	VpCurrentFees      uint64                 `protobuf:"varint,33,opt,name=vp_current_fees,json=vpCurrentFees,proto3" json:"vp_current_fees,omitempty"`
-	VpCurrentDeposit   uint64                 `protobuf:"varint,34,opt,name=vp_current_deposit,json=vpCurrentDeposit,proto3" json:"vp_current_deposit,omitempty"`
-	VpSummaryDigestSri string                 `protobuf:"bytes,35,opt,name=vp_summary_digest_sri,json=vpSummaryDigestSri,proto3" json:"vp_summary_digest_sri,omitempty"`
-	VpTermRequested    *timestamppb.Timestamp `protobuf:"bytes,36,opt,name=vp_term_requested,json=vpTermRequested,proto3" json:"vp_term_requested,omitempty"`
+	PermId uint64 `protobuf:"varint,1,opt,name=perm_id,json=permId,proto3" json:"perm_id,omitempty"`
+	// user_agent_rewards is the amount, in uvna, earned as user agent
+	UserAgentRewards uint64 `protobuf:"varint,2,opt,name=user_agent_rewards,json=userAgentRewards,proto3" json:"user_agent_rewards,omitempty"`
+	// wallet_agent_rewards is the amount, in uvna, earned as wallet agent
+	WalletAgentRewards uint64 `protobuf:"varint,3,opt,name=wallet_agent_rewards,json=walletAgentRewards,proto3" json:"wallet_agent_rewards,omitempty"`
+	// trust_deposit is the part of the rewards, in uvna, that went to the
+	// trust deposit of the grantee
+	TrustDeposit uint64                 `protobuf:"varint,4,opt,name=trust_deposit,json=trustDeposit,proto3" json:"trust_deposit,omitempty"`
+	LastReward   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_reward,json=lastReward,proto3" json:"last_reward,omitempty"`
 }
 
-func (x *Permission) Reset() {
-	*x = Permission{}
+func (x *AgentReward) Reset() {
+	*x = AgentReward{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_perm_v1_types_proto_msgTypes[0]
+		mi := &file_verana_perm_v1_types_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Permission) String() string {
+func (x *AgentReward) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Permission) ProtoMessage() {}
+func (*AgentReward) ProtoMessage() {}
 
-// Deprecated: Use Permission.ProtoReflect.Descriptor instead.
-func (*Permission) Descriptor() ([]byte, []int) {
-	return file_verana_perm_v1_types_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use AgentReward.ProtoReflect.Descriptor instead.
+func (*AgentReward) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_types_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Permission) GetId() uint64 {
+func (x *AgentReward) GetPermId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.PermId
 	}
 	return 0
 }
 
-func (x *Permission) GetSchemaId() uint64 {
+func (x *AgentReward) GetUserAgentRewards() uint64 {
 	if x != nil {
-		return x.SchemaId
+		return x.UserAgentRewards
 	}
 	return 0
 }
 
-func (x *Permission) GetType_() PermissionType {
+func (x *AgentReward) GetWalletAgentRewards() uint64 {
 	if x != nil {
-		return x.Type_
+		return x.WalletAgentRewards
 	}
-	return PermissionType_PERMISSION_TYPE_UNSPECIFIED
+	return 0
 }
 
-func (x *Permission) GetDid() string {
+func (x *AgentReward) GetTrustDeposit() uint64 {
 	if x != nil {
-		return x.Did
+		return x.TrustDeposit
 	}
-	return ""
+	return 0
 }
 
-func (x *Permission) GetGrantee() string {
+func (x *AgentReward) GetLastReward() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Grantee
+		return x.LastReward
 	}
-	return ""
+	return nil
 }
 
-func (x *Permission) GetCreated() *timestamppb.Timestamp {
+// VerificationCredit is a bundle of prepaid verification sessions. The session
+// fees of all credits are distributed to the beneficiaries when the bundle is
+// purchased, a session of the buyer then consumes a credit instead of paying
+// the fees.
+type VerificationCredit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Buyer          string `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	VerifierPermId uint64 `protobuf:"varint,3,opt,name=verifier_perm_id,json=verifierPermId,proto3" json:"verifier_perm_id,omitempty"`
+	// issuer_perm_id is the issuer perm of the sessions, 0 if none
+	IssuerPermId uint64 `protobuf:"varint,4,opt,name=issuer_perm_id,json=issuerPermId,proto3" json:"issuer_perm_id,omitempty"`
+	// executor_perm_id is the perm whose deposit holds the buyer trust deposit
+	ExecutorPermId uint64 `protobuf:"varint,5,opt,name=executor_perm_id,json=executorPermId,proto3" json:"executor_perm_id,omitempty"`
+	Purchased      uint64 `protobuf:"varint,6,opt,name=purchased,proto3" json:"purchased,omitempty"`
+	Remaining      uint64 `protobuf:"varint,7,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	// unit_fees is the amount, in uvna, paid to the beneficiaries per credit
+	UnitFees uint64 `protobuf:"varint,8,opt,name=unit_fees,json=unitFees,proto3" json:"unit_fees,omitempty"`
+	// unit_trust_deposit is the amount, in uvna, added to the buyer trust
+	// deposit per credit
+	UnitTrustDeposit uint64                 `protobuf:"varint,9,opt,name=unit_trust_deposit,json=unitTrustDeposit,proto3" json:"unit_trust_deposit,omitempty"`
+	Created          *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created,proto3" json:"created,omitempty"`
+	Modified         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=modified,proto3" json:"modified,omitempty"`
+	// refunded is set when the unused credits have been refunded
+	Refunded *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=refunded,proto3" json:"refunded,omitempty"`
+}
+
+func (x *VerificationCredit) Reset() {
+	*x = VerificationCredit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_types_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerificationCredit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerificationCredit) ProtoMessage() {}
+
+// Deprecated: Use VerificationCredit.ProtoReflect.Descriptor instead.
+func (*VerificationCredit) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_types_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *VerificationCredit) GetId() uint64 {
 	if x != nil {
-		return x.Created
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *Permission) GetCreatedBy() string {
+func (x *VerificationCredit) GetBuyer() string {
 	if x != nil {
-		return x.CreatedBy
+		return x.Buyer
 	}
 	return ""
 }
 
-func (x *Permission) GetExtended() *timestamppb.Timestamp {
+func (x *VerificationCredit) GetVerifierPermId() uint64 {
 	if x != nil {
-		return x.Extended
+		return x.VerifierPermId
 	}
-	return nil
+	return 0
 }
 
-func (x *Permission) GetExtendedBy() string {
+func (x *VerificationCredit) GetIssuerPermId() uint64 {
 	if x != nil {
-		return x.ExtendedBy
+		return x.IssuerPermId
 	}
-	return ""
+	return 0
 }
 
-func (x *Permission) GetSlashed() *timestamppb.Timestamp {
+func (x *VerificationCredit) GetExecutorPermId() uint64 {
 	if x != nil {
-		return x.Slashed
+		return x.ExecutorPermId
 	}
-	return nil
+	return 0
 }
 
-func (x *Permission) GetSlashedBy() string {
+func (x *VerificationCredit) GetPurchased() uint64 {
 	if x != nil {
-		return x.SlashedBy
+		return x.Purchased
 	}
-	return ""
+	return 0
 }
 
-func (x *Permission) GetRepaid() *timestamppb.Timestamp {
+func (x *VerificationCredit) GetRemaining() uint64 {
 	if x != nil {
-		return x.Repaid
+		return x.Remaining
 	}
-	return nil
+	return 0
 }
 
-func (x *Permission) GetRepaidBy() string {
+func (x *VerificationCredit) GetUnitFees() uint64 {
 	if x != nil {
-		return x.RepaidBy
+		return x.UnitFees
 	}
-	return ""
+	return 0
 }
 
-func (x *Permission) GetEffectiveFrom() *timestamppb.Timestamp {
+func (x *VerificationCredit) GetUnitTrustDeposit() uint64 {
 	if x != nil {
-		return x.EffectiveFrom
+		return x.UnitTrustDeposit
 	}
-	return nil
+	return 0
 }
 
-func (x *Permission) GetEffectiveUntil() *timestamppb.Timestamp {
+func (x *VerificationCredit) GetCreated() *timestamppb.Timestamp {
 	if x != nil {
-		return x.EffectiveUntil
+		return x.Created
 	}
 	return nil
 }
 
-func (x *Permission) GetModified() *timestamppb.Timestamp {
+func (x *VerificationCredit) GetModified() *timestamppb.Timestamp {
 	if x != nil {
 		return x.Modified
 	}
 	return nil
 }
 
-func (x *Permission) GetValidationFees() uint64 {
+func (x *VerificationCredit) GetRefunded() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ValidationFees
+		return x.Refunded
 	}
-	return 0
+	return nil
 }
 
-func (x *Permission) GetIssuanceFees() uint64 {
+// SessionVoucher is a session authorization signed off-chain by the grantee
+// of the verifier perm. As in SessionAuthz, the executor is the issuer perm
+// and the beneficiary the verifier perm of the session. Aggregators settle vouchers in batches with
+// MsgSettleSessionVouchers, the signer then pays the session fees.
+type SessionVoucher struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// signer is the account that signed the voucher and pays the session
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// executor_perm_id is the issuer perm of the session, 0 if none
+	ExecutorPermId uint64 `protobuf:"varint,2,opt,name=executor_perm_id,json=executorPermId,proto3" json:"executor_perm_id,omitempty"`
+	// beneficiary_perm_id is the verifier perm of the session
+	BeneficiaryPermId uint64 `protobuf:"varint,3,opt,name=beneficiary_perm_id,json=beneficiaryPermId,proto3" json:"beneficiary_perm_id,omitempty"`
+	AgentPermId       uint64 `protobuf:"varint,4,opt,name=agent_perm_id,json=agentPermId,proto3" json:"agent_perm_id,omitempty"`
+	// nonce must not have been settled for the signer yet, and be greater than
+	// the highest nonce settled for the signer minus the voucher nonce window
+	Nonce uint64 `protobuf:"varint,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// amount is the maximum, in uvna, the signer agrees to pay for the session
+	Amount uint64 `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *SessionVoucher) Reset() {
+	*x = SessionVoucher{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_types_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionVoucher) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionVoucher) ProtoMessage() {}
+
+// Deprecated: Use SessionVoucher.ProtoReflect.Descriptor instead.
+func (*SessionVoucher) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_types_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SessionVoucher) GetSigner() string {
 	if x != nil {
-		return x.IssuanceFees
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *SessionVoucher) GetExecutorPermId() uint64 {
+	if x != nil {
+		return x.ExecutorPermId
 	}
 	return 0
 }
 
-func (x *Permission) GetVerificationFees() uint64 {
+func (x *SessionVoucher) GetBeneficiaryPermId() uint64 {
 	if x != nil {
-		return x.VerificationFees
+		return x.BeneficiaryPermId
 	}
 	return 0
 }
 
-func (x *Permission) GetDeposit() uint64 {
+func (x *SessionVoucher) GetAgentPermId() uint64 {
 	if x != nil {
-		return x.Deposit
+		return x.AgentPermId
 	}
 	return 0
 }
 
-func (x *Permission) GetSlashedDeposit() uint64 {
+func (x *SessionVoucher) GetNonce() uint64 {
 	if x != nil {
-		return x.SlashedDeposit
+		return x.Nonce
 	}
 	return 0
 }
 
-func (x *Permission) GetRepaidDeposit() uint64 {
+func (x *SessionVoucher) GetAmount() uint64 {
 	if x != nil {
-		return x.RepaidDeposit
+		return x.Amount
 	}
 	return 0
 }
 
-func (x *Permission) GetRevoked() *timestamppb.Timestamp {
+// SessionVoucherSignDoc is the document signed for a session voucher.
+type SessionVoucherSignDoc struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId string          `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Voucher *SessionVoucher `protobuf:"bytes,2,opt,name=voucher,proto3" json:"voucher,omitempty"`
+}
+
+func (x *SessionVoucherSignDoc) Reset() {
+	*x = SessionVoucherSignDoc{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_types_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionVoucherSignDoc) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionVoucherSignDoc) ProtoMessage() {}
+
+// Deprecated: Use SessionVoucherSignDoc.ProtoReflect.Descriptor instead.
+func (*SessionVoucherSignDoc) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_types_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SessionVoucherSignDoc) GetChainId() string {
 	if x != nil {
-		return x.Revoked
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *SessionVoucherSignDoc) GetVoucher() *SessionVoucher {
+	if x != nil {
+		return x.Voucher
 	}
 	return nil
 }
 
-func (x *Permission) GetRevokedBy() string {
+// VoucherNonce holds the voucher nonces settled for a signer.
+type VoucherNonce struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// nonce is the highest nonce settled
+	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// used_nonces are the nonces settled within the voucher nonce window below
+	// nonce, nonce included
+	UsedNonces []uint64 `protobuf:"varint,3,rep,packed,name=used_nonces,json=usedNonces,proto3" json:"used_nonces,omitempty"`
+}
+
+func (x *VoucherNonce) Reset() {
+	*x = VoucherNonce{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_types_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VoucherNonce) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoucherNonce) ProtoMessage() {}
+
+// Deprecated: Use VoucherNonce.ProtoReflect.Descriptor instead.
+func (*VoucherNonce) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_types_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *VoucherNonce) GetSigner() string {
 	if x != nil {
-		return x.RevokedBy
+		return x.Signer
 	}
 	return ""
 }
 
-func (x *Permission) GetTerminated() *timestamppb.Timestamp {
+func (x *VoucherNonce) GetNonce() uint64 {
 	if x != nil {
-		return x.Terminated
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *VoucherNonce) GetUsedNonces() []uint64 {
+	if x != nil {
+		return x.UsedNonces
 	}
 	return nil
 }
 
-func (x *Permission) GetTerminatedBy() string {
+// VPMessage anchors a message exchanged, off-chain and encrypted, between the
+// applicant and the validator of the validation process of a perm.
+type VPMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PermId uint64 `protobuf:"varint,1,opt,name=perm_id,json=permId,proto3" json:"perm_id,omitempty"`
+	// index orders the messages of the validation process, starting at 1
+	Index uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// sender is the applicant or validator grantee who anchored the message
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	// digest_sri is the digest SRI of the message content
+	DigestSri string `protobuf:"bytes,4,opt,name=digest_sri,json=digestSri,proto3" json:"digest_sri,omitempty"`
+	// encrypted_uri is where the encrypted message can be retrieved
+	EncryptedUri string                 `protobuf:"bytes,5,opt,name=encrypted_uri,json=encryptedUri,proto3" json:"encrypted_uri,omitempty"`
+	Created      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created,proto3" json:"created,omitempty"`
+}
+
+func (x *VPMessage) Reset() {
+	*x = VPMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_types_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VPMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VPMessage) ProtoMessage() {}
+
+// Deprecated: Use VPMessage.ProtoReflect.Descriptor instead.
+func (*VPMessage) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_types_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *VPMessage) GetPermId() uint64 {
 	if x != nil {
-		return x.TerminatedBy
+		return x.PermId
+	}
+	return 0
+}
+
+func (x *VPMessage) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *VPMessage) GetSender() string {
+	if x != nil {
+		return x.Sender
 	}
 	return ""
 }
 
-func (x *Permission) GetCountry() string {
+func (x *VPMessage) GetDigestSri() string {
 	if x != nil {
-		return x.Country
+		return x.DigestSri
 	}
 	return ""
 }
 
-func (x *Permission) GetValidatorPermId() uint64 {
+func (x *VPMessage) GetEncryptedUri() string {
+	if x != nil {
+		return x.EncryptedUri
+	}
+	return ""
+}
+
+func (x *VPMessage) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+// VPApproval is the approval of a validation process by one of its validators.
+// All the approvals of a validation process must agree on the same terms.
+type VPApproval struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ValidatorPermId    uint64                 `protobuf:"varint,1,opt,name=validator_perm_id,json=validatorPermId,proto3" json:"validator_perm_id,omitempty"`
+	Approver           string                 `protobuf:"bytes,2,opt,name=approver,proto3" json:"approver,omitempty"`
+	EffectiveUntil     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=effective_until,json=effectiveUntil,proto3" json:"effective_until,omitempty"`
+	ValidationFees     uint64                 `protobuf:"varint,4,opt,name=validation_fees,json=validationFees,proto3" json:"validation_fees,omitempty"`
+	IssuanceFees       uint64                 `protobuf:"varint,5,opt,name=issuance_fees,json=issuanceFees,proto3" json:"issuance_fees,omitempty"`
+	VerificationFees   uint64                 `protobuf:"varint,6,opt,name=verification_fees,json=verificationFees,proto3" json:"verification_fees,omitempty"`
+	Countries          []string               `protobuf:"bytes,7,rep,name=countries,proto3" json:"countries,omitempty"`
+	VpSummaryDigestSri string                 `protobuf:"bytes,8,opt,name=vp_summary_digest_sri,json=vpSummaryDigestSri,proto3" json:"vp_summary_digest_sri,omitempty"`
+	Created            *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created,proto3" json:"created,omitempty"`
+}
+
+func (x *VPApproval) Reset() {
+	*x = VPApproval{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_types_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VPApproval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VPApproval) ProtoMessage() {}
+
+// Deprecated: Use VPApproval.ProtoReflect.Descriptor instead.
+func (*VPApproval) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_types_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *VPApproval) GetValidatorPermId() uint64 {
 	if x != nil {
 		return x.ValidatorPermId
 	}
 	return 0
 }
 
-func (x *Permission) GetVpState() ValidationState {
+func (x *VPApproval) GetApprover() string {
 	if x != nil {
-		return x.VpState
+		return x.Approver
 	}
-	return ValidationState_VALIDATION_STATE_UNSPECIFIED
+	return ""
 }
 
-func (x *Permission) GetVpExp() *timestamppb.Timestamp {
+func (x *VPApproval) GetEffectiveUntil() *timestamppb.Timestamp {
 	if x != nil {
-		return x.VpExp
+		return x.EffectiveUntil
 	}
 	return nil
 }
 
-func (x *Permission) GetVpLastStateChange() *timestamppb.Timestamp {
+func (x *VPApproval) GetValidationFees() uint64 {
 	if x != nil {
-		return x.VpLastStateChange
+		return x.ValidationFees
 	}
-	return nil
+	return 0
 }
 
-func (x *Permission) GetVpValidatorDeposit() uint64 {
+func (x *VPApproval) GetIssuanceFees() uint64 {
 	if x != nil {
-		return x.VpValidatorDeposit
+		return x.IssuanceFees
 	}
 	return 0
 }
 
-func (x *Permission) GetVpCurrentFees() uint64 {
+func (x *VPApproval) GetVerificationFees() uint64 {
 	if x != nil {
-		return x.VpCurrentFees
+		return x.VerificationFees
 	}
 	return 0
 }
 
-func (x *Permission) GetVpCurrentDeposit() uint64 {
+func (x *VPApproval) GetCountries() []string {
 	if x != nil {
-		return x.VpCurrentDeposit
+		return x.Countries
 	}
-	return 0
+	return nil
 }
 
-func (x *Permission) GetVpSummaryDigestSri() string {
+func (x *VPApproval) GetVpSummaryDigestSri() string {
 	if x != nil {
 		return x.VpSummaryDigestSri
 	}
 	return ""
 }
 
-func (x *Permission) GetVpTermRequested() *timestamppb.Timestamp {
+func (x *VPApproval) GetCreated() *timestamppb.Timestamp {
 	if x != nil {
-		return x.VpTermRequested
+		return x.Created
 	}
 	return nil
 }
 
-type PermissionSession struct {
+// CoValidatorDeposit is the trust deposit, in uvna, a co-validator earned
+// from the validation of a perm.
+type CoValidatorDeposit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Controller  string                 `protobuf:"bytes,2,opt,name=controller,proto3" json:"controller,omitempty"`
-	AgentPermId uint64                 `protobuf:"varint,3,opt,name=agent_perm_id,json=agentPermId,proto3" json:"agent_perm_id,omitempty"`
-	Authz       []*SessionAuthz        `protobuf:"bytes,4,rep,name=authz,proto3" json:"authz,omitempty"`
-	Created     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created,proto3" json:"created,omitempty"`
-	Modified    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=modified,proto3" json:"modified,omitempty"`
+	ValidatorPermId uint64 `protobuf:"varint,1,opt,name=validator_perm_id,json=validatorPermId,proto3" json:"validator_perm_id,omitempty"`
+	Deposit         uint64 `protobuf:"varint,2,opt,name=deposit,proto3" json:"deposit,omitempty"`
 }
 
-func (x *PermissionSession) Reset() {
-	*x = PermissionSession{}
+func (x *CoValidatorDeposit) Reset() {
+	*x = CoValidatorDeposit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_perm_v1_types_proto_msgTypes[1]
+		mi := &file_verana_perm_v1_types_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PermissionSession) String() string {
+func (x *CoValidatorDeposit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PermissionSession) ProtoMessage() {}
+func (*CoValidatorDeposit) ProtoMessage() {}
 
-// Deprecated: Use PermissionSession.ProtoReflect.Descriptor instead.
-func (*PermissionSession) Descriptor() ([]byte, []int) {
-	return file_verana_perm_v1_types_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use CoValidatorDeposit.ProtoReflect.Descriptor instead.
+func (*CoValidatorDeposit) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_types_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *PermissionSession) GetId() string {
+func (x *CoValidatorDeposit) GetValidatorPermId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.ValidatorPermId
 	}
-	return ""
+	return 0
 }
 
-func (x *PermissionSession) GetController() string {
+func (x *CoValidatorDeposit) GetDeposit() uint64 {
 	if x != nil {
-		return x.Controller
+		return x.Deposit
 	}
-	return ""
+	return 0
 }
 
-func (x *PermissionSession) GetAgentPermId() uint64 {
+// PermissionFees is a fee schedule of a perm, in trust units.
+type PermissionFees struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ValidationFees   uint64 `protobuf:"varint,1,opt,name=validation_fees,json=validationFees,proto3" json:"validation_fees,omitempty"`
+	IssuanceFees     uint64 `protobuf:"varint,2,opt,name=issuance_fees,json=issuanceFees,proto3" json:"issuance_fees,omitempty"`
+	VerificationFees uint64 `protobuf:"varint,3,opt,name=verification_fees,json=verificationFees,proto3" json:"verification_fees,omitempty"`
+	// effective_from is the time the fees apply from
+	EffectiveFrom *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+}
+
+func (x *PermissionFees) Reset() {
+	*x = PermissionFees{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_verana_perm_v1_types_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PermissionFees) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermissionFees) ProtoMessage() {}
+
+// Deprecated: Use PermissionFees.ProtoReflect.Descriptor instead.
+func (*PermissionFees) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_types_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PermissionFees) GetValidationFees() uint64 {
 	if x != nil {
-		return x.AgentPermId
+		return x.ValidationFees
 	}
 	return 0
 }
 
-func (x *PermissionSession) GetAuthz() []*SessionAuthz {
+func (x *PermissionFees) GetIssuanceFees() uint64 {
 	if x != nil {
-		return x.Authz
+		return x.IssuanceFees
 	}
-	return nil
+	return 0
 }
 
-func (x *PermissionSession) GetCreated() *timestamppb.Timestamp {
+func (x *PermissionFees) GetVerificationFees() uint64 {
 	if x != nil {
-		return x.Created
+		return x.VerificationFees
 	}
-	return nil
+	return 0
 }
 
-func (x *PermissionSession) GetModified() *timestamppb.Timestamp {
+func (x *PermissionFees) GetEffectiveFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Modified
+		return x.EffectiveFrom
 	}
 	return nil
 }
 
-type SessionAuthz struct {
+// PermissionFeeRecord is an entry of the fee history of a perm. The first
+// entry holds the fees the perm had before its first fee update.
+type PermissionFeeRecord struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ExecutorPermId    uint64 `protobuf:"varint,1,opt,name=executor_perm_id,json=executorPermId,proto3" json:"executor_perm_id,omitempty"`
-	BeneficiaryPermId uint64 `protobuf:"varint,2,opt,name=beneficiary_perm_id,json=beneficiaryPermId,proto3" json:"beneficiary_perm_id,omitempty"`
-	WalletAgentPermId uint64 `protobuf:"varint,3,opt,name=wallet_agent_perm_id,json=walletAgentPermId,proto3" json:"wallet_agent_perm_id,omitempty"`
+	PermId uint64 `protobuf:"varint,1,opt,name=perm_id,json=permId,proto3" json:"perm_id,omitempty"`
+	// index orders the fee history of the perm, starting at 1
+	Index uint64          `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Fees  *PermissionFees `protobuf:"bytes,3,opt,name=fees,proto3" json:"fees,omitempty"`
+	// updated_by is the grantee who set the fees, empty for the first entry
+	UpdatedBy string `protobuf:"bytes,4,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 }
 
-func (x *SessionAuthz) Reset() {
-	*x = SessionAuthz{}
+func (x *PermissionFeeRecord) Reset() {
+	*x = PermissionFeeRecord{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_verana_perm_v1_types_proto_msgTypes[2]
+		mi := &file_verana_perm_v1_types_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SessionAuthz) String() string {
+func (x *PermissionFeeRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionAuthz) ProtoMessage() {}
+func (*PermissionFeeRecord) ProtoMessage() {}
 
-// Deprecated: Use SessionAuthz.ProtoReflect.Descriptor instead.
-func (*SessionAuthz) Descriptor() ([]byte, []int) {
-	return file_verana_perm_v1_types_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use PermissionFeeRecord.ProtoReflect.Descriptor instead.
+func (*PermissionFeeRecord) Descriptor() ([]byte, []int) {
+	return file_verana_perm_v1_types_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *SessionAuthz) GetExecutorPermId() uint64 {
+func (x *PermissionFeeRecord) GetPermId() uint64 {
 	if x != nil {
-		return x.ExecutorPermId
+		return x.PermId
 	}
 	return 0
 }
 
-func (x *SessionAuthz) GetBeneficiaryPermId() uint64 {
+func (x *PermissionFeeRecord) GetIndex() uint64 {
 	if x != nil {
-		return x.BeneficiaryPermId
+		return x.Index
 	}
 	return 0
 }
 
-func (x *SessionAuthz) GetWalletAgentPermId() uint64 {
+func (x *PermissionFeeRecord) GetFees() *PermissionFees {
 	if x != nil {
-		return x.WalletAgentPermId
+		return x.Fees
 	}
-	return 0
+	return nil
+}
+
+func (x *PermissionFeeRecord) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
 }
 
 var File_verana_perm_v1_types_proto protoreflect.FileDescriptor
@@ -4590,190 +17530,504 @@ var file_verana_perm_v1_types_proto_rawDesc = []byte{
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
 	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xcb, 0x0e, 0x0a, 0x0a, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x49, 0x64, 0x12, 0x32, 0x0a,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x76, 0x65,
-	0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x64, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07,
-	0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x6f, 0x1a, 0x18, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x74, 0x64, 0x2f, 0x76, 0x31, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x83, 0x13, 0x0a, 0x0a,
+	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70,
+	0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x64,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x64, 0x12, 0x32, 0x0a,
+	0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65,
+	0x65, 0x12, 0x3a, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04,
+	0x90, 0xdf, 0x1f, 0x01, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x37, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x3c, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64,
+	0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x12, 0x37, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62,
-	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x3c, 0x0a, 0x08,
-	0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01,
-	0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x0b, 0x65, 0x78,
-	0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65,
+	0x6e, 0x64, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64,
+	0x5f, 0x62, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x42, 0x79, 0x12,
+	0x3a, 0x0a, 0x07, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf,
+	0x1f, 0x01, 0x52, 0x07, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x12, 0x37, 0x0a, 0x0a, 0x73,
+	0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42,
 	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e,
-	0x64, 0x65, 0x64, 0x42, 0x79, 0x12, 0x3a, 0x0a, 0x07, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x07, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65,
-	0x64, 0x12, 0x37, 0x0a, 0x0a, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
-	0x09, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x42, 0x79, 0x12, 0x38, 0x0a, 0x06, 0x72, 0x65,
-	0x70, 0x61, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x06, 0x72, 0x65,
-	0x70, 0x61, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x62,
-	0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x52, 0x08, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x42, 0x79, 0x12, 0x47, 0x0a, 0x0e, 0x65,
-	0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x0e, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
-	0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
-	0x46, 0x72, 0x6f, 0x6d, 0x12, 0x49, 0x0a, 0x0f, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76,
-	0x65, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x73, 0x6c, 0x61, 0x73, 0x68,
+	0x65, 0x64, 0x42, 0x79, 0x12, 0x38, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x06, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x12, 0x35,
+	0x0a, 0x09, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x72, 0x65, 0x70,
+	0x61, 0x69, 0x64, 0x42, 0x79, 0x12, 0x47, 0x0a, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
 	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52,
-	0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12,
-	0x3c, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90,
-	0xdf, 0x1f, 0x01, 0x52, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x27, 0x0a,
-	0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x73,
-	0x18, 0x11, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e,
-	0x63, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x69,
-	0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x65, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x76,
-	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x73,
-	0x18, 0x13, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x64, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x6c, 0x61,
-	0x73, 0x68, 0x65, 0x64, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72,
-	0x65, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x16, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x44, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x12, 0x3a, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x18, 0x17, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
-	0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x12, 0x37,
-	0x0a, 0x0a, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x18, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x72, 0x65,
-	0x76, 0x6f, 0x6b, 0x65, 0x64, 0x42, 0x79, 0x12, 0x40, 0x0a, 0x0a, 0x74, 0x65, 0x72, 0x6d, 0x69,
-	0x6e, 0x61, 0x74, 0x65, 0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x49,
+	0x0a, 0x0f, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x75, 0x6e, 0x74, 0x69,
+	0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x3c, 0x0a, 0x08, 0x6d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0a, 0x74,
-	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x12, 0x3d, 0x0a, 0x0d, 0x74, 0x65, 0x72,
-	0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x08, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65,
+	0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63,
+	0x65, 0x46, 0x65, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65,
+	0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x27, 0x0a, 0x0f,
+	0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x5f,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x72,
+	0x65, 0x70, 0x61, 0x69, 0x64, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x3a, 0x0a, 0x07,
+	0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52,
+	0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x12, 0x37, 0x0a, 0x0a, 0x72, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x42,
+	0x79, 0x12, 0x40, 0x0a, 0x0a, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0a, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x3d, 0x0a, 0x0d, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64,
+	0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x1b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x2a, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x65,
+	0x72, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x08,
+	0x76, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f,
+	0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x07, 0x76, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x76, 0x70, 0x5f, 0x65,
+	0x78, 0x70, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x05, 0x76, 0x70, 0x45, 0x78,
+	0x70, 0x12, 0x51, 0x0a, 0x14, 0x76, 0x70, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f,
+	0x01, 0x52, 0x11, 0x76, 0x70, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x76, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x20, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x12, 0x76, 0x70, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x76, 0x70, 0x5f, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x21, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0d, 0x76, 0x70, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x73, 0x12, 0x2c,
+	0x0a, 0x12, 0x76, 0x70, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x18, 0x22, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x76, 0x70, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x31, 0x0a, 0x15,
+	0x76, 0x70, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73,
+	0x74, 0x5f, 0x73, 0x72, 0x69, 0x18, 0x23, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x76, 0x70, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x53, 0x72, 0x69, 0x12,
+	0x4c, 0x0a, 0x11, 0x76, 0x70, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x65, 0x64, 0x18, 0x24, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0f, 0x76, 0x70,
+	0x54, 0x65, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x3e, 0x0a,
+	0x09, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x25, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf,
+	0x1f, 0x01, 0x52, 0x09, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x3b, 0x0a,
+	0x0c, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x26, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x73,
+	0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x42, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x75,
+	0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x27, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x49, 0x0a, 0x0f, 0x73, 0x75, 0x73, 0x70, 0x65,
+	0x6e, 0x64, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf,
+	0x1f, 0x01, 0x52, 0x0e, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x55, 0x6e, 0x74,
+	0x69, 0x6c, 0x12, 0x31, 0x0a, 0x15, 0x63, 0x6f, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x29, 0x20, 0x03, 0x28,
+	0x04, 0x52, 0x12, 0x63, 0x6f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x65,
+	0x72, 0x6d, 0x49, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x70, 0x5f, 0x71, 0x75, 0x6f, 0x72,
+	0x75, 0x6d, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x76, 0x70, 0x51, 0x75, 0x6f, 0x72,
+	0x75, 0x6d, 0x12, 0x43, 0x0a, 0x0c, 0x76, 0x70, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x73, 0x18, 0x2b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e,
+	0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x50, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x61, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x76, 0x70, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x12, 0x61, 0x0a, 0x18, 0x76, 0x70, 0x5f, 0x63, 0x6f,
+	0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x73, 0x18, 0x2c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x76, 0x65, 0x72, 0x61,
+	0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x15, 0x76, 0x70, 0x43, 0x6f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x12, 0x45, 0x0a, 0x0e, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x2d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x65,
+	0x65, 0x73, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x46, 0x65, 0x65,
+	0x73, 0x22, 0xd1, 0x02, 0x0a, 0x11, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0d, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x5f, 0x70, 0x65, 0x72, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x05, 0x61,
+	0x75, 0x74, 0x68, 0x7a, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x76, 0x65, 0x72,
+	0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x7a, 0x52, 0x05, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x12,
+	0x3a, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf,
+	0x1f, 0x01, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x08, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52,
+	0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x07, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x07, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x41, 0x75, 0x74, 0x68, 0x7a, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x6f, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x49, 0x64,
+	0x12, 0x2e, 0x0a, 0x13, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x5f,
+	0x70, 0x65, 0x72, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x62,
+	0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x50, 0x65, 0x72, 0x6d, 0x49, 0x64,
+	0x12, 0x2f, 0x0a, 0x14, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x5f, 0x70, 0x65, 0x72, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x49,
+	0x64, 0x22, 0xc2, 0x03, 0x0a, 0x10, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c,
+	0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72,
+	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x49, 0x64, 0x12, 0x22, 0x0a,
+	0x0d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x49,
+	0x64, 0x12, 0x28, 0x0a, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5f, 0x70, 0x65,
+	0x72, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x76, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65,
+	0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x12,
+	0x3a, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf,
+	0x1f, 0x01, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x08, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52,
+	0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x43, 0x0a, 0x10, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x26,
+	0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x72, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xb1, 0x06, 0x0a, 0x0b, 0x53, 0x6c, 0x61, 0x73, 0x68,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x6d, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x65, 0x72, 0x6d, 0x49, 0x64, 0x12,
+	0x32, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
 	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x74, 0x65, 0x72, 0x6d,
-	0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x72, 0x79, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x2a, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f,
-	0x70, 0x65, 0x72, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x49, 0x64, 0x12, 0x3a,
-	0x0a, 0x08, 0x76, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x1f, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76,
-	0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x52, 0x07, 0x76, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x76, 0x70,
-	0x5f, 0x65, 0x78, 0x70, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e,
+	0x74, 0x65, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x0a, 0x73,
+	0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x73, 0x6c, 0x61, 0x73, 0x68,
+	0x65, 0x64, 0x42, 0x79, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65,
+	0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3a, 0x0a, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x05, 0x76, 0x70,
-	0x45, 0x78, 0x70, 0x12, 0x51, 0x0a, 0x14, 0x76, 0x70, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x1f, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90,
-	0xdf, 0x1f, 0x01, 0x52, 0x11, 0x76, 0x70, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x76, 0x70, 0x5f, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x20,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x76, 0x70, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x76, 0x70, 0x5f, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x21, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0d, 0x76, 0x70, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x73,
-	0x12, 0x2c, 0x0a, 0x12, 0x76, 0x70, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x64,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x22, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x76, 0x70,
-	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x31,
-	0x0a, 0x15, 0x76, 0x70, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x69, 0x67,
-	0x65, 0x73, 0x74, 0x5f, 0x73, 0x72, 0x69, 0x18, 0x23, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x76,
-	0x70, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x53, 0x72,
-	0x69, 0x12, 0x4c, 0x0a, 0x11, 0x76, 0x70, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x5f, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x24, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0f,
-	0x76, 0x70, 0x54, 0x65, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x22,
-	0x95, 0x02, 0x0a, 0x11, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
-	0x6c, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x70,
-	0x65, 0x72, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x61, 0x67,
-	0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x05, 0x61, 0x75, 0x74,
-	0x68, 0x7a, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e,
-	0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x41, 0x75, 0x74, 0x68, 0x7a, 0x52, 0x05, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x12, 0x3a, 0x0a,
-	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x49, 0x0a, 0x0f, 0x61, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x5f,
+	0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
 	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01,
-	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x08, 0x6d, 0x6f, 0x64,
-	0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x52, 0x0e, 0x61, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
+	0x12, 0x3c, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04,
+	0x90, 0xdf, 0x1f, 0x01, 0x52, 0x08, 0x61, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x12, 0x2a,
+	0x0a, 0x11, 0x61, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x5f,
+	0x73, 0x72, 0x69, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x70, 0x70, 0x65, 0x61,
+	0x6c, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x53, 0x72, 0x69, 0x12, 0x3c, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x08,
+	0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x64, 0x42, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x76, 0x69, 0x64,
+	0x65, 0x6e, 0x63, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x2e, 0x0a, 0x13, 0x65, 0x76, 0x69, 0x64, 0x65,
+	0x6e, 0x63, 0x65, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x72, 0x69, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x44, 0x69,
+	0x67, 0x65, 0x73, 0x74, 0x53, 0x72, 0x69, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x66, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x67, 0x66, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76,
+	0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x74, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6c, 0x61, 0x73,
+	0x68, 0x53, 0x68, 0x61, 0x72, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x64, 0x69,
+	0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x79, 0x0a, 0x1e, 0x54, 0x72,
+	0x75, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x53, 0x6c, 0x61, 0x73, 0x68,
+	0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x0a, 0x05,
+	0x74, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x72, 0x49,
+	0x64, 0x12, 0x42, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61,
+	0x2e, 0x74, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9e, 0x02, 0x0a, 0x11, 0x52, 0x65, 0x76, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x73, 0x63, 0x61, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70,
+	0x65, 0x72, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x65,
+	0x72, 0x6d, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x70,
+	0x65, 0x72, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x50, 0x65, 0x72, 0x6d, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x04, 0x6d, 0x6f,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e,
+	0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x73, 0x63, 0x61, 0x64,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x39, 0x0a, 0x0b, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x3a, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64,
+	0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x43,
+	0x68, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x22, 0xee, 0x01, 0x0a, 0x0b, 0x41, 0x67, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x6d, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x65, 0x72, 0x6d, 0x49, 0x64, 0x12,
+	0x2c, 0x0a, 0x12, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x75, 0x73, 0x65,
+	0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x30, 0x0a,
+	0x14, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x72, 0x75, 0x73, 0x74, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x12, 0x41, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0a, 0x6c, 0x61, 0x73,
+	0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x22, 0x8d, 0x04, 0x0a, 0x12, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e,
+	0x0a, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x12, 0x28,
+	0x0a, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x49, 0x64, 0x12, 0x28,
+	0x0a, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x5f,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x6f, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x72, 0x63,
+	0x68, 0x61, 0x73, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x75, 0x72,
+	0x63, 0x68, 0x61, 0x73, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x66, 0x65, 0x65,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x74, 0x46, 0x65, 0x65,
+	0x73, 0x12, 0x2c, 0x0a, 0x12, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x75,
+	0x6e, 0x69, 0x74, 0x54, 0x72, 0x75, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12,
+	0x3a, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf,
+	0x1f, 0x01, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x08, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52,
+	0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x08, 0x72, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x08, 0x6d,
-	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x22, 0x99, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x7a, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x78, 0x65, 0x63,
-	0x75, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x6d,
-	0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72,
-	0x79, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x11, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x50, 0x65, 0x72, 0x6d,
-	0x49, 0x64, 0x12, 0x2f, 0x0a, 0x14, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x67, 0x65,
-	0x6e, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x11, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72,
-	0x6d, 0x49, 0x64, 0x2a, 0xf0, 0x01, 0x0a, 0x0e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53,
-	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x45, 0x52, 0x4d, 0x49,
-	0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x53, 0x53, 0x55, 0x45,
-	0x52, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f,
-	0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x45, 0x52, 0x10,
-	0x02, 0x12, 0x22, 0x0a, 0x1e, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x53, 0x53, 0x55, 0x45, 0x52, 0x5f, 0x47, 0x52, 0x41, 0x4e,
-	0x54, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x24, 0x0a, 0x20, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x08, 0x72,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x22, 0xee, 0x01, 0x0a, 0x0e, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x10,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72,
+	0x50, 0x65, 0x72, 0x6d, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69,
+	0x63, 0x69, 0x61, 0x72, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x11, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79,
+	0x50, 0x65, 0x72, 0x6d, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f,
+	0x70, 0x65, 0x72, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x72, 0x0a, 0x15, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x44, 0x6f,
+	0x63, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x07,
+	0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x07, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x22, 0x77, 0x0a, 0x0c,
+	0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x06,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e,
+	0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x64, 0x4e,
+	0x6f, 0x6e, 0x63, 0x65, 0x73, 0x22, 0xec, 0x01, 0x0a, 0x09, 0x56, 0x50, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x65, 0x72, 0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x73,
+	0x72, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x53, 0x72, 0x69, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64,
+	0x5f, 0x75, 0x72, 0x69, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x65, 0x64, 0x55, 0x72, 0x69, 0x12, 0x3a, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x22, 0xc1, 0x03, 0x0a, 0x0a, 0x56, 0x50, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x70, 0x65, 0x72, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x49, 0x64, 0x12,
+	0x34, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x61, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x49, 0x0a, 0x0f, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01,
+	0x52, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c,
+	0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66,
+	0x65, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x73,
+	0x75, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x65, 0x65, 0x73, 0x12, 0x2b,
+	0x0a, 0x11, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66,
+	0x65, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x76, 0x70, 0x5f,
+	0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x73,
+	0x72, 0x69, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x76, 0x70, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x53, 0x72, 0x69, 0x12, 0x3a, 0x0a, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x5a, 0x0a, 0x12, 0x43, 0x6f, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x2a,
+	0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x22, 0xd4, 0x01, 0x0a, 0x0e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63,
+	0x65, 0x46, 0x65, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65,
+	0x65, 0x73, 0x12, 0x47, 0x0a, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0d, 0x65, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x22, 0xb7, 0x01, 0x0a, 0x13,
+	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x65, 0x72, 0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x38, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x73,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x66, 0x65, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x42, 0x79, 0x2a, 0xf0, 0x01, 0x0a, 0x0e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x45, 0x52, 0x4d,
+	0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x45, 0x52,
+	0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x53, 0x53,
+	0x55, 0x45, 0x52, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53,
 	0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x45,
-	0x52, 0x5f, 0x47, 0x52, 0x41, 0x4e, 0x54, 0x4f, 0x52, 0x10, 0x04, 0x12, 0x1d, 0x0a, 0x19, 0x50,
-	0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45,
-	0x43, 0x4f, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x10, 0x05, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x45,
-	0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x48, 0x4f,
-	0x4c, 0x44, 0x45, 0x52, 0x10, 0x06, 0x2a, 0xbe, 0x01, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x56, 0x41,
-	0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55,
-	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18,
+	0x52, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f,
+	0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x53, 0x53, 0x55, 0x45, 0x52, 0x5f, 0x47, 0x52,
+	0x41, 0x4e, 0x54, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x24, 0x0a, 0x20, 0x50, 0x45, 0x52, 0x4d, 0x49,
+	0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x45, 0x52, 0x49, 0x46,
+	0x49, 0x45, 0x52, 0x5f, 0x47, 0x52, 0x41, 0x4e, 0x54, 0x4f, 0x52, 0x10, 0x04, 0x12, 0x1d, 0x0a,
+	0x19, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x45, 0x43, 0x4f, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x10, 0x05, 0x12, 0x1a, 0x0a, 0x16,
+	0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x48, 0x4f, 0x4c, 0x44, 0x45, 0x52, 0x10, 0x06, 0x2a, 0xbe, 0x01, 0x0a, 0x0f, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x1c,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c,
+	0x0a, 0x18, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b,
 	0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
-	0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x56, 0x41,
-	0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x56,
-	0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x56, 0x41,
-	0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x54,
-	0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x2a, 0x0a, 0x26, 0x56,
-	0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
-	0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x51, 0x55,
-	0x45, 0x53, 0x54, 0x45, 0x44, 0x10, 0x04, 0x42, 0xbe, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e,
-	0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x0a,
-	0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x42, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2d,
-	0x6c, 0x61, 0x62, 0x73, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2d, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e,
-	0x61, 0x2f, 0x70, 0x65, 0x72, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x65, 0x72, 0x6d, 0x76, 0x31,
-	0xa2, 0x02, 0x03, 0x56, 0x50, 0x58, 0xaa, 0x02, 0x0e, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e,
-	0x50, 0x65, 0x72, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61,
-	0x5c, 0x50, 0x65, 0x72, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x56, 0x65, 0x72, 0x61, 0x6e,
-	0x61, 0x5c, 0x50, 0x65, 0x72, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x3a, 0x3a,
-	0x50, 0x65, 0x72, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x5f, 0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x2a, 0x0a,
+	0x26, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45,
+	0x51, 0x55, 0x45, 0x53, 0x54, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x96, 0x01, 0x0a, 0x0b, 0x53, 0x6c,
+	0x61, 0x73, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x4c, 0x41,
+	0x53, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x4c, 0x41, 0x53, 0x48,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10,
+	0x01, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x4c, 0x41, 0x53, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x41, 0x50, 0x50, 0x45, 0x41, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15,
+	0x53, 0x4c, 0x41, 0x53, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58, 0x45,
+	0x43, 0x55, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x4c, 0x41, 0x53, 0x48,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x56, 0x45, 0x52, 0x53, 0x45, 0x44,
+	0x10, 0x04, 0x2a, 0x57, 0x0a, 0x0b, 0x43, 0x61, 0x73, 0x63, 0x61, 0x64, 0x65, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x41, 0x53, 0x43, 0x41, 0x44, 0x45, 0x5f, 0x4d, 0x4f, 0x44,
+	0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x41, 0x53, 0x43,
+	0x41, 0x44, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x56, 0x4f, 0x4b, 0x45, 0x10,
+	0x01, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x41, 0x53, 0x43, 0x41, 0x44, 0x45, 0x5f, 0x4d, 0x4f, 0x44,
+	0x45, 0x5f, 0x53, 0x55, 0x53, 0x50, 0x45, 0x4e, 0x44, 0x10, 0x02, 0x42, 0xbe, 0x01, 0x0a, 0x12,
+	0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x2e,
+	0x76, 0x31, 0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x42, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x65, 0x72,
+	0x61, 0x6e, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2d,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x70, 0x65, 0x72, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x65,
+	0x72, 0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x56, 0x50, 0x58, 0xaa, 0x02, 0x0e, 0x56, 0x65, 0x72,
+	0x61, 0x6e, 0x61, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x56, 0x65,
+	0x72, 0x61, 0x6e, 0x61, 0x5c, 0x50, 0x65, 0x72, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x56,
+	0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x50, 0x65, 0x72, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x56, 0x65, 0x72, 0x61,
+	0x6e, 0x61, 0x3a, 0x3a, 0x50, 0x65, 0x72, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4788,39 +18042,83 @@ func file_verana_perm_v1_types_proto_rawDescGZIP() []byte {
 	return file_verana_perm_v1_types_proto_rawDescData
 }
 
-var file_verana_perm_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_verana_perm_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_verana_perm_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_verana_perm_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_verana_perm_v1_types_proto_goTypes = []interface{}{
-	(PermissionType)(0),           // 0: verana.perm.v1.PermissionType
-	(ValidationState)(0),          // 1: verana.perm.v1.ValidationState
-	(*Permission)(nil),            // 2: verana.perm.v1.Permission
-	(*PermissionSession)(nil),     // 3: verana.perm.v1.PermissionSession
-	(*SessionAuthz)(nil),          // 4: verana.perm.v1.SessionAuthz
-	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+	(PermissionType)(0),                    // 0: verana.perm.v1.PermissionType
+	(ValidationState)(0),                   // 1: verana.perm.v1.ValidationState
+	(SlashStatus)(0),                       // 2: verana.perm.v1.SlashStatus
+	(CascadeMode)(0),                       // 3: verana.perm.v1.CascadeMode
+	(*Permission)(nil),                     // 4: verana.perm.v1.Permission
+	(*PermissionSession)(nil),              // 5: verana.perm.v1.PermissionSession
+	(*SessionAuthz)(nil),                   // 6: verana.perm.v1.SessionAuthz
+	(*SessionAllowance)(nil),               // 7: verana.perm.v1.SessionAllowance
+	(*SlashRecord)(nil),                    // 8: verana.perm.v1.SlashRecord
+	(*TrustRegistrySlashDistribution)(nil), // 9: verana.perm.v1.TrustRegistrySlashDistribution
+	(*RevocationCascade)(nil),              // 10: verana.perm.v1.RevocationCascade
+	(*AgentReward)(nil),                    // 11: verana.perm.v1.AgentReward
+	(*VerificationCredit)(nil),             // 12: verana.perm.v1.VerificationCredit
+	(*SessionVoucher)(nil),                 // 13: verana.perm.v1.SessionVoucher
+	(*SessionVoucherSignDoc)(nil),          // 14: verana.perm.v1.SessionVoucherSignDoc
+	(*VoucherNonce)(nil),                   // 15: verana.perm.v1.VoucherNonce
+	(*VPMessage)(nil),                      // 16: verana.perm.v1.VPMessage
+	(*VPApproval)(nil),                     // 17: verana.perm.v1.VPApproval
+	(*CoValidatorDeposit)(nil),             // 18: verana.perm.v1.CoValidatorDeposit
+	(*PermissionFees)(nil),                 // 19: verana.perm.v1.PermissionFees
+	(*PermissionFeeRecord)(nil),            // 20: verana.perm.v1.PermissionFeeRecord
+	(*timestamppb.Timestamp)(nil),          // 21: google.protobuf.Timestamp
+	(*v1.SlashShare)(nil),                  // 22: verana.td.v1.SlashShare
 }
 var file_verana_perm_v1_types_proto_depIdxs = []int32{
 	0,  // 0: verana.perm.v1.Permission.type:type_name -> verana.perm.v1.PermissionType
-	5,  // 1: verana.perm.v1.Permission.created:type_name -> google.protobuf.Timestamp
-	5,  // 2: verana.perm.v1.Permission.extended:type_name -> google.protobuf.Timestamp
-	5,  // 3: verana.perm.v1.Permission.slashed:type_name -> google.protobuf.Timestamp
-	5,  // 4: verana.perm.v1.Permission.repaid:type_name -> google.protobuf.Timestamp
-	5,  // 5: verana.perm.v1.Permission.effective_from:type_name -> google.protobuf.Timestamp
-	5,  // 6: verana.perm.v1.Permission.effective_until:type_name -> google.protobuf.Timestamp
-	5,  // 7: verana.perm.v1.Permission.modified:type_name -> google.protobuf.Timestamp
-	5,  // 8: verana.perm.v1.Permission.revoked:type_name -> google.protobuf.Timestamp
-	5,  // 9: verana.perm.v1.Permission.terminated:type_name -> google.protobuf.Timestamp
+	21, // 1: verana.perm.v1.Permission.created:type_name -> google.protobuf.Timestamp
+	21, // 2: verana.perm.v1.Permission.extended:type_name -> google.protobuf.Timestamp
+	21, // 3: verana.perm.v1.Permission.slashed:type_name -> google.protobuf.Timestamp
+	21, // 4: verana.perm.v1.Permission.repaid:type_name -> google.protobuf.Timestamp
+	21, // 5: verana.perm.v1.Permission.effective_from:type_name -> google.protobuf.Timestamp
+	21, // 6: verana.perm.v1.Permission.effective_until:type_name -> google.protobuf.Timestamp
+	21, // 7: verana.perm.v1.Permission.modified:type_name -> google.protobuf.Timestamp
+	21, // 8: verana.perm.v1.Permission.revoked:type_name -> google.protobuf.Timestamp
+	21, // 9: verana.perm.v1.Permission.terminated:type_name -> google.protobuf.Timestamp
 	1,  // 10: verana.perm.v1.Permission.vp_state:type_name -> verana.perm.v1.ValidationState
-	5,  // 11: verana.perm.v1.Permission.vp_exp:type_name -> google.protobuf.Timestamp
-	5,  // 12: verana.perm.v1.Permission.vp_last_state_change:type_name -> google.protobuf.Timestamp
-	5,  // 13: verana.perm.v1.Permission.vp_term_requested:type_name -> google.protobuf.Timestamp
-	4,  // 14: verana.perm.v1.PermissionSession.authz:type_name -> verana.perm.v1.SessionAuthz
-	5,  // 15: verana.perm.v1.PermissionSession.created:type_name -> google.protobuf.Timestamp
-	5,  // 16: verana.perm.v1.PermissionSession.modified:type_name -> google.protobuf.Timestamp
-	17, // [17:17] is the sub-list for method output_type
-	17, // [17:17] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	21, // 11: verana.perm.v1.Permission.vp_exp:type_name -> google.protobuf.Timestamp
+	21, // 12: verana.perm.v1.Permission.vp_last_state_change:type_name -> google.protobuf.Timestamp
+	21, // 13: verana.perm.v1.Permission.vp_term_requested:type_name -> google.protobuf.Timestamp
+	21, // 14: verana.perm.v1.Permission.suspended:type_name -> google.protobuf.Timestamp
+	21, // 15: verana.perm.v1.Permission.suspended_until:type_name -> google.protobuf.Timestamp
+	17, // 16: verana.perm.v1.Permission.vp_approvals:type_name -> verana.perm.v1.VPApproval
+	18, // 17: verana.perm.v1.Permission.vp_co_validator_deposits:type_name -> verana.perm.v1.CoValidatorDeposit
+	19, // 18: verana.perm.v1.Permission.scheduled_fees:type_name -> verana.perm.v1.PermissionFees
+	6,  // 19: verana.perm.v1.PermissionSession.authz:type_name -> verana.perm.v1.SessionAuthz
+	21, // 20: verana.perm.v1.PermissionSession.created:type_name -> google.protobuf.Timestamp
+	21, // 21: verana.perm.v1.PermissionSession.modified:type_name -> google.protobuf.Timestamp
+	21, // 22: verana.perm.v1.PermissionSession.expires:type_name -> google.protobuf.Timestamp
+	21, // 23: verana.perm.v1.SessionAllowance.created:type_name -> google.protobuf.Timestamp
+	21, // 24: verana.perm.v1.SessionAllowance.modified:type_name -> google.protobuf.Timestamp
+	2,  // 25: verana.perm.v1.SlashRecord.status:type_name -> verana.perm.v1.SlashStatus
+	21, // 26: verana.perm.v1.SlashRecord.created:type_name -> google.protobuf.Timestamp
+	21, // 27: verana.perm.v1.SlashRecord.appeal_deadline:type_name -> google.protobuf.Timestamp
+	21, // 28: verana.perm.v1.SlashRecord.appealed:type_name -> google.protobuf.Timestamp
+	21, // 29: verana.perm.v1.SlashRecord.resolved:type_name -> google.protobuf.Timestamp
+	22, // 30: verana.perm.v1.SlashRecord.distribution:type_name -> verana.td.v1.SlashShare
+	22, // 31: verana.perm.v1.TrustRegistrySlashDistribution.distribution:type_name -> verana.td.v1.SlashShare
+	3,  // 32: verana.perm.v1.RevocationCascade.mode:type_name -> verana.perm.v1.CascadeMode
+	21, // 33: verana.perm.v1.RevocationCascade.created:type_name -> google.protobuf.Timestamp
+	21, // 34: verana.perm.v1.AgentReward.last_reward:type_name -> google.protobuf.Timestamp
+	21, // 35: verana.perm.v1.VerificationCredit.created:type_name -> google.protobuf.Timestamp
+	21, // 36: verana.perm.v1.VerificationCredit.modified:type_name -> google.protobuf.Timestamp
+	21, // 37: verana.perm.v1.VerificationCredit.refunded:type_name -> google.protobuf.Timestamp
+	13, // 38: verana.perm.v1.SessionVoucherSignDoc.voucher:type_name -> verana.perm.v1.SessionVoucher
+	21, // 39: verana.perm.v1.VPMessage.created:type_name -> google.protobuf.Timestamp
+	21, // 40: verana.perm.v1.VPApproval.effective_until:type_name -> google.protobuf.Timestamp
+	21, // 41: verana.perm.v1.VPApproval.created:type_name -> google.protobuf.Timestamp
+	21, // 42: verana.perm.v1.PermissionFees.effective_from:type_name -> google.protobuf.Timestamp
+	19, // 43: verana.perm.v1.PermissionFeeRecord.fees:type_name -> verana.perm.v1.PermissionFees
+	44, // [44:44] is the sub-list for method output_type
+	44, // [44:44] is the sub-list for method input_type
+	44, // [44:44] is the sub-list for extension type_name
+	44, // [44:44] is the sub-list for extension extendee
+	0,  // [0:44] is the sub-list for field type_name
 }
 
 func init() { file_verana_perm_v1_types_proto_init() }
@@ -4865,14 +18163,182 @@ func file_verana_perm_v1_types_proto_init() {
 				return nil
 			}
 		}
+		file_verana_perm_v1_types_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionAllowance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_types_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlashRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_types_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrustRegistrySlashDistribution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_types_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevocationCascade); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_types_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentReward); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_types_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerificationCredit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_types_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionVoucher); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_types_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionVoucherSignDoc); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_types_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoucherNonce); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_types_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VPMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_types_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VPApproval); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_types_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CoValidatorDeposit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_types_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PermissionFees); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_verana_perm_v1_types_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PermissionFeeRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_verana_perm_v1_types_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   3,
+			NumEnums:      4,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -9,6 +9,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
@@ -65,10 +66,274 @@ func (x *_GenesisState_2_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_3_list)(nil)
+
+type _GenesisState_3_list struct {
+	list *[]*SlashDistribution
+}
+
+func (x *_GenesisState_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SlashDistribution)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SlashDistribution)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_3_list) AppendMutable() protoreflect.Value {
+	v := new(SlashDistribution)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_3_list) NewElement() protoreflect.Value {
+	v := new(SlashDistribution)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*TrustDepositUnbonding
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TrustDepositUnbonding)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TrustDepositUnbonding)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(TrustDepositUnbonding)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(TrustDepositUnbonding)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*YieldRecord
+}
+
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*YieldRecord)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*YieldRecord)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(YieldRecord)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(YieldRecord)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*TrustDepositDelegation
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TrustDepositDelegation)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TrustDepositDelegation)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(TrustDepositDelegation)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(TrustDepositDelegation)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_11_list)(nil)
+
+type _GenesisState_11_list struct {
+	list *[]*TrustDepositRewardRatio
+}
+
+func (x *_GenesisState_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TrustDepositRewardRatio)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TrustDepositRewardRatio)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_11_list) AppendMutable() protoreflect.Value {
+	v := new(TrustDepositRewardRatio)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_11_list) NewElement() protoreflect.Value {
+	v := new(TrustDepositRewardRatio)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState                protoreflect.MessageDescriptor
-	fd_GenesisState_params         protoreflect.FieldDescriptor
-	fd_GenesisState_trust_deposits protoreflect.FieldDescriptor
+	md_GenesisState                            protoreflect.MessageDescriptor
+	fd_GenesisState_params                     protoreflect.FieldDescriptor
+	fd_GenesisState_trust_deposits             protoreflect.FieldDescriptor
+	fd_GenesisState_slash_distributions        protoreflect.FieldDescriptor
+	fd_GenesisState_next_slash_distribution_id protoreflect.FieldDescriptor
+	fd_GenesisState_unbondings                 protoreflect.FieldDescriptor
+	fd_GenesisState_next_unbonding_id          protoreflect.FieldDescriptor
+	fd_GenesisState_yield_records              protoreflect.FieldDescriptor
+	fd_GenesisState_next_yield_record_id       protoreflect.FieldDescriptor
+	fd_GenesisState_yield_reserve              protoreflect.FieldDescriptor
+	fd_GenesisState_delegations                protoreflect.FieldDescriptor
+	fd_GenesisState_reward_ratios              protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -76,6 +341,15 @@ func init() {
 	md_GenesisState = File_verana_td_v1_genesis_proto.Messages().ByName("GenesisState")
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
 	fd_GenesisState_trust_deposits = md_GenesisState.Fields().ByName("trust_deposits")
+	fd_GenesisState_slash_distributions = md_GenesisState.Fields().ByName("slash_distributions")
+	fd_GenesisState_next_slash_distribution_id = md_GenesisState.Fields().ByName("next_slash_distribution_id")
+	fd_GenesisState_unbondings = md_GenesisState.Fields().ByName("unbondings")
+	fd_GenesisState_next_unbonding_id = md_GenesisState.Fields().ByName("next_unbonding_id")
+	fd_GenesisState_yield_records = md_GenesisState.Fields().ByName("yield_records")
+	fd_GenesisState_next_yield_record_id = md_GenesisState.Fields().ByName("next_yield_record_id")
+	fd_GenesisState_yield_reserve = md_GenesisState.Fields().ByName("yield_reserve")
+	fd_GenesisState_delegations = md_GenesisState.Fields().ByName("delegations")
+	fd_GenesisState_reward_ratios = md_GenesisState.Fields().ByName("reward_ratios")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -155,6 +429,60 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.SlashDistributions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_3_list{list: &x.SlashDistributions})
+		if !f(fd_GenesisState_slash_distributions, value) {
+			return
+		}
+	}
+	if x.NextSlashDistributionId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextSlashDistributionId)
+		if !f(fd_GenesisState_next_slash_distribution_id, value) {
+			return
+		}
+	}
+	if len(x.Unbondings) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.Unbondings})
+		if !f(fd_GenesisState_unbondings, value) {
+			return
+		}
+	}
+	if x.NextUnbondingId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextUnbondingId)
+		if !f(fd_GenesisState_next_unbonding_id, value) {
+			return
+		}
+	}
+	if len(x.YieldRecords) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.YieldRecords})
+		if !f(fd_GenesisState_yield_records, value) {
+			return
+		}
+	}
+	if x.NextYieldRecordId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextYieldRecordId)
+		if !f(fd_GenesisState_next_yield_record_id, value) {
+			return
+		}
+	}
+	if x.YieldReserve != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.YieldReserve)
+		if !f(fd_GenesisState_yield_reserve, value) {
+			return
+		}
+	}
+	if len(x.Delegations) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.Delegations})
+		if !f(fd_GenesisState_delegations, value) {
+			return
+		}
+	}
+	if len(x.RewardRatios) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_11_list{list: &x.RewardRatios})
+		if !f(fd_GenesisState_reward_ratios, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -174,6 +502,24 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return x.Params != nil
 	case "verana.td.v1.GenesisState.trust_deposits":
 		return len(x.TrustDeposits) != 0
+	case "verana.td.v1.GenesisState.slash_distributions":
+		return len(x.SlashDistributions) != 0
+	case "verana.td.v1.GenesisState.next_slash_distribution_id":
+		return x.NextSlashDistributionId != uint64(0)
+	case "verana.td.v1.GenesisState.unbondings":
+		return len(x.Unbondings) != 0
+	case "verana.td.v1.GenesisState.next_unbonding_id":
+		return x.NextUnbondingId != uint64(0)
+	case "verana.td.v1.GenesisState.yield_records":
+		return len(x.YieldRecords) != 0
+	case "verana.td.v1.GenesisState.next_yield_record_id":
+		return x.NextYieldRecordId != uint64(0)
+	case "verana.td.v1.GenesisState.yield_reserve":
+		return x.YieldReserve != uint64(0)
+	case "verana.td.v1.GenesisState.delegations":
+		return len(x.Delegations) != 0
+	case "verana.td.v1.GenesisState.reward_ratios":
+		return len(x.RewardRatios) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.GenesisState"))
@@ -194,6 +540,24 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.Params = nil
 	case "verana.td.v1.GenesisState.trust_deposits":
 		x.TrustDeposits = nil
+	case "verana.td.v1.GenesisState.slash_distributions":
+		x.SlashDistributions = nil
+	case "verana.td.v1.GenesisState.next_slash_distribution_id":
+		x.NextSlashDistributionId = uint64(0)
+	case "verana.td.v1.GenesisState.unbondings":
+		x.Unbondings = nil
+	case "verana.td.v1.GenesisState.next_unbonding_id":
+		x.NextUnbondingId = uint64(0)
+	case "verana.td.v1.GenesisState.yield_records":
+		x.YieldRecords = nil
+	case "verana.td.v1.GenesisState.next_yield_record_id":
+		x.NextYieldRecordId = uint64(0)
+	case "verana.td.v1.GenesisState.yield_reserve":
+		x.YieldReserve = uint64(0)
+	case "verana.td.v1.GenesisState.delegations":
+		x.Delegations = nil
+	case "verana.td.v1.GenesisState.reward_ratios":
+		x.RewardRatios = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.GenesisState"))
@@ -219,6 +583,48 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 		}
 		listValue := &_GenesisState_2_list{list: &x.TrustDeposits}
 		return protoreflect.ValueOfList(listValue)
+	case "verana.td.v1.GenesisState.slash_distributions":
+		if len(x.SlashDistributions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_3_list{})
+		}
+		listValue := &_GenesisState_3_list{list: &x.SlashDistributions}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.td.v1.GenesisState.next_slash_distribution_id":
+		value := x.NextSlashDistributionId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.td.v1.GenesisState.unbondings":
+		if len(x.Unbondings) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.Unbondings}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.td.v1.GenesisState.next_unbonding_id":
+		value := x.NextUnbondingId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.td.v1.GenesisState.yield_records":
+		if len(x.YieldRecords) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.YieldRecords}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.td.v1.GenesisState.next_yield_record_id":
+		value := x.NextYieldRecordId
+		return protoreflect.ValueOfUint64(value)
+	case "verana.td.v1.GenesisState.yield_reserve":
+		value := x.YieldReserve
+		return protoreflect.ValueOfUint64(value)
+	case "verana.td.v1.GenesisState.delegations":
+		if len(x.Delegations) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.Delegations}
+		return protoreflect.ValueOfList(listValue)
+	case "verana.td.v1.GenesisState.reward_ratios":
+		if len(x.RewardRatios) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_11_list{})
+		}
+		listValue := &_GenesisState_11_list{list: &x.RewardRatios}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.GenesisState"))
@@ -245,6 +651,34 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		lv := value.List()
 		clv := lv.(*_GenesisState_2_list)
 		x.TrustDeposits = *clv.list
+	case "verana.td.v1.GenesisState.slash_distributions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_3_list)
+		x.SlashDistributions = *clv.list
+	case "verana.td.v1.GenesisState.next_slash_distribution_id":
+		x.NextSlashDistributionId = value.Uint()
+	case "verana.td.v1.GenesisState.unbondings":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.Unbondings = *clv.list
+	case "verana.td.v1.GenesisState.next_unbonding_id":
+		x.NextUnbondingId = value.Uint()
+	case "verana.td.v1.GenesisState.yield_records":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.YieldRecords = *clv.list
+	case "verana.td.v1.GenesisState.next_yield_record_id":
+		x.NextYieldRecordId = value.Uint()
+	case "verana.td.v1.GenesisState.yield_reserve":
+		x.YieldReserve = value.Uint()
+	case "verana.td.v1.GenesisState.delegations":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.Delegations = *clv.list
+	case "verana.td.v1.GenesisState.reward_ratios":
+		lv := value.List()
+		clv := lv.(*_GenesisState_11_list)
+		x.RewardRatios = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.GenesisState"))
@@ -276,6 +710,44 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_2_list{list: &x.TrustDeposits}
 		return protoreflect.ValueOfList(value)
+	case "verana.td.v1.GenesisState.slash_distributions":
+		if x.SlashDistributions == nil {
+			x.SlashDistributions = []*SlashDistribution{}
+		}
+		value := &_GenesisState_3_list{list: &x.SlashDistributions}
+		return protoreflect.ValueOfList(value)
+	case "verana.td.v1.GenesisState.unbondings":
+		if x.Unbondings == nil {
+			x.Unbondings = []*TrustDepositUnbonding{}
+		}
+		value := &_GenesisState_5_list{list: &x.Unbondings}
+		return protoreflect.ValueOfList(value)
+	case "verana.td.v1.GenesisState.yield_records":
+		if x.YieldRecords == nil {
+			x.YieldRecords = []*YieldRecord{}
+		}
+		value := &_GenesisState_7_list{list: &x.YieldRecords}
+		return protoreflect.ValueOfList(value)
+	case "verana.td.v1.GenesisState.delegations":
+		if x.Delegations == nil {
+			x.Delegations = []*TrustDepositDelegation{}
+		}
+		value := &_GenesisState_10_list{list: &x.Delegations}
+		return protoreflect.ValueOfList(value)
+	case "verana.td.v1.GenesisState.reward_ratios":
+		if x.RewardRatios == nil {
+			x.RewardRatios = []*TrustDepositRewardRatio{}
+		}
+		value := &_GenesisState_11_list{list: &x.RewardRatios}
+		return protoreflect.ValueOfList(value)
+	case "verana.td.v1.GenesisState.next_slash_distribution_id":
+		panic(fmt.Errorf("field next_slash_distribution_id of message verana.td.v1.GenesisState is not mutable"))
+	case "verana.td.v1.GenesisState.next_unbonding_id":
+		panic(fmt.Errorf("field next_unbonding_id of message verana.td.v1.GenesisState is not mutable"))
+	case "verana.td.v1.GenesisState.next_yield_record_id":
+		panic(fmt.Errorf("field next_yield_record_id of message verana.td.v1.GenesisState is not mutable"))
+	case "verana.td.v1.GenesisState.yield_reserve":
+		panic(fmt.Errorf("field yield_reserve of message verana.td.v1.GenesisState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.GenesisState"))
@@ -295,6 +767,29 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "verana.td.v1.GenesisState.trust_deposits":
 		list := []*TrustDepositRecord{}
 		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
+	case "verana.td.v1.GenesisState.slash_distributions":
+		list := []*SlashDistribution{}
+		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
+	case "verana.td.v1.GenesisState.next_slash_distribution_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.td.v1.GenesisState.unbondings":
+		list := []*TrustDepositUnbonding{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "verana.td.v1.GenesisState.next_unbonding_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.td.v1.GenesisState.yield_records":
+		list := []*YieldRecord{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+	case "verana.td.v1.GenesisState.next_yield_record_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.td.v1.GenesisState.yield_reserve":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.td.v1.GenesisState.delegations":
+		list := []*TrustDepositDelegation{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "verana.td.v1.GenesisState.reward_ratios":
+		list := []*TrustDepositRewardRatio{}
+		return protoreflect.ValueOfList(&_GenesisState_11_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.GenesisState"))
@@ -374,6 +869,48 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.SlashDistributions) > 0 {
+			for _, e := range x.SlashDistributions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextSlashDistributionId != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextSlashDistributionId))
+		}
+		if len(x.Unbondings) > 0 {
+			for _, e := range x.Unbondings {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextUnbondingId != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextUnbondingId))
+		}
+		if len(x.YieldRecords) > 0 {
+			for _, e := range x.YieldRecords {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextYieldRecordId != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextYieldRecordId))
+		}
+		if x.YieldReserve != 0 {
+			n += 1 + runtime.Sov(uint64(x.YieldReserve))
+		}
+		if len(x.Delegations) > 0 {
+			for _, e := range x.Delegations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RewardRatios) > 0 {
+			for _, e := range x.RewardRatios {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -403,6 +940,106 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.RewardRatios) > 0 {
+			for iNdEx := len(x.RewardRatios) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RewardRatios[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if len(x.Delegations) > 0 {
+			for iNdEx := len(x.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Delegations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if x.YieldReserve != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.YieldReserve))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.NextYieldRecordId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextYieldRecordId))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.YieldRecords) > 0 {
+			for iNdEx := len(x.YieldRecords) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.YieldRecords[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if x.NextUnbondingId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextUnbondingId))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Unbondings) > 0 {
+			for iNdEx := len(x.Unbondings) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Unbondings[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.NextSlashDistributionId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextSlashDistributionId))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.SlashDistributions) > 0 {
+			for iNdEx := len(x.SlashDistributions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SlashDistributions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
 		if len(x.TrustDeposits) > 0 {
 			for iNdEx := len(x.TrustDeposits) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.TrustDeposits[iNdEx])
@@ -552,6 +1189,252 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashDistributions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SlashDistributions = append(x.SlashDistributions, &SlashDistribution{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SlashDistributions[len(x.SlashDistributions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextSlashDistributionId", wireType)
+				}
+				x.NextSlashDistributionId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextSlashDistributionId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Unbondings = append(x.Unbondings, &TrustDepositUnbonding{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Unbondings[len(x.Unbondings)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextUnbondingId", wireType)
+				}
+				x.NextUnbondingId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextUnbondingId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field YieldRecords", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.YieldRecords = append(x.YieldRecords, &YieldRecord{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.YieldRecords[len(x.YieldRecords)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextYieldRecordId", wireType)
+				}
+				x.NextYieldRecordId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextYieldRecordId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field YieldReserve", wireType)
+				}
+				x.YieldReserve = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.YieldReserve |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegations = append(x.Delegations, &TrustDepositDelegation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Delegations[len(x.Delegations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardRatios", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RewardRatios = append(x.RewardRatios, &TrustDepositRewardRatio{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RewardRatios[len(x.RewardRatios)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -588,11 +1471,17 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_TrustDepositRecord           protoreflect.MessageDescriptor
-	fd_TrustDepositRecord_account   protoreflect.FieldDescriptor
-	fd_TrustDepositRecord_share     protoreflect.FieldDescriptor
-	fd_TrustDepositRecord_amount    protoreflect.FieldDescriptor
-	fd_TrustDepositRecord_claimable protoreflect.FieldDescriptor
+	md_TrustDepositRecord                 protoreflect.MessageDescriptor
+	fd_TrustDepositRecord_account         protoreflect.FieldDescriptor
+	fd_TrustDepositRecord_share           protoreflect.FieldDescriptor
+	fd_TrustDepositRecord_amount          protoreflect.FieldDescriptor
+	fd_TrustDepositRecord_claimable       protoreflect.FieldDescriptor
+	fd_TrustDepositRecord_slashed_deposit protoreflect.FieldDescriptor
+	fd_TrustDepositRecord_repaid_deposit  protoreflect.FieldDescriptor
+	fd_TrustDepositRecord_last_slashed    protoreflect.FieldDescriptor
+	fd_TrustDepositRecord_last_repaid     protoreflect.FieldDescriptor
+	fd_TrustDepositRecord_slash_count     protoreflect.FieldDescriptor
+	fd_TrustDepositRecord_last_repaid_by  protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -602,6 +1491,12 @@ func init() {
 	fd_TrustDepositRecord_share = md_TrustDepositRecord.Fields().ByName("share")
 	fd_TrustDepositRecord_amount = md_TrustDepositRecord.Fields().ByName("amount")
 	fd_TrustDepositRecord_claimable = md_TrustDepositRecord.Fields().ByName("claimable")
+	fd_TrustDepositRecord_slashed_deposit = md_TrustDepositRecord.Fields().ByName("slashed_deposit")
+	fd_TrustDepositRecord_repaid_deposit = md_TrustDepositRecord.Fields().ByName("repaid_deposit")
+	fd_TrustDepositRecord_last_slashed = md_TrustDepositRecord.Fields().ByName("last_slashed")
+	fd_TrustDepositRecord_last_repaid = md_TrustDepositRecord.Fields().ByName("last_repaid")
+	fd_TrustDepositRecord_slash_count = md_TrustDepositRecord.Fields().ByName("slash_count")
+	fd_TrustDepositRecord_last_repaid_by = md_TrustDepositRecord.Fields().ByName("last_repaid_by")
 }
 
 var _ protoreflect.Message = (*fastReflection_TrustDepositRecord)(nil)
@@ -693,6 +1588,42 @@ func (x *fastReflection_TrustDepositRecord) Range(f func(protoreflect.FieldDescr
 			return
 		}
 	}
+	if x.SlashedDeposit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SlashedDeposit)
+		if !f(fd_TrustDepositRecord_slashed_deposit, value) {
+			return
+		}
+	}
+	if x.RepaidDeposit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RepaidDeposit)
+		if !f(fd_TrustDepositRecord_repaid_deposit, value) {
+			return
+		}
+	}
+	if x.LastSlashed != nil {
+		value := protoreflect.ValueOfMessage(x.LastSlashed.ProtoReflect())
+		if !f(fd_TrustDepositRecord_last_slashed, value) {
+			return
+		}
+	}
+	if x.LastRepaid != nil {
+		value := protoreflect.ValueOfMessage(x.LastRepaid.ProtoReflect())
+		if !f(fd_TrustDepositRecord_last_repaid, value) {
+			return
+		}
+	}
+	if x.SlashCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SlashCount)
+		if !f(fd_TrustDepositRecord_slash_count, value) {
+			return
+		}
+	}
+	if x.LastRepaidBy != "" {
+		value := protoreflect.ValueOfString(x.LastRepaidBy)
+		if !f(fd_TrustDepositRecord_last_repaid_by, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -716,6 +1647,18 @@ func (x *fastReflection_TrustDepositRecord) Has(fd protoreflect.FieldDescriptor)
 		return x.Amount != uint64(0)
 	case "verana.td.v1.TrustDepositRecord.claimable":
 		return x.Claimable != uint64(0)
+	case "verana.td.v1.TrustDepositRecord.slashed_deposit":
+		return x.SlashedDeposit != uint64(0)
+	case "verana.td.v1.TrustDepositRecord.repaid_deposit":
+		return x.RepaidDeposit != uint64(0)
+	case "verana.td.v1.TrustDepositRecord.last_slashed":
+		return x.LastSlashed != nil
+	case "verana.td.v1.TrustDepositRecord.last_repaid":
+		return x.LastRepaid != nil
+	case "verana.td.v1.TrustDepositRecord.slash_count":
+		return x.SlashCount != uint64(0)
+	case "verana.td.v1.TrustDepositRecord.last_repaid_by":
+		return x.LastRepaidBy != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.TrustDepositRecord"))
@@ -740,6 +1683,18 @@ func (x *fastReflection_TrustDepositRecord) Clear(fd protoreflect.FieldDescripto
 		x.Amount = uint64(0)
 	case "verana.td.v1.TrustDepositRecord.claimable":
 		x.Claimable = uint64(0)
+	case "verana.td.v1.TrustDepositRecord.slashed_deposit":
+		x.SlashedDeposit = uint64(0)
+	case "verana.td.v1.TrustDepositRecord.repaid_deposit":
+		x.RepaidDeposit = uint64(0)
+	case "verana.td.v1.TrustDepositRecord.last_slashed":
+		x.LastSlashed = nil
+	case "verana.td.v1.TrustDepositRecord.last_repaid":
+		x.LastRepaid = nil
+	case "verana.td.v1.TrustDepositRecord.slash_count":
+		x.SlashCount = uint64(0)
+	case "verana.td.v1.TrustDepositRecord.last_repaid_by":
+		x.LastRepaidBy = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.TrustDepositRecord"))
@@ -768,6 +1723,24 @@ func (x *fastReflection_TrustDepositRecord) Get(descriptor protoreflect.FieldDes
 	case "verana.td.v1.TrustDepositRecord.claimable":
 		value := x.Claimable
 		return protoreflect.ValueOfUint64(value)
+	case "verana.td.v1.TrustDepositRecord.slashed_deposit":
+		value := x.SlashedDeposit
+		return protoreflect.ValueOfUint64(value)
+	case "verana.td.v1.TrustDepositRecord.repaid_deposit":
+		value := x.RepaidDeposit
+		return protoreflect.ValueOfUint64(value)
+	case "verana.td.v1.TrustDepositRecord.last_slashed":
+		value := x.LastSlashed
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.td.v1.TrustDepositRecord.last_repaid":
+		value := x.LastRepaid
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "verana.td.v1.TrustDepositRecord.slash_count":
+		value := x.SlashCount
+		return protoreflect.ValueOfUint64(value)
+	case "verana.td.v1.TrustDepositRecord.last_repaid_by":
+		value := x.LastRepaidBy
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.TrustDepositRecord"))
@@ -796,6 +1769,18 @@ func (x *fastReflection_TrustDepositRecord) Set(fd protoreflect.FieldDescriptor,
 		x.Amount = value.Uint()
 	case "verana.td.v1.TrustDepositRecord.claimable":
 		x.Claimable = value.Uint()
+	case "verana.td.v1.TrustDepositRecord.slashed_deposit":
+		x.SlashedDeposit = value.Uint()
+	case "verana.td.v1.TrustDepositRecord.repaid_deposit":
+		x.RepaidDeposit = value.Uint()
+	case "verana.td.v1.TrustDepositRecord.last_slashed":
+		x.LastSlashed = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.td.v1.TrustDepositRecord.last_repaid":
+		x.LastRepaid = value.Message().Interface().(*timestamppb.Timestamp)
+	case "verana.td.v1.TrustDepositRecord.slash_count":
+		x.SlashCount = value.Uint()
+	case "verana.td.v1.TrustDepositRecord.last_repaid_by":
+		x.LastRepaidBy = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.TrustDepositRecord"))
@@ -816,6 +1801,16 @@ func (x *fastReflection_TrustDepositRecord) Set(fd protoreflect.FieldDescriptor,
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_TrustDepositRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "verana.td.v1.TrustDepositRecord.last_slashed":
+		if x.LastSlashed == nil {
+			x.LastSlashed = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.LastSlashed.ProtoReflect())
+	case "verana.td.v1.TrustDepositRecord.last_repaid":
+		if x.LastRepaid == nil {
+			x.LastRepaid = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.LastRepaid.ProtoReflect())
 	case "verana.td.v1.TrustDepositRecord.account":
 		panic(fmt.Errorf("field account of message verana.td.v1.TrustDepositRecord is not mutable"))
 	case "verana.td.v1.TrustDepositRecord.share":
@@ -824,6 +1819,14 @@ func (x *fastReflection_TrustDepositRecord) Mutable(fd protoreflect.FieldDescrip
 		panic(fmt.Errorf("field amount of message verana.td.v1.TrustDepositRecord is not mutable"))
 	case "verana.td.v1.TrustDepositRecord.claimable":
 		panic(fmt.Errorf("field claimable of message verana.td.v1.TrustDepositRecord is not mutable"))
+	case "verana.td.v1.TrustDepositRecord.slashed_deposit":
+		panic(fmt.Errorf("field slashed_deposit of message verana.td.v1.TrustDepositRecord is not mutable"))
+	case "verana.td.v1.TrustDepositRecord.repaid_deposit":
+		panic(fmt.Errorf("field repaid_deposit of message verana.td.v1.TrustDepositRecord is not mutable"))
+	case "verana.td.v1.TrustDepositRecord.slash_count":
+		panic(fmt.Errorf("field slash_count of message verana.td.v1.TrustDepositRecord is not mutable"))
+	case "verana.td.v1.TrustDepositRecord.last_repaid_by":
+		panic(fmt.Errorf("field last_repaid_by of message verana.td.v1.TrustDepositRecord is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.TrustDepositRecord"))
@@ -841,10 +1844,24 @@ func (x *fastReflection_TrustDepositRecord) NewField(fd protoreflect.FieldDescri
 		return protoreflect.ValueOfString("")
 	case "verana.td.v1.TrustDepositRecord.share":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "verana.td.v1.TrustDepositRecord.amount":
+	case "verana.td.v1.TrustDepositRecord.amount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.td.v1.TrustDepositRecord.claimable":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.td.v1.TrustDepositRecord.slashed_deposit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.td.v1.TrustDepositRecord.repaid_deposit":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "verana.td.v1.TrustDepositRecord.claimable":
+	case "verana.td.v1.TrustDepositRecord.last_slashed":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.td.v1.TrustDepositRecord.last_repaid":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "verana.td.v1.TrustDepositRecord.slash_count":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.td.v1.TrustDepositRecord.last_repaid_by":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.TrustDepositRecord"))
@@ -927,6 +1944,27 @@ func (x *fastReflection_TrustDepositRecord) ProtoMethods() *protoiface.Methods {
 		if x.Claimable != 0 {
 			n += 1 + runtime.Sov(uint64(x.Claimable))
 		}
+		if x.SlashedDeposit != 0 {
+			n += 1 + runtime.Sov(uint64(x.SlashedDeposit))
+		}
+		if x.RepaidDeposit != 0 {
+			n += 1 + runtime.Sov(uint64(x.RepaidDeposit))
+		}
+		if x.LastSlashed != nil {
+			l = options.Size(x.LastSlashed)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LastRepaid != nil {
+			l = options.Size(x.LastRepaid)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SlashCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.SlashCount))
+		}
+		l = len(x.LastRepaidBy)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -956,6 +1994,56 @@ func (x *fastReflection_TrustDepositRecord) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.LastRepaidBy) > 0 {
+			i -= len(x.LastRepaidBy)
+			copy(dAtA[i:], x.LastRepaidBy)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LastRepaidBy)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.SlashCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SlashCount))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.LastRepaid != nil {
+			encoded, err := options.Marshal(x.LastRepaid)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.LastSlashed != nil {
+			encoded, err := options.Marshal(x.LastSlashed)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.RepaidDeposit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RepaidDeposit))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.SlashedDeposit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SlashedDeposit))
+			i--
+			dAtA[i] = 0x28
+		}
 		if x.Claimable != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.Claimable))
 			i--
@@ -1116,6 +2204,167 @@ func (x *fastReflection_TrustDepositRecord) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashedDeposit", wireType)
+				}
+				x.SlashedDeposit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SlashedDeposit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepaidDeposit", wireType)
+				}
+				x.RepaidDeposit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RepaidDeposit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastSlashed", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.LastSlashed == nil {
+					x.LastSlashed = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LastSlashed); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastRepaid", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.LastRepaid == nil {
+					x.LastRepaid = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LastRepaid); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashCount", wireType)
+				}
+				x.SlashCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SlashCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastRepaidBy", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LastRepaidBy = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1173,6 +2422,24 @@ type GenesisState struct {
 	// params defines all the parameters of the module.
 	Params        *Params               `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
 	TrustDeposits []*TrustDepositRecord `protobuf:"bytes,2,rep,name=trust_deposits,json=trustDeposits,proto3" json:"trust_deposits,omitempty"`
+	// slash_distributions is a list of all SlashDistribution objects
+	SlashDistributions []*SlashDistribution `protobuf:"bytes,3,rep,name=slash_distributions,json=slashDistributions,proto3" json:"slash_distributions,omitempty"`
+	// next_slash_distribution_id is the next auto-increment ID to be assigned to a new slash distribution
+	NextSlashDistributionId uint64 `protobuf:"varint,4,opt,name=next_slash_distribution_id,json=nextSlashDistributionId,proto3" json:"next_slash_distribution_id,omitempty"`
+	// unbondings is a list of all pending TrustDepositUnbonding objects
+	Unbondings []*TrustDepositUnbonding `protobuf:"bytes,5,rep,name=unbondings,proto3" json:"unbondings,omitempty"`
+	// next_unbonding_id is the next auto-increment ID to be assigned to a new unbonding
+	NextUnbondingId uint64 `protobuf:"varint,6,opt,name=next_unbonding_id,json=nextUnbondingId,proto3" json:"next_unbonding_id,omitempty"`
+	// yield_records is the history of yield distributions
+	YieldRecords []*YieldRecord `protobuf:"bytes,7,rep,name=yield_records,json=yieldRecords,proto3" json:"yield_records,omitempty"`
+	// next_yield_record_id is the next auto-increment ID to be assigned to a new yield record
+	NextYieldRecordId uint64 `protobuf:"varint,8,opt,name=next_yield_record_id,json=nextYieldRecordId,proto3" json:"next_yield_record_id,omitempty"`
+	// yield_reserve is the amount held by the module to be released as yield
+	YieldReserve uint64 `protobuf:"varint,9,opt,name=yield_reserve,json=yieldReserve,proto3" json:"yield_reserve,omitempty"`
+	// delegations is a list of all TrustDepositDelegation objects
+	Delegations []*TrustDepositDelegation `protobuf:"bytes,10,rep,name=delegations,proto3" json:"delegations,omitempty"`
+	// reward_ratios are the staking reward ratios of the validators delegated to
+	RewardRatios []*TrustDepositRewardRatio `protobuf:"bytes,11,rep,name=reward_ratios,json=rewardRatios,proto3" json:"reward_ratios,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1209,6 +2476,69 @@ func (x *GenesisState) GetTrustDeposits() []*TrustDepositRecord {
 	return nil
 }
 
+func (x *GenesisState) GetSlashDistributions() []*SlashDistribution {
+	if x != nil {
+		return x.SlashDistributions
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNextSlashDistributionId() uint64 {
+	if x != nil {
+		return x.NextSlashDistributionId
+	}
+	return 0
+}
+
+func (x *GenesisState) GetUnbondings() []*TrustDepositUnbonding {
+	if x != nil {
+		return x.Unbondings
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNextUnbondingId() uint64 {
+	if x != nil {
+		return x.NextUnbondingId
+	}
+	return 0
+}
+
+func (x *GenesisState) GetYieldRecords() []*YieldRecord {
+	if x != nil {
+		return x.YieldRecords
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNextYieldRecordId() uint64 {
+	if x != nil {
+		return x.NextYieldRecordId
+	}
+	return 0
+}
+
+func (x *GenesisState) GetYieldReserve() uint64 {
+	if x != nil {
+		return x.YieldReserve
+	}
+	return 0
+}
+
+func (x *GenesisState) GetDelegations() []*TrustDepositDelegation {
+	if x != nil {
+		return x.Delegations
+	}
+	return nil
+}
+
+func (x *GenesisState) GetRewardRatios() []*TrustDepositRewardRatio {
+	if x != nil {
+		return x.RewardRatios
+	}
+	return nil
+}
+
 // TrustDepositRecord defines a trust deposit entry for genesis state
 type TrustDepositRecord struct {
 	state         protoimpl.MessageState
@@ -1219,6 +2549,13 @@ type TrustDepositRecord struct {
 	Share     uint64 `protobuf:"varint,2,opt,name=share,proto3" json:"share,omitempty"`
 	Amount    uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
 	Claimable uint64 `protobuf:"varint,4,opt,name=claimable,proto3" json:"claimable,omitempty"`
+	// slashing related fields, kept so that slashed deposits survive export/import
+	SlashedDeposit uint64                 `protobuf:"varint,5,opt,name=slashed_deposit,json=slashedDeposit,proto3" json:"slashed_deposit,omitempty"`
+	RepaidDeposit  uint64                 `protobuf:"varint,6,opt,name=repaid_deposit,json=repaidDeposit,proto3" json:"repaid_deposit,omitempty"`
+	LastSlashed    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_slashed,json=lastSlashed,proto3" json:"last_slashed,omitempty"`
+	LastRepaid     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_repaid,json=lastRepaid,proto3" json:"last_repaid,omitempty"`
+	SlashCount     uint64                 `protobuf:"varint,9,opt,name=slash_count,json=slashCount,proto3" json:"slash_count,omitempty"`
+	LastRepaidBy   string                 `protobuf:"bytes,10,opt,name=last_repaid_by,json=lastRepaidBy,proto3" json:"last_repaid_by,omitempty"`
 }
 
 func (x *TrustDepositRecord) Reset() {
@@ -1269,6 +2606,48 @@ func (x *TrustDepositRecord) GetClaimable() uint64 {
 	return 0
 }
 
+func (x *TrustDepositRecord) GetSlashedDeposit() uint64 {
+	if x != nil {
+		return x.SlashedDeposit
+	}
+	return 0
+}
+
+func (x *TrustDepositRecord) GetRepaidDeposit() uint64 {
+	if x != nil {
+		return x.RepaidDeposit
+	}
+	return 0
+}
+
+func (x *TrustDepositRecord) GetLastSlashed() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSlashed
+	}
+	return nil
+}
+
+func (x *TrustDepositRecord) GetLastRepaid() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRepaid
+	}
+	return nil
+}
+
+func (x *TrustDepositRecord) GetSlashCount() uint64 {
+	if x != nil {
+		return x.SlashCount
+	}
+	return 0
+}
+
+func (x *TrustDepositRecord) GetLastRepaidBy() string {
+	if x != nil {
+		return x.LastRepaidBy
+	}
+	return ""
+}
+
 var File_verana_td_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_verana_td_v1_genesis_proto_rawDesc = []byte{
@@ -1277,37 +2656,96 @@ var file_verana_td_v1_genesis_proto_rawDesc = []byte{
 	0x72, 0x61, 0x6e, 0x61, 0x2e, 0x74, 0x64, 0x2e, 0x76, 0x31, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e,
 	0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67,
 	0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x74, 0x64, 0x2f, 0x76,
-	0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x96,
-	0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
-	0x37, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x74, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
-	0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x4d, 0x0a, 0x0e, 0x74, 0x72, 0x75, 0x73,
-	0x74, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x20, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x74, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x54, 0x72, 0x75, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x74, 0x72, 0x75, 0x73, 0x74, 0x44,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x22, 0x7a, 0x0a, 0x12, 0x54, 0x72, 0x75, 0x73, 0x74,
-	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62,
-	0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61,
-	0x62, 0x6c, 0x65, 0x42, 0xb2, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x65, 0x72, 0x61,
-	0x6e, 0x61, 0x2e, 0x74, 0x64, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
-	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73,
-	0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x74, 0x64,
-	0x2f, 0x76, 0x31, 0x3b, 0x74, 0x64, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x56, 0x54, 0x58, 0xaa, 0x02,
-	0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x54, 0x64, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c,
-	0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x54, 0x64, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18, 0x56,
-	0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x54, 0x64, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61,
-	0x3a, 0x3a, 0x54, 0x64, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x74, 0x64, 0x2f,
+	0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x18, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x74, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xde, 0x05, 0x0a, 0x0c, 0x47, 0x65,
+	0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x65, 0x72,
+	0x61, 0x6e, 0x61, 0x2e, 0x74, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x4d, 0x0a, 0x0e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x76, 0x65,
+	0x72, 0x61, 0x6e, 0x61, 0x2e, 0x74, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x75, 0x73, 0x74,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x0d, 0x74, 0x72, 0x75, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x73, 0x12, 0x56, 0x0a, 0x13, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x64, 0x69, 0x73, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x74, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x6c, 0x61, 0x73, 0x68, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x12, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x44, 0x69, 0x73,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3b, 0x0a, 0x1a, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17,
+	0x6e, 0x65, 0x78, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x0a, 0x75, 0x6e, 0x62, 0x6f, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x76, 0x65,
+	0x72, 0x61, 0x6e, 0x61, 0x2e, 0x74, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x75, 0x73, 0x74,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x75, 0x6e, 0x62, 0x6f, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6e,
+	0x65, 0x78, 0x74, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x44,
+	0x0a, 0x0d, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x74,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x59, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x79, 0x69, 0x65,
+	0x6c, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x11, 0x6e, 0x65, 0x78, 0x74, 0x59, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x79, 0x69,
+	0x65, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x12, 0x4c, 0x0a, 0x0b, 0x64, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x74, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x72, 0x75, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x50, 0x0a, 0x0d, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x74, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x72, 0x75, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x73, 0x22, 0x99, 0x03, 0x0a, 0x12, 0x54,
+	0x72, 0x75, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6c, 0x61,
+	0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x6c,
+	0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x6c, 0x61, 0x73, 0x68,
+	0x65, 0x64, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x43, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52,
+	0x0b, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x12, 0x41, 0x0a, 0x0b,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90,
+	0xdf, 0x1f, 0x01, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x70, 0x61, 0x69, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x5f,
+	0x62, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65,
+	0x70, 0x61, 0x69, 0x64, 0x42, 0x79, 0x42, 0xb2, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x76,
+	0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x74, 0x64, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e,
+	0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2d, 0x6c,
+	0x61, 0x62, 0x73, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2d, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61,
+	0x2f, 0x74, 0x64, 0x2f, 0x76, 0x31, 0x3b, 0x74, 0x64, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x56, 0x54,
+	0x58, 0xaa, 0x02, 0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x54, 0x64, 0x2e, 0x56, 0x31,
+	0xca, 0x02, 0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x54, 0x64, 0x5c, 0x56, 0x31, 0xe2,
+	0x02, 0x18, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x54, 0x64, 0x5c, 0x56, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x56, 0x65, 0x72,
+	0x61, 0x6e, 0x61, 0x3a, 0x3a, 0x54, 0x64, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1324,18 +2762,31 @@ func file_verana_td_v1_genesis_proto_rawDescGZIP() []byte {
 
 var file_verana_td_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_verana_td_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),       // 0: verana.td.v1.GenesisState
-	(*TrustDepositRecord)(nil), // 1: verana.td.v1.TrustDepositRecord
-	(*Params)(nil),             // 2: verana.td.v1.Params
+	(*GenesisState)(nil),            // 0: verana.td.v1.GenesisState
+	(*TrustDepositRecord)(nil),      // 1: verana.td.v1.TrustDepositRecord
+	(*Params)(nil),                  // 2: verana.td.v1.Params
+	(*SlashDistribution)(nil),       // 3: verana.td.v1.SlashDistribution
+	(*TrustDepositUnbonding)(nil),   // 4: verana.td.v1.TrustDepositUnbonding
+	(*YieldRecord)(nil),             // 5: verana.td.v1.YieldRecord
+	(*TrustDepositDelegation)(nil),  // 6: verana.td.v1.TrustDepositDelegation
+	(*TrustDepositRewardRatio)(nil), // 7: verana.td.v1.TrustDepositRewardRatio
+	(*timestamppb.Timestamp)(nil),   // 8: google.protobuf.Timestamp
 }
 var file_verana_td_v1_genesis_proto_depIdxs = []int32{
 	2, // 0: verana.td.v1.GenesisState.params:type_name -> verana.td.v1.Params
 	1, // 1: verana.td.v1.GenesisState.trust_deposits:type_name -> verana.td.v1.TrustDepositRecord
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 2: verana.td.v1.GenesisState.slash_distributions:type_name -> verana.td.v1.SlashDistribution
+	4, // 3: verana.td.v1.GenesisState.unbondings:type_name -> verana.td.v1.TrustDepositUnbonding
+	5, // 4: verana.td.v1.GenesisState.yield_records:type_name -> verana.td.v1.YieldRecord
+	6, // 5: verana.td.v1.GenesisState.delegations:type_name -> verana.td.v1.TrustDepositDelegation
+	7, // 6: verana.td.v1.GenesisState.reward_ratios:type_name -> verana.td.v1.TrustDepositRewardRatio
+	8, // 7: verana.td.v1.TrustDepositRecord.last_slashed:type_name -> google.protobuf.Timestamp
+	8, // 8: verana.td.v1.TrustDepositRecord.last_repaid:type_name -> google.protobuf.Timestamp
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_verana_td_v1_genesis_proto_init() }
@@ -1344,6 +2795,7 @@ func file_verana_td_v1_genesis_proto_init() {
 		return
 	}
 	file_verana_td_v1_params_proto_init()
+	file_verana_td_v1_types_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_verana_td_v1_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
@@ -4,6 +4,7 @@ package tdv1
 import (
 	_ "cosmossdk.io/api/amino"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -15,12 +16,16 @@ import (
 )
 
 var (
-	md_Params                                 protoreflect.MessageDescriptor
-	fd_Params_trust_deposit_reclaim_burn_rate protoreflect.FieldDescriptor
-	fd_Params_trust_deposit_share_value       protoreflect.FieldDescriptor
-	fd_Params_trust_deposit_rate              protoreflect.FieldDescriptor
-	fd_Params_wallet_user_agent_reward_rate   protoreflect.FieldDescriptor
-	fd_Params_user_agent_reward_rate          protoreflect.FieldDescriptor
+	md_Params                                     protoreflect.MessageDescriptor
+	fd_Params_trust_deposit_reclaim_burn_rate     protoreflect.FieldDescriptor
+	fd_Params_trust_deposit_share_value           protoreflect.FieldDescriptor
+	fd_Params_trust_deposit_rate                  protoreflect.FieldDescriptor
+	fd_Params_wallet_user_agent_reward_rate       protoreflect.FieldDescriptor
+	fd_Params_user_agent_reward_rate              protoreflect.FieldDescriptor
+	fd_Params_trust_deposit_unbonding_period_days protoreflect.FieldDescriptor
+	fd_Params_yield_epoch_blocks                  protoreflect.FieldDescriptor
+	fd_Params_yield_fee_collector_share           protoreflect.FieldDescriptor
+	fd_Params_yield_reserve_release_rate          protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -31,6 +36,10 @@ func init() {
 	fd_Params_trust_deposit_rate = md_Params.Fields().ByName("trust_deposit_rate")
 	fd_Params_wallet_user_agent_reward_rate = md_Params.Fields().ByName("wallet_user_agent_reward_rate")
 	fd_Params_user_agent_reward_rate = md_Params.Fields().ByName("user_agent_reward_rate")
+	fd_Params_trust_deposit_unbonding_period_days = md_Params.Fields().ByName("trust_deposit_unbonding_period_days")
+	fd_Params_yield_epoch_blocks = md_Params.Fields().ByName("yield_epoch_blocks")
+	fd_Params_yield_fee_collector_share = md_Params.Fields().ByName("yield_fee_collector_share")
+	fd_Params_yield_reserve_release_rate = md_Params.Fields().ByName("yield_reserve_release_rate")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -128,6 +137,30 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.TrustDepositUnbondingPeriodDays != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TrustDepositUnbondingPeriodDays)
+		if !f(fd_Params_trust_deposit_unbonding_period_days, value) {
+			return
+		}
+	}
+	if x.YieldEpochBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.YieldEpochBlocks)
+		if !f(fd_Params_yield_epoch_blocks, value) {
+			return
+		}
+	}
+	if x.YieldFeeCollectorShare != "" {
+		value := protoreflect.ValueOfString(x.YieldFeeCollectorShare)
+		if !f(fd_Params_yield_fee_collector_share, value) {
+			return
+		}
+	}
+	if x.YieldReserveReleaseRate != "" {
+		value := protoreflect.ValueOfString(x.YieldReserveReleaseRate)
+		if !f(fd_Params_yield_reserve_release_rate, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -153,6 +186,14 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.WalletUserAgentRewardRate != ""
 	case "verana.td.v1.Params.user_agent_reward_rate":
 		return x.UserAgentRewardRate != ""
+	case "verana.td.v1.Params.trust_deposit_unbonding_period_days":
+		return x.TrustDepositUnbondingPeriodDays != uint64(0)
+	case "verana.td.v1.Params.yield_epoch_blocks":
+		return x.YieldEpochBlocks != uint64(0)
+	case "verana.td.v1.Params.yield_fee_collector_share":
+		return x.YieldFeeCollectorShare != ""
+	case "verana.td.v1.Params.yield_reserve_release_rate":
+		return x.YieldReserveReleaseRate != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.Params"))
@@ -179,6 +220,14 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.WalletUserAgentRewardRate = ""
 	case "verana.td.v1.Params.user_agent_reward_rate":
 		x.UserAgentRewardRate = ""
+	case "verana.td.v1.Params.trust_deposit_unbonding_period_days":
+		x.TrustDepositUnbondingPeriodDays = uint64(0)
+	case "verana.td.v1.Params.yield_epoch_blocks":
+		x.YieldEpochBlocks = uint64(0)
+	case "verana.td.v1.Params.yield_fee_collector_share":
+		x.YieldFeeCollectorShare = ""
+	case "verana.td.v1.Params.yield_reserve_release_rate":
+		x.YieldReserveReleaseRate = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.Params"))
@@ -210,6 +259,18 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "verana.td.v1.Params.user_agent_reward_rate":
 		value := x.UserAgentRewardRate
 		return protoreflect.ValueOfString(value)
+	case "verana.td.v1.Params.trust_deposit_unbonding_period_days":
+		value := x.TrustDepositUnbondingPeriodDays
+		return protoreflect.ValueOfUint64(value)
+	case "verana.td.v1.Params.yield_epoch_blocks":
+		value := x.YieldEpochBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "verana.td.v1.Params.yield_fee_collector_share":
+		value := x.YieldFeeCollectorShare
+		return protoreflect.ValueOfString(value)
+	case "verana.td.v1.Params.yield_reserve_release_rate":
+		value := x.YieldReserveReleaseRate
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.Params"))
@@ -240,6 +301,14 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.WalletUserAgentRewardRate = value.Interface().(string)
 	case "verana.td.v1.Params.user_agent_reward_rate":
 		x.UserAgentRewardRate = value.Interface().(string)
+	case "verana.td.v1.Params.trust_deposit_unbonding_period_days":
+		x.TrustDepositUnbondingPeriodDays = value.Uint()
+	case "verana.td.v1.Params.yield_epoch_blocks":
+		x.YieldEpochBlocks = value.Uint()
+	case "verana.td.v1.Params.yield_fee_collector_share":
+		x.YieldFeeCollectorShare = value.Interface().(string)
+	case "verana.td.v1.Params.yield_reserve_release_rate":
+		x.YieldReserveReleaseRate = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.Params"))
@@ -270,6 +339,14 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field wallet_user_agent_reward_rate of message verana.td.v1.Params is not mutable"))
 	case "verana.td.v1.Params.user_agent_reward_rate":
 		panic(fmt.Errorf("field user_agent_reward_rate of message verana.td.v1.Params is not mutable"))
+	case "verana.td.v1.Params.trust_deposit_unbonding_period_days":
+		panic(fmt.Errorf("field trust_deposit_unbonding_period_days of message verana.td.v1.Params is not mutable"))
+	case "verana.td.v1.Params.yield_epoch_blocks":
+		panic(fmt.Errorf("field yield_epoch_blocks of message verana.td.v1.Params is not mutable"))
+	case "verana.td.v1.Params.yield_fee_collector_share":
+		panic(fmt.Errorf("field yield_fee_collector_share of message verana.td.v1.Params is not mutable"))
+	case "verana.td.v1.Params.yield_reserve_release_rate":
+		panic(fmt.Errorf("field yield_reserve_release_rate of message verana.td.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.Params"))
@@ -293,6 +370,14 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfString("")
 	case "verana.td.v1.Params.user_agent_reward_rate":
 		return protoreflect.ValueOfString("")
+	case "verana.td.v1.Params.trust_deposit_unbonding_period_days":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.td.v1.Params.yield_epoch_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "verana.td.v1.Params.yield_fee_collector_share":
+		return protoreflect.ValueOfString("")
+	case "verana.td.v1.Params.yield_reserve_release_rate":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: verana.td.v1.Params"))
@@ -382,6 +467,20 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.TrustDepositUnbondingPeriodDays != 0 {
+			n += 1 + runtime.Sov(uint64(x.TrustDepositUnbondingPeriodDays))
+		}
+		if x.YieldEpochBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.YieldEpochBlocks))
+		}
+		l = len(x.YieldFeeCollectorShare)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.YieldReserveReleaseRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -411,6 +510,30 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.YieldReserveReleaseRate) > 0 {
+			i -= len(x.YieldReserveReleaseRate)
+			copy(dAtA[i:], x.YieldReserveReleaseRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.YieldReserveReleaseRate)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.YieldFeeCollectorShare) > 0 {
+			i -= len(x.YieldFeeCollectorShare)
+			copy(dAtA[i:], x.YieldFeeCollectorShare)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.YieldFeeCollectorShare)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.YieldEpochBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.YieldEpochBlocks))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.TrustDepositUnbondingPeriodDays != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TrustDepositUnbondingPeriodDays))
+			i--
+			dAtA[i] = 0x30
+		}
 		if len(x.UserAgentRewardRate) > 0 {
 			i -= len(x.UserAgentRewardRate)
 			copy(dAtA[i:], x.UserAgentRewardRate)
@@ -655,6 +778,108 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.UserAgentRewardRate = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TrustDepositUnbondingPeriodDays", wireType)
+				}
+				x.TrustDepositUnbondingPeriodDays = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TrustDepositUnbondingPeriodDays |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field YieldEpochBlocks", wireType)
+				}
+				x.YieldEpochBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.YieldEpochBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field YieldFeeCollectorShare", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.YieldFeeCollectorShare = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field YieldReserveReleaseRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.YieldReserveReleaseRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -714,6 +939,18 @@ type Params struct {
 	TrustDepositRate            string `protobuf:"bytes,3,opt,name=trust_deposit_rate,json=trustDepositRate,proto3" json:"trust_deposit_rate,omitempty"`
 	WalletUserAgentRewardRate   string `protobuf:"bytes,4,opt,name=wallet_user_agent_reward_rate,json=walletUserAgentRewardRate,proto3" json:"wallet_user_agent_reward_rate,omitempty"`
 	UserAgentRewardRate         string `protobuf:"bytes,5,opt,name=user_agent_reward_rate,json=userAgentRewardRate,proto3" json:"user_agent_reward_rate,omitempty"`
+	// trust_deposit_unbonding_period_days is the number of days reclaimed
+	// trust deposits stay locked, and slashable, before they are paid out.
+	TrustDepositUnbondingPeriodDays uint64 `protobuf:"varint,6,opt,name=trust_deposit_unbonding_period_days,json=trustDepositUnbondingPeriodDays,proto3" json:"trust_deposit_unbonding_period_days,omitempty"`
+	// yield_epoch_blocks is the number of blocks between two yield
+	// distributions to trust deposit holders.
+	YieldEpochBlocks uint64 `protobuf:"varint,7,opt,name=yield_epoch_blocks,json=yieldEpochBlocks,proto3" json:"yield_epoch_blocks,omitempty"`
+	// yield_fee_collector_share is the share of the fee collector balance,
+	// block inflation and transaction fees, distributed as yield each epoch.
+	YieldFeeCollectorShare string `protobuf:"bytes,8,opt,name=yield_fee_collector_share,json=yieldFeeCollectorShare,proto3" json:"yield_fee_collector_share,omitempty"`
+	// yield_reserve_release_rate is the share of the yield reserve distributed
+	// as yield each epoch.
+	YieldReserveReleaseRate string `protobuf:"bytes,9,opt,name=yield_reserve_release_rate,json=yieldReserveReleaseRate,proto3" json:"yield_reserve_release_rate,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -771,71 +1008,132 @@ func (x *Params) GetUserAgentRewardRate() string {
 	return ""
 }
 
+func (x *Params) GetTrustDepositUnbondingPeriodDays() uint64 {
+	if x != nil {
+		return x.TrustDepositUnbondingPeriodDays
+	}
+	return 0
+}
+
+func (x *Params) GetYieldEpochBlocks() uint64 {
+	if x != nil {
+		return x.YieldEpochBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetYieldFeeCollectorShare() string {
+	if x != nil {
+		return x.YieldFeeCollectorShare
+	}
+	return ""
+}
+
+func (x *Params) GetYieldReserveReleaseRate() string {
+	if x != nil {
+		return x.YieldReserveReleaseRate
+	}
+	return ""
+}
+
 var File_verana_td_v1_params_proto protoreflect.FileDescriptor
 
 var file_verana_td_v1_params_proto_rawDesc = []byte{
 	0x0a, 0x19, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2f, 0x74, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x70,
 	0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x76, 0x65, 0x72,
 	0x61, 0x6e, 0x61, 0x2e, 0x74, 0x64, 0x2e, 0x76, 0x31, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f,
-	0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f,
-	0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0xc5, 0x05, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x93, 0x01,
-	0x0a, 0x1f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f,
-	0x72, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x62, 0x75, 0x72, 0x6e, 0x5f, 0x72, 0x61, 0x74,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
-	0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
-	0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x26,
-	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x62, 0x75, 0x72, 0x6e,
-	0x5f, 0x72, 0x61, 0x74, 0x65, 0x22, 0x52, 0x1b, 0x74, 0x72, 0x75, 0x73, 0x74, 0x44, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x42, 0x75, 0x72, 0x6e, 0x52,
-	0x61, 0x74, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x19, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x64, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x47, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
-	0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
-	0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x20,
-	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
-	0x52, 0x16, 0x74, 0x72, 0x75, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x53, 0x68,
-	0x61, 0x72, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x6e, 0x0a, 0x12, 0x74, 0x72, 0x75, 0x73,
-	0x74, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x40, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
-	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x19, 0x79, 0x61, 0x6d,
-	0x6c, 0x3a, 0x22, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x5f, 0x72, 0x61, 0x74, 0x65, 0x22, 0x52, 0x10, 0x74, 0x72, 0x75, 0x73, 0x74, 0x44, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x8d, 0x01, 0x0a, 0x1d, 0x77, 0x61, 0x6c,
-	0x6c, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x72,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x4b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
-	0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x24, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x77,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74,
-	0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x22, 0x52, 0x19, 0x77,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x52, 0x61, 0x74, 0x65, 0x12, 0x79, 0x0a, 0x16, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x61,
-	0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x44, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
-	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
-	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f,
-	0x1d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x65, 0x6e,
-	0x74, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x22, 0x52, 0x13,
-	0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52,
-	0x61, 0x74, 0x65, 0x3a, 0x25, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x1c, 0x76, 0x65,
-	0x72, 0x61, 0x6e, 0x61, 0x2f, 0x78, 0x2f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x64, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xb1, 0x01, 0x0a, 0x10, 0x63,
-	0x6f, 0x6d, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x74, 0x64, 0x2e, 0x76, 0x31, 0x42,
-	0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3e,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e,
-	0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2d, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x65, 0x72,
-	0x61, 0x6e, 0x61, 0x2f, 0x74, 0x64, 0x2f, 0x76, 0x31, 0x3b, 0x74, 0x64, 0x76, 0x31, 0xa2, 0x02,
-	0x03, 0x56, 0x54, 0x58, 0xaa, 0x02, 0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x54, 0x64,
-	0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x54, 0x64, 0x5c,
-	0x56, 0x31, 0xe2, 0x02, 0x18, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x54, 0x64, 0x5c, 0x56,
-	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e,
-	0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x3a, 0x3a, 0x54, 0x64, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb9, 0x09,
+	0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x93, 0x01, 0x0a, 0x1f, 0x74, 0x72, 0x75,
+	0x73, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x6c, 0x61,
+	0x69, 0x6d, 0x5f, 0x62, 0x75, 0x72, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x4d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x26, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x72,
+	0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x62, 0x75, 0x72, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x22, 0x52, 0x1b, 0x74, 0x72, 0x75, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52,
+	0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x42, 0x75, 0x72, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x82,
+	0x01, 0x0a, 0x19, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x47, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x52, 0x16, 0x74, 0x72, 0x75,
+	0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x6e, 0x0a, 0x12, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x40, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x19, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x72,
+	0x75, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x22, 0x52, 0x10, 0x74, 0x72, 0x75, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x8d, 0x01, 0x0a, 0x1d, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4b, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63,
+	0xf2, 0xde, 0x1f, 0x24, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x22, 0x52, 0x19, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x79, 0x0a, 0x16, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x44, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x1d, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x22, 0x52, 0x13, 0x75, 0x73, 0x65, 0x72, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x61, 0x74, 0x65, 0x12, 0x7c,
+	0x0a, 0x23, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f,
+	0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2e, 0xf2, 0xde, 0x1f,
+	0x2a, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x5f, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x22, 0x52, 0x1f, 0x74, 0x72, 0x75,
+	0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x44, 0x61, 0x79, 0x73, 0x12, 0x4b, 0x0a, 0x12,
+	0x79, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1d, 0xf2, 0xde, 0x1f, 0x19, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x52, 0x10, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x90, 0x01, 0x0a, 0x19, 0x79, 0x69,
+	0x65, 0x6c, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x55, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
+	0x65, 0x63, 0xf2, 0xde, 0x1f, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x79, 0x69, 0x65, 0x6c,
+	0x64, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x44, 0x65, 0x63, 0x52, 0x16, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x46, 0x65, 0x65, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x93, 0x01, 0x0a,
+	0x1a, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x56, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x21, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x79, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x17, 0x79, 0x69, 0x65, 0x6c, 0x64,
+	0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x61,
+	0x74, 0x65, 0x3a, 0x25, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x1c, 0x76, 0x65, 0x72,
+	0x61, 0x6e, 0x61, 0x2f, 0x78, 0x2f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xb1, 0x01, 0x0a, 0x10, 0x63, 0x6f,
+	0x6d, 0x2e, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x74, 0x64, 0x2e, 0x76, 0x31, 0x42, 0x0b,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3e, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61,
+	0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x76, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2d, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x65, 0x72, 0x61,
+	0x6e, 0x61, 0x2f, 0x74, 0x64, 0x2f, 0x76, 0x31, 0x3b, 0x74, 0x64, 0x76, 0x31, 0xa2, 0x02, 0x03,
+	0x56, 0x54, 0x58, 0xaa, 0x02, 0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x2e, 0x54, 0x64, 0x2e,
+	0x56, 0x31, 0xca, 0x02, 0x0c, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x54, 0x64, 0x5c, 0x56,
+	0x31, 0xe2, 0x02, 0x18, 0x56, 0x65, 0x72, 0x61, 0x6e, 0x61, 0x5c, 0x54, 0x64, 0x5c, 0x56, 0x31,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x56,
+	0x65, 0x72, 0x61, 0x6e, 0x61, 0x3a, 0x3a, 0x54, 0x64, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -19,8 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName          = "/verana.td.v1.Query/Params"
-	Query_GetTrustDeposit_FullMethodName = "/verana.td.v1.Query/GetTrustDeposit"
+	Query_Params_FullMethodName                    = "/verana.td.v1.Query/Params"
+	Query_GetTrustDeposit_FullMethodName           = "/verana.td.v1.Query/GetTrustDeposit"
+	Query_ListSlashDistributions_FullMethodName    = "/verana.td.v1.Query/ListSlashDistributions"
+	Query_ListUnbondings_FullMethodName            = "/verana.td.v1.Query/ListUnbondings"
+	Query_ListYieldRecords_FullMethodName          = "/verana.td.v1.Query/ListYieldRecords"
+	Query_GetYieldReserve_FullMethodName           = "/verana.td.v1.Query/GetYieldReserve"
+	Query_SimulateReclaim_FullMethodName           = "/verana.td.v1.Query/SimulateReclaim"
+	Query_GetClaimableYield_FullMethodName         = "/verana.td.v1.Query/GetClaimableYield"
+	Query_GetTrustDepositDelegation_FullMethodName = "/verana.td.v1.Query/GetTrustDepositDelegation"
 )
 
 // QueryClient is the client API for Query service.
@@ -30,6 +37,23 @@ type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	GetTrustDeposit(ctx context.Context, in *QueryGetTrustDepositRequest, opts ...grpc.CallOption) (*QueryGetTrustDepositResponse, error)
+	// ListSlashDistributions lists where slashed trust deposits went, filtered
+	// by slashed account and/or recipient.
+	ListSlashDistributions(ctx context.Context, in *QueryListSlashDistributionsRequest, opts ...grpc.CallOption) (*QueryListSlashDistributionsResponse, error)
+	// ListUnbondings lists the reclaimed trust deposits waiting for the end of
+	// the unbonding period, optionally filtered by account.
+	ListUnbondings(ctx context.Context, in *QueryListUnbondingsRequest, opts ...grpc.CallOption) (*QueryListUnbondingsResponse, error)
+	// ListYieldRecords lists the most recent yield distributions, newest first,
+	// with their annualized yield.
+	ListYieldRecords(ctx context.Context, in *QueryListYieldRecordsRequest, opts ...grpc.CallOption) (*QueryListYieldRecordsResponse, error)
+	// GetYieldReserve returns the amount left to be released as yield.
+	GetYieldReserve(ctx context.Context, in *QueryGetYieldReserveRequest, opts ...grpc.CallOption) (*QueryGetYieldReserveResponse, error)
+	// SimulateReclaim previews the outcome of a ReclaimTrustDeposit of amount by account.
+	SimulateReclaim(ctx context.Context, in *QuerySimulateReclaimRequest, opts ...grpc.CallOption) (*QuerySimulateReclaimResponse, error)
+	// GetClaimableYield previews the outcome of a ReclaimTrustDepositYield by account.
+	GetClaimableYield(ctx context.Context, in *QueryGetClaimableYieldRequest, opts ...grpc.CallOption) (*QueryGetClaimableYieldResponse, error)
+	// GetTrustDepositDelegation returns the delegated part of the trust deposit of account.
+	GetTrustDepositDelegation(ctx context.Context, in *QueryGetTrustDepositDelegationRequest, opts ...grpc.CallOption) (*QueryGetTrustDepositDelegationResponse, error)
 }
 
 type queryClient struct {
@@ -58,6 +82,69 @@ func (c *queryClient) GetTrustDeposit(ctx context.Context, in *QueryGetTrustDepo
 	return out, nil
 }
 
+func (c *queryClient) ListSlashDistributions(ctx context.Context, in *QueryListSlashDistributionsRequest, opts ...grpc.CallOption) (*QueryListSlashDistributionsResponse, error) {
+	out := new(QueryListSlashDistributionsResponse)
+	err := c.cc.Invoke(ctx, Query_ListSlashDistributions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListUnbondings(ctx context.Context, in *QueryListUnbondingsRequest, opts ...grpc.CallOption) (*QueryListUnbondingsResponse, error) {
+	out := new(QueryListUnbondingsResponse)
+	err := c.cc.Invoke(ctx, Query_ListUnbondings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListYieldRecords(ctx context.Context, in *QueryListYieldRecordsRequest, opts ...grpc.CallOption) (*QueryListYieldRecordsResponse, error) {
+	out := new(QueryListYieldRecordsResponse)
+	err := c.cc.Invoke(ctx, Query_ListYieldRecords_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetYieldReserve(ctx context.Context, in *QueryGetYieldReserveRequest, opts ...grpc.CallOption) (*QueryGetYieldReserveResponse, error) {
+	out := new(QueryGetYieldReserveResponse)
+	err := c.cc.Invoke(ctx, Query_GetYieldReserve_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateReclaim(ctx context.Context, in *QuerySimulateReclaimRequest, opts ...grpc.CallOption) (*QuerySimulateReclaimResponse, error) {
+	out := new(QuerySimulateReclaimResponse)
+	err := c.cc.Invoke(ctx, Query_SimulateReclaim_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetClaimableYield(ctx context.Context, in *QueryGetClaimableYieldRequest, opts ...grpc.CallOption) (*QueryGetClaimableYieldResponse, error) {
+	out := new(QueryGetClaimableYieldResponse)
+	err := c.cc.Invoke(ctx, Query_GetClaimableYield_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetTrustDepositDelegation(ctx context.Context, in *QueryGetTrustDepositDelegationRequest, opts ...grpc.CallOption) (*QueryGetTrustDepositDelegationResponse, error) {
+	out := new(QueryGetTrustDepositDelegationResponse)
+	err := c.cc.Invoke(ctx, Query_GetTrustDepositDelegation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -65,6 +152,23 @@ type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	GetTrustDeposit(context.Context, *QueryGetTrustDepositRequest) (*QueryGetTrustDepositResponse, error)
+	// ListSlashDistributions lists where slashed trust deposits went, filtered
+	// by slashed account and/or recipient.
+	ListSlashDistributions(context.Context, *QueryListSlashDistributionsRequest) (*QueryListSlashDistributionsResponse, error)
+	// ListUnbondings lists the reclaimed trust deposits waiting for the end of
+	// the unbonding period, optionally filtered by account.
+	ListUnbondings(context.Context, *QueryListUnbondingsRequest) (*QueryListUnbondingsResponse, error)
+	// ListYieldRecords lists the most recent yield distributions, newest first,
+	// with their annualized yield.
+	ListYieldRecords(context.Context, *QueryListYieldRecordsRequest) (*QueryListYieldRecordsResponse, error)
+	// GetYieldReserve returns the amount left to be released as yield.
+	GetYieldReserve(context.Context, *QueryGetYieldReserveRequest) (*QueryGetYieldReserveResponse, error)
+	// SimulateReclaim previews the outcome of a ReclaimTrustDeposit of amount by account.
+	SimulateReclaim(context.Context, *QuerySimulateReclaimRequest) (*QuerySimulateReclaimResponse, error)
+	// GetClaimableYield previews the outcome of a ReclaimTrustDepositYield by account.
+	GetClaimableYield(context.Context, *QueryGetClaimableYieldRequest) (*QueryGetClaimableYieldResponse, error)
+	// GetTrustDepositDelegation returns the delegated part of the trust deposit of account.
+	GetTrustDepositDelegation(context.Context, *QueryGetTrustDepositDelegationRequest) (*QueryGetTrustDepositDelegationResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -78,6 +182,27 @@ func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*Q
 func (UnimplementedQueryServer) GetTrustDeposit(context.Context, *QueryGetTrustDepositRequest) (*QueryGetTrustDepositResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTrustDeposit not implemented")
 }
+func (UnimplementedQueryServer) ListSlashDistributions(context.Context, *QueryListSlashDistributionsRequest) (*QueryListSlashDistributionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSlashDistributions not implemented")
+}
+func (UnimplementedQueryServer) ListUnbondings(context.Context, *QueryListUnbondingsRequest) (*QueryListUnbondingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUnbondings not implemented")
+}
+func (UnimplementedQueryServer) ListYieldRecords(context.Context, *QueryListYieldRecordsRequest) (*QueryListYieldRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListYieldRecords not implemented")
+}
+func (UnimplementedQueryServer) GetYieldReserve(context.Context, *QueryGetYieldReserveRequest) (*QueryGetYieldReserveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetYieldReserve not implemented")
+}
+func (UnimplementedQueryServer) SimulateReclaim(context.Context, *QuerySimulateReclaimRequest) (*QuerySimulateReclaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateReclaim not implemented")
+}
+func (UnimplementedQueryServer) GetClaimableYield(context.Context, *QueryGetClaimableYieldRequest) (*QueryGetClaimableYieldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClaimableYield not implemented")
+}
+func (UnimplementedQueryServer) GetTrustDepositDelegation(context.Context, *QueryGetTrustDepositDelegationRequest) (*QueryGetTrustDepositDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrustDepositDelegation not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -127,6 +252,132 @@ func _Query_GetTrustDeposit_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ListSlashDistributions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListSlashDistributionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListSlashDistributions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListSlashDistributions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListSlashDistributions(ctx, req.(*QueryListSlashDistributionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListUnbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListUnbondingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListUnbondings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListUnbondings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListUnbondings(ctx, req.(*QueryListUnbondingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListYieldRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListYieldRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListYieldRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListYieldRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListYieldRecords(ctx, req.(*QueryListYieldRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetYieldReserve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetYieldReserveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetYieldReserve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetYieldReserve_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetYieldReserve(ctx, req.(*QueryGetYieldReserveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateReclaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateReclaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateReclaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateReclaim_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateReclaim(ctx, req.(*QuerySimulateReclaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetClaimableYield_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetClaimableYieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetClaimableYield(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetClaimableYield_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetClaimableYield(ctx, req.(*QueryGetClaimableYieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetTrustDepositDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTrustDepositDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTrustDepositDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetTrustDepositDelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTrustDepositDelegation(ctx, req.(*QueryGetTrustDepositDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -142,6 +393,34 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTrustDeposit",
 			Handler:    _Query_GetTrustDeposit_Handler,
 		},
+		{
+			MethodName: "ListSlashDistributions",
+			Handler:    _Query_ListSlashDistributions_Handler,
+		},
+		{
+			MethodName: "ListUnbondings",
+			Handler:    _Query_ListUnbondings_Handler,
+		},
+		{
+			MethodName: "ListYieldRecords",
+			Handler:    _Query_ListYieldRecords_Handler,
+		},
+		{
+			MethodName: "GetYieldReserve",
+			Handler:    _Query_GetYieldReserve_Handler,
+		},
+		{
+			MethodName: "SimulateReclaim",
+			Handler:    _Query_SimulateReclaim_Handler,
+		},
+		{
+			MethodName: "GetClaimableYield",
+			Handler:    _Query_GetClaimableYield_Handler,
+		},
+		{
+			MethodName: "GetTrustDepositDelegation",
+			Handler:    _Query_GetTrustDepositDelegation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "verana/td/v1/query.proto",
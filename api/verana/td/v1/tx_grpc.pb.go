@@ -23,6 +23,9 @@ const (
 	Msg_ReclaimTrustDepositYield_FullMethodName = "/verana.td.v1.Msg/ReclaimTrustDepositYield"
 	Msg_ReclaimTrustDeposit_FullMethodName      = "/verana.td.v1.Msg/ReclaimTrustDeposit"
 	Msg_RepaySlashedTrustDeposit_FullMethodName = "/verana.td.v1.Msg/RepaySlashedTrustDeposit"
+	Msg_FundYieldReserve_FullMethodName         = "/verana.td.v1.Msg/FundYieldReserve"
+	Msg_DelegateTrustDeposit_FullMethodName     = "/verana.td.v1.Msg/DelegateTrustDeposit"
+	Msg_UndelegateTrustDeposit_FullMethodName   = "/verana.td.v1.Msg/UndelegateTrustDeposit"
 )
 
 // MsgClient is the client API for Msg service.
@@ -34,8 +37,11 @@ type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	ReclaimTrustDepositYield(ctx context.Context, in *MsgReclaimTrustDepositYield, opts ...grpc.CallOption) (*MsgReclaimTrustDepositYieldResponse, error)
 	ReclaimTrustDeposit(ctx context.Context, in *MsgReclaimTrustDeposit, opts ...grpc.CallOption) (*MsgReclaimTrustDepositResponse, error)
-	// rpc SlashTrustDeposit(MsgSlashTrustDeposit) returns (MsgSlashTrustDepositResponse);
+	//  rpc SlashTrustDeposit(MsgSlashTrustDeposit) returns (MsgSlashTrustDepositResponse);
 	RepaySlashedTrustDeposit(ctx context.Context, in *MsgRepaySlashedTrustDeposit, opts ...grpc.CallOption) (*MsgRepaySlashedTrustDepositResponse, error)
+	FundYieldReserve(ctx context.Context, in *MsgFundYieldReserve, opts ...grpc.CallOption) (*MsgFundYieldReserveResponse, error)
+	DelegateTrustDeposit(ctx context.Context, in *MsgDelegateTrustDeposit, opts ...grpc.CallOption) (*MsgDelegateTrustDepositResponse, error)
+	UndelegateTrustDeposit(ctx context.Context, in *MsgUndelegateTrustDeposit, opts ...grpc.CallOption) (*MsgUndelegateTrustDepositResponse, error)
 }
 
 type msgClient struct {
@@ -82,6 +88,33 @@ func (c *msgClient) RepaySlashedTrustDeposit(ctx context.Context, in *MsgRepaySl
 	return out, nil
 }
 
+func (c *msgClient) FundYieldReserve(ctx context.Context, in *MsgFundYieldReserve, opts ...grpc.CallOption) (*MsgFundYieldReserveResponse, error) {
+	out := new(MsgFundYieldReserveResponse)
+	err := c.cc.Invoke(ctx, Msg_FundYieldReserve_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DelegateTrustDeposit(ctx context.Context, in *MsgDelegateTrustDeposit, opts ...grpc.CallOption) (*MsgDelegateTrustDepositResponse, error) {
+	out := new(MsgDelegateTrustDepositResponse)
+	err := c.cc.Invoke(ctx, Msg_DelegateTrustDeposit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UndelegateTrustDeposit(ctx context.Context, in *MsgUndelegateTrustDeposit, opts ...grpc.CallOption) (*MsgUndelegateTrustDepositResponse, error) {
+	out := new(MsgUndelegateTrustDepositResponse)
+	err := c.cc.Invoke(ctx, Msg_UndelegateTrustDeposit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -91,8 +124,11 @@ type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	ReclaimTrustDepositYield(context.Context, *MsgReclaimTrustDepositYield) (*MsgReclaimTrustDepositYieldResponse, error)
 	ReclaimTrustDeposit(context.Context, *MsgReclaimTrustDeposit) (*MsgReclaimTrustDepositResponse, error)
-	// rpc SlashTrustDeposit(MsgSlashTrustDeposit) returns (MsgSlashTrustDepositResponse);
+	//  rpc SlashTrustDeposit(MsgSlashTrustDeposit) returns (MsgSlashTrustDepositResponse);
 	RepaySlashedTrustDeposit(context.Context, *MsgRepaySlashedTrustDeposit) (*MsgRepaySlashedTrustDepositResponse, error)
+	FundYieldReserve(context.Context, *MsgFundYieldReserve) (*MsgFundYieldReserveResponse, error)
+	DelegateTrustDeposit(context.Context, *MsgDelegateTrustDeposit) (*MsgDelegateTrustDepositResponse, error)
+	UndelegateTrustDeposit(context.Context, *MsgUndelegateTrustDeposit) (*MsgUndelegateTrustDepositResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -112,6 +148,15 @@ func (UnimplementedMsgServer) ReclaimTrustDeposit(context.Context, *MsgReclaimTr
 func (UnimplementedMsgServer) RepaySlashedTrustDeposit(context.Context, *MsgRepaySlashedTrustDeposit) (*MsgRepaySlashedTrustDepositResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RepaySlashedTrustDeposit not implemented")
 }
+func (UnimplementedMsgServer) FundYieldReserve(context.Context, *MsgFundYieldReserve) (*MsgFundYieldReserveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundYieldReserve not implemented")
+}
+func (UnimplementedMsgServer) DelegateTrustDeposit(context.Context, *MsgDelegateTrustDeposit) (*MsgDelegateTrustDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateTrustDeposit not implemented")
+}
+func (UnimplementedMsgServer) UndelegateTrustDeposit(context.Context, *MsgUndelegateTrustDeposit) (*MsgUndelegateTrustDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateTrustDeposit not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -197,6 +242,60 @@ func _Msg_RepaySlashedTrustDeposit_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_FundYieldReserve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundYieldReserve)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundYieldReserve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_FundYieldReserve_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundYieldReserve(ctx, req.(*MsgFundYieldReserve))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DelegateTrustDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateTrustDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateTrustDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DelegateTrustDeposit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateTrustDeposit(ctx, req.(*MsgDelegateTrustDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UndelegateTrustDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateTrustDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateTrustDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UndelegateTrustDeposit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateTrustDeposit(ctx, req.(*MsgUndelegateTrustDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -220,6 +319,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RepaySlashedTrustDeposit",
 			Handler:    _Msg_RepaySlashedTrustDeposit_Handler,
 		},
+		{
+			MethodName: "FundYieldReserve",
+			Handler:    _Msg_FundYieldReserve_Handler,
+		},
+		{
+			MethodName: "DelegateTrustDeposit",
+			Handler:    _Msg_DelegateTrustDeposit_Handler,
+		},
+		{
+			MethodName: "UndelegateTrustDeposit",
+			Handler:    _Msg_UndelegateTrustDeposit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "verana/td/v1/tx.proto",
@@ -19,9 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName              = "/verana.tr.v1.Query/Params"
-	Query_GetTrustRegistry_FullMethodName    = "/verana.tr.v1.Query/GetTrustRegistry"
-	Query_ListTrustRegistries_FullMethodName = "/verana.tr.v1.Query/ListTrustRegistries"
+	Query_Params_FullMethodName                     = "/verana.tr.v1.Query/Params"
+	Query_GetTrustRegistry_FullMethodName           = "/verana.tr.v1.Query/GetTrustRegistry"
+	Query_ListTrustRegistries_FullMethodName        = "/verana.tr.v1.Query/ListTrustRegistries"
+	Query_GetTrustRegistryController_FullMethodName = "/verana.tr.v1.Query/GetTrustRegistryController"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +35,9 @@ type QueryClient interface {
 	GetTrustRegistry(ctx context.Context, in *QueryGetTrustRegistryRequest, opts ...grpc.CallOption) (*QueryGetTrustRegistryResponse, error)
 	// ListTrustRegistries returns a list of Trust Registries
 	ListTrustRegistries(ctx context.Context, in *QueryListTrustRegistriesRequest, opts ...grpc.CallOption) (*QueryListTrustRegistriesResponse, error)
+	// GetTrustRegistryController returns the controller of a trust registry and,
+	// when it is an x/group policy, the policy and the members of its group.
+	GetTrustRegistryController(ctx context.Context, in *QueryGetTrustRegistryControllerRequest, opts ...grpc.CallOption) (*QueryGetTrustRegistryControllerResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +75,15 @@ func (c *queryClient) ListTrustRegistries(ctx context.Context, in *QueryListTrus
 	return out, nil
 }
 
+func (c *queryClient) GetTrustRegistryController(ctx context.Context, in *QueryGetTrustRegistryControllerRequest, opts ...grpc.CallOption) (*QueryGetTrustRegistryControllerResponse, error) {
+	out := new(QueryGetTrustRegistryControllerResponse)
+	err := c.cc.Invoke(ctx, Query_GetTrustRegistryController_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +94,9 @@ type QueryServer interface {
 	GetTrustRegistry(context.Context, *QueryGetTrustRegistryRequest) (*QueryGetTrustRegistryResponse, error)
 	// ListTrustRegistries returns a list of Trust Registries
 	ListTrustRegistries(context.Context, *QueryListTrustRegistriesRequest) (*QueryListTrustRegistriesResponse, error)
+	// GetTrustRegistryController returns the controller of a trust registry and,
+	// when it is an x/group policy, the policy and the members of its group.
+	GetTrustRegistryController(context.Context, *QueryGetTrustRegistryControllerRequest) (*QueryGetTrustRegistryControllerResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +113,9 @@ func (UnimplementedQueryServer) GetTrustRegistry(context.Context, *QueryGetTrust
 func (UnimplementedQueryServer) ListTrustRegistries(context.Context, *QueryListTrustRegistriesRequest) (*QueryListTrustRegistriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTrustRegistries not implemented")
 }
+func (UnimplementedQueryServer) GetTrustRegistryController(context.Context, *QueryGetTrustRegistryControllerRequest) (*QueryGetTrustRegistryControllerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrustRegistryController not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +183,24 @@ func _Query_ListTrustRegistries_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetTrustRegistryController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTrustRegistryControllerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTrustRegistryController(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetTrustRegistryController_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTrustRegistryController(ctx, req.(*QueryGetTrustRegistryControllerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +220,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListTrustRegistries",
 			Handler:    _Query_ListTrustRegistries_Handler,
 		},
+		{
+			MethodName: "GetTrustRegistryController",
+			Handler:    _Query_GetTrustRegistryController_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "verana/tr/v1/query.proto",
@@ -25,6 +25,7 @@ const (
 	Msg_IncreaseActiveGovernanceFrameworkVersion_FullMethodName = "/verana.tr.v1.Msg/IncreaseActiveGovernanceFrameworkVersion"
 	Msg_UpdateTrustRegistry_FullMethodName                      = "/verana.tr.v1.Msg/UpdateTrustRegistry"
 	Msg_ArchiveTrustRegistry_FullMethodName                     = "/verana.tr.v1.Msg/ArchiveTrustRegistry"
+	Msg_UpdateTrustRegistryController_FullMethodName            = "/verana.tr.v1.Msg/UpdateTrustRegistryController"
 )
 
 // MsgClient is the client API for Msg service.
@@ -40,6 +41,7 @@ type MsgClient interface {
 	IncreaseActiveGovernanceFrameworkVersion(ctx context.Context, in *MsgIncreaseActiveGovernanceFrameworkVersion, opts ...grpc.CallOption) (*MsgIncreaseActiveGovernanceFrameworkVersionResponse, error)
 	UpdateTrustRegistry(ctx context.Context, in *MsgUpdateTrustRegistry, opts ...grpc.CallOption) (*MsgUpdateTrustRegistryResponse, error)
 	ArchiveTrustRegistry(ctx context.Context, in *MsgArchiveTrustRegistry, opts ...grpc.CallOption) (*MsgArchiveTrustRegistryResponse, error)
+	UpdateTrustRegistryController(ctx context.Context, in *MsgUpdateTrustRegistryController, opts ...grpc.CallOption) (*MsgUpdateTrustRegistryControllerResponse, error)
 }
 
 type msgClient struct {
@@ -104,6 +106,15 @@ func (c *msgClient) ArchiveTrustRegistry(ctx context.Context, in *MsgArchiveTrus
 	return out, nil
 }
 
+func (c *msgClient) UpdateTrustRegistryController(ctx context.Context, in *MsgUpdateTrustRegistryController, opts ...grpc.CallOption) (*MsgUpdateTrustRegistryControllerResponse, error) {
+	out := new(MsgUpdateTrustRegistryControllerResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateTrustRegistryController_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -117,6 +128,7 @@ type MsgServer interface {
 	IncreaseActiveGovernanceFrameworkVersion(context.Context, *MsgIncreaseActiveGovernanceFrameworkVersion) (*MsgIncreaseActiveGovernanceFrameworkVersionResponse, error)
 	UpdateTrustRegistry(context.Context, *MsgUpdateTrustRegistry) (*MsgUpdateTrustRegistryResponse, error)
 	ArchiveTrustRegistry(context.Context, *MsgArchiveTrustRegistry) (*MsgArchiveTrustRegistryResponse, error)
+	UpdateTrustRegistryController(context.Context, *MsgUpdateTrustRegistryController) (*MsgUpdateTrustRegistryControllerResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -142,6 +154,9 @@ func (UnimplementedMsgServer) UpdateTrustRegistry(context.Context, *MsgUpdateTru
 func (UnimplementedMsgServer) ArchiveTrustRegistry(context.Context, *MsgArchiveTrustRegistry) (*MsgArchiveTrustRegistryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ArchiveTrustRegistry not implemented")
 }
+func (UnimplementedMsgServer) UpdateTrustRegistryController(context.Context, *MsgUpdateTrustRegistryController) (*MsgUpdateTrustRegistryControllerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTrustRegistryController not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -263,6 +278,24 @@ func _Msg_ArchiveTrustRegistry_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateTrustRegistryController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTrustRegistryController)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTrustRegistryController(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateTrustRegistryController_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTrustRegistryController(ctx, req.(*MsgUpdateTrustRegistryController))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -294,6 +327,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ArchiveTrustRegistry",
 			Handler:    _Msg_ArchiveTrustRegistry_Handler,
 		},
+		{
+			MethodName: "UpdateTrustRegistryController",
+			Handler:    _Msg_UpdateTrustRegistryController_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "verana/tr/v1/tx.proto",
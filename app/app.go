@@ -351,6 +351,10 @@ func New(
 	// build app
 	app.App = appBuilder.Build(db, traceStore, baseAppOptions...)
 
+	// spend limited PermissionAuthorization grants quote the fees of the
+	// messages they accept with the perm keeper carried by the tx context
+	app.SetAnteHandler(permissionmodulekeeper.NewFeeQuoterAnteHandler(app.PermissionKeeper, app.AnteHandler()))
+
 	// register legacy modules
 	if err := app.registerIBCModules(appOpts); err != nil {
 		return nil, err
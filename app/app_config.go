@@ -121,6 +121,9 @@ var (
 	beginBlockers = []string{
 		// cosmos sdk modules
 		minttypes.ModuleName,
+		// trust deposit takes its share of the fee collector before the
+		// distribution module hands it out to validators and delegators
+		trustdepositmoduletypes.ModuleName,
 		distrtypes.ModuleName,
 		slashingtypes.ModuleName,
 		evidencetypes.ModuleName,
@@ -138,7 +141,6 @@ var (
 		diddirectorymoduletypes.ModuleName,
 		credentialschemamoduletypes.ModuleName,
 		permissionmoduletypes.ModuleName,
-		trustdepositmoduletypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/beginBlockers
 	}
 
@@ -146,6 +148,9 @@ var (
 		// cosmos sdk modules
 		crisistypes.ModuleName,
 		govtypes.ModuleName,
+		// trust deposit reads the unbonding delegations of its delegated
+		// deposits before staking completes them
+		trustdepositmoduletypes.ModuleName,
 		stakingtypes.ModuleName,
 		feegrant.ModuleName,
 		group.ModuleName,
@@ -161,7 +166,6 @@ var (
 		diddirectorymoduletypes.ModuleName,
 		credentialschemamoduletypes.ModuleName,
 		permissionmoduletypes.ModuleName,
-		trustdepositmoduletypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/endBlockers
 	}
 
@@ -0,0 +1,94 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/stretchr/testify/require"
+
+	permissionkeeper "github.com/verana-labs/verana-blockchain/x/permission/keeper"
+	permissiontypes "github.com/verana-labs/verana-blockchain/x/permission/types"
+)
+
+func TestPermissionAuthorizationExec(t *testing.T) {
+	a, accs := setupExportTestApp(t)
+	populateVeranaState(t, a, accs)
+
+	blockTime := time.Now().UTC()
+	ctx := a.BaseApp.NewUncachedContext(false, cmtproto.Header{Height: a.LastBlockHeight() + 1, Time: blockTime})
+
+	// the issuer perm created while populating the state is slashed, start a new one
+	permMsgServer := permissionkeeper.NewMsgServerImpl(a.PermissionKeeper)
+	_, err := permMsgServer.StartPermissionVP(ctx, &permissiontypes.MsgStartPermissionVP{
+		Creator:         accs.issuer,
+		Type:            uint32(permissiontypes.PermissionType_PERMISSION_TYPE_ISSUER),
+		ValidatorPermId: 1,
+		Countries:       []string{"US"},
+	})
+	require.NoError(t, err)
+	_, err = permMsgServer.SetPermissionVPToValidated(ctx, &permissiontypes.MsgSetPermissionVPToValidated{
+		Creator:            accs.controller,
+		Id:                 4,
+		IssuanceFees:       5,
+		Countries:          []string{"US"},
+		VpSummaryDigestSri: exportTestDigestSri,
+	})
+	require.NoError(t, err)
+
+	granter := sdk.MustAccAddressFromBech32(accs.issuer)
+	grantee := sdk.MustAccAddressFromBech32(accs.wallet)
+	sessionURL := sdk.MsgTypeURL(&permissiontypes.MsgCreateOrUpdatePermissionSession{})
+
+	newSession := func(id string, issuerPermID uint64) *permissiontypes.MsgCreateOrUpdatePermissionSession {
+		return &permissiontypes.MsgCreateOrUpdatePermissionSession{
+			Creator:      accs.issuer,
+			Id:           id,
+			IssuerPermId: issuerPermID,
+			AgentPermId:  3,
+		}
+	}
+
+	fees, err := a.PermissionKeeper.QuoteMsgFees(ctx, newSession("5f0c3e7a-8a2b-4c1d-9e6f-1a2b3c4d5e6f", 4))
+	require.NoError(t, err)
+	require.False(t, fees.IsZero())
+
+	// grant two sessions worth of fees on perm 4 only
+	expiration := blockTime.Add(time.Hour)
+	authorization := permissiontypes.NewPermissionAuthorization(sessionURL, []uint64{4}, fees.Add(fees...), &expiration)
+	grantMsg, err := authz.NewMsgGrant(granter, grantee, authorization, &expiration)
+	require.NoError(t, err)
+	_, err = a.AuthzKeeper.Grant(ctx, grantMsg)
+	require.NoError(t, err)
+
+	exec := func(msg sdk.Msg) error {
+		execMsg := authz.NewMsgExec(grantee, []sdk.Msg{msg})
+		_, err := a.AuthzKeeper.Exec(ctx, &execMsg)
+		return err
+	}
+
+	// spend limits cannot be enforced outside of a tx context carrying the quoter
+	require.ErrorContains(t, exec(newSession("5f0c3e7a-8a2b-4c1d-9e6f-1a2b3c4d5e6f", 4)), "no fee quoter")
+	ctx, err = permissionkeeper.NewFeeQuoterAnteHandler(a.PermissionKeeper, nil)(ctx, nil, false)
+	require.NoError(t, err)
+
+	// first session reduces the spend limit
+	granterBalance := a.BankKeeper.GetBalance(ctx, granter, permissiontypes.BondDenom)
+	require.NoError(t, exec(newSession("5f0c3e7a-8a2b-4c1d-9e6f-1a2b3c4d5e6f", 4)))
+	require.True(t, a.BankKeeper.GetBalance(ctx, granter, permissiontypes.BondDenom).IsLT(granterBalance))
+
+	updated, _ := a.AuthzKeeper.GetAuthorization(ctx, grantee, granter, sessionURL)
+	require.NotNil(t, updated)
+	require.Equal(t, fees, updated.(*permissiontypes.PermissionAuthorization).SpendLimit)
+
+	// perms outside of the grant are rejected
+	require.Error(t, exec(&permissiontypes.MsgRevokePermission{Creator: accs.issuer, Id: 3}))
+
+	// second session exhausts the spend limit and removes the grant
+	require.NoError(t, exec(newSession("6a1d4f8b-9b3c-4d2e-8f7a-2b3c4d5e6f70", 4)))
+	updated, _ = a.AuthzKeeper.GetAuthorization(ctx, grantee, granter, sessionURL)
+	require.Nil(t, updated)
+	require.Error(t, exec(newSession("7b2e5a9c-ac4d-4e3f-9a8b-3c4d5e6f7a81", 4)))
+}
@@ -0,0 +1,460 @@
+package app_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/verana-labs/verana-blockchain/app"
+	credentialschemakeeper "github.com/verana-labs/verana-blockchain/x/credentialschema/keeper"
+	credentialschematypes "github.com/verana-labs/verana-blockchain/x/credentialschema/types"
+	diddirectorykeeper "github.com/verana-labs/verana-blockchain/x/diddirectory/keeper"
+	diddirectorytypes "github.com/verana-labs/verana-blockchain/x/diddirectory/types"
+	permissionkeeper "github.com/verana-labs/verana-blockchain/x/permission/keeper"
+	permissiontypes "github.com/verana-labs/verana-blockchain/x/permission/types"
+	trustdeposittypes "github.com/verana-labs/verana-blockchain/x/trustdeposit/types"
+	trustregistrykeeper "github.com/verana-labs/verana-blockchain/x/trustregistry/keeper"
+	trustregistrytypes "github.com/verana-labs/verana-blockchain/x/trustregistry/types"
+)
+
+const exportTestSchema = `{
+  "$id": "vpr:verana:mainnet/cs/v1/js/1",
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "title": "ExampleCredential",
+  "description": "ExampleCredential using JsonSchema",
+  "type": "object",
+  "properties": {
+    "credentialSubject": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string",
+          "format": "uri"
+        }
+      },
+      "required": ["id"]
+    }
+  }
+}`
+
+const exportTestDigestSri = "sha384-MzNNbQTWCSUSi0bbz7dbua+RcENv7C6FvlmYJ1Y+I727HsPOHdzwELMYO9Mz68M26"
+
+// veranaModules are the modules whose state must survive an export/import round trip.
+var veranaModules = []string{
+	trustregistrytypes.ModuleName,
+	diddirectorytypes.ModuleName,
+	credentialschematypes.ModuleName,
+	trustdeposittypes.ModuleName,
+	permissiontypes.ModuleName,
+}
+
+// exportTestAccounts are the funded accounts used to populate the chain.
+type exportTestAccounts struct {
+	controller string
+	issuer     string
+	holder     string
+	wallet     string
+}
+
+func TestVeranaGenesisRoundTrip(t *testing.T) {
+	source, accs := setupExportTestApp(t)
+	populateVeranaState(t, source, accs)
+
+	// export at zero height
+	exported, err := source.ExportAppStateAndValidators(true, nil, nil)
+	require.NoError(t, err)
+
+	var genesisState app.GenesisState
+	require.NoError(t, json.Unmarshal(exported.AppState, &genesisState))
+
+	// validate
+	validateVeranaGenesis(t, source, genesisState)
+
+	// the exported state must carry what was populated
+	var csGenesis credentialschematypes.GenesisState
+	source.AppCodec().MustUnmarshalJSON(genesisState[credentialschematypes.ModuleName], &csGenesis)
+	require.Len(t, csGenesis.CredentialSchemas, 2)
+	require.NotNil(t, csGenesis.CredentialSchemas[1].Archived)
+	require.Equal(t, uint64(2), csGenesis.SchemaCounter)
+
+	var trGenesis trustregistrytypes.GenesisState
+	source.AppCodec().MustUnmarshalJSON(genesisState[trustregistrytypes.ModuleName], &trGenesis)
+	require.Len(t, trGenesis.GovernanceFrameworkVersions, 2)
+	require.Equal(t, int32(2), trGenesis.TrustRegistries[0].ActiveVersion)
+
+	var permGenesis permissiontypes.GenesisState
+	source.AppCodec().MustUnmarshalJSON(genesisState[permissiontypes.ModuleName], &permGenesis)
+	require.Len(t, permGenesis.Permissions, 3)
+	require.Len(t, permGenesis.PermissionSessions, 1)
+	require.Equal(t, uint64(4), permGenesis.NextPermissionId)
+	require.Len(t, permGenesis.SlashRecords, 2)
+	require.Equal(t, permissiontypes.SlashStatus_SLASH_STATUS_EXECUTED, permGenesis.SlashRecords[0].Status)
+	require.Equal(t, permissiontypes.SlashStatus_SLASH_STATUS_PENDING, permGenesis.SlashRecords[1].Status)
+	require.Len(t, permGenesis.SlashDistributions, 1)
+
+	var tdGenesis trustdeposittypes.GenesisState
+	source.AppCodec().MustUnmarshalJSON(genesisState[trustdeposittypes.ModuleName], &tdGenesis)
+	slashed := false
+	for _, td := range tdGenesis.TrustDeposits {
+		if td.Account == accs.issuer {
+			slashed = td.SlashedDeposit > 0 && td.SlashCount == 1 && td.LastSlashed != nil
+		}
+	}
+	require.True(t, slashed, "slashed trust deposit must be exported")
+	require.Len(t, tdGenesis.SlashDistributions, 1)
+	require.Equal(t, trustdeposittypes.SlashDestination_SLASH_DESTINATION_COMMUNITY_POOL, tdGenesis.SlashDistributions[0].Destination)
+	require.Equal(t, uint64(2), tdGenesis.NextSlashDistributionId)
+
+	// import into a fresh chain
+	target := newExportTestApp(t)
+	ctx := target.BaseApp.NewUncachedContext(false, cmtproto.Header{Time: time.Now().UTC()})
+	for _, name := range veranaModules {
+		mod, ok := target.ModuleManager.Modules[name].(module.HasGenesis)
+		require.True(t, ok, name)
+		mod.InitGenesis(ctx, target.AppCodec(), genesisState[name])
+	}
+
+	// compare
+	reExported, err := target.ModuleManager.ExportGenesisForModules(ctx, target.AppCodec(), veranaModules)
+	require.NoError(t, err)
+	for _, name := range veranaModules {
+		require.JSONEq(t, string(genesisState[name]), string(reExported[name]), name)
+	}
+}
+
+func TestVeranaGenesisCrossModuleReferences(t *testing.T) {
+	source, accs := setupExportTestApp(t)
+	populateVeranaState(t, source, accs)
+
+	exported, err := source.ExportAppStateAndValidators(true, nil, nil)
+	require.NoError(t, err)
+
+	var genesisState app.GenesisState
+	require.NoError(t, json.Unmarshal(exported.AppState, &genesisState))
+	require.NoError(t, genesisState.ValidateCrossModuleReferences(source.AppCodec()))
+
+	// dropping the trust registries orphans the credential schemas
+	var trGenesis trustregistrytypes.GenesisState
+	source.AppCodec().MustUnmarshalJSON(genesisState[trustregistrytypes.ModuleName], &trGenesis)
+	trGenesis.TrustRegistries = nil
+	trGenesis.GovernanceFrameworkVersions = nil
+	trGenesis.GovernanceFrameworkDocuments = nil
+	broken := copyGenesisState(genesisState)
+	broken[trustregistrytypes.ModuleName] = source.AppCodec().MustMarshalJSON(&trGenesis)
+	require.ErrorContains(t, broken.ValidateCrossModuleReferences(source.AppCodec()), "unknown trust registry ID")
+
+	// dropping the credential schemas orphans the perms
+	var csGenesis credentialschematypes.GenesisState
+	source.AppCodec().MustUnmarshalJSON(genesisState[credentialschematypes.ModuleName], &csGenesis)
+	csGenesis.CredentialSchemas = nil
+	broken = copyGenesisState(genesisState)
+	broken[credentialschematypes.ModuleName] = source.AppCodec().MustMarshalJSON(&csGenesis)
+	require.ErrorContains(t, broken.ValidateCrossModuleReferences(source.AppCodec()), "unknown credential schema ID")
+
+	// dropping the trust deposits orphans the perm deposits
+	var tdGenesis trustdeposittypes.GenesisState
+	source.AppCodec().MustUnmarshalJSON(genesisState[trustdeposittypes.ModuleName], &tdGenesis)
+	tdGenesis.TrustDeposits = nil
+	broken = copyGenesisState(genesisState)
+	broken[trustdeposittypes.ModuleName] = source.AppCodec().MustMarshalJSON(&tdGenesis)
+	require.ErrorContains(t, broken.ValidateCrossModuleReferences(source.AppCodec()), "has no trust deposit")
+}
+
+func TestValidateGenesisChecksCrossModuleReferences(t *testing.T) {
+	source, accs := setupExportTestApp(t)
+	populateVeranaState(t, source, accs)
+
+	exported, err := source.ExportAppStateAndValidators(true, nil, nil)
+	require.NoError(t, err)
+
+	var genesisState app.GenesisState
+	require.NoError(t, json.Unmarshal(exported.AppState, &genesisState))
+
+	basicManager := module.NewBasicManagerFromManager(source.ModuleManager, nil)
+	require.NoError(t, app.ValidateGenesis(basicManager, source.AppCodec(), source.TxConfig(), genesisState))
+
+	// every module genesis is valid on its own, the perms reference dropped schemas
+	var csGenesis credentialschematypes.GenesisState
+	source.AppCodec().MustUnmarshalJSON(genesisState[credentialschematypes.ModuleName], &csGenesis)
+	csGenesis.CredentialSchemas = nil
+	broken := copyGenesisState(genesisState)
+	broken[credentialschematypes.ModuleName] = source.AppCodec().MustMarshalJSON(&csGenesis)
+	require.NoError(t, basicManager.ValidateGenesis(source.AppCodec(), source.TxConfig(), broken))
+	require.ErrorContains(t, app.ValidateGenesis(basicManager, source.AppCodec(), source.TxConfig(), broken), "unknown credential schema ID")
+}
+
+// setupExportTestApp starts a single validator chain with funded accounts.
+func setupExportTestApp(t *testing.T) (*app.App, exportTestAccounts) {
+	t.Helper()
+
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	validator := cmttypes.NewValidator(pubKey, 1)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{validator})
+
+	var (
+		genAccs  []authtypes.GenesisAccount
+		balances []banktypes.Balance
+		addrs    []string
+	)
+	for i := 0; i < 4; i++ {
+		addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+		genAccs = append(genAccs, authtypes.NewBaseAccount(addr, nil, 0, 0))
+		balances = append(balances, banktypes.Balance{
+			Address: addr.String(),
+			Coins: sdk.NewCoins(
+				sdk.NewCoin(permissiontypes.BondDenom, sdkmath.NewInt(1_000_000_000_000)),
+				sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1_000_000_000_000)),
+			),
+		})
+		addrs = append(addrs, addr.String())
+	}
+
+	a := newExportTestApp(t)
+	genesisState, err := simtestutil.GenesisStateWithValSet(a.AppCodec(), a.DefaultGenesis(), valSet, genAccs, balances...)
+	require.NoError(t, err)
+	stateBytes, err := json.MarshalIndent(genesisState, "", " ")
+	require.NoError(t, err)
+
+	_, err = a.InitChain(&abci.RequestInitChain{
+		ChainId:         SimAppChainID,
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	require.NoError(t, err)
+
+	_, err = a.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, Time: time.Now().UTC()})
+	require.NoError(t, err)
+	_, err = a.Commit()
+	require.NoError(t, err)
+
+	return a, exportTestAccounts{
+		controller: addrs[0],
+		issuer:     addrs[1],
+		holder:     addrs[2],
+		wallet:     addrs[3],
+	}
+}
+
+// populateVeranaState drives the Verana msg servers so that every module holds
+// non-trivial state: GF versions, an archived schema, a perm tree with a
+// session, a session allowance and a slashed trust deposit.
+func populateVeranaState(t *testing.T, a *app.App, accs exportTestAccounts) {
+	t.Helper()
+
+	blockTime := time.Now().UTC()
+	ctx := a.BaseApp.NewUncachedContext(false, cmtproto.Header{Height: a.LastBlockHeight() + 1, Time: blockTime})
+
+	trMsgServer := trustregistrykeeper.NewMsgServerImpl(a.TrustregistryKeeper)
+	ddMsgServer := diddirectorykeeper.NewMsgServerImpl(a.DiddirectoryKeeper)
+	csMsgServer := credentialschemakeeper.NewMsgServerImpl(a.CredentialschemaKeeper)
+	permMsgServer := permissionkeeper.NewMsgServerImpl(a.PermissionKeeper)
+
+	// trust registry with a second, active, governance framework version
+	_, err := trMsgServer.CreateTrustRegistry(ctx, &trustregistrytypes.MsgCreateTrustRegistry{
+		Creator:      accs.controller,
+		Did:          "did:example:trustregistry",
+		Language:     "en",
+		DocUrl:       "https://example.com/gf-v1.pdf",
+		DocDigestSri: exportTestDigestSri,
+	})
+	require.NoError(t, err)
+	_, err = trMsgServer.AddGovernanceFrameworkDocument(ctx, &trustregistrytypes.MsgAddGovernanceFrameworkDocument{
+		Creator:      accs.controller,
+		Id:           1,
+		DocLanguage:  "en",
+		DocUrl:       "https://example.com/gf-v2.pdf",
+		DocDigestSri: exportTestDigestSri,
+		Version:      2,
+	})
+	require.NoError(t, err)
+	_, err = trMsgServer.IncreaseActiveGovernanceFrameworkVersion(ctx, &trustregistrytypes.MsgIncreaseActiveGovernanceFrameworkVersion{
+		Creator: accs.controller,
+		Id:      1,
+	})
+	require.NoError(t, err)
+
+	// DID directory entry
+	_, err = ddMsgServer.AddDID(ctx, &diddirectorytypes.MsgAddDID{
+		Creator: accs.wallet,
+		Did:     "did:example:wallet",
+		Years:   1,
+	})
+	require.NoError(t, err)
+
+	// one live and one archived credential schema
+	for i := 0; i < 2; i++ {
+		_, err = csMsgServer.CreateCredentialSchema(ctx, &credentialschematypes.MsgCreateCredentialSchema{
+			Creator:                                 accs.controller,
+			TrId:                                    1,
+			JsonSchema:                              exportTestSchema,
+			IssuerGrantorValidationValidityPeriod:   365,
+			VerifierGrantorValidationValidityPeriod: 365,
+			IssuerValidationValidityPeriod:          180,
+			VerifierValidationValidityPeriod:        180,
+			HolderValidationValidityPeriod:          180,
+			IssuerPermManagementMode:                uint32(credentialschematypes.CredentialSchemaPermManagementMode_ECOSYSTEM),
+			VerifierPermManagementMode:              uint32(credentialschematypes.CredentialSchemaPermManagementMode_ECOSYSTEM),
+		})
+		require.NoError(t, err)
+	}
+	_, err = csMsgServer.ArchiveCredentialSchema(ctx, &credentialschematypes.MsgArchiveCredentialSchema{
+		Creator: accs.controller,
+		Id:      2,
+		Archive: true,
+	})
+	require.NoError(t, err)
+
+	// ECOSYSTEM -> ISSUER -> HOLDER perm tree
+	_, err = permMsgServer.CreateRootPermission(ctx, &permissiontypes.MsgCreateRootPermission{
+		Creator:        accs.controller,
+		SchemaId:       1,
+		Did:            "did:example:ecosystem",
+		Countries:      []string{"US"},
+		ValidationFees: 10,
+		IssuanceFees:   5,
+	})
+	require.NoError(t, err)
+
+	_, err = permMsgServer.StartPermissionVP(ctx, &permissiontypes.MsgStartPermissionVP{
+		Creator:         accs.issuer,
+		Type:            uint32(permissiontypes.PermissionType_PERMISSION_TYPE_ISSUER),
+		ValidatorPermId: 1,
+		Countries:       []string{"US"},
+	})
+	require.NoError(t, err)
+	_, err = permMsgServer.SetPermissionVPToValidated(ctx, &permissiontypes.MsgSetPermissionVPToValidated{
+		Creator:            accs.controller,
+		Id:                 2,
+		IssuanceFees:       5,
+		Countries:          []string{"US"},
+		VpSummaryDigestSri: exportTestDigestSri,
+	})
+	require.NoError(t, err)
+
+	_, err = permMsgServer.StartPermissionVP(ctx, &permissiontypes.MsgStartPermissionVP{
+		Creator:         accs.holder,
+		Type:            uint32(permissiontypes.PermissionType_PERMISSION_TYPE_HOLDER),
+		ValidatorPermId: 2,
+		Countries:       []string{"US"},
+	})
+	require.NoError(t, err)
+	_, err = permMsgServer.SetPermissionVPToValidated(ctx, &permissiontypes.MsgSetPermissionVPToValidated{
+		Creator:   accs.issuer,
+		Id:        3,
+		Countries: []string{"US"},
+	})
+	require.NoError(t, err)
+
+	// issuance session paid by the wallet
+	_, err = permMsgServer.CreateOrUpdatePermissionSession(ctx, &permissiontypes.MsgCreateOrUpdatePermissionSession{
+		Creator:      accs.wallet,
+		Id:           "0b4d6f5e-4a5e-4c8e-9c1a-5b9f7a3b2c10",
+		IssuerPermId: 2,
+		AgentPermId:  3,
+	})
+	require.NoError(t, err)
+
+	// session allowance sponsored by the controller
+	_, err = permMsgServer.CreateSessionAllowance(ctx, &permissiontypes.MsgCreateSessionAllowance{
+		Creator:         accs.controller,
+		SchemaId:        1,
+		Amount:          1000,
+		AllowedCreators: []string{accs.issuer},
+		MaxPerSession:   100,
+	})
+	require.NoError(t, err)
+
+	// slashed deposits of the trust registry perms fund the community pool
+	_, err = permMsgServer.SetSlashDistribution(ctx, &permissiontypes.MsgSetSlashDistribution{
+		Creator: accs.controller,
+		TrId:    1,
+		Distribution: []trustdeposittypes.SlashShare{{
+			Destination: trustdeposittypes.SlashDestination_SLASH_DESTINATION_COMMUNITY_POOL,
+			Weight:      sdkmath.LegacyOneDec(),
+		}},
+	})
+	require.NoError(t, err)
+
+	// slash part of the issuer deposit
+	_, err = permMsgServer.SlashPermissionTrustDeposit(ctx, &permissiontypes.MsgSlashPermissionTrustDeposit{
+		Creator:    accs.controller,
+		Id:         2,
+		Amount:     1,
+		ReasonCode: 1,
+	})
+	require.NoError(t, err)
+
+	_, err = a.FinalizeBlock(&abci.RequestFinalizeBlock{Height: a.LastBlockHeight() + 1, Time: blockTime})
+	require.NoError(t, err)
+	_, err = a.Commit()
+	require.NoError(t, err)
+
+	// the unappealed slash is executed once its appeal period is over
+	blockTime = blockTime.AddDate(0, 0, int(permissiontypes.DefaultSlashAppealPeriodDays))
+	_, err = a.FinalizeBlock(&abci.RequestFinalizeBlock{Height: a.LastBlockHeight() + 1, Time: blockTime})
+	require.NoError(t, err)
+	_, err = a.Commit()
+	require.NoError(t, err)
+
+	// a second slash is left pending
+	ctx = a.BaseApp.NewUncachedContext(false, cmtproto.Header{Height: a.LastBlockHeight() + 1, Time: blockTime})
+	_, err = permMsgServer.SlashPermissionTrustDeposit(ctx, &permissiontypes.MsgSlashPermissionTrustDeposit{
+		Creator:    accs.controller,
+		Id:         2,
+		Amount:     1,
+		ReasonCode: 1,
+	})
+	require.NoError(t, err)
+
+	_, err = a.FinalizeBlock(&abci.RequestFinalizeBlock{Height: a.LastBlockHeight() + 1, Time: blockTime})
+	require.NoError(t, err)
+	_, err = a.Commit()
+	require.NoError(t, err)
+}
+
+// validateVeranaGenesis runs the module level and the cross-module validation.
+func validateVeranaGenesis(t *testing.T, a *app.App, genesisState app.GenesisState) {
+	t.Helper()
+
+	for _, name := range veranaModules {
+		mod, ok := a.ModuleManager.Modules[name].(module.HasGenesisBasics)
+		require.True(t, ok, name)
+		require.NoError(t, mod.ValidateGenesis(a.AppCodec(), a.TxConfig(), genesisState[name]), name)
+	}
+	require.NoError(t, genesisState.ValidateCrossModuleReferences(a.AppCodec()))
+}
+
+func newExportTestApp(t *testing.T) *app.App {
+	t.Helper()
+
+	a, err := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{}, baseapp.SetChainID(SimAppChainID))
+	require.NoError(t, err)
+	return a
+}
+
+func copyGenesisState(gs app.GenesisState) app.GenesisState {
+	cp := make(app.GenesisState, len(gs))
+	for k, v := range gs {
+		cp[k] = v
+	}
+	return cp
+}
@@ -2,6 +2,17 @@ package app
 
 import (
 	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/gogoproto/proto"
+
+	credentialschematypes "github.com/verana-labs/verana-blockchain/x/credentialschema/types"
+	permissiontypes "github.com/verana-labs/verana-blockchain/x/permission/types"
+	trustdeposittypes "github.com/verana-labs/verana-blockchain/x/trustdeposit/types"
+	trustregistrytypes "github.com/verana-labs/verana-blockchain/x/trustregistry/types"
 )
 
 // GenesisState of the blockchain is represented here as a map of raw json
@@ -12,3 +23,89 @@ import (
 // the ModuleBasicManager which populates json from each BasicModule
 // object provided to it during init.
 type GenesisState map[string]json.RawMessage
+
+// ValidateGenesis validates the genesis state of every module, then the
+// references between the Verana module genesis states. It backs the
+// validate-genesis command so that dangling references are reported before the
+// chain starts.
+func ValidateGenesis(mbm module.BasicManager, cdc codec.JSONCodec, txConfig client.TxEncodingConfig, gs GenesisState) error {
+	if err := mbm.ValidateGenesis(cdc, txConfig, gs); err != nil {
+		return err
+	}
+	return gs.ValidateCrossModuleReferences(cdc)
+}
+
+// ValidateCrossModuleReferences checks the references between the Verana module
+// genesis states that a single module cannot see on its own:
+// Permission.schema_id -> CredentialSchema.tr_id -> TrustRegistry, and perm
+// deposits -> TrustDeposit. Modules missing from the genesis are skipped.
+func (gs GenesisState) ValidateCrossModuleReferences(cdc codec.JSONCodec) error {
+	var trGenesis trustregistrytypes.GenesisState
+	hasTr, err := gs.unmarshalModuleGenesis(cdc, trustregistrytypes.ModuleName, &trGenesis)
+	if err != nil {
+		return err
+	}
+
+	var csGenesis credentialschematypes.GenesisState
+	hasCs, err := gs.unmarshalModuleGenesis(cdc, credentialschematypes.ModuleName, &csGenesis)
+	if err != nil {
+		return err
+	}
+
+	var tdGenesis trustdeposittypes.GenesisState
+	hasTd, err := gs.unmarshalModuleGenesis(cdc, trustdeposittypes.ModuleName, &tdGenesis)
+	if err != nil {
+		return err
+	}
+
+	var permGenesis permissiontypes.GenesisState
+	hasPerm, err := gs.unmarshalModuleGenesis(cdc, permissiontypes.ModuleName, &permGenesis)
+	if err != nil {
+		return err
+	}
+
+	if hasTr && hasCs {
+		trustRegistryIDs := make(map[uint64]bool, len(trGenesis.TrustRegistries))
+		for _, tr := range trGenesis.TrustRegistries {
+			trustRegistryIDs[tr.Id] = true
+		}
+		if err := csGenesis.ValidateTrustRegistryReferences(trustRegistryIDs); err != nil {
+			return fmt.Errorf("%s genesis: %w", credentialschematypes.ModuleName, err)
+		}
+	}
+
+	if hasCs && hasPerm {
+		schemaIDs := make(map[uint64]bool, len(csGenesis.CredentialSchemas))
+		for _, cs := range csGenesis.CredentialSchemas {
+			schemaIDs[cs.Id] = true
+		}
+		if err := permGenesis.ValidateSchemaReferences(schemaIDs); err != nil {
+			return fmt.Errorf("%s genesis: %w", permissiontypes.ModuleName, err)
+		}
+	}
+
+	if hasTd && hasPerm {
+		trustDepositAccounts := make(map[string]bool, len(tdGenesis.TrustDeposits))
+		for _, td := range tdGenesis.TrustDeposits {
+			trustDepositAccounts[td.Account] = true
+		}
+		if err := permGenesis.ValidateTrustDepositReferences(trustDepositAccounts); err != nil {
+			return fmt.Errorf("%s genesis: %w", permissiontypes.ModuleName, err)
+		}
+	}
+
+	return nil
+}
+
+// unmarshalModuleGenesis decodes the genesis of the given module into target and
+// reports whether the module is present at all.
+func (gs GenesisState) unmarshalModuleGenesis(cdc codec.JSONCodec, moduleName string, target proto.Message) (bool, error) {
+	bz, ok := gs[moduleName]
+	if !ok || len(bz) == 0 {
+		return false, nil
+	}
+	if err := cdc.UnmarshalJSON(bz, target); err != nil {
+		return false, fmt.Errorf("failed to unmarshal %s genesis state: %w", moduleName, err)
+	}
+	return true, nil
+}
@@ -0,0 +1,155 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/stretchr/testify/require"
+
+	"github.com/verana-labs/verana-blockchain/app"
+	permissiontypes "github.com/verana-labs/verana-blockchain/x/permission/types"
+	trustregistrykeeper "github.com/verana-labs/verana-blockchain/x/trustregistry/keeper"
+	trustregistrytypes "github.com/verana-labs/verana-blockchain/x/trustregistry/types"
+)
+
+func TestTrustRegistryGroupController(t *testing.T) {
+	a, accs := setupExportTestApp(t)
+	ctx := a.BaseApp.NewUncachedContext(false, cmtproto.Header{Height: a.LastBlockHeight() + 1, Time: time.Now().UTC()})
+
+	trMsgServer := trustregistrykeeper.NewMsgServerImpl(a.TrustregistryKeeper)
+	trQueryServer := trustregistrykeeper.NewQueryServerImpl(a.TrustregistryKeeper)
+
+	// 2 of 3 group of ecosystem governors
+	members := []string{accs.controller, accs.issuer, accs.holder}
+	var memberRequests []group.MemberRequest
+	for _, member := range members {
+		memberRequests = append(memberRequests, group.MemberRequest{Address: member, Weight: "1"})
+	}
+	createGroupMsg, err := group.NewMsgCreateGroupWithPolicy(
+		accs.controller,
+		memberRequests,
+		"ecosystem governors",
+		"",
+		true,
+		group.NewThresholdDecisionPolicy("2", time.Hour, 0),
+	)
+	require.NoError(t, err)
+	groupRes, err := a.GroupKeeper.CreateGroupWithPolicy(ctx, createGroupMsg)
+	require.NoError(t, err)
+	policyAddress := groupRes.GroupPolicyAddress
+
+	// the group policy pays the trust registry deposit
+	require.NoError(t, a.BankKeeper.SendCoins(
+		ctx,
+		sdk.MustAccAddressFromBech32(accs.controller),
+		sdk.MustAccAddressFromBech32(policyAddress),
+		sdk.NewCoins(sdk.NewInt64Coin(permissiontypes.BondDenom, 100_000_000)),
+	))
+
+	// the group creates the trust registry and adds a governance framework document
+	executeGroupProposal(t, a, ctx, policyAddress, accs.controller, accs.issuer, &trustregistrytypes.MsgCreateTrustRegistry{
+		Creator:      policyAddress,
+		Did:          "did:example:groupregistry",
+		Language:     "en",
+		DocUrl:       "https://example.com/gf-v1.pdf",
+		DocDigestSri: exportTestDigestSri,
+	})
+	trID, err := a.TrustregistryKeeper.GetTrustRegistryByDID(ctx, "did:example:groupregistry")
+	require.NoError(t, err)
+	require.Equal(t, policyAddress, trID.Controller)
+
+	addDocMsg := &trustregistrytypes.MsgAddGovernanceFrameworkDocument{
+		Creator:      policyAddress,
+		Id:           trID.Id,
+		DocLanguage:  "en",
+		DocUrl:       "https://example.com/gf-v2.pdf",
+		DocDigestSri: exportTestDigestSri,
+		Version:      2,
+	}
+	executeGroupProposal(t, a, ctx, policyAddress, accs.controller, accs.holder, addDocMsg)
+
+	trRes, err := trQueryServer.GetTrustRegistry(ctx, &trustregistrytypes.QueryGetTrustRegistryRequest{TrId: trID.Id})
+	require.NoError(t, err)
+	require.Len(t, trRes.TrustRegistry.Versions, 2)
+	require.Equal(t, "https://example.com/gf-v2.pdf", trRes.TrustRegistry.Versions[1].Documents[0].Url)
+
+	// a single member cannot act for the group
+	addDocMsg.Creator = accs.controller
+	addDocMsg.DocLanguage = "fr"
+	_, err = trMsgServer.AddGovernanceFrameworkDocument(ctx, addDocMsg)
+	require.Error(t, err)
+
+	// the controller query shows the decision policy and the members behind it
+	controllerRes, err := trQueryServer.GetTrustRegistryController(ctx, &trustregistrytypes.QueryGetTrustRegistryControllerRequest{TrId: trID.Id})
+	require.NoError(t, err)
+	require.Equal(t, policyAddress, controllerRes.Controller)
+	require.NotNil(t, controllerRes.GroupPolicy)
+	require.Equal(t, groupRes.GroupId, controllerRes.GroupPolicy.GroupId)
+	decisionPolicy, err := controllerRes.GroupPolicy.GetDecisionPolicy()
+	require.NoError(t, err)
+	require.Equal(t, "2", decisionPolicy.(*group.ThresholdDecisionPolicy).Threshold)
+	require.Len(t, controllerRes.Members, len(members))
+
+	// a single key controller hands its trust registry over to the group
+	_, err = trMsgServer.CreateTrustRegistry(ctx, &trustregistrytypes.MsgCreateTrustRegistry{
+		Creator:      accs.wallet,
+		Did:          "did:example:walletregistry",
+		Language:     "en",
+		DocUrl:       "https://example.com/gf-v1.pdf",
+		DocDigestSri: exportTestDigestSri,
+	})
+	require.NoError(t, err)
+	walletTR, err := a.TrustregistryKeeper.GetTrustRegistryByDID(ctx, "did:example:walletregistry")
+	require.NoError(t, err)
+
+	controllerRes, err = trQueryServer.GetTrustRegistryController(ctx, &trustregistrytypes.QueryGetTrustRegistryControllerRequest{TrId: walletTR.Id})
+	require.NoError(t, err)
+	require.Nil(t, controllerRes.GroupPolicy)
+	require.Empty(t, controllerRes.Members)
+
+	_, err = trMsgServer.UpdateTrustRegistryController(ctx, &trustregistrytypes.MsgUpdateTrustRegistryController{
+		Creator:    accs.wallet,
+		Id:         walletTR.Id,
+		Controller: policyAddress,
+	})
+	require.NoError(t, err)
+
+	controllerRes, err = trQueryServer.GetTrustRegistryController(ctx, &trustregistrytypes.QueryGetTrustRegistryControllerRequest{TrId: walletTR.Id})
+	require.NoError(t, err)
+	require.Equal(t, policyAddress, controllerRes.Controller)
+	require.NotNil(t, controllerRes.GroupPolicy)
+}
+
+// executeGroupProposal submits msg on behalf of the group policy, has two
+// members vote yes and checks the proposal executed successfully.
+func executeGroupProposal(t *testing.T, a *app.App, ctx sdk.Context, policyAddress, proposer, voter string, msg sdk.Msg) {
+	t.Helper()
+
+	submitMsg, err := group.NewMsgSubmitProposal(policyAddress, []string{proposer}, []sdk.Msg{msg}, "", group.Exec_EXEC_UNSPECIFIED, "proposal", "")
+	require.NoError(t, err)
+	submitRes, err := a.GroupKeeper.SubmitProposal(ctx, submitMsg)
+	require.NoError(t, err)
+
+	for i, member := range []string{proposer, voter} {
+		exec := group.Exec_EXEC_UNSPECIFIED
+		if i == 1 {
+			exec = group.Exec_EXEC_TRY
+		}
+		_, err = a.GroupKeeper.Vote(ctx, &group.MsgVote{
+			ProposalId: submitRes.ProposalId,
+			Voter:      member,
+			Option:     group.VOTE_OPTION_YES,
+			Exec:       exec,
+		})
+		require.NoError(t, err)
+	}
+
+	// successfully executed proposals are pruned
+	proposalRes, err := a.GroupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: submitRes.ProposalId})
+	if err == nil {
+		require.Equal(t, group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, proposalRes.Proposal.ExecutorResult, "proposal failed to execute")
+	}
+}
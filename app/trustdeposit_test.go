@@ -0,0 +1,76 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	trustdepositkeeper "github.com/verana-labs/verana-blockchain/x/trustdeposit/keeper"
+	trustdeposittypes "github.com/verana-labs/verana-blockchain/x/trustdeposit/types"
+)
+
+func TestTrustDepositDelegation(t *testing.T) {
+	a, accs := setupExportTestApp(t)
+	ctx := a.BaseApp.NewUncachedContext(false, cmtproto.Header{Height: a.LastBlockHeight() + 1, Time: time.Now().UTC()})
+	msgServer := trustdepositkeeper.NewMsgServerImpl(a.TrustdepositKeeper)
+	moduleAddr := authtypes.NewModuleAddress(trustdeposittypes.ModuleName)
+
+	require.NoError(t, a.TrustdepositKeeper.AdjustTrustDeposit(ctx, accs.holder, 1_000_000))
+
+	// trust deposits can only be staked when the staking denom is the trust
+	// deposit denom
+	stakingParams, err := a.StakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	stakingParams.BondDenom = trustdeposittypes.BondDenom
+	require.NoError(t, a.StakingKeeper.SetParams(ctx, stakingParams))
+
+	validators, err := a.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, validators)
+	valAddr, err := sdk.ValAddressFromBech32(validators[0].OperatorAddress)
+	require.NoError(t, err)
+
+	// the trust deposit module account delegates for the account
+	_, err = msgServer.DelegateTrustDeposit(ctx, &trustdeposittypes.MsgDelegateTrustDeposit{
+		Creator:          accs.holder,
+		ValidatorAddress: valAddr.String(),
+		Amount:           600_000,
+	})
+	require.NoError(t, err)
+	delegation, err := a.StakingKeeper.GetDelegation(ctx, moduleAddr, valAddr)
+	require.NoError(t, err)
+	require.True(t, delegation.Shares.IsPositive())
+
+	// the slash unbonds the part the undelegated deposit does not cover
+	require.NoError(t, a.TrustdepositKeeper.BurnEcosystemSlashedTrustDeposit(ctx, accs.holder, 700_000))
+	td, err := a.TrustdepositKeeper.TrustDeposit.Get(ctx, accs.holder)
+	require.NoError(t, err)
+	require.Equal(t, uint64(300_000), td.Amount)
+	delegation, err = a.StakingKeeper.GetDelegation(ctx, moduleAddr, valAddr)
+	require.NoError(t, err)
+	validator, err := a.StakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(300_000), validator.TokensFromShares(delegation.Shares).TruncateInt())
+
+	// undelegation goes through the staking unbonding period
+	resp, err := msgServer.UndelegateTrustDeposit(ctx, &trustdeposittypes.MsgUndelegateTrustDeposit{Creator: accs.holder})
+	require.NoError(t, err)
+	require.Equal(t, uint64(300_000), resp.Amount)
+	ubd, err := a.StakingKeeper.GetUnbondingDelegation(ctx, moduleAddr, valAddr)
+	require.NoError(t, err)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, sdkmath.NewInt(300_000), ubd.Entries[0].Balance)
+
+	// the amount being undelegated can still be escrowed by a slash
+	require.NoError(t, a.TrustdepositKeeper.EscrowSlashedTrustDeposit(ctx, accs.holder, 100_000))
+	td, err = a.TrustdepositKeeper.TrustDeposit.Get(ctx, accs.holder)
+	require.NoError(t, err)
+	require.Equal(t, uint64(200_000), td.Amount)
+	err = a.TrustdepositKeeper.EscrowSlashedTrustDeposit(ctx, accs.holder, 200_001)
+	require.ErrorContains(t, err, "amount exceeds available deposit")
+}
@@ -3,8 +3,10 @@ package upgrades
 import (
 	"github.com/verana-labs/verana-blockchain/app/upgrades/types"
 	v6 "github.com/verana-labs/verana-blockchain/app/upgrades/v6"
+	v7 "github.com/verana-labs/verana-blockchain/app/upgrades/v7"
 )
 
 var Upgrades = []types.Upgrade{
 	v6.Upgrade,
+	v7.Upgrade,
 }
@@ -289,6 +289,11 @@ func restorePermissionData(ctx sdk.Context, keepers types.AppKeepers, data Permi
 		validatorPermID, _ := strconv.ParseUint(perm.ValidatorPermID, 10, 64)
 		vpState, _ := strconv.ParseUint(perm.VPState, 10, 64)
 
+		var countries []string
+		if perm.Country != "" {
+			countries = []string{perm.Country}
+		}
+
 		permUpdated := permissiontypes.Permission{
 			Id:                 id,
 			SchemaId:           schemaID,
@@ -316,7 +321,7 @@ func restorePermissionData(ctx sdk.Context, keepers types.AppKeepers, data Permi
 			RevokedBy:          "",
 			Terminated:         nil,
 			TerminatedBy:       "",
-			Country:            perm.Country,
+			Countries:          countries,
 			ValidatorPermId:    validatorPermID,
 			VpState:            permissiontypes.ValidationState(vpState),
 			VpExp:              nil,
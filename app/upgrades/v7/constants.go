@@ -0,0 +1,17 @@
+package v7
+
+import (
+	store "cosmossdk.io/store/types"
+	"github.com/verana-labs/verana-blockchain/app/upgrades/types"
+)
+
+const UpgradeName = "v0.7"
+
+var Upgrade = types.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades: store.StoreUpgrades{
+		Added:   []string{},
+		Deleted: []string{},
+	},
+}
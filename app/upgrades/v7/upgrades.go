@@ -0,0 +1,24 @@
+package v7
+
+import (
+	"context"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/verana-labs/verana-blockchain/app/upgrades/types"
+)
+
+// CreateUpgradeHandler runs the in-place store migrations registered by the
+// modules, state is no longer restored from embedded data.
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	_ types.BaseAppParamManager,
+	_ types.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(context context.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		ctx := sdk.UnwrapSDKContext(context)
+		return mm.RunMigrations(ctx, configurator, fromVM)
+	}
+}
@@ -58,7 +58,7 @@ func initRootCmd(
 		genutilcli.CollectGenTxsCmd(banktypes.GenesisBalancesIterator{}, app.DefaultNodeHome, genutiltypes.DefaultMessageValidator, valOperAddressCodec),
 		AddGenesisAccountCmd(app.DefaultNodeHome),
 		genutilcli.GenTxCmd(app.ModuleBasics, txConfig, banktypes.GenesisBalancesIterator{}, app.DefaultNodeHome, valOperAddressCodec),
-		genutilcli.ValidateGenesisCmd(app.ModuleBasics),
+		validateGenesisCmd(basicManager),
 	)
 }
 
@@ -70,6 +70,15 @@ func addModuleInitFlags(startCmd *cobra.Command) {
 func genesisCommand(txConfig client.TxConfig, basicManager module.BasicManager, cmds ...*cobra.Command) *cobra.Command {
 	cmd := genutilcli.Commands(txConfig, basicManager, app.DefaultNodeHome)
 
+	// replace the genutil validate command with one that also checks the
+	// references between the Verana module genesis states
+	for _, subCmd := range cmd.Commands() {
+		if subCmd.Name() == "validate" {
+			cmd.RemoveCommand(subCmd)
+		}
+	}
+	cmd.AddCommand(validateGenesisCmd(basicManager))
+
 	for _, subCmd := range cmds {
 		cmd.AddCommand(subCmd)
 	}
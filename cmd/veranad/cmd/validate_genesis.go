@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/cobra"
+
+	"github.com/verana-labs/verana-blockchain/app"
+)
+
+// validateGenesisCmd takes a genesis file and makes sure that it is valid. It
+// mirrors the genutil command and also checks the references between the
+// Verana module genesis states.
+func validateGenesisCmd(mbm module.BasicManager) *cobra.Command {
+	return &cobra.Command{
+		Use:     "validate [file]",
+		Aliases: []string{"validate-genesis"},
+		Args:    cobra.RangeArgs(0, 1),
+		Short:   "Validates the genesis file at the default location or at the location passed as an arg",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			// Load default if passed no args, otherwise load passed file
+			genesis := serverCtx.Config.GenesisFile()
+			if len(args) > 0 {
+				genesis = args[0]
+			}
+
+			appGenesis, err := genutiltypes.AppGenesisFromFile(genesis)
+			if err != nil {
+				return err
+			}
+			if err := appGenesis.ValidateAndComplete(); err != nil {
+				return fmt.Errorf("invalid consensus params in genesis file %s: %w", genesis, err)
+			}
+
+			var genState app.GenesisState
+			if err := json.Unmarshal(appGenesis.AppState, &genState); err != nil {
+				if strings.Contains(err.Error(), "unexpected end of JSON input") {
+					return fmt.Errorf("app_state is missing in the genesis file: %s", err.Error())
+				}
+				return fmt.Errorf("error unmarshalling genesis doc %s: %w", genesis, err)
+			}
+
+			if err := app.ValidateGenesis(mbm, clientCtx.Codec, clientCtx.TxConfig, genState); err != nil {
+				errStr := fmt.Sprintf("error validating genesis file %s: %s", genesis, err.Error())
+				if errors.Is(err, io.EOF) {
+					errStr = fmt.Sprintf("%s: section is missing in the app_state", errStr)
+				}
+				return errors.New(errStr)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "File at %s is a valid genesis file\n", genesis)
+			return nil
+		},
+	}
+}
@@ -23,6 +23,18 @@ import (
 )
 
 func PermissionKeeper(t testing.TB) (keeper.Keeper, *MockCredentialSchemaKeeper, *MockTrustRegistryKeeper, sdk.Context) {
+	k, csKeeper, trkKeeper, _, ctx := newPermissionKeeper(t)
+	return k, csKeeper, trkKeeper, ctx
+}
+
+// PermissionKeeperWithTrustDeposit returns a perm keeper along with the mock
+// trust deposit keeper, whose rates can be set and which records the trust
+// deposit adjustments.
+func PermissionKeeperWithTrustDeposit(t testing.TB) (keeper.Keeper, *MockCredentialSchemaKeeper, *MockTrustRegistryKeeper, *MockTrustDepositKeeper, sdk.Context) {
+	return newPermissionKeeper(t)
+}
+
+func newPermissionKeeper(t testing.TB) (keeper.Keeper, *MockCredentialSchemaKeeper, *MockTrustRegistryKeeper, *MockTrustDepositKeeper, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
 	db := dbm.NewMemDB()
@@ -57,7 +69,7 @@ func PermissionKeeper(t testing.TB) (keeper.Keeper, *MockCredentialSchemaKeeper,
 		panic(err)
 	}
 
-	return k, csKeeper, trkKeeper, ctx
+	return k, csKeeper, trkKeeper, mockTrustDepositKeeper, ctx
 }
 
 type MockCredentialSchemaKeeper struct {
@@ -79,6 +91,14 @@ func (k *MockCredentialSchemaKeeper) UpdateMockCredentialSchema(id uint64, trId
 	}
 }
 
+// SetMockValidationQuorums sets the validation quorums of a mock credential schema
+func (k *MockCredentialSchemaKeeper) SetMockValidationQuorums(id uint64, issuerQuorum, verifierQuorum uint32) {
+	cs := k.credentialSchemas[id]
+	cs.IssuerValidationQuorum = issuerQuorum
+	cs.VerifierValidationQuorum = verifierQuorum
+	k.credentialSchemas[id] = cs
+}
+
 func (k *MockCredentialSchemaKeeper) GetCredentialSchemaById(ctx sdk.Context, id uint64) (cstypes.CredentialSchema, error) {
 	if cs, ok := k.credentialSchemas[id]; ok {
 		return cs, nil
@@ -1,7 +1,12 @@
 package keeper
 
 import (
+	"context"
+	"fmt"
 	"testing"
+	"time"
+
+	"cosmossdk.io/math"
 
 	"cosmossdk.io/log"
 	"cosmossdk.io/store"
@@ -15,6 +20,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/verana-labs/verana-blockchain/x/trustdeposit/keeper"
@@ -22,6 +28,37 @@ import (
 )
 
 func TrustdepositKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	k, _, ctx := TrustdepositKeeperWithDistribution(t)
+	return k, ctx
+}
+
+// TrustdepositKeeperWithDistribution returns a trust deposit keeper along with
+// the mock distribution keeper funding the community pool.
+func TrustdepositKeeperWithDistribution(t testing.TB) (keeper.Keeper, *MockDistributionKeeper, sdk.Context) {
+	k, _, distrKeeper, ctx := trustdepositKeeper(t)
+	return k, distrKeeper, ctx
+}
+
+// TrustdepositKeeperWithBank returns a trust deposit keeper along with the
+// mock bank keeper holding the module balances.
+func TrustdepositKeeperWithBank(t testing.TB) (keeper.Keeper, *MockTrustDepositBankKeeper, sdk.Context) {
+	k, bankKeeper, _, ctx := trustdepositKeeper(t)
+	return k, bankKeeper, ctx
+}
+
+// TrustdepositKeeperWithStaking returns a trust deposit keeper along with the
+// mock staking keeper, which holds the mock bank and distribution keepers.
+func TrustdepositKeeperWithStaking(t testing.TB) (keeper.Keeper, *MockStakingKeeper, sdk.Context) {
+	k, _, _, stakingKeeper, ctx := newTrustdepositKeeper(t)
+	return k, stakingKeeper, ctx
+}
+
+func trustdepositKeeper(t testing.TB) (keeper.Keeper, *MockTrustDepositBankKeeper, *MockDistributionKeeper, sdk.Context) {
+	k, bankKeeper, distrKeeper, _, ctx := newTrustdepositKeeper(t)
+	return k, bankKeeper, distrKeeper, ctx
+}
+
+func newTrustdepositKeeper(t testing.TB) (keeper.Keeper, *MockTrustDepositBankKeeper, *MockDistributionKeeper, *MockStakingKeeper, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
 	db := dbm.NewMemDB()
@@ -32,7 +69,9 @@ func TrustdepositKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 	registry := codectypes.NewInterfaceRegistry()
 	cdc := codec.NewProtoCodec(registry)
 	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
-	bankKeeper := NewMockBankKeeper()
+	bankKeeper := NewMockTrustDepositBankKeeper()
+	distrKeeper := &MockDistributionKeeper{Bank: bankKeeper, Rewards: make(map[string]sdk.Coins)}
+	stakingKeeper := NewMockStakingKeeper(bankKeeper, distrKeeper)
 
 	k := keeper.NewKeeper(
 		cdc,
@@ -40,6 +79,8 @@ func TrustdepositKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		log.NewNopLogger(),
 		authority.String(),
 		bankKeeper,
+		distrKeeper,
+		stakingKeeper,
 	)
 
 	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
@@ -49,5 +90,222 @@ func TrustdepositKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		panic(err)
 	}
 
-	return k, ctx
+	return k, bankKeeper, distrKeeper, stakingKeeper, ctx
+}
+
+// MockDistributionKeeper is a mock implementation of the DistributionKeeper interface for testing
+type MockDistributionKeeper struct {
+	CommunityPool sdk.Coins
+	// Rewards are the pending delegation rewards, by validator
+	Rewards map[string]sdk.Coins
+	Bank    *MockTrustDepositBankKeeper
+}
+
+func (m *MockDistributionKeeper) FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	m.CommunityPool = m.CommunityPool.Add(amount...)
+	return nil
+}
+
+// WithdrawDelegationRewards pays the pending rewards of valAddr to the trust
+// deposit module account
+func (m *MockDistributionKeeper) WithdrawDelegationRewards(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, error) {
+	rewards := m.Rewards[valAddr.String()]
+	delete(m.Rewards, valAddr.String())
+	if m.Bank != nil && !rewards.IsZero() {
+		m.Bank.FundModule(types.ModuleName, rewards)
+	}
+	return rewards, nil
+}
+
+// MockTrustDepositBankKeeper is a mock implementation of the trust deposit
+// BankKeeper interface tracking module account balances
+type MockTrustDepositBankKeeper struct {
+	*MockBankKeeper
+	ModuleBalances map[string]sdk.Coins
+}
+
+func NewMockTrustDepositBankKeeper() *MockTrustDepositBankKeeper {
+	return &MockTrustDepositBankKeeper{
+		MockBankKeeper: NewMockBankKeeper(),
+		ModuleBalances: make(map[string]sdk.Coins),
+	}
+}
+
+// FundModule adds amount to the balance of the module account name
+func (m *MockTrustDepositBankKeeper) FundModule(name string, amount sdk.Coins) {
+	m.ModuleBalances[name] = m.ModuleBalances[name].Add(amount...)
+}
+
+func (m *MockTrustDepositBankKeeper) GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	for name, balance := range m.ModuleBalances {
+		if authtypes.NewModuleAddress(name).Equals(addr) {
+			return sdk.NewCoin(denom, balance.AmountOf(denom))
+		}
+	}
+	return sdk.NewInt64Coin(denom, 0)
+}
+
+func (m *MockTrustDepositBankKeeper) SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	balance, hasNeg := m.ModuleBalances[senderModule].SafeSub(amt...)
+	if hasNeg {
+		return fmt.Errorf("insufficient funds in module %s", senderModule)
+	}
+	m.ModuleBalances[senderModule] = balance
+	m.ModuleBalances[recipientModule] = m.ModuleBalances[recipientModule].Add(amt...)
+	return nil
+}
+
+// MockStakingKeeper is a mock implementation of the trust deposit
+// StakingKeeper interface. Only the trust deposit module account delegates.
+type MockStakingKeeper struct {
+	Bank         *MockTrustDepositBankKeeper
+	Distribution *MockDistributionKeeper
+	Validators   map[string]stakingtypes.Validator
+	// Delegations are the delegation shares of the module account, by validator
+	Delegations          map[string]math.LegacyDec
+	UnbondingDelegations map[string]stakingtypes.UnbondingDelegation
+	UnbondingTime        time.Duration
+}
+
+func NewMockStakingKeeper(bank *MockTrustDepositBankKeeper, distr *MockDistributionKeeper) *MockStakingKeeper {
+	return &MockStakingKeeper{
+		Bank:                 bank,
+		Distribution:         distr,
+		Validators:           make(map[string]stakingtypes.Validator),
+		Delegations:          make(map[string]math.LegacyDec),
+		UnbondingDelegations: make(map[string]stakingtypes.UnbondingDelegation),
+		UnbondingTime:        21 * 24 * time.Hour,
+	}
+}
+
+// AddValidator adds a bonded validator without delegations
+func (m *MockStakingKeeper) AddValidator(valAddr sdk.ValAddress) {
+	m.Validators[valAddr.String()] = stakingtypes.Validator{
+		OperatorAddress: valAddr.String(),
+		Status:          stakingtypes.Bonded,
+		Tokens:          math.ZeroInt(),
+		DelegatorShares: math.LegacyZeroDec(),
+	}
+}
+
+// SlashValidator slashes fraction of the tokens of valAddr and of its
+// unbonding delegations
+func (m *MockStakingKeeper) SlashValidator(valAddr sdk.ValAddress, fraction math.LegacyDec) {
+	validator := m.Validators[valAddr.String()]
+	slashed := math.LegacyNewDecFromInt(validator.Tokens).Mul(fraction).TruncateInt()
+	validator = validator.RemoveTokens(slashed)
+	m.Validators[valAddr.String()] = validator
+	m.Bank.ModuleBalances[stakingtypes.BondedPoolName] = m.Bank.ModuleBalances[stakingtypes.BondedPoolName].Sub(sdk.NewCoin(types.BondDenom, slashed))
+
+	ubd, found := m.UnbondingDelegations[valAddr.String()]
+	if !found {
+		return
+	}
+	for i, entry := range ubd.Entries {
+		entrySlashed := math.LegacyNewDecFromInt(entry.InitialBalance).Mul(fraction).TruncateInt()
+		entrySlashed = math.MinInt(entrySlashed, entry.Balance)
+		ubd.Entries[i].Balance = entry.Balance.Sub(entrySlashed)
+		m.Bank.ModuleBalances[stakingtypes.NotBondedPoolName] = m.Bank.ModuleBalances[stakingtypes.NotBondedPoolName].Sub(sdk.NewCoin(types.BondDenom, entrySlashed))
+	}
+	m.UnbondingDelegations[valAddr.String()] = ubd
+}
+
+// CompleteUnbondings pays out the mature unbonding delegations, as the
+// staking EndBlocker does
+func (m *MockStakingKeeper) CompleteUnbondings(ctx sdk.Context) {
+	for val, ubd := range m.UnbondingDelegations {
+		var entries []stakingtypes.UnbondingDelegationEntry
+		for _, entry := range ubd.Entries {
+			if entry.IsMature(ctx.BlockTime()) {
+				coins := sdk.NewCoins(sdk.NewCoin(types.BondDenom, entry.Balance))
+				if err := m.Bank.SendCoinsFromModuleToModule(ctx, stakingtypes.NotBondedPoolName, types.ModuleName, coins); err != nil {
+					panic(err)
+				}
+				continue
+			}
+			entries = append(entries, entry)
+		}
+		ubd.Entries = entries
+		m.UnbondingDelegations[val] = ubd
+	}
+}
+
+func (m *MockStakingKeeper) BondDenom(ctx context.Context) (string, error) {
+	return types.BondDenom, nil
+}
+
+func (m *MockStakingKeeper) GetValidator(ctx context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error) {
+	validator, found := m.Validators[addr.String()]
+	if !found {
+		return stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound
+	}
+	return validator, nil
+}
+
+func (m *MockStakingKeeper) Delegate(ctx context.Context, delAddr sdk.AccAddress, bondAmt math.Int, tokenSrc stakingtypes.BondStatus, validator stakingtypes.Validator, subtractAccount bool) (math.LegacyDec, error) {
+	coins := sdk.NewCoins(sdk.NewCoin(types.BondDenom, bondAmt))
+	if err := m.Bank.SendCoinsFromModuleToModule(ctx, types.ModuleName, stakingtypes.BondedPoolName, coins); err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	validator = m.Validators[validator.OperatorAddress]
+	validator, shares := validator.AddTokensFromDel(bondAmt)
+	m.Validators[validator.OperatorAddress] = validator
+
+	current, found := m.Delegations[validator.OperatorAddress]
+	if !found {
+		current = math.LegacyZeroDec()
+	}
+	m.Delegations[validator.OperatorAddress] = current.Add(shares)
+	return shares, nil
+}
+
+func (m *MockStakingKeeper) GetDelegation(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stakingtypes.Delegation, error) {
+	shares, found := m.Delegations[valAddr.String()]
+	if !found {
+		return stakingtypes.Delegation{}, stakingtypes.ErrNoDelegation
+	}
+	return stakingtypes.NewDelegation(delAddr.String(), valAddr.String(), shares), nil
+}
+
+func (m *MockStakingKeeper) Unbond(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec) (math.Int, error) {
+	current, found := m.Delegations[valAddr.String()]
+	if !found || current.LT(shares) {
+		return math.Int{}, stakingtypes.ErrNotEnoughDelegationShares
+	}
+	m.Delegations[valAddr.String()] = current.Sub(shares)
+
+	validator, amount := m.Validators[valAddr.String()].RemoveDelShares(shares)
+	m.Validators[valAddr.String()] = validator
+	return amount, nil
+}
+
+func (m *MockStakingKeeper) Undelegate(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesAmount math.LegacyDec) (time.Time, math.Int, error) {
+	amount, err := m.Unbond(ctx, delAddr, valAddr, sharesAmount)
+	if err != nil {
+		return time.Time{}, math.Int{}, err
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(types.BondDenom, amount))
+	if err := m.Bank.SendCoinsFromModuleToModule(ctx, stakingtypes.BondedPoolName, stakingtypes.NotBondedPoolName, coins); err != nil {
+		return time.Time{}, math.Int{}, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	completionTime := sdkCtx.BlockTime().Add(m.UnbondingTime)
+	ubd := m.UnbondingDelegations[valAddr.String()]
+	ubd.DelegatorAddress = delAddr.String()
+	ubd.ValidatorAddress = valAddr.String()
+	ubd.Entries = append(ubd.Entries, stakingtypes.NewUnbondingDelegationEntry(sdkCtx.BlockHeight(), completionTime, amount, 0))
+	m.UnbondingDelegations[valAddr.String()] = ubd
+
+	return completionTime, amount, nil
+}
+
+func (m *MockStakingKeeper) GetUnbondingDelegation(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stakingtypes.UnbondingDelegation, error) {
+	ubd, found := m.UnbondingDelegations[valAddr.String()]
+	if !found {
+		return stakingtypes.UnbondingDelegation{}, stakingtypes.ErrNoUnbondingDelegation
+	}
+	return ubd, nil
 }
@@ -1,9 +1,11 @@
 package keeper
 
 import (
-	"cosmossdk.io/math"
+	"context"
 	"testing"
 
+	"cosmossdk.io/math"
+
 	"cosmossdk.io/log"
 	"cosmossdk.io/store"
 	"cosmossdk.io/store/metrics"
@@ -14,15 +16,25 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
 	"github.com/stretchr/testify/require"
 
+	trustdeposittypes "github.com/verana-labs/verana-blockchain/x/trustdeposit/types"
 	"github.com/verana-labs/verana-blockchain/x/trustregistry/keeper"
 	"github.com/verana-labs/verana-blockchain/x/trustregistry/types"
 )
 
 func TrustregistryKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	k, _, ctx := TrustregistryKeeperWithGroups(t)
+	return k, ctx
+}
+
+// TrustregistryKeeperWithGroups returns a trust registry keeper along with the
+// mock group keeper used to resolve group policy controllers.
+func TrustregistryKeeperWithGroups(t testing.TB) (keeper.Keeper, *MockGroupKeeper, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
 	db := dbm.NewMemDB()
@@ -36,6 +48,7 @@ func TrustregistryKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 
 	// Create mock TrustDepositKeeper
 	mockTrustDepositKeeper := &MockTrustDepositKeeper{}
+	mockGroupKeeper := NewMockGroupKeeper()
 
 	k := keeper.NewKeeper(
 		cdc,
@@ -43,6 +56,7 @@ func TrustregistryKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		log.NewNopLogger(),
 		authority.String(),
 		mockTrustDepositKeeper,
+		mockGroupKeeper,
 	)
 
 	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
@@ -52,36 +66,101 @@ func TrustregistryKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		panic(err)
 	}
 
-	return k, ctx
+	return k, mockGroupKeeper, ctx
+}
+
+// MockTrustDepositKeeper is a mock implementation of the TrustDepositKeeper interface for testing.
+// Unset rates are zero.
+type MockTrustDepositKeeper struct {
+	UserAgentRewardRate       *math.LegacyDec
+	WalletUserAgentRewardRate *math.LegacyDec
+	TrustDepositRate          *math.LegacyDec
+
+	// Adjustments holds the sum of the trust deposit adjustments, by account
+	Adjustments map[string]int64
 }
 
-// MockTrustDepositKeeper is a mock implementation of the TrustDepositKeeper interface for testing
-type MockTrustDepositKeeper struct{}
+func mockRate(rate *math.LegacyDec) math.LegacyDec {
+	if rate == nil {
+		return math.LegacyZeroDec()
+	}
+	return *rate
+}
 
 func (m *MockTrustDepositKeeper) BurnEcosystemSlashedTrustDeposit(ctx sdk.Context, account string, amount uint64) error {
 	return nil
 }
 
+func (m *MockTrustDepositKeeper) EscrowSlashedTrustDeposit(ctx sdk.Context, account string, amount uint64) error {
+	return nil
+}
+
+func (m *MockTrustDepositKeeper) DistributeEscrowedSlashedTrustDeposit(ctx sdk.Context, account string, amount uint64, distribution []trustdeposittypes.SlashShare) error {
+	return nil
+}
+
+func (m *MockTrustDepositKeeper) ReleaseEscrowedSlashedTrustDeposit(ctx sdk.Context, account string, amount uint64) error {
+	return nil
+}
+
 func (m *MockTrustDepositKeeper) GetUserAgentRewardRate(ctx sdk.Context) math.LegacyDec {
-	//unimplemented
-	v, _ := math.LegacyNewDecFromStr("0")
-	return v
+	return mockRate(m.UserAgentRewardRate)
 }
 
 func (m *MockTrustDepositKeeper) GetWalletUserAgentRewardRate(ctx sdk.Context) math.LegacyDec {
-	//unimplemented
-	v, _ := math.LegacyNewDecFromStr("0")
-	return v
+	return mockRate(m.WalletUserAgentRewardRate)
 }
 
 func (m *MockTrustDepositKeeper) GetTrustDepositRate(ctx sdk.Context) math.LegacyDec {
-	//unimplemented
-	v, _ := math.LegacyNewDecFromStr("0")
-	return v
+	return mockRate(m.TrustDepositRate)
 }
 
 // AdjustTrustDeposit implements the TrustDepositKeeper interface
 func (m *MockTrustDepositKeeper) AdjustTrustDeposit(ctx sdk.Context, account string, augend int64) error {
 	// For testing, always succeed
+	if m.Adjustments == nil {
+		m.Adjustments = make(map[string]int64)
+	}
+	m.Adjustments[account] += augend
 	return nil
 }
+
+// MockGroupKeeper is a mock implementation of the GroupKeeper interface for testing
+type MockGroupKeeper struct {
+	policies map[string]*group.GroupPolicyInfo
+	members  map[uint64][]*group.GroupMember
+}
+
+func NewMockGroupKeeper() *MockGroupKeeper {
+	return &MockGroupKeeper{
+		policies: make(map[string]*group.GroupPolicyInfo),
+		members:  make(map[uint64][]*group.GroupMember),
+	}
+}
+
+// CreateMockGroupPolicy registers a group policy and the members of its group
+func (m *MockGroupKeeper) CreateMockGroupPolicy(policyAddress string, groupID uint64, members ...string) {
+	m.policies[policyAddress] = &group.GroupPolicyInfo{
+		Address: policyAddress,
+		GroupId: groupID,
+		Admin:   policyAddress,
+	}
+	for _, member := range members {
+		m.members[groupID] = append(m.members[groupID], &group.GroupMember{
+			GroupId: groupID,
+			Member:  &group.Member{Address: member, Weight: "1"},
+		})
+	}
+}
+
+func (m *MockGroupKeeper) GroupPolicyInfo(ctx context.Context, req *group.QueryGroupPolicyInfoRequest) (*group.QueryGroupPolicyInfoResponse, error) {
+	policy, ok := m.policies[req.Address]
+	if !ok {
+		return nil, sdkerrors.ErrNotFound.Wrap("group policy not found")
+	}
+	return &group.QueryGroupPolicyInfoResponse{Info: policy}, nil
+}
+
+func (m *MockGroupKeeper) GroupMembers(ctx context.Context, req *group.QueryGroupMembersRequest) (*group.QueryGroupMembersResponse, error) {
+	return &group.QueryGroupMembersResponse{Members: m.members[req.GroupId]}, nil
+}
@@ -92,6 +92,8 @@ func (ms msgServer) executeCreateCredentialSchema(ctx sdk.Context, schemaID uint
 		HolderValidationValidityPeriod:          msg.HolderValidationValidityPeriod,
 		IssuerPermManagementMode:                types.CredentialSchemaPermManagementMode(msg.IssuerPermManagementMode),
 		VerifierPermManagementMode:              types.CredentialSchemaPermManagementMode(msg.VerifierPermManagementMode),
+		IssuerValidationQuorum:                  msg.IssuerValidationQuorum,
+		VerifierValidationQuorum:                msg.VerifierValidationQuorum,
 	}
 
 	// Persist the credential schema using keeper method
@@ -69,12 +69,19 @@ func (ms msgServer) UpdateCredentialSchema(goCtx context.Context, msg *types.Msg
 		return nil, fmt.Errorf("invalid validity period: %w", err)
 	}
 
+	if err := types.ValidateValidationQuorums(cs.IssuerPermManagementMode, cs.VerifierPermManagementMode,
+		msg.IssuerValidationQuorum, msg.VerifierValidationQuorum); err != nil {
+		return nil, fmt.Errorf("invalid validation quorum: %w", err)
+	}
+
 	// [MOD-CS-MSG-2-3] Update mutable fields only
 	cs.IssuerGrantorValidationValidityPeriod = msg.IssuerGrantorValidationValidityPeriod
 	cs.VerifierGrantorValidationValidityPeriod = msg.VerifierGrantorValidationValidityPeriod
 	cs.IssuerValidationValidityPeriod = msg.IssuerValidationValidityPeriod
 	cs.VerifierValidationValidityPeriod = msg.VerifierValidationValidityPeriod
 	cs.HolderValidationValidityPeriod = msg.HolderValidationValidityPeriod
+	cs.IssuerValidationQuorum = msg.IssuerValidationQuorum
+	cs.VerifierValidationQuorum = msg.VerifierValidationQuorum
 	cs.Modified = ctx.BlockTime()
 
 	if err := ms.CredentialSchema.Set(ctx, cs.Id, cs); err != nil {
@@ -127,6 +127,18 @@ $ veranad tx cs create-credential-schema 1 schema.json 365 365 180 180 180 2 2`,
 							ProtoField: "verifier_perm_management_mode",
 						},
 					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"issuer_validation_quorum": {
+							Name:         "issuer-validation-quorum",
+							Usage:        "Number of validator approvals an issuer validation process requires (0 or 1 for a single validator)",
+							DefaultValue: "0",
+						},
+						"verifier_validation_quorum": {
+							Name:         "verifier-validation-quorum",
+							Usage:        "Number of validator approvals a verifier validation process requires (0 or 1 for a single validator)",
+							DefaultValue: "0",
+						},
+					},
 				},
 				{
 					RpcMethod: "UpdateCredentialSchema",
@@ -153,6 +165,18 @@ $ veranad tx cs create-credential-schema 1 schema.json 365 365 180 180 180 2 2`,
 							ProtoField: "holder_validation_validity_period",
 						},
 					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"issuer_validation_quorum": {
+							Name:         "issuer-validation-quorum",
+							Usage:        "Number of validator approvals an issuer validation process requires (0 or 1 for a single validator)",
+							DefaultValue: "0",
+						},
+						"verifier_validation_quorum": {
+							Name:         "verifier-validation-quorum",
+							Usage:        "Number of validator approvals a verifier validation process requires (0 or 1 for a single validator)",
+							DefaultValue: "0",
+						},
+					},
 				},
 				{
 					RpcMethod: "ArchiveCredentialSchema",
@@ -37,17 +37,22 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		}
 	}
 
-	// Set counter to the highest existing ID
-	// This is the fix: Always set the counter, even if maxID is 0
-	// This ensures the collections key exists for later retrieval
-	err := k.Counter.Set(ctx, "cs", maxID)
+	// Set counter to the highest existing ID, or to the exported counter if it is
+	// ahead (schemas may have been deleted). Always set the counter, even if it is 0,
+	// so the collections key exists for later retrieval
+	counter := maxID
+	if genState.SchemaCounter > counter {
+		counter = genState.SchemaCounter
+	}
+	err := k.Counter.Set(ctx, "cs", counter)
 	if err != nil {
 		panic(fmt.Sprintf("failed to set counter: %s", err))
 	}
 
 	k.Logger().Info("Initialized Credential Schema module",
 		"schemas_count", len(schemas),
-		"highest_id", maxID)
+		"highest_id", maxID,
+		"counter", counter)
 }
 
 // ExportGenesis returns the module's exported genesis.
@@ -26,6 +26,7 @@ func (gs GenesisState) Validate() error {
 
 	// Validate credential schemas
 	seenCredentialSchemaIDs := make(map[uint64]bool)
+	maxSchemaID := uint64(0)
 
 	// Validate each credential schema and check for duplicates
 	for i, cs := range gs.CredentialSchemas {
@@ -63,6 +64,11 @@ func (gs GenesisState) Validate() error {
 				i, cs.VerifierPermManagementMode)
 		}
 
+		if err := ValidateValidationQuorums(cs.IssuerPermManagementMode, cs.VerifierPermManagementMode,
+			cs.IssuerValidationQuorum, cs.VerifierValidationQuorum); err != nil {
+			return fmt.Errorf("credential schema at index %d has invalid validation quorum: %w", i, err)
+		}
+
 		// Validate JSON schema format (basic check)
 		if err := validateJSONSchema(cs.JsonSchema); err != nil {
 			return fmt.Errorf("credential schema at index %d has invalid JSON schema: %w", i, err)
@@ -106,6 +112,29 @@ func (gs GenesisState) Validate() error {
 				return fmt.Errorf("credential schema at index %d has archive time before creation time", i)
 			}
 		}
+
+		if cs.Id > maxSchemaID {
+			maxSchemaID = cs.Id
+		}
+	}
+
+	// A non-zero counter must not hand out an ID that is already taken
+	if gs.SchemaCounter != 0 && gs.SchemaCounter < maxSchemaID {
+		return fmt.Errorf("schema_counter (%d) must not be lower than the maximum credential schema ID (%d)",
+			gs.SchemaCounter, maxSchemaID)
+	}
+
+	return nil
+}
+
+// ValidateTrustRegistryReferences checks that every credential schema belongs to
+// one of the given trust registries. It is used to cross-check the credential
+// schema genesis against the trust registry genesis.
+func (gs GenesisState) ValidateTrustRegistryReferences(trustRegistryIDs map[uint64]bool) error {
+	for i, cs := range gs.CredentialSchemas {
+		if !trustRegistryIDs[cs.TrId] {
+			return fmt.Errorf("credential schema at index %d references unknown trust registry ID: %d", i, cs.TrId)
+		}
 	}
 
 	return nil
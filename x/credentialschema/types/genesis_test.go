@@ -128,6 +128,52 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			name: "schema counter covering archived schemas",
+			genState: &types.GenesisState{
+				Params:            types.DefaultParams(),
+				CredentialSchemas: []types.CredentialSchema{validSchema},
+				SchemaCounter:     3,
+			},
+			valid: true,
+		},
+		{
+			name: "schema counter below max schema ID",
+			genState: &types.GenesisState{
+				Params:            types.DefaultParams(),
+				CredentialSchemas: []types.CredentialSchema{schemaWithID(validSchema, 5)},
+				SchemaCounter:     2,
+			},
+			valid: false,
+		},
+		{
+			name: "valid validation quorums",
+			genState: &types.GenesisState{
+				Params:            types.DefaultParams(),
+				CredentialSchemas: []types.CredentialSchema{schemaWithQuorums(validSchema, 3, 2)},
+			},
+			valid: true,
+		},
+		{
+			name: "validation quorum above maximum",
+			genState: &types.GenesisState{
+				Params:            types.DefaultParams(),
+				CredentialSchemas: []types.CredentialSchema{schemaWithQuorums(validSchema, types.MaxValidationQuorum+1, 0)},
+			},
+			valid: false,
+		},
+		{
+			name: "validation quorum without grantor validation",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				CredentialSchemas: []types.CredentialSchema{func() types.CredentialSchema {
+					cs := schemaWithQuorums(validSchema, 2, 0)
+					cs.IssuerPermManagementMode = types.CredentialSchemaPermManagementMode_ECOSYSTEM
+					return cs
+				}()},
+			},
+			valid: false,
+		},
 		{
 			name: "invalid parameter values",
 			genState: &types.GenesisState{
@@ -157,3 +203,29 @@ func TestGenesisState_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisState_ValidateTrustRegistryReferences(t *testing.T) {
+	genState := types.GenesisState{
+		Params: types.DefaultParams(),
+		CredentialSchemas: []types.CredentialSchema{
+			{Id: 1, TrId: 100},
+			{Id: 2, TrId: 101},
+		},
+	}
+
+	require.NoError(t, genState.ValidateTrustRegistryReferences(map[uint64]bool{100: true, 101: true}))
+
+	err := genState.ValidateTrustRegistryReferences(map[uint64]bool{100: true})
+	require.ErrorContains(t, err, "unknown trust registry ID: 101")
+}
+
+func schemaWithID(cs types.CredentialSchema, id uint64) types.CredentialSchema {
+	cs.Id = id
+	return cs
+}
+
+func schemaWithQuorums(cs types.CredentialSchema, issuerQuorum, verifierQuorum uint32) types.CredentialSchema {
+	cs.IssuerValidationQuorum = issuerQuorum
+	cs.VerifierValidationQuorum = verifierQuorum
+	return cs
+}
@@ -137,6 +137,8 @@ type MsgCreateCredentialSchema struct {
 	HolderValidationValidityPeriod          uint32 `protobuf:"varint,8,opt,name=holder_validation_validity_period,json=holderValidationValidityPeriod,proto3" json:"holder_validation_validity_period,omitempty"`
 	IssuerPermManagementMode                uint32 `protobuf:"varint,9,opt,name=issuer_perm_management_mode,json=issuerPermManagementMode,proto3" json:"issuer_perm_management_mode,omitempty"`
 	VerifierPermManagementMode              uint32 `protobuf:"varint,10,opt,name=verifier_perm_management_mode,json=verifierPermManagementMode,proto3" json:"verifier_perm_management_mode,omitempty"`
+	IssuerValidationQuorum                  uint32 `protobuf:"varint,11,opt,name=issuer_validation_quorum,json=issuerValidationQuorum,proto3" json:"issuer_validation_quorum,omitempty"`
+	VerifierValidationQuorum                uint32 `protobuf:"varint,12,opt,name=verifier_validation_quorum,json=verifierValidationQuorum,proto3" json:"verifier_validation_quorum,omitempty"`
 }
 
 func (m *MsgCreateCredentialSchema) Reset()         { *m = MsgCreateCredentialSchema{} }
@@ -242,6 +244,20 @@ func (m *MsgCreateCredentialSchema) GetVerifierPermManagementMode() uint32 {
 	return 0
 }
 
+func (m *MsgCreateCredentialSchema) GetIssuerValidationQuorum() uint32 {
+	if m != nil {
+		return m.IssuerValidationQuorum
+	}
+	return 0
+}
+
+func (m *MsgCreateCredentialSchema) GetVerifierValidationQuorum() uint32 {
+	if m != nil {
+		return m.VerifierValidationQuorum
+	}
+	return 0
+}
+
 type MsgCreateCredentialSchemaResponse struct {
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
@@ -294,6 +310,8 @@ type MsgUpdateCredentialSchema struct {
 	IssuerValidationValidityPeriod          uint32 `protobuf:"varint,5,opt,name=issuer_validation_validity_period,json=issuerValidationValidityPeriod,proto3" json:"issuer_validation_validity_period,omitempty"`
 	VerifierValidationValidityPeriod        uint32 `protobuf:"varint,6,opt,name=verifier_validation_validity_period,json=verifierValidationValidityPeriod,proto3" json:"verifier_validation_validity_period,omitempty"`
 	HolderValidationValidityPeriod          uint32 `protobuf:"varint,7,opt,name=holder_validation_validity_period,json=holderValidationValidityPeriod,proto3" json:"holder_validation_validity_period,omitempty"`
+	IssuerValidationQuorum                  uint32 `protobuf:"varint,8,opt,name=issuer_validation_quorum,json=issuerValidationQuorum,proto3" json:"issuer_validation_quorum,omitempty"`
+	VerifierValidationQuorum                uint32 `protobuf:"varint,9,opt,name=verifier_validation_quorum,json=verifierValidationQuorum,proto3" json:"verifier_validation_quorum,omitempty"`
 }
 
 func (m *MsgUpdateCredentialSchema) Reset()         { *m = MsgUpdateCredentialSchema{} }
@@ -378,6 +396,20 @@ func (m *MsgUpdateCredentialSchema) GetHolderValidationValidityPeriod() uint32 {
 	return 0
 }
 
+func (m *MsgUpdateCredentialSchema) GetIssuerValidationQuorum() uint32 {
+	if m != nil {
+		return m.IssuerValidationQuorum
+	}
+	return 0
+}
+
+func (m *MsgUpdateCredentialSchema) GetVerifierValidationQuorum() uint32 {
+	if m != nil {
+		return m.VerifierValidationQuorum
+	}
+	return 0
+}
+
 type MsgUpdateCredentialSchemaResponse struct {
 }
 
@@ -524,55 +556,58 @@ func init() {
 func init() { proto.RegisterFile("verana/cs/v1/tx.proto", fileDescriptor_28caaebe5b206ca2) }
 
 var fileDescriptor_28caaebe5b206ca2 = []byte{
-	// 764 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0x4f, 0x4f, 0x13, 0x41,
-	0x18, 0xc6, 0xbb, 0xfd, 0x4b, 0x07, 0xd4, 0xb8, 0xa2, 0x2c, 0x6b, 0x28, 0xa5, 0x48, 0x28, 0x18,
-	0xba, 0x02, 0x89, 0x26, 0x44, 0x0f, 0x85, 0x83, 0xe1, 0xd0, 0x84, 0x2c, 0x4a, 0x8c, 0x9a, 0x6c,
-	0xa6, 0xbb, 0xe3, 0x76, 0xb4, 0xbb, 0xd3, 0xcc, 0x4c, 0x1b, 0xb8, 0x19, 0x4f, 0x86, 0x93, 0x1f,
-	0xc3, 0x23, 0x31, 0x7e, 0x00, 0xbd, 0x71, 0x24, 0x9e, 0x3c, 0x19, 0x03, 0x07, 0xbe, 0x86, 0xe9,
-	0xcc, 0x6e, 0xa1, 0xa5, 0x5b, 0x6a, 0x02, 0x97, 0x66, 0x77, 0xde, 0xe7, 0xfd, 0xbd, 0xcf, 0x64,
-	0x9f, 0xed, 0x2c, 0xb8, 0xdb, 0x42, 0x14, 0xfa, 0xd0, 0xb0, 0x99, 0xd1, 0x5a, 0x36, 0xf8, 0x6e,
-	0xa9, 0x41, 0x09, 0x27, 0xea, 0x98, 0x5c, 0x2e, 0xd9, 0xac, 0xd4, 0x5a, 0xd6, 0x6f, 0x43, 0x0f,
-	0xfb, 0xc4, 0x10, 0xbf, 0x52, 0xa0, 0x4f, 0xd8, 0x84, 0x79, 0x84, 0x19, 0x1e, 0x73, 0xdb, 0x8d,
-	0x1e, 0x73, 0x83, 0xc2, 0xa4, 0x2c, 0x58, 0xe2, 0xce, 0x90, 0x37, 0x41, 0x69, 0xdc, 0x25, 0x2e,
-	0x91, 0xeb, 0xed, 0xab, 0xb0, 0xa1, 0xcb, 0x41, 0x03, 0x52, 0xe8, 0x85, 0x0d, 0x5a, 0xb7, 0xb9,
-	0xbd, 0x06, 0x0a, 0x2a, 0x85, 0x1f, 0x0a, 0xb8, 0x55, 0x61, 0xee, 0xcb, 0x86, 0x03, 0x39, 0xda,
-	0x12, 0x3d, 0xea, 0x63, 0x90, 0x85, 0x4d, 0x5e, 0x23, 0x14, 0xf3, 0x3d, 0x4d, 0xc9, 0x2b, 0xc5,
-	0xec, 0xba, 0xf6, 0xeb, 0xfb, 0xd2, 0x78, 0xe0, 0xa1, 0xec, 0x38, 0x14, 0x31, 0xb6, 0xcd, 0x29,
-	0xf6, 0x5d, 0xf3, 0x4c, 0xaa, 0x3e, 0x01, 0x69, 0x39, 0x55, 0x8b, 0xe7, 0x95, 0xe2, 0xe8, 0xca,
-	0x78, 0xe9, 0xfc, 0xe6, 0x4b, 0x92, 0xbe, 0x9e, 0x3d, 0xfc, 0x33, 0x1d, 0xfb, 0x7a, 0x7a, 0xb0,
-	0xa8, 0x98, 0x81, 0x7c, 0xed, 0xe9, 0xa7, 0xd3, 0x83, 0xc5, 0x33, 0xd0, 0xfe, 0xe9, 0xc1, 0xe2,
-	0x42, 0xe0, 0x78, 0xd7, 0xb0, 0x29, 0x72, 0x90, 0xcf, 0x31, 0xac, 0x33, 0xbb, 0x86, 0x3c, 0x68,
-	0xf4, 0xd8, 0x2d, 0x4c, 0x82, 0x89, 0x9e, 0x25, 0x13, 0xb1, 0x06, 0xf1, 0x19, 0x2a, 0x7c, 0x4b,
-	0x81, 0xc9, 0x0a, 0x73, 0x37, 0x28, 0x82, 0x1c, 0x6d, 0x74, 0x40, 0xdb, 0x02, 0xa4, 0xae, 0x80,
-	0x8c, 0xdd, 0xae, 0x10, 0x7a, 0xe9, 0x2e, 0x43, 0xa1, 0x7a, 0x07, 0xa4, 0x38, 0xb5, 0xb0, 0x23,
-	0xb6, 0x98, 0x34, 0x93, 0x9c, 0x6e, 0x3a, 0xea, 0x34, 0x18, 0x7d, 0xcf, 0x88, 0x6f, 0x49, 0x83,
-	0x5a, 0xa2, 0x0d, 0x33, 0x41, 0x7b, 0x29, 0x98, 0xf4, 0x0a, 0x2c, 0x60, 0xc6, 0x9a, 0x88, 0x5a,
-	0x2e, 0x85, 0x3e, 0x27, 0xd4, 0x6a, 0xc1, 0x3a, 0x76, 0x20, 0xc7, 0xc4, 0x97, 0x97, 0x98, 0xef,
-	0x59, 0x0d, 0x44, 0x31, 0x71, 0xb4, 0x64, 0x5e, 0x29, 0xde, 0x30, 0xe7, 0x64, 0xc3, 0x73, 0xa9,
-	0xdf, 0xe9, 0xc8, 0x77, 0x02, 0xf5, 0x96, 0x10, 0xab, 0x6f, 0xc1, 0xc3, 0x16, 0xa2, 0xf8, 0x1d,
-	0x1e, 0x8e, 0x9d, 0x12, 0xec, 0xf9, 0xb0, 0xe5, 0x32, 0xfa, 0x26, 0x98, 0x09, 0x7c, 0x0f, 0x60,
-	0xa6, 0x05, 0x33, 0x27, 0x85, 0x91, 0xa8, 0x0a, 0x98, 0xed, 0x18, 0x1d, 0x00, 0xcb, 0x08, 0x58,
-	0x3e, 0x94, 0x0e, 0x72, 0x56, 0x23, 0x75, 0x67, 0x30, 0x6c, 0x44, 0x3a, 0x93, 0xc2, 0x48, 0xd4,
-	0x33, 0x70, 0x3f, 0xd8, 0x64, 0x03, 0x51, 0xcf, 0xf2, 0xa0, 0x0f, 0x5d, 0xe4, 0x21, 0x9f, 0x5b,
-	0x1e, 0x71, 0x90, 0x96, 0x15, 0x10, 0x4d, 0x4a, 0xb6, 0x10, 0xf5, 0x2a, 0x1d, 0x41, 0x85, 0x38,
-	0x48, 0x2d, 0x83, 0xa9, 0xce, 0xc6, 0xfa, 0x02, 0x80, 0x00, 0xe8, 0xa1, 0xe8, 0x22, 0x62, 0x6d,
-	0xac, 0x9d, 0xff, 0x30, 0x62, 0x85, 0x55, 0x30, 0x13, 0x99, 0xd9, 0x30, 0xd9, 0xea, 0x4d, 0x10,
-	0xc7, 0x8e, 0x88, 0x6d, 0xd2, 0x8c, 0x63, 0xa7, 0xb0, 0x9f, 0x14, 0x49, 0x97, 0x6f, 0xc1, 0x95,
-	0x24, 0x5d, 0x4e, 0x88, 0x87, 0x13, 0xfe, 0x2f, 0xc3, 0x89, 0x6b, 0xcc, 0x70, 0xf2, 0x1a, 0x32,
-	0x9c, 0xba, 0xca, 0x0c, 0xa7, 0xaf, 0x32, 0xc3, 0x99, 0x61, 0x32, 0xdc, 0x93, 0xa0, 0x59, 0x91,
-	0xa0, 0xfe, 0x59, 0xe8, 0xfc, 0x37, 0x7e, 0x56, 0x80, 0x5e, 0x61, 0x6e, 0x99, 0xda, 0x35, 0xdc,
-	0xba, 0x9e, 0xc8, 0x68, 0x20, 0x03, 0x25, 0x5e, 0x04, 0x62, 0xc4, 0x0c, 0x6f, 0x7b, 0xfc, 0x3e,
-	0x00, 0x85, 0x68, 0x27, 0xa1, 0xe1, 0x95, 0x9f, 0x09, 0x90, 0xa8, 0x30, 0x57, 0x7d, 0x01, 0xc6,
-	0xba, 0x8e, 0xab, 0xa9, 0xee, 0x63, 0xa6, 0xe7, 0x2c, 0xd0, 0xe7, 0x06, 0x96, 0x3b, 0x2f, 0x14,
-	0x05, 0xf7, 0x22, 0x8e, 0x89, 0xf9, 0x0b, 0x80, 0xfe, 0x42, 0xdd, 0x18, 0x52, 0x78, 0x7e, 0x66,
-	0xc4, 0x0b, 0x3b, 0x1f, 0x61, 0x7a, 0x88, 0x99, 0x83, 0x1f, 0xbb, 0xda, 0x04, 0x13, 0x51, 0x8f,
-	0xbc, 0x78, 0x81, 0x15, 0xa1, 0xd4, 0x1f, 0x0d, 0xab, 0x0c, 0xc7, 0xea, 0xa9, 0x8f, 0xed, 0x13,
-	0x7f, 0xfd, 0xcd, 0xe1, 0x71, 0x4e, 0x39, 0x3a, 0xce, 0x29, 0x7f, 0x8f, 0x73, 0xca, 0x97, 0x93,
-	0x5c, 0xec, 0xe8, 0x24, 0x17, 0xfb, 0x7d, 0x92, 0x8b, 0xbd, 0x2e, 0xbb, 0x98, 0xd7, 0x9a, 0xd5,
-	0x92, 0x4d, 0x3c, 0x43, 0xc2, 0x97, 0xea, 0xb0, 0xca, 0xc2, 0xeb, 0x6a, 0x9d, 0xd8, 0x1f, 0xec,
-	0x1a, 0xc4, 0x7e, 0xbf, 0x4f, 0x02, 0xf1, 0x45, 0x53, 0x4d, 0x8b, 0x4f, 0x9a, 0xd5, 0x7f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x94, 0xf4, 0xaf, 0xc7, 0x8b, 0x09, 0x00, 0x00,
+	// 810 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4d, 0x4f, 0x1b, 0x47,
+	0x18, 0xf6, 0xfa, 0x13, 0x0f, 0x6e, 0xab, 0x6e, 0x29, 0xac, 0xb7, 0xc2, 0x18, 0x53, 0x84, 0xa1,
+	0xc2, 0x5b, 0x40, 0x6a, 0x2b, 0x44, 0x0f, 0x86, 0x43, 0xc5, 0xc1, 0x12, 0x5d, 0x5a, 0x14, 0x25,
+	0x91, 0x56, 0xe3, 0xdd, 0xc9, 0x7a, 0x12, 0xef, 0x8e, 0x33, 0xb3, 0xb6, 0xe0, 0x16, 0x71, 0x8a,
+	0x72, 0xca, 0xcf, 0x88, 0x72, 0xe2, 0x90, 0x1f, 0x90, 0xdc, 0x38, 0xa2, 0x9c, 0x72, 0x8a, 0x22,
+	0x38, 0xf0, 0x37, 0x22, 0xcf, 0xec, 0x2e, 0xf8, 0x63, 0x8d, 0x51, 0xe0, 0x62, 0xcd, 0xcc, 0xfb,
+	0xbc, 0xcf, 0xfb, 0xcc, 0xec, 0xfb, 0x78, 0x06, 0xfc, 0xdc, 0x41, 0x14, 0xba, 0x50, 0x33, 0x99,
+	0xd6, 0x59, 0xd3, 0xbc, 0xc3, 0x4a, 0x8b, 0x12, 0x8f, 0xc8, 0x39, 0xb1, 0x5c, 0x31, 0x59, 0xa5,
+	0xb3, 0xa6, 0xfe, 0x08, 0x1d, 0xec, 0x12, 0x8d, 0xff, 0x0a, 0x80, 0x3a, 0x63, 0x12, 0xe6, 0x10,
+	0xa6, 0x39, 0xcc, 0xee, 0x26, 0x3a, 0xcc, 0xf6, 0x03, 0x79, 0x11, 0x30, 0xf8, 0x4c, 0x13, 0x13,
+	0x3f, 0x34, 0x65, 0x13, 0x9b, 0x88, 0xf5, 0xee, 0x28, 0x48, 0xe8, 0x51, 0xd0, 0x82, 0x14, 0x3a,
+	0x41, 0x82, 0xd2, 0x2b, 0xee, 0xa8, 0x85, 0xfc, 0x48, 0xe9, 0xbd, 0x04, 0x7e, 0xa8, 0x31, 0xfb,
+	0xff, 0x96, 0x05, 0x3d, 0xb4, 0xc7, 0x73, 0xe4, 0x3f, 0x40, 0x16, 0xb6, 0xbd, 0x06, 0xa1, 0xd8,
+	0x3b, 0x52, 0xa4, 0xa2, 0x54, 0xce, 0x6e, 0x2b, 0x1f, 0xdf, 0xad, 0x4e, 0xf9, 0x1a, 0xaa, 0x96,
+	0x45, 0x11, 0x63, 0xfb, 0x1e, 0xc5, 0xae, 0xad, 0x5f, 0x41, 0xe5, 0x3f, 0x41, 0x5a, 0x54, 0x55,
+	0xe2, 0x45, 0xa9, 0x3c, 0xb9, 0x3e, 0x55, 0xb9, 0xbe, 0xf9, 0x8a, 0x60, 0xdf, 0xce, 0x9e, 0x7e,
+	0x9e, 0x8b, 0xbd, 0xb9, 0x3c, 0x59, 0x91, 0x74, 0x1f, 0xbe, 0xb9, 0x75, 0x7c, 0x79, 0xb2, 0x72,
+	0x45, 0xf4, 0xea, 0xf2, 0x64, 0x65, 0xd9, 0x57, 0x7c, 0xa8, 0x99, 0x14, 0x59, 0xc8, 0xf5, 0x30,
+	0x6c, 0x32, 0xb3, 0x81, 0x1c, 0xa8, 0xf5, 0xc9, 0x2d, 0xe5, 0xc1, 0x4c, 0xdf, 0x92, 0x8e, 0x58,
+	0x8b, 0xb8, 0x0c, 0x95, 0xde, 0xa6, 0x41, 0xbe, 0xc6, 0xec, 0x1d, 0x8a, 0xa0, 0x87, 0x76, 0x42,
+	0xa2, 0x7d, 0x4e, 0x24, 0xaf, 0x83, 0x8c, 0xd9, 0x8d, 0x10, 0x7a, 0xe3, 0x2e, 0x03, 0xa0, 0xfc,
+	0x13, 0x48, 0x79, 0xd4, 0xc0, 0x16, 0xdf, 0x62, 0x52, 0x4f, 0x7a, 0x74, 0xd7, 0x92, 0xe7, 0xc0,
+	0xe4, 0x53, 0x46, 0x5c, 0x43, 0x08, 0x54, 0x12, 0x5d, 0x32, 0x1d, 0x74, 0x97, 0xfc, 0x4a, 0x0f,
+	0xc0, 0x32, 0x66, 0xac, 0x8d, 0xa8, 0x61, 0x53, 0xe8, 0x7a, 0x84, 0x1a, 0x1d, 0xd8, 0xc4, 0x16,
+	0xf4, 0x30, 0x71, 0xc5, 0x10, 0x7b, 0x47, 0x46, 0x0b, 0x51, 0x4c, 0x2c, 0x25, 0x59, 0x94, 0xca,
+	0xdf, 0xe9, 0x8b, 0x22, 0xe1, 0x1f, 0x81, 0x3f, 0x08, 0xe1, 0x07, 0x3e, 0x7a, 0x8f, 0x83, 0xe5,
+	0xc7, 0xe0, 0xb7, 0x0e, 0xa2, 0xf8, 0x09, 0x1e, 0x8f, 0x3b, 0xc5, 0xb9, 0x97, 0x82, 0x94, 0x9b,
+	0xd8, 0x77, 0xc1, 0xbc, 0xaf, 0x7b, 0x04, 0x67, 0x9a, 0x73, 0x16, 0x04, 0x30, 0x92, 0xaa, 0x06,
+	0x16, 0x42, 0xa1, 0x23, 0xc8, 0x32, 0x9c, 0xac, 0x18, 0x40, 0x47, 0x29, 0x6b, 0x90, 0xa6, 0x35,
+	0x9a, 0x6c, 0x42, 0x28, 0x13, 0xc0, 0x48, 0xaa, 0xbf, 0xc1, 0x2f, 0xfe, 0x26, 0x5b, 0x88, 0x3a,
+	0x86, 0x03, 0x5d, 0x68, 0x23, 0x07, 0xb9, 0x9e, 0xe1, 0x10, 0x0b, 0x29, 0x59, 0x4e, 0xa2, 0x08,
+	0xc8, 0x1e, 0xa2, 0x4e, 0x2d, 0x04, 0xd4, 0x88, 0x85, 0xe4, 0x2a, 0x98, 0x0d, 0x37, 0x36, 0x94,
+	0x00, 0x70, 0x02, 0x35, 0x00, 0x0d, 0xa1, 0xf8, 0x0b, 0x28, 0x83, 0xc7, 0xfc, 0xbc, 0x4d, 0x68,
+	0xdb, 0x51, 0x26, 0x79, 0xf6, 0x74, 0xff, 0xe9, 0xfe, 0xcb, 0xa3, 0xf2, 0x16, 0x50, 0x87, 0x9d,
+	0xaa, 0x9f, 0x9b, 0x13, 0xd2, 0x07, 0x0f, 0x53, 0x64, 0x6f, 0xe6, 0xba, 0xbe, 0x0b, 0x5a, 0xbb,
+	0xb4, 0x01, 0xe6, 0x23, 0xbd, 0x12, 0x38, 0x4a, 0xfe, 0x1e, 0xc4, 0xb1, 0xc5, 0xed, 0x92, 0xd4,
+	0xe3, 0xd8, 0x2a, 0x1d, 0xa7, 0x40, 0x3e, 0x74, 0xdf, 0x9d, 0x38, 0x4c, 0x54, 0x88, 0x07, 0x15,
+	0x6e, 0xe7, 0x9d, 0xc4, 0x3d, 0x7a, 0x27, 0x79, 0x0f, 0xde, 0x49, 0xdd, 0xa5, 0x77, 0xd2, 0x77,
+	0xe9, 0x9d, 0xcc, 0x58, 0xde, 0x19, 0xd5, 0xb9, 0x13, 0xdf, 0xd0, 0xb9, 0xd9, 0x5b, 0x75, 0xee,
+	0x02, 0x98, 0x8f, 0xec, 0xc1, 0xf0, 0x2e, 0x78, 0x29, 0x01, 0xb5, 0xc6, 0xec, 0x2a, 0x35, 0x1b,
+	0xb8, 0x73, 0x3f, 0xad, 0xaa, 0x80, 0x0c, 0x14, 0xf4, 0xbc, 0x11, 0x27, 0xf4, 0x60, 0xda, 0xa7,
+	0xf7, 0x57, 0x50, 0x8a, 0x56, 0x12, 0x08, 0x5e, 0xff, 0x90, 0x00, 0x89, 0x1a, 0xb3, 0xe5, 0xff,
+	0x40, 0xae, 0xe7, 0x7a, 0x9e, 0xed, 0xbd, 0x56, 0xfb, 0xee, 0x3e, 0x75, 0x71, 0x64, 0x38, 0x34,
+	0x32, 0x05, 0xd3, 0x11, 0xd7, 0xe2, 0xd2, 0x00, 0xc1, 0x70, 0xa0, 0xaa, 0x8d, 0x09, 0xbc, 0x5e,
+	0x33, 0xe2, 0x8f, 0x62, 0x29, 0x42, 0xf4, 0x18, 0x35, 0x47, 0x7f, 0x76, 0xb9, 0x0d, 0x66, 0xa2,
+	0x3e, 0x79, 0x79, 0x80, 0x2b, 0x02, 0xa9, 0xfe, 0x3e, 0x2e, 0x32, 0x28, 0xab, 0xa6, 0x5e, 0x74,
+	0x5f, 0x38, 0xdb, 0x8f, 0x4e, 0xcf, 0x0b, 0xd2, 0xd9, 0x79, 0x41, 0xfa, 0x72, 0x5e, 0x90, 0x5e,
+	0x5f, 0x14, 0x62, 0x67, 0x17, 0x85, 0xd8, 0xa7, 0x8b, 0x42, 0xec, 0x61, 0xd5, 0xc6, 0x5e, 0xa3,
+	0x5d, 0xaf, 0x98, 0xc4, 0xd1, 0x04, 0xf9, 0x6a, 0x13, 0xd6, 0x59, 0x30, 0xae, 0x37, 0x89, 0xf9,
+	0xcc, 0x6c, 0x40, 0xec, 0x0e, 0x7b, 0x02, 0xf1, 0x17, 0x5c, 0x3d, 0xcd, 0x9f, 0x70, 0x1b, 0x5f,
+	0x07, 0x00, 0x25, 0xd9, 0x40, 0x86, 0x7b, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -857,6 +892,16 @@ func (m *MsgCreateCredentialSchema) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.VerifierValidationQuorum != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.VerifierValidationQuorum))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.IssuerValidationQuorum != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.IssuerValidationQuorum))
+		i--
+		dAtA[i] = 0x58
+	}
 	if m.VerifierPermManagementMode != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.VerifierPermManagementMode))
 		i--
@@ -962,6 +1007,16 @@ func (m *MsgUpdateCredentialSchema) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.VerifierValidationQuorum != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.VerifierValidationQuorum))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.IssuerValidationQuorum != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.IssuerValidationQuorum))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.HolderValidationValidityPeriod != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.HolderValidationValidityPeriod))
 		i--
@@ -1166,6 +1221,12 @@ func (m *MsgCreateCredentialSchema) Size() (n int) {
 	if m.VerifierPermManagementMode != 0 {
 		n += 1 + sovTx(uint64(m.VerifierPermManagementMode))
 	}
+	if m.IssuerValidationQuorum != 0 {
+		n += 1 + sovTx(uint64(m.IssuerValidationQuorum))
+	}
+	if m.VerifierValidationQuorum != 0 {
+		n += 1 + sovTx(uint64(m.VerifierValidationQuorum))
+	}
 	return n
 }
 
@@ -1209,6 +1270,12 @@ func (m *MsgUpdateCredentialSchema) Size() (n int) {
 	if m.HolderValidationValidityPeriod != 0 {
 		n += 1 + sovTx(uint64(m.HolderValidationValidityPeriod))
 	}
+	if m.IssuerValidationQuorum != 0 {
+		n += 1 + sovTx(uint64(m.IssuerValidationQuorum))
+	}
+	if m.VerifierValidationQuorum != 0 {
+		n += 1 + sovTx(uint64(m.VerifierValidationQuorum))
+	}
 	return n
 }
 
@@ -1665,6 +1732,44 @@ func (m *MsgCreateCredentialSchema) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerValidationQuorum", wireType)
+			}
+			m.IssuerValidationQuorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuerValidationQuorum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifierValidationQuorum", wireType)
+			}
+			m.VerifierValidationQuorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerifierValidationQuorum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1930,6 +2035,44 @@ func (m *MsgUpdateCredentialSchema) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerValidationQuorum", wireType)
+			}
+			m.IssuerValidationQuorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuerValidationQuorum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifierValidationQuorum", wireType)
+			}
+			m.VerifierValidationQuorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerifierValidationQuorum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
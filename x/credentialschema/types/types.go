@@ -104,6 +104,10 @@ const jsonSchemaMetaSchema = `{
 `
 const TypeMsgCreateCredentialSchema = "create_credential_schema"
 
+// MaxValidationQuorum is the maximum number of validator approvals a
+// credential schema can require for a validation process.
+const MaxValidationQuorum = 16
+
 var _ sdk.Msg = &MsgCreateCredentialSchema{}
 
 // NewMsgCreateCredentialSchema creates a new MsgCreateCredentialSchema instance
@@ -180,6 +184,16 @@ func (msg *MsgCreateCredentialSchema) ValidateBasic() error {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
+	// Validate validation quorums
+	if err := ValidateValidationQuorums(
+		CredentialSchemaPermManagementMode(msg.IssuerPermManagementMode),
+		CredentialSchemaPermManagementMode(msg.VerifierPermManagementMode),
+		msg.IssuerValidationQuorum,
+		msg.VerifierValidationQuorum,
+	); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
 	return nil
 }
 
@@ -318,6 +332,25 @@ func validatePermManagementModes(msg *MsgCreateCredentialSchema) error {
 	return nil
 }
 
+// ValidateValidationQuorums checks the validation quorums of a credential
+// schema. A quorum above 1 requires the GRANTOR_VALIDATION mode, where the
+// applicant can choose several grantors as validators.
+func ValidateValidationQuorums(issuerMode, verifierMode CredentialSchemaPermManagementMode, issuerQuorum, verifierQuorum uint32) error {
+	if issuerQuorum > MaxValidationQuorum {
+		return fmt.Errorf("issuer validation quorum cannot exceed %d", MaxValidationQuorum)
+	}
+	if verifierQuorum > MaxValidationQuorum {
+		return fmt.Errorf("verifier validation quorum cannot exceed %d", MaxValidationQuorum)
+	}
+	if issuerQuorum > 1 && issuerMode != CredentialSchemaPermManagementMode_GRANTOR_VALIDATION {
+		return fmt.Errorf("issuer validation quorum requires the GRANTOR_VALIDATION issuer perm management mode")
+	}
+	if verifierQuorum > 1 && verifierMode != CredentialSchemaPermManagementMode_GRANTOR_VALIDATION {
+		return fmt.Errorf("verifier validation quorum requires the GRANTOR_VALIDATION verifier perm management mode")
+	}
+	return nil
+}
+
 func (msg *MsgUpdateCredentialSchema) ValidateBasic() error {
 	// Validate creator address
 	_, err := sdk.AccAddressFromBech32(msg.Creator)
@@ -334,6 +367,10 @@ func (msg *MsgUpdateCredentialSchema) ValidateBasic() error {
 		return fmt.Errorf("credential schema id is required")
 	}
 
+	if msg.IssuerValidationQuorum > MaxValidationQuorum || msg.VerifierValidationQuorum > MaxValidationQuorum {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "validation quorum cannot exceed %d", MaxValidationQuorum)
+	}
+
 	return nil
 }
 
@@ -81,6 +81,12 @@ type CredentialSchema struct {
 	HolderValidationValidityPeriod          uint32                             `protobuf:"varint,12,opt,name=holder_validation_validity_period,json=holderValidationValidityPeriod,proto3" json:"holder_validation_validity_period,omitempty"`
 	IssuerPermManagementMode                CredentialSchemaPermManagementMode `protobuf:"varint,13,opt,name=issuer_perm_management_mode,json=issuerPermManagementMode,proto3,enum=verana.cs.v1.CredentialSchemaPermManagementMode" json:"issuer_perm_management_mode,omitempty"`
 	VerifierPermManagementMode              CredentialSchemaPermManagementMode `protobuf:"varint,14,opt,name=verifier_perm_management_mode,json=verifierPermManagementMode,proto3,enum=verana.cs.v1.CredentialSchemaPermManagementMode" json:"verifier_perm_management_mode,omitempty"`
+	// issuer_validation_quorum is the number of validator approvals an ISSUER
+	// validation process requires. 0 or 1 means a single validator.
+	IssuerValidationQuorum uint32 `protobuf:"varint,15,opt,name=issuer_validation_quorum,json=issuerValidationQuorum,proto3" json:"issuer_validation_quorum,omitempty"`
+	// verifier_validation_quorum is the number of validator approvals a
+	// VERIFIER validation process requires. 0 or 1 means a single validator.
+	VerifierValidationQuorum uint32 `protobuf:"varint,16,opt,name=verifier_validation_quorum,json=verifierValidationQuorum,proto3" json:"verifier_validation_quorum,omitempty"`
 }
 
 func (m *CredentialSchema) Reset()         { *m = CredentialSchema{} }
@@ -214,6 +220,20 @@ func (m *CredentialSchema) GetVerifierPermManagementMode() CredentialSchemaPermM
 	return CredentialSchemaPermManagementMode_MODE_UNSPECIFIED
 }
 
+func (m *CredentialSchema) GetIssuerValidationQuorum() uint32 {
+	if m != nil {
+		return m.IssuerValidationQuorum
+	}
+	return 0
+}
+
+func (m *CredentialSchema) GetVerifierValidationQuorum() uint32 {
+	if m != nil {
+		return m.VerifierValidationQuorum
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("verana.cs.v1.CredentialSchemaPermManagementMode", CredentialSchemaPermManagementMode_name, CredentialSchemaPermManagementMode_value)
 	proto.RegisterType((*CredentialSchema)(nil), "verana.cs.v1.CredentialSchema")
@@ -222,46 +242,48 @@ func init() {
 func init() { proto.RegisterFile("verana/cs/v1/types.proto", fileDescriptor_73b144e4376bdc5f) }
 
 var fileDescriptor_73b144e4376bdc5f = []byte{
-	// 613 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xdd, 0x6e, 0xd3, 0x3e,
-	0x18, 0xc6, 0x9b, 0xae, 0x5b, 0x3b, 0xef, 0x43, 0xfd, 0xfb, 0x3f, 0x21, 0x53, 0x44, 0x5a, 0x86,
-	0x10, 0x05, 0x44, 0xc2, 0xc6, 0x39, 0xa2, 0xdb, 0xca, 0x54, 0x89, 0x7e, 0x28, 0x2d, 0x13, 0x5f,
-	0x52, 0xe4, 0xc6, 0x5e, 0x6a, 0x56, 0xc7, 0x95, 0xed, 0x55, 0xec, 0x2e, 0x76, 0x3b, 0xdc, 0xc1,
-	0x0e, 0x77, 0xc8, 0x11, 0xa0, 0xf5, 0x46, 0x50, 0xe3, 0x26, 0x42, 0x8c, 0x75, 0x83, 0x93, 0xea,
-	0xb5, 0xdf, 0xe7, 0xf9, 0xe5, 0x79, 0x2d, 0xbd, 0x05, 0x68, 0x4c, 0x25, 0x8e, 0xb0, 0x1b, 0x28,
-	0x77, 0xbc, 0xe5, 0xea, 0x93, 0x11, 0x55, 0xce, 0x48, 0x0a, 0x2d, 0xe0, 0xaa, 0xe9, 0x38, 0x81,
-	0x72, 0xc6, 0x5b, 0xa5, 0xff, 0x30, 0x67, 0x91, 0x70, 0xe3, 0x5f, 0x23, 0x28, 0x6d, 0x84, 0x22,
-	0x14, 0x71, 0xe9, 0x4e, 0xab, 0xd9, 0x6d, 0x39, 0x14, 0x22, 0x1c, 0x52, 0x37, 0x3e, 0xf5, 0x8f,
-	0x0f, 0x5d, 0xcd, 0x38, 0x55, 0x1a, 0xf3, 0xd1, 0x4c, 0x70, 0x3b, 0x10, 0x8a, 0x0b, 0xe5, 0x1b,
-	0xa7, 0x39, 0x98, 0xd6, 0xe6, 0x97, 0x3c, 0x28, 0xee, 0x4a, 0x4a, 0x68, 0xa4, 0x19, 0x1e, 0x76,
-	0x83, 0x01, 0xe5, 0x18, 0xae, 0x83, 0x2c, 0x23, 0xc8, 0xaa, 0x58, 0xd5, 0x9c, 0x97, 0x65, 0x04,
-	0xfe, 0x0f, 0x16, 0xb5, 0xf4, 0x19, 0x41, 0xd9, 0xf8, 0x2a, 0xa7, 0x65, 0x83, 0xc0, 0x17, 0x20,
-	0x1f, 0x48, 0x8a, 0x35, 0x25, 0x68, 0xa1, 0x62, 0x55, 0x57, 0xb6, 0x4b, 0x8e, 0xc9, 0xe1, 0x24,
-	0x39, 0x9c, 0x5e, 0x92, 0x63, 0xa7, 0x70, 0xf6, 0xad, 0x9c, 0x39, 0xfd, 0x5e, 0xb6, 0xbc, 0xc4,
-	0x04, 0x5f, 0x82, 0x02, 0x17, 0x84, 0x1d, 0x32, 0x4a, 0x50, 0xee, 0x2f, 0x00, 0xa9, 0x6b, 0x4a,
-	0xc0, 0x32, 0x18, 0xb0, 0x31, 0x25, 0x68, 0xf1, 0x46, 0x04, 0xcb, 0x10, 0x12, 0x17, 0x44, 0x20,
-	0x4f, 0xe8, 0x48, 0x28, 0xa6, 0xd1, 0x52, 0x3c, 0x5a, 0x72, 0x84, 0x65, 0xb0, 0xf2, 0x49, 0x89,
-	0xc8, 0x57, 0xf1, 0x8b, 0xa0, 0x7c, 0xc5, 0xaa, 0x2e, 0x7b, 0x60, 0x7a, 0x35, 0x7b, 0xa3, 0xb7,
-	0xe0, 0x11, 0x53, 0xea, 0x98, 0x4a, 0x3f, 0x94, 0x38, 0xd2, 0x42, 0xfa, 0x63, 0x3c, 0x64, 0x04,
-	0x6b, 0x26, 0x22, 0x53, 0x32, 0x7d, 0xe2, 0x8f, 0xa8, 0x64, 0x82, 0xa0, 0x42, 0xc5, 0xaa, 0xae,
-	0x79, 0x0f, 0x8c, 0x61, 0xdf, 0xe8, 0x0f, 0x52, 0xf9, 0xc1, 0x4c, 0xdd, 0x89, 0xc5, 0xf0, 0x23,
-	0x78, 0x32, 0xa6, 0x72, 0x3a, 0xe2, 0x8d, 0xd8, 0xcb, 0x31, 0xfb, 0x61, 0x62, 0xb9, 0x8e, 0xde,
-	0x00, 0xf7, 0x66, 0xb9, 0xe7, 0x30, 0x41, 0xcc, 0xb4, 0x8d, 0xf0, 0x4a, 0x54, 0x13, 0xdc, 0x4f,
-	0x83, 0xce, 0x81, 0xad, 0xc4, 0xb0, 0x4a, 0x22, 0x9d, 0x97, 0x6c, 0x20, 0x86, 0x64, 0x3e, 0x6c,
-	0xd5, 0x24, 0x33, 0xc2, 0x2b, 0x51, 0x02, 0xdc, 0x99, 0x0d, 0x39, 0xa2, 0x92, 0xfb, 0x1c, 0x47,
-	0x38, 0xa4, 0x9c, 0x46, 0xda, 0xe7, 0x82, 0x50, 0xb4, 0x56, 0xb1, 0xaa, 0xeb, 0xdb, 0xcf, 0x9c,
-	0x5f, 0xd7, 0xcd, 0xf9, 0x7d, 0x0b, 0x3a, 0x54, 0xf2, 0x66, 0x6a, 0x6c, 0x0a, 0x42, 0x3d, 0x64,
-	0xa0, 0x97, 0x3b, 0x50, 0x81, 0xbb, 0xe9, 0x53, 0xfc, 0xf1, 0x93, 0xeb, 0xff, 0xf8, 0xc9, 0x52,
-	0x82, 0xbd, 0xdc, 0x7b, 0x7c, 0x04, 0x36, 0xaf, 0x27, 0xc0, 0x0d, 0x50, 0x6c, 0xb6, 0xf7, 0xea,
-	0xfe, 0x9b, 0x56, 0xb7, 0x53, 0xdf, 0x6d, 0xbc, 0x6a, 0xd4, 0xf7, 0x8a, 0x19, 0x58, 0x00, 0xb9,
-	0x76, 0xa7, 0xde, 0x2a, 0x5a, 0xf0, 0x16, 0x80, 0xfb, 0x5e, 0xad, 0xd5, 0x6b, 0x7b, 0xfe, 0x41,
-	0xed, 0x75, 0x63, 0xaf, 0xd6, 0x6b, 0xb4, 0x5b, 0xc5, 0x2c, 0x5c, 0x03, 0xcb, 0xf5, 0xdd, 0x76,
-	0xf7, 0x5d, 0xb7, 0x57, 0x6f, 0x16, 0x17, 0x76, 0x3e, 0x9c, 0x5d, 0xd8, 0xd6, 0xf9, 0x85, 0x6d,
-	0xfd, 0xb8, 0xb0, 0xad, 0xd3, 0x89, 0x9d, 0x39, 0x9f, 0xd8, 0x99, 0xaf, 0x13, 0x3b, 0xf3, 0xbe,
-	0x16, 0x32, 0x3d, 0x38, 0xee, 0x3b, 0x81, 0xe0, 0xae, 0x19, 0xef, 0xe9, 0x10, 0xf7, 0x55, 0x52,
-	0xf7, 0x87, 0x22, 0x38, 0x0a, 0x06, 0x98, 0x45, 0xee, 0x67, 0x37, 0x48, 0xf3, 0x9a, 0xc5, 0x32,
-	0x7f, 0x7f, 0xfd, 0xa5, 0x78, 0x5f, 0x9f, 0xff, 0x0c, 0x00, 0x00, 0xff, 0xff, 0xbc, 0xb3, 0xce,
-	0x13, 0x1b, 0x05, 0x00, 0x00,
+	// 650 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x5d, 0x6f, 0xd3, 0x3a,
+	0x1c, 0xc6, 0x9b, 0xae, 0xdb, 0x3a, 0xef, 0xe5, 0xe4, 0xf8, 0x4c, 0x93, 0x4f, 0x8f, 0x4e, 0x5a,
+	0x86, 0x10, 0x05, 0x44, 0xc2, 0xc6, 0x0d, 0x17, 0x08, 0xd1, 0x6d, 0x65, 0xaa, 0x44, 0x5f, 0x48,
+	0xcb, 0xc4, 0x9b, 0x14, 0xb9, 0xb1, 0x97, 0x9a, 0x35, 0x71, 0x71, 0xdc, 0x8a, 0x7d, 0x8b, 0x7d,
+	0x15, 0xbe, 0xc5, 0x2e, 0x77, 0xc9, 0x15, 0xa0, 0xed, 0x8b, 0xa0, 0xda, 0x49, 0x85, 0xf6, 0xd2,
+	0x0d, 0x6e, 0x22, 0xbf, 0x3c, 0xcf, 0xcf, 0x8f, 0xff, 0x7f, 0x39, 0x00, 0x8d, 0xa8, 0xc0, 0x11,
+	0x76, 0xfc, 0xd8, 0x19, 0x6d, 0x38, 0xf2, 0x70, 0x40, 0x63, 0x7b, 0x20, 0xb8, 0xe4, 0x70, 0x49,
+	0xef, 0xd8, 0x7e, 0x6c, 0x8f, 0x36, 0x0a, 0x7f, 0xe3, 0x90, 0x45, 0xdc, 0x51, 0x5f, 0x2d, 0x28,
+	0xac, 0x06, 0x3c, 0xe0, 0x6a, 0xe8, 0x8c, 0x47, 0xc9, 0x6a, 0x31, 0xe0, 0x3c, 0xe8, 0x53, 0x47,
+	0xcd, 0xba, 0xc3, 0x7d, 0x47, 0xb2, 0x90, 0xc6, 0x12, 0x87, 0x83, 0x44, 0xf0, 0xaf, 0xcf, 0xe3,
+	0x90, 0xc7, 0x9e, 0x76, 0xea, 0x89, 0xde, 0x5a, 0xff, 0x92, 0x07, 0xe6, 0xb6, 0xa0, 0x84, 0x46,
+	0x92, 0xe1, 0x7e, 0xdb, 0xef, 0xd1, 0x10, 0xc3, 0x15, 0x90, 0x65, 0x04, 0x19, 0x25, 0xa3, 0x9c,
+	0x73, 0xb3, 0x8c, 0xc0, 0x7f, 0xc0, 0xac, 0x14, 0x1e, 0x23, 0x28, 0xab, 0x96, 0x72, 0x52, 0xd4,
+	0x08, 0x7c, 0x06, 0xe6, 0x7d, 0x41, 0xb1, 0xa4, 0x04, 0xcd, 0x94, 0x8c, 0xf2, 0xe2, 0x66, 0xc1,
+	0xd6, 0x39, 0xec, 0x34, 0x87, 0xdd, 0x49, 0x73, 0x6c, 0xe5, 0x8f, 0xbf, 0x15, 0x33, 0x47, 0xdf,
+	0x8b, 0x86, 0x9b, 0x9a, 0xe0, 0x73, 0x90, 0x0f, 0x39, 0x61, 0xfb, 0x8c, 0x12, 0x94, 0xfb, 0x0d,
+	0xc0, 0xc4, 0x35, 0x26, 0x60, 0xe1, 0xf7, 0xd8, 0x88, 0x12, 0x34, 0x7b, 0x23, 0x82, 0xa1, 0x09,
+	0xa9, 0x0b, 0x22, 0x30, 0x4f, 0xe8, 0x80, 0xc7, 0x4c, 0xa2, 0x39, 0x75, 0xb5, 0x74, 0x0a, 0x8b,
+	0x60, 0xf1, 0x63, 0xcc, 0x23, 0x2f, 0x56, 0x15, 0x41, 0xf3, 0x25, 0xa3, 0xbc, 0xe0, 0x82, 0xf1,
+	0x52, 0x52, 0xa3, 0x37, 0xe0, 0x1e, 0x8b, 0xe3, 0x21, 0x15, 0x5e, 0x20, 0x70, 0x24, 0xb9, 0xf0,
+	0x46, 0xb8, 0xcf, 0x08, 0x96, 0x8c, 0x47, 0x7a, 0xc8, 0xe4, 0xa1, 0x37, 0xa0, 0x82, 0x71, 0x82,
+	0xf2, 0x25, 0xa3, 0xbc, 0xec, 0xde, 0xd1, 0x86, 0x5d, 0xad, 0xdf, 0x9b, 0xc8, 0xf7, 0x12, 0x75,
+	0x4b, 0x89, 0xe1, 0x07, 0xf0, 0x60, 0x44, 0xc5, 0xf8, 0x8a, 0x37, 0x62, 0x2f, 0x28, 0xf6, 0xdd,
+	0xd4, 0x72, 0x1d, 0xbd, 0x06, 0x6e, 0x25, 0xb9, 0xa7, 0x30, 0x81, 0x62, 0x5a, 0x5a, 0x78, 0x25,
+	0xaa, 0x0e, 0x6e, 0x4f, 0x82, 0x4e, 0x81, 0x2d, 0x2a, 0x58, 0x29, 0x95, 0x4e, 0x4b, 0xd6, 0xe3,
+	0x7d, 0x32, 0x1d, 0xb6, 0xa4, 0x93, 0x69, 0xe1, 0x95, 0x28, 0x0e, 0xfe, 0x4b, 0x2e, 0x39, 0xa0,
+	0x22, 0xf4, 0x42, 0x1c, 0xe1, 0x80, 0x86, 0x34, 0x92, 0x5e, 0xc8, 0x09, 0x45, 0xcb, 0x25, 0xa3,
+	0xbc, 0xb2, 0xf9, 0xc8, 0xfe, 0xf5, 0xb9, 0xd9, 0xe7, 0x5f, 0x41, 0x8b, 0x8a, 0xb0, 0x3e, 0x31,
+	0xd6, 0x39, 0xa1, 0x2e, 0xd2, 0xd0, 0x8b, 0x3b, 0x30, 0x06, 0xff, 0x4f, 0x4a, 0x71, 0xe9, 0x91,
+	0x2b, 0x7f, 0x78, 0x64, 0x21, 0xc5, 0x5e, 0x72, 0xe8, 0x13, 0x80, 0x2e, 0xb6, 0xf2, 0xd3, 0x90,
+	0x8b, 0x61, 0x88, 0xfe, 0x52, 0x75, 0x5a, 0x3b, 0xdf, 0xc1, 0x57, 0x6a, 0x17, 0x3e, 0x05, 0x85,
+	0xcb, 0x3a, 0x97, 0x78, 0x4d, 0xe5, 0x45, 0x17, 0x1b, 0xa6, 0xdd, 0xf7, 0x0f, 0xc0, 0xfa, 0xf5,
+	0xc9, 0xe1, 0x2a, 0x30, 0xeb, 0xcd, 0x9d, 0xaa, 0xf7, 0xba, 0xd1, 0x6e, 0x55, 0xb7, 0x6b, 0x2f,
+	0x6a, 0xd5, 0x1d, 0x33, 0x03, 0xf3, 0x20, 0xd7, 0x6c, 0x55, 0x1b, 0xa6, 0x01, 0xd7, 0x00, 0xdc,
+	0x75, 0x2b, 0x8d, 0x4e, 0xd3, 0xf5, 0xf6, 0x2a, 0x2f, 0x6b, 0x3b, 0x95, 0x4e, 0xad, 0xd9, 0x30,
+	0xb3, 0x70, 0x19, 0x2c, 0x54, 0xb7, 0x9b, 0xed, 0xb7, 0xed, 0x4e, 0xb5, 0x6e, 0xce, 0x6c, 0xbd,
+	0x3f, 0x3e, 0xb5, 0x8c, 0x93, 0x53, 0xcb, 0xf8, 0x71, 0x6a, 0x19, 0x47, 0x67, 0x56, 0xe6, 0xe4,
+	0xcc, 0xca, 0x7c, 0x3d, 0xb3, 0x32, 0xef, 0x2a, 0x01, 0x93, 0xbd, 0x61, 0xd7, 0xf6, 0x79, 0xe8,
+	0xe8, 0xb2, 0x3e, 0xec, 0xe3, 0x6e, 0x9c, 0x8e, 0xbb, 0x7d, 0xee, 0x1f, 0xf8, 0x3d, 0xcc, 0x22,
+	0xe7, 0xb3, 0xe3, 0x4f, 0xf2, 0xea, 0x07, 0xad, 0x7f, 0xbb, 0xdd, 0x39, 0xf5, 0x9f, 0x78, 0xfc,
+	0x73, 0x00, 0x60, 0x9b, 0xf9, 0x29, 0x93, 0x05, 0x00, 0x00,
 }
 
 func (m *CredentialSchema) Marshal() (dAtA []byte, err error) {
@@ -284,6 +306,18 @@ func (m *CredentialSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.VerifierValidationQuorum != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.VerifierValidationQuorum))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.IssuerValidationQuorum != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.IssuerValidationQuorum))
+		i--
+		dAtA[i] = 0x78
+	}
 	if m.VerifierPermManagementMode != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.VerifierPermManagementMode))
 		i--
@@ -429,6 +463,12 @@ func (m *CredentialSchema) Size() (n int) {
 	if m.VerifierPermManagementMode != 0 {
 		n += 1 + sovTypes(uint64(m.VerifierPermManagementMode))
 	}
+	if m.IssuerValidationQuorum != 0 {
+		n += 1 + sovTypes(uint64(m.IssuerValidationQuorum))
+	}
+	if m.VerifierValidationQuorum != 0 {
+		n += 2 + sovTypes(uint64(m.VerifierValidationQuorum))
+	}
 	return n
 }
 
@@ -791,6 +831,44 @@ func (m *CredentialSchema) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerValidationQuorum", wireType)
+			}
+			m.IssuerValidationQuorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuerValidationQuorum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifierValidationQuorum", wireType)
+			}
+			m.VerifierValidationQuorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerifierValidationQuorum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
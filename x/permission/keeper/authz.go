@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/verana-labs/verana-blockchain/x/permission/types"
+)
+
+var _ types.FeeQuoter = Keeper{}
+
+// NewFeeQuoterAnteHandler wraps next so that the context of every tx carries
+// the keeper as the FeeQuoter of PermissionAuthorization.Accept.
+func NewFeeQuoterAnteHandler(k Keeper, next sdk.AnteHandler) sdk.AnteHandler {
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		ctx = types.ContextWithFeeQuoter(ctx, k)
+		if next == nil {
+			return ctx, nil
+		}
+		return next(ctx, tx, simulate)
+	}
+}
+
+// QuoteMsgFees returns the fees and trust deposit the signer of msg is charged
+// when it is executed. It is used to enforce the spend limit of a
+// PermissionAuthorization.
+func (k Keeper) QuoteMsgFees(ctx sdk.Context, msg sdk.Msg) (sdk.Coins, error) {
+	ms := msgServer{Keeper: k}
+
+	var amount uint64
+	switch m := msg.(type) {
+	case *types.MsgCreateOrUpdatePermissionSession:
+		permSet, err := ms.findBeneficiaries(ctx, m.IssuerPermId, m.VerifierPermId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find beneficiaries: %w", err)
+		}
+		amount = k.sessionRequiredFunds(ctx, permSet, m.VerifierPermId != 0, m.WalletAgentPermId != 0)
+		if m.CreditId != 0 {
+			// the session fees are prepaid
+			amount -= k.sessionFees(ctx, permSet, m.VerifierPermId != 0)
+		} else if m.AllowanceId != 0 {
+			// the beneficiary fees are paid by the sponsor
+			amount -= k.sessionSponsoredFees(ctx, permSet, m.VerifierPermId != 0)
+		}
+
+	case *types.MsgPurchaseVerificationCredits:
+		permSet, err := ms.findBeneficiaries(ctx, m.IssuerPermId, m.VerifierPermId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find beneficiaries: %w", err)
+		}
+		unitFees, unitTrustDeposit := k.verificationCreditUnitCost(ctx, permSet)
+		amount, err = verificationCreditsCost(unitFees, unitTrustDeposit, m.Count)
+		if err != nil {
+			return nil, err
+		}
+
+	case *types.MsgStartPermissionVP:
+		validatorPerm, err := k.GetPermissionByID(ctx, m.ValidatorPermId)
+		if err != nil {
+			return nil, fmt.Errorf("validator perm not found: %w", err)
+		}
+		fees, deposit, err := ms.validateAndCalculateFees(ctx, m.Creator, validatorPerm)
+		if err != nil {
+			return nil, err
+		}
+		amount = fees + deposit
+
+	case *types.MsgRenewPermissionVP:
+		applicantPerm, err := k.GetPermissionByID(ctx, m.Id)
+		if err != nil {
+			return nil, fmt.Errorf("perm not found: %w", err)
+		}
+		validatorPerm, err := k.GetPermissionByID(ctx, applicantPerm.ValidatorPermId)
+		if err != nil {
+			return nil, fmt.Errorf("validator perm not found: %w", err)
+		}
+		fees, deposit, err := ms.validateAndCalculateFees(ctx, m.Creator, validatorPerm)
+		if err != nil {
+			return nil, err
+		}
+		amount = fees + deposit
+
+	case *types.MsgRepayPermissionSlashedTrustDeposit:
+		perm, err := k.GetPermissionByID(ctx, m.Id)
+		if err != nil {
+			return nil, fmt.Errorf("perm not found: %w", err)
+		}
+		if perm.SlashedDeposit > perm.RepaidDeposit {
+			amount = perm.SlashedDeposit - perm.RepaidDeposit
+		}
+	}
+
+	if amount == 0 {
+		return sdk.NewCoins(), nil
+	}
+	return sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, int64(amount))), nil
+}
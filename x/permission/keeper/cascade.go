@@ -0,0 +1,242 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/verana-labs/verana-blockchain/x/permission/types"
+)
+
+// cascadeGasPerPermission is the gas charged for each descendant perm a
+// cascading revocation goes through.
+const cascadeGasPerPermission = 10_000
+
+// releasePermissionDeposits releases the trust deposits locked by a perm: the
+// deposit of its grantee and the deposits of its validators.
+func (k Keeper) releasePermissionDeposits(ctx sdk.Context, perm *types.Permission, validatorPerm types.Permission) error {
+	if perm.Deposit > 0 {
+		if err := k.trustDeposit.AdjustTrustDeposit(ctx, perm.Grantee, -int64(perm.Deposit)); err != nil {
+			return fmt.Errorf("failed to reduce applicant trust deposit: %w", err)
+		}
+		perm.Deposit = 0
+	}
+
+	if perm.VpValidatorDeposit > 0 {
+		if err := k.trustDeposit.AdjustTrustDeposit(ctx, validatorPerm.Grantee, -int64(perm.VpValidatorDeposit)); err != nil {
+			return fmt.Errorf("failed to reduce validator trust deposit: %w", err)
+		}
+		perm.VpValidatorDeposit = 0
+	}
+
+	return k.releaseCoValidatorDeposits(ctx, perm)
+}
+
+// startRevocationCascade revokes or suspends the descendants of a revoked
+// perm, up to the cascade batch size. The rest of the tree is left to the
+// EndBlocker. It returns the number of descendants processed and whether the
+// cascade is still in progress.
+func (k Keeper) startRevocationCascade(ctx sdk.Context, permID uint64, mode types.CascadeMode, executedBy string) (uint64, bool, error) {
+	now := ctx.BlockTime()
+	queue := []types.RevocationCascade{{
+		PermId:       permID,
+		OriginPermId: permID,
+		Mode:         mode,
+		ExecutedBy:   executedBy,
+		Created:      &now,
+	}}
+
+	processed, remaining, err := k.runRevocationCascades(ctx, queue, k.GetParams(ctx).CascadeBatchSize)
+	if err != nil {
+		return 0, false, err
+	}
+	return processed, remaining > 0, nil
+}
+
+// ContinueRevocationCascades goes on with the cascading revocations that did
+// not fit in their revocation transaction, up to the cascade batch size. It is
+// called from the module EndBlocker.
+func (k Keeper) ContinueRevocationCascades(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	budget := k.GetParams(sdkCtx).CascadeBatchSize
+
+	// Loading at most budget cascades bounds the work of a block, as every
+	// cascade but the last is either processed or finished
+	var queue []types.RevocationCascade
+	err := k.RevocationCascade.Walk(sdkCtx, nil, func(permID uint64, cascade types.RevocationCascade) (bool, error) {
+		queue = append(queue, cascade)
+		return uint64(len(queue)) >= budget, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to load revocation cascades: %w", err)
+	}
+	if len(queue) == 0 {
+		return nil
+	}
+
+	_, _, err = k.runRevocationCascades(sdkCtx, queue, budget)
+	return err
+}
+
+// runRevocationCascades walks the tree below the perms of queue breadth first
+// and processes at most budget descendant perms. Finished cascades are removed
+// from the store while unfinished ones, with their cursor, are saved so they
+// can be continued later. A descendant that fails is left as it is, its own
+// descendants are still processed. It returns the number of descendants
+// processed and the number of cascades left.
+func (k Keeper) runRevocationCascades(ctx sdk.Context, queue []types.RevocationCascade, budget uint64) (uint64, int, error) {
+	processed := uint64(0)
+	for len(queue) > 0 && processed < budget {
+		cascade := queue[0]
+
+		rng := collections.NewPrefixedPairRange[uint64, uint64](cascade.PermId)
+		if cascade.LastChildId != 0 {
+			rng = rng.StartExclusive(cascade.LastChildId)
+		}
+		children, finished, err := k.nextValidatedPermissionIDs(ctx, rng, budget-processed)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		for _, childID := range children {
+			k.applyCascadeToPermission(ctx, cascade, childID)
+			processed++
+			cascade.LastChildId = childID
+
+			child := cascade
+			child.PermId = childID
+			child.LastChildId = 0
+			queue = append(queue, child)
+		}
+		if !finished {
+			queue[0] = cascade
+			break
+		}
+
+		if err := k.RevocationCascade.Remove(ctx, cascade.PermId); err != nil {
+			return 0, 0, fmt.Errorf("failed to remove revocation cascade: %w", err)
+		}
+		queue = queue[1:]
+	}
+
+	for _, cascade := range queue {
+		if err := k.RevocationCascade.Set(ctx, cascade.PermId, cascade); err != nil {
+			return 0, 0, fmt.Errorf("failed to save revocation cascade: %w", err)
+		}
+	}
+
+	return processed, len(queue), nil
+}
+
+// applyCascadeToPermission runs cascadeToPermission in a cache context, so
+// that a perm that can't be revoked or suspended does not halt the cascade. The
+// failure is logged and reported with an event.
+func (k Keeper) applyCascadeToPermission(ctx sdk.Context, cascade types.RevocationCascade, permID uint64) {
+	cacheCtx, write := ctx.CacheContext()
+	err := k.cascadeToPermission(cacheCtx, cascade, permID)
+	if err == nil {
+		write()
+		return
+	}
+
+	k.Logger().Error("failed to cascade revocation to perm", "perm_id", permID, "origin_perm_id", cascade.OriginPermId, "error", err)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCascadePermissionFailed,
+			sdk.NewAttribute(types.AttributeKeyPermissionID, strconv.FormatUint(permID, 10)),
+			sdk.NewAttribute(types.AttributeKeyOriginPermID, strconv.FormatUint(cascade.OriginPermId, 10)),
+			sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+		),
+	)
+}
+
+// nextValidatedPermissionIDs returns at most limit perm IDs of the validator
+// perm index in rng, and whether rng has no more perms.
+func (k Keeper) nextValidatedPermissionIDs(ctx sdk.Context, rng collections.Ranger[collections.Pair[uint64, uint64]], limit uint64) ([]uint64, bool, error) {
+	iter, err := k.PermissionByValidator.Iterate(ctx, rng)
+	if err != nil {
+		return nil, false, err
+	}
+	defer iter.Close()
+
+	var ids []uint64
+	for ; iter.Valid(); iter.Next() {
+		if uint64(len(ids)) >= limit {
+			return ids, false, nil
+		}
+		key, err := iter.Key()
+		if err != nil {
+			return nil, false, err
+		}
+		ids = append(ids, key.K2())
+	}
+	return ids, true, nil
+}
+
+// cascadeToPermission revokes or suspends a descendant perm. Perms already
+// revoked, or already suspended in suspend mode, are left as they are but
+// their own descendants are still processed.
+func (k Keeper) cascadeToPermission(ctx sdk.Context, cascade types.RevocationCascade, permID uint64) error {
+	ctx.GasMeter().ConsumeGas(cascadeGasPerPermission, "cascading revocation")
+
+	perm, err := k.Permission.Get(ctx, permID)
+	if err != nil {
+		return fmt.Errorf("failed to get perm %d: %w", permID, err)
+	}
+	if perm.Revoked != nil {
+		return nil
+	}
+
+	now := ctx.BlockTime()
+	eventType := types.EventTypeCascadeRevokePermission
+	switch cascade.Mode {
+	case types.CascadeMode_CASCADE_MODE_REVOKE:
+		// A deposit that cannot be released, e.g. because the trust deposit
+		// was slashed, stays locked so that the cascade is not blocked
+		validatorPerm, err := k.Permission.Get(ctx, perm.ValidatorPermId)
+		if err != nil {
+			return fmt.Errorf("failed to get validator perm %d: %w", perm.ValidatorPermId, err)
+		}
+		cacheCtx, write := ctx.CacheContext()
+		released := perm
+		if err := k.releasePermissionDeposits(cacheCtx, &released, validatorPerm); err != nil {
+			k.Logger().Error("failed to release deposits of revoked perm", "perm_id", permID, "error", err)
+		} else {
+			write()
+			perm = released
+		}
+		perm.Revoked = &now
+		perm.RevokedBy = cascade.ExecutedBy
+	case types.CascadeMode_CASCADE_MODE_SUSPEND:
+		if isSuspendedAt(perm, now) {
+			return nil
+		}
+		eventType = types.EventTypeCascadeSuspendPermission
+		perm.Suspended = &now
+		perm.SuspendedBy = cascade.ExecutedBy
+		perm.SuspensionReason = fmt.Sprintf("revocation of perm %d", cascade.OriginPermId)
+		perm.SuspendedUntil = nil
+	default:
+		return fmt.Errorf("invalid cascade mode: %s", cascade.Mode)
+	}
+	perm.Modified = &now
+
+	if err := k.UpdatePermission(ctx, perm); err != nil {
+		return fmt.Errorf("failed to update perm %d: %w", permID, err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyPermissionID, strconv.FormatUint(permID, 10)),
+			sdk.NewAttribute(types.AttributeKeyOriginPermID, strconv.FormatUint(cascade.OriginPermId, 10)),
+			sdk.NewAttribute(types.AttributeKeyExecutedBy, cascade.ExecutedBy),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+		),
+	)
+
+	return nil
+}
@@ -1,8 +1,10 @@
 package keeper
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"strconv"
 
 	"cosmossdk.io/math"
 	credentialschematypes "github.com/verana-labs/verana-blockchain/x/credentialschema/types"
@@ -16,11 +18,16 @@ import (
 	"github.com/verana-labs/verana-blockchain/x/permission/types"
 )
 
+// sessionPruneBatchSize bounds the number of expired sessions pruned per block.
+const sessionPruneBatchSize = 200
+
 func (ms msgServer) validateSessionAccess(ctx sdk.Context, msg *types.MsgCreateOrUpdatePermissionSession) error {
 	existingSession, err := ms.PermissionSession.Get(ctx, msg.Id)
 	if err != nil {
 		if errors.Is(err, collections.ErrNotFound) {
-			return nil // New session case
+			// New session case
+			_, err := ms.sessionExpiration(ctx, msg)
+			return err
 		}
 		return sdkerrors.ErrInvalidRequest.Wrapf("failed to get session: %v", err)
 	}
@@ -30,6 +37,20 @@ func (ms msgServer) validateSessionAccess(ctx sdk.Context, msg *types.MsgCreateO
 		return sdkerrors.ErrUnauthorized.Wrap("only session controller can update")
 	}
 
+	if msg.Expires != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("expires can only be set when the session is created")
+	}
+
+	// Expired sessions are awaiting pruning
+	if existingSession.Expires != nil && !ctx.BlockTime().Before(*existingSession.Expires) {
+		return sdkerrors.ErrInvalidRequest.Wrap("session has expired")
+	}
+
+	maxAuthz := ms.GetParams(ctx).SessionMaxAuthz
+	if uint64(len(existingSession.Authz)) >= maxAuthz {
+		return sdkerrors.ErrInvalidRequest.Wrapf("session already has the maximum number of authorizations (%d)", maxAuthz)
+	}
+
 	// Check for duplicate authorization
 	for _, authz := range existingSession.Authz {
 		if authz.ExecutorPermId == msg.IssuerPermId &&
@@ -42,11 +63,29 @@ func (ms msgServer) validateSessionAccess(ctx sdk.Context, msg *types.MsgCreateO
 	return nil
 }
 
+// sessionExecutorPermID returns the perm whose deposit holds the trust deposit
+// processFees adds for the session creator.
+func sessionExecutorPermID(permSet []types.Permission, isVerifier bool) uint64 {
+	if isVerifier {
+		// For verification, use the verifier perm
+		return permSet[0].ValidatorPermId
+	}
+	// For issuance, use the issuer perm
+	return permSet[0].Id
+}
+
+// processFees pays the fees of count sessions to the beneficiaries in permSet
+// and funds the matching trust deposit of creator. The beneficiary fees are
+// paid by creator, or out of the module account, where a sponsor escrowed
+// them, when sponsored is set. The trust deposit of creator is always funded
+// by creator.
 func (ms msgServer) processFees(
 	ctx sdk.Context,
 	creator string,
+	sponsored bool,
 	permSet []types.Permission,
 	isVerifier bool,
+	count uint64,
 	trustUnitPrice uint64,
 	trustDepositRate math.LegacyDec,
 ) error {
@@ -56,50 +95,36 @@ func (ms msgServer) processFees(
 	}
 
 	// Get the executor perm (issuer or verifier)
-	var executorPerm types.Permission
-	if isVerifier {
-		// For verification, use the verifier perm
-		executorPerm, err = ms.Permission.Get(ctx, permSet[0].ValidatorPermId)
-	} else {
-		// For issuance, use the issuer perm
-		executorPerm, err = ms.Permission.Get(ctx, permSet[0].Id)
-	}
+	executorPerm, err := ms.Permission.Get(ctx, sessionExecutorPermID(permSet, isVerifier))
 	if err != nil {
 		return fmt.Errorf("failed to get executor perm: %w", err)
 	}
 
 	// Process each perm's fees
 	for _, perm := range permSet {
-		var fees uint64
-		if isVerifier {
-			fees = perm.VerificationFees
-		} else {
-			fees = perm.IssuanceFees
-		}
+		fee := beneficiaryFee(perm, isVerifier, trustUnitPrice, trustDepositRate)
+		fee.DirectFees *= count
+		fee.TrustDeposit *= count
 
-		if fees > 0 {
-			// Calculate fees in denom
-			feesInDenom := fees * trustUnitPrice
+		if fee.Fees > 0 {
+			trustDepositAmount := fee.TrustDeposit
 
-			// Calculate trust deposit amount
-			trustDepositAmount := uint64(math.LegacyNewDec(int64(feesInDenom)).Mul(trustDepositRate).TruncateInt64())
+			// Direct fees are the portion that goes directly to the grantee
+			directFeesAmount := fee.DirectFees
 
-			// Calculate direct fees (the portion that goes directly to the grantee)
-			directFeesAmount := feesInDenom - trustDepositAmount
-
-			// 1. Transfer direct fees from creator to perm grantee
+			// 1. Transfer direct fees from creator, or from the sponsor escrow, to perm grantee
 			if directFeesAmount > 0 {
 				granteeAddr, err := sdk.AccAddressFromBech32(perm.Grantee)
 				if err != nil {
 					return fmt.Errorf("invalid grantee address: %w", err)
 				}
 
-				err = ms.bankKeeper.SendCoins(
-					ctx,
-					creatorAddr,
-					granteeAddr,
-					sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, int64(directFeesAmount))),
-				)
+				coins := sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, int64(directFeesAmount)))
+				if sponsored {
+					err = ms.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, granteeAddr, coins)
+				} else {
+					err = ms.bankKeeper.SendCoins(ctx, creatorAddr, granteeAddr, coins)
+				}
 				if err != nil {
 					return fmt.Errorf("failed to transfer direct fees: %w", err)
 				}
@@ -107,15 +132,18 @@ func (ms msgServer) processFees(
 
 			// 2. Increase trust deposit for the grantee
 			if trustDepositAmount > 0 {
-				// First transfer funds from creator to module account
-				err = ms.bankKeeper.SendCoinsFromAccountToModule(
-					ctx,
-					creatorAddr,
-					types.ModuleName,
-					sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, int64(trustDepositAmount))),
-				)
-				if err != nil {
-					return fmt.Errorf("failed to transfer trust deposit to module: %w", err)
+				// First transfer funds from creator to module account, the
+				// sponsor funds are already there
+				if !sponsored {
+					err = ms.bankKeeper.SendCoinsFromAccountToModule(
+						ctx,
+						creatorAddr,
+						types.ModuleName,
+						sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, int64(trustDepositAmount))),
+					)
+					if err != nil {
+						return fmt.Errorf("failed to transfer trust deposit to module: %w", err)
+					}
 				}
 
 				// Then adjust grantee's trust deposit
@@ -156,6 +184,25 @@ func (ms msgServer) processFees(
 	return nil
 }
 
+// sessionExpiration returns the expiration of a new session: msg.Expires if
+// set, else the maximum session duration from now.
+func (ms msgServer) sessionExpiration(ctx sdk.Context, msg *types.MsgCreateOrUpdatePermissionSession) (time.Time, error) {
+	now := ctx.BlockTime()
+	maxExpires := now.AddDate(0, 0, int(ms.GetParams(ctx).SessionMaxDurationDays))
+	if msg.Expires == nil {
+		return maxExpires, nil
+	}
+
+	if !msg.Expires.After(now) {
+		return time.Time{}, sdkerrors.ErrInvalidRequest.Wrap("expires must be in the future")
+	}
+	if msg.Expires.After(maxExpires) {
+		return time.Time{}, sdkerrors.ErrInvalidRequest.Wrapf("expires cannot be later than %s", maxExpires)
+	}
+
+	return *msg.Expires, nil
+}
+
 func (ms msgServer) createOrUpdateSession(ctx sdk.Context, msg *types.MsgCreateOrUpdatePermissionSession, now time.Time) error {
 	session := &types.PermissionSession{
 		Id:          msg.Id,
@@ -171,7 +218,15 @@ func (ms msgServer) createOrUpdateSession(ctx sdk.Context, msg *types.MsgCreateO
 		session.Modified = &now
 	} else if errors.Is(err, collections.ErrNotFound) {
 		// New session
+		expires, err := ms.sessionExpiration(ctx, msg)
+		if err != nil {
+			return err
+		}
 		session.Created = &now
+		session.Expires = &expires
+		if err := ms.SessionExpiryQueue.Set(ctx, collections.Join(expires, msg.Id)); err != nil {
+			return err
+		}
 	} else {
 		return err
 	}
@@ -186,6 +241,246 @@ func (ms msgServer) createOrUpdateSession(ctx sdk.Context, msg *types.MsgCreateO
 	return ms.PermissionSession.Set(ctx, msg.Id, *session)
 }
 
+// removeSession deletes a perm session and its expiry queue entry.
+func (k Keeper) removeSession(ctx sdk.Context, session types.PermissionSession) error {
+	if session.Expires != nil {
+		if err := k.SessionExpiryQueue.Remove(ctx, collections.Join(*session.Expires, session.Id)); err != nil {
+			return err
+		}
+	}
+	return k.PermissionSession.Remove(ctx, session.Id)
+}
+
+// PruneExpiredSessions deletes the perm sessions that have expired, at most
+// sessionPruneBatchSize per call. It is called from the module EndBlocker.
+func (k Keeper) PruneExpiredSessions(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime()
+
+	iter, err := k.SessionExpiryQueue.Iterate(sdkCtx, nil)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	var expired []collections.Pair[time.Time, string]
+	for ; iter.Valid() && len(expired) < sessionPruneBatchSize; iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return err
+		}
+		if key.K1().After(now) {
+			break
+		}
+		expired = append(expired, key)
+	}
+
+	for _, key := range expired {
+		if err := k.SessionExpiryQueue.Remove(sdkCtx, key); err != nil {
+			return err
+		}
+		if err := k.PermissionSession.Remove(sdkCtx, key.K2()); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePrunePermissionSession,
+				sdk.NewAttribute(types.AttributeKeySessionID, key.K2()),
+				sdk.NewAttribute(types.AttributeKeyExpires, key.K1().String()),
+			),
+		)
+	}
+
+	return nil
+}
+
+// beneficiaryFee returns the fees processFees charges the session creator for
+// a beneficiary perm. The fees in denom are split between the grantee account
+// and the grantee trust deposit, and the creator funds a matching trust deposit.
+func beneficiaryFee(perm types.Permission, isVerifier bool, trustUnitPrice uint64, trustDepositRate math.LegacyDec) types.BeneficiaryFee {
+	fees := perm.IssuanceFees
+	if isVerifier {
+		fees = perm.VerificationFees
+	}
+
+	feesInDenom := fees * trustUnitPrice
+	trustDepositAmount := uint64(math.LegacyNewDec(int64(feesInDenom)).Mul(trustDepositRate).TruncateInt64())
+
+	return types.BeneficiaryFee{
+		PermId:              perm.Id,
+		Type:                perm.Type,
+		Grantee:             perm.Grantee,
+		Fees:                fees,
+		FeesInDenom:         feesInDenom,
+		DirectFees:          feesInDenom - trustDepositAmount,
+		TrustDeposit:        trustDepositAmount,
+		CreatorTrustDeposit: trustDepositAmount,
+	}
+}
+
+// sessionBeneficiaryFees returns the fees of each beneficiary of a session.
+func (k Keeper) sessionBeneficiaryFees(ctx sdk.Context, permSet []types.Permission, isVerifier bool) []types.BeneficiaryFee {
+	trustUnitPrice := k.trustRegistryKeeper.GetTrustUnitPrice(ctx)
+	trustDepositRate := k.trustDeposit.GetTrustDepositRate(ctx)
+
+	fees := make([]types.BeneficiaryFee, 0, len(permSet))
+	for _, perm := range permSet {
+		fees = append(fees, beneficiaryFee(perm, isVerifier, trustUnitPrice, trustDepositRate))
+	}
+
+	return fees
+}
+
+// sessionFees returns the funds processFees charges the session creator: for
+// each beneficiary its fees and the matching trust deposit of the creator.
+func (k Keeper) sessionFees(ctx sdk.Context, permSet []types.Permission, isVerifier bool) uint64 {
+	total := uint64(0)
+	for _, fee := range k.sessionBeneficiaryFees(ctx, permSet, isVerifier) {
+		total += fee.FeesInDenom + fee.CreatorTrustDeposit
+	}
+
+	return total
+}
+
+// sessionSponsoredFees returns the part of the session fees a sponsor
+// allowance pays: the fees of each beneficiary, without the trust deposit of
+// the creator.
+func (k Keeper) sessionSponsoredFees(ctx sdk.Context, permSet []types.Permission, isVerifier bool) uint64 {
+	total := uint64(0)
+	for _, fee := range k.sessionBeneficiaryFees(ctx, permSet, isVerifier) {
+		total += fee.FeesInDenom
+	}
+
+	return total
+}
+
+// sessionRewards returns the rewards the session creator pays to the user
+// agent and, if the session has one, to the wallet agent. Both are a share of
+// the beneficiary fees.
+func (k Keeper) sessionRewards(ctx sdk.Context, permSet []types.Permission, isVerifier bool, hasWalletAgent bool) (uint64, uint64) {
+	trustUnitPrice := k.trustRegistryKeeper.GetTrustUnitPrice(ctx)
+
+	// Calculate beneficiary fees
+	beneficiaryFees := uint64(0)
+	for _, perm := range permSet {
+		if isVerifier {
+			beneficiaryFees += perm.VerificationFees
+		} else {
+			beneficiaryFees += perm.IssuanceFees
+		}
+	}
+
+	totalFees := math.LegacyNewDec(int64(beneficiaryFees * trustUnitPrice))
+	userAgentReward := uint64(totalFees.Mul(k.trustDeposit.GetUserAgentRewardRate(ctx)).TruncateInt64())
+
+	walletAgentReward := uint64(0)
+	if hasWalletAgent {
+		walletAgentReward = uint64(totalFees.Mul(k.trustDeposit.GetWalletUserAgentRewardRate(ctx)).TruncateInt64())
+	}
+
+	return userAgentReward, walletAgentReward
+}
+
+// sessionRequiredFunds returns the funds the session creator must hold: the
+// session fees and the agent rewards.
+func (k Keeper) sessionRequiredFunds(ctx sdk.Context, permSet []types.Permission, isVerifier bool, hasWalletAgent bool) uint64 {
+	userAgentReward, walletAgentReward := k.sessionRewards(ctx, permSet, isVerifier, hasWalletAgent)
+	return k.sessionFees(ctx, permSet, isVerifier) + userAgentReward + walletAgentReward
+}
+
+// payAgentReward transfers reward from the session creator to the grantee of
+// the agent perm. The trust deposit share of the reward is then moved to the
+// trust deposit of the grantee, and the reward is accrued to the agent perm.
+func (ms msgServer) payAgentReward(
+	ctx sdk.Context,
+	creator string,
+	sessionID string,
+	permID uint64,
+	reward uint64,
+	rewardType string,
+	trustDepositRate math.LegacyDec,
+) error {
+	if reward == 0 {
+		return nil
+	}
+
+	creatorAddr, err := sdk.AccAddressFromBech32(creator)
+	if err != nil {
+		return fmt.Errorf("invalid creator address: %w", err)
+	}
+
+	// Reload the agent perm, processFees may have updated its deposit
+	agentPerm, err := ms.Permission.Get(ctx, permID)
+	if err != nil {
+		return fmt.Errorf("failed to get agent perm: %w", err)
+	}
+
+	granteeAddr, err := sdk.AccAddressFromBech32(agentPerm.Grantee)
+	if err != nil {
+		return fmt.Errorf("invalid grantee address: %w", err)
+	}
+
+	if err := ms.bankKeeper.SendCoins(
+		ctx,
+		creatorAddr,
+		granteeAddr,
+		sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, int64(reward))),
+	); err != nil {
+		return fmt.Errorf("failed to transfer agent reward: %w", err)
+	}
+
+	// Move the trust deposit share of the reward to the grantee trust deposit
+	trustDepositAmount := uint64(math.LegacyNewDec(int64(reward)).Mul(trustDepositRate).TruncateInt64())
+	if trustDepositAmount > 0 {
+		if err := ms.trustDeposit.AdjustTrustDeposit(ctx, agentPerm.Grantee, int64(trustDepositAmount)); err != nil {
+			return fmt.Errorf("failed to adjust agent trust deposit: %w", err)
+		}
+
+		agentPerm.Deposit += trustDepositAmount
+		if err := ms.Keeper.UpdatePermission(ctx, agentPerm); err != nil {
+			return fmt.Errorf("failed to update agent perm deposit: %w", err)
+		}
+	}
+
+	// Accrue the reward to the agent perm
+	accrued, err := ms.AgentReward.Get(ctx, permID)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return fmt.Errorf("failed to get agent reward: %w", err)
+		}
+		accrued = types.AgentReward{PermId: permID}
+	}
+
+	if rewardType == types.RewardTypeWalletAgent {
+		accrued.WalletAgentRewards += reward
+	} else {
+		accrued.UserAgentRewards += reward
+	}
+	accrued.TrustDeposit += trustDepositAmount
+	now := ctx.BlockTime()
+	accrued.LastReward = &now
+
+	if err := ms.AgentReward.Set(ctx, permID, accrued); err != nil {
+		return fmt.Errorf("failed to save agent reward: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePayAgentReward,
+			sdk.NewAttribute(types.AttributeKeySessionID, sessionID),
+			sdk.NewAttribute(types.AttributeKeyPermissionID, strconv.FormatUint(permID, 10)),
+			sdk.NewAttribute(types.AttributeKeyRewardType, rewardType),
+			sdk.NewAttribute(types.AttributeKeyRecipient, agentPerm.Grantee),
+			sdk.NewAttribute(types.AttributeKeyAmount, strconv.FormatUint(reward, 10)),
+			sdk.NewAttribute(types.AttributeKeyTrustDeposit, strconv.FormatUint(trustDepositAmount, 10)),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+		),
+	)
+
+	return nil
+}
+
 // findBeneficiaries gets the set of permissions that should receive fees
 func (ms msgServer) findBeneficiaries(ctx sdk.Context, issuerPermId, verifierPermId uint64) ([]types.Permission, error) {
 	var foundPerms []types.Permission
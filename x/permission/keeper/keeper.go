@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"slices"
 	"time"
 
 	"cosmossdk.io/collections"
@@ -24,9 +25,47 @@ type (
 		// should be the x/gov module account.
 		authority string
 		// state
-		Permission        collections.Map[uint64, types.Permission]
-		PermissionCounter collections.Item[uint64]
-		PermissionSession collections.Map[string, types.PermissionSession]
+		Permission              collections.Map[uint64, types.Permission]
+		PermissionCounter       collections.Item[uint64]
+		PermissionSession       collections.Map[string, types.PermissionSession]
+		SessionAllowance        collections.Map[uint64, types.SessionAllowance]
+		SessionAllowanceCounter collections.Item[uint64]
+		SlashRecord             collections.Map[uint64, types.SlashRecord]
+		SlashRecordCounter      collections.Item[uint64]
+		// SlashAppealQueue indexes pending slashes by appeal deadline
+		SlashAppealQueue collections.KeySet[collections.Pair[time.Time, uint64]]
+		// SlashRecordByPerm indexes slash records by perm ID
+		SlashRecordByPerm collections.KeySet[collections.Pair[uint64, uint64]]
+		// SlashRecordByGrantee indexes slash records by grantee of the slashed perm
+		SlashRecordByGrantee collections.KeySet[collections.Pair[string, uint64]]
+		// SlashRecordBySlasher indexes slash records by slasher account
+		SlashRecordBySlasher collections.KeySet[collections.Pair[string, uint64]]
+		// SlashDistribution holds where slashed deposits go, by trust registry ID
+		SlashDistribution collections.Map[uint64, types.TrustRegistrySlashDistribution]
+		// PermissionByValidator indexes perms by validator perm ID
+		PermissionByValidator collections.KeySet[collections.Pair[uint64, uint64]]
+		// RevocationCascade holds the cascading revocations in progress, by perm ID
+		RevocationCascade collections.Map[uint64, types.RevocationCascade]
+		// AgentReward holds the session rewards accrued by agent perms, by perm ID
+		AgentReward collections.Map[uint64, types.AgentReward]
+		// SessionExpiryQueue indexes perm sessions by expiration
+		SessionExpiryQueue collections.KeySet[collections.Pair[time.Time, string]]
+		// VerificationCredit holds the prepaid verification credits, by ID
+		VerificationCredit        collections.Map[uint64, types.VerificationCredit]
+		VerificationCreditCounter collections.Item[uint64]
+		// VoucherNonce holds the highest session voucher nonce settled, by signer
+		VoucherNonce collections.Map[string, uint64]
+		// UsedVoucherNonce holds the session voucher nonces settled within the
+		// nonce window, by signer
+		UsedVoucherNonce collections.KeySet[collections.Pair[string, uint64]]
+		// PermissionByCountry indexes perms by the codes of their country scope
+		PermissionByCountry collections.KeySet[collections.Pair[string, uint64]]
+		// VPMessage holds the messages anchored for validation processes, by perm ID and index
+		VPMessage collections.Map[collections.Pair[uint64, uint64], types.VPMessage]
+		// PermissionFeeRecord holds the fee history of the perms, by perm ID and index
+		PermissionFeeRecord collections.Map[collections.Pair[uint64, uint64], types.PermissionFeeRecord]
+		// FeeUpdateQueue indexes scheduled fee updates by effective time
+		FeeUpdateQueue collections.KeySet[collections.Pair[time.Time, uint64]]
 
 		// external keeper
 		credentialSchemaKeeper types.CredentialSchemaKeeper
@@ -53,17 +92,38 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		cdc:                    cdc,
-		storeService:           storeService,
-		authority:              authority,
-		logger:                 logger,
-		Permission:             collections.NewMap(sb, types.PermissionKey, "perm", collections.Uint64Key, codec.CollValue[types.Permission](cdc)),
-		PermissionCounter:      collections.NewItem(sb, types.PermissionCounterKey, "permission_counter", collections.Uint64Value),
-		PermissionSession:      collections.NewMap(sb, types.PermissionSessionKey, "permission_session", collections.StringKey, codec.CollValue[types.PermissionSession](cdc)),
-		credentialSchemaKeeper: credentialSchemaKeeper,
-		trustRegistryKeeper:    trustRegistryKeeper,
-		trustDeposit:           trustDeposit,
-		bankKeeper:             bankKeeper,
+		cdc:                       cdc,
+		storeService:              storeService,
+		authority:                 authority,
+		logger:                    logger,
+		Permission:                collections.NewMap(sb, types.PermissionKey, "perm", collections.Uint64Key, codec.CollValue[types.Permission](cdc)),
+		PermissionCounter:         collections.NewItem(sb, types.PermissionCounterKey, "permission_counter", collections.Uint64Value),
+		PermissionSession:         collections.NewMap(sb, types.PermissionSessionKey, "permission_session", collections.StringKey, codec.CollValue[types.PermissionSession](cdc)),
+		SessionAllowance:          collections.NewMap(sb, types.SessionAllowanceKey, "session_allowance", collections.Uint64Key, codec.CollValue[types.SessionAllowance](cdc)),
+		SessionAllowanceCounter:   collections.NewItem(sb, types.SessionAllowanceCounterKey, "session_allowance_counter", collections.Uint64Value),
+		SlashRecord:               collections.NewMap(sb, types.SlashRecordKey, "slash_record", collections.Uint64Key, codec.CollValue[types.SlashRecord](cdc)),
+		SlashRecordCounter:        collections.NewItem(sb, types.SlashRecordCounterKey, "slash_record_counter", collections.Uint64Value),
+		SlashAppealQueue:          collections.NewKeySet(sb, types.SlashAppealQueueKey, "slash_appeal_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key)),
+		SlashRecordByPerm:         collections.NewKeySet(sb, types.SlashRecordByPermKey, "slash_record_by_perm", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key)),
+		SlashRecordByGrantee:      collections.NewKeySet(sb, types.SlashRecordByGranteeKey, "slash_record_by_grantee", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		SlashRecordBySlasher:      collections.NewKeySet(sb, types.SlashRecordBySlasherKey, "slash_record_by_slasher", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		SlashDistribution:         collections.NewMap(sb, types.SlashDistributionKey, "slash_distribution", collections.Uint64Key, codec.CollValue[types.TrustRegistrySlashDistribution](cdc)),
+		PermissionByValidator:     collections.NewKeySet(sb, types.PermissionByValidatorKey, "permission_by_validator", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key)),
+		RevocationCascade:         collections.NewMap(sb, types.RevocationCascadeKey, "revocation_cascade", collections.Uint64Key, codec.CollValue[types.RevocationCascade](cdc)),
+		AgentReward:               collections.NewMap(sb, types.AgentRewardKey, "agent_reward", collections.Uint64Key, codec.CollValue[types.AgentReward](cdc)),
+		SessionExpiryQueue:        collections.NewKeySet(sb, types.SessionExpiryQueueKey, "session_expiry_queue", collections.PairKeyCodec(sdk.TimeKey, collections.StringKey)),
+		VerificationCredit:        collections.NewMap(sb, types.VerificationCreditKey, "verification_credit", collections.Uint64Key, codec.CollValue[types.VerificationCredit](cdc)),
+		VerificationCreditCounter: collections.NewItem(sb, types.VerificationCreditCounterKey, "verification_credit_counter", collections.Uint64Value),
+		VoucherNonce:              collections.NewMap(sb, types.VoucherNonceKey, "voucher_nonce", collections.StringKey, collections.Uint64Value),
+		UsedVoucherNonce:          collections.NewKeySet(sb, types.UsedVoucherNonceKey, "used_voucher_nonce", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		PermissionByCountry:       collections.NewKeySet(sb, types.PermissionByCountryKey, "permission_by_country", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		VPMessage:                 collections.NewMap(sb, types.VPMessageKey, "vp_message", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), codec.CollValue[types.VPMessage](cdc)),
+		PermissionFeeRecord:       collections.NewMap(sb, types.PermissionFeeRecordKey, "permission_fee_record", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), codec.CollValue[types.PermissionFeeRecord](cdc)),
+		FeeUpdateQueue:            collections.NewKeySet(sb, types.FeeUpdateQueueKey, "fee_update_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key)),
+		credentialSchemaKeeper:    credentialSchemaKeeper,
+		trustRegistryKeeper:       trustRegistryKeeper,
+		trustDeposit:              trustDeposit,
+		bankKeeper:                bankKeeper,
 	}
 }
 
@@ -91,6 +151,14 @@ func (k Keeper) CreatePermission(ctx sdk.Context, perm types.Permission) (uint64
 	if err := k.Permission.Set(ctx, id, perm); err != nil {
 		return 0, err
 	}
+	if perm.ValidatorPermId != 0 {
+		if err := k.PermissionByValidator.Set(ctx, collections.Join(perm.ValidatorPermId, id)); err != nil {
+			return 0, err
+		}
+	}
+	if err := k.indexPermissionCountries(ctx, id, perm.Countries); err != nil {
+		return 0, err
+	}
 
 	return id, nil
 }
@@ -112,20 +180,46 @@ func (k Keeper) getNextPermissionID(ctx sdk.Context) (uint64, error) {
 }
 
 func (k Keeper) UpdatePermission(ctx sdk.Context, perm types.Permission) error {
+	old, err := k.Permission.Get(ctx, perm.Id)
+	if err != nil {
+		return err
+	}
+	if !slices.Equal(old.Countries, perm.Countries) {
+		for _, code := range old.Countries {
+			if err := k.PermissionByCountry.Remove(ctx, collections.Join(code, perm.Id)); err != nil {
+				return err
+			}
+		}
+		if err := k.indexPermissionCountries(ctx, perm.Id, perm.Countries); err != nil {
+			return err
+		}
+	}
 	return k.Permission.Set(ctx, perm.Id, perm)
 }
 
-// IsValidPermission checks if a perm is valid for a given country code and time
+// indexPermissionCountries indexes a perm by each code of its country scope.
+// Worldwide perms, with an empty scope, are not indexed.
+func (k Keeper) indexPermissionCountries(ctx sdk.Context, id uint64, countries []string) error {
+	for _, code := range countries {
+		if err := k.PermissionByCountry.Set(ctx, collections.Join(code, id)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsValidPermission checks if a perm is valid for a given country scope and time
 // A valid perm:
-// - Has a matching country (perm country is null or matches the provided country)
+// - Has a country scope covering the provided scope (perm scope is empty or lists every provided code, or its country)
 // - Is currently effective (effective_from ≤ now < effective_until)
 // - Is not revoked or terminated
 // - Is not slashed
-func IsValidPermission(perm types.Permission, country string, checkTime time.Time) error {
+// - Is not suspended
+func IsValidPermission(perm types.Permission, countries []string, checkTime time.Time) error {
 	// Check country compatibility
-	if perm.Country != "" && perm.Country != country {
-		return fmt.Errorf("perm country mismatch: perm has %s, requested %s",
-			perm.Country, country)
+	if !types.CountryScopeWithin(countries, perm.Countries) {
+		return fmt.Errorf("perm country mismatch: perm has %v, requested %v",
+			perm.Countries, countries)
 	}
 
 	// Check if perm is effective at the given time
@@ -152,5 +246,19 @@ func IsValidPermission(perm types.Permission, country string, checkTime time.Tim
 		return fmt.Errorf("perm is slashed with amount %d", perm.SlashedDeposit)
 	}
 
+	// Check if perm is suspended
+	if isSuspendedAt(perm, checkTime) {
+		return fmt.Errorf("perm is suspended since %v", perm.Suspended)
+	}
+
 	return nil
 }
+
+// isSuspendedAt checks if a perm is suspended at a given time. A suspension
+// with suspended_until set ends by itself at that time.
+func isSuspendedAt(perm types.Permission, t time.Time) bool {
+	if perm.Suspended == nil || t.Before(*perm.Suspended) {
+		return false
+	}
+	return perm.SuspendedUntil == nil || t.Before(*perm.SuspendedUntil)
+}
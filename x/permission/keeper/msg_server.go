@@ -3,10 +3,11 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
-	"cosmossdk.io/math"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	credentialschematypes "github.com/verana-labs/verana-blockchain/x/credentialschema/types"
 	trustdeposittypes "github.com/verana-labs/verana-blockchain/x/trustdeposit/types"
@@ -32,7 +33,7 @@ func (ms msgServer) StartPermissionVP(goCtx context.Context, msg *types.MsgStart
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// [MOD-PERM-MSG-1-2-2] Permission checks
-	validatorPerm, err := ms.validatePermissionChecks(ctx, msg)
+	validatorPerm, quorum, err := ms.validatePermissionChecks(ctx, msg)
 	if err != nil {
 		return nil, fmt.Errorf("perm validation failed: %w", err)
 	}
@@ -44,7 +45,7 @@ func (ms msgServer) StartPermissionVP(goCtx context.Context, msg *types.MsgStart
 	}
 
 	// [MOD-PERM-MSG-1-3] Execute the perm VP creation
-	permID, err := ms.executeStartPermissionVP(ctx, msg, validatorPerm, fees, deposit)
+	permID, err := ms.executeStartPermissionVP(ctx, msg, validatorPerm, quorum, fees, deposit)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute perm VP: %w", err)
 	}
@@ -55,8 +56,9 @@ func (ms msgServer) StartPermissionVP(goCtx context.Context, msg *types.MsgStart
 			sdk.NewAttribute(types.AttributeKeyPermissionID, strconv.FormatUint(permID, 10)),
 			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
 			sdk.NewAttribute(types.AttributeKeyValidatorPermID, strconv.FormatUint(msg.ValidatorPermId, 10)),
+			sdk.NewAttribute(types.AttributeKeyCoValidatorPermIDs, joinPermIDs(msg.CoValidatorPermIds)),
 			sdk.NewAttribute(types.AttributeKeyType, types.PermissionType(msg.Type).String()),
-			sdk.NewAttribute(types.AttributeKeyCountry, msg.Country),
+			sdk.NewAttribute(types.AttributeKeyCountries, strings.Join(msg.Countries, ",")),
 			sdk.NewAttribute(types.AttributeKeyFees, strconv.FormatUint(fees, 10)),
 			sdk.NewAttribute(types.AttributeKeyDeposit, strconv.FormatUint(deposit, 10)),
 		),
@@ -87,7 +89,7 @@ func (ms msgServer) RenewPermissionVP(goCtx context.Context, msg *types.MsgRenew
 		return nil, fmt.Errorf("validator perm not found: %w", err)
 	}
 
-	if err := IsValidPermission(validatorPerm, applicantPerm.Country, ctx.BlockTime()); err != nil {
+	if err := IsValidPermission(validatorPerm, applicantPerm.Countries, ctx.BlockTime()); err != nil {
 		return nil, fmt.Errorf("validator perm is not valid: %w", err)
 	}
 
@@ -178,9 +180,9 @@ func (ms msgServer) SetPermissionVPToValidated(goCtx context.Context, msg *types
 		return nil, fmt.Errorf("verification_fees cannot be changed during renewal")
 	}
 
-	// country: If applicant_perm.effective_from is not null (renewal) country MUST be equal to applicant_perm.country
-	if applicantPerm.EffectiveFrom != nil && msg.Country != applicantPerm.Country {
-		return nil, fmt.Errorf("country cannot be changed during renewal")
+	// countries: If applicant_perm.effective_from is not null (renewal) countries MUST be equal to applicant_perm.countries
+	if applicantPerm.EffectiveFrom != nil && !slices.Equal(msg.Countries, applicantPerm.Countries) {
+		return nil, fmt.Errorf("countries cannot be changed during renewal")
 	}
 
 	// vp_summary_digest_sri: MUST be null if validation.type is set to HOLDER
@@ -231,6 +233,11 @@ func (ms msgServer) SetPermissionVPToValidated(goCtx context.Context, msg *types
 	}
 
 	// [MOD-PERM-MSG-3-2-2] Validator perms
+	// a validation process with a quorum is validated once enough validators approved it
+	if applicantPerm.VpQuorum > 1 {
+		return ms.approvePermissionVP(ctx, applicantPerm, msg, now, vpExp)
+	}
+
 	// load validator_perm from applicant_perm.validator_perm_id
 	validatorPerm, err := ms.Keeper.GetPermissionByID(ctx, applicantPerm.ValidatorPermId)
 	if err != nil {
@@ -243,8 +250,13 @@ func (ms msgServer) SetPermissionVPToValidated(goCtx context.Context, msg *types
 		return nil, fmt.Errorf("account running method must be validator grantee")
 	}
 
+	// countries MUST be within validator_perm.countries
+	if !types.CountryScopeWithin(msg.Countries, validatorPerm.Countries) {
+		return nil, fmt.Errorf("country scope %v is not within validator perm scope %v", msg.Countries, validatorPerm.Countries)
+	}
+
 	// [MOD-PERM-MSG-3-3] Execution
-	return ms.executeSetPermissionVPToValidated(ctx, applicantPerm, validatorPerm, msg, now, vpExp)
+	return ms.executeSetPermissionVPToValidated(ctx, applicantPerm, []types.Permission{validatorPerm}, msg, now, vpExp)
 }
 
 func (ms msgServer) RequestPermissionVPTermination(goCtx context.Context, msg *types.MsgRequestPermissionVPTermination) (*types.MsgRequestPermissionVPTerminationResponse, error) {
@@ -299,6 +311,10 @@ func (ms msgServer) RequestPermissionVPTermination(goCtx context.Context, msg *t
 			}
 			applicantPerm.VpValidatorDeposit = 0
 		}
+
+		if err := ms.Keeper.releaseCoValidatorDeposits(ctx, &applicantPerm); err != nil {
+			return nil, err
+		}
 	}
 
 	// [MOD-PERM-MSG-4-3] Execution
@@ -369,7 +385,9 @@ func (ms msgServer) handleTerminationDeposits(ctx sdk.Context, perm *types.Permi
 		// Clear the validator deposit in the perm
 		perm.VpValidatorDeposit = 0
 	}
-	return nil
+
+	// Handle co-validators' deposits
+	return ms.Keeper.releaseCoValidatorDeposits(ctx, perm)
 }
 
 func (ms msgServer) ConfirmPermissionVPTermination(goCtx context.Context, msg *types.MsgConfirmPermissionVPTermination) (*types.MsgConfirmPermissionVPTerminationResponse, error) {
@@ -474,6 +492,11 @@ func (ms msgServer) executeConfirmPermissionVPTermination(ctx sdk.Context, appli
 		applicantPerm.VpValidatorDeposit = 0
 	}
 
+	// Co-validators have no say in the confirmation, their deposits are released
+	if err := ms.Keeper.releaseCoValidatorDeposits(ctx, &applicantPerm); err != nil {
+		return err
+	}
+
 	// Persist changes
 	return ms.Keeper.UpdatePermission(ctx, applicantPerm)
 }
@@ -556,6 +579,9 @@ func (ms msgServer) executeCancelPermissionVPLastRequest(ctx sdk.Context, perm t
 		perm.VpCurrentDeposit = 0
 	}
 
+	// Drop the approvals of the cancelled request
+	perm.VpApprovals = nil
+
 	// Persist changes
 	return ms.Keeper.UpdatePermission(ctx, perm)
 }
@@ -633,7 +659,7 @@ func (ms msgServer) executeCreateRootPermission(ctx sdk.Context, msg *types.MsgC
 		Modified:         &now,
 		EffectiveFrom:    msg.EffectiveFrom,
 		EffectiveUntil:   msg.EffectiveUntil,
-		Country:          msg.Country,
+		Countries:        msg.Countries,
 		ValidationFees:   msg.ValidationFees,
 		IssuanceFees:     msg.IssuanceFees,
 		VerificationFees: msg.VerificationFees,
@@ -699,7 +725,7 @@ func (ms msgServer) validateExtendPermissionAuthority(ctx sdk.Context, creator s
 			return fmt.Errorf("validator perm not found: %w", err)
 		}
 
-		if err = IsValidPermission(validatorPerm, perm.Country, ctx.BlockTime()); err != nil {
+		if err = IsValidPermission(validatorPerm, perm.Countries, ctx.BlockTime()); err != nil {
 			return fmt.Errorf("validator perm is not valid: %w", err)
 		}
 
@@ -736,7 +762,7 @@ func (ms msgServer) RevokePermission(goCtx context.Context, msg *types.MsgRevoke
 		return nil, fmt.Errorf("validator perm not found: %w", err)
 	}
 
-	if err := IsValidPermission(validatorPerm, applicantPerm.Country, ctx.BlockTime()); err != nil {
+	if err := IsValidPermission(validatorPerm, applicantPerm.Countries, ctx.BlockTime()); err != nil {
 		return nil, fmt.Errorf("validator perm is not valid: %w", err)
 	}
 
@@ -744,26 +770,9 @@ func (ms msgServer) RevokePermission(goCtx context.Context, msg *types.MsgRevoke
 		return nil, fmt.Errorf("creator is not the validator")
 	}
 
-	// Handle applicant's trust deposit
-	if applicantPerm.Deposit > 0 {
-		err = ms.trustDeposit.AdjustTrustDeposit(ctx, applicantPerm.Grantee, -int64(applicantPerm.Deposit))
-		if err != nil {
-			return nil, fmt.Errorf("failed to reduce applicant trust deposit: %w", err)
-		}
-		applicantPerm.Deposit = 0
-	}
-
-	// Handle validator's trust deposit
-	if applicantPerm.VpValidatorDeposit > 0 {
-		validatorPerm, err := ms.Keeper.GetPermissionByID(ctx, applicantPerm.ValidatorPermId)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get validator perm: %w", err)
-		}
-		err = ms.trustDeposit.AdjustTrustDeposit(ctx, validatorPerm.Grantee, -int64(applicantPerm.VpValidatorDeposit))
-		if err != nil {
-			return nil, fmt.Errorf("failed to reduce validator trust deposit: %w", err)
-		}
-		applicantPerm.VpValidatorDeposit = 0
+	// Handle applicant's and validator's trust deposits
+	if err := ms.releasePermissionDeposits(ctx, &applicantPerm, validatorPerm); err != nil {
+		return nil, err
 	}
 
 	// [MOD-PERM-MSG-9-3] Execution
@@ -771,7 +780,17 @@ func (ms msgServer) RevokePermission(goCtx context.Context, msg *types.MsgRevoke
 		return nil, fmt.Errorf("failed to revoke perm: %w", err)
 	}
 
-	return &types.MsgRevokePermissionResponse{}, nil
+	resp := &types.MsgRevokePermissionResponse{}
+	if msg.Cascade != types.CascadeMode_CASCADE_MODE_NONE {
+		cascaded, pending, err := ms.startRevocationCascade(ctx, applicantPerm.Id, msg.Cascade, msg.Creator)
+		if err != nil {
+			return nil, fmt.Errorf("failed to cascade revocation: %w", err)
+		}
+		resp.Cascaded = cascaded
+		resp.CascadePending = pending
+	}
+
+	return resp, nil
 }
 
 func (ms msgServer) executeRevokePermission(ctx sdk.Context, perm types.Permission, creator string, now time.Time) error {
@@ -782,6 +801,137 @@ func (ms msgServer) executeRevokePermission(ctx sdk.Context, perm types.Permissi
 	return ms.Keeper.UpdatePermission(ctx, perm)
 }
 
+func (ms msgServer) SuspendPermission(goCtx context.Context, msg *types.MsgSuspendPermission) (*types.MsgSuspendPermissionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	now := ctx.BlockTime()
+
+	perm, err := ms.checkPermissionValidator(ctx, msg.Id, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	if perm.Revoked != nil || perm.Terminated != nil {
+		return nil, fmt.Errorf("perm is revoked or terminated")
+	}
+	if isSuspendedAt(perm, now) {
+		return nil, fmt.Errorf("perm is already suspended")
+	}
+	if msg.SuspendedUntil != nil && !msg.SuspendedUntil.After(now) {
+		return nil, fmt.Errorf("suspended_until must be in the future")
+	}
+
+	perm.Suspended = &now
+	perm.SuspendedBy = msg.Creator
+	perm.SuspensionReason = msg.Reason
+	perm.SuspendedUntil = msg.SuspendedUntil
+	perm.Modified = &now
+	if err := ms.Keeper.UpdatePermission(ctx, perm); err != nil {
+		return nil, fmt.Errorf("failed to suspend perm: %w", err)
+	}
+
+	suspendedUntil := ""
+	if msg.SuspendedUntil != nil {
+		suspendedUntil = msg.SuspendedUntil.String()
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSuspendPermission,
+			sdk.NewAttribute(types.AttributeKeyPermissionID, strconv.FormatUint(perm.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyReason, msg.Reason),
+			sdk.NewAttribute(types.AttributeKeySuspendedUntil, suspendedUntil),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+		),
+	)
+
+	return &types.MsgSuspendPermissionResponse{}, nil
+}
+
+func (ms msgServer) UnsuspendPermission(goCtx context.Context, msg *types.MsgUnsuspendPermission) (*types.MsgUnsuspendPermissionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	now := ctx.BlockTime()
+
+	perm, err := ms.checkPermissionValidator(ctx, msg.Id, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	// An expired suspension can still be cleared
+	if perm.Suspended == nil {
+		return nil, fmt.Errorf("perm is not suspended")
+	}
+
+	perm.Suspended = nil
+	perm.SuspendedBy = ""
+	perm.SuspensionReason = ""
+	perm.SuspendedUntil = nil
+	perm.Modified = &now
+	if err := ms.Keeper.UpdatePermission(ctx, perm); err != nil {
+		return nil, fmt.Errorf("failed to unsuspend perm: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnsuspendPermission,
+			sdk.NewAttribute(types.AttributeKeyPermissionID, strconv.FormatUint(perm.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+		),
+	)
+
+	return &types.MsgUnsuspendPermissionResponse{}, nil
+}
+
+func (ms msgServer) ClosePermissionSession(goCtx context.Context, msg *types.MsgClosePermissionSession) (*types.MsgClosePermissionSessionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	session, err := ms.PermissionSession.Get(ctx, msg.Id)
+	if err != nil {
+		return nil, fmt.Errorf("session not found: %w", err)
+	}
+
+	if session.Controller != msg.Creator {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("only session controller can close the session")
+	}
+
+	if err := ms.removeSession(ctx, session); err != nil {
+		return nil, fmt.Errorf("failed to close session: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClosePermissionSession,
+			sdk.NewAttribute(types.AttributeKeySessionID, msg.Id),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, ctx.BlockTime().String()),
+		),
+	)
+
+	return &types.MsgClosePermissionSessionResponse{}, nil
+}
+
+// checkPermissionValidator returns the perm if creator is the grantee of its
+// validator perm and the validator perm is valid.
+func (ms msgServer) checkPermissionValidator(ctx sdk.Context, id uint64, creator string) (types.Permission, error) {
+	perm, err := ms.Keeper.GetPermissionByID(ctx, id)
+	if err != nil {
+		return types.Permission{}, fmt.Errorf("perm not found: %w", err)
+	}
+
+	validatorPerm, err := ms.Keeper.GetPermissionByID(ctx, perm.ValidatorPermId)
+	if err != nil {
+		return types.Permission{}, fmt.Errorf("validator perm not found: %w", err)
+	}
+	if err := IsValidPermission(validatorPerm, perm.Countries, ctx.BlockTime()); err != nil {
+		return types.Permission{}, fmt.Errorf("validator perm is not valid: %w", err)
+	}
+	if validatorPerm.Grantee != creator {
+		return types.Permission{}, fmt.Errorf("creator is not the validator")
+	}
+
+	return perm, nil
+}
+
 type PermissionSet []types.Permission
 
 func (ps PermissionSet) contains(id uint64) bool {
@@ -804,6 +954,7 @@ func (ms msgServer) CreateOrUpdatePermissionSession(goCtx context.Context, msg *
 
 	// Define variables for issuerPerm and verifierPerm
 	var verifierPerm *types.Permission
+	var schemaID uint64
 
 	// Load and validate issuer perm if specified
 	if msg.IssuerPermId != 0 {
@@ -819,6 +970,11 @@ func (ms msgServer) CreateOrUpdatePermissionSession(goCtx context.Context, msg *
 		if perm.Revoked != nil || perm.Terminated != nil || perm.SlashedDeposit > 0 {
 			return nil, sdkerrors.ErrInvalidRequest.Wrap("issuer perm is revoked, terminated, or slashed")
 		}
+		if isSuspendedAt(perm, now) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap("issuer perm is suspended")
+		}
+
+		schemaID = perm.SchemaId
 	}
 
 	// Load and validate verifier perm if specified
@@ -835,8 +991,12 @@ func (ms msgServer) CreateOrUpdatePermissionSession(goCtx context.Context, msg *
 		if perm.Revoked != nil || perm.Terminated != nil || perm.SlashedDeposit > 0 {
 			return nil, sdkerrors.ErrInvalidRequest.Wrap("verifier perm is revoked, terminated, or slashed")
 		}
+		if isSuspendedAt(perm, now) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap("verifier perm is suspended")
+		}
 
 		verifierPerm = &perm
+		schemaID = perm.SchemaId
 	}
 
 	// Validate agent perm
@@ -852,6 +1012,9 @@ func (ms msgServer) CreateOrUpdatePermissionSession(goCtx context.Context, msg *
 	if agentPerm.Revoked != nil || agentPerm.Terminated != nil || agentPerm.SlashedDeposit > 0 {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap("agent perm is revoked, terminated, or slashed")
 	}
+	if isSuspendedAt(agentPerm, now) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("agent perm is suspended")
+	}
 
 	// Validate wallet agent perm if provided
 	if msg.WalletAgentPermId != 0 {
@@ -867,6 +1030,9 @@ func (ms msgServer) CreateOrUpdatePermissionSession(goCtx context.Context, msg *
 		if perm.Revoked != nil || perm.Terminated != nil || perm.SlashedDeposit > 0 {
 			return nil, sdkerrors.ErrInvalidRequest.Wrap("wallet agent perm is revoked, terminated, or slashed")
 		}
+		if isSuspendedAt(perm, now) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap("wallet agent perm is suspended")
+		}
 
 	}
 
@@ -879,27 +1045,27 @@ func (ms msgServer) CreateOrUpdatePermissionSession(goCtx context.Context, msg *
 	// Calculate fees
 	trustUnitPrice := ms.trustRegistryKeeper.GetTrustUnitPrice(ctx)
 	trustDepositRate := ms.trustDeposit.GetTrustDepositRate(ctx)
-	userAgentRewardRate := ms.trustDeposit.GetUserAgentRewardRate(ctx)
-	walletUserAgentRewardRate := ms.trustDeposit.GetWalletUserAgentRewardRate(ctx)
-
-	// Calculate beneficiary fees
-	beneficiaryFees := uint64(0)
-	for _, perm := range foundPermSet {
-		if verifierPerm != nil {
-			beneficiaryFees += perm.VerificationFees
-		} else {
-			beneficiaryFees += perm.IssuanceFees
+
+	// Calculate required balance
+	requiredFunds := ms.sessionRequiredFunds(ctx, foundPermSet, verifierPerm != nil, msg.WalletAgentPermId != 0)
+
+	if msg.CreditId != 0 {
+		// The session fees were paid when the credit was purchased
+		if err := ms.consumeVerificationCredit(ctx, msg); err != nil {
+			return nil, err
+		}
+		requiredFunds -= ms.sessionFees(ctx, foundPermSet, verifierPerm != nil)
+	} else if msg.AllowanceId != 0 {
+		// The sponsor allowance pays the beneficiary fees out of its escrow,
+		// the creator still funds its own trust deposit
+		sponsoredFees := ms.sessionSponsoredFees(ctx, foundPermSet, verifierPerm != nil)
+		if err := ms.drawSessionAllowance(ctx, msg, schemaID, sponsoredFees); err != nil {
+			return nil, err
 		}
+		requiredFunds -= sponsoredFees
 	}
 
-	// Calculate total required funds
-	totalFees := beneficiaryFees * trustUnitPrice
-	trustFees := uint64(math.LegacyNewDec(int64(totalFees)).Mul(trustDepositRate).TruncateInt64())
-	rewardRate := userAgentRewardRate.Add(walletUserAgentRewardRate)
-	rewards := uint64(math.LegacyNewDec(int64(totalFees)).Mul(rewardRate).TruncateInt64())
-
-	// Calculate required balance
-	requiredAmount := sdk.NewInt64Coin(types.BondDenom, int64(totalFees+trustFees+rewards))
+	requiredAmount := sdk.NewInt64Coin(types.BondDenom, int64(requiredFunds))
 
 	// Validate sender has sufficient balance
 	creatorAddr, err := sdk.AccAddressFromBech32(msg.Creator)
@@ -912,8 +1078,21 @@ func (ms msgServer) CreateOrUpdatePermissionSession(goCtx context.Context, msg *
 	}
 
 	// Process fees
-	if err := ms.processFees(ctx, msg.Creator, foundPermSet, verifierPerm != nil, trustUnitPrice, trustDepositRate); err != nil {
-		return nil, fmt.Errorf("failed to process fees: %w", err)
+	if msg.CreditId == 0 {
+		if err := ms.processFees(ctx, msg.Creator, msg.AllowanceId != 0, foundPermSet, verifierPerm != nil, 1, trustUnitPrice, trustDepositRate); err != nil {
+			return nil, fmt.Errorf("failed to process fees: %w", err)
+		}
+	}
+
+	// Pay the user agent and wallet agent rewards
+	userAgentReward, walletAgentReward := ms.sessionRewards(ctx, foundPermSet, verifierPerm != nil, msg.WalletAgentPermId != 0)
+	if err := ms.payAgentReward(ctx, msg.Creator, msg.Id, msg.AgentPermId, userAgentReward, types.RewardTypeUserAgent, trustDepositRate); err != nil {
+		return nil, fmt.Errorf("failed to pay user agent reward: %w", err)
+	}
+	if msg.WalletAgentPermId != 0 {
+		if err := ms.payAgentReward(ctx, msg.Creator, msg.Id, msg.WalletAgentPermId, walletAgentReward, types.RewardTypeWalletAgent, trustDepositRate); err != nil {
+			return nil, fmt.Errorf("failed to pay wallet agent reward: %w", err)
+		}
 	}
 
 	// Create or update session
@@ -954,9 +1133,18 @@ func (ms msgServer) SlashPermissionTrustDeposit(goCtx context.Context, msg *type
 	//	return nil, fmt.Errorf("perm is not valid (revoked or terminated)")
 	//}
 
-	// amount MUST be lower or equal to applicant_perm.deposit
-	if msg.Amount > applicantPerm.Deposit {
-		return nil, fmt.Errorf("amount exceeds available deposit: %d > %d", msg.Amount, applicantPerm.Deposit)
+	// amount MUST be lower or equal to applicant_perm.deposit, minus the
+	// amounts already escrowed by open slashes
+	openSlashed, err := ms.openSlashedAmount(ctx, applicantPerm.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load open slashes: %w", err)
+	}
+	availableDeposit := uint64(0)
+	if applicantPerm.Deposit > openSlashed {
+		availableDeposit = applicantPerm.Deposit - openSlashed
+	}
+	if msg.Amount > availableDeposit {
+		return nil, fmt.Errorf("amount exceeds available deposit: %d > %d", msg.Amount, availableDeposit)
 	}
 
 	// [MOD-PERM-MSG-12-2-2] Slash Permission Trust Deposit validator perms
@@ -991,7 +1179,10 @@ func (ms msgServer) SlashPermissionTrustDeposit(goCtx context.Context, msg *type
 	// This is handled by the SDK automatically
 
 	// [MOD-PERM-MSG-12-3] Slash Permission Trust Deposit execution
-	if err := ms.executeSlashPermissionTrustDeposit(ctx, applicantPerm, msg.Amount, msg.Creator); err != nil {
+	// The amount is escrowed and the slash only executed once its appeal
+	// period is over, or once an appeal is resolved in favor of the slash.
+	record, err := ms.createSlashRecord(ctx, applicantPerm, msg)
+	if err != nil {
 		return nil, fmt.Errorf("failed to slash perm trust deposit: %w", err)
 	}
 
@@ -1000,36 +1191,20 @@ func (ms msgServer) SlashPermissionTrustDeposit(goCtx context.Context, msg *type
 		sdk.NewEvent(
 			types.EventTypeSlashPermissionTrustDeposit,
 			sdk.NewAttribute(types.AttributeKeyPermissionID, strconv.FormatUint(msg.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeySlashID, strconv.FormatUint(record.Id, 10)),
 			sdk.NewAttribute(types.AttributeKeySlashedAmount, strconv.FormatUint(msg.Amount, 10)),
 			sdk.NewAttribute(types.AttributeKeySlashedBy, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyReasonCode, strconv.FormatUint(uint64(record.ReasonCode), 10)),
+			sdk.NewAttribute(types.AttributeKeyGfVersion, strconv.FormatInt(int64(record.GfVersion), 10)),
+			sdk.NewAttribute(types.AttributeKeyEvidenceURL, record.EvidenceUrl),
+			sdk.NewAttribute(types.AttributeKeyAppealDeadline, record.AppealDeadline.String()),
 			sdk.NewAttribute(types.AttributeKeyTimestamp, ctx.BlockTime().String()),
 		),
 	})
 
-	return &types.MsgSlashPermissionTrustDepositResponse{}, nil
-}
-
-// executeSlashPermissionTrustDeposit performs the actual slashing execution
-func (ms msgServer) executeSlashPermissionTrustDeposit(ctx sdk.Context, applicantPerm types.Permission, amount uint64, slashedBy string) error {
-	now := ctx.BlockTime()
-
-	// Update Permission entry applicant_perm
-	applicantPerm.Slashed = &now
-	applicantPerm.Modified = &now
-	applicantPerm.SlashedDeposit = applicantPerm.SlashedDeposit + amount
-	applicantPerm.SlashedBy = slashedBy
-
-	// This functionality doesn't exist yet, so commenting out for now
-	if err := ms.trustDeposit.BurnEcosystemSlashedTrustDeposit(ctx, applicantPerm.Grantee, amount); err != nil {
-		return fmt.Errorf("failed to burn trust deposit: %w", err)
-	}
-
-	// Update perm
-	if err := ms.Keeper.UpdatePermission(ctx, applicantPerm); err != nil {
-		return fmt.Errorf("failed to update perm: %w", err)
-	}
-
-	return nil
+	return &types.MsgSlashPermissionTrustDepositResponse{
+		SlashId: record.Id,
+	}, nil
 }
 
 // findEcosystemPermissionForSchema finds the ECOSYSTEM perm for a given schema
@@ -1177,9 +1352,9 @@ func (ms msgServer) CreatePermission(goCtx context.Context, msg *types.MsgCreate
 		}
 	}
 
-	// country validation
-	if msg.Country != "" && !isValidCountryCode(msg.Country) {
-		return nil, fmt.Errorf("invalid country code format")
+	// countries validation
+	if err := types.ValidateCountryScope(msg.Countries); err != nil {
+		return nil, fmt.Errorf("invalid country code format: %w", err)
 	}
 
 	// verification_fees must be >= 0 (uint64 is naturally >= 0)
@@ -1237,6 +1412,11 @@ func (ms msgServer) executeCreatePermission(ctx sdk.Context, msg *types.MsgCreat
 		return 0, fmt.Errorf("failed to find ecosystem perm: %w", err)
 	}
 
+	// The country scope must be within the ecosystem perm scope
+	if !types.CountryScopeWithin(msg.Countries, ecosystemPerm.Countries) {
+		return 0, fmt.Errorf("country scope %v is not within ecosystem perm scope %v", msg.Countries, ecosystemPerm.Countries)
+	}
+
 	// Create new Permission entry
 	perm := types.Permission{
 		SchemaId:         msg.SchemaId,
@@ -1248,7 +1428,7 @@ func (ms msgServer) executeCreatePermission(ctx sdk.Context, msg *types.MsgCreat
 		Modified:         &now,
 		EffectiveFrom:    msg.EffectiveFrom,
 		EffectiveUntil:   msg.EffectiveUntil,
-		Country:          msg.Country,
+		Countries:        msg.Countries,
 		VerificationFees: msg.VerificationFees,
 		VpState:          types.ValidationState_VALIDATION_STATE_VALIDATED,
 		ValidatorPermId:  ecosystemPerm.Id, // Set the ecosystem perm as the validator
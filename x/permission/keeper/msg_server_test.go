@@ -5,8 +5,11 @@ import (
 	"testing"
 	"time"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 	"github.com/google/uuid"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	cstypes "github.com/verana-labs/verana-blockchain/x/credentialschema/types"
 
@@ -15,6 +18,7 @@ import (
 	keepertest "github.com/verana-labs/verana-blockchain/testutil/keeper"
 	"github.com/verana-labs/verana-blockchain/x/permission/keeper"
 	"github.com/verana-labs/verana-blockchain/x/permission/types"
+	tdtypes "github.com/verana-labs/verana-blockchain/x/trustdeposit/types"
 )
 
 func setupMsgServer(t testing.TB) (keeper.Keeper, types.MsgServer, *keepertest.MockCredentialSchemaKeeper, *keepertest.MockTrustRegistryKeeper, context.Context) {
@@ -57,7 +61,7 @@ func TestStartPermissionVP(t *testing.T) {
 		Extended:   &now,
 		ExtendedBy: creator,
 		Modified:   &now,
-		Country:    "US",
+		Countries:  []string{"US"},
 		VpState:    types.ValidationState_VALIDATION_STATE_VALIDATED, // validator must be validated
 	}
 	validatorPermID, err := k.CreatePermission(sdkCtx, validatorPerm)
@@ -73,7 +77,7 @@ func TestStartPermissionVP(t *testing.T) {
 		Extended:   &now,
 		ExtendedBy: creator,
 		Modified:   &now,
-		Country:    "FR", // Different country
+		Countries:  []string{"FR"}, // Different country
 		VpState:    types.ValidationState_VALIDATION_STATE_VALIDATED,
 	}
 	verifierGrantorPermID, err := k.CreatePermission(sdkCtx, verifierGrantorPerm)
@@ -90,7 +94,7 @@ func TestStartPermissionVP(t *testing.T) {
 				Creator:         creator,
 				Type:            uint32(types.PermissionType_PERMISSION_TYPE_ISSUER),
 				ValidatorPermId: validatorPermID,
-				Country:         "US",
+				Countries:       []string{"US"},
 				Did:             validDid,
 			},
 			err: "",
@@ -101,7 +105,7 @@ func TestStartPermissionVP(t *testing.T) {
 				Creator:         creator,
 				Type:            uint32(types.PermissionType_PERMISSION_TYPE_ISSUER),
 				ValidatorPermId: 999,
-				Country:         "US",
+				Countries:       []string{"US"},
 				Did:             validDid,
 			},
 			err: "validator perm not found",
@@ -123,7 +127,7 @@ func TestStartPermissionVP(t *testing.T) {
 				Creator:         creator,
 				Type:            uint32(types.PermissionType_PERMISSION_TYPE_ISSUER),
 				ValidatorPermId: verifierGrantorPermID, // Wrong validator type
-				Country:         "FR",
+				Countries:       []string{"FR"},
 				Did:             validDid,
 			},
 			err: "issuer perm requires ISSUER_GRANTOR validator",
@@ -147,7 +151,7 @@ func TestStartPermissionVP(t *testing.T) {
 				require.NoError(t, err)
 				require.Equal(t, tc.msg.Type, uint32(perm.Type))
 				require.Equal(t, tc.msg.Creator, perm.Grantee)
-				require.Equal(t, tc.msg.Country, perm.Country)
+				require.Equal(t, tc.msg.Countries, perm.Countries)
 				require.Equal(t, tc.msg.ValidatorPermId, perm.ValidatorPermId)
 				require.Equal(t, types.ValidationState_VALIDATION_STATE_PENDING, perm.VpState)
 				require.NotNil(t, perm.Created)
@@ -178,7 +182,7 @@ func TestRenewPermissionVP(t *testing.T) {
 		Extended:   &now,
 		ExtendedBy: creator,
 		Modified:   &now,
-		Country:    "US",
+		Countries:  []string{"US"},
 		VpState:    types.ValidationState_VALIDATION_STATE_VALIDATED,
 	}
 	validatorPermID, err := k.CreatePermission(sdk.UnwrapSDKContext(ctx), validatorPerm)
@@ -194,7 +198,7 @@ func TestRenewPermissionVP(t *testing.T) {
 		Extended:        &now,
 		ExtendedBy:      creator,
 		Modified:        &now,
-		Country:         "US",
+		Countries:       []string{"US"},
 		ValidatorPermId: validatorPermID,
 		VpState:         types.ValidationState_VALIDATION_STATE_VALIDATED,
 	}
@@ -271,7 +275,7 @@ func TestSetPermissionVPToValidated(t *testing.T) {
 		Extended:   &now,
 		ExtendedBy: validatorAddr,
 		Modified:   &now,
-		Country:    "US",
+		Countries:  []string{"US"},
 		VpState:    types.ValidationState_VALIDATION_STATE_VALIDATED,
 	}
 	validatorPermID, err := k.CreatePermission(sdkCtx, validatorPerm)
@@ -289,7 +293,7 @@ func TestSetPermissionVPToValidated(t *testing.T) {
 			Extended:        &now,
 			ExtendedBy:      creator,
 			Modified:        &now,
-			Country:         "US",
+			Countries:       []string{"US"},
 			ValidatorPermId: validatorPermID,
 			VpState:         types.ValidationState_VALIDATION_STATE_PENDING,
 		}
@@ -303,7 +307,7 @@ func TestSetPermissionVPToValidated(t *testing.T) {
 			ValidationFees:     10,
 			IssuanceFees:       5,
 			VerificationFees:   3,
-			Country:            "US",
+			Countries:          []string{"US"},
 			EffectiveUntil:     &futureTime,
 			VpSummaryDigestSri: "sha384-validDigest",
 		}
@@ -319,7 +323,7 @@ func TestSetPermissionVPToValidated(t *testing.T) {
 		require.Equal(t, msg.ValidationFees, updatedPerm.ValidationFees)
 		require.Equal(t, msg.IssuanceFees, updatedPerm.IssuanceFees)
 		require.Equal(t, msg.VerificationFees, updatedPerm.VerificationFees)
-		require.Equal(t, msg.Country, updatedPerm.Country)
+		require.Equal(t, msg.Countries, updatedPerm.Countries)
 		require.NotNil(t, updatedPerm.EffectiveFrom)
 		require.NotNil(t, updatedPerm.EffectiveUntil)
 		require.Equal(t, msg.VpSummaryDigestSri, updatedPerm.VpSummaryDigestSri)
@@ -402,7 +406,7 @@ func TestSetPermissionVPToValidated(t *testing.T) {
 			Extended:        &now,
 			ExtendedBy:      creator,
 			Modified:        &now,
-			Country:         "US",
+			Countries:       []string{"US"},
 			ValidatorPermId: validatorPermID,
 			VpState:         types.ValidationState_VALIDATION_STATE_VALIDATED, // Not PENDING
 		}
@@ -432,7 +436,7 @@ func TestSetPermissionVPToValidated(t *testing.T) {
 			Extended:        &now,
 			ExtendedBy:      creator,
 			Modified:        &now,
-			Country:         "US",
+			Countries:       []string{"US"},
 			ValidatorPermId: validatorPermID,
 			VpState:         types.ValidationState_VALIDATION_STATE_PENDING,
 		}
@@ -462,7 +466,7 @@ func TestSetPermissionVPToValidated(t *testing.T) {
 			Extended:        &now,
 			ExtendedBy:      creator,
 			Modified:        &now,
-			Country:         "US",
+			Countries:       []string{"US"},
 			ValidatorPermId: validatorPermID,
 			VpState:         types.ValidationState_VALIDATION_STATE_PENDING,
 		}
@@ -475,7 +479,7 @@ func TestSetPermissionVPToValidated(t *testing.T) {
 			ValidationFees:     10,
 			IssuanceFees:       5,
 			VerificationFees:   3,
-			Country:            "US",
+			Countries:          []string{"US"},
 			VpSummaryDigestSri: "sha384-someDigest", // Should be empty for HOLDER
 		}
 
@@ -519,7 +523,7 @@ func TestMsgServerCreateRootPermission(t *testing.T) {
 				ValidationFees:   100,
 				IssuanceFees:     50,
 				VerificationFees: 25,
-				Country:          "US",
+				Countries:        []string{"US"},
 				EffectiveFrom:    &now,
 				EffectiveUntil:   &futureTime,
 			},
@@ -575,7 +579,7 @@ func TestMsgServerCreateRootPermission(t *testing.T) {
 				require.Equal(t, tc.msg.ValidationFees, perm.ValidationFees)
 				require.Equal(t, tc.msg.IssuanceFees, perm.IssuanceFees)
 				require.Equal(t, tc.msg.VerificationFees, perm.VerificationFees)
-				require.Equal(t, tc.msg.Country, perm.Country)
+				require.Equal(t, tc.msg.Countries, perm.Countries)
 
 				// Verify time fields if set
 				if tc.msg.EffectiveFrom != nil {
@@ -629,7 +633,7 @@ func TestRequestPermissionVPTermination(t *testing.T) {
 		Extended:   &now,
 		ExtendedBy: validatorAddr,
 		Modified:   &now,
-		Country:    "US",
+		Countries:  []string{"US"},
 		VpState:    types.ValidationState_VALIDATION_STATE_VALIDATED,
 	}
 	validatorPermID, err := k.CreatePermission(sdkCtx, validatorPerm)
@@ -646,7 +650,7 @@ func TestRequestPermissionVPTermination(t *testing.T) {
 		Extended:        &now,
 		ExtendedBy:      creator,
 		Modified:        &now,
-		Country:         "US",
+		Countries:       []string{"US"},
 		ValidatorPermId: validatorPermID,
 		VpState:         types.ValidationState_VALIDATION_STATE_VALIDATED,
 	}
@@ -664,7 +668,7 @@ func TestRequestPermissionVPTermination(t *testing.T) {
 		Extended:        &now,
 		ExtendedBy:      creator,
 		Modified:        &now,
-		Country:         "US",
+		Countries:       []string{"US"},
 		ValidatorPermId: validatorPermID,
 		VpState:         types.ValidationState_VALIDATION_STATE_VALIDATED,
 		VpExp:           &pastTime, // Clearly expired VP
@@ -683,7 +687,7 @@ func TestRequestPermissionVPTermination(t *testing.T) {
 		Extended:        &now,
 		ExtendedBy:      creator,
 		Modified:        &now,
-		Country:         "US",
+		Countries:       []string{"US"},
 		ValidatorPermId: validatorPermID,
 		VpState:         types.ValidationState_VALIDATION_STATE_VALIDATED,
 		VpExp:           &futureTime, // Not expired
@@ -704,7 +708,7 @@ func TestRequestPermissionVPTermination(t *testing.T) {
 		Extended:        &now,
 		ExtendedBy:      creator,
 		Modified:        &now,
-		Country:         "US",
+		Countries:       []string{"US"},
 		ValidatorPermId: validatorPermID,
 		VpState:         types.ValidationState_VALIDATION_STATE_PENDING,
 	}
@@ -849,7 +853,7 @@ func TestConfirmPermissionVPTermination(t *testing.T) {
 		Extended:   &now,
 		ExtendedBy: validatorAddr,
 		Modified:   &now,
-		Country:    "US",
+		Countries:  []string{"US"},
 		VpState:    types.ValidationState_VALIDATION_STATE_VALIDATED,
 	}
 	validatorPermID, err := k.CreatePermission(sdkCtx, validatorPerm)
@@ -867,7 +871,7 @@ func TestConfirmPermissionVPTermination(t *testing.T) {
 		Extended:           &now,
 		ExtendedBy:         creator,
 		Modified:           &now,
-		Country:            "US",
+		Countries:          []string{"US"},
 		ValidatorPermId:    validatorPermID,
 		VpState:            types.ValidationState_VALIDATION_STATE_TERMINATION_REQUESTED,
 		VpTermRequested:    &termRequested,
@@ -893,7 +897,7 @@ func TestConfirmPermissionVPTermination(t *testing.T) {
 		Extended:           &now,
 		ExtendedBy:         creator,
 		Modified:           &now,
-		Country:            "US",
+		Countries:          []string{"US"},
 		ValidatorPermId:    validatorPermID,
 		VpState:            types.ValidationState_VALIDATION_STATE_TERMINATION_REQUESTED,
 		VpTermRequested:    &termRequestedTimeout,
@@ -1025,7 +1029,7 @@ func TestCancelPermissionVPLastRequest(t *testing.T) {
 		Extended:   &now,
 		ExtendedBy: validatorAddr,
 		Modified:   &now,
-		Country:    "US",
+		Countries:  []string{"US"},
 		VpState:    types.ValidationState_VALIDATION_STATE_VALIDATED,
 	}
 	validatorPermID, err := k.CreatePermission(sdkCtx, validatorPerm)
@@ -1042,7 +1046,7 @@ func TestCancelPermissionVPLastRequest(t *testing.T) {
 		Extended:         &now,
 		ExtendedBy:       creator,
 		Modified:         &now,
-		Country:          "US",
+		Countries:        []string{"US"},
 		ValidatorPermId:  validatorPermID,
 		VpState:          types.ValidationState_VALIDATION_STATE_PENDING,
 		VpCurrentFees:    100,
@@ -1064,7 +1068,7 @@ func TestCancelPermissionVPLastRequest(t *testing.T) {
 		Extended:         &now,
 		ExtendedBy:       creator,
 		Modified:         &now,
-		Country:          "US",
+		Countries:        []string{"US"},
 		ValidatorPermId:  validatorPermID,
 		VpState:          types.ValidationState_VALIDATION_STATE_PENDING,
 		VpExp:            &futureTime, // Has a previous validation
@@ -1084,7 +1088,7 @@ func TestCancelPermissionVPLastRequest(t *testing.T) {
 		Extended:        &now,
 		ExtendedBy:      creator,
 		Modified:        &now,
-		Country:         "US",
+		Countries:       []string{"US"},
 		ValidatorPermId: validatorPermID,
 		VpState:         types.ValidationState_VALIDATION_STATE_VALIDATED, // Not in PENDING state
 	}
@@ -1208,7 +1212,7 @@ func TestExtendPermission(t *testing.T) {
 		Extended:   &now,
 		ExtendedBy: validatorAddr,
 		Modified:   &now,
-		Country:    "US",
+		Countries:  []string{"US"},
 		VpState:    types.ValidationState_VALIDATION_STATE_VALIDATED,
 	}
 	validatorPermID, err := k.CreatePermission(sdkCtx, validatorPerm)
@@ -1225,7 +1229,7 @@ func TestExtendPermission(t *testing.T) {
 		ExtendedBy:      creator,
 		Modified:        &now,
 		EffectiveUntil:  &currentEffectiveUntil,
-		Country:         "US",
+		Countries:       []string{"US"},
 		ValidatorPermId: validatorPermID,
 		VpState:         types.ValidationState_VALIDATION_STATE_VALIDATED,
 		VpExp:           &futureVpExp,
@@ -1244,7 +1248,7 @@ func TestExtendPermission(t *testing.T) {
 		ExtendedBy:     trustRegistryAddr,
 		Modified:       &now,
 		EffectiveUntil: &currentEffectiveUntil,
-		Country:        "US",
+		Countries:      []string{"US"},
 		VpState:        types.ValidationState_VALIDATION_STATE_VALIDATED,
 	}
 	trustRegistryPermID, err := k.CreatePermission(sdkCtx, trustRegistryPerm)
@@ -1262,7 +1266,7 @@ func TestExtendPermission(t *testing.T) {
 		ExtendedBy:      creator,
 		Modified:        &now,
 		EffectiveUntil:  &currentEffectiveUntil, // Same as the regular test
-		Country:         "US",
+		Countries:       []string{"US"},
 		ValidatorPermId: validatorPermID,
 		VpState:         types.ValidationState_VALIDATION_STATE_VALIDATED,
 		VpExp:           &futureVpExp,
@@ -1398,7 +1402,7 @@ func TestRevokePermission(t *testing.T) {
 		Extended:   &now,
 		ExtendedBy: validatorAddr,
 		Modified:   &now,
-		Country:    "US",
+		Countries:  []string{"US"},
 		VpState:    types.ValidationState_VALIDATION_STATE_VALIDATED,
 	}
 	validatorPermID, err := k.CreatePermission(sdkCtx, validatorPerm)
@@ -1414,7 +1418,7 @@ func TestRevokePermission(t *testing.T) {
 		Extended:        &now,
 		ExtendedBy:      creator,
 		Modified:        &now,
-		Country:         "US",
+		Countries:       []string{"US"},
 		ValidatorPermId: validatorPermID,
 		VpState:         types.ValidationState_VALIDATION_STATE_VALIDATED,
 	}
@@ -1519,7 +1523,7 @@ func TestCreateOrUpdatePermissionSession(t *testing.T) {
 		Extended:         &now,
 		ExtendedBy:       creator,
 		Modified:         &now,
-		Country:          "US",
+		Countries:        []string{"US"},
 		VpState:          types.ValidationState_VALIDATION_STATE_VALIDATED,
 		ValidationFees:   10,
 		IssuanceFees:     5,
@@ -1538,7 +1542,7 @@ func TestCreateOrUpdatePermissionSession(t *testing.T) {
 		Extended:        &now,
 		ExtendedBy:      creator,
 		Modified:        &now,
-		Country:         "US",
+		Countries:       []string{"US"},
 		ValidatorPermId: trustPermID,
 		VpState:         types.ValidationState_VALIDATION_STATE_VALIDATED,
 	}
@@ -1572,7 +1576,7 @@ func TestCreateOrUpdatePermissionSession(t *testing.T) {
 		Extended:        &now,
 		ExtendedBy:      creator,
 		Modified:        &now,
-		Country:         "US",
+		Countries:       []string{"US"},
 		ValidatorPermId: issuerPermID, // Issued by the issuer
 		VpState:         types.ValidationState_VALIDATION_STATE_VALIDATED,
 	}
@@ -1589,7 +1593,7 @@ func TestCreateOrUpdatePermissionSession(t *testing.T) {
 		Extended:        &now,
 		ExtendedBy:      creator,
 		Modified:        &now,
-		Country:         "US",
+		Countries:       []string{"US"},
 		ValidatorPermId: issuerPermID, // Issued by the issuer
 		VpState:         types.ValidationState_VALIDATION_STATE_VALIDATED,
 	}
@@ -1789,7 +1793,7 @@ func TestGetPermissionByID(t *testing.T) {
 		Extended:   &now,
 		ExtendedBy: creator,
 		Modified:   &now,
-		Country:    "US",
+		Countries:  []string{"US"},
 		VpState:    types.ValidationState_VALIDATION_STATE_VALIDATED,
 	}
 	permID, err := k.CreatePermission(sdkCtx, testPerm)
@@ -1802,7 +1806,7 @@ func TestGetPermissionByID(t *testing.T) {
 	require.Equal(t, testPerm.SchemaId, retrievedPerm.SchemaId, "Schema ID should match")
 	require.Equal(t, testPerm.Type, retrievedPerm.Type, "Type should match")
 	require.Equal(t, testPerm.Grantee, retrievedPerm.Grantee, "Grantee should match")
-	require.Equal(t, testPerm.Country, retrievedPerm.Country, "Country should match")
+	require.Equal(t, testPerm.Countries, retrievedPerm.Countries, "Countries should match")
 
 	// Test getting a non-existent perm
 	_, err = k.GetPermissionByID(sdkCtx, 9999)
@@ -1827,7 +1831,7 @@ func TestCreateAndUpdatePermission(t *testing.T) {
 		Extended:   &now,
 		ExtendedBy: creator,
 		Modified:   &now,
-		Country:    "US",
+		Countries:  []string{"US"},
 		VpState:    types.ValidationState_VALIDATION_STATE_VALIDATED,
 	}
 
@@ -1842,8 +1846,8 @@ func TestCreateAndUpdatePermission(t *testing.T) {
 	require.Equal(t, testPerm.SchemaId, retrievedPerm.SchemaId, "Created perm schema ID should match")
 
 	// Test UpdatePermission
-	updatedCountry := "FR"
-	retrievedPerm.Country = updatedCountry
+	updatedCountries := []string{"FR"}
+	retrievedPerm.Countries = updatedCountries
 	futureTime := now.Add(24 * time.Hour)
 	retrievedPerm.EffectiveUntil = &futureTime
 
@@ -1853,7 +1857,7 @@ func TestCreateAndUpdatePermission(t *testing.T) {
 	// Retrieve the updated perm
 	updatedPerm, err := k.GetPermissionByID(sdkCtx, permID)
 	require.NoError(t, err)
-	require.Equal(t, updatedCountry, updatedPerm.Country, "Country should be updated")
+	require.Equal(t, updatedCountries, updatedPerm.Countries, "Countries should be updated")
 	require.Equal(t, futureTime.Unix(), updatedPerm.EffectiveUntil.Unix(), "EffectiveUntil should be updated")
 }
 
@@ -1887,7 +1891,7 @@ func TestQueryPermissions(t *testing.T) {
 		Extended:   &now,
 		ExtendedBy: creator,
 		Modified:   &now,
-		Country:    "US",
+		Countries:  []string{"US"},
 		VpState:    types.ValidationState_VALIDATION_STATE_VALIDATED,
 	}
 	trustPermID, err := k.CreatePermission(sdkCtx, trustPerm)
@@ -1904,7 +1908,7 @@ func TestQueryPermissions(t *testing.T) {
 		Extended:        &now,
 		ExtendedBy:      creator,
 		Modified:        &now,
-		Country:         "US",
+		Countries:       []string{"US"},
 		ValidatorPermId: trustPermID,
 		VpState:         types.ValidationState_VALIDATION_STATE_VALIDATED,
 	}
@@ -1922,7 +1926,7 @@ func TestQueryPermissions(t *testing.T) {
 		Extended:        &now,
 		ExtendedBy:      creator,
 		Modified:        &now,
-		Country:         "FR", // Different country
+		Countries:       []string{"FR"}, // Different country
 		ValidatorPermId: trustPermID,
 		VpState:         types.ValidationState_VALIDATION_STATE_VALIDATED,
 	}
@@ -2044,7 +2048,7 @@ func TestSlashPermissionTrustDeposit(t *testing.T) {
 		Extended:   &now,
 		ExtendedBy: ecosystemAddr,
 		Modified:   &now,
-		Country:    "US",
+		Countries:  []string{"US"},
 		VpState:    types.ValidationState_VALIDATION_STATE_VALIDATED,
 	}
 	_, err := k.CreatePermission(sdkCtx, ecosystemPerm)
@@ -2060,7 +2064,7 @@ func TestSlashPermissionTrustDeposit(t *testing.T) {
 		Extended:   &now,
 		ExtendedBy: validatorAddr,
 		Modified:   &now,
-		Country:    "US",
+		Countries:  []string{"US"},
 		VpState:    types.ValidationState_VALIDATION_STATE_VALIDATED,
 	}
 	validatorPermID, err := k.CreatePermission(sdkCtx, validatorPerm)
@@ -2076,7 +2080,7 @@ func TestSlashPermissionTrustDeposit(t *testing.T) {
 		Extended:        &now,
 		ExtendedBy:      creator,
 		Modified:        &now,
-		Country:         "US",
+		Countries:       []string{"US"},
 		ValidatorPermId: validatorPermID,
 		VpState:         types.ValidationState_VALIDATION_STATE_VALIDATED,
 		Deposit:         1000, // Set initial deposit
@@ -2165,15 +2169,16 @@ func TestSlashPermissionTrustDeposit(t *testing.T) {
 }
 
 func TestRepayPermissionSlashedTrustDeposit(t *testing.T) {
-	k, ms, csKeeper, _, ctx := setupMsgServer(t)
+	k, ms, csKeeper, trkKeeper, ctx := setupMsgServer(t)
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
 	creator := sdk.AccAddress([]byte("test_creator")).String()
 	validatorAddr := sdk.AccAddress([]byte("test_validator")).String()
 	ecosystemAddr := sdk.AccAddress([]byte("test_ecosystem")).String()
 
-	// Create mock credential schema
-	csKeeper.CreateMockCredentialSchema(1,
+	// Create mock trust registry and credential schema
+	trID := trkKeeper.CreateMockTrustRegistry(ecosystemAddr, "did:example:123456789abcdefghi")
+	csKeeper.UpdateMockCredentialSchema(1, trID,
 		cstypes.CredentialSchemaPermManagementMode_GRANTOR_VALIDATION,
 		cstypes.CredentialSchemaPermManagementMode_GRANTOR_VALIDATION)
 
@@ -2189,7 +2194,7 @@ func TestRepayPermissionSlashedTrustDeposit(t *testing.T) {
 		Extended:   &now,
 		ExtendedBy: ecosystemAddr,
 		Modified:   &now,
-		Country:    "US",
+		Countries:  []string{"US"},
 		VpState:    types.ValidationState_VALIDATION_STATE_VALIDATED,
 	}
 	_, err := k.CreatePermission(sdkCtx, ecosystemPerm)
@@ -2205,7 +2210,7 @@ func TestRepayPermissionSlashedTrustDeposit(t *testing.T) {
 		Extended:   &now,
 		ExtendedBy: validatorAddr,
 		Modified:   &now,
-		Country:    "US",
+		Countries:  []string{"US"},
 		VpState:    types.ValidationState_VALIDATION_STATE_VALIDATED,
 	}
 	validatorPermID, err := k.CreatePermission(sdkCtx, validatorPerm)
@@ -2221,7 +2226,7 @@ func TestRepayPermissionSlashedTrustDeposit(t *testing.T) {
 		Extended:        &now,
 		ExtendedBy:      creator,
 		Modified:        &now,
-		Country:         "US",
+		Countries:       []string{"US"},
 		ValidatorPermId: validatorPermID,
 		VpState:         types.ValidationState_VALIDATION_STATE_VALIDATED,
 		Deposit:         1000, // Initial deposit
@@ -2231,9 +2236,10 @@ func TestRepayPermissionSlashedTrustDeposit(t *testing.T) {
 
 	// First slash the perm
 	slashMsg := &types.MsgSlashPermissionTrustDeposit{
-		Creator: validatorAddr,
-		Id:      applicantPermID,
-		Amount:  500, // Slash half of the deposit
+		Creator:    validatorAddr,
+		Id:         applicantPermID,
+		Amount:     500, // Slash half of the deposit
+		ReasonCode: 1,
 	}
 	_, err = ms.SlashPermissionTrustDeposit(ctx, slashMsg)
 	require.NoError(t, err)
@@ -2303,6 +2309,375 @@ func TestRepayPermissionSlashedTrustDeposit(t *testing.T) {
 	}
 }
 
+func TestSlashAppeal(t *testing.T) {
+	k, ms, csKeeper, trkKeeper, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	controller := sdk.AccAddress([]byte("test_controller")).String()
+	grantee := sdk.AccAddress([]byte("test_grantee")).String()
+	validatorAddr := sdk.AccAddress([]byte("test_validator")).String()
+
+	trID := trkKeeper.CreateMockTrustRegistry(controller, "did:example:123456789abcdefghi")
+	csKeeper.UpdateMockCredentialSchema(1, trID,
+		cstypes.CredentialSchemaPermManagementMode_GRANTOR_VALIDATION,
+		cstypes.CredentialSchemaPermManagementMode_GRANTOR_VALIDATION)
+
+	now := time.Now()
+	sdkCtx = sdkCtx.WithBlockTime(now)
+
+	validatorPermID, err := k.CreatePermission(sdkCtx, types.Permission{
+		SchemaId: 1,
+		Type:     types.PermissionType_PERMISSION_TYPE_ISSUER_GRANTOR,
+		Grantee:  validatorAddr,
+		Created:  &now,
+		Modified: &now,
+		VpState:  types.ValidationState_VALIDATION_STATE_VALIDATED,
+	})
+	require.NoError(t, err)
+
+	applicantPermID, err := k.CreatePermission(sdkCtx, types.Permission{
+		SchemaId:        1,
+		Type:            types.PermissionType_PERMISSION_TYPE_ISSUER,
+		Grantee:         grantee,
+		Created:         &now,
+		Modified:        &now,
+		ValidatorPermId: validatorPermID,
+		VpState:         types.ValidationState_VALIDATION_STATE_VALIDATED,
+		Deposit:         1000,
+	})
+	require.NoError(t, err)
+
+	slash := func(amount uint64) uint64 {
+		resp, err := ms.SlashPermissionTrustDeposit(sdkCtx, &types.MsgSlashPermissionTrustDeposit{
+			Creator: validatorAddr,
+			Id:      applicantPermID,
+			Amount:  amount,
+		})
+		require.NoError(t, err)
+		return resp.SlashId
+	}
+	appealDeadline := now.AddDate(0, 0, int(types.DefaultSlashAppealPeriodDays))
+
+	// A slash is escrowed as pending, the perm is not slashed yet
+	slashID := slash(400)
+	record, err := k.SlashRecord.Get(sdkCtx, slashID)
+	require.NoError(t, err)
+	require.Equal(t, types.SlashStatus_SLASH_STATUS_PENDING, record.Status)
+	require.Equal(t, grantee, record.Grantee)
+	require.Equal(t, validatorAddr, record.SlashedBy)
+	require.True(t, appealDeadline.Equal(*record.AppealDeadline))
+
+	perm, err := k.GetPermissionByID(sdkCtx, applicantPermID)
+	require.NoError(t, err)
+	require.Nil(t, perm.Slashed)
+	require.Equal(t, uint64(0), perm.SlashedDeposit)
+
+	// Open slashes reduce the deposit available for further slashes
+	_, err = ms.SlashPermissionTrustDeposit(sdkCtx, &types.MsgSlashPermissionTrustDeposit{
+		Creator: validatorAddr,
+		Id:      applicantPermID,
+		Amount:  700,
+	})
+	require.ErrorContains(t, err, "amount exceeds available deposit: 700 > 600")
+
+	// Only the grantee can appeal
+	_, err = ms.AppealSlash(sdkCtx, &types.MsgAppealSlash{
+		Creator:           validatorAddr,
+		Id:                slashID,
+		EvidenceDigestSri: "sha384-MzNNbQTWCSUSi0bbz7dbua+RcENv7C6FvlmYJ1Y+I727HsPOHdzwELMYO9Mz68M26",
+	})
+	require.ErrorContains(t, err, "only the grantee of the slashed perm can appeal")
+
+	_, err = ms.AppealSlash(sdkCtx, &types.MsgAppealSlash{
+		Creator:           grantee,
+		Id:                slashID,
+		EvidenceDigestSri: "sha384-MzNNbQTWCSUSi0bbz7dbua+RcENv7C6FvlmYJ1Y+I727HsPOHdzwELMYO9Mz68M26",
+	})
+	require.NoError(t, err)
+
+	record, err = k.SlashRecord.Get(sdkCtx, slashID)
+	require.NoError(t, err)
+	require.Equal(t, types.SlashStatus_SLASH_STATUS_APPEALED, record.Status)
+	require.NotNil(t, record.Appealed)
+
+	// An appealed slash is not executed once the deadline passes
+	require.NoError(t, k.ExecuteExpiredSlashes(sdkCtx.WithBlockTime(appealDeadline.Add(time.Hour))))
+	record, err = k.SlashRecord.Get(sdkCtx, slashID)
+	require.NoError(t, err)
+	require.Equal(t, types.SlashStatus_SLASH_STATUS_APPEALED, record.Status)
+
+	// Only the trust registry controller or governance can resolve the appeal
+	_, err = ms.ResolveSlashAppeal(sdkCtx, &types.MsgResolveSlashAppeal{
+		Creator: validatorAddr,
+		Id:      slashID,
+		Uphold:  true,
+	})
+	require.ErrorContains(t, err, "only the trust registry controller or governance can resolve a slash appeal")
+
+	_, err = ms.ResolveSlashAppeal(sdkCtx, &types.MsgResolveSlashAppeal{
+		Creator: controller,
+		Id:      slashID,
+		Uphold:  false,
+	})
+	require.NoError(t, err)
+
+	record, err = k.SlashRecord.Get(sdkCtx, slashID)
+	require.NoError(t, err)
+	require.Equal(t, types.SlashStatus_SLASH_STATUS_REVERSED, record.Status)
+	require.Equal(t, controller, record.ResolvedBy)
+
+	perm, err = k.GetPermissionByID(sdkCtx, applicantPermID)
+	require.NoError(t, err)
+	require.Nil(t, perm.Slashed)
+
+	// A resolved slash cannot be appealed or resolved again
+	_, err = ms.ResolveSlashAppeal(sdkCtx, &types.MsgResolveSlashAppeal{
+		Creator: controller,
+		Id:      slashID,
+		Uphold:  true,
+	})
+	require.ErrorContains(t, err, "slash is not appealed")
+
+	// An unappealed slash is executed by the EndBlocker once its deadline passes
+	slashID = slash(300)
+	require.NoError(t, k.ExecuteExpiredSlashes(sdkCtx.WithBlockTime(appealDeadline.Add(-time.Hour))))
+	record, err = k.SlashRecord.Get(sdkCtx, slashID)
+	require.NoError(t, err)
+	require.Equal(t, types.SlashStatus_SLASH_STATUS_PENDING, record.Status)
+
+	require.NoError(t, k.ExecuteExpiredSlashes(sdkCtx.WithBlockTime(appealDeadline)))
+	record, err = k.SlashRecord.Get(sdkCtx, slashID)
+	require.NoError(t, err)
+	require.Equal(t, types.SlashStatus_SLASH_STATUS_EXECUTED, record.Status)
+
+	perm, err = k.GetPermissionByID(sdkCtx, applicantPermID)
+	require.NoError(t, err)
+	require.NotNil(t, perm.Slashed)
+	require.Equal(t, uint64(300), perm.SlashedDeposit)
+	require.Equal(t, validatorAddr, perm.SlashedBy)
+
+	// An appeal after the deadline is rejected
+	slashID = slash(200)
+	_, err = ms.AppealSlash(sdkCtx.WithBlockTime(appealDeadline), &types.MsgAppealSlash{
+		Creator:           grantee,
+		Id:                slashID,
+		EvidenceDigestSri: "sha384-MzNNbQTWCSUSi0bbz7dbua+RcENv7C6FvlmYJ1Y+I727HsPOHdzwELMYO9Mz68M26",
+	})
+	require.ErrorContains(t, err, "appeal deadline passed")
+
+	// Governance can uphold an appealed slash
+	_, err = ms.AppealSlash(sdkCtx, &types.MsgAppealSlash{
+		Creator:           grantee,
+		Id:                slashID,
+		EvidenceDigestSri: "sha384-MzNNbQTWCSUSi0bbz7dbua+RcENv7C6FvlmYJ1Y+I727HsPOHdzwELMYO9Mz68M26",
+	})
+	require.NoError(t, err)
+
+	_, err = ms.ResolveSlashAppeal(sdkCtx, &types.MsgResolveSlashAppeal{
+		Creator: k.GetAuthority(),
+		Id:      slashID,
+		Uphold:  true,
+	})
+	require.NoError(t, err)
+
+	record, err = k.SlashRecord.Get(sdkCtx, slashID)
+	require.NoError(t, err)
+	require.Equal(t, types.SlashStatus_SLASH_STATUS_EXECUTED, record.Status)
+
+	perm, err = k.GetPermissionByID(sdkCtx, applicantPermID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(500), perm.SlashedDeposit)
+
+	// A slash that fails to execute does not fail the EndBlocker, it stays
+	// pending and is queued again for a retry
+	slashID = slash(100)
+	require.NoError(t, k.Permission.Remove(sdkCtx, applicantPermID))
+	require.NoError(t, k.ExecuteExpiredSlashes(sdkCtx.WithBlockTime(appealDeadline)))
+	record, err = k.SlashRecord.Get(sdkCtx, slashID)
+	require.NoError(t, err)
+	require.Equal(t, types.SlashStatus_SLASH_STATUS_PENDING, record.Status)
+	retried, err := k.SlashAppealQueue.Has(sdkCtx, collections.Join(appealDeadline.Add(time.Hour), slashID))
+	require.NoError(t, err)
+	require.True(t, retried)
+}
+
+func TestSlashRecords(t *testing.T) {
+	k, ms, csKeeper, trkKeeper, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	grantee := sdk.AccAddress([]byte("test_grantee")).String()
+	validatorAddr := sdk.AccAddress([]byte("test_validator")).String()
+	ecosystemAddr := sdk.AccAddress([]byte("test_ecosystem")).String()
+
+	trID := trkKeeper.CreateMockTrustRegistry(ecosystemAddr, "did:example:123456789abcdefghi")
+	csKeeper.UpdateMockCredentialSchema(1, trID,
+		cstypes.CredentialSchemaPermManagementMode_GRANTOR_VALIDATION,
+		cstypes.CredentialSchemaPermManagementMode_GRANTOR_VALIDATION)
+
+	now := time.Now()
+	sdkCtx = sdkCtx.WithBlockTime(now)
+
+	_, err := k.CreatePermission(sdkCtx, types.Permission{
+		SchemaId: 1,
+		Type:     types.PermissionType_PERMISSION_TYPE_ECOSYSTEM,
+		Grantee:  ecosystemAddr,
+		Created:  &now,
+		Modified: &now,
+		VpState:  types.ValidationState_VALIDATION_STATE_VALIDATED,
+	})
+	require.NoError(t, err)
+
+	validatorPermID, err := k.CreatePermission(sdkCtx, types.Permission{
+		SchemaId: 1,
+		Type:     types.PermissionType_PERMISSION_TYPE_ISSUER_GRANTOR,
+		Grantee:  validatorAddr,
+		Created:  &now,
+		Modified: &now,
+		VpState:  types.ValidationState_VALIDATION_STATE_VALIDATED,
+	})
+	require.NoError(t, err)
+
+	applicantPermID, err := k.CreatePermission(sdkCtx, types.Permission{
+		SchemaId:        1,
+		Type:            types.PermissionType_PERMISSION_TYPE_ISSUER,
+		Grantee:         grantee,
+		Created:         &now,
+		Modified:        &now,
+		ValidatorPermId: validatorPermID,
+		VpState:         types.ValidationState_VALIDATION_STATE_VALIDATED,
+		Deposit:         1000,
+	})
+	require.NoError(t, err)
+
+	// A governance framework version that does not exist is rejected
+	_, err = ms.SlashPermissionTrustDeposit(sdkCtx, &types.MsgSlashPermissionTrustDeposit{
+		Creator:    validatorAddr,
+		Id:         applicantPermID,
+		Amount:     100,
+		ReasonCode: 3,
+		GfVersion:  2,
+	})
+	require.ErrorContains(t, err, "governance framework version 2 does not exist")
+
+	// The evidence is recorded and the active version used by default
+	resp, err := ms.SlashPermissionTrustDeposit(sdkCtx, &types.MsgSlashPermissionTrustDeposit{
+		Creator:           validatorAddr,
+		Id:                applicantPermID,
+		Amount:            100,
+		ReasonCode:        3,
+		EvidenceUrl:       "https://example.com/evidence.json",
+		EvidenceDigestSri: "sha384-MzNNbQTWCSUSi0bbz7dbua+RcENv7C6FvlmYJ1Y+I727HsPOHdzwELMYO9Mz68M26",
+	})
+	require.NoError(t, err)
+
+	record, err := k.GetSlashRecord(sdkCtx, &types.QueryGetSlashRecordRequest{Id: resp.SlashId})
+	require.NoError(t, err)
+	require.Equal(t, uint32(3), record.SlashRecord.ReasonCode)
+	require.Equal(t, "https://example.com/evidence.json", record.SlashRecord.EvidenceUrl)
+	require.Equal(t, "sha384-MzNNbQTWCSUSi0bbz7dbua+RcENv7C6FvlmYJ1Y+I727HsPOHdzwELMYO9Mz68M26", record.SlashRecord.EvidenceDigestSri)
+	require.Equal(t, int32(1), record.SlashRecord.GfVersion)
+	require.Equal(t, uint64(100), record.SlashRecord.Amount)
+
+	// A second slash by the ecosystem keeps the history of both
+	_, err = ms.SlashPermissionTrustDeposit(sdkCtx, &types.MsgSlashPermissionTrustDeposit{
+		Creator:    ecosystemAddr,
+		Id:         applicantPermID,
+		Amount:     200,
+		ReasonCode: 5,
+		GfVersion:  1,
+	})
+	require.NoError(t, err)
+
+	// A slash of another perm
+	validatorPerm, err := k.GetPermissionByID(sdkCtx, validatorPermID)
+	require.NoError(t, err)
+	validatorPerm.Deposit = 500
+	require.NoError(t, k.UpdatePermission(sdkCtx, validatorPerm))
+	_, err = ms.SlashPermissionTrustDeposit(sdkCtx, &types.MsgSlashPermissionTrustDeposit{
+		Creator:    ecosystemAddr,
+		Id:         validatorPermID,
+		Amount:     50,
+		ReasonCode: 1,
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		req      *types.QueryListSlashRecordsRequest
+		expected []uint64
+	}{
+		{
+			name:     "all",
+			req:      &types.QueryListSlashRecordsRequest{},
+			expected: []uint64{1, 2, 3},
+		},
+		{
+			name:     "by perm",
+			req:      &types.QueryListSlashRecordsRequest{PermId: applicantPermID},
+			expected: []uint64{1, 2},
+		},
+		{
+			name:     "by grantee",
+			req:      &types.QueryListSlashRecordsRequest{Grantee: validatorAddr},
+			expected: []uint64{3},
+		},
+		{
+			name:     "by slasher",
+			req:      &types.QueryListSlashRecordsRequest{SlashedBy: ecosystemAddr},
+			expected: []uint64{2, 3},
+		},
+		{
+			name:     "by perm and slasher",
+			req:      &types.QueryListSlashRecordsRequest{PermId: applicantPermID, SlashedBy: validatorAddr},
+			expected: []uint64{1},
+		},
+		{
+			name:     "max size",
+			req:      &types.QueryListSlashRecordsRequest{ResponseMaxSize: 2},
+			expected: []uint64{1, 2},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := k.ListSlashRecords(sdkCtx, tc.req)
+			require.NoError(t, err)
+			ids := []uint64{}
+			for _, record := range resp.SlashRecords {
+				ids = append(ids, record.Id)
+			}
+			require.Equal(t, tc.expected, ids)
+		})
+	}
+
+	// pages continue after the last record listed
+	page, err := k.ListSlashRecords(sdkCtx, &types.QueryListSlashRecordsRequest{SlashedBy: ecosystemAddr, ResponseMaxSize: 1})
+	require.NoError(t, err)
+	require.Len(t, page.SlashRecords, 1)
+	require.Equal(t, uint64(2), page.SlashRecords[0].Id)
+	require.Equal(t, uint64(2), page.NextAfterId)
+	page, err = k.ListSlashRecords(sdkCtx, &types.QueryListSlashRecordsRequest{SlashedBy: ecosystemAddr, ResponseMaxSize: 1, AfterId: page.NextAfterId})
+	require.NoError(t, err)
+	require.Len(t, page.SlashRecords, 1)
+	require.Equal(t, uint64(3), page.SlashRecords[0].Id)
+	require.Zero(t, page.NextAfterId)
+
+	page, err = k.ListSlashRecords(sdkCtx, &types.QueryListSlashRecordsRequest{ResponseMaxSize: 2})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), page.NextAfterId)
+	page, err = k.ListSlashRecords(sdkCtx, &types.QueryListSlashRecordsRequest{AfterId: page.NextAfterId})
+	require.NoError(t, err)
+	require.Len(t, page.SlashRecords, 1)
+	require.Equal(t, uint64(3), page.SlashRecords[0].Id)
+	require.Zero(t, page.NextAfterId)
+
+	_, err = k.ListSlashRecords(sdkCtx, &types.QueryListSlashRecordsRequest{Grantee: "invalid"})
+	require.Error(t, err)
+
+	_, err = k.GetSlashRecord(sdkCtx, &types.QueryGetSlashRecordRequest{Id: 99})
+	require.ErrorContains(t, err, "slash record not found")
+}
+
 func TestCreatePermission(t *testing.T) {
 	k, ms, mockCsKeeper, trkKeeper, ctx := setupMsgServer(t)
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -2331,7 +2706,7 @@ func TestCreatePermission(t *testing.T) {
 		Created:   &now,
 		CreatedBy: creator,
 		Modified:  &now,
-		Country:   "US",
+		Countries: []string{"US"},
 		VpState:   types.ValidationState_VALIDATION_STATE_VALIDATED,
 	}
 	ecosystemPermID, err := k.CreatePermission(sdkCtx, ecosystemPerm)
@@ -2350,7 +2725,7 @@ func TestCreatePermission(t *testing.T) {
 				SchemaId:         1,
 				Type:             types.PermissionType_PERMISSION_TYPE_ISSUER,
 				Did:              validDid,
-				Country:          "US",
+				Countries:        []string{"US"},
 				EffectiveFrom:    &now,
 				EffectiveUntil:   &futureTime,
 				VerificationFees: 100,
@@ -2364,7 +2739,7 @@ func TestCreatePermission(t *testing.T) {
 				SchemaId:         1,
 				Type:             types.PermissionType_PERMISSION_TYPE_VERIFIER,
 				Did:              validDid,
-				Country:          "US",
+				Countries:        []string{"US"},
 				EffectiveFrom:    &now,
 				EffectiveUntil:   &futureTime,
 				VerificationFees: 100,
@@ -2378,7 +2753,7 @@ func TestCreatePermission(t *testing.T) {
 				SchemaId:         999, // Non-existent schema
 				Type:             types.PermissionType_PERMISSION_TYPE_ISSUER,
 				Did:              validDid,
-				Country:          "US",
+				Countries:        []string{"US"},
 				VerificationFees: 100,
 			},
 			isValid: false,
@@ -2391,7 +2766,7 @@ func TestCreatePermission(t *testing.T) {
 				SchemaId:         1,
 				Type:             types.PermissionType_PERMISSION_TYPE_UNSPECIFIED,
 				Did:              validDid,
-				Country:          "US",
+				Countries:        []string{"US"},
 				VerificationFees: 100,
 			},
 			isValid: false,
@@ -2404,7 +2779,7 @@ func TestCreatePermission(t *testing.T) {
 				SchemaId:         1,
 				Type:             types.PermissionType_PERMISSION_TYPE_ISSUER,
 				Did:              validDid,
-				Country:          "INVALID",
+				Countries:        []string{"INVALID"},
 				VerificationFees: 100,
 			},
 			isValid: false,
@@ -2417,7 +2792,7 @@ func TestCreatePermission(t *testing.T) {
 				SchemaId:         1,
 				Type:             types.PermissionType_PERMISSION_TYPE_ISSUER,
 				Did:              validDid,
-				Country:          "US",
+				Countries:        []string{"US"},
 				EffectiveFrom:    &futureTime,
 				EffectiveUntil:   &now, // Before effective_from
 				VerificationFees: 100,
@@ -2448,7 +2823,7 @@ func TestCreatePermission(t *testing.T) {
 				require.Equal(t, tc.msg.Type, perm.Type)
 				require.Equal(t, tc.msg.Did, perm.Did)
 				require.Equal(t, tc.msg.Creator, perm.Grantee)
-				require.Equal(t, tc.msg.Country, perm.Country)
+				require.Equal(t, tc.msg.Countries, perm.Countries)
 				require.Equal(t, tc.msg.VerificationFees, perm.VerificationFees)
 				require.Equal(t, ecosystemPermID, perm.ValidatorPermId)
 				require.Equal(t, types.ValidationState_VALIDATION_STATE_VALIDATED, perm.VpState)
@@ -2475,3 +2850,1828 @@ func TestCreatePermission(t *testing.T) {
 		})
 	}
 }
+
+func TestSessionAllowance(t *testing.T) {
+	k, ms, csKeeper, _, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	sponsor := sdk.AccAddress([]byte("test_sponsor")).String()
+	creator := sdk.AccAddress([]byte("test_creator")).String()
+
+	csKeeper.CreateMockCredentialSchema(1,
+		cstypes.CredentialSchemaPermManagementMode_GRANTOR_VALIDATION,
+		cstypes.CredentialSchemaPermManagementMode_GRANTOR_VALIDATION)
+
+	now := sdkCtx.BlockTime()
+	newPerm := func(permType types.PermissionType, validatorPermID uint64, issuanceFees uint64) uint64 {
+		id, err := k.CreatePermission(sdkCtx, types.Permission{
+			SchemaId:        1,
+			Type:            permType,
+			Grantee:         creator,
+			Created:         &now,
+			CreatedBy:       creator,
+			Modified:        &now,
+			Countries:       []string{"US"},
+			ValidatorPermId: validatorPermID,
+			VpState:         types.ValidationState_VALIDATION_STATE_VALIDATED,
+			IssuanceFees:    issuanceFees,
+		})
+		require.NoError(t, err)
+		return id
+	}
+	ecosystemPermID := newPerm(types.PermissionType_PERMISSION_TYPE_ECOSYSTEM, 0, 5)
+	issuerPermID := newPerm(types.PermissionType_PERMISSION_TYPE_ISSUER, ecosystemPermID, 0)
+	agentPermID := newPerm(types.PermissionType_PERMISSION_TYPE_HOLDER, issuerPermID, 0)
+	otherAgentPermID := newPerm(types.PermissionType_PERMISSION_TYPE_HOLDER, issuerPermID, 0)
+
+	// create and fund
+	_, err := ms.CreateSessionAllowance(ctx, &types.MsgCreateSessionAllowance{
+		Creator:     sponsor,
+		AgentPermId: issuerPermID,
+		Amount:      100,
+	})
+	require.ErrorContains(t, err, "agent perm must be HOLDER type")
+
+	schemaResp, err := ms.CreateSessionAllowance(ctx, &types.MsgCreateSessionAllowance{
+		Creator:         sponsor,
+		SchemaId:        1,
+		Amount:          100,
+		AllowedCreators: []string{creator},
+		MaxPerSession:   5,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), schemaResp.Id)
+
+	agentResp, err := ms.CreateSessionAllowance(ctx, &types.MsgCreateSessionAllowance{
+		Creator:     sponsor,
+		AgentPermId: otherAgentPermID,
+		Amount:      100,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), agentResp.Id)
+
+	_, err = ms.FundSessionAllowance(ctx, &types.MsgFundSessionAllowance{Creator: creator, Id: schemaResp.Id, Amount: 50})
+	require.ErrorContains(t, err, "only the sponsor")
+	_, err = ms.FundSessionAllowance(ctx, &types.MsgFundSessionAllowance{Creator: sponsor, Id: schemaResp.Id, Amount: 50})
+	require.NoError(t, err)
+
+	// sessions outside of the allowance scope are rejected
+	_, err = ms.CreateOrUpdatePermissionSession(ctx, &types.MsgCreateOrUpdatePermissionSession{
+		Creator:      creator,
+		Id:           uuid.New().String(),
+		IssuerPermId: issuerPermID,
+		AgentPermId:  agentPermID,
+		AllowanceId:  agentResp.Id,
+	})
+	require.ErrorContains(t, err, "does not cover this session")
+
+	// the session fees are drawn from the schema allowance
+	_, err = ms.CreateOrUpdatePermissionSession(ctx, &types.MsgCreateOrUpdatePermissionSession{
+		Creator:      creator,
+		Id:           uuid.New().String(),
+		IssuerPermId: issuerPermID,
+		AgentPermId:  agentPermID,
+		AllowanceId:  schemaResp.Id,
+	})
+	require.NoError(t, err)
+
+	getResp, err := k.GetSessionAllowance(ctx, &types.QueryGetSessionAllowanceRequest{Id: schemaResp.Id})
+	require.NoError(t, err)
+	require.Equal(t, uint64(145), getResp.Allowance.Remaining)
+	require.Equal(t, uint64(5), getResp.Allowance.Spent)
+
+	// a third party running sessions on its own perms of the schema cannot
+	// draw the allowance
+	outsider := sdk.AccAddress([]byte("test_outsider")).String()
+	outsiderIssuerPermID, err := k.CreatePermission(sdkCtx, types.Permission{
+		SchemaId:        1,
+		Type:            types.PermissionType_PERMISSION_TYPE_ISSUER,
+		Grantee:         outsider,
+		Created:         &now,
+		CreatedBy:       outsider,
+		Modified:        &now,
+		Countries:       []string{"US"},
+		ValidatorPermId: ecosystemPermID,
+		VpState:         types.ValidationState_VALIDATION_STATE_VALIDATED,
+	})
+	require.NoError(t, err)
+	_, err = ms.CreateOrUpdatePermissionSession(ctx, &types.MsgCreateOrUpdatePermissionSession{
+		Creator:      outsider,
+		Id:           uuid.New().String(),
+		IssuerPermId: outsiderIssuerPermID,
+		AgentPermId:  agentPermID,
+		AllowanceId:  schemaResp.Id,
+	})
+	require.ErrorContains(t, err, "does not pay for sessions of")
+	getResp, err = k.GetSessionAllowance(ctx, &types.QueryGetSessionAllowanceRequest{Id: schemaResp.Id})
+	require.NoError(t, err)
+	require.Equal(t, uint64(145), getResp.Allowance.Remaining)
+
+	// queries filter by sponsor and scope
+	listResp, err := k.ListSessionAllowances(ctx, &types.QueryListSessionAllowancesRequest{Sponsor: sponsor})
+	require.NoError(t, err)
+	require.Len(t, listResp.Allowances, 2)
+	listResp, err = k.ListSessionAllowances(ctx, &types.QueryListSessionAllowancesRequest{AgentPermId: otherAgentPermID})
+	require.NoError(t, err)
+	require.Len(t, listResp.Allowances, 1)
+	require.Equal(t, agentResp.Id, listResp.Allowances[0].Id)
+	listResp, err = k.ListSessionAllowances(ctx, &types.QueryListSessionAllowancesRequest{Sponsor: creator})
+	require.NoError(t, err)
+	require.Empty(t, listResp.Allowances)
+
+	// withdraw
+	_, err = ms.WithdrawSessionAllowance(ctx, &types.MsgWithdrawSessionAllowance{Creator: sponsor, Id: schemaResp.Id, Amount: 146})
+	require.ErrorContains(t, err, "exceeds remaining allowance")
+	withdrawResp, err := ms.WithdrawSessionAllowance(ctx, &types.MsgWithdrawSessionAllowance{Creator: sponsor, Id: schemaResp.Id})
+	require.NoError(t, err)
+	require.Equal(t, uint64(145), withdrawResp.Amount)
+
+	// an empty allowance cannot pay for sessions
+	_, err = ms.CreateOrUpdatePermissionSession(ctx, &types.MsgCreateOrUpdatePermissionSession{
+		Creator:      creator,
+		Id:           uuid.New().String(),
+		IssuerPermId: issuerPermID,
+		AgentPermId:  agentPermID,
+		AllowanceId:  schemaResp.Id,
+	})
+	require.ErrorContains(t, err, "exceed remaining allowance")
+
+	// sessions cannot draw more than the maximum per session
+	cappedResp, err := ms.CreateSessionAllowance(ctx, &types.MsgCreateSessionAllowance{
+		Creator:       sponsor,
+		AgentPermId:   agentPermID,
+		Amount:        100,
+		MaxPerSession: 4,
+	})
+	require.NoError(t, err)
+	_, err = ms.CreateOrUpdatePermissionSession(ctx, &types.MsgCreateOrUpdatePermissionSession{
+		Creator:      creator,
+		Id:           uuid.New().String(),
+		IssuerPermId: issuerPermID,
+		AgentPermId:  agentPermID,
+		AllowanceId:  cappedResp.Id,
+	})
+	require.ErrorContains(t, err, "exceed the allowance maximum per session")
+}
+
+func TestSessionAllowanceCreatorTrustDeposit(t *testing.T) {
+	k, csKeeper, _, tdKeeper, ctx := keepertest.PermissionKeeperWithTrustDeposit(t)
+	ms := keeper.NewMsgServerImpl(k)
+
+	trustDepositRate := math.LegacyMustNewDecFromStr("0.2")
+	tdKeeper.TrustDepositRate = &trustDepositRate
+
+	sponsor := sdk.AccAddress([]byte("test_sponsor")).String()
+	creator := sdk.AccAddress([]byte("test_creator")).String()
+	ecosystem := sdk.AccAddress([]byte("test_ecosystem")).String()
+
+	csKeeper.CreateMockCredentialSchema(1,
+		cstypes.CredentialSchemaPermManagementMode_GRANTOR_VALIDATION,
+		cstypes.CredentialSchemaPermManagementMode_GRANTOR_VALIDATION)
+
+	now := ctx.BlockTime()
+	newPerm := func(grantee string, permType types.PermissionType, validatorPermID uint64, issuanceFees uint64) uint64 {
+		id, err := k.CreatePermission(ctx, types.Permission{
+			SchemaId:        1,
+			Type:            permType,
+			Grantee:         grantee,
+			Created:         &now,
+			CreatedBy:       grantee,
+			Modified:        &now,
+			Countries:       []string{"US"},
+			ValidatorPermId: validatorPermID,
+			VpState:         types.ValidationState_VALIDATION_STATE_VALIDATED,
+			IssuanceFees:    issuanceFees,
+		})
+		require.NoError(t, err)
+		return id
+	}
+	ecosystemPermID := newPerm(ecosystem, types.PermissionType_PERMISSION_TYPE_ECOSYSTEM, 0, 10)
+	issuerPermID := newPerm(creator, types.PermissionType_PERMISSION_TYPE_ISSUER, ecosystemPermID, 0)
+	agentPermID := newPerm(creator, types.PermissionType_PERMISSION_TYPE_HOLDER, issuerPermID, 0)
+
+	allowanceResp, err := ms.CreateSessionAllowance(ctx, &types.MsgCreateSessionAllowance{
+		Creator:  sponsor,
+		SchemaId: 1,
+		Amount:   100,
+	})
+	require.NoError(t, err)
+
+	_, err = ms.CreateOrUpdatePermissionSession(ctx, &types.MsgCreateOrUpdatePermissionSession{
+		Creator:      creator,
+		Id:           uuid.New().String(),
+		IssuerPermId: issuerPermID,
+		AgentPermId:  agentPermID,
+		AllowanceId:  allowanceResp.Id,
+	})
+	require.NoError(t, err)
+
+	// the sponsor only pays the fees of the ecosystem, 8 direct fees and a
+	// trust deposit of 2
+	getResp, err := k.GetSessionAllowance(ctx, &types.QueryGetSessionAllowanceRequest{Id: allowanceResp.Id})
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), getResp.Allowance.Spent)
+	require.Equal(t, uint64(90), getResp.Allowance.Remaining)
+	require.Equal(t, int64(2), tdKeeper.Adjustments[ecosystem])
+
+	// the reclaimable trust deposit of the creator only grows by the amount
+	// the creator funds itself, not by sponsor funds
+	require.Equal(t, int64(2), tdKeeper.Adjustments[creator])
+
+	// the creator is charged its own trust deposit only
+	quote, err := k.QuoteMsgFees(ctx, &types.MsgCreateOrUpdatePermissionSession{
+		Creator:      creator,
+		Id:           uuid.New().String(),
+		IssuerPermId: issuerPermID,
+		AgentPermId:  agentPermID,
+		AllowanceId:  allowanceResp.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, 2)), quote)
+}
+
+func TestSlashDistribution(t *testing.T) {
+	k, ms, csKeeper, trkKeeper, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	grantee := sdk.AccAddress([]byte("test_grantee")).String()
+	ecosystemAddr := sdk.AccAddress([]byte("test_ecosystem")).String()
+	beneficiary := sdk.AccAddress([]byte("test_beneficiary")).String()
+
+	trID := trkKeeper.CreateMockTrustRegistry(ecosystemAddr, "did:example:123456789abcdefghi")
+	csKeeper.UpdateMockCredentialSchema(1, trID,
+		cstypes.CredentialSchemaPermManagementMode_GRANTOR_VALIDATION,
+		cstypes.CredentialSchemaPermManagementMode_GRANTOR_VALIDATION)
+
+	now := time.Now()
+	sdkCtx = sdkCtx.WithBlockTime(now)
+
+	ecosystemPermID, err := k.CreatePermission(sdkCtx, types.Permission{
+		SchemaId: 1,
+		Type:     types.PermissionType_PERMISSION_TYPE_ECOSYSTEM,
+		Grantee:  ecosystemAddr,
+		Created:  &now,
+		Modified: &now,
+		VpState:  types.ValidationState_VALIDATION_STATE_VALIDATED,
+	})
+	require.NoError(t, err)
+
+	applicantPermID, err := k.CreatePermission(sdkCtx, types.Permission{
+		SchemaId:        1,
+		Type:            types.PermissionType_PERMISSION_TYPE_ISSUER,
+		Grantee:         grantee,
+		Created:         &now,
+		Modified:        &now,
+		ValidatorPermId: ecosystemPermID,
+		VpState:         types.ValidationState_VALIDATION_STATE_VALIDATED,
+		Deposit:         1000,
+	})
+	require.NoError(t, err)
+
+	trDistribution := []tdtypes.SlashShare{
+		{Destination: tdtypes.SlashDestination_SLASH_DESTINATION_COMMUNITY_POOL, Weight: math.LegacyMustNewDecFromStr("0.5")},
+		{Destination: tdtypes.SlashDestination_SLASH_DESTINATION_BENEFICIARY, Beneficiary: beneficiary, Weight: math.LegacyMustNewDecFromStr("0.5")},
+	}
+
+	// Only the trust registry controller can set the distribution
+	_, err = ms.SetSlashDistribution(sdkCtx, &types.MsgSetSlashDistribution{
+		Creator:      grantee,
+		TrId:         trID,
+		Distribution: trDistribution,
+	})
+	require.ErrorContains(t, err, "only the trust registry controller")
+
+	_, err = k.GetSlashDistribution(sdkCtx, &types.QueryGetSlashDistributionRequest{TrId: trID})
+	require.Error(t, err)
+
+	_, err = ms.SetSlashDistribution(sdkCtx, &types.MsgSetSlashDistribution{
+		Creator:      ecosystemAddr,
+		TrId:         trID,
+		Distribution: trDistribution,
+	})
+	require.NoError(t, err)
+
+	distResp, err := k.GetSlashDistribution(sdkCtx, &types.QueryGetSlashDistributionRequest{TrId: trID})
+	require.NoError(t, err)
+	require.Equal(t, trID, distResp.SlashDistribution.TrId)
+	require.Len(t, distResp.SlashDistribution.Distribution, 2)
+
+	// Slashes use the distribution of the trust registry by default
+	resp, err := ms.SlashPermissionTrustDeposit(sdkCtx, &types.MsgSlashPermissionTrustDeposit{
+		Creator:    ecosystemAddr,
+		Id:         applicantPermID,
+		Amount:     100,
+		ReasonCode: 1,
+	})
+	require.NoError(t, err)
+
+	record, err := k.GetSlashRecord(sdkCtx, &types.QueryGetSlashRecordRequest{Id: resp.SlashId})
+	require.NoError(t, err)
+	require.Equal(t, trDistribution, record.SlashRecord.Distribution)
+
+	// A distribution set on the slash overrides the trust registry one
+	burn := []tdtypes.SlashShare{
+		{Destination: tdtypes.SlashDestination_SLASH_DESTINATION_BURN, Weight: math.LegacyOneDec()},
+	}
+	resp, err = ms.SlashPermissionTrustDeposit(sdkCtx, &types.MsgSlashPermissionTrustDeposit{
+		Creator:      ecosystemAddr,
+		Id:           applicantPermID,
+		Amount:       100,
+		ReasonCode:   1,
+		Distribution: burn,
+	})
+	require.NoError(t, err)
+
+	record, err = k.GetSlashRecord(sdkCtx, &types.QueryGetSlashRecordRequest{Id: resp.SlashId})
+	require.NoError(t, err)
+	require.Equal(t, burn, record.SlashRecord.Distribution)
+
+	// An empty distribution removes the configuration, slashed deposits are burned
+	_, err = ms.SetSlashDistribution(sdkCtx, &types.MsgSetSlashDistribution{
+		Creator: ecosystemAddr,
+		TrId:    trID,
+	})
+	require.NoError(t, err)
+
+	_, err = k.GetSlashDistribution(sdkCtx, &types.QueryGetSlashDistributionRequest{TrId: trID})
+	require.Error(t, err)
+
+	resp, err = ms.SlashPermissionTrustDeposit(sdkCtx, &types.MsgSlashPermissionTrustDeposit{
+		Creator:    ecosystemAddr,
+		Id:         applicantPermID,
+		Amount:     100,
+		ReasonCode: 1,
+	})
+	require.NoError(t, err)
+
+	record, err = k.GetSlashRecord(sdkCtx, &types.QueryGetSlashRecordRequest{Id: resp.SlashId})
+	require.NoError(t, err)
+	require.Empty(t, record.SlashRecord.Distribution)
+}
+
+func TestPermissionTree(t *testing.T) {
+	k, _, csKeeper, trkKeeper, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	creator := sdk.AccAddress([]byte("test_creator")).String()
+	trID := trkKeeper.CreateMockTrustRegistry(creator, "did:example:123456789abcdefghi")
+	csKeeper.UpdateMockCredentialSchema(1, trID,
+		cstypes.CredentialSchemaPermManagementMode_GRANTOR_VALIDATION,
+		cstypes.CredentialSchemaPermManagementMode_GRANTOR_VALIDATION)
+
+	now := time.Now()
+	past := now.Add(-time.Hour)
+	sdkCtx = sdkCtx.WithBlockTime(now)
+
+	create := func(permType types.PermissionType, validatorPermID uint64, countries ...string) uint64 {
+		id, err := k.CreatePermission(sdkCtx, types.Permission{
+			SchemaId:        1,
+			Type:            permType,
+			Grantee:         creator,
+			Created:         &past,
+			Modified:        &past,
+			EffectiveFrom:   &past,
+			ValidatorPermId: validatorPermID,
+			Countries:       countries,
+			VpState:         types.ValidationState_VALIDATION_STATE_VALIDATED,
+		})
+		require.NoError(t, err)
+		return id
+	}
+
+	// ECOSYSTEM -> ISSUER_GRANTOR (x2) -> ISSUER -> HOLDER
+	ecosystemID := create(types.PermissionType_PERMISSION_TYPE_ECOSYSTEM, 0)
+	grantorID := create(types.PermissionType_PERMISSION_TYPE_ISSUER_GRANTOR, ecosystemID, "US")
+	otherGrantorID := create(types.PermissionType_PERMISSION_TYPE_ISSUER_GRANTOR, ecosystemID, "FR")
+	issuerID := create(types.PermissionType_PERMISSION_TYPE_ISSUER, grantorID, "US")
+	holderID := create(types.PermissionType_PERMISSION_TYPE_HOLDER, issuerID, "US")
+
+	// the grantor is revoked now
+	grantor, err := k.GetPermissionByID(sdkCtx, grantorID)
+	require.NoError(t, err)
+	grantor.Revoked = &now
+	require.NoError(t, k.UpdatePermission(sdkCtx, grantor))
+
+	nodeIDs := func(nodes []types.PermissionNode) []uint64 {
+		var ids []uint64
+		for _, node := range nodes {
+			ids = append(ids, node.Permission.Id)
+		}
+		return ids
+	}
+
+	// whole tree, breadth first
+	tree, err := k.GetPermissionTree(sdkCtx, &types.QueryGetPermissionTreeRequest{RootId: ecosystemID})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{ecosystemID, grantorID, otherGrantorID, issuerID, holderID}, nodeIDs(tree.Nodes))
+	require.Equal(t, []uint32{0, 1, 1, 2, 3}, []uint32{tree.Nodes[0].Depth, tree.Nodes[1].Depth, tree.Nodes[2].Depth, tree.Nodes[3].Depth, tree.Nodes[4].Depth})
+	require.False(t, tree.Nodes[1].Valid)
+	require.Contains(t, tree.Nodes[1].InvalidReason, "revoked")
+	require.False(t, tree.Truncated)
+
+	// depth and type filters
+	tree, err = k.GetPermissionTree(sdkCtx, &types.QueryGetPermissionTreeRequest{RootId: ecosystemID, Depth: 1})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{ecosystemID, grantorID, otherGrantorID}, nodeIDs(tree.Nodes))
+	tree, err = k.GetPermissionTree(sdkCtx, &types.QueryGetPermissionTreeRequest{
+		RootId: ecosystemID,
+		Type:   uint32(types.PermissionType_PERMISSION_TYPE_ISSUER_GRANTOR),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{grantorID, otherGrantorID}, nodeIDs(tree.Nodes))
+
+	// validity filter, with the country used for the check
+	tree, err = k.GetPermissionTree(sdkCtx, &types.QueryGetPermissionTreeRequest{RootId: ecosystemID, OnlyValid: true, Country: "FR"})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{ecosystemID, otherGrantorID}, nodeIDs(tree.Nodes))
+
+	// before the revocation the whole US chain was valid
+	tree, err = k.GetPermissionTree(sdkCtx, &types.QueryGetPermissionTreeRequest{RootId: ecosystemID, OnlyValid: true, Country: "US", When: &past})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{ecosystemID, grantorID, issuerID, holderID}, nodeIDs(tree.Nodes))
+
+	// truncation
+	tree, err = k.GetPermissionTree(sdkCtx, &types.QueryGetPermissionTreeRequest{RootId: ecosystemID, ResponseMaxSize: 2})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{ecosystemID, grantorID}, nodeIDs(tree.Nodes))
+	require.True(t, tree.Truncated)
+
+	_, err = k.GetPermissionTree(sdkCtx, &types.QueryGetPermissionTreeRequest{RootId: 999})
+	require.ErrorContains(t, err, "perm not found")
+	_, err = k.GetPermissionTree(sdkCtx, &types.QueryGetPermissionTreeRequest{RootId: ecosystemID, Type: 7})
+	require.ErrorContains(t, err, "invalid perm type")
+
+	// ancestry from the holder up to the root
+	ancestry, err := k.GetPermissionAncestry(sdkCtx, &types.QueryGetPermissionAncestryRequest{Id: holderID})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{holderID, issuerID, grantorID, ecosystemID}, nodeIDs(ancestry.Ancestors))
+	require.False(t, ancestry.ChainValid)
+	require.True(t, ancestry.Ancestors[0].Valid)
+	require.False(t, ancestry.Ancestors[2].Valid)
+
+	ancestry, err = k.GetPermissionAncestry(sdkCtx, &types.QueryGetPermissionAncestryRequest{Id: holderID, When: &past})
+	require.NoError(t, err)
+	require.True(t, ancestry.ChainValid)
+
+	_, err = k.GetPermissionAncestry(sdkCtx, &types.QueryGetPermissionAncestryRequest{Id: 0})
+	require.Error(t, err)
+}
+
+func TestRevocationCascade(t *testing.T) {
+	k, ms, csKeeper, trkKeeper, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	ecosystemAddr := sdk.AccAddress([]byte("test_ecosystem")).String()
+	grantorAddr := sdk.AccAddress([]byte("test_grantor")).String()
+	trID := trkKeeper.CreateMockTrustRegistry(ecosystemAddr, "did:example:123456789abcdefghi")
+	csKeeper.UpdateMockCredentialSchema(1, trID,
+		cstypes.CredentialSchemaPermManagementMode_GRANTOR_VALIDATION,
+		cstypes.CredentialSchemaPermManagementMode_GRANTOR_VALIDATION)
+
+	now := time.Now()
+	sdkCtx = sdkCtx.WithBlockTime(now)
+
+	params := k.GetParams(sdkCtx)
+	params.CascadeBatchSize = 2
+	require.NoError(t, k.SetParams(sdkCtx, params))
+
+	create := func(permType types.PermissionType, grantee string, validatorPermID uint64) uint64 {
+		id, err := k.CreatePermission(sdkCtx, types.Permission{
+			SchemaId:        1,
+			Type:            permType,
+			Grantee:         grantee,
+			Created:         &now,
+			Modified:        &now,
+			ValidatorPermId: validatorPermID,
+			VpState:         types.ValidationState_VALIDATION_STATE_VALIDATED,
+		})
+		require.NoError(t, err)
+		return id
+	}
+
+	// ECOSYSTEM -> ISSUER_GRANTOR -> ISSUER (x3), the first issuer has two holders
+	ecosystemID := create(types.PermissionType_PERMISSION_TYPE_ECOSYSTEM, ecosystemAddr, 0)
+	grantorID := create(types.PermissionType_PERMISSION_TYPE_ISSUER_GRANTOR, grantorAddr, ecosystemID)
+	issuerIDs := []uint64{
+		create(types.PermissionType_PERMISSION_TYPE_ISSUER, grantorAddr, grantorID),
+		create(types.PermissionType_PERMISSION_TYPE_ISSUER, grantorAddr, grantorID),
+		create(types.PermissionType_PERMISSION_TYPE_ISSUER, grantorAddr, grantorID),
+	}
+	holderIDs := []uint64{
+		create(types.PermissionType_PERMISSION_TYPE_HOLDER, grantorAddr, issuerIDs[0]),
+		create(types.PermissionType_PERMISSION_TYPE_HOLDER, grantorAddr, issuerIDs[0]),
+	}
+	descendants := append(append([]uint64{}, issuerIDs...), holderIDs...)
+
+	// the dry run lists every descendant, breadth first, without revoking
+	simulation, err := k.SimulateRevocationCascade(sdkCtx, &types.QuerySimulateRevocationCascadeRequest{
+		Id:   grantorID,
+		Mode: types.CascadeMode_CASCADE_MODE_REVOKE,
+	})
+	require.NoError(t, err)
+	var affected []uint64
+	for _, node := range simulation.Affected {
+		affected = append(affected, node.Permission.Id)
+	}
+	require.Equal(t, descendants, affected)
+	require.Equal(t, uint32(2), simulation.Affected[3].Depth)
+	perm, err := k.GetPermissionByID(sdkCtx, issuerIDs[0])
+	require.NoError(t, err)
+	require.Nil(t, perm.Revoked)
+
+	_, err = k.SimulateRevocationCascade(sdkCtx, &types.QuerySimulateRevocationCascadeRequest{Id: grantorID})
+	require.Error(t, err)
+
+	countEvents := func(ctx sdk.Context, eventType string) int {
+		count := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == eventType {
+				count++
+			}
+		}
+		return count
+	}
+
+	// the transaction processes the first batch
+	txCtx := sdkCtx.WithEventManager(sdk.NewEventManager())
+	resp, err := ms.RevokePermission(txCtx, &types.MsgRevokePermission{
+		Creator: ecosystemAddr,
+		Id:      grantorID,
+		Cascade: types.CascadeMode_CASCADE_MODE_REVOKE,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), resp.Cascaded)
+	require.True(t, resp.CascadePending)
+	require.Equal(t, 2, countEvents(txCtx, types.EventTypeCascadeRevokePermission))
+
+	isRevoked := func(id uint64) bool {
+		perm, err := k.GetPermissionByID(sdkCtx, id)
+		require.NoError(t, err)
+		return perm.Revoked != nil
+	}
+	require.True(t, isRevoked(issuerIDs[0]))
+	require.True(t, isRevoked(issuerIDs[1]))
+	require.False(t, isRevoked(issuerIDs[2]))
+
+	cascades, err := k.ListRevocationCascades(sdkCtx, &types.QueryListRevocationCascadesRequest{})
+	require.NoError(t, err)
+	require.NotEmpty(t, cascades.Cascades)
+	require.Equal(t, grantorID, cascades.Cascades[0].OriginPermId)
+
+	// the EndBlocker continues with the rest of the tree
+	require.NoError(t, k.ContinueRevocationCascades(sdkCtx))
+	require.True(t, isRevoked(issuerIDs[2]))
+	require.True(t, isRevoked(holderIDs[0]))
+	require.False(t, isRevoked(holderIDs[1]))
+
+	require.NoError(t, k.ContinueRevocationCascades(sdkCtx))
+	require.NoError(t, k.ContinueRevocationCascades(sdkCtx))
+	for _, id := range descendants {
+		require.True(t, isRevoked(id))
+		perm, err := k.GetPermissionByID(sdkCtx, id)
+		require.NoError(t, err)
+		require.Equal(t, ecosystemAddr, perm.RevokedBy)
+	}
+	cascades, err = k.ListRevocationCascades(sdkCtx, &types.QueryListRevocationCascadesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, cascades.Cascades)
+
+	// suspend mode suspends the descendants without revoking them
+	otherGrantorID := create(types.PermissionType_PERMISSION_TYPE_ISSUER_GRANTOR, grantorAddr, ecosystemID)
+	otherIssuerID := create(types.PermissionType_PERMISSION_TYPE_ISSUER, grantorAddr, otherGrantorID)
+
+	// a descendant that can't be processed does not stop the cascade
+	require.NoError(t, k.PermissionByValidator.Set(sdkCtx, collections.Join(otherGrantorID, uint64(9999))))
+
+	txCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	resp, err = ms.RevokePermission(txCtx, &types.MsgRevokePermission{
+		Creator: ecosystemAddr,
+		Id:      otherGrantorID,
+		Cascade: types.CascadeMode_CASCADE_MODE_SUSPEND,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), resp.Cascaded)
+	require.Equal(t, 1, countEvents(txCtx, types.EventTypeCascadeSuspendPermission))
+	require.Equal(t, 1, countEvents(txCtx, types.EventTypeCascadePermissionFailed))
+	require.NoError(t, k.ContinueRevocationCascades(sdkCtx))
+	cascades, err = k.ListRevocationCascades(sdkCtx, &types.QueryListRevocationCascadesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, cascades.Cascades)
+
+	perm, err = k.GetPermissionByID(sdkCtx, otherIssuerID)
+	require.NoError(t, err)
+	require.Nil(t, perm.Revoked)
+	require.NotNil(t, perm.Suspended)
+	require.Equal(t, ecosystemAddr, perm.SuspendedBy)
+	require.ErrorContains(t, keeper.IsValidPermission(perm, nil, now), "suspended")
+}
+
+func TestSuspendPermission(t *testing.T) {
+	k, ms, csKeeper, _, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	validatorAddr := sdk.AccAddress([]byte("test_validator")).String()
+	creator := sdk.AccAddress([]byte("test_creator")).String()
+	csKeeper.CreateMockCredentialSchema(1,
+		cstypes.CredentialSchemaPermManagementMode_GRANTOR_VALIDATION,
+		cstypes.CredentialSchemaPermManagementMode_GRANTOR_VALIDATION)
+
+	now := time.Date(2023, 1, 15, 0, 0, 0, 0, time.UTC)
+	sdkCtx = sdkCtx.WithBlockTime(now)
+
+	create := func(permType types.PermissionType, grantee string, validatorPermID uint64) uint64 {
+		id, err := k.CreatePermission(sdkCtx, types.Permission{
+			SchemaId:        1,
+			Type:            permType,
+			Grantee:         grantee,
+			Created:         &now,
+			Modified:        &now,
+			ValidatorPermId: validatorPermID,
+			VpState:         types.ValidationState_VALIDATION_STATE_VALIDATED,
+		})
+		require.NoError(t, err)
+		return id
+	}
+	ecosystemID := create(types.PermissionType_PERMISSION_TYPE_ECOSYSTEM, validatorAddr, 0)
+	issuerID := create(types.PermissionType_PERMISSION_TYPE_ISSUER, creator, ecosystemID)
+	agentID := create(types.PermissionType_PERMISSION_TYPE_HOLDER, creator, issuerID)
+
+	// only the validator can suspend
+	_, err := ms.SuspendPermission(sdkCtx, &types.MsgSuspendPermission{Creator: creator, Id: issuerID})
+	require.ErrorContains(t, err, "creator is not the validator")
+
+	past := now.Add(-time.Hour)
+	_, err = ms.SuspendPermission(sdkCtx, &types.MsgSuspendPermission{Creator: validatorAddr, Id: issuerID, SuspendedUntil: &past})
+	require.ErrorContains(t, err, "suspended_until must be in the future")
+
+	until := now.Add(time.Hour)
+	_, err = ms.SuspendPermission(sdkCtx, &types.MsgSuspendPermission{
+		Creator:        validatorAddr,
+		Id:             issuerID,
+		Reason:         "investigation",
+		SuspendedUntil: &until,
+	})
+	require.NoError(t, err)
+
+	perm, err := k.GetPermissionByID(sdkCtx, issuerID)
+	require.NoError(t, err)
+	require.Equal(t, validatorAddr, perm.SuspendedBy)
+	require.Equal(t, "investigation", perm.SuspensionReason)
+	require.ErrorContains(t, keeper.IsValidPermission(perm, nil, now), "suspended")
+	require.NoError(t, keeper.IsValidPermission(perm, nil, until))
+
+	_, err = ms.SuspendPermission(sdkCtx, &types.MsgSuspendPermission{Creator: validatorAddr, Id: issuerID})
+	require.ErrorContains(t, err, "already suspended")
+
+	// a suspended perm cannot be used in a session
+	_, err = ms.CreateOrUpdatePermissionSession(sdkCtx, &types.MsgCreateOrUpdatePermissionSession{
+		Creator:      creator,
+		Id:           uuid.New().String(),
+		IssuerPermId: issuerID,
+		AgentPermId:  agentID,
+	})
+	require.ErrorContains(t, err, "issuer perm is suspended")
+
+	// the suspension is visible in the perm tree
+	tree, err := k.GetPermissionTree(sdkCtx, &types.QueryGetPermissionTreeRequest{RootId: ecosystemID})
+	require.NoError(t, err)
+	require.False(t, tree.Nodes[1].Valid)
+	require.Contains(t, tree.Nodes[1].InvalidReason, "suspended")
+
+	// unsuspension clears the suspension
+	_, err = ms.UnsuspendPermission(sdkCtx, &types.MsgUnsuspendPermission{Creator: creator, Id: issuerID})
+	require.ErrorContains(t, err, "creator is not the validator")
+	_, err = ms.UnsuspendPermission(sdkCtx, &types.MsgUnsuspendPermission{Creator: validatorAddr, Id: issuerID})
+	require.NoError(t, err)
+
+	perm, err = k.GetPermissionByID(sdkCtx, issuerID)
+	require.NoError(t, err)
+	require.Nil(t, perm.Suspended)
+	require.Empty(t, perm.SuspensionReason)
+	require.NoError(t, keeper.IsValidPermission(perm, nil, now))
+
+	_, err = ms.UnsuspendPermission(sdkCtx, &types.MsgUnsuspendPermission{Creator: validatorAddr, Id: issuerID})
+	require.ErrorContains(t, err, "not suspended")
+}
+
+func TestAgentRewards(t *testing.T) {
+	k, csKeeper, _, tdKeeper, ctx := keepertest.PermissionKeeperWithTrustDeposit(t)
+	ms := keeper.NewMsgServerImpl(k)
+
+	userAgentRate := math.LegacyMustNewDecFromStr("0.1")
+	walletRate := math.LegacyMustNewDecFromStr("0.05")
+	trustDepositRate := math.LegacyMustNewDecFromStr("0.2")
+	tdKeeper.UserAgentRewardRate = &userAgentRate
+	tdKeeper.WalletUserAgentRewardRate = &walletRate
+	tdKeeper.TrustDepositRate = &trustDepositRate
+
+	creator := sdk.AccAddress([]byte("test_creator")).String()
+	agentAddr := sdk.AccAddress([]byte("test_agent")).String()
+	walletAddr := sdk.AccAddress([]byte("test_wallet")).String()
+
+	csKeeper.CreateMockCredentialSchema(1,
+		cstypes.CredentialSchemaPermManagementMode_GRANTOR_VALIDATION,
+		cstypes.CredentialSchemaPermManagementMode_GRANTOR_VALIDATION)
+
+	now := ctx.BlockTime()
+	newPerm := func(permType types.PermissionType, grantee string, validatorPermID uint64, issuanceFees uint64) uint64 {
+		id, err := k.CreatePermission(ctx, types.Permission{
+			SchemaId:        1,
+			Type:            permType,
+			Grantee:         grantee,
+			Created:         &now,
+			CreatedBy:       grantee,
+			Modified:        &now,
+			Countries:       []string{"US"},
+			ValidatorPermId: validatorPermID,
+			VpState:         types.ValidationState_VALIDATION_STATE_VALIDATED,
+			IssuanceFees:    issuanceFees,
+		})
+		require.NoError(t, err)
+		return id
+	}
+	ecosystemPermID := newPerm(types.PermissionType_PERMISSION_TYPE_ECOSYSTEM, creator, 0, 1000)
+	issuerPermID := newPerm(types.PermissionType_PERMISSION_TYPE_ISSUER, creator, ecosystemPermID, 0)
+	agentPermID := newPerm(types.PermissionType_PERMISSION_TYPE_HOLDER, agentAddr, issuerPermID, 0)
+	walletPermID := newPerm(types.PermissionType_PERMISSION_TYPE_HOLDER, walletAddr, issuerPermID, 0)
+
+	msg := &types.MsgCreateOrUpdatePermissionSession{
+		Creator:           creator,
+		Id:                uuid.New().String(),
+		IssuerPermId:      issuerPermID,
+		AgentPermId:       agentPermID,
+		WalletAgentPermId: walletPermID,
+	}
+
+	// fees 1000, creator trust deposit 200, user agent 100, wallet agent 50
+	quote, err := k.QuoteMsgFees(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, int64(1350), quote.AmountOf(types.BondDenom).Int64())
+
+	_, err = ms.CreateOrUpdatePermissionSession(ctx, msg)
+	require.NoError(t, err)
+
+	// the trust deposit share of the rewards goes to the agent trust deposits
+	require.Equal(t, int64(20), tdKeeper.Adjustments[agentAddr])
+	require.Equal(t, int64(10), tdKeeper.Adjustments[walletAddr])
+	agentPerm, err := k.GetPermissionByID(ctx, agentPermID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(20), agentPerm.Deposit)
+
+	resp, err := k.GetAgentRewards(ctx, &types.QueryGetAgentRewardsRequest{PermId: agentPermID})
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), resp.AgentReward.UserAgentRewards)
+	require.Equal(t, uint64(0), resp.AgentReward.WalletAgentRewards)
+	require.Equal(t, uint64(20), resp.AgentReward.TrustDeposit)
+
+	resp, err = k.GetAgentRewards(ctx, &types.QueryGetAgentRewardsRequest{PermId: walletPermID})
+	require.NoError(t, err)
+	require.Equal(t, uint64(50), resp.AgentReward.WalletAgentRewards)
+	require.Equal(t, uint64(10), resp.AgentReward.TrustDeposit)
+
+	// without wallet agent, only the user agent is rewarded
+	_, err = ms.CreateOrUpdatePermissionSession(ctx, &types.MsgCreateOrUpdatePermissionSession{
+		Creator:      creator,
+		Id:           uuid.New().String(),
+		IssuerPermId: issuerPermID,
+		AgentPermId:  agentPermID,
+	})
+	require.NoError(t, err)
+
+	resp, err = k.GetAgentRewards(ctx, &types.QueryGetAgentRewardsRequest{PermId: agentPermID})
+	require.NoError(t, err)
+	require.Equal(t, uint64(200), resp.AgentReward.UserAgentRewards)
+	require.Equal(t, uint64(40), resp.AgentReward.TrustDeposit)
+	require.Equal(t, int64(10), tdKeeper.Adjustments[walletAddr])
+
+	// perms that never acted as agent report no rewards
+	resp, err = k.GetAgentRewards(ctx, &types.QueryGetAgentRewardsRequest{PermId: issuerPermID})
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), resp.AgentReward.UserAgentRewards)
+
+	_, err = k.GetAgentRewards(ctx, &types.QueryGetAgentRewardsRequest{PermId: 99})
+	require.Error(t, err)
+}
+
+func TestPermissionSessionLifecycle(t *testing.T) {
+	k, ms, csKeeper, _, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	creator := sdk.AccAddress([]byte("test_creator")).String()
+	other := sdk.AccAddress([]byte("test_other")).String()
+
+	csKeeper.CreateMockCredentialSchema(1,
+		cstypes.CredentialSchemaPermManagementMode_GRANTOR_VALIDATION,
+		cstypes.CredentialSchemaPermManagementMode_GRANTOR_VALIDATION)
+
+	params := types.DefaultParams()
+	params.SessionMaxAuthz = 2
+	require.NoError(t, k.SetParams(sdkCtx, params))
+
+	now := sdkCtx.BlockTime()
+	newPerm := func(permType types.PermissionType, validatorPermID uint64) uint64 {
+		id, err := k.CreatePermission(sdkCtx, types.Permission{
+			SchemaId:        1,
+			Type:            permType,
+			Grantee:         creator,
+			Created:         &now,
+			CreatedBy:       creator,
+			Modified:        &now,
+			Countries:       []string{"US"},
+			ValidatorPermId: validatorPermID,
+			VpState:         types.ValidationState_VALIDATION_STATE_VALIDATED,
+		})
+		require.NoError(t, err)
+		return id
+	}
+	ecosystemPermID := newPerm(types.PermissionType_PERMISSION_TYPE_ECOSYSTEM, 0)
+	issuerPermID := newPerm(types.PermissionType_PERMISSION_TYPE_ISSUER, ecosystemPermID)
+	otherIssuerPermID := newPerm(types.PermissionType_PERMISSION_TYPE_ISSUER, ecosystemPermID)
+	thirdIssuerPermID := newPerm(types.PermissionType_PERMISSION_TYPE_ISSUER, ecosystemPermID)
+	agentPermID := newPerm(types.PermissionType_PERMISSION_TYPE_HOLDER, issuerPermID)
+	otherAgentPermID := newPerm(types.PermissionType_PERMISSION_TYPE_HOLDER, issuerPermID)
+
+	newSession := func(id string, agent uint64, expires *time.Time) error {
+		_, err := ms.CreateOrUpdatePermissionSession(ctx, &types.MsgCreateOrUpdatePermissionSession{
+			Creator:      creator,
+			Id:           id,
+			IssuerPermId: issuerPermID,
+			AgentPermId:  agent,
+			Expires:      expires,
+		})
+		return err
+	}
+
+	// the expiration is capped by the maximum session duration
+	maxExpires := now.AddDate(0, 0, int(params.SessionMaxDurationDays))
+	tooLate := maxExpires.Add(time.Second)
+	require.ErrorContains(t, newSession(uuid.New().String(), agentPermID, &tooLate), "expires cannot be later than")
+	require.ErrorContains(t, newSession(uuid.New().String(), agentPermID, &now), "expires must be in the future")
+
+	sessionID := uuid.New().String()
+	require.NoError(t, newSession(sessionID, agentPermID, nil))
+	session, err := k.PermissionSession.Get(sdkCtx, sessionID)
+	require.NoError(t, err)
+	require.Equal(t, maxExpires, *session.Expires)
+
+	shortExpires := now.Add(time.Hour)
+	shortSessionID := uuid.New().String()
+	require.NoError(t, newSession(shortSessionID, otherAgentPermID, &shortExpires))
+
+	// the number of authz entries per session is capped
+	_, err = ms.CreateOrUpdatePermissionSession(ctx, &types.MsgCreateOrUpdatePermissionSession{
+		Creator:      creator,
+		Id:           sessionID,
+		IssuerPermId: otherIssuerPermID,
+		AgentPermId:  agentPermID,
+	})
+	require.NoError(t, err)
+	_, err = ms.CreateOrUpdatePermissionSession(ctx, &types.MsgCreateOrUpdatePermissionSession{
+		Creator:      creator,
+		Id:           sessionID,
+		IssuerPermId: thirdIssuerPermID,
+		AgentPermId:  agentPermID,
+	})
+	require.ErrorContains(t, err, "maximum number of authorizations")
+
+	// sessions can be filtered by controller and agent perm
+	listResp, err := k.ListPermissionSessions(ctx, &types.QueryListPermissionSessionsRequest{AgentPermId: otherAgentPermID})
+	require.NoError(t, err)
+	require.Len(t, listResp.Sessions, 1)
+	require.Equal(t, shortSessionID, listResp.Sessions[0].Id)
+	listResp, err = k.ListPermissionSessions(ctx, &types.QueryListPermissionSessionsRequest{Controller: other})
+	require.NoError(t, err)
+	require.Empty(t, listResp.Sessions)
+
+	// only the controller closes a session
+	_, err = ms.ClosePermissionSession(ctx, &types.MsgClosePermissionSession{Creator: other, Id: sessionID})
+	require.ErrorContains(t, err, "only session controller")
+	_, err = ms.ClosePermissionSession(ctx, &types.MsgClosePermissionSession{Creator: creator, Id: sessionID})
+	require.NoError(t, err)
+	_, err = k.PermissionSession.Get(sdkCtx, sessionID)
+	require.Error(t, err)
+	indexed, err := k.SessionExpiryQueue.Has(sdkCtx, collections.Join(maxExpires, sessionID))
+	require.NoError(t, err)
+	require.False(t, indexed)
+
+	// expired sessions can no longer be updated and are pruned
+	sdkCtx = sdkCtx.WithBlockTime(shortExpires)
+	_, err = ms.CreateOrUpdatePermissionSession(sdkCtx, &types.MsgCreateOrUpdatePermissionSession{
+		Creator:      creator,
+		Id:           shortSessionID,
+		IssuerPermId: otherIssuerPermID,
+		AgentPermId:  otherAgentPermID,
+	})
+	require.ErrorContains(t, err, "session has expired")
+
+	require.NoError(t, k.PruneExpiredSessions(sdkCtx))
+	_, err = k.PermissionSession.Get(sdkCtx, shortSessionID)
+	require.Error(t, err)
+}
+
+func TestVerifySessionAuthorization(t *testing.T) {
+	k, ms, csKeeper, _, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	creator := sdk.AccAddress([]byte("test_creator")).String()
+
+	csKeeper.CreateMockCredentialSchema(1,
+		cstypes.CredentialSchemaPermManagementMode_GRANTOR_VALIDATION,
+		cstypes.CredentialSchemaPermManagementMode_GRANTOR_VALIDATION)
+
+	now := sdkCtx.BlockTime()
+	newPerm := func(permType types.PermissionType, validatorPermID uint64) uint64 {
+		id, err := k.CreatePermission(sdkCtx, types.Permission{
+			SchemaId:        1,
+			Type:            permType,
+			Grantee:         creator,
+			Created:         &now,
+			CreatedBy:       creator,
+			Modified:        &now,
+			Countries:       []string{"US"},
+			ValidatorPermId: validatorPermID,
+			VpState:         types.ValidationState_VALIDATION_STATE_VALIDATED,
+		})
+		require.NoError(t, err)
+		return id
+	}
+	ecosystemPermID := newPerm(types.PermissionType_PERMISSION_TYPE_ECOSYSTEM, 0)
+	issuerPermID := newPerm(types.PermissionType_PERMISSION_TYPE_ISSUER, ecosystemPermID)
+	agentPermID := newPerm(types.PermissionType_PERMISSION_TYPE_HOLDER, issuerPermID)
+	walletPermID := newPerm(types.PermissionType_PERMISSION_TYPE_HOLDER, issuerPermID)
+
+	sessionID := uuid.New().String()
+	_, err := ms.CreateOrUpdatePermissionSession(ctx, &types.MsgCreateOrUpdatePermissionSession{
+		Creator:           creator,
+		Id:                sessionID,
+		IssuerPermId:      issuerPermID,
+		AgentPermId:       agentPermID,
+		WalletAgentPermId: walletPermID,
+	})
+	require.NoError(t, err)
+
+	verify := func(ctx sdk.Context, executorPermID, agent uint64) *types.QueryVerifySessionAuthorizationResponse {
+		resp, err := k.VerifySessionAuthorization(ctx, &types.QueryVerifySessionAuthorizationRequest{
+			SessionId:      sessionID,
+			ExecutorPermId: executorPermID,
+			AgentPermId:    agent,
+		})
+		require.NoError(t, err)
+		return resp
+	}
+
+	resp := verify(sdkCtx, issuerPermID, agentPermID)
+	require.True(t, resp.Authorized, resp.Reason)
+	require.Equal(t, walletPermID, resp.Authz.WalletAgentPermId)
+	require.Len(t, resp.Permissions, 3)
+	require.Equal(t, types.SessionRoleWalletAgent, resp.Permissions[2].Role)
+
+	// the agent perm and the authz must match the session
+	resp = verify(sdkCtx, issuerPermID, walletPermID)
+	require.False(t, resp.Authorized)
+	require.Contains(t, resp.Reason, "session agent perm")
+	resp = verify(sdkCtx, ecosystemPermID, agentPermID)
+	require.False(t, resp.Authorized)
+	require.Nil(t, resp.Authz)
+
+	// an invalid perm involved in the session denies the exchange
+	_, err = ms.SuspendPermission(sdkCtx, &types.MsgSuspendPermission{Creator: creator, Id: walletPermID, Reason: "audit"})
+	require.NoError(t, err)
+	resp = verify(sdkCtx, issuerPermID, agentPermID)
+	require.False(t, resp.Authorized)
+	require.Contains(t, resp.Reason, "wallet_agent perm")
+	require.False(t, resp.Permissions[2].Valid)
+	require.True(t, resp.Permissions[0].Valid)
+
+	// expired sessions authorize nothing
+	session, err := k.PermissionSession.Get(sdkCtx, sessionID)
+	require.NoError(t, err)
+	resp = verify(sdkCtx.WithBlockTime(*session.Expires), issuerPermID, agentPermID)
+	require.False(t, resp.Authorized)
+	require.Contains(t, resp.Reason, "session expired")
+
+	_, err = k.VerifySessionAuthorization(sdkCtx, &types.QueryVerifySessionAuthorizationRequest{SessionId: sessionID, AgentPermId: agentPermID})
+	require.Error(t, err)
+}
+
+func TestQuoteFees(t *testing.T) {
+	k, csKeeper, _, tdKeeper, ctx := keepertest.PermissionKeeperWithTrustDeposit(t)
+
+	userAgentRate := math.LegacyMustNewDecFromStr("0.1")
+	walletRate := math.LegacyMustNewDecFromStr("0.05")
+	trustDepositRate := math.LegacyMustNewDecFromStr("0.2")
+	tdKeeper.UserAgentRewardRate = &userAgentRate
+	tdKeeper.WalletUserAgentRewardRate = &walletRate
+	tdKeeper.TrustDepositRate = &trustDepositRate
+
+	creator := sdk.AccAddress([]byte("test_creator")).String()
+
+	csKeeper.CreateMockCredentialSchema(1,
+		cstypes.CredentialSchemaPermManagementMode_GRANTOR_VALIDATION,
+		cstypes.CredentialSchemaPermManagementMode_GRANTOR_VALIDATION)
+
+	now := ctx.BlockTime()
+	newPerm := func(permType types.PermissionType, validatorPermID uint64, issuanceFees, validationFees uint64) uint64 {
+		id, err := k.CreatePermission(ctx, types.Permission{
+			SchemaId:        1,
+			Type:            permType,
+			Grantee:         creator,
+			Created:         &now,
+			CreatedBy:       creator,
+			Modified:        &now,
+			Countries:       []string{"US"},
+			ValidatorPermId: validatorPermID,
+			VpState:         types.ValidationState_VALIDATION_STATE_VALIDATED,
+			IssuanceFees:    issuanceFees,
+			ValidationFees:  validationFees,
+		})
+		require.NoError(t, err)
+		return id
+	}
+	ecosystemPermID := newPerm(types.PermissionType_PERMISSION_TYPE_ECOSYSTEM, 0, 1000, 50)
+	grantorPermID := newPerm(types.PermissionType_PERMISSION_TYPE_ISSUER_GRANTOR, ecosystemPermID, 500, 0)
+	issuerPermID := newPerm(types.PermissionType_PERMISSION_TYPE_ISSUER, grantorPermID, 0, 0)
+	agentPermID := newPerm(types.PermissionType_PERMISSION_TYPE_HOLDER, issuerPermID, 0, 0)
+	walletPermID := newPerm(types.PermissionType_PERMISSION_TYPE_HOLDER, issuerPermID, 0, 0)
+
+	resp, err := k.QuoteSessionFees(ctx, &types.QueryQuoteSessionFeesRequest{
+		IssuerPermId:      issuerPermID,
+		AgentPermId:       agentPermID,
+		WalletAgentPermId: walletPermID,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Beneficiaries, 2)
+	require.Equal(t, grantorPermID, resp.Beneficiaries[0].PermId)
+	require.Equal(t, uint64(500), resp.Beneficiaries[0].FeesInDenom)
+	require.Equal(t, uint64(400), resp.Beneficiaries[0].DirectFees)
+	require.Equal(t, uint64(100), resp.Beneficiaries[0].TrustDeposit)
+	require.Equal(t, uint64(100), resp.Beneficiaries[0].CreatorTrustDeposit)
+	require.Equal(t, ecosystemPermID, resp.Beneficiaries[1].PermId)
+	require.Equal(t, uint64(150), resp.UserAgentReward)
+	require.Equal(t, uint64(75), resp.WalletAgentReward)
+	// fees 1500, creator trust deposit 300, rewards 225
+	require.Equal(t, uint64(2025), resp.Total)
+
+	// the quote matches the fees charged to the signer of the session
+	msgQuote, err := k.QuoteMsgFees(ctx, &types.MsgCreateOrUpdatePermissionSession{
+		Creator:           creator,
+		Id:                uuid.New().String(),
+		IssuerPermId:      issuerPermID,
+		AgentPermId:       agentPermID,
+		WalletAgentPermId: walletPermID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(resp.Total), msgQuote.AmountOf(types.BondDenom).Int64())
+
+	_, err = k.QuoteSessionFees(ctx, &types.QueryQuoteSessionFeesRequest{AgentPermId: agentPermID})
+	require.Error(t, err)
+
+	validationResp, err := k.QuoteValidationFees(ctx, &types.QueryQuoteValidationFeesRequest{ValidatorPermId: ecosystemPermID})
+	require.NoError(t, err)
+	require.Equal(t, uint64(50), validationResp.FeesInDenom)
+	require.Equal(t, uint64(10), validationResp.TrustDeposit)
+	require.Equal(t, uint64(60), validationResp.Total)
+
+	_, err = k.QuoteValidationFees(ctx, &types.QueryQuoteValidationFeesRequest{ValidatorPermId: 99})
+	require.Error(t, err)
+}
+
+func TestVerificationCredits(t *testing.T) {
+	k, csKeeper, _, tdKeeper, ctx := keepertest.PermissionKeeperWithTrustDeposit(t)
+	ms := keeper.NewMsgServerImpl(k)
+
+	userAgentRate := math.LegacyMustNewDecFromStr("0.1")
+	trustDepositRate := math.LegacyMustNewDecFromStr("0.2")
+	tdKeeper.UserAgentRewardRate = &userAgentRate
+	tdKeeper.TrustDepositRate = &trustDepositRate
+
+	ecosystemAddr := sdk.AccAddress([]byte("test_ecosystem")).String()
+	grantorAddr := sdk.AccAddress([]byte("test_grantor")).String()
+	buyer := sdk.AccAddress([]byte("test_buyer")).String()
+	other := sdk.AccAddress([]byte("test_other")).String()
+
+	csKeeper.CreateMockCredentialSchema(1,
+		cstypes.CredentialSchemaPermManagementMode_GRANTOR_VALIDATION,
+		cstypes.CredentialSchemaPermManagementMode_GRANTOR_VALIDATION)
+
+	now := ctx.BlockTime()
+	newPerm := func(permType types.PermissionType, grantee string, validatorPermID uint64, verificationFees uint64) uint64 {
+		id, err := k.CreatePermission(ctx, types.Permission{
+			SchemaId:         1,
+			Type:             permType,
+			Grantee:          grantee,
+			Created:          &now,
+			CreatedBy:        grantee,
+			Modified:         &now,
+			Countries:        []string{"US"},
+			ValidatorPermId:  validatorPermID,
+			VpState:          types.ValidationState_VALIDATION_STATE_VALIDATED,
+			VerificationFees: verificationFees,
+		})
+		require.NoError(t, err)
+		return id
+	}
+	ecosystemPermID := newPerm(types.PermissionType_PERMISSION_TYPE_ECOSYSTEM, ecosystemAddr, 0, 1000)
+	grantorPermID := newPerm(types.PermissionType_PERMISSION_TYPE_VERIFIER_GRANTOR, grantorAddr, ecosystemPermID, 500)
+	verifierPermID := newPerm(types.PermissionType_PERMISSION_TYPE_VERIFIER, buyer, grantorPermID, 0)
+	agentPermID := newPerm(types.PermissionType_PERMISSION_TYPE_HOLDER, other, verifierPermID, 0)
+
+	purchase := &types.MsgPurchaseVerificationCredits{
+		Creator:        buyer,
+		VerifierPermId: verifierPermID,
+		Count:          3,
+	}
+
+	// fees 1500 and creator trust deposit 300 per credit
+	quote, err := k.QuoteMsgFees(ctx, purchase)
+	require.NoError(t, err)
+	require.Equal(t, int64(5400), quote.AmountOf(types.BondDenom).Int64())
+
+	resp, err := ms.PurchaseVerificationCredits(ctx, purchase)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), resp.Id)
+	require.Equal(t, uint64(5400), resp.Cost)
+	require.Equal(t, uint64(4500), resp.Fees)
+	require.Equal(t, uint64(900), resp.TrustDeposit)
+	require.Equal(t, int64(900), tdKeeper.Adjustments[buyer])
+
+	creditResp, err := k.GetVerificationCredit(ctx, &types.QueryGetVerificationCreditRequest{Id: resp.Id})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), creditResp.Credit.Remaining)
+	require.Equal(t, uint64(1500), creditResp.Credit.UnitFees)
+	require.Equal(t, uint64(300), creditResp.Credit.UnitTrustDeposit)
+
+	_, err = ms.PurchaseVerificationCredits(ctx, &types.MsgPurchaseVerificationCredits{
+		Creator:        buyer,
+		VerifierPermId: agentPermID,
+		Count:          1,
+	})
+	require.Error(t, err)
+
+	// a cost that does not fit in a coin amount is rejected, not wrapped
+	overflow := &types.MsgPurchaseVerificationCredits{
+		Creator:        buyer,
+		VerifierPermId: verifierPermID,
+		Count:          1 << 62,
+	}
+	_, err = k.QuoteMsgFees(ctx, overflow)
+	require.ErrorContains(t, err, "overflows")
+	_, err = ms.PurchaseVerificationCredits(ctx, overflow)
+	require.ErrorContains(t, err, "overflows")
+
+	session := func(creator string) *types.MsgCreateOrUpdatePermissionSession {
+		return &types.MsgCreateOrUpdatePermissionSession{
+			Creator:        creator,
+			Id:             uuid.New().String(),
+			VerifierPermId: verifierPermID,
+			AgentPermId:    agentPermID,
+			CreditId:       resp.Id,
+		}
+	}
+
+	// a session with a credit only pays the agent reward
+	msg := session(buyer)
+	quote, err = k.QuoteMsgFees(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, int64(150), quote.AmountOf(types.BondDenom).Int64())
+
+	_, err = ms.CreateOrUpdatePermissionSession(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, int64(900), tdKeeper.Adjustments[buyer])
+
+	creditResp, err = k.GetVerificationCredit(ctx, &types.QueryGetVerificationCreditRequest{Id: resp.Id})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), creditResp.Credit.Remaining)
+
+	// credits can only be consumed by their buyer
+	_, err = ms.CreateOrUpdatePermissionSession(ctx, session(other))
+	require.Error(t, err)
+
+	listResp, err := k.ListVerificationCredits(ctx, &types.QueryListVerificationCreditsRequest{Buyer: buyer, WithRemaining: true})
+	require.NoError(t, err)
+	require.Len(t, listResp.Credits, 1)
+
+	// refunding releases the trust deposit of the unused credits
+	_, err = ms.RefundVerificationCredits(ctx, &types.MsgRefundVerificationCredits{Creator: other, Id: resp.Id})
+	require.Error(t, err)
+
+	executorPerm, err := k.GetPermissionByID(ctx, creditResp.Credit.ExecutorPermId)
+	require.NoError(t, err)
+	deposit := executorPerm.Deposit
+
+	refundResp, err := ms.RefundVerificationCredits(ctx, &types.MsgRefundVerificationCredits{Creator: buyer, Id: resp.Id})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), refundResp.RefundedCredits)
+	require.Equal(t, uint64(600), refundResp.ReleasedTrustDeposit)
+	require.Equal(t, int64(300), tdKeeper.Adjustments[buyer])
+
+	executorPerm, err = k.GetPermissionByID(ctx, creditResp.Credit.ExecutorPermId)
+	require.NoError(t, err)
+	require.Equal(t, deposit-600, executorPerm.Deposit)
+
+	// refunded credits can no longer be consumed or refunded
+	_, err = ms.CreateOrUpdatePermissionSession(ctx, session(buyer))
+	require.Error(t, err)
+	_, err = ms.RefundVerificationCredits(ctx, &types.MsgRefundVerificationCredits{Creator: buyer, Id: resp.Id})
+	require.Error(t, err)
+
+	listResp, err = k.ListVerificationCredits(ctx, &types.QueryListVerificationCreditsRequest{Buyer: buyer, WithRemaining: true})
+	require.NoError(t, err)
+	require.Empty(t, listResp.Credits)
+}
+
+func TestSettleSessionVouchers(t *testing.T) {
+	k, csKeeper, _, tdKeeper, ctx := keepertest.PermissionKeeperWithTrustDeposit(t)
+	ms := keeper.NewMsgServerImpl(k)
+	ctx = ctx.WithChainID("test-chain")
+
+	userAgentRate := math.LegacyMustNewDecFromStr("0.1")
+	trustDepositRate := math.LegacyMustNewDecFromStr("0.2")
+	tdKeeper.UserAgentRewardRate = &userAgentRate
+	tdKeeper.TrustDepositRate = &trustDepositRate
+
+	signerKey := secp256k1.GenPrivKey()
+	otherKey := secp256k1.GenPrivKey()
+	signer := sdk.AccAddress(signerKey.PubKey().Address()).String()
+	aggregator := sdk.AccAddress([]byte("test_aggregator")).String()
+	ecosystemAddr := sdk.AccAddress([]byte("test_ecosystem")).String()
+	grantorAddr := sdk.AccAddress([]byte("test_grantor")).String()
+	agentAddr := sdk.AccAddress([]byte("test_agent")).String()
+
+	csKeeper.CreateMockCredentialSchema(1,
+		cstypes.CredentialSchemaPermManagementMode_GRANTOR_VALIDATION,
+		cstypes.CredentialSchemaPermManagementMode_GRANTOR_VALIDATION)
+
+	now := ctx.BlockTime()
+	newPerm := func(permType types.PermissionType, grantee string, validatorPermID uint64, verificationFees uint64) uint64 {
+		id, err := k.CreatePermission(ctx, types.Permission{
+			SchemaId:         1,
+			Type:             permType,
+			Grantee:          grantee,
+			Created:          &now,
+			CreatedBy:        grantee,
+			Modified:         &now,
+			Countries:        []string{"US"},
+			ValidatorPermId:  validatorPermID,
+			VpState:          types.ValidationState_VALIDATION_STATE_VALIDATED,
+			VerificationFees: verificationFees,
+		})
+		require.NoError(t, err)
+		return id
+	}
+	ecosystemPermID := newPerm(types.PermissionType_PERMISSION_TYPE_ECOSYSTEM, ecosystemAddr, 0, 1000)
+	grantorPermID := newPerm(types.PermissionType_PERMISSION_TYPE_VERIFIER_GRANTOR, grantorAddr, ecosystemPermID, 500)
+	verifierPermID := newPerm(types.PermissionType_PERMISSION_TYPE_VERIFIER, signer, grantorPermID, 0)
+	agentPermID := newPerm(types.PermissionType_PERMISSION_TYPE_HOLDER, agentAddr, verifierPermID, 0)
+	otherAgentPermID := newPerm(types.PermissionType_PERMISSION_TYPE_HOLDER, agentAddr, verifierPermID, 0)
+
+	sign := func(key *secp256k1.PrivKey, voucher types.SessionVoucher) types.SignedSessionVoucher {
+		signBytes, err := voucher.SignBytes(ctx.ChainID())
+		require.NoError(t, err)
+		sig, err := key.Sign(signBytes)
+		require.NoError(t, err)
+		return types.SignedSessionVoucher{
+			Voucher:   voucher,
+			PubKey:    key.PubKey().Bytes(),
+			Signature: sig,
+		}
+	}
+	voucher := func(nonce, agentPermID, amount uint64) types.SessionVoucher {
+		return types.SessionVoucher{
+			Signer:            signer,
+			BeneficiaryPermId: verifierPermID,
+			AgentPermId:       agentPermID,
+			Nonce:             nonce,
+			Amount:            amount,
+		}
+	}
+
+	// fees 1500, creator trust deposit 300 and user agent reward 150 per session
+	resp, err := ms.SettleSessionVouchers(ctx, &types.MsgSettleSessionVouchers{
+		Creator: aggregator,
+		Vouchers: []types.SignedSessionVoucher{
+			sign(signerKey, voucher(1, agentPermID, 2000)),
+			sign(signerKey, voucher(2, agentPermID, 2000)),
+			sign(signerKey, voucher(3, otherAgentPermID, 2000)),
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), resp.Settled)
+	require.Equal(t, uint64(5850), resp.Total)
+	require.Equal(t, int64(900), tdKeeper.Adjustments[signer])
+
+	rewards, err := k.GetAgentRewards(ctx, &types.QueryGetAgentRewardsRequest{PermId: agentPermID})
+	require.NoError(t, err)
+	require.Equal(t, uint64(300), rewards.AgentReward.UserAgentRewards)
+	rewards, err = k.GetAgentRewards(ctx, &types.QueryGetAgentRewardsRequest{PermId: otherAgentPermID})
+	require.NoError(t, err)
+	require.Equal(t, uint64(150), rewards.AgentReward.UserAgentRewards)
+
+	nonceResp, err := k.GetVoucherNonce(ctx, &types.QueryGetVoucherNonceRequest{Signer: signer})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), nonceResp.Nonce)
+
+	tampered := sign(signerKey, voucher(5, agentPermID, 2000))
+	tampered.Voucher.Amount = 3000
+
+	for _, tc := range []struct {
+		desc     string
+		vouchers []types.SignedSessionVoucher
+		err      string
+	}{
+		{
+			desc:     "replayed nonce",
+			vouchers: []types.SignedSessionVoucher{sign(signerKey, voucher(3, agentPermID, 2000))},
+			err:      "nonce 3 already used",
+		},
+		{
+			desc:     "nonce repeated within the batch",
+			vouchers: []types.SignedSessionVoucher{sign(signerKey, voucher(5, agentPermID, 2000)), sign(signerKey, voucher(5, agentPermID, 2000))},
+			err:      "nonce 5 already used",
+		},
+		{
+			desc:     "key of another account",
+			vouchers: []types.SignedSessionVoucher{sign(otherKey, voucher(4, agentPermID, 2000))},
+			err:      "public key does not match the voucher signer",
+		},
+		{
+			desc:     "voucher modified after signing",
+			vouchers: []types.SignedSessionVoucher{tampered},
+			err:      "invalid voucher signature",
+		},
+		{
+			desc:     "amount below the session cost",
+			vouchers: []types.SignedSessionVoucher{sign(signerKey, voucher(4, agentPermID, 1000))},
+			err:      "session costs 1950, more than the authorized amount 1000",
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := &types.MsgSettleSessionVouchers{Creator: aggregator, Vouchers: tc.vouchers}
+			require.NoError(t, msg.ValidateBasic())
+			_, err := ms.SettleSessionVouchers(ctx, msg)
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+
+	nonceResp, err = k.GetVoucherNonce(ctx, &types.QueryGetVoucherNonceRequest{Signer: signer})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), nonceResp.Nonce)
+
+	// a later voucher does not void the earlier ones of the window
+	settle := func(nonce uint64) error {
+		_, err := ms.SettleSessionVouchers(ctx, &types.MsgSettleSessionVouchers{
+			Creator:  aggregator,
+			Vouchers: []types.SignedSessionVoucher{sign(signerKey, voucher(nonce, agentPermID, 2000))},
+		})
+		return err
+	}
+	require.NoError(t, settle(6))
+	require.NoError(t, settle(4))
+	require.ErrorContains(t, settle(4), "nonce 4 already used")
+	nonceResp, err = k.GetVoucherNonce(ctx, &types.QueryGetVoucherNonceRequest{Signer: signer})
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), nonceResp.Nonce)
+	require.Equal(t, []uint64{1, 2, 3, 4, 6}, nonceResp.UsedNonces)
+
+	// nonces out of the window are rejected, and forgotten
+	highest := uint64(types.VoucherNonceWindow + 5)
+	require.NoError(t, settle(highest))
+	require.ErrorContains(t, settle(5), "nonce 5 is too old")
+	require.NoError(t, settle(6+1))
+	nonceResp, err = k.GetVoucherNonce(ctx, &types.QueryGetVoucherNonceRequest{Signer: signer})
+	require.NoError(t, err)
+	require.Equal(t, highest, nonceResp.Nonce)
+	require.Equal(t, []uint64{6, 7, highest}, nonceResp.UsedNonces)
+}
+
+func TestPermissionCountryScopes(t *testing.T) {
+	k, ms, csKeeper, trkKeeper, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	creator := sdk.AccAddress([]byte("test_creator")).String()
+	validDid := "did:example:123456789abcdefghi"
+	trID := trkKeeper.CreateMockTrustRegistry(creator, validDid)
+	csKeeper.UpdateMockCredentialSchema(1, trID,
+		cstypes.CredentialSchemaPermManagementMode_GRANTOR_VALIDATION,
+		cstypes.CredentialSchemaPermManagementMode_GRANTOR_VALIDATION)
+	csKeeper.UpdateMockCredentialSchema(2, trID,
+		cstypes.CredentialSchemaPermManagementMode_OPEN,
+		cstypes.CredentialSchemaPermManagementMode_OPEN)
+
+	now := time.Now()
+	validatorPermID, err := k.CreatePermission(sdkCtx, types.Permission{
+		SchemaId:  1,
+		Type:      types.PermissionType_PERMISSION_TYPE_ISSUER_GRANTOR,
+		Grantee:   creator,
+		Created:   &now,
+		CreatedBy: creator,
+		Modified:  &now,
+		Countries: []string{"US", "FR-75"},
+		VpState:   types.ValidationState_VALIDATION_STATE_VALIDATED,
+	})
+	require.NoError(t, err)
+
+	// the applicant scope must be within the validator perm scope
+	for _, countries := range [][]string{{"US"}, {"US-CA", "FR-75"}} {
+		_, err := ms.StartPermissionVP(ctx, &types.MsgStartPermissionVP{
+			Creator:         creator,
+			Type:            uint32(types.PermissionType_PERMISSION_TYPE_ISSUER),
+			ValidatorPermId: validatorPermID,
+			Countries:       countries,
+			Did:             validDid,
+		})
+		require.NoError(t, err, countries)
+	}
+	for _, countries := range [][]string{nil, {"FR"}, {"US", "DE"}} {
+		_, err := ms.StartPermissionVP(ctx, &types.MsgStartPermissionVP{
+			Creator:         creator,
+			Type:            uint32(types.PermissionType_PERMISSION_TYPE_ISSUER),
+			ValidatorPermId: validatorPermID,
+			Countries:       countries,
+			Did:             validDid,
+		})
+		require.ErrorContains(t, err, "country mismatch", countries)
+	}
+
+	// a created perm must be within the ecosystem perm scope
+	_, err = k.CreatePermission(sdkCtx, types.Permission{
+		SchemaId:  2,
+		Type:      types.PermissionType_PERMISSION_TYPE_ECOSYSTEM,
+		Grantee:   creator,
+		Created:   &now,
+		CreatedBy: creator,
+		Modified:  &now,
+		Countries: []string{"DE"},
+		VpState:   types.ValidationState_VALIDATION_STATE_VALIDATED,
+	})
+	require.NoError(t, err)
+	createResp, err := ms.CreatePermission(ctx, &types.MsgCreatePermission{
+		Creator:   creator,
+		SchemaId:  2,
+		Type:      types.PermissionType_PERMISSION_TYPE_VERIFIER,
+		Did:       validDid,
+		Countries: []string{"DE-BY"},
+	})
+	require.NoError(t, err)
+	_, err = ms.CreatePermission(ctx, &types.MsgCreatePermission{
+		Creator:   creator,
+		SchemaId:  2,
+		Type:      types.PermissionType_PERMISSION_TYPE_VERIFIER,
+		Did:       validDid,
+		Countries: []string{"DE", "AT"},
+	})
+	require.ErrorContains(t, err, "not within ecosystem perm scope")
+
+	listIDs := func(req *types.QueryListPermissionsByCountryRequest) []uint64 {
+		resp, err := k.ListPermissionsByCountry(ctx, req)
+		require.NoError(t, err)
+		var ids []uint64
+		for _, perm := range resp.Permissions {
+			ids = append(ids, perm.Id)
+		}
+		return ids
+	}
+
+	// a subdivision is covered by the perms of its country
+	require.Equal(t, []uint64{validatorPermID, 2, 3}, listIDs(&types.QueryListPermissionsByCountryRequest{Country: "US-CA"}))
+	require.Equal(t, []uint64{validatorPermID, 2}, listIDs(&types.QueryListPermissionsByCountryRequest{Country: "US"}))
+	require.Equal(t, []uint64{2}, listIDs(&types.QueryListPermissionsByCountryRequest{Country: "US", Type: types.PermissionType_PERMISSION_TYPE_ISSUER}))
+	require.Equal(t, []uint64{createResp.Id}, listIDs(&types.QueryListPermissionsByCountryRequest{Country: "DE-BY", SchemaId: 2, Type: types.PermissionType_PERMISSION_TYPE_VERIFIER}))
+	require.Empty(t, listIDs(&types.QueryListPermissionsByCountryRequest{Country: "FR"}))
+
+	_, err = k.ListPermissionsByCountry(ctx, &types.QueryListPermissionsByCountryRequest{Country: "XX"})
+	require.Error(t, err)
+
+	// the index follows scope updates
+	perm, err := k.GetPermissionByID(sdkCtx, createResp.Id)
+	require.NoError(t, err)
+	perm.Countries = []string{"DE-HE"}
+	require.NoError(t, k.UpdatePermission(sdkCtx, perm))
+	require.Empty(t, listIDs(&types.QueryListPermissionsByCountryRequest{Country: "DE-BY", Type: types.PermissionType_PERMISSION_TYPE_VERIFIER}))
+	require.Equal(t, []uint64{createResp.Id}, listIDs(&types.QueryListPermissionsByCountryRequest{Country: "DE-HE", Type: types.PermissionType_PERMISSION_TYPE_VERIFIER}))
+}
+
+func TestAnchorVPMessage(t *testing.T) {
+	k, ms, csKeeper, trkKeeper, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	validator := sdk.AccAddress([]byte("test_validator")).String()
+	applicant := sdk.AccAddress([]byte("test_applicant")).String()
+	other := sdk.AccAddress([]byte("test_other")).String()
+	validDid := "did:example:123456789abcdefghi"
+	trID := trkKeeper.CreateMockTrustRegistry(validator, validDid)
+	csKeeper.UpdateMockCredentialSchema(1, trID,
+		cstypes.CredentialSchemaPermManagementMode_GRANTOR_VALIDATION,
+		cstypes.CredentialSchemaPermManagementMode_GRANTOR_VALIDATION)
+
+	now := time.Now()
+	validatorPermID, err := k.CreatePermission(sdkCtx, types.Permission{
+		SchemaId:  1,
+		Type:      types.PermissionType_PERMISSION_TYPE_ECOSYSTEM,
+		Grantee:   validator,
+		Created:   &now,
+		CreatedBy: validator,
+		Modified:  &now,
+		VpState:   types.ValidationState_VALIDATION_STATE_VALIDATED,
+	})
+	require.NoError(t, err)
+	applicantPermID, err := k.CreatePermission(sdkCtx, types.Permission{
+		SchemaId:        1,
+		Type:            types.PermissionType_PERMISSION_TYPE_ISSUER_GRANTOR,
+		Grantee:         applicant,
+		Created:         &now,
+		CreatedBy:       applicant,
+		Modified:        &now,
+		ValidatorPermId: validatorPermID,
+		VpState:         types.ValidationState_VALIDATION_STATE_PENDING,
+	})
+	require.NoError(t, err)
+
+	anchor := func(creator string, permID uint64) (*types.MsgAnchorVPMessageResponse, error) {
+		return ms.AnchorVPMessage(ctx, &types.MsgAnchorVPMessage{
+			Creator:      creator,
+			PermId:       permID,
+			DigestSri:    "sha384-MzNNbQTWCSUSi0bbz7dbua+RcENv7C6FvlmYJ1Y+I727HsPOHdzwELMYO9Mz68M26",
+			EncryptedUri: "https://example.com/vp/messages/1.jwe",
+		})
+	}
+
+	// the applicant and the validator anchor messages in order
+	resp, err := anchor(applicant, applicantPermID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), resp.Index)
+	resp, err = anchor(validator, applicantPermID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), resp.Index)
+
+	// other accounts cannot anchor messages
+	_, err = anchor(other, applicantPermID)
+	require.ErrorContains(t, err, "only the applicant or the validator grantee")
+
+	// a root perm has no validation process
+	_, err = anchor(validator, validatorPermID)
+	require.ErrorContains(t, err, "no validation process")
+
+	listResp, err := k.ListVPMessages(ctx, &types.QueryListVPMessagesRequest{PermId: applicantPermID})
+	require.NoError(t, err)
+	require.Len(t, listResp.Messages, 2)
+	require.Equal(t, applicant, listResp.Messages[0].Sender)
+	require.Equal(t, validator, listResp.Messages[1].Sender)
+
+	listResp, err = k.ListVPMessages(ctx, &types.QueryListVPMessagesRequest{PermId: applicantPermID, AfterIndex: 1})
+	require.NoError(t, err)
+	require.Len(t, listResp.Messages, 1)
+	require.Equal(t, uint64(2), listResp.Messages[0].Index)
+
+	// messages cannot be anchored once the validation process is terminated
+	applicantPerm, err := k.GetPermissionByID(sdkCtx, applicantPermID)
+	require.NoError(t, err)
+	applicantPerm.VpState = types.ValidationState_VALIDATION_STATE_TERMINATED
+	require.NoError(t, k.UpdatePermission(sdkCtx, applicantPerm))
+	_, err = anchor(applicant, applicantPermID)
+	require.ErrorContains(t, err, "terminated")
+}
+
+func TestQuorumValidationProcess(t *testing.T) {
+	k, csKeeper, trkKeeper, tdKeeper, ctx := keepertest.PermissionKeeperWithTrustDeposit(t)
+	ms := keeper.NewMsgServerImpl(k)
+
+	trustDepositRate := math.LegacyMustNewDecFromStr("0.2")
+	tdKeeper.TrustDepositRate = &trustDepositRate
+
+	applicant := sdk.AccAddress([]byte("test_applicant")).String()
+	other := sdk.AccAddress([]byte("test_other")).String()
+	grantors := []string{
+		sdk.AccAddress([]byte("test_grantor_1")).String(),
+		sdk.AccAddress([]byte("test_grantor_2")).String(),
+		sdk.AccAddress([]byte("test_grantor_3")).String(),
+	}
+	trID := trkKeeper.CreateMockTrustRegistry(grantors[0], "did:example:123456789abcdefghi")
+	csKeeper.UpdateMockCredentialSchema(1, trID,
+		cstypes.CredentialSchemaPermManagementMode_GRANTOR_VALIDATION,
+		cstypes.CredentialSchemaPermManagementMode_GRANTOR_VALIDATION)
+	csKeeper.SetMockValidationQuorums(1, 2, 0)
+
+	now := ctx.BlockTime()
+	grantorPermIDs := make([]uint64, len(grantors))
+	for i, grantor := range grantors {
+		id, err := k.CreatePermission(ctx, types.Permission{
+			SchemaId:       1,
+			Type:           types.PermissionType_PERMISSION_TYPE_ISSUER_GRANTOR,
+			Grantee:        grantor,
+			Created:        &now,
+			CreatedBy:      grantor,
+			Modified:       &now,
+			Countries:      []string{"US"},
+			ValidationFees: 11,
+			VpState:        types.ValidationState_VALIDATION_STATE_VALIDATED,
+		})
+		require.NoError(t, err)
+		grantorPermIDs[i] = id
+	}
+
+	startMsg := &types.MsgStartPermissionVP{
+		Creator:         applicant,
+		Type:            uint32(types.PermissionType_PERMISSION_TYPE_ISSUER),
+		ValidatorPermId: grantorPermIDs[0],
+		Countries:       []string{"US"},
+	}
+
+	// the quorum of the schema cannot be reached by the validator perm alone
+	_, err := ms.StartPermissionVP(ctx, startMsg)
+	require.ErrorContains(t, err, "requires at least 1 co-validator perms")
+
+	startMsg.CoValidatorPermIds = grantorPermIDs[1:]
+	startResp, err := ms.StartPermissionVP(ctx, startMsg)
+	require.NoError(t, err)
+	permID := startResp.PermissionId
+
+	perm, err := k.GetPermissionByID(ctx, permID)
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), perm.VpQuorum)
+	require.Equal(t, grantorPermIDs[1:], perm.CoValidatorPermIds)
+	require.Equal(t, uint64(11), perm.VpCurrentFees)
+
+	validate := func(creator string, validationFees uint64) error {
+		_, err := ms.SetPermissionVPToValidated(ctx, &types.MsgSetPermissionVPToValidated{
+			Creator:            creator,
+			Id:                 permID,
+			ValidationFees:     validationFees,
+			IssuanceFees:       5,
+			VerificationFees:   3,
+			Countries:          []string{"US"},
+			VpSummaryDigestSri: "sha384-validDigest",
+		})
+		return err
+	}
+
+	require.ErrorContains(t, validate(other, 10), "grantee of a validator perm")
+
+	// a suspended co-validator cannot approve
+	suspended, err := k.GetPermissionByID(ctx, grantorPermIDs[2])
+	require.NoError(t, err)
+	suspended.Suspended = &now
+	require.NoError(t, k.UpdatePermission(ctx, suspended))
+	require.ErrorContains(t, validate(grantors[2], 10), "suspended")
+	suspended.Suspended = nil
+	require.NoError(t, k.UpdatePermission(ctx, suspended))
+	perm, err = k.GetPermissionByID(ctx, permID)
+	require.NoError(t, err)
+	require.Empty(t, perm.VpApprovals)
+
+	// a first approval leaves the perm pending
+	require.NoError(t, validate(grantors[1], 10))
+	perm, err = k.GetPermissionByID(ctx, permID)
+	require.NoError(t, err)
+	require.Equal(t, types.ValidationState_VALIDATION_STATE_PENDING, perm.VpState)
+	require.Len(t, perm.VpApprovals, 1)
+	require.Equal(t, grantorPermIDs[1], perm.VpApprovals[0].ValidatorPermId)
+
+	require.ErrorContains(t, validate(grantors[1], 10), "already approved")
+	require.ErrorContains(t, validate(grantors[2], 20), "approval terms differ")
+
+	// the second approval reaches the quorum, the fees are split among the approvers
+	require.NoError(t, validate(grantors[0], 10))
+	perm, err = k.GetPermissionByID(ctx, permID)
+	require.NoError(t, err)
+	require.Equal(t, types.ValidationState_VALIDATION_STATE_VALIDATED, perm.VpState)
+	require.Equal(t, uint64(10), perm.ValidationFees)
+	require.Empty(t, perm.VpApprovals)
+	require.Zero(t, perm.VpCurrentFees)
+	require.Equal(t, uint64(1), perm.VpValidatorDeposit)
+	require.Equal(t, []types.CoValidatorDeposit{{ValidatorPermId: grantorPermIDs[1], Deposit: 1}}, perm.VpCoValidatorDeposits)
+	require.Equal(t, int64(1), tdKeeper.Adjustments[grantors[0]])
+	require.Equal(t, int64(1), tdKeeper.Adjustments[grantors[1]])
+	require.Zero(t, tdKeeper.Adjustments[grantors[2]])
+
+	// terminating the perm releases the deposits of all its validators
+	_, err = ms.RequestPermissionVPTermination(ctx, &types.MsgRequestPermissionVPTermination{Creator: applicant, Id: permID})
+	require.NoError(t, err)
+	perm, err = k.GetPermissionByID(ctx, permID)
+	require.NoError(t, err)
+	require.Empty(t, perm.VpCoValidatorDeposits)
+	require.Zero(t, tdKeeper.Adjustments[grantors[0]])
+	require.Zero(t, tdKeeper.Adjustments[grantors[1]])
+
+	// co-validators are rejected when the schema sets no quorum
+	csKeeper.SetMockValidationQuorums(1, 0, 0)
+	_, err = ms.StartPermissionVP(ctx, startMsg)
+	require.ErrorContains(t, err, "does not set a validation quorum")
+}
+
+func TestUpdatePermissionFees(t *testing.T) {
+	k, ms, csKeeper, _, ctx := setupMsgServer(t)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockTime(now)
+
+	grantee := sdk.AccAddress([]byte("test_grantee")).String()
+	other := sdk.AccAddress([]byte("test_other")).String()
+	csKeeper.CreateMockCredentialSchema(1,
+		cstypes.CredentialSchemaPermManagementMode_GRANTOR_VALIDATION,
+		cstypes.CredentialSchemaPermManagementMode_GRANTOR_VALIDATION)
+
+	permID, err := k.CreatePermission(sdkCtx, types.Permission{
+		SchemaId:         1,
+		Type:             types.PermissionType_PERMISSION_TYPE_ECOSYSTEM,
+		Grantee:          grantee,
+		Created:          &now,
+		CreatedBy:        grantee,
+		Modified:         &now,
+		EffectiveFrom:    &now,
+		ValidationFees:   10,
+		IssuanceFees:     5,
+		VerificationFees: 3,
+	})
+	require.NoError(t, err)
+	pendingPermID, err := k.CreatePermission(sdkCtx, types.Permission{
+		SchemaId:        1,
+		Type:            types.PermissionType_PERMISSION_TYPE_ISSUER_GRANTOR,
+		Grantee:         grantee,
+		Created:         &now,
+		CreatedBy:       grantee,
+		Modified:        &now,
+		ValidatorPermId: permID,
+		VpState:         types.ValidationState_VALIDATION_STATE_PENDING,
+	})
+	require.NoError(t, err)
+
+	update := func(creator string, id uint64, validationFees uint64) (*types.MsgUpdatePermissionFeesResponse, error) {
+		return ms.UpdatePermissionFees(sdkCtx, &types.MsgUpdatePermissionFees{
+			Creator:          creator,
+			Id:               id,
+			ValidationFees:   validationFees,
+			IssuanceFees:     6,
+			VerificationFees: 4,
+		})
+	}
+
+	_, err = update(other, permID, 20)
+	require.ErrorContains(t, err, "only the grantee")
+	_, err = update(grantee, pendingPermID, 20)
+	require.ErrorContains(t, err, "has not been validated")
+
+	// a second update replaces the pending one
+	_, err = update(grantee, permID, 20)
+	require.NoError(t, err)
+	sdkCtx = sdkCtx.WithBlockTime(now.Add(time.Hour))
+	resp, err := update(grantee, permID, 30)
+	require.NoError(t, err)
+	effectiveFrom := now.Add(time.Hour).AddDate(0, 0, int(types.DefaultFeeUpdateNoticePeriodDays))
+	require.Equal(t, effectiveFrom, *resp.EffectiveFrom)
+
+	feesResp, err := k.GetPermissionFees(sdkCtx, &types.QueryGetPermissionFeesRequest{Id: permID})
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), feesResp.Current.ValidationFees)
+	require.NotNil(t, feesResp.Scheduled)
+	require.Equal(t, uint64(30), feesResp.Scheduled.ValidationFees)
+	require.Empty(t, feesResp.History)
+
+	// the fees do not change before the end of the notice period
+	require.NoError(t, k.ApplyScheduledFeeUpdates(sdkCtx.WithBlockTime(effectiveFrom.Add(-time.Second))))
+	perm, err := k.GetPermissionByID(sdkCtx, permID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), perm.ValidationFees)
+
+	sdkCtx = sdkCtx.WithBlockTime(effectiveFrom)
+	require.NoError(t, k.ApplyScheduledFeeUpdates(sdkCtx))
+	perm, err = k.GetPermissionByID(sdkCtx, permID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(30), perm.ValidationFees)
+	require.Equal(t, uint64(6), perm.IssuanceFees)
+	require.Equal(t, uint64(4), perm.VerificationFees)
+	require.Nil(t, perm.ScheduledFees)
+
+	// the history holds the original fees and the update
+	feesResp, err = k.GetPermissionFees(sdkCtx, &types.QueryGetPermissionFeesRequest{Id: permID})
+	require.NoError(t, err)
+	require.Nil(t, feesResp.Scheduled)
+	require.Equal(t, uint64(30), feesResp.Current.ValidationFees)
+	require.Equal(t, effectiveFrom, *feesResp.Current.EffectiveFrom)
+	require.Len(t, feesResp.History, 2)
+	require.Equal(t, uint64(10), feesResp.History[0].Fees.ValidationFees)
+	require.Equal(t, now, *feesResp.History[0].Fees.EffectiveFrom)
+	require.Empty(t, feesResp.History[0].UpdatedBy)
+	require.Equal(t, uint64(30), feesResp.History[1].Fees.ValidationFees)
+	require.Equal(t, grantee, feesResp.History[1].UpdatedBy)
+
+	feesResp, err = k.GetPermissionFees(sdkCtx, &types.QueryGetPermissionFeesRequest{Id: permID, ResponseMaxSize: 1})
+	require.NoError(t, err)
+	require.Len(t, feesResp.History, 1)
+	require.Equal(t, uint64(2), feesResp.History[0].Index)
+
+	// an update queued again after a failure is applied when it is retried
+	resp, err = update(grantee, permID, 40)
+	require.NoError(t, err)
+	retryAt := resp.EffectiveFrom.Add(time.Hour)
+	require.NoError(t, k.FeeUpdateQueue.Remove(sdkCtx, collections.Join(*resp.EffectiveFrom, permID)))
+	require.NoError(t, k.FeeUpdateQueue.Set(sdkCtx, collections.Join(retryAt, permID)))
+	require.NoError(t, k.ApplyScheduledFeeUpdates(sdkCtx.WithBlockTime(*resp.EffectiveFrom)))
+	perm, err = k.GetPermissionByID(sdkCtx, permID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(30), perm.ValidationFees)
+	require.NoError(t, k.ApplyScheduledFeeUpdates(sdkCtx.WithBlockTime(retryAt)))
+	perm, err = k.GetPermissionByID(sdkCtx, permID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(40), perm.ValidationFees)
+	require.Nil(t, perm.ScheduledFees)
+}
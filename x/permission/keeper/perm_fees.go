@@ -0,0 +1,229 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/verana-labs/verana-blockchain/x/permission/types"
+)
+
+const (
+	// feeUpdateBatchSize bounds the number of scheduled fee updates applied per block.
+	feeUpdateBatchSize = 200
+	// feeUpdateRetryDelay is the delay after which a fee update that failed to apply is retried.
+	feeUpdateRetryDelay = time.Hour
+)
+
+// UpdatePermissionFees schedules new fees for a perm of the grantee. The fees
+// replace the current fees at the end of the fee update notice period, a fee
+// update still pending is replaced.
+func (ms msgServer) UpdatePermissionFees(goCtx context.Context, msg *types.MsgUpdatePermissionFees) (*types.MsgUpdatePermissionFeesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	now := ctx.BlockTime()
+
+	perm, err := ms.Permission.Get(ctx, msg.Id)
+	if err != nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("perm not found: %v", err)
+	}
+	if perm.Grantee != msg.Creator {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("only the grantee can update the fees of a perm")
+	}
+	if perm.EffectiveFrom == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("perm has not been validated, its fees are not set yet")
+	}
+	if perm.Revoked != nil || perm.Terminated != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("perm is revoked or terminated")
+	}
+
+	if perm.ScheduledFees != nil && perm.ScheduledFees.EffectiveFrom != nil {
+		if err := ms.FeeUpdateQueue.Remove(ctx, collections.Join(*perm.ScheduledFees.EffectiveFrom, perm.Id)); err != nil {
+			return nil, fmt.Errorf("failed to remove pending fee update: %w", err)
+		}
+	}
+
+	effectiveFrom := now.AddDate(0, 0, int(ms.GetParams(ctx).FeeUpdateNoticePeriodDays))
+	perm.ScheduledFees = &types.PermissionFees{
+		ValidationFees:   msg.ValidationFees,
+		IssuanceFees:     msg.IssuanceFees,
+		VerificationFees: msg.VerificationFees,
+		EffectiveFrom:    &effectiveFrom,
+	}
+	perm.Modified = &now
+	if err := ms.Keeper.UpdatePermission(ctx, perm); err != nil {
+		return nil, fmt.Errorf("failed to update perm: %w", err)
+	}
+	if err := ms.FeeUpdateQueue.Set(ctx, collections.Join(effectiveFrom, perm.Id)); err != nil {
+		return nil, fmt.Errorf("failed to schedule fee update: %w", err)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdatePermissionFees,
+			sdk.NewAttribute(types.AttributeKeyPermissionID, strconv.FormatUint(perm.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyValidationFees, strconv.FormatUint(msg.ValidationFees, 10)),
+			sdk.NewAttribute(types.AttributeKeyIssuanceFees, strconv.FormatUint(msg.IssuanceFees, 10)),
+			sdk.NewAttribute(types.AttributeKeyVerificationFees, strconv.FormatUint(msg.VerificationFees, 10)),
+			sdk.NewAttribute(types.AttributeKeyEffectiveFrom, effectiveFrom.String()),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+		),
+	})
+
+	return &types.MsgUpdatePermissionFeesResponse{
+		EffectiveFrom: &effectiveFrom,
+	}, nil
+}
+
+// ApplyScheduledFeeUpdates applies the fee updates whose notice period has
+// ended, at most feeUpdateBatchSize per call. A fee update that fails to apply
+// is logged and queued again feeUpdateRetryDelay later. It is called from the
+// module EndBlocker.
+func (k Keeper) ApplyScheduledFeeUpdates(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime()
+
+	rng := new(collections.Range[collections.Pair[time.Time, uint64]]).
+		EndInclusive(collections.Join(now, uint64(math.MaxUint64)))
+	iter, err := k.FeeUpdateQueue.Iterate(sdkCtx, rng)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	var due []collections.Pair[time.Time, uint64]
+	for ; iter.Valid() && len(due) < feeUpdateBatchSize; iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return err
+		}
+		due = append(due, key)
+	}
+
+	for _, key := range due {
+		if err := k.FeeUpdateQueue.Remove(sdkCtx, key); err != nil {
+			return err
+		}
+
+		perm, err := k.Permission.Get(sdkCtx, key.K2())
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		// a retried update is queued after its effective time
+		scheduled := perm.ScheduledFees
+		if scheduled == nil || scheduled.EffectiveFrom == nil || scheduled.EffectiveFrom.After(key.K1()) {
+			continue
+		}
+
+		cacheCtx, write := sdkCtx.CacheContext()
+		if err := k.applyScheduledFees(cacheCtx, perm); err != nil {
+			k.Logger().Error("failed to apply fee update, retrying later", "perm_id", perm.Id, "error", err)
+			if err := k.FeeUpdateQueue.Set(sdkCtx, collections.Join(now.Add(feeUpdateRetryDelay), perm.Id)); err != nil {
+				return err
+			}
+			continue
+		}
+		write()
+	}
+
+	return nil
+}
+
+// applyScheduledFees replaces the fees of the perm with its scheduled fees and
+// records them in its fee history. The first update also records the fees the
+// perm had before.
+func (k Keeper) applyScheduledFees(ctx sdk.Context, perm types.Permission) error {
+	now := ctx.BlockTime()
+
+	lastIndex, err := k.getLastFeeRecordIndex(ctx, perm.Id)
+	if err != nil {
+		return err
+	}
+	if lastIndex == 0 {
+		lastIndex++
+		if err := k.PermissionFeeRecord.Set(ctx, collections.Join(perm.Id, lastIndex), types.PermissionFeeRecord{
+			PermId: perm.Id,
+			Index:  lastIndex,
+			Fees:   currentPermissionFees(perm, nil),
+		}); err != nil {
+			return fmt.Errorf("failed to save fee record: %w", err)
+		}
+	}
+
+	fees := *perm.ScheduledFees
+	if err := k.PermissionFeeRecord.Set(ctx, collections.Join(perm.Id, lastIndex+1), types.PermissionFeeRecord{
+		PermId:    perm.Id,
+		Index:     lastIndex + 1,
+		Fees:      fees,
+		UpdatedBy: perm.Grantee,
+	}); err != nil {
+		return fmt.Errorf("failed to save fee record: %w", err)
+	}
+
+	perm.ValidationFees = fees.ValidationFees
+	perm.IssuanceFees = fees.IssuanceFees
+	perm.VerificationFees = fees.VerificationFees
+	perm.ScheduledFees = nil
+	perm.Modified = &now
+	if err := k.UpdatePermission(ctx, perm); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeApplyPermissionFees,
+			sdk.NewAttribute(types.AttributeKeyPermissionID, strconv.FormatUint(perm.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyValidationFees, strconv.FormatUint(fees.ValidationFees, 10)),
+			sdk.NewAttribute(types.AttributeKeyIssuanceFees, strconv.FormatUint(fees.IssuanceFees, 10)),
+			sdk.NewAttribute(types.AttributeKeyVerificationFees, strconv.FormatUint(fees.VerificationFees, 10)),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+		),
+	})
+
+	return nil
+}
+
+// currentPermissionFees returns the current fees of the perm. They apply from
+// the last entry of its fee history, or from the perm effective_from if its
+// fees were never updated.
+func currentPermissionFees(perm types.Permission, lastRecord *types.PermissionFeeRecord) types.PermissionFees {
+	effectiveFrom := perm.EffectiveFrom
+	if lastRecord != nil {
+		effectiveFrom = lastRecord.Fees.EffectiveFrom
+	}
+	return types.PermissionFees{
+		ValidationFees:   perm.ValidationFees,
+		IssuanceFees:     perm.IssuanceFees,
+		VerificationFees: perm.VerificationFees,
+		EffectiveFrom:    effectiveFrom,
+	}
+}
+
+// getLastFeeRecordIndex returns the index of the last entry of the fee history
+// of a perm, 0 if none.
+func (k Keeper) getLastFeeRecordIndex(ctx sdk.Context, permID uint64) (uint64, error) {
+	iter, err := k.PermissionFeeRecord.Iterate(ctx, collections.NewPrefixedPairRange[uint64, uint64](permID).Descending())
+	if err != nil {
+		return 0, fmt.Errorf("failed to iterate fee records: %w", err)
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 0, nil
+	}
+	key, err := iter.Key()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get fee record key: %w", err)
+	}
+	return key.K2(), nil
+}
@@ -41,10 +41,13 @@ func calculateVPExp(currentVPExp *time.Time, validityPeriod uint64, now time.Tim
 	return &exp
 }
 
+// executeSetPermissionVPToValidated validates the perm. validatorPerms are the
+// validators that approved it, the primary validator alone unless the
+// validation process has a quorum.
 func (ms msgServer) executeSetPermissionVPToValidated(
 	ctx sdk.Context,
 	applicantPerm types.Permission,
-	validatorPerm types.Permission,
+	validatorPerms []types.Permission,
 	msg *types.MsgSetPermissionVPToValidated,
 	now time.Time,
 	vpExp *time.Time,
@@ -70,48 +73,65 @@ func (ms msgServer) executeSetPermissionVPToValidated(
 		applicantPerm.ValidationFees = msg.ValidationFees
 		applicantPerm.IssuanceFees = msg.IssuanceFees
 		applicantPerm.VerificationFees = msg.VerificationFees
-		applicantPerm.Country = msg.Country
+		applicantPerm.Countries = msg.Countries
 		applicantPerm.EffectiveFrom = &now
 	}
 
 	// Fees and Trust Deposits:
-	// transfer the full amount applicant_perm.vp_current_fees from escrow account to validator account
+	// transfer applicant_perm.vp_current_fees from escrow account to the validator accounts, split
+	// equally among the validators of a validation process with a quorum
 	if applicantPerm.VpCurrentFees > 0 {
-		validatorAddr, err := sdk.AccAddressFromBech32(validatorPerm.Grantee)
-		if err != nil {
-			return nil, fmt.Errorf("invalid validator address: %w", err)
-		}
+		trustDepositRate := ms.trustDeposit.GetTrustDepositRate(ctx)
+		shares := splitValidationFees(applicantPerm.VpCurrentFees, len(validatorPerms))
 
-		err = ms.bankKeeper.SendCoinsFromModuleToAccount(
-			ctx,
-			types.ModuleName,
-			validatorAddr,
-			sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, int64(applicantPerm.VpCurrentFees))),
-		)
-		if err != nil {
-			return nil, fmt.Errorf("failed to transfer fees to validator: %w", err)
-		}
+		for i, validatorPerm := range validatorPerms {
+			if shares[i] == 0 {
+				continue
+			}
 
-		// Calculate validator_trust_deposit = applicant_perm.vp_current_fees * GlobalVariables.trust_deposit_rate
-		trustDepositRate := ms.trustDeposit.GetTrustDepositRate(ctx)
-		validatorTrustDeposit := ms.Keeper.validatorTrustDepositAmount(applicantPerm.VpCurrentFees, trustDepositRate)
+			validatorAddr, err := sdk.AccAddressFromBech32(validatorPerm.Grantee)
+			if err != nil {
+				return nil, fmt.Errorf("invalid validator address: %w", err)
+			}
 
-		// Increase validator perm trust deposit: use [MOD-TD-MSG-1] to increase by validator_trust_deposit
-		if validatorTrustDeposit > 0 {
-			err = ms.trustDeposit.AdjustTrustDeposit(
+			err = ms.bankKeeper.SendCoinsFromModuleToAccount(
 				ctx,
-				validatorPerm.Grantee,
-				int64(validatorTrustDeposit),
+				types.ModuleName,
+				validatorAddr,
+				sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, int64(shares[i]))),
 			)
 			if err != nil {
-				return nil, fmt.Errorf("failed to adjust validator trust deposit: %w", err)
+				return nil, fmt.Errorf("failed to transfer fees to validator: %w", err)
 			}
 
-			// Set applicant_perm.vp_validator_deposit to applicant_perm.vp_validator_deposit + validator_trust_deposit
-			applicantPerm.VpValidatorDeposit += validatorTrustDeposit
+			// Calculate validator_trust_deposit = validator fees * GlobalVariables.trust_deposit_rate
+			validatorTrustDeposit := ms.Keeper.validatorTrustDepositAmount(shares[i], trustDepositRate)
+
+			// Increase validator perm trust deposit: use [MOD-TD-MSG-1] to increase by validator_trust_deposit
+			if validatorTrustDeposit > 0 {
+				err = ms.trustDeposit.AdjustTrustDeposit(
+					ctx,
+					validatorPerm.Grantee,
+					int64(validatorTrustDeposit),
+				)
+				if err != nil {
+					return nil, fmt.Errorf("failed to adjust validator trust deposit: %w", err)
+				}
+
+				// Set applicant_perm.vp_validator_deposit to applicant_perm.vp_validator_deposit + validator_trust_deposit,
+				// co-validators keep their share in applicant_perm.vp_co_validator_deposits
+				if validatorPerm.Id == applicantPerm.ValidatorPermId {
+					applicantPerm.VpValidatorDeposit += validatorTrustDeposit
+				} else {
+					addCoValidatorDeposit(&applicantPerm, validatorPerm.Id, validatorTrustDeposit)
+				}
+			}
 		}
 	}
 
+	// the approvals of the validation process have been used
+	applicantPerm.VpApprovals = nil
+
 	// set applicant_perm.vp_current_fees to 0
 	applicantPerm.VpCurrentFees = 0
 	// set applicant_perm.vp_current_deposit to 0
@@ -130,3 +150,18 @@ func (k Keeper) validatorTrustDepositAmount(vpCurrentFees uint64, trustDepositRa
 	validatorTrustDeposit := vpCurrentFeesDec.Mul(trustDepositRate)
 	return validatorTrustDeposit.TruncateInt().Uint64()
 }
+
+// addCoValidatorDeposit adds a trust deposit earned by a co-validator to the
+// co-validator deposits of the perm.
+func addCoValidatorDeposit(perm *types.Permission, validatorPermID, deposit uint64) {
+	for i := range perm.VpCoValidatorDeposits {
+		if perm.VpCoValidatorDeposits[i].ValidatorPermId == validatorPermID {
+			perm.VpCoValidatorDeposits[i].Deposit += deposit
+			return
+		}
+	}
+	perm.VpCoValidatorDeposits = append(perm.VpCoValidatorDeposits, types.CoValidatorDeposit{
+		ValidatorPermId: validatorPermID,
+		Deposit:         deposit,
+	})
+}
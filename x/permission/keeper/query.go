@@ -5,7 +5,9 @@ import (
 	errors2 "errors"
 	"fmt"
 	"regexp"
+	"slices"
 	"sort"
+	"strings"
 	"time"
 
 	"cosmossdk.io/collections"
@@ -131,6 +133,12 @@ func (k Keeper) ListPermissionSessions(ctx context.Context, req *types.QueryList
 		if req.ModifiedAfter != nil && !session.Modified.After(*req.ModifiedAfter) {
 			return false, nil
 		}
+		if req.Controller != "" && session.Controller != req.Controller {
+			return false, nil
+		}
+		if req.AgentPermId != 0 && session.AgentPermId != req.AgentPermId {
+			return false, nil
+		}
 
 		sessions = append(sessions, session)
 		return len(sessions) >= int(req.ResponseMaxSize), nil
@@ -190,7 +198,7 @@ func (k Keeper) FindPermissionsWithDID(goCtx context.Context, req *types.QueryFi
 	}
 
 	// Check country code if provided
-	if req.Country != "" && !isValidCountryCode(req.Country) {
+	if req.Country != "" && !types.IsValidCountryCode(req.Country) {
 		return nil, status.Error(codes.InvalidArgument, "invalid country code format")
 	}
 
@@ -219,7 +227,7 @@ func (k Keeper) FindPermissionsWithDID(goCtx context.Context, req *types.QueryFi
 		}
 
 		// Filter by country
-		if req.Country != "" && perm.Country != "" && perm.Country != req.Country {
+		if req.Country != "" && !types.CountryScopeCovers(perm.Countries, req.Country) {
 			return false, nil
 		}
 
@@ -252,7 +260,7 @@ func (k Keeper) FindPermissionsWithDID(goCtx context.Context, req *types.QueryFi
 			if perm.SchemaId == req.SchemaId &&
 				perm.Type == types.PermissionType_PERMISSION_TYPE_ECOSYSTEM {
 				// Check country compatibility
-				if req.Country == "" || perm.Country == "" || perm.Country == req.Country {
+				if req.Country == "" || types.CountryScopeCovers(perm.Countries, req.Country) {
 					// Check time validity if "when" is specified
 					if req.When == nil || isPermissionValidAtTime(perm, *req.When) {
 						ecosystemPerm = perm
@@ -309,6 +317,11 @@ func isPermissionValidAtTime(perm types.Permission, when time.Time) bool {
 		return false
 	}
 
+	// Check suspended
+	if isSuspendedAt(perm, when) {
+		return false
+	}
+
 	return true
 }
 
@@ -319,10 +332,72 @@ func isValidDID(did string) bool {
 	return didRegex.MatchString(did)
 }
 
-func isValidCountryCode(code string) bool {
-	// Basic check for ISO 3166-1 alpha-2 format
-	match, _ := regexp.MatchString(`^[A-Z]{2}$`, code)
-	return match
+// ListPermissionsByCountry lists, in ID order, the perms whose country scope
+// lists the country, or its country if it is a subdivision.
+func (k Keeper) ListPermissionsByCountry(goCtx context.Context, req *types.QueryListPermissionsByCountryRequest) (*types.QueryListPermissionsByCountryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !types.IsValidCountryCode(req.Country) {
+		return nil, status.Error(codes.InvalidArgument, "invalid country code")
+	}
+	if req.ResponseMaxSize == 0 {
+		req.ResponseMaxSize = 64 // Default value
+	}
+	if req.ResponseMaxSize < 1 || req.ResponseMaxSize > 1024 {
+		return nil, status.Error(codes.InvalidArgument, "response_max_size must be between 1 and 1,024")
+	}
+
+	// A subdivision is also covered by the perms of its country
+	scopeCodes := []string{req.Country}
+	if country, _, ok := strings.Cut(req.Country, "-"); ok {
+		scopeCodes = append(scopeCodes, country)
+	}
+
+	seen := make(map[uint64]bool)
+	var ids []uint64
+	for _, code := range scopeCodes {
+		iter, err := k.PermissionByCountry.Iterate(ctx, collections.NewPrefixedPairRange[string, uint64](code))
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		keys, err := iter.Keys()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		for _, key := range keys {
+			if !seen[key.K2()] {
+				seen[key.K2()] = true
+				ids = append(ids, key.K2())
+			}
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	permissions := []types.Permission{}
+	for _, id := range ids {
+		perm, err := k.Permission.Get(ctx, id)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if req.SchemaId != 0 && perm.SchemaId != req.SchemaId {
+			continue
+		}
+		if req.Type != types.PermissionType_PERMISSION_TYPE_UNSPECIFIED && perm.Type != req.Type {
+			continue
+		}
+		permissions = append(permissions, perm)
+		if len(permissions) >= int(req.ResponseMaxSize) {
+			break
+		}
+	}
+
+	return &types.QueryListPermissionsByCountryResponse{
+		Permissions: permissions,
+	}, nil
 }
 
 func (k Keeper) FindBeneficiaries(goCtx context.Context, req *types.QueryFindBeneficiariesRequest) (*types.QueryFindBeneficiariesResponse, error) {
@@ -480,3 +555,858 @@ func (k Keeper) FindBeneficiaries(goCtx context.Context, req *types.QueryFindBen
 		Permissions: permissions,
 	}, nil
 }
+
+func (k Keeper) GetSessionAllowance(goCtx context.Context, req *types.QueryGetSessionAllowanceRequest) (*types.QueryGetSessionAllowanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "allowance ID cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	allowance, err := k.SessionAllowance.Get(ctx, req.Id)
+	if err != nil {
+		if errors2.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "session allowance not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to get session allowance")
+	}
+
+	return &types.QueryGetSessionAllowanceResponse{
+		Allowance: allowance,
+	}, nil
+}
+
+func (k Keeper) ListSessionAllowances(goCtx context.Context, req *types.QueryListSessionAllowancesRequest) (*types.QueryListSessionAllowancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// Validate response_max_size
+	if req.ResponseMaxSize == 0 {
+		req.ResponseMaxSize = 64 // Default value
+	}
+	if req.ResponseMaxSize < 1 || req.ResponseMaxSize > 1024 {
+		return nil, status.Error(codes.InvalidArgument, "response_max_size must be between 1 and 1,024")
+	}
+
+	if req.Sponsor != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Sponsor); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid sponsor address")
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	var allowances []types.SessionAllowance
+
+	// allowances are walked in ID order
+	err := k.SessionAllowance.Walk(ctx, nil, func(id uint64, allowance types.SessionAllowance) (bool, error) {
+		if req.Sponsor != "" && allowance.Sponsor != req.Sponsor {
+			return false, nil
+		}
+		if req.SchemaId != 0 && allowance.SchemaId != req.SchemaId {
+			return false, nil
+		}
+		if req.AgentPermId != 0 && allowance.AgentPermId != req.AgentPermId {
+			return false, nil
+		}
+		if req.VerifierPermId != 0 && allowance.VerifierPermId != req.VerifierPermId {
+			return false, nil
+		}
+
+		allowances = append(allowances, allowance)
+		return len(allowances) >= int(req.ResponseMaxSize), nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list session allowances")
+	}
+
+	return &types.QueryListSessionAllowancesResponse{
+		Allowances: allowances,
+	}, nil
+}
+
+func (k Keeper) GetSlashRecord(goCtx context.Context, req *types.QueryGetSlashRecordRequest) (*types.QueryGetSlashRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "slash record ID cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	record, err := k.SlashRecord.Get(ctx, req.Id)
+	if err != nil {
+		if errors2.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "slash record not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to get slash record")
+	}
+
+	return &types.QueryGetSlashRecordResponse{
+		SlashRecord: record,
+	}, nil
+}
+
+func (k Keeper) ListSlashRecords(goCtx context.Context, req *types.QueryListSlashRecordsRequest) (*types.QueryListSlashRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// Validate response_max_size
+	if req.ResponseMaxSize == 0 {
+		req.ResponseMaxSize = 64 // Default value
+	}
+	if req.ResponseMaxSize < 1 || req.ResponseMaxSize > 1024 {
+		return nil, status.Error(codes.InvalidArgument, "response_max_size must be between 1 and 1,024")
+	}
+
+	if req.Grantee != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Grantee); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid grantee address")
+		}
+	}
+	if req.SlashedBy != "" {
+		if _, err := sdk.AccAddressFromBech32(req.SlashedBy); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid slashed_by address")
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	var records []types.SlashRecord
+	nextAfterID := uint64(0)
+
+	// visit adds the record if it matches the filters, and stops the walk on
+	// the first match past a full page
+	visit := func(record types.SlashRecord) (bool, error) {
+		if req.PermId != 0 && record.PermId != req.PermId {
+			return false, nil
+		}
+		if req.Grantee != "" && record.Grantee != req.Grantee {
+			return false, nil
+		}
+		if req.SlashedBy != "" && record.SlashedBy != req.SlashedBy {
+			return false, nil
+		}
+		if len(records) >= int(req.ResponseMaxSize) {
+			nextAfterID = records[len(records)-1].Id
+			return true, nil
+		}
+		records = append(records, record)
+		return false, nil
+	}
+	visitID := func(id uint64) (bool, error) {
+		record, err := k.SlashRecord.Get(ctx, id)
+		if err != nil {
+			return true, err
+		}
+		return visit(record)
+	}
+
+	// slash records are walked in ID order, through the index of the most
+	// selective filter
+	var err error
+	switch {
+	case req.PermId != 0:
+		rng := collections.NewPrefixedPairRange[uint64, uint64](req.PermId).StartExclusive(req.AfterId)
+		err = k.SlashRecordByPerm.Walk(ctx, rng, func(key collections.Pair[uint64, uint64]) (bool, error) {
+			return visitID(key.K2())
+		})
+	case req.Grantee != "":
+		rng := collections.NewPrefixedPairRange[string, uint64](req.Grantee).StartExclusive(req.AfterId)
+		err = k.SlashRecordByGrantee.Walk(ctx, rng, func(key collections.Pair[string, uint64]) (bool, error) {
+			return visitID(key.K2())
+		})
+	case req.SlashedBy != "":
+		rng := collections.NewPrefixedPairRange[string, uint64](req.SlashedBy).StartExclusive(req.AfterId)
+		err = k.SlashRecordBySlasher.Walk(ctx, rng, func(key collections.Pair[string, uint64]) (bool, error) {
+			return visitID(key.K2())
+		})
+	default:
+		rng := new(collections.Range[uint64]).StartExclusive(req.AfterId)
+		err = k.SlashRecord.Walk(ctx, rng, func(id uint64, record types.SlashRecord) (bool, error) {
+			return visit(record)
+		})
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list slash records")
+	}
+
+	return &types.QueryListSlashRecordsResponse{
+		SlashRecords: records,
+		NextAfterId:  nextAfterID,
+	}, nil
+}
+
+func (k Keeper) GetSlashDistribution(goCtx context.Context, req *types.QueryGetSlashDistributionRequest) (*types.QueryGetSlashDistributionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.TrId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "trust registry ID cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	distribution, err := k.SlashDistribution.Get(ctx, req.TrId)
+	if err != nil {
+		if errors2.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "slash distribution not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to get slash distribution")
+	}
+
+	return &types.QueryGetSlashDistributionResponse{
+		SlashDistribution: distribution,
+	}, nil
+}
+
+func (k Keeper) GetPermissionTree(goCtx context.Context, req *types.QueryGetPermissionTreeRequest) (*types.QueryGetPermissionTreeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.RootId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "root perm ID cannot be 0")
+	}
+	if req.Type > uint32(types.PermissionType_PERMISSION_TYPE_HOLDER) {
+		return nil, status.Error(codes.InvalidArgument,
+			fmt.Sprintf("invalid perm type value: %d, must be between 1 and 6", req.Type))
+	}
+	if req.Country != "" && !types.IsValidCountryCode(req.Country) {
+		return nil, status.Error(codes.InvalidArgument, "invalid country code format")
+	}
+	if req.ResponseMaxSize == 0 {
+		req.ResponseMaxSize = 64 // Default value
+	}
+	if req.ResponseMaxSize < 1 || req.ResponseMaxSize > 1024 {
+		return nil, status.Error(codes.InvalidArgument, "response_max_size must be between 1 and 1,024")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	when := ctx.BlockTime()
+	if req.When != nil {
+		when = *req.When
+	}
+
+	root, err := k.Permission.Get(ctx, req.RootId)
+	if err != nil {
+		if errors2.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "perm not found")
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get perm: %v", err))
+	}
+
+	// The tree is walked breadth first through the validator perm index, so
+	// that a truncated response holds the perms closest to the root
+	resp := &types.QueryGetPermissionTreeResponse{}
+	queue := []types.PermissionNode{newPermissionNode(root, 0, req.Country, when)}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+
+		if (req.Type == 0 || node.Permission.Type == types.PermissionType(req.Type)) &&
+			(!req.OnlyValid || node.Valid) {
+			if len(resp.Nodes) >= int(req.ResponseMaxSize) {
+				resp.Truncated = true
+				break
+			}
+			resp.Nodes = append(resp.Nodes, node)
+		}
+
+		if req.Depth != 0 && node.Depth >= req.Depth {
+			continue
+		}
+		children, err := k.getValidatedPermissions(ctx, node.Permission.Id)
+		if err != nil {
+			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get validated perms: %v", err))
+		}
+		for _, child := range children {
+			queue = append(queue, newPermissionNode(child, node.Depth+1, req.Country, when))
+		}
+	}
+
+	return resp, nil
+}
+
+func (k Keeper) GetPermissionAncestry(goCtx context.Context, req *types.QueryGetPermissionAncestryRequest) (*types.QueryGetPermissionAncestryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "perm ID cannot be 0")
+	}
+	if req.Country != "" && !types.IsValidCountryCode(req.Country) {
+		return nil, status.Error(codes.InvalidArgument, "invalid country code format")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	when := ctx.BlockTime()
+	if req.When != nil {
+		when = *req.When
+	}
+
+	resp := &types.QueryGetPermissionAncestryResponse{ChainValid: true}
+	visited := make(map[uint64]bool)
+	for id, depth := req.Id, uint32(0); id != 0; depth++ {
+		// a validator perm is always created before the perms it validates, a
+		// perm seen twice can only come from corrupted state
+		if visited[id] {
+			return nil, status.Error(codes.Internal, fmt.Sprintf("validator perm cycle at perm %d", id))
+		}
+		visited[id] = true
+
+		perm, err := k.Permission.Get(ctx, id)
+		if err != nil {
+			if errors2.Is(err, collections.ErrNotFound) {
+				return nil, status.Error(codes.NotFound, fmt.Sprintf("perm %d not found", id))
+			}
+			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get perm: %v", err))
+		}
+
+		node := newPermissionNode(perm, depth, req.Country, when)
+		resp.ChainValid = resp.ChainValid && node.Valid
+		resp.Ancestors = append(resp.Ancestors, node)
+		id = perm.ValidatorPermId
+	}
+
+	return resp, nil
+}
+
+// getValidatedPermissions returns the perms whose validator perm is
+// validatorPermID, in ID order.
+func (k Keeper) getValidatedPermissions(ctx sdk.Context, validatorPermID uint64) ([]types.Permission, error) {
+	iter, err := k.PermissionByValidator.Iterate(ctx, collections.NewPrefixedPairRange[uint64, uint64](validatorPermID))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var perms []types.Permission
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, err
+		}
+		perm, err := k.Permission.Get(ctx, key.K2())
+		if err != nil {
+			return nil, err
+		}
+		perms = append(perms, perm)
+	}
+	return perms, nil
+}
+
+func newPermissionNode(perm types.Permission, depth uint32, country string, when time.Time) types.PermissionNode {
+	node := types.PermissionNode{
+		Permission: perm,
+		Depth:      depth,
+		Valid:      true,
+	}
+	if err := permissionValidityAt(perm, country, when); err != nil {
+		node.Valid = false
+		node.InvalidReason = err.Error()
+	}
+	return node
+}
+
+// permissionValidityAt checks the validity of a perm at a given time. Unlike
+// IsValidPermission, a revocation or termination only invalidates the perm
+// from the time it happened. An empty country matches any perm country scope.
+func permissionValidityAt(perm types.Permission, country string, when time.Time) error {
+	if country != "" && !types.CountryScopeCovers(perm.Countries, country) {
+		return fmt.Errorf("perm country mismatch: perm has %v, requested %s", perm.Countries, country)
+	}
+	if perm.EffectiveFrom != nil && when.Before(*perm.EffectiveFrom) {
+		return fmt.Errorf("perm not yet effective: begins at %v", perm.EffectiveFrom)
+	}
+	if perm.EffectiveUntil != nil && !when.Before(*perm.EffectiveUntil) {
+		return fmt.Errorf("perm expired: ended at %v", perm.EffectiveUntil)
+	}
+	if perm.Revoked != nil && !when.Before(*perm.Revoked) {
+		return fmt.Errorf("perm is revoked since %v", perm.Revoked)
+	}
+	if perm.Terminated != nil && !when.Before(*perm.Terminated) {
+		return fmt.Errorf("perm is terminated since %v", perm.Terminated)
+	}
+	if perm.SlashedDeposit > 0 {
+		return fmt.Errorf("perm is slashed with amount %d", perm.SlashedDeposit)
+	}
+	if isSuspendedAt(perm, when) {
+		return fmt.Errorf("perm is suspended since %v", perm.Suspended)
+	}
+	return nil
+}
+
+func (k Keeper) SimulateRevocationCascade(goCtx context.Context, req *types.QuerySimulateRevocationCascadeRequest) (*types.QuerySimulateRevocationCascadeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "perm ID cannot be 0")
+	}
+	if req.Mode != types.CascadeMode_CASCADE_MODE_REVOKE && req.Mode != types.CascadeMode_CASCADE_MODE_SUSPEND {
+		return nil, status.Error(codes.InvalidArgument, "cascade mode must be revoke or suspend")
+	}
+	if req.ResponseMaxSize == 0 {
+		req.ResponseMaxSize = 64 // Default value
+	}
+	if req.ResponseMaxSize < 1 || req.ResponseMaxSize > 1024 {
+		return nil, status.Error(codes.InvalidArgument, "response_max_size must be between 1 and 1,024")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := k.Permission.Get(ctx, req.Id); err != nil {
+		if errors2.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "perm not found")
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get perm: %v", err))
+	}
+
+	// The descendants are walked in the order the cascade processes them,
+	// including the ones that are left as they are, whose own descendants are
+	// still affected
+	resp := &types.QuerySimulateRevocationCascadeResponse{}
+	type item struct {
+		id    uint64
+		depth uint32
+	}
+	queue := []item{{id: req.Id}}
+	for len(queue) > 0 && !resp.Truncated {
+		parent := queue[0]
+		queue = queue[1:]
+
+		children, err := k.getValidatedPermissions(ctx, parent.id)
+		if err != nil {
+			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get validated perms: %v", err))
+		}
+		for _, child := range children {
+			queue = append(queue, item{id: child.Id, depth: parent.depth + 1})
+
+			affected := child.Revoked == nil
+			if req.Mode == types.CascadeMode_CASCADE_MODE_SUSPEND {
+				affected = affected && !isSuspendedAt(child, ctx.BlockTime())
+			}
+			if !affected {
+				continue
+			}
+			if len(resp.Affected) >= int(req.ResponseMaxSize) {
+				resp.Truncated = true
+				break
+			}
+			resp.Affected = append(resp.Affected, newPermissionNode(child, parent.depth+1, "", ctx.BlockTime()))
+		}
+	}
+
+	return resp, nil
+}
+
+func (k Keeper) ListRevocationCascades(goCtx context.Context, req *types.QueryListRevocationCascadesRequest) (*types.QueryListRevocationCascadesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ResponseMaxSize == 0 {
+		req.ResponseMaxSize = 64 // Default value
+	}
+	if req.ResponseMaxSize < 1 || req.ResponseMaxSize > 1024 {
+		return nil, status.Error(codes.InvalidArgument, "response_max_size must be between 1 and 1,024")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	var cascades []types.RevocationCascade
+	err := k.RevocationCascade.Walk(ctx, nil, func(permID uint64, cascade types.RevocationCascade) (bool, error) {
+		cascades = append(cascades, cascade)
+		return len(cascades) >= int(req.ResponseMaxSize), nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list revocation cascades")
+	}
+
+	return &types.QueryListRevocationCascadesResponse{
+		Cascades: cascades,
+	}, nil
+}
+
+func (k Keeper) VerifySessionAuthorization(goCtx context.Context, req *types.QueryVerifySessionAuthorizationRequest) (*types.QueryVerifySessionAuthorizationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "session ID is required")
+	}
+	if req.ExecutorPermId == 0 && req.BeneficiaryPermId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one of executor_perm_id or beneficiary_perm_id must be provided")
+	}
+	if req.AgentPermId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "agent perm ID is required")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	when := ctx.BlockTime()
+	if req.When != nil {
+		when = *req.When
+	}
+
+	resp := &types.QueryVerifySessionAuthorizationResponse{}
+	deny := func(reason string) (*types.QueryVerifySessionAuthorizationResponse, error) {
+		resp.Authorized = false
+		resp.Reason = reason
+		return resp, nil
+	}
+
+	addStatus := func(role string, permID uint64) error {
+		permStatus := types.SessionPermissionStatus{Role: role, PermId: permID, Valid: true}
+		perm, err := k.Permission.Get(ctx, permID)
+		if err != nil {
+			if !errors2.Is(err, collections.ErrNotFound) {
+				return status.Error(codes.Internal, fmt.Sprintf("failed to get perm: %v", err))
+			}
+			permStatus.Valid = false
+			permStatus.InvalidReason = "perm not found"
+		} else if err := permissionValidityAt(perm, "", when); err != nil {
+			permStatus.Valid = false
+			permStatus.InvalidReason = err.Error()
+		}
+		resp.Permissions = append(resp.Permissions, permStatus)
+		return nil
+	}
+
+	if err := addStatus(types.SessionRoleAgent, req.AgentPermId); err != nil {
+		return nil, err
+	}
+	if req.ExecutorPermId != 0 {
+		if err := addStatus(types.SessionRoleExecutor, req.ExecutorPermId); err != nil {
+			return nil, err
+		}
+	}
+	if req.BeneficiaryPermId != 0 {
+		if err := addStatus(types.SessionRoleBeneficiary, req.BeneficiaryPermId); err != nil {
+			return nil, err
+		}
+	}
+
+	session, err := k.PermissionSession.Get(ctx, req.SessionId)
+	if err != nil {
+		if errors2.Is(err, collections.ErrNotFound) {
+			return deny("session not found")
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get session: %v", err))
+	}
+
+	if session.Created != nil && when.Before(*session.Created) {
+		return deny("session not yet created")
+	}
+	if session.Expires != nil && !when.Before(*session.Expires) {
+		return deny(fmt.Sprintf("session expired at %v", session.Expires))
+	}
+	if session.AgentPermId != req.AgentPermId {
+		return deny(fmt.Sprintf("session agent perm is %d", session.AgentPermId))
+	}
+
+	for _, authz := range session.Authz {
+		if authz.ExecutorPermId == req.ExecutorPermId && authz.BeneficiaryPermId == req.BeneficiaryPermId {
+			resp.Authz = authz
+			break
+		}
+	}
+	if resp.Authz == nil {
+		return deny("session has no authorization for this executor and beneficiary")
+	}
+
+	if resp.Authz.WalletAgentPermId != 0 {
+		if err := addStatus(types.SessionRoleWalletAgent, resp.Authz.WalletAgentPermId); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, permStatus := range resp.Permissions {
+		if !permStatus.Valid {
+			return deny(fmt.Sprintf("%s perm %d is not valid", permStatus.Role, permStatus.PermId))
+		}
+	}
+
+	resp.Authorized = true
+	return resp, nil
+}
+
+func (k Keeper) QuoteSessionFees(goCtx context.Context, req *types.QueryQuoteSessionFeesRequest) (*types.QueryQuoteSessionFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.IssuerPermId == 0 && req.VerifierPermId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one of issuer_perm_id or verifier_perm_id must be provided")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, id := range []uint64{req.AgentPermId, req.WalletAgentPermId} {
+		if id == 0 {
+			continue
+		}
+		if _, err := k.Permission.Get(ctx, id); err != nil {
+			if errors2.Is(err, collections.ErrNotFound) {
+				return nil, status.Error(codes.NotFound, fmt.Sprintf("agent perm %d not found", id))
+			}
+			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get perm: %v", err))
+		}
+	}
+
+	ms := msgServer{Keeper: k}
+	permSet, err := ms.findBeneficiaries(ctx, req.IssuerPermId, req.VerifierPermId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("failed to find beneficiaries: %v", err))
+	}
+
+	// Quote with the code CreateOrUpdatePermissionSession charges with
+	isVerifier := req.VerifierPermId != 0
+	hasWalletAgent := req.WalletAgentPermId != 0
+	userAgentReward, walletAgentReward := k.sessionRewards(ctx, permSet, isVerifier, hasWalletAgent)
+
+	return &types.QueryQuoteSessionFeesResponse{
+		Beneficiaries:     k.sessionBeneficiaryFees(ctx, permSet, isVerifier),
+		UserAgentReward:   userAgentReward,
+		WalletAgentReward: walletAgentReward,
+		Total:             k.sessionRequiredFunds(ctx, permSet, isVerifier, hasWalletAgent),
+		TrustUnitPrice:    k.trustRegistryKeeper.GetTrustUnitPrice(ctx),
+	}, nil
+}
+
+func (k Keeper) QuoteValidationFees(goCtx context.Context, req *types.QueryQuoteValidationFeesRequest) (*types.QueryQuoteValidationFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.ValidatorPermId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "validator perm ID cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	validatorPerm, err := k.Permission.Get(ctx, req.ValidatorPermId)
+	if err != nil {
+		if errors2.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "validator perm not found")
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get perm: %v", err))
+	}
+
+	// Quote with the code StartPermissionVP and RenewPermissionVP charge with
+	ms := msgServer{Keeper: k}
+	fees, deposit, err := ms.validateAndCalculateFees(ctx, "", validatorPerm)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to calculate fees: %v", err))
+	}
+
+	return &types.QueryQuoteValidationFeesResponse{
+		ValidatorPermId: validatorPerm.Id,
+		ValidationFees:  validatorPerm.ValidationFees,
+		FeesInDenom:     fees,
+		TrustDeposit:    deposit,
+		Total:           fees + deposit,
+		TrustUnitPrice:  k.trustRegistryKeeper.GetTrustUnitPrice(ctx),
+	}, nil
+}
+
+func (k Keeper) GetAgentRewards(goCtx context.Context, req *types.QueryGetAgentRewardsRequest) (*types.QueryGetAgentRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.PermId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "perm ID cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := k.Permission.Get(ctx, req.PermId); err != nil {
+		if errors2.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "perm not found")
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get perm: %v", err))
+	}
+
+	// A perm that never acted as agent has no accrued rewards
+	reward, err := k.AgentReward.Get(ctx, req.PermId)
+	if err != nil {
+		if !errors2.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get agent reward: %v", err))
+		}
+		reward = types.AgentReward{PermId: req.PermId}
+	}
+
+	return &types.QueryGetAgentRewardsResponse{
+		AgentReward: reward,
+	}, nil
+}
+
+func (k Keeper) GetVerificationCredit(goCtx context.Context, req *types.QueryGetVerificationCreditRequest) (*types.QueryGetVerificationCreditResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "verification credit ID cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	credit, err := k.VerificationCredit.Get(ctx, req.Id)
+	if err != nil {
+		if errors2.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "verification credit not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to get verification credit")
+	}
+
+	return &types.QueryGetVerificationCreditResponse{
+		Credit: credit,
+	}, nil
+}
+
+func (k Keeper) ListVerificationCredits(goCtx context.Context, req *types.QueryListVerificationCreditsRequest) (*types.QueryListVerificationCreditsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// Validate response_max_size
+	if req.ResponseMaxSize == 0 {
+		req.ResponseMaxSize = 64 // Default value
+	}
+	if req.ResponseMaxSize < 1 || req.ResponseMaxSize > 1024 {
+		return nil, status.Error(codes.InvalidArgument, "response_max_size must be between 1 and 1,024")
+	}
+
+	if req.Buyer != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Buyer); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid buyer address")
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	var credits []types.VerificationCredit
+
+	// credits are walked in ID order
+	err := k.VerificationCredit.Walk(ctx, nil, func(id uint64, credit types.VerificationCredit) (bool, error) {
+		if req.Buyer != "" && credit.Buyer != req.Buyer {
+			return false, nil
+		}
+		if req.VerifierPermId != 0 && credit.VerifierPermId != req.VerifierPermId {
+			return false, nil
+		}
+		if req.WithRemaining && credit.Remaining == 0 {
+			return false, nil
+		}
+
+		credits = append(credits, credit)
+		return len(credits) >= int(req.ResponseMaxSize), nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list verification credits")
+	}
+
+	return &types.QueryListVerificationCreditsResponse{
+		Credits: credits,
+	}, nil
+}
+
+func (k Keeper) GetVoucherNonce(goCtx context.Context, req *types.QueryGetVoucherNonceRequest) (*types.QueryGetVoucherNonceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Signer); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid signer address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	nonce, err := k.getVoucherNonce(ctx, req.Signer)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	used, err := k.usedVoucherNonces(ctx, req.Signer)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetVoucherNonceResponse{
+		Nonce:      nonce,
+		UsedNonces: used,
+	}, nil
+}
+
+func (k Keeper) ListVPMessages(goCtx context.Context, req *types.QueryListVPMessagesRequest) (*types.QueryListVPMessagesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.PermId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "perm ID is required")
+	}
+	if req.ResponseMaxSize == 0 {
+		req.ResponseMaxSize = 64 // Default value
+	}
+	if req.ResponseMaxSize < 1 || req.ResponseMaxSize > 1024 {
+		return nil, status.Error(codes.InvalidArgument, "response_max_size must be between 1 and 1,024")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := k.Permission.Get(ctx, req.PermId); err != nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("perm not found: %v", err))
+	}
+
+	messages := []types.VPMessage{}
+	rng := collections.NewPrefixedPairRange[uint64, uint64](req.PermId).StartExclusive(req.AfterIndex)
+	err := k.VPMessage.Walk(ctx, rng, func(_ collections.Pair[uint64, uint64], message types.VPMessage) (bool, error) {
+		messages = append(messages, message)
+		return len(messages) >= int(req.ResponseMaxSize), nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListVPMessagesResponse{
+		Messages: messages,
+	}, nil
+}
+
+func (k Keeper) GetPermissionFees(goCtx context.Context, req *types.QueryGetPermissionFeesRequest) (*types.QueryGetPermissionFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "perm ID is required")
+	}
+	if req.ResponseMaxSize == 0 {
+		req.ResponseMaxSize = 64 // Default value
+	}
+	if req.ResponseMaxSize < 1 || req.ResponseMaxSize > 1024 {
+		return nil, status.Error(codes.InvalidArgument, "response_max_size must be between 1 and 1,024")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	perm, err := k.Permission.Get(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("perm not found: %v", err))
+	}
+
+	// Walk the fee history from its last entry, then put it back in order
+	history := []types.PermissionFeeRecord{}
+	rng := collections.NewPrefixedPairRange[uint64, uint64](req.Id).Descending()
+	err = k.PermissionFeeRecord.Walk(ctx, rng, func(_ collections.Pair[uint64, uint64], record types.PermissionFeeRecord) (bool, error) {
+		history = append(history, record)
+		return len(history) >= int(req.ResponseMaxSize), nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	slices.Reverse(history)
+
+	var lastRecord *types.PermissionFeeRecord
+	if len(history) > 0 {
+		lastRecord = &history[len(history)-1]
+	}
+
+	return &types.QueryGetPermissionFeesResponse{
+		Current:   currentPermissionFees(perm, lastRecord),
+		Scheduled: perm.ScheduledFees,
+		History:   history,
+	}, nil
+}
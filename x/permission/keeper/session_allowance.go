@@ -0,0 +1,297 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/verana-labs/verana-blockchain/x/permission/types"
+)
+
+// CreateSessionAllowance escrows the sponsor funds in the module account and
+// creates a session allowance for the requested scope.
+func (ms msgServer) CreateSessionAllowance(goCtx context.Context, msg *types.MsgCreateSessionAllowance) (*types.MsgCreateSessionAllowanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	now := ctx.BlockTime()
+
+	if err := ms.validateSessionAllowanceScope(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	sponsorAddr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, fmt.Errorf("invalid creator address: %w", err)
+	}
+
+	if err := ms.bankKeeper.SendCoinsFromAccountToModule(
+		ctx,
+		sponsorAddr,
+		types.ModuleName,
+		sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, int64(msg.Amount))),
+	); err != nil {
+		return nil, fmt.Errorf("failed to escrow allowance: %w", err)
+	}
+
+	id, err := ms.getNextSessionAllowanceID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allowance := types.SessionAllowance{
+		Id:              id,
+		Sponsor:         msg.Creator,
+		SchemaId:        msg.SchemaId,
+		AgentPermId:     msg.AgentPermId,
+		VerifierPermId:  msg.VerifierPermId,
+		Remaining:       msg.Amount,
+		Created:         &now,
+		Modified:        &now,
+		AllowedCreators: msg.AllowedCreators,
+		MaxPerSession:   msg.MaxPerSession,
+	}
+	if err := ms.SessionAllowance.Set(ctx, id, allowance); err != nil {
+		return nil, fmt.Errorf("failed to save session allowance: %w", err)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateSessionAllowance,
+			sdk.NewAttribute(types.AttributeKeyAllowanceID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeySponsor, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeySchemaID, strconv.FormatUint(msg.SchemaId, 10)),
+			sdk.NewAttribute(types.AttributeKeyAgentPermID, strconv.FormatUint(msg.AgentPermId, 10)),
+			sdk.NewAttribute(types.AttributeKeyVerifierPermID, strconv.FormatUint(msg.VerifierPermId, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, strconv.FormatUint(msg.Amount, 10)),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+		),
+	})
+
+	return &types.MsgCreateSessionAllowanceResponse{
+		Id: id,
+	}, nil
+}
+
+// FundSessionAllowance adds funds to an allowance of the sponsor.
+func (ms msgServer) FundSessionAllowance(goCtx context.Context, msg *types.MsgFundSessionAllowance) (*types.MsgFundSessionAllowanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	now := ctx.BlockTime()
+
+	allowance, err := ms.getSponsorAllowance(ctx, msg.Id, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	sponsorAddr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, fmt.Errorf("invalid creator address: %w", err)
+	}
+
+	if err := ms.bankKeeper.SendCoinsFromAccountToModule(
+		ctx,
+		sponsorAddr,
+		types.ModuleName,
+		sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, int64(msg.Amount))),
+	); err != nil {
+		return nil, fmt.Errorf("failed to escrow allowance: %w", err)
+	}
+
+	allowance.Remaining += msg.Amount
+	allowance.Modified = &now
+	if err := ms.SessionAllowance.Set(ctx, allowance.Id, allowance); err != nil {
+		return nil, fmt.Errorf("failed to save session allowance: %w", err)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFundSessionAllowance,
+			sdk.NewAttribute(types.AttributeKeyAllowanceID, strconv.FormatUint(allowance.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, strconv.FormatUint(msg.Amount, 10)),
+			sdk.NewAttribute(types.AttributeKeyRemaining, strconv.FormatUint(allowance.Remaining, 10)),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+		),
+	})
+
+	return &types.MsgFundSessionAllowanceResponse{}, nil
+}
+
+// WithdrawSessionAllowance returns unspent funds of an allowance to its sponsor.
+func (ms msgServer) WithdrawSessionAllowance(goCtx context.Context, msg *types.MsgWithdrawSessionAllowance) (*types.MsgWithdrawSessionAllowanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	now := ctx.BlockTime()
+
+	allowance, err := ms.getSponsorAllowance(ctx, msg.Id, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := msg.Amount
+	if amount == 0 {
+		amount = allowance.Remaining
+	}
+	if amount == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("nothing left to withdraw")
+	}
+	if amount > allowance.Remaining {
+		return nil, sdkerrors.ErrInsufficientFunds.Wrapf("amount %d exceeds remaining allowance %d", amount, allowance.Remaining)
+	}
+
+	sponsorAddr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, fmt.Errorf("invalid creator address: %w", err)
+	}
+
+	if err := ms.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx,
+		types.ModuleName,
+		sponsorAddr,
+		sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, int64(amount))),
+	); err != nil {
+		return nil, fmt.Errorf("failed to withdraw allowance: %w", err)
+	}
+
+	allowance.Remaining -= amount
+	allowance.Modified = &now
+	if err := ms.SessionAllowance.Set(ctx, allowance.Id, allowance); err != nil {
+		return nil, fmt.Errorf("failed to save session allowance: %w", err)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeWithdrawSessionAllowance,
+			sdk.NewAttribute(types.AttributeKeyAllowanceID, strconv.FormatUint(allowance.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, strconv.FormatUint(amount, 10)),
+			sdk.NewAttribute(types.AttributeKeyRemaining, strconv.FormatUint(allowance.Remaining, 10)),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+		),
+	})
+
+	return &types.MsgWithdrawSessionAllowanceResponse{
+		Amount: amount,
+	}, nil
+}
+
+func (ms msgServer) validateSessionAllowanceScope(ctx sdk.Context, msg *types.MsgCreateSessionAllowance) error {
+	switch {
+	case msg.SchemaId != 0:
+		if _, err := ms.credentialSchemaKeeper.GetCredentialSchemaById(ctx, msg.SchemaId); err != nil {
+			return sdkerrors.ErrNotFound.Wrapf("credential schema not found: %v", err)
+		}
+	case msg.AgentPermId != 0:
+		perm, err := ms.Permission.Get(ctx, msg.AgentPermId)
+		if err != nil {
+			return sdkerrors.ErrNotFound.Wrapf("agent perm not found: %v", err)
+		}
+		if perm.Type != types.PermissionType_PERMISSION_TYPE_HOLDER {
+			return sdkerrors.ErrInvalidRequest.Wrap("agent perm must be HOLDER type")
+		}
+	case msg.VerifierPermId != 0:
+		perm, err := ms.Permission.Get(ctx, msg.VerifierPermId)
+		if err != nil {
+			return sdkerrors.ErrNotFound.Wrapf("verifier perm not found: %v", err)
+		}
+		if perm.Type != types.PermissionType_PERMISSION_TYPE_VERIFIER {
+			return sdkerrors.ErrInvalidRequest.Wrap("verifier perm must be VERIFIER type")
+		}
+	}
+
+	return nil
+}
+
+func (ms msgServer) getSponsorAllowance(ctx sdk.Context, id uint64, sponsor string) (types.SessionAllowance, error) {
+	allowance, err := ms.SessionAllowance.Get(ctx, id)
+	if err != nil {
+		return types.SessionAllowance{}, sdkerrors.ErrNotFound.Wrapf("session allowance not found: %v", err)
+	}
+
+	if allowance.Sponsor != sponsor {
+		return types.SessionAllowance{}, sdkerrors.ErrUnauthorized.Wrap("only the sponsor can manage the session allowance")
+	}
+
+	return allowance, nil
+}
+
+// drawSessionAllowance takes amount out of the allowance to pay the beneficiary
+// fees of the session. The funds stay in the module account, processFees pays
+// the beneficiaries from there, so that sponsor funds never reach the session
+// creator. The allowance must cover the scope of the session: its schema, its
+// agent perm or its verifier perm, and the restrictions of the sponsor on the
+// creator and the amount.
+func (ms msgServer) drawSessionAllowance(ctx sdk.Context, msg *types.MsgCreateOrUpdatePermissionSession, schemaID uint64, amount uint64) error {
+	allowance, err := ms.SessionAllowance.Get(ctx, msg.AllowanceId)
+	if err != nil {
+		return sdkerrors.ErrNotFound.Wrapf("session allowance not found: %v", err)
+	}
+
+	if !allowanceCoversSession(allowance, msg, schemaID) {
+		return sdkerrors.ErrUnauthorized.Wrapf("session allowance %d does not cover this session", allowance.Id)
+	}
+
+	if len(allowance.AllowedCreators) > 0 && !slices.Contains(allowance.AllowedCreators, msg.Creator) {
+		return sdkerrors.ErrUnauthorized.Wrapf("session allowance %d does not pay for sessions of %s", allowance.Id, msg.Creator)
+	}
+
+	if amount == 0 {
+		return nil
+	}
+
+	if allowance.MaxPerSession != 0 && amount > allowance.MaxPerSession {
+		return sdkerrors.ErrInsufficientFunds.Wrapf("session fees %d exceed the allowance maximum per session %d", amount, allowance.MaxPerSession)
+	}
+
+	if amount > allowance.Remaining {
+		return sdkerrors.ErrInsufficientFunds.Wrapf("session fees %d exceed remaining allowance %d", amount, allowance.Remaining)
+	}
+
+	now := ctx.BlockTime()
+	allowance.Remaining -= amount
+	allowance.Spent += amount
+	allowance.Modified = &now
+	if err := ms.SessionAllowance.Set(ctx, allowance.Id, allowance); err != nil {
+		return fmt.Errorf("failed to save session allowance: %w", err)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDrawSessionAllowance,
+			sdk.NewAttribute(types.AttributeKeyAllowanceID, strconv.FormatUint(allowance.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeySessionID, msg.Id),
+			sdk.NewAttribute(types.AttributeKeyAmount, strconv.FormatUint(amount, 10)),
+			sdk.NewAttribute(types.AttributeKeyRemaining, strconv.FormatUint(allowance.Remaining, 10)),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+		),
+	})
+
+	return nil
+}
+
+func allowanceCoversSession(allowance types.SessionAllowance, msg *types.MsgCreateOrUpdatePermissionSession, schemaID uint64) bool {
+	switch {
+	case allowance.SchemaId != 0:
+		return allowance.SchemaId == schemaID
+	case allowance.AgentPermId != 0:
+		return allowance.AgentPermId == msg.AgentPermId
+	case allowance.VerifierPermId != 0:
+		return allowance.VerifierPermId == msg.VerifierPermId
+	default:
+		return false
+	}
+}
+
+// getNextSessionAllowanceID gets the next available session allowance ID
+func (k Keeper) getNextSessionAllowanceID(ctx sdk.Context) (uint64, error) {
+	id, err := k.SessionAllowanceCounter.Get(ctx)
+	if err != nil {
+		id = 0
+	}
+
+	nextID := id + 1
+	if err := k.SessionAllowanceCounter.Set(ctx, nextID); err != nil {
+		return 0, fmt.Errorf("failed to set session allowance counter: %w", err)
+	}
+
+	return nextID, nil
+}
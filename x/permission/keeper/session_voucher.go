@@ -0,0 +1,308 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/verana-labs/verana-blockchain/x/permission/types"
+)
+
+// voucherFeeGroup holds the vouchers of a signer sharing the same issuer and
+// verifier perms, whose fees are processed at once.
+type voucherFeeGroup struct {
+	signer  string
+	permSet []types.Permission
+	count   uint64
+}
+
+// voucherRewardGroup holds the vouchers of a signer sharing the same agent
+// perm, whose rewards are paid at once.
+type voucherRewardGroup struct {
+	signer      string
+	agentPermID uint64
+	reward      uint64
+}
+
+// SettleSessionVouchers verifies a batch of signed session vouchers and pays
+// their session fees and agent rewards. The batch is settled atomically, an
+// invalid voucher fails the whole message.
+func (ms msgServer) SettleSessionVouchers(goCtx context.Context, msg *types.MsgSettleSessionVouchers) (*types.MsgSettleSessionVouchersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	now := ctx.BlockTime()
+
+	var (
+		feeGroups     []*voucherFeeGroup
+		rewardGroups  []*voucherRewardGroup
+		feeIndex      = make(map[string]*voucherFeeGroup)
+		rewardIndex   = make(map[string]*voucherRewardGroup)
+		highestNonces = make(map[string]uint64)
+		batchNonces   = make(map[string]bool)
+		usedNonces    []collections.Pair[string, uint64]
+		signerTotals  = make(map[string]uint64)
+		signers       []string
+		total         uint64
+	)
+
+	for i, signed := range msg.Vouchers {
+		voucher := signed.Voucher
+
+		if err := verifySessionVoucher(ctx.ChainID(), signed); err != nil {
+			return nil, fmt.Errorf("voucher %d: %w", i, err)
+		}
+
+		// A nonce of a signer is settled once, and must be within the window
+		// below the highest nonce settled, batch included
+		highest, seen := highestNonces[voucher.Signer]
+		if !seen {
+			var err error
+			highest, err = ms.getVoucherNonce(ctx, voucher.Signer)
+			if err != nil {
+				return nil, err
+			}
+			signers = append(signers, voucher.Signer)
+		}
+		if highest >= types.VoucherNonceWindow && voucher.Nonce <= highest-types.VoucherNonceWindow {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("voucher %d: nonce %d is too old, highest settled nonce is %d", i, voucher.Nonce, highest)
+		}
+		nonceKey := collections.Join(voucher.Signer, voucher.Nonce)
+		used, err := ms.UsedVoucherNonce.Has(ctx, nonceKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check voucher nonce: %w", err)
+		}
+		batchKey := fmt.Sprintf("%s/%d", voucher.Signer, voucher.Nonce)
+		if used || batchNonces[batchKey] {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("voucher %d: nonce %d already used", i, voucher.Nonce)
+		}
+		batchNonces[batchKey] = true
+		usedNonces = append(usedNonces, nonceKey)
+		highestNonces[voucher.Signer] = max(highest, voucher.Nonce)
+
+		if err := ms.validateVoucherPerms(ctx, voucher); err != nil {
+			return nil, fmt.Errorf("voucher %d: %w", i, err)
+		}
+
+		permSet, err := ms.findBeneficiaries(ctx, voucher.ExecutorPermId, voucher.BeneficiaryPermId)
+		if err != nil {
+			return nil, fmt.Errorf("voucher %d: failed to find beneficiaries: %w", i, err)
+		}
+
+		required := ms.sessionRequiredFunds(ctx, permSet, true, false)
+		if required > voucher.Amount {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("voucher %d: session costs %d, more than the authorized amount %d", i, required, voucher.Amount)
+		}
+		signerTotals[voucher.Signer] += required
+		total += required
+
+		if len(permSet) > 0 {
+			key := fmt.Sprintf("%s/%d/%d", voucher.Signer, voucher.ExecutorPermId, voucher.BeneficiaryPermId)
+			group, ok := feeIndex[key]
+			if !ok {
+				group = &voucherFeeGroup{signer: voucher.Signer, permSet: permSet}
+				feeIndex[key] = group
+				feeGroups = append(feeGroups, group)
+			}
+			group.count++
+		}
+
+		userAgentReward, _ := ms.sessionRewards(ctx, permSet, true, false)
+		if userAgentReward > 0 {
+			key := fmt.Sprintf("%s/%d", voucher.Signer, voucher.AgentPermId)
+			group, ok := rewardIndex[key]
+			if !ok {
+				group = &voucherRewardGroup{signer: voucher.Signer, agentPermID: voucher.AgentPermId}
+				rewardIndex[key] = group
+				rewardGroups = append(rewardGroups, group)
+			}
+			group.reward += userAgentReward
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeSettleSessionVoucher,
+			sdk.NewAttribute(types.AttributeKeySigner, voucher.Signer),
+			sdk.NewAttribute(types.AttributeKeyNonce, strconv.FormatUint(voucher.Nonce, 10)),
+			sdk.NewAttribute(types.AttributeKeyIssuerPermID, strconv.FormatUint(voucher.ExecutorPermId, 10)),
+			sdk.NewAttribute(types.AttributeKeyVerifierPermID, strconv.FormatUint(voucher.BeneficiaryPermId, 10)),
+			sdk.NewAttribute(types.AttributeKeyAgentPermID, strconv.FormatUint(voucher.AgentPermId, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, strconv.FormatUint(required, 10)),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+		))
+	}
+
+	// Validate every signer can pay its vouchers before moving funds
+	for _, signer := range signers {
+		signerAddr, err := sdk.AccAddressFromBech32(signer)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signer address: %w", err)
+		}
+		requiredAmount := sdk.NewInt64Coin(types.BondDenom, int64(signerTotals[signer]))
+		if !ms.bankKeeper.HasBalance(ctx, signerAddr, requiredAmount) {
+			return nil, sdkerrors.ErrInsufficientFunds.Wrapf("insufficient funds for signer %s: required %s", signer, requiredAmount)
+		}
+	}
+
+	trustUnitPrice := ms.trustRegistryKeeper.GetTrustUnitPrice(ctx)
+	trustDepositRate := ms.trustDeposit.GetTrustDepositRate(ctx)
+
+	for _, group := range feeGroups {
+		if err := ms.processFees(ctx, group.signer, false, group.permSet, true, group.count, trustUnitPrice, trustDepositRate); err != nil {
+			return nil, fmt.Errorf("failed to process fees: %w", err)
+		}
+	}
+
+	for _, group := range rewardGroups {
+		if err := ms.payAgentReward(ctx, group.signer, "", group.agentPermID, group.reward, types.RewardTypeUserAgent, trustDepositRate); err != nil {
+			return nil, fmt.Errorf("failed to pay user agent reward: %w", err)
+		}
+	}
+
+	for _, key := range usedNonces {
+		if err := ms.UsedVoucherNonce.Set(ctx, key); err != nil {
+			return nil, fmt.Errorf("failed to save voucher nonce: %w", err)
+		}
+	}
+	for _, signer := range signers {
+		if err := ms.VoucherNonce.Set(ctx, signer, highestNonces[signer]); err != nil {
+			return nil, fmt.Errorf("failed to save voucher nonce: %w", err)
+		}
+		if err := ms.pruneVoucherNonces(ctx, signer, highestNonces[signer]); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgSettleSessionVouchersResponse{
+		Settled: uint64(len(msg.Vouchers)),
+		Total:   total,
+	}, nil
+}
+
+// verifySessionVoucher checks that the voucher is signed, on this chain, by
+// the key of its signer.
+func verifySessionVoucher(chainID string, signed types.SignedSessionVoucher) error {
+	signerAddr, err := sdk.AccAddressFromBech32(signed.Voucher.Signer)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid signer address: %s", err)
+	}
+
+	pubKey := &secp256k1.PubKey{Key: signed.PubKey}
+	if !signerAddr.Equals(sdk.AccAddress(pubKey.Address())) {
+		return sdkerrors.ErrUnauthorized.Wrap("public key does not match the voucher signer")
+	}
+
+	signBytes, err := signed.Voucher.SignBytes(chainID)
+	if err != nil {
+		return fmt.Errorf("failed to get voucher sign bytes: %w", err)
+	}
+	if !pubKey.VerifySignature(signBytes, signed.Signature) {
+		return sdkerrors.ErrUnauthorized.Wrap("invalid voucher signature")
+	}
+
+	return nil
+}
+
+// validateVoucherPerms checks the perms of the voucher session. The verifier
+// perm must be held by the voucher signer.
+func (ms msgServer) validateVoucherPerms(ctx sdk.Context, voucher types.SessionVoucher) error {
+	now := ctx.BlockTime()
+
+	verifierPerm, err := ms.Permission.Get(ctx, voucher.BeneficiaryPermId)
+	if err != nil {
+		return sdkerrors.ErrNotFound.Wrapf("verifier perm not found: %v", err)
+	}
+	if verifierPerm.Type != types.PermissionType_PERMISSION_TYPE_VERIFIER {
+		return sdkerrors.ErrInvalidRequest.Wrap("verifier perm must be VERIFIER type")
+	}
+	if verifierPerm.Grantee != voucher.Signer {
+		return sdkerrors.ErrUnauthorized.Wrap("voucher signer is not the grantee of the verifier perm")
+	}
+	if err := permissionValidityAt(verifierPerm, "", now); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("verifier perm is not valid: %v", err)
+	}
+
+	if voucher.ExecutorPermId != 0 {
+		issuerPerm, err := ms.Permission.Get(ctx, voucher.ExecutorPermId)
+		if err != nil {
+			return sdkerrors.ErrNotFound.Wrapf("issuer perm not found: %v", err)
+		}
+		if issuerPerm.Type != types.PermissionType_PERMISSION_TYPE_ISSUER {
+			return sdkerrors.ErrInvalidRequest.Wrap("issuer perm must be ISSUER type")
+		}
+		if issuerPerm.SchemaId != verifierPerm.SchemaId {
+			return sdkerrors.ErrInvalidRequest.Wrap("issuer perm and verifier perm must belong to the same schema")
+		}
+		if err := permissionValidityAt(issuerPerm, "", now); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("issuer perm is not valid: %v", err)
+		}
+	}
+
+	agentPerm, err := ms.Permission.Get(ctx, voucher.AgentPermId)
+	if err != nil {
+		return sdkerrors.ErrNotFound.Wrap("agent perm not found")
+	}
+	if agentPerm.Type != types.PermissionType_PERMISSION_TYPE_HOLDER {
+		return sdkerrors.ErrInvalidRequest.Wrap("agent perm must be HOLDER type")
+	}
+	if err := permissionValidityAt(agentPerm, "", now); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("agent perm is not valid: %v", err)
+	}
+
+	return nil
+}
+
+// getVoucherNonce returns the highest voucher nonce settled for the signer, 0
+// if none.
+func (k Keeper) getVoucherNonce(ctx sdk.Context, signer string) (uint64, error) {
+	nonce, err := k.VoucherNonce.Get(ctx, signer)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to get voucher nonce: %w", err)
+	}
+	return nonce, nil
+}
+
+// usedVoucherNonces returns the voucher nonces settled for the signer within
+// the nonce window, in increasing order.
+func (k Keeper) usedVoucherNonces(ctx sdk.Context, signer string) ([]uint64, error) {
+	var nonces []uint64
+	rng := collections.NewPrefixedPairRange[string, uint64](signer)
+	err := k.UsedVoucherNonce.Walk(ctx, rng, func(key collections.Pair[string, uint64]) (bool, error) {
+		nonces = append(nonces, key.K2())
+		return false, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get used voucher nonces: %w", err)
+	}
+	return nonces, nil
+}
+
+// pruneVoucherNonces forgets the used nonces of the signer that fell out of
+// the window below highest. They are rejected as too old from then on.
+func (k Keeper) pruneVoucherNonces(ctx sdk.Context, signer string, highest uint64) error {
+	if highest < types.VoucherNonceWindow {
+		return nil
+	}
+
+	rng := collections.NewPrefixedPairRange[string, uint64](signer).EndInclusive(highest - types.VoucherNonceWindow)
+	iter, err := k.UsedVoucherNonce.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return fmt.Errorf("failed to get expired voucher nonces: %w", err)
+	}
+	for _, key := range keys {
+		if err := k.UsedVoucherNonce.Remove(ctx, key); err != nil {
+			return fmt.Errorf("failed to remove expired voucher nonce: %w", err)
+		}
+	}
+	return nil
+}
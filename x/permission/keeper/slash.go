@@ -0,0 +1,428 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/verana-labs/verana-blockchain/x/permission/types"
+	trustregistrytypes "github.com/verana-labs/verana-blockchain/x/trustregistry/types"
+)
+
+const (
+	// slashExecutionBatchSize bounds the number of expired slashes executed per block.
+	slashExecutionBatchSize = 100
+	// slashRetryDelay is the delay after which a slash that failed to execute is retried.
+	slashRetryDelay = time.Hour
+)
+
+// createSlashRecord escrows the slashed amount from the trust deposit of the
+// perm grantee and opens a pending slash that can be appealed until its
+// deadline.
+func (k Keeper) createSlashRecord(ctx sdk.Context, perm types.Permission, msg *types.MsgSlashPermissionTrustDeposit) (types.SlashRecord, error) {
+	now := ctx.BlockTime()
+	params := k.GetParams(ctx)
+	deadline := now.AddDate(0, 0, int(params.SlashAppealPeriodDays))
+
+	// The violated governance framework version defaults to the active one
+	tr, err := k.permTrustRegistry(ctx, perm)
+	if err != nil {
+		return types.SlashRecord{}, err
+	}
+	gfVersion := msg.GfVersion
+	if gfVersion == 0 {
+		gfVersion = tr.ActiveVersion
+	}
+	if gfVersion < 1 || gfVersion > tr.ActiveVersion {
+		return types.SlashRecord{}, fmt.Errorf("governance framework version %d does not exist, active version is %d", gfVersion, tr.ActiveVersion)
+	}
+
+	// The slash destination defaults to the one configured for the trust
+	// registry, and the slashed amount is burned when none is set
+	distribution := msg.Distribution
+	if len(distribution) == 0 {
+		trDistribution, err := k.SlashDistribution.Get(ctx, tr.Id)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return types.SlashRecord{}, fmt.Errorf("failed to get slash distribution: %w", err)
+		}
+		distribution = trDistribution.Distribution
+	}
+
+	if err := k.trustDeposit.EscrowSlashedTrustDeposit(ctx, perm.Grantee, msg.Amount); err != nil {
+		return types.SlashRecord{}, fmt.Errorf("failed to escrow trust deposit: %w", err)
+	}
+
+	id, err := k.getNextSlashRecordID(ctx)
+	if err != nil {
+		return types.SlashRecord{}, err
+	}
+
+	record := types.SlashRecord{
+		Id:                id,
+		PermId:            perm.Id,
+		Grantee:           perm.Grantee,
+		Amount:            msg.Amount,
+		SlashedBy:         msg.Creator,
+		Status:            types.SlashStatus_SLASH_STATUS_PENDING,
+		Created:           &now,
+		AppealDeadline:    &deadline,
+		ReasonCode:        msg.ReasonCode,
+		EvidenceUrl:       msg.EvidenceUrl,
+		EvidenceDigestSri: msg.EvidenceDigestSri,
+		GfVersion:         gfVersion,
+		Distribution:      distribution,
+	}
+	if err := k.SlashRecord.Set(ctx, id, record); err != nil {
+		return types.SlashRecord{}, fmt.Errorf("failed to save slash record: %w", err)
+	}
+	if err := k.indexSlashRecord(ctx, record); err != nil {
+		return types.SlashRecord{}, err
+	}
+	if err := k.SlashAppealQueue.Set(ctx, collections.Join(deadline, id)); err != nil {
+		return types.SlashRecord{}, fmt.Errorf("failed to queue slash record: %w", err)
+	}
+
+	return record, nil
+}
+
+// indexSlashRecord indexes a slash record by perm, grantee and slasher.
+func (k Keeper) indexSlashRecord(ctx context.Context, record types.SlashRecord) error {
+	if err := k.SlashRecordByPerm.Set(ctx, collections.Join(record.PermId, record.Id)); err != nil {
+		return fmt.Errorf("failed to index slash record by perm: %w", err)
+	}
+	if err := k.SlashRecordByGrantee.Set(ctx, collections.Join(record.Grantee, record.Id)); err != nil {
+		return fmt.Errorf("failed to index slash record by grantee: %w", err)
+	}
+	if err := k.SlashRecordBySlasher.Set(ctx, collections.Join(record.SlashedBy, record.Id)); err != nil {
+		return fmt.Errorf("failed to index slash record by slasher: %w", err)
+	}
+	return nil
+}
+
+// openSlashedAmount returns the amount escrowed by the pending and appealed
+// slashes of a perm.
+func (k Keeper) openSlashedAmount(ctx sdk.Context, permID uint64) (uint64, error) {
+	total := uint64(0)
+	err := k.SlashRecordByPerm.Walk(ctx, collections.NewPrefixedPairRange[uint64, uint64](permID), func(key collections.Pair[uint64, uint64]) (bool, error) {
+		record, err := k.SlashRecord.Get(ctx, key.K2())
+		if err != nil {
+			return true, fmt.Errorf("failed to get slash record %d: %w", key.K2(), err)
+		}
+		if isOpenSlash(record) {
+			total += record.Amount
+		}
+		return false, nil
+	})
+	return total, err
+}
+
+func isOpenSlash(record types.SlashRecord) bool {
+	return record.Status == types.SlashStatus_SLASH_STATUS_PENDING ||
+		record.Status == types.SlashStatus_SLASH_STATUS_APPEALED
+}
+
+// AppealSlash moves a pending slash to the appealed state so that it is only
+// executed once the appeal is resolved.
+func (ms msgServer) AppealSlash(goCtx context.Context, msg *types.MsgAppealSlash) (*types.MsgAppealSlashResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	now := ctx.BlockTime()
+
+	record, err := ms.SlashRecord.Get(ctx, msg.Id)
+	if err != nil {
+		return nil, fmt.Errorf("slash record not found: %w", err)
+	}
+
+	if record.Grantee != msg.Creator {
+		return nil, fmt.Errorf("only the grantee of the slashed perm can appeal")
+	}
+
+	if record.Status != types.SlashStatus_SLASH_STATUS_PENDING {
+		return nil, fmt.Errorf("slash is not pending: %s", record.Status)
+	}
+
+	if !now.Before(*record.AppealDeadline) {
+		return nil, fmt.Errorf("appeal deadline passed at %v", record.AppealDeadline)
+	}
+
+	if err := ms.SlashAppealQueue.Remove(ctx, collections.Join(*record.AppealDeadline, record.Id)); err != nil {
+		return nil, fmt.Errorf("failed to dequeue slash record: %w", err)
+	}
+
+	record.Status = types.SlashStatus_SLASH_STATUS_APPEALED
+	record.Appealed = &now
+	record.AppealDigestSri = msg.EvidenceDigestSri
+	if err := ms.SlashRecord.Set(ctx, record.Id, record); err != nil {
+		return nil, fmt.Errorf("failed to save slash record: %w", err)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAppealSlash,
+			sdk.NewAttribute(types.AttributeKeySlashID, strconv.FormatUint(record.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyPermissionID, strconv.FormatUint(record.PermId, 10)),
+			sdk.NewAttribute(types.AttributeKeyEvidenceDigestSRI, msg.EvidenceDigestSri),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+		),
+	})
+
+	return &types.MsgAppealSlashResponse{}, nil
+}
+
+// ResolveSlashAppeal executes or reverses an appealed slash. It is restricted
+// to the controller of the trust registry the perm schema belongs to and to
+// governance.
+func (ms msgServer) ResolveSlashAppeal(goCtx context.Context, msg *types.MsgResolveSlashAppeal) (*types.MsgResolveSlashAppealResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	record, err := ms.SlashRecord.Get(ctx, msg.Id)
+	if err != nil {
+		return nil, fmt.Errorf("slash record not found: %w", err)
+	}
+
+	if record.Status != types.SlashStatus_SLASH_STATUS_APPEALED {
+		return nil, fmt.Errorf("slash is not appealed: %s", record.Status)
+	}
+
+	if msg.Creator != ms.authority {
+		controller, err := ms.slashRecordController(ctx, record)
+		if err != nil {
+			return nil, err
+		}
+		if controller != msg.Creator {
+			return nil, fmt.Errorf("only the trust registry controller or governance can resolve a slash appeal")
+		}
+	}
+
+	if msg.Uphold {
+		err = ms.executeSlash(ctx, record, msg.Creator)
+	} else {
+		err = ms.reverseSlash(ctx, record, msg.Creator)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeResolveSlashAppeal,
+			sdk.NewAttribute(types.AttributeKeySlashID, strconv.FormatUint(record.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyPermissionID, strconv.FormatUint(record.PermId, 10)),
+			sdk.NewAttribute(types.AttributeKeyUphold, strconv.FormatBool(msg.Uphold)),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, ctx.BlockTime().String()),
+		),
+	})
+
+	return &types.MsgResolveSlashAppealResponse{}, nil
+}
+
+// slashRecordController returns the controller of the trust registry the
+// slashed perm schema belongs to.
+func (k Keeper) slashRecordController(ctx sdk.Context, record types.SlashRecord) (string, error) {
+	perm, err := k.GetPermissionByID(ctx, record.PermId)
+	if err != nil {
+		return "", fmt.Errorf("perm not found: %w", err)
+	}
+
+	tr, err := k.permTrustRegistry(ctx, perm)
+	if err != nil {
+		return "", err
+	}
+
+	return tr.Controller, nil
+}
+
+// permTrustRegistry returns the trust registry the perm schema belongs to.
+func (k Keeper) permTrustRegistry(ctx sdk.Context, perm types.Permission) (trustregistrytypes.TrustRegistry, error) {
+	cs, err := k.credentialSchemaKeeper.GetCredentialSchemaById(ctx, perm.SchemaId)
+	if err != nil {
+		return trustregistrytypes.TrustRegistry{}, fmt.Errorf("credential schema not found: %w", err)
+	}
+
+	tr, err := k.trustRegistryKeeper.GetTrustRegistry(ctx, cs.TrId)
+	if err != nil {
+		return trustregistrytypes.TrustRegistry{}, fmt.Errorf("trust registry not found: %w", err)
+	}
+
+	return tr, nil
+}
+
+// executeSlash distributes the escrowed amount and marks the perm as slashed.
+func (k Keeper) executeSlash(ctx sdk.Context, record types.SlashRecord, resolvedBy string) error {
+	now := ctx.BlockTime()
+
+	perm, err := k.GetPermissionByID(ctx, record.PermId)
+	if err != nil {
+		return fmt.Errorf("perm not found: %w", err)
+	}
+
+	if err := k.trustDeposit.DistributeEscrowedSlashedTrustDeposit(ctx, record.Grantee, record.Amount, record.Distribution); err != nil {
+		return fmt.Errorf("failed to distribute slashed trust deposit: %w", err)
+	}
+
+	// Update Permission entry applicant_perm
+	perm.Slashed = &now
+	perm.Modified = &now
+	perm.SlashedDeposit = perm.SlashedDeposit + record.Amount
+	perm.SlashedBy = record.SlashedBy
+	if err := k.UpdatePermission(ctx, perm); err != nil {
+		return fmt.Errorf("failed to update perm: %w", err)
+	}
+
+	record.Status = types.SlashStatus_SLASH_STATUS_EXECUTED
+	record.Resolved = &now
+	record.ResolvedBy = resolvedBy
+	if err := k.SlashRecord.Set(ctx, record.Id, record); err != nil {
+		return fmt.Errorf("failed to save slash record: %w", err)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeExecuteSlash,
+			sdk.NewAttribute(types.AttributeKeySlashID, strconv.FormatUint(record.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyPermissionID, strconv.FormatUint(record.PermId, 10)),
+			sdk.NewAttribute(types.AttributeKeySlashedAmount, strconv.FormatUint(record.Amount, 10)),
+			sdk.NewAttribute(types.AttributeKeySlashedBy, record.SlashedBy),
+			sdk.NewAttribute(types.AttributeKeyReasonCode, strconv.FormatUint(uint64(record.ReasonCode), 10)),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+		),
+	})
+
+	return nil
+}
+
+// reverseSlash returns the escrowed amount to the trust deposit of the grantee.
+func (k Keeper) reverseSlash(ctx sdk.Context, record types.SlashRecord, resolvedBy string) error {
+	now := ctx.BlockTime()
+
+	if err := k.trustDeposit.ReleaseEscrowedSlashedTrustDeposit(ctx, record.Grantee, record.Amount); err != nil {
+		return fmt.Errorf("failed to release trust deposit: %w", err)
+	}
+
+	record.Status = types.SlashStatus_SLASH_STATUS_REVERSED
+	record.Resolved = &now
+	record.ResolvedBy = resolvedBy
+	if err := k.SlashRecord.Set(ctx, record.Id, record); err != nil {
+		return fmt.Errorf("failed to save slash record: %w", err)
+	}
+
+	return nil
+}
+
+// ExecuteExpiredSlashes executes the pending slashes whose appeal deadline has
+// passed, at most slashExecutionBatchSize per call. A slash that fails is
+// logged and queued again slashRetryDelay later, so that it neither halts the
+// chain nor holds back the slashes behind it. It is called from the module
+// EndBlocker.
+func (k Keeper) ExecuteExpiredSlashes(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime()
+
+	rng := new(collections.Range[collections.Pair[time.Time, uint64]]).
+		EndInclusive(collections.Join(now, uint64(math.MaxUint64)))
+	iter, err := k.SlashAppealQueue.Iterate(sdkCtx, rng)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	var expired []collections.Pair[time.Time, uint64]
+	for ; iter.Valid() && len(expired) < slashExecutionBatchSize; iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return err
+		}
+		expired = append(expired, key)
+	}
+
+	for _, key := range expired {
+		if err := k.SlashAppealQueue.Remove(sdkCtx, key); err != nil {
+			return err
+		}
+
+		record, err := k.SlashRecord.Get(sdkCtx, key.K2())
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if record.Status != types.SlashStatus_SLASH_STATUS_PENDING {
+			continue
+		}
+
+		cacheCtx, write := sdkCtx.CacheContext()
+		if err := k.executeSlash(cacheCtx, record, ""); err != nil {
+			k.Logger().Error("failed to execute slash, retrying later", "slash_id", record.Id, "perm_id", record.PermId, "error", err)
+			if err := k.SlashAppealQueue.Set(sdkCtx, collections.Join(now.Add(slashRetryDelay), record.Id)); err != nil {
+				return err
+			}
+			continue
+		}
+		write()
+	}
+
+	return nil
+}
+
+// getNextSlashRecordID gets the next available slash record ID
+func (k Keeper) getNextSlashRecordID(ctx sdk.Context) (uint64, error) {
+	id, err := k.SlashRecordCounter.Get(ctx)
+	if err != nil {
+		id = 0
+	}
+
+	nextID := id + 1
+	if err := k.SlashRecordCounter.Set(ctx, nextID); err != nil {
+		return 0, fmt.Errorf("failed to set slash record counter: %w", err)
+	}
+
+	return nextID, nil
+}
+
+// SetSlashDistribution sets where the slashed deposits of the perms of a trust
+// registry go. An empty distribution removes the configuration, so that
+// slashed deposits are burned.
+func (ms msgServer) SetSlashDistribution(goCtx context.Context, msg *types.MsgSetSlashDistribution) (*types.MsgSetSlashDistributionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tr, err := ms.trustRegistryKeeper.GetTrustRegistry(ctx, msg.TrId)
+	if err != nil {
+		return nil, fmt.Errorf("trust registry not found: %w", err)
+	}
+
+	if tr.Controller != msg.Creator {
+		return nil, fmt.Errorf("only the trust registry controller can set the slash distribution")
+	}
+
+	if len(msg.Distribution) == 0 {
+		if err := ms.SlashDistribution.Remove(ctx, msg.TrId); err != nil {
+			return nil, fmt.Errorf("failed to remove slash distribution: %w", err)
+		}
+	} else {
+		if err := ms.SlashDistribution.Set(ctx, msg.TrId, types.TrustRegistrySlashDistribution{
+			TrId:         msg.TrId,
+			Distribution: msg.Distribution,
+		}); err != nil {
+			return nil, fmt.Errorf("failed to save slash distribution: %w", err)
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetSlashDistribution,
+			sdk.NewAttribute(types.AttributeKeyTrustRegistryID, strconv.FormatUint(msg.TrId, 10)),
+			sdk.NewAttribute(types.AttributeKeyShareCount, strconv.Itoa(len(msg.Distribution))),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, ctx.BlockTime().String()),
+		),
+	})
+
+	return &types.MsgSetSlashDistributionResponse{}, nil
+}
@@ -8,35 +8,38 @@ import (
 	"github.com/verana-labs/verana-blockchain/x/permission/types"
 )
 
-func (ms msgServer) validatePermissionChecks(ctx sdk.Context, msg *types.MsgStartPermissionVP) (types.Permission, error) {
+// validatePermissionChecks returns the validator perm of the validation
+// process and the number of validator approvals it requires.
+func (ms msgServer) validatePermissionChecks(ctx sdk.Context, msg *types.MsgStartPermissionVP) (types.Permission, uint32, error) {
 	// Load validator perm
 	validatorPerm, err := ms.Keeper.GetPermissionByID(ctx, msg.ValidatorPermId)
 	if err != nil {
-		return types.Permission{}, fmt.Errorf("validator perm not found: %w", err)
+		return types.Permission{}, 0, fmt.Errorf("validator perm not found: %w", err)
 	}
 
-	// Check if validator perm is valid
-	if err := IsValidPermission(validatorPerm, msg.Country, ctx.BlockTime()); err != nil {
-		return types.Permission{}, fmt.Errorf("validator perm is not valid: %w", err)
-	}
-
-	// Check country compatibility
-	if validatorPerm.Country != "" && validatorPerm.Country != msg.Country {
-		return types.Permission{}, fmt.Errorf("validator perm country mismatch")
+	// Check if validator perm is valid for the applicant country scope
+	if err := IsValidPermission(validatorPerm, msg.Countries, ctx.BlockTime()); err != nil {
+		return types.Permission{}, 0, fmt.Errorf("validator perm is not valid: %w", err)
 	}
 
 	// Load credential schema
 	cs, err := ms.credentialSchemaKeeper.GetCredentialSchemaById(ctx, validatorPerm.SchemaId)
 	if err != nil {
-		return types.Permission{}, fmt.Errorf("credential schema not found: %w", err)
+		return types.Permission{}, 0, fmt.Errorf("credential schema not found: %w", err)
 	}
 
 	// Validate perm type combinations
 	if err := validatePermissionTypeCombination(types.PermissionType(msg.Type), validatorPerm.Type, cs); err != nil {
-		return types.Permission{}, err
+		return types.Permission{}, 0, err
+	}
+
+	// Validate co-validators against the validation quorum of the schema
+	quorum := validationQuorum(types.PermissionType(msg.Type), cs)
+	if err := ms.validateCoValidators(ctx, msg, validatorPerm, quorum); err != nil {
+		return types.Permission{}, 0, err
 	}
 
-	return validatorPerm, nil
+	return validatorPerm, quorum, nil
 }
 
 func (ms msgServer) validateAndCalculateFees(ctx sdk.Context, _ string, validatorPerm types.Permission) (uint64, uint64, error) {
@@ -56,7 +59,7 @@ func (k Keeper) validationTrustDepositInDenomAmount(validationFeesInDenom uint64
 	return validationTrustDepositInDenom.TruncateInt().Uint64()
 }
 
-func (ms msgServer) executeStartPermissionVP(ctx sdk.Context, msg *types.MsgStartPermissionVP, validatorPerm types.Permission, fees, deposit uint64) (uint64, error) {
+func (ms msgServer) executeStartPermissionVP(ctx sdk.Context, msg *types.MsgStartPermissionVP, validatorPerm types.Permission, quorum uint32, fees, deposit uint64) (uint64, error) {
 	// Calculate fees and deposits as per spec
 	validationFeesInDenom := fees
 	validationTrustDepositInDenom := deposit
@@ -101,7 +104,7 @@ func (ms msgServer) executeStartPermissionVP(ctx sdk.Context, msg *types.MsgStar
 		ValidationFees:     0,                             // applicant_perm.validation_fees: 0
 		IssuanceFees:       0,                             // applicant_perm.issuance_fees: 0
 		VerificationFees:   0,                             // applicant_perm.verification_fees: 0
-		Country:            msg.Country,
+		Countries:          msg.Countries,
 		ValidatorPermId:    msg.ValidatorPermId,                            // applicant_perm.validator_perm_id: validator_perm_id
 		VpLastStateChange:  &now,                                           // applicant_perm.vp_last_state_change: now
 		VpState:            types.ValidationState_VALIDATION_STATE_PENDING, // applicant_perm.vp_state: PENDING
@@ -110,6 +113,8 @@ func (ms msgServer) executeStartPermissionVP(ctx sdk.Context, msg *types.MsgStar
 		VpSummaryDigestSri: "",                                             // applicant_perm.vp_summary_digest_sri: null
 		VpTermRequested:    nil,                                            // applicant_perm.vp_term_requested: null
 		VpValidatorDeposit: 0,                                              // applicant_perm.vp_validator_deposit: 0
+		CoValidatorPermIds: msg.CoValidatorPermIds,
+		VpQuorum:           quorum,
 	}
 
 	// Store the perm
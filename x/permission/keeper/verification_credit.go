@@ -0,0 +1,260 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/verana-labs/verana-blockchain/x/permission/types"
+)
+
+// PurchaseVerificationCredits pays the session fees of count verification
+// sessions at once and records them as credits of the buyer. The beneficiary
+// fees are distributed right away and are not refunded for unused credits,
+// the response reports them apart from the refundable buyer trust deposit.
+func (ms msgServer) PurchaseVerificationCredits(goCtx context.Context, msg *types.MsgPurchaseVerificationCredits) (*types.MsgPurchaseVerificationCreditsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	now := ctx.BlockTime()
+
+	verifierPerm, err := ms.Permission.Get(ctx, msg.VerifierPermId)
+	if err != nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("verifier perm not found: %v", err)
+	}
+	if verifierPerm.Type != types.PermissionType_PERMISSION_TYPE_VERIFIER {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("verifier perm must be VERIFIER type")
+	}
+	if err := permissionValidityAt(verifierPerm, "", now); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("verifier perm is not valid: %v", err)
+	}
+
+	if msg.IssuerPermId != 0 {
+		issuerPerm, err := ms.Permission.Get(ctx, msg.IssuerPermId)
+		if err != nil {
+			return nil, sdkerrors.ErrNotFound.Wrapf("issuer perm not found: %v", err)
+		}
+		if issuerPerm.Type != types.PermissionType_PERMISSION_TYPE_ISSUER {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap("issuer perm must be ISSUER type")
+		}
+		if issuerPerm.SchemaId != verifierPerm.SchemaId {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap("issuer perm and verifier perm must belong to the same schema")
+		}
+		if err := permissionValidityAt(issuerPerm, "", now); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("issuer perm is not valid: %v", err)
+		}
+	}
+
+	permSet, err := ms.findBeneficiaries(ctx, msg.IssuerPermId, msg.VerifierPermId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find beneficiaries: %w", err)
+	}
+	if len(permSet) == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("verification sessions of these perms have no beneficiary")
+	}
+
+	unitFees, unitTrustDeposit := ms.verificationCreditUnitCost(ctx, permSet)
+	cost, err := verificationCreditsCost(unitFees, unitTrustDeposit, msg.Count)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	creatorAddr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, fmt.Errorf("invalid creator address: %w", err)
+	}
+	requiredAmount := sdk.NewInt64Coin(types.BondDenom, int64(cost))
+	if !ms.bankKeeper.HasBalance(ctx, creatorAddr, requiredAmount) {
+		return nil, sdkerrors.ErrInsufficientFunds.Wrapf("insufficient funds: required %s", requiredAmount)
+	}
+
+	// Distribute the fees of all credits, as processFees does for each session
+	trustUnitPrice := ms.trustRegistryKeeper.GetTrustUnitPrice(ctx)
+	trustDepositRate := ms.trustDeposit.GetTrustDepositRate(ctx)
+	if err := ms.processFees(ctx, msg.Creator, false, permSet, true, msg.Count, trustUnitPrice, trustDepositRate); err != nil {
+		return nil, fmt.Errorf("failed to process fees: %w", err)
+	}
+
+	id, err := ms.getNextVerificationCreditID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	credit := types.VerificationCredit{
+		Id:               id,
+		Buyer:            msg.Creator,
+		VerifierPermId:   msg.VerifierPermId,
+		IssuerPermId:     msg.IssuerPermId,
+		ExecutorPermId:   sessionExecutorPermID(permSet, true),
+		Purchased:        msg.Count,
+		Remaining:        msg.Count,
+		UnitFees:         unitFees,
+		UnitTrustDeposit: unitTrustDeposit,
+		Created:          &now,
+		Modified:         &now,
+	}
+	if err := ms.VerificationCredit.Set(ctx, id, credit); err != nil {
+		return nil, fmt.Errorf("failed to save verification credit: %w", err)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePurchaseVerificationCredits,
+			sdk.NewAttribute(types.AttributeKeyCreditID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyVerifierPermID, strconv.FormatUint(msg.VerifierPermId, 10)),
+			sdk.NewAttribute(types.AttributeKeyIssuerPermID, strconv.FormatUint(msg.IssuerPermId, 10)),
+			sdk.NewAttribute(types.AttributeKeyCount, strconv.FormatUint(msg.Count, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, strconv.FormatUint(cost, 10)),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+		),
+	})
+
+	return &types.MsgPurchaseVerificationCreditsResponse{
+		Id:           id,
+		Cost:         cost,
+		Fees:         unitFees * msg.Count,
+		TrustDeposit: unitTrustDeposit * msg.Count,
+	}, nil
+}
+
+// RefundVerificationCredits closes a credit bundle of the buyer. The fees of
+// the unused credits were distributed at purchase and are not returned, the
+// buyer trust deposit funded for them is released and becomes claimable.
+func (ms msgServer) RefundVerificationCredits(goCtx context.Context, msg *types.MsgRefundVerificationCredits) (*types.MsgRefundVerificationCreditsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	now := ctx.BlockTime()
+
+	credit, err := ms.VerificationCredit.Get(ctx, msg.Id)
+	if err != nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("verification credit not found: %v", err)
+	}
+	if credit.Buyer != msg.Creator {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("only the buyer can refund verification credits")
+	}
+	if credit.Refunded != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("verification credits have already been refunded")
+	}
+	if credit.Remaining == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("no unused verification credit to refund")
+	}
+
+	refunded := credit.Remaining
+	released := refunded * credit.UnitTrustDeposit
+	if released > 0 {
+		if err := ms.trustDeposit.AdjustTrustDeposit(ctx, credit.Buyer, -int64(released)); err != nil {
+			return nil, fmt.Errorf("failed to release buyer trust deposit: %w", err)
+		}
+
+		executorPerm, err := ms.Permission.Get(ctx, credit.ExecutorPermId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get executor perm: %w", err)
+		}
+		executorPerm.Deposit -= min(released, executorPerm.Deposit)
+		if err := ms.Keeper.UpdatePermission(ctx, executorPerm); err != nil {
+			return nil, fmt.Errorf("failed to update executor perm deposit: %w", err)
+		}
+	}
+
+	credit.Remaining = 0
+	credit.Modified = &now
+	credit.Refunded = &now
+	if err := ms.VerificationCredit.Set(ctx, credit.Id, credit); err != nil {
+		return nil, fmt.Errorf("failed to save verification credit: %w", err)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRefundVerificationCredits,
+			sdk.NewAttribute(types.AttributeKeyCreditID, strconv.FormatUint(credit.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyCount, strconv.FormatUint(refunded, 10)),
+			sdk.NewAttribute(types.AttributeKeyTrustDeposit, strconv.FormatUint(released, 10)),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+		),
+	})
+
+	return &types.MsgRefundVerificationCreditsResponse{
+		RefundedCredits:      refunded,
+		ReleasedTrustDeposit: released,
+	}, nil
+}
+
+// consumeVerificationCredit takes one credit of the bundle of the session. The
+// bundle must belong to the session creator and match its issuer and verifier
+// perms.
+func (ms msgServer) consumeVerificationCredit(ctx sdk.Context, msg *types.MsgCreateOrUpdatePermissionSession) error {
+	credit, err := ms.VerificationCredit.Get(ctx, msg.CreditId)
+	if err != nil {
+		return sdkerrors.ErrNotFound.Wrapf("verification credit not found: %v", err)
+	}
+
+	if credit.Buyer != msg.Creator {
+		return sdkerrors.ErrUnauthorized.Wrap("only the buyer can consume verification credits")
+	}
+	if credit.VerifierPermId != msg.VerifierPermId || credit.IssuerPermId != msg.IssuerPermId {
+		return sdkerrors.ErrUnauthorized.Wrapf("verification credit %d does not cover this session", credit.Id)
+	}
+	if credit.Remaining == 0 {
+		return sdkerrors.ErrInsufficientFunds.Wrapf("no verification credit left in %d", credit.Id)
+	}
+
+	now := ctx.BlockTime()
+	credit.Remaining--
+	credit.Modified = &now
+	if err := ms.VerificationCredit.Set(ctx, credit.Id, credit); err != nil {
+		return fmt.Errorf("failed to save verification credit: %w", err)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConsumeVerificationCredit,
+			sdk.NewAttribute(types.AttributeKeyCreditID, strconv.FormatUint(credit.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeySessionID, msg.Id),
+			sdk.NewAttribute(types.AttributeKeyRemaining, strconv.FormatUint(credit.Remaining, 10)),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+		),
+	})
+
+	return nil
+}
+
+// verificationCreditUnitCost returns, per credit, the fees paid to the
+// beneficiaries and the trust deposit added for the buyer.
+func (k Keeper) verificationCreditUnitCost(ctx sdk.Context, permSet []types.Permission) (uint64, uint64) {
+	var fees, trustDeposit uint64
+	for _, fee := range k.sessionBeneficiaryFees(ctx, permSet, true) {
+		fees += fee.FeesInDenom
+		trustDeposit += fee.CreatorTrustDeposit
+	}
+	return fees, trustDeposit
+}
+
+// verificationCreditsCost returns the cost of count credits, or an error when
+// it does not fit in a coin amount.
+func verificationCreditsCost(unitFees, unitTrustDeposit, count uint64) (uint64, error) {
+	cost := math.NewIntFromUint64(unitFees).
+		Add(math.NewIntFromUint64(unitTrustDeposit)).
+		Mul(math.NewIntFromUint64(count))
+	if !cost.IsInt64() {
+		return 0, fmt.Errorf("cost of %d verification credits overflows: %s", count, cost)
+	}
+	return cost.Uint64(), nil
+}
+
+// getNextVerificationCreditID gets the next available verification credit ID
+func (k Keeper) getNextVerificationCreditID(ctx sdk.Context) (uint64, error) {
+	id, err := k.VerificationCreditCounter.Get(ctx)
+	if err != nil {
+		id = 0
+	}
+
+	nextID := id + 1
+	if err := k.VerificationCreditCounter.Set(ctx, nextID); err != nil {
+		return 0, fmt.Errorf("failed to set verification credit counter: %w", err)
+	}
+
+	return nextID, nil
+}
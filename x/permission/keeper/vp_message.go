@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/verana-labs/verana-blockchain/x/permission/types"
+)
+
+// AnchorVPMessage records the digest and location of a message exchanged
+// between the applicant and the validator of the validation process of a perm.
+func (ms msgServer) AnchorVPMessage(goCtx context.Context, msg *types.MsgAnchorVPMessage) (*types.MsgAnchorVPMessageResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	now := ctx.BlockTime()
+
+	perm, err := ms.Permission.Get(ctx, msg.PermId)
+	if err != nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("perm not found: %v", err)
+	}
+	if perm.ValidatorPermId == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("perm has no validation process")
+	}
+	if perm.VpState == types.ValidationState_VALIDATION_STATE_TERMINATED {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("validation process is terminated")
+	}
+
+	// Only the parties of the validation process can anchor messages
+	if msg.Creator != perm.Grantee {
+		validatorPerm, err := ms.Permission.Get(ctx, perm.ValidatorPermId)
+		if err != nil {
+			return nil, fmt.Errorf("validator perm not found: %w", err)
+		}
+		if msg.Creator != validatorPerm.Grantee {
+			return nil, sdkerrors.ErrUnauthorized.Wrap("only the applicant or the validator grantee can anchor messages")
+		}
+	}
+
+	lastIndex, err := ms.getLastVPMessageIndex(ctx, msg.PermId)
+	if err != nil {
+		return nil, err
+	}
+	if lastIndex >= types.MaxVPMessagesPerPerm {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("validation process already has %d messages", types.MaxVPMessagesPerPerm)
+	}
+
+	vpMessage := types.VPMessage{
+		PermId:       msg.PermId,
+		Index:        lastIndex + 1,
+		Sender:       msg.Creator,
+		DigestSri:    msg.DigestSri,
+		EncryptedUri: msg.EncryptedUri,
+		Created:      &now,
+	}
+	if err := ms.VPMessage.Set(ctx, collections.Join(vpMessage.PermId, vpMessage.Index), vpMessage); err != nil {
+		return nil, fmt.Errorf("failed to save vp message: %w", err)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAnchorVPMessage,
+			sdk.NewAttribute(types.AttributeKeyPermissionID, strconv.FormatUint(vpMessage.PermId, 10)),
+			sdk.NewAttribute(types.AttributeKeyIndex, strconv.FormatUint(vpMessage.Index, 10)),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyDigestSri, msg.DigestSri),
+			sdk.NewAttribute(types.AttributeKeyEncryptedURI, msg.EncryptedUri),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+		),
+	})
+
+	return &types.MsgAnchorVPMessageResponse{
+		Index: vpMessage.Index,
+	}, nil
+}
+
+// getLastVPMessageIndex returns the index of the last message anchored for the
+// validation process of a perm, 0 if none.
+func (k Keeper) getLastVPMessageIndex(ctx sdk.Context, permID uint64) (uint64, error) {
+	iter, err := k.VPMessage.Iterate(ctx, collections.NewPrefixedPairRange[uint64, uint64](permID).Descending())
+	if err != nil {
+		return 0, fmt.Errorf("failed to iterate vp messages: %w", err)
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 0, nil
+	}
+	key, err := iter.Key()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get vp message key: %w", err)
+	}
+	return key.K2(), nil
+}
@@ -0,0 +1,217 @@
+package keeper
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cstypes "github.com/verana-labs/verana-blockchain/x/credentialschema/types"
+	"github.com/verana-labs/verana-blockchain/x/permission/types"
+)
+
+// validationQuorum returns the number of validator approvals the credential
+// schema requires for a validation process of the perm type. 0 or 1 means a
+// single validator.
+func validationQuorum(permType types.PermissionType, cs cstypes.CredentialSchema) uint32 {
+	switch permType {
+	case types.PermissionType_PERMISSION_TYPE_ISSUER:
+		return cs.IssuerValidationQuorum
+	case types.PermissionType_PERMISSION_TYPE_VERIFIER:
+		return cs.VerifierValidationQuorum
+	default:
+		return 0
+	}
+}
+
+// validateCoValidators checks the co-validators named by the applicant. With a
+// quorum, the validator perms must be enough to reach it, share the type and
+// schema of the validator perm, be valid for the applicant country scope and
+// have distinct grantees. Without a quorum, no co-validator is allowed.
+func (ms msgServer) validateCoValidators(ctx sdk.Context, msg *types.MsgStartPermissionVP, validatorPerm types.Permission, quorum uint32) error {
+	if quorum <= 1 {
+		if len(msg.CoValidatorPermIds) > 0 {
+			return fmt.Errorf("credential schema does not set a validation quorum for %s perms", types.PermissionType(msg.Type))
+		}
+		return nil
+	}
+
+	if uint32(len(msg.CoValidatorPermIds))+1 < quorum {
+		return fmt.Errorf("validation quorum of %d requires at least %d co-validator perms", quorum, quorum-1)
+	}
+
+	grantees := map[string]bool{validatorPerm.Grantee: true}
+	for _, id := range msg.CoValidatorPermIds {
+		coValidatorPerm, err := ms.Keeper.GetPermissionByID(ctx, id)
+		if err != nil {
+			return fmt.Errorf("co-validator perm %d not found: %w", id, err)
+		}
+		if coValidatorPerm.Type != validatorPerm.Type || coValidatorPerm.SchemaId != validatorPerm.SchemaId {
+			return fmt.Errorf("co-validator perm %d must have the type and schema of the validator perm", id)
+		}
+		if err := IsValidPermission(coValidatorPerm, msg.Countries, ctx.BlockTime()); err != nil {
+			return fmt.Errorf("co-validator perm %d is not valid: %w", id, err)
+		}
+		if grantees[coValidatorPerm.Grantee] {
+			return fmt.Errorf("co-validator perm %d has the grantee of another validator perm", id)
+		}
+		grantees[coValidatorPerm.Grantee] = true
+	}
+
+	return nil
+}
+
+// approvePermissionVP records the approval of a validation process with a
+// quorum by the validator perm of msg.Creator. Once vp_quorum validators have
+// approved the same terms, the perm is validated and the escrowed fees are
+// split among them.
+func (ms msgServer) approvePermissionVP(
+	ctx sdk.Context,
+	applicantPerm types.Permission,
+	msg *types.MsgSetPermissionVPToValidated,
+	now time.Time,
+	vpExp *time.Time,
+) (*types.MsgSetPermissionVPToValidatedResponse, error) {
+	approverPerm, err := ms.findApproverPerm(ctx, applicantPerm, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	// the approver perm MUST be valid, as the validator perm of a single
+	// validator process
+	if err := IsValidPermission(approverPerm, approverPerm.Countries, now); err != nil {
+		return nil, fmt.Errorf("validator perm %d is not valid: %w", approverPerm.Id, err)
+	}
+
+	for _, approval := range applicantPerm.VpApprovals {
+		if approval.ValidatorPermId == approverPerm.Id {
+			return nil, fmt.Errorf("validator perm %d has already approved the validation process", approverPerm.Id)
+		}
+	}
+
+	// countries MUST be within the country scope of the approver perm
+	if !types.CountryScopeWithin(msg.Countries, approverPerm.Countries) {
+		return nil, fmt.Errorf("country scope %v is not within validator perm scope %v", msg.Countries, approverPerm.Countries)
+	}
+
+	approval := types.VPApproval{
+		ValidatorPermId:    approverPerm.Id,
+		Approver:           msg.Creator,
+		EffectiveUntil:     msg.EffectiveUntil,
+		ValidationFees:     msg.ValidationFees,
+		IssuanceFees:       msg.IssuanceFees,
+		VerificationFees:   msg.VerificationFees,
+		Countries:          msg.Countries,
+		VpSummaryDigestSri: msg.VpSummaryDigestSri,
+		Created:            &now,
+	}
+	if len(applicantPerm.VpApprovals) > 0 && !sameVPApprovalTerms(applicantPerm.VpApprovals[0], approval) {
+		return nil, fmt.Errorf("approval terms differ from the terms already approved by validator perm %d",
+			applicantPerm.VpApprovals[0].ValidatorPermId)
+	}
+	applicantPerm.VpApprovals = append(applicantPerm.VpApprovals, approval)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeApprovePermissionVP,
+		sdk.NewAttribute(types.AttributeKeyPermissionID, strconv.FormatUint(applicantPerm.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyValidatorPermID, strconv.FormatUint(approverPerm.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyApprovals, strconv.Itoa(len(applicantPerm.VpApprovals))),
+		sdk.NewAttribute(types.AttributeKeyQuorum, strconv.FormatUint(uint64(applicantPerm.VpQuorum), 10)),
+		sdk.NewAttribute(types.AttributeKeyTimestamp, now.String()),
+	))
+
+	if uint32(len(applicantPerm.VpApprovals)) < applicantPerm.VpQuorum {
+		applicantPerm.Modified = &now
+		if err := ms.Keeper.UpdatePermission(ctx, applicantPerm); err != nil {
+			return nil, fmt.Errorf("failed to update permission: %w", err)
+		}
+		return &types.MsgSetPermissionVPToValidatedResponse{}, nil
+	}
+
+	// Quorum reached: validate the perm with every approver as validator
+	approverPerms := make([]types.Permission, 0, len(applicantPerm.VpApprovals))
+	for _, approval := range applicantPerm.VpApprovals {
+		perm, err := ms.Keeper.GetPermissionByID(ctx, approval.ValidatorPermId)
+		if err != nil {
+			return nil, fmt.Errorf("validator permission not found: %w", err)
+		}
+		approverPerms = append(approverPerms, perm)
+	}
+
+	return ms.executeSetPermissionVPToValidated(ctx, applicantPerm, approverPerms, msg, now, vpExp)
+}
+
+// findApproverPerm returns the validator perm of the validation process held
+// by the approver.
+func (ms msgServer) findApproverPerm(ctx sdk.Context, applicantPerm types.Permission, approver string) (types.Permission, error) {
+	validatorPermIDs := append([]uint64{applicantPerm.ValidatorPermId}, applicantPerm.CoValidatorPermIds...)
+	for _, id := range validatorPermIDs {
+		perm, err := ms.Keeper.GetPermissionByID(ctx, id)
+		if err != nil {
+			return types.Permission{}, fmt.Errorf("validator permission not found: %w", err)
+		}
+		if perm.Grantee == approver {
+			return perm, nil
+		}
+	}
+	return types.Permission{}, fmt.Errorf("account running method must be the grantee of a validator perm of the validation process")
+}
+
+// sameVPApprovalTerms reports whether two approvals validate the perm on the
+// same terms.
+func sameVPApprovalTerms(a, b types.VPApproval) bool {
+	if (a.EffectiveUntil == nil) != (b.EffectiveUntil == nil) {
+		return false
+	}
+	if a.EffectiveUntil != nil && !a.EffectiveUntil.Equal(*b.EffectiveUntil) {
+		return false
+	}
+	return a.ValidationFees == b.ValidationFees &&
+		a.IssuanceFees == b.IssuanceFees &&
+		a.VerificationFees == b.VerificationFees &&
+		slices.Equal(a.Countries, b.Countries) &&
+		a.VpSummaryDigestSri == b.VpSummaryDigestSri
+}
+
+// splitValidationFees splits the escrowed validation fees equally among the
+// validators, the remainder goes to the first one.
+func splitValidationFees(fees uint64, validators int) []uint64 {
+	shares := make([]uint64, validators)
+	for i := range shares {
+		shares[i] = fees / uint64(validators)
+	}
+	shares[0] += fees % uint64(validators)
+	return shares
+}
+
+// releaseCoValidatorDeposits releases the trust deposits the co-validators of
+// a perm earned from its validation.
+func (k Keeper) releaseCoValidatorDeposits(ctx sdk.Context, perm *types.Permission) error {
+	for _, coValidatorDeposit := range perm.VpCoValidatorDeposits {
+		if coValidatorDeposit.Deposit == 0 {
+			continue
+		}
+		coValidatorPerm, err := k.GetPermissionByID(ctx, coValidatorDeposit.ValidatorPermId)
+		if err != nil {
+			return fmt.Errorf("co-validator perm not found: %w", err)
+		}
+		if err := k.trustDeposit.AdjustTrustDeposit(ctx, coValidatorPerm.Grantee, -int64(coValidatorDeposit.Deposit)); err != nil {
+			return fmt.Errorf("failed to reduce co-validator trust deposit: %w", err)
+		}
+	}
+	perm.VpCoValidatorDeposits = nil
+	return nil
+}
+
+// joinPermIDs formats perm IDs as an event attribute value.
+func joinPermIDs(ids []uint64) string {
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = strconv.FormatUint(id, 10)
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,2970 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: verana/perm/migrations/v2/legacy.proto
+
+package v2
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type PermissionType int32
+
+const (
+	PermissionType_PERMISSION_TYPE_UNSPECIFIED      PermissionType = 0
+	PermissionType_PERMISSION_TYPE_ISSUER           PermissionType = 1
+	PermissionType_PERMISSION_TYPE_VERIFIER         PermissionType = 2
+	PermissionType_PERMISSION_TYPE_ISSUER_GRANTOR   PermissionType = 3
+	PermissionType_PERMISSION_TYPE_VERIFIER_GRANTOR PermissionType = 4
+	PermissionType_PERMISSION_TYPE_ECOSYSTEM        PermissionType = 5
+	PermissionType_PERMISSION_TYPE_HOLDER           PermissionType = 6
+)
+
+var PermissionType_name = map[int32]string{
+	0: "PERMISSION_TYPE_UNSPECIFIED",
+	1: "PERMISSION_TYPE_ISSUER",
+	2: "PERMISSION_TYPE_VERIFIER",
+	3: "PERMISSION_TYPE_ISSUER_GRANTOR",
+	4: "PERMISSION_TYPE_VERIFIER_GRANTOR",
+	5: "PERMISSION_TYPE_ECOSYSTEM",
+	6: "PERMISSION_TYPE_HOLDER",
+}
+
+var PermissionType_value = map[string]int32{
+	"PERMISSION_TYPE_UNSPECIFIED":      0,
+	"PERMISSION_TYPE_ISSUER":           1,
+	"PERMISSION_TYPE_VERIFIER":         2,
+	"PERMISSION_TYPE_ISSUER_GRANTOR":   3,
+	"PERMISSION_TYPE_VERIFIER_GRANTOR": 4,
+	"PERMISSION_TYPE_ECOSYSTEM":        5,
+	"PERMISSION_TYPE_HOLDER":           6,
+}
+
+func (x PermissionType) String() string {
+	return proto.EnumName(PermissionType_name, int32(x))
+}
+
+func (PermissionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_75cdb1b02e85c0e0, []int{0}
+}
+
+type ValidationState int32
+
+const (
+	ValidationState_VALIDATION_STATE_UNSPECIFIED           ValidationState = 0
+	ValidationState_VALIDATION_STATE_PENDING               ValidationState = 1
+	ValidationState_VALIDATION_STATE_VALIDATED             ValidationState = 2
+	ValidationState_VALIDATION_STATE_TERMINATED            ValidationState = 3
+	ValidationState_VALIDATION_STATE_TERMINATION_REQUESTED ValidationState = 4
+)
+
+var ValidationState_name = map[int32]string{
+	0: "VALIDATION_STATE_UNSPECIFIED",
+	1: "VALIDATION_STATE_PENDING",
+	2: "VALIDATION_STATE_VALIDATED",
+	3: "VALIDATION_STATE_TERMINATED",
+	4: "VALIDATION_STATE_TERMINATION_REQUESTED",
+}
+
+var ValidationState_value = map[string]int32{
+	"VALIDATION_STATE_UNSPECIFIED":           0,
+	"VALIDATION_STATE_PENDING":               1,
+	"VALIDATION_STATE_VALIDATED":             2,
+	"VALIDATION_STATE_TERMINATED":            3,
+	"VALIDATION_STATE_TERMINATION_REQUESTED": 4,
+}
+
+func (x ValidationState) String() string {
+	return proto.EnumName(ValidationState_name, int32(x))
+}
+
+func (ValidationState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_75cdb1b02e85c0e0, []int{1}
+}
+
+// Permission is a v1 perm, scoped to a single country.
+type Permission struct {
+	Id                 uint64          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SchemaId           uint64          `protobuf:"varint,2,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
+	Type               PermissionType  `protobuf:"varint,3,opt,name=type,proto3,enum=verana.perm.migrations.v2.PermissionType" json:"type,omitempty"`
+	Did                string          `protobuf:"bytes,4,opt,name=did,proto3" json:"did,omitempty"`
+	Grantee            string          `protobuf:"bytes,5,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	Created            *time.Time      `protobuf:"bytes,6,opt,name=created,proto3,stdtime" json:"created,omitempty"`
+	CreatedBy          string          `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	Extended           *time.Time      `protobuf:"bytes,8,opt,name=extended,proto3,stdtime" json:"extended,omitempty"`
+	ExtendedBy         string          `protobuf:"bytes,9,opt,name=extended_by,json=extendedBy,proto3" json:"extended_by,omitempty"`
+	Slashed            *time.Time      `protobuf:"bytes,10,opt,name=slashed,proto3,stdtime" json:"slashed,omitempty"`
+	SlashedBy          string          `protobuf:"bytes,11,opt,name=slashed_by,json=slashedBy,proto3" json:"slashed_by,omitempty"`
+	Repaid             *time.Time      `protobuf:"bytes,12,opt,name=repaid,proto3,stdtime" json:"repaid,omitempty"`
+	RepaidBy           string          `protobuf:"bytes,13,opt,name=repaid_by,json=repaidBy,proto3" json:"repaid_by,omitempty"`
+	EffectiveFrom      *time.Time      `protobuf:"bytes,14,opt,name=effective_from,json=effectiveFrom,proto3,stdtime" json:"effective_from,omitempty"`
+	EffectiveUntil     *time.Time      `protobuf:"bytes,15,opt,name=effective_until,json=effectiveUntil,proto3,stdtime" json:"effective_until,omitempty"`
+	Modified           *time.Time      `protobuf:"bytes,16,opt,name=modified,proto3,stdtime" json:"modified,omitempty"`
+	ValidationFees     uint64          `protobuf:"varint,17,opt,name=validation_fees,json=validationFees,proto3" json:"validation_fees,omitempty"`
+	IssuanceFees       uint64          `protobuf:"varint,18,opt,name=issuance_fees,json=issuanceFees,proto3" json:"issuance_fees,omitempty"`
+	VerificationFees   uint64          `protobuf:"varint,19,opt,name=verification_fees,json=verificationFees,proto3" json:"verification_fees,omitempty"`
+	Deposit            uint64          `protobuf:"varint,20,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	SlashedDeposit     uint64          `protobuf:"varint,21,opt,name=slashed_deposit,json=slashedDeposit,proto3" json:"slashed_deposit,omitempty"`
+	RepaidDeposit      uint64          `protobuf:"varint,22,opt,name=repaid_deposit,json=repaidDeposit,proto3" json:"repaid_deposit,omitempty"`
+	Revoked            *time.Time      `protobuf:"bytes,23,opt,name=revoked,proto3,stdtime" json:"revoked,omitempty"`
+	RevokedBy          string          `protobuf:"bytes,24,opt,name=revoked_by,json=revokedBy,proto3" json:"revoked_by,omitempty"`
+	Terminated         *time.Time      `protobuf:"bytes,25,opt,name=terminated,proto3,stdtime" json:"terminated,omitempty"`
+	TerminatedBy       string          `protobuf:"bytes,26,opt,name=terminated_by,json=terminatedBy,proto3" json:"terminated_by,omitempty"`
+	Country            string          `protobuf:"bytes,27,opt,name=country,proto3" json:"country,omitempty"`
+	ValidatorPermId    uint64          `protobuf:"varint,28,opt,name=validator_perm_id,json=validatorPermId,proto3" json:"validator_perm_id,omitempty"`
+	VpState            ValidationState `protobuf:"varint,29,opt,name=vp_state,json=vpState,proto3,enum=verana.perm.migrations.v2.ValidationState" json:"vp_state,omitempty"`
+	VpExp              *time.Time      `protobuf:"bytes,30,opt,name=vp_exp,json=vpExp,proto3,stdtime" json:"vp_exp,omitempty"`
+	VpLastStateChange  *time.Time      `protobuf:"bytes,31,opt,name=vp_last_state_change,json=vpLastStateChange,proto3,stdtime" json:"vp_last_state_change,omitempty"`
+	VpValidatorDeposit uint64          `protobuf:"varint,32,opt,name=vp_validator_deposit,json=vpValidatorDeposit,proto3" json:"vp_validator_deposit,omitempty"`
+	VpCurrentFees      uint64          `protobuf:"varint,33,opt,name=vp_current_fees,json=vpCurrentFees,proto3" json:"vp_current_fees,omitempty"`
+	VpCurrentDeposit   uint64          `protobuf:"varint,34,opt,name=vp_current_deposit,json=vpCurrentDeposit,proto3" json:"vp_current_deposit,omitempty"`
+	VpSummaryDigestSri string          `protobuf:"bytes,35,opt,name=vp_summary_digest_sri,json=vpSummaryDigestSri,proto3" json:"vp_summary_digest_sri,omitempty"`
+	VpTermRequested    *time.Time      `protobuf:"bytes,36,opt,name=vp_term_requested,json=vpTermRequested,proto3,stdtime" json:"vp_term_requested,omitempty"`
+}
+
+func (m *Permission) Reset()         { *m = Permission{} }
+func (m *Permission) String() string { return proto.CompactTextString(m) }
+func (*Permission) ProtoMessage()    {}
+func (*Permission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75cdb1b02e85c0e0, []int{0}
+}
+func (m *Permission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Permission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Permission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Permission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Permission.Merge(m, src)
+}
+func (m *Permission) XXX_Size() int {
+	return m.Size()
+}
+func (m *Permission) XXX_DiscardUnknown() {
+	xxx_messageInfo_Permission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Permission proto.InternalMessageInfo
+
+func (m *Permission) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Permission) GetSchemaId() uint64 {
+	if m != nil {
+		return m.SchemaId
+	}
+	return 0
+}
+
+func (m *Permission) GetType() PermissionType {
+	if m != nil {
+		return m.Type
+	}
+	return PermissionType_PERMISSION_TYPE_UNSPECIFIED
+}
+
+func (m *Permission) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+func (m *Permission) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *Permission) GetCreated() *time.Time {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
+func (m *Permission) GetCreatedBy() string {
+	if m != nil {
+		return m.CreatedBy
+	}
+	return ""
+}
+
+func (m *Permission) GetExtended() *time.Time {
+	if m != nil {
+		return m.Extended
+	}
+	return nil
+}
+
+func (m *Permission) GetExtendedBy() string {
+	if m != nil {
+		return m.ExtendedBy
+	}
+	return ""
+}
+
+func (m *Permission) GetSlashed() *time.Time {
+	if m != nil {
+		return m.Slashed
+	}
+	return nil
+}
+
+func (m *Permission) GetSlashedBy() string {
+	if m != nil {
+		return m.SlashedBy
+	}
+	return ""
+}
+
+func (m *Permission) GetRepaid() *time.Time {
+	if m != nil {
+		return m.Repaid
+	}
+	return nil
+}
+
+func (m *Permission) GetRepaidBy() string {
+	if m != nil {
+		return m.RepaidBy
+	}
+	return ""
+}
+
+func (m *Permission) GetEffectiveFrom() *time.Time {
+	if m != nil {
+		return m.EffectiveFrom
+	}
+	return nil
+}
+
+func (m *Permission) GetEffectiveUntil() *time.Time {
+	if m != nil {
+		return m.EffectiveUntil
+	}
+	return nil
+}
+
+func (m *Permission) GetModified() *time.Time {
+	if m != nil {
+		return m.Modified
+	}
+	return nil
+}
+
+func (m *Permission) GetValidationFees() uint64 {
+	if m != nil {
+		return m.ValidationFees
+	}
+	return 0
+}
+
+func (m *Permission) GetIssuanceFees() uint64 {
+	if m != nil {
+		return m.IssuanceFees
+	}
+	return 0
+}
+
+func (m *Permission) GetVerificationFees() uint64 {
+	if m != nil {
+		return m.VerificationFees
+	}
+	return 0
+}
+
+func (m *Permission) GetDeposit() uint64 {
+	if m != nil {
+		return m.Deposit
+	}
+	return 0
+}
+
+func (m *Permission) GetSlashedDeposit() uint64 {
+	if m != nil {
+		return m.SlashedDeposit
+	}
+	return 0
+}
+
+func (m *Permission) GetRepaidDeposit() uint64 {
+	if m != nil {
+		return m.RepaidDeposit
+	}
+	return 0
+}
+
+func (m *Permission) GetRevoked() *time.Time {
+	if m != nil {
+		return m.Revoked
+	}
+	return nil
+}
+
+func (m *Permission) GetRevokedBy() string {
+	if m != nil {
+		return m.RevokedBy
+	}
+	return ""
+}
+
+func (m *Permission) GetTerminated() *time.Time {
+	if m != nil {
+		return m.Terminated
+	}
+	return nil
+}
+
+func (m *Permission) GetTerminatedBy() string {
+	if m != nil {
+		return m.TerminatedBy
+	}
+	return ""
+}
+
+func (m *Permission) GetCountry() string {
+	if m != nil {
+		return m.Country
+	}
+	return ""
+}
+
+func (m *Permission) GetValidatorPermId() uint64 {
+	if m != nil {
+		return m.ValidatorPermId
+	}
+	return 0
+}
+
+func (m *Permission) GetVpState() ValidationState {
+	if m != nil {
+		return m.VpState
+	}
+	return ValidationState_VALIDATION_STATE_UNSPECIFIED
+}
+
+func (m *Permission) GetVpExp() *time.Time {
+	if m != nil {
+		return m.VpExp
+	}
+	return nil
+}
+
+func (m *Permission) GetVpLastStateChange() *time.Time {
+	if m != nil {
+		return m.VpLastStateChange
+	}
+	return nil
+}
+
+func (m *Permission) GetVpValidatorDeposit() uint64 {
+	if m != nil {
+		return m.VpValidatorDeposit
+	}
+	return 0
+}
+
+func (m *Permission) GetVpCurrentFees() uint64 {
+	if m != nil {
+		return m.VpCurrentFees
+	}
+	return 0
+}
+
+func (m *Permission) GetVpCurrentDeposit() uint64 {
+	if m != nil {
+		return m.VpCurrentDeposit
+	}
+	return 0
+}
+
+func (m *Permission) GetVpSummaryDigestSri() string {
+	if m != nil {
+		return m.VpSummaryDigestSri
+	}
+	return ""
+}
+
+func (m *Permission) GetVpTermRequested() *time.Time {
+	if m != nil {
+		return m.VpTermRequested
+	}
+	return nil
+}
+
+// PermissionSession is a v1 perm session, without expiration.
+type PermissionSession struct {
+	Id          string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Controller  string          `protobuf:"bytes,2,opt,name=controller,proto3" json:"controller,omitempty"`
+	AgentPermId uint64          `protobuf:"varint,3,opt,name=agent_perm_id,json=agentPermId,proto3" json:"agent_perm_id,omitempty"`
+	Authz       []*SessionAuthz `protobuf:"bytes,4,rep,name=authz,proto3" json:"authz,omitempty"`
+	Created     *time.Time      `protobuf:"bytes,5,opt,name=created,proto3,stdtime" json:"created,omitempty"`
+	Modified    *time.Time      `protobuf:"bytes,6,opt,name=modified,proto3,stdtime" json:"modified,omitempty"`
+}
+
+func (m *PermissionSession) Reset()         { *m = PermissionSession{} }
+func (m *PermissionSession) String() string { return proto.CompactTextString(m) }
+func (*PermissionSession) ProtoMessage()    {}
+func (*PermissionSession) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75cdb1b02e85c0e0, []int{1}
+}
+func (m *PermissionSession) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PermissionSession) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PermissionSession.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PermissionSession) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PermissionSession.Merge(m, src)
+}
+func (m *PermissionSession) XXX_Size() int {
+	return m.Size()
+}
+func (m *PermissionSession) XXX_DiscardUnknown() {
+	xxx_messageInfo_PermissionSession.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PermissionSession proto.InternalMessageInfo
+
+func (m *PermissionSession) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PermissionSession) GetController() string {
+	if m != nil {
+		return m.Controller
+	}
+	return ""
+}
+
+func (m *PermissionSession) GetAgentPermId() uint64 {
+	if m != nil {
+		return m.AgentPermId
+	}
+	return 0
+}
+
+func (m *PermissionSession) GetAuthz() []*SessionAuthz {
+	if m != nil {
+		return m.Authz
+	}
+	return nil
+}
+
+func (m *PermissionSession) GetCreated() *time.Time {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
+func (m *PermissionSession) GetModified() *time.Time {
+	if m != nil {
+		return m.Modified
+	}
+	return nil
+}
+
+type SessionAuthz struct {
+	ExecutorPermId    uint64 `protobuf:"varint,1,opt,name=executor_perm_id,json=executorPermId,proto3" json:"executor_perm_id,omitempty"`
+	BeneficiaryPermId uint64 `protobuf:"varint,2,opt,name=beneficiary_perm_id,json=beneficiaryPermId,proto3" json:"beneficiary_perm_id,omitempty"`
+	WalletAgentPermId uint64 `protobuf:"varint,3,opt,name=wallet_agent_perm_id,json=walletAgentPermId,proto3" json:"wallet_agent_perm_id,omitempty"`
+}
+
+func (m *SessionAuthz) Reset()         { *m = SessionAuthz{} }
+func (m *SessionAuthz) String() string { return proto.CompactTextString(m) }
+func (*SessionAuthz) ProtoMessage()    {}
+func (*SessionAuthz) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75cdb1b02e85c0e0, []int{2}
+}
+func (m *SessionAuthz) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SessionAuthz) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SessionAuthz.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SessionAuthz) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionAuthz.Merge(m, src)
+}
+func (m *SessionAuthz) XXX_Size() int {
+	return m.Size()
+}
+func (m *SessionAuthz) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionAuthz.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionAuthz proto.InternalMessageInfo
+
+func (m *SessionAuthz) GetExecutorPermId() uint64 {
+	if m != nil {
+		return m.ExecutorPermId
+	}
+	return 0
+}
+
+func (m *SessionAuthz) GetBeneficiaryPermId() uint64 {
+	if m != nil {
+		return m.BeneficiaryPermId
+	}
+	return 0
+}
+
+func (m *SessionAuthz) GetWalletAgentPermId() uint64 {
+	if m != nil {
+		return m.WalletAgentPermId
+	}
+	return 0
+}
+
+// Params are the v1 perm params.
+type Params struct {
+	ValidationTermRequestedTimeoutDays uint64 `protobuf:"varint,1,opt,name=validation_term_requested_timeout_days,json=validationTermRequestedTimeoutDays,proto3" json:"validation_term_requested_timeout_days,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75cdb1b02e85c0e0, []int{3}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetValidationTermRequestedTimeoutDays() uint64 {
+	if m != nil {
+		return m.ValidationTermRequestedTimeoutDays
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("verana.perm.migrations.v2.PermissionType", PermissionType_name, PermissionType_value)
+	proto.RegisterEnum("verana.perm.migrations.v2.ValidationState", ValidationState_name, ValidationState_value)
+	proto.RegisterType((*Permission)(nil), "verana.perm.migrations.v2.Permission")
+	proto.RegisterType((*PermissionSession)(nil), "verana.perm.migrations.v2.PermissionSession")
+	proto.RegisterType((*SessionAuthz)(nil), "verana.perm.migrations.v2.SessionAuthz")
+	proto.RegisterType((*Params)(nil), "verana.perm.migrations.v2.Params")
+}
+
+func init() {
+	proto.RegisterFile("verana/perm/migrations/v2/legacy.proto", fileDescriptor_75cdb1b02e85c0e0)
+}
+
+var fileDescriptor_75cdb1b02e85c0e0 = []byte{
+	// 1278 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x97, 0xdd, 0x72, 0xdb, 0xc4,
+	0x17, 0xc0, 0xa3, 0xc4, 0x71, 0xe2, 0x93, 0xc4, 0x1f, 0xdb, 0xb4, 0xff, 0x4d, 0x9a, 0x3a, 0xfe,
+	0xbb, 0x25, 0x0d, 0x81, 0xda, 0x10, 0x86, 0x29, 0x74, 0xe8, 0x0c, 0x76, 0xac, 0x16, 0xcf, 0xa4,
+	0x49, 0x2a, 0x39, 0x99, 0x29, 0x03, 0xa3, 0x59, 0x4b, 0x6b, 0x67, 0xa7, 0xd6, 0x07, 0x2b, 0x59,
+	0x44, 0x3c, 0x45, 0x6f, 0xb9, 0xe3, 0x21, 0xb8, 0xe6, 0x9a, 0xcb, 0x0e, 0x57, 0xdc, 0x01, 0xed,
+	0x0b, 0xf0, 0x08, 0xcc, 0xae, 0xa4, 0xd8, 0x49, 0x9b, 0xda, 0xbd, 0x5b, 0x9d, 0xf3, 0x3b, 0x7b,
+	0x3e, 0xf6, 0xec, 0x59, 0x1b, 0xb6, 0x42, 0xca, 0x89, 0x43, 0xea, 0x1e, 0xe5, 0x76, 0xdd, 0x66,
+	0x7d, 0x4e, 0x02, 0xe6, 0x3a, 0x7e, 0x3d, 0xdc, 0xad, 0x0f, 0x68, 0x9f, 0x98, 0x51, 0xcd, 0xe3,
+	0x6e, 0xe0, 0xa2, 0xb5, 0x98, 0xab, 0x09, 0xae, 0x36, 0xe2, 0x6a, 0xe1, 0xee, 0xfa, 0x9a, 0xe9,
+	0xfa, 0xb6, 0xeb, 0x1b, 0x12, 0xac, 0xc7, 0x1f, 0xb1, 0xd5, 0xfa, 0x6a, 0xdf, 0xed, 0xbb, 0xb1,
+	0x5c, 0xac, 0x12, 0xe9, 0x66, 0xdf, 0x75, 0xfb, 0x03, 0x5a, 0x97, 0x5f, 0xdd, 0x61, 0xaf, 0x1e,
+	0x30, 0x9b, 0xfa, 0x01, 0xb1, 0xbd, 0x18, 0xa8, 0xfe, 0x93, 0x07, 0x38, 0xa2, 0xdc, 0x66, 0xbe,
+	0xcf, 0x5c, 0x07, 0xe5, 0x61, 0x96, 0x59, 0x58, 0xa9, 0x28, 0xdb, 0x19, 0x6d, 0x96, 0x59, 0xe8,
+	0x26, 0xe4, 0x7c, 0xf3, 0x94, 0xda, 0xc4, 0x60, 0x16, 0x9e, 0x95, 0xe2, 0xc5, 0x58, 0xd0, 0xb6,
+	0xd0, 0x43, 0xc8, 0x04, 0x91, 0x47, 0xf1, 0x5c, 0x45, 0xd9, 0xce, 0xef, 0x7e, 0x58, 0xbb, 0x32,
+	0xee, 0xda, 0xc8, 0x43, 0x27, 0xf2, 0xa8, 0x26, 0xcd, 0x50, 0x11, 0xe6, 0x2c, 0x66, 0xe1, 0x4c,
+	0x45, 0xd9, 0xce, 0x69, 0x62, 0x89, 0x76, 0x61, 0xa1, 0xcf, 0x89, 0x13, 0x50, 0x8a, 0xe7, 0x85,
+	0xb4, 0x89, 0xff, 0xf8, 0xf5, 0xde, 0x6a, 0x92, 0x66, 0xc3, 0xb2, 0x38, 0xf5, 0x7d, 0x3d, 0xe0,
+	0xcc, 0xe9, 0x6b, 0x29, 0x88, 0x1e, 0xc0, 0x82, 0xc9, 0x29, 0x09, 0xa8, 0x85, 0xb3, 0x15, 0x65,
+	0x7b, 0x69, 0x77, 0xbd, 0x16, 0xe7, 0x5c, 0x4b, 0x73, 0xae, 0x75, 0xd2, 0x9c, 0x9b, 0x99, 0x17,
+	0x7f, 0x6d, 0x2a, 0x5a, 0x6a, 0x80, 0xee, 0x03, 0x24, 0x4b, 0xa3, 0x1b, 0xe1, 0x85, 0x09, 0x2e,
+	0x73, 0x09, 0xdb, 0x8c, 0xd0, 0x57, 0xb0, 0x48, 0xcf, 0x02, 0xea, 0x58, 0xd4, 0xc2, 0x8b, 0x53,
+	0x7a, 0x3d, 0xb7, 0x40, 0x5f, 0xc2, 0x52, 0xba, 0x16, 0x7e, 0x73, 0x13, 0xfc, 0x42, 0x0a, 0x37,
+	0x23, 0x91, 0xad, 0x3f, 0x20, 0xfe, 0x29, 0xb5, 0x30, 0x4c, 0x9b, 0x6d, 0x62, 0x20, 0xb2, 0x4d,
+	0x96, 0xc2, 0xeb, 0xd2, 0xa4, 0x6c, 0x13, 0xb6, 0x19, 0xa1, 0x2f, 0x20, 0xcb, 0xa9, 0x47, 0x98,
+	0x85, 0x97, 0xa7, 0xf4, 0x99, 0xf0, 0xe8, 0x73, 0xc8, 0xc5, 0x2b, 0xe1, 0x71, 0x65, 0x82, 0xc7,
+	0xc5, 0x18, 0x6d, 0x46, 0xe8, 0x31, 0xe4, 0x69, 0xaf, 0x47, 0xcd, 0x80, 0x85, 0xd4, 0xe8, 0x71,
+	0xd7, 0xc6, 0xf9, 0x29, 0x1d, 0xaf, 0x9c, 0xdb, 0x3d, 0xe2, 0xae, 0x8d, 0xda, 0x50, 0x18, 0x6d,
+	0x34, 0x74, 0x02, 0x36, 0xc0, 0x85, 0x29, 0x77, 0x1a, 0x45, 0x70, 0x2c, 0xec, 0xc4, 0x91, 0xdb,
+	0xae, 0xc5, 0x7a, 0x8c, 0x5a, 0xb8, 0x38, 0xed, 0x91, 0xa7, 0x16, 0xe8, 0x2e, 0x14, 0x42, 0x32,
+	0x60, 0x96, 0xbc, 0x12, 0x46, 0x8f, 0x52, 0x1f, 0x97, 0xe4, 0x6d, 0xca, 0x8f, 0xc4, 0x8f, 0x28,
+	0xf5, 0xd1, 0x6d, 0x58, 0x61, 0xbe, 0x3f, 0x24, 0x8e, 0x49, 0x63, 0x0c, 0x49, 0x6c, 0x39, 0x15,
+	0x4a, 0xe8, 0x23, 0x28, 0x85, 0x94, 0xb3, 0x1e, 0x33, 0xc7, 0xf6, 0xbb, 0x26, 0xc1, 0xe2, 0xb8,
+	0x42, 0xc2, 0x18, 0x16, 0x2c, 0xea, 0xb9, 0x3e, 0x0b, 0xf0, 0xaa, 0x44, 0xd2, 0x4f, 0x11, 0x54,
+	0xda, 0x10, 0x29, 0x71, 0x3d, 0x0e, 0x2a, 0x11, 0xb7, 0x12, 0xf0, 0x03, 0xc8, 0x27, 0xc7, 0x98,
+	0x72, 0x37, 0x24, 0xb7, 0x12, 0x4b, 0x53, 0xec, 0x01, 0x2c, 0x70, 0x1a, 0xba, 0xcf, 0xa9, 0x85,
+	0xff, 0x37, 0x6d, 0x73, 0x26, 0x06, 0xa2, 0x39, 0x93, 0xa5, 0x68, 0x15, 0x3c, 0xa9, 0x39, 0x13,
+	0xb6, 0x19, 0xa1, 0xaf, 0x01, 0x02, 0x31, 0x5d, 0x1c, 0x39, 0x02, 0xd6, 0xa6, 0xf4, 0x3b, 0x66,
+	0x83, 0x1e, 0xc2, 0xca, 0xe8, 0x4b, 0x78, 0x5f, 0x9f, 0xe0, 0x7d, 0x79, 0x84, 0x37, 0x23, 0x51,
+	0x5f, 0xd3, 0x1d, 0x3a, 0x01, 0x8f, 0xf0, 0x4d, 0x39, 0xca, 0xd2, 0x4f, 0xb4, 0x03, 0xa5, 0xe4,
+	0x74, 0x5d, 0x6e, 0x88, 0xa9, 0x28, 0x86, 0xe8, 0x86, 0xac, 0x5c, 0xe1, 0x5c, 0x21, 0x46, 0x63,
+	0xdb, 0x42, 0x2a, 0x2c, 0x86, 0x9e, 0xe1, 0x07, 0x24, 0xa0, 0xf8, 0x96, 0x9c, 0xa7, 0x3b, 0xef,
+	0x98, 0xa7, 0x27, 0xe7, 0x4d, 0xa3, 0x0b, 0x0b, 0x6d, 0x21, 0xf4, 0xe4, 0x02, 0xdd, 0x87, 0x6c,
+	0xe8, 0x19, 0xf4, 0xcc, 0xc3, 0xe5, 0x29, 0x2b, 0x31, 0x1f, 0x7a, 0xea, 0x99, 0x87, 0x9e, 0xc2,
+	0x6a, 0xe8, 0x19, 0x03, 0xe2, 0x07, 0x71, 0x10, 0x86, 0x79, 0x4a, 0x9c, 0x3e, 0xc5, 0x9b, 0x53,
+	0x6e, 0x53, 0x0a, 0xbd, 0x7d, 0xe2, 0x07, 0x32, 0x8a, 0x3d, 0x69, 0x8a, 0x3e, 0x91, 0x5b, 0x8e,
+	0x2a, 0x90, 0xf6, 0x4e, 0x45, 0x56, 0x00, 0x85, 0xde, 0x49, 0xaa, 0x4a, 0x1b, 0x68, 0x0b, 0x0a,
+	0xa1, 0x67, 0x98, 0x43, 0xce, 0xa9, 0x13, 0xc4, 0x5d, 0xfd, 0xff, 0xb8, 0xd1, 0x42, 0x6f, 0x2f,
+	0x96, 0xca, 0x96, 0xfe, 0x18, 0xd0, 0x18, 0x97, 0xee, 0x5b, 0x4d, 0x2e, 0x40, 0x8a, 0xa6, 0xbb,
+	0x7e, 0x0a, 0xd7, 0x45, 0x69, 0x87, 0xb6, 0x4d, 0x78, 0x64, 0x58, 0xac, 0x4f, 0x45, 0x92, 0x9c,
+	0xe1, 0xdb, 0xf2, 0xb8, 0x50, 0xe8, 0xe9, 0xb1, 0xae, 0x25, 0x55, 0x3a, 0x67, 0x68, 0x1f, 0x4a,
+	0xa1, 0x67, 0x88, 0x63, 0x36, 0x38, 0xfd, 0x61, 0x48, 0x7d, 0xd1, 0x5b, 0x77, 0xa6, 0x2c, 0x45,
+	0x21, 0xf4, 0x3a, 0x94, 0xdb, 0x5a, 0x6a, 0x58, 0xfd, 0x65, 0x16, 0x4a, 0xa3, 0x17, 0x50, 0xa7,
+	0x97, 0x9f, 0xda, 0x9c, 0x7c, 0x6a, 0xcb, 0x00, 0xa6, 0xeb, 0x04, 0xdc, 0x1d, 0x0c, 0x28, 0x97,
+	0x6f, 0x6d, 0x4e, 0x1b, 0x93, 0xa0, 0x2a, 0xac, 0x90, 0xbe, 0xc8, 0x37, 0xed, 0xa4, 0x39, 0x99,
+	0xef, 0x92, 0x14, 0x26, 0x5d, 0xf4, 0x10, 0xe6, 0xc9, 0x30, 0x38, 0xfd, 0x09, 0x67, 0x2a, 0x73,
+	0xdb, 0x4b, 0xbb, 0x77, 0xdf, 0xd1, 0x42, 0x49, 0x18, 0x0d, 0x81, 0x6b, 0xb1, 0xd5, 0xf8, 0x5b,
+	0x3a, 0xff, 0xbe, 0x6f, 0xe9, 0xf8, 0x7c, 0xcc, 0xbe, 0xef, 0x7c, 0xac, 0xfe, 0xac, 0xc0, 0xf2,
+	0x78, 0x44, 0x68, 0x1b, 0x8a, 0xf4, 0x8c, 0x9a, 0xc3, 0xf1, 0xab, 0x13, 0xff, 0x2c, 0xc9, 0xa7,
+	0xf2, 0x24, 0xe7, 0x1a, 0x5c, 0xeb, 0x52, 0x87, 0xf6, 0x98, 0xc9, 0xc4, 0xf9, 0xa6, 0x70, 0xfc,
+	0x63, 0xa5, 0x34, 0xa6, 0x4a, 0xf8, 0x3a, 0xac, 0xfe, 0x48, 0x06, 0x03, 0x1a, 0x18, 0x6f, 0x2b,
+	0x67, 0x29, 0xd6, 0x35, 0x46, 0x45, 0xad, 0x7e, 0x07, 0xd9, 0x23, 0xc2, 0x89, 0xed, 0x23, 0x0d,
+	0xb6, 0xc6, 0xa6, 0xf8, 0xc5, 0xf6, 0x30, 0xc4, 0x2f, 0x2b, 0x77, 0x18, 0x18, 0x16, 0x89, 0xfc,
+	0x24, 0xd4, 0xea, 0x88, 0xbe, 0xd0, 0x11, 0x9d, 0x18, 0x6d, 0x91, 0xc8, 0xdf, 0xf9, 0x57, 0x81,
+	0xfc, 0xc5, 0x9f, 0x47, 0x68, 0x13, 0x6e, 0x1e, 0xa9, 0xda, 0x93, 0xb6, 0xae, 0xb7, 0x0f, 0x0f,
+	0x8c, 0xce, 0xb3, 0x23, 0xd5, 0x38, 0x3e, 0xd0, 0x8f, 0xd4, 0xbd, 0xf6, 0xa3, 0xb6, 0xda, 0x2a,
+	0xce, 0xa0, 0x75, 0xb8, 0x71, 0x19, 0x68, 0xeb, 0xfa, 0xb1, 0xaa, 0x15, 0x15, 0xb4, 0x01, 0xf8,
+	0xb2, 0xee, 0x44, 0xd5, 0x84, 0xa5, 0x56, 0x9c, 0x45, 0x55, 0x28, 0xbf, 0xdd, 0xd2, 0x78, 0xac,
+	0x35, 0x0e, 0x3a, 0x87, 0x5a, 0x71, 0x0e, 0xdd, 0x81, 0xca, 0x55, 0x3b, 0x9c, 0x53, 0x19, 0x74,
+	0x0b, 0xd6, 0x2e, 0x53, 0xea, 0xde, 0xa1, 0xfe, 0x4c, 0xef, 0xa8, 0x4f, 0x8a, 0xf3, 0x6f, 0x0b,
+	0xf1, 0x9b, 0xc3, 0xfd, 0x96, 0xaa, 0x15, 0xb3, 0x3b, 0xbf, 0x29, 0x50, 0xb8, 0x34, 0xc1, 0x50,
+	0x05, 0x36, 0x4e, 0x1a, 0xfb, 0xed, 0x56, 0xa3, 0x23, 0x78, 0xbd, 0xd3, 0xe8, 0x5c, 0x4e, 0x7a,
+	0x03, 0xf0, 0x1b, 0xc4, 0x91, 0x7a, 0xd0, 0x6a, 0x1f, 0x3c, 0x2e, 0x2a, 0xa8, 0x0c, 0xeb, 0x6f,
+	0x68, 0x13, 0x81, 0xda, 0x2a, 0xce, 0x8a, 0x9a, 0xbe, 0xa1, 0xef, 0x88, 0x00, 0x0f, 0x24, 0x30,
+	0x87, 0x76, 0x60, 0xeb, 0x4a, 0x40, 0x48, 0x34, 0xf5, 0xe9, 0xb1, 0xaa, 0x0b, 0x36, 0xd3, 0xfc,
+	0xfe, 0xf7, 0x57, 0x65, 0xe5, 0xe5, 0xab, 0xb2, 0xf2, 0xf7, 0xab, 0xb2, 0xf2, 0xe2, 0x75, 0x79,
+	0xe6, 0xe5, 0xeb, 0xf2, 0xcc, 0x9f, 0xaf, 0xcb, 0x33, 0xdf, 0xee, 0xf5, 0x59, 0x70, 0x3a, 0xec,
+	0xd6, 0x4c, 0xd7, 0xae, 0xc7, 0x77, 0xef, 0xde, 0x80, 0x74, 0xfd, 0x74, 0xdd, 0x1d, 0xb8, 0xe6,
+	0x73, 0xf3, 0x94, 0x30, 0xa7, 0x7e, 0x56, 0xf7, 0xce, 0x4f, 0xfd, 0xe2, 0xbf, 0x81, 0x6e, 0x56,
+	0x5e, 0x98, 0xcf, 0xfe, 0x1b, 0x00, 0x6e, 0x86, 0xaa, 0x34, 0x31, 0x0c, 0x00, 0x00,
+}
+
+func (m *Permission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Permission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Permission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VpTermRequested != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VpTermRequested, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VpTermRequested):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintLegacy(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.VpSummaryDigestSri) > 0 {
+		i -= len(m.VpSummaryDigestSri)
+		copy(dAtA[i:], m.VpSummaryDigestSri)
+		i = encodeVarintLegacy(dAtA, i, uint64(len(m.VpSummaryDigestSri)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.VpCurrentDeposit != 0 {
+		i = encodeVarintLegacy(dAtA, i, uint64(m.VpCurrentDeposit))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.VpCurrentFees != 0 {
+		i = encodeVarintLegacy(dAtA, i, uint64(m.VpCurrentFees))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.VpValidatorDeposit != 0 {
+		i = encodeVarintLegacy(dAtA, i, uint64(m.VpValidatorDeposit))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.VpLastStateChange != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VpLastStateChange, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VpLastStateChange):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintLegacy(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
+	}
+	if m.VpExp != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VpExp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VpExp):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintLegacy(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
+	if m.VpState != 0 {
+		i = encodeVarintLegacy(dAtA, i, uint64(m.VpState))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	if m.ValidatorPermId != 0 {
+		i = encodeVarintLegacy(dAtA, i, uint64(m.ValidatorPermId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if len(m.Country) > 0 {
+		i -= len(m.Country)
+		copy(dAtA[i:], m.Country)
+		i = encodeVarintLegacy(dAtA, i, uint64(len(m.Country)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if len(m.TerminatedBy) > 0 {
+		i -= len(m.TerminatedBy)
+		copy(dAtA[i:], m.TerminatedBy)
+		i = encodeVarintLegacy(dAtA, i, uint64(len(m.TerminatedBy)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if m.Terminated != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Terminated, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Terminated):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintLegacy(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.RevokedBy) > 0 {
+		i -= len(m.RevokedBy)
+		copy(dAtA[i:], m.RevokedBy)
+		i = encodeVarintLegacy(dAtA, i, uint64(len(m.RevokedBy)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.Revoked != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Revoked, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Revoked):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintLegacy(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.RepaidDeposit != 0 {
+		i = encodeVarintLegacy(dAtA, i, uint64(m.RepaidDeposit))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.SlashedDeposit != 0 {
+		i = encodeVarintLegacy(dAtA, i, uint64(m.SlashedDeposit))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.Deposit != 0 {
+		i = encodeVarintLegacy(dAtA, i, uint64(m.Deposit))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.VerificationFees != 0 {
+		i = encodeVarintLegacy(dAtA, i, uint64(m.VerificationFees))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.IssuanceFees != 0 {
+		i = encodeVarintLegacy(dAtA, i, uint64(m.IssuanceFees))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.ValidationFees != 0 {
+		i = encodeVarintLegacy(dAtA, i, uint64(m.ValidationFees))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.Modified != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Modified, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Modified):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintLegacy(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.EffectiveUntil != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintLegacy(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.EffectiveFrom != nil {
+		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveFrom, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveFrom):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintLegacy(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.RepaidBy) > 0 {
+		i -= len(m.RepaidBy)
+		copy(dAtA[i:], m.RepaidBy)
+		i = encodeVarintLegacy(dAtA, i, uint64(len(m.RepaidBy)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Repaid != nil {
+		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Repaid, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Repaid):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintLegacy(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.SlashedBy) > 0 {
+		i -= len(m.SlashedBy)
+		copy(dAtA[i:], m.SlashedBy)
+		i = encodeVarintLegacy(dAtA, i, uint64(len(m.SlashedBy)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Slashed != nil {
+		n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Slashed, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Slashed):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintLegacy(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ExtendedBy) > 0 {
+		i -= len(m.ExtendedBy)
+		copy(dAtA[i:], m.ExtendedBy)
+		i = encodeVarintLegacy(dAtA, i, uint64(len(m.ExtendedBy)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Extended != nil {
+		n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Extended, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Extended):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintLegacy(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.CreatedBy) > 0 {
+		i -= len(m.CreatedBy)
+		copy(dAtA[i:], m.CreatedBy)
+		i = encodeVarintLegacy(dAtA, i, uint64(len(m.CreatedBy)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Created != nil {
+		n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Created, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created):])
+		if err12 != nil {
+			return 0, err12
+		}
+		i -= n12
+		i = encodeVarintLegacy(dAtA, i, uint64(n12))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintLegacy(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintLegacy(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Type != 0 {
+		i = encodeVarintLegacy(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SchemaId != 0 {
+		i = encodeVarintLegacy(dAtA, i, uint64(m.SchemaId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintLegacy(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PermissionSession) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PermissionSession) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PermissionSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Modified != nil {
+		n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Modified, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Modified):])
+		if err13 != nil {
+			return 0, err13
+		}
+		i -= n13
+		i = encodeVarintLegacy(dAtA, i, uint64(n13))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Created != nil {
+		n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Created, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintLegacy(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Authz) > 0 {
+		for iNdEx := len(m.Authz) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Authz[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLegacy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.AgentPermId != 0 {
+		i = encodeVarintLegacy(dAtA, i, uint64(m.AgentPermId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintLegacy(dAtA, i, uint64(len(m.Controller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintLegacy(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SessionAuthz) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SessionAuthz) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SessionAuthz) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WalletAgentPermId != 0 {
+		i = encodeVarintLegacy(dAtA, i, uint64(m.WalletAgentPermId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BeneficiaryPermId != 0 {
+		i = encodeVarintLegacy(dAtA, i, uint64(m.BeneficiaryPermId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ExecutorPermId != 0 {
+		i = encodeVarintLegacy(dAtA, i, uint64(m.ExecutorPermId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValidationTermRequestedTimeoutDays != 0 {
+		i = encodeVarintLegacy(dAtA, i, uint64(m.ValidationTermRequestedTimeoutDays))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLegacy(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLegacy(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Permission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLegacy(uint64(m.Id))
+	}
+	if m.SchemaId != 0 {
+		n += 1 + sovLegacy(uint64(m.SchemaId))
+	}
+	if m.Type != 0 {
+		n += 1 + sovLegacy(uint64(m.Type))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovLegacy(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovLegacy(uint64(l))
+	}
+	if m.Created != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created)
+		n += 1 + l + sovLegacy(uint64(l))
+	}
+	l = len(m.CreatedBy)
+	if l > 0 {
+		n += 1 + l + sovLegacy(uint64(l))
+	}
+	if m.Extended != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Extended)
+		n += 1 + l + sovLegacy(uint64(l))
+	}
+	l = len(m.ExtendedBy)
+	if l > 0 {
+		n += 1 + l + sovLegacy(uint64(l))
+	}
+	if m.Slashed != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Slashed)
+		n += 1 + l + sovLegacy(uint64(l))
+	}
+	l = len(m.SlashedBy)
+	if l > 0 {
+		n += 1 + l + sovLegacy(uint64(l))
+	}
+	if m.Repaid != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Repaid)
+		n += 1 + l + sovLegacy(uint64(l))
+	}
+	l = len(m.RepaidBy)
+	if l > 0 {
+		n += 1 + l + sovLegacy(uint64(l))
+	}
+	if m.EffectiveFrom != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveFrom)
+		n += 1 + l + sovLegacy(uint64(l))
+	}
+	if m.EffectiveUntil != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil)
+		n += 1 + l + sovLegacy(uint64(l))
+	}
+	if m.Modified != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Modified)
+		n += 2 + l + sovLegacy(uint64(l))
+	}
+	if m.ValidationFees != 0 {
+		n += 2 + sovLegacy(uint64(m.ValidationFees))
+	}
+	if m.IssuanceFees != 0 {
+		n += 2 + sovLegacy(uint64(m.IssuanceFees))
+	}
+	if m.VerificationFees != 0 {
+		n += 2 + sovLegacy(uint64(m.VerificationFees))
+	}
+	if m.Deposit != 0 {
+		n += 2 + sovLegacy(uint64(m.Deposit))
+	}
+	if m.SlashedDeposit != 0 {
+		n += 2 + sovLegacy(uint64(m.SlashedDeposit))
+	}
+	if m.RepaidDeposit != 0 {
+		n += 2 + sovLegacy(uint64(m.RepaidDeposit))
+	}
+	if m.Revoked != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Revoked)
+		n += 2 + l + sovLegacy(uint64(l))
+	}
+	l = len(m.RevokedBy)
+	if l > 0 {
+		n += 2 + l + sovLegacy(uint64(l))
+	}
+	if m.Terminated != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Terminated)
+		n += 2 + l + sovLegacy(uint64(l))
+	}
+	l = len(m.TerminatedBy)
+	if l > 0 {
+		n += 2 + l + sovLegacy(uint64(l))
+	}
+	l = len(m.Country)
+	if l > 0 {
+		n += 2 + l + sovLegacy(uint64(l))
+	}
+	if m.ValidatorPermId != 0 {
+		n += 2 + sovLegacy(uint64(m.ValidatorPermId))
+	}
+	if m.VpState != 0 {
+		n += 2 + sovLegacy(uint64(m.VpState))
+	}
+	if m.VpExp != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VpExp)
+		n += 2 + l + sovLegacy(uint64(l))
+	}
+	if m.VpLastStateChange != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VpLastStateChange)
+		n += 2 + l + sovLegacy(uint64(l))
+	}
+	if m.VpValidatorDeposit != 0 {
+		n += 2 + sovLegacy(uint64(m.VpValidatorDeposit))
+	}
+	if m.VpCurrentFees != 0 {
+		n += 2 + sovLegacy(uint64(m.VpCurrentFees))
+	}
+	if m.VpCurrentDeposit != 0 {
+		n += 2 + sovLegacy(uint64(m.VpCurrentDeposit))
+	}
+	l = len(m.VpSummaryDigestSri)
+	if l > 0 {
+		n += 2 + l + sovLegacy(uint64(l))
+	}
+	if m.VpTermRequested != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VpTermRequested)
+		n += 2 + l + sovLegacy(uint64(l))
+	}
+	return n
+}
+
+func (m *PermissionSession) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovLegacy(uint64(l))
+	}
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovLegacy(uint64(l))
+	}
+	if m.AgentPermId != 0 {
+		n += 1 + sovLegacy(uint64(m.AgentPermId))
+	}
+	if len(m.Authz) > 0 {
+		for _, e := range m.Authz {
+			l = e.Size()
+			n += 1 + l + sovLegacy(uint64(l))
+		}
+	}
+	if m.Created != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created)
+		n += 1 + l + sovLegacy(uint64(l))
+	}
+	if m.Modified != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Modified)
+		n += 1 + l + sovLegacy(uint64(l))
+	}
+	return n
+}
+
+func (m *SessionAuthz) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExecutorPermId != 0 {
+		n += 1 + sovLegacy(uint64(m.ExecutorPermId))
+	}
+	if m.BeneficiaryPermId != 0 {
+		n += 1 + sovLegacy(uint64(m.BeneficiaryPermId))
+	}
+	if m.WalletAgentPermId != 0 {
+		n += 1 + sovLegacy(uint64(m.WalletAgentPermId))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidationTermRequestedTimeoutDays != 0 {
+		n += 1 + sovLegacy(uint64(m.ValidationTermRequestedTimeoutDays))
+	}
+	return n
+}
+
+func sovLegacy(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLegacy(x uint64) (n int) {
+	return sovLegacy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Permission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLegacy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Permission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Permission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaId", wireType)
+			}
+			m.SchemaId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SchemaId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= PermissionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extended", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Extended == nil {
+				m.Extended = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Extended, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtendedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Slashed == nil {
+				m.Slashed = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Slashed, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repaid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repaid == nil {
+				m.Repaid = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Repaid, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepaidBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepaidBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EffectiveFrom == nil {
+				m.EffectiveFrom = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveFrom, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EffectiveUntil == nil {
+				m.EffectiveUntil = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Modified", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Modified == nil {
+				m.Modified = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Modified, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidationFees", wireType)
+			}
+			m.ValidationFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidationFees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceFees", wireType)
+			}
+			m.IssuanceFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuanceFees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationFees", wireType)
+			}
+			m.VerificationFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerificationFees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			m.Deposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedDeposit", wireType)
+			}
+			m.SlashedDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashedDeposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepaidDeposit", wireType)
+			}
+			m.RepaidDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepaidDeposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Revoked == nil {
+				m.Revoked = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Revoked, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevokedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Terminated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Terminated == nil {
+				m.Terminated = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Terminated, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TerminatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TerminatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Country", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Country = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPermId", wireType)
+			}
+			m.ValidatorPermId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorPermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VpState", wireType)
+			}
+			m.VpState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VpState |= ValidationState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VpExp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VpExp == nil {
+				m.VpExp = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.VpExp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VpLastStateChange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VpLastStateChange == nil {
+				m.VpLastStateChange = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.VpLastStateChange, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VpValidatorDeposit", wireType)
+			}
+			m.VpValidatorDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VpValidatorDeposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VpCurrentFees", wireType)
+			}
+			m.VpCurrentFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VpCurrentFees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VpCurrentDeposit", wireType)
+			}
+			m.VpCurrentDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VpCurrentDeposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VpSummaryDigestSri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VpSummaryDigestSri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VpTermRequested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VpTermRequested == nil {
+				m.VpTermRequested = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.VpTermRequested, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLegacy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PermissionSession) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLegacy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PermissionSession: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PermissionSession: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Controller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentPermId", wireType)
+			}
+			m.AgentPermId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AgentPermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authz", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authz = append(m.Authz, &SessionAuthz{})
+			if err := m.Authz[len(m.Authz)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Modified", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Modified == nil {
+				m.Modified = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Modified, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLegacy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionAuthz) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLegacy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionAuthz: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionAuthz: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorPermId", wireType)
+			}
+			m.ExecutorPermId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutorPermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeneficiaryPermId", wireType)
+			}
+			m.BeneficiaryPermId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BeneficiaryPermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletAgentPermId", wireType)
+			}
+			m.WalletAgentPermId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WalletAgentPermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLegacy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLegacy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidationTermRequestedTimeoutDays", wireType)
+			}
+			m.ValidationTermRequestedTimeoutDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidationTermRequestedTimeoutDays |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLegacy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLegacy(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLegacy
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLegacy
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLegacy
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLegacy
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLegacy        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLegacy          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLegacy = fmt.Errorf("proto: unexpected end of group")
+)
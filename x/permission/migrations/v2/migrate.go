@@ -0,0 +1,443 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/verana-labs/verana-blockchain/x/permission/types"
+)
+
+// MigrateStore performs in-place store migrations from ConsensusVersion 1 to 2.
+// The v1 entries are decoded with the frozen v1 types of this package, then
+// each step below runs on the re-encoded store:
+//
+//   - MigratePermissions re-encodes the v1 perms. The former single country
+//     becomes a one-code scope.
+//   - RepairRootPermissionTypes sets the type of root perms restored by the
+//     v0.6 upgrade, which lost it.
+//   - MapLegacyCountryScopes maps the legacy country codes to ISO 3166.
+//   - SetPermissionCounter moves the perm counter up to the highest stored
+//     perm ID.
+//   - IndexPermissionsByValidator indexes perms by validator perm.
+//   - IndexPermissionsByCountry indexes perms by the codes of their scope.
+//   - MigrateParams sets the params introduced since v1 to their defaults.
+//   - MigrateSessions re-encodes the v1 perm sessions, which used to live
+//     forever, with an expiration.
+func MigrateStore(ctx context.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	if err := MigratePermissions(ctx, storeService, cdc); err != nil {
+		return err
+	}
+	if err := RepairRootPermissionTypes(ctx, storeService, cdc); err != nil {
+		return err
+	}
+	if err := MapLegacyCountryScopes(ctx, storeService, cdc); err != nil {
+		return err
+	}
+	if err := SetPermissionCounter(ctx, storeService, cdc); err != nil {
+		return err
+	}
+	if err := IndexPermissionsByValidator(ctx, storeService, cdc); err != nil {
+		return err
+	}
+	if err := IndexPermissionsByCountry(ctx, storeService, cdc); err != nil {
+		return err
+	}
+
+	params, err := MigrateParams(ctx, storeService, cdc)
+	if err != nil {
+		return err
+	}
+
+	return MigrateSessions(ctx, storeService, cdc, params)
+}
+
+// permissionsMap returns the perm map of the store with the current perm type.
+func permissionsMap(storeService store.KVStoreService, cdc codec.BinaryCodec) (collections.Map[uint64, types.Permission], error) {
+	sb := collections.NewSchemaBuilder(storeService)
+	permissions := collections.NewMap(sb, types.PermissionKey, "perm", collections.Uint64Key, codec.CollValue[types.Permission](cdc))
+	if _, err := sb.Build(); err != nil {
+		return permissions, err
+	}
+	return permissions, nil
+}
+
+// MigratePermissions decodes the v1 perms and stores them with the current
+// perm type. The single country of a v1 perm becomes a one-code scope.
+func MigratePermissions(ctx context.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	sb := collections.NewSchemaBuilder(storeService)
+	legacyPermissions := collections.NewMap(sb, types.PermissionKey, "perm", collections.Uint64Key, codec.CollValue[Permission](cdc))
+	if _, err := sb.Build(); err != nil {
+		return err
+	}
+	permissions, err := permissionsMap(storeService, cdc)
+	if err != nil {
+		return err
+	}
+
+	var migrated []types.Permission
+	err = legacyPermissions.Walk(ctx, nil, func(id uint64, perm Permission) (bool, error) {
+		migrated = append(migrated, migratePermission(perm))
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, perm := range migrated {
+		if err := permissions.Set(ctx, perm.Id, perm); err != nil {
+			return fmt.Errorf("failed to migrate perm %d: %w", perm.Id, err)
+		}
+	}
+
+	return nil
+}
+
+func migratePermission(perm Permission) types.Permission {
+	var countries []string
+	if perm.Country != "" {
+		countries = []string{perm.Country}
+	}
+
+	return types.Permission{
+		Id:                 perm.Id,
+		SchemaId:           perm.SchemaId,
+		Type:               types.PermissionType(perm.Type),
+		Did:                perm.Did,
+		Grantee:            perm.Grantee,
+		Created:            perm.Created,
+		CreatedBy:          perm.CreatedBy,
+		Extended:           perm.Extended,
+		ExtendedBy:         perm.ExtendedBy,
+		Slashed:            perm.Slashed,
+		SlashedBy:          perm.SlashedBy,
+		Repaid:             perm.Repaid,
+		RepaidBy:           perm.RepaidBy,
+		EffectiveFrom:      perm.EffectiveFrom,
+		EffectiveUntil:     perm.EffectiveUntil,
+		Modified:           perm.Modified,
+		ValidationFees:     perm.ValidationFees,
+		IssuanceFees:       perm.IssuanceFees,
+		VerificationFees:   perm.VerificationFees,
+		Deposit:            perm.Deposit,
+		SlashedDeposit:     perm.SlashedDeposit,
+		RepaidDeposit:      perm.RepaidDeposit,
+		Revoked:            perm.Revoked,
+		RevokedBy:          perm.RevokedBy,
+		Terminated:         perm.Terminated,
+		TerminatedBy:       perm.TerminatedBy,
+		Countries:          countries,
+		ValidatorPermId:    perm.ValidatorPermId,
+		VpState:            types.ValidationState(perm.VpState),
+		VpExp:              perm.VpExp,
+		VpLastStateChange:  perm.VpLastStateChange,
+		VpValidatorDeposit: perm.VpValidatorDeposit,
+		VpCurrentFees:      perm.VpCurrentFees,
+		VpCurrentDeposit:   perm.VpCurrentDeposit,
+		VpSummaryDigestSri: perm.VpSummaryDigestSri,
+		VpTermRequested:    perm.VpTermRequested,
+	}
+}
+
+// RepairRootPermissionTypes sets the type of root perms restored by the v0.6
+// upgrade, which parsed the enum names as integers. A perm without validator
+// perm and with an unspecified type can only be an ECOSYSTEM perm.
+func RepairRootPermissionTypes(ctx context.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	permissions, err := permissionsMap(storeService, cdc)
+	if err != nil {
+		return err
+	}
+
+	var repaired []types.Permission
+	err = permissions.Walk(ctx, nil, func(id uint64, perm types.Permission) (bool, error) {
+		if perm.Type == types.PermissionType_PERMISSION_TYPE_UNSPECIFIED && perm.ValidatorPermId == 0 {
+			perm.Type = types.PermissionType_PERMISSION_TYPE_ECOSYSTEM
+			repaired = append(repaired, perm)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, perm := range repaired {
+		if err := permissions.Set(ctx, perm.Id, perm); err != nil {
+			return fmt.Errorf("failed to repair perm %d: %w", perm.Id, err)
+		}
+	}
+
+	return nil
+}
+
+// MapLegacyCountryScopes maps the legacy codes of perm country scopes to their
+// ISO 3166 equivalent, EU to the member states. Other legacy codes are kept,
+// dropping them would widen the scope.
+func MapLegacyCountryScopes(ctx context.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	permissions, err := permissionsMap(storeService, cdc)
+	if err != nil {
+		return err
+	}
+
+	var mapped []types.Permission
+	err = permissions.Walk(ctx, nil, func(id uint64, perm types.Permission) (bool, error) {
+		if scope, ok := mapLegacyCountryScope(perm.Countries); ok {
+			perm.Countries = scope
+			mapped = append(mapped, perm)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, perm := range mapped {
+		if err := permissions.Set(ctx, perm.Id, perm); err != nil {
+			return fmt.Errorf("failed to map country scope of perm %d: %w", perm.Id, err)
+		}
+	}
+
+	return nil
+}
+
+// SetPermissionCounter moves the perm counter, which holds the last assigned
+// ID, up to the highest stored perm ID if it is behind.
+func SetPermissionCounter(ctx context.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	sb := collections.NewSchemaBuilder(storeService)
+	permissionCounter := collections.NewItem(sb, types.PermissionCounterKey, "permission_counter", collections.Uint64Value)
+	if _, err := sb.Build(); err != nil {
+		return err
+	}
+	permissions, err := permissionsMap(storeService, cdc)
+	if err != nil {
+		return err
+	}
+
+	iter, err := permissions.Iterate(ctx, new(collections.Range[uint64]).Descending())
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return nil
+	}
+	maxID, err := iter.Key()
+	if err != nil {
+		return err
+	}
+
+	counter, err := permissionCounter.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if counter < maxID {
+		if err := permissionCounter.Set(ctx, maxID); err != nil {
+			return fmt.Errorf("failed to set perm counter: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// IndexPermissionsByValidator indexes perms by validator perm, for perm tree
+// queries.
+func IndexPermissionsByValidator(ctx context.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	sb := collections.NewSchemaBuilder(storeService)
+	permissionByValidator := collections.NewKeySet(sb, types.PermissionByValidatorKey, "permission_by_validator", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key))
+	if _, err := sb.Build(); err != nil {
+		return err
+	}
+	permissions, err := permissionsMap(storeService, cdc)
+	if err != nil {
+		return err
+	}
+
+	var children []collections.Pair[uint64, uint64]
+	err = permissions.Walk(ctx, nil, func(id uint64, perm types.Permission) (bool, error) {
+		if perm.ValidatorPermId != 0 {
+			children = append(children, collections.Join(perm.ValidatorPermId, id))
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, child := range children {
+		if err := permissionByValidator.Set(ctx, child); err != nil {
+			return fmt.Errorf("failed to index perm %d: %w", child.K2(), err)
+		}
+	}
+
+	return nil
+}
+
+// IndexPermissionsByCountry indexes perms by the codes of their country scope.
+func IndexPermissionsByCountry(ctx context.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	sb := collections.NewSchemaBuilder(storeService)
+	permissionByCountry := collections.NewKeySet(sb, types.PermissionByCountryKey, "permission_by_country", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key))
+	if _, err := sb.Build(); err != nil {
+		return err
+	}
+	permissions, err := permissionsMap(storeService, cdc)
+	if err != nil {
+		return err
+	}
+
+	var countries []collections.Pair[string, uint64]
+	err = permissions.Walk(ctx, nil, func(id uint64, perm types.Permission) (bool, error) {
+		for _, code := range perm.Countries {
+			countries = append(countries, collections.Join(code, id))
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, country := range countries {
+		if err := permissionByCountry.Set(ctx, country); err != nil {
+			return fmt.Errorf("failed to index country of perm %d: %w", country.K2(), err)
+		}
+	}
+
+	return nil
+}
+
+// legacyCountryCodes maps the legacy codes of country scopes to their ISO 3166
+// equivalent
+var legacyCountryCodes = map[string][]string{
+	"UK": {"GB"},
+	"EL": {"GR"},
+	"EU": {
+		"AT", "BE", "BG", "CY", "CZ", "DE", "DK", "EE", "ES", "FI", "FR", "GR", "HR", "HU",
+		"IE", "IT", "LT", "LU", "LV", "MT", "NL", "PL", "PT", "RO", "SE", "SI", "SK",
+	},
+}
+
+// mapLegacyCountryScope maps the legacy codes of a country scope, and reports
+// whether the scope changed. Duplicate codes and subdivisions of a listed
+// country that result from the mapping are removed.
+func mapLegacyCountryScope(countries []string) ([]string, bool) {
+	mapped := false
+	var scope []string
+	for _, code := range countries {
+		if codes, ok := legacyCountryCodes[code]; ok {
+			scope = append(scope, codes...)
+			mapped = true
+			continue
+		}
+		scope = append(scope, code)
+	}
+	if !mapped {
+		return countries, false
+	}
+
+	listed := make(map[string]bool, len(scope))
+	for _, code := range scope {
+		listed[code] = true
+	}
+	seen := make(map[string]bool, len(scope))
+	result := make([]string, 0, len(scope))
+	for _, code := range scope {
+		if seen[code] || (len(code) > 2 && listed[code[:2]]) {
+			continue
+		}
+		seen[code] = true
+		result = append(result, code)
+	}
+	return result, true
+}
+
+// MigrateSessions decodes the v1 perm sessions, which used to live forever,
+// and stores them with the current session type. They expire after the
+// maximum session duration from the migration and are indexed by expiration.
+func MigrateSessions(ctx context.Context, storeService store.KVStoreService, cdc codec.BinaryCodec, params types.Params) error {
+	sb := collections.NewSchemaBuilder(storeService)
+	legacySessions := collections.NewMap(sb, types.PermissionSessionKey, "permission_session", collections.StringKey, codec.CollValue[PermissionSession](cdc))
+	sessionExpiryQueue := collections.NewKeySet(sb, types.SessionExpiryQueueKey, "session_expiry_queue", collections.PairKeyCodec(sdk.TimeKey, collections.StringKey))
+	if _, err := sb.Build(); err != nil {
+		return err
+	}
+	sessions := collections.NewMap(collections.NewSchemaBuilder(storeService), types.PermissionSessionKey, "permission_session", collections.StringKey, codec.CollValue[types.PermissionSession](cdc))
+
+	expires := sdk.UnwrapSDKContext(ctx).BlockTime().AddDate(0, 0, int(params.SessionMaxDurationDays))
+	var migrated []types.PermissionSession
+	err := legacySessions.Walk(ctx, nil, func(id string, session PermissionSession) (bool, error) {
+		migrated = append(migrated, migrateSession(session, expires))
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, session := range migrated {
+		if err := sessions.Set(ctx, session.Id, session); err != nil {
+			return fmt.Errorf("failed to set expiration of session %s: %w", session.Id, err)
+		}
+		if err := sessionExpiryQueue.Set(ctx, collections.Join(expires, session.Id)); err != nil {
+			return fmt.Errorf("failed to index session %s: %w", session.Id, err)
+		}
+	}
+
+	return nil
+}
+
+func migrateSession(session PermissionSession, expires time.Time) types.PermissionSession {
+	authz := make([]*types.SessionAuthz, 0, len(session.Authz))
+	for _, a := range session.Authz {
+		authz = append(authz, &types.SessionAuthz{
+			ExecutorPermId:    a.ExecutorPermId,
+			BeneficiaryPermId: a.BeneficiaryPermId,
+			WalletAgentPermId: a.WalletAgentPermId,
+		})
+	}
+
+	return types.PermissionSession{
+		Id:          session.Id,
+		Controller:  session.Controller,
+		AgentPermId: session.AgentPermId,
+		Authz:       authz,
+		Created:     session.Created,
+		Modified:    session.Modified,
+		Expires:     &expires,
+	}
+}
+
+// MigrateParams decodes the v1 params and stores them with the current params
+// type. The params introduced since v1 are set to their defaults: the slash
+// appeal period, introduced with slash disputes, the cascade batch size,
+// introduced with cascading revocations, the session lifetime params and the
+// fee update notice period.
+func MigrateParams(ctx context.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) (types.Params, error) {
+	kvStore := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+	var legacyParams Params
+	if bz := kvStore.Get(types.ParamsKey); bz != nil {
+		if err := cdc.Unmarshal(bz, &legacyParams); err != nil {
+			return types.Params{}, err
+		}
+	}
+
+	params := types.Params{
+		ValidationTermRequestedTimeoutDays: legacyParams.ValidationTermRequestedTimeoutDays,
+		SlashAppealPeriodDays:              types.DefaultSlashAppealPeriodDays,
+		CascadeBatchSize:                   types.DefaultCascadeBatchSize,
+		SessionMaxDurationDays:             types.DefaultSessionMaxDurationDays,
+		SessionMaxAuthz:                    types.DefaultSessionMaxAuthz,
+		FeeUpdateNoticePeriodDays:          types.DefaultFeeUpdateNoticePeriodDays,
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return params, err
+	}
+	kvStore.Set(types.ParamsKey, bz)
+
+	return params, nil
+}
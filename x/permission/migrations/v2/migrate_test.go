@@ -0,0 +1,410 @@
+package v2_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	v2 "github.com/verana-labs/verana-blockchain/x/permission/migrations/v2"
+	"github.com/verana-labs/verana-blockchain/x/permission/types"
+)
+
+// v1State is the v1 store fixture, its entries are decoded with the frozen v1
+// types.
+type v1State struct {
+	Permissions      []json.RawMessage `json:"permissions"`
+	NextPermissionID uint64            `json:"next_permission_id,string"`
+	Sessions         []json.RawMessage `json:"sessions"`
+	Params           json.RawMessage   `json:"params"`
+}
+
+// migrationStore holds a perm store and its collections, with the v1 and the
+// current types.
+type migrationStore struct {
+	ctx          sdk.Context
+	storeService store.KVStoreService
+	cdc          codec.Codec
+
+	v1Permissions         collections.Map[uint64, v2.Permission]
+	v1Sessions            collections.Map[string, v2.PermissionSession]
+	permissions           collections.Map[uint64, types.Permission]
+	permissionCounter     collections.Item[uint64]
+	permissionByValidator collections.KeySet[collections.Pair[uint64, uint64]]
+	permissionByCountry   collections.KeySet[collections.Pair[string, uint64]]
+	sessions              collections.Map[string, types.PermissionSession]
+	sessionExpiryQueue    collections.KeySet[collections.Pair[time.Time, string]]
+}
+
+func newMigrationStore(t *testing.T) migrationStore {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	ctx = ctx.WithBlockTime(time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC))
+	storeService := runtime.NewKVStoreService(storeKey)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	v1 := collections.NewSchemaBuilder(storeService)
+	sb := collections.NewSchemaBuilder(storeService)
+	s := migrationStore{
+		ctx:                   ctx,
+		storeService:          storeService,
+		cdc:                   cdc,
+		v1Permissions:         collections.NewMap(v1, types.PermissionKey, "perm", collections.Uint64Key, codec.CollValue[v2.Permission](cdc)),
+		v1Sessions:            collections.NewMap(v1, types.PermissionSessionKey, "permission_session", collections.StringKey, codec.CollValue[v2.PermissionSession](cdc)),
+		permissions:           collections.NewMap(sb, types.PermissionKey, "perm", collections.Uint64Key, codec.CollValue[types.Permission](cdc)),
+		permissionCounter:     collections.NewItem(sb, types.PermissionCounterKey, "permission_counter", collections.Uint64Value),
+		permissionByValidator: collections.NewKeySet(sb, types.PermissionByValidatorKey, "permission_by_validator", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key)),
+		permissionByCountry:   collections.NewKeySet(sb, types.PermissionByCountryKey, "permission_by_country", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		sessions:              collections.NewMap(sb, types.PermissionSessionKey, "permission_session", collections.StringKey, codec.CollValue[types.PermissionSession](cdc)),
+		sessionExpiryQueue:    collections.NewKeySet(sb, types.SessionExpiryQueueKey, "session_expiry_queue", collections.PairKeyCodec(sdk.TimeKey, collections.StringKey)),
+	}
+	_, err := v1.Build()
+	require.NoError(t, err)
+	_, err = sb.Build()
+	require.NoError(t, err)
+
+	return s
+}
+
+// loadV1State stores the v1 state as restored by the v0.6 upgrade: root perms
+// without a type, single country perms, the next perm ID stored as counter and
+// sessions without expiration.
+func (s migrationStore) loadV1State(t *testing.T) {
+	bz, err := os.ReadFile("testdata/v1_state.json")
+	require.NoError(t, err)
+	var fixture v1State
+	require.NoError(t, json.Unmarshal(bz, &fixture))
+
+	for _, raw := range fixture.Permissions {
+		var perm v2.Permission
+		require.NoError(t, s.cdc.UnmarshalJSON(raw, &perm))
+		require.NoError(t, s.v1Permissions.Set(s.ctx, perm.Id, perm))
+	}
+	require.NoError(t, s.permissionCounter.Set(s.ctx, fixture.NextPermissionID))
+	for _, raw := range fixture.Sessions {
+		var session v2.PermissionSession
+		require.NoError(t, s.cdc.UnmarshalJSON(raw, &session))
+		require.NoError(t, s.v1Sessions.Set(s.ctx, session.Id, session))
+	}
+	var params v2.Params
+	require.NoError(t, s.cdc.UnmarshalJSON(fixture.Params, &params))
+	require.NoError(t, s.storeService.OpenKVStore(s.ctx).Set(types.ParamsKey, s.cdc.MustMarshal(&params)))
+}
+
+// setPermissions stores perms as left by the former steps of the migration.
+func (s migrationStore) setPermissions(t *testing.T, perms ...types.Permission) {
+	for _, perm := range perms {
+		require.NoError(t, s.permissions.Set(s.ctx, perm.Id, perm))
+	}
+}
+
+func TestMigrateStore(t *testing.T) {
+	s := newMigrationStore(t)
+	s.loadV1State(t)
+
+	require.NoError(t, v2.MigrateStore(s.ctx, s.storeService, s.cdc))
+
+	// root perms are ECOSYSTEM perms, a perm with a validator cannot be
+	// guessed and is left untouched
+	for id, permType := range map[uint64]types.PermissionType{
+		2: types.PermissionType_PERMISSION_TYPE_ECOSYSTEM,
+		3: types.PermissionType_PERMISSION_TYPE_ECOSYSTEM,
+		4: types.PermissionType_PERMISSION_TYPE_UNSPECIFIED,
+		5: types.PermissionType_PERMISSION_TYPE_ISSUER,
+	} {
+		perm, err := s.permissions.Get(s.ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, permType, perm.Type, id)
+	}
+
+	// single countries are one-code scopes, legacy codes are mapped and
+	// those with no ISO 3166 equivalent are kept
+	perm, err := s.permissions.Get(s.ctx, 3)
+	require.NoError(t, err)
+	require.Equal(t, []string{"FR"}, perm.Countries)
+	perm, err = s.permissions.Get(s.ctx, 4)
+	require.NoError(t, err)
+	require.Len(t, perm.Countries, 27)
+	require.NotContains(t, perm.Countries, "EU")
+	perm, err = s.permissions.Get(s.ctx, 5)
+	require.NoError(t, err)
+	require.Equal(t, []string{"XK"}, perm.Countries)
+	require.NoError(t, types.ValidateStoredCountryScope(perm.Countries))
+
+	// perms are indexed by validator perm and by country
+	for _, child := range []uint64{4, 5} {
+		indexed, err := s.permissionByValidator.Has(s.ctx, collections.Join(uint64(2), child))
+		require.NoError(t, err)
+		require.True(t, indexed)
+	}
+	for _, country := range []collections.Pair[string, uint64]{
+		collections.Join("FR", uint64(3)),
+		collections.Join("DE", uint64(4)),
+		collections.Join("XK", uint64(5)),
+	} {
+		indexed, err := s.permissionByCountry.Has(s.ctx, country)
+		require.NoError(t, err)
+		require.True(t, indexed)
+	}
+
+	// the counter caught up with the stored perms
+	counter, err := s.permissionCounter.Get(s.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), counter)
+
+	// the v1 params are kept, the new ones are set to their defaults
+	var params types.Params
+	bz, err := s.storeService.OpenKVStore(s.ctx).Get(types.ParamsKey)
+	require.NoError(t, err)
+	require.NoError(t, s.cdc.Unmarshal(bz, &params))
+	require.Equal(t, uint64(30), params.ValidationTermRequestedTimeoutDays)
+	require.NoError(t, params.Validate())
+
+	// legacy sessions expire after the maximum session duration
+	session, err := s.sessions.Get(s.ctx, "legacy-session")
+	require.NoError(t, err)
+	expires := s.ctx.BlockTime().AddDate(0, 0, int(types.DefaultSessionMaxDurationDays))
+	require.Equal(t, expires, *session.Expires)
+}
+
+func TestMigratePermissions(t *testing.T) {
+	s := newMigrationStore(t)
+
+	created := time.Date(2025, 6, 18, 16, 27, 24, 0, time.UTC)
+	exp := created.AddDate(1, 0, 0)
+	require.NoError(t, s.v1Permissions.Set(s.ctx, 1, v2.Permission{
+		Id:                 1,
+		SchemaId:           2,
+		Type:               v2.PermissionType_PERMISSION_TYPE_ISSUER,
+		Did:                "did:example:issuer",
+		Grantee:            "verana1k6exwj6644xy028vxtzxs2fhf9nt8hymeuqkz7",
+		Created:            &created,
+		Modified:           &created,
+		IssuanceFees:       10,
+		Deposit:            20,
+		SlashedDeposit:     5,
+		Country:            "FR",
+		ValidatorPermId:    3,
+		VpState:            v2.ValidationState_VALIDATION_STATE_VALIDATED,
+		VpExp:              &exp,
+		VpSummaryDigestSri: "sha384-digest",
+	}))
+	require.NoError(t, s.v1Permissions.Set(s.ctx, 2, v2.Permission{Id: 2, Created: &created}))
+
+	require.NoError(t, v2.MigratePermissions(s.ctx, s.storeService, s.cdc))
+
+	// the v1 fields are kept, the country is a one-code scope
+	perm, err := s.permissions.Get(s.ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, types.Permission{
+		Id:                 1,
+		SchemaId:           2,
+		Type:               types.PermissionType_PERMISSION_TYPE_ISSUER,
+		Did:                "did:example:issuer",
+		Grantee:            "verana1k6exwj6644xy028vxtzxs2fhf9nt8hymeuqkz7",
+		Created:            &created,
+		Modified:           &created,
+		IssuanceFees:       10,
+		Deposit:            20,
+		SlashedDeposit:     5,
+		Countries:          []string{"FR"},
+		ValidatorPermId:    3,
+		VpState:            types.ValidationState_VALIDATION_STATE_VALIDATED,
+		VpExp:              &exp,
+		VpSummaryDigestSri: "sha384-digest",
+	}, perm)
+
+	// a perm without country has an empty scope
+	perm, err = s.permissions.Get(s.ctx, 2)
+	require.NoError(t, err)
+	require.Empty(t, perm.Countries)
+}
+
+func TestRepairRootPermissionTypes(t *testing.T) {
+	s := newMigrationStore(t)
+	s.setPermissions(t,
+		types.Permission{Id: 1},
+		types.Permission{Id: 2, ValidatorPermId: 1},
+		types.Permission{Id: 3, Type: types.PermissionType_PERMISSION_TYPE_ISSUER, ValidatorPermId: 1},
+	)
+
+	require.NoError(t, v2.RepairRootPermissionTypes(s.ctx, s.storeService, s.cdc))
+
+	for id, permType := range map[uint64]types.PermissionType{
+		1: types.PermissionType_PERMISSION_TYPE_ECOSYSTEM,
+		2: types.PermissionType_PERMISSION_TYPE_UNSPECIFIED,
+		3: types.PermissionType_PERMISSION_TYPE_ISSUER,
+	} {
+		perm, err := s.permissions.Get(s.ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, permType, perm.Type, id)
+	}
+}
+
+func TestMapLegacyCountryScopes(t *testing.T) {
+	s := newMigrationStore(t)
+	s.setPermissions(t,
+		types.Permission{Id: 1, Countries: []string{"EU", "FR-75", "XK"}},
+		types.Permission{Id: 2, Countries: []string{"UK", "EL"}},
+		types.Permission{Id: 3, Countries: []string{"US"}},
+	)
+
+	require.NoError(t, v2.MapLegacyCountryScopes(s.ctx, s.storeService, s.cdc))
+
+	// legacy codes are mapped to ISO 3166 codes, subdivisions of a mapped
+	// country are dropped and codes with no equivalent are kept, genesis
+	// validation still accepts the scope
+	perm, err := s.permissions.Get(s.ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, perm.Countries, 28)
+	require.Contains(t, perm.Countries, "FR")
+	require.Contains(t, perm.Countries, "XK")
+	require.NotContains(t, perm.Countries, "EU")
+	require.NotContains(t, perm.Countries, "FR-75")
+	require.NoError(t, types.ValidateStoredCountryScope(perm.Countries))
+	require.Error(t, types.ValidateCountryScope(perm.Countries))
+
+	perm, err = s.permissions.Get(s.ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, []string{"GB", "GR"}, perm.Countries)
+
+	perm, err = s.permissions.Get(s.ctx, 3)
+	require.NoError(t, err)
+	require.Equal(t, []string{"US"}, perm.Countries)
+}
+
+func TestSetPermissionCounter(t *testing.T) {
+	s := newMigrationStore(t)
+
+	// an empty store keeps no counter
+	require.NoError(t, v2.SetPermissionCounter(s.ctx, s.storeService, s.cdc))
+	_, err := s.permissionCounter.Get(s.ctx)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	// a counter behind the stored perms catches up
+	s.setPermissions(t, types.Permission{Id: 2}, types.Permission{Id: 4})
+	require.NoError(t, s.permissionCounter.Set(s.ctx, 3))
+	require.NoError(t, v2.SetPermissionCounter(s.ctx, s.storeService, s.cdc))
+	counter, err := s.permissionCounter.Get(s.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), counter)
+
+	// a counter ahead of the stored perms is kept
+	require.NoError(t, s.permissionCounter.Set(s.ctx, 9))
+	require.NoError(t, v2.SetPermissionCounter(s.ctx, s.storeService, s.cdc))
+	counter, err = s.permissionCounter.Get(s.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(9), counter)
+}
+
+func TestIndexPermissionsByValidator(t *testing.T) {
+	s := newMigrationStore(t)
+	s.setPermissions(t,
+		types.Permission{Id: 1},
+		types.Permission{Id: 2, ValidatorPermId: 1},
+		types.Permission{Id: 3, ValidatorPermId: 2},
+	)
+
+	require.NoError(t, v2.IndexPermissionsByValidator(s.ctx, s.storeService, s.cdc))
+
+	var indexed []collections.Pair[uint64, uint64]
+	require.NoError(t, s.permissionByValidator.Walk(s.ctx, nil, func(key collections.Pair[uint64, uint64]) (bool, error) {
+		indexed = append(indexed, key)
+		return false, nil
+	}))
+	require.Equal(t, []collections.Pair[uint64, uint64]{
+		collections.Join(uint64(1), uint64(2)),
+		collections.Join(uint64(2), uint64(3)),
+	}, indexed)
+}
+
+func TestIndexPermissionsByCountry(t *testing.T) {
+	s := newMigrationStore(t)
+	s.setPermissions(t,
+		types.Permission{Id: 1, Countries: []string{"FR", "DE"}},
+		types.Permission{Id: 2},
+	)
+
+	require.NoError(t, v2.IndexPermissionsByCountry(s.ctx, s.storeService, s.cdc))
+
+	var indexed []collections.Pair[string, uint64]
+	require.NoError(t, s.permissionByCountry.Walk(s.ctx, nil, func(key collections.Pair[string, uint64]) (bool, error) {
+		indexed = append(indexed, key)
+		return false, nil
+	}))
+	require.Equal(t, []collections.Pair[string, uint64]{
+		collections.Join("DE", uint64(1)),
+		collections.Join("FR", uint64(1)),
+	}, indexed)
+}
+
+func TestMigrateParams(t *testing.T) {
+	s := newMigrationStore(t)
+	kvStore := s.storeService.OpenKVStore(s.ctx)
+	require.NoError(t, kvStore.Set(types.ParamsKey, s.cdc.MustMarshal(&v2.Params{ValidationTermRequestedTimeoutDays: 30})))
+
+	params, err := v2.MigrateParams(s.ctx, s.storeService, s.cdc)
+	require.NoError(t, err)
+
+	// the v1 param is kept, the params introduced since are set to their
+	// defaults
+	expected := types.Params{
+		ValidationTermRequestedTimeoutDays: 30,
+		SlashAppealPeriodDays:              types.DefaultSlashAppealPeriodDays,
+		CascadeBatchSize:                   types.DefaultCascadeBatchSize,
+		SessionMaxDurationDays:             types.DefaultSessionMaxDurationDays,
+		SessionMaxAuthz:                    types.DefaultSessionMaxAuthz,
+		FeeUpdateNoticePeriodDays:          types.DefaultFeeUpdateNoticePeriodDays,
+	}
+	require.Equal(t, expected, params)
+
+	var stored types.Params
+	bz, err := kvStore.Get(types.ParamsKey)
+	require.NoError(t, err)
+	require.NoError(t, s.cdc.Unmarshal(bz, &stored))
+	require.Equal(t, expected, stored)
+}
+
+func TestMigrateSessions(t *testing.T) {
+	s := newMigrationStore(t)
+
+	created := time.Date(2025, 6, 22, 10, 0, 0, 0, time.UTC)
+	require.NoError(t, s.v1Sessions.Set(s.ctx, "legacy-session", v2.PermissionSession{
+		Id:          "legacy-session",
+		Controller:  "verana1k6exwj6644xy028vxtzxs2fhf9nt8hymeuqkz7",
+		AgentPermId: 4,
+		Authz:       []*v2.SessionAuthz{{ExecutorPermId: 5, BeneficiaryPermId: 2, WalletAgentPermId: 4}},
+		Created:     &created,
+		Modified:    &created,
+	}))
+
+	require.NoError(t, v2.MigrateSessions(s.ctx, s.storeService, s.cdc, types.Params{SessionMaxDurationDays: 10}))
+
+	// legacy sessions expire after the maximum session duration from the
+	// migration and are indexed by expiration
+	expires := s.ctx.BlockTime().AddDate(0, 0, 10)
+	session, err := s.sessions.Get(s.ctx, "legacy-session")
+	require.NoError(t, err)
+	require.Equal(t, types.PermissionSession{
+		Id:          "legacy-session",
+		Controller:  "verana1k6exwj6644xy028vxtzxs2fhf9nt8hymeuqkz7",
+		AgentPermId: 4,
+		Authz:       []*types.SessionAuthz{{ExecutorPermId: 5, BeneficiaryPermId: 2, WalletAgentPermId: 4}},
+		Created:     &created,
+		Modified:    &created,
+		Expires:     &expires,
+	}, session)
+	indexed, err := s.sessionExpiryQueue.Has(s.ctx, collections.Join(expires, "legacy-session"))
+	require.NoError(t, err)
+	require.True(t, indexed)
+}
@@ -1,9 +1,18 @@
 package permission
 
 import (
+	"fmt"
+	"strconv"
+
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
 
 	modulev1 "github.com/verana-labs/verana-blockchain/api/verana/perm/v1"
+	"github.com/verana-labs/verana-blockchain/x/permission/types"
+	tdtypes "github.com/verana-labs/verana-blockchain/x/trustdeposit/types"
 )
 
 // AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
@@ -73,6 +82,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 							Usage:        "Maximum number of results to return (1-1024)",
 							DefaultValue: "64",
 						},
+						"controller": {
+							Name:  "controller",
+							Usage: "Filter by session controller",
+						},
+						"agent_perm_id": {
+							Name:         "agent-perm-id",
+							Usage:        "Filter by agent perm ID",
+							DefaultValue: "0",
+						},
 					},
 				},
 				{
@@ -116,6 +134,362 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						},
 					},
 				},
+				{
+					RpcMethod: "GetSessionAllowance",
+					Use:       "get-session-allowance [id]",
+					Short:     "Get a session allowance by ID",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "id",
+						},
+					},
+				},
+				{
+					RpcMethod: "ListSessionAllowances",
+					Use:       "list-session-allowances",
+					Short:     "List session allowances",
+					Long:      "List session allowances, optionally filtered by sponsor and scope",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"sponsor": {
+							Name:         "sponsor",
+							DefaultValue: "",
+							Usage:        "Filter by sponsor address",
+						},
+						"schema_id": {
+							Name:         "schema-id",
+							DefaultValue: "0",
+							Usage:        "Filter by credential schema ID",
+						},
+						"agent_perm_id": {
+							Name:         "agent-perm-id",
+							DefaultValue: "0",
+							Usage:        "Filter by agent perm ID",
+						},
+						"verifier_perm_id": {
+							Name:         "verifier-perm-id",
+							DefaultValue: "0",
+							Usage:        "Filter by verifier perm ID",
+						},
+						"response_max_size": {
+							Name:         "response-max-size",
+							DefaultValue: "64",
+							Usage:        "Maximum number of results (1-1024)",
+						},
+					},
+				},
+				{
+					RpcMethod: "GetSlashRecord",
+					Use:       "get-slash-record [id]",
+					Short:     "Get a slash record by ID",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "id",
+						},
+					},
+				},
+				{
+					RpcMethod: "ListSlashRecords",
+					Use:       "list-slash-records",
+					Short:     "List slash records",
+					Long:      "List slash records, optionally filtered by perm, grantee and slasher",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"perm_id": {
+							Name:         "perm-id",
+							DefaultValue: "0",
+							Usage:        "Filter by slashed perm ID",
+						},
+						"grantee": {
+							Name:         "grantee",
+							DefaultValue: "",
+							Usage:        "Filter by grantee address of the slashed perm",
+						},
+						"slashed_by": {
+							Name:         "slashed-by",
+							DefaultValue: "",
+							Usage:        "Filter by slasher address",
+						},
+						"after_id": {
+							Name:         "after-id",
+							DefaultValue: "0",
+							Usage:        "List the slash records with an ID above this one, next_after_id of the previous page",
+						},
+						"response_max_size": {
+							Name:         "response-max-size",
+							DefaultValue: "64",
+							Usage:        "Maximum number of results (1-1024)",
+						},
+					},
+				},
+				{
+					RpcMethod: "GetSlashDistribution",
+					Use:       "get-slash-distribution [tr-id]",
+					Short:     "Get where the slashed deposits of the perms of a trust registry go",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "tr_id",
+						},
+					},
+				},
+				{
+					RpcMethod: "GetPermissionTree",
+					Use:       "get-perm-tree [root-id]",
+					Short:     "Get the perms validated by a root perm",
+					Long:      "Get the perms validated, directly or not, by a root perm, breadth first, with their validity at the specified time",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "root_id"},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"depth": {
+							Name:         "depth",
+							DefaultValue: "0",
+							Usage:        "Maximum depth of the returned perms, 0 for no limit",
+						},
+						"type": {
+							Name:         "type",
+							DefaultValue: "0",
+							Usage:        "Filter by perm type",
+						},
+						"country": {
+							Name:         "country",
+							DefaultValue: "",
+							Usage:        "Country code (ISO 3166-1 alpha-2) used to check validity",
+						},
+						"when": {
+							Name:         "when",
+							DefaultValue: "",
+							Usage:        "Check validity at specified timestamp (RFC3339 format), defaults to the block time",
+						},
+						"only_valid": {
+							Name:         "only-valid",
+							DefaultValue: "false",
+							Usage:        "Only return valid perms",
+						},
+						"response_max_size": {
+							Name:         "response-max-size",
+							DefaultValue: "64",
+							Usage:        "Maximum number of results (1-1024)",
+						},
+					},
+				},
+				{
+					RpcMethod: "GetPermissionAncestry",
+					Use:       "get-perm-ancestry [id]",
+					Short:     "Get the chain of validators of a perm",
+					Long:      "Get the chain of validator perms of a perm up to its root perm, with their validity at the specified time",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"country": {
+							Name:         "country",
+							DefaultValue: "",
+							Usage:        "Country code (ISO 3166-1 alpha-2) used to check validity",
+						},
+						"when": {
+							Name:         "when",
+							DefaultValue: "",
+							Usage:        "Check validity at specified timestamp (RFC3339 format), defaults to the block time",
+						},
+					},
+				},
+				{
+					RpcMethod: "SimulateRevocationCascade",
+					Use:       "simulate-revocation-cascade [id] [mode]",
+					Short:     "List the perms a cascading revocation would affect",
+					Long:      "List the descendant perms a cascading revocation of a perm would revoke or suspend. Mode is revoke or suspend.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+						{ProtoField: "mode"},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"response_max_size": {
+							Name:         "response-max-size",
+							DefaultValue: "64",
+							Usage:        "Maximum number of results (1-1024)",
+						},
+					},
+				},
+				{
+					RpcMethod: "ListRevocationCascades",
+					Use:       "list-revocation-cascades",
+					Short:     "List the cascading revocations in progress",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"response_max_size": {
+							Name:         "response-max-size",
+							DefaultValue: "64",
+							Usage:        "Maximum number of results (1-1024)",
+						},
+					},
+				},
+				{
+					RpcMethod: "VerifySessionAuthorization",
+					Use:       "verify-session-authz [session-id] [agent-perm-id]",
+					Short:     "Verify that a perm session authorizes a credential exchange",
+					Long:      "Check that a perm session authorizes the executor (issuer) and beneficiary (verifier) perms with the agent perm, and report the validity of every perm involved",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "session_id"},
+						{ProtoField: "agent_perm_id"},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"executor_perm_id": {
+							Name:         "executor-perm-id",
+							Usage:        "ID of the executor (issuer) perm",
+							DefaultValue: "0",
+						},
+						"beneficiary_perm_id": {
+							Name:         "beneficiary-perm-id",
+							Usage:        "ID of the beneficiary (verifier) perm",
+							DefaultValue: "0",
+						},
+						"when": {
+							Name:  "when",
+							Usage: "Time of the verification (RFC3339 format), defaults to now",
+						},
+					},
+				},
+				{
+					RpcMethod: "QuoteSessionFees",
+					Use:       "quote-session-fees",
+					Short:     "Quote the fees of a perm session",
+					Long:      "Get the itemized fees, trust deposits and agent rewards the creation of a perm session costs",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"issuer_perm_id": {
+							Name:         "issuer-perm-id",
+							Usage:        "ID of the issuer perm",
+							DefaultValue: "0",
+						},
+						"verifier_perm_id": {
+							Name:         "verifier-perm-id",
+							Usage:        "ID of the verifier perm",
+							DefaultValue: "0",
+						},
+						"agent_perm_id": {
+							Name:         "agent-perm-id",
+							Usage:        "ID of the agent perm",
+							DefaultValue: "0",
+						},
+						"wallet_agent_perm_id": {
+							Name:         "wallet-agent-perm-id",
+							Usage:        "ID of the wallet agent perm",
+							DefaultValue: "0",
+						},
+					},
+				},
+				{
+					RpcMethod: "QuoteValidationFees",
+					Use:       "quote-validation-fees [validator-perm-id]",
+					Short:     "Quote the fees of a validation process",
+					Long:      "Get the validation fees and trust deposit a validation process with the validator perm costs",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_perm_id"},
+					},
+				},
+				{
+					RpcMethod: "GetAgentRewards",
+					Use:       "get-agent-rewards [perm-id]",
+					Short:     "Get the session rewards accrued by an agent perm",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "perm_id"},
+					},
+				},
+				{
+					RpcMethod: "GetVerificationCredit",
+					Use:       "get-verification-credit [id]",
+					Short:     "Get a verification credit bundle by ID",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "ListVerificationCredits",
+					Use:       "list-verification-credits",
+					Short:     "List verification credit bundles",
+					Long:      "List verification credit bundles, optionally filtered by buyer and verifier perm",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"buyer": {
+							Name:         "buyer",
+							DefaultValue: "",
+							Usage:        "Filter by buyer address",
+						},
+						"verifier_perm_id": {
+							Name:         "verifier-perm-id",
+							DefaultValue: "0",
+							Usage:        "Filter by verifier perm ID",
+						},
+						"with_remaining": {
+							Name:         "with-remaining",
+							DefaultValue: "false",
+							Usage:        "Only list bundles with unused credits",
+						},
+						"response_max_size": {
+							Name:         "response-max-size",
+							DefaultValue: "64",
+							Usage:        "Maximum number of results (1-1024)",
+						},
+					},
+				},
+				{
+					RpcMethod: "GetVoucherNonce",
+					Use:       "get-voucher-nonce [signer]",
+					Short:     "Get the last session voucher nonce settled for a signer",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "signer"},
+					},
+				},
+				{
+					RpcMethod: "ListPermissionsByCountry",
+					Use:       "list-perms-by-country [country]",
+					Short:     "List the perms whose country scope covers a country or subdivision",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "country"},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"schema_id": {
+							Name:  "schema-id",
+							Usage: "Filter by credential schema ID",
+						},
+						"type": {
+							Name:  "type",
+							Usage: "Filter by perm type",
+						},
+						"response_max_size": {
+							Name:  "response-max-size",
+							Usage: "Maximum number of results (1-1024, default 64)",
+						},
+					},
+				},
+				{
+					RpcMethod: "ListVPMessages",
+					Use:       "list-vp-messages [perm-id]",
+					Short:     "List the messages anchored for the validation process of a perm",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "perm_id"},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"after_index": {
+							Name:  "after-index",
+							Usage: "List the messages anchored after this index",
+						},
+						"response_max_size": {
+							Name:  "response-max-size",
+							Usage: "Maximum number of results (1-1024, default 64)",
+						},
+					},
+				},
+				{
+					RpcMethod: "GetPermissionFees",
+					Use:       "get-perm-fees [id]",
+					Short:     "Get the current and scheduled fees of a perm, and its fee history",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"response_max_size": {
+							Name:  "response-max-size",
+							Usage: "Maximum number of fee history entries, the last ones (1-1024, default 64)",
+						},
+					},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -129,12 +503,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				},
 				{
 					RpcMethod: "StartPermissionVP",
-					Use:       "start-perm-vp [type] [validator-perm-id] [country]",
+					Use:       "start-perm-vp [type] [validator-perm-id] [countries...]",
 					Short:     "Start a new perm validation process",
 					Long: `Start a new perm validation process with the specified parameters:
 - type: Permission type (0=Unspecified, 1=Issuer, 2=Verifier, 3=IssuerGrantor, 4=VerifierGrantor, 5=TrustRegistry, 6=Holder)
 - validator-perm-id: ID of the validator perm
-- country: ISO 3166-1 alpha-2 country code`,
+- countries: ISO 3166-1 alpha-2 country or ISO 3166-2 subdivision codes, within the validator perm scope`,
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{
 							ProtoField: "type",
@@ -143,7 +517,8 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 							ProtoField: "validator_perm_id",
 						},
 						{
-							ProtoField: "country",
+							ProtoField: "countries",
+							Varargs:    true,
 						},
 					},
 					FlagOptions: map[string]*autocliv1.FlagOptions{
@@ -152,6 +527,10 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 							Usage:        "Optional DID for this perm",
 							DefaultValue: "",
 						},
+						"co_validator_perm_ids": {
+							Name:  "co-validator-perm-ids",
+							Usage: "Other validator perm IDs, required when the credential schema sets a validation quorum",
+						},
 					},
 				},
 				{
@@ -176,7 +555,7 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 - validation-fees: Optional validation fees
 - issuance-fees: Optional issuance fees
 - verification-fees: Optional verification fees
-- country: Optional country code (ISO 3166-1 alpha-2)
+- countries: Optional country codes (ISO 3166-1 alpha-2 or ISO 3166-2), empty for worldwide
 - vp-summary-digest-sri: Optional digest SRI of validation information`,
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{
@@ -204,10 +583,9 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 							Usage:        "Verification fees",
 							DefaultValue: "0",
 						},
-						"country": {
-							Name:         "country",
-							Usage:        "Country code (ISO 3166-1 alpha-2)",
-							DefaultValue: "",
+						"countries": {
+							Name:  "countries",
+							Usage: "Comma separated country codes (ISO 3166-1 alpha-2 or ISO 3166-2), empty for worldwide",
 						},
 						"vp_summary_digest_sri": {
 							Name:         "vp-summary-digest-sri",
@@ -276,10 +654,9 @@ For active VPs, only the grantee can request termination unless it's a HOLDER ty
 						},
 					},
 					FlagOptions: map[string]*autocliv1.FlagOptions{
-						"country": {
-							Name:         "country",
-							DefaultValue: "",
-							Usage:        "Optional country code (ISO 3166-1 alpha-2)",
+						"countries": {
+							Name:  "countries",
+							Usage: "Optional comma separated country codes (ISO 3166-1 alpha-2 or ISO 3166-2), empty for worldwide",
 						},
 						"effective_from": {
 							Name:         "effective-from",
@@ -311,7 +688,48 @@ For active VPs, only the grantee can request termination unless it's a HOLDER ty
 					RpcMethod: "RevokePermission",
 					Use:       "revoke-perm [id]",
 					Short:     "Revoke a perm",
-					Long:      "Revoke a perm. Can only be executed by the validator of the perm.",
+					Long:      "Revoke a perm. Can only be executed by the validator of the perm. With --cascade, every descendant perm is also revoked or suspended.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "id",
+						},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"cascade": {
+							Name:         "cascade",
+							DefaultValue: "none",
+							Usage:        "What happens to the descendant perms: none, revoke or suspend",
+						},
+					},
+				},
+				{
+					RpcMethod: "SuspendPermission",
+					Use:       "suspend-perm [id]",
+					Short:     "Suspend a perm",
+					Long:      "Temporarily suspend a perm. Can only be executed by the validator of the perm. The suspension lasts until the perm is unsuspended or, if set, until --suspended-until.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "id",
+						},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"reason": {
+							Name:         "reason",
+							DefaultValue: "",
+							Usage:        "Reason of the suspension",
+						},
+						"suspended_until": {
+							Name:         "suspended-until",
+							DefaultValue: "",
+							Usage:        "Time the suspension ends by itself (RFC3339 format)",
+						},
+					},
+				},
+				{
+					RpcMethod: "UnsuspendPermission",
+					Use:       "unsuspend-perm [id]",
+					Short:     "Unsuspend a perm",
+					Long:      "Lift the suspension of a perm. Can only be executed by the validator of the perm.",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{
 							ProtoField: "id",
@@ -329,6 +747,9 @@ Optional parameters:
 - issuer-perm-id: ID of the issuer perm
 - verifier-perm-id: ID of the verifier perm
 - wallet-agent-perm-id: ID of the wallet agent perm if different from agent
+- allowance-id: ID of the session allowance paying the fees
+- credit-id: ID of the verification credit bundle prepaying the fees
+- expires: expiration of a new session, defaults to the maximum session duration
 
 At least one of issuer-perm-id or verifier-perm-id must be provided.`,
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
@@ -355,11 +776,36 @@ At least one of issuer-perm-id or verifier-perm-id must be provided.`,
 							Usage:        "ID of the wallet agent perm if different from agent",
 							DefaultValue: "0",
 						},
+						"allowance_id": {
+							Name:         "allowance-id",
+							Usage:        "ID of the session allowance paying the fees",
+							DefaultValue: "0",
+						},
+						"credit_id": {
+							Name:         "credit-id",
+							Usage:        "ID of the verification credit bundle prepaying the fees",
+							DefaultValue: "0",
+						},
+						"expires": {
+							Name:  "expires",
+							Usage: "Expiration of a new session (RFC3339 format)",
+						},
+					},
+				},
+				{
+					RpcMethod: "ClosePermissionSession",
+					Use:       "close-perm-session [id]",
+					Short:     "Close a perm session",
+					Long:      "Close a perm session before it expires. Can only be executed by the session controller.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "id",
+						},
 					},
 				},
 				{
 					RpcMethod: "SlashPermissionTrustDeposit",
-					Use:       "slash-perm-td [id] [amount]",
+					Use:       "slash-perm-td [id] [amount] [reason-code]",
 					Short:     "Slash a perm's trust deposit",
 					Long: `Slash a perm's trust deposit. Can only be executed by:
 - The validator that created the perm
@@ -368,7 +814,8 @@ At least one of issuer-perm-id or verifier-perm-id must be provided.`,
 
 Parameters:
 - id: ID of the perm to slash
-- amount: Amount to slash from the trust deposit`,
+- amount: Amount to slash from the trust deposit
+- reason-code: Code of the violated rule, as listed by the governance framework`,
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{
 							ProtoField: "id",
@@ -376,6 +823,26 @@ Parameters:
 						{
 							ProtoField: "amount",
 						},
+						{
+							ProtoField: "reason_code",
+						},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"evidence_url": {
+							Name:         "evidence-url",
+							DefaultValue: "",
+							Usage:        "URL of the evidence backing the slash",
+						},
+						"evidence_digest_sri": {
+							Name:         "evidence-digest-sri",
+							DefaultValue: "",
+							Usage:        "Digest SRI of the evidence document",
+						},
+						"gf_version": {
+							Name:         "gf-version",
+							DefaultValue: "0",
+							Usage:        "Violated governance framework version, the active version if 0",
+						},
 					},
 				},
 				{
@@ -407,7 +874,7 @@ Parameters:
 - did: DID of the grantee service
 
 Optional flags:
-- country: ISO 3166-1 alpha-2 country code
+- countries: ISO 3166-1 alpha-2 or ISO 3166-2 codes, within the ecosystem perm scope
 - effective-from: Timestamp when perm becomes effective (RFC3339)
 - effective-until: Timestamp when perm expires (RFC3339)
 - verification-fees: Fees for credential verification (default: 0)`,
@@ -423,10 +890,9 @@ Optional flags:
 						},
 					},
 					FlagOptions: map[string]*autocliv1.FlagOptions{
-						"country": {
-							Name:         "country",
-							DefaultValue: "",
-							Usage:        "Optional country code (ISO 3166-1 alpha-2)",
+						"countries": {
+							Name:  "countries",
+							Usage: "Optional comma separated country codes (ISO 3166-1 alpha-2 or ISO 3166-2), empty for worldwide",
 						},
 						"effective_from": {
 							Name:         "effective-from",
@@ -445,8 +911,241 @@ Optional flags:
 						},
 					},
 				},
+				{
+					RpcMethod: "CreateSessionAllowance",
+					Use:       "create-session-allowance [amount]",
+					Short:     "Create a session allowance to sponsor session fees",
+					Long: `Escrow amount uvna to pay the fees of perm sessions. Exactly one scope must be set:
+- schema-id: sessions on perms of this credential schema
+- agent-perm-id: sessions of this agent perm
+- verifier-perm-id: sessions of this verifier perm
+A schema scoped allowance must list the accounts allowed to create the sessions it pays for.`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "amount",
+						},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"schema_id": {
+							Name:         "schema-id",
+							Usage:        "ID of the credential schema the allowance is scoped to",
+							DefaultValue: "0",
+						},
+						"agent_perm_id": {
+							Name:         "agent-perm-id",
+							Usage:        "ID of the agent perm the allowance is scoped to",
+							DefaultValue: "0",
+						},
+						"verifier_perm_id": {
+							Name:         "verifier-perm-id",
+							Usage:        "ID of the verifier perm the allowance is scoped to",
+							DefaultValue: "0",
+						},
+						"allowed_creators": {
+							Name:  "allowed-creators",
+							Usage: "Comma separated accounts allowed to create sessions paid by the allowance",
+						},
+						"max_per_session": {
+							Name:         "max-per-session",
+							Usage:        "Maximum amount in uvna drawn for a single session, 0 for no maximum",
+							DefaultValue: "0",
+						},
+					},
+				},
+				{
+					RpcMethod: "FundSessionAllowance",
+					Use:       "fund-session-allowance [id] [amount]",
+					Short:     "Add funds to a session allowance",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "id",
+						},
+						{
+							ProtoField: "amount",
+						},
+					},
+				},
+				{
+					RpcMethod: "WithdrawSessionAllowance",
+					Use:       "withdraw-session-allowance [id]",
+					Short:     "Withdraw the remaining funds of a session allowance",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "id",
+						},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"amount": {
+							Name:         "amount",
+							Usage:        "Amount to withdraw, everything that remains if 0",
+							DefaultValue: "0",
+						},
+					},
+				},
+				{
+					RpcMethod: "PurchaseVerificationCredits",
+					Use:       "purchase-verification-credits [verifier-perm-id] [count]",
+					Short:     "Prepay the fees of verification sessions",
+					Long: `Pay at once the session fees of count verification sessions with the verifier perm.
+The credits are consumed by passing --credit-id to create-or-update-perm-session.
+Optional parameters:
+- issuer-perm-id: ID of the issuer perm of the verified credentials`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "verifier_perm_id",
+						},
+						{
+							ProtoField: "count",
+						},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"issuer_perm_id": {
+							Name:         "issuer-perm-id",
+							Usage:        "ID of the issuer perm",
+							DefaultValue: "0",
+						},
+					},
+				},
+				{
+					RpcMethod: "RefundVerificationCredits",
+					Use:       "refund-verification-credits [id]",
+					Short:     "Close a verification credit bundle",
+					Long:      "Close a verification credit bundle. The fees of the unused credits are not returned, their trust deposit is released.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "id",
+						},
+					},
+				},
+				{
+					RpcMethod: "SettleSessionVouchers",
+					Skip:      true, // skipped because vouchers are signed off-chain and submitted by aggregators
+				},
+				{
+					RpcMethod: "AnchorVPMessage",
+					Use:       "anchor-vp-message [perm-id] [digest-sri] [encrypted-uri]",
+					Short:     "Anchor a message of the validation process of a perm",
+					Long: `Anchor a message exchanged off-chain between the applicant and the validator of a validation process:
+- perm-id: ID of the applicant perm
+- digest-sri: Digest SRI of the message content
+- encrypted-uri: URI where the encrypted message can be retrieved`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "perm_id"},
+						{ProtoField: "digest_sri"},
+						{ProtoField: "encrypted_uri"},
+					},
+				},
+				{
+					RpcMethod: "UpdatePermissionFees",
+					Use:       "update-perm-fees [id] [validation-fees] [issuance-fees] [verification-fees]",
+					Short:     "Schedule new fees for a perm",
+					Long: `Schedule new fees, in trust units, for a perm you are the grantee of. The fees take
+effect after the fee update notice period, a pending fee update is replaced:
+- id: ID of the perm
+- validation-fees: Validation fees
+- issuance-fees: Issuance fees
+- verification-fees: Verification fees`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+						{ProtoField: "validation_fees"},
+						{ProtoField: "issuance_fees"},
+						{ProtoField: "verification_fees"},
+					},
+				},
+				{
+					RpcMethod: "AppealSlash",
+					Use:       "appeal-slash [id] [evidence-digest-sri]",
+					Short:     "Appeal a pending slash of your perm trust deposit",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "id",
+						},
+						{
+							ProtoField: "evidence_digest_sri",
+						},
+					},
+				},
+				{
+					RpcMethod: "ResolveSlashAppeal",
+					Use:       "resolve-slash-appeal [id] [uphold]",
+					Short:     "Execute (uphold=true) or reverse (uphold=false) an appealed slash",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "id",
+						},
+						{
+							ProtoField: "uphold",
+						},
+					},
+				},
+				{
+					RpcMethod: "SetSlashDistribution",
+					Skip:      true, // custom command, weights are decimals
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
 	}
 }
+
+// GetTxCmd returns the custom perm tx commands, autocli adds the others.
+func (am AppModule) GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdSetSlashDistribution())
+
+	return cmd
+}
+
+func CmdSetSlashDistribution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-slash-distribution [tr-id] [distribution]",
+		Short: "Set where the slashed deposits of the perms of a trust registry go",
+		Long: `Set where the slashed deposits of the perms of a trust registry go. Can only be executed by the trust registry controller.
+
+Parameters:
+- tr-id: ID of the trust registry
+- distribution: comma separated destination:weight shares, where destination is burn, community-pool or a beneficiary address, e.g. burn:0.5,community-pool:0.5. Slashed deposits are burned if empty.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			trId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid trust registry ID: %s", args[0])
+			}
+
+			var distribution []tdtypes.SlashShare
+			if len(args) > 1 {
+				distribution, err = tdtypes.ParseSlashShares(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := &types.MsgSetSlashDistribution{
+				Creator:      clientCtx.GetFromAddress().String(),
+				TrId:         trId,
+				Distribution: distribution,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -15,10 +15,17 @@ import (
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *types.GenesisState {
 	return &types.GenesisState{
-		Params:             types.DefaultParams(),
-		Permissions:        []types.Permission{},
-		PermissionSessions: []types.PermissionSession{},
-		NextPermissionId:   1, // Start with 1 as first ID
+		Params:                   types.DefaultParams(),
+		Permissions:              []types.Permission{},
+		PermissionSessions:       []types.PermissionSession{},
+		NextPermissionId:         1, // Start with 1 as first ID
+		SessionAllowances:        []types.SessionAllowance{},
+		NextSessionAllowanceId:   1,
+		SlashRecords:             []types.SlashRecord{},
+		NextSlashRecordId:        1,
+		SlashDistributions:       []types.TrustRegistrySlashDistribution{},
+		VerificationCredits:      []types.VerificationCredit{},
+		NextVerificationCreditId: 1,
 	}
 }
 
@@ -34,6 +41,21 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		if err := k.Permission.Set(ctx, perm.Id, perm); err != nil {
 			panic(fmt.Errorf("failed to set perm: %w", err))
 		}
+		if perm.ValidatorPermId != 0 {
+			if err := k.PermissionByValidator.Set(ctx, collections.Join(perm.ValidatorPermId, perm.Id)); err != nil {
+				panic(fmt.Errorf("failed to index perm: %w", err))
+			}
+		}
+		for _, code := range perm.Countries {
+			if err := k.PermissionByCountry.Set(ctx, collections.Join(code, perm.Id)); err != nil {
+				panic(fmt.Errorf("failed to index perm country: %w", err))
+			}
+		}
+		if perm.ScheduledFees != nil {
+			if err := k.FeeUpdateQueue.Set(ctx, collections.Join(*perm.ScheduledFees.EffectiveFrom, perm.Id)); err != nil {
+				panic(fmt.Errorf("failed to index fee update: %w", err))
+			}
+		}
 	}
 
 	// Import all perm sessions
@@ -41,12 +63,128 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		if err := k.PermissionSession.Set(ctx, session.Id, session); err != nil {
 			panic(fmt.Errorf("failed to set perm session: %w", err))
 		}
+		if session.Expires != nil {
+			if err := k.SessionExpiryQueue.Set(ctx, collections.Join(*session.Expires, session.Id)); err != nil {
+				panic(fmt.Errorf("failed to index perm session expiration: %w", err))
+			}
+		}
 	}
 
-	// Set the permissions counter
-	if err := k.PermissionCounter.Set(ctx, genState.NextPermissionId); err != nil {
+	// Set the permissions counter. The counter holds the last assigned ID, while
+	// genesis carries the next ID to be assigned.
+	lastPermissionId := uint64(0)
+	if genState.NextPermissionId > 0 {
+		lastPermissionId = genState.NextPermissionId - 1
+	}
+	if err := k.PermissionCounter.Set(ctx, lastPermissionId); err != nil {
 		panic(fmt.Errorf("failed to set perm counter: %w", err))
 	}
+
+	// Import all session allowances
+	for _, allowance := range genState.SessionAllowances {
+		if err := k.SessionAllowance.Set(ctx, allowance.Id, allowance); err != nil {
+			panic(fmt.Errorf("failed to set session allowance: %w", err))
+		}
+	}
+
+	lastSessionAllowanceId := uint64(0)
+	if genState.NextSessionAllowanceId > 0 {
+		lastSessionAllowanceId = genState.NextSessionAllowanceId - 1
+	}
+	if err := k.SessionAllowanceCounter.Set(ctx, lastSessionAllowanceId); err != nil {
+		panic(fmt.Errorf("failed to set session allowance counter: %w", err))
+	}
+
+	// Import all slash records, pending ones are queued again by appeal deadline
+	for _, record := range genState.SlashRecords {
+		if err := k.SlashRecord.Set(ctx, record.Id, record); err != nil {
+			panic(fmt.Errorf("failed to set slash record: %w", err))
+		}
+		if err := k.SlashRecordByPerm.Set(ctx, collections.Join(record.PermId, record.Id)); err != nil {
+			panic(fmt.Errorf("failed to index slash record by perm: %w", err))
+		}
+		if err := k.SlashRecordByGrantee.Set(ctx, collections.Join(record.Grantee, record.Id)); err != nil {
+			panic(fmt.Errorf("failed to index slash record by grantee: %w", err))
+		}
+		if err := k.SlashRecordBySlasher.Set(ctx, collections.Join(record.SlashedBy, record.Id)); err != nil {
+			panic(fmt.Errorf("failed to index slash record by slasher: %w", err))
+		}
+		if record.Status == types.SlashStatus_SLASH_STATUS_PENDING {
+			if err := k.SlashAppealQueue.Set(ctx, collections.Join(*record.AppealDeadline, record.Id)); err != nil {
+				panic(fmt.Errorf("failed to queue slash record: %w", err))
+			}
+		}
+	}
+
+	lastSlashRecordId := uint64(0)
+	if genState.NextSlashRecordId > 0 {
+		lastSlashRecordId = genState.NextSlashRecordId - 1
+	}
+	if err := k.SlashRecordCounter.Set(ctx, lastSlashRecordId); err != nil {
+		panic(fmt.Errorf("failed to set slash record counter: %w", err))
+	}
+
+	// Import the slash distributions of trust registries
+	for _, distribution := range genState.SlashDistributions {
+		if err := k.SlashDistribution.Set(ctx, distribution.TrId, distribution); err != nil {
+			panic(fmt.Errorf("failed to set slash distribution: %w", err))
+		}
+	}
+
+	// Import the cascading revocations in progress
+	for _, cascade := range genState.RevocationCascades {
+		if err := k.RevocationCascade.Set(ctx, cascade.PermId, cascade); err != nil {
+			panic(fmt.Errorf("failed to set revocation cascade: %w", err))
+		}
+	}
+
+	// Import the rewards accrued by agent perms
+	for _, reward := range genState.AgentRewards {
+		if err := k.AgentReward.Set(ctx, reward.PermId, reward); err != nil {
+			panic(fmt.Errorf("failed to set agent reward: %w", err))
+		}
+	}
+
+	// Import the prepaid verification credits
+	for _, credit := range genState.VerificationCredits {
+		if err := k.VerificationCredit.Set(ctx, credit.Id, credit); err != nil {
+			panic(fmt.Errorf("failed to set verification credit: %w", err))
+		}
+	}
+
+	lastVerificationCreditId := uint64(0)
+	if genState.NextVerificationCreditId > 0 {
+		lastVerificationCreditId = genState.NextVerificationCreditId - 1
+	}
+	if err := k.VerificationCreditCounter.Set(ctx, lastVerificationCreditId); err != nil {
+		panic(fmt.Errorf("failed to set verification credit counter: %w", err))
+	}
+
+	// Import the voucher nonces settled per signer
+	for _, nonce := range genState.VoucherNonces {
+		if err := k.VoucherNonce.Set(ctx, nonce.Signer, nonce.Nonce); err != nil {
+			panic(fmt.Errorf("failed to set voucher nonce: %w", err))
+		}
+		for _, used := range nonce.UsedNonces {
+			if err := k.UsedVoucherNonce.Set(ctx, collections.Join(nonce.Signer, used)); err != nil {
+				panic(fmt.Errorf("failed to set used voucher nonce: %w", err))
+			}
+		}
+	}
+
+	// Import all vp messages
+	for _, message := range genState.VpMessages {
+		if err := k.VPMessage.Set(ctx, collections.Join(message.PermId, message.Index), message); err != nil {
+			panic(fmt.Errorf("failed to set vp message: %w", err))
+		}
+	}
+
+	// Import the fee history of the perms
+	for _, record := range genState.FeeRecords {
+		if err := k.PermissionFeeRecord.Set(ctx, collections.Join(record.PermId, record.Index), record); err != nil {
+			panic(fmt.Errorf("failed to set fee record: %w", err))
+		}
+	}
 }
 
 // ExportGenesis returns the module's exported genesis.
@@ -88,18 +226,141 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 
 	genesis.PermissionSessions = sessions
 
-	// Export perm counter
-	nextId, err := k.PermissionCounter.Get(ctx)
+	// Export perm counter, which holds the last assigned ID
+	lastId, err := k.PermissionCounter.Get(ctx)
 	if err != nil && !errors.Is(err, collections.ErrNotFound) {
 		panic(fmt.Errorf("failed to get perm counter: %w", err))
 	}
 
-	// In case of no permissions, set next ID to 1
-	if errors.Is(err, collections.ErrNotFound) {
-		nextId = 1
+	// In case of no permissions, lastId is 0 and next ID is 1
+	genesis.NextPermissionId = lastId + 1
+
+	// Export all session allowances, walked in ID order
+	allowances := []types.SessionAllowance{}
+	if err := k.SessionAllowance.Walk(ctx, nil, func(id uint64, allowance types.SessionAllowance) (bool, error) {
+		allowances = append(allowances, allowance)
+		return false, nil
+	}); err != nil {
+		panic(fmt.Errorf("failed to export session allowances: %w", err))
+	}
+
+	genesis.SessionAllowances = allowances
+
+	lastAllowanceId, err := k.SessionAllowanceCounter.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		panic(fmt.Errorf("failed to get session allowance counter: %w", err))
+	}
+
+	genesis.NextSessionAllowanceId = lastAllowanceId + 1
+
+	// Export all slash records, walked in ID order
+	slashRecords := []types.SlashRecord{}
+	if err := k.SlashRecord.Walk(ctx, nil, func(id uint64, record types.SlashRecord) (bool, error) {
+		slashRecords = append(slashRecords, record)
+		return false, nil
+	}); err != nil {
+		panic(fmt.Errorf("failed to export slash records: %w", err))
+	}
+
+	genesis.SlashRecords = slashRecords
+
+	lastSlashRecordId, err := k.SlashRecordCounter.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		panic(fmt.Errorf("failed to get slash record counter: %w", err))
+	}
+
+	genesis.NextSlashRecordId = lastSlashRecordId + 1
+
+	// Export the slash distributions of trust registries
+	slashDistributions := []types.TrustRegistrySlashDistribution{}
+	if err := k.SlashDistribution.Walk(ctx, nil, func(trId uint64, distribution types.TrustRegistrySlashDistribution) (bool, error) {
+		slashDistributions = append(slashDistributions, distribution)
+		return false, nil
+	}); err != nil {
+		panic(fmt.Errorf("failed to export slash distributions: %w", err))
+	}
+
+	genesis.SlashDistributions = slashDistributions
+
+	// Export the cascading revocations in progress, walked in perm ID order
+	cascades := []types.RevocationCascade{}
+	if err := k.RevocationCascade.Walk(ctx, nil, func(permId uint64, cascade types.RevocationCascade) (bool, error) {
+		cascades = append(cascades, cascade)
+		return false, nil
+	}); err != nil {
+		panic(fmt.Errorf("failed to export revocation cascades: %w", err))
+	}
+
+	genesis.RevocationCascades = cascades
+
+	// Export the rewards accrued by agent perms, walked in perm ID order
+	agentRewards := []types.AgentReward{}
+	if err := k.AgentReward.Walk(ctx, nil, func(permId uint64, reward types.AgentReward) (bool, error) {
+		agentRewards = append(agentRewards, reward)
+		return false, nil
+	}); err != nil {
+		panic(fmt.Errorf("failed to export agent rewards: %w", err))
+	}
+
+	genesis.AgentRewards = agentRewards
+
+	// Export the prepaid verification credits, walked in ID order
+	credits := []types.VerificationCredit{}
+	if err := k.VerificationCredit.Walk(ctx, nil, func(id uint64, credit types.VerificationCredit) (bool, error) {
+		credits = append(credits, credit)
+		return false, nil
+	}); err != nil {
+		panic(fmt.Errorf("failed to export verification credits: %w", err))
+	}
+
+	genesis.VerificationCredits = credits
+
+	lastVerificationCreditId, err := k.VerificationCreditCounter.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		panic(fmt.Errorf("failed to get verification credit counter: %w", err))
+	}
+
+	genesis.NextVerificationCreditId = lastVerificationCreditId + 1
+
+	// Export the voucher nonces settled, walked in signer and nonce order
+	usedNonces := make(map[string][]uint64)
+	if err := k.UsedVoucherNonce.Walk(ctx, nil, func(key collections.Pair[string, uint64]) (bool, error) {
+		usedNonces[key.K1()] = append(usedNonces[key.K1()], key.K2())
+		return false, nil
+	}); err != nil {
+		panic(fmt.Errorf("failed to export used voucher nonces: %w", err))
+	}
+	voucherNonces := []types.VoucherNonce{}
+	if err := k.VoucherNonce.Walk(ctx, nil, func(signer string, nonce uint64) (bool, error) {
+		voucherNonces = append(voucherNonces, types.VoucherNonce{Signer: signer, Nonce: nonce, UsedNonces: usedNonces[signer]})
+		return false, nil
+	}); err != nil {
+		panic(fmt.Errorf("failed to export voucher nonces: %w", err))
+	}
+
+	genesis.VoucherNonces = voucherNonces
+
+	// Export the vp messages, walked in perm ID and index order
+	vpMessages := []types.VPMessage{}
+	if err := k.VPMessage.Walk(ctx, nil, func(_ collections.Pair[uint64, uint64], message types.VPMessage) (bool, error) {
+		vpMessages = append(vpMessages, message)
+		return false, nil
+	}); err != nil {
+		panic(fmt.Errorf("failed to export vp messages: %w", err))
+	}
+
+	genesis.VpMessages = vpMessages
+
+	// Export the fee history, walked in perm ID and index order
+	feeRecords := []types.PermissionFeeRecord{}
+	if err := k.PermissionFeeRecord.Walk(ctx, nil, func(_ collections.Pair[uint64, uint64], record types.PermissionFeeRecord) (bool, error) {
+		feeRecords = append(feeRecords, record)
+		return false, nil
+	}); err != nil {
+		panic(fmt.Errorf("failed to export fee records: %w", err))
 	}
 
-	genesis.NextPermissionId = nextId
+	genesis.FeeRecords = feeRecords
 
 	return genesis
 }
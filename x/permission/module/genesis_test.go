@@ -53,7 +53,7 @@ func TestDeterministicGenesis(t *testing.T) {
 		CreatedBy:       creatorAddr,
 		Modified:        &nowTime,
 		SchemaId:        1,
-		Country:         "CA",
+		Countries:       []string{"CA"},
 		ValidatorPermId: 1,
 		EffectiveFrom:   &nowTime,
 		EffectiveUntil:  &futureTime,
@@ -68,7 +68,7 @@ func TestDeterministicGenesis(t *testing.T) {
 		CreatedBy:      creatorAddr,
 		Modified:       &nowTime,
 		SchemaId:       1,
-		Country:        "US",
+		Countries:      []string{"US"},
 		EffectiveFrom:  &nowTime,
 		EffectiveUntil: &futureTime,
 	}
@@ -85,6 +85,7 @@ func TestDeterministicGenesis(t *testing.T) {
 		AgentPermId: 2,
 		Created:     &nowTime,
 		Modified:    &nowTime,
+		Expires:     &futureTime,
 		Authz: []*types.SessionAuthz{
 			{
 				ExecutorPermId:    1,
@@ -99,6 +100,7 @@ func TestDeterministicGenesis(t *testing.T) {
 		AgentPermId: 1,
 		Created:     &nowTime,
 		Modified:    &nowTime,
+		Expires:     &futureTime,
 		Authz: []*types.SessionAuthz{
 			{
 				ExecutorPermId:    1,
@@ -177,7 +179,7 @@ func TestGenesisImportExport(t *testing.T) {
 		CreatedBy:      creatorAddr,
 		Modified:       &nowTime,
 		SchemaId:       1,
-		Country:        "US",
+		Countries:      []string{"US"},
 		EffectiveFrom:  &nowTime,
 		EffectiveUntil: &futureTime,
 	}
@@ -191,7 +193,7 @@ func TestGenesisImportExport(t *testing.T) {
 		CreatedBy:       creatorAddr,
 		Modified:        &nowTime,
 		SchemaId:        1,
-		Country:         "CA",
+		Countries:       []string{"CA"},
 		EffectiveFrom:   &nowTime,
 		EffectiveUntil:  &futureTime,
 		ValidatorPermId: 1,
@@ -206,7 +208,7 @@ func TestGenesisImportExport(t *testing.T) {
 		CreatedBy:       creatorAddr,
 		Modified:        &nowTime,
 		SchemaId:        1,
-		Country:         "UK",
+		Countries:       []string{"UK"},
 		EffectiveFrom:   &nowTime,
 		EffectiveUntil:  &futureTime,
 		ValidatorPermId: 1,
@@ -215,7 +217,7 @@ func TestGenesisImportExport(t *testing.T) {
 	require.NoError(t, k.Permission.Set(ctx, perm1.Id, perm1))
 	require.NoError(t, k.Permission.Set(ctx, perm2.Id, perm2))
 	require.NoError(t, k.Permission.Set(ctx, perm3.Id, perm3))
-	require.NoError(t, k.PermissionCounter.Set(ctx, 4))
+	require.NoError(t, k.PermissionCounter.Set(ctx, 3))
 
 	// Create test perm sessions
 	session1 := types.PermissionSession{
@@ -279,11 +281,12 @@ func TestGenesisImportExport(t *testing.T) {
 	perm3Get, err := k2.Permission.Get(ctx2, 3)
 	require.NoError(t, err)
 	require.Equal(t, perm3.Id, perm3Get.Id)
-	require.Equal(t, perm3.Country, perm3Get.Country)
+	require.Equal(t, perm3.Countries, perm3Get.Countries)
 
+	// The counter holds the last assigned ID
 	counter, err := k2.PermissionCounter.Get(ctx2)
 	require.NoError(t, err)
-	require.Equal(t, uint64(4), counter)
+	require.Equal(t, uint64(3), counter)
 
 	session1Get, err := k2.PermissionSession.Get(ctx2, "test-session-id-1")
 	require.NoError(t, err)
@@ -0,0 +1,214 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var _ authz.Authorization = &PermissionAuthorization{}
+
+// FeeQuoter prices the fees a perm message charges its signer. Authorizations
+// have no access to the store, so the keeper provides it through the tx context
+// to enforce the spend limit of a PermissionAuthorization.
+type FeeQuoter interface {
+	QuoteMsgFees(ctx sdk.Context, msg sdk.Msg) (sdk.Coins, error)
+}
+
+type feeQuoterContextKey struct{}
+
+// ContextWithFeeQuoter returns a copy of ctx carrying the FeeQuoter used by
+// PermissionAuthorization.Accept.
+func ContextWithFeeQuoter(ctx sdk.Context, quoter FeeQuoter) sdk.Context {
+	return ctx.WithValue(feeQuoterContextKey{}, quoter)
+}
+
+// FeeQuoterFromContext returns the FeeQuoter carried by ctx, if any.
+func FeeQuoterFromContext(ctx sdk.Context) (FeeQuoter, bool) {
+	quoter, ok := ctx.Value(feeQuoterContextKey{}).(FeeQuoter)
+	return quoter, ok && quoter != nil
+}
+
+// NewPermissionAuthorization creates a new PermissionAuthorization object.
+func NewPermissionAuthorization(msgTypeURL string, permissionIDs []uint64, spendLimit sdk.Coins, expiration *time.Time) *PermissionAuthorization {
+	return &PermissionAuthorization{
+		MsgTypeUrl:    msgTypeURL,
+		PermissionIds: permissionIDs,
+		SpendLimit:    spendLimit,
+		Expiration:    expiration,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a PermissionAuthorization) MsgTypeURL() string {
+	return a.MsgTypeUrl
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a PermissionAuthorization) ValidateBasic() error {
+	if !IsDelegableMsgType(a.MsgTypeUrl) {
+		return sdkerrors.ErrInvalidType.Wrapf("message type %s cannot be delegated", a.MsgTypeUrl)
+	}
+
+	if len(a.PermissionIds) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("at least one perm ID must be authorized")
+	}
+
+	seen := make(map[uint64]bool, len(a.PermissionIds))
+	for _, id := range a.PermissionIds {
+		if id == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrap("perm ID cannot be 0")
+		}
+		if seen[id] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate perm ID: %d", id)
+		}
+		seen[id] = true
+	}
+
+	if err := a.SpendLimit.Validate(); err != nil {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid spend limit: %s", err)
+	}
+
+	return nil
+}
+
+// Accept implements Authorization.Accept. The message must be of the
+// authorized type, act on authorized perms only and its fees must fit in the
+// remaining spend limit, which is reduced accordingly.
+func (a PermissionAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if sdk.MsgTypeURL(msg) != a.MsgTypeUrl {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrapf("expected %s, got %s", a.MsgTypeUrl, sdk.MsgTypeURL(msg))
+	}
+
+	if a.Expiration != nil && !sdkCtx.BlockTime().Before(*a.Expiration) {
+		return authz.AcceptResponse{}, authz.ErrAuthorizationExpired
+	}
+
+	permissionIDs, err := MsgPermissionIDs(msg)
+	if err != nil {
+		return authz.AcceptResponse{}, err
+	}
+	for _, id := range permissionIDs {
+		if !a.isAuthorizedPermission(id) {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("perm %d is not authorized", id)
+		}
+	}
+
+	if len(a.SpendLimit) == 0 {
+		return authz.AcceptResponse{Accept: true}, nil
+	}
+
+	feeQuoter, ok := FeeQuoterFromContext(sdkCtx)
+	if !ok {
+		return authz.AcceptResponse{}, fmt.Errorf("no fee quoter in context, cannot enforce spend limit")
+	}
+	fees, err := feeQuoter.QuoteMsgFees(sdkCtx, msg)
+	if err != nil {
+		return authz.AcceptResponse{}, fmt.Errorf("failed to quote fees: %w", err)
+	}
+
+	limitLeft, isNegative := a.SpendLimit.SafeSub(fees...)
+	if isNegative {
+		return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("fees %s exceed the spend limit %s", fees, a.SpendLimit)
+	}
+	if limitLeft.IsZero() {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{
+		Accept:  true,
+		Updated: NewPermissionAuthorization(a.MsgTypeUrl, a.PermissionIds, limitLeft, a.Expiration),
+	}, nil
+}
+
+func (a PermissionAuthorization) isAuthorizedPermission(id uint64) bool {
+	for _, authorized := range a.PermissionIds {
+		if authorized == id {
+			return true
+		}
+	}
+	return false
+}
+
+// IsDelegableMsgType reports whether the message type acts on a perm and can
+// therefore be granted with a PermissionAuthorization.
+func IsDelegableMsgType(msgTypeURL string) bool {
+	switch msgTypeURL {
+	case sdk.MsgTypeURL(&MsgStartPermissionVP{}),
+		sdk.MsgTypeURL(&MsgRenewPermissionVP{}),
+		sdk.MsgTypeURL(&MsgSetPermissionVPToValidated{}),
+		sdk.MsgTypeURL(&MsgRequestPermissionVPTermination{}),
+		sdk.MsgTypeURL(&MsgConfirmPermissionVPTermination{}),
+		sdk.MsgTypeURL(&MsgCancelPermissionVPLastRequest{}),
+		sdk.MsgTypeURL(&MsgExtendPermission{}),
+		sdk.MsgTypeURL(&MsgRevokePermission{}),
+		sdk.MsgTypeURL(&MsgSuspendPermission{}),
+		sdk.MsgTypeURL(&MsgUnsuspendPermission{}),
+		sdk.MsgTypeURL(&MsgCreateOrUpdatePermissionSession{}),
+		sdk.MsgTypeURL(&MsgPurchaseVerificationCredits{}),
+		sdk.MsgTypeURL(&MsgAnchorVPMessage{}),
+		sdk.MsgTypeURL(&MsgUpdatePermissionFees{}),
+		sdk.MsgTypeURL(&MsgSlashPermissionTrustDeposit{}),
+		sdk.MsgTypeURL(&MsgRepayPermissionSlashedTrustDeposit{}):
+		return true
+	default:
+		return false
+	}
+}
+
+// MsgPermissionIDs returns the perms a message acts on: the validator perm of
+// a validation process, the executor perms of a session, or the perm itself.
+func MsgPermissionIDs(msg sdk.Msg) ([]uint64, error) {
+	switch m := msg.(type) {
+	case *MsgStartPermissionVP:
+		return []uint64{m.ValidatorPermId}, nil
+	case *MsgRenewPermissionVP:
+		return []uint64{m.Id}, nil
+	case *MsgSetPermissionVPToValidated:
+		return []uint64{m.Id}, nil
+	case *MsgRequestPermissionVPTermination:
+		return []uint64{m.Id}, nil
+	case *MsgConfirmPermissionVPTermination:
+		return []uint64{m.Id}, nil
+	case *MsgCancelPermissionVPLastRequest:
+		return []uint64{m.Id}, nil
+	case *MsgExtendPermission:
+		return []uint64{m.Id}, nil
+	case *MsgRevokePermission:
+		return []uint64{m.Id}, nil
+	case *MsgSuspendPermission:
+		return []uint64{m.Id}, nil
+	case *MsgUnsuspendPermission:
+		return []uint64{m.Id}, nil
+	case *MsgCreateOrUpdatePermissionSession:
+		var ids []uint64
+		if m.IssuerPermId != 0 {
+			ids = append(ids, m.IssuerPermId)
+		}
+		if m.VerifierPermId != 0 {
+			ids = append(ids, m.VerifierPermId)
+		}
+		return ids, nil
+	case *MsgPurchaseVerificationCredits:
+		if m.IssuerPermId != 0 {
+			return []uint64{m.IssuerPermId, m.VerifierPermId}, nil
+		}
+		return []uint64{m.VerifierPermId}, nil
+	case *MsgAnchorVPMessage:
+		return []uint64{m.PermId}, nil
+	case *MsgUpdatePermissionFees:
+		return []uint64{m.Id}, nil
+	case *MsgSlashPermissionTrustDeposit:
+		return []uint64{m.Id}, nil
+	case *MsgRepayPermissionSlashedTrustDeposit:
+		return []uint64{m.Id}, nil
+	default:
+		return nil, sdkerrors.ErrInvalidType.Wrapf("message type %s cannot be delegated", sdk.MsgTypeURL(msg))
+	}
+}
@@ -0,0 +1,580 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: verana/perm/v1/authz.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PermissionAuthorization allows the grantee to execute one perm message type
+// on behalf of the granter, restricted to a set of perm IDs, a fee spend limit
+// and an expiry.
+type PermissionAuthorization struct {
+	// msg_type_url is the type URL of the perm message the grantee may execute.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// permission_ids are the perms the grantee may act on.
+	PermissionIds []uint64 `protobuf:"varint,2,rep,packed,name=permission_ids,json=permissionIds,proto3" json:"permission_ids,omitempty"`
+	// spend_limit is the maximum amount of fees the grantee may spend on behalf
+	// of the granter. If it is empty, there is no spend limit.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// expiration is the time after which the grantee may no longer use the
+	// authorization.
+	Expiration *time.Time `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+}
+
+func (m *PermissionAuthorization) Reset()         { *m = PermissionAuthorization{} }
+func (m *PermissionAuthorization) String() string { return proto.CompactTextString(m) }
+func (*PermissionAuthorization) ProtoMessage()    {}
+func (*PermissionAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f653550cd8cf9f74, []int{0}
+}
+func (m *PermissionAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PermissionAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PermissionAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PermissionAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PermissionAuthorization.Merge(m, src)
+}
+func (m *PermissionAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *PermissionAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_PermissionAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PermissionAuthorization proto.InternalMessageInfo
+
+func (m *PermissionAuthorization) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *PermissionAuthorization) GetPermissionIds() []uint64 {
+	if m != nil {
+		return m.PermissionIds
+	}
+	return nil
+}
+
+func (m *PermissionAuthorization) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func (m *PermissionAuthorization) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*PermissionAuthorization)(nil), "verana.perm.v1.PermissionAuthorization")
+}
+
+func init() { proto.RegisterFile("verana/perm/v1/authz.proto", fileDescriptor_f653550cd8cf9f74) }
+
+var fileDescriptor_f653550cd8cf9f74 = []byte{
+	// 453 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x3b, 0xdb, 0x45, 0x74, 0xba, 0x2e, 0x18, 0x04, 0xbb, 0x3d, 0x24, 0x61, 0x45, 0x08,
+	0x85, 0xce, 0xd0, 0xf5, 0xa6, 0x27, 0xab, 0x08, 0x82, 0x88, 0x94, 0xdd, 0x8b, 0x97, 0x30, 0x49,
+	0xc7, 0x74, 0xd8, 0x4c, 0x26, 0xe4, 0x4d, 0xca, 0x76, 0x8f, 0x1e, 0x3d, 0xed, 0xd9, 0x4f, 0x20,
+	0x9e, 0x7a, 0xf0, 0x43, 0x14, 0x4f, 0x7b, 0xf4, 0xb4, 0x2b, 0xed, 0xa1, 0x9f, 0x42, 0x90, 0x64,
+	0x26, 0xb6, 0x1e, 0xbc, 0x24, 0xf3, 0xde, 0xff, 0xbd, 0xbc, 0xf7, 0xff, 0x65, 0x70, 0x6f, 0xc6,
+	0x0b, 0x96, 0x31, 0x9a, 0xf3, 0x42, 0xd2, 0xd9, 0x90, 0xb2, 0x52, 0x4f, 0x2f, 0x49, 0x5e, 0x28,
+	0xad, 0x9c, 0x43, 0xa3, 0x91, 0x4a, 0x23, 0xb3, 0x61, 0xef, 0x01, 0x93, 0x22, 0x53, 0xb4, 0x7e,
+	0x9a, 0x92, 0x9e, 0x1b, 0x2b, 0x90, 0x0a, 0x68, 0xc4, 0x80, 0xd3, 0xd9, 0x30, 0xe2, 0x9a, 0x0d,
+	0x69, 0xac, 0x44, 0x66, 0xf5, 0x23, 0xa3, 0x87, 0x75, 0x44, 0x4d, 0x60, 0xa5, 0x87, 0x89, 0x4a,
+	0x94, 0xc9, 0x57, 0x27, 0x9b, 0xf5, 0x12, 0xa5, 0x92, 0x94, 0xd3, 0x3a, 0x8a, 0xca, 0x8f, 0x54,
+	0x0b, 0xc9, 0x41, 0x33, 0x99, 0x9b, 0x82, 0xe3, 0xdf, 0x7b, 0xf8, 0xd1, 0x7b, 0x5e, 0x48, 0x01,
+	0x20, 0x54, 0xf6, 0xa2, 0xd4, 0x53, 0x55, 0x88, 0x4b, 0xa6, 0x85, 0xca, 0x1c, 0x1f, 0x1f, 0x48,
+	0x48, 0x42, 0x3d, 0xcf, 0x79, 0x58, 0x16, 0x69, 0x17, 0xf9, 0x28, 0xb8, 0x37, 0xc6, 0x12, 0x92,
+	0xd3, 0x79, 0xce, 0xcf, 0x8a, 0xd4, 0x79, 0x82, 0x0f, 0xf3, 0xbf, 0xcd, 0xa1, 0x98, 0x40, 0x77,
+	0xcf, 0x6f, 0x07, 0xfb, 0xe3, 0xfb, 0xdb, 0xec, 0x9b, 0x09, 0x38, 0x9f, 0x10, 0xee, 0x40, 0xce,
+	0xb3, 0x49, 0x98, 0x0a, 0x29, 0x74, 0xb7, 0xed, 0xb7, 0x83, 0xce, 0xc9, 0x11, 0xb1, 0x06, 0x2a,
+	0xb7, 0xc4, 0xba, 0x25, 0x2f, 0x95, 0xc8, 0x46, 0xaf, 0x97, 0x37, 0x5e, 0xeb, 0xdb, 0xad, 0x17,
+	0x24, 0x42, 0x4f, 0xcb, 0x88, 0xc4, 0x4a, 0x5a, 0xb7, 0xf6, 0x35, 0x80, 0xc9, 0x39, 0xad, 0x16,
+	0x83, 0xba, 0x01, 0xbe, 0x6c, 0x16, 0xfd, 0x83, 0x94, 0x27, 0x2c, 0x9e, 0x87, 0x15, 0x2f, 0xf8,
+	0xba, 0x59, 0xf4, 0xd1, 0x18, 0xd7, 0x53, 0xdf, 0x56, 0x43, 0x9d, 0x57, 0x18, 0xf3, 0x8b, 0x5c,
+	0x14, 0xb5, 0xb7, 0xee, 0xbe, 0x8f, 0x82, 0xce, 0x49, 0x8f, 0x18, 0x3e, 0xa4, 0xe1, 0x43, 0x4e,
+	0x1b, 0x3e, 0xa3, 0xbb, 0xcb, 0x1b, 0x0f, 0x5d, 0xdd, 0x7a, 0x68, 0xbc, 0xd3, 0xf7, 0xec, 0xdd,
+	0x8f, 0xef, 0x83, 0x63, 0xbb, 0xb7, 0xf9, 0xb9, 0xcd, 0xe2, 0xff, 0xb0, 0xfb, 0xbc, 0x59, 0xf4,
+	0x1f, 0xef, 0xde, 0x85, 0xff, 0x30, 0x1e, 0x9d, 0x2d, 0x57, 0x2e, 0xba, 0x5e, 0xb9, 0xe8, 0xd7,
+	0xca, 0x45, 0x57, 0x6b, 0xb7, 0x75, 0xbd, 0x76, 0x5b, 0x3f, 0xd7, 0x6e, 0xeb, 0xc3, 0xf3, 0x1d,
+	0xef, 0xe6, 0x4b, 0x83, 0x94, 0x45, 0xd0, 0x9c, 0xa3, 0x54, 0xc5, 0xe7, 0xf1, 0x94, 0x89, 0x8c,
+	0x5e, 0xd0, 0x2d, 0x6e, 0x03, 0x25, 0xba, 0x53, 0x1b, 0x7a, 0xfa, 0x67, 0x00, 0x4f, 0x9f, 0xac,
+	0x5a, 0x90, 0x02, 0x00, 0x00,
+}
+
+func (m *PermissionAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PermissionAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PermissionAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintAuthz(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PermissionIds) > 0 {
+		dAtA3 := make([]byte, len(m.PermissionIds)*10)
+		var j2 int
+		for _, num := range m.PermissionIds {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintAuthz(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PermissionAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.PermissionIds) > 0 {
+		l = 0
+		for _, e := range m.PermissionIds {
+			l += sovAuthz(uint64(e))
+		}
+		n += 1 + sovAuthz(uint64(l)) + l
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PermissionAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PermissionAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PermissionAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthz
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PermissionIds = append(m.PermissionIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthz
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuthz
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuthz
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PermissionIds) == 0 {
+					m.PermissionIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuthz
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PermissionIds = append(m.PermissionIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermissionIds", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
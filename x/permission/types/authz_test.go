@@ -0,0 +1,144 @@
+package types_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/stretchr/testify/require"
+
+	"github.com/verana-labs/verana-blockchain/x/permission/types"
+)
+
+type stubFeeQuoter struct {
+	fees sdk.Coins
+}
+
+func (q stubFeeQuoter) QuoteMsgFees(_ sdk.Context, _ sdk.Msg) (sdk.Coins, error) {
+	return q.fees, nil
+}
+
+func TestPermissionAuthorization_ValidateBasic(t *testing.T) {
+	sessionURL := sdk.MsgTypeURL(&types.MsgCreateOrUpdatePermissionSession{})
+
+	tests := []struct {
+		name  string
+		auth  *types.PermissionAuthorization
+		valid bool
+	}{
+		{
+			name:  "valid without spend limit",
+			auth:  types.NewPermissionAuthorization(sessionURL, []uint64{1, 2}, nil, nil),
+			valid: true,
+		},
+		{
+			name:  "valid with spend limit",
+			auth:  types.NewPermissionAuthorization(sessionURL, []uint64{1}, sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, 100)), nil),
+			valid: true,
+		},
+		{
+			name:  "non delegable message type",
+			auth:  types.NewPermissionAuthorization(sdk.MsgTypeURL(&types.MsgUpdateParams{}), []uint64{1}, nil, nil),
+			valid: false,
+		},
+		{
+			name:  "no perm IDs",
+			auth:  types.NewPermissionAuthorization(sessionURL, nil, nil, nil),
+			valid: false,
+		},
+		{
+			name:  "zero perm ID",
+			auth:  types.NewPermissionAuthorization(sessionURL, []uint64{0}, nil, nil),
+			valid: false,
+		},
+		{
+			name:  "duplicate perm ID",
+			auth:  types.NewPermissionAuthorization(sessionURL, []uint64{1, 1}, nil, nil),
+			valid: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.auth.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestPermissionAuthorization_Accept(t *testing.T) {
+	now := time.Now().UTC()
+	ctx := sdk.Context{}.WithContext(context.Background()).WithBlockTime(now)
+	sessionURL := sdk.MsgTypeURL(&types.MsgCreateOrUpdatePermissionSession{})
+	msg := &types.MsgCreateOrUpdatePermissionSession{IssuerPermId: 1, VerifierPermId: 2}
+	limit := sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, 100))
+
+	ctx = types.ContextWithFeeQuoter(ctx, stubFeeQuoter{fees: sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, 40))})
+
+	t.Run("wrong message type", func(t *testing.T) {
+		auth := types.NewPermissionAuthorization(sessionURL, []uint64{1, 2}, nil, nil)
+		_, err := auth.Accept(ctx, &types.MsgRevokePermission{Id: 1})
+		require.Error(t, err)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		expiration := now
+		auth := types.NewPermissionAuthorization(sessionURL, []uint64{1, 2}, nil, &expiration)
+		_, err := auth.Accept(ctx, msg)
+		require.ErrorIs(t, err, authz.ErrAuthorizationExpired)
+	})
+
+	t.Run("unauthorized perm", func(t *testing.T) {
+		auth := types.NewPermissionAuthorization(sessionURL, []uint64{1}, nil, nil)
+		_, err := auth.Accept(ctx, msg)
+		require.Error(t, err)
+	})
+
+	t.Run("no spend limit", func(t *testing.T) {
+		expiration := now.Add(time.Hour)
+		auth := types.NewPermissionAuthorization(sessionURL, []uint64{1, 2}, nil, &expiration)
+		resp, err := auth.Accept(ctx, msg)
+		require.NoError(t, err)
+		require.True(t, resp.Accept)
+		require.False(t, resp.Delete)
+		require.Nil(t, resp.Updated)
+	})
+
+	t.Run("spend limit reduced", func(t *testing.T) {
+		auth := types.NewPermissionAuthorization(sessionURL, []uint64{1, 2}, limit, nil)
+		resp, err := auth.Accept(ctx, msg)
+		require.NoError(t, err)
+		require.True(t, resp.Accept)
+		require.False(t, resp.Delete)
+		updated, ok := resp.Updated.(*types.PermissionAuthorization)
+		require.True(t, ok)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, 60)), updated.SpendLimit)
+		require.Equal(t, []uint64{1, 2}, updated.PermissionIds)
+	})
+
+	t.Run("spend limit exhausted", func(t *testing.T) {
+		auth := types.NewPermissionAuthorization(sessionURL, []uint64{1, 2}, sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, 40)), nil)
+		resp, err := auth.Accept(ctx, msg)
+		require.NoError(t, err)
+		require.True(t, resp.Accept)
+		require.True(t, resp.Delete)
+	})
+
+	t.Run("spend limit without fee quoter", func(t *testing.T) {
+		plainCtx := sdk.Context{}.WithContext(context.Background()).WithBlockTime(now)
+		auth := types.NewPermissionAuthorization(sessionURL, []uint64{1, 2}, limit, nil)
+		_, err := auth.Accept(plainCtx, msg)
+		require.ErrorContains(t, err, "no fee quoter")
+	})
+
+	t.Run("spend limit exceeded", func(t *testing.T) {
+		auth := types.NewPermissionAuthorization(sessionURL, []uint64{1, 2}, sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, 39)), nil)
+		_, err := auth.Accept(ctx, msg)
+		require.Error(t, err)
+	})
+}
@@ -6,6 +6,7 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	// this line is used by starport scaffolding # 1
 )
 
@@ -23,6 +24,21 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgSlashPermissionTrustDeposit{}, "/perm/v1/slash-perm-td")
 	legacy.RegisterAminoMsg(cdc, &MsgRepayPermissionSlashedTrustDeposit{}, "/perm/v1/repay-perm-slashed-td")
 	legacy.RegisterAminoMsg(cdc, &MsgCreatePermission{}, "/perm/v1/create-perm")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateSessionAllowance{}, "/perm/v1/create-session-allowance")
+	legacy.RegisterAminoMsg(cdc, &MsgFundSessionAllowance{}, "/perm/v1/fund-session-allowance")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawSessionAllowance{}, "/perm/v1/withdraw-session-allowance")
+	legacy.RegisterAminoMsg(cdc, &MsgAppealSlash{}, "/perm/v1/appeal-slash")
+	legacy.RegisterAminoMsg(cdc, &MsgResolveSlashAppeal{}, "/perm/v1/resolve-slash-appeal")
+	legacy.RegisterAminoMsg(cdc, &MsgSetSlashDistribution{}, "/perm/v1/set-slash-distribution")
+	legacy.RegisterAminoMsg(cdc, &MsgSuspendPermission{}, "/perm/v1/suspend-perm")
+	legacy.RegisterAminoMsg(cdc, &MsgUnsuspendPermission{}, "/perm/v1/unsuspend-perm")
+	legacy.RegisterAminoMsg(cdc, &MsgClosePermissionSession{}, "/perm/v1/close-perm-session")
+	legacy.RegisterAminoMsg(cdc, &MsgPurchaseVerificationCredits{}, "/perm/v1/purchase-verification-credits")
+	legacy.RegisterAminoMsg(cdc, &MsgRefundVerificationCredits{}, "/perm/v1/refund-verification-credits")
+	legacy.RegisterAminoMsg(cdc, &MsgSettleSessionVouchers{}, "/perm/v1/settle-session-vouchers")
+	legacy.RegisterAminoMsg(cdc, &MsgAnchorVPMessage{}, "/perm/v1/anchor-vp-message")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdatePermissionFees{}, "/perm/v1/update-permission-fees")
+	cdc.RegisterConcrete(&PermissionAuthorization{}, "verana/perm/PermissionAuthorization", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -43,6 +59,23 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgSlashPermissionTrustDeposit{},
 		&MsgRepayPermissionSlashedTrustDeposit{},
 		&MsgCreatePermission{},
+		&MsgCreateSessionAllowance{},
+		&MsgFundSessionAllowance{},
+		&MsgWithdrawSessionAllowance{},
+		&MsgAppealSlash{},
+		&MsgResolveSlashAppeal{},
+		&MsgSetSlashDistribution{},
+		&MsgSuspendPermission{},
+		&MsgUnsuspendPermission{},
+		&MsgClosePermissionSession{},
+		&MsgPurchaseVerificationCredits{},
+		&MsgRefundVerificationCredits{},
+		&MsgSettleSessionVouchers{},
+		&MsgAnchorVPMessage{},
+		&MsgUpdatePermissionFees{},
+	)
+	registry.RegisterImplementations((*authz.Authorization)(nil),
+		&PermissionAuthorization{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
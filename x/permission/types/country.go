@@ -0,0 +1,141 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// MaxCountryScopeSize bounds the number of codes in the country scope of a perm
+const MaxCountryScopeSize = 256
+
+// iso3166Alpha2 holds the officially assigned ISO 3166-1 alpha-2 codes
+var iso3166Alpha2 = func() map[string]bool {
+	codes := make(map[string]bool)
+	for _, code := range strings.Fields(`
+		AD AE AF AG AI AL AM AO AQ AR AS AT AU AW AX AZ
+		BA BB BD BE BF BG BH BI BJ BL BM BN BO BQ BR BS BT BV BW BY BZ
+		CA CC CD CF CG CH CI CK CL CM CN CO CR CU CV CW CX CY CZ
+		DE DJ DK DM DO DZ
+		EC EE EG EH ER ES ET
+		FI FJ FK FM FO FR
+		GA GB GD GE GF GG GH GI GL GM GN GP GQ GR GS GT GU GW GY
+		HK HM HN HR HT HU
+		ID IE IL IM IN IO IQ IR IS IT
+		JE JM JO JP
+		KE KG KH KI KM KN KP KR KW KY KZ
+		LA LB LC LI LK LR LS LT LU LV LY
+		MA MC MD ME MF MG MH MK ML MM MN MO MP MQ MR MS MT MU MV MW MX MY MZ
+		NA NC NE NF NG NI NL NO NP NR NU NZ
+		OM
+		PA PE PF PG PH PK PL PM PN PR PS PT PW PY
+		QA
+		RE RO RS RU RW
+		SA SB SC SD SE SG SH SI SJ SK SL SM SN SO SR SS ST SV SX SY SZ
+		TC TD TF TG TH TJ TK TL TM TN TO TR TT TV TW TZ
+		UA UG UM US UY UZ
+		VA VC VE VG VI VN VU
+		WF WS
+		YE YT
+		ZA ZM ZW`) {
+		codes[code] = true
+	}
+	return codes
+}()
+
+// subdivisionCodeRegex matches the format of ISO 3166-2 subdivision codes
+var subdivisionCodeRegex = regexp.MustCompile(`^[A-Z]{2}-[A-Z0-9]{1,3}$`)
+
+// IsValidCountryCode reports whether code is an assigned ISO 3166-1 alpha-2
+// code, or an ISO 3166-2 subdivision code of one, such as US-CA. Only the
+// format of the subdivision part is checked.
+func IsValidCountryCode(code string) bool {
+	if iso3166Alpha2[code] {
+		return true
+	}
+	return subdivisionCodeRegex.MatchString(code) && iso3166Alpha2[code[:2]]
+}
+
+// countryOf returns the ISO 3166-1 alpha-2 country of a country or
+// subdivision code.
+func countryOf(code string) string {
+	if len(code) < 2 {
+		return code
+	}
+	return code[:2]
+}
+
+// legacyCountryCodeRegex matches the format country codes were checked
+// against before scopes were checked against ISO 3166
+var legacyCountryCodeRegex = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// ValidateCountryScope checks that the codes of a country scope are valid and
+// distinct, and that no subdivision is listed together with its country.
+func ValidateCountryScope(countries []string) error {
+	return validateCountryScope(countries, IsValidCountryCode)
+}
+
+// ValidateStoredCountryScope checks the country scope of a stored perm. Perms
+// created before scopes were checked against ISO 3166 may also hold
+// two-letter codes, such as XK, that the v2 migration could not map.
+func ValidateStoredCountryScope(countries []string) error {
+	return validateCountryScope(countries, func(code string) bool {
+		return IsValidCountryCode(code) || legacyCountryCodeRegex.MatchString(code)
+	})
+}
+
+func validateCountryScope(countries []string, isValid func(code string) bool) error {
+	if len(countries) > MaxCountryScopeSize {
+		return fmt.Errorf("country scope cannot have more than %d codes", MaxCountryScopeSize)
+	}
+
+	seen := make(map[string]bool, len(countries))
+	for _, code := range countries {
+		if !isValid(code) {
+			return fmt.Errorf("invalid ISO 3166 country code: %q", code)
+		}
+		if seen[code] {
+			return fmt.Errorf("duplicate country code: %s", code)
+		}
+		seen[code] = true
+	}
+
+	for _, code := range countries {
+		if country := countryOf(code); country != code && seen[country] {
+			return fmt.Errorf("subdivision %s is already covered by %s", code, country)
+		}
+	}
+
+	return nil
+}
+
+// CountryScopeCovers reports whether a country scope covers code. An empty
+// scope covers any code, a country covers its subdivisions.
+func CountryScopeCovers(scope []string, code string) bool {
+	if len(scope) == 0 {
+		return true
+	}
+	for _, c := range scope {
+		if c == code || c == countryOf(code) {
+			return true
+		}
+	}
+	return false
+}
+
+// CountryScopeWithin reports whether every code of scope is covered by outer.
+// An empty, worldwide, scope is only within an empty scope.
+func CountryScopeWithin(scope, outer []string) bool {
+	if len(outer) == 0 {
+		return true
+	}
+	if len(scope) == 0 {
+		return false
+	}
+	for _, code := range scope {
+		if !CountryScopeCovers(outer, code) {
+			return false
+		}
+	}
+	return true
+}
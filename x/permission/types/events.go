@@ -25,5 +25,67 @@ const (
 	EventTypeCreatePermission                   = "create_permission"
 	AttributeKeyValidatorPermID                 = "validator_perm_id"
 	AttributeKeyType                            = "type"
-	AttributeKeyCountry                         = "country"
+	AttributeKeyCountries                       = "countries"
+	EventTypeCreateSessionAllowance             = "create_session_allowance"
+	EventTypeFundSessionAllowance               = "fund_session_allowance"
+	EventTypeWithdrawSessionAllowance           = "withdraw_session_allowance"
+	EventTypeDrawSessionAllowance               = "draw_session_allowance"
+	AttributeKeyAllowanceID                     = "allowance_id"
+	AttributeKeySponsor                         = "sponsor"
+	AttributeKeyAmount                          = "amount"
+	AttributeKeyRemaining                       = "remaining"
+	EventTypeAppealSlash                        = "appeal_slash"
+	EventTypeResolveSlashAppeal                 = "resolve_slash_appeal"
+	EventTypeExecuteSlash                       = "execute_slash"
+	AttributeKeySlashID                         = "slash_id"
+	AttributeKeyAppealDeadline                  = "appeal_deadline"
+	AttributeKeyEvidenceDigestSRI               = "evidence_digest_sri"
+	AttributeKeyUphold                          = "uphold"
+	AttributeKeyReasonCode                      = "reason_code"
+	AttributeKeyGfVersion                       = "gf_version"
+	AttributeKeyEvidenceURL                     = "evidence_url"
+	EventTypeSetSlashDistribution               = "set_slash_distribution"
+	AttributeKeyTrustRegistryID                 = "tr_id"
+	AttributeKeyShareCount                      = "share_count"
+	EventTypeCascadeRevokePermission            = "cascade_revoke_permission"
+	EventTypeCascadeSuspendPermission           = "cascade_suspend_permission"
+	EventTypeCascadePermissionFailed            = "cascade_permission_failed"
+	AttributeKeyOriginPermID                    = "origin_perm_id"
+	AttributeKeyExecutedBy                      = "executed_by"
+	EventTypeSuspendPermission                  = "suspend_permission"
+	EventTypeUnsuspendPermission                = "unsuspend_permission"
+	AttributeKeyReason                          = "reason"
+	AttributeKeySuspendedUntil                  = "suspended_until"
+	EventTypePayAgentReward                     = "pay_agent_reward"
+	AttributeKeyRewardType                      = "reward_type"
+	AttributeKeyRecipient                       = "recipient"
+	AttributeKeyTrustDeposit                    = "trust_deposit"
+	EventTypeClosePermissionSession             = "close_permission_session"
+	EventTypePrunePermissionSession             = "prune_permission_session"
+	AttributeKeyExpires                         = "expires"
+	EventTypePurchaseVerificationCredits        = "purchase_verification_credits"
+	EventTypeConsumeVerificationCredit          = "consume_verification_credit"
+	EventTypeRefundVerificationCredits          = "refund_verification_credits"
+	AttributeKeyCreditID                        = "credit_id"
+	AttributeKeyCount                           = "count"
+	EventTypeSettleSessionVoucher               = "settle_session_voucher"
+	AttributeKeySigner                          = "signer"
+	AttributeKeyNonce                           = "nonce"
+	EventTypeAnchorVPMessage                    = "anchor_vp_message"
+	AttributeKeyIndex                           = "index"
+	AttributeKeyDigestSri                       = "digest_sri"
+	AttributeKeyEncryptedURI                    = "encrypted_uri"
+	EventTypeApprovePermissionVP                = "approve_permission_vp"
+	AttributeKeyCoValidatorPermIDs              = "co_validator_perm_ids"
+	AttributeKeyApprovals                       = "approvals"
+	AttributeKeyQuorum                          = "quorum"
+	EventTypeUpdatePermissionFees               = "update_permission_fees"
+	EventTypeApplyPermissionFees                = "apply_permission_fees"
+	AttributeKeyValidationFees                  = "validation_fees"
+	AttributeKeyIssuanceFees                    = "issuance_fees"
+	AttributeKeyVerificationFees                = "verification_fees"
+	AttributeKeyEffectiveFrom                   = "effective_from"
+
+	RewardTypeUserAgent   = "user_agent"
+	RewardTypeWalletAgent = "wallet_agent"
 )
@@ -5,6 +5,7 @@ import (
 	"cosmossdk.io/math"
 
 	credentialschematypes "github.com/verana-labs/verana-blockchain/x/credentialschema/types"
+	trustdeposittypes "github.com/verana-labs/verana-blockchain/x/trustdeposit/types"
 	trustregistrytypes "github.com/verana-labs/verana-blockchain/x/trustregistry/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -48,4 +49,7 @@ type TrustDepositKeeper interface {
 	GetUserAgentRewardRate(ctx sdk.Context) math.LegacyDec
 	GetWalletUserAgentRewardRate(ctx sdk.Context) math.LegacyDec
 	BurnEcosystemSlashedTrustDeposit(ctx sdk.Context, account string, amount uint64) error
+	EscrowSlashedTrustDeposit(ctx sdk.Context, account string, amount uint64) error
+	DistributeEscrowedSlashedTrustDeposit(ctx sdk.Context, account string, amount uint64, distribution []trustdeposittypes.SlashShare) error
+	ReleaseEscrowedSlashedTrustDeposit(ctx sdk.Context, account string, amount uint64) error
 }
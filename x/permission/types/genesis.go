@@ -2,15 +2,28 @@ package types
 
 import (
 	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	credentialschematypes "github.com/verana-labs/verana-blockchain/x/credentialschema/types"
+	trustdeposittypes "github.com/verana-labs/verana-blockchain/x/trustdeposit/types"
 )
 
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params:             DefaultParams(),
-		Permissions:        []Permission{},
-		PermissionSessions: []PermissionSession{},
-		NextPermissionId:   1,
+		Params:                   DefaultParams(),
+		Permissions:              []Permission{},
+		PermissionSessions:       []PermissionSession{},
+		NextPermissionId:         1,
+		SessionAllowances:        []SessionAllowance{},
+		NextSessionAllowanceId:   1,
+		SlashRecords:             []SlashRecord{},
+		NextSlashRecordId:        1,
+		SlashDistributions:       []TrustRegistrySlashDistribution{},
+		RevocationCascades:       []RevocationCascade{},
+		AgentRewards:             []AgentReward{},
+		VerificationCredits:      []VerificationCredit{},
+		NextVerificationCreditId: 1,
 	}
 }
 
@@ -80,6 +93,253 @@ func (gs GenesisState) Validate() error {
 			gs.NextPermissionId, maxPermId)
 	}
 
+	// Check session allowances
+	allowanceIds := make(map[uint64]bool)
+	maxAllowanceId := uint64(0)
+	for _, allowance := range gs.SessionAllowances {
+		if allowance.Id == 0 {
+			return fmt.Errorf("session allowance ID cannot be 0")
+		}
+
+		if allowanceIds[allowance.Id] {
+			return fmt.Errorf("duplicate session allowance ID found: %d", allowance.Id)
+		}
+		allowanceIds[allowance.Id] = true
+
+		if allowance.Id > maxAllowanceId {
+			maxAllowanceId = allowance.Id
+		}
+
+		if err := validateSessionAllowance(allowance, permissionIds); err != nil {
+			return err
+		}
+	}
+
+	if maxAllowanceId > 0 && gs.NextSessionAllowanceId <= maxAllowanceId {
+		return fmt.Errorf("next_session_allowance_id (%d) must be greater than the maximum session allowance ID (%d)",
+			gs.NextSessionAllowanceId, maxAllowanceId)
+	}
+
+	// Check slash records
+	slashRecordIds := make(map[uint64]bool)
+	maxSlashRecordId := uint64(0)
+	for _, record := range gs.SlashRecords {
+		if record.Id == 0 {
+			return fmt.Errorf("slash record ID cannot be 0")
+		}
+
+		if slashRecordIds[record.Id] {
+			return fmt.Errorf("duplicate slash record ID found: %d", record.Id)
+		}
+		slashRecordIds[record.Id] = true
+
+		if record.Id > maxSlashRecordId {
+			maxSlashRecordId = record.Id
+		}
+
+		if err := validateSlashRecord(record, permissionIds); err != nil {
+			return err
+		}
+	}
+
+	if maxSlashRecordId > 0 && gs.NextSlashRecordId <= maxSlashRecordId {
+		return fmt.Errorf("next_slash_record_id (%d) must be greater than the maximum slash record ID (%d)",
+			gs.NextSlashRecordId, maxSlashRecordId)
+	}
+
+	// Check slash distributions
+	distributionTrIds := make(map[uint64]bool)
+	for _, distribution := range gs.SlashDistributions {
+		if distribution.TrId == 0 {
+			return fmt.Errorf("slash distribution trust registry ID cannot be 0")
+		}
+
+		if distributionTrIds[distribution.TrId] {
+			return fmt.Errorf("duplicate slash distribution for trust registry ID %d", distribution.TrId)
+		}
+		distributionTrIds[distribution.TrId] = true
+
+		if len(distribution.Distribution) == 0 {
+			return fmt.Errorf("slash distribution for trust registry ID %d cannot be empty", distribution.TrId)
+		}
+
+		if err := trustdeposittypes.ValidateSlashShares(distribution.Distribution); err != nil {
+			return fmt.Errorf("invalid slash distribution for trust registry ID %d: %w", distribution.TrId, err)
+		}
+	}
+
+	// Check cascading revocations in progress
+	cascadePermIds := make(map[uint64]bool)
+	for _, cascade := range gs.RevocationCascades {
+		if !permissionIds[cascade.PermId] {
+			return fmt.Errorf("revocation cascade references non-existent perm ID: %d", cascade.PermId)
+		}
+		if cascadePermIds[cascade.PermId] {
+			return fmt.Errorf("duplicate revocation cascade for perm ID %d", cascade.PermId)
+		}
+		cascadePermIds[cascade.PermId] = true
+
+		if !permissionIds[cascade.OriginPermId] {
+			return fmt.Errorf("revocation cascade references non-existent origin perm ID: %d", cascade.OriginPermId)
+		}
+		if cascade.Mode != CascadeMode_CASCADE_MODE_REVOKE && cascade.Mode != CascadeMode_CASCADE_MODE_SUSPEND {
+			return fmt.Errorf("invalid revocation cascade mode for perm ID %d: %s", cascade.PermId, cascade.Mode)
+		}
+		if _, err := sdk.AccAddressFromBech32(cascade.ExecutedBy); err != nil {
+			return fmt.Errorf("invalid revocation cascade executor for perm ID %d: %w", cascade.PermId, err)
+		}
+	}
+
+	// Check agent rewards
+	rewardPermIds := make(map[uint64]bool)
+	for _, reward := range gs.AgentRewards {
+		if !permissionIds[reward.PermId] {
+			return fmt.Errorf("agent reward references non-existent perm ID: %d", reward.PermId)
+		}
+		if rewardPermIds[reward.PermId] {
+			return fmt.Errorf("duplicate agent reward for perm ID %d", reward.PermId)
+		}
+		rewardPermIds[reward.PermId] = true
+
+		if reward.TrustDeposit > reward.UserAgentRewards+reward.WalletAgentRewards {
+			return fmt.Errorf("agent reward trust deposit exceeds the rewards for perm ID %d", reward.PermId)
+		}
+	}
+
+	// Check verification credits
+	creditIds := make(map[uint64]bool)
+	maxCreditId := uint64(0)
+	for _, credit := range gs.VerificationCredits {
+		if credit.Id == 0 {
+			return fmt.Errorf("verification credit ID cannot be 0")
+		}
+
+		if creditIds[credit.Id] {
+			return fmt.Errorf("duplicate verification credit ID found: %d", credit.Id)
+		}
+		creditIds[credit.Id] = true
+
+		if credit.Id > maxCreditId {
+			maxCreditId = credit.Id
+		}
+
+		if err := validateVerificationCredit(credit, permissionIds); err != nil {
+			return err
+		}
+	}
+
+	if maxCreditId > 0 && gs.NextVerificationCreditId <= maxCreditId {
+		return fmt.Errorf("next_verification_credit_id (%d) must be greater than the maximum verification credit ID (%d)",
+			gs.NextVerificationCreditId, maxCreditId)
+	}
+
+	// Check voucher nonces
+	nonceSigners := make(map[string]bool)
+	for _, nonce := range gs.VoucherNonces {
+		if _, err := sdk.AccAddressFromBech32(nonce.Signer); err != nil {
+			return fmt.Errorf("invalid voucher nonce signer %s: %w", nonce.Signer, err)
+		}
+		if nonceSigners[nonce.Signer] {
+			return fmt.Errorf("duplicate voucher nonce for signer %s", nonce.Signer)
+		}
+		nonceSigners[nonce.Signer] = true
+		usedNonces := make(map[uint64]bool, len(nonce.UsedNonces))
+		for _, used := range nonce.UsedNonces {
+			if used == 0 || used > nonce.Nonce {
+				return fmt.Errorf("used voucher nonce %d of signer %s must be between 1 and %d", used, nonce.Signer, nonce.Nonce)
+			}
+			if nonce.Nonce >= VoucherNonceWindow && used <= nonce.Nonce-VoucherNonceWindow {
+				return fmt.Errorf("used voucher nonce %d of signer %s is out of the nonce window", used, nonce.Signer)
+			}
+			if usedNonces[used] {
+				return fmt.Errorf("duplicate used voucher nonce %d for signer %s", used, nonce.Signer)
+			}
+			usedNonces[used] = true
+		}
+	}
+
+	// Check vp messages
+	vpMessageKeys := make(map[[2]uint64]bool)
+	vpMessageCounts := make(map[uint64]uint64)
+	for _, message := range gs.VpMessages {
+		if !permissionIds[message.PermId] {
+			return fmt.Errorf("vp message references non-existent perm ID: %d", message.PermId)
+		}
+		if message.Index == 0 {
+			return fmt.Errorf("vp message index cannot be 0 for perm ID %d", message.PermId)
+		}
+		key := [2]uint64{message.PermId, message.Index}
+		if vpMessageKeys[key] {
+			return fmt.Errorf("duplicate vp message %d for perm ID %d", message.Index, message.PermId)
+		}
+		vpMessageKeys[key] = true
+
+		vpMessageCounts[message.PermId]++
+		if vpMessageCounts[message.PermId] > MaxVPMessagesPerPerm {
+			return fmt.Errorf("more than %d vp messages for perm ID %d", MaxVPMessagesPerPerm, message.PermId)
+		}
+		if _, err := sdk.AccAddressFromBech32(message.Sender); err != nil {
+			return fmt.Errorf("invalid vp message sender %s: %w", message.Sender, err)
+		}
+		if message.DigestSri == "" || !isValidDigestSRI(message.DigestSri) {
+			return fmt.Errorf("invalid digest_sri of vp message %d for perm ID %d", message.Index, message.PermId)
+		}
+	}
+
+	// Check fee records
+	feeRecordKeys := make(map[[2]uint64]bool)
+	for _, record := range gs.FeeRecords {
+		if !permissionIds[record.PermId] {
+			return fmt.Errorf("fee record references non-existent perm ID: %d", record.PermId)
+		}
+		if record.Index == 0 {
+			return fmt.Errorf("fee record index cannot be 0 for perm ID %d", record.PermId)
+		}
+		key := [2]uint64{record.PermId, record.Index}
+		if feeRecordKeys[key] {
+			return fmt.Errorf("duplicate fee record %d for perm ID %d", record.Index, record.PermId)
+		}
+		feeRecordKeys[key] = true
+
+		if record.UpdatedBy != "" {
+			if _, err := sdk.AccAddressFromBech32(record.UpdatedBy); err != nil {
+				return fmt.Errorf("invalid updated_by of fee record %d for perm ID %d: %w", record.Index, record.PermId, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateSchemaReferences checks that every perm belongs to one of the given
+// credential schemas. It is used to cross-check the perm genesis against the
+// credential schema genesis.
+func (gs GenesisState) ValidateSchemaReferences(schemaIDs map[uint64]bool) error {
+	for _, perm := range gs.Permissions {
+		if !schemaIDs[perm.SchemaId] {
+			return fmt.Errorf("perm ID %d references unknown credential schema ID: %d", perm.Id, perm.SchemaId)
+		}
+	}
+
+	for _, allowance := range gs.SessionAllowances {
+		if allowance.SchemaId != 0 && !schemaIDs[allowance.SchemaId] {
+			return fmt.Errorf("session allowance ID %d references unknown credential schema ID: %d", allowance.Id, allowance.SchemaId)
+		}
+	}
+
+	return nil
+}
+
+// ValidateTrustDepositReferences checks that every grantee holding a deposit
+// has a trust deposit entry. It is used to cross-check the perm genesis against
+// the trust deposit genesis.
+func (gs GenesisState) ValidateTrustDepositReferences(trustDepositAccounts map[string]bool) error {
+	for _, perm := range gs.Permissions {
+		if perm.Deposit > 0 && !trustDepositAccounts[perm.Grantee] {
+			return fmt.Errorf("perm ID %d holds a deposit but grantee %s has no trust deposit", perm.Id, perm.Grantee)
+		}
+	}
+
 	return nil
 }
 
@@ -94,6 +354,10 @@ func validatePermission(perm Permission, allPerms []Permission) error {
 		return fmt.Errorf("grantee cannot be empty for perm ID %d", perm.Id)
 	}
 
+	if err := ValidateStoredCountryScope(perm.Countries); err != nil {
+		return fmt.Errorf("invalid countries for perm ID %d: %w", perm.Id, err)
+	}
+
 	// Validate validator perm reference
 	if perm.ValidatorPermId != 0 {
 		validatorFound := false
@@ -112,6 +376,54 @@ func validatePermission(perm Permission, allPerms []Permission) error {
 		}
 	}
 
+	if perm.ScheduledFees != nil && perm.ScheduledFees.EffectiveFrom == nil {
+		return fmt.Errorf("scheduled fees without effective_from for perm ID %d", perm.Id)
+	}
+
+	return validatePermissionQuorum(perm, allPerms)
+}
+
+// validatePermissionQuorum validates the co-validators and the pending
+// approvals of a validation process with a quorum
+func validatePermissionQuorum(perm Permission, allPerms []Permission) error {
+	if perm.VpQuorum > credentialschematypes.MaxValidationQuorum {
+		return fmt.Errorf("vp quorum %d exceeds %d for perm ID %d", perm.VpQuorum, credentialschematypes.MaxValidationQuorum, perm.Id)
+	}
+	if len(perm.CoValidatorPermIds) > 0 && perm.VpQuorum <= 1 {
+		return fmt.Errorf("co-validator perms set without a vp quorum for perm ID %d", perm.Id)
+	}
+	if len(perm.VpApprovals) > 0 && uint32(len(perm.VpApprovals)) >= perm.VpQuorum {
+		return fmt.Errorf("perm ID %d has %d pending approvals for a vp quorum of %d", perm.Id, len(perm.VpApprovals), perm.VpQuorum)
+	}
+
+	validators := map[uint64]bool{perm.ValidatorPermId: true}
+	for _, id := range perm.CoValidatorPermIds {
+		found := false
+		for _, p := range allPerms {
+			if p.Id == id {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("co-validator perm ID %d not found for perm ID %d", id, perm.Id)
+		}
+		validators[id] = true
+	}
+
+	for _, approval := range perm.VpApprovals {
+		if !validators[approval.ValidatorPermId] {
+			return fmt.Errorf("approval of perm ID %d by perm ID %d, not a validator of its validation process",
+				perm.Id, approval.ValidatorPermId)
+		}
+	}
+	for _, deposit := range perm.VpCoValidatorDeposits {
+		if !validators[deposit.ValidatorPermId] || deposit.ValidatorPermId == perm.ValidatorPermId {
+			return fmt.Errorf("co-validator deposit of perm ID %d for perm ID %d, not a co-validator of its validation process",
+				perm.Id, deposit.ValidatorPermId)
+		}
+	}
+
 	return nil
 }
 
@@ -164,6 +476,14 @@ func validatePermissionSession(session PermissionSession, permissionIds map[uint
 		return fmt.Errorf("modified timestamp is required for session ID %s", session.Id)
 	}
 
+	if session.Expires == nil {
+		return fmt.Errorf("expires timestamp is required for session ID %s", session.Id)
+	}
+
+	if session.Expires.Before(*session.Created) {
+		return fmt.Errorf("expires timestamp cannot be before created timestamp for session ID %s", session.Id)
+	}
+
 	// Validate each authorization entry
 	for i, authz := range session.Authz {
 		// At least one of executor or beneficiary must be set
@@ -193,3 +513,108 @@ func validatePermissionSession(session PermissionSession, permissionIds map[uint
 
 	return nil
 }
+
+// validateSessionAllowance validates a single session allowance
+func validateSessionAllowance(allowance SessionAllowance, permissionIds map[uint64]bool) error {
+	if _, err := sdk.AccAddressFromBech32(allowance.Sponsor); err != nil {
+		return fmt.Errorf("invalid sponsor address for session allowance ID %d: %w", allowance.Id, err)
+	}
+
+	scopes := 0
+	for _, id := range []uint64{allowance.SchemaId, allowance.AgentPermId, allowance.VerifierPermId} {
+		if id != 0 {
+			scopes++
+		}
+	}
+	if scopes != 1 {
+		return fmt.Errorf("session allowance ID %d must have exactly one scope", allowance.Id)
+	}
+
+	if err := ValidateAllowedCreators(allowance.AllowedCreators); err != nil {
+		return fmt.Errorf("session allowance ID %d: %w", allowance.Id, err)
+	}
+
+	if allowance.AgentPermId != 0 && !permissionIds[allowance.AgentPermId] {
+		return fmt.Errorf("agent perm ID %d not found for session allowance ID %d", allowance.AgentPermId, allowance.Id)
+	}
+
+	if allowance.VerifierPermId != 0 && !permissionIds[allowance.VerifierPermId] {
+		return fmt.Errorf("verifier perm ID %d not found for session allowance ID %d", allowance.VerifierPermId, allowance.Id)
+	}
+
+	if allowance.Created == nil || allowance.Modified == nil {
+		return fmt.Errorf("created and modified timestamps are required for session allowance ID %d", allowance.Id)
+	}
+
+	return nil
+}
+
+// validateVerificationCredit validates a single verification credit bundle
+func validateVerificationCredit(credit VerificationCredit, permissionIds map[uint64]bool) error {
+	if _, err := sdk.AccAddressFromBech32(credit.Buyer); err != nil {
+		return fmt.Errorf("invalid buyer address for verification credit ID %d: %w", credit.Id, err)
+	}
+
+	if !permissionIds[credit.VerifierPermId] {
+		return fmt.Errorf("verifier perm ID %d not found for verification credit ID %d", credit.VerifierPermId, credit.Id)
+	}
+
+	if credit.IssuerPermId != 0 && !permissionIds[credit.IssuerPermId] {
+		return fmt.Errorf("issuer perm ID %d not found for verification credit ID %d", credit.IssuerPermId, credit.Id)
+	}
+
+	if !permissionIds[credit.ExecutorPermId] {
+		return fmt.Errorf("executor perm ID %d not found for verification credit ID %d", credit.ExecutorPermId, credit.Id)
+	}
+
+	if credit.Purchased == 0 {
+		return fmt.Errorf("purchased credits must be greater than 0 for verification credit ID %d", credit.Id)
+	}
+
+	if credit.Remaining > credit.Purchased {
+		return fmt.Errorf("remaining credits exceed purchased credits for verification credit ID %d", credit.Id)
+	}
+
+	if credit.Refunded != nil && credit.Remaining != 0 {
+		return fmt.Errorf("refunded verification credit ID %d cannot have remaining credits", credit.Id)
+	}
+
+	if credit.Created == nil || credit.Modified == nil {
+		return fmt.Errorf("created and modified timestamps are required for verification credit ID %d", credit.Id)
+	}
+
+	return nil
+}
+
+// validateSlashRecord validates a single slash record
+func validateSlashRecord(record SlashRecord, permissionIds map[uint64]bool) error {
+	if !permissionIds[record.PermId] {
+		return fmt.Errorf("perm ID %d not found for slash record ID %d", record.PermId, record.Id)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(record.Grantee); err != nil {
+		return fmt.Errorf("invalid grantee address for slash record ID %d: %w", record.Id, err)
+	}
+
+	if record.Amount == 0 {
+		return fmt.Errorf("amount must be greater than 0 for slash record ID %d", record.Id)
+	}
+
+	if record.Status == SlashStatus_SLASH_STATUS_UNSPECIFIED {
+		return fmt.Errorf("status must be specified for slash record ID %d", record.Id)
+	}
+
+	if record.GfVersion < 1 {
+		return fmt.Errorf("governance framework version must be positive for slash record ID %d", record.Id)
+	}
+
+	if record.Created == nil || record.AppealDeadline == nil {
+		return fmt.Errorf("created and appeal deadline timestamps are required for slash record ID %d", record.Id)
+	}
+
+	if err := trustdeposittypes.ValidateSlashShares(record.Distribution); err != nil {
+		return fmt.Errorf("invalid distribution for slash record ID %d: %w", record.Id, err)
+	}
+
+	return nil
+}
@@ -34,6 +34,30 @@ type GenesisState struct {
 	PermissionSessions []PermissionSession `protobuf:"bytes,3,rep,name=permission_sessions,json=permissionSessions,proto3" json:"permission_sessions"`
 	// next_permission_id is the next auto-increment ID to be assigned to a new permission
 	NextPermissionId uint64 `protobuf:"varint,4,opt,name=next_permission_id,json=nextPermissionId,proto3" json:"next_permission_id,omitempty"`
+	// session_allowances is a list of all SessionAllowance objects
+	SessionAllowances []SessionAllowance `protobuf:"bytes,5,rep,name=session_allowances,json=sessionAllowances,proto3" json:"session_allowances"`
+	// next_session_allowance_id is the next auto-increment ID to be assigned to a new session allowance
+	NextSessionAllowanceId uint64 `protobuf:"varint,6,opt,name=next_session_allowance_id,json=nextSessionAllowanceId,proto3" json:"next_session_allowance_id,omitempty"`
+	// slash_records is a list of all SlashRecord objects
+	SlashRecords []SlashRecord `protobuf:"bytes,7,rep,name=slash_records,json=slashRecords,proto3" json:"slash_records"`
+	// next_slash_record_id is the next auto-increment ID to be assigned to a new slash record
+	NextSlashRecordId uint64 `protobuf:"varint,8,opt,name=next_slash_record_id,json=nextSlashRecordId,proto3" json:"next_slash_record_id,omitempty"`
+	// slash_distributions is a list of the trust registry slash distributions
+	SlashDistributions []TrustRegistrySlashDistribution `protobuf:"bytes,9,rep,name=slash_distributions,json=slashDistributions,proto3" json:"slash_distributions"`
+	// revocation_cascades is a list of the cascading revocations in progress
+	RevocationCascades []RevocationCascade `protobuf:"bytes,10,rep,name=revocation_cascades,json=revocationCascades,proto3" json:"revocation_cascades"`
+	// agent_rewards is a list of the rewards accrued by agent perms
+	AgentRewards []AgentReward `protobuf:"bytes,11,rep,name=agent_rewards,json=agentRewards,proto3" json:"agent_rewards"`
+	// verification_credits is a list of all VerificationCredit objects
+	VerificationCredits []VerificationCredit `protobuf:"bytes,12,rep,name=verification_credits,json=verificationCredits,proto3" json:"verification_credits"`
+	// next_verification_credit_id is the next verification credit ID to be assigned
+	NextVerificationCreditId uint64 `protobuf:"varint,13,opt,name=next_verification_credit_id,json=nextVerificationCreditId,proto3" json:"next_verification_credit_id,omitempty"`
+	// voucher_nonces is a list of the voucher nonces settled per signer
+	VoucherNonces []VoucherNonce `protobuf:"bytes,14,rep,name=voucher_nonces,json=voucherNonces,proto3" json:"voucher_nonces"`
+	// vp_messages is a list of the messages anchored for validation processes
+	VpMessages []VPMessage `protobuf:"bytes,15,rep,name=vp_messages,json=vpMessages,proto3" json:"vp_messages"`
+	// fee_records is the fee history of the perms
+	FeeRecords []PermissionFeeRecord `protobuf:"bytes,16,rep,name=fee_records,json=feeRecords,proto3" json:"fee_records"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -97,6 +121,90 @@ func (m *GenesisState) GetNextPermissionId() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetSessionAllowances() []SessionAllowance {
+	if m != nil {
+		return m.SessionAllowances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextSessionAllowanceId() uint64 {
+	if m != nil {
+		return m.NextSessionAllowanceId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSlashRecords() []SlashRecord {
+	if m != nil {
+		return m.SlashRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextSlashRecordId() uint64 {
+	if m != nil {
+		return m.NextSlashRecordId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSlashDistributions() []TrustRegistrySlashDistribution {
+	if m != nil {
+		return m.SlashDistributions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRevocationCascades() []RevocationCascade {
+	if m != nil {
+		return m.RevocationCascades
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAgentRewards() []AgentReward {
+	if m != nil {
+		return m.AgentRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVerificationCredits() []VerificationCredit {
+	if m != nil {
+		return m.VerificationCredits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextVerificationCreditId() uint64 {
+	if m != nil {
+		return m.NextVerificationCreditId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetVoucherNonces() []VoucherNonce {
+	if m != nil {
+		return m.VoucherNonces
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVpMessages() []VPMessage {
+	if m != nil {
+		return m.VpMessages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeRecords() []PermissionFeeRecord {
+	if m != nil {
+		return m.FeeRecords
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "verana.perm.v1.GenesisState")
 }
@@ -104,27 +212,48 @@ func init() {
 func init() { proto.RegisterFile("verana/perm/v1/genesis.proto", fileDescriptor_dcce93a8adf2002d) }
 
 var fileDescriptor_dcce93a8adf2002d = []byte{
-	// 316 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x29, 0x4b, 0x2d, 0x4a,
-	0xcc, 0x4b, 0xd4, 0x2f, 0x48, 0x2d, 0xca, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d,
-	0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0xc8, 0xea, 0x81, 0x64, 0xf5,
-	0xca, 0x0c, 0xa5, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x89, 0x94, 0x48,
-	0x7a, 0x7e, 0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x45, 0xa5, 0xd1, 0x8c, 0x2d, 0x48, 0x2c,
-	0x4a, 0xcc, 0x85, 0x9a, 0x2a, 0x25, 0x85, 0x26, 0x59, 0x52, 0x59, 0x90, 0x0a, 0x95, 0x53, 0x9a,
-	0xca, 0xc4, 0xc5, 0xe3, 0x0e, 0x71, 0x43, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x25, 0x17, 0x1b,
-	0x44, 0xb3, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x98, 0x1e, 0xaa, 0x9b, 0xf4, 0x02, 0xc0,
-	0xb2, 0x4e, 0x9c, 0x27, 0xee, 0xc9, 0x33, 0xac, 0x78, 0xbe, 0x41, 0x8b, 0x31, 0x08, 0xaa, 0x41,
-	0xc8, 0x89, 0x8b, 0x1b, 0xa4, 0x28, 0xb3, 0xb8, 0x38, 0x33, 0x3f, 0xaf, 0x58, 0x82, 0x49, 0x81,
-	0x59, 0x83, 0xdb, 0x48, 0x0a, 0x43, 0x3f, 0x5c, 0x89, 0x13, 0x0b, 0xc8, 0x8c, 0x20, 0x64, 0x4d,
-	0x42, 0x11, 0x5c, 0xc2, 0x08, 0x6e, 0x7c, 0x71, 0x2a, 0xd4, 0x2c, 0x66, 0xb0, 0x59, 0x8a, 0xb8,
-	0xcd, 0x0a, 0x4e, 0x45, 0x36, 0x52, 0xa8, 0x00, 0x5d, 0xa2, 0x58, 0x48, 0x87, 0x4b, 0x28, 0x2f,
-	0xb5, 0xa2, 0x24, 0x1e, 0xc9, 0xf8, 0xcc, 0x14, 0x09, 0x16, 0x05, 0x46, 0x0d, 0x96, 0x20, 0x01,
-	0x90, 0x0c, 0xc2, 0x30, 0xcf, 0x14, 0xa7, 0xd0, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63,
-	0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96,
-	0x63, 0x88, 0xb2, 0x4e, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87, 0x38,
-	0x47, 0x37, 0x27, 0x31, 0xa9, 0x18, 0xc6, 0x4e, 0xca, 0xc9, 0x4f, 0xce, 0x4e, 0xce, 0x48, 0xcc,
-	0xcc, 0xd3, 0xaf, 0xd0, 0x47, 0xd8, 0x05, 0x09, 0xf4, 0x24, 0x36, 0x70, 0xa8, 0x1b, 0x03, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0x0e, 0x93, 0xdd, 0x7a, 0x07, 0x02, 0x00, 0x00,
+	// 644 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0x41, 0x4f, 0xd4, 0x4e,
+	0x18, 0xc6, 0xb7, 0x7f, 0xf8, 0xa3, 0xcc, 0x02, 0xc2, 0x40, 0x48, 0x59, 0xc8, 0xba, 0xe2, 0x85,
+	0x18, 0x6d, 0x03, 0x9e, 0x88, 0x31, 0x91, 0xc5, 0x60, 0xd6, 0x44, 0x43, 0x8a, 0x18, 0xa3, 0x87,
+	0x66, 0xb6, 0x7d, 0xe9, 0x36, 0xee, 0x76, 0x9a, 0x79, 0xbb, 0x05, 0xbe, 0x85, 0x9f, 0xc0, 0xb3,
+	0x47, 0x3f, 0x06, 0x47, 0x8e, 0x9e, 0x8c, 0x81, 0x83, 0x5f, 0xc3, 0xcc, 0x74, 0x4a, 0x4b, 0xbb,
+	0x5c, 0x9a, 0xe9, 0xfb, 0x3c, 0xf3, 0x7b, 0x9f, 0xcc, 0x3b, 0x2d, 0xd9, 0x48, 0x41, 0xb0, 0x88,
+	0xd9, 0x31, 0x88, 0x91, 0x9d, 0x6e, 0xdb, 0x01, 0x44, 0x80, 0x21, 0x5a, 0xb1, 0xe0, 0x09, 0xa7,
+	0x0b, 0x99, 0x6a, 0x49, 0xd5, 0x4a, 0xb7, 0x5b, 0x4b, 0x6c, 0x14, 0x46, 0xdc, 0x56, 0xcf, 0xcc,
+	0xd2, 0x5a, 0x09, 0x78, 0xc0, 0xd5, 0xd2, 0x96, 0x2b, 0x5d, 0x5d, 0xaf, 0x60, 0x63, 0x26, 0xd8,
+	0x48, 0x53, 0x5b, 0xad, 0x8a, 0x98, 0x9c, 0xc7, 0xa0, 0xb5, 0xcd, 0xef, 0xb3, 0x64, 0xee, 0x4d,
+	0x96, 0xe1, 0x28, 0x61, 0x09, 0xd0, 0x5d, 0x32, 0x93, 0x6d, 0x36, 0x8d, 0x8e, 0xb1, 0xd5, 0xdc,
+	0x59, 0xb5, 0x6e, 0x67, 0xb2, 0x0e, 0x95, 0xda, 0x9d, 0xbd, 0xf8, 0xfd, 0xb0, 0xf1, 0xe3, 0xef,
+	0xcf, 0x27, 0x86, 0xa3, 0x37, 0xd0, 0x2e, 0x69, 0x4a, 0x53, 0x88, 0x18, 0xf2, 0x08, 0xcd, 0xff,
+	0x3a, 0x53, 0x5b, 0xcd, 0x9d, 0x56, 0x6d, 0xff, 0x8d, 0xa5, 0x3b, 0x2d, 0x19, 0x4e, 0x79, 0x13,
+	0xfd, 0x44, 0x96, 0x8b, 0x57, 0x17, 0x41, 0xb3, 0xa6, 0x14, 0xeb, 0xd1, 0xdd, 0xac, 0x23, 0x28,
+	0x23, 0x69, 0x5c, 0x15, 0x90, 0x3e, 0x25, 0x34, 0x82, 0xb3, 0xc4, 0x2d, 0xe1, 0x43, 0xdf, 0x9c,
+	0xee, 0x18, 0x5b, 0xd3, 0xce, 0xa2, 0x54, 0x0a, 0x58, 0xcf, 0xa7, 0xc7, 0x84, 0xea, 0xe6, 0x2e,
+	0x1b, 0x0e, 0xf9, 0x29, 0x8b, 0x3c, 0x40, 0xf3, 0x7f, 0x15, 0xa3, 0x53, 0x8d, 0xa1, 0x7b, 0xec,
+	0xe5, 0x46, 0x9d, 0x62, 0x09, 0x2b, 0x75, 0xa4, 0xbb, 0x64, 0x4d, 0x85, 0xa8, 0xb1, 0x65, 0x96,
+	0x19, 0x95, 0x65, 0x55, 0x1a, 0xaa, 0xc4, 0x9e, 0x4f, 0x0f, 0xc8, 0x3c, 0x0e, 0x19, 0x0e, 0x5c,
+	0x01, 0x1e, 0x17, 0x3e, 0x9a, 0xf7, 0x54, 0x98, 0xf5, 0x5a, 0x18, 0x69, 0x72, 0x94, 0x47, 0xe7,
+	0x98, 0xc3, 0xa2, 0x84, 0xd4, 0x26, 0x2b, 0x59, 0x84, 0x12, 0x4c, 0x76, 0xbf, 0xaf, 0xba, 0x2f,
+	0xa9, 0xee, 0x85, 0xbf, 0xe7, 0x53, 0x20, 0xcb, 0x99, 0xd7, 0x0f, 0x31, 0x11, 0x61, 0x7f, 0x9c,
+	0xa8, 0x91, 0xcc, 0xaa, 0xf6, 0x56, 0xb5, 0xfd, 0x07, 0x31, 0xc6, 0xc4, 0x81, 0x40, 0x7a, 0xcf,
+	0x15, 0xe8, 0x75, 0x69, 0x5b, 0x3e, 0x1f, 0xac, 0x0a, 0x6a, 0xf2, 0x02, 0x52, 0xee, 0x31, 0xf9,
+	0xea, 0x7a, 0x0c, 0x3d, 0xe6, 0x03, 0x9a, 0x64, 0xf2, 0xe4, 0x9d, 0x1b, 0xeb, 0x7e, 0xe6, 0xcc,
+	0xc9, 0xa2, 0x2a, 0xa0, 0x3c, 0x39, 0x16, 0x40, 0x94, 0xb8, 0x02, 0x4e, 0x99, 0x3c, 0xb9, 0xe6,
+	0xe4, 0x93, 0xdb, 0x93, 0x26, 0x47, 0x79, 0xf2, 0x93, 0x63, 0x45, 0x09, 0xe9, 0x17, 0xb2, 0x92,
+	0x82, 0x08, 0x4f, 0xc2, 0x3c, 0xa3, 0x00, 0x3f, 0x4c, 0xd0, 0x9c, 0x53, 0xb8, 0xcd, 0x2a, 0xee,
+	0x63, 0xc9, 0xbb, 0xaf, 0xac, 0x9a, 0xba, 0x9c, 0xd6, 0x14, 0xa4, 0x2f, 0xc9, 0xba, 0x1a, 0xcb,
+	0x84, 0x0e, 0x72, 0x3a, 0xf3, 0x6a, 0x3a, 0xa6, 0xb4, 0xd4, 0xb9, 0x3d, 0x9f, 0xf6, 0xc8, 0x42,
+	0xca, 0xc7, 0xde, 0x00, 0x84, 0x1b, 0x71, 0x75, 0x57, 0x17, 0x54, 0xaa, 0x8d, 0x5a, 0xaa, 0xcc,
+	0xf5, 0x9e, 0x17, 0xf7, 0x74, 0x3e, 0x2d, 0xd5, 0x90, 0xbe, 0x22, 0xcd, 0x34, 0x76, 0x47, 0x80,
+	0xc8, 0x02, 0x40, 0xf3, 0x81, 0xe2, 0xac, 0xd5, 0x38, 0x87, 0xef, 0x32, 0x87, 0x86, 0x90, 0x34,
+	0xd6, 0x05, 0xa4, 0x6f, 0x49, 0xf3, 0x04, 0xe0, 0xe6, 0xa2, 0x2e, 0x2a, 0xc2, 0xe3, 0xbb, 0x3f,
+	0xde, 0x03, 0x80, 0x5b, 0x17, 0x96, 0x9c, 0xe4, 0x05, 0xec, 0x1e, 0x5f, 0x5c, 0xb5, 0x8d, 0xcb,
+	0xab, 0xb6, 0xf1, 0xe7, 0xaa, 0x6d, 0x7c, 0xbb, 0x6e, 0x37, 0x2e, 0xaf, 0xdb, 0x8d, 0x5f, 0xd7,
+	0xed, 0xc6, 0xe7, 0x17, 0x41, 0x98, 0x0c, 0xc6, 0x7d, 0xcb, 0xe3, 0x23, 0x3b, 0x43, 0x3f, 0x1b,
+	0xb2, 0x3e, 0xe6, 0xeb, 0xfe, 0x90, 0x7b, 0x5f, 0xbd, 0x01, 0x0b, 0x23, 0xfb, 0xcc, 0x2e, 0x3e,
+	0xfa, 0xec, 0xef, 0xd7, 0x9f, 0x51, 0xbf, 0xbf, 0xe7, 0xff, 0x06, 0x00, 0xce, 0xe2, 0xa4, 0xfc,
+	0x90, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -147,6 +276,149 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeRecords) > 0 {
+		for iNdEx := len(m.FeeRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.VpMessages) > 0 {
+		for iNdEx := len(m.VpMessages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VpMessages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.VoucherNonces) > 0 {
+		for iNdEx := len(m.VoucherNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoucherNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.NextVerificationCreditId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextVerificationCreditId))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.VerificationCredits) > 0 {
+		for iNdEx := len(m.VerificationCredits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VerificationCredits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.AgentRewards) > 0 {
+		for iNdEx := len(m.AgentRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AgentRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.RevocationCascades) > 0 {
+		for iNdEx := len(m.RevocationCascades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RevocationCascades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.SlashDistributions) > 0 {
+		for iNdEx := len(m.SlashDistributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashDistributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.NextSlashRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextSlashRecordId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.SlashRecords) > 0 {
+		for iNdEx := len(m.SlashRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.NextSessionAllowanceId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextSessionAllowanceId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.SessionAllowances) > 0 {
+		for iNdEx := len(m.SessionAllowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SessionAllowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.NextPermissionId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.NextPermissionId))
 		i--
@@ -227,6 +499,69 @@ func (m *GenesisState) Size() (n int) {
 	if m.NextPermissionId != 0 {
 		n += 1 + sovGenesis(uint64(m.NextPermissionId))
 	}
+	if len(m.SessionAllowances) > 0 {
+		for _, e := range m.SessionAllowances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextSessionAllowanceId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextSessionAllowanceId))
+	}
+	if len(m.SlashRecords) > 0 {
+		for _, e := range m.SlashRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextSlashRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextSlashRecordId))
+	}
+	if len(m.SlashDistributions) > 0 {
+		for _, e := range m.SlashDistributions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RevocationCascades) > 0 {
+		for _, e := range m.RevocationCascades {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AgentRewards) > 0 {
+		for _, e := range m.AgentRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VerificationCredits) > 0 {
+		for _, e := range m.VerificationCredits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextVerificationCreditId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextVerificationCreditId))
+	}
+	if len(m.VoucherNonces) > 0 {
+		for _, e := range m.VoucherNonces {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VpMessages) > 0 {
+		for _, e := range m.VpMessages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeRecords) > 0 {
+		for _, e := range m.FeeRecords {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -385,6 +720,369 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionAllowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionAllowances = append(m.SessionAllowances, SessionAllowance{})
+			if err := m.SessionAllowances[len(m.SessionAllowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSessionAllowanceId", wireType)
+			}
+			m.NextSessionAllowanceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSessionAllowanceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashRecords = append(m.SlashRecords, SlashRecord{})
+			if err := m.SlashRecords[len(m.SlashRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSlashRecordId", wireType)
+			}
+			m.NextSlashRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSlashRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashDistributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashDistributions = append(m.SlashDistributions, TrustRegistrySlashDistribution{})
+			if err := m.SlashDistributions[len(m.SlashDistributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevocationCascades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevocationCascades = append(m.RevocationCascades, RevocationCascade{})
+			if err := m.RevocationCascades[len(m.RevocationCascades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentRewards = append(m.AgentRewards, AgentReward{})
+			if err := m.AgentRewards[len(m.AgentRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationCredits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationCredits = append(m.VerificationCredits, VerificationCredit{})
+			if err := m.VerificationCredits[len(m.VerificationCredits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextVerificationCreditId", wireType)
+			}
+			m.NextVerificationCreditId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextVerificationCreditId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoucherNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoucherNonces = append(m.VoucherNonces, VoucherNonce{})
+			if err := m.VoucherNonces[len(m.VoucherNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VpMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VpMessages = append(m.VpMessages, VPMessage{})
+			if err := m.VpMessages[len(m.VpMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeRecords = append(m.FeeRecords, PermissionFeeRecord{})
+			if err := m.FeeRecords[len(m.FeeRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -4,9 +4,11 @@ import (
 	"testing"
 	"time"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	"github.com/verana-labs/verana-blockchain/x/permission/types"
+	tdtypes "github.com/verana-labs/verana-blockchain/x/trustdeposit/types"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
@@ -23,7 +25,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		CreatedBy:      creatorAddr,
 		Modified:       &nowTime,
 		SchemaId:       1,
-		Country:        "US",
+		Countries:      []string{"US"},
 		EffectiveFrom:  &nowTime,
 		EffectiveUntil: &futureTime,
 	}
@@ -37,7 +39,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		CreatedBy:       creatorAddr,
 		Modified:        &nowTime,
 		SchemaId:        1,
-		Country:         "CA",
+		Countries:       []string{"CA"},
 		EffectiveFrom:   &nowTime,
 		EffectiveUntil:  &futureTime,
 		ValidatorPermId: 1,
@@ -49,6 +51,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		AgentPermId: 2,
 		Created:     &nowTime,
 		Modified:    &nowTime,
+		Expires:     &futureTime,
 		Authz: []*types.SessionAuthz{
 			{
 				ExecutorPermId:    1,
@@ -57,6 +60,39 @@ func TestGenesisState_Validate(t *testing.T) {
 		},
 	}
 
+	validAllowance := types.SessionAllowance{
+		Id:          1,
+		Sponsor:     creatorAddr,
+		AgentPermId: 2,
+		Remaining:   100,
+		Created:     &nowTime,
+		Modified:    &nowTime,
+	}
+
+	validSlashRecord := types.SlashRecord{
+		Id:             1,
+		PermId:         2,
+		Grantee:        creatorAddr,
+		Amount:         100,
+		SlashedBy:      creatorAddr,
+		Status:         types.SlashStatus_SLASH_STATUS_PENDING,
+		Created:        &nowTime,
+		AppealDeadline: &nowTime,
+		ReasonCode:     1,
+		GfVersion:      1,
+	}
+
+	validCredit := types.VerificationCredit{
+		Id:             1,
+		Buyer:          creatorAddr,
+		VerifierPermId: 2,
+		ExecutorPermId: 1,
+		Purchased:      3,
+		Remaining:      2,
+		Created:        &nowTime,
+		Modified:       &nowTime,
+	}
+
 	tests := []struct {
 		desc        string
 		genState    *types.GenesisState
@@ -141,6 +177,138 @@ func TestGenesisState_Validate(t *testing.T) {
 			valid:       false,
 			errorString: "validator perm ID 999 not found",
 		},
+		{
+			desc: "invalid country scope",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Permissions: []types.Permission{
+					{
+						Id:        1,
+						Type:      types.PermissionType_PERMISSION_TYPE_ECOSYSTEM,
+						Grantee:   creatorAddr,
+						Created:   &nowTime,
+						Modified:  &nowTime,
+						Countries: []string{"US", "US-CA"}, // subdivision of a listed country
+					},
+				},
+				PermissionSessions: []types.PermissionSession{},
+				NextPermissionId:   2,
+			},
+			valid:       false,
+			errorString: "invalid countries for perm ID 1",
+		},
+		{
+			desc: "legacy country code of a stored perm",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Permissions: []types.Permission{
+					{
+						Id:        1,
+						Type:      types.PermissionType_PERMISSION_TYPE_ECOSYSTEM,
+						Grantee:   creatorAddr,
+						Created:   &nowTime,
+						Modified:  &nowTime,
+						Countries: []string{"XK", "RS"},
+					},
+				},
+				PermissionSessions: []types.PermissionSession{},
+				NextPermissionId:   2,
+			},
+			valid: true,
+		},
+		{
+			desc: "valid vp messages",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				Permissions:      []types.Permission{validPerm1, validPerm2},
+				NextPermissionId: 3,
+				VpMessages: []types.VPMessage{
+					{PermId: 2, Index: 1, Sender: creatorAddr, DigestSri: "sha256-abc123", EncryptedUri: "https://example.com/1.jwe", Created: &nowTime},
+					{PermId: 2, Index: 2, Sender: creatorAddr, DigestSri: "sha256-def456", EncryptedUri: "https://example.com/2.jwe", Created: &nowTime},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate vp message",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				Permissions:      []types.Permission{validPerm1, validPerm2},
+				NextPermissionId: 3,
+				VpMessages: []types.VPMessage{
+					{PermId: 2, Index: 1, Sender: creatorAddr, DigestSri: "sha256-abc123", EncryptedUri: "https://example.com/1.jwe", Created: &nowTime},
+					{PermId: 2, Index: 1, Sender: creatorAddr, DigestSri: "sha256-def456", EncryptedUri: "https://example.com/2.jwe", Created: &nowTime},
+				},
+			},
+			valid:       false,
+			errorString: "duplicate vp message 1 for perm ID 2",
+		},
+		{
+			desc: "valid fee records",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				Permissions:      []types.Permission{validPerm1, validPerm2},
+				NextPermissionId: 3,
+				FeeRecords: []types.PermissionFeeRecord{
+					{PermId: 2, Index: 1, Fees: types.PermissionFees{ValidationFees: 10, EffectiveFrom: &nowTime}},
+					{PermId: 2, Index: 2, Fees: types.PermissionFees{ValidationFees: 20, EffectiveFrom: &futureTime}, UpdatedBy: creatorAddr},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate fee record",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				Permissions:      []types.Permission{validPerm1, validPerm2},
+				NextPermissionId: 3,
+				FeeRecords: []types.PermissionFeeRecord{
+					{PermId: 2, Index: 1, Fees: types.PermissionFees{ValidationFees: 10, EffectiveFrom: &nowTime}},
+					{PermId: 2, Index: 1, Fees: types.PermissionFees{ValidationFees: 20, EffectiveFrom: &futureTime}},
+				},
+			},
+			valid:       false,
+			errorString: "duplicate fee record 1 for perm ID 2",
+		},
+		{
+			desc: "pending quorum approval",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Permissions: []types.Permission{validPerm1, func() types.Permission {
+					perm := validPerm2
+					perm.VpQuorum = 2
+					perm.CoValidatorPermIds = []uint64{1}
+					perm.ValidatorPermId = 3
+					perm.VpApprovals = []types.VPApproval{{ValidatorPermId: 1, Approver: creatorAddr, Created: &nowTime}}
+					return perm
+				}(), {
+					Id:        3,
+					Type:      types.PermissionType_PERMISSION_TYPE_ISSUER_GRANTOR,
+					Grantee:   creatorAddr,
+					Created:   &nowTime,
+					CreatedBy: creatorAddr,
+					Modified:  &nowTime,
+					SchemaId:  1,
+				}},
+				NextPermissionId: 4,
+			},
+			valid: true,
+		},
+		{
+			desc: "unknown co-validator perm",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Permissions: []types.Permission{validPerm1, func() types.Permission {
+					perm := validPerm2
+					perm.VpQuorum = 2
+					perm.CoValidatorPermIds = []uint64{9}
+					return perm
+				}()},
+				NextPermissionId: 3,
+			},
+			valid:       false,
+			errorString: "co-validator perm ID 9 not found for perm ID 2",
+		},
 		{
 			desc: "next perm ID too low",
 			genState: &types.GenesisState{
@@ -164,6 +332,7 @@ func TestGenesisState_Validate(t *testing.T) {
 						AgentPermId: 999, // Non-existent perm
 						Created:     &nowTime,
 						Modified:    &nowTime,
+						Expires:     &futureTime,
 					},
 				},
 				NextPermissionId: 2,
@@ -171,6 +340,330 @@ func TestGenesisState_Validate(t *testing.T) {
 			valid:       false,
 			errorString: "agent perm ID 999 not found",
 		},
+		{
+			desc: "session without expiration",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				Permissions: []types.Permission{validPerm1, validPerm2},
+				PermissionSessions: []types.PermissionSession{
+					{
+						Id:          "test-session-id",
+						Controller:  creatorAddr,
+						AgentPermId: 2,
+						Created:     &nowTime,
+						Modified:    &nowTime,
+					},
+				},
+				NextPermissionId: 3,
+			},
+			valid:       false,
+			errorString: "expires timestamp is required for session ID test-session-id",
+		},
+		{
+			desc: "valid session allowance",
+			genState: &types.GenesisState{
+				Params:                 types.DefaultParams(),
+				Permissions:            []types.Permission{validPerm1, validPerm2},
+				NextPermissionId:       3,
+				SessionAllowances:      []types.SessionAllowance{validAllowance},
+				NextSessionAllowanceId: 2,
+			},
+			valid: true,
+		},
+		{
+			desc: "session allowance without scope",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				Permissions:      []types.Permission{validPerm1, validPerm2},
+				NextPermissionId: 3,
+				SessionAllowances: []types.SessionAllowance{
+					{Id: 1, Sponsor: creatorAddr, Created: &nowTime, Modified: &nowTime},
+				},
+				NextSessionAllowanceId: 2,
+			},
+			valid:       false,
+			errorString: "must have exactly one scope",
+		},
+		{
+			desc: "session allowance missing agent perm",
+			genState: &types.GenesisState{
+				Params:                 types.DefaultParams(),
+				Permissions:            []types.Permission{validPerm1},
+				NextPermissionId:       2,
+				SessionAllowances:      []types.SessionAllowance{validAllowance},
+				NextSessionAllowanceId: 2,
+			},
+			valid:       false,
+			errorString: "agent perm ID 2 not found for session allowance",
+		},
+		{
+			desc: "session allowance with duplicate allowed creator",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				Permissions:      []types.Permission{validPerm1, validPerm2},
+				NextPermissionId: 3,
+				SessionAllowances: []types.SessionAllowance{
+					{
+						Id:              1,
+						Sponsor:         creatorAddr,
+						AgentPermId:     2,
+						Created:         &nowTime,
+						Modified:        &nowTime,
+						AllowedCreators: []string{creatorAddr, creatorAddr},
+					},
+				},
+				NextSessionAllowanceId: 2,
+			},
+			valid:       false,
+			errorString: "duplicate allowed creator",
+		},
+		{
+			desc: "next session allowance ID too low",
+			genState: &types.GenesisState{
+				Params:                 types.DefaultParams(),
+				Permissions:            []types.Permission{validPerm1, validPerm2},
+				NextPermissionId:       3,
+				SessionAllowances:      []types.SessionAllowance{validAllowance},
+				NextSessionAllowanceId: 1,
+			},
+			valid:       false,
+			errorString: "next_session_allowance_id (1) must be greater than",
+		},
+		{
+			desc: "valid slash record",
+			genState: &types.GenesisState{
+				Params:            types.DefaultParams(),
+				Permissions:       []types.Permission{validPerm1, validPerm2},
+				NextPermissionId:  3,
+				SlashRecords:      []types.SlashRecord{validSlashRecord},
+				NextSlashRecordId: 2,
+			},
+			valid: true,
+		},
+		{
+			desc: "slash record missing perm",
+			genState: &types.GenesisState{
+				Params:            types.DefaultParams(),
+				Permissions:       []types.Permission{validPerm1},
+				NextPermissionId:  2,
+				SlashRecords:      []types.SlashRecord{validSlashRecord},
+				NextSlashRecordId: 2,
+			},
+			valid:       false,
+			errorString: "perm ID 2 not found for slash record",
+		},
+		{
+			desc: "next slash record ID too low",
+			genState: &types.GenesisState{
+				Params:            types.DefaultParams(),
+				Permissions:       []types.Permission{validPerm1, validPerm2},
+				NextPermissionId:  3,
+				SlashRecords:      []types.SlashRecord{validSlashRecord},
+				NextSlashRecordId: 1,
+			},
+			valid:       false,
+			errorString: "next_slash_record_id (1) must be greater than",
+		},
+		{
+			desc: "valid slash distribution",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				NextPermissionId: 1,
+				SlashDistributions: []types.TrustRegistrySlashDistribution{{
+					TrId: 1,
+					Distribution: []tdtypes.SlashShare{
+						{Destination: tdtypes.SlashDestination_SLASH_DESTINATION_BURN, Weight: math.LegacyMustNewDecFromStr("0.4")},
+						{Destination: tdtypes.SlashDestination_SLASH_DESTINATION_COMMUNITY_POOL, Weight: math.LegacyMustNewDecFromStr("0.6")},
+					},
+				}},
+			},
+			valid: true,
+		},
+		{
+			desc: "slash distribution weights not summing to 1",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				NextPermissionId: 1,
+				SlashDistributions: []types.TrustRegistrySlashDistribution{{
+					TrId: 1,
+					Distribution: []tdtypes.SlashShare{
+						{Destination: tdtypes.SlashDestination_SLASH_DESTINATION_BURN, Weight: math.LegacyMustNewDecFromStr("0.4")},
+					},
+				}},
+			},
+			valid:       false,
+			errorString: "invalid slash distribution for trust registry ID 1",
+		},
+		{
+			desc: "duplicate slash distribution",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				NextPermissionId: 1,
+				SlashDistributions: []types.TrustRegistrySlashDistribution{
+					{TrId: 1, Distribution: []tdtypes.SlashShare{{Destination: tdtypes.SlashDestination_SLASH_DESTINATION_BURN, Weight: math.LegacyOneDec()}}},
+					{TrId: 1, Distribution: []tdtypes.SlashShare{{Destination: tdtypes.SlashDestination_SLASH_DESTINATION_BURN, Weight: math.LegacyOneDec()}}},
+				},
+			},
+			valid:       false,
+			errorString: "duplicate slash distribution for trust registry ID 1",
+		},
+		{
+			desc: "valid revocation cascade",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				Permissions:      []types.Permission{validPerm1, validPerm2},
+				NextPermissionId: 3,
+				RevocationCascades: []types.RevocationCascade{{
+					PermId:       2,
+					OriginPermId: 1,
+					Mode:         types.CascadeMode_CASCADE_MODE_REVOKE,
+					ExecutedBy:   validPerm1.Grantee,
+				}},
+			},
+			valid: true,
+		},
+		{
+			desc: "revocation cascade without mode",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				Permissions:      []types.Permission{validPerm1, validPerm2},
+				NextPermissionId: 3,
+				RevocationCascades: []types.RevocationCascade{{
+					PermId:       2,
+					OriginPermId: 1,
+					ExecutedBy:   validPerm1.Grantee,
+				}},
+			},
+			valid:       false,
+			errorString: "invalid revocation cascade mode for perm ID 2",
+		},
+		{
+			desc: "revocation cascade missing perm",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				Permissions:      []types.Permission{validPerm1},
+				NextPermissionId: 2,
+				RevocationCascades: []types.RevocationCascade{{
+					PermId:       2,
+					OriginPermId: 1,
+					Mode:         types.CascadeMode_CASCADE_MODE_SUSPEND,
+					ExecutedBy:   validPerm1.Grantee,
+				}},
+			},
+			valid:       false,
+			errorString: "revocation cascade references non-existent perm ID: 2",
+		},
+		{
+			desc: "valid agent reward",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				Permissions:      []types.Permission{validPerm1},
+				NextPermissionId: 2,
+				AgentRewards: []types.AgentReward{{
+					PermId:             1,
+					UserAgentRewards:   100,
+					WalletAgentRewards: 50,
+					TrustDeposit:       30,
+				}},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate agent reward",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				Permissions:      []types.Permission{validPerm1},
+				NextPermissionId: 2,
+				AgentRewards:     []types.AgentReward{{PermId: 1}, {PermId: 1}},
+			},
+			valid:       false,
+			errorString: "duplicate agent reward for perm ID 1",
+		},
+		{
+			desc: "agent reward trust deposit exceeds rewards",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				Permissions:      []types.Permission{validPerm1},
+				NextPermissionId: 2,
+				AgentRewards:     []types.AgentReward{{PermId: 1, UserAgentRewards: 10, TrustDeposit: 11}},
+			},
+			valid:       false,
+			errorString: "agent reward trust deposit exceeds the rewards for perm ID 1",
+		},
+		{
+			desc: "valid verification credit",
+			genState: &types.GenesisState{
+				Params:                   types.DefaultParams(),
+				Permissions:              []types.Permission{validPerm1, validPerm2},
+				NextPermissionId:         3,
+				VerificationCredits:      []types.VerificationCredit{validCredit},
+				NextVerificationCreditId: 2,
+			},
+			valid: true,
+		},
+		{
+			desc: "verification credit with more remaining than purchased",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				Permissions:      []types.Permission{validPerm1, validPerm2},
+				NextPermissionId: 3,
+				VerificationCredits: []types.VerificationCredit{
+					{Id: 1, Buyer: creatorAddr, VerifierPermId: 2, ExecutorPermId: 1, Purchased: 1, Remaining: 2, Created: &nowTime, Modified: &nowTime},
+				},
+				NextVerificationCreditId: 2,
+			},
+			valid:       false,
+			errorString: "remaining credits exceed purchased credits for verification credit ID 1",
+		},
+		{
+			desc: "verification credit missing verifier perm",
+			genState: &types.GenesisState{
+				Params:                   types.DefaultParams(),
+				Permissions:              []types.Permission{validPerm1},
+				NextPermissionId:         2,
+				VerificationCredits:      []types.VerificationCredit{validCredit},
+				NextVerificationCreditId: 2,
+			},
+			valid:       false,
+			errorString: "verifier perm ID 2 not found for verification credit ID 1",
+		},
+		{
+			desc: "next verification credit ID too low",
+			genState: &types.GenesisState{
+				Params:                   types.DefaultParams(),
+				Permissions:              []types.Permission{validPerm1, validPerm2},
+				NextPermissionId:         3,
+				VerificationCredits:      []types.VerificationCredit{validCredit},
+				NextVerificationCreditId: 1,
+			},
+			valid:       false,
+			errorString: "next_verification_credit_id (1) must be greater than",
+		},
+		{
+			desc: "duplicate voucher nonce",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				NextPermissionId: 1,
+				VoucherNonces: []types.VoucherNonce{
+					{Signer: creatorAddr, Nonce: 1},
+					{Signer: creatorAddr, Nonce: 2},
+				},
+			},
+			valid:       false,
+			errorString: "duplicate voucher nonce for signer",
+		},
+		{
+			desc: "used voucher nonce out of the nonce window",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				NextPermissionId: 1,
+				VoucherNonces: []types.VoucherNonce{
+					{Signer: creatorAddr, Nonce: types.VoucherNonceWindow + 10, UsedNonces: []uint64{10, types.VoucherNonceWindow + 10}},
+				},
+			},
+			valid:       false,
+			errorString: "out of the nonce window",
+		},
 	}
 
 	for _, tc := range tests {
@@ -187,3 +680,26 @@ func TestGenesisState_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisState_ValidateReferences(t *testing.T) {
+	creatorAddr := sdk.AccAddress([]byte("test_creator")).String()
+	granteeAddr := sdk.AccAddress([]byte("test_grantee")).String()
+
+	genState := types.GenesisState{
+		Params: types.DefaultParams(),
+		Permissions: []types.Permission{
+			{Id: 1, SchemaId: 1, Grantee: creatorAddr},
+			{Id: 2, SchemaId: 2, Grantee: granteeAddr, Deposit: 100},
+		},
+		NextPermissionId: 3,
+	}
+
+	require.NoError(t, genState.ValidateSchemaReferences(map[uint64]bool{1: true, 2: true}))
+	require.ErrorContains(t, genState.ValidateSchemaReferences(map[uint64]bool{1: true}),
+		"unknown credential schema ID: 2")
+
+	// only grantees holding a deposit need a trust deposit entry
+	require.NoError(t, genState.ValidateTrustDepositReferences(map[string]bool{granteeAddr: true}))
+	require.ErrorContains(t, genState.ValidateTrustDepositReferences(map[string]bool{creatorAddr: true}),
+		"has no trust deposit")
+}
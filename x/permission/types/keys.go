@@ -17,11 +17,40 @@ const (
 	BondDenom = "uvna"
 )
 
+// Roles of the perms involved in a perm session
+const (
+	SessionRoleAgent       = "agent"
+	SessionRoleExecutor    = "executor"
+	SessionRoleBeneficiary = "beneficiary"
+	SessionRoleWalletAgent = "wallet_agent"
+)
+
 var (
-	ParamsKey            = []byte("p_permission")
-	PermissionKey        = collections.NewPrefix(0)
-	PermissionCounterKey = collections.NewPrefix(1)
-	PermissionSessionKey = collections.NewPrefix(2)
+	ParamsKey                    = []byte("p_permission")
+	PermissionKey                = collections.NewPrefix(0)
+	PermissionCounterKey         = collections.NewPrefix(1)
+	PermissionSessionKey         = collections.NewPrefix(2)
+	SessionAllowanceKey          = collections.NewPrefix(3)
+	SessionAllowanceCounterKey   = collections.NewPrefix(4)
+	SlashRecordKey               = collections.NewPrefix(5)
+	SlashRecordCounterKey        = collections.NewPrefix(6)
+	SlashAppealQueueKey          = collections.NewPrefix(7)
+	SlashDistributionKey         = collections.NewPrefix(8)
+	PermissionByValidatorKey     = collections.NewPrefix(9)
+	RevocationCascadeKey         = collections.NewPrefix(10)
+	AgentRewardKey               = collections.NewPrefix(11)
+	SessionExpiryQueueKey        = collections.NewPrefix(12)
+	VerificationCreditKey        = collections.NewPrefix(13)
+	VerificationCreditCounterKey = collections.NewPrefix(14)
+	VoucherNonceKey              = collections.NewPrefix(15)
+	PermissionByCountryKey       = collections.NewPrefix(16)
+	VPMessageKey                 = collections.NewPrefix(17)
+	PermissionFeeRecordKey       = collections.NewPrefix(18)
+	FeeUpdateQueueKey            = collections.NewPrefix(19)
+	SlashRecordByPermKey         = collections.NewPrefix(20)
+	SlashRecordByGranteeKey      = collections.NewPrefix(21)
+	SlashRecordBySlasherKey      = collections.NewPrefix(22)
+	UsedVoucherNonceKey          = collections.NewPrefix(23)
 )
 
 func KeyPrefix(p string) []byte {
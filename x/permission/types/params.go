@@ -10,6 +10,11 @@ var _ paramtypes.ParamSet = (*Params)(nil)
 
 const (
 	DefaultValidationTermRequestedTimeoutDays = uint64(7) // 7 days
+	DefaultSlashAppealPeriodDays              = uint64(7) // 7 days
+	DefaultCascadeBatchSize                   = uint64(100)
+	DefaultSessionMaxDurationDays             = uint64(30) // 30 days
+	DefaultSessionMaxAuthz                    = uint64(100)
+	DefaultFeeUpdateNoticePeriodDays          = uint64(30) // 30 days
 )
 
 // ParamKeyTable the param key table for launch module
@@ -20,9 +25,19 @@ func ParamKeyTable() paramtypes.KeyTable {
 // NewParams creates a new Params instance
 func NewParams(
 	validationTermRequestedTimeoutDays uint64,
+	slashAppealPeriodDays uint64,
+	cascadeBatchSize uint64,
+	sessionMaxDurationDays uint64,
+	sessionMaxAuthz uint64,
+	feeUpdateNoticePeriodDays uint64,
 ) Params {
 	return Params{
 		ValidationTermRequestedTimeoutDays: validationTermRequestedTimeoutDays,
+		SlashAppealPeriodDays:              slashAppealPeriodDays,
+		CascadeBatchSize:                   cascadeBatchSize,
+		SessionMaxDurationDays:             sessionMaxDurationDays,
+		SessionMaxAuthz:                    sessionMaxAuthz,
+		FeeUpdateNoticePeriodDays:          feeUpdateNoticePeriodDays,
 	}
 }
 
@@ -30,6 +45,11 @@ func NewParams(
 func DefaultParams() Params {
 	return NewParams(
 		DefaultValidationTermRequestedTimeoutDays,
+		DefaultSlashAppealPeriodDays,
+		DefaultCascadeBatchSize,
+		DefaultSessionMaxDurationDays,
+		DefaultSessionMaxAuthz,
+		DefaultFeeUpdateNoticePeriodDays,
 	)
 }
 
@@ -41,6 +61,31 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 			&p.ValidationTermRequestedTimeoutDays,
 			validatePositiveUint64,
 		),
+		paramtypes.NewParamSetPair(
+			[]byte("SlashAppealPeriodDays"),
+			&p.SlashAppealPeriodDays,
+			validatePositiveUint64,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("CascadeBatchSize"),
+			&p.CascadeBatchSize,
+			validatePositiveUint64,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("SessionMaxDurationDays"),
+			&p.SessionMaxDurationDays,
+			validatePositiveUint64,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("SessionMaxAuthz"),
+			&p.SessionMaxAuthz,
+			validatePositiveUint64,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("FeeUpdateNoticePeriodDays"),
+			&p.FeeUpdateNoticePeriodDays,
+			validatePositiveUint64,
+		),
 	}
 }
 
@@ -49,6 +94,21 @@ func (p Params) Validate() error {
 	if p.ValidationTermRequestedTimeoutDays == 0 {
 		return fmt.Errorf("validation term requested timeout days must be positive")
 	}
+	if p.SlashAppealPeriodDays == 0 {
+		return fmt.Errorf("slash appeal period days must be positive")
+	}
+	if p.CascadeBatchSize == 0 {
+		return fmt.Errorf("cascade batch size must be positive")
+	}
+	if p.SessionMaxDurationDays == 0 {
+		return fmt.Errorf("session max duration days must be positive")
+	}
+	if p.SessionMaxAuthz == 0 {
+		return fmt.Errorf("session max authz must be positive")
+	}
+	if p.FeeUpdateNoticePeriodDays == 0 {
+		return fmt.Errorf("fee update notice period days must be positive")
+	}
 	return nil
 }
 
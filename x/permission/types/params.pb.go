@@ -27,6 +27,19 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params defines the parameters for the module.
 type Params struct {
 	ValidationTermRequestedTimeoutDays uint64 `protobuf:"varint,1,opt,name=validation_term_requested_timeout_days,json=validationTermRequestedTimeoutDays,proto3" json:"validation_term_requested_timeout_days,omitempty"`
+	// slash_appeal_period_days is the number of days a slashed grantee has to
+	// appeal a slash before it is executed
+	SlashAppealPeriodDays uint64 `protobuf:"varint,2,opt,name=slash_appeal_period_days,json=slashAppealPeriodDays,proto3" json:"slash_appeal_period_days,omitempty"`
+	// cascade_batch_size is the maximum number of descendant perms a cascading
+	// revocation processes in the revocation transaction, and then in each block
+	CascadeBatchSize uint64 `protobuf:"varint,3,opt,name=cascade_batch_size,json=cascadeBatchSize,proto3" json:"cascade_batch_size,omitempty"`
+	// session_max_duration_days is the maximum lifetime of a perm session
+	SessionMaxDurationDays uint64 `protobuf:"varint,4,opt,name=session_max_duration_days,json=sessionMaxDurationDays,proto3" json:"session_max_duration_days,omitempty"`
+	// session_max_authz is the maximum number of authz entries of a perm session
+	SessionMaxAuthz uint64 `protobuf:"varint,5,opt,name=session_max_authz,json=sessionMaxAuthz,proto3" json:"session_max_authz,omitempty"`
+	// fee_update_notice_period_days is the number of days after which the fees
+	// set with MsgUpdatePermissionFees take effect
+	FeeUpdateNoticePeriodDays uint64 `protobuf:"varint,6,opt,name=fee_update_notice_period_days,json=feeUpdateNoticePeriodDays,proto3" json:"fee_update_notice_period_days,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -69,6 +82,41 @@ func (m *Params) GetValidationTermRequestedTimeoutDays() uint64 {
 	return 0
 }
 
+func (m *Params) GetSlashAppealPeriodDays() uint64 {
+	if m != nil {
+		return m.SlashAppealPeriodDays
+	}
+	return 0
+}
+
+func (m *Params) GetCascadeBatchSize() uint64 {
+	if m != nil {
+		return m.CascadeBatchSize
+	}
+	return 0
+}
+
+func (m *Params) GetSessionMaxDurationDays() uint64 {
+	if m != nil {
+		return m.SessionMaxDurationDays
+	}
+	return 0
+}
+
+func (m *Params) GetSessionMaxAuthz() uint64 {
+	if m != nil {
+		return m.SessionMaxAuthz
+	}
+	return 0
+}
+
+func (m *Params) GetFeeUpdateNoticePeriodDays() uint64 {
+	if m != nil {
+		return m.FeeUpdateNoticePeriodDays
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "verana.perm.v1.Params")
 }
@@ -76,24 +124,33 @@ func init() {
 func init() { proto.RegisterFile("verana/perm/v1/params.proto", fileDescriptor_c5bb1955e5b4218b) }
 
 var fileDescriptor_c5bb1955e5b4218b = []byte{
-	// 259 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2e, 0x4b, 0x2d, 0x4a,
-	0xcc, 0x4b, 0xd4, 0x2f, 0x48, 0x2d, 0xca, 0xd5, 0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc,
-	0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0x48, 0xea, 0x81, 0x24, 0xf5, 0xca,
-	0x0c, 0xa5, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x89, 0x94, 0x48, 0x7a,
-	0x7e, 0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x44, 0x95, 0x1a, 0x19, 0xb9, 0xd8, 0x02, 0xc0,
-	0x26, 0x09, 0x05, 0x71, 0xa9, 0x95, 0x25, 0xe6, 0x64, 0xa6, 0x24, 0x96, 0x64, 0xe6, 0xe7, 0xc5,
-	0x97, 0xa4, 0x16, 0xe5, 0xc6, 0x17, 0xa5, 0x16, 0x96, 0xa6, 0x16, 0x97, 0xa4, 0xa6, 0xc4, 0x97,
-	0x64, 0xe6, 0xa6, 0xe6, 0x97, 0x96, 0xc4, 0xa7, 0x24, 0x56, 0x16, 0x4b, 0x30, 0x2a, 0x30, 0x6a,
-	0xb0, 0x04, 0x29, 0x21, 0x54, 0x87, 0xa4, 0x16, 0xe5, 0x06, 0xc1, 0xd4, 0x86, 0x40, 0x94, 0xba,
-	0x24, 0x56, 0x16, 0x5b, 0x29, 0xbf, 0x58, 0x20, 0xcf, 0xd8, 0xf5, 0x7c, 0x83, 0x96, 0x14, 0xd4,
-	0xf5, 0x15, 0x60, 0xf7, 0x67, 0x16, 0x17, 0x67, 0xe6, 0xe7, 0xe9, 0x43, 0x2c, 0x76, 0x0a, 0x3d,
-	0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63,
-	0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xeb, 0xf4, 0xcc, 0x92, 0x8c, 0xd2,
-	0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x88, 0x01, 0xba, 0x39, 0x89, 0x49, 0xc5, 0x30, 0x76, 0x52,
-	0x4e, 0x7e, 0x72, 0x76, 0x72, 0x46, 0x62, 0x66, 0x1e, 0xaa, 0xb9, 0x25, 0x95, 0x05, 0xa9, 0xc5,
-	0x49, 0x6c, 0x60, 0x1f, 0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x6b, 0xb6, 0x59, 0xf4, 0x39,
-	0x01, 0x00, 0x00,
+	// 402 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0x63, 0x5a, 0x72, 0xd8, 0x03, 0x50, 0x0b, 0x90, 0x1b, 0x84, 0x41, 0x45, 0x42, 0xa8,
+	0x02, 0x5b, 0x15, 0x07, 0x04, 0x5c, 0x68, 0xd5, 0x2b, 0xa8, 0x0a, 0xed, 0x85, 0xcb, 0x6a, 0x6c,
+	0x4f, 0xe2, 0x15, 0x5e, 0xef, 0xb2, 0xbb, 0xb6, 0x92, 0x3c, 0x02, 0x27, 0x78, 0x03, 0x1e, 0x81,
+	0xc7, 0xe0, 0x98, 0x23, 0x47, 0x94, 0x1c, 0xe0, 0x31, 0x90, 0x67, 0x1d, 0xa5, 0xb9, 0x58, 0xa3,
+	0xfd, 0xfe, 0xff, 0x1f, 0x8f, 0x7e, 0xf6, 0xa0, 0x45, 0x03, 0x35, 0xa4, 0x1a, 0x8d, 0x4c, 0xdb,
+	0x93, 0x54, 0x83, 0x01, 0x69, 0x13, 0x6d, 0x94, 0x53, 0xe1, 0x2d, 0x0f, 0x93, 0x0e, 0x26, 0xed,
+	0xc9, 0xe8, 0x00, 0xa4, 0xa8, 0x55, 0x4a, 0x5f, 0x2f, 0x19, 0xdd, 0x9d, 0xaa, 0xa9, 0xa2, 0x31,
+	0xed, 0x26, 0xff, 0x7a, 0xf4, 0x7d, 0x8f, 0x0d, 0x2f, 0x28, 0x29, 0x1c, 0xb3, 0xa7, 0x2d, 0x54,
+	0xa2, 0x00, 0x27, 0x54, 0xcd, 0x1d, 0x1a, 0xc9, 0x0d, 0x7e, 0x69, 0xd0, 0x3a, 0x2c, 0xb8, 0x13,
+	0x12, 0x55, 0xe3, 0x78, 0x01, 0x73, 0x1b, 0x05, 0x8f, 0x83, 0x67, 0xfb, 0xe3, 0xa3, 0xad, 0xfa,
+	0x12, 0x8d, 0x1c, 0x6f, 0xb4, 0x97, 0x5e, 0x7a, 0x0e, 0x73, 0x1b, 0xbe, 0x62, 0x91, 0xad, 0xc0,
+	0x96, 0x1c, 0xb4, 0x46, 0xa8, 0xb8, 0x46, 0x23, 0x54, 0xe1, 0x53, 0x6e, 0x50, 0xca, 0x3d, 0xe2,
+	0xa7, 0x84, 0x2f, 0x88, 0x92, 0xf1, 0x39, 0x0b, 0x73, 0xb0, 0x39, 0x14, 0xc8, 0x33, 0x70, 0x79,
+	0xc9, 0xad, 0x58, 0x60, 0xb4, 0x47, 0x96, 0x3b, 0x3d, 0x39, 0xeb, 0xc0, 0x47, 0xb1, 0xc0, 0xf0,
+	0x35, 0x3b, 0xb4, 0x68, 0x6d, 0xf7, 0xdf, 0x12, 0x66, 0xbc, 0x68, 0x8c, 0x3f, 0x82, 0xf6, 0xec,
+	0x93, 0xe9, 0x7e, 0x2f, 0x78, 0x0f, 0xb3, 0xf3, 0x1e, 0xd3, 0xa2, 0x63, 0x76, 0x70, 0xdd, 0x0a,
+	0x8d, 0x2b, 0x17, 0xd1, 0x4d, 0xb2, 0xdc, 0xde, 0x5a, 0x4e, 0xbb, 0xe7, 0xf0, 0x1d, 0x7b, 0x38,
+	0x41, 0xe4, 0x8d, 0x2e, 0xc0, 0x21, 0xaf, 0x95, 0x13, 0x39, 0xee, 0x9c, 0x34, 0x24, 0xdf, 0xe1,
+	0x04, 0xf1, 0x8a, 0x34, 0x1f, 0x48, 0xb2, 0x3d, 0xeb, 0xcd, 0x93, 0x7f, 0x3f, 0x1e, 0x05, 0x5f,
+	0xff, 0xfe, 0x3c, 0x1e, 0xf5, 0x6d, 0xce, 0xa8, 0x4f, 0x41, 0x7b, 0x52, 0x5f, 0xc4, 0xd9, 0xd5,
+	0xaf, 0x55, 0x1c, 0x2c, 0x57, 0x71, 0xf0, 0x67, 0x15, 0x07, 0xdf, 0xd6, 0xf1, 0x60, 0xb9, 0x8e,
+	0x07, 0xbf, 0xd7, 0xf1, 0xe0, 0xd3, 0xdb, 0xa9, 0x70, 0x65, 0x93, 0x25, 0xb9, 0x92, 0xa9, 0x0f,
+	0x78, 0x51, 0x41, 0x66, 0x37, 0x73, 0x56, 0xa9, 0xfc, 0x73, 0x5e, 0x82, 0xa8, 0x77, 0x73, 0xdd,
+	0x5c, 0xa3, 0xcd, 0x86, 0xd4, 0xf8, 0xcb, 0xff, 0x03, 0x00, 0xc1, 0x27, 0x0e, 0xf5, 0x49, 0x02,
+	0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -118,6 +175,21 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.ValidationTermRequestedTimeoutDays != that1.ValidationTermRequestedTimeoutDays {
 		return false
 	}
+	if this.SlashAppealPeriodDays != that1.SlashAppealPeriodDays {
+		return false
+	}
+	if this.CascadeBatchSize != that1.CascadeBatchSize {
+		return false
+	}
+	if this.SessionMaxDurationDays != that1.SessionMaxDurationDays {
+		return false
+	}
+	if this.SessionMaxAuthz != that1.SessionMaxAuthz {
+		return false
+	}
+	if this.FeeUpdateNoticePeriodDays != that1.FeeUpdateNoticePeriodDays {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -140,6 +212,31 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FeeUpdateNoticePeriodDays != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FeeUpdateNoticePeriodDays))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SessionMaxAuthz != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SessionMaxAuthz))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SessionMaxDurationDays != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SessionMaxDurationDays))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CascadeBatchSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CascadeBatchSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SlashAppealPeriodDays != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SlashAppealPeriodDays))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.ValidationTermRequestedTimeoutDays != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.ValidationTermRequestedTimeoutDays))
 		i--
@@ -168,6 +265,21 @@ func (m *Params) Size() (n int) {
 	if m.ValidationTermRequestedTimeoutDays != 0 {
 		n += 1 + sovParams(uint64(m.ValidationTermRequestedTimeoutDays))
 	}
+	if m.SlashAppealPeriodDays != 0 {
+		n += 1 + sovParams(uint64(m.SlashAppealPeriodDays))
+	}
+	if m.CascadeBatchSize != 0 {
+		n += 1 + sovParams(uint64(m.CascadeBatchSize))
+	}
+	if m.SessionMaxDurationDays != 0 {
+		n += 1 + sovParams(uint64(m.SessionMaxDurationDays))
+	}
+	if m.SessionMaxAuthz != 0 {
+		n += 1 + sovParams(uint64(m.SessionMaxAuthz))
+	}
+	if m.FeeUpdateNoticePeriodDays != 0 {
+		n += 1 + sovParams(uint64(m.FeeUpdateNoticePeriodDays))
+	}
 	return n
 }
 
@@ -225,6 +337,101 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashAppealPeriodDays", wireType)
+			}
+			m.SlashAppealPeriodDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashAppealPeriodDays |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CascadeBatchSize", wireType)
+			}
+			m.CascadeBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CascadeBatchSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionMaxDurationDays", wireType)
+			}
+			m.SessionMaxDurationDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionMaxDurationDays |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionMaxAuthz", wireType)
+			}
+			m.SessionMaxAuthz = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionMaxAuthz |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeUpdateNoticePeriodDays", wireType)
+			}
+			m.FeeUpdateNoticePeriodDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeUpdateNoticePeriodDays |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -393,6 +393,10 @@ func (m *QueryGetPermissionSessionResponse) GetSession() *PermissionSession {
 type QueryListPermissionSessionsRequest struct {
 	ModifiedAfter   *time.Time `protobuf:"bytes,1,opt,name=modified_after,json=modifiedAfter,proto3,stdtime" json:"modified_after,omitempty"`
 	ResponseMaxSize uint32     `protobuf:"varint,2,opt,name=response_max_size,json=responseMaxSize,proto3" json:"response_max_size,omitempty"`
+	// controller, if set, only returns the sessions of this controller
+	Controller string `protobuf:"bytes,3,opt,name=controller,proto3" json:"controller,omitempty"`
+	// agent_perm_id, if set, only returns the sessions of this agent perm
+	AgentPermId uint64 `protobuf:"varint,4,opt,name=agent_perm_id,json=agentPermId,proto3" json:"agent_perm_id,omitempty"`
 }
 
 func (m *QueryListPermissionSessionsRequest) Reset()         { *m = QueryListPermissionSessionsRequest{} }
@@ -442,6 +446,20 @@ func (m *QueryListPermissionSessionsRequest) GetResponseMaxSize() uint32 {
 	return 0
 }
 
+func (m *QueryListPermissionSessionsRequest) GetController() string {
+	if m != nil {
+		return m.Controller
+	}
+	return ""
+}
+
+func (m *QueryListPermissionSessionsRequest) GetAgentPermId() uint64 {
+	if m != nil {
+		return m.AgentPermId
+	}
+	return 0
+}
+
 type QueryListPermissionSessionsResponse struct {
 	Sessions []PermissionSession `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions"`
 }
@@ -606,6 +624,119 @@ func (m *QueryFindPermissionsWithDIDResponse) GetPermissions() []Permission {
 	return nil
 }
 
+type QueryListPermissionsByCountryRequest struct {
+	// country is an ISO 3166-1 alpha-2 or ISO 3166-2 subdivision code
+	Country         string         `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty"`
+	SchemaId        uint64         `protobuf:"varint,2,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
+	Type            PermissionType `protobuf:"varint,3,opt,name=type,proto3,enum=verana.perm.v1.PermissionType" json:"type,omitempty"`
+	ResponseMaxSize uint32         `protobuf:"varint,4,opt,name=response_max_size,json=responseMaxSize,proto3" json:"response_max_size,omitempty"`
+}
+
+func (m *QueryListPermissionsByCountryRequest) Reset()         { *m = QueryListPermissionsByCountryRequest{} }
+func (m *QueryListPermissionsByCountryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListPermissionsByCountryRequest) ProtoMessage()    {}
+func (*QueryListPermissionsByCountryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{12}
+}
+func (m *QueryListPermissionsByCountryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListPermissionsByCountryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListPermissionsByCountryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListPermissionsByCountryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListPermissionsByCountryRequest.Merge(m, src)
+}
+func (m *QueryListPermissionsByCountryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListPermissionsByCountryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListPermissionsByCountryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListPermissionsByCountryRequest proto.InternalMessageInfo
+
+func (m *QueryListPermissionsByCountryRequest) GetCountry() string {
+	if m != nil {
+		return m.Country
+	}
+	return ""
+}
+
+func (m *QueryListPermissionsByCountryRequest) GetSchemaId() uint64 {
+	if m != nil {
+		return m.SchemaId
+	}
+	return 0
+}
+
+func (m *QueryListPermissionsByCountryRequest) GetType() PermissionType {
+	if m != nil {
+		return m.Type
+	}
+	return PermissionType_PERMISSION_TYPE_UNSPECIFIED
+}
+
+func (m *QueryListPermissionsByCountryRequest) GetResponseMaxSize() uint32 {
+	if m != nil {
+		return m.ResponseMaxSize
+	}
+	return 0
+}
+
+type QueryListPermissionsByCountryResponse struct {
+	Permissions []Permission `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions"`
+}
+
+func (m *QueryListPermissionsByCountryResponse) Reset()         { *m = QueryListPermissionsByCountryResponse{} }
+func (m *QueryListPermissionsByCountryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListPermissionsByCountryResponse) ProtoMessage()    {}
+func (*QueryListPermissionsByCountryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{13}
+}
+func (m *QueryListPermissionsByCountryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListPermissionsByCountryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListPermissionsByCountryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListPermissionsByCountryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListPermissionsByCountryResponse.Merge(m, src)
+}
+func (m *QueryListPermissionsByCountryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListPermissionsByCountryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListPermissionsByCountryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListPermissionsByCountryResponse proto.InternalMessageInfo
+
+func (m *QueryListPermissionsByCountryResponse) GetPermissions() []Permission {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
 type QueryFindBeneficiariesRequest struct {
 	IssuerPermId   uint64 `protobuf:"varint,1,opt,name=issuer_perm_id,json=issuerPermId,proto3" json:"issuer_perm_id,omitempty"`
 	VerifierPermId uint64 `protobuf:"varint,2,opt,name=verifier_perm_id,json=verifierPermId,proto3" json:"verifier_perm_id,omitempty"`
@@ -615,7 +746,7 @@ func (m *QueryFindBeneficiariesRequest) Reset()         { *m = QueryFindBenefici
 func (m *QueryFindBeneficiariesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFindBeneficiariesRequest) ProtoMessage()    {}
 func (*QueryFindBeneficiariesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a1619f447f3af85e, []int{12}
+	return fileDescriptor_a1619f447f3af85e, []int{14}
 }
 func (m *QueryFindBeneficiariesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -666,7 +797,7 @@ func (m *QueryFindBeneficiariesResponse) Reset()         { *m = QueryFindBenefic
 func (m *QueryFindBeneficiariesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFindBeneficiariesResponse) ProtoMessage()    {}
 func (*QueryFindBeneficiariesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a1619f447f3af85e, []int{13}
+	return fileDescriptor_a1619f447f3af85e, []int{15}
 }
 func (m *QueryFindBeneficiariesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -702,1104 +833,11027 @@ func (m *QueryFindBeneficiariesResponse) GetPermissions() []Permission {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "verana.perm.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "verana.perm.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryListPermissionsRequest)(nil), "verana.perm.v1.QueryListPermissionsRequest")
-	proto.RegisterType((*QueryListPermissionsResponse)(nil), "verana.perm.v1.QueryListPermissionsResponse")
-	proto.RegisterType((*QueryGetPermissionRequest)(nil), "verana.perm.v1.QueryGetPermissionRequest")
-	proto.RegisterType((*QueryGetPermissionResponse)(nil), "verana.perm.v1.QueryGetPermissionResponse")
-	proto.RegisterType((*QueryGetPermissionSessionRequest)(nil), "verana.perm.v1.QueryGetPermissionSessionRequest")
-	proto.RegisterType((*QueryGetPermissionSessionResponse)(nil), "verana.perm.v1.QueryGetPermissionSessionResponse")
-	proto.RegisterType((*QueryListPermissionSessionsRequest)(nil), "verana.perm.v1.QueryListPermissionSessionsRequest")
-	proto.RegisterType((*QueryListPermissionSessionsResponse)(nil), "verana.perm.v1.QueryListPermissionSessionsResponse")
-	proto.RegisterType((*QueryFindPermissionsWithDIDRequest)(nil), "verana.perm.v1.QueryFindPermissionsWithDIDRequest")
-	proto.RegisterType((*QueryFindPermissionsWithDIDResponse)(nil), "verana.perm.v1.QueryFindPermissionsWithDIDResponse")
-	proto.RegisterType((*QueryFindBeneficiariesRequest)(nil), "verana.perm.v1.QueryFindBeneficiariesRequest")
-	proto.RegisterType((*QueryFindBeneficiariesResponse)(nil), "verana.perm.v1.QueryFindBeneficiariesResponse")
+type QueryGetSessionAllowanceRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func init() { proto.RegisterFile("verana/perm/v1/query.proto", fileDescriptor_a1619f447f3af85e) }
-
-var fileDescriptor_a1619f447f3af85e = []byte{
-	// 940 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x96, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x33, 0x8e, 0x9b, 0x26, 0xaf, 0xc4, 0x6d, 0x86, 0xc8, 0x32, 0x9b, 0x74, 0xed, 0x6e,
-	0x8b, 0x14, 0x52, 0xba, 0x8b, 0xd3, 0x0b, 0xa8, 0x17, 0x30, 0x15, 0x28, 0x02, 0xa4, 0xe0, 0x82,
-	0x90, 0x38, 0xb0, 0xcc, 0x7a, 0xc7, 0xf6, 0x40, 0x76, 0x67, 0xb3, 0xb3, 0x76, 0x93, 0x22, 0x2e,
-	0x9c, 0x10, 0xa7, 0x4a, 0x48, 0x48, 0x1c, 0x38, 0x22, 0x2a, 0x71, 0xe1, 0x2b, 0x70, 0xcb, 0xb1,
-	0x12, 0x17, 0x4e, 0x05, 0x25, 0x48, 0x7c, 0x0d, 0xb4, 0xb3, 0x33, 0x8e, 0xb3, 0x59, 0x3b, 0x8e,
-	0x54, 0x89, 0x4b, 0x34, 0x99, 0xf9, 0xbf, 0xf7, 0x7e, 0xef, 0xcd, 0xec, 0x3f, 0x01, 0x63, 0x48,
-	0x63, 0x12, 0x12, 0x27, 0xa2, 0x71, 0xe0, 0x0c, 0x9b, 0xce, 0xde, 0x80, 0xc6, 0x07, 0x76, 0x14,
-	0xf3, 0x84, 0xe3, 0x4a, 0x76, 0x66, 0xa7, 0x67, 0xf6, 0xb0, 0x69, 0xac, 0x90, 0x80, 0x85, 0xdc,
-	0x91, 0x3f, 0x33, 0x89, 0xb1, 0xda, 0xe3, 0x3d, 0x2e, 0x97, 0x4e, 0xba, 0x52, 0xbb, 0xeb, 0x3d,
-	0xce, 0x7b, 0xbb, 0xd4, 0x21, 0x11, 0x73, 0x48, 0x18, 0xf2, 0x84, 0x24, 0x8c, 0x87, 0x42, 0x9d,
-	0x6e, 0x76, 0xb8, 0x08, 0xb8, 0x70, 0x3c, 0x22, 0x68, 0x56, 0xcf, 0x19, 0x36, 0x3d, 0x9a, 0x90,
-	0xa6, 0x13, 0x91, 0x1e, 0x0b, 0xa5, 0x58, 0x69, 0xd7, 0x72, 0x78, 0x11, 0x89, 0x49, 0xa0, 0x13,
-	0xe5, 0xd9, 0x93, 0x83, 0x88, 0xea, 0xb3, 0xba, 0x42, 0x90, 0xbf, 0x79, 0x83, 0xae, 0x93, 0xb0,
-	0x80, 0x8a, 0x84, 0x04, 0x51, 0x26, 0xb0, 0x56, 0x01, 0x7f, 0x98, 0xd6, 0xde, 0x91, 0x19, 0xdb,
-	0x74, 0x6f, 0x40, 0x45, 0x62, 0xed, 0xc0, 0x8b, 0xa7, 0x76, 0x45, 0xc4, 0x43, 0x41, 0xf1, 0x1b,
-	0xb0, 0x90, 0x55, 0xae, 0xa1, 0x06, 0xda, 0xb8, 0xb2, 0x55, 0xb5, 0x4f, 0x8f, 0xc6, 0xce, 0xf4,
-	0xad, 0xa5, 0xc3, 0x67, 0xf5, 0xb9, 0x27, 0xff, 0xfe, 0xb6, 0x89, 0xda, 0x2a, 0xc0, 0xfa, 0x01,
-	0xc1, 0x9a, 0x4c, 0xf9, 0x3e, 0x13, 0xc9, 0x0e, 0x8d, 0x03, 0x26, 0x44, 0x3a, 0x0c, 0x55, 0x11,
-	0xbf, 0x07, 0x95, 0x80, 0xfb, 0xac, 0xcb, 0xa8, 0xef, 0x92, 0x6e, 0x42, 0x63, 0x55, 0xc2, 0xb0,
-	0xb3, 0x0e, 0x6c, 0xdd, 0x81, 0xfd, 0x91, 0xee, 0xa0, 0xb5, 0x78, 0xf8, 0xac, 0x8e, 0x1e, 0xff,
-	0x55, 0x47, 0xed, 0x65, 0x1d, 0xfb, 0x56, 0x1a, 0x8a, 0x37, 0x61, 0x25, 0x56, 0xcc, 0x6e, 0x40,
-	0xf6, 0x5d, 0xc1, 0x1e, 0xd1, 0x5a, 0xa9, 0x81, 0x36, 0x96, 0xdb, 0x57, 0xf5, 0xc1, 0x07, 0x64,
-	0xff, 0x01, 0x7b, 0x44, 0x2d, 0x0f, 0xd6, 0x8b, 0xb9, 0x54, 0xcf, 0x2d, 0xb8, 0x12, 0x9d, 0x6c,
-	0xd7, 0x50, 0x63, 0x5e, 0x52, 0xe5, 0x1b, 0x1f, 0x49, 0x5a, 0xe5, 0xb4, 0xf9, 0xf6, 0x78, 0x90,
-	0x75, 0x1b, 0x5e, 0x92, 0x35, 0xde, 0xa5, 0x63, 0x25, 0x74, 0xe7, 0x15, 0x28, 0x31, 0x5f, 0x76,
-	0x5b, 0x6e, 0x97, 0x98, 0x6f, 0x7d, 0x06, 0x46, 0x91, 0x58, 0xe1, 0xbc, 0x09, 0x70, 0x92, 0x79,
-	0x34, 0xa3, 0xf3, 0x68, 0xc6, 0x62, 0xac, 0x2d, 0x68, 0x9c, 0xcd, 0xff, 0x80, 0x4e, 0x60, 0x5a,
-	0x92, 0x4c, 0x9f, 0xc3, 0x8d, 0x29, 0x31, 0x0a, 0xed, 0x1e, 0x5c, 0x16, 0x74, 0x9c, 0xeb, 0xc6,
-	0x64, 0x2e, 0x1d, 0xab, 0x23, 0xac, 0x9f, 0x10, 0x58, 0x05, 0xf7, 0xa0, 0x74, 0xff, 0xff, 0x33,
-	0xf9, 0x02, 0x6e, 0x4e, 0xc5, 0x53, 0x33, 0x78, 0x1b, 0x16, 0x55, 0x47, 0xfa, 0xa9, 0x9c, 0x3f,
-	0x04, 0x75, 0x47, 0xa3, 0x40, 0xeb, 0x77, 0x3d, 0x8b, 0x77, 0x58, 0xe8, 0x8f, 0xbd, 0xc9, 0x4f,
-	0x58, 0xd2, 0xbf, 0xbf, 0x7d, 0x5f, 0xcf, 0xe2, 0x1a, 0xcc, 0xfb, 0xa3, 0x5b, 0x4a, 0x97, 0x18,
-	0x43, 0x39, 0xfd, 0xf8, 0x55, 0x0f, 0x72, 0x8d, 0xd7, 0x60, 0x49, 0x74, 0xfa, 0x34, 0x20, 0x2e,
-	0xf3, 0x6b, 0xf3, 0xf2, 0x95, 0x2d, 0x66, 0x1b, 0xdb, 0x3e, 0x36, 0xe1, 0x72, 0x87, 0x0f, 0xc2,
-	0x24, 0x3e, 0xa8, 0x95, 0xd3, 0x34, 0x12, 0x05, 0xb5, 0xf5, 0x26, 0x7e, 0x1d, 0xca, 0x0f, 0xfb,
-	0x34, 0xac, 0x5d, 0xba, 0xc0, 0x90, 0x65, 0x84, 0xc5, 0xd4, 0xbc, 0x26, 0xb5, 0xf0, 0x1c, 0xbf,
-	0x2e, 0x0e, 0xd7, 0x47, 0xa5, 0x5a, 0x34, 0xa4, 0x5d, 0xd6, 0x61, 0x24, 0x66, 0x74, 0xf4, 0x68,
-	0x6e, 0x41, 0x85, 0x09, 0x31, 0xa0, 0xb1, 0x9b, 0x86, 0xb9, 0xa3, 0xaf, 0xed, 0x85, 0x6c, 0x37,
-	0xcd, 0xbe, 0xed, 0xe3, 0x0d, 0xb8, 0x36, 0xa4, 0x71, 0xfa, 0x3c, 0x4e, 0x74, 0x25, 0xa9, 0xab,
-	0xe8, 0xfd, 0x4c, 0x69, 0xf9, 0x60, 0x4e, 0x2a, 0xf8, 0xfc, 0xda, 0xda, 0xfa, 0x75, 0x11, 0x2e,
-	0xc9, 0x32, 0x78, 0x0f, 0x16, 0x32, 0x63, 0xc5, 0x56, 0x3e, 0xc5, 0x59, 0xef, 0x36, 0x6e, 0x4e,
-	0xd5, 0x64, 0x80, 0x96, 0xf9, 0xcd, 0x1f, 0xff, 0x7c, 0x5f, 0xaa, 0xe1, 0xaa, 0x53, 0xf8, 0x97,
-	0x05, 0x7f, 0x87, 0xe0, 0x6a, 0xce, 0x11, 0xf1, 0xed, 0xc2, 0xc4, 0xc5, 0x7e, 0x6e, 0xbc, 0x3a,
-	0x9b, 0x58, 0xe1, 0xac, 0x4b, 0x9c, 0x2a, 0x5e, 0xcd, 0xe3, 0xec, 0x32, 0x91, 0xe0, 0x6f, 0x11,
-	0x2c, 0x9f, 0x72, 0x1e, 0xfc, 0x4a, 0x61, 0xf6, 0x22, 0x7b, 0x35, 0x36, 0x67, 0x91, 0x2a, 0x8c,
-	0x86, 0xc4, 0x30, 0x70, 0x2d, 0x8f, 0xd1, 0xa3, 0x89, 0xf3, 0x15, 0xf3, 0xbf, 0xc6, 0x3f, 0x23,
-	0x58, 0x2d, 0x32, 0x41, 0xfc, 0xda, 0xf9, 0x65, 0x4e, 0x7b, 0xac, 0xd1, 0xbc, 0x40, 0x84, 0xe2,
-	0xdb, 0x90, 0x7c, 0x16, 0x6e, 0x14, 0xf0, 0xb9, 0xca, 0x3e, 0x32, 0xce, 0x5f, 0x10, 0x54, 0x8b,
-	0xad, 0x0a, 0x6f, 0xcd, 0x70, 0x33, 0x39, 0xdb, 0x35, 0xee, 0x5e, 0x28, 0x46, 0xd1, 0xde, 0x92,
-	0xb4, 0x26, 0x5e, 0x2f, 0xba, 0x54, 0x8d, 0x8b, 0x9f, 0x20, 0xa8, 0x16, 0x9b, 0xc4, 0x04, 0xd2,
-	0xa9, 0xa6, 0x38, 0x81, 0x74, 0xba, 0x0b, 0x59, 0x2f, 0x4b, 0xd2, 0x3a, 0xbe, 0x9e, 0x27, 0xed,
-	0xb2, 0xd0, 0x77, 0x1f, 0xb2, 0xa4, 0xef, 0xa6, 0xf6, 0xfa, 0x23, 0x82, 0x95, 0x33, 0xdf, 0x3c,
-	0xbe, 0x33, 0xb1, 0x62, 0x91, 0x19, 0x19, 0xf6, 0xac, 0xf2, 0xf3, 0xd8, 0xbc, 0x71, 0x79, 0xeb,
-	0xe3, 0xc3, 0x23, 0x13, 0x3d, 0x3d, 0x32, 0xd1, 0xdf, 0x47, 0x26, 0x7a, 0x7c, 0x6c, 0xce, 0x3d,
-	0x3d, 0x36, 0xe7, 0xfe, 0x3c, 0x36, 0xe7, 0x3e, 0xbd, 0xd7, 0x63, 0x49, 0x7f, 0xe0, 0xd9, 0x1d,
-	0x1e, 0xa8, 0x14, 0x77, 0x76, 0x89, 0x27, 0xf4, 0xda, 0xdb, 0xe5, 0x9d, 0x2f, 0x3b, 0x7d, 0xc2,
-	0x42, 0x67, 0xdf, 0x39, 0xf1, 0x9f, 0xec, 0xbf, 0x48, 0x6f, 0x41, 0x5a, 0xfd, 0xdd, 0xff, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0x03, 0x9a, 0xc4, 0x5a, 0x20, 0x0b, 0x00, 0x00,
+func (m *QueryGetSessionAllowanceRequest) Reset()         { *m = QueryGetSessionAllowanceRequest{} }
+func (m *QueryGetSessionAllowanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSessionAllowanceRequest) ProtoMessage()    {}
+func (*QueryGetSessionAllowanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{16}
+}
+func (m *QueryGetSessionAllowanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetSessionAllowanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSessionAllowanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetSessionAllowanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSessionAllowanceRequest.Merge(m, src)
+}
+func (m *QueryGetSessionAllowanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSessionAllowanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSessionAllowanceRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryGetSessionAllowanceRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	ListPermissions(ctx context.Context, in *QueryListPermissionsRequest, opts ...grpc.CallOption) (*QueryListPermissionsResponse, error)
-	GetPermission(ctx context.Context, in *QueryGetPermissionRequest, opts ...grpc.CallOption) (*QueryGetPermissionResponse, error)
-	GetPermissionSession(ctx context.Context, in *QueryGetPermissionSessionRequest, opts ...grpc.CallOption) (*QueryGetPermissionSessionResponse, error)
-	ListPermissionSessions(ctx context.Context, in *QueryListPermissionSessionsRequest, opts ...grpc.CallOption) (*QueryListPermissionSessionsResponse, error)
-	FindPermissionsWithDID(ctx context.Context, in *QueryFindPermissionsWithDIDRequest, opts ...grpc.CallOption) (*QueryFindPermissionsWithDIDResponse, error)
-	FindBeneficiaries(ctx context.Context, in *QueryFindBeneficiariesRequest, opts ...grpc.CallOption) (*QueryFindBeneficiariesResponse, error)
+func (m *QueryGetSessionAllowanceRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryGetSessionAllowanceResponse struct {
+	Allowance SessionAllowance `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryGetSessionAllowanceResponse) Reset()         { *m = QueryGetSessionAllowanceResponse{} }
+func (m *QueryGetSessionAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSessionAllowanceResponse) ProtoMessage()    {}
+func (*QueryGetSessionAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{17}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/verana.perm.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetSessionAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetSessionAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSessionAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetSessionAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSessionAllowanceResponse.Merge(m, src)
+}
+func (m *QueryGetSessionAllowanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSessionAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSessionAllowanceResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ListPermissions(ctx context.Context, in *QueryListPermissionsRequest, opts ...grpc.CallOption) (*QueryListPermissionsResponse, error) {
-	out := new(QueryListPermissionsResponse)
-	err := c.cc.Invoke(ctx, "/verana.perm.v1.Query/ListPermissions", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetSessionAllowanceResponse proto.InternalMessageInfo
+
+func (m *QueryGetSessionAllowanceResponse) GetAllowance() SessionAllowance {
+	if m != nil {
+		return m.Allowance
 	}
-	return out, nil
+	return SessionAllowance{}
 }
 
-func (c *queryClient) GetPermission(ctx context.Context, in *QueryGetPermissionRequest, opts ...grpc.CallOption) (*QueryGetPermissionResponse, error) {
-	out := new(QueryGetPermissionResponse)
-	err := c.cc.Invoke(ctx, "/verana.perm.v1.Query/GetPermission", in, out, opts...)
-	if err != nil {
-		return nil, err
+type QueryListSessionAllowancesRequest struct {
+	Sponsor         string `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	SchemaId        uint64 `protobuf:"varint,2,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
+	AgentPermId     uint64 `protobuf:"varint,3,opt,name=agent_perm_id,json=agentPermId,proto3" json:"agent_perm_id,omitempty"`
+	VerifierPermId  uint64 `protobuf:"varint,4,opt,name=verifier_perm_id,json=verifierPermId,proto3" json:"verifier_perm_id,omitempty"`
+	ResponseMaxSize uint32 `protobuf:"varint,5,opt,name=response_max_size,json=responseMaxSize,proto3" json:"response_max_size,omitempty"`
+}
+
+func (m *QueryListSessionAllowancesRequest) Reset()         { *m = QueryListSessionAllowancesRequest{} }
+func (m *QueryListSessionAllowancesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListSessionAllowancesRequest) ProtoMessage()    {}
+func (*QueryListSessionAllowancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{18}
+}
+func (m *QueryListSessionAllowancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListSessionAllowancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListSessionAllowancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryListSessionAllowancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListSessionAllowancesRequest.Merge(m, src)
+}
+func (m *QueryListSessionAllowancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListSessionAllowancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListSessionAllowancesRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetPermissionSession(ctx context.Context, in *QueryGetPermissionSessionRequest, opts ...grpc.CallOption) (*QueryGetPermissionSessionResponse, error) {
-	out := new(QueryGetPermissionSessionResponse)
-	err := c.cc.Invoke(ctx, "/verana.perm.v1.Query/GetPermissionSession", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryListSessionAllowancesRequest proto.InternalMessageInfo
+
+func (m *QueryListSessionAllowancesRequest) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) ListPermissionSessions(ctx context.Context, in *QueryListPermissionSessionsRequest, opts ...grpc.CallOption) (*QueryListPermissionSessionsResponse, error) {
-	out := new(QueryListPermissionSessionsResponse)
-	err := c.cc.Invoke(ctx, "/verana.perm.v1.Query/ListPermissionSessions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryListSessionAllowancesRequest) GetSchemaId() uint64 {
+	if m != nil {
+		return m.SchemaId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) FindPermissionsWithDID(ctx context.Context, in *QueryFindPermissionsWithDIDRequest, opts ...grpc.CallOption) (*QueryFindPermissionsWithDIDResponse, error) {
-	out := new(QueryFindPermissionsWithDIDResponse)
-	err := c.cc.Invoke(ctx, "/verana.perm.v1.Query/FindPermissionsWithDID", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryListSessionAllowancesRequest) GetAgentPermId() uint64 {
+	if m != nil {
+		return m.AgentPermId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) FindBeneficiaries(ctx context.Context, in *QueryFindBeneficiariesRequest, opts ...grpc.CallOption) (*QueryFindBeneficiariesResponse, error) {
-	out := new(QueryFindBeneficiariesResponse)
-	err := c.cc.Invoke(ctx, "/verana.perm.v1.Query/FindBeneficiaries", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryListSessionAllowancesRequest) GetVerifierPermId() uint64 {
+	if m != nil {
+		return m.VerifierPermId
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	ListPermissions(context.Context, *QueryListPermissionsRequest) (*QueryListPermissionsResponse, error)
-	GetPermission(context.Context, *QueryGetPermissionRequest) (*QueryGetPermissionResponse, error)
-	GetPermissionSession(context.Context, *QueryGetPermissionSessionRequest) (*QueryGetPermissionSessionResponse, error)
-	ListPermissionSessions(context.Context, *QueryListPermissionSessionsRequest) (*QueryListPermissionSessionsResponse, error)
-	FindPermissionsWithDID(context.Context, *QueryFindPermissionsWithDIDRequest) (*QueryFindPermissionsWithDIDResponse, error)
-	FindBeneficiaries(context.Context, *QueryFindBeneficiariesRequest) (*QueryFindBeneficiariesResponse, error)
+func (m *QueryListSessionAllowancesRequest) GetResponseMaxSize() uint32 {
+	if m != nil {
+		return m.ResponseMaxSize
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryListSessionAllowancesResponse struct {
+	Allowances []SessionAllowance `protobuf:"bytes,1,rep,name=allowances,proto3" json:"allowances"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryListSessionAllowancesResponse) Reset()         { *m = QueryListSessionAllowancesResponse{} }
+func (m *QueryListSessionAllowancesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListSessionAllowancesResponse) ProtoMessage()    {}
+func (*QueryListSessionAllowancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{19}
 }
-func (*UnimplementedQueryServer) ListPermissions(ctx context.Context, req *QueryListPermissionsRequest) (*QueryListPermissionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListPermissions not implemented")
+func (m *QueryListSessionAllowancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetPermission(ctx context.Context, req *QueryGetPermissionRequest) (*QueryGetPermissionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPermission not implemented")
-}
-func (*UnimplementedQueryServer) GetPermissionSession(ctx context.Context, req *QueryGetPermissionSessionRequest) (*QueryGetPermissionSessionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPermissionSession not implemented")
+func (m *QueryListSessionAllowancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListSessionAllowancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ListPermissionSessions(ctx context.Context, req *QueryListPermissionSessionsRequest) (*QueryListPermissionSessionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListPermissionSessions not implemented")
+func (m *QueryListSessionAllowancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListSessionAllowancesResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) FindPermissionsWithDID(ctx context.Context, req *QueryFindPermissionsWithDIDRequest) (*QueryFindPermissionsWithDIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FindPermissionsWithDID not implemented")
+func (m *QueryListSessionAllowancesResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) FindBeneficiaries(ctx context.Context, req *QueryFindBeneficiariesRequest) (*QueryFindBeneficiariesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FindBeneficiaries not implemented")
+func (m *QueryListSessionAllowancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListSessionAllowancesResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryListSessionAllowancesResponse proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.perm.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryListSessionAllowancesResponse) GetAllowances() []SessionAllowance {
+	if m != nil {
+		return m.Allowances
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ListPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListPermissionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListPermissions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.perm.v1.Query/ListPermissions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListPermissions(ctx, req.(*QueryListPermissionsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryGetSlashRecordRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func _Query_GetPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetPermissionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetPermission(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.perm.v1.Query/GetPermission",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetPermission(ctx, req.(*QueryGetPermissionRequest))
+func (m *QueryGetSlashRecordRequest) Reset()         { *m = QueryGetSlashRecordRequest{} }
+func (m *QueryGetSlashRecordRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSlashRecordRequest) ProtoMessage()    {}
+func (*QueryGetSlashRecordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{20}
+}
+func (m *QueryGetSlashRecordRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetSlashRecordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSlashRecordRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetSlashRecordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSlashRecordRequest.Merge(m, src)
+}
+func (m *QueryGetSlashRecordRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSlashRecordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSlashRecordRequest.DiscardUnknown(m)
 }
 
-func _Query_GetPermissionSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetPermissionSessionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetPermissionSession(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.perm.v1.Query/GetPermissionSession",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetPermissionSession(ctx, req.(*QueryGetPermissionSessionRequest))
+var xxx_messageInfo_QueryGetSlashRecordRequest proto.InternalMessageInfo
+
+func (m *QueryGetSlashRecordRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_ListPermissionSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListPermissionSessionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListPermissionSessions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.perm.v1.Query/ListPermissionSessions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListPermissionSessions(ctx, req.(*QueryListPermissionSessionsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryGetSlashRecordResponse struct {
+	SlashRecord SlashRecord `protobuf:"bytes,1,opt,name=slash_record,json=slashRecord,proto3" json:"slash_record"`
 }
 
-func _Query_FindPermissionsWithDID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFindPermissionsWithDIDRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FindPermissionsWithDID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.perm.v1.Query/FindPermissionsWithDID",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FindPermissionsWithDID(ctx, req.(*QueryFindPermissionsWithDIDRequest))
+func (m *QueryGetSlashRecordResponse) Reset()         { *m = QueryGetSlashRecordResponse{} }
+func (m *QueryGetSlashRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSlashRecordResponse) ProtoMessage()    {}
+func (*QueryGetSlashRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{21}
+}
+func (m *QueryGetSlashRecordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetSlashRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSlashRecordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetSlashRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSlashRecordResponse.Merge(m, src)
+}
+func (m *QueryGetSlashRecordResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSlashRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSlashRecordResponse.DiscardUnknown(m)
 }
 
-func _Query_FindBeneficiaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFindBeneficiariesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FindBeneficiaries(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.perm.v1.Query/FindBeneficiaries",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FindBeneficiaries(ctx, req.(*QueryFindBeneficiariesRequest))
+var xxx_messageInfo_QueryGetSlashRecordResponse proto.InternalMessageInfo
+
+func (m *QueryGetSlashRecordResponse) GetSlashRecord() SlashRecord {
+	if m != nil {
+		return m.SlashRecord
 	}
-	return interceptor(ctx, in, info, handler)
+	return SlashRecord{}
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "verana.perm.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ListPermissions",
-			Handler:    _Query_ListPermissions_Handler,
-		},
-		{
-			MethodName: "GetPermission",
-			Handler:    _Query_GetPermission_Handler,
-		},
-		{
-			MethodName: "GetPermissionSession",
-			Handler:    _Query_GetPermissionSession_Handler,
-		},
-		{
-			MethodName: "ListPermissionSessions",
-			Handler:    _Query_ListPermissionSessions_Handler,
-		},
-		{
-			MethodName: "FindPermissionsWithDID",
-			Handler:    _Query_FindPermissionsWithDID_Handler,
-		},
-		{
-			MethodName: "FindBeneficiaries",
-			Handler:    _Query_FindBeneficiaries_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "verana/perm/v1/query.proto",
+type QueryListSlashRecordsRequest struct {
+	PermId          uint64 `protobuf:"varint,1,opt,name=perm_id,json=permId,proto3" json:"perm_id,omitempty"`
+	Grantee         string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	SlashedBy       string `protobuf:"bytes,3,opt,name=slashed_by,json=slashedBy,proto3" json:"slashed_by,omitempty"`
+	ResponseMaxSize uint32 `protobuf:"varint,4,opt,name=response_max_size,json=responseMaxSize,proto3" json:"response_max_size,omitempty"`
+	// after_id lists the slash records with an ID above it, 0 for all
+	AfterId uint64 `protobuf:"varint,5,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryListSlashRecordsRequest) Reset()         { *m = QueryListSlashRecordsRequest{} }
+func (m *QueryListSlashRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListSlashRecordsRequest) ProtoMessage()    {}
+func (*QueryListSlashRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{22}
+}
+func (m *QueryListSlashRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListSlashRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListSlashRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryListSlashRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListSlashRecordsRequest.Merge(m, src)
+}
+func (m *QueryListSlashRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListSlashRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListSlashRecordsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryListSlashRecordsRequest proto.InternalMessageInfo
+
+func (m *QueryListSlashRecordsRequest) GetPermId() uint64 {
+	if m != nil {
+		return m.PermId
+	}
+	return 0
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryListSlashRecordsRequest) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryListSlashRecordsRequest) GetSlashedBy() string {
+	if m != nil {
+		return m.SlashedBy
+	}
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryListSlashRecordsRequest) GetResponseMaxSize() uint32 {
+	if m != nil {
+		return m.ResponseMaxSize
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryListPermissionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryListSlashRecordsRequest) GetAfterId() uint64 {
+	if m != nil {
+		return m.AfterId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryListPermissionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryListSlashRecordsResponse struct {
+	SlashRecords []SlashRecord `protobuf:"bytes,1,rep,name=slash_records,json=slashRecords,proto3" json:"slash_records"`
+	// next_after_id is the after_id of the next page, 0 if there is none
+	NextAfterId uint64 `protobuf:"varint,2,opt,name=next_after_id,json=nextAfterId,proto3" json:"next_after_id,omitempty"`
 }
 
-func (m *QueryListPermissionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ResponseMaxSize != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ResponseMaxSize))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ModifiedAfter != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ModifiedAfter, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ModifiedAfter):])
-		if err2 != nil {
-			return 0, err2
+func (m *QueryListSlashRecordsResponse) Reset()         { *m = QueryListSlashRecordsResponse{} }
+func (m *QueryListSlashRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListSlashRecordsResponse) ProtoMessage()    {}
+func (*QueryListSlashRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{23}
+}
+func (m *QueryListSlashRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListSlashRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListSlashRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n2
-		i = encodeVarintQuery(dAtA, i, uint64(n2))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryListPermissionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryListSlashRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListSlashRecordsResponse.Merge(m, src)
 }
-
-func (m *QueryListPermissionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryListSlashRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListSlashRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListSlashRecordsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryListPermissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Permissions) > 0 {
-		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Permissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_QueryListSlashRecordsResponse proto.InternalMessageInfo
+
+func (m *QueryListSlashRecordsResponse) GetSlashRecords() []SlashRecord {
+	if m != nil {
+		return m.SlashRecords
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetPermissionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryListSlashRecordsResponse) GetNextAfterId() uint64 {
+	if m != nil {
+		return m.NextAfterId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryGetPermissionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetSlashDistributionRequest struct {
+	TrId uint64 `protobuf:"varint,1,opt,name=tr_id,json=trId,proto3" json:"tr_id,omitempty"`
 }
 
-func (m *QueryGetPermissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryGetSlashDistributionRequest) Reset()         { *m = QueryGetSlashDistributionRequest{} }
+func (m *QueryGetSlashDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSlashDistributionRequest) ProtoMessage()    {}
+func (*QueryGetSlashDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{24}
+}
+func (m *QueryGetSlashDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetSlashDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSlashDistributionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetSlashDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSlashDistributionRequest.Merge(m, src)
+}
+func (m *QueryGetSlashDistributionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSlashDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSlashDistributionRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetPermissionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetSlashDistributionRequest proto.InternalMessageInfo
+
+func (m *QueryGetSlashDistributionRequest) GetTrId() uint64 {
+	if m != nil {
+		return m.TrId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryGetPermissionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetSlashDistributionResponse struct {
+	SlashDistribution TrustRegistrySlashDistribution `protobuf:"bytes,1,opt,name=slash_distribution,json=slashDistribution,proto3" json:"slash_distribution"`
 }
 
-func (m *QueryGetPermissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Permission.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetSlashDistributionResponse) Reset()         { *m = QueryGetSlashDistributionResponse{} }
+func (m *QueryGetSlashDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSlashDistributionResponse) ProtoMessage()    {}
+func (*QueryGetSlashDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{25}
+}
+func (m *QueryGetSlashDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetSlashDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSlashDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetSlashDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSlashDistributionResponse.Merge(m, src)
+}
+func (m *QueryGetSlashDistributionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSlashDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSlashDistributionResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetPermissionSessionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetSlashDistributionResponse proto.InternalMessageInfo
+
+func (m *QueryGetSlashDistributionResponse) GetSlashDistribution() TrustRegistrySlashDistribution {
+	if m != nil {
+		return m.SlashDistribution
 	}
-	return dAtA[:n], nil
+	return TrustRegistrySlashDistribution{}
 }
 
-func (m *QueryGetPermissionSessionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PermissionNode is a perm of a perm tree or ancestry, with its validity at
+// the requested time.
+type PermissionNode struct {
+	Permission Permission `protobuf:"bytes,1,opt,name=permission,proto3" json:"permission"`
+	// depth is the distance to the requested perm
+	Depth uint32 `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
+	Valid bool   `protobuf:"varint,3,opt,name=valid,proto3" json:"valid,omitempty"`
+	// invalid_reason is set when the perm is not valid
+	InvalidReason string `protobuf:"bytes,4,opt,name=invalid_reason,json=invalidReason,proto3" json:"invalid_reason,omitempty"`
 }
 
-func (m *QueryGetPermissionSessionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+func (m *PermissionNode) Reset()         { *m = PermissionNode{} }
+func (m *PermissionNode) String() string { return proto.CompactTextString(m) }
+func (*PermissionNode) ProtoMessage()    {}
+func (*PermissionNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{26}
+}
+func (m *PermissionNode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PermissionNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PermissionNode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PermissionNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PermissionNode.Merge(m, src)
+}
+func (m *PermissionNode) XXX_Size() int {
+	return m.Size()
+}
+func (m *PermissionNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_PermissionNode.DiscardUnknown(m)
 }
 
-func (m *QueryGetPermissionSessionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PermissionNode proto.InternalMessageInfo
+
+func (m *PermissionNode) GetPermission() Permission {
+	if m != nil {
+		return m.Permission
 	}
-	return dAtA[:n], nil
+	return Permission{}
 }
 
-func (m *QueryGetPermissionSessionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PermissionNode) GetDepth() uint32 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
 }
 
-func (m *QueryGetPermissionSessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Session != nil {
-		{
-			size, err := m.Session.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *PermissionNode) GetValid() bool {
+	if m != nil {
+		return m.Valid
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *QueryListPermissionSessionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PermissionNode) GetInvalidReason() string {
+	if m != nil {
+		return m.InvalidReason
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryListPermissionSessionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetPermissionTreeRequest struct {
+	RootId uint64 `protobuf:"varint,1,opt,name=root_id,json=rootId,proto3" json:"root_id,omitempty"`
+	// depth limits the depth of the returned perms, 0 means no limit
+	Depth uint32 `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
+	// type, when set, only returns perms of that type
+	Type uint32 `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`
+	// country is used to check the validity of the perms
+	Country string `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`
+	// when defaults to the current block time
+	When *time.Time `protobuf:"bytes,5,opt,name=when,proto3,stdtime" json:"when,omitempty"`
+	// only_valid only returns perms valid at when
+	OnlyValid       bool   `protobuf:"varint,6,opt,name=only_valid,json=onlyValid,proto3" json:"only_valid,omitempty"`
+	ResponseMaxSize uint32 `protobuf:"varint,7,opt,name=response_max_size,json=responseMaxSize,proto3" json:"response_max_size,omitempty"`
+}
+
+func (m *QueryGetPermissionTreeRequest) Reset()         { *m = QueryGetPermissionTreeRequest{} }
+func (m *QueryGetPermissionTreeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPermissionTreeRequest) ProtoMessage()    {}
+func (*QueryGetPermissionTreeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{27}
+}
+func (m *QueryGetPermissionTreeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryListPermissionSessionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ResponseMaxSize != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ResponseMaxSize))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ModifiedAfter != nil {
-		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ModifiedAfter, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ModifiedAfter):])
-		if err5 != nil {
-			return 0, err5
+func (m *QueryGetPermissionTreeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPermissionTreeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n5
-		i = encodeVarintQuery(dAtA, i, uint64(n5))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetPermissionTreeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPermissionTreeRequest.Merge(m, src)
+}
+func (m *QueryGetPermissionTreeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPermissionTreeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPermissionTreeRequest.DiscardUnknown(m)
 }
 
-func (m *QueryListPermissionSessionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetPermissionTreeRequest proto.InternalMessageInfo
+
+func (m *QueryGetPermissionTreeRequest) GetRootId() uint64 {
+	if m != nil {
+		return m.RootId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryListPermissionSessionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetPermissionTreeRequest) GetDepth() uint32 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
 }
 
-func (m *QueryListPermissionSessionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sessions) > 0 {
-		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *QueryGetPermissionTreeRequest) GetType() uint32 {
+	if m != nil {
+		return m.Type
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryFindPermissionsWithDIDRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetPermissionTreeRequest) GetCountry() string {
+	if m != nil {
+		return m.Country
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryFindPermissionsWithDIDRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetPermissionTreeRequest) GetWhen() *time.Time {
+	if m != nil {
+		return m.When
+	}
+	return nil
 }
 
-func (m *QueryFindPermissionsWithDIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.When != nil {
-		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.When, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.When):])
-		if err6 != nil {
-			return 0, err6
-		}
-		i -= n6
-		i = encodeVarintQuery(dAtA, i, uint64(n6))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Country) > 0 {
-		i -= len(m.Country)
-		copy(dAtA[i:], m.Country)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Country)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.SchemaId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SchemaId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Type != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Did) > 0 {
-		i -= len(m.Did)
-		copy(dAtA[i:], m.Did)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Did)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryGetPermissionTreeRequest) GetOnlyValid() bool {
+	if m != nil {
+		return m.OnlyValid
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *QueryFindPermissionsWithDIDResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetPermissionTreeRequest) GetResponseMaxSize() uint32 {
+	if m != nil {
+		return m.ResponseMaxSize
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryFindPermissionsWithDIDResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetPermissionTreeResponse struct {
+	Nodes []PermissionNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes"`
+	// truncated is set when response_max_size was reached before the end of the tree
+	Truncated bool `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"`
 }
 
-func (m *QueryFindPermissionsWithDIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Permissions) > 0 {
-		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Permissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryGetPermissionTreeResponse) Reset()         { *m = QueryGetPermissionTreeResponse{} }
+func (m *QueryGetPermissionTreeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPermissionTreeResponse) ProtoMessage()    {}
+func (*QueryGetPermissionTreeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{28}
+}
+func (m *QueryGetPermissionTreeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPermissionTreeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPermissionTreeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryFindBeneficiariesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetPermissionTreeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPermissionTreeResponse.Merge(m, src)
 }
-
-func (m *QueryFindBeneficiariesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetPermissionTreeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPermissionTreeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPermissionTreeResponse.DiscardUnknown(m)
 }
 
-func (m *QueryFindBeneficiariesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VerifierPermId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.VerifierPermId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.IssuerPermId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.IssuerPermId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryGetPermissionTreeResponse proto.InternalMessageInfo
+
+func (m *QueryGetPermissionTreeResponse) GetNodes() []PermissionNode {
+	if m != nil {
+		return m.Nodes
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryFindBeneficiariesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetPermissionTreeResponse) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryFindBeneficiariesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetPermissionAncestryRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// country is used to check the validity of the perms
+	Country string `protobuf:"bytes,2,opt,name=country,proto3" json:"country,omitempty"`
+	// when defaults to the current block time
+	When *time.Time `protobuf:"bytes,3,opt,name=when,proto3,stdtime" json:"when,omitempty"`
 }
 
-func (m *QueryFindBeneficiariesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Permissions) > 0 {
-		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Permissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryGetPermissionAncestryRequest) Reset()         { *m = QueryGetPermissionAncestryRequest{} }
+func (m *QueryGetPermissionAncestryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPermissionAncestryRequest) ProtoMessage()    {}
+func (*QueryGetPermissionAncestryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{29}
+}
+func (m *QueryGetPermissionAncestryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPermissionAncestryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPermissionAncestryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryGetPermissionAncestryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPermissionAncestryRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryGetPermissionAncestryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPermissionAncestryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPermissionAncestryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryGetPermissionAncestryRequest proto.InternalMessageInfo
+
+func (m *QueryGetPermissionAncestryRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryListPermissionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ModifiedAfter != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ModifiedAfter)
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ResponseMaxSize != 0 {
-		n += 1 + sovQuery(uint64(m.ResponseMaxSize))
+func (m *QueryGetPermissionAncestryRequest) GetCountry() string {
+	if m != nil {
+		return m.Country
 	}
-	return n
+	return ""
 }
 
-func (m *QueryListPermissionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetPermissionAncestryRequest) GetWhen() *time.Time {
+	if m != nil {
+		return m.When
 	}
-	var l int
-	_ = l
-	if len(m.Permissions) > 0 {
-		for _, e := range m.Permissions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+type QueryGetPermissionAncestryResponse struct {
+	// ancestors starts with the requested perm and ends with its root perm
+	Ancestors []PermissionNode `protobuf:"bytes,1,rep,name=ancestors,proto3" json:"ancestors"`
+	// chain_valid is set when all the perms of the chain are valid
+	ChainValid bool `protobuf:"varint,2,opt,name=chain_valid,json=chainValid,proto3" json:"chain_valid,omitempty"`
+}
+
+func (m *QueryGetPermissionAncestryResponse) Reset()         { *m = QueryGetPermissionAncestryResponse{} }
+func (m *QueryGetPermissionAncestryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPermissionAncestryResponse) ProtoMessage()    {}
+func (*QueryGetPermissionAncestryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{30}
+}
+func (m *QueryGetPermissionAncestryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPermissionAncestryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPermissionAncestryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryGetPermissionAncestryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPermissionAncestryResponse.Merge(m, src)
+}
+func (m *QueryGetPermissionAncestryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPermissionAncestryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPermissionAncestryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetPermissionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+var xxx_messageInfo_QueryGetPermissionAncestryResponse proto.InternalMessageInfo
+
+func (m *QueryGetPermissionAncestryResponse) GetAncestors() []PermissionNode {
+	if m != nil {
+		return m.Ancestors
 	}
-	return n
+	return nil
 }
 
-func (m *QueryGetPermissionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetPermissionAncestryResponse) GetChainValid() bool {
+	if m != nil {
+		return m.ChainValid
 	}
-	var l int
-	_ = l
-	l = m.Permission.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return false
 }
 
-func (m *QueryGetPermissionSessionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QuerySimulateRevocationCascadeRequest struct {
+	Id              uint64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Mode            CascadeMode `protobuf:"varint,2,opt,name=mode,proto3,enum=verana.perm.v1.CascadeMode" json:"mode,omitempty"`
+	ResponseMaxSize uint32      `protobuf:"varint,3,opt,name=response_max_size,json=responseMaxSize,proto3" json:"response_max_size,omitempty"`
 }
 
-func (m *QueryGetPermissionSessionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Session != nil {
-		l = m.Session.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySimulateRevocationCascadeRequest) Reset()         { *m = QuerySimulateRevocationCascadeRequest{} }
+func (m *QuerySimulateRevocationCascadeRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateRevocationCascadeRequest) ProtoMessage()    {}
+func (*QuerySimulateRevocationCascadeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{31}
+}
+func (m *QuerySimulateRevocationCascadeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateRevocationCascadeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateRevocationCascadeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QuerySimulateRevocationCascadeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateRevocationCascadeRequest.Merge(m, src)
+}
+func (m *QuerySimulateRevocationCascadeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateRevocationCascadeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateRevocationCascadeRequest.DiscardUnknown(m)
 }
 
-func (m *QueryListPermissionSessionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ModifiedAfter != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ModifiedAfter)
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QuerySimulateRevocationCascadeRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateRevocationCascadeRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if m.ResponseMaxSize != 0 {
-		n += 1 + sovQuery(uint64(m.ResponseMaxSize))
+	return 0
+}
+
+func (m *QuerySimulateRevocationCascadeRequest) GetMode() CascadeMode {
+	if m != nil {
+		return m.Mode
 	}
-	return n
+	return CascadeMode_CASCADE_MODE_NONE
 }
 
-func (m *QueryListPermissionSessionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySimulateRevocationCascadeRequest) GetResponseMaxSize() uint32 {
+	if m != nil {
+		return m.ResponseMaxSize
 	}
-	var l int
-	_ = l
-	if len(m.Sessions) > 0 {
-		for _, e := range m.Sessions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+type QuerySimulateRevocationCascadeResponse struct {
+	// affected lists the descendant perms that would be revoked or suspended,
+	// breadth first
+	Affected []PermissionNode `protobuf:"bytes,1,rep,name=affected,proto3" json:"affected"`
+	// truncated is set when response_max_size was reached before the end of the tree
+	Truncated bool `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"`
+}
+
+func (m *QuerySimulateRevocationCascadeResponse) Reset() {
+	*m = QuerySimulateRevocationCascadeResponse{}
+}
+func (m *QuerySimulateRevocationCascadeResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateRevocationCascadeResponse) ProtoMessage()    {}
+func (*QuerySimulateRevocationCascadeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{32}
+}
+func (m *QuerySimulateRevocationCascadeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateRevocationCascadeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateRevocationCascadeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QuerySimulateRevocationCascadeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateRevocationCascadeResponse.Merge(m, src)
+}
+func (m *QuerySimulateRevocationCascadeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateRevocationCascadeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateRevocationCascadeResponse.DiscardUnknown(m)
 }
 
-func (m *QueryFindPermissionsWithDIDRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Type != 0 {
-		n += 1 + sovQuery(uint64(m.Type))
-	}
-	if m.SchemaId != 0 {
-		n += 1 + sovQuery(uint64(m.SchemaId))
-	}
-	l = len(m.Country)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.When != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.When)
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QuerySimulateRevocationCascadeResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateRevocationCascadeResponse) GetAffected() []PermissionNode {
+	if m != nil {
+		return m.Affected
 	}
-	return n
+	return nil
 }
 
-func (m *QueryFindPermissionsWithDIDResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySimulateRevocationCascadeResponse) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
 	}
-	var l int
-	_ = l
-	if len(m.Permissions) > 0 {
-		for _, e := range m.Permissions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return false
+}
+
+type QueryListRevocationCascadesRequest struct {
+	ResponseMaxSize uint32 `protobuf:"varint,1,opt,name=response_max_size,json=responseMaxSize,proto3" json:"response_max_size,omitempty"`
+}
+
+func (m *QueryListRevocationCascadesRequest) Reset()         { *m = QueryListRevocationCascadesRequest{} }
+func (m *QueryListRevocationCascadesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListRevocationCascadesRequest) ProtoMessage()    {}
+func (*QueryListRevocationCascadesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{33}
+}
+func (m *QueryListRevocationCascadesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListRevocationCascadesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListRevocationCascadesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryListRevocationCascadesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListRevocationCascadesRequest.Merge(m, src)
+}
+func (m *QueryListRevocationCascadesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListRevocationCascadesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListRevocationCascadesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryFindBeneficiariesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.IssuerPermId != 0 {
-		n += 1 + sovQuery(uint64(m.IssuerPermId))
-	}
-	if m.VerifierPermId != 0 {
-		n += 1 + sovQuery(uint64(m.VerifierPermId))
+var xxx_messageInfo_QueryListRevocationCascadesRequest proto.InternalMessageInfo
+
+func (m *QueryListRevocationCascadesRequest) GetResponseMaxSize() uint32 {
+	if m != nil {
+		return m.ResponseMaxSize
 	}
-	return n
+	return 0
 }
 
-func (m *QueryFindBeneficiariesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Permissions) > 0 {
-		for _, e := range m.Permissions {
-			l = e.Size()
+type QueryListRevocationCascadesResponse struct {
+	Cascades []RevocationCascade `protobuf:"bytes,1,rep,name=cascades,proto3" json:"cascades"`
+}
+
+func (m *QueryListRevocationCascadesResponse) Reset()         { *m = QueryListRevocationCascadesResponse{} }
+func (m *QueryListRevocationCascadesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListRevocationCascadesResponse) ProtoMessage()    {}
+func (*QueryListRevocationCascadesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{34}
+}
+func (m *QueryListRevocationCascadesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListRevocationCascadesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListRevocationCascadesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListRevocationCascadesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListRevocationCascadesResponse.Merge(m, src)
+}
+func (m *QueryListRevocationCascadesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListRevocationCascadesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListRevocationCascadesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListRevocationCascadesResponse proto.InternalMessageInfo
+
+func (m *QueryListRevocationCascadesResponse) GetCascades() []RevocationCascade {
+	if m != nil {
+		return m.Cascades
+	}
+	return nil
+}
+
+type QueryGetAgentRewardsRequest struct {
+	PermId uint64 `protobuf:"varint,1,opt,name=perm_id,json=permId,proto3" json:"perm_id,omitempty"`
+}
+
+func (m *QueryGetAgentRewardsRequest) Reset()         { *m = QueryGetAgentRewardsRequest{} }
+func (m *QueryGetAgentRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAgentRewardsRequest) ProtoMessage()    {}
+func (*QueryGetAgentRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{35}
+}
+func (m *QueryGetAgentRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAgentRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAgentRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetAgentRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAgentRewardsRequest.Merge(m, src)
+}
+func (m *QueryGetAgentRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAgentRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAgentRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetAgentRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryGetAgentRewardsRequest) GetPermId() uint64 {
+	if m != nil {
+		return m.PermId
+	}
+	return 0
+}
+
+type QueryGetAgentRewardsResponse struct {
+	AgentReward AgentReward `protobuf:"bytes,1,opt,name=agent_reward,json=agentReward,proto3" json:"agent_reward"`
+}
+
+func (m *QueryGetAgentRewardsResponse) Reset()         { *m = QueryGetAgentRewardsResponse{} }
+func (m *QueryGetAgentRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAgentRewardsResponse) ProtoMessage()    {}
+func (*QueryGetAgentRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{36}
+}
+func (m *QueryGetAgentRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAgentRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAgentRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetAgentRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAgentRewardsResponse.Merge(m, src)
+}
+func (m *QueryGetAgentRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAgentRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAgentRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetAgentRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryGetAgentRewardsResponse) GetAgentReward() AgentReward {
+	if m != nil {
+		return m.AgentReward
+	}
+	return AgentReward{}
+}
+
+type QueryGetVoucherNonceRequest struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *QueryGetVoucherNonceRequest) Reset()         { *m = QueryGetVoucherNonceRequest{} }
+func (m *QueryGetVoucherNonceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetVoucherNonceRequest) ProtoMessage()    {}
+func (*QueryGetVoucherNonceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{37}
+}
+func (m *QueryGetVoucherNonceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetVoucherNonceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetVoucherNonceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetVoucherNonceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetVoucherNonceRequest.Merge(m, src)
+}
+func (m *QueryGetVoucherNonceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetVoucherNonceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetVoucherNonceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetVoucherNonceRequest proto.InternalMessageInfo
+
+func (m *QueryGetVoucherNonceRequest) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+type QueryGetVoucherNonceResponse struct {
+	// nonce is the highest nonce settled, 0 if no voucher of the signer was
+	// settled
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// used_nonces are the nonces settled within the voucher nonce window below
+	// nonce, nonce included
+	UsedNonces []uint64 `protobuf:"varint,2,rep,packed,name=used_nonces,json=usedNonces,proto3" json:"used_nonces,omitempty"`
+}
+
+func (m *QueryGetVoucherNonceResponse) Reset()         { *m = QueryGetVoucherNonceResponse{} }
+func (m *QueryGetVoucherNonceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetVoucherNonceResponse) ProtoMessage()    {}
+func (*QueryGetVoucherNonceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{38}
+}
+func (m *QueryGetVoucherNonceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetVoucherNonceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetVoucherNonceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetVoucherNonceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetVoucherNonceResponse.Merge(m, src)
+}
+func (m *QueryGetVoucherNonceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetVoucherNonceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetVoucherNonceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetVoucherNonceResponse proto.InternalMessageInfo
+
+func (m *QueryGetVoucherNonceResponse) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *QueryGetVoucherNonceResponse) GetUsedNonces() []uint64 {
+	if m != nil {
+		return m.UsedNonces
+	}
+	return nil
+}
+
+type QueryListVPMessagesRequest struct {
+	PermId uint64 `protobuf:"varint,1,opt,name=perm_id,json=permId,proto3" json:"perm_id,omitempty"`
+	// after_index lists the messages anchored after this index, 0 for all
+	AfterIndex      uint64 `protobuf:"varint,2,opt,name=after_index,json=afterIndex,proto3" json:"after_index,omitempty"`
+	ResponseMaxSize uint32 `protobuf:"varint,3,opt,name=response_max_size,json=responseMaxSize,proto3" json:"response_max_size,omitempty"`
+}
+
+func (m *QueryListVPMessagesRequest) Reset()         { *m = QueryListVPMessagesRequest{} }
+func (m *QueryListVPMessagesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListVPMessagesRequest) ProtoMessage()    {}
+func (*QueryListVPMessagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{39}
+}
+func (m *QueryListVPMessagesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListVPMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListVPMessagesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListVPMessagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListVPMessagesRequest.Merge(m, src)
+}
+func (m *QueryListVPMessagesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListVPMessagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListVPMessagesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListVPMessagesRequest proto.InternalMessageInfo
+
+func (m *QueryListVPMessagesRequest) GetPermId() uint64 {
+	if m != nil {
+		return m.PermId
+	}
+	return 0
+}
+
+func (m *QueryListVPMessagesRequest) GetAfterIndex() uint64 {
+	if m != nil {
+		return m.AfterIndex
+	}
+	return 0
+}
+
+func (m *QueryListVPMessagesRequest) GetResponseMaxSize() uint32 {
+	if m != nil {
+		return m.ResponseMaxSize
+	}
+	return 0
+}
+
+type QueryListVPMessagesResponse struct {
+	Messages []VPMessage `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages"`
+}
+
+func (m *QueryListVPMessagesResponse) Reset()         { *m = QueryListVPMessagesResponse{} }
+func (m *QueryListVPMessagesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListVPMessagesResponse) ProtoMessage()    {}
+func (*QueryListVPMessagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{40}
+}
+func (m *QueryListVPMessagesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListVPMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListVPMessagesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListVPMessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListVPMessagesResponse.Merge(m, src)
+}
+func (m *QueryListVPMessagesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListVPMessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListVPMessagesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListVPMessagesResponse proto.InternalMessageInfo
+
+func (m *QueryListVPMessagesResponse) GetMessages() []VPMessage {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+type QueryGetPermissionFeesRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// response_max_size limits the fee history to its last entries
+	ResponseMaxSize uint32 `protobuf:"varint,2,opt,name=response_max_size,json=responseMaxSize,proto3" json:"response_max_size,omitempty"`
+}
+
+func (m *QueryGetPermissionFeesRequest) Reset()         { *m = QueryGetPermissionFeesRequest{} }
+func (m *QueryGetPermissionFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPermissionFeesRequest) ProtoMessage()    {}
+func (*QueryGetPermissionFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{41}
+}
+func (m *QueryGetPermissionFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPermissionFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPermissionFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPermissionFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPermissionFeesRequest.Merge(m, src)
+}
+func (m *QueryGetPermissionFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPermissionFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPermissionFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPermissionFeesRequest proto.InternalMessageInfo
+
+func (m *QueryGetPermissionFeesRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *QueryGetPermissionFeesRequest) GetResponseMaxSize() uint32 {
+	if m != nil {
+		return m.ResponseMaxSize
+	}
+	return 0
+}
+
+type QueryGetPermissionFeesResponse struct {
+	Current PermissionFees `protobuf:"bytes,1,opt,name=current,proto3" json:"current"`
+	// scheduled is the fee update pending its notice period, if any
+	Scheduled *PermissionFees `protobuf:"bytes,2,opt,name=scheduled,proto3" json:"scheduled,omitempty"`
+	// history lists the fee history of the perm, oldest first
+	History []PermissionFeeRecord `protobuf:"bytes,3,rep,name=history,proto3" json:"history"`
+}
+
+func (m *QueryGetPermissionFeesResponse) Reset()         { *m = QueryGetPermissionFeesResponse{} }
+func (m *QueryGetPermissionFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPermissionFeesResponse) ProtoMessage()    {}
+func (*QueryGetPermissionFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{42}
+}
+func (m *QueryGetPermissionFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPermissionFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPermissionFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPermissionFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPermissionFeesResponse.Merge(m, src)
+}
+func (m *QueryGetPermissionFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPermissionFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPermissionFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPermissionFeesResponse proto.InternalMessageInfo
+
+func (m *QueryGetPermissionFeesResponse) GetCurrent() PermissionFees {
+	if m != nil {
+		return m.Current
+	}
+	return PermissionFees{}
+}
+
+func (m *QueryGetPermissionFeesResponse) GetScheduled() *PermissionFees {
+	if m != nil {
+		return m.Scheduled
+	}
+	return nil
+}
+
+func (m *QueryGetPermissionFeesResponse) GetHistory() []PermissionFeeRecord {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+type QueryVerifySessionAuthorizationRequest struct {
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// executor_perm_id is the issuer perm of the session authz, 0 if none
+	ExecutorPermId uint64 `protobuf:"varint,2,opt,name=executor_perm_id,json=executorPermId,proto3" json:"executor_perm_id,omitempty"`
+	// beneficiary_perm_id is the verifier perm of the session authz, 0 if none
+	BeneficiaryPermId uint64 `protobuf:"varint,3,opt,name=beneficiary_perm_id,json=beneficiaryPermId,proto3" json:"beneficiary_perm_id,omitempty"`
+	AgentPermId       uint64 `protobuf:"varint,4,opt,name=agent_perm_id,json=agentPermId,proto3" json:"agent_perm_id,omitempty"`
+	// when defaults to the current block time
+	When *time.Time `protobuf:"bytes,5,opt,name=when,proto3,stdtime" json:"when,omitempty"`
+}
+
+func (m *QueryVerifySessionAuthorizationRequest) Reset() {
+	*m = QueryVerifySessionAuthorizationRequest{}
+}
+func (m *QueryVerifySessionAuthorizationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifySessionAuthorizationRequest) ProtoMessage()    {}
+func (*QueryVerifySessionAuthorizationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{43}
+}
+func (m *QueryVerifySessionAuthorizationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifySessionAuthorizationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifySessionAuthorizationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerifySessionAuthorizationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifySessionAuthorizationRequest.Merge(m, src)
+}
+func (m *QueryVerifySessionAuthorizationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifySessionAuthorizationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifySessionAuthorizationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifySessionAuthorizationRequest proto.InternalMessageInfo
+
+func (m *QueryVerifySessionAuthorizationRequest) GetSessionId() string {
+	if m != nil {
+		return m.SessionId
+	}
+	return ""
+}
+
+func (m *QueryVerifySessionAuthorizationRequest) GetExecutorPermId() uint64 {
+	if m != nil {
+		return m.ExecutorPermId
+	}
+	return 0
+}
+
+func (m *QueryVerifySessionAuthorizationRequest) GetBeneficiaryPermId() uint64 {
+	if m != nil {
+		return m.BeneficiaryPermId
+	}
+	return 0
+}
+
+func (m *QueryVerifySessionAuthorizationRequest) GetAgentPermId() uint64 {
+	if m != nil {
+		return m.AgentPermId
+	}
+	return 0
+}
+
+func (m *QueryVerifySessionAuthorizationRequest) GetWhen() *time.Time {
+	if m != nil {
+		return m.When
+	}
+	return nil
+}
+
+// SessionPermissionStatus is the validity of a perm involved in a session.
+type SessionPermissionStatus struct {
+	// role is one of agent, executor, beneficiary and wallet_agent
+	Role   string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	PermId uint64 `protobuf:"varint,2,opt,name=perm_id,json=permId,proto3" json:"perm_id,omitempty"`
+	Valid  bool   `protobuf:"varint,3,opt,name=valid,proto3" json:"valid,omitempty"`
+	// invalid_reason is set when the perm is not valid
+	InvalidReason string `protobuf:"bytes,4,opt,name=invalid_reason,json=invalidReason,proto3" json:"invalid_reason,omitempty"`
+}
+
+func (m *SessionPermissionStatus) Reset()         { *m = SessionPermissionStatus{} }
+func (m *SessionPermissionStatus) String() string { return proto.CompactTextString(m) }
+func (*SessionPermissionStatus) ProtoMessage()    {}
+func (*SessionPermissionStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{44}
+}
+func (m *SessionPermissionStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SessionPermissionStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SessionPermissionStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SessionPermissionStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionPermissionStatus.Merge(m, src)
+}
+func (m *SessionPermissionStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *SessionPermissionStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionPermissionStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionPermissionStatus proto.InternalMessageInfo
+
+func (m *SessionPermissionStatus) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *SessionPermissionStatus) GetPermId() uint64 {
+	if m != nil {
+		return m.PermId
+	}
+	return 0
+}
+
+func (m *SessionPermissionStatus) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *SessionPermissionStatus) GetInvalidReason() string {
+	if m != nil {
+		return m.InvalidReason
+	}
+	return ""
+}
+
+type QueryVerifySessionAuthorizationResponse struct {
+	Authorized bool `protobuf:"varint,1,opt,name=authorized,proto3" json:"authorized,omitempty"`
+	// reason is set when the session does not authorize the exchange
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// authz is the matching authz entry of the session, if any
+	Authz       *SessionAuthz             `protobuf:"bytes,3,opt,name=authz,proto3" json:"authz,omitempty"`
+	Permissions []SessionPermissionStatus `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions"`
+}
+
+func (m *QueryVerifySessionAuthorizationResponse) Reset() {
+	*m = QueryVerifySessionAuthorizationResponse{}
+}
+func (m *QueryVerifySessionAuthorizationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifySessionAuthorizationResponse) ProtoMessage()    {}
+func (*QueryVerifySessionAuthorizationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{45}
+}
+func (m *QueryVerifySessionAuthorizationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifySessionAuthorizationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifySessionAuthorizationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerifySessionAuthorizationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifySessionAuthorizationResponse.Merge(m, src)
+}
+func (m *QueryVerifySessionAuthorizationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifySessionAuthorizationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifySessionAuthorizationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifySessionAuthorizationResponse proto.InternalMessageInfo
+
+func (m *QueryVerifySessionAuthorizationResponse) GetAuthorized() bool {
+	if m != nil {
+		return m.Authorized
+	}
+	return false
+}
+
+func (m *QueryVerifySessionAuthorizationResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *QueryVerifySessionAuthorizationResponse) GetAuthz() *SessionAuthz {
+	if m != nil {
+		return m.Authz
+	}
+	return nil
+}
+
+func (m *QueryVerifySessionAuthorizationResponse) GetPermissions() []SessionPermissionStatus {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+// BeneficiaryFee is the share of the session fees paid to a beneficiary perm.
+type BeneficiaryFee struct {
+	PermId  uint64         `protobuf:"varint,1,opt,name=perm_id,json=permId,proto3" json:"perm_id,omitempty"`
+	Type    PermissionType `protobuf:"varint,2,opt,name=type,proto3,enum=verana.perm.v1.PermissionType" json:"type,omitempty"`
+	Grantee string         `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// fees is the amount in trust units set by the beneficiary perm
+	Fees uint64 `protobuf:"varint,4,opt,name=fees,proto3" json:"fees,omitempty"`
+	// fees_in_denom is fees converted to uvna, direct_fees plus trust_deposit
+	FeesInDenom uint64 `protobuf:"varint,5,opt,name=fees_in_denom,json=feesInDenom,proto3" json:"fees_in_denom,omitempty"`
+	// direct_fees is the part of fees_in_denom transferred to the grantee
+	DirectFees uint64 `protobuf:"varint,6,opt,name=direct_fees,json=directFees,proto3" json:"direct_fees,omitempty"`
+	// trust_deposit is the part of fees_in_denom added to the grantee trust deposit
+	TrustDeposit uint64 `protobuf:"varint,7,opt,name=trust_deposit,json=trustDeposit,proto3" json:"trust_deposit,omitempty"`
+	// creator_trust_deposit is the matching amount added to the session creator
+	// trust deposit
+	CreatorTrustDeposit uint64 `protobuf:"varint,8,opt,name=creator_trust_deposit,json=creatorTrustDeposit,proto3" json:"creator_trust_deposit,omitempty"`
+}
+
+func (m *BeneficiaryFee) Reset()         { *m = BeneficiaryFee{} }
+func (m *BeneficiaryFee) String() string { return proto.CompactTextString(m) }
+func (*BeneficiaryFee) ProtoMessage()    {}
+func (*BeneficiaryFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{46}
+}
+func (m *BeneficiaryFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BeneficiaryFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeneficiaryFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BeneficiaryFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeneficiaryFee.Merge(m, src)
+}
+func (m *BeneficiaryFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *BeneficiaryFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeneficiaryFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeneficiaryFee proto.InternalMessageInfo
+
+func (m *BeneficiaryFee) GetPermId() uint64 {
+	if m != nil {
+		return m.PermId
+	}
+	return 0
+}
+
+func (m *BeneficiaryFee) GetType() PermissionType {
+	if m != nil {
+		return m.Type
+	}
+	return PermissionType_PERMISSION_TYPE_UNSPECIFIED
+}
+
+func (m *BeneficiaryFee) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *BeneficiaryFee) GetFees() uint64 {
+	if m != nil {
+		return m.Fees
+	}
+	return 0
+}
+
+func (m *BeneficiaryFee) GetFeesInDenom() uint64 {
+	if m != nil {
+		return m.FeesInDenom
+	}
+	return 0
+}
+
+func (m *BeneficiaryFee) GetDirectFees() uint64 {
+	if m != nil {
+		return m.DirectFees
+	}
+	return 0
+}
+
+func (m *BeneficiaryFee) GetTrustDeposit() uint64 {
+	if m != nil {
+		return m.TrustDeposit
+	}
+	return 0
+}
+
+func (m *BeneficiaryFee) GetCreatorTrustDeposit() uint64 {
+	if m != nil {
+		return m.CreatorTrustDeposit
+	}
+	return 0
+}
+
+type QueryQuoteSessionFeesRequest struct {
+	IssuerPermId      uint64 `protobuf:"varint,1,opt,name=issuer_perm_id,json=issuerPermId,proto3" json:"issuer_perm_id,omitempty"`
+	VerifierPermId    uint64 `protobuf:"varint,2,opt,name=verifier_perm_id,json=verifierPermId,proto3" json:"verifier_perm_id,omitempty"`
+	AgentPermId       uint64 `protobuf:"varint,3,opt,name=agent_perm_id,json=agentPermId,proto3" json:"agent_perm_id,omitempty"`
+	WalletAgentPermId uint64 `protobuf:"varint,4,opt,name=wallet_agent_perm_id,json=walletAgentPermId,proto3" json:"wallet_agent_perm_id,omitempty"`
+}
+
+func (m *QueryQuoteSessionFeesRequest) Reset()         { *m = QueryQuoteSessionFeesRequest{} }
+func (m *QueryQuoteSessionFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQuoteSessionFeesRequest) ProtoMessage()    {}
+func (*QueryQuoteSessionFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{47}
+}
+func (m *QueryQuoteSessionFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuoteSessionFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuoteSessionFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQuoteSessionFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteSessionFeesRequest.Merge(m, src)
+}
+func (m *QueryQuoteSessionFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuoteSessionFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteSessionFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQuoteSessionFeesRequest proto.InternalMessageInfo
+
+func (m *QueryQuoteSessionFeesRequest) GetIssuerPermId() uint64 {
+	if m != nil {
+		return m.IssuerPermId
+	}
+	return 0
+}
+
+func (m *QueryQuoteSessionFeesRequest) GetVerifierPermId() uint64 {
+	if m != nil {
+		return m.VerifierPermId
+	}
+	return 0
+}
+
+func (m *QueryQuoteSessionFeesRequest) GetAgentPermId() uint64 {
+	if m != nil {
+		return m.AgentPermId
+	}
+	return 0
+}
+
+func (m *QueryQuoteSessionFeesRequest) GetWalletAgentPermId() uint64 {
+	if m != nil {
+		return m.WalletAgentPermId
+	}
+	return 0
+}
+
+type QueryQuoteSessionFeesResponse struct {
+	Beneficiaries     []BeneficiaryFee `protobuf:"bytes,1,rep,name=beneficiaries,proto3" json:"beneficiaries"`
+	UserAgentReward   uint64           `protobuf:"varint,2,opt,name=user_agent_reward,json=userAgentReward,proto3" json:"user_agent_reward,omitempty"`
+	WalletAgentReward uint64           `protobuf:"varint,3,opt,name=wallet_agent_reward,json=walletAgentReward,proto3" json:"wallet_agent_reward,omitempty"`
+	// total is the balance, in uvna, the session creator must hold
+	Total          uint64 `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	TrustUnitPrice uint64 `protobuf:"varint,5,opt,name=trust_unit_price,json=trustUnitPrice,proto3" json:"trust_unit_price,omitempty"`
+}
+
+func (m *QueryQuoteSessionFeesResponse) Reset()         { *m = QueryQuoteSessionFeesResponse{} }
+func (m *QueryQuoteSessionFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQuoteSessionFeesResponse) ProtoMessage()    {}
+func (*QueryQuoteSessionFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{48}
+}
+func (m *QueryQuoteSessionFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuoteSessionFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuoteSessionFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQuoteSessionFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteSessionFeesResponse.Merge(m, src)
+}
+func (m *QueryQuoteSessionFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuoteSessionFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteSessionFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQuoteSessionFeesResponse proto.InternalMessageInfo
+
+func (m *QueryQuoteSessionFeesResponse) GetBeneficiaries() []BeneficiaryFee {
+	if m != nil {
+		return m.Beneficiaries
+	}
+	return nil
+}
+
+func (m *QueryQuoteSessionFeesResponse) GetUserAgentReward() uint64 {
+	if m != nil {
+		return m.UserAgentReward
+	}
+	return 0
+}
+
+func (m *QueryQuoteSessionFeesResponse) GetWalletAgentReward() uint64 {
+	if m != nil {
+		return m.WalletAgentReward
+	}
+	return 0
+}
+
+func (m *QueryQuoteSessionFeesResponse) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *QueryQuoteSessionFeesResponse) GetTrustUnitPrice() uint64 {
+	if m != nil {
+		return m.TrustUnitPrice
+	}
+	return 0
+}
+
+type QueryQuoteValidationFeesRequest struct {
+	ValidatorPermId uint64 `protobuf:"varint,1,opt,name=validator_perm_id,json=validatorPermId,proto3" json:"validator_perm_id,omitempty"`
+}
+
+func (m *QueryQuoteValidationFeesRequest) Reset()         { *m = QueryQuoteValidationFeesRequest{} }
+func (m *QueryQuoteValidationFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQuoteValidationFeesRequest) ProtoMessage()    {}
+func (*QueryQuoteValidationFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{49}
+}
+func (m *QueryQuoteValidationFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuoteValidationFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuoteValidationFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQuoteValidationFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteValidationFeesRequest.Merge(m, src)
+}
+func (m *QueryQuoteValidationFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuoteValidationFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteValidationFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQuoteValidationFeesRequest proto.InternalMessageInfo
+
+func (m *QueryQuoteValidationFeesRequest) GetValidatorPermId() uint64 {
+	if m != nil {
+		return m.ValidatorPermId
+	}
+	return 0
+}
+
+type QueryQuoteValidationFeesResponse struct {
+	ValidatorPermId uint64 `protobuf:"varint,1,opt,name=validator_perm_id,json=validatorPermId,proto3" json:"validator_perm_id,omitempty"`
+	// validation_fees is the amount in trust units set by the validator perm
+	ValidationFees uint64 `protobuf:"varint,2,opt,name=validation_fees,json=validationFees,proto3" json:"validation_fees,omitempty"`
+	// fees_in_denom is validation_fees converted to uvna, escrowed until the
+	// validation process completes
+	FeesInDenom uint64 `protobuf:"varint,3,opt,name=fees_in_denom,json=feesInDenom,proto3" json:"fees_in_denom,omitempty"`
+	// trust_deposit is the amount, in uvna, added to the applicant trust deposit
+	TrustDeposit uint64 `protobuf:"varint,4,opt,name=trust_deposit,json=trustDeposit,proto3" json:"trust_deposit,omitempty"`
+	// total is fees_in_denom plus trust_deposit
+	Total          uint64 `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	TrustUnitPrice uint64 `protobuf:"varint,6,opt,name=trust_unit_price,json=trustUnitPrice,proto3" json:"trust_unit_price,omitempty"`
+}
+
+func (m *QueryQuoteValidationFeesResponse) Reset()         { *m = QueryQuoteValidationFeesResponse{} }
+func (m *QueryQuoteValidationFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQuoteValidationFeesResponse) ProtoMessage()    {}
+func (*QueryQuoteValidationFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{50}
+}
+func (m *QueryQuoteValidationFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuoteValidationFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuoteValidationFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQuoteValidationFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteValidationFeesResponse.Merge(m, src)
+}
+func (m *QueryQuoteValidationFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuoteValidationFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteValidationFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQuoteValidationFeesResponse proto.InternalMessageInfo
+
+func (m *QueryQuoteValidationFeesResponse) GetValidatorPermId() uint64 {
+	if m != nil {
+		return m.ValidatorPermId
+	}
+	return 0
+}
+
+func (m *QueryQuoteValidationFeesResponse) GetValidationFees() uint64 {
+	if m != nil {
+		return m.ValidationFees
+	}
+	return 0
+}
+
+func (m *QueryQuoteValidationFeesResponse) GetFeesInDenom() uint64 {
+	if m != nil {
+		return m.FeesInDenom
+	}
+	return 0
+}
+
+func (m *QueryQuoteValidationFeesResponse) GetTrustDeposit() uint64 {
+	if m != nil {
+		return m.TrustDeposit
+	}
+	return 0
+}
+
+func (m *QueryQuoteValidationFeesResponse) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *QueryQuoteValidationFeesResponse) GetTrustUnitPrice() uint64 {
+	if m != nil {
+		return m.TrustUnitPrice
+	}
+	return 0
+}
+
+type QueryGetVerificationCreditRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryGetVerificationCreditRequest) Reset()         { *m = QueryGetVerificationCreditRequest{} }
+func (m *QueryGetVerificationCreditRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetVerificationCreditRequest) ProtoMessage()    {}
+func (*QueryGetVerificationCreditRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{51}
+}
+func (m *QueryGetVerificationCreditRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetVerificationCreditRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetVerificationCreditRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetVerificationCreditRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetVerificationCreditRequest.Merge(m, src)
+}
+func (m *QueryGetVerificationCreditRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetVerificationCreditRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetVerificationCreditRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetVerificationCreditRequest proto.InternalMessageInfo
+
+func (m *QueryGetVerificationCreditRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type QueryGetVerificationCreditResponse struct {
+	Credit VerificationCredit `protobuf:"bytes,1,opt,name=credit,proto3" json:"credit"`
+}
+
+func (m *QueryGetVerificationCreditResponse) Reset()         { *m = QueryGetVerificationCreditResponse{} }
+func (m *QueryGetVerificationCreditResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetVerificationCreditResponse) ProtoMessage()    {}
+func (*QueryGetVerificationCreditResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{52}
+}
+func (m *QueryGetVerificationCreditResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetVerificationCreditResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetVerificationCreditResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetVerificationCreditResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetVerificationCreditResponse.Merge(m, src)
+}
+func (m *QueryGetVerificationCreditResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetVerificationCreditResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetVerificationCreditResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetVerificationCreditResponse proto.InternalMessageInfo
+
+func (m *QueryGetVerificationCreditResponse) GetCredit() VerificationCredit {
+	if m != nil {
+		return m.Credit
+	}
+	return VerificationCredit{}
+}
+
+type QueryListVerificationCreditsRequest struct {
+	Buyer          string `protobuf:"bytes,1,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	VerifierPermId uint64 `protobuf:"varint,2,opt,name=verifier_perm_id,json=verifierPermId,proto3" json:"verifier_perm_id,omitempty"`
+	// with_remaining only returns the bundles with credits left
+	WithRemaining   bool   `protobuf:"varint,3,opt,name=with_remaining,json=withRemaining,proto3" json:"with_remaining,omitempty"`
+	ResponseMaxSize uint32 `protobuf:"varint,4,opt,name=response_max_size,json=responseMaxSize,proto3" json:"response_max_size,omitempty"`
+}
+
+func (m *QueryListVerificationCreditsRequest) Reset()         { *m = QueryListVerificationCreditsRequest{} }
+func (m *QueryListVerificationCreditsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListVerificationCreditsRequest) ProtoMessage()    {}
+func (*QueryListVerificationCreditsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{53}
+}
+func (m *QueryListVerificationCreditsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListVerificationCreditsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListVerificationCreditsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListVerificationCreditsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListVerificationCreditsRequest.Merge(m, src)
+}
+func (m *QueryListVerificationCreditsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListVerificationCreditsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListVerificationCreditsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListVerificationCreditsRequest proto.InternalMessageInfo
+
+func (m *QueryListVerificationCreditsRequest) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
+func (m *QueryListVerificationCreditsRequest) GetVerifierPermId() uint64 {
+	if m != nil {
+		return m.VerifierPermId
+	}
+	return 0
+}
+
+func (m *QueryListVerificationCreditsRequest) GetWithRemaining() bool {
+	if m != nil {
+		return m.WithRemaining
+	}
+	return false
+}
+
+func (m *QueryListVerificationCreditsRequest) GetResponseMaxSize() uint32 {
+	if m != nil {
+		return m.ResponseMaxSize
+	}
+	return 0
+}
+
+type QueryListVerificationCreditsResponse struct {
+	Credits []VerificationCredit `protobuf:"bytes,1,rep,name=credits,proto3" json:"credits"`
+}
+
+func (m *QueryListVerificationCreditsResponse) Reset()         { *m = QueryListVerificationCreditsResponse{} }
+func (m *QueryListVerificationCreditsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListVerificationCreditsResponse) ProtoMessage()    {}
+func (*QueryListVerificationCreditsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1619f447f3af85e, []int{54}
+}
+func (m *QueryListVerificationCreditsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListVerificationCreditsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListVerificationCreditsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListVerificationCreditsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListVerificationCreditsResponse.Merge(m, src)
+}
+func (m *QueryListVerificationCreditsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListVerificationCreditsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListVerificationCreditsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListVerificationCreditsResponse proto.InternalMessageInfo
+
+func (m *QueryListVerificationCreditsResponse) GetCredits() []VerificationCredit {
+	if m != nil {
+		return m.Credits
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "verana.perm.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "verana.perm.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryListPermissionsRequest)(nil), "verana.perm.v1.QueryListPermissionsRequest")
+	proto.RegisterType((*QueryListPermissionsResponse)(nil), "verana.perm.v1.QueryListPermissionsResponse")
+	proto.RegisterType((*QueryGetPermissionRequest)(nil), "verana.perm.v1.QueryGetPermissionRequest")
+	proto.RegisterType((*QueryGetPermissionResponse)(nil), "verana.perm.v1.QueryGetPermissionResponse")
+	proto.RegisterType((*QueryGetPermissionSessionRequest)(nil), "verana.perm.v1.QueryGetPermissionSessionRequest")
+	proto.RegisterType((*QueryGetPermissionSessionResponse)(nil), "verana.perm.v1.QueryGetPermissionSessionResponse")
+	proto.RegisterType((*QueryListPermissionSessionsRequest)(nil), "verana.perm.v1.QueryListPermissionSessionsRequest")
+	proto.RegisterType((*QueryListPermissionSessionsResponse)(nil), "verana.perm.v1.QueryListPermissionSessionsResponse")
+	proto.RegisterType((*QueryFindPermissionsWithDIDRequest)(nil), "verana.perm.v1.QueryFindPermissionsWithDIDRequest")
+	proto.RegisterType((*QueryFindPermissionsWithDIDResponse)(nil), "verana.perm.v1.QueryFindPermissionsWithDIDResponse")
+	proto.RegisterType((*QueryListPermissionsByCountryRequest)(nil), "verana.perm.v1.QueryListPermissionsByCountryRequest")
+	proto.RegisterType((*QueryListPermissionsByCountryResponse)(nil), "verana.perm.v1.QueryListPermissionsByCountryResponse")
+	proto.RegisterType((*QueryFindBeneficiariesRequest)(nil), "verana.perm.v1.QueryFindBeneficiariesRequest")
+	proto.RegisterType((*QueryFindBeneficiariesResponse)(nil), "verana.perm.v1.QueryFindBeneficiariesResponse")
+	proto.RegisterType((*QueryGetSessionAllowanceRequest)(nil), "verana.perm.v1.QueryGetSessionAllowanceRequest")
+	proto.RegisterType((*QueryGetSessionAllowanceResponse)(nil), "verana.perm.v1.QueryGetSessionAllowanceResponse")
+	proto.RegisterType((*QueryListSessionAllowancesRequest)(nil), "verana.perm.v1.QueryListSessionAllowancesRequest")
+	proto.RegisterType((*QueryListSessionAllowancesResponse)(nil), "verana.perm.v1.QueryListSessionAllowancesResponse")
+	proto.RegisterType((*QueryGetSlashRecordRequest)(nil), "verana.perm.v1.QueryGetSlashRecordRequest")
+	proto.RegisterType((*QueryGetSlashRecordResponse)(nil), "verana.perm.v1.QueryGetSlashRecordResponse")
+	proto.RegisterType((*QueryListSlashRecordsRequest)(nil), "verana.perm.v1.QueryListSlashRecordsRequest")
+	proto.RegisterType((*QueryListSlashRecordsResponse)(nil), "verana.perm.v1.QueryListSlashRecordsResponse")
+	proto.RegisterType((*QueryGetSlashDistributionRequest)(nil), "verana.perm.v1.QueryGetSlashDistributionRequest")
+	proto.RegisterType((*QueryGetSlashDistributionResponse)(nil), "verana.perm.v1.QueryGetSlashDistributionResponse")
+	proto.RegisterType((*PermissionNode)(nil), "verana.perm.v1.PermissionNode")
+	proto.RegisterType((*QueryGetPermissionTreeRequest)(nil), "verana.perm.v1.QueryGetPermissionTreeRequest")
+	proto.RegisterType((*QueryGetPermissionTreeResponse)(nil), "verana.perm.v1.QueryGetPermissionTreeResponse")
+	proto.RegisterType((*QueryGetPermissionAncestryRequest)(nil), "verana.perm.v1.QueryGetPermissionAncestryRequest")
+	proto.RegisterType((*QueryGetPermissionAncestryResponse)(nil), "verana.perm.v1.QueryGetPermissionAncestryResponse")
+	proto.RegisterType((*QuerySimulateRevocationCascadeRequest)(nil), "verana.perm.v1.QuerySimulateRevocationCascadeRequest")
+	proto.RegisterType((*QuerySimulateRevocationCascadeResponse)(nil), "verana.perm.v1.QuerySimulateRevocationCascadeResponse")
+	proto.RegisterType((*QueryListRevocationCascadesRequest)(nil), "verana.perm.v1.QueryListRevocationCascadesRequest")
+	proto.RegisterType((*QueryListRevocationCascadesResponse)(nil), "verana.perm.v1.QueryListRevocationCascadesResponse")
+	proto.RegisterType((*QueryGetAgentRewardsRequest)(nil), "verana.perm.v1.QueryGetAgentRewardsRequest")
+	proto.RegisterType((*QueryGetAgentRewardsResponse)(nil), "verana.perm.v1.QueryGetAgentRewardsResponse")
+	proto.RegisterType((*QueryGetVoucherNonceRequest)(nil), "verana.perm.v1.QueryGetVoucherNonceRequest")
+	proto.RegisterType((*QueryGetVoucherNonceResponse)(nil), "verana.perm.v1.QueryGetVoucherNonceResponse")
+	proto.RegisterType((*QueryListVPMessagesRequest)(nil), "verana.perm.v1.QueryListVPMessagesRequest")
+	proto.RegisterType((*QueryListVPMessagesResponse)(nil), "verana.perm.v1.QueryListVPMessagesResponse")
+	proto.RegisterType((*QueryGetPermissionFeesRequest)(nil), "verana.perm.v1.QueryGetPermissionFeesRequest")
+	proto.RegisterType((*QueryGetPermissionFeesResponse)(nil), "verana.perm.v1.QueryGetPermissionFeesResponse")
+	proto.RegisterType((*QueryVerifySessionAuthorizationRequest)(nil), "verana.perm.v1.QueryVerifySessionAuthorizationRequest")
+	proto.RegisterType((*SessionPermissionStatus)(nil), "verana.perm.v1.SessionPermissionStatus")
+	proto.RegisterType((*QueryVerifySessionAuthorizationResponse)(nil), "verana.perm.v1.QueryVerifySessionAuthorizationResponse")
+	proto.RegisterType((*BeneficiaryFee)(nil), "verana.perm.v1.BeneficiaryFee")
+	proto.RegisterType((*QueryQuoteSessionFeesRequest)(nil), "verana.perm.v1.QueryQuoteSessionFeesRequest")
+	proto.RegisterType((*QueryQuoteSessionFeesResponse)(nil), "verana.perm.v1.QueryQuoteSessionFeesResponse")
+	proto.RegisterType((*QueryQuoteValidationFeesRequest)(nil), "verana.perm.v1.QueryQuoteValidationFeesRequest")
+	proto.RegisterType((*QueryQuoteValidationFeesResponse)(nil), "verana.perm.v1.QueryQuoteValidationFeesResponse")
+	proto.RegisterType((*QueryGetVerificationCreditRequest)(nil), "verana.perm.v1.QueryGetVerificationCreditRequest")
+	proto.RegisterType((*QueryGetVerificationCreditResponse)(nil), "verana.perm.v1.QueryGetVerificationCreditResponse")
+	proto.RegisterType((*QueryListVerificationCreditsRequest)(nil), "verana.perm.v1.QueryListVerificationCreditsRequest")
+	proto.RegisterType((*QueryListVerificationCreditsResponse)(nil), "verana.perm.v1.QueryListVerificationCreditsResponse")
+}
+
+func init() { proto.RegisterFile("verana/perm/v1/query.proto", fileDescriptor_a1619f447f3af85e) }
+
+var fileDescriptor_a1619f447f3af85e = []byte{
+	// 3031 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcb, 0x6f, 0x1c, 0xc7,
+	0xd1, 0xd7, 0x90, 0xcb, 0x57, 0x51, 0xa4, 0xcc, 0x16, 0x2d, 0xaf, 0x86, 0xd4, 0x92, 0x1a, 0x49,
+	0x16, 0x4d, 0x49, 0xbb, 0xe2, 0xca, 0xcf, 0xcf, 0x1f, 0x3e, 0x7c, 0xa4, 0x04, 0x7d, 0xd0, 0x97,
+	0xc8, 0x91, 0x57, 0xb2, 0x02, 0x38, 0x40, 0x26, 0xb3, 0x3b, 0xcd, 0xe5, 0xd8, 0xbb, 0x33, 0xab,
+	0x99, 0x59, 0x4a, 0x2b, 0x42, 0x40, 0xe2, 0x1c, 0xec, 0x24, 0x17, 0x03, 0x79, 0x20, 0xcf, 0xa3,
+	0x11, 0x07, 0x08, 0x10, 0x03, 0x3e, 0x38, 0x48, 0x90, 0x43, 0x1e, 0x07, 0x1f, 0x0d, 0x24, 0x87,
+	0x9c, 0x9c, 0xc4, 0x0a, 0x92, 0xff, 0x21, 0x97, 0x04, 0xdd, 0x5d, 0xf3, 0xd8, 0x99, 0xee, 0x7d,
+	0xc0, 0x0a, 0x90, 0x8b, 0xb4, 0x53, 0x53, 0xd5, 0xf3, 0xab, 0xea, 0xaa, 0xea, 0xaa, 0x2e, 0x82,
+	0xbe, 0x4f, 0x7d, 0xcb, 0xb5, 0x2a, 0x1d, 0xea, 0xb7, 0x2b, 0xfb, 0x5b, 0x95, 0x3b, 0x5d, 0xea,
+	0xf7, 0xca, 0x1d, 0xdf, 0x0b, 0x3d, 0xb2, 0x28, 0xde, 0x95, 0xd9, 0xbb, 0xf2, 0xfe, 0x96, 0xbe,
+	0x64, 0xb5, 0x1d, 0xd7, 0xab, 0xf0, 0x7f, 0x05, 0x8b, 0xbe, 0xdc, 0xf4, 0x9a, 0x1e, 0xff, 0x59,
+	0x61, 0xbf, 0x90, 0xba, 0xda, 0xf4, 0xbc, 0x66, 0x8b, 0x56, 0xac, 0x8e, 0x53, 0xb1, 0x5c, 0xd7,
+	0x0b, 0xad, 0xd0, 0xf1, 0xdc, 0x00, 0xdf, 0x6e, 0x36, 0xbc, 0xa0, 0xed, 0x05, 0x95, 0xba, 0x15,
+	0x50, 0xf1, 0xbd, 0xca, 0xfe, 0x56, 0x9d, 0x86, 0xd6, 0x56, 0xa5, 0x63, 0x35, 0x1d, 0x97, 0x33,
+	0x23, 0xef, 0x4a, 0x06, 0x5e, 0xc7, 0xf2, 0xad, 0x76, 0xb4, 0x50, 0x16, 0x7b, 0xd8, 0xeb, 0xd0,
+	0xe8, 0xdd, 0x1a, 0x42, 0xe0, 0x4f, 0xf5, 0xee, 0x6e, 0x25, 0x74, 0xda, 0x34, 0x08, 0xad, 0x76,
+	0x47, 0x30, 0x18, 0xcb, 0x40, 0x5e, 0x66, 0xdf, 0xbe, 0xc1, 0x57, 0xac, 0xd1, 0x3b, 0x5d, 0x1a,
+	0x84, 0xc6, 0x0d, 0x38, 0xda, 0x47, 0x0d, 0x3a, 0x9e, 0x1b, 0x50, 0xf2, 0x02, 0x4c, 0x8b, 0x2f,
+	0x17, 0xb5, 0x75, 0x6d, 0x63, 0xbe, 0x7a, 0xac, 0xdc, 0x6f, 0x9a, 0xb2, 0xe0, 0xdf, 0x99, 0xfb,
+	0xf0, 0xe3, 0xb5, 0x43, 0xef, 0xfe, 0xfd, 0xbd, 0x4d, 0xad, 0x86, 0x02, 0xc6, 0x77, 0x34, 0x58,
+	0xe1, 0x4b, 0x7e, 0xd6, 0x09, 0xc2, 0x1b, 0xd4, 0x6f, 0x3b, 0x41, 0xc0, 0x8c, 0x81, 0x5f, 0x24,
+	0x9f, 0x81, 0xc5, 0xb6, 0x67, 0x3b, 0xbb, 0x0e, 0xb5, 0x4d, 0x6b, 0x37, 0xa4, 0x3e, 0x7e, 0x42,
+	0x2f, 0x0b, 0x0d, 0xca, 0x91, 0x06, 0xe5, 0x5b, 0x91, 0x06, 0x3b, 0xb3, 0x1f, 0x7e, 0xbc, 0xa6,
+	0xbd, 0xfd, 0xa7, 0x35, 0xad, 0xb6, 0x10, 0xc9, 0x6e, 0x33, 0x51, 0xb2, 0x09, 0x4b, 0x3e, 0x62,
+	0x36, 0xdb, 0xd6, 0x3d, 0x33, 0x70, 0xee, 0xd3, 0xe2, 0xc4, 0xba, 0xb6, 0xb1, 0x50, 0x3b, 0x12,
+	0xbd, 0xb8, 0x6e, 0xdd, 0xbb, 0xe9, 0xdc, 0xa7, 0x46, 0x1d, 0x56, 0xe5, 0xb8, 0x50, 0xe7, 0x1d,
+	0x98, 0xef, 0x24, 0xe4, 0xa2, 0xb6, 0x3e, 0xc9, 0x51, 0x65, 0x15, 0x8f, 0x59, 0x76, 0x0a, 0x4c,
+	0xf9, 0x5a, 0x5a, 0xc8, 0x38, 0x07, 0xc7, 0xf9, 0x37, 0xfe, 0x8f, 0xa6, 0x3e, 0x11, 0x69, 0xbe,
+	0x08, 0x13, 0x8e, 0xcd, 0xb5, 0x2d, 0xd4, 0x26, 0x1c, 0xdb, 0xf8, 0x22, 0xe8, 0x32, 0x66, 0x84,
+	0xf3, 0xbf, 0x00, 0xc9, 0xca, 0xb1, 0x8d, 0x86, 0xa1, 0x49, 0xc9, 0x18, 0x55, 0x58, 0xcf, 0xaf,
+	0x7f, 0x93, 0x2a, 0x30, 0xcd, 0x71, 0x4c, 0x5f, 0x82, 0x93, 0x03, 0x64, 0x10, 0xda, 0x8b, 0x30,
+	0x13, 0xd0, 0x34, 0xae, 0x93, 0x6a, 0x5c, 0x91, 0x6c, 0x24, 0x61, 0xfc, 0x45, 0x03, 0x43, 0xb2,
+	0x0f, 0x37, 0xe9, 0x7f, 0x86, 0x9b, 0x90, 0x12, 0x40, 0xc3, 0x73, 0x43, 0xdf, 0x6b, 0xb5, 0xa8,
+	0x5f, 0x9c, 0xe4, 0x96, 0x49, 0x51, 0x88, 0x01, 0x0b, 0x56, 0x93, 0xba, 0xa1, 0xc9, 0x74, 0x35,
+	0x1d, 0xbb, 0x58, 0xe0, 0x1b, 0x3a, 0xcf, 0x89, 0x4c, 0x9f, 0x6b, 0xb6, 0xf1, 0x1a, 0x9c, 0x1a,
+	0xa8, 0x22, 0xda, 0xf1, 0x32, 0xcc, 0x06, 0xb4, 0xcf, 0xdd, 0x86, 0x1b, 0x12, 0xf7, 0x39, 0x16,
+	0x34, 0x7e, 0x1d, 0xd9, 0xf3, 0xaa, 0xe3, 0xda, 0x09, 0x7b, 0xf0, 0x79, 0x27, 0xdc, 0xbb, 0x72,
+	0xed, 0x4a, 0x64, 0xcf, 0xc7, 0x60, 0xd2, 0x8e, 0x77, 0x9a, 0xfd, 0x24, 0x04, 0x0a, 0x2c, 0x81,
+	0xa0, 0x1d, 0xf8, 0x6f, 0xb2, 0x02, 0x73, 0x41, 0x63, 0x8f, 0xb6, 0x2d, 0xa6, 0xd8, 0x24, 0x57,
+	0x6c, 0x56, 0x10, 0xae, 0xd9, 0xa4, 0x04, 0x33, 0x0d, 0xaf, 0xeb, 0x86, 0x7e, 0x8f, 0xeb, 0x3c,
+	0xc7, 0xa1, 0x68, 0xb5, 0x88, 0x48, 0x9e, 0x87, 0xc2, 0xdd, 0x3d, 0xea, 0x16, 0xa7, 0xc6, 0xd8,
+	0x28, 0x2e, 0x61, 0x38, 0x70, 0x6a, 0xa0, 0x0a, 0x8f, 0x30, 0x42, 0x7f, 0xa5, 0xc1, 0x69, 0x59,
+	0x1a, 0xd8, 0xe9, 0x5d, 0x16, 0x6a, 0x44, 0x06, 0x2b, 0x26, 0xda, 0x0a, 0xa3, 0xc5, 0x7a, 0xf6,
+	0x19, 0x69, 0x22, 0x63, 0xa4, 0x2a, 0x5a, 0x95, 0x19, 0x6f, 0xb1, 0x5a, 0x52, 0x83, 0xbb, 0xd5,
+	0xeb, 0x50, 0xb4, 0xba, 0xd4, 0x3d, 0x0b, 0xf2, 0x2c, 0xf6, 0x3a, 0x9c, 0x19, 0x02, 0xff, 0x11,
+	0x1a, 0xcb, 0x83, 0x13, 0xf1, 0xbe, 0xec, 0x50, 0x97, 0xee, 0x3a, 0x0d, 0xc7, 0xf2, 0x1d, 0x1a,
+	0x47, 0xe9, 0x69, 0x58, 0x74, 0x82, 0xa0, 0x4b, 0xfd, 0x38, 0x1a, 0x44, 0x7a, 0x3b, 0x2c, 0xa8,
+	0x22, 0x1c, 0xc8, 0x06, 0x3c, 0xb6, 0x4f, 0x7d, 0x16, 0x8f, 0x09, 0x9f, 0xb0, 0xdb, 0x62, 0x44,
+	0xc7, 0xc0, 0xb1, 0xa1, 0xa4, 0xfa, 0xe0, 0x23, 0x54, 0x6b, 0x0b, 0xd6, 0xa2, 0x24, 0x87, 0x51,
+	0xb5, 0xdd, 0x6a, 0x79, 0x77, 0x2d, 0xb7, 0x41, 0x55, 0xb9, 0x7a, 0x0f, 0xd6, 0xd5, 0x22, 0x08,
+	0xed, 0x0a, 0xcc, 0x59, 0x11, 0x11, 0xb3, 0xd5, 0x7a, 0x16, 0x58, 0x56, 0x18, 0xe1, 0x25, 0x82,
+	0xc6, 0x1f, 0x34, 0x38, 0x19, 0xef, 0x70, 0x96, 0x3d, 0x48, 0x79, 0x27, 0xff, 0xaa, 0xe7, 0x47,
+	0xde, 0x89, 0x8f, 0x83, 0xbd, 0x33, 0x97, 0xbc, 0x26, 0x73, 0xc9, 0x4b, 0xba, 0x5b, 0x05, 0xd9,
+	0x6e, 0xc9, 0xfd, 0x76, 0x4a, 0xee, 0xb7, 0x2d, 0x30, 0x06, 0x69, 0x85, 0x26, 0xbc, 0x0a, 0x10,
+	0x5b, 0x22, 0xda, 0xdc, 0x51, 0x6d, 0x98, 0x92, 0x34, 0xce, 0x27, 0x47, 0xeb, 0xcd, 0x96, 0x15,
+	0xec, 0xd5, 0x68, 0xc3, 0xf3, 0x6d, 0xd5, 0xe6, 0x36, 0x60, 0x45, 0xca, 0x1d, 0xef, 0xeb, 0xe1,
+	0x80, 0x91, 0x4d, 0x9f, 0xd3, 0x71, 0x6b, 0x57, 0x72, 0xb0, 0x12, 0xd1, 0xc8, 0xe9, 0x82, 0x84,
+	0x64, 0x7c, 0xa0, 0xa5, 0xea, 0x8f, 0x14, 0x6f, 0xbc, 0xa5, 0x4f, 0xc0, 0x4c, 0x7f, 0x10, 0x4d,
+	0x77, 0x84, 0x99, 0x8b, 0x30, 0xd3, 0xf4, 0x2d, 0x37, 0xa4, 0x22, 0x57, 0xcf, 0xd5, 0xa2, 0x47,
+	0x72, 0x02, 0x80, 0x7f, 0x82, 0xda, 0x66, 0xbd, 0x87, 0x67, 0xd5, 0x1c, 0x52, 0x76, 0x7a, 0xe3,
+	0xe4, 0x15, 0x72, 0x1c, 0x66, 0xf9, 0x31, 0xcb, 0x3e, 0x3f, 0xc5, 0x3f, 0x3f, 0xc3, 0x9f, 0xaf,
+	0xd9, 0xc6, 0x37, 0x34, 0x38, 0xa1, 0x40, 0x1e, 0x6f, 0xdb, 0x42, 0xda, 0x42, 0xd1, 0xce, 0x8d,
+	0x60, 0xa2, 0xc3, 0x29, 0x13, 0x05, 0xcc, 0x3d, 0x5d, 0x7a, 0x2f, 0x34, 0x63, 0x24, 0xc2, 0x7f,
+	0xe7, 0x19, 0x71, 0x1b, 0xd1, 0x3c, 0x97, 0x8a, 0x44, 0x26, 0x7b, 0xc5, 0x09, 0x42, 0xdf, 0xa9,
+	0x77, 0xc3, 0x54, 0x55, 0x73, 0x14, 0xa6, 0x42, 0x3f, 0x31, 0x64, 0x21, 0x64, 0x82, 0x6f, 0x69,
+	0x70, 0x72, 0x80, 0x24, 0xaa, 0xd2, 0x00, 0x22, 0x54, 0xb1, 0x53, 0x6f, 0x71, 0xcb, 0xcb, 0x59,
+	0x7d, 0x6e, 0xf9, 0xdd, 0x20, 0xac, 0xd1, 0x26, 0x63, 0xed, 0xe5, 0xd6, 0x44, 0x15, 0x97, 0x82,
+	0xec, 0x0b, 0xe3, 0x1d, 0x0d, 0x16, 0x93, 0x14, 0xf5, 0x92, 0x67, 0x3f, 0x82, 0x72, 0x8f, 0x2c,
+	0xc3, 0x94, 0x4d, 0x3b, 0xe1, 0x1e, 0x1e, 0xe8, 0xe2, 0x81, 0x51, 0xf7, 0xad, 0x16, 0x46, 0xfa,
+	0x6c, 0x4d, 0x3c, 0x90, 0x33, 0xb0, 0xe8, 0xb8, 0xfc, 0xa7, 0xe9, 0x53, 0x2b, 0xf0, 0x5c, 0x71,
+	0xa2, 0xd7, 0x16, 0x90, 0x5a, 0xe3, 0x44, 0xe3, 0x2b, 0x13, 0x70, 0x22, 0x5f, 0x0e, 0xde, 0xf2,
+	0x29, 0x4d, 0x39, 0xad, 0xef, 0x79, 0x61, 0xca, 0x69, 0xd9, 0xe3, 0x35, 0x5b, 0x81, 0x86, 0xa4,
+	0x4e, 0xc7, 0xa8, 0xe6, 0x28, 0x66, 0xca, 0x8a, 0x47, 0x50, 0x50, 0xb0, 0xc0, 0xf0, 0xdc, 0x56,
+	0xcf, 0x14, 0xaa, 0x4f, 0x73, 0xd5, 0xe7, 0x18, 0xe5, 0x36, 0x57, 0x5f, 0x1a, 0x18, 0x33, 0xf2,
+	0xc4, 0x75, 0x1f, 0x4a, 0x2a, 0x13, 0xa0, 0xcb, 0xfc, 0x17, 0x4c, 0xb9, 0x9e, 0x1d, 0xe7, 0xab,
+	0x01, 0x67, 0x3e, 0xdb, 0x69, 0xdc, 0x39, 0x21, 0x42, 0x56, 0x61, 0x2e, 0xf4, 0xbb, 0x6e, 0xc3,
+	0x0a, 0xa9, 0xf0, 0xf6, 0xd9, 0x5a, 0x42, 0x30, 0xde, 0xd4, 0x64, 0xe5, 0xf8, 0x36, 0x4b, 0x71,
+	0xa9, 0x4a, 0x25, 0x93, 0xce, 0xd2, 0x06, 0x9d, 0x90, 0x1b, 0x74, 0x72, 0xec, 0x0a, 0xed, 0x6b,
+	0x51, 0x95, 0xa9, 0x40, 0x12, 0x9f, 0xce, 0x73, 0x3c, 0x01, 0x87, 0x9e, 0x3f, 0x9e, 0x39, 0x12,
+	0x31, 0xb2, 0x06, 0xf3, 0x8d, 0x3d, 0xcb, 0x71, 0x71, 0xf3, 0x84, 0x51, 0x80, 0x93, 0xf8, 0xee,
+	0x19, 0xdf, 0xd2, 0xb0, 0x06, 0xba, 0xe9, 0xb4, 0xbb, 0x2d, 0x2b, 0xa4, 0x35, 0xba, 0xef, 0x35,
+	0x78, 0x17, 0x7d, 0xd9, 0x0a, 0x1a, 0x96, 0xad, 0x3a, 0xc5, 0x49, 0x05, 0x0a, 0x6d, 0xcf, 0x16,
+	0x69, 0x74, 0x31, 0x9f, 0x9e, 0x50, 0xfa, 0xba, 0x67, 0xd3, 0x1a, 0x67, 0x94, 0x3b, 0xca, 0xa4,
+	0xdc, 0x51, 0xde, 0xd2, 0xe0, 0xc9, 0x61, 0xb0, 0xe2, 0xde, 0x6e, 0xd6, 0xda, 0xdd, 0xa5, 0x0d,
+	0xb6, 0xe9, 0xe3, 0x58, 0x29, 0x96, 0x1a, 0xe2, 0x37, 0x37, 0x52, 0x87, 0x6d, 0x0e, 0x45, 0x7c,
+	0xe0, 0x48, 0x95, 0xd3, 0xe4, 0xca, 0xa5, 0x3b, 0x1a, 0xd9, 0x8a, 0x49, 0x47, 0xd3, 0x40, 0x9a,
+	0xaa, 0xa3, 0xc9, 0x49, 0x47, 0xba, 0x45, 0x82, 0xc6, 0xb3, 0xc9, 0x71, 0xbc, 0xcd, 0xea, 0x92,
+	0x1a, 0xbd, 0x6b, 0x8d, 0x70, 0x4e, 0x1a, 0x36, 0xac, 0xca, 0xe5, 0x92, 0x73, 0x5c, 0x14, 0x3f,
+	0x3e, 0x7f, 0xa1, 0x3a, 0xc7, 0x53, 0xb2, 0xd1, 0x39, 0x6e, 0x25, 0x24, 0xe3, 0x99, 0x04, 0xdd,
+	0x6d, 0xaf, 0xdb, 0xd8, 0xa3, 0xfe, 0x4b, 0x5e, 0xaa, 0x70, 0x3c, 0x06, 0xd3, 0x81, 0xd3, 0x74,
+	0x69, 0x54, 0x97, 0xe1, 0x93, 0xf1, 0x0a, 0xac, 0xca, 0xc5, 0x10, 0xdc, 0x32, 0x4b, 0x22, 0x51,
+	0xe1, 0x58, 0xa8, 0x89, 0x07, 0x16, 0x0b, 0xdd, 0x80, 0xda, 0x26, 0x7f, 0x0a, 0x8a, 0x13, 0xeb,
+	0x93, 0x1b, 0x85, 0x1a, 0x30, 0x12, 0x97, 0x0e, 0x8c, 0x37, 0x34, 0xd0, 0xe3, 0x8d, 0xb9, 0x7d,
+	0xe3, 0x3a, 0x0d, 0x02, 0xab, 0x49, 0x87, 0xd7, 0x14, 0x6b, 0x30, 0x8f, 0x87, 0xac, 0x6b, 0xd3,
+	0x7b, 0x78, 0xce, 0x82, 0x38, 0xf1, 0x19, 0x65, 0x2c, 0xcf, 0x7f, 0x15, 0x56, 0xa4, 0x18, 0xe2,
+	0xeb, 0x82, 0xd9, 0x36, 0xd2, 0xd0, 0x29, 0x8e, 0x67, 0x6d, 0x1e, 0x4b, 0x45, 0xce, 0x10, 0x09,
+	0x18, 0x5f, 0x90, 0x9d, 0x40, 0x57, 0x29, 0x0d, 0x54, 0x31, 0x3e, 0xce, 0x95, 0xd0, 0x43, 0x0d,
+	0x4a, 0xaa, 0xd5, 0x11, 0xfc, 0xff, 0xc0, 0x4c, 0xa3, 0xeb, 0xfb, 0xd4, 0x0d, 0xd1, 0x5f, 0x06,
+	0x44, 0x2a, 0x13, 0x44, 0x05, 0x22, 0x21, 0xf2, 0xdf, 0xa2, 0x1c, 0xb7, 0xbb, 0x2d, 0x0c, 0xd4,
+	0xa1, 0x2b, 0xd4, 0x12, 0x01, 0x72, 0x19, 0x66, 0xf6, 0x1c, 0x96, 0x16, 0x59, 0x75, 0xc7, 0x2c,
+	0x77, 0x6a, 0xa0, 0x6c, 0x5f, 0x69, 0x15, 0x49, 0x1a, 0xff, 0x8c, 0x12, 0xd3, 0x6d, 0x56, 0xbe,
+	0xf7, 0xa2, 0xf2, 0xb9, 0x1b, 0xee, 0x79, 0xbe, 0x73, 0xdf, 0x4a, 0x17, 0x4e, 0xac, 0xa0, 0x14,
+	0xaf, 0xcd, 0xf8, 0xb2, 0x60, 0x0e, 0x29, 0xa2, 0x35, 0xa0, 0xf7, 0x68, 0xa3, 0x1b, 0x7a, 0xb9,
+	0x46, 0x2e, 0xa2, 0x63, 0x6b, 0x50, 0x86, 0xa3, 0xf5, 0xb8, 0x7f, 0xeb, 0x65, 0xda, 0x8d, 0xa5,
+	0xd4, 0x2b, 0xe4, 0x1f, 0xe1, 0x56, 0xe5, 0x53, 0xdc, 0x2f, 0x7c, 0x55, 0x83, 0x27, 0x50, 0xed,
+	0xd4, 0x85, 0x4a, 0x68, 0x85, 0xdd, 0x80, 0x95, 0x24, 0xbe, 0xd7, 0xa2, 0xa8, 0x2c, 0xff, 0x9d,
+	0x0e, 0x9b, 0x89, 0xbe, 0xb0, 0xf9, 0x54, 0xd5, 0xd4, 0xdf, 0x34, 0x38, 0x3b, 0x74, 0x1f, 0xd0,
+	0xed, 0x4a, 0x00, 0x16, 0xbe, 0xa0, 0x62, 0x23, 0x66, 0x6b, 0x29, 0x0a, 0x4b, 0x33, 0xf8, 0x29,
+	0x71, 0xc4, 0xe3, 0x13, 0xa9, 0xc2, 0x14, 0xe3, 0xba, 0x8f, 0x47, 0xfc, 0xaa, 0xaa, 0x77, 0x62,
+	0x3c, 0x35, 0xc1, 0x4a, 0x3e, 0xd7, 0xdf, 0x52, 0x17, 0xb8, 0xa3, 0x9d, 0x55, 0x48, 0x66, 0xed,
+	0x27, 0xeb, 0xaf, 0x7f, 0x32, 0x01, 0x8b, 0x49, 0xf7, 0xde, 0xbb, 0x4a, 0xa9, 0x3a, 0x11, 0x55,
+	0x53, 0xb7, 0x50, 0xa3, 0xde, 0x97, 0xa4, 0x1a, 0xa2, 0xc9, 0xfe, 0x86, 0x88, 0x40, 0x61, 0x97,
+	0xd2, 0x00, 0xbd, 0x87, 0xff, 0x66, 0xae, 0xc5, 0xfe, 0x37, 0x1d, 0xd7, 0xb4, 0xa9, 0xeb, 0xb5,
+	0xb1, 0xbd, 0x99, 0x67, 0xc4, 0x6b, 0xee, 0x15, 0x46, 0x62, 0xe9, 0xd0, 0x76, 0x7c, 0xda, 0x08,
+	0x4d, 0x2e, 0x3e, 0x2d, 0xd2, 0xa1, 0x20, 0xb1, 0x98, 0x24, 0xa7, 0x60, 0x21, 0x64, 0xc5, 0xbe,
+	0x69, 0xd3, 0x8e, 0x17, 0x38, 0x21, 0xaf, 0x16, 0x0b, 0xb5, 0xc3, 0x9c, 0x78, 0x45, 0xd0, 0x48,
+	0x15, 0x1e, 0x6f, 0xf8, 0xd4, 0x62, 0xd1, 0xd1, 0xcf, 0x3c, 0xcb, 0x99, 0x8f, 0xe2, 0xcb, 0x5b,
+	0x29, 0x19, 0xe3, 0xb7, 0x51, 0x5b, 0xf8, 0x72, 0xd7, 0x0b, 0x29, 0x1a, 0xf9, 0x2a, 0xfd, 0xb7,
+	0x5d, 0xb1, 0x8c, 0x74, 0x05, 0x50, 0x81, 0xe5, 0xbb, 0x56, 0xab, 0x45, 0x43, 0x53, 0x16, 0x94,
+	0x4b, 0xe2, 0xdd, 0x76, 0x22, 0x60, 0xbc, 0x19, 0x35, 0x0a, 0x79, 0x2d, 0xd0, 0xa1, 0xff, 0x1f,
+	0x16, 0xea, 0xe9, 0x0b, 0x1d, 0x55, 0xdd, 0xd3, 0xef, 0x37, 0xe8, 0x5d, 0xfd, 0xa2, 0x2c, 0xc5,
+	0x77, 0x03, 0xea, 0x9b, 0x7d, 0xa7, 0xb9, 0xd0, 0xf6, 0x08, 0x7b, 0x91, 0x3a, 0xc1, 0x59, 0x22,
+	0xea, 0x53, 0x05, 0xb9, 0x27, 0x73, 0x9a, 0x20, 0xff, 0x32, 0x4c, 0x85, 0x5e, 0x68, 0xb5, 0x50,
+	0x57, 0xf1, 0xc0, 0xcc, 0x2b, 0x76, 0xb4, 0xeb, 0x3a, 0xa1, 0xd9, 0xf1, 0x9d, 0x06, 0x45, 0x37,
+	0x5a, 0xe4, 0xf4, 0x57, 0x5c, 0x27, 0xbc, 0xc1, 0xa8, 0xc6, 0x75, 0x58, 0x4b, 0x0c, 0xc1, 0xeb,
+	0x55, 0x2b, 0x8c, 0x6d, 0x11, 0xd7, 0x5d, 0xfb, 0xe2, 0x85, 0x97, 0xdd, 0xd4, 0x23, 0xf1, 0x0b,
+	0x34, 0xec, 0x97, 0x27, 0x60, 0x5d, 0xbd, 0x1e, 0xda, 0x76, 0x8c, 0x05, 0xc9, 0x59, 0x88, 0x48,
+	0x2c, 0xc9, 0x73, 0x6f, 0x8f, 0xfc, 0xa4, 0x6f, 0xf1, 0x7c, 0xd8, 0x4c, 0xe6, 0xc3, 0x26, 0x17,
+	0x15, 0x05, 0x49, 0x54, 0xc4, 0x16, 0x9d, 0x1a, 0x66, 0xd1, 0x69, 0xa9, 0x45, 0x2f, 0x25, 0x3d,
+	0x10, 0x4f, 0x9c, 0x0e, 0x56, 0x8f, 0x3e, 0xb5, 0x9d, 0x50, 0x75, 0xa5, 0xb3, 0x0b, 0xc6, 0x20,
+	0xa1, 0xb8, 0x0e, 0x9f, 0x6e, 0x70, 0x0a, 0x9e, 0xed, 0x46, 0xae, 0x2e, 0xc9, 0xc9, 0xa2, 0x47,
+	0xa2, 0x9c, 0xf1, 0x73, 0x2d, 0x55, 0x18, 0xe7, 0xb9, 0xe3, 0x3d, 0x5f, 0x86, 0xa9, 0x7a, 0xb7,
+	0x17, 0x57, 0x85, 0xe2, 0x61, 0x8c, 0xa8, 0x3d, 0x03, 0x8b, 0x77, 0x9d, 0x90, 0x5d, 0xb0, 0xb4,
+	0x2d, 0xc7, 0x75, 0xdc, 0x26, 0x9e, 0x40, 0x0b, 0x8c, 0x5a, 0x8b, 0x88, 0x63, 0xdd, 0x24, 0xbf,
+	0x06, 0xa7, 0x07, 0x23, 0x8f, 0x7b, 0xba, 0x19, 0xa1, 0x6c, 0x14, 0xb3, 0xa3, 0x5b, 0x29, 0x12,
+	0xac, 0xfe, 0xe3, 0x24, 0x4c, 0xf1, 0x8f, 0x91, 0x3b, 0x30, 0x2d, 0x66, 0x87, 0x24, 0xb7, 0x4c,
+	0x7e, 0x3c, 0xa9, 0x9f, 0x1a, 0xc8, 0x23, 0x00, 0x1a, 0xa5, 0x37, 0x7e, 0xff, 0xd7, 0x6f, 0x4e,
+	0x14, 0xc9, 0xb1, 0x8a, 0x74, 0x78, 0x4a, 0xbe, 0xae, 0xc1, 0x91, 0xcc, 0x75, 0x39, 0x39, 0x27,
+	0x5d, 0x58, 0x3e, 0xb2, 0xd4, 0xcf, 0x8f, 0xc6, 0x8c, 0x70, 0x56, 0x39, 0x9c, 0x63, 0x64, 0x39,
+	0x0b, 0xa7, 0xe5, 0x04, 0x21, 0x79, 0x4b, 0x83, 0x85, 0xbe, 0x6a, 0x93, 0x3c, 0x25, 0x5d, 0x5d,
+	0x36, 0x41, 0xd4, 0x37, 0x47, 0x61, 0x45, 0x18, 0xeb, 0x1c, 0x86, 0x4e, 0x8a, 0x59, 0x18, 0x4d,
+	0x1a, 0x56, 0x0e, 0x1c, 0xfb, 0x01, 0x79, 0x47, 0x83, 0x65, 0xd9, 0x9c, 0x8f, 0x5c, 0x1c, 0xfe,
+	0x99, 0xfe, 0x31, 0xa2, 0xbe, 0x35, 0x86, 0x04, 0xe2, 0xdb, 0xe0, 0xf8, 0x0c, 0xb2, 0x2e, 0xc1,
+	0x67, 0x62, 0xc9, 0x29, 0x70, 0xfe, 0x58, 0x83, 0x63, 0xf2, 0x49, 0x1a, 0xa9, 0x8e, 0xb0, 0x33,
+	0x99, 0xc9, 0xa2, 0x7e, 0x69, 0x2c, 0x19, 0x44, 0x7b, 0x9a, 0xa3, 0x2d, 0x91, 0x55, 0xd9, 0xa6,
+	0x46, 0x70, 0xc9, 0xbb, 0x1a, 0x1c, 0x93, 0xcf, 0xb0, 0x14, 0x48, 0x07, 0xce, 0xec, 0xf4, 0x4b,
+	0x63, 0xc9, 0x20, 0xd2, 0x33, 0x1c, 0xe9, 0x1a, 0x39, 0x91, 0x45, 0xba, 0xeb, 0xb8, 0xb6, 0xc9,
+	0x93, 0x08, 0x9b, 0xfe, 0xfd, 0x42, 0x83, 0xa2, 0x6a, 0x86, 0x44, 0x9e, 0x1e, 0xc5, 0xe1, 0xb3,
+	0x13, 0x33, 0xfd, 0x99, 0x31, 0xa5, 0x10, 0xf0, 0x16, 0x07, 0x7c, 0x8e, 0x3c, 0x25, 0x35, 0x6d,
+	0xbd, 0x67, 0xe2, 0xed, 0x55, 0xe5, 0x00, 0x7f, 0x3c, 0x20, 0xdf, 0xd3, 0x60, 0x29, 0x37, 0x22,
+	0x22, 0x17, 0x94, 0xe6, 0x92, 0xcd, 0xae, 0xf4, 0xf2, 0xa8, 0xec, 0xc3, 0x0c, 0xdb, 0x5f, 0x9c,
+	0xfc, 0x54, 0x83, 0xa3, 0x92, 0x29, 0x11, 0xa9, 0xa8, 0x42, 0x44, 0x31, 0x82, 0xd2, 0x2f, 0x8e,
+	0x2e, 0x80, 0x08, 0xab, 0x1c, 0xe1, 0x79, 0xb2, 0x39, 0x20, 0xa4, 0xcc, 0x78, 0x4a, 0x22, 0x82,
+	0xeb, 0x67, 0x1a, 0x3c, 0x2e, 0x9d, 0xc9, 0x90, 0x2d, 0xe5, 0x76, 0xaa, 0xa6, 0x52, 0x7a, 0x75,
+	0x1c, 0x11, 0x04, 0x5d, 0xe1, 0xa0, 0x9f, 0x22, 0x67, 0x07, 0x45, 0x56, 0x82, 0x3a, 0x20, 0xdf,
+	0xd5, 0x60, 0xb1, 0x7f, 0x52, 0x43, 0x94, 0x79, 0x31, 0x3f, 0xfc, 0xd1, 0xcf, 0x8d, 0xc4, 0x8b,
+	0xe0, 0x2e, 0x70, 0x70, 0x67, 0xc9, 0x19, 0xa9, 0x45, 0x53, 0x23, 0x0f, 0x61, 0xcc, 0x1f, 0x68,
+	0xf0, 0x58, 0x76, 0x48, 0x42, 0xd4, 0xa7, 0x87, 0x64, 0x0a, 0xa4, 0x5f, 0x18, 0x91, 0x1b, 0x01,
+	0x6e, 0x72, 0x80, 0xa7, 0x89, 0x21, 0xb7, 0x5e, 0x0a, 0x61, 0x40, 0xde, 0x17, 0xf9, 0x3e, 0x37,
+	0xa7, 0x50, 0xe7, 0x7b, 0xd5, 0x80, 0x45, 0xdf, 0x1a, 0x43, 0x02, 0x91, 0x3e, 0xcb, 0x91, 0x5e,
+	0x24, 0x65, 0xb5, 0x29, 0xd3, 0x23, 0x97, 0xca, 0x01, 0x9f, 0xe0, 0x3c, 0x20, 0xdf, 0xd7, 0x60,
+	0x29, 0x77, 0xf7, 0xae, 0x88, 0x75, 0xd5, 0x98, 0x42, 0x2f, 0x8f, 0xca, 0x8e, 0x60, 0x9f, 0xe4,
+	0x60, 0xd7, 0x49, 0x29, 0x0b, 0x36, 0xf4, 0x29, 0xad, 0x1c, 0xe0, 0xc8, 0x83, 0x1f, 0xa1, 0x8f,
+	0x4b, 0x6f, 0xc4, 0xc9, 0x08, 0x27, 0x62, 0xe6, 0x1e, 0x5f, 0xaf, 0x8e, 0x23, 0x32, 0x2c, 0x29,
+	0x59, 0xc8, 0x29, 0x1c, 0xf3, 0x77, 0x1a, 0x1c, 0x57, 0x5e, 0x4b, 0x13, 0x79, 0xe2, 0x1e, 0x76,
+	0xbb, 0xae, 0x3f, 0x3b, 0xae, 0x18, 0x62, 0x7e, 0x9e, 0x63, 0xae, 0x92, 0x8b, 0x59, 0xcc, 0x01,
+	0x8a, 0x9a, 0x7e, 0x2c, 0x6b, 0xe2, 0xad, 0xb0, 0x50, 0xe3, 0x7d, 0xac, 0x04, 0x72, 0x6b, 0x0f,
+	0xaa, 0x04, 0x94, 0x17, 0xe0, 0xfa, 0xa5, 0xb1, 0x64, 0x10, 0xfd, 0x45, 0x8e, 0x7e, 0x93, 0x6c,
+	0x48, 0x23, 0x2e, 0x8f, 0x3c, 0x20, 0xbf, 0xd1, 0x40, 0x57, 0x5f, 0xf9, 0x10, 0xb9, 0x19, 0x87,
+	0xde, 0xd5, 0xe9, 0xcf, 0x8d, 0x2d, 0x87, 0x1a, 0x5c, 0xe2, 0x1a, 0x5c, 0x20, 0xe7, 0xb2, 0x1a,
+	0xf0, 0x9e, 0xa3, 0x97, 0x14, 0x5f, 0xc9, 0x55, 0xa0, 0x48, 0x6d, 0xd9, 0xe6, 0x5e, 0x91, 0xda,
+	0x14, 0x37, 0x19, 0xfa, 0x85, 0x11, 0xb9, 0x87, 0xa5, 0xb6, 0x3b, 0x4c, 0x22, 0x3e, 0x19, 0xf8,
+	0x1d, 0xcf, 0x2f, 0x35, 0x38, 0x2a, 0xe9, 0x90, 0x15, 0x87, 0xae, 0xba, 0x37, 0xd7, 0x2f, 0x8e,
+	0x2e, 0x80, 0x30, 0xb7, 0x39, 0xcc, 0x17, 0xc9, 0x0b, 0x72, 0x98, 0x99, 0x66, 0xbb, 0x72, 0x90,
+	0xeb, 0xd4, 0x1f, 0x90, 0xf7, 0x44, 0x16, 0xc9, 0xb7, 0x51, 0xea, 0x2c, 0xa2, 0xec, 0x84, 0xf5,
+	0xea, 0x38, 0x22, 0xc3, 0x7c, 0x7a, 0x3f, 0x25, 0x63, 0x8a, 0x66, 0x4e, 0x44, 0xe2, 0x07, 0x1a,
+	0x3c, 0xa1, 0x68, 0x1c, 0x89, 0x3a, 0xac, 0xd4, 0x0d, 0xb2, 0xfe, 0xf4, 0x78, 0x42, 0x23, 0xd5,
+	0x8e, 0x12, 0xf4, 0x01, 0xf9, 0x91, 0x06, 0x47, 0x32, 0x13, 0x22, 0xa2, 0xac, 0x09, 0x24, 0xf3,
+	0x27, 0xfd, 0xfc, 0x68, 0xcc, 0xc3, 0xca, 0x9b, 0xf4, 0x75, 0x54, 0x50, 0x39, 0x88, 0x9d, 0xe1,
+	0x87, 0x02, 0x5f, 0x7a, 0x48, 0xa4, 0xc6, 0x27, 0x99, 0x40, 0xe9, 0xe7, 0x47, 0x63, 0x46, 0x7c,
+	0x65, 0x8e, 0x6f, 0x83, 0x3c, 0x99, 0xdb, 0x7a, 0xc1, 0x2d, 0x46, 0x4f, 0x95, 0x03, 0x31, 0xc6,
+	0x7a, 0xc0, 0xab, 0xaf, 0xfe, 0x39, 0x8f, 0xa2, 0xfa, 0x92, 0x0e, 0xa4, 0xf4, 0x73, 0x23, 0xf1,
+	0x0e, 0xab, 0xbe, 0xf6, 0x3b, 0x66, 0x34, 0x20, 0x4a, 0x59, 0xee, 0xdb, 0xd9, 0x4a, 0x81, 0xa7,
+	0x80, 0x11, 0x2a, 0x85, 0x74, 0x02, 0x28, 0x8f, 0xca, 0x8e, 0x18, 0x4f, 0x72, 0x8c, 0x2b, 0xe4,
+	0x78, 0xae, 0xdd, 0xe2, 0xd1, 0xee, 0xd8, 0x0f, 0x76, 0x5e, 0xf9, 0xf0, 0x93, 0x92, 0xf6, 0xd1,
+	0x27, 0x25, 0xed, 0xcf, 0x9f, 0x94, 0xb4, 0xb7, 0x1f, 0x96, 0x0e, 0x7d, 0xf4, 0xb0, 0x74, 0xe8,
+	0x8f, 0x0f, 0x4b, 0x87, 0x5e, 0x7d, 0xb1, 0xe9, 0x84, 0x7b, 0xdd, 0x7a, 0xb9, 0xe1, 0xb5, 0x51,
+	0xfc, 0x42, 0xcb, 0xaa, 0x07, 0xd1, 0xef, 0x7a, 0xcb, 0x6b, 0xbc, 0xce, 0x07, 0xdf, 0x95, 0x7b,
+	0x95, 0xe4, 0x82, 0x5d, 0xfc, 0xdd, 0x77, 0x7d, 0x9a, 0x0f, 0x3e, 0x2e, 0xfd, 0x6b, 0x00, 0x94,
+	0x13, 0x71, 0xab, 0xd2, 0x2e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	ListPermissions(ctx context.Context, in *QueryListPermissionsRequest, opts ...grpc.CallOption) (*QueryListPermissionsResponse, error)
+	GetPermission(ctx context.Context, in *QueryGetPermissionRequest, opts ...grpc.CallOption) (*QueryGetPermissionResponse, error)
+	GetPermissionSession(ctx context.Context, in *QueryGetPermissionSessionRequest, opts ...grpc.CallOption) (*QueryGetPermissionSessionResponse, error)
+	ListPermissionSessions(ctx context.Context, in *QueryListPermissionSessionsRequest, opts ...grpc.CallOption) (*QueryListPermissionSessionsResponse, error)
+	FindPermissionsWithDID(ctx context.Context, in *QueryFindPermissionsWithDIDRequest, opts ...grpc.CallOption) (*QueryFindPermissionsWithDIDResponse, error)
+	// ListPermissionsByCountry returns the perms whose country scope lists the
+	// country, or its country if it is a subdivision. Worldwide perms are not
+	// listed.
+	ListPermissionsByCountry(ctx context.Context, in *QueryListPermissionsByCountryRequest, opts ...grpc.CallOption) (*QueryListPermissionsByCountryResponse, error)
+	FindBeneficiaries(ctx context.Context, in *QueryFindBeneficiariesRequest, opts ...grpc.CallOption) (*QueryFindBeneficiariesResponse, error)
+	GetSessionAllowance(ctx context.Context, in *QueryGetSessionAllowanceRequest, opts ...grpc.CallOption) (*QueryGetSessionAllowanceResponse, error)
+	ListSessionAllowances(ctx context.Context, in *QueryListSessionAllowancesRequest, opts ...grpc.CallOption) (*QueryListSessionAllowancesResponse, error)
+	GetSlashRecord(ctx context.Context, in *QueryGetSlashRecordRequest, opts ...grpc.CallOption) (*QueryGetSlashRecordResponse, error)
+	// ListSlashRecords lists slash records, filtered by perm, grantee and/or slasher.
+	ListSlashRecords(ctx context.Context, in *QueryListSlashRecordsRequest, opts ...grpc.CallOption) (*QueryListSlashRecordsResponse, error)
+	GetSlashDistribution(ctx context.Context, in *QueryGetSlashDistributionRequest, opts ...grpc.CallOption) (*QueryGetSlashDistributionResponse, error)
+	// GetPermissionTree returns the perms validated, directly or not, by a root
+	// perm, walking the tree breadth first.
+	GetPermissionTree(ctx context.Context, in *QueryGetPermissionTreeRequest, opts ...grpc.CallOption) (*QueryGetPermissionTreeResponse, error)
+	// GetPermissionAncestry returns the chain of validators of a perm, up to
+	// its root perm.
+	GetPermissionAncestry(ctx context.Context, in *QueryGetPermissionAncestryRequest, opts ...grpc.CallOption) (*QueryGetPermissionAncestryResponse, error)
+	// SimulateRevocationCascade lists the descendant perms a cascading
+	// revocation of a perm would affect, without revoking anything.
+	SimulateRevocationCascade(ctx context.Context, in *QuerySimulateRevocationCascadeRequest, opts ...grpc.CallOption) (*QuerySimulateRevocationCascadeResponse, error)
+	// ListRevocationCascades lists the cascading revocations in progress.
+	ListRevocationCascades(ctx context.Context, in *QueryListRevocationCascadesRequest, opts ...grpc.CallOption) (*QueryListRevocationCascadesResponse, error)
+	// VerifySessionAuthorization checks that a perm session authorizes a
+	// credential exchange between an executor and a beneficiary perm.
+	VerifySessionAuthorization(ctx context.Context, in *QueryVerifySessionAuthorizationRequest, opts ...grpc.CallOption) (*QueryVerifySessionAuthorizationResponse, error)
+	// QuoteSessionFees returns the itemized funds a perm session creation costs.
+	QuoteSessionFees(ctx context.Context, in *QueryQuoteSessionFeesRequest, opts ...grpc.CallOption) (*QueryQuoteSessionFeesResponse, error)
+	// QuoteValidationFees returns the funds a validation process with a
+	// validator perm costs.
+	QuoteValidationFees(ctx context.Context, in *QueryQuoteValidationFeesRequest, opts ...grpc.CallOption) (*QueryQuoteValidationFeesResponse, error)
+	GetVerificationCredit(ctx context.Context, in *QueryGetVerificationCreditRequest, opts ...grpc.CallOption) (*QueryGetVerificationCreditResponse, error)
+	ListVerificationCredits(ctx context.Context, in *QueryListVerificationCreditsRequest, opts ...grpc.CallOption) (*QueryListVerificationCreditsResponse, error)
+	// GetAgentRewards returns the rewards accrued by an agent perm.
+	GetAgentRewards(ctx context.Context, in *QueryGetAgentRewardsRequest, opts ...grpc.CallOption) (*QueryGetAgentRewardsResponse, error)
+	// GetVoucherNonce returns the session voucher nonces settled for a signer.
+	GetVoucherNonce(ctx context.Context, in *QueryGetVoucherNonceRequest, opts ...grpc.CallOption) (*QueryGetVoucherNonceResponse, error)
+	// ListVPMessages lists, in order, the messages anchored for the validation
+	// process of a perm.
+	ListVPMessages(ctx context.Context, in *QueryListVPMessagesRequest, opts ...grpc.CallOption) (*QueryListVPMessagesResponse, error)
+	// GetPermissionFees returns the current fees of a perm, its scheduled fee
+	// update if any, and its fee history.
+	GetPermissionFees(ctx context.Context, in *QueryGetPermissionFeesRequest, opts ...grpc.CallOption) (*QueryGetPermissionFeesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListPermissions(ctx context.Context, in *QueryListPermissionsRequest, opts ...grpc.CallOption) (*QueryListPermissionsResponse, error) {
+	out := new(QueryListPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Query/ListPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetPermission(ctx context.Context, in *QueryGetPermissionRequest, opts ...grpc.CallOption) (*QueryGetPermissionResponse, error) {
+	out := new(QueryGetPermissionResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Query/GetPermission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetPermissionSession(ctx context.Context, in *QueryGetPermissionSessionRequest, opts ...grpc.CallOption) (*QueryGetPermissionSessionResponse, error) {
+	out := new(QueryGetPermissionSessionResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Query/GetPermissionSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListPermissionSessions(ctx context.Context, in *QueryListPermissionSessionsRequest, opts ...grpc.CallOption) (*QueryListPermissionSessionsResponse, error) {
+	out := new(QueryListPermissionSessionsResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Query/ListPermissionSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FindPermissionsWithDID(ctx context.Context, in *QueryFindPermissionsWithDIDRequest, opts ...grpc.CallOption) (*QueryFindPermissionsWithDIDResponse, error) {
+	out := new(QueryFindPermissionsWithDIDResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Query/FindPermissionsWithDID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListPermissionsByCountry(ctx context.Context, in *QueryListPermissionsByCountryRequest, opts ...grpc.CallOption) (*QueryListPermissionsByCountryResponse, error) {
+	out := new(QueryListPermissionsByCountryResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Query/ListPermissionsByCountry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FindBeneficiaries(ctx context.Context, in *QueryFindBeneficiariesRequest, opts ...grpc.CallOption) (*QueryFindBeneficiariesResponse, error) {
+	out := new(QueryFindBeneficiariesResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Query/FindBeneficiaries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetSessionAllowance(ctx context.Context, in *QueryGetSessionAllowanceRequest, opts ...grpc.CallOption) (*QueryGetSessionAllowanceResponse, error) {
+	out := new(QueryGetSessionAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Query/GetSessionAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListSessionAllowances(ctx context.Context, in *QueryListSessionAllowancesRequest, opts ...grpc.CallOption) (*QueryListSessionAllowancesResponse, error) {
+	out := new(QueryListSessionAllowancesResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Query/ListSessionAllowances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetSlashRecord(ctx context.Context, in *QueryGetSlashRecordRequest, opts ...grpc.CallOption) (*QueryGetSlashRecordResponse, error) {
+	out := new(QueryGetSlashRecordResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Query/GetSlashRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListSlashRecords(ctx context.Context, in *QueryListSlashRecordsRequest, opts ...grpc.CallOption) (*QueryListSlashRecordsResponse, error) {
+	out := new(QueryListSlashRecordsResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Query/ListSlashRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetSlashDistribution(ctx context.Context, in *QueryGetSlashDistributionRequest, opts ...grpc.CallOption) (*QueryGetSlashDistributionResponse, error) {
+	out := new(QueryGetSlashDistributionResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Query/GetSlashDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetPermissionTree(ctx context.Context, in *QueryGetPermissionTreeRequest, opts ...grpc.CallOption) (*QueryGetPermissionTreeResponse, error) {
+	out := new(QueryGetPermissionTreeResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Query/GetPermissionTree", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetPermissionAncestry(ctx context.Context, in *QueryGetPermissionAncestryRequest, opts ...grpc.CallOption) (*QueryGetPermissionAncestryResponse, error) {
+	out := new(QueryGetPermissionAncestryResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Query/GetPermissionAncestry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateRevocationCascade(ctx context.Context, in *QuerySimulateRevocationCascadeRequest, opts ...grpc.CallOption) (*QuerySimulateRevocationCascadeResponse, error) {
+	out := new(QuerySimulateRevocationCascadeResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Query/SimulateRevocationCascade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListRevocationCascades(ctx context.Context, in *QueryListRevocationCascadesRequest, opts ...grpc.CallOption) (*QueryListRevocationCascadesResponse, error) {
+	out := new(QueryListRevocationCascadesResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Query/ListRevocationCascades", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifySessionAuthorization(ctx context.Context, in *QueryVerifySessionAuthorizationRequest, opts ...grpc.CallOption) (*QueryVerifySessionAuthorizationResponse, error) {
+	out := new(QueryVerifySessionAuthorizationResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Query/VerifySessionAuthorization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuoteSessionFees(ctx context.Context, in *QueryQuoteSessionFeesRequest, opts ...grpc.CallOption) (*QueryQuoteSessionFeesResponse, error) {
+	out := new(QueryQuoteSessionFeesResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Query/QuoteSessionFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuoteValidationFees(ctx context.Context, in *QueryQuoteValidationFeesRequest, opts ...grpc.CallOption) (*QueryQuoteValidationFeesResponse, error) {
+	out := new(QueryQuoteValidationFeesResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Query/QuoteValidationFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetVerificationCredit(ctx context.Context, in *QueryGetVerificationCreditRequest, opts ...grpc.CallOption) (*QueryGetVerificationCreditResponse, error) {
+	out := new(QueryGetVerificationCreditResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Query/GetVerificationCredit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListVerificationCredits(ctx context.Context, in *QueryListVerificationCreditsRequest, opts ...grpc.CallOption) (*QueryListVerificationCreditsResponse, error) {
+	out := new(QueryListVerificationCreditsResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Query/ListVerificationCredits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetAgentRewards(ctx context.Context, in *QueryGetAgentRewardsRequest, opts ...grpc.CallOption) (*QueryGetAgentRewardsResponse, error) {
+	out := new(QueryGetAgentRewardsResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Query/GetAgentRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetVoucherNonce(ctx context.Context, in *QueryGetVoucherNonceRequest, opts ...grpc.CallOption) (*QueryGetVoucherNonceResponse, error) {
+	out := new(QueryGetVoucherNonceResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Query/GetVoucherNonce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListVPMessages(ctx context.Context, in *QueryListVPMessagesRequest, opts ...grpc.CallOption) (*QueryListVPMessagesResponse, error) {
+	out := new(QueryListVPMessagesResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Query/ListVPMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetPermissionFees(ctx context.Context, in *QueryGetPermissionFeesRequest, opts ...grpc.CallOption) (*QueryGetPermissionFeesResponse, error) {
+	out := new(QueryGetPermissionFeesResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Query/GetPermissionFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	ListPermissions(context.Context, *QueryListPermissionsRequest) (*QueryListPermissionsResponse, error)
+	GetPermission(context.Context, *QueryGetPermissionRequest) (*QueryGetPermissionResponse, error)
+	GetPermissionSession(context.Context, *QueryGetPermissionSessionRequest) (*QueryGetPermissionSessionResponse, error)
+	ListPermissionSessions(context.Context, *QueryListPermissionSessionsRequest) (*QueryListPermissionSessionsResponse, error)
+	FindPermissionsWithDID(context.Context, *QueryFindPermissionsWithDIDRequest) (*QueryFindPermissionsWithDIDResponse, error)
+	// ListPermissionsByCountry returns the perms whose country scope lists the
+	// country, or its country if it is a subdivision. Worldwide perms are not
+	// listed.
+	ListPermissionsByCountry(context.Context, *QueryListPermissionsByCountryRequest) (*QueryListPermissionsByCountryResponse, error)
+	FindBeneficiaries(context.Context, *QueryFindBeneficiariesRequest) (*QueryFindBeneficiariesResponse, error)
+	GetSessionAllowance(context.Context, *QueryGetSessionAllowanceRequest) (*QueryGetSessionAllowanceResponse, error)
+	ListSessionAllowances(context.Context, *QueryListSessionAllowancesRequest) (*QueryListSessionAllowancesResponse, error)
+	GetSlashRecord(context.Context, *QueryGetSlashRecordRequest) (*QueryGetSlashRecordResponse, error)
+	// ListSlashRecords lists slash records, filtered by perm, grantee and/or slasher.
+	ListSlashRecords(context.Context, *QueryListSlashRecordsRequest) (*QueryListSlashRecordsResponse, error)
+	GetSlashDistribution(context.Context, *QueryGetSlashDistributionRequest) (*QueryGetSlashDistributionResponse, error)
+	// GetPermissionTree returns the perms validated, directly or not, by a root
+	// perm, walking the tree breadth first.
+	GetPermissionTree(context.Context, *QueryGetPermissionTreeRequest) (*QueryGetPermissionTreeResponse, error)
+	// GetPermissionAncestry returns the chain of validators of a perm, up to
+	// its root perm.
+	GetPermissionAncestry(context.Context, *QueryGetPermissionAncestryRequest) (*QueryGetPermissionAncestryResponse, error)
+	// SimulateRevocationCascade lists the descendant perms a cascading
+	// revocation of a perm would affect, without revoking anything.
+	SimulateRevocationCascade(context.Context, *QuerySimulateRevocationCascadeRequest) (*QuerySimulateRevocationCascadeResponse, error)
+	// ListRevocationCascades lists the cascading revocations in progress.
+	ListRevocationCascades(context.Context, *QueryListRevocationCascadesRequest) (*QueryListRevocationCascadesResponse, error)
+	// VerifySessionAuthorization checks that a perm session authorizes a
+	// credential exchange between an executor and a beneficiary perm.
+	VerifySessionAuthorization(context.Context, *QueryVerifySessionAuthorizationRequest) (*QueryVerifySessionAuthorizationResponse, error)
+	// QuoteSessionFees returns the itemized funds a perm session creation costs.
+	QuoteSessionFees(context.Context, *QueryQuoteSessionFeesRequest) (*QueryQuoteSessionFeesResponse, error)
+	// QuoteValidationFees returns the funds a validation process with a
+	// validator perm costs.
+	QuoteValidationFees(context.Context, *QueryQuoteValidationFeesRequest) (*QueryQuoteValidationFeesResponse, error)
+	GetVerificationCredit(context.Context, *QueryGetVerificationCreditRequest) (*QueryGetVerificationCreditResponse, error)
+	ListVerificationCredits(context.Context, *QueryListVerificationCreditsRequest) (*QueryListVerificationCreditsResponse, error)
+	// GetAgentRewards returns the rewards accrued by an agent perm.
+	GetAgentRewards(context.Context, *QueryGetAgentRewardsRequest) (*QueryGetAgentRewardsResponse, error)
+	// GetVoucherNonce returns the session voucher nonces settled for a signer.
+	GetVoucherNonce(context.Context, *QueryGetVoucherNonceRequest) (*QueryGetVoucherNonceResponse, error)
+	// ListVPMessages lists, in order, the messages anchored for the validation
+	// process of a perm.
+	ListVPMessages(context.Context, *QueryListVPMessagesRequest) (*QueryListVPMessagesResponse, error)
+	// GetPermissionFees returns the current fees of a perm, its scheduled fee
+	// update if any, and its fee history.
+	GetPermissionFees(context.Context, *QueryGetPermissionFeesRequest) (*QueryGetPermissionFeesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ListPermissions(ctx context.Context, req *QueryListPermissionsRequest) (*QueryListPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPermissions not implemented")
+}
+func (*UnimplementedQueryServer) GetPermission(ctx context.Context, req *QueryGetPermissionRequest) (*QueryGetPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPermission not implemented")
+}
+func (*UnimplementedQueryServer) GetPermissionSession(ctx context.Context, req *QueryGetPermissionSessionRequest) (*QueryGetPermissionSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPermissionSession not implemented")
+}
+func (*UnimplementedQueryServer) ListPermissionSessions(ctx context.Context, req *QueryListPermissionSessionsRequest) (*QueryListPermissionSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPermissionSessions not implemented")
+}
+func (*UnimplementedQueryServer) FindPermissionsWithDID(ctx context.Context, req *QueryFindPermissionsWithDIDRequest) (*QueryFindPermissionsWithDIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindPermissionsWithDID not implemented")
+}
+func (*UnimplementedQueryServer) ListPermissionsByCountry(ctx context.Context, req *QueryListPermissionsByCountryRequest) (*QueryListPermissionsByCountryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPermissionsByCountry not implemented")
+}
+func (*UnimplementedQueryServer) FindBeneficiaries(ctx context.Context, req *QueryFindBeneficiariesRequest) (*QueryFindBeneficiariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindBeneficiaries not implemented")
+}
+func (*UnimplementedQueryServer) GetSessionAllowance(ctx context.Context, req *QueryGetSessionAllowanceRequest) (*QueryGetSessionAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessionAllowance not implemented")
+}
+func (*UnimplementedQueryServer) ListSessionAllowances(ctx context.Context, req *QueryListSessionAllowancesRequest) (*QueryListSessionAllowancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessionAllowances not implemented")
+}
+func (*UnimplementedQueryServer) GetSlashRecord(ctx context.Context, req *QueryGetSlashRecordRequest) (*QueryGetSlashRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSlashRecord not implemented")
+}
+func (*UnimplementedQueryServer) ListSlashRecords(ctx context.Context, req *QueryListSlashRecordsRequest) (*QueryListSlashRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSlashRecords not implemented")
+}
+func (*UnimplementedQueryServer) GetSlashDistribution(ctx context.Context, req *QueryGetSlashDistributionRequest) (*QueryGetSlashDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSlashDistribution not implemented")
+}
+func (*UnimplementedQueryServer) GetPermissionTree(ctx context.Context, req *QueryGetPermissionTreeRequest) (*QueryGetPermissionTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPermissionTree not implemented")
+}
+func (*UnimplementedQueryServer) GetPermissionAncestry(ctx context.Context, req *QueryGetPermissionAncestryRequest) (*QueryGetPermissionAncestryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPermissionAncestry not implemented")
+}
+func (*UnimplementedQueryServer) SimulateRevocationCascade(ctx context.Context, req *QuerySimulateRevocationCascadeRequest) (*QuerySimulateRevocationCascadeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateRevocationCascade not implemented")
+}
+func (*UnimplementedQueryServer) ListRevocationCascades(ctx context.Context, req *QueryListRevocationCascadesRequest) (*QueryListRevocationCascadesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRevocationCascades not implemented")
+}
+func (*UnimplementedQueryServer) VerifySessionAuthorization(ctx context.Context, req *QueryVerifySessionAuthorizationRequest) (*QueryVerifySessionAuthorizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifySessionAuthorization not implemented")
+}
+func (*UnimplementedQueryServer) QuoteSessionFees(ctx context.Context, req *QueryQuoteSessionFeesRequest) (*QueryQuoteSessionFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteSessionFees not implemented")
+}
+func (*UnimplementedQueryServer) QuoteValidationFees(ctx context.Context, req *QueryQuoteValidationFeesRequest) (*QueryQuoteValidationFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteValidationFees not implemented")
+}
+func (*UnimplementedQueryServer) GetVerificationCredit(ctx context.Context, req *QueryGetVerificationCreditRequest) (*QueryGetVerificationCreditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVerificationCredit not implemented")
+}
+func (*UnimplementedQueryServer) ListVerificationCredits(ctx context.Context, req *QueryListVerificationCreditsRequest) (*QueryListVerificationCreditsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVerificationCredits not implemented")
+}
+func (*UnimplementedQueryServer) GetAgentRewards(ctx context.Context, req *QueryGetAgentRewardsRequest) (*QueryGetAgentRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAgentRewards not implemented")
+}
+func (*UnimplementedQueryServer) GetVoucherNonce(ctx context.Context, req *QueryGetVoucherNonceRequest) (*QueryGetVoucherNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVoucherNonce not implemented")
+}
+func (*UnimplementedQueryServer) ListVPMessages(ctx context.Context, req *QueryListVPMessagesRequest) (*QueryListVPMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVPMessages not implemented")
+}
+func (*UnimplementedQueryServer) GetPermissionFees(ctx context.Context, req *QueryGetPermissionFeesRequest) (*QueryGetPermissionFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPermissionFees not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Query/ListPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListPermissions(ctx, req.(*QueryListPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Query/GetPermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPermission(ctx, req.(*QueryGetPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetPermissionSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPermissionSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPermissionSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Query/GetPermissionSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPermissionSession(ctx, req.(*QueryGetPermissionSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListPermissionSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListPermissionSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListPermissionSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Query/ListPermissionSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListPermissionSessions(ctx, req.(*QueryListPermissionSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FindPermissionsWithDID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFindPermissionsWithDIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FindPermissionsWithDID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Query/FindPermissionsWithDID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FindPermissionsWithDID(ctx, req.(*QueryFindPermissionsWithDIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListPermissionsByCountry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListPermissionsByCountryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListPermissionsByCountry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Query/ListPermissionsByCountry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListPermissionsByCountry(ctx, req.(*QueryListPermissionsByCountryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FindBeneficiaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFindBeneficiariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FindBeneficiaries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Query/FindBeneficiaries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FindBeneficiaries(ctx, req.(*QueryFindBeneficiariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetSessionAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetSessionAllowanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetSessionAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Query/GetSessionAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetSessionAllowance(ctx, req.(*QueryGetSessionAllowanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListSessionAllowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListSessionAllowancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListSessionAllowances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Query/ListSessionAllowances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListSessionAllowances(ctx, req.(*QueryListSessionAllowancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetSlashRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetSlashRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetSlashRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Query/GetSlashRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetSlashRecord(ctx, req.(*QueryGetSlashRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListSlashRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListSlashRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListSlashRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Query/ListSlashRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListSlashRecords(ctx, req.(*QueryListSlashRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetSlashDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetSlashDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetSlashDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Query/GetSlashDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetSlashDistribution(ctx, req.(*QueryGetSlashDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetPermissionTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPermissionTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPermissionTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Query/GetPermissionTree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPermissionTree(ctx, req.(*QueryGetPermissionTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetPermissionAncestry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPermissionAncestryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPermissionAncestry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Query/GetPermissionAncestry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPermissionAncestry(ctx, req.(*QueryGetPermissionAncestryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateRevocationCascade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateRevocationCascadeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateRevocationCascade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Query/SimulateRevocationCascade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateRevocationCascade(ctx, req.(*QuerySimulateRevocationCascadeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListRevocationCascades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListRevocationCascadesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListRevocationCascades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Query/ListRevocationCascades",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListRevocationCascades(ctx, req.(*QueryListRevocationCascadesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifySessionAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifySessionAuthorizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifySessionAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Query/VerifySessionAuthorization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifySessionAuthorization(ctx, req.(*QueryVerifySessionAuthorizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuoteSessionFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuoteSessionFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuoteSessionFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Query/QuoteSessionFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuoteSessionFees(ctx, req.(*QueryQuoteSessionFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuoteValidationFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuoteValidationFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuoteValidationFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Query/QuoteValidationFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuoteValidationFees(ctx, req.(*QueryQuoteValidationFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetVerificationCredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetVerificationCreditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetVerificationCredit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Query/GetVerificationCredit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetVerificationCredit(ctx, req.(*QueryGetVerificationCreditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListVerificationCredits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListVerificationCreditsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListVerificationCredits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Query/ListVerificationCredits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListVerificationCredits(ctx, req.(*QueryListVerificationCreditsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetAgentRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAgentRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAgentRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Query/GetAgentRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAgentRewards(ctx, req.(*QueryGetAgentRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetVoucherNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetVoucherNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetVoucherNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Query/GetVoucherNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetVoucherNonce(ctx, req.(*QueryGetVoucherNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListVPMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListVPMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListVPMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Query/ListVPMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListVPMessages(ctx, req.(*QueryListVPMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetPermissionFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPermissionFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPermissionFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Query/GetPermissionFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPermissionFees(ctx, req.(*QueryGetPermissionFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "verana.perm.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ListPermissions",
+			Handler:    _Query_ListPermissions_Handler,
+		},
+		{
+			MethodName: "GetPermission",
+			Handler:    _Query_GetPermission_Handler,
+		},
+		{
+			MethodName: "GetPermissionSession",
+			Handler:    _Query_GetPermissionSession_Handler,
+		},
+		{
+			MethodName: "ListPermissionSessions",
+			Handler:    _Query_ListPermissionSessions_Handler,
+		},
+		{
+			MethodName: "FindPermissionsWithDID",
+			Handler:    _Query_FindPermissionsWithDID_Handler,
+		},
+		{
+			MethodName: "ListPermissionsByCountry",
+			Handler:    _Query_ListPermissionsByCountry_Handler,
+		},
+		{
+			MethodName: "FindBeneficiaries",
+			Handler:    _Query_FindBeneficiaries_Handler,
+		},
+		{
+			MethodName: "GetSessionAllowance",
+			Handler:    _Query_GetSessionAllowance_Handler,
+		},
+		{
+			MethodName: "ListSessionAllowances",
+			Handler:    _Query_ListSessionAllowances_Handler,
+		},
+		{
+			MethodName: "GetSlashRecord",
+			Handler:    _Query_GetSlashRecord_Handler,
+		},
+		{
+			MethodName: "ListSlashRecords",
+			Handler:    _Query_ListSlashRecords_Handler,
+		},
+		{
+			MethodName: "GetSlashDistribution",
+			Handler:    _Query_GetSlashDistribution_Handler,
+		},
+		{
+			MethodName: "GetPermissionTree",
+			Handler:    _Query_GetPermissionTree_Handler,
+		},
+		{
+			MethodName: "GetPermissionAncestry",
+			Handler:    _Query_GetPermissionAncestry_Handler,
+		},
+		{
+			MethodName: "SimulateRevocationCascade",
+			Handler:    _Query_SimulateRevocationCascade_Handler,
+		},
+		{
+			MethodName: "ListRevocationCascades",
+			Handler:    _Query_ListRevocationCascades_Handler,
+		},
+		{
+			MethodName: "VerifySessionAuthorization",
+			Handler:    _Query_VerifySessionAuthorization_Handler,
+		},
+		{
+			MethodName: "QuoteSessionFees",
+			Handler:    _Query_QuoteSessionFees_Handler,
+		},
+		{
+			MethodName: "QuoteValidationFees",
+			Handler:    _Query_QuoteValidationFees_Handler,
+		},
+		{
+			MethodName: "GetVerificationCredit",
+			Handler:    _Query_GetVerificationCredit_Handler,
+		},
+		{
+			MethodName: "ListVerificationCredits",
+			Handler:    _Query_ListVerificationCredits_Handler,
+		},
+		{
+			MethodName: "GetAgentRewards",
+			Handler:    _Query_GetAgentRewards_Handler,
+		},
+		{
+			MethodName: "GetVoucherNonce",
+			Handler:    _Query_GetVoucherNonce_Handler,
+		},
+		{
+			MethodName: "ListVPMessages",
+			Handler:    _Query_ListVPMessages_Handler,
+		},
+		{
+			MethodName: "GetPermissionFees",
+			Handler:    _Query_GetPermissionFees_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "verana/perm/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListPermissionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListPermissionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListPermissionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResponseMaxSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ResponseMaxSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ModifiedAfter != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ModifiedAfter, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ModifiedAfter):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintQuery(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListPermissionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListPermissionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListPermissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Permissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPermissionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPermissionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPermissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPermissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPermissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPermissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Permission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPermissionSessionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPermissionSessionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPermissionSessionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPermissionSessionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPermissionSessionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPermissionSessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Session != nil {
+		{
+			size, err := m.Session.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListPermissionSessionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListPermissionSessionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListPermissionSessionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AgentPermId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AgentPermId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Controller)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ResponseMaxSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ResponseMaxSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ModifiedAfter != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ModifiedAfter, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ModifiedAfter):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintQuery(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListPermissionSessionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListPermissionSessionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListPermissionSessionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sessions) > 0 {
+		for iNdEx := len(m.Sessions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sessions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFindPermissionsWithDIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFindPermissionsWithDIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFindPermissionsWithDIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.When != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.When, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.When):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintQuery(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Country) > 0 {
+		i -= len(m.Country)
+		copy(dAtA[i:], m.Country)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Country)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SchemaId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SchemaId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFindPermissionsWithDIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFindPermissionsWithDIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFindPermissionsWithDIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Permissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListPermissionsByCountryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListPermissionsByCountryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListPermissionsByCountryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResponseMaxSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ResponseMaxSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SchemaId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SchemaId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Country) > 0 {
+		i -= len(m.Country)
+		copy(dAtA[i:], m.Country)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Country)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListPermissionsByCountryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListPermissionsByCountryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListPermissionsByCountryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Permissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFindBeneficiariesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFindBeneficiariesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFindBeneficiariesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VerifierPermId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VerifierPermId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.IssuerPermId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.IssuerPermId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFindBeneficiariesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFindBeneficiariesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFindBeneficiariesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Permissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSessionAllowanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSessionAllowanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSessionAllowanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSessionAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSessionAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSessionAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListSessionAllowancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListSessionAllowancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListSessionAllowancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResponseMaxSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ResponseMaxSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.VerifierPermId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VerifierPermId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AgentPermId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AgentPermId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SchemaId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SchemaId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListSessionAllowancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListSessionAllowancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListSessionAllowancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Allowances) > 0 {
+		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSlashRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSlashRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSlashRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSlashRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSlashRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSlashRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SlashRecord.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListSlashRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListSlashRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListSlashRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AfterId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AfterId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ResponseMaxSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ResponseMaxSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SlashedBy) > 0 {
+		i -= len(m.SlashedBy)
+		copy(dAtA[i:], m.SlashedBy)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PermId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PermId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListSlashRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListSlashRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListSlashRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextAfterId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextAfterId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SlashRecords) > 0 {
+		for iNdEx := len(m.SlashRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSlashDistributionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSlashDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSlashDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TrId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TrId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSlashDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSlashDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSlashDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SlashDistribution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PermissionNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PermissionNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PermissionNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InvalidReason) > 0 {
+		i -= len(m.InvalidReason)
+		copy(dAtA[i:], m.InvalidReason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InvalidReason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Depth != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Permission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPermissionTreeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPermissionTreeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPermissionTreeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResponseMaxSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ResponseMaxSize))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.OnlyValid {
+		i--
+		if m.OnlyValid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.When != nil {
+		n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.When, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.When):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintQuery(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Country) > 0 {
+		i -= len(m.Country)
+		copy(dAtA[i:], m.Country)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Country)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Depth != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RootId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RootId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPermissionTreeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPermissionTreeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPermissionTreeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Truncated {
+		i--
+		if m.Truncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPermissionAncestryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPermissionAncestryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPermissionAncestryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.When != nil {
+		n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.When, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.When):])
+		if err12 != nil {
+			return 0, err12
+		}
+		i -= n12
+		i = encodeVarintQuery(dAtA, i, uint64(n12))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Country) > 0 {
+		i -= len(m.Country)
+		copy(dAtA[i:], m.Country)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Country)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPermissionAncestryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPermissionAncestryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPermissionAncestryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainValid {
+		i--
+		if m.ChainValid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Ancestors) > 0 {
+		for iNdEx := len(m.Ancestors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ancestors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateRevocationCascadeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateRevocationCascadeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateRevocationCascadeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResponseMaxSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ResponseMaxSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Mode != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateRevocationCascadeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateRevocationCascadeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateRevocationCascadeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Truncated {
+		i--
+		if m.Truncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Affected) > 0 {
+		for iNdEx := len(m.Affected) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Affected[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListRevocationCascadesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListRevocationCascadesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListRevocationCascadesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResponseMaxSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ResponseMaxSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListRevocationCascadesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListRevocationCascadesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListRevocationCascadesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Cascades) > 0 {
+		for iNdEx := len(m.Cascades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cascades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAgentRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAgentRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAgentRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PermId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PermId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAgentRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAgentRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAgentRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AgentReward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetVoucherNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetVoucherNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetVoucherNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetVoucherNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetVoucherNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetVoucherNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UsedNonces) > 0 {
+		dAtA15 := make([]byte, len(m.UsedNonces)*10)
+		var j14 int
+		for _, num := range m.UsedNonces {
+			for num >= 1<<7 {
+				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j14++
+			}
+			dAtA15[j14] = uint8(num)
+			j14++
+		}
+		i -= j14
+		copy(dAtA[i:], dAtA15[:j14])
+		i = encodeVarintQuery(dAtA, i, uint64(j14))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListVPMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListVPMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListVPMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResponseMaxSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ResponseMaxSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AfterIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AfterIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PermId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PermId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListVPMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListVPMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListVPMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPermissionFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPermissionFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPermissionFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResponseMaxSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ResponseMaxSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPermissionFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPermissionFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPermissionFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Scheduled != nil {
+		{
+			size, err := m.Scheduled.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Current.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifySessionAuthorizationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifySessionAuthorizationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifySessionAuthorizationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.When != nil {
+		n18, err18 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.When, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.When):])
+		if err18 != nil {
+			return 0, err18
+		}
+		i -= n18
+		i = encodeVarintQuery(dAtA, i, uint64(n18))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.AgentPermId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AgentPermId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BeneficiaryPermId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BeneficiaryPermId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ExecutorPermId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExecutorPermId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SessionId) > 0 {
+		i -= len(m.SessionId)
+		copy(dAtA[i:], m.SessionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SessionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SessionPermissionStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SessionPermissionStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SessionPermissionStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InvalidReason) > 0 {
+		i -= len(m.InvalidReason)
+		copy(dAtA[i:], m.InvalidReason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InvalidReason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PermId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PermId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifySessionAuthorizationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifySessionAuthorizationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifySessionAuthorizationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Permissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Authz != nil {
+		{
+			size, err := m.Authz.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Authorized {
+		i--
+		if m.Authorized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BeneficiaryFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeneficiaryFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeneficiaryFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatorTrustDeposit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CreatorTrustDeposit))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TrustDeposit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TrustDeposit))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.DirectFees != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DirectFees))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FeesInDenom != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FeesInDenom))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Fees != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Fees))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PermId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PermId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteSessionFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteSessionFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteSessionFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WalletAgentPermId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WalletAgentPermId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AgentPermId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AgentPermId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VerifierPermId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VerifierPermId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.IssuerPermId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.IssuerPermId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteSessionFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteSessionFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteSessionFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TrustUnitPrice != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TrustUnitPrice))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Total != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WalletAgentReward != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WalletAgentReward))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.UserAgentReward != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UserAgentReward))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Beneficiaries) > 0 {
+		for iNdEx := len(m.Beneficiaries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Beneficiaries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteValidationFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteValidationFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteValidationFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValidatorPermId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ValidatorPermId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteValidationFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteValidationFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteValidationFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TrustUnitPrice != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TrustUnitPrice))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Total != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TrustDeposit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TrustDeposit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FeesInDenom != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FeesInDenom))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ValidationFees != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ValidationFees))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ValidatorPermId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ValidatorPermId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetVerificationCreditRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetVerificationCreditRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetVerificationCreditRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetVerificationCreditResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetVerificationCreditResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetVerificationCreditResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Credit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListVerificationCreditsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListVerificationCreditsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListVerificationCreditsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResponseMaxSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ResponseMaxSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WithRemaining {
+		i--
+		if m.WithRemaining {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VerifierPermId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VerifierPermId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListVerificationCreditsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListVerificationCreditsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListVerificationCreditsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Credits) > 0 {
+		for iNdEx := len(m.Credits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Credits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryListPermissionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ModifiedAfter != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ModifiedAfter)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ResponseMaxSize != 0 {
+		n += 1 + sovQuery(uint64(m.ResponseMaxSize))
+	}
+	return n
+}
+
+func (m *QueryListPermissionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		for _, e := range m.Permissions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetPermissionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetPermissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Permission.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetPermissionSessionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPermissionSessionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Session != nil {
+		l = m.Session.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListPermissionSessionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ModifiedAfter != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ModifiedAfter)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ResponseMaxSize != 0 {
+		n += 1 + sovQuery(uint64(m.ResponseMaxSize))
+	}
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AgentPermId != 0 {
+		n += 1 + sovQuery(uint64(m.AgentPermId))
+	}
+	return n
+}
+
+func (m *QueryListPermissionSessionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sessions) > 0 {
+		for _, e := range m.Sessions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFindPermissionsWithDIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	if m.SchemaId != 0 {
+		n += 1 + sovQuery(uint64(m.SchemaId))
+	}
+	l = len(m.Country)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.When != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.When)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFindPermissionsWithDIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		for _, e := range m.Permissions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryListPermissionsByCountryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Country)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SchemaId != 0 {
+		n += 1 + sovQuery(uint64(m.SchemaId))
+	}
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	if m.ResponseMaxSize != 0 {
+		n += 1 + sovQuery(uint64(m.ResponseMaxSize))
+	}
+	return n
+}
+
+func (m *QueryListPermissionsByCountryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		for _, e := range m.Permissions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFindBeneficiariesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IssuerPermId != 0 {
+		n += 1 + sovQuery(uint64(m.IssuerPermId))
+	}
+	if m.VerifierPermId != 0 {
+		n += 1 + sovQuery(uint64(m.VerifierPermId))
+	}
+	return n
+}
+
+func (m *QueryFindBeneficiariesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		for _, e := range m.Permissions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetSessionAllowanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetSessionAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Allowance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryListSessionAllowancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SchemaId != 0 {
+		n += 1 + sovQuery(uint64(m.SchemaId))
+	}
+	if m.AgentPermId != 0 {
+		n += 1 + sovQuery(uint64(m.AgentPermId))
+	}
+	if m.VerifierPermId != 0 {
+		n += 1 + sovQuery(uint64(m.VerifierPermId))
+	}
+	if m.ResponseMaxSize != 0 {
+		n += 1 + sovQuery(uint64(m.ResponseMaxSize))
+	}
+	return n
+}
+
+func (m *QueryListSessionAllowancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetSlashRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetSlashRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SlashRecord.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryListSlashRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PermId != 0 {
+		n += 1 + sovQuery(uint64(m.PermId))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SlashedBy)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ResponseMaxSize != 0 {
+		n += 1 + sovQuery(uint64(m.ResponseMaxSize))
+	}
+	if m.AfterId != 0 {
+		n += 1 + sovQuery(uint64(m.AfterId))
+	}
+	return n
+}
+
+func (m *QueryListSlashRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SlashRecords) > 0 {
+		for _, e := range m.SlashRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.NextAfterId != 0 {
+		n += 1 + sovQuery(uint64(m.NextAfterId))
+	}
+	return n
+}
+
+func (m *QueryGetSlashDistributionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TrId != 0 {
+		n += 1 + sovQuery(uint64(m.TrId))
+	}
+	return n
+}
+
+func (m *QueryGetSlashDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SlashDistribution.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *PermissionNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Permission.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Depth != 0 {
+		n += 1 + sovQuery(uint64(m.Depth))
+	}
+	if m.Valid {
+		n += 2
+	}
+	l = len(m.InvalidReason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPermissionTreeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RootId != 0 {
+		n += 1 + sovQuery(uint64(m.RootId))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovQuery(uint64(m.Depth))
+	}
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	l = len(m.Country)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.When != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.When)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.OnlyValid {
+		n += 2
+	}
+	if m.ResponseMaxSize != 0 {
+		n += 1 + sovQuery(uint64(m.ResponseMaxSize))
+	}
+	return n
+}
+
+func (m *QueryGetPermissionTreeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Truncated {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryGetPermissionAncestryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	l = len(m.Country)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.When != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.When)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPermissionAncestryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ancestors) > 0 {
+		for _, e := range m.Ancestors {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ChainValid {
+		n += 2
+	}
+	return n
+}
+
+func (m *QuerySimulateRevocationCascadeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovQuery(uint64(m.Mode))
+	}
+	if m.ResponseMaxSize != 0 {
+		n += 1 + sovQuery(uint64(m.ResponseMaxSize))
+	}
+	return n
+}
+
+func (m *QuerySimulateRevocationCascadeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Affected) > 0 {
+		for _, e := range m.Affected {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Truncated {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryListRevocationCascadesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResponseMaxSize != 0 {
+		n += 1 + sovQuery(uint64(m.ResponseMaxSize))
+	}
+	return n
+}
+
+func (m *QueryListRevocationCascadesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Cascades) > 0 {
+		for _, e := range m.Cascades {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetAgentRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PermId != 0 {
+		n += 1 + sovQuery(uint64(m.PermId))
+	}
+	return n
+}
+
+func (m *QueryGetAgentRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AgentReward.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetVoucherNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetVoucherNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	if len(m.UsedNonces) > 0 {
+		l = 0
+		for _, e := range m.UsedNonces {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryListVPMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PermId != 0 {
+		n += 1 + sovQuery(uint64(m.PermId))
+	}
+	if m.AfterIndex != 0 {
+		n += 1 + sovQuery(uint64(m.AfterIndex))
+	}
+	if m.ResponseMaxSize != 0 {
+		n += 1 + sovQuery(uint64(m.ResponseMaxSize))
+	}
+	return n
+}
+
+func (m *QueryListVPMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetPermissionFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	if m.ResponseMaxSize != 0 {
+		n += 1 + sovQuery(uint64(m.ResponseMaxSize))
+	}
+	return n
+}
+
+func (m *QueryGetPermissionFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Current.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Scheduled != nil {
+		l = m.Scheduled.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryVerifySessionAuthorizationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ExecutorPermId != 0 {
+		n += 1 + sovQuery(uint64(m.ExecutorPermId))
+	}
+	if m.BeneficiaryPermId != 0 {
+		n += 1 + sovQuery(uint64(m.BeneficiaryPermId))
+	}
+	if m.AgentPermId != 0 {
+		n += 1 + sovQuery(uint64(m.AgentPermId))
+	}
+	if m.When != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.When)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SessionPermissionStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PermId != 0 {
+		n += 1 + sovQuery(uint64(m.PermId))
+	}
+	if m.Valid {
+		n += 2
+	}
+	l = len(m.InvalidReason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifySessionAuthorizationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Authorized {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Authz != nil {
+		l = m.Authz.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Permissions) > 0 {
+		for _, e := range m.Permissions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BeneficiaryFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PermId != 0 {
+		n += 1 + sovQuery(uint64(m.PermId))
+	}
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Fees != 0 {
+		n += 1 + sovQuery(uint64(m.Fees))
+	}
+	if m.FeesInDenom != 0 {
+		n += 1 + sovQuery(uint64(m.FeesInDenom))
+	}
+	if m.DirectFees != 0 {
+		n += 1 + sovQuery(uint64(m.DirectFees))
+	}
+	if m.TrustDeposit != 0 {
+		n += 1 + sovQuery(uint64(m.TrustDeposit))
+	}
+	if m.CreatorTrustDeposit != 0 {
+		n += 1 + sovQuery(uint64(m.CreatorTrustDeposit))
+	}
+	return n
+}
+
+func (m *QueryQuoteSessionFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IssuerPermId != 0 {
+		n += 1 + sovQuery(uint64(m.IssuerPermId))
+	}
+	if m.VerifierPermId != 0 {
+		n += 1 + sovQuery(uint64(m.VerifierPermId))
+	}
+	if m.AgentPermId != 0 {
+		n += 1 + sovQuery(uint64(m.AgentPermId))
+	}
+	if m.WalletAgentPermId != 0 {
+		n += 1 + sovQuery(uint64(m.WalletAgentPermId))
+	}
+	return n
+}
+
+func (m *QueryQuoteSessionFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Beneficiaries) > 0 {
+		for _, e := range m.Beneficiaries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.UserAgentReward != 0 {
+		n += 1 + sovQuery(uint64(m.UserAgentReward))
+	}
+	if m.WalletAgentReward != 0 {
+		n += 1 + sovQuery(uint64(m.WalletAgentReward))
+	}
+	if m.Total != 0 {
+		n += 1 + sovQuery(uint64(m.Total))
+	}
+	if m.TrustUnitPrice != 0 {
+		n += 1 + sovQuery(uint64(m.TrustUnitPrice))
+	}
+	return n
+}
+
+func (m *QueryQuoteValidationFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorPermId != 0 {
+		n += 1 + sovQuery(uint64(m.ValidatorPermId))
+	}
+	return n
+}
+
+func (m *QueryQuoteValidationFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorPermId != 0 {
+		n += 1 + sovQuery(uint64(m.ValidatorPermId))
+	}
+	if m.ValidationFees != 0 {
+		n += 1 + sovQuery(uint64(m.ValidationFees))
+	}
+	if m.FeesInDenom != 0 {
+		n += 1 + sovQuery(uint64(m.FeesInDenom))
+	}
+	if m.TrustDeposit != 0 {
+		n += 1 + sovQuery(uint64(m.TrustDeposit))
+	}
+	if m.Total != 0 {
+		n += 1 + sovQuery(uint64(m.Total))
+	}
+	if m.TrustUnitPrice != 0 {
+		n += 1 + sovQuery(uint64(m.TrustUnitPrice))
+	}
+	return n
+}
+
+func (m *QueryGetVerificationCreditRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetVerificationCreditResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Credit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryListVerificationCreditsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.VerifierPermId != 0 {
+		n += 1 + sovQuery(uint64(m.VerifierPermId))
+	}
+	if m.WithRemaining {
+		n += 2
+	}
+	if m.ResponseMaxSize != 0 {
+		n += 1 + sovQuery(uint64(m.ResponseMaxSize))
+	}
+	return n
+}
+
+func (m *QueryListVerificationCreditsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Credits) > 0 {
+		for _, e := range m.Credits {
+			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListPermissionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListPermissionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListPermissionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ModifiedAfter == nil {
+				m.ModifiedAfter = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ModifiedAfter, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseMaxSize", wireType)
+			}
+			m.ResponseMaxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResponseMaxSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListPermissionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListPermissionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListPermissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permissions = append(m.Permissions, Permission{})
+			if err := m.Permissions[len(m.Permissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPermissionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPermissionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPermissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPermissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPermissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Permission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPermissionSessionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPermissionSessionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPermissionSessionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPermissionSessionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPermissionSessionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPermissionSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Session == nil {
+				m.Session = &PermissionSession{}
+			}
+			if err := m.Session.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListPermissionSessionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListPermissionSessionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListPermissionSessionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ModifiedAfter == nil {
+				m.ModifiedAfter = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ModifiedAfter, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseMaxSize", wireType)
+			}
+			m.ResponseMaxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResponseMaxSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Controller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentPermId", wireType)
+			}
+			m.AgentPermId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AgentPermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListPermissionSessionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListPermissionSessionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListPermissionSessionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sessions = append(m.Sessions, PermissionSession{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFindPermissionsWithDIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFindPermissionsWithDIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFindPermissionsWithDIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaId", wireType)
+			}
+			m.SchemaId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SchemaId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Country", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Country = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.When == nil {
+				m.When = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.When, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFindPermissionsWithDIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFindPermissionsWithDIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFindPermissionsWithDIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permissions = append(m.Permissions, Permission{})
+			if err := m.Permissions[len(m.Permissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListPermissionsByCountryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListPermissionsByCountryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListPermissionsByCountryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Country", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Country = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaId", wireType)
+			}
+			m.SchemaId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SchemaId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= PermissionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseMaxSize", wireType)
+			}
+			m.ResponseMaxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResponseMaxSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListPermissionsByCountryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListPermissionsByCountryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListPermissionsByCountryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permissions = append(m.Permissions, Permission{})
+			if err := m.Permissions[len(m.Permissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFindBeneficiariesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFindBeneficiariesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFindBeneficiariesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerPermId", wireType)
+			}
+			m.IssuerPermId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuerPermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifierPermId", wireType)
+			}
+			m.VerifierPermId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerifierPermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFindBeneficiariesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFindBeneficiariesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFindBeneficiariesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permissions = append(m.Permissions, Permission{})
+			if err := m.Permissions[len(m.Permissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSessionAllowanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSessionAllowanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSessionAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSessionAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSessionAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSessionAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListSessionAllowancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListSessionAllowancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListSessionAllowancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaId", wireType)
+			}
+			m.SchemaId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SchemaId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentPermId", wireType)
+			}
+			m.AgentPermId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AgentPermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifierPermId", wireType)
+			}
+			m.VerifierPermId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerifierPermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseMaxSize", wireType)
+			}
+			m.ResponseMaxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResponseMaxSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListSessionAllowancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListSessionAllowancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListSessionAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowances = append(m.Allowances, SessionAllowance{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSlashRecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSlashRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSlashRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSlashRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSlashRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSlashRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListSlashRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListSlashRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListSlashRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermId", wireType)
+			}
+			m.PermId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseMaxSize", wireType)
+			}
+			m.ResponseMaxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResponseMaxSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterId", wireType)
+			}
+			m.AfterId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AfterId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListSlashRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListSlashRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListSlashRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashRecords = append(m.SlashRecords, SlashRecord{})
+			if err := m.SlashRecords[len(m.SlashRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextAfterId", wireType)
+			}
+			m.NextAfterId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextAfterId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSlashDistributionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSlashDistributionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSlashDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrId", wireType)
+			}
+			m.TrId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSlashDistributionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSlashDistributionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSlashDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashDistribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PermissionNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PermissionNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PermissionNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Permission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPermissionTreeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPermissionTreeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPermissionTreeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootId", wireType)
+			}
+			m.RootId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RootId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Country", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Country = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.When == nil {
+				m.When = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.When, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnlyValid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OnlyValid = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseMaxSize", wireType)
+			}
+			m.ResponseMaxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResponseMaxSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPermissionTreeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPermissionTreeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPermissionTreeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, PermissionNode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Truncated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPermissionAncestryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPermissionAncestryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPermissionAncestryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Country", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Country = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.When == nil {
+				m.When = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.When, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPermissionAncestryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPermissionAncestryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPermissionAncestryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ancestors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ancestors = append(m.Ancestors, PermissionNode{})
+			if err := m.Ancestors[len(m.Ancestors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainValid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ChainValid = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateRevocationCascadeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateRevocationCascadeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateRevocationCascadeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= CascadeMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseMaxSize", wireType)
+			}
+			m.ResponseMaxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResponseMaxSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateRevocationCascadeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateRevocationCascadeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateRevocationCascadeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Affected", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Affected = append(m.Affected, PermissionNode{})
+			if err := m.Affected[len(m.Affected)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Truncated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListRevocationCascadesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListRevocationCascadesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListRevocationCascadesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseMaxSize", wireType)
+			}
+			m.ResponseMaxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResponseMaxSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListRevocationCascadesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListRevocationCascadesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListRevocationCascadesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cascades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cascades = append(m.Cascades, RevocationCascade{})
+			if err := m.Cascades[len(m.Cascades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAgentRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAgentRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAgentRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermId", wireType)
+			}
+			m.PermId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAgentRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAgentRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAgentRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AgentReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetVoucherNonceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetVoucherNonceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetVoucherNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetVoucherNonceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetVoucherNonceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetVoucherNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.UsedNonces = append(m.UsedNonces, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.UsedNonces) == 0 {
+					m.UsedNonces = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.UsedNonces = append(m.UsedNonces, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedNonces", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListVPMessagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListVPMessagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListVPMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermId", wireType)
+			}
+			m.PermId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterIndex", wireType)
+			}
+			m.AfterIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AfterIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseMaxSize", wireType)
+			}
+			m.ResponseMaxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResponseMaxSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListVPMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListVPMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListVPMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, VPMessage{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryGetPermissionFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPermissionFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPermissionFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseMaxSize", wireType)
+			}
+			m.ResponseMaxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResponseMaxSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetPermissionFeesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1822,12 +11876,115 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetPermissionFeesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetPermissionFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Current.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheduled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scheduled == nil {
+				m.Scheduled = &PermissionFees{}
+			}
+			if err := m.Scheduled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, PermissionFeeRecord{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1849,7 +12006,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVerifySessionAuthorizationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1859,28 +12016,117 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifySessionAuthorizationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifySessionAuthorizationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorPermId", wireType)
+			}
+			m.ExecutorPermId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutorPermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeneficiaryPermId", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.BeneficiaryPermId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BeneficiaryPermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentPermId", wireType)
+			}
+			m.AgentPermId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AgentPermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1907,7 +12153,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.When == nil {
+				m.When = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.When, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1932,7 +12181,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListPermissionsRequest) Unmarshal(dAtA []byte) error {
+func (m *SessionPermissionStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1955,17 +12204,17 @@ func (m *QueryListPermissionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListPermissionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SessionPermissionStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListPermissionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SessionPermissionStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedAfter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1975,33 +12224,29 @@ func (m *QueryListPermissionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ModifiedAfter == nil {
-				m.ModifiedAfter = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ModifiedAfter, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Role = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResponseMaxSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PermId", wireType)
 			}
-			m.ResponseMaxSize = 0
+			m.PermId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2011,66 +12256,36 @@ func (m *QueryListPermissionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ResponseMaxSize |= uint32(b&0x7F) << shift
+				m.PermId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryListPermissionsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListPermissionsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListPermissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Valid = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidReason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2080,25 +12295,23 @@ func (m *QueryListPermissionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Permissions = append(m.Permissions, Permission{})
-			if err := m.Permissions[len(m.Permissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.InvalidReason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2121,7 +12334,7 @@ func (m *QueryListPermissionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPermissionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVerifySessionAuthorizationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2144,17 +12357,17 @@ func (m *QueryGetPermissionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPermissionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerifySessionAuthorizationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPermissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerifySessionAuthorizationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorized", wireType)
 			}
-			m.Id = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2164,64 +12377,83 @@ func (m *QueryGetPermissionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			m.Authorized = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetPermissionResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authz", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPermissionResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Authz == nil {
+				m.Authz = &SessionAuthz{}
+			}
+			if err := m.Authz.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2248,7 +12480,8 @@ func (m *QueryGetPermissionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Permission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Permissions = append(m.Permissions, SessionPermissionStatus{})
+			if err := m.Permissions[len(m.Permissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2273,7 +12506,7 @@ func (m *QueryGetPermissionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPermissionSessionRequest) Unmarshal(dAtA []byte) error {
+func (m *BeneficiaryFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2296,15 +12529,53 @@ func (m *QueryGetPermissionSessionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPermissionSessionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BeneficiaryFee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPermissionSessionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BeneficiaryFee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermId", wireType)
+			}
+			m.PermId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= PermissionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2332,8 +12603,103 @@ func (m *QueryGetPermissionSessionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			m.Fees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesInDenom", wireType)
+			}
+			m.FeesInDenom = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeesInDenom |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DirectFees", wireType)
+			}
+			m.DirectFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DirectFees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustDeposit", wireType)
+			}
+			m.TrustDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrustDeposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorTrustDeposit", wireType)
+			}
+			m.CreatorTrustDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatorTrustDeposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2355,7 +12721,7 @@ func (m *QueryGetPermissionSessionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPermissionSessionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryQuoteSessionFeesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2378,17 +12744,17 @@ func (m *QueryGetPermissionSessionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPermissionSessionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryQuoteSessionFeesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPermissionSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryQuoteSessionFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Session", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerPermId", wireType)
 			}
-			var msglen int
+			m.IssuerPermId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2398,28 +12764,68 @@ func (m *QueryGetPermissionSessionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.IssuerPermId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifierPermId", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			m.VerifierPermId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerifierPermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentPermId", wireType)
 			}
-			if m.Session == nil {
-				m.Session = &PermissionSession{}
+			m.AgentPermId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AgentPermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if err := m.Session.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletAgentPermId", wireType)
+			}
+			m.WalletAgentPermId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WalletAgentPermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2441,7 +12847,7 @@ func (m *QueryGetPermissionSessionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListPermissionSessionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryQuoteSessionFeesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2464,17 +12870,89 @@ func (m *QueryListPermissionSessionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListPermissionSessionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryQuoteSessionFeesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListPermissionSessionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryQuoteSessionFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedAfter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiaries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiaries = append(m.Beneficiaries, BeneficiaryFee{})
+			if err := m.Beneficiaries[len(m.Beneficiaries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserAgentReward", wireType)
+			}
+			m.UserAgentReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserAgentReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletAgentReward", wireType)
+			}
+			m.WalletAgentReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WalletAgentReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var msglen int
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2484,33 +12962,16 @@ func (m *QueryListPermissionSessionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Total |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ModifiedAfter == nil {
-				m.ModifiedAfter = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ModifiedAfter, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResponseMaxSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustUnitPrice", wireType)
 			}
-			m.ResponseMaxSize = 0
+			m.TrustUnitPrice = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2520,7 +12981,7 @@ func (m *QueryListPermissionSessionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ResponseMaxSize |= uint32(b&0x7F) << shift
+				m.TrustUnitPrice |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2546,7 +13007,7 @@ func (m *QueryListPermissionSessionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListPermissionSessionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryQuoteValidationFeesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2569,17 +13030,17 @@ func (m *QueryListPermissionSessionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListPermissionSessionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryQuoteValidationFeesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListPermissionSessionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryQuoteValidationFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPermId", wireType)
 			}
-			var msglen int
+			m.ValidatorPermId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2589,26 +13050,11 @@ func (m *QueryListPermissionSessionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ValidatorPermId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sessions = append(m.Sessions, PermissionSession{})
-			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2630,7 +13076,7 @@ func (m *QueryListPermissionSessionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFindPermissionsWithDIDRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryQuoteValidationFeesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2653,17 +13099,17 @@ func (m *QueryFindPermissionsWithDIDRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFindPermissionsWithDIDRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryQuoteValidationFeesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFindPermissionsWithDIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryQuoteValidationFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPermId", wireType)
 			}
-			var stringLen uint64
+			m.ValidatorPermId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2673,29 +13119,16 @@ func (m *QueryFindPermissionsWithDIDRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ValidatorPermId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Did = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidationFees", wireType)
 			}
-			m.Type = 0
+			m.ValidationFees = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2705,16 +13138,16 @@ func (m *QueryFindPermissionsWithDIDRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= uint32(b&0x7F) << shift
+				m.ValidationFees |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchemaId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesInDenom", wireType)
 			}
-			m.SchemaId = 0
+			m.FeesInDenom = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2724,16 +13157,16 @@ func (m *QueryFindPermissionsWithDIDRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SchemaId |= uint64(b&0x7F) << shift
+				m.FeesInDenom |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Country", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustDeposit", wireType)
 			}
-			var stringLen uint64
+			m.TrustDeposit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2743,29 +13176,35 @@ func (m *QueryFindPermissionsWithDIDRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TrustDeposit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Country = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustUnitPrice", wireType)
 			}
-			var msglen int
+			m.TrustUnitPrice = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2775,28 +13214,80 @@ func (m *QueryFindPermissionsWithDIDRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TrustUnitPrice |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.When == nil {
-				m.When = new(time.Time)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetVerificationCreditRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.When, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetVerificationCreditRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetVerificationCreditRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2818,7 +13309,7 @@ func (m *QueryFindPermissionsWithDIDRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFindPermissionsWithDIDResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetVerificationCreditResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2841,15 +13332,15 @@ func (m *QueryFindPermissionsWithDIDResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFindPermissionsWithDIDResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetVerificationCreditResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFindPermissionsWithDIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetVerificationCreditResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Credit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2876,8 +13367,7 @@ func (m *QueryFindPermissionsWithDIDResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Permissions = append(m.Permissions, Permission{})
-			if err := m.Permissions[len(m.Permissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Credit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2902,7 +13392,7 @@ func (m *QueryFindPermissionsWithDIDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFindBeneficiariesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListVerificationCreditsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2925,17 +13415,17 @@ func (m *QueryFindBeneficiariesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFindBeneficiariesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListVerificationCreditsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFindBeneficiariesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListVerificationCreditsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuerPermId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
 			}
-			m.IssuerPermId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2945,11 +13435,24 @@ func (m *QueryFindBeneficiariesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IssuerPermId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field VerifierPermId", wireType)
@@ -2969,6 +13472,45 @@ func (m *QueryFindBeneficiariesRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithRemaining", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithRemaining = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseMaxSize", wireType)
+			}
+			m.ResponseMaxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResponseMaxSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2990,7 +13532,7 @@ func (m *QueryFindBeneficiariesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFindBeneficiariesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListVerificationCreditsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3013,15 +13555,15 @@ func (m *QueryFindBeneficiariesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFindBeneficiariesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListVerificationCreditsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFindBeneficiariesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListVerificationCreditsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Credits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3048,8 +13590,8 @@ func (m *QueryFindBeneficiariesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Permissions = append(m.Permissions, Permission{})
-			if err := m.Permissions[len(m.Permissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Credits = append(m.Credits, VerificationCredit{})
+			if err := m.Credits[len(m.Credits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13,6 +13,7 @@ import (
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	types "github.com/verana-labs/verana-blockchain/x/trustdeposit/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -135,8 +136,12 @@ type MsgStartPermissionVP struct {
 	Creator         string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Type            uint32 `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
 	ValidatorPermId uint64 `protobuf:"varint,3,opt,name=validator_perm_id,json=validatorPermId,proto3" json:"validator_perm_id,omitempty"`
-	Country         string `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`
-	Did             string `protobuf:"bytes,5,opt,name=did,proto3" json:"did,omitempty"`
+	// countries must be within the country scope of the validator perm
+	Countries []string `protobuf:"bytes,4,rep,name=countries,proto3" json:"countries,omitempty"`
+	Did       string   `protobuf:"bytes,5,opt,name=did,proto3" json:"did,omitempty"`
+	// co_validator_perm_ids are the other validator perms of the validation
+	// process, required when the credential schema sets a validation quorum
+	CoValidatorPermIds []uint64 `protobuf:"varint,6,rep,packed,name=co_validator_perm_ids,json=coValidatorPermIds,proto3" json:"co_validator_perm_ids,omitempty"`
 }
 
 func (m *MsgStartPermissionVP) Reset()         { *m = MsgStartPermissionVP{} }
@@ -193,11 +198,11 @@ func (m *MsgStartPermissionVP) GetValidatorPermId() uint64 {
 	return 0
 }
 
-func (m *MsgStartPermissionVP) GetCountry() string {
+func (m *MsgStartPermissionVP) GetCountries() []string {
 	if m != nil {
-		return m.Country
+		return m.Countries
 	}
-	return ""
+	return nil
 }
 
 func (m *MsgStartPermissionVP) GetDid() string {
@@ -207,6 +212,13 @@ func (m *MsgStartPermissionVP) GetDid() string {
 	return ""
 }
 
+func (m *MsgStartPermissionVP) GetCoValidatorPermIds() []uint64 {
+	if m != nil {
+		return m.CoValidatorPermIds
+	}
+	return nil
+}
+
 // MsgStartPermissionVPResponse defines the Msg/StartPermissionVP response type
 type MsgStartPermissionVPResponse struct {
 	PermissionId uint64 `protobuf:"varint,1,opt,name=permission_id,json=permissionId,proto3" json:"permission_id,omitempty"`
@@ -350,7 +362,7 @@ type MsgSetPermissionVPToValidated struct {
 	ValidationFees     uint64     `protobuf:"varint,4,opt,name=validation_fees,json=validationFees,proto3" json:"validation_fees,omitempty"`
 	IssuanceFees       uint64     `protobuf:"varint,5,opt,name=issuance_fees,json=issuanceFees,proto3" json:"issuance_fees,omitempty"`
 	VerificationFees   uint64     `protobuf:"varint,6,opt,name=verification_fees,json=verificationFees,proto3" json:"verification_fees,omitempty"`
-	Country            string     `protobuf:"bytes,7,opt,name=country,proto3" json:"country,omitempty"`
+	Countries          []string   `protobuf:"bytes,7,rep,name=countries,proto3" json:"countries,omitempty"`
 	VpSummaryDigestSri string     `protobuf:"bytes,8,opt,name=vp_summary_digest_sri,json=vpSummaryDigestSri,proto3" json:"vp_summary_digest_sri,omitempty"`
 }
 
@@ -429,11 +441,11 @@ func (m *MsgSetPermissionVPToValidated) GetVerificationFees() uint64 {
 	return 0
 }
 
-func (m *MsgSetPermissionVPToValidated) GetCountry() string {
+func (m *MsgSetPermissionVPToValidated) GetCountries() []string {
 	if m != nil {
-		return m.Country
+		return m.Countries
 	}
-	return ""
+	return nil
 }
 
 func (m *MsgSetPermissionVPToValidated) GetVpSummaryDigestSri() string {
@@ -760,7 +772,7 @@ type MsgCreateRootPermission struct {
 	Creator          string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	SchemaId         uint64     `protobuf:"varint,2,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
 	Did              string     `protobuf:"bytes,3,opt,name=did,proto3" json:"did,omitempty"`
-	Country          string     `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`
+	Countries        []string   `protobuf:"bytes,4,rep,name=countries,proto3" json:"countries,omitempty"`
 	EffectiveFrom    *time.Time `protobuf:"bytes,5,opt,name=effective_from,json=effectiveFrom,proto3,stdtime" json:"effective_from,omitempty"`
 	EffectiveUntil   *time.Time `protobuf:"bytes,6,opt,name=effective_until,json=effectiveUntil,proto3,stdtime" json:"effective_until,omitempty"`
 	ValidationFees   uint64     `protobuf:"varint,7,opt,name=validation_fees,json=validationFees,proto3" json:"validation_fees,omitempty"`
@@ -822,11 +834,11 @@ func (m *MsgCreateRootPermission) GetDid() string {
 	return ""
 }
 
-func (m *MsgCreateRootPermission) GetCountry() string {
+func (m *MsgCreateRootPermission) GetCountries() []string {
 	if m != nil {
-		return m.Country
+		return m.Countries
 	}
-	return ""
+	return nil
 }
 
 func (m *MsgCreateRootPermission) GetEffectiveFrom() *time.Time {
@@ -1007,6 +1019,8 @@ var xxx_messageInfo_MsgExtendPermissionResponse proto.InternalMessageInfo
 type MsgRevokePermission struct {
 	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// cascade, when set, also revokes or suspends every descendant perm
+	Cascade CascadeMode `protobuf:"varint,3,opt,name=cascade,proto3,enum=verana.perm.v1.CascadeMode" json:"cascade,omitempty"`
 }
 
 func (m *MsgRevokePermission) Reset()         { *m = MsgRevokePermission{} }
@@ -1056,7 +1070,18 @@ func (m *MsgRevokePermission) GetId() uint64 {
 	return 0
 }
 
+func (m *MsgRevokePermission) GetCascade() CascadeMode {
+	if m != nil {
+		return m.Cascade
+	}
+	return CascadeMode_CASCADE_MODE_NONE
+}
+
 type MsgRevokePermissionResponse struct {
+	// cascaded is the number of descendant perms processed by the transaction
+	Cascaded uint64 `protobuf:"varint,1,opt,name=cascaded,proto3" json:"cascaded,omitempty"`
+	// cascade_pending is set when the cascade continues in the next blocks
+	CascadePending bool `protobuf:"varint,2,opt,name=cascade_pending,json=cascadePending,proto3" json:"cascade_pending,omitempty"`
 }
 
 func (m *MsgRevokePermissionResponse) Reset()         { *m = MsgRevokePermissionResponse{} }
@@ -1092,6 +1117,20 @@ func (m *MsgRevokePermissionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRevokePermissionResponse proto.InternalMessageInfo
 
+func (m *MsgRevokePermissionResponse) GetCascaded() uint64 {
+	if m != nil {
+		return m.Cascaded
+	}
+	return 0
+}
+
+func (m *MsgRevokePermissionResponse) GetCascadePending() bool {
+	if m != nil {
+		return m.CascadePending
+	}
+	return false
+}
+
 type MsgCreateOrUpdatePermissionSession struct {
 	Creator           string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Id                string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -1099,6 +1138,14 @@ type MsgCreateOrUpdatePermissionSession struct {
 	VerifierPermId    uint64 `protobuf:"varint,4,opt,name=verifier_perm_id,json=verifierPermId,proto3" json:"verifier_perm_id,omitempty"`
 	AgentPermId       uint64 `protobuf:"varint,5,opt,name=agent_perm_id,json=agentPermId,proto3" json:"agent_perm_id,omitempty"`
 	WalletAgentPermId uint64 `protobuf:"varint,6,opt,name=wallet_agent_perm_id,json=walletAgentPermId,proto3" json:"wallet_agent_perm_id,omitempty"`
+	// allowance_id, if set, is the session allowance the fees are drawn from
+	AllowanceId uint64 `protobuf:"varint,7,opt,name=allowance_id,json=allowanceId,proto3" json:"allowance_id,omitempty"`
+	// expires, if set, is the expiration of a new session. It defaults to, and
+	// cannot be later than, the maximum session duration.
+	Expires *time.Time `protobuf:"bytes,8,opt,name=expires,proto3,stdtime" json:"expires,omitempty"`
+	// credit_id, if set, is the verification credit bundle the session consumes
+	// a credit of instead of paying the fees
+	CreditId uint64 `protobuf:"varint,9,opt,name=credit_id,json=creditId,proto3" json:"credit_id,omitempty"`
 }
 
 func (m *MsgCreateOrUpdatePermissionSession) Reset()         { *m = MsgCreateOrUpdatePermissionSession{} }
@@ -1176,6 +1223,27 @@ func (m *MsgCreateOrUpdatePermissionSession) GetWalletAgentPermId() uint64 {
 	return 0
 }
 
+func (m *MsgCreateOrUpdatePermissionSession) GetAllowanceId() uint64 {
+	if m != nil {
+		return m.AllowanceId
+	}
+	return 0
+}
+
+func (m *MsgCreateOrUpdatePermissionSession) GetExpires() *time.Time {
+	if m != nil {
+		return m.Expires
+	}
+	return nil
+}
+
+func (m *MsgCreateOrUpdatePermissionSession) GetCreditId() uint64 {
+	if m != nil {
+		return m.CreditId
+	}
+	return 0
+}
+
 type MsgCreateOrUpdatePermissionSessionResponse struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
@@ -1228,6 +1296,16 @@ type MsgSlashPermissionTrustDeposit struct {
 	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 	Amount  uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// reason_code identifies the violated rule, as listed by the governance
+	// framework of the trust registry
+	ReasonCode        uint32 `protobuf:"varint,4,opt,name=reason_code,json=reasonCode,proto3" json:"reason_code,omitempty"`
+	EvidenceUrl       string `protobuf:"bytes,5,opt,name=evidence_url,json=evidenceUrl,proto3" json:"evidence_url,omitempty"`
+	EvidenceDigestSri string `protobuf:"bytes,6,opt,name=evidence_digest_sri,json=evidenceDigestSri,proto3" json:"evidence_digest_sri,omitempty"`
+	// gf_version is the governance framework version that was violated,
+	// the active version of the trust registry if 0
+	GfVersion int32 `protobuf:"varint,7,opt,name=gf_version,json=gfVersion,proto3" json:"gf_version,omitempty"`
+	// distribution overrides the slash distribution of the trust registry
+	Distribution []types.SlashShare `protobuf:"bytes,8,rep,name=distribution,proto3" json:"distribution"`
 }
 
 func (m *MsgSlashPermissionTrustDeposit) Reset()         { *m = MsgSlashPermissionTrustDeposit{} }
@@ -1284,7 +1362,44 @@ func (m *MsgSlashPermissionTrustDeposit) GetAmount() uint64 {
 	return 0
 }
 
+func (m *MsgSlashPermissionTrustDeposit) GetReasonCode() uint32 {
+	if m != nil {
+		return m.ReasonCode
+	}
+	return 0
+}
+
+func (m *MsgSlashPermissionTrustDeposit) GetEvidenceUrl() string {
+	if m != nil {
+		return m.EvidenceUrl
+	}
+	return ""
+}
+
+func (m *MsgSlashPermissionTrustDeposit) GetEvidenceDigestSri() string {
+	if m != nil {
+		return m.EvidenceDigestSri
+	}
+	return ""
+}
+
+func (m *MsgSlashPermissionTrustDeposit) GetGfVersion() int32 {
+	if m != nil {
+		return m.GfVersion
+	}
+	return 0
+}
+
+func (m *MsgSlashPermissionTrustDeposit) GetDistribution() []types.SlashShare {
+	if m != nil {
+		return m.Distribution
+	}
+	return nil
+}
+
 type MsgSlashPermissionTrustDepositResponse struct {
+	// slash_id is the id of the pending slash record
+	SlashId uint64 `protobuf:"varint,1,opt,name=slash_id,json=slashId,proto3" json:"slash_id,omitempty"`
 }
 
 func (m *MsgSlashPermissionTrustDepositResponse) Reset() {
@@ -1322,6 +1437,13 @@ func (m *MsgSlashPermissionTrustDepositResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSlashPermissionTrustDepositResponse proto.InternalMessageInfo
 
+func (m *MsgSlashPermissionTrustDepositResponse) GetSlashId() uint64 {
+	if m != nil {
+		return m.SlashId
+	}
+	return 0
+}
+
 type MsgRepayPermissionSlashedTrustDeposit struct {
 	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -1415,14 +1537,15 @@ func (m *MsgRepayPermissionSlashedTrustDepositResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgRepayPermissionSlashedTrustDepositResponse proto.InternalMessageInfo
 
 type MsgCreatePermission struct {
-	Creator          string         `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	SchemaId         uint64         `protobuf:"varint,2,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
-	Type             PermissionType `protobuf:"varint,3,opt,name=type,proto3,enum=verana.perm.v1.PermissionType" json:"type,omitempty"`
-	Did              string         `protobuf:"bytes,4,opt,name=did,proto3" json:"did,omitempty"`
-	Country          string         `protobuf:"bytes,5,opt,name=country,proto3" json:"country,omitempty"`
-	EffectiveFrom    *time.Time     `protobuf:"bytes,6,opt,name=effective_from,json=effectiveFrom,proto3,stdtime" json:"effective_from,omitempty"`
-	EffectiveUntil   *time.Time     `protobuf:"bytes,7,opt,name=effective_until,json=effectiveUntil,proto3,stdtime" json:"effective_until,omitempty"`
-	VerificationFees uint64         `protobuf:"varint,8,opt,name=verification_fees,json=verificationFees,proto3" json:"verification_fees,omitempty"`
+	Creator  string         `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	SchemaId uint64         `protobuf:"varint,2,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
+	Type     PermissionType `protobuf:"varint,3,opt,name=type,proto3,enum=verana.perm.v1.PermissionType" json:"type,omitempty"`
+	Did      string         `protobuf:"bytes,4,opt,name=did,proto3" json:"did,omitempty"`
+	// countries must be within the country scope of the ecosystem perm
+	Countries        []string   `protobuf:"bytes,5,rep,name=countries,proto3" json:"countries,omitempty"`
+	EffectiveFrom    *time.Time `protobuf:"bytes,6,opt,name=effective_from,json=effectiveFrom,proto3,stdtime" json:"effective_from,omitempty"`
+	EffectiveUntil   *time.Time `protobuf:"bytes,7,opt,name=effective_until,json=effectiveUntil,proto3,stdtime" json:"effective_until,omitempty"`
+	VerificationFees uint64     `protobuf:"varint,8,opt,name=verification_fees,json=verificationFees,proto3" json:"verification_fees,omitempty"`
 }
 
 func (m *MsgCreatePermission) Reset()         { *m = MsgCreatePermission{} }
@@ -1486,11 +1609,11 @@ func (m *MsgCreatePermission) GetDid() string {
 	return ""
 }
 
-func (m *MsgCreatePermission) GetCountry() string {
+func (m *MsgCreatePermission) GetCountries() []string {
 	if m != nil {
-		return m.Country
+		return m.Countries
 	}
-	return ""
+	return nil
 }
 
 func (m *MsgCreatePermission) GetEffectiveFrom() *time.Time {
@@ -1558,2189 +1681,9080 @@ func (m *MsgCreatePermissionResponse) GetId() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "verana.perm.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "verana.perm.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgStartPermissionVP)(nil), "verana.perm.v1.MsgStartPermissionVP")
-	proto.RegisterType((*MsgStartPermissionVPResponse)(nil), "verana.perm.v1.MsgStartPermissionVPResponse")
-	proto.RegisterType((*MsgRenewPermissionVP)(nil), "verana.perm.v1.MsgRenewPermissionVP")
-	proto.RegisterType((*MsgRenewPermissionVPResponse)(nil), "verana.perm.v1.MsgRenewPermissionVPResponse")
-	proto.RegisterType((*MsgSetPermissionVPToValidated)(nil), "verana.perm.v1.MsgSetPermissionVPToValidated")
-	proto.RegisterType((*MsgSetPermissionVPToValidatedResponse)(nil), "verana.perm.v1.MsgSetPermissionVPToValidatedResponse")
-	proto.RegisterType((*MsgRequestPermissionVPTermination)(nil), "verana.perm.v1.MsgRequestPermissionVPTermination")
-	proto.RegisterType((*MsgRequestPermissionVPTerminationResponse)(nil), "verana.perm.v1.MsgRequestPermissionVPTerminationResponse")
-	proto.RegisterType((*MsgConfirmPermissionVPTermination)(nil), "verana.perm.v1.MsgConfirmPermissionVPTermination")
-	proto.RegisterType((*MsgConfirmPermissionVPTerminationResponse)(nil), "verana.perm.v1.MsgConfirmPermissionVPTerminationResponse")
-	proto.RegisterType((*MsgCancelPermissionVPLastRequest)(nil), "verana.perm.v1.MsgCancelPermissionVPLastRequest")
-	proto.RegisterType((*MsgCancelPermissionVPLastRequestResponse)(nil), "verana.perm.v1.MsgCancelPermissionVPLastRequestResponse")
-	proto.RegisterType((*MsgCreateRootPermission)(nil), "verana.perm.v1.MsgCreateRootPermission")
-	proto.RegisterType((*MsgCreateRootPermissionResponse)(nil), "verana.perm.v1.MsgCreateRootPermissionResponse")
-	proto.RegisterType((*MsgExtendPermission)(nil), "verana.perm.v1.MsgExtendPermission")
-	proto.RegisterType((*MsgExtendPermissionResponse)(nil), "verana.perm.v1.MsgExtendPermissionResponse")
-	proto.RegisterType((*MsgRevokePermission)(nil), "verana.perm.v1.MsgRevokePermission")
-	proto.RegisterType((*MsgRevokePermissionResponse)(nil), "verana.perm.v1.MsgRevokePermissionResponse")
-	proto.RegisterType((*MsgCreateOrUpdatePermissionSession)(nil), "verana.perm.v1.MsgCreateOrUpdatePermissionSession")
-	proto.RegisterType((*MsgCreateOrUpdatePermissionSessionResponse)(nil), "verana.perm.v1.MsgCreateOrUpdatePermissionSessionResponse")
-	proto.RegisterType((*MsgSlashPermissionTrustDeposit)(nil), "verana.perm.v1.MsgSlashPermissionTrustDeposit")
-	proto.RegisterType((*MsgSlashPermissionTrustDepositResponse)(nil), "verana.perm.v1.MsgSlashPermissionTrustDepositResponse")
-	proto.RegisterType((*MsgRepayPermissionSlashedTrustDeposit)(nil), "verana.perm.v1.MsgRepayPermissionSlashedTrustDeposit")
-	proto.RegisterType((*MsgRepayPermissionSlashedTrustDepositResponse)(nil), "verana.perm.v1.MsgRepayPermissionSlashedTrustDepositResponse")
-	proto.RegisterType((*MsgCreatePermission)(nil), "verana.perm.v1.MsgCreatePermission")
-	proto.RegisterType((*MsgCreatePermissionResponse)(nil), "verana.perm.v1.MsgCreatePermissionResponse")
+// MsgCreateSessionAllowance escrows amount to pay the session fees of the
+// scope it is created for. Exactly one of schema_id, agent_perm_id and
+// verifier_perm_id must be set.
+type MsgCreateSessionAllowance struct {
+	Creator        string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	SchemaId       uint64 `protobuf:"varint,2,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
+	AgentPermId    uint64 `protobuf:"varint,3,opt,name=agent_perm_id,json=agentPermId,proto3" json:"agent_perm_id,omitempty"`
+	VerifierPermId uint64 `protobuf:"varint,4,opt,name=verifier_perm_id,json=verifierPermId,proto3" json:"verifier_perm_id,omitempty"`
+	Amount         uint64 `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// allowed_creators restricts the accounts that can create sessions paid by
+	// the allowance, it is required for a schema scope
+	AllowedCreators []string `protobuf:"bytes,6,rep,name=allowed_creators,json=allowedCreators,proto3" json:"allowed_creators,omitempty"`
+	// max_per_session caps the amount drawn for a single session, 0 for no cap
+	MaxPerSession uint64 `protobuf:"varint,7,opt,name=max_per_session,json=maxPerSession,proto3" json:"max_per_session,omitempty"`
+}
+
+func (m *MsgCreateSessionAllowance) Reset()         { *m = MsgCreateSessionAllowance{} }
+func (m *MsgCreateSessionAllowance) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateSessionAllowance) ProtoMessage()    {}
+func (*MsgCreateSessionAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4739eb2b981c63a, []int{28}
+}
+func (m *MsgCreateSessionAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func init() { proto.RegisterFile("verana/perm/v1/tx.proto", fileDescriptor_f4739eb2b981c63a) }
-
-var fileDescriptor_f4739eb2b981c63a = []byte{
-	// 1383 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xc6, 0xce, 0x87, 0x5f, 0x13, 0xb7, 0x59, 0x42, 0xeb, 0x6e, 0xa8, 0x1d, 0x9c, 0x42,
-	0x8d, 0x4b, 0xbc, 0xc4, 0xa8, 0x15, 0x2d, 0x70, 0x68, 0x5a, 0x8a, 0x22, 0x88, 0x88, 0x36, 0x49,
-	0x85, 0xb8, 0x58, 0x1b, 0xef, 0x78, 0xbd, 0xaa, 0x77, 0x67, 0xd9, 0x59, 0xbb, 0xf1, 0x0d, 0x55,
-	0x42, 0x42, 0x45, 0x42, 0xe5, 0xc0, 0x85, 0x1b, 0x37, 0x8e, 0x39, 0x20, 0x2e, 0xf0, 0x07, 0x44,
-	0x3d, 0x55, 0x9c, 0x38, 0x15, 0x94, 0x1c, 0xf2, 0x6f, 0xa0, 0x99, 0xfd, 0xb0, 0xbd, 0x3b, 0xfe,
-	0x48, 0xeb, 0x70, 0xb1, 0x76, 0x67, 0x7e, 0x6f, 0xde, 0xef, 0x7d, 0xcc, 0x7b, 0x6f, 0x0d, 0x97,
-	0x5a, 0xc8, 0x51, 0x2d, 0x55, 0xb6, 0x91, 0x63, 0xca, 0xad, 0x35, 0xd9, 0xdd, 0x2f, 0xd9, 0x0e,
-	0x76, 0xb1, 0x98, 0xf6, 0x36, 0x4a, 0x74, 0xa3, 0xd4, 0x5a, 0x93, 0x16, 0x54, 0xd3, 0xb0, 0xb0,
-	0xcc, 0x7e, 0x3d, 0x88, 0x74, 0xa9, 0x8a, 0x89, 0x89, 0x89, 0x6c, 0x12, 0x9d, 0x8a, 0x9a, 0x44,
-	0xf7, 0x37, 0x2e, 0x7b, 0x1b, 0x15, 0xf6, 0x26, 0x7b, 0x2f, 0xfe, 0xd6, 0xa2, 0x8e, 0x75, 0xec,
-	0xad, 0xd3, 0x27, 0x7f, 0x75, 0x29, 0xc2, 0xc2, 0x56, 0x1d, 0xd5, 0x0c, 0x44, 0xa4, 0x28, 0xc5,
-	0xb6, 0x8d, 0x82, 0xbd, 0x9c, 0x8e, 0xb1, 0xde, 0x40, 0x32, 0x7b, 0xdb, 0x6b, 0xd6, 0x64, 0xd7,
-	0x30, 0x11, 0x71, 0x55, 0xd3, 0xf6, 0x00, 0xf9, 0x3f, 0x05, 0x38, 0xbf, 0x49, 0xf4, 0x5d, 0x5b,
-	0x53, 0x5d, 0xb4, 0xc5, 0x8e, 0x15, 0x6f, 0x42, 0x4a, 0x6d, 0xba, 0x75, 0xec, 0x18, 0x6e, 0x3b,
-	0x23, 0x2c, 0x0b, 0x85, 0xd4, 0x7a, 0xe6, 0xaf, 0xdf, 0x56, 0x17, 0x7d, 0xa2, 0x77, 0x34, 0xcd,
-	0x41, 0x84, 0x6c, 0xbb, 0x8e, 0x61, 0xe9, 0x4a, 0x07, 0x2a, 0xde, 0x82, 0x69, 0x8f, 0x58, 0x66,
-	0x72, 0x59, 0x28, 0x9c, 0x2b, 0x5f, 0x2c, 0xf5, 0xfa, 0xa8, 0xe4, 0x9d, 0xbf, 0x9e, 0x3a, 0x7c,
-	0x91, 0x9b, 0xf8, 0xf5, 0xe4, 0xa0, 0x28, 0x28, 0xbe, 0xc0, 0xed, 0x9b, 0x8f, 0x4f, 0x0e, 0x8a,
-	0x9d, 0xa3, 0x9e, 0x9c, 0x1c, 0x14, 0x57, 0x7c, 0xb3, 0xf6, 0x99, 0x61, 0x06, 0x21, 0x06, 0xb6,
-	0xe4, 0x08, 0xd5, 0xfc, 0x65, 0xb8, 0x14, 0x59, 0x52, 0x10, 0xb1, 0xb1, 0x45, 0x50, 0xfe, 0x99,
-	0x00, 0x8b, 0x9b, 0x44, 0xdf, 0x76, 0x55, 0xc7, 0xdd, 0x0a, 0x4f, 0x78, 0xb0, 0x25, 0x96, 0x61,
-	0xa6, 0xea, 0x20, 0xd5, 0xc5, 0xce, 0x50, 0xe3, 0x02, 0xa0, 0x28, 0x42, 0x92, 0xba, 0x95, 0x19,
-	0x36, 0xaf, 0xb0, 0x67, 0xb1, 0x08, 0x0b, 0x2d, 0xb5, 0x61, 0x68, 0x14, 0x50, 0xa1, 0x1c, 0x2b,
-	0x86, 0x96, 0x49, 0x2c, 0x0b, 0x85, 0xa4, 0x72, 0x3e, 0xdc, 0xa0, 0x9a, 0x37, 0x34, 0x31, 0x03,
-	0x33, 0x55, 0xdc, 0xb4, 0x5c, 0xa7, 0x9d, 0x49, 0x52, 0x9d, 0x4a, 0xf0, 0x2a, 0x5e, 0x84, 0x84,
-	0x66, 0x68, 0x99, 0x29, 0xc6, 0x24, 0x79, 0xf8, 0x22, 0x27, 0x28, 0x74, 0xe1, 0xf6, 0x1c, 0xf5,
-	0x48, 0xa0, 0x3f, 0x7f, 0x17, 0xde, 0xe0, 0xd9, 0x12, 0x18, 0x2b, 0xae, 0xc0, 0x7c, 0xc7, 0x4b,
-	0x94, 0x87, 0xc0, 0x78, 0xcc, 0x75, 0x16, 0x37, 0xb4, 0x7c, 0x9d, 0x39, 0x44, 0x41, 0x16, 0x7a,
-	0xf4, 0xca, 0x0e, 0x49, 0xc3, 0xa4, 0xa1, 0x31, 0x77, 0x24, 0x95, 0xc9, 0x18, 0xdd, 0x2c, 0xa3,
-	0x1b, 0xd3, 0x14, 0xc6, 0xe6, 0xbb, 0x04, 0x5c, 0xa1, 0xf6, 0xa0, 0x1e, 0x6b, 0x76, 0xf0, 0x03,
-	0xcf, 0x6d, 0x48, 0x1b, 0x07, 0x27, 0x71, 0x13, 0xce, 0xa3, 0x5a, 0x0d, 0x55, 0x5d, 0xa3, 0x85,
-	0x2a, 0x4d, 0xcb, 0x35, 0x1a, 0x2c, 0x3c, 0xe7, 0xca, 0x52, 0xc9, 0xbb, 0x16, 0xa5, 0xe0, 0x5a,
-	0x94, 0x76, 0x82, 0x6b, 0xb1, 0x3e, 0x4b, 0x43, 0xf0, 0xf4, 0x9f, 0x9c, 0xa0, 0xa4, 0x43, 0xe1,
-	0x5d, 0x2a, 0x2b, 0x5e, 0x83, 0x20, 0xac, 0xd4, 0xc7, 0x35, 0x84, 0x08, 0x8b, 0x65, 0x52, 0x49,
-	0x77, 0x96, 0xef, 0x23, 0x44, 0x68, 0x30, 0x0c, 0x42, 0x9a, 0xaa, 0x55, 0x45, 0x1e, 0x6c, 0xca,
-	0x0b, 0x46, 0xb0, 0xc8, 0x40, 0xd7, 0x61, 0xa1, 0x85, 0x1c, 0xa3, 0x66, 0x54, 0xbb, 0xce, 0x9b,
-	0x66, 0xc0, 0x0b, 0xdd, 0x1b, 0x0c, 0xdc, 0x95, 0x3e, 0x33, 0xbd, 0xe9, 0xb3, 0x06, 0xaf, 0xb7,
-	0xec, 0x0a, 0x69, 0x9a, 0xa6, 0xea, 0xb4, 0x2b, 0x9a, 0xa1, 0x23, 0xe2, 0x56, 0x88, 0x63, 0x64,
-	0x66, 0x19, 0x4e, 0x6c, 0xd9, 0xdb, 0xde, 0xde, 0x3d, 0xb6, 0xb5, 0xed, 0x18, 0x91, 0x50, 0x5d,
-	0x83, 0xb7, 0x06, 0x46, 0x22, 0x8c, 0x59, 0x13, 0xde, 0x64, 0x31, 0xfd, 0xba, 0x89, 0x48, 0x2f,
-	0x98, 0x3e, 0x5b, 0x8c, 0xec, 0x19, 0xa4, 0xd2, 0x75, 0x78, 0x67, 0xa8, 0xda, 0x08, 0xc7, 0xbb,
-	0xd8, 0xaa, 0x19, 0x8e, 0xf9, 0x7f, 0x73, 0x1c, 0xac, 0x36, 0xe4, 0xe8, 0xc2, 0x32, 0x05, 0xd3,
-	0x44, 0x68, 0x74, 0x63, 0x3f, 0x57, 0x89, 0xeb, 0x9b, 0x79, 0x06, 0x14, 0x8b, 0x50, 0x18, 0xa6,
-	0x35, 0x64, 0xf8, 0x47, 0x82, 0x55, 0xd5, 0xbb, 0x54, 0x14, 0x29, 0x18, 0x77, 0xb9, 0xfd, 0xa5,
-	0x98, 0x2d, 0x41, 0x8a, 0x54, 0xeb, 0xc8, 0x54, 0x2b, 0x21, 0xc1, 0x59, 0x6f, 0x61, 0x43, 0x13,
-	0x2f, 0x78, 0xf5, 0x2f, 0xc1, 0xd2, 0x95, 0x3e, 0x0e, 0xa8, 0x95, 0x9f, 0x41, 0xe7, 0x4e, 0x56,
-	0x6a, 0x0e, 0x36, 0xd9, 0xcd, 0x1a, 0xf5, 0x3e, 0xcf, 0x87, 0xb2, 0xf7, 0x1d, 0x6c, 0xf2, 0xaa,
-	0xc3, 0xf4, 0x78, 0xab, 0xc3, 0xcc, 0x68, 0xd5, 0x61, 0x76, 0xd4, 0xea, 0x90, 0xe2, 0x57, 0x87,
-	0x48, 0xa4, 0xd7, 0x20, 0xd7, 0x27, 0x78, 0x61, 0xb7, 0xf0, 0x52, 0x45, 0x08, 0x52, 0x25, 0xff,
-	0xbb, 0x00, 0xaf, 0x6d, 0x12, 0xfd, 0x93, 0x7d, 0x17, 0x59, 0xda, 0x2b, 0x06, 0xfb, 0x6c, 0x8b,
-	0x70, 0xc4, 0xd6, 0x2b, 0xb0, 0xc4, 0xe1, 0x1d, 0x26, 0xb2, 0xce, 0xcc, 0x52, 0x50, 0x0b, 0x3f,
-	0x44, 0xe3, 0x35, 0x8b, 0xcb, 0x23, 0xaa, 0x28, 0xe4, 0xf1, 0xcb, 0x24, 0xe4, 0xc3, 0x98, 0x7c,
-	0xe1, 0xf8, 0xe3, 0x4a, 0x88, 0xdb, 0x46, 0xe3, 0xe0, 0x95, 0x62, 0xee, 0xbe, 0x0a, 0x69, 0x9a,
-	0x48, 0x28, 0x3a, 0x91, 0xcc, 0x79, 0xab, 0xfe, 0x38, 0x52, 0x00, 0x3f, 0x8b, 0xba, 0x70, 0x41,
-	0x2f, 0xf3, 0xd7, 0x7d, 0x64, 0x1e, 0xe6, 0x55, 0x1d, 0x59, 0x6e, 0x08, 0xf3, 0x7a, 0xd9, 0x39,
-	0xb6, 0xe8, 0x63, 0x6e, 0xc0, 0xe2, 0x23, 0xb5, 0xd1, 0x40, 0x6e, 0xa5, 0x17, 0xca, 0xba, 0x99,
-	0x3f, 0xd3, 0x2c, 0x78, 0x88, 0x3b, 0x1d, 0xb1, 0x88, 0x0b, 0x3f, 0x82, 0xe2, 0x70, 0x17, 0x71,
-	0x32, 0x98, 0x99, 0x9d, 0x7f, 0x22, 0x40, 0x96, 0xb6, 0xb1, 0x86, 0x4a, 0xea, 0x1d, 0xa9, 0x1d,
-	0xa7, 0x49, 0xdc, 0x7b, 0xc8, 0xc6, 0xc4, 0x18, 0x4b, 0x4d, 0x15, 0x2f, 0xc2, 0xb4, 0x6a, 0xd2,
-	0x62, 0xe4, 0x7b, 0xd5, 0x7f, 0x8b, 0x98, 0x52, 0x80, 0xb7, 0x07, 0x73, 0x09, 0x13, 0xa3, 0xcd,
-	0x9a, 0xaf, 0x82, 0x6c, 0xb5, 0xdd, 0x65, 0x2b, 0x15, 0x44, 0xda, 0xb8, 0xc9, 0x47, 0x48, 0xca,
-	0xb0, 0x3a, 0x92, 0xea, 0x90, 0xeb, 0x4f, 0x09, 0x76, 0x9b, 0xbc, 0x08, 0x9d, 0x65, 0x47, 0x28,
-	0xfb, 0xb3, 0x36, 0x75, 0x71, 0xba, 0x9c, 0x8d, 0x7d, 0x44, 0x74, 0x5c, 0xda, 0xb6, 0x91, 0x3f,
-	0x8b, 0xfb, 0x5d, 0x24, 0xc9, 0xed, 0x22, 0x53, 0xbd, 0x5d, 0xe4, 0xd3, 0x58, 0x17, 0x19, 0x5e,
-	0xf7, 0x93, 0xbc, 0x0e, 0xb2, 0x11, 0x2f, 0x6d, 0x33, 0x23, 0x9e, 0x14, 0xed, 0x1e, 0xdc, 0x7a,
-	0x3f, 0x3b, 0x52, 0xbd, 0x5f, 0x65, 0xb5, 0x27, 0x1a, 0x96, 0x7e, 0xb5, 0xbe, 0xfc, 0x6c, 0x1e,
-	0x12, 0x9b, 0x44, 0x17, 0xbf, 0x84, 0xb9, 0x9e, 0x8f, 0xbe, 0x5c, 0xd4, 0xcf, 0x91, 0xef, 0x2a,
-	0xe9, 0xda, 0x10, 0x40, 0xa8, 0x51, 0x87, 0x85, 0xf8, 0x47, 0xd7, 0x55, 0x8e, 0x74, 0x0c, 0x25,
-	0xbd, 0x3b, 0x0a, 0xaa, 0x5b, 0x51, 0xfc, 0x63, 0x86, 0xa7, 0x28, 0x86, 0xe2, 0x2a, 0xea, 0xfb,
-	0xb9, 0x22, 0x3e, 0x16, 0x40, 0x1a, 0xf0, 0xad, 0xb2, 0xca, 0x63, 0xdd, 0x17, 0x2e, 0xdd, 0x38,
-	0x15, 0x3c, 0x24, 0xf1, 0x83, 0x00, 0xd9, 0x21, 0xd3, 0xf7, 0x1a, 0xd7, 0xaa, 0x41, 0x22, 0xd2,
-	0xad, 0x53, 0x8b, 0xf4, 0x10, 0x1a, 0x32, 0x6a, 0xf3, 0x08, 0x0d, 0x16, 0xe1, 0x12, 0x1a, 0x6d,
-	0xb2, 0x16, 0xbf, 0x17, 0xe0, 0xca, 0xe0, 0xb9, 0xfa, 0x3d, 0xde, 0xe1, 0x83, 0x24, 0xa4, 0x0f,
-	0x4e, 0x2b, 0x11, 0xb2, 0xb1, 0x61, 0x91, 0x3b, 0x41, 0xf3, 0xee, 0x11, 0x0f, 0x28, 0xc9, 0x23,
-	0x02, 0x43, 0x8d, 0x1a, 0x5c, 0x88, 0x8d, 0x70, 0x2b, 0x9c, 0x43, 0xa2, 0x20, 0xe9, 0xfa, 0x08,
-	0xa0, 0x6e, 0x2d, 0xb1, 0x89, 0x6a, 0x85, 0x9b, 0x45, 0xbd, 0x20, 0xae, 0x96, 0x7e, 0x23, 0x93,
-	0xf8, 0xa3, 0x00, 0xb9, 0xa1, 0xf3, 0x52, 0x5f, 0x07, 0xf5, 0x95, 0x91, 0x6e, 0x9f, 0x5e, 0x26,
-	0xe4, 0xf4, 0xad, 0x00, 0x4b, 0x83, 0x26, 0x8c, 0x12, 0xef, 0x62, 0xf7, 0xc7, 0x4b, 0x37, 0x4f,
-	0x87, 0x0f, 0x79, 0xfc, 0x2c, 0x40, 0x7e, 0x84, 0x99, 0xe1, 0x06, 0xd7, 0xdf, 0xc3, 0xc4, 0xa4,
-	0x8f, 0x5f, 0x4a, 0xac, 0x3b, 0x3d, 0x62, 0x23, 0xc2, 0x4a, 0x5f, 0xa7, 0x0f, 0x49, 0x8f, 0x7e,
-	0x5d, 0x4d, 0x9a, 0xfa, 0xe6, 0xe4, 0xa0, 0x28, 0xac, 0xef, 0x1e, 0x1e, 0x65, 0x85, 0xe7, 0x47,
-	0x59, 0xe1, 0xdf, 0xa3, 0xac, 0xf0, 0xf4, 0x38, 0x3b, 0xf1, 0xfc, 0x38, 0x3b, 0xf1, 0xf7, 0x71,
-	0x76, 0xe2, 0xab, 0x0f, 0x75, 0xc3, 0xad, 0x37, 0xf7, 0x4a, 0x55, 0x6c, 0xca, 0xde, 0xb9, 0xab,
-	0x0d, 0x75, 0x8f, 0x04, 0xcf, 0x7b, 0x0d, 0x5c, 0x7d, 0x58, 0xad, 0xab, 0x86, 0xd5, 0xfb, 0xff,
-	0x22, 0xfb, 0xef, 0x74, 0x6f, 0x9a, 0xf5, 0xed, 0xf7, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x5a,
-	0x23, 0x17, 0x8a, 0xfd, 0x15, 0x00, 0x00,
+func (m *MsgCreateSessionAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateSessionAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	StartPermissionVP(ctx context.Context, in *MsgStartPermissionVP, opts ...grpc.CallOption) (*MsgStartPermissionVPResponse, error)
-	RenewPermissionVP(ctx context.Context, in *MsgRenewPermissionVP, opts ...grpc.CallOption) (*MsgRenewPermissionVPResponse, error)
-	SetPermissionVPToValidated(ctx context.Context, in *MsgSetPermissionVPToValidated, opts ...grpc.CallOption) (*MsgSetPermissionVPToValidatedResponse, error)
-	RequestPermissionVPTermination(ctx context.Context, in *MsgRequestPermissionVPTermination, opts ...grpc.CallOption) (*MsgRequestPermissionVPTerminationResponse, error)
-	ConfirmPermissionVPTermination(ctx context.Context, in *MsgConfirmPermissionVPTermination, opts ...grpc.CallOption) (*MsgConfirmPermissionVPTerminationResponse, error)
-	CancelPermissionVPLastRequest(ctx context.Context, in *MsgCancelPermissionVPLastRequest, opts ...grpc.CallOption) (*MsgCancelPermissionVPLastRequestResponse, error)
-	CreateRootPermission(ctx context.Context, in *MsgCreateRootPermission, opts ...grpc.CallOption) (*MsgCreateRootPermissionResponse, error)
-	ExtendPermission(ctx context.Context, in *MsgExtendPermission, opts ...grpc.CallOption) (*MsgExtendPermissionResponse, error)
-	RevokePermission(ctx context.Context, in *MsgRevokePermission, opts ...grpc.CallOption) (*MsgRevokePermissionResponse, error)
-	CreateOrUpdatePermissionSession(ctx context.Context, in *MsgCreateOrUpdatePermissionSession, opts ...grpc.CallOption) (*MsgCreateOrUpdatePermissionSessionResponse, error)
-	SlashPermissionTrustDeposit(ctx context.Context, in *MsgSlashPermissionTrustDeposit, opts ...grpc.CallOption) (*MsgSlashPermissionTrustDepositResponse, error)
-	RepayPermissionSlashedTrustDeposit(ctx context.Context, in *MsgRepayPermissionSlashedTrustDeposit, opts ...grpc.CallOption) (*MsgRepayPermissionSlashedTrustDepositResponse, error)
-	CreatePermission(ctx context.Context, in *MsgCreatePermission, opts ...grpc.CallOption) (*MsgCreatePermissionResponse, error)
+func (m *MsgCreateSessionAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateSessionAllowance.Merge(m, src)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgCreateSessionAllowance) XXX_Size() int {
+	return m.Size()
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgCreateSessionAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateSessionAllowance.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCreateSessionAllowance proto.InternalMessageInfo
+
+func (m *MsgCreateSessionAllowance) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) StartPermissionVP(ctx context.Context, in *MsgStartPermissionVP, opts ...grpc.CallOption) (*MsgStartPermissionVPResponse, error) {
-	out := new(MsgStartPermissionVPResponse)
-	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/StartPermissionVP", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateSessionAllowance) GetSchemaId() uint64 {
+	if m != nil {
+		return m.SchemaId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) RenewPermissionVP(ctx context.Context, in *MsgRenewPermissionVP, opts ...grpc.CallOption) (*MsgRenewPermissionVPResponse, error) {
-	out := new(MsgRenewPermissionVPResponse)
-	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/RenewPermissionVP", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateSessionAllowance) GetAgentPermId() uint64 {
+	if m != nil {
+		return m.AgentPermId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) SetPermissionVPToValidated(ctx context.Context, in *MsgSetPermissionVPToValidated, opts ...grpc.CallOption) (*MsgSetPermissionVPToValidatedResponse, error) {
-	out := new(MsgSetPermissionVPToValidatedResponse)
-	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/SetPermissionVPToValidated", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateSessionAllowance) GetVerifierPermId() uint64 {
+	if m != nil {
+		return m.VerifierPermId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) RequestPermissionVPTermination(ctx context.Context, in *MsgRequestPermissionVPTermination, opts ...grpc.CallOption) (*MsgRequestPermissionVPTerminationResponse, error) {
-	out := new(MsgRequestPermissionVPTerminationResponse)
-	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/RequestPermissionVPTermination", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateSessionAllowance) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) ConfirmPermissionVPTermination(ctx context.Context, in *MsgConfirmPermissionVPTermination, opts ...grpc.CallOption) (*MsgConfirmPermissionVPTerminationResponse, error) {
-	out := new(MsgConfirmPermissionVPTerminationResponse)
-	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/ConfirmPermissionVPTermination", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateSessionAllowance) GetAllowedCreators() []string {
+	if m != nil {
+		return m.AllowedCreators
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) CancelPermissionVPLastRequest(ctx context.Context, in *MsgCancelPermissionVPLastRequest, opts ...grpc.CallOption) (*MsgCancelPermissionVPLastRequestResponse, error) {
-	out := new(MsgCancelPermissionVPLastRequestResponse)
-	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/CancelPermissionVPLastRequest", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateSessionAllowance) GetMaxPerSession() uint64 {
+	if m != nil {
+		return m.MaxPerSession
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) CreateRootPermission(ctx context.Context, in *MsgCreateRootPermission, opts ...grpc.CallOption) (*MsgCreateRootPermissionResponse, error) {
-	out := new(MsgCreateRootPermissionResponse)
-	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/CreateRootPermission", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgCreateSessionAllowanceResponse struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (c *msgClient) ExtendPermission(ctx context.Context, in *MsgExtendPermission, opts ...grpc.CallOption) (*MsgExtendPermissionResponse, error) {
-	out := new(MsgExtendPermissionResponse)
-	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/ExtendPermission", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateSessionAllowanceResponse) Reset()         { *m = MsgCreateSessionAllowanceResponse{} }
+func (m *MsgCreateSessionAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateSessionAllowanceResponse) ProtoMessage()    {}
+func (*MsgCreateSessionAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4739eb2b981c63a, []int{29}
+}
+func (m *MsgCreateSessionAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateSessionAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateSessionAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgCreateSessionAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateSessionAllowanceResponse.Merge(m, src)
+}
+func (m *MsgCreateSessionAllowanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateSessionAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateSessionAllowanceResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) RevokePermission(ctx context.Context, in *MsgRevokePermission, opts ...grpc.CallOption) (*MsgRevokePermissionResponse, error) {
-	out := new(MsgRevokePermissionResponse)
-	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/RevokePermission", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCreateSessionAllowanceResponse proto.InternalMessageInfo
+
+func (m *MsgCreateSessionAllowanceResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) CreateOrUpdatePermissionSession(ctx context.Context, in *MsgCreateOrUpdatePermissionSession, opts ...grpc.CallOption) (*MsgCreateOrUpdatePermissionSessionResponse, error) {
-	out := new(MsgCreateOrUpdatePermissionSessionResponse)
-	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/CreateOrUpdatePermissionSession", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgFundSessionAllowance adds amount to an existing session allowance.
+type MsgFundSessionAllowance struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Amount  uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (c *msgClient) SlashPermissionTrustDeposit(ctx context.Context, in *MsgSlashPermissionTrustDeposit, opts ...grpc.CallOption) (*MsgSlashPermissionTrustDepositResponse, error) {
-	out := new(MsgSlashPermissionTrustDepositResponse)
-	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/SlashPermissionTrustDeposit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgFundSessionAllowance) Reset()         { *m = MsgFundSessionAllowance{} }
+func (m *MsgFundSessionAllowance) String() string { return proto.CompactTextString(m) }
+func (*MsgFundSessionAllowance) ProtoMessage()    {}
+func (*MsgFundSessionAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4739eb2b981c63a, []int{30}
+}
+func (m *MsgFundSessionAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundSessionAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundSessionAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgFundSessionAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundSessionAllowance.Merge(m, src)
+}
+func (m *MsgFundSessionAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundSessionAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundSessionAllowance.DiscardUnknown(m)
 }
 
-func (c *msgClient) RepayPermissionSlashedTrustDeposit(ctx context.Context, in *MsgRepayPermissionSlashedTrustDeposit, opts ...grpc.CallOption) (*MsgRepayPermissionSlashedTrustDepositResponse, error) {
-	out := new(MsgRepayPermissionSlashedTrustDepositResponse)
-	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/RepayPermissionSlashedTrustDeposit", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgFundSessionAllowance proto.InternalMessageInfo
+
+func (m *MsgFundSessionAllowance) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CreatePermission(ctx context.Context, in *MsgCreatePermission, opts ...grpc.CallOption) (*MsgCreatePermissionResponse, error) {
-	out := new(MsgCreatePermissionResponse)
-	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/CreatePermission", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgFundSessionAllowance) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return 0
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	StartPermissionVP(context.Context, *MsgStartPermissionVP) (*MsgStartPermissionVPResponse, error)
-	RenewPermissionVP(context.Context, *MsgRenewPermissionVP) (*MsgRenewPermissionVPResponse, error)
-	SetPermissionVPToValidated(context.Context, *MsgSetPermissionVPToValidated) (*MsgSetPermissionVPToValidatedResponse, error)
-	RequestPermissionVPTermination(context.Context, *MsgRequestPermissionVPTermination) (*MsgRequestPermissionVPTerminationResponse, error)
-	ConfirmPermissionVPTermination(context.Context, *MsgConfirmPermissionVPTermination) (*MsgConfirmPermissionVPTerminationResponse, error)
-	CancelPermissionVPLastRequest(context.Context, *MsgCancelPermissionVPLastRequest) (*MsgCancelPermissionVPLastRequestResponse, error)
-	CreateRootPermission(context.Context, *MsgCreateRootPermission) (*MsgCreateRootPermissionResponse, error)
-	ExtendPermission(context.Context, *MsgExtendPermission) (*MsgExtendPermissionResponse, error)
-	RevokePermission(context.Context, *MsgRevokePermission) (*MsgRevokePermissionResponse, error)
-	CreateOrUpdatePermissionSession(context.Context, *MsgCreateOrUpdatePermissionSession) (*MsgCreateOrUpdatePermissionSessionResponse, error)
-	SlashPermissionTrustDeposit(context.Context, *MsgSlashPermissionTrustDeposit) (*MsgSlashPermissionTrustDepositResponse, error)
-	RepayPermissionSlashedTrustDeposit(context.Context, *MsgRepayPermissionSlashedTrustDeposit) (*MsgRepayPermissionSlashedTrustDepositResponse, error)
-	CreatePermission(context.Context, *MsgCreatePermission) (*MsgCreatePermissionResponse, error)
+func (m *MsgFundSessionAllowance) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+type MsgFundSessionAllowanceResponse struct {
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
-}
-func (*UnimplementedMsgServer) StartPermissionVP(ctx context.Context, req *MsgStartPermissionVP) (*MsgStartPermissionVPResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StartPermissionVP not implemented")
+func (m *MsgFundSessionAllowanceResponse) Reset()         { *m = MsgFundSessionAllowanceResponse{} }
+func (m *MsgFundSessionAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundSessionAllowanceResponse) ProtoMessage()    {}
+func (*MsgFundSessionAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4739eb2b981c63a, []int{31}
 }
-func (*UnimplementedMsgServer) RenewPermissionVP(ctx context.Context, req *MsgRenewPermissionVP) (*MsgRenewPermissionVPResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RenewPermissionVP not implemented")
+func (m *MsgFundSessionAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) SetPermissionVPToValidated(ctx context.Context, req *MsgSetPermissionVPToValidated) (*MsgSetPermissionVPToValidatedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetPermissionVPToValidated not implemented")
+func (m *MsgFundSessionAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundSessionAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) RequestPermissionVPTermination(ctx context.Context, req *MsgRequestPermissionVPTermination) (*MsgRequestPermissionVPTerminationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RequestPermissionVPTermination not implemented")
+func (m *MsgFundSessionAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundSessionAllowanceResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) ConfirmPermissionVPTermination(ctx context.Context, req *MsgConfirmPermissionVPTermination) (*MsgConfirmPermissionVPTerminationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConfirmPermissionVPTermination not implemented")
+func (m *MsgFundSessionAllowanceResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) CancelPermissionVPLastRequest(ctx context.Context, req *MsgCancelPermissionVPLastRequest) (*MsgCancelPermissionVPLastRequestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelPermissionVPLastRequest not implemented")
+func (m *MsgFundSessionAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundSessionAllowanceResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) CreateRootPermission(ctx context.Context, req *MsgCreateRootPermission) (*MsgCreateRootPermissionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateRootPermission not implemented")
+
+var xxx_messageInfo_MsgFundSessionAllowanceResponse proto.InternalMessageInfo
+
+// MsgWithdrawSessionAllowance returns amount of the remaining allowance to its
+// sponsor. An amount of 0 withdraws everything that remains.
+type MsgWithdrawSessionAllowance struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Amount  uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
-func (*UnimplementedMsgServer) ExtendPermission(ctx context.Context, req *MsgExtendPermission) (*MsgExtendPermissionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExtendPermission not implemented")
+
+func (m *MsgWithdrawSessionAllowance) Reset()         { *m = MsgWithdrawSessionAllowance{} }
+func (m *MsgWithdrawSessionAllowance) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawSessionAllowance) ProtoMessage()    {}
+func (*MsgWithdrawSessionAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4739eb2b981c63a, []int{32}
 }
-func (*UnimplementedMsgServer) RevokePermission(ctx context.Context, req *MsgRevokePermission) (*MsgRevokePermissionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RevokePermission not implemented")
+func (m *MsgWithdrawSessionAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CreateOrUpdatePermissionSession(ctx context.Context, req *MsgCreateOrUpdatePermissionSession) (*MsgCreateOrUpdatePermissionSessionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateOrUpdatePermissionSession not implemented")
+func (m *MsgWithdrawSessionAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawSessionAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) SlashPermissionTrustDeposit(ctx context.Context, req *MsgSlashPermissionTrustDeposit) (*MsgSlashPermissionTrustDepositResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SlashPermissionTrustDeposit not implemented")
+func (m *MsgWithdrawSessionAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawSessionAllowance.Merge(m, src)
 }
-func (*UnimplementedMsgServer) RepayPermissionSlashedTrustDeposit(ctx context.Context, req *MsgRepayPermissionSlashedTrustDeposit) (*MsgRepayPermissionSlashedTrustDepositResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RepayPermissionSlashedTrustDeposit not implemented")
+func (m *MsgWithdrawSessionAllowance) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) CreatePermission(ctx context.Context, req *MsgCreatePermission) (*MsgCreatePermissionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePermission not implemented")
+func (m *MsgWithdrawSessionAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawSessionAllowance.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgWithdrawSessionAllowance proto.InternalMessageInfo
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.perm.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgWithdrawSessionAllowance) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_StartPermissionVP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgStartPermissionVP)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).StartPermissionVP(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.perm.v1.Msg/StartPermissionVP",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).StartPermissionVP(ctx, req.(*MsgStartPermissionVP))
+func (m *MsgWithdrawSessionAllowance) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_RenewPermissionVP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRenewPermissionVP)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RenewPermissionVP(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.perm.v1.Msg/RenewPermissionVP",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RenewPermissionVP(ctx, req.(*MsgRenewPermissionVP))
+func (m *MsgWithdrawSessionAllowance) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_SetPermissionVPToValidated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetPermissionVPToValidated)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetPermissionVPToValidated(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.perm.v1.Msg/SetPermissionVPToValidated",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetPermissionVPToValidated(ctx, req.(*MsgSetPermissionVPToValidated))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgWithdrawSessionAllowanceResponse struct {
+	Amount uint64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func _Msg_RequestPermissionVPTermination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRequestPermissionVPTermination)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RequestPermissionVPTermination(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.perm.v1.Msg/RequestPermissionVPTermination",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RequestPermissionVPTermination(ctx, req.(*MsgRequestPermissionVPTermination))
+func (m *MsgWithdrawSessionAllowanceResponse) Reset()         { *m = MsgWithdrawSessionAllowanceResponse{} }
+func (m *MsgWithdrawSessionAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawSessionAllowanceResponse) ProtoMessage()    {}
+func (*MsgWithdrawSessionAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4739eb2b981c63a, []int{33}
+}
+func (m *MsgWithdrawSessionAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawSessionAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawSessionAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgWithdrawSessionAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawSessionAllowanceResponse.Merge(m, src)
+}
+func (m *MsgWithdrawSessionAllowanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawSessionAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawSessionAllowanceResponse.DiscardUnknown(m)
 }
 
-func _Msg_ConfirmPermissionVPTermination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgConfirmPermissionVPTermination)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ConfirmPermissionVPTermination(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.perm.v1.Msg/ConfirmPermissionVPTermination",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ConfirmPermissionVPTermination(ctx, req.(*MsgConfirmPermissionVPTermination))
+var xxx_messageInfo_MsgWithdrawSessionAllowanceResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawSessionAllowanceResponse) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_CancelPermissionVPLastRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelPermissionVPLastRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelPermissionVPLastRequest(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.perm.v1.Msg/CancelPermissionVPLastRequest",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelPermissionVPLastRequest(ctx, req.(*MsgCancelPermissionVPLastRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgAppealSlash lets the grantee of a slashed perm dispute a pending slash
+// before its appeal deadline.
+type MsgAppealSlash struct {
+	Creator           string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id                uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	EvidenceDigestSri string `protobuf:"bytes,3,opt,name=evidence_digest_sri,json=evidenceDigestSri,proto3" json:"evidence_digest_sri,omitempty"`
 }
 
-func _Msg_CreateRootPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateRootPermission)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateRootPermission(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.perm.v1.Msg/CreateRootPermission",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateRootPermission(ctx, req.(*MsgCreateRootPermission))
+func (m *MsgAppealSlash) Reset()         { *m = MsgAppealSlash{} }
+func (m *MsgAppealSlash) String() string { return proto.CompactTextString(m) }
+func (*MsgAppealSlash) ProtoMessage()    {}
+func (*MsgAppealSlash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4739eb2b981c63a, []int{34}
+}
+func (m *MsgAppealSlash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAppealSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAppealSlash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgAppealSlash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAppealSlash.Merge(m, src)
+}
+func (m *MsgAppealSlash) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAppealSlash) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAppealSlash.DiscardUnknown(m)
 }
 
-func _Msg_ExtendPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgExtendPermission)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ExtendPermission(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.perm.v1.Msg/ExtendPermission",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ExtendPermission(ctx, req.(*MsgExtendPermission))
+var xxx_messageInfo_MsgAppealSlash proto.InternalMessageInfo
+
+func (m *MsgAppealSlash) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_RevokePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRevokePermission)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RevokePermission(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.perm.v1.Msg/RevokePermission",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RevokePermission(ctx, req.(*MsgRevokePermission))
+func (m *MsgAppealSlash) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_CreateOrUpdatePermissionSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateOrUpdatePermissionSession)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateOrUpdatePermissionSession(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.perm.v1.Msg/CreateOrUpdatePermissionSession",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateOrUpdatePermissionSession(ctx, req.(*MsgCreateOrUpdatePermissionSession))
+func (m *MsgAppealSlash) GetEvidenceDigestSri() string {
+	if m != nil {
+		return m.EvidenceDigestSri
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SlashPermissionTrustDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSlashPermissionTrustDeposit)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SlashPermissionTrustDeposit(ctx, in)
+type MsgAppealSlashResponse struct {
+}
+
+func (m *MsgAppealSlashResponse) Reset()         { *m = MsgAppealSlashResponse{} }
+func (m *MsgAppealSlashResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAppealSlashResponse) ProtoMessage()    {}
+func (*MsgAppealSlashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4739eb2b981c63a, []int{35}
+}
+func (m *MsgAppealSlashResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAppealSlashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAppealSlashResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.perm.v1.Msg/SlashPermissionTrustDeposit",
+}
+func (m *MsgAppealSlashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAppealSlashResponse.Merge(m, src)
+}
+func (m *MsgAppealSlashResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAppealSlashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAppealSlashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAppealSlashResponse proto.InternalMessageInfo
+
+// MsgResolveSlashAppeal resolves an appealed slash. It can be executed by the
+// controller of the trust registry of the perm schema or by governance.
+type MsgResolveSlashAppeal struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// uphold executes the slash when true and reverses it when false
+	Uphold bool `protobuf:"varint,3,opt,name=uphold,proto3" json:"uphold,omitempty"`
+}
+
+func (m *MsgResolveSlashAppeal) Reset()         { *m = MsgResolveSlashAppeal{} }
+func (m *MsgResolveSlashAppeal) String() string { return proto.CompactTextString(m) }
+func (*MsgResolveSlashAppeal) ProtoMessage()    {}
+func (*MsgResolveSlashAppeal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4739eb2b981c63a, []int{36}
+}
+func (m *MsgResolveSlashAppeal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolveSlashAppeal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolveSlashAppeal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SlashPermissionTrustDeposit(ctx, req.(*MsgSlashPermissionTrustDeposit))
+}
+func (m *MsgResolveSlashAppeal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveSlashAppeal.Merge(m, src)
+}
+func (m *MsgResolveSlashAppeal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolveSlashAppeal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveSlashAppeal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResolveSlashAppeal proto.InternalMessageInfo
+
+func (m *MsgResolveSlashAppeal) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_RepayPermissionSlashedTrustDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRepayPermissionSlashedTrustDeposit)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgResolveSlashAppeal) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RepayPermissionSlashedTrustDeposit(ctx, in)
+	return 0
+}
+
+func (m *MsgResolveSlashAppeal) GetUphold() bool {
+	if m != nil {
+		return m.Uphold
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.perm.v1.Msg/RepayPermissionSlashedTrustDeposit",
+	return false
+}
+
+type MsgResolveSlashAppealResponse struct {
+}
+
+func (m *MsgResolveSlashAppealResponse) Reset()         { *m = MsgResolveSlashAppealResponse{} }
+func (m *MsgResolveSlashAppealResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResolveSlashAppealResponse) ProtoMessage()    {}
+func (*MsgResolveSlashAppealResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4739eb2b981c63a, []int{37}
+}
+func (m *MsgResolveSlashAppealResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolveSlashAppealResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolveSlashAppealResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RepayPermissionSlashedTrustDeposit(ctx, req.(*MsgRepayPermissionSlashedTrustDeposit))
+}
+func (m *MsgResolveSlashAppealResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveSlashAppealResponse.Merge(m, src)
+}
+func (m *MsgResolveSlashAppealResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolveSlashAppealResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveSlashAppealResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResolveSlashAppealResponse proto.InternalMessageInfo
+
+// MsgSetSlashDistribution sets where the slashed deposits of the perms of a
+// trust registry go. It can only be executed by the trust registry controller.
+// An empty distribution restores burning.
+type MsgSetSlashDistribution struct {
+	Creator      string             `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TrId         uint64             `protobuf:"varint,2,opt,name=tr_id,json=trId,proto3" json:"tr_id,omitempty"`
+	Distribution []types.SlashShare `protobuf:"bytes,3,rep,name=distribution,proto3" json:"distribution"`
+}
+
+func (m *MsgSetSlashDistribution) Reset()         { *m = MsgSetSlashDistribution{} }
+func (m *MsgSetSlashDistribution) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSlashDistribution) ProtoMessage()    {}
+func (*MsgSetSlashDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4739eb2b981c63a, []int{38}
+}
+func (m *MsgSetSlashDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSlashDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSlashDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetSlashDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSlashDistribution.Merge(m, src)
+}
+func (m *MsgSetSlashDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSlashDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSlashDistribution.DiscardUnknown(m)
 }
 
-func _Msg_CreatePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreatePermission)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetSlashDistribution proto.InternalMessageInfo
+
+func (m *MsgSetSlashDistribution) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreatePermission(ctx, in)
+	return ""
+}
+
+func (m *MsgSetSlashDistribution) GetTrId() uint64 {
+	if m != nil {
+		return m.TrId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.perm.v1.Msg/CreatePermission",
+	return 0
+}
+
+func (m *MsgSetSlashDistribution) GetDistribution() []types.SlashShare {
+	if m != nil {
+		return m.Distribution
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreatePermission(ctx, req.(*MsgCreatePermission))
+	return nil
+}
+
+type MsgSetSlashDistributionResponse struct {
+}
+
+func (m *MsgSetSlashDistributionResponse) Reset()         { *m = MsgSetSlashDistributionResponse{} }
+func (m *MsgSetSlashDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSlashDistributionResponse) ProtoMessage()    {}
+func (*MsgSetSlashDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4739eb2b981c63a, []int{39}
+}
+func (m *MsgSetSlashDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSlashDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSlashDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetSlashDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSlashDistributionResponse.Merge(m, src)
+}
+func (m *MsgSetSlashDistributionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSlashDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSlashDistributionResponse.DiscardUnknown(m)
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "verana.perm.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "StartPermissionVP",
-			Handler:    _Msg_StartPermissionVP_Handler,
-		},
-		{
-			MethodName: "RenewPermissionVP",
-			Handler:    _Msg_RenewPermissionVP_Handler,
-		},
-		{
-			MethodName: "SetPermissionVPToValidated",
-			Handler:    _Msg_SetPermissionVPToValidated_Handler,
-		},
-		{
-			MethodName: "RequestPermissionVPTermination",
-			Handler:    _Msg_RequestPermissionVPTermination_Handler,
-		},
-		{
-			MethodName: "ConfirmPermissionVPTermination",
-			Handler:    _Msg_ConfirmPermissionVPTermination_Handler,
-		},
-		{
-			MethodName: "CancelPermissionVPLastRequest",
-			Handler:    _Msg_CancelPermissionVPLastRequest_Handler,
-		},
-		{
-			MethodName: "CreateRootPermission",
-			Handler:    _Msg_CreateRootPermission_Handler,
-		},
-		{
-			MethodName: "ExtendPermission",
-			Handler:    _Msg_ExtendPermission_Handler,
-		},
-		{
-			MethodName: "RevokePermission",
-			Handler:    _Msg_RevokePermission_Handler,
-		},
-		{
-			MethodName: "CreateOrUpdatePermissionSession",
-			Handler:    _Msg_CreateOrUpdatePermissionSession_Handler,
-		},
-		{
-			MethodName: "SlashPermissionTrustDeposit",
-			Handler:    _Msg_SlashPermissionTrustDeposit_Handler,
-		},
-		{
-			MethodName: "RepayPermissionSlashedTrustDeposit",
-			Handler:    _Msg_RepayPermissionSlashedTrustDeposit_Handler,
-		},
-		{
-			MethodName: "CreatePermission",
-			Handler:    _Msg_CreatePermission_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "verana/perm/v1/tx.proto",
-}
+var xxx_messageInfo_MsgSetSlashDistributionResponse proto.InternalMessageInfo
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// MsgSuspendPermission temporarily suspends a perm. A suspended perm is not
+// valid until it is unsuspended or its suspension expires.
+type MsgSuspendPermission struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Reason  string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// suspended_until, if set, is the time the suspension ends by itself
+	SuspendedUntil *time.Time `protobuf:"bytes,4,opt,name=suspended_until,json=suspendedUntil,proto3,stdtime" json:"suspended_until,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSuspendPermission) Reset()         { *m = MsgSuspendPermission{} }
+func (m *MsgSuspendPermission) String() string { return proto.CompactTextString(m) }
+func (*MsgSuspendPermission) ProtoMessage()    {}
+func (*MsgSuspendPermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4739eb2b981c63a, []int{40}
 }
-
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgSuspendPermission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuspendPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuspendPermission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSuspendPermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuspendPermission.Merge(m, src)
+}
+func (m *MsgSuspendPermission) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuspendPermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuspendPermission.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSuspendPermission proto.InternalMessageInfo
+
+func (m *MsgSuspendPermission) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSuspendPermission) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSuspendPermission) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (m *MsgStartPermissionVP) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSuspendPermission) GetSuspendedUntil() *time.Time {
+	if m != nil {
+		return m.SuspendedUntil
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgStartPermissionVP) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSuspendPermissionResponse struct {
 }
 
-func (m *MsgStartPermissionVP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Did) > 0 {
-		i -= len(m.Did)
-		copy(dAtA[i:], m.Did)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Country) > 0 {
-		i -= len(m.Country)
-		copy(dAtA[i:], m.Country)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Country)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.ValidatorPermId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ValidatorPermId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Type != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgSuspendPermissionResponse) Reset()         { *m = MsgSuspendPermissionResponse{} }
+func (m *MsgSuspendPermissionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSuspendPermissionResponse) ProtoMessage()    {}
+func (*MsgSuspendPermissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4739eb2b981c63a, []int{41}
+}
+func (m *MsgSuspendPermissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuspendPermissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuspendPermissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSuspendPermissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuspendPermissionResponse.Merge(m, src)
+}
+func (m *MsgSuspendPermissionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuspendPermissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuspendPermissionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgStartPermissionVPResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgSuspendPermissionResponse proto.InternalMessageInfo
+
+type MsgUnsuspendPermission struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgStartPermissionVPResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUnsuspendPermission) Reset()         { *m = MsgUnsuspendPermission{} }
+func (m *MsgUnsuspendPermission) String() string { return proto.CompactTextString(m) }
+func (*MsgUnsuspendPermission) ProtoMessage()    {}
+func (*MsgUnsuspendPermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4739eb2b981c63a, []int{42}
+}
+func (m *MsgUnsuspendPermission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnsuspendPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnsuspendPermission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnsuspendPermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnsuspendPermission.Merge(m, src)
+}
+func (m *MsgUnsuspendPermission) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnsuspendPermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnsuspendPermission.DiscardUnknown(m)
 }
 
-func (m *MsgStartPermissionVPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PermissionId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PermissionId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_MsgUnsuspendPermission proto.InternalMessageInfo
+
+func (m *MsgUnsuspendPermission) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgRenewPermissionVP) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUnsuspendPermission) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgRenewPermissionVP) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgUnsuspendPermissionResponse struct {
 }
 
-func (m *MsgRenewPermissionVP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
+func (m *MsgUnsuspendPermissionResponse) Reset()         { *m = MsgUnsuspendPermissionResponse{} }
+func (m *MsgUnsuspendPermissionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnsuspendPermissionResponse) ProtoMessage()    {}
+func (*MsgUnsuspendPermissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4739eb2b981c63a, []int{43}
+}
+func (m *MsgUnsuspendPermissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnsuspendPermissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnsuspendPermissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgUnsuspendPermissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnsuspendPermissionResponse.Merge(m, src)
+}
+func (m *MsgUnsuspendPermissionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnsuspendPermissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnsuspendPermissionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnsuspendPermissionResponse proto.InternalMessageInfo
+
+type MsgClosePermissionSession struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgClosePermissionSession) Reset()         { *m = MsgClosePermissionSession{} }
+func (m *MsgClosePermissionSession) String() string { return proto.CompactTextString(m) }
+func (*MsgClosePermissionSession) ProtoMessage()    {}
+func (*MsgClosePermissionSession) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4739eb2b981c63a, []int{44}
+}
+func (m *MsgClosePermissionSession) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClosePermissionSession) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClosePermissionSession.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgClosePermissionSession) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClosePermissionSession.Merge(m, src)
+}
+func (m *MsgClosePermissionSession) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClosePermissionSession) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClosePermissionSession.DiscardUnknown(m)
 }
 
-func (m *MsgRenewPermissionVPResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgClosePermissionSession proto.InternalMessageInfo
+
+func (m *MsgClosePermissionSession) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRenewPermissionVPResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgClosePermissionSession) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
 }
 
-func (m *MsgRenewPermissionVPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgClosePermissionSessionResponse struct {
 }
 
-func (m *MsgSetPermissionVPToValidated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgClosePermissionSessionResponse) Reset()         { *m = MsgClosePermissionSessionResponse{} }
+func (m *MsgClosePermissionSessionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClosePermissionSessionResponse) ProtoMessage()    {}
+func (*MsgClosePermissionSessionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4739eb2b981c63a, []int{45}
+}
+func (m *MsgClosePermissionSessionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClosePermissionSessionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClosePermissionSessionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgClosePermissionSessionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClosePermissionSessionResponse.Merge(m, src)
+}
+func (m *MsgClosePermissionSessionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClosePermissionSessionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClosePermissionSessionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSetPermissionVPToValidated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgClosePermissionSessionResponse proto.InternalMessageInfo
+
+// MsgPurchaseVerificationCredits buys count verification sessions of the
+// verifier perm, with the issuer perm if set. The session fees of all credits
+// are paid at once: the beneficiary fees are distributed at purchase and are
+// not refunded for unused credits, only the buyer trust deposit funded for
+// unused credits is released by MsgRefundVerificationCredits.
+type MsgPurchaseVerificationCredits struct {
+	Creator        string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	VerifierPermId uint64 `protobuf:"varint,2,opt,name=verifier_perm_id,json=verifierPermId,proto3" json:"verifier_perm_id,omitempty"`
+	IssuerPermId   uint64 `protobuf:"varint,3,opt,name=issuer_perm_id,json=issuerPermId,proto3" json:"issuer_perm_id,omitempty"`
+	Count          uint64 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (m *MsgSetPermissionVPToValidated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.VpSummaryDigestSri) > 0 {
-		i -= len(m.VpSummaryDigestSri)
-		copy(dAtA[i:], m.VpSummaryDigestSri)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VpSummaryDigestSri)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.Country) > 0 {
-		i -= len(m.Country)
-		copy(dAtA[i:], m.Country)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Country)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.VerificationFees != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.VerificationFees))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.IssuanceFees != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.IssuanceFees))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.ValidationFees != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ValidationFees))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.EffectiveUntil != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil):])
-		if err2 != nil {
-			return 0, err2
+func (m *MsgPurchaseVerificationCredits) Reset()         { *m = MsgPurchaseVerificationCredits{} }
+func (m *MsgPurchaseVerificationCredits) String() string { return proto.CompactTextString(m) }
+func (*MsgPurchaseVerificationCredits) ProtoMessage()    {}
+func (*MsgPurchaseVerificationCredits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4739eb2b981c63a, []int{46}
+}
+func (m *MsgPurchaseVerificationCredits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPurchaseVerificationCredits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPurchaseVerificationCredits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n2
-		i = encodeVarintTx(dAtA, i, uint64(n2))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgPurchaseVerificationCredits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPurchaseVerificationCredits.Merge(m, src)
+}
+func (m *MsgPurchaseVerificationCredits) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPurchaseVerificationCredits) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPurchaseVerificationCredits.DiscardUnknown(m)
 }
 
-func (m *MsgSetPermissionVPToValidatedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgPurchaseVerificationCredits proto.InternalMessageInfo
+
+func (m *MsgPurchaseVerificationCredits) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSetPermissionVPToValidatedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgPurchaseVerificationCredits) GetVerifierPermId() uint64 {
+	if m != nil {
+		return m.VerifierPermId
+	}
+	return 0
 }
 
-func (m *MsgSetPermissionVPToValidatedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgPurchaseVerificationCredits) GetIssuerPermId() uint64 {
+	if m != nil {
+		return m.IssuerPermId
+	}
+	return 0
 }
 
-func (m *MsgRequestPermissionVPTermination) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgPurchaseVerificationCredits) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgRequestPermissionVPTermination) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgPurchaseVerificationCreditsResponse struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// cost is the amount, in uvna, paid for the credits, fees plus
+	// trust_deposit
+	Cost uint64 `protobuf:"varint,2,opt,name=cost,proto3" json:"cost,omitempty"`
+	// fees is the amount, in uvna, distributed to the beneficiaries. It is not
+	// refunded for unused credits.
+	Fees uint64 `protobuf:"varint,3,opt,name=fees,proto3" json:"fees,omitempty"`
+	// trust_deposit is the amount, in uvna, added to the buyer trust deposit.
+	// The part funded for unused credits is released on refund.
+	TrustDeposit uint64 `protobuf:"varint,4,opt,name=trust_deposit,json=trustDeposit,proto3" json:"trust_deposit,omitempty"`
+}
+
+func (m *MsgPurchaseVerificationCreditsResponse) Reset() {
+	*m = MsgPurchaseVerificationCreditsResponse{}
+}
+func (m *MsgPurchaseVerificationCreditsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPurchaseVerificationCreditsResponse) ProtoMessage()    {}
+func (*MsgPurchaseVerificationCreditsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4739eb2b981c63a, []int{47}
+}
+func (m *MsgPurchaseVerificationCreditsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgRequestPermissionVPTermination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
+func (m *MsgPurchaseVerificationCreditsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPurchaseVerificationCreditsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgPurchaseVerificationCreditsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPurchaseVerificationCreditsResponse.Merge(m, src)
+}
+func (m *MsgPurchaseVerificationCreditsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPurchaseVerificationCreditsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPurchaseVerificationCreditsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPurchaseVerificationCreditsResponse proto.InternalMessageInfo
+
+func (m *MsgPurchaseVerificationCreditsResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgRequestPermissionVPTerminationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgPurchaseVerificationCreditsResponse) GetCost() uint64 {
+	if m != nil {
+		return m.Cost
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgRequestPermissionVPTerminationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgPurchaseVerificationCreditsResponse) GetFees() uint64 {
+	if m != nil {
+		return m.Fees
+	}
+	return 0
 }
 
-func (m *MsgRequestPermissionVPTerminationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgPurchaseVerificationCreditsResponse) GetTrustDeposit() uint64 {
+	if m != nil {
+		return m.TrustDeposit
+	}
+	return 0
 }
 
-func (m *MsgConfirmPermissionVPTermination) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// MsgRefundVerificationCredits closes a verification credit bundle. The fees
+// of the unused credits are not refunded: they were distributed to the
+// beneficiaries at purchase and stay with them. Only the buyer trust deposit
+// funded for the unused credits is released and becomes claimable.
+type MsgRefundVerificationCredits struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgConfirmPermissionVPTermination) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRefundVerificationCredits) Reset()         { *m = MsgRefundVerificationCredits{} }
+func (m *MsgRefundVerificationCredits) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundVerificationCredits) ProtoMessage()    {}
+func (*MsgRefundVerificationCredits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4739eb2b981c63a, []int{48}
 }
-
-func (m *MsgConfirmPermissionVPTermination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgRefundVerificationCredits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefundVerificationCredits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefundVerificationCredits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRefundVerificationCredits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundVerificationCredits.Merge(m, src)
+}
+func (m *MsgRefundVerificationCredits) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefundVerificationCredits) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundVerificationCredits.DiscardUnknown(m)
 }
 
-func (m *MsgConfirmPermissionVPTerminationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRefundVerificationCredits proto.InternalMessageInfo
+
+func (m *MsgRefundVerificationCredits) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgConfirmPermissionVPTerminationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRefundVerificationCredits) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *MsgConfirmPermissionVPTerminationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgRefundVerificationCreditsResponse struct {
+	RefundedCredits uint64 `protobuf:"varint,1,opt,name=refunded_credits,json=refundedCredits,proto3" json:"refunded_credits,omitempty"`
+	// released_trust_deposit is the amount, in uvna, of buyer trust deposit
+	// made claimable
+	ReleasedTrustDeposit uint64 `protobuf:"varint,2,opt,name=released_trust_deposit,json=releasedTrustDeposit,proto3" json:"released_trust_deposit,omitempty"`
 }
 
-func (m *MsgCancelPermissionVPLastRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRefundVerificationCreditsResponse) Reset()         { *m = MsgRefundVerificationCreditsResponse{} }
+func (m *MsgRefundVerificationCreditsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundVerificationCreditsResponse) ProtoMessage()    {}
+func (*MsgRefundVerificationCreditsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4739eb2b981c63a, []int{49}
+}
+func (m *MsgRefundVerificationCreditsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefundVerificationCreditsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefundVerificationCreditsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgCancelPermissionVPLastRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRefundVerificationCreditsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundVerificationCreditsResponse.Merge(m, src)
+}
+func (m *MsgRefundVerificationCreditsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefundVerificationCreditsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundVerificationCreditsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCancelPermissionVPLastRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgRefundVerificationCreditsResponse proto.InternalMessageInfo
+
+func (m *MsgRefundVerificationCreditsResponse) GetRefundedCredits() uint64 {
+	if m != nil {
+		return m.RefundedCredits
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgCancelPermissionVPLastRequestResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRefundVerificationCreditsResponse) GetReleasedTrustDeposit() uint64 {
+	if m != nil {
+		return m.ReleasedTrustDeposit
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCancelPermissionVPLastRequestResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SignedSessionVoucher is a session voucher with the signature of its signer.
+type SignedSessionVoucher struct {
+	Voucher SessionVoucher `protobuf:"bytes,1,opt,name=voucher,proto3" json:"voucher"`
+	// pub_key is the compressed secp256k1 public key of the signer
+	PubKey []byte `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// signature is the signature of the SessionVoucherSignDoc of the voucher
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (m *MsgCancelPermissionVPLastRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *SignedSessionVoucher) Reset()         { *m = SignedSessionVoucher{} }
+func (m *SignedSessionVoucher) String() string { return proto.CompactTextString(m) }
+func (*SignedSessionVoucher) ProtoMessage()    {}
+func (*SignedSessionVoucher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4739eb2b981c63a, []int{50}
 }
-
-func (m *MsgCreateRootPermission) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SignedSessionVoucher) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignedSessionVoucher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignedSessionVoucher.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgCreateRootPermission) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SignedSessionVoucher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedSessionVoucher.Merge(m, src)
+}
+func (m *SignedSessionVoucher) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignedSessionVoucher) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedSessionVoucher.DiscardUnknown(m)
 }
 
-func (m *MsgCreateRootPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VerificationFees != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.VerificationFees))
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.IssuanceFees != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.IssuanceFees))
-		i--
-		dAtA[i] = 0x40
+var xxx_messageInfo_SignedSessionVoucher proto.InternalMessageInfo
+
+func (m *SignedSessionVoucher) GetVoucher() SessionVoucher {
+	if m != nil {
+		return m.Voucher
 	}
-	if m.ValidationFees != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ValidationFees))
-		i--
-		dAtA[i] = 0x38
+	return SessionVoucher{}
+}
+
+func (m *SignedSessionVoucher) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
 	}
-	if m.EffectiveUntil != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil):])
-		if err3 != nil {
-			return 0, err3
-		}
-		i -= n3
-		i = encodeVarintTx(dAtA, i, uint64(n3))
-		i--
-		dAtA[i] = 0x32
+	return nil
+}
+
+func (m *SignedSessionVoucher) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
 	}
-	if m.EffectiveFrom != nil {
-		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveFrom, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveFrom):])
-		if err4 != nil {
-			return 0, err4
+	return nil
+}
+
+// MsgSettleSessionVouchers settles a batch of signed session vouchers. The
+// session fees are distributed per signer and perms in aggregate.
+type MsgSettleSessionVouchers struct {
+	Creator  string                 `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Vouchers []SignedSessionVoucher `protobuf:"bytes,2,rep,name=vouchers,proto3" json:"vouchers"`
+}
+
+func (m *MsgSettleSessionVouchers) Reset()         { *m = MsgSettleSessionVouchers{} }
+func (m *MsgSettleSessionVouchers) String() string { return proto.CompactTextString(m) }
+func (*MsgSettleSessionVouchers) ProtoMessage()    {}
+func (*MsgSettleSessionVouchers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4739eb2b981c63a, []int{51}
+}
+func (m *MsgSettleSessionVouchers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSettleSessionVouchers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSettleSessionVouchers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n4
-		i = encodeVarintTx(dAtA, i, uint64(n4))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Country) > 0 {
-		i -= len(m.Country)
-		copy(dAtA[i:], m.Country)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Country)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Did) > 0 {
-		i -= len(m.Did)
-		copy(dAtA[i:], m.Did)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.SchemaId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SchemaId))
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgSettleSessionVouchers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSettleSessionVouchers.Merge(m, src)
+}
+func (m *MsgSettleSessionVouchers) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSettleSessionVouchers) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSettleSessionVouchers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSettleSessionVouchers proto.InternalMessageInfo
+
+func (m *MsgSettleSessionVouchers) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCreateRootPermissionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSettleSessionVouchers) GetVouchers() []SignedSessionVoucher {
+	if m != nil {
+		return m.Vouchers
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgCreateRootPermissionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSettleSessionVouchersResponse struct {
+	Settled uint64 `protobuf:"varint,1,opt,name=settled,proto3" json:"settled,omitempty"`
+	// total is the amount, in uvna, paid by the signers of the vouchers
+	Total uint64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 }
 
-func (m *MsgCreateRootPermissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgSettleSessionVouchersResponse) Reset()         { *m = MsgSettleSessionVouchersResponse{} }
+func (m *MsgSettleSessionVouchersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSettleSessionVouchersResponse) ProtoMessage()    {}
+func (*MsgSettleSessionVouchersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4739eb2b981c63a, []int{52}
+}
+func (m *MsgSettleSessionVouchersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSettleSessionVouchersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSettleSessionVouchersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSettleSessionVouchersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSettleSessionVouchersResponse.Merge(m, src)
+}
+func (m *MsgSettleSessionVouchersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSettleSessionVouchersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSettleSessionVouchersResponse.DiscardUnknown(m)
 }
 
-func (m *MsgExtendPermission) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSettleSessionVouchersResponse proto.InternalMessageInfo
+
+func (m *MsgSettleSessionVouchersResponse) GetSettled() uint64 {
+	if m != nil {
+		return m.Settled
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgExtendPermission) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSettleSessionVouchersResponse) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
 }
 
-func (m *MsgExtendPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EffectiveUntil != nil {
-		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil):])
-		if err5 != nil {
-			return 0, err5
+// MsgAnchorVPMessage anchors a message of the validation process of a perm.
+// The message is exchanged off-chain and encrypted, only its digest and
+// location are recorded.
+type MsgAnchorVPMessage struct {
+	// creator must be the applicant or the validator grantee
+	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	PermId       uint64 `protobuf:"varint,2,opt,name=perm_id,json=permId,proto3" json:"perm_id,omitempty"`
+	DigestSri    string `protobuf:"bytes,3,opt,name=digest_sri,json=digestSri,proto3" json:"digest_sri,omitempty"`
+	EncryptedUri string `protobuf:"bytes,4,opt,name=encrypted_uri,json=encryptedUri,proto3" json:"encrypted_uri,omitempty"`
+}
+
+func (m *MsgAnchorVPMessage) Reset()         { *m = MsgAnchorVPMessage{} }
+func (m *MsgAnchorVPMessage) String() string { return proto.CompactTextString(m) }
+func (*MsgAnchorVPMessage) ProtoMessage()    {}
+func (*MsgAnchorVPMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4739eb2b981c63a, []int{53}
+}
+func (m *MsgAnchorVPMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAnchorVPMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAnchorVPMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n5
-		i = encodeVarintTx(dAtA, i, uint64(n5))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgAnchorVPMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAnchorVPMessage.Merge(m, src)
+}
+func (m *MsgAnchorVPMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAnchorVPMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAnchorVPMessage.DiscardUnknown(m)
 }
 
-func (m *MsgExtendPermissionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAnchorVPMessage proto.InternalMessageInfo
+
+func (m *MsgAnchorVPMessage) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgExtendPermissionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgAnchorVPMessage) GetPermId() uint64 {
+	if m != nil {
+		return m.PermId
+	}
+	return 0
 }
 
-func (m *MsgExtendPermissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgAnchorVPMessage) GetDigestSri() string {
+	if m != nil {
+		return m.DigestSri
+	}
+	return ""
 }
 
-func (m *MsgRevokePermission) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAnchorVPMessage) GetEncryptedUri() string {
+	if m != nil {
+		return m.EncryptedUri
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRevokePermission) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgAnchorVPMessageResponse struct {
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
 }
 
-func (m *MsgRevokePermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgAnchorVPMessageResponse) Reset()         { *m = MsgAnchorVPMessageResponse{} }
+func (m *MsgAnchorVPMessageResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAnchorVPMessageResponse) ProtoMessage()    {}
+func (*MsgAnchorVPMessageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4739eb2b981c63a, []int{54}
+}
+func (m *MsgAnchorVPMessageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAnchorVPMessageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAnchorVPMessageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgAnchorVPMessageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAnchorVPMessageResponse.Merge(m, src)
+}
+func (m *MsgAnchorVPMessageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAnchorVPMessageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAnchorVPMessageResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRevokePermissionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAnchorVPMessageResponse proto.InternalMessageInfo
+
+func (m *MsgAnchorVPMessageResponse) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgRevokePermissionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdatePermissionFees schedules new fees for a perm. The fees take effect
+// after the fee update notice period, a pending update is replaced.
+type MsgUpdatePermissionFees struct {
+	// creator must be the grantee of the perm
+	Creator          string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id               uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	ValidationFees   uint64 `protobuf:"varint,3,opt,name=validation_fees,json=validationFees,proto3" json:"validation_fees,omitempty"`
+	IssuanceFees     uint64 `protobuf:"varint,4,opt,name=issuance_fees,json=issuanceFees,proto3" json:"issuance_fees,omitempty"`
+	VerificationFees uint64 `protobuf:"varint,5,opt,name=verification_fees,json=verificationFees,proto3" json:"verification_fees,omitempty"`
 }
 
-func (m *MsgRevokePermissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdatePermissionFees) Reset()         { *m = MsgUpdatePermissionFees{} }
+func (m *MsgUpdatePermissionFees) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePermissionFees) ProtoMessage()    {}
+func (*MsgUpdatePermissionFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4739eb2b981c63a, []int{55}
 }
-
-func (m *MsgCreateOrUpdatePermissionSession) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdatePermissionFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePermissionFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePermissionFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgCreateOrUpdatePermissionSession) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdatePermissionFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePermissionFees.Merge(m, src)
+}
+func (m *MsgUpdatePermissionFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePermissionFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePermissionFees.DiscardUnknown(m)
 }
 
-func (m *MsgCreateOrUpdatePermissionSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.WalletAgentPermId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.WalletAgentPermId))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.AgentPermId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AgentPermId))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.VerifierPermId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.VerifierPermId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.IssuerPermId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.IssuerPermId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgUpdatePermissionFees proto.InternalMessageInfo
+
+func (m *MsgUpdatePermissionFees) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCreateOrUpdatePermissionSessionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdatePermissionFees) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCreateOrUpdatePermissionSessionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdatePermissionFees) GetValidationFees() uint64 {
+	if m != nil {
+		return m.ValidationFees
+	}
+	return 0
 }
 
-func (m *MsgCreateOrUpdatePermissionSessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgUpdatePermissionFees) GetIssuanceFees() uint64 {
+	if m != nil {
+		return m.IssuanceFees
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgSlashPermissionTrustDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdatePermissionFees) GetVerificationFees() uint64 {
+	if m != nil {
+		return m.VerificationFees
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgSlashPermissionTrustDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgUpdatePermissionFeesResponse struct {
+	// effective_from is the time the new fees apply from
+	EffectiveFrom *time.Time `protobuf:"bytes,1,opt,name=effective_from,json=effectiveFrom,proto3,stdtime" json:"effective_from,omitempty"`
 }
 
-func (m *MsgSlashPermissionTrustDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgUpdatePermissionFeesResponse) Reset()         { *m = MsgUpdatePermissionFeesResponse{} }
+func (m *MsgUpdatePermissionFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePermissionFeesResponse) ProtoMessage()    {}
+func (*MsgUpdatePermissionFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4739eb2b981c63a, []int{56}
+}
+func (m *MsgUpdatePermissionFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePermissionFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePermissionFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUpdatePermissionFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePermissionFeesResponse.Merge(m, src)
+}
+func (m *MsgUpdatePermissionFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePermissionFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePermissionFeesResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSlashPermissionTrustDepositResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdatePermissionFeesResponse proto.InternalMessageInfo
+
+func (m *MsgUpdatePermissionFeesResponse) GetEffectiveFrom() *time.Time {
+	if m != nil {
+		return m.EffectiveFrom
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgSlashPermissionTrustDepositResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "verana.perm.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "verana.perm.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgStartPermissionVP)(nil), "verana.perm.v1.MsgStartPermissionVP")
+	proto.RegisterType((*MsgStartPermissionVPResponse)(nil), "verana.perm.v1.MsgStartPermissionVPResponse")
+	proto.RegisterType((*MsgRenewPermissionVP)(nil), "verana.perm.v1.MsgRenewPermissionVP")
+	proto.RegisterType((*MsgRenewPermissionVPResponse)(nil), "verana.perm.v1.MsgRenewPermissionVPResponse")
+	proto.RegisterType((*MsgSetPermissionVPToValidated)(nil), "verana.perm.v1.MsgSetPermissionVPToValidated")
+	proto.RegisterType((*MsgSetPermissionVPToValidatedResponse)(nil), "verana.perm.v1.MsgSetPermissionVPToValidatedResponse")
+	proto.RegisterType((*MsgRequestPermissionVPTermination)(nil), "verana.perm.v1.MsgRequestPermissionVPTermination")
+	proto.RegisterType((*MsgRequestPermissionVPTerminationResponse)(nil), "verana.perm.v1.MsgRequestPermissionVPTerminationResponse")
+	proto.RegisterType((*MsgConfirmPermissionVPTermination)(nil), "verana.perm.v1.MsgConfirmPermissionVPTermination")
+	proto.RegisterType((*MsgConfirmPermissionVPTerminationResponse)(nil), "verana.perm.v1.MsgConfirmPermissionVPTerminationResponse")
+	proto.RegisterType((*MsgCancelPermissionVPLastRequest)(nil), "verana.perm.v1.MsgCancelPermissionVPLastRequest")
+	proto.RegisterType((*MsgCancelPermissionVPLastRequestResponse)(nil), "verana.perm.v1.MsgCancelPermissionVPLastRequestResponse")
+	proto.RegisterType((*MsgCreateRootPermission)(nil), "verana.perm.v1.MsgCreateRootPermission")
+	proto.RegisterType((*MsgCreateRootPermissionResponse)(nil), "verana.perm.v1.MsgCreateRootPermissionResponse")
+	proto.RegisterType((*MsgExtendPermission)(nil), "verana.perm.v1.MsgExtendPermission")
+	proto.RegisterType((*MsgExtendPermissionResponse)(nil), "verana.perm.v1.MsgExtendPermissionResponse")
+	proto.RegisterType((*MsgRevokePermission)(nil), "verana.perm.v1.MsgRevokePermission")
+	proto.RegisterType((*MsgRevokePermissionResponse)(nil), "verana.perm.v1.MsgRevokePermissionResponse")
+	proto.RegisterType((*MsgCreateOrUpdatePermissionSession)(nil), "verana.perm.v1.MsgCreateOrUpdatePermissionSession")
+	proto.RegisterType((*MsgCreateOrUpdatePermissionSessionResponse)(nil), "verana.perm.v1.MsgCreateOrUpdatePermissionSessionResponse")
+	proto.RegisterType((*MsgSlashPermissionTrustDeposit)(nil), "verana.perm.v1.MsgSlashPermissionTrustDeposit")
+	proto.RegisterType((*MsgSlashPermissionTrustDepositResponse)(nil), "verana.perm.v1.MsgSlashPermissionTrustDepositResponse")
+	proto.RegisterType((*MsgRepayPermissionSlashedTrustDeposit)(nil), "verana.perm.v1.MsgRepayPermissionSlashedTrustDeposit")
+	proto.RegisterType((*MsgRepayPermissionSlashedTrustDepositResponse)(nil), "verana.perm.v1.MsgRepayPermissionSlashedTrustDepositResponse")
+	proto.RegisterType((*MsgCreatePermission)(nil), "verana.perm.v1.MsgCreatePermission")
+	proto.RegisterType((*MsgCreatePermissionResponse)(nil), "verana.perm.v1.MsgCreatePermissionResponse")
+	proto.RegisterType((*MsgCreateSessionAllowance)(nil), "verana.perm.v1.MsgCreateSessionAllowance")
+	proto.RegisterType((*MsgCreateSessionAllowanceResponse)(nil), "verana.perm.v1.MsgCreateSessionAllowanceResponse")
+	proto.RegisterType((*MsgFundSessionAllowance)(nil), "verana.perm.v1.MsgFundSessionAllowance")
+	proto.RegisterType((*MsgFundSessionAllowanceResponse)(nil), "verana.perm.v1.MsgFundSessionAllowanceResponse")
+	proto.RegisterType((*MsgWithdrawSessionAllowance)(nil), "verana.perm.v1.MsgWithdrawSessionAllowance")
+	proto.RegisterType((*MsgWithdrawSessionAllowanceResponse)(nil), "verana.perm.v1.MsgWithdrawSessionAllowanceResponse")
+	proto.RegisterType((*MsgAppealSlash)(nil), "verana.perm.v1.MsgAppealSlash")
+	proto.RegisterType((*MsgAppealSlashResponse)(nil), "verana.perm.v1.MsgAppealSlashResponse")
+	proto.RegisterType((*MsgResolveSlashAppeal)(nil), "verana.perm.v1.MsgResolveSlashAppeal")
+	proto.RegisterType((*MsgResolveSlashAppealResponse)(nil), "verana.perm.v1.MsgResolveSlashAppealResponse")
+	proto.RegisterType((*MsgSetSlashDistribution)(nil), "verana.perm.v1.MsgSetSlashDistribution")
+	proto.RegisterType((*MsgSetSlashDistributionResponse)(nil), "verana.perm.v1.MsgSetSlashDistributionResponse")
+	proto.RegisterType((*MsgSuspendPermission)(nil), "verana.perm.v1.MsgSuspendPermission")
+	proto.RegisterType((*MsgSuspendPermissionResponse)(nil), "verana.perm.v1.MsgSuspendPermissionResponse")
+	proto.RegisterType((*MsgUnsuspendPermission)(nil), "verana.perm.v1.MsgUnsuspendPermission")
+	proto.RegisterType((*MsgUnsuspendPermissionResponse)(nil), "verana.perm.v1.MsgUnsuspendPermissionResponse")
+	proto.RegisterType((*MsgClosePermissionSession)(nil), "verana.perm.v1.MsgClosePermissionSession")
+	proto.RegisterType((*MsgClosePermissionSessionResponse)(nil), "verana.perm.v1.MsgClosePermissionSessionResponse")
+	proto.RegisterType((*MsgPurchaseVerificationCredits)(nil), "verana.perm.v1.MsgPurchaseVerificationCredits")
+	proto.RegisterType((*MsgPurchaseVerificationCreditsResponse)(nil), "verana.perm.v1.MsgPurchaseVerificationCreditsResponse")
+	proto.RegisterType((*MsgRefundVerificationCredits)(nil), "verana.perm.v1.MsgRefundVerificationCredits")
+	proto.RegisterType((*MsgRefundVerificationCreditsResponse)(nil), "verana.perm.v1.MsgRefundVerificationCreditsResponse")
+	proto.RegisterType((*SignedSessionVoucher)(nil), "verana.perm.v1.SignedSessionVoucher")
+	proto.RegisterType((*MsgSettleSessionVouchers)(nil), "verana.perm.v1.MsgSettleSessionVouchers")
+	proto.RegisterType((*MsgSettleSessionVouchersResponse)(nil), "verana.perm.v1.MsgSettleSessionVouchersResponse")
+	proto.RegisterType((*MsgAnchorVPMessage)(nil), "verana.perm.v1.MsgAnchorVPMessage")
+	proto.RegisterType((*MsgAnchorVPMessageResponse)(nil), "verana.perm.v1.MsgAnchorVPMessageResponse")
+	proto.RegisterType((*MsgUpdatePermissionFees)(nil), "verana.perm.v1.MsgUpdatePermissionFees")
+	proto.RegisterType((*MsgUpdatePermissionFeesResponse)(nil), "verana.perm.v1.MsgUpdatePermissionFeesResponse")
 }
 
-func (m *MsgSlashPermissionTrustDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("verana/perm/v1/tx.proto", fileDescriptor_f4739eb2b981c63a) }
+
+var fileDescriptor_f4739eb2b981c63a = []byte{
+	// 2556 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0xcd, 0x6f, 0xdb, 0xc8,
+	0x15, 0x0f, 0x2d, 0xf9, 0xeb, 0xf9, 0x2b, 0x66, 0x1c, 0x47, 0xa1, 0xd7, 0xb2, 0x23, 0x67, 0x13,
+	0xc7, 0x89, 0xa5, 0xb5, 0xb3, 0x09, 0x9a, 0xb4, 0x5b, 0x20, 0x71, 0x9a, 0xc2, 0xd8, 0x1a, 0x35,
+	0xe8, 0xd8, 0x2d, 0x7a, 0x11, 0x68, 0x71, 0x2c, 0x31, 0x91, 0x48, 0x96, 0x43, 0x29, 0x36, 0x50,
+	0xa0, 0xed, 0x16, 0x8b, 0x05, 0xb6, 0x1f, 0xd8, 0xf6, 0xd6, 0x1e, 0x0a, 0x14, 0xe8, 0xa1, 0xc7,
+	0x00, 0x5d, 0xf4, 0x54, 0xa0, 0xd7, 0x5c, 0x8a, 0x2e, 0x7a, 0xea, 0x69, 0xdb, 0x26, 0x40, 0xf3,
+	0x6f, 0x14, 0xf3, 0xc1, 0x91, 0x48, 0x0e, 0x25, 0xca, 0x91, 0xdb, 0x8b, 0x21, 0xce, 0xfc, 0x1e,
+	0xdf, 0x6f, 0xde, 0x7b, 0xf3, 0xde, 0x9b, 0xa1, 0xe1, 0x52, 0x0b, 0x79, 0x86, 0x6d, 0x94, 0x5c,
+	0xe4, 0x35, 0x4a, 0xad, 0x8d, 0x92, 0x7f, 0x5c, 0x74, 0x3d, 0xc7, 0x77, 0xd4, 0x69, 0x36, 0x51,
+	0x24, 0x13, 0xc5, 0xd6, 0x86, 0x36, 0x6b, 0x34, 0x2c, 0xdb, 0x29, 0xd1, 0xbf, 0x0c, 0xa2, 0x5d,
+	0xaa, 0x38, 0xb8, 0xe1, 0xe0, 0x52, 0x03, 0x57, 0x89, 0x68, 0x03, 0x57, 0xf9, 0xc4, 0x65, 0x36,
+	0x51, 0xa6, 0x4f, 0x25, 0xf6, 0xc0, 0xa7, 0xe6, 0xaa, 0x4e, 0xd5, 0x61, 0xe3, 0xe4, 0x17, 0x1f,
+	0x5d, 0x88, 0xb0, 0x70, 0x0d, 0xcf, 0x68, 0x04, 0x22, 0x5a, 0x94, 0xe2, 0x89, 0x8b, 0x82, 0xb9,
+	0xa5, 0xaa, 0xe3, 0x54, 0xeb, 0xa8, 0x44, 0x9f, 0x0e, 0x9b, 0x47, 0x25, 0xdf, 0x6a, 0x20, 0xec,
+	0x1b, 0x0d, 0x97, 0x03, 0x72, 0x5c, 0xd8, 0x37, 0x23, 0xa2, 0x85, 0x3f, 0x2b, 0x30, 0xb3, 0x83,
+	0xab, 0xfb, 0xae, 0x69, 0xf8, 0x68, 0x97, 0x2a, 0x54, 0xef, 0xc2, 0xb8, 0xd1, 0xf4, 0x6b, 0x8e,
+	0x67, 0xf9, 0x27, 0x39, 0x65, 0x59, 0x59, 0x1d, 0x7f, 0x98, 0xfb, 0xfb, 0xe7, 0xeb, 0x73, 0x7c,
+	0x09, 0x0f, 0x4c, 0xd3, 0x43, 0x18, 0xef, 0xf9, 0x9e, 0x65, 0x57, 0xf5, 0x36, 0x54, 0xbd, 0x07,
+	0x23, 0x8c, 0x72, 0x6e, 0x68, 0x59, 0x59, 0x9d, 0xd8, 0x9c, 0x2f, 0x86, 0xad, 0x57, 0x64, 0xef,
+	0x7f, 0x38, 0xfe, 0xf2, 0xcb, 0xa5, 0x73, 0x7f, 0x78, 0xf3, 0x62, 0x4d, 0xd1, 0xb9, 0xc0, 0xfd,
+	0xbb, 0x1f, 0xbd, 0x79, 0xb1, 0xd6, 0x7e, 0xd5, 0xa7, 0x6f, 0x5e, 0xac, 0xad, 0x70, 0xce, 0xc7,
+	0x74, 0xc9, 0x16, 0xc6, 0x96, 0x63, 0x97, 0x22, 0x54, 0x0b, 0x97, 0xe1, 0x52, 0x64, 0x48, 0x47,
+	0xd8, 0x75, 0x6c, 0x8c, 0x0a, 0x3f, 0x1e, 0x82, 0xb9, 0x1d, 0x5c, 0xdd, 0xf3, 0x0d, 0xcf, 0xdf,
+	0x15, 0x6f, 0x38, 0xd8, 0x55, 0x37, 0x61, 0xb4, 0xe2, 0x21, 0xc3, 0x77, 0xbc, 0x9e, 0x8b, 0x0b,
+	0x80, 0xaa, 0x0a, 0x59, 0x62, 0x35, 0xba, 0xb0, 0x29, 0x9d, 0xfe, 0x56, 0xd7, 0x60, 0xb6, 0x65,
+	0xd4, 0x2d, 0x93, 0x00, 0xca, 0x84, 0x63, 0xd9, 0x32, 0x73, 0x99, 0x65, 0x65, 0x35, 0xab, 0xcf,
+	0x88, 0x09, 0xa2, 0x79, 0xdb, 0x54, 0xdf, 0x81, 0xf1, 0x8a, 0xd3, 0xb4, 0x7d, 0xcf, 0x42, 0x38,
+	0x97, 0x5d, 0xce, 0xac, 0x8e, 0xeb, 0xed, 0x01, 0x75, 0x1e, 0x32, 0xa6, 0x65, 0xe6, 0x86, 0x29,
+	0x9b, 0xec, 0xcb, 0x2f, 0x97, 0x14, 0x9d, 0x0c, 0xa8, 0x1b, 0x70, 0xb1, 0xe2, 0x94, 0x63, 0x4a,
+	0x70, 0x6e, 0x64, 0x39, 0xb3, 0x9a, 0xd5, 0xd5, 0x8a, 0x73, 0x10, 0xd6, 0x83, 0xef, 0x4f, 0x12,
+	0x43, 0x06, 0xb4, 0x0b, 0x5b, 0xf0, 0x8e, 0xcc, 0x04, 0x81, 0x8d, 0xd4, 0x15, 0x98, 0x6a, 0x1b,
+	0x97, 0xd0, 0x57, 0x28, 0xfd, 0xc9, 0xf6, 0xe0, 0xb6, 0x59, 0xa8, 0x51, 0x3b, 0xea, 0xc8, 0x46,
+	0xcf, 0xdf, 0xda, 0x8e, 0xd3, 0x30, 0x64, 0x99, 0xd4, 0x8a, 0x59, 0x7d, 0xc8, 0x32, 0x23, 0x74,
+	0xf3, 0xf0, 0x8e, 0x4c, 0x93, 0x70, 0xe9, 0xcf, 0x32, 0xb0, 0x48, 0xd6, 0x83, 0x42, 0xab, 0x79,
+	0x12, 0x58, 0x01, 0x99, 0x83, 0xe0, 0xa4, 0xee, 0xc0, 0x0c, 0x3a, 0x3a, 0x42, 0x15, 0xdf, 0x6a,
+	0xa1, 0x72, 0xd3, 0xf6, 0xad, 0x3a, 0xf5, 0xea, 0xc4, 0xa6, 0x56, 0x64, 0xfb, 0xac, 0x18, 0xec,
+	0xb3, 0xe2, 0x93, 0x60, 0x9f, 0x3d, 0x1c, 0x23, 0x5e, 0xfb, 0xec, 0x9f, 0x4b, 0x8a, 0x3e, 0x2d,
+	0x84, 0xf7, 0x89, 0xac, 0x7a, 0x1d, 0x82, 0x68, 0x20, 0x36, 0x3e, 0x42, 0x34, 0x00, 0x88, 0xae,
+	0xe9, 0xf6, 0xf0, 0x63, 0x84, 0x30, 0x71, 0x86, 0x85, 0x71, 0xd3, 0xb0, 0x2b, 0x88, 0xc1, 0x86,
+	0x99, 0x33, 0x82, 0x41, 0x0a, 0xba, 0x09, 0xb3, 0x2d, 0xe4, 0x59, 0x47, 0x56, 0xa5, 0xe3, 0x7d,
+	0x23, 0x14, 0x78, 0xbe, 0x73, 0x82, 0x82, 0x43, 0x51, 0x37, 0x1a, 0x8d, 0xba, 0x0d, 0xb8, 0xd8,
+	0x72, 0xcb, 0xb8, 0xd9, 0x68, 0x18, 0xde, 0x49, 0xd9, 0xb4, 0xaa, 0x08, 0xfb, 0x65, 0xec, 0x59,
+	0xb9, 0x31, 0x62, 0x39, 0x5d, 0x6d, 0xb9, 0x7b, 0x6c, 0xee, 0x11, 0x9d, 0xda, 0xf3, 0xac, 0x88,
+	0xbb, 0xae, 0xc3, 0xbb, 0x5d, 0xbd, 0x21, 0xfc, 0xd6, 0x84, 0x2b, 0xd4, 0xaf, 0xdf, 0x6f, 0x22,
+	0x1c, 0x06, 0x93, 0xdf, 0x36, 0x25, 0x7c, 0x06, 0xe1, 0x74, 0x13, 0x6e, 0xf4, 0x54, 0x1b, 0xe1,
+	0xb8, 0xe5, 0xd8, 0x47, 0x96, 0xd7, 0xf8, 0x5f, 0x73, 0xec, 0xae, 0x56, 0x70, 0xf4, 0x61, 0x99,
+	0x80, 0x49, 0x30, 0xd4, 0x3b, 0xb1, 0xdf, 0x32, 0xb0, 0xcf, 0x97, 0x79, 0x06, 0x14, 0xd7, 0x60,
+	0xb5, 0x97, 0x56, 0xc1, 0xf0, 0x2f, 0x19, 0x9a, 0x90, 0xb7, 0x88, 0x28, 0xd2, 0x1d, 0xa7, 0xc3,
+	0xec, 0xa7, 0x62, 0xb6, 0x00, 0xe3, 0xb8, 0x52, 0x43, 0x0d, 0xa3, 0x2c, 0x08, 0x8e, 0xb1, 0x81,
+	0x6d, 0x53, 0x3d, 0xcf, 0xd2, 0x66, 0x86, 0x86, 0x2b, 0xf9, 0xd9, 0x23, 0xcd, 0x7e, 0x08, 0xed,
+	0xbd, 0x59, 0x3e, 0xf2, 0x9c, 0x46, 0x6e, 0xb8, 0x8f, 0x7d, 0x3d, 0x25, 0x64, 0x1f, 0x7b, 0x4e,
+	0x43, 0x96, 0x25, 0x46, 0x06, 0x9b, 0x25, 0x46, 0xd3, 0x65, 0x89, 0xb1, 0xb4, 0x59, 0x62, 0x5c,
+	0x9e, 0x25, 0x22, 0xde, 0xde, 0x80, 0xa5, 0x04, 0x07, 0x8a, 0xaa, 0xc1, 0xc2, 0x45, 0x09, 0xc2,
+	0xa5, 0xf0, 0x27, 0x05, 0x2e, 0xec, 0xe0, 0xea, 0x37, 0x8e, 0x7d, 0x64, 0x9b, 0x6f, 0xe9, 0xf0,
+	0xb3, 0x4d, 0xc6, 0x91, 0xb5, 0x2e, 0xc2, 0x82, 0x84, 0xb7, 0x08, 0xe6, 0xdf, 0xb2, 0x75, 0xe9,
+	0xa8, 0xe5, 0x3c, 0x43, 0x03, 0x5e, 0xd7, 0x1d, 0x18, 0xad, 0x18, 0xb8, 0x62, 0x98, 0x88, 0xae,
+	0x67, 0x7a, 0x73, 0x21, 0xda, 0x2c, 0x6d, 0xb1, 0xe9, 0x1d, 0xc7, 0x44, 0x7a, 0x80, 0x8d, 0xf0,
+	0x3f, 0x84, 0x05, 0x09, 0x3f, 0xe1, 0x27, 0x0d, 0xc6, 0xb8, 0x5c, 0xe0, 0x2d, 0xf1, 0x4c, 0xe2,
+	0x8d, 0xff, 0x2e, 0xbb, 0xc8, 0x36, 0x2d, 0xbb, 0x4a, 0xc9, 0x8d, 0xe9, 0xd3, 0x7c, 0x78, 0x97,
+	0x8d, 0x16, 0x7e, 0x97, 0x81, 0x82, 0x08, 0x88, 0x6f, 0x7b, 0xbc, 0xd5, 0x12, 0xca, 0xf6, 0xd0,
+	0x20, 0x6c, 0x32, 0x4e, 0x6d, 0x72, 0x15, 0xa6, 0x49, 0x14, 0xa3, 0x68, 0x37, 0x35, 0xc9, 0x46,
+	0x79, 0x2b, 0xb5, 0x0a, 0x3c, 0x84, 0x3b, 0x70, 0x41, 0x41, 0xe5, 0xe3, 0x1c, 0x59, 0x80, 0x29,
+	0xa3, 0x8a, 0x6c, 0x5f, 0xc0, 0x58, 0x41, 0x9d, 0xa0, 0x83, 0x1c, 0x73, 0x07, 0xe6, 0x9e, 0x1b,
+	0xf5, 0x3a, 0xf2, 0xcb, 0x61, 0x28, 0x2d, 0xa9, 0xbc, 0x17, 0x9b, 0x65, 0x88, 0x07, 0x1d, 0x62,
+	0x57, 0x60, 0xd2, 0xa8, 0xd7, 0x9d, 0xe7, 0x74, 0x1b, 0x5a, 0x26, 0xdf, 0xab, 0x13, 0x62, 0x6c,
+	0xdb, 0x54, 0xbf, 0x0e, 0xa3, 0xe8, 0xd8, 0xb5, 0x3c, 0xbe, 0x45, 0xd3, 0x46, 0x6c, 0x20, 0x44,
+	0x52, 0x5f, 0xc5, 0x43, 0xa6, 0xe5, 0x93, 0xf7, 0x8f, 0x73, 0xf7, 0xd1, 0x81, 0xed, 0x68, 0x86,
+	0xfe, 0x1a, 0xac, 0xf5, 0x76, 0x91, 0x64, 0xfb, 0x52, 0xb3, 0x17, 0xfe, 0x3d, 0x04, 0x79, 0x52,
+	0xc7, 0xeb, 0x06, 0xae, 0xb5, 0xa5, 0x9e, 0x78, 0x4d, 0xec, 0x3f, 0x42, 0xae, 0x83, 0xad, 0x81,
+	0x14, 0x15, 0x75, 0x1e, 0x46, 0x8c, 0x06, 0xc9, 0xc5, 0xdc, 0xab, 0xfc, 0x49, 0x5d, 0x82, 0x09,
+	0x0f, 0x19, 0xd8, 0xb1, 0xcb, 0x15, 0xc7, 0x44, 0xd4, 0x95, 0x53, 0x3a, 0xb0, 0xa1, 0x2d, 0xc7,
+	0x44, 0xc4, 0xd6, 0xa8, 0x65, 0x99, 0x88, 0x98, 0xba, 0xe9, 0xd5, 0x59, 0x9b, 0xac, 0x4f, 0x04,
+	0x63, 0xfb, 0x5e, 0x5d, 0x2d, 0xc2, 0x05, 0x01, 0xe9, 0x68, 0x64, 0x46, 0x28, 0x72, 0x36, 0x98,
+	0x12, 0x7d, 0x8c, 0xba, 0x08, 0x50, 0x3d, 0x2a, 0xb7, 0x90, 0x47, 0x56, 0x4a, 0x9d, 0x37, 0xac,
+	0x8f, 0x57, 0x8f, 0x0e, 0xd8, 0x80, 0xfa, 0x10, 0x26, 0x4d, 0x0b, 0xfb, 0x9e, 0x75, 0xd8, 0x24,
+	0x59, 0x32, 0x37, 0xb6, 0x9c, 0x59, 0x9d, 0xd8, 0xcc, 0x05, 0x3b, 0xd4, 0x37, 0xc9, 0xfe, 0xa4,
+	0xf6, 0xda, 0xab, 0x19, 0x1e, 0xa2, 0x61, 0x72, 0x4e, 0x0f, 0xc9, 0xc4, 0x1a, 0xf1, 0x6b, 0xdd,
+	0x4d, 0x2c, 0xbc, 0x73, 0x19, 0xc6, 0x30, 0x81, 0xb5, 0xbb, 0xf1, 0x51, 0xfa, 0xbc, 0x6d, 0x16,
+	0x4e, 0x68, 0xbf, 0xa5, 0x23, 0xd7, 0x38, 0xe9, 0xf0, 0x2e, 0x99, 0x44, 0xe6, 0xa0, 0xdd, 0x15,
+	0xe1, 0x5f, 0x82, 0xf5, 0x54, 0xaa, 0x45, 0xee, 0xfc, 0x75, 0x06, 0x2e, 0x88, 0x98, 0x3c, 0xcb,
+	0x26, 0x60, 0x93, 0x9f, 0xcc, 0x58, 0x16, 0xcd, 0xc7, 0x8e, 0x9c, 0x6d, 0x6b, 0x9f, 0xb8, 0x88,
+	0x9f, 0xdc, 0x78, 0xe3, 0x90, 0x4d, 0x68, 0x1c, 0x86, 0xa3, 0x8d, 0xc3, 0x37, 0x63, 0x8d, 0x43,
+	0xef, 0x52, 0x9f, 0x95, 0x35, 0x0d, 0xdb, 0xf1, 0x6a, 0x36, 0x9a, 0xf2, 0x4d, 0xd1, 0x86, 0x41,
+	0x5a, 0xe2, 0xc7, 0x52, 0x95, 0xf8, 0x75, 0x58, 0x90, 0xb8, 0x26, 0xb1, 0xbc, 0xff, 0x75, 0x08,
+	0x2e, 0x0b, 0x3c, 0x4f, 0x26, 0x0f, 0x82, 0x44, 0x37, 0x78, 0x87, 0xc6, 0xb2, 0x76, 0x26, 0x9e,
+	0xb5, 0xd3, 0xd7, 0x80, 0x76, 0xd6, 0x19, 0x0e, 0x65, 0x9d, 0x2d, 0x38, 0x4f, 0x93, 0x35, 0x32,
+	0xcb, 0x9c, 0x15, 0x3b, 0x55, 0x77, 0xe3, 0x3f, 0xc3, 0x25, 0xb6, 0xb8, 0x80, 0x7a, 0x0d, 0x66,
+	0x1a, 0xc6, 0x31, 0x61, 0x50, 0xc6, 0xcc, 0x2e, 0xbc, 0x10, 0x4c, 0x35, 0x8c, 0xe3, 0x5d, 0xe4,
+	0x71, 0x63, 0x45, 0xcc, 0x7f, 0x9b, 0x9d, 0x34, 0xa4, 0xe6, 0x4c, 0x74, 0xc2, 0x4f, 0x14, 0xda,
+	0x58, 0x3f, 0x6e, 0xda, 0xe6, 0x40, 0x5c, 0x90, 0x32, 0x3b, 0x47, 0xa8, 0x5f, 0x81, 0xa5, 0x04,
+	0x12, 0x62, 0xe3, 0x7f, 0xa2, 0xd0, 0xe8, 0xfa, 0x8e, 0xe5, 0xd7, 0x4c, 0xcf, 0x78, 0xfe, 0x7f,
+	0x24, 0xfb, 0x01, 0xac, 0x74, 0x21, 0x22, 0x2c, 0xdd, 0x7e, 0x99, 0xd2, 0xf9, 0xb2, 0xc2, 0xaf,
+	0x14, 0x98, 0xde, 0xc1, 0xd5, 0x07, 0xae, 0x8b, 0x8c, 0x3a, 0x4d, 0x75, 0x03, 0xe1, 0x9e, 0x50,
+	0xaa, 0x32, 0x09, 0xa5, 0x2a, 0xb2, 0xa6, 0x1c, 0xcc, 0x87, 0x39, 0xb5, 0xaf, 0xbb, 0x14, 0xb8,
+	0x48, 0x53, 0x34, 0x76, 0xea, 0x2d, 0x44, 0xe7, 0x18, 0x6c, 0x50, 0x16, 0x6f, 0xba, 0x35, 0xa7,
+	0xce, 0x76, 0xe3, 0x98, 0xce, 0x9f, 0x22, 0xec, 0x96, 0x60, 0x51, 0x4a, 0x41, 0x90, 0xfc, 0x9c,
+	0x45, 0xf1, 0x1e, 0xf2, 0xe9, 0xec, 0xa3, 0x8e, 0x82, 0x79, 0x2a, 0x9a, 0x17, 0x60, 0xd8, 0xf7,
+	0xda, 0x49, 0x24, 0xeb, 0x7b, 0xdb, 0x66, 0xac, 0x7a, 0x67, 0xde, 0xba, 0x7a, 0xb3, 0xb0, 0x97,
+	0xb1, 0x16, 0x2b, 0xfb, 0x9b, 0xc2, 0x6e, 0x1b, 0x9b, 0xd8, 0x1d, 0xfc, 0x21, 0x68, 0x1e, 0x46,
+	0x58, 0x3f, 0xc4, 0xc3, 0x84, 0x3f, 0x91, 0xc3, 0x11, 0x66, 0x0a, 0x91, 0xc9, 0xcb, 0x49, 0xb6,
+	0x9f, 0xc3, 0x91, 0x10, 0x96, 0x1d, 0x8e, 0xd8, 0x65, 0x5c, 0x6c, 0x41, 0x62, 0xc5, 0x4f, 0x69,
+	0x28, 0xee, 0xdb, 0xf8, 0x2c, 0x96, 0x1c, 0xe1, 0xb2, 0x0c, 0x79, 0xb9, 0x2e, 0xc1, 0xa6, 0xc1,
+	0x6a, 0x54, 0xdd, 0xc1, 0x67, 0x73, 0x38, 0x89, 0x10, 0x5a, 0x81, 0x2b, 0x89, 0xea, 0x04, 0xa7,
+	0x97, 0x0a, 0xa5, 0xbd, 0xdb, 0xf4, 0x2a, 0x35, 0x03, 0xa3, 0x83, 0x8e, 0xaa, 0xbc, 0x45, 0x1b,
+	0x79, 0x7c, 0x2a, 0x66, 0xb2, 0xe2, 0x37, 0x24, 0x2d, 0x7e, 0xe9, 0x0e, 0x54, 0x73, 0x30, 0x4c,
+	0x5b, 0x1d, 0x5e, 0x41, 0xd9, 0x43, 0x64, 0xbd, 0x1f, 0x2b, 0x70, 0xad, 0xfb, 0x52, 0x92, 0x2a,
+	0x17, 0xb9, 0x3a, 0xaf, 0x38, 0xd8, 0x0f, 0xb6, 0x28, 0xf9, 0x4d, 0xc6, 0x68, 0xbf, 0xc2, 0xe8,
+	0x64, 0x8f, 0xf8, 0xc5, 0x86, 0x4f, 0x3a, 0xc9, 0xb2, 0xc9, 0x5a, 0x49, 0x4e, 0x67, 0xd2, 0xef,
+	0x68, 0x2f, 0x0b, 0x2e, 0xbf, 0x21, 0x3e, 0x6a, 0xda, 0xe6, 0xa0, 0xec, 0xd9, 0x3d, 0xf4, 0x3e,
+	0x51, 0xe0, 0x6a, 0x37, 0x95, 0x62, 0xdd, 0x37, 0xe0, 0xbc, 0x47, 0x41, 0xac, 0xa5, 0x20, 0x73,
+	0xdc, 0x0a, 0x33, 0xc1, 0x78, 0xc0, 0xf2, 0x7d, 0x98, 0xf7, 0x50, 0x1d, 0x19, 0x18, 0x99, 0xe5,
+	0xf0, 0x9a, 0x19, 0x8b, 0xb9, 0x60, 0xb6, 0xb3, 0xb5, 0x2e, 0xfc, 0x5c, 0x81, 0xb9, 0x3d, 0xab,
+	0x6a, 0xa3, 0xa0, 0xf8, 0x1e, 0x38, 0xcd, 0x4a, 0x0d, 0x79, 0xe4, 0xa4, 0xd9, 0x62, 0x3f, 0xa9,
+	0xc2, 0x89, 0x78, 0x17, 0x1c, 0x16, 0xe0, 0x19, 0x2f, 0x10, 0x52, 0x2f, 0xc1, 0xa8, 0xdb, 0x3c,
+	0x2c, 0x3f, 0x43, 0x27, 0x54, 0xff, 0xa4, 0x3e, 0xe2, 0x36, 0x0f, 0x3f, 0x44, 0x27, 0xa4, 0x2b,
+	0xc6, 0x56, 0xd5, 0x36, 0xfc, 0xa6, 0xc7, 0x1a, 0xec, 0x49, 0xbd, 0x3d, 0x50, 0xf8, 0xbd, 0x02,
+	0x39, 0x96, 0x16, 0xfd, 0x3a, 0x0a, 0x6b, 0x38, 0x9d, 0x23, 0x1e, 0xc3, 0x18, 0xa7, 0x44, 0xbe,
+	0x20, 0x91, 0xa4, 0x7d, 0x35, 0xb6, 0x10, 0xc9, 0xfa, 0xf9, 0x72, 0x84, 0x6c, 0xc4, 0x81, 0x3a,
+	0x2c, 0x27, 0xb1, 0x14, 0xbe, 0xcb, 0xc1, 0x28, 0xa6, 0x80, 0xf6, 0x99, 0x8b, 0x3d, 0x92, 0xcd,
+	0xe1, 0x3b, 0xbe, 0x51, 0xe7, 0x9e, 0x61, 0x0f, 0x85, 0x3f, 0x2a, 0xa0, 0x92, 0x3a, 0x6c, 0x57,
+	0x6a, 0x8e, 0x77, 0xb0, 0xbb, 0x83, 0x30, 0x36, 0xaa, 0xa7, 0xeb, 0x6d, 0x88, 0xf1, 0x43, 0x9b,
+	0x78, 0xc4, 0x65, 0xdb, 0x72, 0x11, 0x20, 0xd6, 0x1f, 0x8c, 0x9b, 0xe2, 0x08, 0xbb, 0x02, 0x53,
+	0xc8, 0xae, 0x78, 0x27, 0xae, 0x4f, 0x72, 0xbf, 0x67, 0xf1, 0xd3, 0xcc, 0xa4, 0x18, 0xdc, 0x8f,
+	0x35, 0x0f, 0x9b, 0xa0, 0xc5, 0x49, 0x0b, 0x1b, 0xcc, 0xc1, 0xb0, 0x65, 0x9b, 0xe8, 0x98, 0x5b,
+	0x80, 0x3d, 0x14, 0xfe, 0xa3, 0x74, 0x7e, 0x61, 0x13, 0xa9, 0x8e, 0xde, 0x32, 0x0e, 0xa2, 0xb4,
+	0x49, 0xee, 0x3d, 0x33, 0xe9, 0xee, 0x3d, 0xb3, 0x69, 0xef, 0x3d, 0x87, 0x53, 0x1d, 0x8a, 0x9e,
+	0xc2, 0x52, 0xc2, 0x3a, 0x85, 0x85, 0xe2, 0xc7, 0x40, 0xe5, 0x54, 0xc7, 0xc0, 0xcd, 0x4f, 0x35,
+	0xc8, 0xec, 0xe0, 0xaa, 0xfa, 0x5d, 0x98, 0x0c, 0x7d, 0x78, 0x5d, 0x8a, 0x86, 0x7b, 0xe4, 0xdb,
+	0xa6, 0x76, 0xbd, 0x07, 0x40, 0x50, 0xad, 0xc2, 0x6c, 0xfc, 0xc3, 0xe7, 0x55, 0x89, 0x74, 0x0c,
+	0xa5, 0xdd, 0x4a, 0x83, 0xea, 0x54, 0x14, 0xff, 0x32, 0x28, 0x53, 0x14, 0x43, 0x69, 0xb7, 0xd2,
+	0xa0, 0x84, 0xa2, 0x8f, 0x14, 0xd0, 0xba, 0x7c, 0xf8, 0x5b, 0x97, 0xb1, 0x4e, 0x84, 0x6b, 0x77,
+	0xfa, 0x82, 0x0b, 0x12, 0xbf, 0x50, 0x20, 0xdf, 0xe3, 0x33, 0xd6, 0x86, 0x74, 0x55, 0xdd, 0x44,
+	0xb4, 0x7b, 0x7d, 0x8b, 0x84, 0x08, 0xf5, 0xf8, 0x66, 0x25, 0x23, 0xd4, 0x5d, 0x44, 0xbb, 0xd7,
+	0xb7, 0x88, 0x20, 0xf4, 0x53, 0x05, 0x16, 0xbb, 0x7f, 0xa0, 0x7a, 0x4f, 0xf6, 0xf2, 0x6e, 0x12,
+	0xda, 0x57, 0xfa, 0x95, 0x10, 0x6c, 0x5c, 0x98, 0x93, 0x7e, 0x8a, 0x92, 0xed, 0x23, 0x19, 0x50,
+	0x2b, 0xa5, 0x04, 0x0a, 0x8d, 0x26, 0x9c, 0x8f, 0x7d, 0x07, 0x59, 0x91, 0xbc, 0x24, 0x0a, 0xd2,
+	0x6e, 0xa6, 0x00, 0x75, 0x6a, 0x89, 0x7d, 0x95, 0x58, 0x91, 0x46, 0x51, 0x18, 0xa4, 0xdd, 0x4c,
+	0x01, 0x12, 0x5a, 0x7e, 0xa9, 0xc0, 0x52, 0xcf, 0x7b, 0xff, 0x44, 0x03, 0x25, 0xca, 0x68, 0xf7,
+	0xfb, 0x97, 0x11, 0x9c, 0x3e, 0x56, 0x60, 0xa1, 0xdb, 0x4d, 0x75, 0x51, 0xb6, 0xb1, 0x93, 0xf1,
+	0xda, 0xdd, 0xfe, 0xf0, 0x82, 0xc7, 0x6f, 0x14, 0x28, 0xa4, 0xb8, 0x89, 0xbd, 0x23, 0xb5, 0x77,
+	0x2f, 0x31, 0xed, 0x83, 0x53, 0x89, 0x75, 0x86, 0x47, 0xec, 0xe2, 0x75, 0x25, 0xd1, 0xe8, 0x3d,
+	0xc2, 0x23, 0xf1, 0x9e, 0xb0, 0x05, 0xf3, 0x09, 0x77, 0x82, 0x37, 0x12, 0x5f, 0x13, 0x85, 0x6a,
+	0x1b, 0xa9, 0xa1, 0x9d, 0x9b, 0x5a, 0x7a, 0x0d, 0x26, 0xdb, 0xd4, 0x32, 0xa0, 0x56, 0x4a, 0x09,
+	0x14, 0x1a, 0x7f, 0x00, 0xb9, 0xc4, 0xfb, 0x2c, 0x99, 0xc9, 0x92, 0xc0, 0xda, 0xed, 0x3e, 0xc0,
+	0x42, 0xfb, 0x3e, 0x4c, 0x74, 0x5e, 0x42, 0xe5, 0x25, 0xef, 0xe8, 0x98, 0xd7, 0xae, 0x75, 0x9f,
+	0x17, 0xaf, 0x7d, 0x0a, 0xaa, 0xe4, 0xb2, 0xe8, 0x5d, 0x69, 0xe4, 0x45, 0x61, 0xda, 0x7a, 0x2a,
+	0x58, 0xa7, 0xcb, 0xa4, 0x77, 0x3e, 0xd7, 0xe5, 0x65, 0x38, 0x06, 0xd4, 0x4a, 0x29, 0x81, 0xa1,
+	0x06, 0x28, 0x76, 0x31, 0x21, 0x6d, 0x80, 0xa2, 0x28, 0xed, 0x56, 0x1a, 0x94, 0x50, 0xd4, 0x80,
+	0x0b, 0xb2, 0x3b, 0x10, 0x99, 0x17, 0x24, 0x38, 0xad, 0x98, 0x0e, 0x17, 0xda, 0x74, 0xf2, 0x4b,
+	0x0e, 0xe9, 0xa6, 0x93, 0x42, 0xb5, 0x8d, 0xd4, 0xd0, 0x50, 0xde, 0xed, 0x76, 0x91, 0x21, 0x5b,
+	0x47, 0x17, 0xbc, 0x76, 0xb7, 0x3f, 0xbc, 0xe0, 0xf1, 0x43, 0xb8, 0x9c, 0x7c, 0xfa, 0x97, 0x77,
+	0x94, 0x09, 0x68, 0xed, 0xfd, 0x7e, 0xd0, 0x82, 0x00, 0x86, 0x8b, 0xf2, 0x13, 0xef, 0xaa, 0x3c,
+	0x44, 0xe3, 0x48, 0xed, 0xbd, 0xb4, 0x48, 0xa1, 0xd4, 0x80, 0x99, 0xe8, 0x59, 0xb3, 0x20, 0xdb,
+	0xe6, 0x61, 0x8c, 0xb6, 0xd6, 0x1b, 0xd3, 0xb9, 0x45, 0xa5, 0x87, 0xbc, 0x2e, 0x47, 0x8e, 0x10,
+	0x50, 0x2b, 0xa5, 0x04, 0x06, 0x1a, 0xb5, 0xe1, 0x1f, 0x91, 0x7f, 0x01, 0x7d, 0xb8, 0xff, 0xf2,
+	0x55, 0x5e, 0xf9, 0xe2, 0x55, 0x5e, 0xf9, 0xd7, 0xab, 0xbc, 0xf2, 0xd9, 0xeb, 0xfc, 0xb9, 0x2f,
+	0x5e, 0xe7, 0xcf, 0xfd, 0xe3, 0x75, 0xfe, 0xdc, 0xf7, 0xbe, 0x5a, 0xb5, 0xfc, 0x5a, 0xf3, 0xb0,
+	0x58, 0x71, 0x1a, 0x25, 0xf6, 0xee, 0xf5, 0xba, 0x71, 0x88, 0x83, 0xdf, 0x87, 0x75, 0xa7, 0xf2,
+	0xac, 0x52, 0x33, 0x2c, 0x3b, 0xfc, 0x3f, 0xa2, 0xf4, 0xdf, 0x5b, 0x0f, 0x47, 0xe8, 0x61, 0xec,
+	0xf6, 0x7f, 0x07, 0x00, 0x64, 0xdf, 0x83, 0x23, 0xdb, 0x2b, 0x00, 0x00,
 }
 
-func (m *MsgRepayPermissionSlashedTrustDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	StartPermissionVP(ctx context.Context, in *MsgStartPermissionVP, opts ...grpc.CallOption) (*MsgStartPermissionVPResponse, error)
+	RenewPermissionVP(ctx context.Context, in *MsgRenewPermissionVP, opts ...grpc.CallOption) (*MsgRenewPermissionVPResponse, error)
+	SetPermissionVPToValidated(ctx context.Context, in *MsgSetPermissionVPToValidated, opts ...grpc.CallOption) (*MsgSetPermissionVPToValidatedResponse, error)
+	RequestPermissionVPTermination(ctx context.Context, in *MsgRequestPermissionVPTermination, opts ...grpc.CallOption) (*MsgRequestPermissionVPTerminationResponse, error)
+	ConfirmPermissionVPTermination(ctx context.Context, in *MsgConfirmPermissionVPTermination, opts ...grpc.CallOption) (*MsgConfirmPermissionVPTerminationResponse, error)
+	CancelPermissionVPLastRequest(ctx context.Context, in *MsgCancelPermissionVPLastRequest, opts ...grpc.CallOption) (*MsgCancelPermissionVPLastRequestResponse, error)
+	CreateRootPermission(ctx context.Context, in *MsgCreateRootPermission, opts ...grpc.CallOption) (*MsgCreateRootPermissionResponse, error)
+	ExtendPermission(ctx context.Context, in *MsgExtendPermission, opts ...grpc.CallOption) (*MsgExtendPermissionResponse, error)
+	RevokePermission(ctx context.Context, in *MsgRevokePermission, opts ...grpc.CallOption) (*MsgRevokePermissionResponse, error)
+	CreateOrUpdatePermissionSession(ctx context.Context, in *MsgCreateOrUpdatePermissionSession, opts ...grpc.CallOption) (*MsgCreateOrUpdatePermissionSessionResponse, error)
+	SlashPermissionTrustDeposit(ctx context.Context, in *MsgSlashPermissionTrustDeposit, opts ...grpc.CallOption) (*MsgSlashPermissionTrustDepositResponse, error)
+	RepayPermissionSlashedTrustDeposit(ctx context.Context, in *MsgRepayPermissionSlashedTrustDeposit, opts ...grpc.CallOption) (*MsgRepayPermissionSlashedTrustDepositResponse, error)
+	CreatePermission(ctx context.Context, in *MsgCreatePermission, opts ...grpc.CallOption) (*MsgCreatePermissionResponse, error)
+	CreateSessionAllowance(ctx context.Context, in *MsgCreateSessionAllowance, opts ...grpc.CallOption) (*MsgCreateSessionAllowanceResponse, error)
+	FundSessionAllowance(ctx context.Context, in *MsgFundSessionAllowance, opts ...grpc.CallOption) (*MsgFundSessionAllowanceResponse, error)
+	WithdrawSessionAllowance(ctx context.Context, in *MsgWithdrawSessionAllowance, opts ...grpc.CallOption) (*MsgWithdrawSessionAllowanceResponse, error)
+	AppealSlash(ctx context.Context, in *MsgAppealSlash, opts ...grpc.CallOption) (*MsgAppealSlashResponse, error)
+	ResolveSlashAppeal(ctx context.Context, in *MsgResolveSlashAppeal, opts ...grpc.CallOption) (*MsgResolveSlashAppealResponse, error)
+	SetSlashDistribution(ctx context.Context, in *MsgSetSlashDistribution, opts ...grpc.CallOption) (*MsgSetSlashDistributionResponse, error)
+	SuspendPermission(ctx context.Context, in *MsgSuspendPermission, opts ...grpc.CallOption) (*MsgSuspendPermissionResponse, error)
+	UnsuspendPermission(ctx context.Context, in *MsgUnsuspendPermission, opts ...grpc.CallOption) (*MsgUnsuspendPermissionResponse, error)
+	ClosePermissionSession(ctx context.Context, in *MsgClosePermissionSession, opts ...grpc.CallOption) (*MsgClosePermissionSessionResponse, error)
+	PurchaseVerificationCredits(ctx context.Context, in *MsgPurchaseVerificationCredits, opts ...grpc.CallOption) (*MsgPurchaseVerificationCreditsResponse, error)
+	RefundVerificationCredits(ctx context.Context, in *MsgRefundVerificationCredits, opts ...grpc.CallOption) (*MsgRefundVerificationCreditsResponse, error)
+	SettleSessionVouchers(ctx context.Context, in *MsgSettleSessionVouchers, opts ...grpc.CallOption) (*MsgSettleSessionVouchersResponse, error)
+	AnchorVPMessage(ctx context.Context, in *MsgAnchorVPMessage, opts ...grpc.CallOption) (*MsgAnchorVPMessageResponse, error)
+	UpdatePermissionFees(ctx context.Context, in *MsgUpdatePermissionFees, opts ...grpc.CallOption) (*MsgUpdatePermissionFeesResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/UpdateParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRepayPermissionSlashedTrustDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) StartPermissionVP(ctx context.Context, in *MsgStartPermissionVP, opts ...grpc.CallOption) (*MsgStartPermissionVPResponse, error) {
+	out := new(MsgStartPermissionVPResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/StartPermissionVP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRepayPermissionSlashedTrustDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
+func (c *msgClient) RenewPermissionVP(ctx context.Context, in *MsgRenewPermissionVP, opts ...grpc.CallOption) (*MsgRenewPermissionVPResponse, error) {
+	out := new(MsgRenewPermissionVPResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/RenewPermissionVP", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) SetPermissionVPToValidated(ctx context.Context, in *MsgSetPermissionVPToValidated, opts ...grpc.CallOption) (*MsgSetPermissionVPToValidatedResponse, error) {
+	out := new(MsgSetPermissionVPToValidatedResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/SetPermissionVPToValidated", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRepayPermissionSlashedTrustDepositResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RequestPermissionVPTermination(ctx context.Context, in *MsgRequestPermissionVPTermination, opts ...grpc.CallOption) (*MsgRequestPermissionVPTerminationResponse, error) {
+	out := new(MsgRequestPermissionVPTerminationResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/RequestPermissionVPTermination", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRepayPermissionSlashedTrustDepositResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ConfirmPermissionVPTermination(ctx context.Context, in *MsgConfirmPermissionVPTermination, opts ...grpc.CallOption) (*MsgConfirmPermissionVPTerminationResponse, error) {
+	out := new(MsgConfirmPermissionVPTerminationResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/ConfirmPermissionVPTermination", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRepayPermissionSlashedTrustDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) CancelPermissionVPLastRequest(ctx context.Context, in *MsgCancelPermissionVPLastRequest, opts ...grpc.CallOption) (*MsgCancelPermissionVPLastRequestResponse, error) {
+	out := new(MsgCancelPermissionVPLastRequestResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/CancelPermissionVPLastRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreatePermission) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateRootPermission(ctx context.Context, in *MsgCreateRootPermission, opts ...grpc.CallOption) (*MsgCreateRootPermissionResponse, error) {
+	out := new(MsgCreateRootPermissionResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/CreateRootPermission", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCreatePermission) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ExtendPermission(ctx context.Context, in *MsgExtendPermission, opts ...grpc.CallOption) (*MsgExtendPermissionResponse, error) {
+	out := new(MsgExtendPermissionResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/ExtendPermission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreatePermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VerificationFees != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.VerificationFees))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.EffectiveUntil != nil {
-		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil):])
-		if err6 != nil {
-			return 0, err6
-		}
-		i -= n6
-		i = encodeVarintTx(dAtA, i, uint64(n6))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.EffectiveFrom != nil {
-		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveFrom, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveFrom):])
-		if err7 != nil {
-			return 0, err7
-		}
-		i -= n7
-		i = encodeVarintTx(dAtA, i, uint64(n7))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Country) > 0 {
-		i -= len(m.Country)
-		copy(dAtA[i:], m.Country)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Country)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Did) > 0 {
-		i -= len(m.Did)
-		copy(dAtA[i:], m.Did)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Type != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.SchemaId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SchemaId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) RevokePermission(ctx context.Context, in *MsgRevokePermission, opts ...grpc.CallOption) (*MsgRevokePermissionResponse, error) {
+	out := new(MsgRevokePermissionResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/RevokePermission", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCreatePermissionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateOrUpdatePermissionSession(ctx context.Context, in *MsgCreateOrUpdatePermissionSession, opts ...grpc.CallOption) (*MsgCreateOrUpdatePermissionSessionResponse, error) {
+	out := new(MsgCreateOrUpdatePermissionSessionResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/CreateOrUpdatePermissionSession", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCreatePermissionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SlashPermissionTrustDeposit(ctx context.Context, in *MsgSlashPermissionTrustDeposit, opts ...grpc.CallOption) (*MsgSlashPermissionTrustDepositResponse, error) {
+	out := new(MsgSlashPermissionTrustDepositResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/SlashPermissionTrustDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreatePermissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (c *msgClient) RepayPermissionSlashedTrustDeposit(ctx context.Context, in *MsgRepayPermissionSlashedTrustDeposit, opts ...grpc.CallOption) (*MsgRepayPermissionSlashedTrustDepositResponse, error) {
+	out := new(MsgRepayPermissionSlashedTrustDepositResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/RepayPermissionSlashedTrustDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *msgClient) CreatePermission(ctx context.Context, in *MsgCreatePermission, opts ...grpc.CallOption) (*MsgCreatePermissionResponse, error) {
+	out := new(MsgCreatePermissionResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/CreatePermission", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+func (c *msgClient) CreateSessionAllowance(ctx context.Context, in *MsgCreateSessionAllowance, opts ...grpc.CallOption) (*MsgCreateSessionAllowanceResponse, error) {
+	out := new(MsgCreateSessionAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/CreateSessionAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) FundSessionAllowance(ctx context.Context, in *MsgFundSessionAllowance, opts ...grpc.CallOption) (*MsgFundSessionAllowanceResponse, error) {
+	out := new(MsgFundSessionAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/FundSessionAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgStartPermissionVP) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Type != 0 {
-		n += 1 + sovTx(uint64(m.Type))
-	}
-	if m.ValidatorPermId != 0 {
-		n += 1 + sovTx(uint64(m.ValidatorPermId))
-	}
-	l = len(m.Country)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) WithdrawSessionAllowance(ctx context.Context, in *MsgWithdrawSessionAllowance, opts ...grpc.CallOption) (*MsgWithdrawSessionAllowanceResponse, error) {
+	out := new(MsgWithdrawSessionAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/WithdrawSessionAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgStartPermissionVPResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PermissionId != 0 {
-		n += 1 + sovTx(uint64(m.PermissionId))
+func (c *msgClient) AppealSlash(ctx context.Context, in *MsgAppealSlash, opts ...grpc.CallOption) (*MsgAppealSlashResponse, error) {
+	out := new(MsgAppealSlashResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/AppealSlash", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgRenewPermissionVP) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+func (c *msgClient) ResolveSlashAppeal(ctx context.Context, in *MsgResolveSlashAppeal, opts ...grpc.CallOption) (*MsgResolveSlashAppealResponse, error) {
+	out := new(MsgResolveSlashAppealResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/ResolveSlashAppeal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgRenewPermissionVPResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SetSlashDistribution(ctx context.Context, in *MsgSetSlashDistribution, opts ...grpc.CallOption) (*MsgSetSlashDistributionResponse, error) {
+	out := new(MsgSetSlashDistributionResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/SetSlashDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgSetPermissionVPToValidated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
-	}
-	if m.EffectiveUntil != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil)
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ValidationFees != 0 {
-		n += 1 + sovTx(uint64(m.ValidationFees))
-	}
-	if m.IssuanceFees != 0 {
-		n += 1 + sovTx(uint64(m.IssuanceFees))
-	}
-	if m.VerificationFees != 0 {
-		n += 1 + sovTx(uint64(m.VerificationFees))
+func (c *msgClient) SuspendPermission(ctx context.Context, in *MsgSuspendPermission, opts ...grpc.CallOption) (*MsgSuspendPermissionResponse, error) {
+	out := new(MsgSuspendPermissionResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/SuspendPermission", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Country)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UnsuspendPermission(ctx context.Context, in *MsgUnsuspendPermission, opts ...grpc.CallOption) (*MsgUnsuspendPermissionResponse, error) {
+	out := new(MsgUnsuspendPermissionResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/UnsuspendPermission", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.VpSummaryDigestSri)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) ClosePermissionSession(ctx context.Context, in *MsgClosePermissionSession, opts ...grpc.CallOption) (*MsgClosePermissionSessionResponse, error) {
+	out := new(MsgClosePermissionSessionResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/ClosePermissionSession", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgSetPermissionVPToValidatedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) PurchaseVerificationCredits(ctx context.Context, in *MsgPurchaseVerificationCredits, opts ...grpc.CallOption) (*MsgPurchaseVerificationCreditsResponse, error) {
+	out := new(MsgPurchaseVerificationCreditsResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/PurchaseVerificationCredits", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgRequestPermissionVPTermination) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) RefundVerificationCredits(ctx context.Context, in *MsgRefundVerificationCredits, opts ...grpc.CallOption) (*MsgRefundVerificationCreditsResponse, error) {
+	out := new(MsgRefundVerificationCreditsResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/RefundVerificationCredits", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
-	}
-	return n
+	return out, nil
 }
 
-func (m *MsgRequestPermissionVPTerminationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SettleSessionVouchers(ctx context.Context, in *MsgSettleSessionVouchers, opts ...grpc.CallOption) (*MsgSettleSessionVouchersResponse, error) {
+	out := new(MsgSettleSessionVouchersResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/SettleSessionVouchers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgConfirmPermissionVPTermination) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+func (c *msgClient) AnchorVPMessage(ctx context.Context, in *MsgAnchorVPMessage, opts ...grpc.CallOption) (*MsgAnchorVPMessageResponse, error) {
+	out := new(MsgAnchorVPMessageResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/AnchorVPMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgConfirmPermissionVPTerminationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) UpdatePermissionFees(ctx context.Context, in *MsgUpdatePermissionFees, opts ...grpc.CallOption) (*MsgUpdatePermissionFeesResponse, error) {
+	out := new(MsgUpdatePermissionFeesResponse)
+	err := c.cc.Invoke(ctx, "/verana.perm.v1.Msg/UpdatePermissionFees", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgCancelPermissionVPLastRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
-	}
-	return n
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	StartPermissionVP(context.Context, *MsgStartPermissionVP) (*MsgStartPermissionVPResponse, error)
+	RenewPermissionVP(context.Context, *MsgRenewPermissionVP) (*MsgRenewPermissionVPResponse, error)
+	SetPermissionVPToValidated(context.Context, *MsgSetPermissionVPToValidated) (*MsgSetPermissionVPToValidatedResponse, error)
+	RequestPermissionVPTermination(context.Context, *MsgRequestPermissionVPTermination) (*MsgRequestPermissionVPTerminationResponse, error)
+	ConfirmPermissionVPTermination(context.Context, *MsgConfirmPermissionVPTermination) (*MsgConfirmPermissionVPTerminationResponse, error)
+	CancelPermissionVPLastRequest(context.Context, *MsgCancelPermissionVPLastRequest) (*MsgCancelPermissionVPLastRequestResponse, error)
+	CreateRootPermission(context.Context, *MsgCreateRootPermission) (*MsgCreateRootPermissionResponse, error)
+	ExtendPermission(context.Context, *MsgExtendPermission) (*MsgExtendPermissionResponse, error)
+	RevokePermission(context.Context, *MsgRevokePermission) (*MsgRevokePermissionResponse, error)
+	CreateOrUpdatePermissionSession(context.Context, *MsgCreateOrUpdatePermissionSession) (*MsgCreateOrUpdatePermissionSessionResponse, error)
+	SlashPermissionTrustDeposit(context.Context, *MsgSlashPermissionTrustDeposit) (*MsgSlashPermissionTrustDepositResponse, error)
+	RepayPermissionSlashedTrustDeposit(context.Context, *MsgRepayPermissionSlashedTrustDeposit) (*MsgRepayPermissionSlashedTrustDepositResponse, error)
+	CreatePermission(context.Context, *MsgCreatePermission) (*MsgCreatePermissionResponse, error)
+	CreateSessionAllowance(context.Context, *MsgCreateSessionAllowance) (*MsgCreateSessionAllowanceResponse, error)
+	FundSessionAllowance(context.Context, *MsgFundSessionAllowance) (*MsgFundSessionAllowanceResponse, error)
+	WithdrawSessionAllowance(context.Context, *MsgWithdrawSessionAllowance) (*MsgWithdrawSessionAllowanceResponse, error)
+	AppealSlash(context.Context, *MsgAppealSlash) (*MsgAppealSlashResponse, error)
+	ResolveSlashAppeal(context.Context, *MsgResolveSlashAppeal) (*MsgResolveSlashAppealResponse, error)
+	SetSlashDistribution(context.Context, *MsgSetSlashDistribution) (*MsgSetSlashDistributionResponse, error)
+	SuspendPermission(context.Context, *MsgSuspendPermission) (*MsgSuspendPermissionResponse, error)
+	UnsuspendPermission(context.Context, *MsgUnsuspendPermission) (*MsgUnsuspendPermissionResponse, error)
+	ClosePermissionSession(context.Context, *MsgClosePermissionSession) (*MsgClosePermissionSessionResponse, error)
+	PurchaseVerificationCredits(context.Context, *MsgPurchaseVerificationCredits) (*MsgPurchaseVerificationCreditsResponse, error)
+	RefundVerificationCredits(context.Context, *MsgRefundVerificationCredits) (*MsgRefundVerificationCreditsResponse, error)
+	SettleSessionVouchers(context.Context, *MsgSettleSessionVouchers) (*MsgSettleSessionVouchersResponse, error)
+	AnchorVPMessage(context.Context, *MsgAnchorVPMessage) (*MsgAnchorVPMessageResponse, error)
+	UpdatePermissionFees(context.Context, *MsgUpdatePermissionFees) (*MsgUpdatePermissionFeesResponse, error)
 }
 
-func (m *MsgCancelPermissionVPLastRequestResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgCreateRootPermission) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.SchemaId != 0 {
-		n += 1 + sovTx(uint64(m.SchemaId))
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) StartPermissionVP(ctx context.Context, req *MsgStartPermissionVP) (*MsgStartPermissionVPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartPermissionVP not implemented")
+}
+func (*UnimplementedMsgServer) RenewPermissionVP(ctx context.Context, req *MsgRenewPermissionVP) (*MsgRenewPermissionVPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewPermissionVP not implemented")
+}
+func (*UnimplementedMsgServer) SetPermissionVPToValidated(ctx context.Context, req *MsgSetPermissionVPToValidated) (*MsgSetPermissionVPToValidatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPermissionVPToValidated not implemented")
+}
+func (*UnimplementedMsgServer) RequestPermissionVPTermination(ctx context.Context, req *MsgRequestPermissionVPTermination) (*MsgRequestPermissionVPTerminationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPermissionVPTermination not implemented")
+}
+func (*UnimplementedMsgServer) ConfirmPermissionVPTermination(ctx context.Context, req *MsgConfirmPermissionVPTermination) (*MsgConfirmPermissionVPTerminationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmPermissionVPTermination not implemented")
+}
+func (*UnimplementedMsgServer) CancelPermissionVPLastRequest(ctx context.Context, req *MsgCancelPermissionVPLastRequest) (*MsgCancelPermissionVPLastRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPermissionVPLastRequest not implemented")
+}
+func (*UnimplementedMsgServer) CreateRootPermission(ctx context.Context, req *MsgCreateRootPermission) (*MsgCreateRootPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRootPermission not implemented")
+}
+func (*UnimplementedMsgServer) ExtendPermission(ctx context.Context, req *MsgExtendPermission) (*MsgExtendPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendPermission not implemented")
+}
+func (*UnimplementedMsgServer) RevokePermission(ctx context.Context, req *MsgRevokePermission) (*MsgRevokePermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokePermission not implemented")
+}
+func (*UnimplementedMsgServer) CreateOrUpdatePermissionSession(ctx context.Context, req *MsgCreateOrUpdatePermissionSession) (*MsgCreateOrUpdatePermissionSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrUpdatePermissionSession not implemented")
+}
+func (*UnimplementedMsgServer) SlashPermissionTrustDeposit(ctx context.Context, req *MsgSlashPermissionTrustDeposit) (*MsgSlashPermissionTrustDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashPermissionTrustDeposit not implemented")
+}
+func (*UnimplementedMsgServer) RepayPermissionSlashedTrustDeposit(ctx context.Context, req *MsgRepayPermissionSlashedTrustDeposit) (*MsgRepayPermissionSlashedTrustDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepayPermissionSlashedTrustDeposit not implemented")
+}
+func (*UnimplementedMsgServer) CreatePermission(ctx context.Context, req *MsgCreatePermission) (*MsgCreatePermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePermission not implemented")
+}
+func (*UnimplementedMsgServer) CreateSessionAllowance(ctx context.Context, req *MsgCreateSessionAllowance) (*MsgCreateSessionAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSessionAllowance not implemented")
+}
+func (*UnimplementedMsgServer) FundSessionAllowance(ctx context.Context, req *MsgFundSessionAllowance) (*MsgFundSessionAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundSessionAllowance not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawSessionAllowance(ctx context.Context, req *MsgWithdrawSessionAllowance) (*MsgWithdrawSessionAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawSessionAllowance not implemented")
+}
+func (*UnimplementedMsgServer) AppealSlash(ctx context.Context, req *MsgAppealSlash) (*MsgAppealSlashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppealSlash not implemented")
+}
+func (*UnimplementedMsgServer) ResolveSlashAppeal(ctx context.Context, req *MsgResolveSlashAppeal) (*MsgResolveSlashAppealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveSlashAppeal not implemented")
+}
+func (*UnimplementedMsgServer) SetSlashDistribution(ctx context.Context, req *MsgSetSlashDistribution) (*MsgSetSlashDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSlashDistribution not implemented")
+}
+func (*UnimplementedMsgServer) SuspendPermission(ctx context.Context, req *MsgSuspendPermission) (*MsgSuspendPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendPermission not implemented")
+}
+func (*UnimplementedMsgServer) UnsuspendPermission(ctx context.Context, req *MsgUnsuspendPermission) (*MsgUnsuspendPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsuspendPermission not implemented")
+}
+func (*UnimplementedMsgServer) ClosePermissionSession(ctx context.Context, req *MsgClosePermissionSession) (*MsgClosePermissionSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClosePermissionSession not implemented")
+}
+func (*UnimplementedMsgServer) PurchaseVerificationCredits(ctx context.Context, req *MsgPurchaseVerificationCredits) (*MsgPurchaseVerificationCreditsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchaseVerificationCredits not implemented")
+}
+func (*UnimplementedMsgServer) RefundVerificationCredits(ctx context.Context, req *MsgRefundVerificationCredits) (*MsgRefundVerificationCreditsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundVerificationCredits not implemented")
+}
+func (*UnimplementedMsgServer) SettleSessionVouchers(ctx context.Context, req *MsgSettleSessionVouchers) (*MsgSettleSessionVouchersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleSessionVouchers not implemented")
+}
+func (*UnimplementedMsgServer) AnchorVPMessage(ctx context.Context, req *MsgAnchorVPMessage) (*MsgAnchorVPMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnchorVPMessage not implemented")
+}
+func (*UnimplementedMsgServer) UpdatePermissionFees(ctx context.Context, req *MsgUpdatePermissionFees) (*MsgUpdatePermissionFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePermissionFees not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	l = len(m.Country)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Msg/UpdateParams",
 	}
-	if m.EffectiveFrom != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveFrom)
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
 	}
-	if m.EffectiveUntil != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil)
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_StartPermissionVP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStartPermissionVP)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ValidationFees != 0 {
-		n += 1 + sovTx(uint64(m.ValidationFees))
+	if interceptor == nil {
+		return srv.(MsgServer).StartPermissionVP(ctx, in)
 	}
-	if m.IssuanceFees != 0 {
-		n += 1 + sovTx(uint64(m.IssuanceFees))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Msg/StartPermissionVP",
 	}
-	if m.VerificationFees != 0 {
-		n += 1 + sovTx(uint64(m.VerificationFees))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StartPermissionVP(ctx, req.(*MsgStartPermissionVP))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateRootPermissionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RenewPermissionVP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRenewPermissionVP)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	if interceptor == nil {
+		return srv.(MsgServer).RenewPermissionVP(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Msg/RenewPermissionVP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RenewPermissionVP(ctx, req.(*MsgRenewPermissionVP))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgExtendPermission) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetPermissionVPToValidated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPermissionVPToValidated)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SetPermissionVPToValidated(ctx, in)
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Msg/SetPermissionVPToValidated",
 	}
-	if m.EffectiveUntil != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil)
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPermissionVPToValidated(ctx, req.(*MsgSetPermissionVPToValidated))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgExtendPermissionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgRevokePermission) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RequestPermissionVPTermination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestPermissionVPTermination)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RequestPermissionVPTermination(ctx, in)
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Msg/RequestPermissionVPTermination",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestPermissionVPTermination(ctx, req.(*MsgRequestPermissionVPTermination))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRevokePermissionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ConfirmPermissionVPTermination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConfirmPermissionVPTermination)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).ConfirmPermissionVPTermination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Msg/ConfirmPermissionVPTermination",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConfirmPermissionVPTermination(ctx, req.(*MsgConfirmPermissionVPTermination))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateOrUpdatePermissionSession) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CancelPermissionVPLastRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelPermissionVPLastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CancelPermissionVPLastRequest(ctx, in)
 	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Msg/CancelPermissionVPLastRequest",
 	}
-	if m.IssuerPermId != 0 {
-		n += 1 + sovTx(uint64(m.IssuerPermId))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelPermissionVPLastRequest(ctx, req.(*MsgCancelPermissionVPLastRequest))
 	}
-	if m.VerifierPermId != 0 {
-		n += 1 + sovTx(uint64(m.VerifierPermId))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateRootPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateRootPermission)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.AgentPermId != 0 {
-		n += 1 + sovTx(uint64(m.AgentPermId))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateRootPermission(ctx, in)
 	}
-	if m.WalletAgentPermId != 0 {
-		n += 1 + sovTx(uint64(m.WalletAgentPermId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Msg/CreateRootPermission",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateRootPermission(ctx, req.(*MsgCreateRootPermission))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateOrUpdatePermissionSessionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ExtendPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExtendPermission)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ExtendPermission(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Msg/ExtendPermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExtendPermission(ctx, req.(*MsgExtendPermission))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSlashPermissionTrustDeposit) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RevokePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokePermission)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RevokePermission(ctx, in)
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Msg/RevokePermission",
 	}
-	if m.Amount != 0 {
-		n += 1 + sovTx(uint64(m.Amount))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokePermission(ctx, req.(*MsgRevokePermission))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSlashPermissionTrustDepositResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CreateOrUpdatePermissionSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateOrUpdatePermissionSession)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).CreateOrUpdatePermissionSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Msg/CreateOrUpdatePermissionSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateOrUpdatePermissionSession(ctx, req.(*MsgCreateOrUpdatePermissionSession))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRepayPermissionSlashedTrustDeposit) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SlashPermissionTrustDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSlashPermissionTrustDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SlashPermissionTrustDeposit(ctx, in)
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Msg/SlashPermissionTrustDeposit",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SlashPermissionTrustDeposit(ctx, req.(*MsgSlashPermissionTrustDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRepayPermissionSlashedTrustDepositResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RepayPermissionSlashedTrustDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRepayPermissionSlashedTrustDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).RepayPermissionSlashedTrustDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Msg/RepayPermissionSlashedTrustDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RepayPermissionSlashedTrustDeposit(ctx, req.(*MsgRepayPermissionSlashedTrustDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreatePermission) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CreatePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePermission)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreatePermission(ctx, in)
 	}
-	if m.SchemaId != 0 {
-		n += 1 + sovTx(uint64(m.SchemaId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Msg/CreatePermission",
 	}
-	if m.Type != 0 {
-		n += 1 + sovTx(uint64(m.Type))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreatePermission(ctx, req.(*MsgCreatePermission))
 	}
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateSessionAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateSessionAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Country)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateSessionAllowance(ctx, in)
 	}
-	if m.EffectiveFrom != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveFrom)
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Msg/CreateSessionAllowance",
 	}
-	if m.EffectiveUntil != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil)
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateSessionAllowance(ctx, req.(*MsgCreateSessionAllowance))
 	}
-	if m.VerificationFees != 0 {
-		n += 1 + sovTx(uint64(m.VerificationFees))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundSessionAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundSessionAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundSessionAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Msg/FundSessionAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundSessionAllowance(ctx, req.(*MsgFundSessionAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawSessionAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawSessionAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawSessionAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Msg/WithdrawSessionAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawSessionAllowance(ctx, req.(*MsgWithdrawSessionAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AppealSlash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAppealSlash)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AppealSlash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Msg/AppealSlash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AppealSlash(ctx, req.(*MsgAppealSlash))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResolveSlashAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResolveSlashAppeal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResolveSlashAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Msg/ResolveSlashAppeal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResolveSlashAppeal(ctx, req.(*MsgResolveSlashAppeal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSlashDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSlashDistribution)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSlashDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Msg/SetSlashDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSlashDistribution(ctx, req.(*MsgSetSlashDistribution))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SuspendPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSuspendPermission)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SuspendPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Msg/SuspendPermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SuspendPermission(ctx, req.(*MsgSuspendPermission))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnsuspendPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnsuspendPermission)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnsuspendPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Msg/UnsuspendPermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnsuspendPermission(ctx, req.(*MsgUnsuspendPermission))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClosePermissionSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClosePermissionSession)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClosePermissionSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Msg/ClosePermissionSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClosePermissionSession(ctx, req.(*MsgClosePermissionSession))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PurchaseVerificationCredits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPurchaseVerificationCredits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PurchaseVerificationCredits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Msg/PurchaseVerificationCredits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PurchaseVerificationCredits(ctx, req.(*MsgPurchaseVerificationCredits))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RefundVerificationCredits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefundVerificationCredits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RefundVerificationCredits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Msg/RefundVerificationCredits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RefundVerificationCredits(ctx, req.(*MsgRefundVerificationCredits))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SettleSessionVouchers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSettleSessionVouchers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SettleSessionVouchers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Msg/SettleSessionVouchers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SettleSessionVouchers(ctx, req.(*MsgSettleSessionVouchers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AnchorVPMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAnchorVPMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AnchorVPMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Msg/AnchorVPMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AnchorVPMessage(ctx, req.(*MsgAnchorVPMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdatePermissionFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePermissionFees)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePermissionFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.perm.v1.Msg/UpdatePermissionFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePermissionFees(ctx, req.(*MsgUpdatePermissionFees))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "verana.perm.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "StartPermissionVP",
+			Handler:    _Msg_StartPermissionVP_Handler,
+		},
+		{
+			MethodName: "RenewPermissionVP",
+			Handler:    _Msg_RenewPermissionVP_Handler,
+		},
+		{
+			MethodName: "SetPermissionVPToValidated",
+			Handler:    _Msg_SetPermissionVPToValidated_Handler,
+		},
+		{
+			MethodName: "RequestPermissionVPTermination",
+			Handler:    _Msg_RequestPermissionVPTermination_Handler,
+		},
+		{
+			MethodName: "ConfirmPermissionVPTermination",
+			Handler:    _Msg_ConfirmPermissionVPTermination_Handler,
+		},
+		{
+			MethodName: "CancelPermissionVPLastRequest",
+			Handler:    _Msg_CancelPermissionVPLastRequest_Handler,
+		},
+		{
+			MethodName: "CreateRootPermission",
+			Handler:    _Msg_CreateRootPermission_Handler,
+		},
+		{
+			MethodName: "ExtendPermission",
+			Handler:    _Msg_ExtendPermission_Handler,
+		},
+		{
+			MethodName: "RevokePermission",
+			Handler:    _Msg_RevokePermission_Handler,
+		},
+		{
+			MethodName: "CreateOrUpdatePermissionSession",
+			Handler:    _Msg_CreateOrUpdatePermissionSession_Handler,
+		},
+		{
+			MethodName: "SlashPermissionTrustDeposit",
+			Handler:    _Msg_SlashPermissionTrustDeposit_Handler,
+		},
+		{
+			MethodName: "RepayPermissionSlashedTrustDeposit",
+			Handler:    _Msg_RepayPermissionSlashedTrustDeposit_Handler,
+		},
+		{
+			MethodName: "CreatePermission",
+			Handler:    _Msg_CreatePermission_Handler,
+		},
+		{
+			MethodName: "CreateSessionAllowance",
+			Handler:    _Msg_CreateSessionAllowance_Handler,
+		},
+		{
+			MethodName: "FundSessionAllowance",
+			Handler:    _Msg_FundSessionAllowance_Handler,
+		},
+		{
+			MethodName: "WithdrawSessionAllowance",
+			Handler:    _Msg_WithdrawSessionAllowance_Handler,
+		},
+		{
+			MethodName: "AppealSlash",
+			Handler:    _Msg_AppealSlash_Handler,
+		},
+		{
+			MethodName: "ResolveSlashAppeal",
+			Handler:    _Msg_ResolveSlashAppeal_Handler,
+		},
+		{
+			MethodName: "SetSlashDistribution",
+			Handler:    _Msg_SetSlashDistribution_Handler,
+		},
+		{
+			MethodName: "SuspendPermission",
+			Handler:    _Msg_SuspendPermission_Handler,
+		},
+		{
+			MethodName: "UnsuspendPermission",
+			Handler:    _Msg_UnsuspendPermission_Handler,
+		},
+		{
+			MethodName: "ClosePermissionSession",
+			Handler:    _Msg_ClosePermissionSession_Handler,
+		},
+		{
+			MethodName: "PurchaseVerificationCredits",
+			Handler:    _Msg_PurchaseVerificationCredits_Handler,
+		},
+		{
+			MethodName: "RefundVerificationCredits",
+			Handler:    _Msg_RefundVerificationCredits_Handler,
+		},
+		{
+			MethodName: "SettleSessionVouchers",
+			Handler:    _Msg_SettleSessionVouchers_Handler,
+		},
+		{
+			MethodName: "AnchorVPMessage",
+			Handler:    _Msg_AnchorVPMessage_Handler,
+		},
+		{
+			MethodName: "UpdatePermissionFees",
+			Handler:    _Msg_UpdatePermissionFees_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "verana/perm/v1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStartPermissionVP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStartPermissionVP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStartPermissionVP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CoValidatorPermIds) > 0 {
+		dAtA3 := make([]byte, len(m.CoValidatorPermIds)*10)
+		var j2 int
+		for _, num := range m.CoValidatorPermIds {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintTx(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Countries) > 0 {
+		for iNdEx := len(m.Countries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Countries[iNdEx])
+			copy(dAtA[i:], m.Countries[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Countries[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ValidatorPermId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ValidatorPermId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Type != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStartPermissionVPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStartPermissionVPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStartPermissionVPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PermissionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PermissionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenewPermissionVP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenewPermissionVP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenewPermissionVP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenewPermissionVPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenewPermissionVPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenewPermissionVPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPermissionVPToValidated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPermissionVPToValidated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPermissionVPToValidated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VpSummaryDigestSri) > 0 {
+		i -= len(m.VpSummaryDigestSri)
+		copy(dAtA[i:], m.VpSummaryDigestSri)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VpSummaryDigestSri)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Countries) > 0 {
+		for iNdEx := len(m.Countries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Countries[iNdEx])
+			copy(dAtA[i:], m.Countries[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Countries[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.VerificationFees != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.VerificationFees))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.IssuanceFees != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.IssuanceFees))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ValidationFees != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ValidationFees))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EffectiveUntil != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintTx(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPermissionVPToValidatedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPermissionVPToValidatedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPermissionVPToValidatedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestPermissionVPTermination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestPermissionVPTermination) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestPermissionVPTermination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestPermissionVPTerminationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestPermissionVPTerminationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestPermissionVPTerminationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConfirmPermissionVPTermination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConfirmPermissionVPTermination) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConfirmPermissionVPTermination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConfirmPermissionVPTerminationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConfirmPermissionVPTerminationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConfirmPermissionVPTerminationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelPermissionVPLastRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelPermissionVPLastRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelPermissionVPLastRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelPermissionVPLastRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelPermissionVPLastRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelPermissionVPLastRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateRootPermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateRootPermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateRootPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VerificationFees != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.VerificationFees))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.IssuanceFees != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.IssuanceFees))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ValidationFees != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ValidationFees))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EffectiveUntil != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintTx(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.EffectiveFrom != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveFrom, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveFrom):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintTx(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Countries) > 0 {
+		for iNdEx := len(m.Countries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Countries[iNdEx])
+			copy(dAtA[i:], m.Countries[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Countries[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SchemaId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SchemaId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateRootPermissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateRootPermissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateRootPermissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExtendPermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExtendPermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExtendPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EffectiveUntil != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintTx(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExtendPermissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExtendPermissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExtendPermissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokePermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokePermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokePermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cascade != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Cascade))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokePermissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokePermissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokePermissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CascadePending {
+		i--
+		if m.CascadePending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Cascaded != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Cascaded))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateOrUpdatePermissionSession) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateOrUpdatePermissionSession) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateOrUpdatePermissionSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreditId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CreditId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Expires != nil {
+		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expires, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expires):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintTx(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.AllowanceId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AllowanceId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.WalletAgentPermId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.WalletAgentPermId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AgentPermId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AgentPermId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.VerifierPermId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.VerifierPermId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IssuerPermId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.IssuerPermId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateOrUpdatePermissionSessionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateOrUpdatePermissionSessionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateOrUpdatePermissionSessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSlashPermissionTrustDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSlashPermissionTrustDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSlashPermissionTrustDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Distribution) > 0 {
+		for iNdEx := len(m.Distribution) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distribution[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.GfVersion != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GfVersion))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.EvidenceDigestSri) > 0 {
+		i -= len(m.EvidenceDigestSri)
+		copy(dAtA[i:], m.EvidenceDigestSri)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EvidenceDigestSri)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.EvidenceUrl) > 0 {
+		i -= len(m.EvidenceUrl)
+		copy(dAtA[i:], m.EvidenceUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EvidenceUrl)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ReasonCode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReasonCode))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSlashPermissionTrustDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSlashPermissionTrustDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSlashPermissionTrustDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SlashId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SlashId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRepayPermissionSlashedTrustDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRepayPermissionSlashedTrustDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRepayPermissionSlashedTrustDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRepayPermissionSlashedTrustDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRepayPermissionSlashedTrustDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRepayPermissionSlashedTrustDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VerificationFees != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.VerificationFees))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.EffectiveUntil != nil {
+		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintTx(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.EffectiveFrom != nil {
+		n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveFrom, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveFrom):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintTx(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Countries) > 0 {
+		for iNdEx := len(m.Countries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Countries[iNdEx])
+			copy(dAtA[i:], m.Countries[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Countries[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Type != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SchemaId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SchemaId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePermissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePermissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePermissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateSessionAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateSessionAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateSessionAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPerSession != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxPerSession))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.AllowedCreators) > 0 {
+		for iNdEx := len(m.AllowedCreators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedCreators[iNdEx])
+			copy(dAtA[i:], m.AllowedCreators[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AllowedCreators[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.VerifierPermId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.VerifierPermId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AgentPermId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AgentPermId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SchemaId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SchemaId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateSessionAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateSessionAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateSessionAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundSessionAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundSessionAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundSessionAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundSessionAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundSessionAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundSessionAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawSessionAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawSessionAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawSessionAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawSessionAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawSessionAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawSessionAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAppealSlash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAppealSlash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAppealSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvidenceDigestSri) > 0 {
+		i -= len(m.EvidenceDigestSri)
+		copy(dAtA[i:], m.EvidenceDigestSri)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EvidenceDigestSri)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAppealSlashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAppealSlashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAppealSlashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResolveSlashAppeal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolveSlashAppeal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolveSlashAppeal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Uphold {
+		i--
+		if m.Uphold {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResolveSlashAppealResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolveSlashAppealResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolveSlashAppealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSlashDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSlashDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSlashDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Distribution) > 0 {
+		for iNdEx := len(m.Distribution) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distribution[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.TrId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TrId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSlashDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSlashDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSlashDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSuspendPermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuspendPermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuspendPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SuspendedUntil != nil {
+		n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SuspendedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SuspendedUntil):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintTx(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSuspendPermissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuspendPermissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuspendPermissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnsuspendPermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnsuspendPermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnsuspendPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnsuspendPermissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnsuspendPermissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnsuspendPermissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClosePermissionSession) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClosePermissionSession) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClosePermissionSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClosePermissionSessionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClosePermissionSessionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClosePermissionSessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPurchaseVerificationCredits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPurchaseVerificationCredits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPurchaseVerificationCredits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IssuerPermId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.IssuerPermId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VerifierPermId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.VerifierPermId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPurchaseVerificationCreditsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPurchaseVerificationCreditsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPurchaseVerificationCreditsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TrustDeposit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TrustDeposit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Fees != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Fees))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Cost != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Cost))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundVerificationCredits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundVerificationCredits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundVerificationCredits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundVerificationCreditsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundVerificationCreditsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundVerificationCreditsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReleasedTrustDeposit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReleasedTrustDeposit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RefundedCredits != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RefundedCredits))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignedSessionVoucher) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignedSessionVoucher) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignedSessionVoucher) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Voucher.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSettleSessionVouchers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSettleSessionVouchers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSettleSessionVouchers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Vouchers) > 0 {
+		for iNdEx := len(m.Vouchers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vouchers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSettleSessionVouchersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSettleSessionVouchersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSettleSessionVouchersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Total != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Settled != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Settled))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAnchorVPMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAnchorVPMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAnchorVPMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EncryptedUri) > 0 {
+		i -= len(m.EncryptedUri)
+		copy(dAtA[i:], m.EncryptedUri)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EncryptedUri)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DigestSri) > 0 {
+		i -= len(m.DigestSri)
+		copy(dAtA[i:], m.DigestSri)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DigestSri)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PermId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PermId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAnchorVPMessageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAnchorVPMessageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAnchorVPMessageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePermissionFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePermissionFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePermissionFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VerificationFees != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.VerificationFees))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.IssuanceFees != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.IssuanceFees))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ValidationFees != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ValidationFees))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePermissionFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePermissionFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePermissionFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EffectiveFrom != nil {
+		n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveFrom, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveFrom):])
+		if err13 != nil {
+			return 0, err13
+		}
+		i -= n13
+		i = encodeVarintTx(dAtA, i, uint64(n13))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgStartPermissionVP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovTx(uint64(m.Type))
+	}
+	if m.ValidatorPermId != 0 {
+		n += 1 + sovTx(uint64(m.ValidatorPermId))
+	}
+	if len(m.Countries) > 0 {
+		for _, s := range m.Countries {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CoValidatorPermIds) > 0 {
+		l = 0
+		for _, e := range m.CoValidatorPermIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgStartPermissionVPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PermissionId != 0 {
+		n += 1 + sovTx(uint64(m.PermissionId))
+	}
+	return n
+}
+
+func (m *MsgRenewPermissionVP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgRenewPermissionVPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetPermissionVPToValidated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.EffectiveUntil != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ValidationFees != 0 {
+		n += 1 + sovTx(uint64(m.ValidationFees))
+	}
+	if m.IssuanceFees != 0 {
+		n += 1 + sovTx(uint64(m.IssuanceFees))
+	}
+	if m.VerificationFees != 0 {
+		n += 1 + sovTx(uint64(m.VerificationFees))
+	}
+	if len(m.Countries) > 0 {
+		for _, s := range m.Countries {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.VpSummaryDigestSri)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetPermissionVPToValidatedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRequestPermissionVPTermination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgRequestPermissionVPTerminationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConfirmPermissionVPTermination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgConfirmPermissionVPTerminationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelPermissionVPLastRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelPermissionVPLastRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateRootPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SchemaId != 0 {
+		n += 1 + sovTx(uint64(m.SchemaId))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Countries) > 0 {
+		for _, s := range m.Countries {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.EffectiveFrom != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveFrom)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EffectiveUntil != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ValidationFees != 0 {
+		n += 1 + sovTx(uint64(m.ValidationFees))
+	}
+	if m.IssuanceFees != 0 {
+		n += 1 + sovTx(uint64(m.IssuanceFees))
+	}
+	if m.VerificationFees != 0 {
+		n += 1 + sovTx(uint64(m.VerificationFees))
+	}
+	return n
+}
+
+func (m *MsgCreateRootPermissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgExtendPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.EffectiveUntil != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgExtendPermissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokePermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.Cascade != 0 {
+		n += 1 + sovTx(uint64(m.Cascade))
+	}
+	return n
+}
+
+func (m *MsgRevokePermissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Cascaded != 0 {
+		n += 1 + sovTx(uint64(m.Cascaded))
+	}
+	if m.CascadePending {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgCreateOrUpdatePermissionSession) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.IssuerPermId != 0 {
+		n += 1 + sovTx(uint64(m.IssuerPermId))
+	}
+	if m.VerifierPermId != 0 {
+		n += 1 + sovTx(uint64(m.VerifierPermId))
+	}
+	if m.AgentPermId != 0 {
+		n += 1 + sovTx(uint64(m.AgentPermId))
+	}
+	if m.WalletAgentPermId != 0 {
+		n += 1 + sovTx(uint64(m.WalletAgentPermId))
+	}
+	if m.AllowanceId != 0 {
+		n += 1 + sovTx(uint64(m.AllowanceId))
+	}
+	if m.Expires != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expires)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CreditId != 0 {
+		n += 1 + sovTx(uint64(m.CreditId))
+	}
+	return n
+}
+
+func (m *MsgCreateOrUpdatePermissionSessionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSlashPermissionTrustDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	if m.ReasonCode != 0 {
+		n += 1 + sovTx(uint64(m.ReasonCode))
+	}
+	l = len(m.EvidenceUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EvidenceDigestSri)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GfVersion != 0 {
+		n += 1 + sovTx(uint64(m.GfVersion))
+	}
+	if len(m.Distribution) > 0 {
+		for _, e := range m.Distribution {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSlashPermissionTrustDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlashId != 0 {
+		n += 1 + sovTx(uint64(m.SlashId))
+	}
+	return n
+}
+
+func (m *MsgRepayPermissionSlashedTrustDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgRepayPermissionSlashedTrustDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreatePermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SchemaId != 0 {
+		n += 1 + sovTx(uint64(m.SchemaId))
+	}
+	if m.Type != 0 {
+		n += 1 + sovTx(uint64(m.Type))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Countries) > 0 {
+		for _, s := range m.Countries {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.EffectiveFrom != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveFrom)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EffectiveUntil != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.VerificationFees != 0 {
+		n += 1 + sovTx(uint64(m.VerificationFees))
+	}
+	return n
+}
+
+func (m *MsgCreatePermissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCreateSessionAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SchemaId != 0 {
+		n += 1 + sovTx(uint64(m.SchemaId))
+	}
+	if m.AgentPermId != 0 {
+		n += 1 + sovTx(uint64(m.AgentPermId))
+	}
+	if m.VerifierPermId != 0 {
+		n += 1 + sovTx(uint64(m.VerifierPermId))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	if len(m.AllowedCreators) > 0 {
+		for _, s := range m.AllowedCreators {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.MaxPerSession != 0 {
+		n += 1 + sovTx(uint64(m.MaxPerSession))
+	}
+	return n
+}
+
+func (m *MsgCreateSessionAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgFundSessionAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *MsgFundSessionAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawSessionAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *MsgWithdrawSessionAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *MsgAppealSlash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.EvidenceDigestSri)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAppealSlashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResolveSlashAppeal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.Uphold {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgResolveSlashAppealResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetSlashDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TrId != 0 {
+		n += 1 + sovTx(uint64(m.TrId))
+	}
+	if len(m.Distribution) > 0 {
+		for _, e := range m.Distribution {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetSlashDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSuspendPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SuspendedUntil != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SuspendedUntil)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSuspendPermissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnsuspendPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgUnsuspendPermissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClosePermissionSession) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClosePermissionSessionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPurchaseVerificationCredits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.VerifierPermId != 0 {
+		n += 1 + sovTx(uint64(m.VerifierPermId))
+	}
+	if m.IssuerPermId != 0 {
+		n += 1 + sovTx(uint64(m.IssuerPermId))
+	}
+	if m.Count != 0 {
+		n += 1 + sovTx(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *MsgPurchaseVerificationCreditsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.Cost != 0 {
+		n += 1 + sovTx(uint64(m.Cost))
+	}
+	if m.Fees != 0 {
+		n += 1 + sovTx(uint64(m.Fees))
+	}
+	if m.TrustDeposit != 0 {
+		n += 1 + sovTx(uint64(m.TrustDeposit))
+	}
+	return n
+}
+
+func (m *MsgRefundVerificationCredits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgRefundVerificationCreditsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RefundedCredits != 0 {
+		n += 1 + sovTx(uint64(m.RefundedCredits))
+	}
+	if m.ReleasedTrustDeposit != 0 {
+		n += 1 + sovTx(uint64(m.ReleasedTrustDeposit))
+	}
+	return n
+}
+
+func (m *SignedSessionVoucher) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Voucher.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSettleSessionVouchers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Vouchers) > 0 {
+		for _, e := range m.Vouchers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSettleSessionVouchersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Settled != 0 {
+		n += 1 + sovTx(uint64(m.Settled))
+	}
+	if m.Total != 0 {
+		n += 1 + sovTx(uint64(m.Total))
+	}
+	return n
+}
+
+func (m *MsgAnchorVPMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PermId != 0 {
+		n += 1 + sovTx(uint64(m.PermId))
+	}
+	l = len(m.DigestSri)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EncryptedUri)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAnchorVPMessageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovTx(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *MsgUpdatePermissionFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.ValidationFees != 0 {
+		n += 1 + sovTx(uint64(m.ValidationFees))
+	}
+	if m.IssuanceFees != 0 {
+		n += 1 + sovTx(uint64(m.IssuanceFees))
+	}
+	if m.VerificationFees != 0 {
+		n += 1 + sovTx(uint64(m.VerificationFees))
+	}
+	return n
+}
+
+func (m *MsgUpdatePermissionFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EffectiveFrom != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveFrom)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStartPermissionVP) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStartPermissionVP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStartPermissionVP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPermId", wireType)
+			}
+			m.ValidatorPermId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorPermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Countries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Countries = append(m.Countries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CoValidatorPermIds = append(m.CoValidatorPermIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CoValidatorPermIds) == 0 {
+					m.CoValidatorPermIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CoValidatorPermIds = append(m.CoValidatorPermIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoValidatorPermIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStartPermissionVPResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStartPermissionVPResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStartPermissionVPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermissionId", wireType)
+			}
+			m.PermissionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PermissionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRenewPermissionVP) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRenewPermissionVP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRenewPermissionVP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRenewPermissionVPResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRenewPermissionVPResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRenewPermissionVPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPermissionVPToValidated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPermissionVPToValidated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPermissionVPToValidated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EffectiveUntil == nil {
+				m.EffectiveUntil = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidationFees", wireType)
+			}
+			m.ValidationFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidationFees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceFees", wireType)
+			}
+			m.IssuanceFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuanceFees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationFees", wireType)
+			}
+			m.VerificationFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerificationFees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Countries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Countries = append(m.Countries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VpSummaryDigestSri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VpSummaryDigestSri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPermissionVPToValidatedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPermissionVPToValidatedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPermissionVPToValidatedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestPermissionVPTermination) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestPermissionVPTermination: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestPermissionVPTermination: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestPermissionVPTerminationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestPermissionVPTerminationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestPermissionVPTerminationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConfirmPermissionVPTermination) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConfirmPermissionVPTermination: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConfirmPermissionVPTermination: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConfirmPermissionVPTerminationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConfirmPermissionVPTerminationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConfirmPermissionVPTerminationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelPermissionVPLastRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelPermissionVPLastRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelPermissionVPLastRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelPermissionVPLastRequestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelPermissionVPLastRequestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelPermissionVPLastRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateRootPermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateRootPermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateRootPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaId", wireType)
+			}
+			m.SchemaId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SchemaId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Countries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Countries = append(m.Countries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EffectiveFrom == nil {
+				m.EffectiveFrom = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveFrom, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EffectiveUntil == nil {
+				m.EffectiveUntil = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidationFees", wireType)
+			}
+			m.ValidationFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidationFees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceFees", wireType)
+			}
+			m.IssuanceFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuanceFees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationFees", wireType)
+			}
+			m.VerificationFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerificationFees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateRootPermissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateRootPermissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateRootPermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExtendPermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExtendPermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExtendPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EffectiveUntil == nil {
+				m.EffectiveUntil = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExtendPermissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExtendPermissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExtendPermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokePermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokePermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokePermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cascade", wireType)
+			}
+			m.Cascade = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cascade |= CascadeMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokePermissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokePermissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokePermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cascaded", wireType)
+			}
+			m.Cascaded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cascaded |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CascadePending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CascadePending = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateOrUpdatePermissionSession) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateOrUpdatePermissionSession: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateOrUpdatePermissionSession: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerPermId", wireType)
+			}
+			m.IssuerPermId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuerPermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifierPermId", wireType)
+			}
+			m.VerifierPermId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerifierPermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentPermId", wireType)
+			}
+			m.AgentPermId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AgentPermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletAgentPermId", wireType)
+			}
+			m.WalletAgentPermId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WalletAgentPermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowanceId", wireType)
+			}
+			m.AllowanceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AllowanceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expires", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expires == nil {
+				m.Expires = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expires, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditId", wireType)
+			}
+			m.CreditId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreditId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateOrUpdatePermissionSessionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateOrUpdatePermissionSessionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateOrUpdatePermissionSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSlashPermissionTrustDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSlashPermissionTrustDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSlashPermissionTrustDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReasonCode", wireType)
+			}
+			m.ReasonCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReasonCode |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvidenceUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceDigestSri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvidenceDigestSri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GfVersion", wireType)
+			}
+			m.GfVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GfVersion |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distribution = append(m.Distribution, types.SlashShare{})
+			if err := m.Distribution[len(m.Distribution)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSlashPermissionTrustDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSlashPermissionTrustDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSlashPermissionTrustDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashId", wireType)
+			}
+			m.SlashId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRepayPermissionSlashedTrustDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRepayPermissionSlashedTrustDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRepayPermissionSlashedTrustDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgCreatePermissionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *MsgRepayPermissionSlashedTrustDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRepayPermissionSlashedTrustDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRepayPermissionSlashedTrustDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgCreatePermission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3763,15 +10777,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreatePermission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreatePermission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3799,11 +10813,113 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaId", wireType)
+			}
+			m.SchemaId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SchemaId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= PermissionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Countries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Countries = append(m.Countries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveFrom", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3830,10 +10946,68 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.EffectiveFrom == nil {
+				m.EffectiveFrom = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveFrom, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EffectiveUntil == nil {
+				m.EffectiveUntil = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveUntil, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationFees", wireType)
+			}
+			m.VerificationFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerificationFees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3855,7 +11029,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreatePermissionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3878,12 +11052,31 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreatePermissionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreatePermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3905,7 +11098,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStartPermissionVP) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateSessionAllowance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3928,10 +11121,10 @@ func (m *MsgStartPermissionVP) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStartPermissionVP: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateSessionAllowance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStartPermissionVP: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateSessionAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3968,9 +11161,9 @@ func (m *MsgStartPermissionVP) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaId", wireType)
 			}
-			m.Type = 0
+			m.SchemaId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3980,16 +11173,16 @@ func (m *MsgStartPermissionVP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= uint32(b&0x7F) << shift
+				m.SchemaId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPermId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentPermId", wireType)
 			}
-			m.ValidatorPermId = 0
+			m.AgentPermId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3999,16 +11192,16 @@ func (m *MsgStartPermissionVP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValidatorPermId |= uint64(b&0x7F) << shift
+				m.AgentPermId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Country", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifierPermId", wireType)
 			}
-			var stringLen uint64
+			m.VerifierPermId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4018,27 +11211,33 @@ func (m *MsgStartPermissionVP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.VerifierPermId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Country = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCreators", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4066,8 +11265,27 @@ func (m *MsgStartPermissionVP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Did = string(dAtA[iNdEx:postIndex])
+			m.AllowedCreators = append(m.AllowedCreators, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerSession", wireType)
+			}
+			m.MaxPerSession = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPerSession |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4089,7 +11307,7 @@ func (m *MsgStartPermissionVP) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStartPermissionVPResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateSessionAllowanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4112,17 +11330,17 @@ func (m *MsgStartPermissionVPResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStartPermissionVPResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateSessionAllowanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStartPermissionVPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateSessionAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PermissionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.PermissionId = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4132,7 +11350,7 @@ func (m *MsgStartPermissionVPResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PermissionId |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4158,7 +11376,7 @@ func (m *MsgStartPermissionVPResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRenewPermissionVP) Unmarshal(dAtA []byte) error {
+func (m *MsgFundSessionAllowance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4181,10 +11399,10 @@ func (m *MsgRenewPermissionVP) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRenewPermissionVP: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundSessionAllowance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRenewPermissionVP: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundSessionAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4238,6 +11456,25 @@ func (m *MsgRenewPermissionVP) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4259,7 +11496,7 @@ func (m *MsgRenewPermissionVP) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRenewPermissionVPResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFundSessionAllowanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4282,10 +11519,10 @@ func (m *MsgRenewPermissionVPResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRenewPermissionVPResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundSessionAllowanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRenewPermissionVPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundSessionAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4309,7 +11546,7 @@ func (m *MsgRenewPermissionVPResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetPermissionVPToValidated) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawSessionAllowance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4332,10 +11569,10 @@ func (m *MsgSetPermissionVPToValidated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetPermissionVPToValidated: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawSessionAllowance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetPermissionVPToValidated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawSessionAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4390,135 +11627,10 @@ func (m *MsgSetPermissionVPToValidated) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveUntil", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.EffectiveUntil == nil {
-				m.EffectiveUntil = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveUntil, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidationFees", wireType)
-			}
-			m.ValidationFees = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValidationFees |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceFees", wireType)
-			}
-			m.IssuanceFees = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.IssuanceFees |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationFees", wireType)
-			}
-			m.VerificationFees = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VerificationFees |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Country", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Country = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VpSummaryDigestSri", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4528,24 +11640,11 @@ func (m *MsgSetPermissionVPToValidated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VpSummaryDigestSri = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4567,7 +11666,7 @@ func (m *MsgSetPermissionVPToValidated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetPermissionVPToValidatedResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawSessionAllowanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4590,12 +11689,31 @@ func (m *MsgSetPermissionVPToValidatedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetPermissionVPToValidatedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawSessionAllowanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetPermissionVPToValidatedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawSessionAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4617,7 +11735,7 @@ func (m *MsgSetPermissionVPToValidatedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestPermissionVPTermination) Unmarshal(dAtA []byte) error {
+func (m *MsgAppealSlash) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4640,10 +11758,10 @@ func (m *MsgRequestPermissionVPTermination) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestPermissionVPTermination: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAppealSlash: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestPermissionVPTermination: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAppealSlash: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4697,6 +11815,38 @@ func (m *MsgRequestPermissionVPTermination) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceDigestSri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvidenceDigestSri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4718,7 +11868,7 @@ func (m *MsgRequestPermissionVPTermination) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestPermissionVPTerminationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAppealSlashResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4741,10 +11891,10 @@ func (m *MsgRequestPermissionVPTerminationResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestPermissionVPTerminationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAppealSlashResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestPermissionVPTerminationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAppealSlashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4768,7 +11918,7 @@ func (m *MsgRequestPermissionVPTerminationResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *MsgConfirmPermissionVPTermination) Unmarshal(dAtA []byte) error {
+func (m *MsgResolveSlashAppeal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4791,10 +11941,10 @@ func (m *MsgConfirmPermissionVPTermination) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConfirmPermissionVPTermination: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResolveSlashAppeal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConfirmPermissionVPTermination: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResolveSlashAppeal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4848,6 +11998,26 @@ func (m *MsgConfirmPermissionVPTermination) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uphold", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Uphold = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4869,7 +12039,7 @@ func (m *MsgConfirmPermissionVPTermination) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConfirmPermissionVPTerminationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResolveSlashAppealResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4892,10 +12062,10 @@ func (m *MsgConfirmPermissionVPTerminationResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConfirmPermissionVPTerminationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResolveSlashAppealResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConfirmPermissionVPTerminationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResolveSlashAppealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4919,7 +12089,7 @@ func (m *MsgConfirmPermissionVPTerminationResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *MsgCancelPermissionVPLastRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSlashDistribution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4942,10 +12112,10 @@ func (m *MsgCancelPermissionVPLastRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelPermissionVPLastRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSlashDistribution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelPermissionVPLastRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSlashDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4982,9 +12152,9 @@ func (m *MsgCancelPermissionVPLastRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrId", wireType)
 			}
-			m.Id = 0
+			m.TrId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4994,11 +12164,45 @@ func (m *MsgCancelPermissionVPLastRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.TrId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distribution = append(m.Distribution, types.SlashShare{})
+			if err := m.Distribution[len(m.Distribution)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5020,7 +12224,7 @@ func (m *MsgCancelPermissionVPLastRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelPermissionVPLastRequestResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSlashDistributionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5043,10 +12247,10 @@ func (m *MsgCancelPermissionVPLastRequestResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelPermissionVPLastRequestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSlashDistributionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelPermissionVPLastRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSlashDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5070,7 +12274,7 @@ func (m *MsgCancelPermissionVPLastRequestResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *MsgCreateRootPermission) Unmarshal(dAtA []byte) error {
+func (m *MsgSuspendPermission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5093,10 +12297,10 @@ func (m *MsgCreateRootPermission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateRootPermission: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSuspendPermission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateRootPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSuspendPermission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5133,9 +12337,9 @@ func (m *MsgCreateRootPermission) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchemaId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.SchemaId = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5145,14 +12349,14 @@ func (m *MsgCreateRootPermission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SchemaId |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5180,13 +12384,13 @@ func (m *MsgCreateRootPermission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Did = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Country", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspendedUntil", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5196,65 +12400,133 @@ func (m *MsgCreateRootPermission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Country = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveFrom", wireType)
+			if m.SuspendedUntil == nil {
+				m.SuspendedUntil = new(time.Time)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.SuspendedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EffectiveFrom == nil {
-				m.EffectiveFrom = new(time.Time)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSuspendPermissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveFrom, dAtA[iNdEx:postIndex]); err != nil {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuspendPermissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuspendPermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 6:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnsuspendPermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnsuspendPermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnsuspendPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveUntil", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5264,71 +12536,29 @@ func (m *MsgCreateRootPermission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EffectiveUntil == nil {
-				m.EffectiveUntil = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveUntil, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidationFees", wireType)
-			}
-			m.ValidationFees = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValidationFees |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceFees", wireType)
-			}
-			m.IssuanceFees = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.IssuanceFees |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.VerificationFees = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5338,7 +12568,7 @@ func (m *MsgCreateRootPermission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VerificationFees |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5364,7 +12594,7 @@ func (m *MsgCreateRootPermission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateRootPermissionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnsuspendPermissionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5387,31 +12617,12 @@ func (m *MsgCreateRootPermissionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateRootPermissionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnsuspendPermissionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateRootPermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnsuspendPermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5433,7 +12644,7 @@ func (m *MsgCreateRootPermissionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExtendPermission) Unmarshal(dAtA []byte) error {
+func (m *MsgClosePermissionSession) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5456,10 +12667,10 @@ func (m *MsgExtendPermission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExtendPermission: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClosePermissionSession: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExtendPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClosePermissionSession: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5495,29 +12706,10 @@ func (m *MsgExtendPermission) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveUntil", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5527,27 +12719,23 @@ func (m *MsgExtendPermission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EffectiveUntil == nil {
-				m.EffectiveUntil = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveUntil, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5570,7 +12758,7 @@ func (m *MsgExtendPermission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExtendPermissionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClosePermissionSessionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5593,10 +12781,10 @@ func (m *MsgExtendPermissionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExtendPermissionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClosePermissionSessionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExtendPermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClosePermissionSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5620,7 +12808,7 @@ func (m *MsgExtendPermissionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokePermission) Unmarshal(dAtA []byte) error {
+func (m *MsgPurchaseVerificationCredits) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5643,10 +12831,10 @@ func (m *MsgRevokePermission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokePermission: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPurchaseVerificationCredits: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokePermission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPurchaseVerificationCredits: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5683,9 +12871,9 @@ func (m *MsgRevokePermission) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifierPermId", wireType)
 			}
-			m.Id = 0
+			m.VerifierPermId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5695,7 +12883,45 @@ func (m *MsgRevokePermission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.VerifierPermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerPermId", wireType)
+			}
+			m.IssuerPermId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuerPermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5721,7 +12947,7 @@ func (m *MsgRevokePermission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokePermissionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPurchaseVerificationCreditsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5740,16 +12966,92 @@ func (m *MsgRevokePermissionResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokePermissionResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokePermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPurchaseVerificationCreditsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPurchaseVerificationCreditsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
+			}
+			m.Cost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			m.Fees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustDeposit", wireType)
+			}
+			m.TrustDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrustDeposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5771,7 +13073,7 @@ func (m *MsgRevokePermissionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateOrUpdatePermissionSession) Unmarshal(dAtA []byte) error {
+func (m *MsgRefundVerificationCredits) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5794,10 +13096,10 @@ func (m *MsgCreateOrUpdatePermissionSession) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateOrUpdatePermissionSession: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRefundVerificationCredits: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateOrUpdatePermissionSession: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRefundVerificationCredits: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5833,10 +13135,10 @@ func (m *MsgCreateOrUpdatePermissionSession) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
+			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5846,67 +13148,66 @@ func (m *MsgCreateOrUpdatePermissionSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuerPermId", wireType)
-			}
-			m.IssuerPermId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.IssuerPermId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefundVerificationCreditsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerifierPermId", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.VerifierPermId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VerifierPermId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefundVerificationCreditsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefundVerificationCreditsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AgentPermId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedCredits", wireType)
 			}
-			m.AgentPermId = 0
+			m.RefundedCredits = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5916,16 +13217,16 @@ func (m *MsgCreateOrUpdatePermissionSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AgentPermId |= uint64(b&0x7F) << shift
+				m.RefundedCredits |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WalletAgentPermId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedTrustDeposit", wireType)
 			}
-			m.WalletAgentPermId = 0
+			m.ReleasedTrustDeposit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5935,7 +13236,7 @@ func (m *MsgCreateOrUpdatePermissionSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WalletAgentPermId |= uint64(b&0x7F) << shift
+				m.ReleasedTrustDeposit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5961,7 +13262,7 @@ func (m *MsgCreateOrUpdatePermissionSession) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateOrUpdatePermissionSessionResponse) Unmarshal(dAtA []byte) error {
+func (m *SignedSessionVoucher) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5984,17 +13285,17 @@ func (m *MsgCreateOrUpdatePermissionSessionResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateOrUpdatePermissionSessionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignedSessionVoucher: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateOrUpdatePermissionSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignedSessionVoucher: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voucher", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6004,23 +13305,92 @@ func (m *MsgCreateOrUpdatePermissionSessionResponse) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			if err := m.Voucher.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6043,7 +13413,7 @@ func (m *MsgCreateOrUpdatePermissionSessionResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *MsgSlashPermissionTrustDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgSettleSessionVouchers) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6066,10 +13436,10 @@ func (m *MsgSlashPermissionTrustDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSlashPermissionTrustDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSettleSessionVouchers: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSlashPermissionTrustDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSettleSessionVouchers: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6105,10 +13475,10 @@ func (m *MsgSlashPermissionTrustDeposit) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vouchers", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6118,30 +13488,26 @@ func (m *MsgSlashPermissionTrustDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.Amount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vouchers = append(m.Vouchers, SignedSessionVoucher{})
+			if err := m.Vouchers[len(m.Vouchers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6163,7 +13529,7 @@ func (m *MsgSlashPermissionTrustDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSlashPermissionTrustDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSettleSessionVouchersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6186,12 +13552,50 @@ func (m *MsgSlashPermissionTrustDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSlashPermissionTrustDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSettleSessionVouchersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSlashPermissionTrustDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSettleSessionVouchersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settled", wireType)
+			}
+			m.Settled = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Settled |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6213,7 +13617,7 @@ func (m *MsgSlashPermissionTrustDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRepayPermissionSlashedTrustDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgAnchorVPMessage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6236,10 +13640,10 @@ func (m *MsgRepayPermissionSlashedTrustDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRepayPermissionSlashedTrustDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAnchorVPMessage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRepayPermissionSlashedTrustDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAnchorVPMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6276,9 +13680,9 @@ func (m *MsgRepayPermissionSlashedTrustDeposit) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PermId", wireType)
 			}
-			m.Id = 0
+			m.PermId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6288,11 +13692,75 @@ func (m *MsgRepayPermissionSlashedTrustDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.PermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DigestSri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DigestSri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6314,7 +13782,7 @@ func (m *MsgRepayPermissionSlashedTrustDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRepayPermissionSlashedTrustDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAnchorVPMessageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6337,12 +13805,31 @@ func (m *MsgRepayPermissionSlashedTrustDepositResponse) Unmarshal(dAtA []byte) e
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRepayPermissionSlashedTrustDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAnchorVPMessageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRepayPermissionSlashedTrustDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAnchorVPMessageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6364,7 +13851,7 @@ func (m *MsgRepayPermissionSlashedTrustDepositResponse) Unmarshal(dAtA []byte) e
 	}
 	return nil
 }
-func (m *MsgCreatePermission) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdatePermissionFees) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6387,10 +13874,10 @@ func (m *MsgCreatePermission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePermission: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdatePermissionFees: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePermission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdatePermissionFees: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6427,9 +13914,9 @@ func (m *MsgCreatePermission) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchemaId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.SchemaId = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6439,16 +13926,16 @@ func (m *MsgCreatePermission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SchemaId |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidationFees", wireType)
 			}
-			m.Type = 0
+			m.ValidationFees = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6458,16 +13945,16 @@ func (m *MsgCreatePermission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= PermissionType(b&0x7F) << shift
+				m.ValidationFees |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceFees", wireType)
 			}
-			var stringLen uint64
+			m.IssuanceFees = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6477,129 +13964,12 @@ func (m *MsgCreatePermission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.IssuanceFees |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Did = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Country", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Country = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveFrom", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.EffectiveFrom == nil {
-				m.EffectiveFrom = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveFrom, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveUntil", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.EffectiveUntil == nil {
-				m.EffectiveUntil = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveUntil, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field VerificationFees", wireType)
 			}
@@ -6639,7 +14009,7 @@ func (m *MsgCreatePermission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePermissionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdatePermissionFeesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6662,17 +14032,17 @@ func (m *MsgCreatePermissionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePermissionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdatePermissionFeesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdatePermissionFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveFrom", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6682,11 +14052,28 @@ func (m *MsgCreatePermissionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EffectiveFrom == nil {
+				m.EffectiveFrom = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveFrom, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
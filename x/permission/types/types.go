@@ -2,12 +2,16 @@ package types
 
 import (
 	"fmt"
+	"net/url"
 	"regexp"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/google/uuid"
+	credentialschematypes "github.com/verana-labs/verana-blockchain/x/credentialschema/types"
+	trustdeposittypes "github.com/verana-labs/verana-blockchain/x/trustdeposit/types"
 )
 
 func (msg *MsgStartPermissionVP) ValidateBasic() error {
@@ -23,25 +27,36 @@ func (msg *MsgStartPermissionVP) ValidateBasic() error {
 		return fmt.Errorf("perm type must be between 1 and 6")
 	}
 
-	if msg.Country == "" {
-		return fmt.Errorf("country must be specified")
+	if len(msg.Countries) == 0 {
+		return fmt.Errorf("countries must be specified")
 	}
 
-	if !isValidCountryCode(msg.Country) {
-		return fmt.Errorf("invalid country code format")
+	if err := ValidateCountryScope(msg.Countries); err != nil {
+		return err
 	}
 
 	if msg.Did != "" && !isValidDID(msg.Did) {
 		return fmt.Errorf("invalid DID format")
 	}
 
-	return nil
-}
+	if len(msg.CoValidatorPermIds) > credentialschematypes.MaxValidationQuorum-1 {
+		return fmt.Errorf("at most %d co-validator perms can be specified", credentialschematypes.MaxValidationQuorum-1)
+	}
+	seen := make(map[uint64]bool, len(msg.CoValidatorPermIds))
+	for _, id := range msg.CoValidatorPermIds {
+		if id == 0 {
+			return fmt.Errorf("co-validator perm ID cannot be 0")
+		}
+		if id == msg.ValidatorPermId {
+			return fmt.Errorf("co-validator perm %d is already the validator perm", id)
+		}
+		if seen[id] {
+			return fmt.Errorf("duplicate co-validator perm ID %d", id)
+		}
+		seen[id] = true
+	}
 
-func isValidCountryCode(code string) bool {
-	// Basic check for ISO 3166-1 alpha-2 format
-	match, _ := regexp.MatchString(`^[A-Z]{2}$`, code)
-	return match
+	return nil
 }
 
 func isValidDID(did string) bool {
@@ -87,9 +102,9 @@ func (msg *MsgSetPermissionVPToValidated) ValidateBasic() error {
 		return fmt.Errorf("verification fees cannot be negative")
 	}
 
-	// Validate country code if provided
-	if msg.Country != "" && !isValidCountryCode(msg.Country) {
-		return fmt.Errorf("invalid country code format")
+	// Validate country scope if provided
+	if err := ValidateCountryScope(msg.Countries); err != nil {
+		return err
 	}
 
 	// Validate digest SRI format if provided (optional)
@@ -191,9 +206,9 @@ func (msg *MsgCreateRootPermission) ValidateBasic() error {
 		return fmt.Errorf("verification fees cannot be negative")
 	}
 
-	// Validate country code if present
-	if msg.Country != "" && !isValidCountryCode(msg.Country) {
-		return fmt.Errorf("invalid country code format")
+	// Validate country scope if present
+	if err := ValidateCountryScope(msg.Countries); err != nil {
+		return err
 	}
 
 	// Validate effective dates if present
@@ -243,6 +258,53 @@ func (msg *MsgRevokePermission) ValidateBasic() error {
 		return fmt.Errorf("perm ID cannot be 0")
 	}
 
+	if _, ok := CascadeMode_name[int32(msg.Cascade)]; !ok {
+		return fmt.Errorf("invalid cascade mode: %d", msg.Cascade)
+	}
+
+	return nil
+}
+
+// MaxSuspensionReasonLength is the maximum length of a suspension reason.
+const MaxSuspensionReasonLength = 512
+
+func (msg *MsgSuspendPermission) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return fmt.Errorf("invalid creator address: %w", err)
+	}
+
+	if msg.Id == 0 {
+		return fmt.Errorf("perm ID cannot be 0")
+	}
+
+	if len(msg.Reason) > MaxSuspensionReasonLength {
+		return fmt.Errorf("reason cannot be longer than %d characters", MaxSuspensionReasonLength)
+	}
+
+	return nil
+}
+
+func (msg *MsgUnsuspendPermission) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return fmt.Errorf("invalid creator address: %w", err)
+	}
+
+	if msg.Id == 0 {
+		return fmt.Errorf("perm ID cannot be 0")
+	}
+
+	return nil
+}
+
+func (msg *MsgClosePermissionSession) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+
+	if _, err := uuid.Parse(msg.Id); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("invalid session ID: must be valid UUID")
+	}
+
 	return nil
 }
 
@@ -266,6 +328,16 @@ func (msg *MsgCreateOrUpdatePermissionSession) ValidateBasic() error {
 		return sdkerrors.ErrInvalidRequest.Wrap("agent perm ID required")
 	}
 
+	// credits are bought for verification sessions and already pay the session fees
+	if msg.CreditId != 0 {
+		if msg.AllowanceId != 0 {
+			return sdkerrors.ErrInvalidRequest.Wrap("credit_id and allowance_id cannot be both set")
+		}
+		if msg.VerifierPermId == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrap("credit_id requires verifier_perm_id")
+		}
+	}
+
 	return nil
 }
 
@@ -282,6 +354,44 @@ func (msg *MsgSlashPermissionTrustDeposit) ValidateBasic() error {
 	if msg.Amount == 0 {
 		return sdkerrors.ErrInvalidRequest.Wrap("amount must be greater than 0")
 	}
+
+	if msg.ReasonCode == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("reason code must be greater than 0")
+	}
+
+	if msg.EvidenceUrl != "" {
+		if _, err := url.ParseRequestURI(msg.EvidenceUrl); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid evidence_url: %s", err)
+		}
+	}
+
+	if msg.EvidenceDigestSri != "" && !isValidDigestSRI(msg.EvidenceDigestSri) {
+		return sdkerrors.ErrInvalidRequest.Wrap("invalid evidence_digest_sri format")
+	}
+
+	if msg.GfVersion < 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("gf_version cannot be negative")
+	}
+
+	if err := trustdeposittypes.ValidateSlashShares(msg.Distribution); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid distribution: %s", err)
+	}
+	return nil
+}
+
+func (msg *MsgSetSlashDistribution) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+
+	if msg.TrId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("trust registry ID cannot be 0")
+	}
+
+	if err := trustdeposittypes.ValidateSlashShares(msg.Distribution); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid distribution: %s", err)
+	}
+
 	return nil
 }
 
@@ -317,5 +427,260 @@ func (msg *MsgCreatePermission) ValidateBasic() error {
 	if !isValidDID(msg.Did) {
 		return sdkerrors.ErrInvalidRequest.Wrap("invalid DID syntax")
 	}
+
+	// countries MUST be valid ISO 3166 codes
+	if err := ValidateCountryScope(msg.Countries); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return nil
+}
+
+// MaxSessionAllowanceCreators bounds the allowed creators of a session allowance
+const MaxSessionAllowanceCreators = 64
+
+func (msg *MsgCreateSessionAllowance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+
+	// exactly one scope must be set
+	scopes := 0
+	for _, id := range []uint64{msg.SchemaId, msg.AgentPermId, msg.VerifierPermId} {
+		if id != 0 {
+			scopes++
+		}
+	}
+	if scopes != 1 {
+		return sdkerrors.ErrInvalidRequest.Wrap("exactly one of schema_id, agent_perm_id or verifier_perm_id must be set")
+	}
+
+	if msg.Amount == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("amount must be greater than 0")
+	}
+
+	// any account can create sessions on a schema, so a schema allowance must
+	// name the creators it pays for
+	if msg.SchemaId != 0 && len(msg.AllowedCreators) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("allowed_creators must be set for a schema scoped allowance")
+	}
+	if err := ValidateAllowedCreators(msg.AllowedCreators); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+// ValidateAllowedCreators checks the allowed creators of a session allowance.
+func ValidateAllowedCreators(creators []string) error {
+	if len(creators) > MaxSessionAllowanceCreators {
+		return fmt.Errorf("at most %d allowed creators can be set", MaxSessionAllowanceCreators)
+	}
+	seen := make(map[string]bool, len(creators))
+	for _, creator := range creators {
+		if _, err := sdk.AccAddressFromBech32(creator); err != nil {
+			return fmt.Errorf("invalid allowed creator address %s: %w", creator, err)
+		}
+		if seen[creator] {
+			return fmt.Errorf("duplicate allowed creator: %s", creator)
+		}
+		seen[creator] = true
+	}
+	return nil
+}
+
+func (msg *MsgFundSessionAllowance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+
+	if msg.Id == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("allowance ID cannot be 0")
+	}
+
+	if msg.Amount == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("amount must be greater than 0")
+	}
+
+	return nil
+}
+
+func (msg *MsgWithdrawSessionAllowance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+
+	if msg.Id == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("allowance ID cannot be 0")
+	}
+
+	return nil
+}
+
+// MaxVerificationCreditsPerPurchase bounds the credits bought in a single message
+const MaxVerificationCreditsPerPurchase = 1_000_000
+
+func (msg *MsgPurchaseVerificationCredits) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+
+	if msg.VerifierPermId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("verifier perm ID cannot be 0")
+	}
+
+	if msg.Count == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("count must be greater than 0")
+	}
+	if msg.Count > MaxVerificationCreditsPerPurchase {
+		return sdkerrors.ErrInvalidRequest.Wrapf("count cannot exceed %d", MaxVerificationCreditsPerPurchase)
+	}
+
+	return nil
+}
+
+func (msg *MsgRefundVerificationCredits) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+
+	if msg.Id == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("verification credit ID cannot be 0")
+	}
+
+	return nil
+}
+
+// MaxVouchersPerSettlement bounds the session vouchers settled in a single message
+const MaxVouchersPerSettlement = 500
+
+// VoucherNonceWindow is how far below the highest nonce settled for a signer a
+// voucher nonce is still accepted. Vouchers can be settled out of order within
+// the window, each nonce once.
+const VoucherNonceWindow = 4096
+
+// SignBytes returns the bytes the signer of the voucher signs for chainID.
+func (v SessionVoucher) SignBytes(chainID string) ([]byte, error) {
+	doc := SessionVoucherSignDoc{
+		ChainId: chainID,
+		Voucher: v,
+	}
+	return doc.Marshal()
+}
+
+func (msg *MsgSettleSessionVouchers) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+
+	if len(msg.Vouchers) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("vouchers cannot be empty")
+	}
+	if len(msg.Vouchers) > MaxVouchersPerSettlement {
+		return sdkerrors.ErrInvalidRequest.Wrapf("cannot settle more than %d vouchers", MaxVouchersPerSettlement)
+	}
+
+	for i, signed := range msg.Vouchers {
+		voucher := signed.Voucher
+		if _, err := sdk.AccAddressFromBech32(voucher.Signer); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid signer address of voucher %d: %s", i, err)
+		}
+		if voucher.BeneficiaryPermId == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrapf("verifier perm ID of voucher %d cannot be 0", i)
+		}
+		if voucher.AgentPermId == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrapf("agent perm ID of voucher %d cannot be 0", i)
+		}
+		if voucher.Nonce == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrapf("nonce of voucher %d must be greater than 0", i)
+		}
+		if len(signed.PubKey) != secp256k1.PubKeySize {
+			return sdkerrors.ErrInvalidPubKey.Wrapf("public key of voucher %d must be a compressed secp256k1 key", i)
+		}
+		if len(signed.Signature) == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrapf("signature of voucher %d cannot be empty", i)
+		}
+	}
+
+	return nil
+}
+
+const (
+	// MaxVPMessagesPerPerm bounds the messages anchored for the validation process of a perm
+	MaxVPMessagesPerPerm = 1000
+	// MaxVPMessageDigestSRILength bounds the length of the digest SRI of a VP message
+	MaxVPMessageDigestSRILength = 256
+	// MaxVPMessageURILength bounds the length of the encrypted URI of a VP message
+	MaxVPMessageURILength = 2048
+)
+
+func (msg *MsgAnchorVPMessage) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+
+	if msg.PermId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("perm ID cannot be 0")
+	}
+
+	if msg.DigestSri == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("digest_sri is mandatory")
+	}
+	if len(msg.DigestSri) > MaxVPMessageDigestSRILength {
+		return sdkerrors.ErrInvalidRequest.Wrapf("digest_sri cannot be longer than %d characters", MaxVPMessageDigestSRILength)
+	}
+	if !isValidDigestSRI(msg.DigestSri) {
+		return sdkerrors.ErrInvalidRequest.Wrap("invalid digest_sri format")
+	}
+
+	if msg.EncryptedUri == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("encrypted_uri is mandatory")
+	}
+	if len(msg.EncryptedUri) > MaxVPMessageURILength {
+		return sdkerrors.ErrInvalidRequest.Wrapf("encrypted_uri cannot be longer than %d characters", MaxVPMessageURILength)
+	}
+	if _, err := url.ParseRequestURI(msg.EncryptedUri); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid encrypted_uri: %s", err)
+	}
+
+	return nil
+}
+
+func (msg *MsgUpdatePermissionFees) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+
+	if msg.Id == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("perm ID cannot be 0")
+	}
+
+	return nil
+}
+
+func (msg *MsgAppealSlash) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+
+	if msg.Id == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("slash ID cannot be 0")
+	}
+
+	if !isValidDigestSRI(msg.EvidenceDigestSri) {
+		return sdkerrors.ErrInvalidRequest.Wrap("invalid evidence_digest_sri format")
+	}
+
+	return nil
+}
+
+func (msg *MsgResolveSlashAppeal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+
+	if msg.Id == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("slash ID cannot be 0")
+	}
+
 	return nil
 }
@@ -10,6 +10,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	types "github.com/verana-labs/verana-blockchain/x/trustdeposit/types"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -105,6 +106,78 @@ func (ValidationState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_fd0a3e5374928bd3, []int{1}
 }
 
+// Slash record states
+type SlashStatus int32
+
+const (
+	SlashStatus_SLASH_STATUS_UNSPECIFIED SlashStatus = 0
+	// the slashed amount is escrowed and the grantee can still appeal
+	SlashStatus_SLASH_STATUS_PENDING SlashStatus = 1
+	// the grantee appealed, the slash awaits resolution
+	SlashStatus_SLASH_STATUS_APPEALED SlashStatus = 2
+	// the slash was executed and the escrowed amount burned
+	SlashStatus_SLASH_STATUS_EXECUTED SlashStatus = 3
+	// the slash was reversed and the escrowed amount returned to the deposit
+	SlashStatus_SLASH_STATUS_REVERSED SlashStatus = 4
+)
+
+var SlashStatus_name = map[int32]string{
+	0: "SLASH_STATUS_UNSPECIFIED",
+	1: "SLASH_STATUS_PENDING",
+	2: "SLASH_STATUS_APPEALED",
+	3: "SLASH_STATUS_EXECUTED",
+	4: "SLASH_STATUS_REVERSED",
+}
+
+var SlashStatus_value = map[string]int32{
+	"SLASH_STATUS_UNSPECIFIED": 0,
+	"SLASH_STATUS_PENDING":     1,
+	"SLASH_STATUS_APPEALED":    2,
+	"SLASH_STATUS_EXECUTED":    3,
+	"SLASH_STATUS_REVERSED":    4,
+}
+
+func (x SlashStatus) String() string {
+	return proto.EnumName(SlashStatus_name, int32(x))
+}
+
+func (SlashStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fd0a3e5374928bd3, []int{2}
+}
+
+// What a revocation does to the perms validated, directly or not, by the
+// revoked perm
+type CascadeMode int32
+
+const (
+	// only the revoked perm is affected
+	CascadeMode_CASCADE_MODE_NONE CascadeMode = 0
+	// every descendant perm is revoked
+	CascadeMode_CASCADE_MODE_REVOKE CascadeMode = 1
+	// every descendant perm is suspended
+	CascadeMode_CASCADE_MODE_SUSPEND CascadeMode = 2
+)
+
+var CascadeMode_name = map[int32]string{
+	0: "CASCADE_MODE_NONE",
+	1: "CASCADE_MODE_REVOKE",
+	2: "CASCADE_MODE_SUSPEND",
+}
+
+var CascadeMode_value = map[string]int32{
+	"CASCADE_MODE_NONE":    0,
+	"CASCADE_MODE_REVOKE":  1,
+	"CASCADE_MODE_SUSPEND": 2,
+}
+
+func (x CascadeMode) String() string {
+	return proto.EnumName(CascadeMode_name, int32(x))
+}
+
+func (CascadeMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fd0a3e5374928bd3, []int{3}
+}
+
 type Permission struct {
 	Id         uint64         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	SchemaId   uint64         `protobuf:"varint,2,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
@@ -132,11 +205,13 @@ type Permission struct {
 	SlashedDeposit uint64 `protobuf:"varint,21,opt,name=slashed_deposit,json=slashedDeposit,proto3" json:"slashed_deposit,omitempty"`
 	RepaidDeposit  uint64 `protobuf:"varint,22,opt,name=repaid_deposit,json=repaidDeposit,proto3" json:"repaid_deposit,omitempty"`
 	// END NEW
-	Revoked            *time.Time      `protobuf:"bytes,23,opt,name=revoked,proto3,stdtime" json:"revoked,omitempty"`
-	RevokedBy          string          `protobuf:"bytes,24,opt,name=revoked_by,json=revokedBy,proto3" json:"revoked_by,omitempty"`
-	Terminated         *time.Time      `protobuf:"bytes,25,opt,name=terminated,proto3,stdtime" json:"terminated,omitempty"`
-	TerminatedBy       string          `protobuf:"bytes,26,opt,name=terminated_by,json=terminatedBy,proto3" json:"terminated_by,omitempty"`
-	Country            string          `protobuf:"bytes,27,opt,name=country,proto3" json:"country,omitempty"`
+	Revoked      *time.Time `protobuf:"bytes,23,opt,name=revoked,proto3,stdtime" json:"revoked,omitempty"`
+	RevokedBy    string     `protobuf:"bytes,24,opt,name=revoked_by,json=revokedBy,proto3" json:"revoked_by,omitempty"`
+	Terminated   *time.Time `protobuf:"bytes,25,opt,name=terminated,proto3,stdtime" json:"terminated,omitempty"`
+	TerminatedBy string     `protobuf:"bytes,26,opt,name=terminated_by,json=terminatedBy,proto3" json:"terminated_by,omitempty"`
+	// countries is the ISO 3166 scope of the perm: ISO 3166-1 alpha-2 country
+	// codes or ISO 3166-2 subdivision codes. An empty scope is worldwide.
+	Countries          []string        `protobuf:"bytes,27,rep,name=countries,proto3" json:"countries,omitempty"`
 	ValidatorPermId    uint64          `protobuf:"varint,28,opt,name=validator_perm_id,json=validatorPermId,proto3" json:"validator_perm_id,omitempty"`
 	VpState            ValidationState `protobuf:"varint,29,opt,name=vp_state,json=vpState,proto3,enum=verana.perm.v1.ValidationState" json:"vp_state,omitempty"`
 	VpExp              *time.Time      `protobuf:"bytes,30,opt,name=vp_exp,json=vpExp,proto3,stdtime" json:"vp_exp,omitempty"`
@@ -146,6 +221,25 @@ type Permission struct {
 	VpCurrentDeposit   uint64          `protobuf:"varint,34,opt,name=vp_current_deposit,json=vpCurrentDeposit,proto3" json:"vp_current_deposit,omitempty"`
 	VpSummaryDigestSri string          `protobuf:"bytes,35,opt,name=vp_summary_digest_sri,json=vpSummaryDigestSri,proto3" json:"vp_summary_digest_sri,omitempty"`
 	VpTermRequested    *time.Time      `protobuf:"bytes,36,opt,name=vp_term_requested,json=vpTermRequested,proto3,stdtime" json:"vp_term_requested,omitempty"`
+	Suspended          *time.Time      `protobuf:"bytes,37,opt,name=suspended,proto3,stdtime" json:"suspended,omitempty"`
+	SuspendedBy        string          `protobuf:"bytes,38,opt,name=suspended_by,json=suspendedBy,proto3" json:"suspended_by,omitempty"`
+	SuspensionReason   string          `protobuf:"bytes,39,opt,name=suspension_reason,json=suspensionReason,proto3" json:"suspension_reason,omitempty"`
+	// suspended_until, if set, is the time the suspension ends by itself
+	SuspendedUntil *time.Time `protobuf:"bytes,40,opt,name=suspended_until,json=suspendedUntil,proto3,stdtime" json:"suspended_until,omitempty"`
+	// co_validator_perm_ids are the validator perms that, with validator_perm_id,
+	// validate the perm when the credential schema sets a validation quorum
+	CoValidatorPermIds []uint64 `protobuf:"varint,41,rep,packed,name=co_validator_perm_ids,json=coValidatorPermIds,proto3" json:"co_validator_perm_ids,omitempty"`
+	// vp_quorum is the number of validator approvals the validation process
+	// requires, 0 or 1 for a single validator
+	VpQuorum uint32 `protobuf:"varint,42,opt,name=vp_quorum,json=vpQuorum,proto3" json:"vp_quorum,omitempty"`
+	// vp_approvals are the approvals recorded for the pending validation
+	VpApprovals []VPApproval `protobuf:"bytes,43,rep,name=vp_approvals,json=vpApprovals,proto3" json:"vp_approvals"`
+	// vp_co_validator_deposits are the trust deposits of the co-validators, the
+	// share of validator_perm_id is vp_validator_deposit
+	VpCoValidatorDeposits []CoValidatorDeposit `protobuf:"bytes,44,rep,name=vp_co_validator_deposits,json=vpCoValidatorDeposits,proto3" json:"vp_co_validator_deposits"`
+	// scheduled_fees are the fees set by the grantee, which replace the current
+	// fees at the end of the notice period
+	ScheduledFees *PermissionFees `protobuf:"bytes,45,opt,name=scheduled_fees,json=scheduledFees,proto3" json:"scheduled_fees,omitempty"`
 }
 
 func (m *Permission) Reset()         { *m = Permission{} }
@@ -363,11 +457,11 @@ func (m *Permission) GetTerminatedBy() string {
 	return ""
 }
 
-func (m *Permission) GetCountry() string {
+func (m *Permission) GetCountries() []string {
 	if m != nil {
-		return m.Country
+		return m.Countries
 	}
-	return ""
+	return nil
 }
 
 func (m *Permission) GetValidatorPermId() uint64 {
@@ -433,6 +527,69 @@ func (m *Permission) GetVpTermRequested() *time.Time {
 	return nil
 }
 
+func (m *Permission) GetSuspended() *time.Time {
+	if m != nil {
+		return m.Suspended
+	}
+	return nil
+}
+
+func (m *Permission) GetSuspendedBy() string {
+	if m != nil {
+		return m.SuspendedBy
+	}
+	return ""
+}
+
+func (m *Permission) GetSuspensionReason() string {
+	if m != nil {
+		return m.SuspensionReason
+	}
+	return ""
+}
+
+func (m *Permission) GetSuspendedUntil() *time.Time {
+	if m != nil {
+		return m.SuspendedUntil
+	}
+	return nil
+}
+
+func (m *Permission) GetCoValidatorPermIds() []uint64 {
+	if m != nil {
+		return m.CoValidatorPermIds
+	}
+	return nil
+}
+
+func (m *Permission) GetVpQuorum() uint32 {
+	if m != nil {
+		return m.VpQuorum
+	}
+	return 0
+}
+
+func (m *Permission) GetVpApprovals() []VPApproval {
+	if m != nil {
+		return m.VpApprovals
+	}
+	return nil
+}
+
+func (m *Permission) GetVpCoValidatorDeposits() []CoValidatorDeposit {
+	if m != nil {
+		return m.VpCoValidatorDeposits
+	}
+	return nil
+}
+
+func (m *Permission) GetScheduledFees() *PermissionFees {
+	if m != nil {
+		return m.ScheduledFees
+	}
+	return nil
+}
+
 type PermissionSession struct {
 	Id          string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Controller  string          `protobuf:"bytes,2,opt,name=controller,proto3" json:"controller,omitempty"`
@@ -440,6 +597,9 @@ type PermissionSession struct {
 	Authz       []*SessionAuthz `protobuf:"bytes,4,rep,name=authz,proto3" json:"authz,omitempty"`
 	Created     *time.Time      `protobuf:"bytes,5,opt,name=created,proto3,stdtime" json:"created,omitempty"`
 	Modified    *time.Time      `protobuf:"bytes,6,opt,name=modified,proto3,stdtime" json:"modified,omitempty"`
+	// expires is set when the session is created, the session can no longer be
+	// updated after it and is pruned
+	Expires *time.Time `protobuf:"bytes,7,opt,name=expires,proto3,stdtime" json:"expires,omitempty"`
 }
 
 func (m *PermissionSession) Reset()         { *m = PermissionSession{} }
@@ -517,6 +677,13 @@ func (m *PermissionSession) GetModified() *time.Time {
 	return nil
 }
 
+func (m *PermissionSession) GetExpires() *time.Time {
+	if m != nil {
+		return m.Expires
+	}
+	return nil
+}
+
 type SessionAuthz struct {
 	ExecutorPermId    uint64 `protobuf:"varint,1,opt,name=executor_perm_id,json=executorPermId,proto3" json:"executor_perm_id,omitempty"`
 	BeneficiaryPermId uint64 `protobuf:"varint,2,opt,name=beneficiary_perm_id,json=beneficiaryPermId,proto3" json:"beneficiary_perm_id,omitempty"`
@@ -577,746 +744,6782 @@ func (m *SessionAuthz) GetWalletAgentPermId() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("verana.perm.v1.PermissionType", PermissionType_name, PermissionType_value)
-	proto.RegisterEnum("verana.perm.v1.ValidationState", ValidationState_name, ValidationState_value)
-	proto.RegisterType((*Permission)(nil), "verana.perm.v1.Permission")
-	proto.RegisterType((*PermissionSession)(nil), "verana.perm.v1.PermissionSession")
-	proto.RegisterType((*SessionAuthz)(nil), "verana.perm.v1.SessionAuthz")
+// SessionAllowance is a pre-funded balance a sponsor sets aside to pay the
+// fees of perm sessions. It is scoped to exactly one of a credential schema,
+// an agent perm or a verifier perm, and can further be restricted to some
+// session creators and to a maximum amount per session.
+type SessionAllowance struct {
+	Id             uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sponsor        string `protobuf:"bytes,2,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	SchemaId       uint64 `protobuf:"varint,3,opt,name=schema_id,json=schemaId,proto3" json:"schema_id,omitempty"`
+	AgentPermId    uint64 `protobuf:"varint,4,opt,name=agent_perm_id,json=agentPermId,proto3" json:"agent_perm_id,omitempty"`
+	VerifierPermId uint64 `protobuf:"varint,5,opt,name=verifier_perm_id,json=verifierPermId,proto3" json:"verifier_perm_id,omitempty"`
+	// remaining is the amount, in uvna, still available to pay session fees
+	Remaining uint64 `protobuf:"varint,6,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	// spent is the amount, in uvna, already drawn to pay session fees
+	Spent    uint64     `protobuf:"varint,7,opt,name=spent,proto3" json:"spent,omitempty"`
+	Created  *time.Time `protobuf:"bytes,8,opt,name=created,proto3,stdtime" json:"created,omitempty"`
+	Modified *time.Time `protobuf:"bytes,9,opt,name=modified,proto3,stdtime" json:"modified,omitempty"`
+	// allowed_creators are the accounts that can create sessions paid by the
+	// allowance, any account if empty
+	AllowedCreators []string `protobuf:"bytes,10,rep,name=allowed_creators,json=allowedCreators,proto3" json:"allowed_creators,omitempty"`
+	// max_per_session is the maximum amount, in uvna, drawn for a single
+	// session, no maximum if 0
+	MaxPerSession uint64 `protobuf:"varint,11,opt,name=max_per_session,json=maxPerSession,proto3" json:"max_per_session,omitempty"`
 }
 
-func init() { proto.RegisterFile("verana/perm/v1/types.proto", fileDescriptor_fd0a3e5374928bd3) }
+func (m *SessionAllowance) Reset()         { *m = SessionAllowance{} }
+func (m *SessionAllowance) String() string { return proto.CompactTextString(m) }
+func (*SessionAllowance) ProtoMessage()    {}
+func (*SessionAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd0a3e5374928bd3, []int{3}
+}
+func (m *SessionAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SessionAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SessionAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SessionAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionAllowance.Merge(m, src)
+}
+func (m *SessionAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *SessionAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionAllowance.DiscardUnknown(m)
+}
 
-var fileDescriptor_fd0a3e5374928bd3 = []byte{
-	// 1235 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xc0, 0xb3, 0x89, 0xf3, 0xc7, 0x2f, 0x89, 0xbd, 0x9e, 0xa6, 0x65, 0xea, 0xa6, 0x8e, 0x49,
-	0x4b, 0x89, 0x02, 0xb5, 0x69, 0x10, 0x2a, 0x14, 0x90, 0xb0, 0xe3, 0x6d, 0xb1, 0x94, 0x26, 0xe9,
-	0xae, 0x13, 0xa9, 0x5c, 0x56, 0xeb, 0xdd, 0xb1, 0x33, 0xaa, 0xf7, 0x0f, 0xb3, 0xeb, 0x25, 0xe6,
-	0x53, 0xf4, 0xc2, 0x81, 0xef, 0xc1, 0x99, 0x33, 0x12, 0x97, 0x8a, 0x13, 0x37, 0x50, 0xfb, 0x05,
-	0xf8, 0x08, 0x68, 0x66, 0x76, 0x62, 0xd7, 0x6d, 0x15, 0xf7, 0x62, 0xcd, 0xbc, 0xf7, 0x7b, 0xff,
-	0x66, 0x9e, 0xdf, 0x2c, 0x94, 0x53, 0xc2, 0x9c, 0xc0, 0xa9, 0x47, 0x84, 0xf9, 0xf5, 0xf4, 0x5e,
-	0x3d, 0x19, 0x45, 0x24, 0xae, 0x45, 0x2c, 0x4c, 0x42, 0x54, 0x90, 0xba, 0x1a, 0xd7, 0xd5, 0xd2,
-	0x7b, 0xe5, 0x92, 0xe3, 0xd3, 0x20, 0xac, 0x8b, 0x5f, 0x89, 0x94, 0xaf, 0xbb, 0x61, 0xec, 0x87,
-	0xb1, 0x2d, 0x76, 0x75, 0xb9, 0xc9, 0x54, 0x1b, 0xfd, 0xb0, 0x1f, 0x4a, 0x39, 0x5f, 0x65, 0xd2,
-	0xad, 0x7e, 0x18, 0xf6, 0x07, 0xa4, 0x2e, 0x76, 0xdd, 0x61, 0xaf, 0x9e, 0x50, 0x9f, 0xc4, 0x89,
-	0xe3, 0x47, 0x12, 0xd8, 0xfe, 0xb3, 0x00, 0x70, 0x4c, 0x98, 0x4f, 0xe3, 0x98, 0x86, 0x01, 0x2a,
-	0xc0, 0x3c, 0xf5, 0xb0, 0x56, 0xd5, 0x76, 0x72, 0xe6, 0x3c, 0xf5, 0xd0, 0x0d, 0xc8, 0xc7, 0xee,
-	0x19, 0xf1, 0x1d, 0x9b, 0x7a, 0x78, 0x5e, 0x88, 0x57, 0xa4, 0xa0, 0xed, 0xa1, 0x3d, 0xc8, 0xf1,
-	0xfc, 0xf1, 0x42, 0x55, 0xdb, 0x29, 0xec, 0x55, 0x6a, 0xaf, 0xe7, 0x5f, 0x1b, 0xbb, 0xed, 0x8c,
-	0x22, 0x62, 0x0a, 0x16, 0xe9, 0xb0, 0xe0, 0x51, 0x0f, 0xe7, 0xaa, 0xda, 0x4e, 0xde, 0xe4, 0x4b,
-	0xb4, 0x07, 0xcb, 0x7d, 0xe6, 0x04, 0x09, 0x21, 0x78, 0x91, 0x4b, 0x9b, 0xf8, 0xaf, 0xdf, 0xee,
-	0x6e, 0x64, 0xb5, 0x35, 0x3c, 0x8f, 0x91, 0x38, 0xb6, 0x12, 0x46, 0x83, 0xbe, 0xa9, 0x40, 0xf4,
-	0x00, 0x96, 0x5d, 0x46, 0x9c, 0x84, 0x78, 0x78, 0xa9, 0xaa, 0xed, 0xac, 0xee, 0x95, 0x6b, 0xb2,
-	0xd0, 0x9a, 0x2a, 0xb4, 0xd6, 0x51, 0x85, 0x36, 0x73, 0xcf, 0xff, 0xd9, 0xd2, 0x4c, 0x65, 0x80,
-	0xee, 0x03, 0x64, 0x4b, 0xbb, 0x3b, 0xc2, 0xcb, 0x97, 0x84, 0xcc, 0x67, 0x6c, 0x73, 0x84, 0xbe,
-	0x81, 0x15, 0x72, 0x9e, 0x90, 0xc0, 0x23, 0x1e, 0x5e, 0x99, 0x31, 0xea, 0x85, 0x05, 0xfa, 0x0a,
-	0x56, 0xd5, 0x9a, 0xc7, 0xcd, 0x5f, 0x12, 0x17, 0x14, 0xdc, 0x1c, 0xf1, 0x6a, 0xe3, 0x81, 0x13,
-	0x9f, 0x11, 0x0f, 0xc3, 0xac, 0xd5, 0x66, 0x06, 0xbc, 0xda, 0x6c, 0xc9, 0xa3, 0xae, 0x5e, 0x56,
-	0x6d, 0xc6, 0x36, 0x47, 0xe8, 0x4b, 0x58, 0x62, 0x24, 0x72, 0xa8, 0x87, 0xd7, 0x66, 0x8c, 0x99,
-	0xf1, 0xe8, 0x0b, 0xc8, 0xcb, 0x15, 0x8f, 0xb8, 0x7e, 0x49, 0xc4, 0x15, 0x89, 0x36, 0x47, 0xe8,
-	0x11, 0x14, 0x48, 0xaf, 0x47, 0xdc, 0x84, 0xa6, 0xc4, 0xee, 0xb1, 0xd0, 0xc7, 0x85, 0x19, 0x03,
-	0xaf, 0x5f, 0xd8, 0x3d, 0x64, 0xa1, 0x8f, 0xda, 0x50, 0x1c, 0x3b, 0x1a, 0x06, 0x09, 0x1d, 0xe0,
-	0xe2, 0x8c, 0x9e, 0xc6, 0x19, 0x9c, 0x70, 0x3b, 0x7e, 0xe5, 0x7e, 0xe8, 0xd1, 0x1e, 0x25, 0x1e,
-	0xd6, 0x67, 0xbd, 0x72, 0x65, 0x81, 0x3e, 0x86, 0x62, 0xea, 0x0c, 0xa8, 0xe7, 0x24, 0x34, 0x0c,
-	0xec, 0x1e, 0x21, 0x31, 0x2e, 0x89, 0xbf, 0x50, 0x61, 0x2c, 0x7e, 0x48, 0x48, 0x8c, 0x6e, 0xc1,
-	0x3a, 0x8d, 0xe3, 0xa1, 0x13, 0xb8, 0x44, 0x62, 0x48, 0x60, 0x6b, 0x4a, 0x28, 0xa0, 0x4f, 0xa0,
-	0x94, 0x12, 0x46, 0x7b, 0xd4, 0x9d, 0xf0, 0x77, 0x45, 0x80, 0xfa, 0xa4, 0x42, 0xc0, 0x18, 0x96,
-	0x3d, 0x12, 0x85, 0x31, 0x4d, 0xf0, 0x86, 0x40, 0xd4, 0x96, 0x27, 0xa5, 0x1a, 0x42, 0x11, 0x57,
-	0x65, 0x52, 0x99, 0xb8, 0x95, 0x81, 0x1f, 0x41, 0x21, 0xbb, 0x46, 0xc5, 0x5d, 0x13, 0xdc, 0xba,
-	0x94, 0x2a, 0xec, 0x01, 0x2c, 0x33, 0x92, 0x86, 0xcf, 0x88, 0x87, 0x3f, 0x98, 0xb5, 0x39, 0x33,
-	0x03, 0xde, 0x9c, 0xd9, 0x92, 0xb7, 0x0a, 0xbe, 0xac, 0x39, 0x33, 0xb6, 0x39, 0x42, 0xdf, 0x01,
-	0x24, 0x7c, 0xba, 0x04, 0x62, 0x04, 0x5c, 0x9f, 0x31, 0xee, 0x84, 0x0d, 0xfa, 0x16, 0xd6, 0xc7,
-	0x3b, 0x1e, 0xbd, 0x7c, 0x49, 0xf4, 0xb5, 0x31, 0xde, 0x1c, 0xf1, 0xf3, 0x75, 0xc3, 0x61, 0x90,
-	0xb0, 0x11, 0xbe, 0x21, 0x46, 0x99, 0xda, 0xa2, 0x5d, 0x28, 0x65, 0xb7, 0x1b, 0x32, 0x9b, 0x8f,
-	0x42, 0x3e, 0x39, 0x37, 0xc5, 0xc9, 0x15, 0x2f, 0x14, 0x7c, 0x34, 0xb6, 0x3d, 0xf4, 0x00, 0x56,
-	0xd2, 0xc8, 0x8e, 0x13, 0x27, 0x21, 0xf8, 0xa6, 0x18, 0xa2, 0x5b, 0xd3, 0x43, 0xf4, 0xf4, 0xa2,
-	0x53, 0x2c, 0x8e, 0x99, 0xcb, 0x69, 0x24, 0x16, 0xe8, 0x3e, 0x2c, 0xa5, 0x91, 0x4d, 0xce, 0x23,
-	0x5c, 0x99, 0xb1, 0xfc, 0xc5, 0x34, 0x32, 0xce, 0x23, 0xf4, 0x04, 0x36, 0xd2, 0xc8, 0x1e, 0x38,
-	0x71, 0x22, 0x23, 0xdb, 0xee, 0x99, 0x13, 0xf4, 0x09, 0xde, 0x9a, 0xd1, 0x4d, 0x29, 0x8d, 0x0e,
-	0x9c, 0x38, 0x11, 0x59, 0xec, 0x0b, 0x53, 0xf4, 0x99, 0x70, 0x39, 0x2e, 0x5b, 0x35, 0x4c, 0x55,
-	0x94, 0x8d, 0xd2, 0xe8, 0x54, 0xa9, 0x54, 0xd7, 0xdc, 0x81, 0x62, 0x1a, 0xd9, 0xee, 0x90, 0x31,
-	0x12, 0x24, 0xb2, 0x95, 0x3f, 0x94, 0xdd, 0x95, 0x46, 0xfb, 0x52, 0x2a, 0xfa, 0xf8, 0x53, 0x40,
-	0x13, 0x9c, 0xf2, 0xbb, 0x9d, 0x75, 0xbd, 0x42, 0x95, 0xd7, 0x7b, 0x70, 0x95, 0x9f, 0xe7, 0xd0,
-	0xf7, 0x1d, 0x36, 0xb2, 0x3d, 0xda, 0x27, 0xbc, 0x48, 0x46, 0xf1, 0x2d, 0x71, 0x47, 0x28, 0x8d,
-	0x2c, 0xa9, 0x6b, 0x09, 0x95, 0xc5, 0x28, 0x3a, 0x80, 0x52, 0x1a, 0xd9, 0xfc, 0x6e, 0x6d, 0x46,
-	0x7e, 0x1c, 0x92, 0x98, 0x37, 0xd4, 0xed, 0x19, 0x8f, 0xa2, 0x98, 0x46, 0x1d, 0xc2, 0x7c, 0x53,
-	0x19, 0x6e, 0xff, 0x32, 0x0f, 0xa5, 0xf1, 0xb3, 0x67, 0x91, 0xe9, 0x47, 0x35, 0x2f, 0x1e, 0xd5,
-	0x0a, 0x80, 0x1b, 0x06, 0x09, 0x0b, 0x07, 0x03, 0xc2, 0xc4, 0xab, 0x9a, 0x37, 0x27, 0x24, 0x68,
-	0x1b, 0xd6, 0x9d, 0x3e, 0xaf, 0x57, 0xb5, 0xcf, 0x82, 0xa8, 0x77, 0x55, 0x08, 0xb3, 0xd6, 0xd9,
-	0x83, 0x45, 0x67, 0x98, 0x9c, 0xfd, 0x8c, 0x73, 0xd5, 0x85, 0x9d, 0xd5, 0xbd, 0xcd, 0xe9, 0xbe,
-	0xc9, 0x62, 0x37, 0x38, 0x63, 0x4a, 0x74, 0xf2, 0xd5, 0x5c, 0x7c, 0xdf, 0x57, 0x73, 0x72, 0x12,
-	0x2e, 0xbd, 0xef, 0x24, 0xdc, 0xfe, 0x55, 0x83, 0xb5, 0xc9, 0x8c, 0xd0, 0x0e, 0xe8, 0xe4, 0x9c,
-	0xb8, 0xc3, 0xc9, 0x3f, 0x89, 0xfc, 0xea, 0x28, 0x28, 0x79, 0x56, 0x68, 0x0d, 0xae, 0x74, 0x49,
-	0x40, 0x7a, 0xd4, 0xa5, 0xfc, 0x52, 0x15, 0x2c, 0xbf, 0x45, 0x4a, 0x13, 0xaa, 0x8c, 0xaf, 0xc3,
-	0xc6, 0x4f, 0xce, 0x60, 0x40, 0x12, 0xfb, 0x6d, 0x67, 0x58, 0x92, 0xba, 0xc6, 0xf8, 0x24, 0x77,
-	0xff, 0xd3, 0xa0, 0xf0, 0xfa, 0xa7, 0x0a, 0xda, 0x82, 0x1b, 0xc7, 0x86, 0xf9, 0xb8, 0x6d, 0x59,
-	0xed, 0xa3, 0x43, 0xbb, 0xf3, 0xf4, 0xd8, 0xb0, 0x4f, 0x0e, 0xad, 0x63, 0x63, 0xbf, 0xfd, 0xb0,
-	0x6d, 0xb4, 0xf4, 0x39, 0x54, 0x86, 0x6b, 0xd3, 0x40, 0xdb, 0xb2, 0x4e, 0x0c, 0x53, 0xd7, 0xd0,
-	0x26, 0xe0, 0x69, 0xdd, 0xa9, 0x61, 0x72, 0x4b, 0x53, 0x9f, 0x47, 0xdb, 0x50, 0x79, 0xbb, 0xa5,
-	0xfd, 0xc8, 0x6c, 0x1c, 0x76, 0x8e, 0x4c, 0x7d, 0x01, 0xdd, 0x86, 0xea, 0xbb, 0x3c, 0x5c, 0x50,
-	0x39, 0x74, 0x13, 0xae, 0x4f, 0x53, 0xc6, 0xfe, 0x91, 0xf5, 0xd4, 0xea, 0x18, 0x8f, 0xf5, 0xc5,
-	0xb7, 0xa5, 0xf8, 0xfd, 0xd1, 0x41, 0xcb, 0x30, 0xf5, 0xa5, 0xdd, 0xdf, 0x35, 0x28, 0x4e, 0x0d,
-	0x16, 0x54, 0x85, 0xcd, 0xd3, 0xc6, 0x41, 0xbb, 0xd5, 0xe8, 0x70, 0xde, 0xea, 0x34, 0x3a, 0xd3,
-	0x45, 0x6f, 0x02, 0x7e, 0x83, 0x38, 0x36, 0x0e, 0x5b, 0xed, 0xc3, 0x47, 0xba, 0x86, 0x2a, 0x50,
-	0x7e, 0x43, 0x9b, 0x09, 0x8c, 0x96, 0x3e, 0xcf, 0xcf, 0xf4, 0x0d, 0x7d, 0x87, 0x27, 0x78, 0x28,
-	0x80, 0x05, 0xb4, 0x0b, 0x77, 0xde, 0x09, 0x70, 0x89, 0x69, 0x3c, 0x39, 0x31, 0x2c, 0xce, 0xe6,
-	0x9a, 0x27, 0x7f, 0xbc, 0xac, 0x68, 0x2f, 0x5e, 0x56, 0xb4, 0x7f, 0x5f, 0x56, 0xb4, 0xe7, 0xaf,
-	0x2a, 0x73, 0x2f, 0x5e, 0x55, 0xe6, 0xfe, 0x7e, 0x55, 0x99, 0xfb, 0xe1, 0xeb, 0x3e, 0x4d, 0xce,
-	0x86, 0xdd, 0x9a, 0x1b, 0xfa, 0x75, 0xf9, 0x97, 0xb8, 0x3b, 0x70, 0xba, 0xb1, 0x5a, 0x77, 0x07,
-	0xa1, 0xfb, 0xcc, 0x3d, 0x73, 0x68, 0x50, 0x3f, 0x17, 0x1f, 0xe1, 0xf2, 0xd6, 0xe5, 0x77, 0x78,
-	0x77, 0x49, 0xb4, 0xf2, 0xe7, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x16, 0x7e, 0xe6, 0x08, 0xa6,
-	0x0b, 0x00, 0x00,
+var xxx_messageInfo_SessionAllowance proto.InternalMessageInfo
+
+func (m *SessionAllowance) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *Permission) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SessionAllowance) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Permission) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SessionAllowance) GetSchemaId() uint64 {
+	if m != nil {
+		return m.SchemaId
+	}
+	return 0
 }
 
-func (m *Permission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VpTermRequested != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VpTermRequested, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VpTermRequested):])
-		if err1 != nil {
-			return 0, err1
-		}
-		i -= n1
-		i = encodeVarintTypes(dAtA, i, uint64(n1))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0xa2
+func (m *SessionAllowance) GetAgentPermId() uint64 {
+	if m != nil {
+		return m.AgentPermId
 	}
-	if len(m.VpSummaryDigestSri) > 0 {
-		i -= len(m.VpSummaryDigestSri)
-		copy(dAtA[i:], m.VpSummaryDigestSri)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.VpSummaryDigestSri)))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0x9a
+	return 0
+}
+
+func (m *SessionAllowance) GetVerifierPermId() uint64 {
+	if m != nil {
+		return m.VerifierPermId
 	}
-	if m.VpCurrentDeposit != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.VpCurrentDeposit))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0x90
+	return 0
+}
+
+func (m *SessionAllowance) GetRemaining() uint64 {
+	if m != nil {
+		return m.Remaining
 	}
-	if m.VpCurrentFees != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.VpCurrentFees))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0x88
+	return 0
+}
+
+func (m *SessionAllowance) GetSpent() uint64 {
+	if m != nil {
+		return m.Spent
 	}
-	if m.VpValidatorDeposit != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.VpValidatorDeposit))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0x80
+	return 0
+}
+
+func (m *SessionAllowance) GetCreated() *time.Time {
+	if m != nil {
+		return m.Created
 	}
-	if m.VpLastStateChange != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VpLastStateChange, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VpLastStateChange):])
-		if err2 != nil {
-			return 0, err2
-		}
-		i -= n2
-		i = encodeVarintTypes(dAtA, i, uint64(n2))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xfa
+	return nil
+}
+
+func (m *SessionAllowance) GetModified() *time.Time {
+	if m != nil {
+		return m.Modified
 	}
-	if m.VpExp != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VpExp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VpExp):])
-		if err3 != nil {
-			return 0, err3
-		}
-		i -= n3
-		i = encodeVarintTypes(dAtA, i, uint64(n3))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xf2
+	return nil
+}
+
+func (m *SessionAllowance) GetAllowedCreators() []string {
+	if m != nil {
+		return m.AllowedCreators
 	}
-	if m.VpState != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.VpState))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xe8
+	return nil
+}
+
+func (m *SessionAllowance) GetMaxPerSession() uint64 {
+	if m != nil {
+		return m.MaxPerSession
 	}
-	if m.ValidatorPermId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.ValidatorPermId))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xe0
+	return 0
+}
+
+// SlashRecord tracks a slash of a perm trust deposit from the moment it is
+// requested until it is executed or reversed.
+type SlashRecord struct {
+	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PermId  uint64 `protobuf:"varint,2,opt,name=perm_id,json=permId,proto3" json:"perm_id,omitempty"`
+	Grantee string `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// amount is the slashed amount, in uvna, escrowed while the slash is open
+	Amount    uint64      `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	SlashedBy string      `protobuf:"bytes,5,opt,name=slashed_by,json=slashedBy,proto3" json:"slashed_by,omitempty"`
+	Status    SlashStatus `protobuf:"varint,6,opt,name=status,proto3,enum=verana.perm.v1.SlashStatus" json:"status,omitempty"`
+	Created   *time.Time  `protobuf:"bytes,7,opt,name=created,proto3,stdtime" json:"created,omitempty"`
+	// appeal_deadline is the time after which an unappealed slash is executed
+	AppealDeadline *time.Time `protobuf:"bytes,8,opt,name=appeal_deadline,json=appealDeadline,proto3,stdtime" json:"appeal_deadline,omitempty"`
+	Appealed       *time.Time `protobuf:"bytes,9,opt,name=appealed,proto3,stdtime" json:"appealed,omitempty"`
+	// appeal_digest_sri is the digest SRI of the evidence backing the appeal
+	AppealDigestSri string     `protobuf:"bytes,10,opt,name=appeal_digest_sri,json=appealDigestSri,proto3" json:"appeal_digest_sri,omitempty"`
+	Resolved        *time.Time `protobuf:"bytes,11,opt,name=resolved,proto3,stdtime" json:"resolved,omitempty"`
+	ResolvedBy      string     `protobuf:"bytes,12,opt,name=resolved_by,json=resolvedBy,proto3" json:"resolved_by,omitempty"`
+	// reason_code identifies the violated rule, as listed by the governance
+	// framework of the trust registry
+	ReasonCode  uint32 `protobuf:"varint,13,opt,name=reason_code,json=reasonCode,proto3" json:"reason_code,omitempty"`
+	EvidenceUrl string `protobuf:"bytes,14,opt,name=evidence_url,json=evidenceUrl,proto3" json:"evidence_url,omitempty"`
+	// evidence_digest_sri is the digest SRI of the document at evidence_url
+	EvidenceDigestSri string `protobuf:"bytes,15,opt,name=evidence_digest_sri,json=evidenceDigestSri,proto3" json:"evidence_digest_sri,omitempty"`
+	// gf_version is the governance framework version that was violated
+	GfVersion int32 `protobuf:"varint,16,opt,name=gf_version,json=gfVersion,proto3" json:"gf_version,omitempty"`
+	// distribution defines where the amount goes once the slash is executed,
+	// it is burned if empty
+	Distribution []types.SlashShare `protobuf:"bytes,17,rep,name=distribution,proto3" json:"distribution"`
+}
+
+func (m *SlashRecord) Reset()         { *m = SlashRecord{} }
+func (m *SlashRecord) String() string { return proto.CompactTextString(m) }
+func (*SlashRecord) ProtoMessage()    {}
+func (*SlashRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd0a3e5374928bd3, []int{4}
+}
+func (m *SlashRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Country) > 0 {
-		i -= len(m.Country)
-		copy(dAtA[i:], m.Country)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Country)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xda
+}
+func (m *SlashRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashRecord.Merge(m, src)
+}
+func (m *SlashRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashRecord proto.InternalMessageInfo
+
+func (m *SlashRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.TerminatedBy) > 0 {
-		i -= len(m.TerminatedBy)
-		copy(dAtA[i:], m.TerminatedBy)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.TerminatedBy)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xd2
+	return 0
+}
+
+func (m *SlashRecord) GetPermId() uint64 {
+	if m != nil {
+		return m.PermId
 	}
-	if m.Terminated != nil {
-		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Terminated, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Terminated):])
-		if err4 != nil {
-			return 0, err4
-		}
-		i -= n4
-		i = encodeVarintTypes(dAtA, i, uint64(n4))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xca
-	}
-	if len(m.RevokedBy) > 0 {
-		i -= len(m.RevokedBy)
-		copy(dAtA[i:], m.RevokedBy)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.RevokedBy)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xc2
-	}
-	if m.Revoked != nil {
-		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Revoked, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Revoked):])
-		if err5 != nil {
-			return 0, err5
-		}
-		i -= n5
-		i = encodeVarintTypes(dAtA, i, uint64(n5))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xba
-	}
-	if m.RepaidDeposit != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.RepaidDeposit))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb0
-	}
-	if m.SlashedDeposit != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.SlashedDeposit))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa8
-	}
-	if m.Deposit != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Deposit))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa0
-	}
-	if m.VerificationFees != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.VerificationFees))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x98
-	}
-	if m.IssuanceFees != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.IssuanceFees))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x90
-	}
-	if m.ValidationFees != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.ValidationFees))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x88
-	}
-	if m.Modified != nil {
-		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Modified, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Modified):])
-		if err6 != nil {
-			return 0, err6
-		}
-		i -= n6
-		i = encodeVarintTypes(dAtA, i, uint64(n6))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
-	}
-	if m.EffectiveUntil != nil {
-		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil):])
-		if err7 != nil {
-			return 0, err7
-		}
-		i -= n7
-		i = encodeVarintTypes(dAtA, i, uint64(n7))
-		i--
-		dAtA[i] = 0x7a
-	}
-	if m.EffectiveFrom != nil {
-		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveFrom, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveFrom):])
-		if err8 != nil {
-			return 0, err8
-		}
-		i -= n8
-		i = encodeVarintTypes(dAtA, i, uint64(n8))
-		i--
-		dAtA[i] = 0x72
+	return 0
+}
+
+func (m *SlashRecord) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
 	}
-	if len(m.RepaidBy) > 0 {
-		i -= len(m.RepaidBy)
-		copy(dAtA[i:], m.RepaidBy)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.RepaidBy)))
-		i--
-		dAtA[i] = 0x6a
+	return ""
+}
+
+func (m *SlashRecord) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	if m.Repaid != nil {
-		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Repaid, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Repaid):])
-		if err9 != nil {
-			return 0, err9
-		}
-		i -= n9
-		i = encodeVarintTypes(dAtA, i, uint64(n9))
-		i--
-		dAtA[i] = 0x62
+	return 0
+}
+
+func (m *SlashRecord) GetSlashedBy() string {
+	if m != nil {
+		return m.SlashedBy
 	}
-	if len(m.SlashedBy) > 0 {
-		i -= len(m.SlashedBy)
-		copy(dAtA[i:], m.SlashedBy)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.SlashedBy)))
-		i--
-		dAtA[i] = 0x5a
+	return ""
+}
+
+func (m *SlashRecord) GetStatus() SlashStatus {
+	if m != nil {
+		return m.Status
 	}
-	if m.Slashed != nil {
-		n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Slashed, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Slashed):])
-		if err10 != nil {
-			return 0, err10
-		}
-		i -= n10
-		i = encodeVarintTypes(dAtA, i, uint64(n10))
-		i--
-		dAtA[i] = 0x52
+	return SlashStatus_SLASH_STATUS_UNSPECIFIED
+}
+
+func (m *SlashRecord) GetCreated() *time.Time {
+	if m != nil {
+		return m.Created
 	}
-	if len(m.ExtendedBy) > 0 {
-		i -= len(m.ExtendedBy)
-		copy(dAtA[i:], m.ExtendedBy)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ExtendedBy)))
-		i--
-		dAtA[i] = 0x4a
+	return nil
+}
+
+func (m *SlashRecord) GetAppealDeadline() *time.Time {
+	if m != nil {
+		return m.AppealDeadline
 	}
-	if m.Extended != nil {
-		n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Extended, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Extended):])
-		if err11 != nil {
-			return 0, err11
-		}
-		i -= n11
-		i = encodeVarintTypes(dAtA, i, uint64(n11))
-		i--
-		dAtA[i] = 0x42
+	return nil
+}
+
+func (m *SlashRecord) GetAppealed() *time.Time {
+	if m != nil {
+		return m.Appealed
 	}
-	if len(m.CreatedBy) > 0 {
-		i -= len(m.CreatedBy)
-		copy(dAtA[i:], m.CreatedBy)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.CreatedBy)))
-		i--
-		dAtA[i] = 0x3a
+	return nil
+}
+
+func (m *SlashRecord) GetAppealDigestSri() string {
+	if m != nil {
+		return m.AppealDigestSri
 	}
-	if m.Created != nil {
-		n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Created, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created):])
-		if err12 != nil {
-			return 0, err12
-		}
-		i -= n12
-		i = encodeVarintTypes(dAtA, i, uint64(n12))
-		i--
-		dAtA[i] = 0x32
+	return ""
+}
+
+func (m *SlashRecord) GetResolved() *time.Time {
+	if m != nil {
+		return m.Resolved
 	}
-	if len(m.Grantee) > 0 {
-		i -= len(m.Grantee)
-		copy(dAtA[i:], m.Grantee)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Grantee)))
-		i--
-		dAtA[i] = 0x2a
+	return nil
+}
+
+func (m *SlashRecord) GetResolvedBy() string {
+	if m != nil {
+		return m.ResolvedBy
 	}
-	if len(m.Did) > 0 {
-		i -= len(m.Did)
-		copy(dAtA[i:], m.Did)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Did)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *SlashRecord) GetReasonCode() uint32 {
+	if m != nil {
+		return m.ReasonCode
 	}
-	if m.Type != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x18
+	return 0
+}
+
+func (m *SlashRecord) GetEvidenceUrl() string {
+	if m != nil {
+		return m.EvidenceUrl
 	}
-	if m.SchemaId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.SchemaId))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *SlashRecord) GetEvidenceDigestSri() string {
+	if m != nil {
+		return m.EvidenceDigestSri
 	}
-	if m.Id != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *SlashRecord) GetGfVersion() int32 {
+	if m != nil {
+		return m.GfVersion
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *PermissionSession) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SlashRecord) GetDistribution() []types.SlashShare {
+	if m != nil {
+		return m.Distribution
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PermissionSession) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// TrustRegistrySlashDistribution is the default distribution of the slashed
+// deposits of the perms of a trust registry.
+type TrustRegistrySlashDistribution struct {
+	TrId         uint64             `protobuf:"varint,1,opt,name=tr_id,json=trId,proto3" json:"tr_id,omitempty"`
+	Distribution []types.SlashShare `protobuf:"bytes,2,rep,name=distribution,proto3" json:"distribution"`
 }
 
-func (m *PermissionSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Modified != nil {
-		n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Modified, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Modified):])
-		if err13 != nil {
-			return 0, err13
+func (m *TrustRegistrySlashDistribution) Reset()         { *m = TrustRegistrySlashDistribution{} }
+func (m *TrustRegistrySlashDistribution) String() string { return proto.CompactTextString(m) }
+func (*TrustRegistrySlashDistribution) ProtoMessage()    {}
+func (*TrustRegistrySlashDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd0a3e5374928bd3, []int{5}
+}
+func (m *TrustRegistrySlashDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrustRegistrySlashDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrustRegistrySlashDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n13
-		i = encodeVarintTypes(dAtA, i, uint64(n13))
-		i--
-		dAtA[i] = 0x32
+		return b[:n], nil
 	}
-	if m.Created != nil {
-		n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Created, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created):])
-		if err14 != nil {
-			return 0, err14
-		}
-		i -= n14
-		i = encodeVarintTypes(dAtA, i, uint64(n14))
-		i--
-		dAtA[i] = 0x2a
+}
+func (m *TrustRegistrySlashDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrustRegistrySlashDistribution.Merge(m, src)
+}
+func (m *TrustRegistrySlashDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrustRegistrySlashDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrustRegistrySlashDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrustRegistrySlashDistribution proto.InternalMessageInfo
+
+func (m *TrustRegistrySlashDistribution) GetTrId() uint64 {
+	if m != nil {
+		return m.TrId
 	}
-	if len(m.Authz) > 0 {
-		for iNdEx := len(m.Authz) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Authz[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+	return 0
+}
+
+func (m *TrustRegistrySlashDistribution) GetDistribution() []types.SlashShare {
+	if m != nil {
+		return m.Distribution
+	}
+	return nil
+}
+
+// RevocationCascade is a cascading revocation that still has to go through
+// the perms validated by perm_id. Cascades that do not fit in the revocation
+// transaction are continued by the EndBlocker.
+type RevocationCascade struct {
+	// perm_id is the perm whose validated perms are still to be processed
+	PermId uint64 `protobuf:"varint,1,opt,name=perm_id,json=permId,proto3" json:"perm_id,omitempty"`
+	// origin_perm_id is the revoked perm the cascade started from
+	OriginPermId uint64      `protobuf:"varint,2,opt,name=origin_perm_id,json=originPermId,proto3" json:"origin_perm_id,omitempty"`
+	Mode         CascadeMode `protobuf:"varint,3,opt,name=mode,proto3,enum=verana.perm.v1.CascadeMode" json:"mode,omitempty"`
+	ExecutedBy   string      `protobuf:"bytes,4,opt,name=executed_by,json=executedBy,proto3" json:"executed_by,omitempty"`
+	Created      *time.Time  `protobuf:"bytes,5,opt,name=created,proto3,stdtime" json:"created,omitempty"`
+	// last_child_id is the last validated perm of perm_id already processed
+	LastChildId uint64 `protobuf:"varint,6,opt,name=last_child_id,json=lastChildId,proto3" json:"last_child_id,omitempty"`
+}
+
+func (m *RevocationCascade) Reset()         { *m = RevocationCascade{} }
+func (m *RevocationCascade) String() string { return proto.CompactTextString(m) }
+func (*RevocationCascade) ProtoMessage()    {}
+func (*RevocationCascade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd0a3e5374928bd3, []int{6}
+}
+func (m *RevocationCascade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevocationCascade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevocationCascade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.AgentPermId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.AgentPermId))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *RevocationCascade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevocationCascade.Merge(m, src)
+}
+func (m *RevocationCascade) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevocationCascade) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevocationCascade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevocationCascade proto.InternalMessageInfo
+
+func (m *RevocationCascade) GetPermId() uint64 {
+	if m != nil {
+		return m.PermId
 	}
-	if len(m.Controller) > 0 {
-		i -= len(m.Controller)
-		copy(dAtA[i:], m.Controller)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Controller)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *RevocationCascade) GetOriginPermId() uint64 {
+	if m != nil {
+		return m.OriginPermId
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *RevocationCascade) GetMode() CascadeMode {
+	if m != nil {
+		return m.Mode
 	}
-	return len(dAtA) - i, nil
+	return CascadeMode_CASCADE_MODE_NONE
 }
 
-func (m *SessionAuthz) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RevocationCascade) GetExecutedBy() string {
+	if m != nil {
+		return m.ExecutedBy
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SessionAuthz) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RevocationCascade) GetCreated() *time.Time {
+	if m != nil {
+		return m.Created
+	}
+	return nil
 }
 
-func (m *SessionAuthz) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.WalletAgentPermId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.WalletAgentPermId))
-		i--
-		dAtA[i] = 0x18
+func (m *RevocationCascade) GetLastChildId() uint64 {
+	if m != nil {
+		return m.LastChildId
 	}
-	if m.BeneficiaryPermId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.BeneficiaryPermId))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+// AgentReward holds the rewards accrued by a HOLDER perm acting as user agent
+// or wallet agent of perm sessions.
+type AgentReward struct {
+	PermId uint64 `protobuf:"varint,1,opt,name=perm_id,json=permId,proto3" json:"perm_id,omitempty"`
+	// user_agent_rewards is the amount, in uvna, earned as user agent
+	UserAgentRewards uint64 `protobuf:"varint,2,opt,name=user_agent_rewards,json=userAgentRewards,proto3" json:"user_agent_rewards,omitempty"`
+	// wallet_agent_rewards is the amount, in uvna, earned as wallet agent
+	WalletAgentRewards uint64 `protobuf:"varint,3,opt,name=wallet_agent_rewards,json=walletAgentRewards,proto3" json:"wallet_agent_rewards,omitempty"`
+	// trust_deposit is the part of the rewards, in uvna, that went to the
+	// trust deposit of the grantee
+	TrustDeposit uint64     `protobuf:"varint,4,opt,name=trust_deposit,json=trustDeposit,proto3" json:"trust_deposit,omitempty"`
+	LastReward   *time.Time `protobuf:"bytes,5,opt,name=last_reward,json=lastReward,proto3,stdtime" json:"last_reward,omitempty"`
+}
+
+func (m *AgentReward) Reset()         { *m = AgentReward{} }
+func (m *AgentReward) String() string { return proto.CompactTextString(m) }
+func (*AgentReward) ProtoMessage()    {}
+func (*AgentReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd0a3e5374928bd3, []int{7}
+}
+func (m *AgentReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AgentReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AgentReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.ExecutorPermId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.ExecutorPermId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *AgentReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AgentReward.Merge(m, src)
+}
+func (m *AgentReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *AgentReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_AgentReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AgentReward proto.InternalMessageInfo
+
+func (m *AgentReward) GetPermId() uint64 {
+	if m != nil {
+		return m.PermId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *AgentReward) GetUserAgentRewards() uint64 {
+	if m != nil {
+		return m.UserAgentRewards
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *Permission) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *AgentReward) GetWalletAgentRewards() uint64 {
+	if m != nil {
+		return m.WalletAgentRewards
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTypes(uint64(m.Id))
+	return 0
+}
+
+func (m *AgentReward) GetTrustDeposit() uint64 {
+	if m != nil {
+		return m.TrustDeposit
 	}
-	if m.SchemaId != 0 {
-		n += 1 + sovTypes(uint64(m.SchemaId))
+	return 0
+}
+
+func (m *AgentReward) GetLastReward() *time.Time {
+	if m != nil {
+		return m.LastReward
 	}
-	if m.Type != 0 {
-		n += 1 + sovTypes(uint64(m.Type))
+	return nil
+}
+
+// VerificationCredit is a bundle of prepaid verification sessions. The session
+// fees of all credits are distributed to the beneficiaries when the bundle is
+// purchased, a session of the buyer then consumes a credit instead of paying
+// the fees.
+type VerificationCredit struct {
+	Id             uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Buyer          string `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	VerifierPermId uint64 `protobuf:"varint,3,opt,name=verifier_perm_id,json=verifierPermId,proto3" json:"verifier_perm_id,omitempty"`
+	// issuer_perm_id is the issuer perm of the sessions, 0 if none
+	IssuerPermId uint64 `protobuf:"varint,4,opt,name=issuer_perm_id,json=issuerPermId,proto3" json:"issuer_perm_id,omitempty"`
+	// executor_perm_id is the perm whose deposit holds the buyer trust deposit
+	ExecutorPermId uint64 `protobuf:"varint,5,opt,name=executor_perm_id,json=executorPermId,proto3" json:"executor_perm_id,omitempty"`
+	Purchased      uint64 `protobuf:"varint,6,opt,name=purchased,proto3" json:"purchased,omitempty"`
+	Remaining      uint64 `protobuf:"varint,7,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	// unit_fees is the amount, in uvna, paid to the beneficiaries per credit
+	UnitFees uint64 `protobuf:"varint,8,opt,name=unit_fees,json=unitFees,proto3" json:"unit_fees,omitempty"`
+	// unit_trust_deposit is the amount, in uvna, added to the buyer trust
+	// deposit per credit
+	UnitTrustDeposit uint64     `protobuf:"varint,9,opt,name=unit_trust_deposit,json=unitTrustDeposit,proto3" json:"unit_trust_deposit,omitempty"`
+	Created          *time.Time `protobuf:"bytes,10,opt,name=created,proto3,stdtime" json:"created,omitempty"`
+	Modified         *time.Time `protobuf:"bytes,11,opt,name=modified,proto3,stdtime" json:"modified,omitempty"`
+	// refunded is set when the unused credits have been refunded
+	Refunded *time.Time `protobuf:"bytes,12,opt,name=refunded,proto3,stdtime" json:"refunded,omitempty"`
+}
+
+func (m *VerificationCredit) Reset()         { *m = VerificationCredit{} }
+func (m *VerificationCredit) String() string { return proto.CompactTextString(m) }
+func (*VerificationCredit) ProtoMessage()    {}
+func (*VerificationCredit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd0a3e5374928bd3, []int{8}
+}
+func (m *VerificationCredit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerificationCredit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerificationCredit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+}
+func (m *VerificationCredit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerificationCredit.Merge(m, src)
+}
+func (m *VerificationCredit) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerificationCredit) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerificationCredit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerificationCredit proto.InternalMessageInfo
+
+func (m *VerificationCredit) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+func (m *VerificationCredit) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
 	}
-	if m.Created != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created)
-		n += 1 + l + sovTypes(uint64(l))
+	return ""
+}
+
+func (m *VerificationCredit) GetVerifierPermId() uint64 {
+	if m != nil {
+		return m.VerifierPermId
 	}
-	l = len(m.CreatedBy)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+func (m *VerificationCredit) GetIssuerPermId() uint64 {
+	if m != nil {
+		return m.IssuerPermId
 	}
-	if m.Extended != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Extended)
-		n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+func (m *VerificationCredit) GetExecutorPermId() uint64 {
+	if m != nil {
+		return m.ExecutorPermId
 	}
-	l = len(m.ExtendedBy)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+func (m *VerificationCredit) GetPurchased() uint64 {
+	if m != nil {
+		return m.Purchased
 	}
-	if m.Slashed != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Slashed)
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.SlashedBy)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.Repaid != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Repaid)
-		n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+func (m *VerificationCredit) GetRemaining() uint64 {
+	if m != nil {
+		return m.Remaining
 	}
-	l = len(m.RepaidBy)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+func (m *VerificationCredit) GetUnitFees() uint64 {
+	if m != nil {
+		return m.UnitFees
 	}
-	if m.EffectiveFrom != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveFrom)
-		n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+func (m *VerificationCredit) GetUnitTrustDeposit() uint64 {
+	if m != nil {
+		return m.UnitTrustDeposit
 	}
-	if m.EffectiveUntil != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil)
-		n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+func (m *VerificationCredit) GetCreated() *time.Time {
+	if m != nil {
+		return m.Created
 	}
-	if m.Modified != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Modified)
-		n += 2 + l + sovTypes(uint64(l))
+	return nil
+}
+
+func (m *VerificationCredit) GetModified() *time.Time {
+	if m != nil {
+		return m.Modified
 	}
-	if m.ValidationFees != 0 {
-		n += 2 + sovTypes(uint64(m.ValidationFees))
+	return nil
+}
+
+func (m *VerificationCredit) GetRefunded() *time.Time {
+	if m != nil {
+		return m.Refunded
 	}
-	if m.IssuanceFees != 0 {
-		n += 2 + sovTypes(uint64(m.IssuanceFees))
+	return nil
+}
+
+// SessionVoucher is a session authorization signed off-chain by the grantee
+// of the verifier perm. As in SessionAuthz, the executor is the issuer perm
+// and the beneficiary the verifier perm of the session. Aggregators settle vouchers in batches with
+// MsgSettleSessionVouchers, the signer then pays the session fees.
+type SessionVoucher struct {
+	// signer is the account that signed the voucher and pays the session
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// executor_perm_id is the issuer perm of the session, 0 if none
+	ExecutorPermId uint64 `protobuf:"varint,2,opt,name=executor_perm_id,json=executorPermId,proto3" json:"executor_perm_id,omitempty"`
+	// beneficiary_perm_id is the verifier perm of the session
+	BeneficiaryPermId uint64 `protobuf:"varint,3,opt,name=beneficiary_perm_id,json=beneficiaryPermId,proto3" json:"beneficiary_perm_id,omitempty"`
+	AgentPermId       uint64 `protobuf:"varint,4,opt,name=agent_perm_id,json=agentPermId,proto3" json:"agent_perm_id,omitempty"`
+	// nonce must not have been settled for the signer yet, and be greater than
+	// the highest nonce settled for the signer minus the voucher nonce window
+	Nonce uint64 `protobuf:"varint,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// amount is the maximum, in uvna, the signer agrees to pay for the session
+	Amount uint64 `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *SessionVoucher) Reset()         { *m = SessionVoucher{} }
+func (m *SessionVoucher) String() string { return proto.CompactTextString(m) }
+func (*SessionVoucher) ProtoMessage()    {}
+func (*SessionVoucher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd0a3e5374928bd3, []int{9}
+}
+func (m *SessionVoucher) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SessionVoucher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SessionVoucher.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.VerificationFees != 0 {
-		n += 2 + sovTypes(uint64(m.VerificationFees))
+}
+func (m *SessionVoucher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionVoucher.Merge(m, src)
+}
+func (m *SessionVoucher) XXX_Size() int {
+	return m.Size()
+}
+func (m *SessionVoucher) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionVoucher.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionVoucher proto.InternalMessageInfo
+
+func (m *SessionVoucher) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	if m.Deposit != 0 {
-		n += 2 + sovTypes(uint64(m.Deposit))
+	return ""
+}
+
+func (m *SessionVoucher) GetExecutorPermId() uint64 {
+	if m != nil {
+		return m.ExecutorPermId
 	}
-	if m.SlashedDeposit != 0 {
-		n += 2 + sovTypes(uint64(m.SlashedDeposit))
+	return 0
+}
+
+func (m *SessionVoucher) GetBeneficiaryPermId() uint64 {
+	if m != nil {
+		return m.BeneficiaryPermId
 	}
-	if m.RepaidDeposit != 0 {
-		n += 2 + sovTypes(uint64(m.RepaidDeposit))
+	return 0
+}
+
+func (m *SessionVoucher) GetAgentPermId() uint64 {
+	if m != nil {
+		return m.AgentPermId
 	}
-	if m.Revoked != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Revoked)
-		n += 2 + l + sovTypes(uint64(l))
+	return 0
+}
+
+func (m *SessionVoucher) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
 	}
-	l = len(m.RevokedBy)
-	if l > 0 {
-		n += 2 + l + sovTypes(uint64(l))
+	return 0
+}
+
+func (m *SessionVoucher) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	if m.Terminated != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Terminated)
-		n += 2 + l + sovTypes(uint64(l))
+	return 0
+}
+
+// SessionVoucherSignDoc is the document signed for a session voucher.
+type SessionVoucherSignDoc struct {
+	ChainId string         `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Voucher SessionVoucher `protobuf:"bytes,2,opt,name=voucher,proto3" json:"voucher"`
+}
+
+func (m *SessionVoucherSignDoc) Reset()         { *m = SessionVoucherSignDoc{} }
+func (m *SessionVoucherSignDoc) String() string { return proto.CompactTextString(m) }
+func (*SessionVoucherSignDoc) ProtoMessage()    {}
+func (*SessionVoucherSignDoc) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd0a3e5374928bd3, []int{10}
+}
+func (m *SessionVoucherSignDoc) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SessionVoucherSignDoc) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SessionVoucherSignDoc.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.TerminatedBy)
-	if l > 0 {
-		n += 2 + l + sovTypes(uint64(l))
+}
+func (m *SessionVoucherSignDoc) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionVoucherSignDoc.Merge(m, src)
+}
+func (m *SessionVoucherSignDoc) XXX_Size() int {
+	return m.Size()
+}
+func (m *SessionVoucherSignDoc) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionVoucherSignDoc.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionVoucherSignDoc proto.InternalMessageInfo
+
+func (m *SessionVoucherSignDoc) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	l = len(m.Country)
-	if l > 0 {
-		n += 2 + l + sovTypes(uint64(l))
+	return ""
+}
+
+func (m *SessionVoucherSignDoc) GetVoucher() SessionVoucher {
+	if m != nil {
+		return m.Voucher
 	}
-	if m.ValidatorPermId != 0 {
-		n += 2 + sovTypes(uint64(m.ValidatorPermId))
+	return SessionVoucher{}
+}
+
+// VoucherNonce holds the voucher nonces settled for a signer.
+type VoucherNonce struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// nonce is the highest nonce settled
+	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// used_nonces are the nonces settled within the voucher nonce window below
+	// nonce, nonce included
+	UsedNonces []uint64 `protobuf:"varint,3,rep,packed,name=used_nonces,json=usedNonces,proto3" json:"used_nonces,omitempty"`
+}
+
+func (m *VoucherNonce) Reset()         { *m = VoucherNonce{} }
+func (m *VoucherNonce) String() string { return proto.CompactTextString(m) }
+func (*VoucherNonce) ProtoMessage()    {}
+func (*VoucherNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd0a3e5374928bd3, []int{11}
+}
+func (m *VoucherNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoucherNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoucherNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.VpState != 0 {
-		n += 2 + sovTypes(uint64(m.VpState))
+}
+func (m *VoucherNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoucherNonce.Merge(m, src)
+}
+func (m *VoucherNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoucherNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoucherNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoucherNonce proto.InternalMessageInfo
+
+func (m *VoucherNonce) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	if m.VpExp != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VpExp)
-		n += 2 + l + sovTypes(uint64(l))
+	return ""
+}
+
+func (m *VoucherNonce) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
 	}
-	if m.VpLastStateChange != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VpLastStateChange)
-		n += 2 + l + sovTypes(uint64(l))
+	return 0
+}
+
+func (m *VoucherNonce) GetUsedNonces() []uint64 {
+	if m != nil {
+		return m.UsedNonces
 	}
-	if m.VpValidatorDeposit != 0 {
-		n += 2 + sovTypes(uint64(m.VpValidatorDeposit))
+	return nil
+}
+
+// VPMessage anchors a message exchanged, off-chain and encrypted, between the
+// applicant and the validator of the validation process of a perm.
+type VPMessage struct {
+	PermId uint64 `protobuf:"varint,1,opt,name=perm_id,json=permId,proto3" json:"perm_id,omitempty"`
+	// index orders the messages of the validation process, starting at 1
+	Index uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// sender is the applicant or validator grantee who anchored the message
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	// digest_sri is the digest SRI of the message content
+	DigestSri string `protobuf:"bytes,4,opt,name=digest_sri,json=digestSri,proto3" json:"digest_sri,omitempty"`
+	// encrypted_uri is where the encrypted message can be retrieved
+	EncryptedUri string     `protobuf:"bytes,5,opt,name=encrypted_uri,json=encryptedUri,proto3" json:"encrypted_uri,omitempty"`
+	Created      *time.Time `protobuf:"bytes,6,opt,name=created,proto3,stdtime" json:"created,omitempty"`
+}
+
+func (m *VPMessage) Reset()         { *m = VPMessage{} }
+func (m *VPMessage) String() string { return proto.CompactTextString(m) }
+func (*VPMessage) ProtoMessage()    {}
+func (*VPMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd0a3e5374928bd3, []int{12}
+}
+func (m *VPMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VPMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VPMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.VpCurrentFees != 0 {
-		n += 2 + sovTypes(uint64(m.VpCurrentFees))
+}
+func (m *VPMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VPMessage.Merge(m, src)
+}
+func (m *VPMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *VPMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_VPMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VPMessage proto.InternalMessageInfo
+
+func (m *VPMessage) GetPermId() uint64 {
+	if m != nil {
+		return m.PermId
 	}
-	if m.VpCurrentDeposit != 0 {
-		n += 2 + sovTypes(uint64(m.VpCurrentDeposit))
+	return 0
+}
+
+func (m *VPMessage) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
 	}
-	l = len(m.VpSummaryDigestSri)
-	if l > 0 {
-		n += 2 + l + sovTypes(uint64(l))
+	return 0
+}
+
+func (m *VPMessage) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if m.VpTermRequested != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VpTermRequested)
-		n += 2 + l + sovTypes(uint64(l))
+	return ""
+}
+
+func (m *VPMessage) GetDigestSri() string {
+	if m != nil {
+		return m.DigestSri
 	}
-	return n
+	return ""
 }
 
-func (m *PermissionSession) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *VPMessage) GetEncryptedUri() string {
+	if m != nil {
+		return m.EncryptedUri
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return ""
+}
+
+func (m *VPMessage) GetCreated() *time.Time {
+	if m != nil {
+		return m.Created
 	}
-	l = len(m.Controller)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return nil
+}
+
+// VPApproval is the approval of a validation process by one of its validators.
+// All the approvals of a validation process must agree on the same terms.
+type VPApproval struct {
+	ValidatorPermId    uint64     `protobuf:"varint,1,opt,name=validator_perm_id,json=validatorPermId,proto3" json:"validator_perm_id,omitempty"`
+	Approver           string     `protobuf:"bytes,2,opt,name=approver,proto3" json:"approver,omitempty"`
+	EffectiveUntil     *time.Time `protobuf:"bytes,3,opt,name=effective_until,json=effectiveUntil,proto3,stdtime" json:"effective_until,omitempty"`
+	ValidationFees     uint64     `protobuf:"varint,4,opt,name=validation_fees,json=validationFees,proto3" json:"validation_fees,omitempty"`
+	IssuanceFees       uint64     `protobuf:"varint,5,opt,name=issuance_fees,json=issuanceFees,proto3" json:"issuance_fees,omitempty"`
+	VerificationFees   uint64     `protobuf:"varint,6,opt,name=verification_fees,json=verificationFees,proto3" json:"verification_fees,omitempty"`
+	Countries          []string   `protobuf:"bytes,7,rep,name=countries,proto3" json:"countries,omitempty"`
+	VpSummaryDigestSri string     `protobuf:"bytes,8,opt,name=vp_summary_digest_sri,json=vpSummaryDigestSri,proto3" json:"vp_summary_digest_sri,omitempty"`
+	Created            *time.Time `protobuf:"bytes,9,opt,name=created,proto3,stdtime" json:"created,omitempty"`
+}
+
+func (m *VPApproval) Reset()         { *m = VPApproval{} }
+func (m *VPApproval) String() string { return proto.CompactTextString(m) }
+func (*VPApproval) ProtoMessage()    {}
+func (*VPApproval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd0a3e5374928bd3, []int{13}
+}
+func (m *VPApproval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VPApproval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VPApproval.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.AgentPermId != 0 {
-		n += 1 + sovTypes(uint64(m.AgentPermId))
+}
+func (m *VPApproval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VPApproval.Merge(m, src)
+}
+func (m *VPApproval) XXX_Size() int {
+	return m.Size()
+}
+func (m *VPApproval) XXX_DiscardUnknown() {
+	xxx_messageInfo_VPApproval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VPApproval proto.InternalMessageInfo
+
+func (m *VPApproval) GetValidatorPermId() uint64 {
+	if m != nil {
+		return m.ValidatorPermId
 	}
-	if len(m.Authz) > 0 {
-		for _, e := range m.Authz {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+func (m *VPApproval) GetApprover() string {
+	if m != nil {
+		return m.Approver
+	}
+	return ""
+}
+
+func (m *VPApproval) GetEffectiveUntil() *time.Time {
+	if m != nil {
+		return m.EffectiveUntil
+	}
+	return nil
+}
+
+func (m *VPApproval) GetValidationFees() uint64 {
+	if m != nil {
+		return m.ValidationFees
+	}
+	return 0
+}
+
+func (m *VPApproval) GetIssuanceFees() uint64 {
+	if m != nil {
+		return m.IssuanceFees
+	}
+	return 0
+}
+
+func (m *VPApproval) GetVerificationFees() uint64 {
+	if m != nil {
+		return m.VerificationFees
+	}
+	return 0
+}
+
+func (m *VPApproval) GetCountries() []string {
+	if m != nil {
+		return m.Countries
+	}
+	return nil
+}
+
+func (m *VPApproval) GetVpSummaryDigestSri() string {
+	if m != nil {
+		return m.VpSummaryDigestSri
+	}
+	return ""
+}
+
+func (m *VPApproval) GetCreated() *time.Time {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
+// CoValidatorDeposit is the trust deposit, in uvna, a co-validator earned
+// from the validation of a perm.
+type CoValidatorDeposit struct {
+	ValidatorPermId uint64 `protobuf:"varint,1,opt,name=validator_perm_id,json=validatorPermId,proto3" json:"validator_perm_id,omitempty"`
+	Deposit         uint64 `protobuf:"varint,2,opt,name=deposit,proto3" json:"deposit,omitempty"`
+}
+
+func (m *CoValidatorDeposit) Reset()         { *m = CoValidatorDeposit{} }
+func (m *CoValidatorDeposit) String() string { return proto.CompactTextString(m) }
+func (*CoValidatorDeposit) ProtoMessage()    {}
+func (*CoValidatorDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd0a3e5374928bd3, []int{14}
+}
+func (m *CoValidatorDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CoValidatorDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CoValidatorDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Created != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created)
-		n += 1 + l + sovTypes(uint64(l))
+}
+func (m *CoValidatorDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CoValidatorDeposit.Merge(m, src)
+}
+func (m *CoValidatorDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *CoValidatorDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_CoValidatorDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CoValidatorDeposit proto.InternalMessageInfo
+
+func (m *CoValidatorDeposit) GetValidatorPermId() uint64 {
+	if m != nil {
+		return m.ValidatorPermId
 	}
-	if m.Modified != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Modified)
-		n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+func (m *CoValidatorDeposit) GetDeposit() uint64 {
+	if m != nil {
+		return m.Deposit
 	}
-	return n
+	return 0
 }
 
-func (m *SessionAuthz) Size() (n int) {
-	if m == nil {
-		return 0
+// PermissionFees is a fee schedule of a perm, in trust units.
+type PermissionFees struct {
+	ValidationFees   uint64 `protobuf:"varint,1,opt,name=validation_fees,json=validationFees,proto3" json:"validation_fees,omitempty"`
+	IssuanceFees     uint64 `protobuf:"varint,2,opt,name=issuance_fees,json=issuanceFees,proto3" json:"issuance_fees,omitempty"`
+	VerificationFees uint64 `protobuf:"varint,3,opt,name=verification_fees,json=verificationFees,proto3" json:"verification_fees,omitempty"`
+	// effective_from is the time the fees apply from
+	EffectiveFrom *time.Time `protobuf:"bytes,4,opt,name=effective_from,json=effectiveFrom,proto3,stdtime" json:"effective_from,omitempty"`
+}
+
+func (m *PermissionFees) Reset()         { *m = PermissionFees{} }
+func (m *PermissionFees) String() string { return proto.CompactTextString(m) }
+func (*PermissionFees) ProtoMessage()    {}
+func (*PermissionFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd0a3e5374928bd3, []int{15}
+}
+func (m *PermissionFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PermissionFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PermissionFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PermissionFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PermissionFees.Merge(m, src)
+}
+func (m *PermissionFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *PermissionFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_PermissionFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PermissionFees proto.InternalMessageInfo
+
+func (m *PermissionFees) GetValidationFees() uint64 {
+	if m != nil {
+		return m.ValidationFees
+	}
+	return 0
+}
+
+func (m *PermissionFees) GetIssuanceFees() uint64 {
+	if m != nil {
+		return m.IssuanceFees
+	}
+	return 0
+}
+
+func (m *PermissionFees) GetVerificationFees() uint64 {
+	if m != nil {
+		return m.VerificationFees
+	}
+	return 0
+}
+
+func (m *PermissionFees) GetEffectiveFrom() *time.Time {
+	if m != nil {
+		return m.EffectiveFrom
+	}
+	return nil
+}
+
+// PermissionFeeRecord is an entry of the fee history of a perm. The first
+// entry holds the fees the perm had before its first fee update.
+type PermissionFeeRecord struct {
+	PermId uint64 `protobuf:"varint,1,opt,name=perm_id,json=permId,proto3" json:"perm_id,omitempty"`
+	// index orders the fee history of the perm, starting at 1
+	Index uint64         `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Fees  PermissionFees `protobuf:"bytes,3,opt,name=fees,proto3" json:"fees"`
+	// updated_by is the grantee who set the fees, empty for the first entry
+	UpdatedBy string `protobuf:"bytes,4,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+}
+
+func (m *PermissionFeeRecord) Reset()         { *m = PermissionFeeRecord{} }
+func (m *PermissionFeeRecord) String() string { return proto.CompactTextString(m) }
+func (*PermissionFeeRecord) ProtoMessage()    {}
+func (*PermissionFeeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd0a3e5374928bd3, []int{16}
+}
+func (m *PermissionFeeRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PermissionFeeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PermissionFeeRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PermissionFeeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PermissionFeeRecord.Merge(m, src)
+}
+func (m *PermissionFeeRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *PermissionFeeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_PermissionFeeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PermissionFeeRecord proto.InternalMessageInfo
+
+func (m *PermissionFeeRecord) GetPermId() uint64 {
+	if m != nil {
+		return m.PermId
+	}
+	return 0
+}
+
+func (m *PermissionFeeRecord) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *PermissionFeeRecord) GetFees() PermissionFees {
+	if m != nil {
+		return m.Fees
+	}
+	return PermissionFees{}
+}
+
+func (m *PermissionFeeRecord) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("verana.perm.v1.PermissionType", PermissionType_name, PermissionType_value)
+	proto.RegisterEnum("verana.perm.v1.ValidationState", ValidationState_name, ValidationState_value)
+	proto.RegisterEnum("verana.perm.v1.SlashStatus", SlashStatus_name, SlashStatus_value)
+	proto.RegisterEnum("verana.perm.v1.CascadeMode", CascadeMode_name, CascadeMode_value)
+	proto.RegisterType((*Permission)(nil), "verana.perm.v1.Permission")
+	proto.RegisterType((*PermissionSession)(nil), "verana.perm.v1.PermissionSession")
+	proto.RegisterType((*SessionAuthz)(nil), "verana.perm.v1.SessionAuthz")
+	proto.RegisterType((*SessionAllowance)(nil), "verana.perm.v1.SessionAllowance")
+	proto.RegisterType((*SlashRecord)(nil), "verana.perm.v1.SlashRecord")
+	proto.RegisterType((*TrustRegistrySlashDistribution)(nil), "verana.perm.v1.TrustRegistrySlashDistribution")
+	proto.RegisterType((*RevocationCascade)(nil), "verana.perm.v1.RevocationCascade")
+	proto.RegisterType((*AgentReward)(nil), "verana.perm.v1.AgentReward")
+	proto.RegisterType((*VerificationCredit)(nil), "verana.perm.v1.VerificationCredit")
+	proto.RegisterType((*SessionVoucher)(nil), "verana.perm.v1.SessionVoucher")
+	proto.RegisterType((*SessionVoucherSignDoc)(nil), "verana.perm.v1.SessionVoucherSignDoc")
+	proto.RegisterType((*VoucherNonce)(nil), "verana.perm.v1.VoucherNonce")
+	proto.RegisterType((*VPMessage)(nil), "verana.perm.v1.VPMessage")
+	proto.RegisterType((*VPApproval)(nil), "verana.perm.v1.VPApproval")
+	proto.RegisterType((*CoValidatorDeposit)(nil), "verana.perm.v1.CoValidatorDeposit")
+	proto.RegisterType((*PermissionFees)(nil), "verana.perm.v1.PermissionFees")
+	proto.RegisterType((*PermissionFeeRecord)(nil), "verana.perm.v1.PermissionFeeRecord")
+}
+
+func init() { proto.RegisterFile("verana/perm/v1/types.proto", fileDescriptor_fd0a3e5374928bd3) }
+
+var fileDescriptor_fd0a3e5374928bd3 = []byte{
+	// 2607 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x59, 0xcf, 0x6f, 0xe3, 0xc6,
+	0xf5, 0x5f, 0xfd, 0xb6, 0x9e, 0x7e, 0x58, 0x9a, 0xb5, 0x13, 0xae, 0xd7, 0xb1, 0x1d, 0xed, 0x26,
+	0xf1, 0x77, 0x93, 0xd8, 0xd9, 0xcd, 0xb7, 0x48, 0xba, 0x49, 0x8b, 0x4a, 0x32, 0x93, 0x08, 0xdd,
+	0xb5, 0x1d, 0xca, 0x76, 0x9b, 0x5c, 0x08, 0x9a, 0x1c, 0xcb, 0x44, 0x24, 0x92, 0x99, 0x21, 0xb9,
+	0x56, 0xaf, 0x3d, 0x17, 0xc8, 0xa9, 0x40, 0x2f, 0xfd, 0x1f, 0x0a, 0x14, 0xe8, 0xad, 0x40, 0x7b,
+	0xca, 0x31, 0x2d, 0x7a, 0xe8, 0xa9, 0x2d, 0x92, 0x6b, 0x51, 0xf4, 0x4f, 0x28, 0xe6, 0x07, 0x45,
+	0xea, 0x87, 0x57, 0xf4, 0xe6, 0x22, 0x70, 0xde, 0x7c, 0xde, 0xcc, 0x9b, 0x79, 0x6f, 0xde, 0x2f,
+	0xc1, 0x46, 0x88, 0x89, 0xe1, 0x18, 0xfb, 0x1e, 0x26, 0xa3, 0xfd, 0xf0, 0xe1, 0xbe, 0x3f, 0xf6,
+	0x30, 0xdd, 0xf3, 0x88, 0xeb, 0xbb, 0xa8, 0x2e, 0xe6, 0xf6, 0xd8, 0xdc, 0x5e, 0xf8, 0x70, 0xa3,
+	0x69, 0x8c, 0x6c, 0xc7, 0xdd, 0xe7, 0xbf, 0x02, 0xb2, 0x71, 0xc7, 0x74, 0xe9, 0xc8, 0xa5, 0x3a,
+	0x1f, 0xed, 0x8b, 0x81, 0x9c, 0x5a, 0x1b, 0xb8, 0x03, 0x57, 0xd0, 0xd9, 0x97, 0xa4, 0x6e, 0x0f,
+	0x5c, 0x77, 0x30, 0xc4, 0xfb, 0x7c, 0x74, 0x1e, 0x5c, 0xec, 0xfb, 0xf6, 0x08, 0x53, 0xdf, 0x18,
+	0x79, 0x12, 0xa0, 0x48, 0x81, 0x7c, 0x6b, 0x46, 0x9c, 0xd6, 0x2f, 0x6f, 0x03, 0x1c, 0x63, 0x32,
+	0xb2, 0x29, 0xb5, 0x5d, 0x07, 0xd5, 0x21, 0x6b, 0x5b, 0x4a, 0x66, 0x27, 0xb3, 0x9b, 0xd7, 0xb2,
+	0xb6, 0x85, 0xee, 0x42, 0x99, 0x9a, 0x97, 0x78, 0x64, 0xe8, 0xb6, 0xa5, 0x64, 0x39, 0x79, 0x45,
+	0x10, 0x7a, 0x16, 0x7a, 0x04, 0x79, 0xb6, 0x94, 0x92, 0xdb, 0xc9, 0xec, 0xd6, 0x1f, 0x6d, 0xed,
+	0x4d, 0x9f, 0x6c, 0x2f, 0x5e, 0xf6, 0x64, 0xec, 0x61, 0x8d, 0x63, 0x51, 0x03, 0x72, 0x96, 0x6d,
+	0x29, 0xf9, 0x9d, 0xcc, 0x6e, 0x59, 0x63, 0x9f, 0xe8, 0x11, 0x94, 0x06, 0xc4, 0x70, 0x7c, 0x8c,
+	0x95, 0x02, 0xa3, 0x76, 0x94, 0xbf, 0xfe, 0xfe, 0xed, 0x35, 0x79, 0xea, 0xb6, 0x65, 0x11, 0x4c,
+	0x69, 0xdf, 0x27, 0xb6, 0x33, 0xd0, 0x22, 0x20, 0x7a, 0x0c, 0x25, 0x93, 0x60, 0xc3, 0xc7, 0x96,
+	0x52, 0xdc, 0xc9, 0xec, 0x56, 0x1e, 0x6d, 0xec, 0x89, 0x2b, 0xd8, 0x8b, 0xae, 0x60, 0xef, 0x24,
+	0xba, 0x82, 0x4e, 0xfe, 0xab, 0x7f, 0x6e, 0x67, 0xb4, 0x88, 0x01, 0xbd, 0x07, 0x20, 0x3f, 0xf5,
+	0xf3, 0xb1, 0x52, 0x5a, 0xb2, 0x65, 0x59, 0x62, 0x3b, 0x63, 0xf4, 0x21, 0xac, 0xe0, 0x2b, 0x1f,
+	0x3b, 0x16, 0xb6, 0x94, 0x95, 0x94, 0xbb, 0x4e, 0x38, 0xd0, 0x0f, 0xa1, 0x12, 0x7d, 0xb3, 0x7d,
+	0xcb, 0x4b, 0xf6, 0x85, 0x08, 0xdc, 0x19, 0xb3, 0xd3, 0xd2, 0xa1, 0x41, 0x2f, 0xb1, 0xa5, 0x40,
+	0xda, 0xd3, 0x4a, 0x06, 0x76, 0x5a, 0xf9, 0xc9, 0x76, 0xad, 0x2c, 0x3b, 0xad, 0xc4, 0x76, 0xc6,
+	0xe8, 0x7d, 0x28, 0x12, 0xec, 0x19, 0xb6, 0xa5, 0x54, 0x53, 0xee, 0x29, 0xf1, 0xe8, 0x07, 0x50,
+	0x16, 0x5f, 0x6c, 0xc7, 0xda, 0x92, 0x1d, 0x57, 0x04, 0xb4, 0x33, 0x46, 0x1f, 0x43, 0x1d, 0x5f,
+	0x5c, 0x60, 0xd3, 0xb7, 0x43, 0xac, 0x5f, 0x10, 0x77, 0xa4, 0xd4, 0x53, 0x6e, 0x5c, 0x9b, 0xf0,
+	0x7d, 0x44, 0xdc, 0x11, 0xea, 0xc1, 0x6a, 0xbc, 0x50, 0xe0, 0xf8, 0xf6, 0x50, 0x59, 0x4d, 0xb9,
+	0x52, 0x2c, 0xc1, 0x29, 0xe3, 0x63, 0x2a, 0x1f, 0xb9, 0x96, 0x7d, 0x61, 0x63, 0x4b, 0x69, 0xa4,
+	0x55, 0x79, 0xc4, 0x81, 0xde, 0x80, 0xd5, 0xd0, 0x18, 0xda, 0x96, 0xe1, 0xdb, 0xae, 0xa3, 0x5f,
+	0x60, 0x4c, 0x95, 0x26, 0x7f, 0x42, 0xf5, 0x98, 0xfc, 0x11, 0xc6, 0x14, 0xdd, 0x83, 0x9a, 0x4d,
+	0x69, 0x60, 0x38, 0x26, 0x16, 0x30, 0xc4, 0x61, 0xd5, 0x88, 0xc8, 0x41, 0x6f, 0x42, 0x33, 0xc4,
+	0xc4, 0xbe, 0xb0, 0xcd, 0xc4, 0x7a, 0xb7, 0x39, 0xb0, 0x91, 0x9c, 0xe0, 0x60, 0x05, 0x4a, 0x16,
+	0xf6, 0x5c, 0x6a, 0xfb, 0xca, 0x1a, 0x87, 0x44, 0x43, 0x26, 0x54, 0x64, 0x10, 0x11, 0x62, 0x5d,
+	0x08, 0x25, 0xc9, 0x07, 0x12, 0xf8, 0x1a, 0xd4, 0xa5, 0x1a, 0x23, 0xdc, 0x4b, 0x1c, 0x57, 0x13,
+	0xd4, 0x08, 0xf6, 0x18, 0x4a, 0x04, 0x87, 0xee, 0x17, 0xd8, 0x52, 0x5e, 0x4e, 0x6b, 0x9c, 0x92,
+	0x81, 0x19, 0xa7, 0xfc, 0x64, 0xa6, 0xa2, 0x2c, 0x33, 0x4e, 0x89, 0xed, 0x8c, 0xd1, 0x4f, 0x00,
+	0x7c, 0xe6, 0x5d, 0x1c, 0xee, 0x02, 0xee, 0xa4, 0xdc, 0x37, 0xc1, 0x83, 0x7e, 0x04, 0xb5, 0x78,
+	0xc4, 0x76, 0xdf, 0x58, 0xb2, 0x7b, 0x35, 0x86, 0x77, 0xc6, 0x68, 0x13, 0xca, 0xa6, 0x1b, 0x38,
+	0x3e, 0xb1, 0x31, 0x55, 0xee, 0xee, 0xe4, 0x76, 0xcb, 0x5a, 0x4c, 0x40, 0x0f, 0xa0, 0x29, 0x35,
+	0xec, 0x12, 0x9d, 0xb9, 0x43, 0xe6, 0x3d, 0x37, 0xf9, 0xed, 0xad, 0x4e, 0x26, 0x98, 0x7b, 0xec,
+	0x59, 0xe8, 0x31, 0xac, 0x84, 0x9e, 0x4e, 0x7d, 0xc3, 0xc7, 0xca, 0x2b, 0xdc, 0x91, 0x6e, 0xcf,
+	0x3a, 0xd2, 0xb3, 0x89, 0xb5, 0xf4, 0x19, 0x4c, 0x2b, 0x85, 0x1e, 0xff, 0x40, 0xef, 0x41, 0x31,
+	0xf4, 0x74, 0x7c, 0xe5, 0x29, 0x5b, 0x29, 0xaf, 0xa0, 0x10, 0x7a, 0xea, 0x95, 0x87, 0x3e, 0x85,
+	0xb5, 0xd0, 0xd3, 0x87, 0x06, 0xf5, 0xc5, 0xce, 0xba, 0x79, 0x69, 0x38, 0x03, 0xac, 0x6c, 0xa7,
+	0x5c, 0xa6, 0x19, 0x7a, 0x4f, 0x0c, 0xea, 0x73, 0x29, 0xba, 0x9c, 0x15, 0xbd, 0xc3, 0x97, 0x8c,
+	0x8f, 0x1d, 0x19, 0xcd, 0x0e, 0x3f, 0x36, 0x0a, 0xbd, 0xb3, 0x68, 0x2a, 0xb2, 0x9c, 0xd7, 0x61,
+	0x35, 0xf4, 0x74, 0x33, 0x20, 0x04, 0x3b, 0xbe, 0x30, 0xe7, 0x57, 0x85, 0x85, 0x85, 0x5e, 0x57,
+	0x50, 0xb9, 0x2d, 0xbf, 0x05, 0x28, 0x81, 0x8b, 0xd6, 0x6d, 0x49, 0xcb, 0x8f, 0xa0, 0xd1, 0xaa,
+	0x0f, 0x61, 0x9d, 0xdd, 0x67, 0x30, 0x1a, 0x19, 0x64, 0xac, 0x5b, 0xf6, 0x00, 0xb3, 0x43, 0x12,
+	0x5b, 0xb9, 0xc7, 0x43, 0x0e, 0x0a, 0xbd, 0xbe, 0x98, 0x3b, 0xe0, 0x53, 0x7d, 0x62, 0xa3, 0x27,
+	0xd0, 0x0c, 0x3d, 0x9d, 0xe9, 0x57, 0x27, 0xf8, 0xcb, 0x00, 0x53, 0x66, 0x54, 0xf7, 0x53, 0x5e,
+	0xc5, 0x6a, 0xe8, 0x9d, 0x60, 0x32, 0xd2, 0x22, 0x46, 0xf4, 0x63, 0x28, 0xd3, 0x80, 0x7a, 0x22,
+	0x4e, 0xbc, 0x96, 0x72, 0x95, 0x98, 0x05, 0x7d, 0x00, 0xd5, 0xc9, 0x80, 0x19, 0xe6, 0xeb, 0x4b,
+	0x0c, 0xb3, 0x32, 0x41, 0x77, 0xc6, 0xcc, 0x49, 0x88, 0x21, 0x0b, 0xbb, 0x3a, 0xc1, 0x06, 0x75,
+	0x1d, 0xe5, 0x0d, 0x7e, 0xf2, 0x46, 0x3c, 0xa1, 0x71, 0x3a, 0x73, 0x94, 0xf1, 0x4e, 0xc2, 0x51,
+	0xee, 0xa6, 0x75, 0x94, 0x13, 0x46, 0xe1, 0x28, 0x1f, 0xc2, 0xba, 0xe9, 0xea, 0x73, 0x46, 0x4f,
+	0x95, 0xff, 0xdb, 0xc9, 0x31, 0xf5, 0x9b, 0xee, 0xd9, 0xb4, 0xdd, 0x53, 0x96, 0x5a, 0x84, 0x9e,
+	0xfe, 0x65, 0xe0, 0x92, 0x60, 0xa4, 0x3c, 0xd8, 0xc9, 0xec, 0xd6, 0xb4, 0x95, 0xd0, 0xfb, 0x94,
+	0x8f, 0x51, 0x17, 0xaa, 0xa1, 0xa7, 0x1b, 0x9e, 0x47, 0xdc, 0xd0, 0x18, 0x52, 0xe5, 0xcd, 0x9d,
+	0x1c, 0x97, 0x6b, 0xf6, 0x65, 0x1c, 0xb7, 0x25, 0xa4, 0x93, 0xff, 0xfa, 0x1f, 0xdb, 0xb7, 0xb4,
+	0x4a, 0xe8, 0x45, 0x14, 0x8a, 0x0c, 0x50, 0x98, 0xe1, 0xb8, 0xf3, 0x56, 0x49, 0x95, 0xb7, 0xf8,
+	0x82, 0xad, 0xd9, 0x05, 0xbb, 0xee, 0xac, 0x99, 0xca, 0x85, 0xd7, 0x43, 0x6f, 0x7e, 0x8e, 0x22,
+	0x15, 0xea, 0x2c, 0x1d, 0xb2, 0x82, 0x21, 0xb6, 0x84, 0x09, 0xbf, 0xcd, 0x6f, 0xf0, 0x39, 0xc9,
+	0x10, 0xb3, 0x69, 0xad, 0x36, 0xe1, 0x62, 0xc3, 0xd6, 0x5f, 0xb2, 0xd0, 0x8c, 0x11, 0x7d, 0x3c,
+	0x9b, 0x8c, 0x95, 0x79, 0x32, 0xb6, 0x05, 0x60, 0xba, 0x8e, 0x4f, 0xdc, 0xe1, 0x10, 0x13, 0x9e,
+	0x8d, 0x95, 0xb5, 0x04, 0x05, 0xb5, 0xa0, 0x66, 0x0c, 0xd8, 0x1b, 0x89, 0x5c, 0x4e, 0x8e, 0xbf,
+	0x91, 0x0a, 0x27, 0x4a, 0x77, 0xf3, 0x08, 0x0a, 0x46, 0xe0, 0x5f, 0xfe, 0x42, 0xc9, 0xf3, 0x0b,
+	0xd8, 0x9c, 0x95, 0x53, 0xee, 0xdd, 0x66, 0x18, 0x4d, 0x40, 0x93, 0xd9, 0x56, 0xe1, 0xa6, 0xd9,
+	0x56, 0x32, 0x82, 0x16, 0x6f, 0x1c, 0x41, 0x1f, 0x43, 0x09, 0x5f, 0x79, 0x36, 0xc1, 0x54, 0x29,
+	0xa5, 0x64, 0x8e, 0x18, 0x5a, 0xbf, 0xc9, 0x40, 0x35, 0x79, 0x1a, 0xb4, 0x0b, 0x0d, 0x7c, 0x85,
+	0xcd, 0x20, 0xe9, 0x94, 0x45, 0xa6, 0x5b, 0x8f, 0xe8, 0xf2, 0x92, 0xf6, 0xe0, 0xf6, 0x39, 0x76,
+	0xf0, 0x85, 0x6d, 0xda, 0xcc, 0x89, 0x44, 0x60, 0x91, 0xff, 0x36, 0x13, 0x53, 0x12, 0xbf, 0x0f,
+	0x6b, 0xcf, 0x8c, 0xe1, 0x10, 0xfb, 0xfa, 0xa2, 0xfb, 0x6f, 0x8a, 0xb9, 0x76, 0xac, 0x85, 0xd6,
+	0x9f, 0x73, 0xd0, 0x88, 0x64, 0x1b, 0x0e, 0xdd, 0x67, 0x2c, 0xc8, 0xcf, 0xe5, 0xde, 0x0a, 0x94,
+	0xa8, 0xe7, 0x3a, 0xd4, 0x8d, 0x74, 0x1d, 0x0d, 0xa7, 0xb3, 0xf2, 0xdc, 0x4c, 0x56, 0x3e, 0x67,
+	0x05, 0xf9, 0x79, 0x2b, 0xd8, 0x05, 0x99, 0x32, 0xe0, 0xf8, 0x2a, 0x0a, 0x32, 0x35, 0x91, 0x74,
+	0x89, 0xdc, 0x64, 0xc9, 0xdc, 0xc8, 0xb0, 0x1d, 0xdb, 0x19, 0x70, 0x05, 0xe6, 0xb5, 0x98, 0x80,
+	0xd6, 0xa0, 0xc0, 0xdc, 0x80, 0xcf, 0xb5, 0x93, 0xd7, 0xc4, 0x20, 0x69, 0x2f, 0x2b, 0xdf, 0xc7,
+	0x5e, 0xca, 0x37, 0xb6, 0x97, 0x2e, 0x34, 0x0c, 0x76, 0x9f, 0xd8, 0xd2, 0xf9, 0x82, 0x2e, 0xa1,
+	0x0a, 0xec, 0xe4, 0x9e, 0xeb, 0x3f, 0x57, 0x25, 0x47, 0x57, 0x32, 0xb0, 0xb8, 0x34, 0x32, 0xae,
+	0xd8, 0xbd, 0xe8, 0x54, 0xe8, 0x88, 0xe7, 0xcd, 0x79, 0xad, 0x36, 0x32, 0xae, 0x8e, 0x31, 0x91,
+	0x8a, 0x6b, 0xfd, 0xae, 0x08, 0x95, 0x3e, 0xcb, 0x99, 0x34, 0x6c, 0xba, 0xc4, 0x9a, 0xd3, 0xdf,
+	0xcb, 0x50, 0x9a, 0xb6, 0x9c, 0xa2, 0x17, 0xbd, 0xc1, 0x49, 0xc5, 0x93, 0x4b, 0x5b, 0xf1, 0xbc,
+	0x04, 0x45, 0x63, 0xc4, 0x12, 0x0c, 0xa9, 0x4e, 0x39, 0x9a, 0xc9, 0xef, 0x0b, 0xe9, 0xf3, 0xfb,
+	0x77, 0xa1, 0xc8, 0x42, 0x7f, 0x40, 0xb9, 0x56, 0xeb, 0x8f, 0xee, 0xce, 0x79, 0x02, 0x06, 0xed,
+	0x73, 0x88, 0x26, 0xa1, 0x49, 0xcd, 0x96, 0x6e, 0xaa, 0xd9, 0x1e, 0xac, 0x1a, 0x9e, 0x87, 0x8d,
+	0xa1, 0x6e, 0x61, 0xc3, 0x1a, 0xda, 0x0e, 0x4e, 0x6d, 0x1d, 0x75, 0xc1, 0x78, 0x20, 0xf9, 0x98,
+	0x91, 0x08, 0xca, 0x4d, 0x8c, 0x24, 0xe2, 0x60, 0xd9, 0x59, 0x24, 0x48, 0x9c, 0x1d, 0x00, 0x7f,
+	0x61, 0x52, 0xc2, 0x38, 0x35, 0xf8, 0x10, 0x56, 0x08, 0xa6, 0xee, 0x30, 0xc4, 0x96, 0x52, 0x49,
+	0xbb, 0x53, 0xc4, 0xc1, 0x6a, 0xbe, 0xe8, 0x9b, 0x69, 0xa7, 0xba, 0xac, 0xe6, 0x8b, 0xc0, 0x9d,
+	0x31, 0xda, 0x66, 0xac, 0x2c, 0x4a, 0xeb, 0xa6, 0x6b, 0x61, 0x5e, 0x46, 0xd5, 0x34, 0x10, 0xa4,
+	0xae, 0x6b, 0x61, 0xf4, 0x2a, 0x54, 0x71, 0x68, 0x5b, 0x98, 0xd5, 0x0c, 0x01, 0x19, 0xf2, 0x62,
+	0xa9, 0xac, 0x55, 0x22, 0xda, 0x29, 0x19, 0x32, 0x37, 0x36, 0x81, 0x24, 0x8e, 0xba, 0xca, 0x91,
+	0xcd, 0x68, 0x2a, 0x3e, 0xec, 0x2b, 0x00, 0x83, 0x0b, 0x3d, 0xc4, 0x84, 0xdb, 0x3c, 0xab, 0x77,
+	0x0a, 0x5a, 0x79, 0x70, 0x71, 0x26, 0x08, 0xa8, 0x03, 0x55, 0xcb, 0xa6, 0x3e, 0xb1, 0xcf, 0x03,
+	0x96, 0x8b, 0x2a, 0x4d, 0x1e, 0x41, 0x94, 0xc8, 0x6e, 0x7c, 0x2b, 0xb6, 0x9a, 0x4b, 0x83, 0x60,
+	0x19, 0x38, 0xa7, 0x78, 0x5a, 0x63, 0xd8, 0x3a, 0x21, 0x01, 0xf5, 0x35, 0x3c, 0x60, 0xe4, 0x31,
+	0x87, 0x1f, 0x24, 0x10, 0xe8, 0x36, 0x14, 0x7c, 0x12, 0xbb, 0xe6, 0xbc, 0x4f, 0x7a, 0xd6, 0xdc,
+	0xd6, 0xd9, 0x17, 0xd8, 0xfa, 0xb7, 0x59, 0x68, 0x6a, 0x38, 0x74, 0x45, 0x95, 0xd4, 0x35, 0xa8,
+	0x69, 0x58, 0x38, 0xf9, 0x48, 0x33, 0x53, 0x8f, 0xf4, 0x3e, 0xd4, 0x5d, 0x62, 0x0f, 0x6c, 0x67,
+	0xc6, 0xfd, 0x57, 0x05, 0x75, 0xe2, 0xf9, 0xf3, 0x23, 0xd7, 0x12, 0xef, 0x78, 0xc1, 0x1b, 0x92,
+	0xbb, 0x3c, 0x75, 0x2d, 0xac, 0x71, 0xa0, 0x68, 0x03, 0xb0, 0x60, 0x23, 0x4c, 0x22, 0xbf, 0xbc,
+	0x0d, 0x20, 0xc0, 0xa2, 0x0d, 0xf0, 0xc2, 0x61, 0xb8, 0x05, 0x35, 0x9e, 0xed, 0x9b, 0x97, 0xf6,
+	0xd0, 0x62, 0x87, 0x11, 0xae, 0xbc, 0xc2, 0x88, 0x5d, 0x46, 0xeb, 0x59, 0xad, 0xff, 0x64, 0xa0,
+	0xc2, 0x83, 0x94, 0x86, 0x9f, 0x19, 0xc4, 0xba, 0xfe, 0x6a, 0xde, 0x02, 0x14, 0x50, 0x4c, 0x64,
+	0xb0, 0x23, 0x1c, 0x4d, 0xe5, 0xf5, 0x34, 0xd8, 0x4c, 0x62, 0x15, 0xca, 0x0a, 0x83, 0xa9, 0xe0,
+	0x18, 0xe1, 0x45, 0xdc, 0x42, 0x89, 0xe0, 0x18, 0x71, 0xdc, 0x83, 0x9a, 0xcf, 0x8c, 0x64, 0x92,
+	0xeb, 0x0b, 0x97, 0x57, 0xe5, 0xc4, 0x28, 0xcf, 0x6f, 0x03, 0x17, 0x5e, 0x2e, 0x97, 0xfa, 0x46,
+	0x80, 0x31, 0x89, 0x8d, 0x5a, 0xbf, 0xca, 0x03, 0x3a, 0x4b, 0x54, 0xce, 0x5d, 0x82, 0x2d, 0xdb,
+	0x9f, 0xf3, 0xe3, 0x7b, 0x50, 0x38, 0x0f, 0xc6, 0x51, 0xc6, 0xf5, 0x1c, 0x65, 0x09, 0xd8, 0xc2,
+	0xe0, 0x9a, 0x5b, 0x18, 0x5c, 0xef, 0x43, 0x9d, 0x95, 0xf8, 0x09, 0x5c, 0x3e, 0x2e, 0xfc, 0x27,
+	0xa8, 0x45, 0x79, 0x4b, 0x61, 0x61, 0xde, 0xb2, 0x09, 0x65, 0x2f, 0x20, 0xe6, 0xa5, 0x41, 0x71,
+	0xa4, 0xe1, 0x98, 0x30, 0x1d, 0xca, 0x4b, 0xb3, 0xa1, 0xfc, 0x2e, 0x94, 0x03, 0xc7, 0x96, 0x75,
+	0xd8, 0x8a, 0xc8, 0x29, 0x18, 0x21, 0x2a, 0xc1, 0xf8, 0xe4, 0xb4, 0x5a, 0xca, 0x52, 0xe3, 0x8e,
+	0xed, 0x9f, 0x24, 0x55, 0x93, 0x30, 0x54, 0xf8, 0x3e, 0xf1, 0xbf, 0x72, 0xe3, 0xf8, 0xcf, 0xdd,
+	0xf5, 0x45, 0xc0, 0x4b, 0xaf, 0x6a, 0x7a, 0x77, 0x2d, 0x38, 0xd8, 0x03, 0xa8, 0xcb, 0xe0, 0x7e,
+	0xe6, 0x06, 0xe6, 0x25, 0x26, 0xe8, 0x1d, 0x28, 0x52, 0x7b, 0xe0, 0x60, 0xa2, 0x64, 0x96, 0x28,
+	0x5f, 0xe2, 0x16, 0x6a, 0x2b, 0x7b, 0x93, 0x2c, 0x33, 0x77, 0x5d, 0x96, 0x99, 0x26, 0xb1, 0x5b,
+	0x83, 0x82, 0xe3, 0x3a, 0x26, 0x96, 0x06, 0x22, 0x06, 0x89, 0xe4, 0xa1, 0x98, 0x4c, 0x1e, 0x5a,
+	0x04, 0xd6, 0xa7, 0xcf, 0xdb, 0xb7, 0x07, 0xce, 0x81, 0x6b, 0xa2, 0x3b, 0xb0, 0x62, 0x5e, 0x1a,
+	0xb6, 0xa3, 0x4f, 0xea, 0x8f, 0x12, 0x1f, 0xf7, 0x58, 0x79, 0x5b, 0x0a, 0x05, 0x58, 0xc9, 0x2e,
+	0x2e, 0x75, 0xa6, 0x97, 0x94, 0xbe, 0x38, 0x62, 0x6a, 0x3d, 0x83, 0xaa, 0x9c, 0x39, 0xe4, 0xb2,
+	0xdd, 0xfc, 0x86, 0x27, 0x67, 0xcc, 0x26, 0xcf, 0xb8, 0x0d, 0x95, 0x80, 0x62, 0x4b, 0xe7, 0x23,
+	0xe6, 0x5d, 0x58, 0xdd, 0x09, 0x8c, 0xc4, 0xf7, 0xa1, 0xad, 0x7f, 0x67, 0xa0, 0x7c, 0x76, 0xfc,
+	0x14, 0x53, 0x6a, 0x0c, 0x9e, 0xe3, 0xf7, 0xd7, 0xa0, 0x60, 0x3b, 0x16, 0xbe, 0x8a, 0x56, 0xe7,
+	0x03, 0x2e, 0x25, 0x2b, 0x77, 0xc9, 0xd2, 0x8c, 0x4d, 0xe2, 0x58, 0x30, 0x4d, 0xc4, 0x5c, 0xd1,
+	0xef, 0x2e, 0x5b, 0x93, 0x58, 0x7b, 0x0f, 0x6a, 0xd8, 0x31, 0xc9, 0xd8, 0x63, 0x81, 0x20, 0x20,
+	0xb6, 0x48, 0xdd, 0xb4, 0xea, 0x84, 0x78, 0x4a, 0xec, 0xef, 0xd3, 0xe6, 0x6e, 0xfd, 0x29, 0x07,
+	0x10, 0x97, 0xc7, 0x8b, 0x5b, 0x52, 0x99, 0xc5, 0x2d, 0xa9, 0xff, 0xe7, 0xe9, 0x15, 0x71, 0xc3,
+	0x14, 0x3e, 0x6f, 0x82, 0x5c, 0xd4, 0x76, 0xcd, 0xbd, 0x60, 0xdb, 0x75, 0x41, 0xe3, 0x34, 0x9f,
+	0xae, 0x71, 0x5a, 0x48, 0xdb, 0x38, 0x2d, 0x5e, 0xd3, 0x38, 0x9d, 0x6a, 0xec, 0x95, 0x66, 0x1b,
+	0x7b, 0xd7, 0x36, 0x97, 0x56, 0xae, 0x6d, 0x2e, 0x25, 0x74, 0x58, 0xbe, 0xa9, 0x0e, 0x3f, 0x07,
+	0x34, 0xdf, 0x74, 0xb8, 0x91, 0x2a, 0x13, 0x7d, 0xe0, 0xec, 0x54, 0x1f, 0xb8, 0xf5, 0xb7, 0x0c,
+	0xd4, 0xa7, 0x9b, 0x12, 0x8b, 0xae, 0x3d, 0x93, 0xee, 0xda, 0xb3, 0x69, 0xaf, 0x3d, 0x77, 0xcd,
+	0xb5, 0xcf, 0x37, 0xff, 0xf3, 0x2f, 0xd4, 0xfc, 0x6f, 0xfd, 0x21, 0x03, 0xb7, 0xa7, 0x8e, 0x25,
+	0x8b, 0xb3, 0x1b, 0xbe, 0xf7, 0xf7, 0x21, 0x3f, 0x91, 0x77, 0x69, 0x37, 0x47, 0xba, 0x38, 0xce,
+	0xc1, 0x0a, 0xb2, 0xc0, 0xb3, 0x8c, 0x94, 0xf9, 0x5d, 0x59, 0x62, 0x3b, 0xe3, 0x07, 0xff, 0x9d,
+	0x52, 0x08, 0xfb, 0xcb, 0x0c, 0x6d, 0xc3, 0xdd, 0x63, 0x55, 0x7b, 0xda, 0xeb, 0xf7, 0x7b, 0x47,
+	0x87, 0xfa, 0xc9, 0x67, 0xc7, 0xaa, 0x7e, 0x7a, 0xd8, 0x3f, 0x56, 0xbb, 0xbd, 0x8f, 0x7a, 0xea,
+	0x41, 0xe3, 0x16, 0xda, 0x80, 0x97, 0x66, 0x01, 0xbd, 0x7e, 0xff, 0x54, 0xd5, 0x1a, 0x19, 0xb4,
+	0x09, 0xca, 0xec, 0xdc, 0x99, 0xaa, 0x31, 0x4e, 0xad, 0x91, 0x45, 0x2d, 0xd8, 0x5a, 0xcc, 0xa9,
+	0x7f, 0xac, 0xb5, 0x0f, 0x4f, 0x8e, 0xb4, 0x46, 0x0e, 0xdd, 0x87, 0x9d, 0xeb, 0x56, 0x98, 0xa0,
+	0xf2, 0xe8, 0x15, 0xb8, 0x33, 0x8b, 0x52, 0xbb, 0x47, 0xfd, 0xcf, 0xfa, 0x27, 0xea, 0xd3, 0x46,
+	0x61, 0x91, 0x88, 0x9f, 0x1c, 0x3d, 0x39, 0x50, 0xb5, 0x46, 0xf1, 0xc1, 0x1f, 0x33, 0xb0, 0x3a,
+	0xd3, 0xdc, 0x46, 0x3b, 0xb0, 0x79, 0xd6, 0x7e, 0xd2, 0x3b, 0x68, 0x9f, 0x30, 0x7c, 0xff, 0xa4,
+	0x7d, 0x32, 0x7b, 0xe8, 0x4d, 0x50, 0xe6, 0x10, 0xc7, 0xea, 0xe1, 0x41, 0xef, 0xf0, 0xe3, 0x46,
+	0x06, 0x6d, 0xc1, 0xc6, 0xdc, 0xac, 0x24, 0xa8, 0x07, 0x8d, 0x2c, 0xbb, 0xd3, 0xb9, 0xf9, 0x13,
+	0x26, 0xe0, 0x21, 0x07, 0xe4, 0xd0, 0x03, 0x78, 0xfd, 0x5a, 0x00, 0xa3, 0x68, 0xea, 0xa7, 0xa7,
+	0x6a, 0x9f, 0x61, 0xf3, 0x0f, 0x7e, 0x9d, 0x91, 0x2d, 0x00, 0x51, 0x27, 0x33, 0xd1, 0xfa, 0x4f,
+	0xda, 0xfd, 0x4f, 0x38, 0xdb, 0x69, 0x7f, 0x46, 0x70, 0x05, 0xd6, 0xa6, 0x66, 0x63, 0xa1, 0xef,
+	0xc0, 0xfa, 0xd4, 0x4c, 0xfb, 0xf8, 0x58, 0x6d, 0x3f, 0xe1, 0xf2, 0xce, 0x4e, 0xa9, 0x3f, 0x57,
+	0xbb, 0xa7, 0x42, 0xd2, 0xd9, 0x29, 0x4d, 0x3d, 0x53, 0xb5, 0x3e, 0x17, 0xec, 0x67, 0x50, 0x49,
+	0xd4, 0x1e, 0x68, 0x1d, 0x9a, 0xdd, 0x76, 0xbf, 0xdb, 0x3e, 0x50, 0xf5, 0xa7, 0x47, 0x07, 0xaa,
+	0x7e, 0x78, 0x74, 0xa8, 0x36, 0x6e, 0xa1, 0x97, 0xe1, 0xf6, 0x14, 0x59, 0x53, 0xcf, 0x8e, 0x7e,
+	0xaa, 0x36, 0x32, 0x4c, 0xd2, 0xa9, 0x89, 0xfe, 0x69, 0x9f, 0x09, 0xdb, 0xc8, 0x76, 0x4e, 0xbf,
+	0xfe, 0x76, 0x2b, 0xf3, 0xcd, 0xb7, 0x5b, 0x99, 0x7f, 0x7d, 0xbb, 0x95, 0xf9, 0xea, 0xbb, 0xad,
+	0x5b, 0xdf, 0x7c, 0xb7, 0x75, 0xeb, 0xef, 0xdf, 0x6d, 0xdd, 0xfa, 0xfc, 0x83, 0x81, 0xed, 0x5f,
+	0x06, 0xe7, 0x7b, 0xa6, 0x3b, 0xda, 0x17, 0xcf, 0xe5, 0xed, 0xa1, 0x71, 0x4e, 0xa3, 0xef, 0xf3,
+	0xa1, 0x6b, 0x7e, 0xc1, 0x93, 0x88, 0xfd, 0xab, 0x7d, 0x6f, 0x62, 0xe7, 0xe2, 0xcf, 0xe8, 0xf3,
+	0x22, 0x7f, 0xdf, 0xef, 0xfe, 0x6f, 0x00, 0xad, 0xde, 0x07, 0x92, 0x3a, 0x1f, 0x00, 0x00,
+}
+
+func (m *Permission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Permission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Permission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ExecutorPermId != 0 {
-		n += 1 + sovTypes(uint64(m.ExecutorPermId))
+	if m.ScheduledFees != nil {
+		{
+			size, err := m.ScheduledFees.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xea
 	}
-	if m.BeneficiaryPermId != 0 {
-		n += 1 + sovTypes(uint64(m.BeneficiaryPermId))
+	if len(m.VpCoValidatorDeposits) > 0 {
+		for iNdEx := len(m.VpCoValidatorDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VpCoValidatorDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xe2
+		}
 	}
-	if m.WalletAgentPermId != 0 {
-		n += 1 + sovTypes(uint64(m.WalletAgentPermId))
+	if len(m.VpApprovals) > 0 {
+		for iNdEx := len(m.VpApprovals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VpApprovals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if m.VpQuorum != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.VpQuorum))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd0
+	}
+	if len(m.CoValidatorPermIds) > 0 {
+		dAtA3 := make([]byte, len(m.CoValidatorPermIds)*10)
+		var j2 int
+		for _, num := range m.CoValidatorPermIds {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintTypes(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.SuspendedUntil != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SuspendedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SuspendedUntil):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintTypes(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc2
+	}
+	if len(m.SuspensionReason) > 0 {
+		i -= len(m.SuspensionReason)
+		copy(dAtA[i:], m.SuspensionReason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SuspensionReason)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.SuspendedBy) > 0 {
+		i -= len(m.SuspendedBy)
+		copy(dAtA[i:], m.SuspendedBy)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SuspendedBy)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.Suspended != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Suspended, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Suspended):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintTypes(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.VpTermRequested != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VpTermRequested, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VpTermRequested):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintTypes(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.VpSummaryDigestSri) > 0 {
+		i -= len(m.VpSummaryDigestSri)
+		copy(dAtA[i:], m.VpSummaryDigestSri)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.VpSummaryDigestSri)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.VpCurrentDeposit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.VpCurrentDeposit))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.VpCurrentFees != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.VpCurrentFees))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.VpValidatorDeposit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.VpValidatorDeposit))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.VpLastStateChange != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VpLastStateChange, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VpLastStateChange):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintTypes(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
+	}
+	if m.VpExp != nil {
+		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VpExp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VpExp):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintTypes(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
+	if m.VpState != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.VpState))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	if m.ValidatorPermId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ValidatorPermId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if len(m.Countries) > 0 {
+		for iNdEx := len(m.Countries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Countries[iNdEx])
+			copy(dAtA[i:], m.Countries[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Countries[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.TerminatedBy) > 0 {
+		i -= len(m.TerminatedBy)
+		copy(dAtA[i:], m.TerminatedBy)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TerminatedBy)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if m.Terminated != nil {
+		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Terminated, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Terminated):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintTypes(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.RevokedBy) > 0 {
+		i -= len(m.RevokedBy)
+		copy(dAtA[i:], m.RevokedBy)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RevokedBy)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.Revoked != nil {
+		n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Revoked, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Revoked):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintTypes(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.RepaidDeposit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RepaidDeposit))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.SlashedDeposit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SlashedDeposit))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.Deposit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Deposit))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.VerificationFees != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.VerificationFees))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.IssuanceFees != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.IssuanceFees))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.ValidationFees != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ValidationFees))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.Modified != nil {
+		n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Modified, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Modified):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintTypes(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.EffectiveUntil != nil {
+		n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil):])
+		if err12 != nil {
+			return 0, err12
+		}
+		i -= n12
+		i = encodeVarintTypes(dAtA, i, uint64(n12))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.EffectiveFrom != nil {
+		n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveFrom, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveFrom):])
+		if err13 != nil {
+			return 0, err13
+		}
+		i -= n13
+		i = encodeVarintTypes(dAtA, i, uint64(n13))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.RepaidBy) > 0 {
+		i -= len(m.RepaidBy)
+		copy(dAtA[i:], m.RepaidBy)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RepaidBy)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Repaid != nil {
+		n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Repaid, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Repaid):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintTypes(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.SlashedBy) > 0 {
+		i -= len(m.SlashedBy)
+		copy(dAtA[i:], m.SlashedBy)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SlashedBy)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Slashed != nil {
+		n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Slashed, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Slashed):])
+		if err15 != nil {
+			return 0, err15
+		}
+		i -= n15
+		i = encodeVarintTypes(dAtA, i, uint64(n15))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ExtendedBy) > 0 {
+		i -= len(m.ExtendedBy)
+		copy(dAtA[i:], m.ExtendedBy)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ExtendedBy)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Extended != nil {
+		n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Extended, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Extended):])
+		if err16 != nil {
+			return 0, err16
+		}
+		i -= n16
+		i = encodeVarintTypes(dAtA, i, uint64(n16))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.CreatedBy) > 0 {
+		i -= len(m.CreatedBy)
+		copy(dAtA[i:], m.CreatedBy)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CreatedBy)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Created != nil {
+		n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Created, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created):])
+		if err17 != nil {
+			return 0, err17
+		}
+		i -= n17
+		i = encodeVarintTypes(dAtA, i, uint64(n17))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Type != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SchemaId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SchemaId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PermissionSession) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PermissionSession) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PermissionSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expires != nil {
+		n18, err18 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expires, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expires):])
+		if err18 != nil {
+			return 0, err18
+		}
+		i -= n18
+		i = encodeVarintTypes(dAtA, i, uint64(n18))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Modified != nil {
+		n19, err19 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Modified, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Modified):])
+		if err19 != nil {
+			return 0, err19
+		}
+		i -= n19
+		i = encodeVarintTypes(dAtA, i, uint64(n19))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Created != nil {
+		n20, err20 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Created, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created):])
+		if err20 != nil {
+			return 0, err20
+		}
+		i -= n20
+		i = encodeVarintTypes(dAtA, i, uint64(n20))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Authz) > 0 {
+		for iNdEx := len(m.Authz) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Authz[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.AgentPermId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AgentPermId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Controller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SessionAuthz) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SessionAuthz) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SessionAuthz) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WalletAgentPermId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.WalletAgentPermId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BeneficiaryPermId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BeneficiaryPermId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ExecutorPermId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExecutorPermId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SessionAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SessionAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SessionAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPerSession != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxPerSession))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.AllowedCreators) > 0 {
+		for iNdEx := len(m.AllowedCreators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedCreators[iNdEx])
+			copy(dAtA[i:], m.AllowedCreators[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.AllowedCreators[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Modified != nil {
+		n21, err21 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Modified, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Modified):])
+		if err21 != nil {
+			return 0, err21
+		}
+		i -= n21
+		i = encodeVarintTypes(dAtA, i, uint64(n21))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Created != nil {
+		n22, err22 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Created, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created):])
+		if err22 != nil {
+			return 0, err22
+		}
+		i -= n22
+		i = encodeVarintTypes(dAtA, i, uint64(n22))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Spent != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Spent))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Remaining != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Remaining))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.VerifierPermId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.VerifierPermId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AgentPermId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AgentPermId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SchemaId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SchemaId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Distribution) > 0 {
+		for iNdEx := len(m.Distribution) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distribution[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.GfVersion != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GfVersion))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.EvidenceDigestSri) > 0 {
+		i -= len(m.EvidenceDigestSri)
+		copy(dAtA[i:], m.EvidenceDigestSri)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvidenceDigestSri)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.EvidenceUrl) > 0 {
+		i -= len(m.EvidenceUrl)
+		copy(dAtA[i:], m.EvidenceUrl)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvidenceUrl)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.ReasonCode != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ReasonCode))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.ResolvedBy) > 0 {
+		i -= len(m.ResolvedBy)
+		copy(dAtA[i:], m.ResolvedBy)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ResolvedBy)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Resolved != nil {
+		n23, err23 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Resolved, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Resolved):])
+		if err23 != nil {
+			return 0, err23
+		}
+		i -= n23
+		i = encodeVarintTypes(dAtA, i, uint64(n23))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.AppealDigestSri) > 0 {
+		i -= len(m.AppealDigestSri)
+		copy(dAtA[i:], m.AppealDigestSri)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.AppealDigestSri)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Appealed != nil {
+		n24, err24 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Appealed, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Appealed):])
+		if err24 != nil {
+			return 0, err24
+		}
+		i -= n24
+		i = encodeVarintTypes(dAtA, i, uint64(n24))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.AppealDeadline != nil {
+		n25, err25 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.AppealDeadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.AppealDeadline):])
+		if err25 != nil {
+			return 0, err25
+		}
+		i -= n25
+		i = encodeVarintTypes(dAtA, i, uint64(n25))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Created != nil {
+		n26, err26 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Created, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created):])
+		if err26 != nil {
+			return 0, err26
+		}
+		i -= n26
+		i = encodeVarintTypes(dAtA, i, uint64(n26))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.SlashedBy) > 0 {
+		i -= len(m.SlashedBy)
+		copy(dAtA[i:], m.SlashedBy)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SlashedBy)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PermId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PermId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TrustRegistrySlashDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrustRegistrySlashDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrustRegistrySlashDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Distribution) > 0 {
+		for iNdEx := len(m.Distribution) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distribution[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.TrId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TrId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RevocationCascade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevocationCascade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevocationCascade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastChildId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LastChildId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Created != nil {
+		n27, err27 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Created, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created):])
+		if err27 != nil {
+			return 0, err27
+		}
+		i -= n27
+		i = encodeVarintTypes(dAtA, i, uint64(n27))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ExecutedBy) > 0 {
+		i -= len(m.ExecutedBy)
+		copy(dAtA[i:], m.ExecutedBy)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ExecutedBy)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Mode != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OriginPermId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.OriginPermId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PermId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PermId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AgentReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AgentReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AgentReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastReward != nil {
+		n28, err28 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastReward, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastReward):])
+		if err28 != nil {
+			return 0, err28
+		}
+		i -= n28
+		i = encodeVarintTypes(dAtA, i, uint64(n28))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TrustDeposit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TrustDeposit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WalletAgentRewards != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.WalletAgentRewards))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.UserAgentRewards != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UserAgentRewards))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PermId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PermId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerificationCredit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerificationCredit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerificationCredit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Refunded != nil {
+		n29, err29 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Refunded, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Refunded):])
+		if err29 != nil {
+			return 0, err29
+		}
+		i -= n29
+		i = encodeVarintTypes(dAtA, i, uint64(n29))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Modified != nil {
+		n30, err30 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Modified, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Modified):])
+		if err30 != nil {
+			return 0, err30
+		}
+		i -= n30
+		i = encodeVarintTypes(dAtA, i, uint64(n30))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Created != nil {
+		n31, err31 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Created, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created):])
+		if err31 != nil {
+			return 0, err31
+		}
+		i -= n31
+		i = encodeVarintTypes(dAtA, i, uint64(n31))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.UnitTrustDeposit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UnitTrustDeposit))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.UnitFees != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UnitFees))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Remaining != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Remaining))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Purchased != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Purchased))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ExecutorPermId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExecutorPermId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.IssuerPermId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.IssuerPermId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.VerifierPermId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.VerifierPermId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SessionVoucher) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SessionVoucher) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SessionVoucher) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AgentPermId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AgentPermId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BeneficiaryPermId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BeneficiaryPermId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ExecutorPermId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExecutorPermId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SessionVoucherSignDoc) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SessionVoucherSignDoc) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SessionVoucherSignDoc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Voucher.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoucherNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoucherNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoucherNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UsedNonces) > 0 {
+		dAtA34 := make([]byte, len(m.UsedNonces)*10)
+		var j33 int
+		for _, num := range m.UsedNonces {
+			for num >= 1<<7 {
+				dAtA34[j33] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j33++
+			}
+			dAtA34[j33] = uint8(num)
+			j33++
+		}
+		i -= j33
+		copy(dAtA[i:], dAtA34[:j33])
+		i = encodeVarintTypes(dAtA, i, uint64(j33))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VPMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VPMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VPMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Created != nil {
+		n35, err35 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Created, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created):])
+		if err35 != nil {
+			return 0, err35
+		}
+		i -= n35
+		i = encodeVarintTypes(dAtA, i, uint64(n35))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.EncryptedUri) > 0 {
+		i -= len(m.EncryptedUri)
+		copy(dAtA[i:], m.EncryptedUri)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EncryptedUri)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DigestSri) > 0 {
+		i -= len(m.DigestSri)
+		copy(dAtA[i:], m.DigestSri)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.DigestSri)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Index != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PermId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PermId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VPApproval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VPApproval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VPApproval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Created != nil {
+		n36, err36 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Created, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created):])
+		if err36 != nil {
+			return 0, err36
+		}
+		i -= n36
+		i = encodeVarintTypes(dAtA, i, uint64(n36))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.VpSummaryDigestSri) > 0 {
+		i -= len(m.VpSummaryDigestSri)
+		copy(dAtA[i:], m.VpSummaryDigestSri)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.VpSummaryDigestSri)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Countries) > 0 {
+		for iNdEx := len(m.Countries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Countries[iNdEx])
+			copy(dAtA[i:], m.Countries[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Countries[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.VerificationFees != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.VerificationFees))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.IssuanceFees != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.IssuanceFees))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ValidationFees != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ValidationFees))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EffectiveUntil != nil {
+		n37, err37 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil):])
+		if err37 != nil {
+			return 0, err37
+		}
+		i -= n37
+		i = encodeVarintTypes(dAtA, i, uint64(n37))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Approver) > 0 {
+		i -= len(m.Approver)
+		copy(dAtA[i:], m.Approver)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Approver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ValidatorPermId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ValidatorPermId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CoValidatorDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CoValidatorDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CoValidatorDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deposit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Deposit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ValidatorPermId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ValidatorPermId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PermissionFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PermissionFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PermissionFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EffectiveFrom != nil {
+		n38, err38 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EffectiveFrom, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveFrom):])
+		if err38 != nil {
+			return 0, err38
+		}
+		i -= n38
+		i = encodeVarintTypes(dAtA, i, uint64(n38))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.VerificationFees != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.VerificationFees))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IssuanceFees != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.IssuanceFees))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ValidationFees != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ValidationFees))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PermissionFeeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PermissionFeeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PermissionFeeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Fees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Index != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PermId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PermId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Permission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	if m.SchemaId != 0 {
+		n += 1 + sovTypes(uint64(m.SchemaId))
+	}
+	if m.Type != 0 {
+		n += 1 + sovTypes(uint64(m.Type))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Created != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.CreatedBy)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Extended != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Extended)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ExtendedBy)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Slashed != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Slashed)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.SlashedBy)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Repaid != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Repaid)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.RepaidBy)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.EffectiveFrom != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveFrom)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.EffectiveUntil != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Modified != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Modified)
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if m.ValidationFees != 0 {
+		n += 2 + sovTypes(uint64(m.ValidationFees))
+	}
+	if m.IssuanceFees != 0 {
+		n += 2 + sovTypes(uint64(m.IssuanceFees))
+	}
+	if m.VerificationFees != 0 {
+		n += 2 + sovTypes(uint64(m.VerificationFees))
+	}
+	if m.Deposit != 0 {
+		n += 2 + sovTypes(uint64(m.Deposit))
+	}
+	if m.SlashedDeposit != 0 {
+		n += 2 + sovTypes(uint64(m.SlashedDeposit))
+	}
+	if m.RepaidDeposit != 0 {
+		n += 2 + sovTypes(uint64(m.RepaidDeposit))
+	}
+	if m.Revoked != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Revoked)
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	l = len(m.RevokedBy)
+	if l > 0 {
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if m.Terminated != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Terminated)
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	l = len(m.TerminatedBy)
+	if l > 0 {
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if len(m.Countries) > 0 {
+		for _, s := range m.Countries {
+			l = len(s)
+			n += 2 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.ValidatorPermId != 0 {
+		n += 2 + sovTypes(uint64(m.ValidatorPermId))
+	}
+	if m.VpState != 0 {
+		n += 2 + sovTypes(uint64(m.VpState))
+	}
+	if m.VpExp != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VpExp)
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if m.VpLastStateChange != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VpLastStateChange)
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if m.VpValidatorDeposit != 0 {
+		n += 2 + sovTypes(uint64(m.VpValidatorDeposit))
+	}
+	if m.VpCurrentFees != 0 {
+		n += 2 + sovTypes(uint64(m.VpCurrentFees))
+	}
+	if m.VpCurrentDeposit != 0 {
+		n += 2 + sovTypes(uint64(m.VpCurrentDeposit))
+	}
+	l = len(m.VpSummaryDigestSri)
+	if l > 0 {
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if m.VpTermRequested != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VpTermRequested)
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if m.Suspended != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Suspended)
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	l = len(m.SuspendedBy)
+	if l > 0 {
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	l = len(m.SuspensionReason)
+	if l > 0 {
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if m.SuspendedUntil != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SuspendedUntil)
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if len(m.CoValidatorPermIds) > 0 {
+		l = 0
+		for _, e := range m.CoValidatorPermIds {
+			l += sovTypes(uint64(e))
+		}
+		n += 2 + sovTypes(uint64(l)) + l
+	}
+	if m.VpQuorum != 0 {
+		n += 2 + sovTypes(uint64(m.VpQuorum))
+	}
+	if len(m.VpApprovals) > 0 {
+		for _, e := range m.VpApprovals {
+			l = e.Size()
+			n += 2 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.VpCoValidatorDeposits) > 0 {
+		for _, e := range m.VpCoValidatorDeposits {
+			l = e.Size()
+			n += 2 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.ScheduledFees != nil {
+		l = m.ScheduledFees.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *PermissionSession) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.AgentPermId != 0 {
+		n += 1 + sovTypes(uint64(m.AgentPermId))
+	}
+	if len(m.Authz) > 0 {
+		for _, e := range m.Authz {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Created != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Modified != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Modified)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Expires != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expires)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *SessionAuthz) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExecutorPermId != 0 {
+		n += 1 + sovTypes(uint64(m.ExecutorPermId))
+	}
+	if m.BeneficiaryPermId != 0 {
+		n += 1 + sovTypes(uint64(m.BeneficiaryPermId))
+	}
+	if m.WalletAgentPermId != 0 {
+		n += 1 + sovTypes(uint64(m.WalletAgentPermId))
+	}
+	return n
+}
+
+func (m *SessionAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.SchemaId != 0 {
+		n += 1 + sovTypes(uint64(m.SchemaId))
+	}
+	if m.AgentPermId != 0 {
+		n += 1 + sovTypes(uint64(m.AgentPermId))
+	}
+	if m.VerifierPermId != 0 {
+		n += 1 + sovTypes(uint64(m.VerifierPermId))
+	}
+	if m.Remaining != 0 {
+		n += 1 + sovTypes(uint64(m.Remaining))
+	}
+	if m.Spent != 0 {
+		n += 1 + sovTypes(uint64(m.Spent))
+	}
+	if m.Created != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Modified != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Modified)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.AllowedCreators) > 0 {
+		for _, s := range m.AllowedCreators {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.MaxPerSession != 0 {
+		n += 1 + sovTypes(uint64(m.MaxPerSession))
+	}
+	return n
+}
+
+func (m *SlashRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	if m.PermId != 0 {
+		n += 1 + sovTypes(uint64(m.PermId))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTypes(uint64(m.Amount))
+	}
+	l = len(m.SlashedBy)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	if m.Created != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.AppealDeadline != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.AppealDeadline)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Appealed != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Appealed)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.AppealDigestSri)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Resolved != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Resolved)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ResolvedBy)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ReasonCode != 0 {
+		n += 1 + sovTypes(uint64(m.ReasonCode))
+	}
+	l = len(m.EvidenceUrl)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.EvidenceDigestSri)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.GfVersion != 0 {
+		n += 2 + sovTypes(uint64(m.GfVersion))
+	}
+	if len(m.Distribution) > 0 {
+		for _, e := range m.Distribution {
+			l = e.Size()
+			n += 2 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TrustRegistrySlashDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TrId != 0 {
+		n += 1 + sovTypes(uint64(m.TrId))
+	}
+	if len(m.Distribution) > 0 {
+		for _, e := range m.Distribution {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RevocationCascade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PermId != 0 {
+		n += 1 + sovTypes(uint64(m.PermId))
+	}
+	if m.OriginPermId != 0 {
+		n += 1 + sovTypes(uint64(m.OriginPermId))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovTypes(uint64(m.Mode))
+	}
+	l = len(m.ExecutedBy)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Created != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.LastChildId != 0 {
+		n += 1 + sovTypes(uint64(m.LastChildId))
+	}
+	return n
+}
+
+func (m *AgentReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PermId != 0 {
+		n += 1 + sovTypes(uint64(m.PermId))
+	}
+	if m.UserAgentRewards != 0 {
+		n += 1 + sovTypes(uint64(m.UserAgentRewards))
+	}
+	if m.WalletAgentRewards != 0 {
+		n += 1 + sovTypes(uint64(m.WalletAgentRewards))
+	}
+	if m.TrustDeposit != 0 {
+		n += 1 + sovTypes(uint64(m.TrustDeposit))
+	}
+	if m.LastReward != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastReward)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *VerificationCredit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.VerifierPermId != 0 {
+		n += 1 + sovTypes(uint64(m.VerifierPermId))
+	}
+	if m.IssuerPermId != 0 {
+		n += 1 + sovTypes(uint64(m.IssuerPermId))
+	}
+	if m.ExecutorPermId != 0 {
+		n += 1 + sovTypes(uint64(m.ExecutorPermId))
+	}
+	if m.Purchased != 0 {
+		n += 1 + sovTypes(uint64(m.Purchased))
+	}
+	if m.Remaining != 0 {
+		n += 1 + sovTypes(uint64(m.Remaining))
+	}
+	if m.UnitFees != 0 {
+		n += 1 + sovTypes(uint64(m.UnitFees))
+	}
+	if m.UnitTrustDeposit != 0 {
+		n += 1 + sovTypes(uint64(m.UnitTrustDeposit))
+	}
+	if m.Created != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Modified != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Modified)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Refunded != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Refunded)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *SessionVoucher) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ExecutorPermId != 0 {
+		n += 1 + sovTypes(uint64(m.ExecutorPermId))
+	}
+	if m.BeneficiaryPermId != 0 {
+		n += 1 + sovTypes(uint64(m.BeneficiaryPermId))
+	}
+	if m.AgentPermId != 0 {
+		n += 1 + sovTypes(uint64(m.AgentPermId))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTypes(uint64(m.Nonce))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTypes(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *SessionVoucherSignDoc) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Voucher.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *VoucherNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTypes(uint64(m.Nonce))
+	}
+	if len(m.UsedNonces) > 0 {
+		l = 0
+		for _, e := range m.UsedNonces {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *VPMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PermId != 0 {
+		n += 1 + sovTypes(uint64(m.PermId))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTypes(uint64(m.Index))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.DigestSri)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.EncryptedUri)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Created != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *VPApproval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorPermId != 0 {
+		n += 1 + sovTypes(uint64(m.ValidatorPermId))
+	}
+	l = len(m.Approver)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.EffectiveUntil != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveUntil)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ValidationFees != 0 {
+		n += 1 + sovTypes(uint64(m.ValidationFees))
+	}
+	if m.IssuanceFees != 0 {
+		n += 1 + sovTypes(uint64(m.IssuanceFees))
+	}
+	if m.VerificationFees != 0 {
+		n += 1 + sovTypes(uint64(m.VerificationFees))
+	}
+	if len(m.Countries) > 0 {
+		for _, s := range m.Countries {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.VpSummaryDigestSri)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Created != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *CoValidatorDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorPermId != 0 {
+		n += 1 + sovTypes(uint64(m.ValidatorPermId))
+	}
+	if m.Deposit != 0 {
+		n += 1 + sovTypes(uint64(m.Deposit))
+	}
+	return n
+}
+
+func (m *PermissionFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidationFees != 0 {
+		n += 1 + sovTypes(uint64(m.ValidationFees))
+	}
+	if m.IssuanceFees != 0 {
+		n += 1 + sovTypes(uint64(m.IssuanceFees))
+	}
+	if m.VerificationFees != 0 {
+		n += 1 + sovTypes(uint64(m.VerificationFees))
+	}
+	if m.EffectiveFrom != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EffectiveFrom)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *PermissionFeeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PermId != 0 {
+		n += 1 + sovTypes(uint64(m.PermId))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTypes(uint64(m.Index))
+	}
+	l = m.Fees.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Permission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Permission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Permission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaId", wireType)
+			}
+			m.SchemaId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SchemaId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= PermissionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extended", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Extended == nil {
+				m.Extended = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Extended, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtendedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Slashed == nil {
+				m.Slashed = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Slashed, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repaid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repaid == nil {
+				m.Repaid = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Repaid, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepaidBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepaidBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EffectiveFrom == nil {
+				m.EffectiveFrom = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveFrom, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EffectiveUntil == nil {
+				m.EffectiveUntil = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Modified", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Modified == nil {
+				m.Modified = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Modified, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidationFees", wireType)
+			}
+			m.ValidationFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidationFees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceFees", wireType)
+			}
+			m.IssuanceFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuanceFees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationFees", wireType)
+			}
+			m.VerificationFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerificationFees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			m.Deposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedDeposit", wireType)
+			}
+			m.SlashedDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashedDeposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepaidDeposit", wireType)
+			}
+			m.RepaidDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepaidDeposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Revoked == nil {
+				m.Revoked = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Revoked, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevokedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Terminated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Terminated == nil {
+				m.Terminated = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Terminated, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TerminatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TerminatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Countries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Countries = append(m.Countries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPermId", wireType)
+			}
+			m.ValidatorPermId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorPermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VpState", wireType)
+			}
+			m.VpState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VpState |= ValidationState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VpExp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VpExp == nil {
+				m.VpExp = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.VpExp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VpLastStateChange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VpLastStateChange == nil {
+				m.VpLastStateChange = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.VpLastStateChange, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VpValidatorDeposit", wireType)
+			}
+			m.VpValidatorDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VpValidatorDeposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VpCurrentFees", wireType)
+			}
+			m.VpCurrentFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VpCurrentFees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VpCurrentDeposit", wireType)
+			}
+			m.VpCurrentDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VpCurrentDeposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VpSummaryDigestSri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VpSummaryDigestSri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VpTermRequested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VpTermRequested == nil {
+				m.VpTermRequested = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.VpTermRequested, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspended", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Suspended == nil {
+				m.Suspended = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Suspended, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspendedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SuspendedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 39:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspensionReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SuspensionReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspendedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SuspendedUntil == nil {
+				m.SuspendedUntil = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.SuspendedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 41:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CoValidatorPermIds = append(m.CoValidatorPermIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CoValidatorPermIds) == 0 {
+					m.CoValidatorPermIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CoValidatorPermIds = append(m.CoValidatorPermIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoValidatorPermIds", wireType)
+			}
+		case 42:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VpQuorum", wireType)
+			}
+			m.VpQuorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VpQuorum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 43:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VpApprovals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VpApprovals = append(m.VpApprovals, VPApproval{})
+			if err := m.VpApprovals[len(m.VpApprovals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 44:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VpCoValidatorDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VpCoValidatorDeposits = append(m.VpCoValidatorDeposits, CoValidatorDeposit{})
+			if err := m.VpCoValidatorDeposits[len(m.VpCoValidatorDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 45:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledFees == nil {
+				m.ScheduledFees = &PermissionFees{}
+			}
+			if err := m.ScheduledFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PermissionSession) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PermissionSession: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PermissionSession: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Controller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentPermId", wireType)
+			}
+			m.AgentPermId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AgentPermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authz", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authz = append(m.Authz, &SessionAuthz{})
+			if err := m.Authz[len(m.Authz)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Modified", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Modified == nil {
+				m.Modified = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Modified, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expires", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expires == nil {
+				m.Expires = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expires, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionAuthz) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionAuthz: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionAuthz: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorPermId", wireType)
+			}
+			m.ExecutorPermId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutorPermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeneficiaryPermId", wireType)
+			}
+			m.BeneficiaryPermId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BeneficiaryPermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletAgentPermId", wireType)
+			}
+			m.WalletAgentPermId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WalletAgentPermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaId", wireType)
+			}
+			m.SchemaId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SchemaId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentPermId", wireType)
+			}
+			m.AgentPermId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AgentPermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifierPermId", wireType)
+			}
+			m.VerifierPermId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerifierPermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			m.Remaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Remaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			m.Spent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Spent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Modified", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Modified == nil {
+				m.Modified = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Modified, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCreators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedCreators = append(m.AllowedCreators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerSession", wireType)
+			}
+			m.MaxPerSession = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPerSession |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermId", wireType)
+			}
+			m.PermId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SlashStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppealDeadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AppealDeadline == nil {
+				m.AppealDeadline = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.AppealDeadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Appealed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Appealed == nil {
+				m.Appealed = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Appealed, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppealDigestSri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppealDigestSri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolved", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resolved == nil {
+				m.Resolved = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Resolved, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResolvedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReasonCode", wireType)
+			}
+			m.ReasonCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReasonCode |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvidenceUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceDigestSri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvidenceDigestSri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GfVersion", wireType)
+			}
+			m.GfVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GfVersion |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distribution = append(m.Distribution, types.SlashShare{})
+			if err := m.Distribution[len(m.Distribution)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TrustRegistrySlashDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrustRegistrySlashDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrustRegistrySlashDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrId", wireType)
+			}
+			m.TrId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distribution = append(m.Distribution, types.SlashShare{})
+			if err := m.Distribution[len(m.Distribution)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevocationCascade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevocationCascade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevocationCascade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermId", wireType)
+			}
+			m.PermId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginPermId", wireType)
+			}
+			m.OriginPermId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OriginPermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= CascadeMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastChildId", wireType)
+			}
+			m.LastChildId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastChildId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AgentReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AgentReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AgentReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermId", wireType)
+			}
+			m.PermId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserAgentRewards", wireType)
+			}
+			m.UserAgentRewards = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserAgentRewards |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletAgentRewards", wireType)
+			}
+			m.WalletAgentRewards = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WalletAgentRewards |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustDeposit", wireType)
+			}
+			m.TrustDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrustDeposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastReward == nil {
+				m.LastReward = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastReward, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Permission) Unmarshal(dAtA []byte) error {
+func (m *VerificationCredit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1339,10 +7542,10 @@ func (m *Permission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Permission: wiretype end group for non-group")
+			return fmt.Errorf("proto: VerificationCredit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Permission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VerificationCredit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1365,10 +7568,10 @@ func (m *Permission) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchemaId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
 			}
-			m.SchemaId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1378,16 +7581,29 @@ func (m *Permission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SchemaId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifierPermId", wireType)
 			}
-			m.Type = 0
+			m.VerifierPermId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1397,16 +7613,16 @@ func (m *Permission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= PermissionType(b&0x7F) << shift
+				m.VerifierPermId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerPermId", wireType)
 			}
-			var stringLen uint64
+			m.IssuerPermId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1416,29 +7632,54 @@ func (m *Permission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.IssuerPermId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorPermId", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
+			m.ExecutorPermId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutorPermId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purchased", wireType)
 			}
-			m.Did = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			m.Purchased = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Purchased |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			m.Remaining = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1448,25 +7689,50 @@ func (m *Permission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Remaining |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnitFees", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
+			m.UnitFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnitFees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnitTrustDeposit", wireType)
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			m.UnitTrustDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnitTrustDeposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
 			}
@@ -1502,11 +7768,11 @@ func (m *Permission) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Modified", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1516,27 +7782,31 @@ func (m *Permission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatedBy = string(dAtA[iNdEx:postIndex])
+			if m.Modified == nil {
+				m.Modified = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Modified, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Extended", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1563,50 +7833,68 @@ func (m *Permission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Extended == nil {
-				m.Extended = new(time.Time)
+			if m.Refunded == nil {
+				m.Refunded = new(time.Time)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Extended, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Refunded, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedBy", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionVoucher) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExtendedBy = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionVoucher: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionVoucher: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1616,33 +7904,29 @@ func (m *Permission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Slashed == nil {
-				m.Slashed = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Slashed, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBy", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorPermId", wireType)
 			}
-			var stringLen uint64
+			m.ExecutorPermId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1652,29 +7936,16 @@ func (m *Permission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ExecutorPermId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SlashedBy = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repaid", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeneficiaryPermId", wireType)
 			}
-			var msglen int
+			m.BeneficiaryPermId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1684,33 +7955,16 @@ func (m *Permission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BeneficiaryPermId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Repaid == nil {
-				m.Repaid = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Repaid, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepaidBy", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentPermId", wireType)
 			}
-			var stringLen uint64
+			m.AgentPermId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1720,29 +7974,35 @@ func (m *Permission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AgentPermId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.RepaidBy = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveFrom", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1752,33 +8012,66 @@ func (m *Permission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EffectiveFrom == nil {
-				m.EffectiveFrom = new(time.Time)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionVoucherSignDoc) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveFrom, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 15:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionVoucherSignDoc: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionVoucherSignDoc: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveUntil", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1788,31 +8081,27 @@ func (m *Permission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EffectiveUntil == nil {
-				m.EffectiveUntil = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveUntil, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Modified", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voucher", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1839,75 +8128,65 @@ func (m *Permission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Modified == nil {
-				m.Modified = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Modified, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Voucher.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidationFees", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.ValidationFees = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValidationFees |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
 			}
-		case 18:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceFees", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.IssuanceFees = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.IssuanceFees |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoucherNonce) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
 			}
-		case 19:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationFees", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.VerificationFees = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VerificationFees |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 20:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoucherNonce: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoucherNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			m.Deposit = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1917,16 +8196,29 @@ func (m *Permission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Deposit |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 21:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashedDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			m.SlashedDeposit = 0
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1936,16 +8228,142 @@ func (m *Permission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SlashedDeposit |= uint64(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 22:
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.UsedNonces = append(m.UsedNonces, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.UsedNonces) == 0 {
+					m.UsedNonces = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.UsedNonces = append(m.UsedNonces, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedNonces", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VPMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VPMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VPMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepaidDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PermId", wireType)
 			}
-			m.RepaidDeposit = 0
+			m.PermId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1955,16 +8373,16 @@ func (m *Permission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RepaidDeposit |= uint64(b&0x7F) << shift
+				m.PermId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 23:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var msglen int
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1974,31 +8392,14 @@ func (m *Permission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Revoked == nil {
-				m.Revoked = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Revoked, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 24:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RevokedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2026,13 +8427,13 @@ func (m *Permission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RevokedBy = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 25:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Terminated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DigestSri", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2042,31 +8443,27 @@ func (m *Permission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Terminated == nil {
-				m.Terminated = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Terminated, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DigestSri = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 26:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TerminatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedUri", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2094,13 +8491,13 @@ func (m *Permission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TerminatedBy = string(dAtA[iNdEx:postIndex])
+			m.EncryptedUri = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 27:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Country", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2110,25 +8507,79 @@ func (m *Permission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Country = string(dAtA[iNdEx:postIndex])
+			if m.Created == nil {
+				m.Created = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 28:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VPApproval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VPApproval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VPApproval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPermId", wireType)
 			}
@@ -2147,11 +8598,11 @@ func (m *Permission) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 29:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VpState", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approver", wireType)
 			}
-			m.VpState = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2161,14 +8612,27 @@ func (m *Permission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VpState |= ValidationState(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 30:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VpExp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveUntil", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2195,18 +8659,18 @@ func (m *Permission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VpExp == nil {
-				m.VpExp = new(time.Time)
+			if m.EffectiveUntil == nil {
+				m.EffectiveUntil = new(time.Time)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.VpExp, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveUntil, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 31:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VpLastStateChange", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidationFees", wireType)
 			}
-			var msglen int
+			m.ValidationFees = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2216,33 +8680,16 @@ func (m *Permission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ValidationFees |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.VpLastStateChange == nil {
-				m.VpLastStateChange = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.VpLastStateChange, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 32:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VpValidatorDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceFees", wireType)
 			}
-			m.VpValidatorDeposit = 0
+			m.IssuanceFees = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2252,16 +8699,16 @@ func (m *Permission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VpValidatorDeposit |= uint64(b&0x7F) << shift
+				m.IssuanceFees |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 33:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VpCurrentFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationFees", wireType)
 			}
-			m.VpCurrentFees = 0
+			m.VerificationFees = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2271,16 +8718,16 @@ func (m *Permission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VpCurrentFees |= uint64(b&0x7F) << shift
+				m.VerificationFees |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 34:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VpCurrentDeposit", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Countries", wireType)
 			}
-			m.VpCurrentDeposit = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2290,12 +8737,25 @@ func (m *Permission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VpCurrentDeposit |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 35:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Countries = append(m.Countries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field VpSummaryDigestSri", wireType)
 			}
@@ -2327,9 +8787,9 @@ func (m *Permission) Unmarshal(dAtA []byte) error {
 			}
 			m.VpSummaryDigestSri = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 36:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VpTermRequested", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2356,10 +8816,10 @@ func (m *Permission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VpTermRequested == nil {
-				m.VpTermRequested = new(time.Time)
+			if m.Created == nil {
+				m.Created = new(time.Time)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.VpTermRequested, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Created, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2384,7 +8844,7 @@ func (m *Permission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PermissionSession) Unmarshal(dAtA []byte) error {
+func (m *CoValidatorDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2407,17 +8867,17 @@ func (m *PermissionSession) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PermissionSession: wiretype end group for non-group")
+			return fmt.Errorf("proto: CoValidatorDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PermissionSession: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CoValidatorDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPermId", wireType)
 			}
-			var stringLen uint64
+			m.ValidatorPermId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2427,29 +8887,16 @@ func (m *PermissionSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ValidatorPermId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
-			var stringLen uint64
+			m.Deposit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2459,29 +8906,66 @@ func (m *PermissionSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Deposit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Controller = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PermissionFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PermissionFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PermissionFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AgentPermId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidationFees", wireType)
 			}
-			m.AgentPermId = 0
+			m.ValidationFees = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2491,16 +8975,16 @@ func (m *PermissionSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AgentPermId |= uint64(b&0x7F) << shift
+				m.ValidationFees |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authz", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceFees", wireType)
 			}
-			var msglen int
+			m.IssuanceFees = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2510,31 +8994,16 @@ func (m *PermissionSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.IssuanceFees |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Authz = append(m.Authz, &SessionAuthz{})
-			if err := m.Authz[len(m.Authz)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationFees", wireType)
 			}
-			var msglen int
+			m.VerificationFees = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2544,31 +9013,14 @@ func (m *PermissionSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.VerificationFees |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Created == nil {
-				m.Created = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Created, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Modified", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveFrom", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2595,10 +9047,10 @@ func (m *PermissionSession) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Modified == nil {
-				m.Modified = new(time.Time)
+			if m.EffectiveFrom == nil {
+				m.EffectiveFrom = new(time.Time)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Modified, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EffectiveFrom, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2623,7 +9075,7 @@ func (m *PermissionSession) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SessionAuthz) Unmarshal(dAtA []byte) error {
+func (m *PermissionFeeRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2646,17 +9098,17 @@ func (m *SessionAuthz) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SessionAuthz: wiretype end group for non-group")
+			return fmt.Errorf("proto: PermissionFeeRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SessionAuthz: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PermissionFeeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorPermId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PermId", wireType)
 			}
-			m.ExecutorPermId = 0
+			m.PermId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2666,16 +9118,16 @@ func (m *SessionAuthz) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExecutorPermId |= uint64(b&0x7F) << shift
+				m.PermId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BeneficiaryPermId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.BeneficiaryPermId = 0
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2685,16 +9137,16 @@ func (m *SessionAuthz) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BeneficiaryPermId |= uint64(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WalletAgentPermId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
-			m.WalletAgentPermId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2704,11 +9156,57 @@ func (m *SessionAuthz) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WalletAgentPermId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1,7 +1,6 @@
 package keeper
 
 import (
-	"cosmossdk.io/math"
 	"fmt"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/verana-labs/verana-blockchain/x/trustdeposit/types"
@@ -23,9 +22,13 @@ func (k Keeper) BurnEcosystemSlashedTrustDeposit(ctx sdk.Context, account string
 		return fmt.Errorf("trust deposit entry not found for account %s: %w", account, err)
 	}
 
-	// amount MUST be lower or equal than td.amount
-	if amount > td.Amount {
-		return fmt.Errorf("amount exceeds available deposit: %d > %d", amount, td.Amount)
+	// amount MUST be lower or equal than td.amount, unbonding amounts included
+	slashable, err := k.slashableAmount(ctx, td)
+	if err != nil {
+		return err
+	}
+	if amount > slashable {
+		return fmt.Errorf("amount exceeds available deposit: %d > %d", amount, slashable)
 	}
 
 	// [MOD-TD-MSG-7-3] Execution
@@ -37,40 +40,75 @@ func (k Keeper) BurnEcosystemSlashedTrustDeposit(ctx sdk.Context, account string
 }
 
 func (k Keeper) executeBurnEcosystemSlashedTrustDeposit(ctx sdk.Context, account string, td types.TrustDeposit, amount uint64) error {
-	// Get trust deposit share value from params
-	params := k.GetParams(ctx)
-	trustDepositShareValue := params.TrustDepositShareValue
+	if err := k.escrowSlashedTrustDeposit(ctx, account, td, amount); err != nil {
+		return err
+	}
 
-	// Check if share value is zero using LegacyDec method
-	if trustDepositShareValue.IsZero() {
-		return fmt.Errorf("trust deposit share value cannot be zero")
+	return k.DistributeEscrowedSlashedTrustDeposit(ctx, account, amount, nil)
+}
+
+// EscrowSlashedTrustDeposit removes amount from the trust deposit of account,
+// and from its unbondings if the deposit does not cover it, without burning it. The coins stay in the trust deposit module account until
+// the slash is either executed with DistributeEscrowedSlashedTrustDeposit or
+// reversed with ReleaseEscrowedSlashedTrustDeposit.
+func (k Keeper) EscrowSlashedTrustDeposit(ctx sdk.Context, account string, amount uint64) error {
+	if account == "" {
+		return fmt.Errorf("account cannot be empty")
 	}
 
-	now := ctx.BlockTime()
+	if amount == 0 {
+		return fmt.Errorf("amount must be greater than 0")
+	}
 
-	// Update existing TrustDeposit entry
-	td.Amount = td.Amount - amount
+	td, err := k.TrustDeposit.Get(ctx, account)
+	if err != nil {
+		return fmt.Errorf("trust deposit entry not found for account %s: %w", account, err)
+	}
+
+	slashable, err := k.slashableAmount(ctx, td)
+	if err != nil {
+		return err
+	}
+	if amount > slashable {
+		return fmt.Errorf("amount exceeds available deposit: %d > %d", amount, slashable)
+	}
+
+	return k.escrowSlashedTrustDeposit(ctx, account, td, amount)
+}
+
+func (k Keeper) escrowSlashedTrustDeposit(ctx sdk.Context, account string, td types.TrustDeposit, amount uint64) error {
+	// Take the amount from the deposit, then from the unbondings
+	if err := k.takeSlashedAmount(ctx, &td, amount); err != nil {
+		return err
+	}
+
+	// Save updated trust deposit entry
+	if err := k.TrustDeposit.Set(ctx, account, td); err != nil {
+		return fmt.Errorf("failed to update trust deposit entry: %w", err)
+	}
 
-	// Calculate and reduce shares - convert types properly
-	amountInt := math.NewInt(int64(amount))
-	amountDec := math.LegacyNewDecFromInt(amountInt)
-	shareReductionDec := amountDec.Quo(trustDepositShareValue)
-	shareReduction := shareReductionDec.TruncateInt().Uint64()
+	return nil
+}
 
-	if shareReduction > td.Share {
-		return fmt.Errorf("share reduction exceeds available shares: %d > %d", shareReduction, td.Share)
+// DistributeEscrowedSlashedTrustDeposit sends an amount previously escrowed
+// with EscrowSlashedTrustDeposit to the destinations of distribution, burning
+// it if distribution is empty, and records the slash on the trust deposit of
+// account.
+func (k Keeper) DistributeEscrowedSlashedTrustDeposit(ctx sdk.Context, account string, amount uint64, distribution []types.SlashShare) error {
+	td, err := k.TrustDeposit.Get(ctx, account)
+	if err != nil {
+		return fmt.Errorf("trust deposit entry not found for account %s: %w", account, err)
 	}
-	td.Share = td.Share - shareReduction
+
+	now := ctx.BlockTime()
 
 	// Update v2 slashing fields
 	td.SlashedDeposit += amount
 	td.LastSlashed = &now
 	td.SlashCount += 1
 
-	// Burn amount from TrustDeposit module account
-	burnCoins := sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, int64(amount)))
-	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnCoins); err != nil {
-		return fmt.Errorf("failed to burn coins from trust deposit module: %w", err)
+	if err := k.distributeSlashedAmount(ctx, account, amount, distribution); err != nil {
+		return err
 	}
 
 	// Save updated trust deposit entry
@@ -80,3 +118,27 @@ func (k Keeper) executeBurnEcosystemSlashedTrustDeposit(ctx sdk.Context, account
 
 	return nil
 }
+
+// ReleaseEscrowedSlashedTrustDeposit returns an amount previously escrowed
+// with EscrowSlashedTrustDeposit to the trust deposit of account, at the
+// current share value.
+func (k Keeper) ReleaseEscrowedSlashedTrustDeposit(ctx sdk.Context, account string, amount uint64) error {
+	td, err := k.TrustDeposit.Get(ctx, account)
+	if err != nil {
+		return fmt.Errorf("trust deposit entry not found for account %s: %w", account, err)
+	}
+
+	params := k.GetParams(ctx)
+	if params.TrustDepositShareValue.IsZero() {
+		return fmt.Errorf("trust deposit share value cannot be zero")
+	}
+
+	td.Amount += amount
+	td.Share += k.AmountToShare(amount, params.TrustDepositShareValue)
+
+	if err := k.TrustDeposit.Set(ctx, account, td); err != nil {
+		return fmt.Errorf("failed to update trust deposit entry: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,653 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/verana-labs/verana-blockchain/x/trustdeposit/types"
+)
+
+// The delegations of all trust deposits are held by the trust deposit module
+// account, one staking delegation per validator. A TrustDepositDelegation
+// records the shares of that delegation owned by an account, and the part of
+// its trust deposit they stand for. Delegated deposits stay in td.Amount:
+//
+//   - amount is bonded. It can't be reclaimed before it has been undelegated.
+//     A trust deposit slash unbonds it instantly when the undelegated deposit
+//     does not cover the slash, a validator slash is deducted from the
+//     deposit when reconciled.
+//   - unbonding_amount is being undelegated. It is back in the undelegated
+//     deposit at the end of the staking unbonding period, minus the validator
+//     slashes of the period. A trust deposit slash that the undelegated and
+//     bonded deposits do not cover is taken from it at once and recorded in
+//     unbonding_slashed, the module keeps that part when the undelegation
+//     completes.
+
+const (
+	// undelegationCompletionBatchSize bounds the number of mature undelegations completed per block.
+	undelegationCompletionBatchSize = 200
+	// undelegationRetryDelay is the delay after which an undelegation that failed to complete is retried.
+	undelegationRetryDelay = time.Hour
+	// delegationSyncBatchSize bounds the number of delegations synced per block.
+	delegationSyncBatchSize = 200
+)
+
+// getDelegation returns the delegation of account, if any.
+func (k Keeper) getDelegation(ctx context.Context, account string) (types.TrustDepositDelegation, bool, error) {
+	del, err := k.Delegation.Get(ctx, account)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.TrustDepositDelegation{Shares: sdkmath.LegacyZeroDec()}, false, nil
+	}
+	if err != nil {
+		return types.TrustDepositDelegation{}, false, fmt.Errorf("failed to get delegation: %w", err)
+	}
+	return del, true, nil
+}
+
+// setDelegation saves del, or removes it when nothing is delegated anymore.
+func (k Keeper) setDelegation(ctx context.Context, del types.TrustDepositDelegation) error {
+	if !del.Shares.IsPositive() && del.Amount == 0 && del.UnbondingAmount == 0 {
+		return k.Delegation.Remove(ctx, del.Account)
+	}
+	return k.Delegation.Set(ctx, del.Account, del)
+}
+
+// undelegatedAmount returns the part of the deposit td that is neither bonded
+// nor unbonding.
+func (k Keeper) undelegatedAmount(ctx context.Context, td types.TrustDeposit) (uint64, error) {
+	del, found, err := k.getDelegation(ctx, td.Account)
+	if err != nil || !found {
+		return td.Amount, err
+	}
+	staked := stakedAmount(del)
+	if staked > td.Amount {
+		return 0, nil
+	}
+	return td.Amount - staked, nil
+}
+
+// stakedAmount returns the part of the trust deposit held by del, bonded or
+// being undelegated.
+func stakedAmount(del types.TrustDepositDelegation) uint64 {
+	return del.Amount + del.UnbondingAmount - min(del.UnbondingSlashed, del.UnbondingAmount)
+}
+
+// delegationLoss returns the validator slashes of del not yet deducted from
+// the trust deposit.
+func (k Keeper) delegationLoss(ctx context.Context, del types.TrustDepositDelegation) (uint64, error) {
+	if !del.Shares.IsPositive() || k.stakingKeeper == nil {
+		return 0, nil
+	}
+	valAddr, err := sdk.ValAddressFromBech32(del.ValidatorAddress)
+	if err != nil {
+		return 0, fmt.Errorf("invalid validator address: %w", err)
+	}
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get validator %s: %w", del.ValidatorAddress, err)
+	}
+	tokens := validator.TokensFromShares(del.Shares).TruncateInt().Uint64()
+	if tokens >= del.Amount {
+		return 0, nil
+	}
+	return del.Amount - tokens, nil
+}
+
+// reconcileDelegation deducts from td the validator slashes of del not yet
+// accounted for. td and del are updated but not saved.
+func (k Keeper) reconcileDelegation(ctx sdk.Context, td *types.TrustDeposit, del *types.TrustDepositDelegation) error {
+	loss, err := k.delegationLoss(ctx, *del)
+	if err != nil || loss == 0 {
+		return err
+	}
+
+	del.Amount -= loss
+	k.deductValidatorSlash(ctx, td, del.ValidatorAddress, loss)
+	return nil
+}
+
+// liquidateDelegation unbonds up to amount of the bonded deposit of td
+// without waiting for the staking unbonding period, so that it can be
+// slashed. The coins are moved back to the trust deposit module account. td
+// is updated but not saved.
+func (k Keeper) liquidateDelegation(ctx sdk.Context, td *types.TrustDeposit, del *types.TrustDepositDelegation, amount uint64) error {
+	amount = min(amount, del.Amount)
+	if amount == 0 {
+		return nil
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(del.ValidatorAddress)
+	if err != nil {
+		return fmt.Errorf("invalid validator address: %w", err)
+	}
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return fmt.Errorf("failed to get validator %s: %w", del.ValidatorAddress, err)
+	}
+
+	shares := del.Shares
+	if amount < del.Amount {
+		shares, err = validator.SharesFromTokens(sdkmath.NewIntFromUint64(amount))
+		if err != nil {
+			return fmt.Errorf("failed to compute shares: %w", err)
+		}
+		shares = sdkmath.LegacyMinDec(shares, del.Shares)
+	}
+
+	tokens, err := k.stakingKeeper.Unbond(ctx, authtypes.NewModuleAddress(types.ModuleName), valAddr, shares)
+	if err != nil {
+		return fmt.Errorf("failed to unbond: %w", err)
+	}
+
+	// Unbond leaves the tokens in the pool of the validator status
+	pool := stakingtypes.NotBondedPoolName
+	if validator.IsBonded() {
+		pool = stakingtypes.BondedPoolName
+	}
+	if tokens.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(types.BondDenom, tokens))
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, pool, types.ModuleName, coins); err != nil {
+			return fmt.Errorf("failed to transfer unbonded coins: %w", err)
+		}
+	}
+
+	del.Shares = del.Shares.Sub(shares)
+	del.Amount -= amount
+	if returned := tokens.Uint64(); returned < amount {
+		// the returned amount is truncated, the remainder is lost
+		k.deductValidatorSlash(ctx, td, del.ValidatorAddress, amount-returned)
+	}
+
+	return k.setDelegation(ctx, *del)
+}
+
+// deductValidatorSlash removes loss, slashed by validator, from the deposit td.
+func (k Keeper) deductValidatorSlash(ctx sdk.Context, td *types.TrustDeposit, validator string, loss uint64) {
+	loss = min(loss, td.Amount)
+	shareReduction := min(k.AmountToShare(loss, k.GetParams(ctx).TrustDepositShareValue), td.Share)
+
+	td.Amount -= loss
+	td.Share -= shareReduction
+	if td.Claimable > td.Amount {
+		td.Claimable = td.Amount
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeValidatorSlashTrustDeposit,
+			sdk.NewAttribute(types.AttributeKeyAccount, td.Account),
+			sdk.NewAttribute(types.AttributeKeyValidator, validator),
+			sdk.NewAttribute(types.AttributeKeyAmount, strconv.FormatUint(loss, 10)),
+		),
+	)
+}
+
+// delegateTrustDeposit delegates amount of the undelegated deposit of
+// account to validator.
+func (k Keeper) delegateTrustDeposit(ctx sdk.Context, account, validator string, amount uint64) (sdkmath.LegacyDec, error) {
+	if k.stakingKeeper == nil {
+		return sdkmath.LegacyDec{}, fmt.Errorf("staking is not available")
+	}
+
+	td, err := k.TrustDeposit.Get(ctx, account)
+	if err != nil {
+		return sdkmath.LegacyDec{}, fmt.Errorf("trust deposit not found for account: %s", account)
+	}
+
+	del, found, err := k.getDelegation(ctx, account)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+	if found && del.UnbondingAmount > 0 {
+		return sdkmath.LegacyDec{}, fmt.Errorf("trust deposit is being undelegated until %s", del.UnbondingCompletionTime)
+	}
+	if found && del.Shares.IsPositive() && del.ValidatorAddress != validator {
+		return sdkmath.LegacyDec{}, fmt.Errorf("trust deposit is delegated to %s, undelegate it first", del.ValidatorAddress)
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return sdkmath.LegacyDec{}, fmt.Errorf("failed to get bond denom: %w", err)
+	}
+	if bondDenom != types.BondDenom {
+		return sdkmath.LegacyDec{}, fmt.Errorf("staking bond denom %s does not match trust deposit denom %s", bondDenom, types.BondDenom)
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(validator)
+	if err != nil {
+		return sdkmath.LegacyDec{}, fmt.Errorf("invalid validator address: %w", err)
+	}
+	val, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return sdkmath.LegacyDec{}, fmt.Errorf("failed to get validator %s: %w", validator, err)
+	}
+
+	// Rewards are paid out before the delegation changes, the accounting below
+	// is then up to date
+	del.Account = account
+	del.ValidatorAddress = validator
+	if err := k.withdrawValidatorRewards(ctx, validator); err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+	if err := k.payDelegationRewards(ctx, &td, &del); err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+	if err := k.reconcileDelegation(ctx, &td, &del); err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+
+	undelegated := td.Amount - min(del.Amount, td.Amount)
+	if amount > undelegated {
+		return sdkmath.LegacyDec{}, fmt.Errorf("amount exceeds undelegated trust deposit: %d > %d", amount, undelegated)
+	}
+
+	shares, err := k.stakingKeeper.Delegate(ctx, authtypes.NewModuleAddress(types.ModuleName), sdkmath.NewIntFromUint64(amount), stakingtypes.Unbonded, val, true)
+	if err != nil {
+		return sdkmath.LegacyDec{}, fmt.Errorf("failed to delegate: %w", err)
+	}
+
+	del.Shares = del.Shares.Add(shares)
+	del.Amount += amount
+
+	if err := k.setDelegation(ctx, del); err != nil {
+		return sdkmath.LegacyDec{}, fmt.Errorf("failed to save delegation: %w", err)
+	}
+	if err := k.TrustDeposit.Set(ctx, account, td); err != nil {
+		return sdkmath.LegacyDec{}, fmt.Errorf("failed to update trust deposit: %w", err)
+	}
+
+	return shares, nil
+}
+
+// undelegateTrustDeposit starts the undelegation of the whole bonded deposit
+// of account.
+func (k Keeper) undelegateTrustDeposit(ctx sdk.Context, account string) (types.TrustDepositDelegation, error) {
+	if k.stakingKeeper == nil {
+		return types.TrustDepositDelegation{}, fmt.Errorf("staking is not available")
+	}
+
+	td, err := k.TrustDeposit.Get(ctx, account)
+	if err != nil {
+		return types.TrustDepositDelegation{}, fmt.Errorf("trust deposit not found for account: %s", account)
+	}
+
+	del, found, err := k.getDelegation(ctx, account)
+	if err != nil {
+		return types.TrustDepositDelegation{}, err
+	}
+	if !found || !del.Shares.IsPositive() {
+		return types.TrustDepositDelegation{}, fmt.Errorf("trust deposit is not delegated")
+	}
+	if del.UnbondingAmount > 0 {
+		return types.TrustDepositDelegation{}, fmt.Errorf("trust deposit is being undelegated until %s", del.UnbondingCompletionTime)
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(del.ValidatorAddress)
+	if err != nil {
+		return types.TrustDepositDelegation{}, fmt.Errorf("invalid validator address: %w", err)
+	}
+
+	if err := k.withdrawValidatorRewards(ctx, del.ValidatorAddress); err != nil {
+		return types.TrustDepositDelegation{}, err
+	}
+	if err := k.payDelegationRewards(ctx, &td, &del); err != nil {
+		return types.TrustDepositDelegation{}, err
+	}
+	if err := k.reconcileDelegation(ctx, &td, &del); err != nil {
+		return types.TrustDepositDelegation{}, err
+	}
+
+	completionTime, returned, err := k.stakingKeeper.Undelegate(ctx, authtypes.NewModuleAddress(types.ModuleName), valAddr, del.Shares)
+	if err != nil {
+		return types.TrustDepositDelegation{}, fmt.Errorf("failed to undelegate: %w", err)
+	}
+
+	// the returned amount is truncated, the remainder is lost
+	unbonding := min(returned.Uint64(), del.Amount)
+	if unbonding < del.Amount {
+		k.deductValidatorSlash(ctx, &td, del.ValidatorAddress, del.Amount-unbonding)
+	}
+
+	del.Shares = sdkmath.LegacyZeroDec()
+	del.Amount = 0
+	del.UnbondingAmount = unbonding
+	del.UnbondingCreationHeight = ctx.BlockHeight()
+	del.UnbondingCompletionTime = &completionTime
+
+	if err := k.setDelegation(ctx, del); err != nil {
+		return types.TrustDepositDelegation{}, fmt.Errorf("failed to save delegation: %w", err)
+	}
+	if err := k.UndelegationQueue.Set(ctx, collections.Join(completionTime, account)); err != nil {
+		return types.TrustDepositDelegation{}, fmt.Errorf("failed to queue undelegation: %w", err)
+	}
+	if err := k.TrustDeposit.Set(ctx, account, td); err != nil {
+		return types.TrustDepositDelegation{}, fmt.Errorf("failed to update trust deposit: %w", err)
+	}
+
+	return del, nil
+}
+
+// withdrawValidatorRewards withdraws the staking rewards of the delegation of
+// the module account to validator and adds them to the reward ratio of
+// validator. They are paid out to the trust deposit delegators, pro rata of
+// their shares, by payDelegationRewards. Rounding leftovers stay in the module
+// account.
+func (k Keeper) withdrawValidatorRewards(ctx sdk.Context, validator string) error {
+	if k.distrKeeper == nil {
+		return nil
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(validator)
+	if err != nil {
+		return fmt.Errorf("invalid validator address: %w", err)
+	}
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	delegation, err := k.stakingKeeper.GetDelegation(ctx, moduleAddr, valAddr)
+	if errors.Is(err, stakingtypes.ErrNoDelegation) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get delegation to %s: %w", validator, err)
+	}
+	if !delegation.Shares.IsPositive() {
+		return nil
+	}
+
+	rewards, err := k.distrKeeper.WithdrawDelegationRewards(ctx, moduleAddr, valAddr)
+	if err != nil {
+		return fmt.Errorf("failed to withdraw staking rewards: %w", err)
+	}
+	reward := rewards.AmountOf(types.BondDenom)
+	if !reward.IsPositive() {
+		return nil
+	}
+
+	ratio, err := k.getRewardRatio(ctx, validator)
+	if err != nil {
+		return err
+	}
+	ratio = ratio.Add(sdkmath.LegacyNewDecFromInt(reward).QuoTruncate(delegation.Shares))
+	if err := k.RewardRatio.Set(ctx, validator, ratio); err != nil {
+		return fmt.Errorf("failed to update reward ratio: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeStakingRewards,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator),
+			sdk.NewAttribute(types.AttributeKeyAmount, reward.String()),
+		),
+	)
+
+	return nil
+}
+
+// getRewardRatio returns the reward ratio of validator, zero when nothing was
+// withdrawn from it yet.
+func (k Keeper) getRewardRatio(ctx context.Context, validator string) (sdkmath.LegacyDec, error) {
+	ratio, err := k.RewardRatio.Get(ctx, validator)
+	if errors.Is(err, collections.ErrNotFound) {
+		return sdkmath.LegacyZeroDec(), nil
+	}
+	if err != nil {
+		return sdkmath.LegacyDec{}, fmt.Errorf("failed to get reward ratio: %w", err)
+	}
+	return ratio, nil
+}
+
+// payDelegationRewards adds to the deposit yield of td the staking rewards
+// withdrawn for del since they were last paid out. It must be called before
+// the shares of del change. td and del are updated but not saved.
+func (k Keeper) payDelegationRewards(ctx sdk.Context, td *types.TrustDeposit, del *types.TrustDepositDelegation) error {
+	ratio, err := k.getRewardRatio(ctx, del.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+	if del.Shares.IsPositive() && !del.RewardRatio.IsNil() && ratio.GT(del.RewardRatio) {
+		amount := del.Shares.Mul(ratio.Sub(del.RewardRatio)).TruncateInt().Uint64()
+		// the reward is added to the deposit value, so to the yield of the account
+		td.Share += k.AmountToShare(amount, k.GetParams(ctx).TrustDepositShareValue)
+	}
+	del.RewardRatio = ratio
+	return nil
+}
+
+// startDelegationSync starts a sync of all delegations, unless one is still
+// running. It is called at the end of each yield epoch.
+func (k Keeper) startDelegationSync(ctx sdk.Context) error {
+	running, err := k.DelegationSync.Has(ctx)
+	if err != nil || running {
+		return err
+	}
+	return k.DelegationSync.Set(ctx, "")
+}
+
+// SyncDelegations pays out the staking rewards of the delegated trust
+// deposits and deducts the validator slashes from them, at most
+// delegationSyncBatchSize delegations per call, while a sync is running. It is
+// called at the beginning of each block. A delegation that fails to sync is
+// logged and reported with an event, it is synced again by the next sync.
+func (k Keeper) SyncDelegations(ctx sdk.Context) error {
+	if k.stakingKeeper == nil {
+		return nil
+	}
+	next, err := k.DelegationSync.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	iter, err := k.Delegation.Iterate(ctx, new(collections.Range[string]).StartInclusive(next))
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	var accounts []string
+	for ; iter.Valid() && len(accounts) <= delegationSyncBatchSize; iter.Next() {
+		account, err := iter.Key()
+		if err != nil {
+			return err
+		}
+		accounts = append(accounts, account)
+	}
+
+	done := len(accounts) <= delegationSyncBatchSize
+	if !done {
+		next = accounts[delegationSyncBatchSize]
+		accounts = accounts[:delegationSyncBatchSize]
+	}
+
+	// the rewards of a validator are withdrawn once per call
+	withdrawn := make(map[string]bool)
+	for _, account := range accounts {
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.syncDelegation(cacheCtx, account, withdrawn); err != nil {
+			k.Logger().Error("failed to sync delegation", "account", account, "error", err)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeSyncDelegationFailed,
+					sdk.NewAttribute(types.AttributeKeyAccount, account),
+					sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+				),
+			)
+			continue
+		}
+		write()
+	}
+
+	if done {
+		return k.DelegationSync.Remove(ctx)
+	}
+	return k.DelegationSync.Set(ctx, next)
+}
+
+// syncDelegation pays out the staking rewards of the delegation of account
+// and deducts the validator slashes from it.
+func (k Keeper) syncDelegation(ctx sdk.Context, account string, withdrawn map[string]bool) error {
+	del, found, err := k.getDelegation(ctx, account)
+	if err != nil || !found || !del.Shares.IsPositive() {
+		return err
+	}
+	td, err := k.TrustDeposit.Get(ctx, account)
+	if err != nil {
+		return fmt.Errorf("trust deposit not found for account: %s", account)
+	}
+
+	if !withdrawn[del.ValidatorAddress] {
+		if err := k.withdrawValidatorRewards(ctx, del.ValidatorAddress); err != nil {
+			return err
+		}
+		withdrawn[del.ValidatorAddress] = true
+	}
+	if err := k.payDelegationRewards(ctx, &td, &del); err != nil {
+		return err
+	}
+	if err := k.reconcileDelegation(ctx, &td, &del); err != nil {
+		return err
+	}
+	if err := k.setDelegation(ctx, del); err != nil {
+		return fmt.Errorf("failed to save delegation: %w", err)
+	}
+	if err := k.TrustDeposit.Set(ctx, account, td); err != nil {
+		return fmt.Errorf("failed to update trust deposit: %w", err)
+	}
+	return nil
+}
+
+// CompleteMatureUndelegations adds the undelegated deposits whose staking
+// unbonding period is over back to the undelegated deposits, at most
+// undelegationCompletionBatchSize per call. It is called from the module
+// EndBlocker, before x/staking pays them out, so that the validator slashes of
+// the period can be read from the unbonding delegation entries. An
+// undelegation that fails to complete is logged and queued again
+// undelegationRetryDelay later.
+func (k Keeper) CompleteMatureUndelegations(ctx context.Context) error {
+	if k.stakingKeeper == nil {
+		return nil
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime()
+
+	rng := new(collections.Range[collections.Pair[time.Time, string]]).
+		EndExclusive(collections.Join(now.Add(time.Nanosecond), ""))
+	iter, err := k.UndelegationQueue.Iterate(sdkCtx, rng)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	var mature []collections.Pair[time.Time, string]
+	for ; iter.Valid() && len(mature) < undelegationCompletionBatchSize; iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return err
+		}
+		mature = append(mature, key)
+	}
+
+	for _, key := range mature {
+		if err := k.UndelegationQueue.Remove(sdkCtx, key); err != nil {
+			return err
+		}
+
+		del, found, err := k.getDelegation(sdkCtx, key.K2())
+		if err != nil {
+			return err
+		}
+		if !found || del.UnbondingAmount == 0 || del.UnbondingCompletionTime == nil {
+			continue
+		}
+
+		cacheCtx, write := sdkCtx.CacheContext()
+		if err := k.completeUndelegation(cacheCtx, del); err != nil {
+			k.Logger().Error("failed to complete undelegation, retrying later", "account", del.Account, "validator", del.ValidatorAddress, "error", err)
+			if err := k.UndelegationQueue.Set(sdkCtx, collections.Join(now.Add(undelegationRetryDelay), del.Account)); err != nil {
+				return err
+			}
+			continue
+		}
+		write()
+	}
+
+	return nil
+}
+
+func (k Keeper) completeUndelegation(ctx sdk.Context, del types.TrustDepositDelegation) error {
+	valAddr, err := sdk.ValAddressFromBech32(del.ValidatorAddress)
+	if err != nil {
+		return fmt.Errorf("invalid validator address: %w", err)
+	}
+
+	// Entries created at the same height and completing at the same time
+	// are merged by x/staking, they are slashed by the same ratio
+	returned := del.UnbondingAmount
+	ubd, err := k.stakingKeeper.GetUnbondingDelegation(ctx, authtypes.NewModuleAddress(types.ModuleName), valAddr)
+	if err == nil {
+		for _, entry := range ubd.Entries {
+			if entry.CreationHeight == del.UnbondingCreationHeight &&
+				entry.CompletionTime.Equal(*del.UnbondingCompletionTime) &&
+				entry.InitialBalance.IsPositive() {
+				returned = sdkmath.LegacyNewDecFromInt(entry.Balance).
+					MulInt64(int64(del.UnbondingAmount)).
+					QuoInt(entry.InitialBalance).TruncateInt().Uint64()
+				break
+			}
+		}
+	}
+	returned = min(returned, del.UnbondingAmount)
+
+	td, err := k.TrustDeposit.Get(ctx, del.Account)
+	if err != nil {
+		return fmt.Errorf("trust deposit not found for account: %s", del.Account)
+	}
+	// the part slashed during the period was taken at its full value, the
+	// validator slashes of the whole undelegation are borne by the deposit
+	if returned < del.UnbondingAmount {
+		k.deductValidatorSlash(ctx, &td, del.ValidatorAddress, del.UnbondingAmount-returned)
+	}
+	if err := k.TrustDeposit.Set(ctx, td.Account, td); err != nil {
+		return fmt.Errorf("failed to update trust deposit: %w", err)
+	}
+	returned -= min(del.UnbondingSlashed, returned)
+
+	del.UnbondingAmount = 0
+	del.UnbondingSlashed = 0
+	del.UnbondingCreationHeight = 0
+	del.UnbondingCompletionTime = nil
+	if err := k.setDelegation(ctx, del); err != nil {
+		return fmt.Errorf("failed to save delegation: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCompleteUndelegation,
+			sdk.NewAttribute(types.AttributeKeyAccount, del.Account),
+			sdk.NewAttribute(types.AttributeKeyValidator, del.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyAmount, strconv.FormatUint(returned, 10)),
+		),
+	)
+
+	return nil
+}
+
+// unslashableDelegatedAmount returns the part of the deposit td that can't be
+// slashed because of its delegation: the validator slashes not yet deducted.
+func (k Keeper) unslashableDelegatedAmount(ctx context.Context, td types.TrustDeposit) (uint64, error) {
+	del, found, err := k.getDelegation(ctx, td.Account)
+	if err != nil || !found {
+		return 0, err
+	}
+	return k.delegationLoss(ctx, del)
+}
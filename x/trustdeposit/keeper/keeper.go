@@ -4,6 +4,7 @@ import (
 	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
@@ -23,9 +24,34 @@ type (
 		// should be the x/gov module account.
 		authority string
 		// state
-		TrustDeposit collections.Map[string, types.TrustDeposit]
+		TrustDeposit             collections.Map[string, types.TrustDeposit]
+		SlashDistribution        collections.Map[uint64, types.SlashDistribution]
+		SlashDistributionCounter collections.Item[uint64]
+		Unbonding                collections.Map[uint64, types.TrustDepositUnbonding]
+		UnbondingCounter         collections.Item[uint64]
+		// UnbondingQueue indexes unbondings by completion time
+		UnbondingQueue collections.KeySet[collections.Pair[time.Time, uint64]]
+		// UnbondingByAccount indexes unbondings by account
+		UnbondingByAccount collections.KeySet[collections.Pair[string, uint64]]
+		// YieldRecord is the history of yield distributions
+		YieldRecord        collections.Map[uint64, types.YieldRecord]
+		YieldRecordCounter collections.Item[uint64]
+		// YieldReserve is the amount held by the module to be released as yield
+		YieldReserve collections.Item[uint64]
+		// Delegation is the delegated part of trust deposits, by account
+		Delegation collections.Map[string, types.TrustDepositDelegation]
+		// UndelegationQueue indexes the accounts undelegating by completion time
+		UndelegationQueue collections.KeySet[collections.Pair[time.Time, string]]
+		// RewardRatio is the staking reward per delegation share withdrawn so far, by validator
+		RewardRatio collections.Map[string, math.LegacyDec]
+		// DelegationSync is the next account to sync while a delegation sync is running
+		DelegationSync collections.Item[string]
 		// external keeper
 		bankKeeper types.BankKeeper
+		// distrKeeper funds the community pool, it is optional
+		distrKeeper types.DistributionKeeper
+		// stakingKeeper delegates trust deposits, it is optional
+		stakingKeeper types.StakingKeeper
 	}
 )
 
@@ -35,7 +61,8 @@ func NewKeeper(
 	logger log.Logger,
 	authority string,
 	bankKeeper types.BankKeeper,
-
+	distrKeeper types.DistributionKeeper,
+	stakingKeeper types.StakingKeeper,
 ) Keeper {
 	sb := collections.NewSchemaBuilder(storeService)
 
@@ -44,12 +71,27 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		cdc:          cdc,
-		storeService: storeService,
-		authority:    authority,
-		logger:       logger,
-		TrustDeposit: collections.NewMap(sb, types.TrustDepositKey, "trust_deposit", collections.StringKey, codec.CollValue[types.TrustDeposit](cdc)),
-		bankKeeper:   bankKeeper,
+		cdc:                      cdc,
+		storeService:             storeService,
+		authority:                authority,
+		logger:                   logger,
+		TrustDeposit:             collections.NewMap(sb, types.TrustDepositKey, "trust_deposit", collections.StringKey, codec.CollValue[types.TrustDeposit](cdc)),
+		SlashDistribution:        collections.NewMap(sb, types.SlashDistributionKey, "slash_distribution", collections.Uint64Key, codec.CollValue[types.SlashDistribution](cdc)),
+		SlashDistributionCounter: collections.NewItem(sb, types.SlashDistributionCounterKey, "slash_distribution_counter", collections.Uint64Value),
+		Unbonding:                collections.NewMap(sb, types.UnbondingKey, "unbonding", collections.Uint64Key, codec.CollValue[types.TrustDepositUnbonding](cdc)),
+		UnbondingCounter:         collections.NewItem(sb, types.UnbondingCounterKey, "unbonding_counter", collections.Uint64Value),
+		UnbondingQueue:           collections.NewKeySet(sb, types.UnbondingQueueKey, "unbonding_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key)),
+		UnbondingByAccount:       collections.NewKeySet(sb, types.UnbondingByAccountKey, "unbonding_by_account", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		YieldRecord:              collections.NewMap(sb, types.YieldRecordKey, "yield_record", collections.Uint64Key, codec.CollValue[types.YieldRecord](cdc)),
+		YieldRecordCounter:       collections.NewItem(sb, types.YieldRecordCounterKey, "yield_record_counter", collections.Uint64Value),
+		YieldReserve:             collections.NewItem(sb, types.YieldReserveKey, "yield_reserve", collections.Uint64Value),
+		Delegation:               collections.NewMap(sb, types.DelegationKey, "delegation", collections.StringKey, codec.CollValue[types.TrustDepositDelegation](cdc)),
+		UndelegationQueue:        collections.NewKeySet(sb, types.UndelegationQueueKey, "undelegation_queue", collections.PairKeyCodec(sdk.TimeKey, collections.StringKey)),
+		RewardRatio:              collections.NewMap(sb, types.RewardRatioKey, "reward_ratio", collections.StringKey, sdk.LegacyDecValue),
+		DelegationSync:           collections.NewItem(sb, types.DelegationSyncKey, "delegation_sync", collections.StringValue),
+		bankKeeper:               bankKeeper,
+		distrKeeper:              distrKeeper,
+		stakingKeeper:            stakingKeeper,
 	}
 }
 
@@ -4,6 +4,8 @@ import (
 	"context"
 	"cosmossdk.io/math"
 	"fmt"
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/verana-labs/verana-blockchain/x/trustdeposit/types"
 )
@@ -30,26 +32,12 @@ func (ms msgServer) ReclaimTrustDepositYield(goCtx context.Context, msg *types.M
 		return nil, fmt.Errorf("trust deposit not found for account: %s", account)
 	}
 
-	// [MOD-TD-MSG-2-2-1] Check slashing condition - CRITICAL MISSING CHECK
-	if td.SlashedDeposit > 0 && td.SlashedDeposit < td.RepaidDeposit {
-		return nil, fmt.Errorf("deposit has been slashed and not repaid")
-	}
-
-	// Get share value
-	params := ms.Keeper.GetParams(ctx)
-
-	// [MOD-TD-MSG-2-2-1] Calculate claimable yield
-	// claimable_yield = td.share * GlobalVariables.trust_deposit_share_value - td.deposit
-	depositValue := ms.Keeper.ShareToAmount(td.Share, params.TrustDepositShareValue)
-	if depositValue <= td.Amount { // td.Amount maps to spec's td.deposit
-		return nil, fmt.Errorf("no claimable yield available") // Updated error message
+	claimableYield, sharesToReduce, err := ms.Keeper.computeClaimableYield(ctx, td)
+	if err != nil {
+		return nil, err
 	}
 
-	claimableYield := depositValue - td.Amount
-
-	// [MOD-TD-MSG-2-3] Calculate shares to reduce
-	// td.share = td.share - claimable_yield / GlobalVariables.trust_deposit_share_value
-	sharesToReduce := ms.Keeper.AmountToShare(claimableYield, params.TrustDepositShareValue)
+	// [MOD-TD-MSG-2-3] td.share = td.share - claimable_yield / GlobalVariables.trust_deposit_share_value
 	td.Share -= sharesToReduce
 
 	addr, _ := sdk.AccAddressFromBech32(account)
@@ -109,48 +97,23 @@ func (ms msgServer) ReclaimTrustDeposit(goCtx context.Context, msg *types.MsgRec
 		return nil, fmt.Errorf("trust deposit not found for account: %s", account)
 	}
 
-	// Check if claimed amount is within claimable balance
-	if td.Claimable < msg.Claimed {
-		return nil, fmt.Errorf("claimed amount exceeds claimable balance")
-	}
-
-	// Get module params for calculations
-	params := ms.Keeper.GetParams(ctx)
-
-	// Calculate required minimum deposit using decimal math
-	requiredMinDeposit := ms.Keeper.ShareToAmount(td.Share, params.TrustDepositShareValue)
-
-	if td.Amount < msg.Claimed {
-		return nil, fmt.Errorf("amount less than claimed")
-	}
-
-	if requiredMinDeposit < (td.Amount - msg.Claimed) {
-		return nil, fmt.Errorf("insufficient required minimum deposit")
+	toBurn, toTransfer, shareReduction, err := ms.Keeper.computeReclaim(ctx, td, msg.Claimed)
+	if err != nil {
+		return nil, err
 	}
 
-	// Calculate burn amount and transfer amount using decimal math
-	toBurn := ms.Keeper.CalculateBurnAmount(msg.Claimed, params.TrustDepositReclaimBurnRate)
-	toTransfer := msg.Claimed - toBurn
-
-	// Calculate share reduction using decimal math
-	shareReduction := ms.Keeper.AmountToShare(msg.Claimed, params.TrustDepositShareValue)
-
 	// Update trust deposit
 	td.Claimable -= msg.Claimed
 	td.Amount -= msg.Claimed
 	td.Share -= shareReduction
 
-	addr, _ := sdk.AccAddressFromBech32(msg.Creator)
-	// Transfer claimable amount minus burn to the account
+	// The claimable amount minus burn is paid out to the account at the end of
+	// the unbonding period, it can be slashed until then
+	var unbonding types.TrustDepositUnbonding
 	if toTransfer > 0 {
-		transferCoins := sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, int64(toTransfer)))
-		if err := ms.Keeper.bankKeeper.SendCoinsFromModuleToAccount(
-			ctx,
-			types.ModuleName,
-			addr,
-			transferCoins,
-		); err != nil {
-			return nil, fmt.Errorf("failed to transfer coins: %w", err)
+		unbonding, err = ms.startUnbonding(ctx, account, toTransfer)
+		if err != nil {
+			return nil, err
 		}
 	}
 
@@ -171,9 +134,21 @@ func (ms msgServer) ReclaimTrustDeposit(goCtx context.Context, msg *types.MsgRec
 		return nil, fmt.Errorf("failed to update trust deposit: %w", err)
 	}
 
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReclaimTrustDeposit,
+			sdk.NewAttribute(types.AttributeKeyAccount, account),
+			sdk.NewAttribute(types.AttributeKeyAmount, strconv.FormatUint(toTransfer, 10)),
+			sdk.NewAttribute(types.AttributeKeyBurnedAmount, strconv.FormatUint(toBurn, 10)),
+			sdk.NewAttribute(types.AttributeKeyUnbondingID, strconv.FormatUint(unbonding.Id, 10)),
+		),
+	)
+
 	return &types.MsgReclaimTrustDepositResponse{
-		BurnedAmount:  toBurn,
-		ClaimedAmount: toTransfer,
+		BurnedAmount:   toBurn,
+		ClaimedAmount:  toTransfer,
+		UnbondingId:    unbonding.Id,
+		CompletionTime: unbonding.CompletionTime,
 	}, nil
 }
 
@@ -249,3 +224,89 @@ func (ms msgServer) RepaySlashedTrustDeposit(goCtx context.Context, msg *types.M
 
 	return &types.MsgRepaySlashedTrustDepositResponse{}, nil
 }
+
+func (ms msgServer) FundYieldReserve(goCtx context.Context, msg *types.MsgFundYieldReserve) (*types.MsgFundYieldReserveResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	creatorAddr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, fmt.Errorf("invalid creator address: %w", err)
+	}
+
+	reserve, err := ms.Keeper.GetYieldReserveAmount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Transfer amount from creator to trust deposit module
+	transferCoins := sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, int64(msg.Amount)))
+	if err := ms.Keeper.bankKeeper.SendCoinsFromAccountToModule(
+		ctx,
+		creatorAddr,
+		types.ModuleName,
+		transferCoins,
+	); err != nil {
+		return nil, fmt.Errorf("failed to transfer tokens: %w", err)
+	}
+
+	reserve += msg.Amount
+	if err := ms.Keeper.YieldReserve.Set(ctx, reserve); err != nil {
+		return nil, fmt.Errorf("failed to update yield reserve: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFundYieldReserve,
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyAmount, strconv.FormatUint(msg.Amount, 10)),
+			sdk.NewAttribute(types.AttributeKeyYieldReserve, strconv.FormatUint(reserve, 10)),
+		),
+	)
+
+	return &types.MsgFundYieldReserveResponse{}, nil
+}
+
+func (ms msgServer) DelegateTrustDeposit(goCtx context.Context, msg *types.MsgDelegateTrustDeposit) (*types.MsgDelegateTrustDepositResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	shares, err := ms.Keeper.delegateTrustDeposit(ctx, msg.Creator, msg.ValidatorAddress, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDelegateTrustDeposit,
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyAmount, strconv.FormatUint(msg.Amount, 10)),
+			sdk.NewAttribute(types.AttributeKeyShares, shares.String()),
+		),
+	)
+
+	return &types.MsgDelegateTrustDepositResponse{Shares: shares}, nil
+}
+
+func (ms msgServer) UndelegateTrustDeposit(goCtx context.Context, msg *types.MsgUndelegateTrustDeposit) (*types.MsgUndelegateTrustDepositResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	del, err := ms.Keeper.undelegateTrustDeposit(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUndelegateTrustDeposit,
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyValidator, del.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyAmount, strconv.FormatUint(del.UnbondingAmount, 10)),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, del.UnbondingCompletionTime.String()),
+		),
+	)
+
+	return &types.MsgUndelegateTrustDepositResponse{
+		Amount:         del.UnbondingAmount,
+		CompletionTime: del.UnbondingCompletionTime,
+	}, nil
+}
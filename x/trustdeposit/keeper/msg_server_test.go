@@ -2,10 +2,15 @@ package keeper_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
 
 	keepertest "github.com/verana-labs/verana-blockchain/testutil/keeper"
@@ -564,3 +569,481 @@ func TestUtilityFunctions(t *testing.T) {
 		require.Equal(t, params.TrustDepositShareValue, shareValue)
 	})
 }
+
+func TestEscrowSlashedTrustDeposit(t *testing.T) {
+	k, _, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	account := sdk.AccAddress([]byte("test_address")).String()
+	params := types.DefaultParams()
+	params.TrustDepositShareValue = math.LegacyMustNewDecFromStr("2")
+	require.NoError(t, k.SetParams(sdkCtx, params))
+	require.NoError(t, k.TrustDeposit.Set(sdkCtx, account, types.TrustDeposit{
+		Account: account,
+		Amount:  1000,
+		Share:   500,
+	}))
+
+	// escrow more than the deposit
+	err := k.EscrowSlashedTrustDeposit(sdkCtx, account, 2000)
+	require.ErrorContains(t, err, "amount exceeds available deposit")
+
+	// escrowed amounts leave the deposit without being recorded as slashed
+	require.NoError(t, k.EscrowSlashedTrustDeposit(sdkCtx, account, 400))
+	td, err := k.TrustDeposit.Get(sdkCtx, account)
+	require.NoError(t, err)
+	require.Equal(t, uint64(600), td.Amount)
+	require.Equal(t, uint64(300), td.Share)
+	require.Equal(t, uint64(0), td.SlashedDeposit)
+
+	// a released escrow is returned to the deposit
+	require.NoError(t, k.ReleaseEscrowedSlashedTrustDeposit(sdkCtx, account, 400))
+	td, err = k.TrustDeposit.Get(sdkCtx, account)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), td.Amount)
+	require.Equal(t, uint64(500), td.Share)
+
+	// a burned escrow is recorded as slashed
+	require.NoError(t, k.EscrowSlashedTrustDeposit(sdkCtx, account, 400))
+	require.NoError(t, k.DistributeEscrowedSlashedTrustDeposit(sdkCtx, account, 400, nil))
+	td, err = k.TrustDeposit.Get(sdkCtx, account)
+	require.NoError(t, err)
+	require.Equal(t, uint64(600), td.Amount)
+	require.Equal(t, uint64(400), td.SlashedDeposit)
+	require.Equal(t, uint64(1), td.SlashCount)
+	require.NotNil(t, td.LastSlashed)
+}
+
+func TestDistributeSlashedTrustDeposit(t *testing.T) {
+	k, distrKeeper, sdkCtx := keepertest.TrustdepositKeeperWithDistribution(t)
+
+	account := sdk.AccAddress([]byte("test_address")).String()
+	beneficiary := sdk.AccAddress([]byte("test_beneficiary")).String()
+	require.NoError(t, k.TrustDeposit.Set(sdkCtx, account, types.TrustDeposit{
+		Account: account,
+		Amount:  1000,
+		Share:   1000,
+	}))
+
+	// invalid distributions are rejected
+	require.NoError(t, k.EscrowSlashedTrustDeposit(sdkCtx, account, 101))
+	err := k.DistributeEscrowedSlashedTrustDeposit(sdkCtx, account, 101, []types.SlashShare{
+		{Destination: types.SlashDestination_SLASH_DESTINATION_BURN, Weight: math.LegacyMustNewDecFromStr("0.5")},
+	})
+	require.ErrorContains(t, err, "weights must sum to 1")
+
+	// the amount is split between the shares, the last one getting the remainder
+	distribution := []types.SlashShare{
+		{Destination: types.SlashDestination_SLASH_DESTINATION_BURN, Weight: math.LegacyMustNewDecFromStr("0.5")},
+		{Destination: types.SlashDestination_SLASH_DESTINATION_COMMUNITY_POOL, Weight: math.LegacyMustNewDecFromStr("0.25")},
+		{Destination: types.SlashDestination_SLASH_DESTINATION_BENEFICIARY, Beneficiary: beneficiary, Weight: math.LegacyMustNewDecFromStr("0.25")},
+	}
+	require.NoError(t, k.DistributeEscrowedSlashedTrustDeposit(sdkCtx, account, 101, distribution))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, 25)), distrKeeper.CommunityPool)
+
+	td, err := k.TrustDeposit.Get(sdkCtx, account)
+	require.NoError(t, err)
+	require.Equal(t, uint64(899), td.Amount)
+	require.Equal(t, uint64(101), td.SlashedDeposit)
+
+	resp, err := k.ListSlashDistributions(sdkCtx, &types.QueryListSlashDistributionsRequest{Account: account})
+	require.NoError(t, err)
+	require.Len(t, resp.SlashDistributions, 3)
+	require.Equal(t, uint64(50), resp.SlashDistributions[0].Amount)
+	require.Equal(t, types.SlashDestination_SLASH_DESTINATION_BURN, resp.SlashDistributions[0].Destination)
+	require.Equal(t, uint64(25), resp.SlashDistributions[1].Amount)
+	require.Equal(t, uint64(26), resp.SlashDistributions[2].Amount)
+	require.Equal(t, beneficiary, resp.SlashDistributions[2].Recipient)
+
+	// an empty distribution burns the slashed amount
+	require.NoError(t, k.EscrowSlashedTrustDeposit(sdkCtx, account, 10))
+	require.NoError(t, k.DistributeEscrowedSlashedTrustDeposit(sdkCtx, account, 10, nil))
+
+	resp, err = k.ListSlashDistributions(sdkCtx, &types.QueryListSlashDistributionsRequest{Recipient: beneficiary})
+	require.NoError(t, err)
+	require.Len(t, resp.SlashDistributions, 1)
+
+	resp, err = k.ListSlashDistributions(sdkCtx, &types.QueryListSlashDistributionsRequest{Account: account})
+	require.NoError(t, err)
+	require.Len(t, resp.SlashDistributions, 4)
+	require.Equal(t, types.SlashDestination_SLASH_DESTINATION_BURN, resp.SlashDistributions[3].Destination)
+	require.Equal(t, uint64(10), resp.SlashDistributions[3].Amount)
+}
+
+func TestReclaimTrustDepositUnbonding(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	now := time.Now().UTC()
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockTime(now)
+
+	account := sdk.AccAddress([]byte("test_address")).String()
+	params := types.DefaultParams()
+	params.TrustDepositReclaimBurnRate = math.LegacyMustNewDecFromStr("0.5")
+	params.TrustDepositUnbondingPeriodDays = 10
+	require.NoError(t, k.SetParams(sdkCtx, params))
+	require.NoError(t, k.TrustDeposit.Set(sdkCtx, account, types.TrustDeposit{
+		Account:   account,
+		Share:     1000,
+		Amount:    1000,
+		Claimable: 1000,
+	}))
+
+	// the reclaimed amount minus burn is unbonding
+	resp, err := ms.ReclaimTrustDeposit(sdkCtx, &types.MsgReclaimTrustDeposit{
+		Creator: account,
+		Claimed: 1000,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(500), resp.BurnedAmount)
+	require.Equal(t, uint64(500), resp.ClaimedAmount)
+	require.Equal(t, uint64(1), resp.UnbondingId)
+	require.Equal(t, now.AddDate(0, 0, 10), *resp.CompletionTime)
+
+	td, err := k.TrustDeposit.Get(sdkCtx, account)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), td.Amount)
+
+	unbondings, err := k.ListUnbondings(sdkCtx, &types.QueryListUnbondingsRequest{Account: account})
+	require.NoError(t, err)
+	require.Len(t, unbondings.Unbondings, 1)
+	require.Equal(t, uint64(500), unbondings.Unbondings[0].Amount)
+
+	// the unbonding amount can still be slashed
+	err = k.SlashTrustDeposit(sdkCtx, account, math.NewInt(600))
+	require.ErrorContains(t, err, "deposit: 500, required: 600")
+	require.NoError(t, k.SlashTrustDeposit(sdkCtx, account, math.NewInt(200)))
+	require.NoError(t, k.EscrowSlashedTrustDeposit(sdkCtx, account, 100))
+
+	unbonding, err := k.Unbonding.Get(sdkCtx, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(500), unbonding.InitialAmount)
+	require.Equal(t, uint64(200), unbonding.Amount)
+
+	td, err = k.TrustDeposit.Get(sdkCtx, account)
+	require.NoError(t, err)
+	require.Equal(t, uint64(200), td.SlashedDeposit)
+
+	// nothing is paid out before the end of the unbonding period
+	require.NoError(t, k.CompleteMatureUnbondings(sdkCtx.WithBlockTime(now.AddDate(0, 0, 9))))
+	_, err = k.Unbonding.Get(sdkCtx, 1)
+	require.NoError(t, err)
+
+	// the unbonding is paid out and removed once mature
+	require.NoError(t, k.CompleteMatureUnbondings(sdkCtx.WithBlockTime(now.AddDate(0, 0, 10))))
+	_, err = k.Unbonding.Get(sdkCtx, 1)
+	require.Error(t, err)
+
+	unbondings, err = k.ListUnbondings(sdkCtx, &types.QueryListUnbondingsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, unbondings.Unbondings)
+
+	// an unbonding that fails to complete does not fail the EndBlocker, it is
+	// kept and queued again for a retry
+	mature := now.AddDate(0, 0, 10)
+	require.NoError(t, k.Unbonding.Set(sdkCtx, 2, types.TrustDepositUnbonding{
+		Id:             2,
+		Account:        "invalid",
+		InitialAmount:  10,
+		Amount:         10,
+		Created:        &now,
+		CompletionTime: &mature,
+	}))
+	require.NoError(t, k.UnbondingQueue.Set(sdkCtx, collections.Join(mature, uint64(2))))
+	require.NoError(t, k.CompleteMatureUnbondings(sdkCtx.WithBlockTime(mature)))
+	_, err = k.Unbonding.Get(sdkCtx, 2)
+	require.NoError(t, err)
+	retried, err := k.UnbondingQueue.Has(sdkCtx, collections.Join(mature.Add(time.Hour), uint64(2)))
+	require.NoError(t, err)
+	require.True(t, retried)
+}
+
+func TestYieldDistribution(t *testing.T) {
+	k, bankKeeper, sdkCtx := keepertest.TrustdepositKeeperWithBank(t)
+	ms := keeper.NewMsgServerImpl(k)
+	now := time.Now().UTC()
+	sdkCtx = sdkCtx.WithBlockTime(now).WithBlockHeight(9)
+
+	params := types.DefaultParams()
+	params.YieldEpochBlocks = 10
+	params.YieldFeeCollectorShare = math.LegacyMustNewDecFromStr("0.2")
+	params.YieldReserveReleaseRate = math.LegacyMustNewDecFromStr("0.1")
+	require.NoError(t, k.SetParams(sdkCtx, params))
+
+	account1 := sdk.AccAddress([]byte("test_address1")).String()
+	account2 := sdk.AccAddress([]byte("test_address2")).String()
+	require.NoError(t, k.TrustDeposit.Set(sdkCtx, account1, types.TrustDeposit{Account: account1, Share: 1000, Amount: 1000}))
+	require.NoError(t, k.TrustDeposit.Set(sdkCtx, account2, types.TrustDeposit{Account: account2, Share: 3000, Amount: 3000}))
+	bankKeeper.FundModule(authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, 1000)))
+
+	// fund the reserve
+	_, err := ms.FundYieldReserve(sdkCtx, &types.MsgFundYieldReserve{Creator: account1, Amount: 1000})
+	require.NoError(t, err)
+	reserve, err := k.GetYieldReserve(sdkCtx, &types.QueryGetYieldReserveRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), reserve.YieldReserve)
+
+	// nothing is distributed before the end of the epoch
+	require.NoError(t, k.BeginBlocker(sdkCtx))
+	require.True(t, k.GetParams(sdkCtx).TrustDepositShareValue.Equal(math.LegacyOneDec()))
+
+	// 20% of the fees (200) and 10% of the reserve (100) are distributed
+	// over 4000 shares
+	sdkCtx = sdkCtx.WithBlockHeight(10)
+	require.NoError(t, k.BeginBlocker(sdkCtx))
+	shareValue := k.GetParams(sdkCtx).TrustDepositShareValue
+	require.Equal(t, math.LegacyMustNewDecFromStr("1.075"), shareValue)
+	require.Equal(t, int64(800), bankKeeper.ModuleBalances[authtypes.FeeCollectorName].AmountOf(types.BondDenom).Int64())
+	require.Equal(t, int64(200), bankKeeper.ModuleBalances[types.ModuleName].AmountOf(types.BondDenom).Int64())
+	reserveAmount, err := k.GetYieldReserveAmount(sdkCtx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(900), reserveAmount)
+
+	// holders earn pro rata of their shares
+	require.Equal(t, uint64(1075), k.ShareToAmount(1000, shareValue))
+	require.Equal(t, uint64(3225), k.ShareToAmount(3000, shareValue))
+
+	// the first record has no APY, the next one is annualized over the epoch
+	sdkCtx = sdkCtx.WithBlockHeight(20).WithBlockTime(now.Add(24 * time.Hour))
+	require.NoError(t, k.BeginBlocker(sdkCtx))
+	newShareValue := k.GetParams(sdkCtx).TrustDepositShareValue
+	require.Equal(t, math.LegacyMustNewDecFromStr("1.1375"), newShareValue)
+
+	resp, err := k.ListYieldRecords(sdkCtx, &types.QueryListYieldRecordsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.YieldRecords, 2)
+	require.Equal(t, uint64(2), resp.YieldRecords[0].Id)
+	require.Equal(t, uint64(250), resp.YieldRecords[0].Amount)
+	require.Equal(t, uint64(4000), resp.YieldRecords[0].TotalShares)
+	expectedAPY := newShareValue.Quo(shareValue).Sub(math.LegacyOneDec()).MulInt64(365)
+	require.Equal(t, expectedAPY, resp.YieldRecords[0].Apy)
+	require.Equal(t, uint64(1), resp.YieldRecords[1].Id)
+	require.Equal(t, uint64(300), resp.YieldRecords[1].Amount)
+	require.True(t, resp.YieldRecords[1].Apy.IsZero())
+
+	// the response size is bounded
+	resp, err = k.ListYieldRecords(sdkCtx, &types.QueryListYieldRecordsRequest{ResponseMaxSize: 1})
+	require.NoError(t, err)
+	require.Len(t, resp.YieldRecords, 1)
+	require.Equal(t, uint64(2), resp.YieldRecords[0].Id)
+
+	// a failed distribution is reported and leaves the state untouched, it
+	// runs again at the next epoch
+	params = k.GetParams(sdkCtx)
+	params.YieldFeeCollectorShare = math.LegacyMustNewDecFromStr("1.5")
+	require.NoError(t, k.SetParams(sdkCtx, params))
+	failCtx := sdkCtx.WithBlockHeight(30).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.BeginBlocker(failCtx))
+	failed := 0
+	for _, event := range failCtx.EventManager().Events() {
+		if event.Type == types.EventTypeYieldEpochStepFailed {
+			failed++
+		}
+	}
+	require.Equal(t, 1, failed)
+	require.Equal(t, newShareValue, k.GetParams(sdkCtx).TrustDepositShareValue)
+	reserveAmount, err = k.GetYieldReserveAmount(sdkCtx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(810), reserveAmount)
+
+	params.YieldFeeCollectorShare = math.LegacyMustNewDecFromStr("0.2")
+	require.NoError(t, k.SetParams(sdkCtx, params))
+	require.NoError(t, k.BeginBlocker(sdkCtx.WithBlockHeight(40)))
+	resp, err = k.ListYieldRecords(sdkCtx, &types.QueryListYieldRecordsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.YieldRecords, 3)
+}
+
+func TestDelegateTrustDeposit(t *testing.T) {
+	k, stakingKeeper, sdkCtx := keepertest.TrustdepositKeeperWithStaking(t)
+	ms := keeper.NewMsgServerImpl(k)
+	now := time.Now().UTC()
+	sdkCtx = sdkCtx.WithBlockTime(now).WithBlockHeight(1)
+
+	account := sdk.AccAddress([]byte("test_address")).String()
+	valAddr := sdk.ValAddress([]byte("test_validator"))
+	stakingKeeper.AddValidator(valAddr)
+	stakingKeeper.Bank.FundModule(types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, 1000)))
+	require.NoError(t, k.TrustDeposit.Set(sdkCtx, account, types.TrustDeposit{
+		Account:   account,
+		Share:     1000,
+		Amount:    1000,
+		Claimable: 1000,
+	}))
+	bondedPool := func() int64 {
+		return stakingKeeper.Bank.ModuleBalances[stakingtypes.BondedPoolName].AmountOf(types.BondDenom).Int64()
+	}
+
+	// delegating more than the deposit fails
+	_, err := ms.DelegateTrustDeposit(sdkCtx, &types.MsgDelegateTrustDeposit{Creator: account, ValidatorAddress: valAddr.String(), Amount: 1001})
+	require.ErrorContains(t, err, "amount exceeds undelegated trust deposit")
+
+	resp, err := ms.DelegateTrustDeposit(sdkCtx, &types.MsgDelegateTrustDeposit{Creator: account, ValidatorAddress: valAddr.String(), Amount: 600})
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(600), resp.Shares)
+	require.Equal(t, int64(600), bondedPool())
+
+	// the delegated deposit can't be reclaimed
+	_, err = ms.ReclaimTrustDeposit(sdkCtx, &types.MsgReclaimTrustDeposit{Creator: account, Claimed: 500})
+	require.ErrorContains(t, err, "claimed amount exceeds undelegated trust deposit")
+
+	// staking rewards are added to the deposit yield at the end of the epoch
+	stakingKeeper.Distribution.Rewards[valAddr.String()] = sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, 120))
+	sdkCtx = sdkCtx.WithBlockHeight(int64(types.DefaultYieldEpochBlocks))
+	require.NoError(t, k.BeginBlocker(sdkCtx))
+	yield, err := k.GetClaimableYield(sdkCtx, &types.QueryGetClaimableYieldRequest{Account: account})
+	require.NoError(t, err)
+	require.Equal(t, uint64(120), yield.ClaimableYield)
+
+	// a validator slash is deducted from the deposit when reconciled
+	stakingKeeper.SlashValidator(valAddr, math.LegacyMustNewDecFromStr("0.5"))
+	sdkCtx = sdkCtx.WithBlockHeight(int64(2 * types.DefaultYieldEpochBlocks))
+	require.NoError(t, k.BeginBlocker(sdkCtx))
+	td, err := k.TrustDeposit.Get(sdkCtx, account)
+	require.NoError(t, err)
+	require.Equal(t, uint64(700), td.Amount)
+	del, err := k.GetTrustDepositDelegation(sdkCtx, &types.QueryGetTrustDepositDelegationRequest{Account: account})
+	require.NoError(t, err)
+	require.Equal(t, uint64(300), del.Delegation.Amount)
+
+	// a trust deposit slash unbonds what the undelegated deposit doesn't cover
+	require.NoError(t, k.BurnEcosystemSlashedTrustDeposit(sdkCtx, account, 500))
+	td, err = k.TrustDeposit.Get(sdkCtx, account)
+	require.NoError(t, err)
+	require.Equal(t, uint64(200), td.Amount)
+	del, err = k.GetTrustDepositDelegation(sdkCtx, &types.QueryGetTrustDepositDelegationRequest{Account: account})
+	require.NoError(t, err)
+	require.Equal(t, uint64(200), del.Delegation.Amount)
+	require.Equal(t, math.LegacyNewDec(400), del.Delegation.Shares)
+	require.Equal(t, int64(200), bondedPool())
+
+	// undelegation waits for the staking unbonding period
+	undelegated, err := ms.UndelegateTrustDeposit(sdkCtx, &types.MsgUndelegateTrustDeposit{Creator: account})
+	require.NoError(t, err)
+	require.Equal(t, uint64(200), undelegated.Amount)
+	require.Equal(t, now.Add(stakingKeeper.UnbondingTime), *undelegated.CompletionTime)
+	has, err := k.UndelegationQueue.Has(sdkCtx, collections.Join(*undelegated.CompletionTime, account))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// the amount being undelegated is slashed at once, the module keeps the
+	// slashed part when the undelegation completes
+	stakingKeeper.Bank.FundModule(types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, 50)))
+	require.NoError(t, k.BurnEcosystemSlashedTrustDeposit(sdkCtx, account, 50))
+	td, err = k.TrustDeposit.Get(sdkCtx, account)
+	require.NoError(t, err)
+	require.Equal(t, uint64(150), td.Amount)
+	del, err = k.GetTrustDepositDelegation(sdkCtx, &types.QueryGetTrustDepositDelegationRequest{Account: account})
+	require.NoError(t, err)
+	require.Equal(t, uint64(200), del.Delegation.UnbondingAmount)
+	require.Equal(t, uint64(50), del.Delegation.UnbondingSlashed)
+	err = k.BurnEcosystemSlashedTrustDeposit(sdkCtx, account, 151)
+	require.ErrorContains(t, err, "amount exceeds available deposit")
+
+	// validator slashes of the unbonding period are deducted on completion
+	stakingKeeper.SlashValidator(valAddr, math.LegacyMustNewDecFromStr("0.5"))
+	sdkCtx = sdkCtx.WithBlockTime(*undelegated.CompletionTime)
+	require.NoError(t, k.CompleteMatureUndelegations(sdkCtx))
+	stakingKeeper.CompleteUnbondings(sdkCtx)
+
+	td, err = k.TrustDeposit.Get(sdkCtx, account)
+	require.NoError(t, err)
+	require.Equal(t, uint64(50), td.Amount)
+	_, err = k.GetTrustDepositDelegation(sdkCtx, &types.QueryGetTrustDepositDelegationRequest{Account: account})
+	require.Error(t, err)
+	has, err = k.UndelegationQueue.Has(sdkCtx, collections.Join(*undelegated.CompletionTime, account))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// the deposit is undelegated again, it can be slashed and reclaimed
+	require.NoError(t, k.BurnEcosystemSlashedTrustDeposit(sdkCtx, account, 10))
+	_, err = ms.ReclaimTrustDeposit(sdkCtx, &types.MsgReclaimTrustDeposit{Creator: account, Claimed: 40})
+	require.NoError(t, err)
+
+	// an undelegation that fails to complete is queued again
+	orphan := sdk.AccAddress([]byte("orphan_address")).String()
+	completion := sdkCtx.BlockTime()
+	require.NoError(t, k.Delegation.Set(sdkCtx, orphan, types.TrustDepositDelegation{
+		Account:                 orphan,
+		ValidatorAddress:        valAddr.String(),
+		Shares:                  math.LegacyZeroDec(),
+		UnbondingAmount:         10,
+		UnbondingCompletionTime: &completion,
+	}))
+	require.NoError(t, k.UndelegationQueue.Set(sdkCtx, collections.Join(completion, orphan)))
+	require.NoError(t, k.CompleteMatureUndelegations(sdkCtx))
+	has, err = k.UndelegationQueue.Has(sdkCtx, collections.Join(completion.Add(time.Hour), orphan))
+	require.NoError(t, err)
+	require.True(t, has)
+	del, err = k.GetTrustDepositDelegation(sdkCtx, &types.QueryGetTrustDepositDelegationRequest{Account: orphan})
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), del.Delegation.UnbondingAmount)
+}
+
+func TestSyncDelegations(t *testing.T) {
+	k, stakingKeeper, sdkCtx := keepertest.TrustdepositKeeperWithStaking(t)
+	ms := keeper.NewMsgServerImpl(k)
+	sdkCtx = sdkCtx.WithBlockTime(time.Now().UTC()).WithBlockHeight(1)
+
+	valAddr := sdk.ValAddress([]byte("test_validator"))
+	stakingKeeper.AddValidator(valAddr)
+
+	// one more delegation than synced per block
+	var accounts []string
+	for i := 0; i < 201; i++ {
+		account := sdk.AccAddress([]byte(fmt.Sprintf("test_address_%03d", i))).String()
+		accounts = append(accounts, account)
+		stakingKeeper.Bank.FundModule(types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, 10)))
+		require.NoError(t, k.TrustDeposit.Set(sdkCtx, account, types.TrustDeposit{Account: account, Share: 10, Amount: 10}))
+		_, err := ms.DelegateTrustDeposit(sdkCtx, &types.MsgDelegateTrustDeposit{Creator: account, ValidatorAddress: valAddr.String(), Amount: 10})
+		require.NoError(t, err)
+	}
+
+	// a delegation without trust deposit fails to sync
+	broken := sdk.AccAddress([]byte("broken_address")).String()
+	require.NoError(t, k.Delegation.Set(sdkCtx, broken, types.TrustDepositDelegation{
+		Account:          broken,
+		ValidatorAddress: valAddr.String(),
+		Shares:           math.LegacyNewDec(10),
+		Amount:           10,
+		RewardRatio:      math.LegacyZeroDec(),
+	}))
+
+	// 2 per share
+	stakingKeeper.Distribution.Rewards[valAddr.String()] = sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, 4020))
+
+	countFailed := func(ctx sdk.Context) int {
+		failed := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeSyncDelegationFailed {
+				failed++
+			}
+		}
+		return failed
+	}
+
+	// the sync starts at the end of the epoch and continues on the next block
+	epochCtx := sdkCtx.WithBlockHeight(int64(types.DefaultYieldEpochBlocks)).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.BeginBlocker(epochCtx))
+	running, err := k.DelegationSync.Has(sdkCtx)
+	require.NoError(t, err)
+	require.True(t, running)
+
+	nextCtx := sdkCtx.WithBlockHeight(int64(types.DefaultYieldEpochBlocks) + 1).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.BeginBlocker(nextCtx))
+	running, err = k.DelegationSync.Has(sdkCtx)
+	require.NoError(t, err)
+	require.False(t, running)
+	require.Equal(t, 1, countFailed(epochCtx)+countFailed(nextCtx))
+
+	// the failure does not prevent the other delegations from syncing
+	for _, account := range accounts {
+		yield, err := k.GetClaimableYield(sdkCtx, &types.QueryGetClaimableYieldRequest{Account: account})
+		require.NoError(t, err)
+		require.Equal(t, uint64(20), yield.ClaimableYield)
+	}
+
+	// nothing is synced before the next epoch
+	require.NoError(t, k.BeginBlocker(sdkCtx.WithBlockHeight(int64(types.DefaultYieldEpochBlocks)+2)))
+	running, err = k.DelegationSync.Has(sdkCtx)
+	require.NoError(t, err)
+	require.False(t, running)
+}
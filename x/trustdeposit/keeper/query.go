@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 	"fmt"
+
+	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/verana-labs/verana-blockchain/x/trustdeposit/types"
 	"google.golang.org/grpc/codes"
@@ -34,3 +36,247 @@ func (k Keeper) GetTrustDeposit(goCtx context.Context, req *types.QueryGetTrustD
 		TrustDeposit: trustDeposit,
 	}, nil
 }
+
+func (k Keeper) ListSlashDistributions(goCtx context.Context, req *types.QueryListSlashDistributionsRequest) (*types.QueryListSlashDistributionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// Validate response_max_size
+	if req.ResponseMaxSize == 0 {
+		req.ResponseMaxSize = 64 // Default value
+	}
+	if req.ResponseMaxSize < 1 || req.ResponseMaxSize > 1024 {
+		return nil, status.Error(codes.InvalidArgument, "response_max_size must be between 1 and 1,024")
+	}
+
+	if req.Account != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Account); err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid account address: %s", err))
+		}
+	}
+	if req.Recipient != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Recipient); err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid recipient address: %s", err))
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	var distributions []types.SlashDistribution
+
+	// slash distributions are walked in ID order
+	err := k.SlashDistribution.Walk(ctx, nil, func(id uint64, distribution types.SlashDistribution) (bool, error) {
+		if req.Account != "" && distribution.Account != req.Account {
+			return false, nil
+		}
+		if req.Recipient != "" && distribution.Recipient != req.Recipient {
+			return false, nil
+		}
+
+		distributions = append(distributions, distribution)
+		return len(distributions) >= int(req.ResponseMaxSize), nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list slash distributions")
+	}
+
+	return &types.QueryListSlashDistributionsResponse{
+		SlashDistributions: distributions,
+	}, nil
+}
+
+func (k Keeper) ListUnbondings(goCtx context.Context, req *types.QueryListUnbondingsRequest) (*types.QueryListUnbondingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// Validate response_max_size
+	if req.ResponseMaxSize == 0 {
+		req.ResponseMaxSize = 64 // Default value
+	}
+	if req.ResponseMaxSize < 1 || req.ResponseMaxSize > 1024 {
+		return nil, status.Error(codes.InvalidArgument, "response_max_size must be between 1 and 1,024")
+	}
+
+	if req.Account != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Account); err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid account address: %s", err))
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	var unbondings []types.TrustDepositUnbonding
+
+	// unbondings are walked in ID order, through the account index if set
+	collect := func(unbonding types.TrustDepositUnbonding) (bool, error) {
+		unbondings = append(unbondings, unbonding)
+		return len(unbondings) >= int(req.ResponseMaxSize), nil
+	}
+	var err error
+	if req.Account != "" {
+		err = k.walkAccountUnbondings(ctx, req.Account, collect)
+	} else {
+		err = k.Unbonding.Walk(ctx, nil, func(id uint64, unbonding types.TrustDepositUnbonding) (bool, error) {
+			return collect(unbonding)
+		})
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list unbondings")
+	}
+
+	return &types.QueryListUnbondingsResponse{
+		Unbondings: unbondings,
+	}, nil
+}
+
+func (k Keeper) ListYieldRecords(goCtx context.Context, req *types.QueryListYieldRecordsRequest) (*types.QueryListYieldRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// Validate response_max_size
+	if req.ResponseMaxSize == 0 {
+		req.ResponseMaxSize = 64 // Default value
+	}
+	if req.ResponseMaxSize < 1 || req.ResponseMaxSize > 1024 {
+		return nil, status.Error(codes.InvalidArgument, "response_max_size must be between 1 and 1,024")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	var records []types.YieldRecord
+
+	// yield records are walked newest first
+	err := k.YieldRecord.Walk(ctx, new(collections.Range[uint64]).Descending(), func(id uint64, record types.YieldRecord) (bool, error) {
+		records = append(records, record)
+		return len(records) >= int(req.ResponseMaxSize), nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list yield records")
+	}
+
+	return &types.QueryListYieldRecordsResponse{
+		YieldRecords: records,
+	}, nil
+}
+
+func (k Keeper) GetYieldReserve(goCtx context.Context, req *types.QueryGetYieldReserveRequest) (*types.QueryGetYieldReserveResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	reserve, err := k.GetYieldReserveAmount(goCtx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetYieldReserveResponse{YieldReserve: reserve}, nil
+}
+
+func (k Keeper) SimulateReclaim(goCtx context.Context, req *types.QuerySimulateReclaimRequest) (*types.QuerySimulateReclaimResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Account); err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid account address: %s", err))
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Rejections are reported in the response, with the message
+	// ReclaimTrustDeposit would fail with
+	if req.Amount == 0 {
+		return &types.QuerySimulateReclaimResponse{Reason: "claimed amount must be greater than 0"}, nil
+	}
+
+	td, err := k.TrustDeposit.Get(ctx, req.Account)
+	if err != nil {
+		return &types.QuerySimulateReclaimResponse{
+			Reason: fmt.Sprintf("trust deposit not found for account: %s", req.Account),
+		}, nil
+	}
+
+	toBurn, toTransfer, shareReduction, err := k.computeReclaim(ctx, td, req.Amount)
+	if err != nil {
+		return &types.QuerySimulateReclaimResponse{
+			Reason:    err.Error(),
+			Share:     td.Share,
+			Amount:    td.Amount,
+			Claimable: td.Claimable,
+		}, nil
+	}
+
+	resp := &types.QuerySimulateReclaimResponse{
+		Allowed:       true,
+		BurnedAmount:  toBurn,
+		ClaimedAmount: toTransfer,
+		Share:         td.Share - shareReduction,
+		Amount:        td.Amount - req.Amount,
+		Claimable:     td.Claimable - req.Amount,
+	}
+	if toTransfer > 0 {
+		completionTime := ctx.BlockTime().AddDate(0, 0, int(k.GetParams(ctx).TrustDepositUnbondingPeriodDays))
+		resp.CompletionTime = &completionTime
+	}
+
+	return resp, nil
+}
+
+func (k Keeper) GetClaimableYield(goCtx context.Context, req *types.QueryGetClaimableYieldRequest) (*types.QueryGetClaimableYieldResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Account); err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid account address: %s", err))
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	shareValue := k.GetParams(ctx).TrustDepositShareValue
+
+	// Rejections are reported in the response, with the message
+	// ReclaimTrustDepositYield would fail with
+	td, err := k.TrustDeposit.Get(ctx, req.Account)
+	if err != nil {
+		return &types.QueryGetClaimableYieldResponse{
+			Reason:     fmt.Sprintf("trust deposit not found for account: %s", req.Account),
+			ShareValue: shareValue,
+		}, nil
+	}
+
+	claimableYield, shareReduction, err := k.computeClaimableYield(ctx, td)
+	if err != nil {
+		return &types.QueryGetClaimableYieldResponse{
+			Reason:     err.Error(),
+			ShareValue: shareValue,
+			Share:      td.Share,
+		}, nil
+	}
+
+	return &types.QueryGetClaimableYieldResponse{
+		Allowed:        true,
+		ClaimableYield: claimableYield,
+		ShareValue:     shareValue,
+		Share:          td.Share - shareReduction,
+	}, nil
+}
+
+func (k Keeper) GetTrustDepositDelegation(goCtx context.Context, req *types.QueryGetTrustDepositDelegationRequest) (*types.QueryGetTrustDepositDelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Account); err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid account address: %s", err))
+	}
+
+	del, found, err := k.getDelegation(goCtx, req.Account)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("trust deposit of account %s is not delegated", req.Account))
+	}
+
+	return &types.QueryGetTrustDepositDelegationResponse{Delegation: del}, nil
+}
@@ -1,13 +1,17 @@
 package keeper_test
 
 import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	keepertest "github.com/verana-labs/verana-blockchain/testutil/keeper"
+	"github.com/verana-labs/verana-blockchain/x/trustdeposit/keeper"
 	"github.com/verana-labs/verana-blockchain/x/trustdeposit/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"testing"
 )
 
 func TestGetTrustDeposit(t *testing.T) {
@@ -50,3 +54,102 @@ func TestGetTrustDeposit(t *testing.T) {
 	require.Contains(t, err.Error(), "invalid account address")
 	require.Contains(t, status.Code(err).String(), codes.InvalidArgument.String())
 }
+
+func TestSimulateReclaim(t *testing.T) {
+	k, ctx := keepertest.TrustdepositKeeper(t)
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+
+	testAddr := sdk.AccAddress([]byte("test_address")).String()
+	params := types.DefaultParams()
+	params.TrustDepositReclaimBurnRate = math.LegacyMustNewDecFromStr("0.6")
+	params.TrustDepositUnbondingPeriodDays = 10
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// Missing trust deposit is a rejection, not an error
+	resp, err := k.SimulateReclaim(ctx, &types.QuerySimulateReclaimRequest{Account: testAddr, Amount: 100})
+	require.NoError(t, err)
+	require.False(t, resp.Allowed)
+	require.Contains(t, resp.Reason, "trust deposit not found")
+
+	require.NoError(t, k.TrustDeposit.Set(ctx, testAddr, types.TrustDeposit{
+		Account:   testAddr,
+		Share:     1000,
+		Amount:    1000,
+		Claimable: 500,
+	}))
+
+	// Claiming more than claimable is rejected with the same reason as the msg
+	resp, err = k.SimulateReclaim(ctx, &types.QuerySimulateReclaimRequest{Account: testAddr, Amount: 600})
+	require.NoError(t, err)
+	require.False(t, resp.Allowed)
+	require.Equal(t, "claimed amount exceeds claimable balance", resp.Reason)
+	require.Equal(t, uint64(1000), resp.Share)
+
+	// The simulation matches the reclaim
+	resp, err = k.SimulateReclaim(ctx, &types.QuerySimulateReclaimRequest{Account: testAddr, Amount: 500})
+	require.NoError(t, err)
+	require.True(t, resp.Allowed)
+	require.Equal(t, uint64(300), resp.BurnedAmount)
+	require.Equal(t, uint64(200), resp.ClaimedAmount)
+	require.Equal(t, now.AddDate(0, 0, 10), *resp.CompletionTime)
+
+	msgResp, err := keeper.NewMsgServerImpl(k).ReclaimTrustDeposit(ctx, &types.MsgReclaimTrustDeposit{Creator: testAddr, Claimed: 500})
+	require.NoError(t, err)
+	require.Equal(t, msgResp.BurnedAmount, resp.BurnedAmount)
+	require.Equal(t, msgResp.ClaimedAmount, resp.ClaimedAmount)
+	td, err := k.TrustDeposit.Get(ctx, testAddr)
+	require.NoError(t, err)
+	require.Equal(t, td.Share, resp.Share)
+	require.Equal(t, td.Amount, resp.Amount)
+	require.Equal(t, td.Claimable, resp.Claimable)
+
+	// Invalid account address
+	_, err = k.SimulateReclaim(ctx, &types.QuerySimulateReclaimRequest{Account: "invalid_address", Amount: 1})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetClaimableYield(t *testing.T) {
+	k, ctx := keepertest.TrustdepositKeeper(t)
+
+	testAddr := sdk.AccAddress([]byte("test_address")).String()
+	params := types.DefaultParams()
+	params.TrustDepositShareValue = math.LegacyMustNewDecFromStr("1.5")
+	require.NoError(t, k.SetParams(ctx, params))
+	require.NoError(t, k.TrustDeposit.Set(ctx, testAddr, types.TrustDeposit{
+		Account:        testAddr,
+		Share:          1000,
+		Amount:         1000,
+		SlashedDeposit: 100,
+		RepaidDeposit:  50,
+	}))
+
+	// An unrepaid slash blocks the yield
+	resp, err := k.GetClaimableYield(ctx, &types.QueryGetClaimableYieldRequest{Account: testAddr})
+	require.NoError(t, err)
+	require.False(t, resp.Allowed)
+	require.Equal(t, "deposit has been slashed and not repaid", resp.Reason)
+
+	_, err = keeper.NewMsgServerImpl(k).ReclaimTrustDepositYield(ctx, &types.MsgReclaimTrustDepositYield{Creator: testAddr})
+	require.EqualError(t, err, resp.Reason)
+
+	// Once repaid, the yield matches the reclaim
+	td, err := k.TrustDeposit.Get(ctx, testAddr)
+	require.NoError(t, err)
+	td.RepaidDeposit = 100
+	require.NoError(t, k.TrustDeposit.Set(ctx, testAddr, td))
+
+	resp, err = k.GetClaimableYield(ctx, &types.QueryGetClaimableYieldRequest{Account: testAddr})
+	require.NoError(t, err)
+	require.True(t, resp.Allowed)
+	require.Equal(t, uint64(500), resp.ClaimableYield)
+	require.Equal(t, uint64(667), resp.Share)
+	require.Equal(t, params.TrustDepositShareValue, resp.ShareValue)
+
+	msgResp, err := keeper.NewMsgServerImpl(k).ReclaimTrustDepositYield(ctx, &types.MsgReclaimTrustDepositYield{Creator: testAddr})
+	require.NoError(t, err)
+	require.Equal(t, resp.ClaimableYield, msgResp.ClaimedAmount)
+	td, err = k.TrustDeposit.Get(ctx, testAddr)
+	require.NoError(t, err)
+	require.Equal(t, resp.Share, td.Share)
+}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/verana-labs/verana-blockchain/x/trustdeposit/types"
+)
+
+// computeReclaim checks that claimed can be reclaimed from td and returns the
+// amount burned, the amount paid out and the share reduction. It is shared by
+// ReclaimTrustDeposit and the SimulateReclaim query.
+func (k Keeper) computeReclaim(ctx context.Context, td types.TrustDeposit, claimed uint64) (toBurn, toTransfer, shareReduction uint64, err error) {
+	// Check if claimed amount is within claimable balance
+	if td.Claimable < claimed {
+		return 0, 0, 0, fmt.Errorf("claimed amount exceeds claimable balance")
+	}
+
+	// Get module params for calculations
+	params := k.GetParams(ctx)
+
+	// Calculate required minimum deposit using decimal math
+	requiredMinDeposit := k.ShareToAmount(td.Share, params.TrustDepositShareValue)
+
+	if td.Amount < claimed {
+		return 0, 0, 0, fmt.Errorf("amount less than claimed")
+	}
+
+	if requiredMinDeposit < (td.Amount - claimed) {
+		return 0, 0, 0, fmt.Errorf("insufficient required minimum deposit")
+	}
+
+	// The delegated deposit must be undelegated first
+	undelegated, err := k.undelegatedAmount(ctx, td)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	if undelegated < claimed {
+		return 0, 0, 0, fmt.Errorf("claimed amount exceeds undelegated trust deposit")
+	}
+
+	// Calculate burn amount and transfer amount using decimal math
+	toBurn = k.CalculateBurnAmount(claimed, params.TrustDepositReclaimBurnRate)
+	toTransfer = claimed - toBurn
+
+	// Calculate share reduction using decimal math
+	shareReduction = k.AmountToShare(claimed, params.TrustDepositShareValue)
+
+	return toBurn, toTransfer, shareReduction, nil
+}
+
+// computeClaimableYield checks that td can reclaim its yield and returns the
+// yield and the share reduction. It is shared by ReclaimTrustDepositYield and
+// the GetClaimableYield query.
+func (k Keeper) computeClaimableYield(ctx context.Context, td types.TrustDeposit) (claimableYield, shareReduction uint64, err error) {
+	// [MOD-TD-MSG-2-2-1] Check slashing condition
+	if td.SlashedDeposit > td.RepaidDeposit {
+		return 0, 0, fmt.Errorf("deposit has been slashed and not repaid")
+	}
+
+	// Get share value
+	params := k.GetParams(ctx)
+
+	// [MOD-TD-MSG-2-2-1] Calculate claimable yield
+	// claimable_yield = td.share * GlobalVariables.trust_deposit_share_value - td.deposit
+	depositValue := k.ShareToAmount(td.Share, params.TrustDepositShareValue)
+	if depositValue <= td.Amount { // td.Amount maps to spec's td.deposit
+		return 0, 0, fmt.Errorf("no claimable yield available")
+	}
+
+	claimableYield = depositValue - td.Amount
+
+	// [MOD-TD-MSG-2-3] Calculate shares to reduce
+	shareReduction = k.AmountToShare(claimableYield, params.TrustDepositShareValue)
+
+	return claimableYield, shareReduction, nil
+}
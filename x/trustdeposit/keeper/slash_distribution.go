@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/verana-labs/verana-blockchain/x/trustdeposit/types"
+)
+
+// distributeSlashedAmount sends a slashed amount, held by the trust deposit
+// module account, to the destinations of a slash distribution and tracks each
+// transfer. The amount is burned if the distribution is empty.
+func (k Keeper) distributeSlashedAmount(ctx sdk.Context, account string, amount uint64, shares []types.SlashShare) error {
+	if len(shares) == 0 {
+		shares = []types.SlashShare{{
+			Destination: types.SlashDestination_SLASH_DESTINATION_BURN,
+			Weight:      math.LegacyOneDec(),
+		}}
+	}
+
+	if err := types.ValidateSlashShares(shares); err != nil {
+		return fmt.Errorf("invalid slash distribution: %w", err)
+	}
+
+	now := ctx.BlockTime()
+	amounts := types.SplitSlashedAmount(amount, shares)
+	for i, share := range shares {
+		if amounts[i] == 0 {
+			continue
+		}
+		coins := sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, int64(amounts[i])))
+
+		switch share.Destination {
+		case types.SlashDestination_SLASH_DESTINATION_BURN:
+			if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+				return fmt.Errorf("failed to burn coins from trust deposit module: %w", err)
+			}
+		case types.SlashDestination_SLASH_DESTINATION_COMMUNITY_POOL:
+			if k.distrKeeper == nil {
+				return fmt.Errorf("community pool is not available")
+			}
+			if err := k.distrKeeper.FundCommunityPool(ctx, coins, authtypes.NewModuleAddress(types.ModuleName)); err != nil {
+				return fmt.Errorf("failed to fund community pool: %w", err)
+			}
+		case types.SlashDestination_SLASH_DESTINATION_BENEFICIARY:
+			beneficiary, err := sdk.AccAddressFromBech32(share.Beneficiary)
+			if err != nil {
+				return fmt.Errorf("invalid beneficiary address: %w", err)
+			}
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, beneficiary, coins); err != nil {
+				return fmt.Errorf("failed to send coins to beneficiary: %w", err)
+			}
+		}
+
+		id, err := k.getNextSlashDistributionID(ctx)
+		if err != nil {
+			return err
+		}
+		distribution := types.SlashDistribution{
+			Id:          id,
+			Account:     account,
+			Destination: share.Destination,
+			Recipient:   share.Beneficiary,
+			Amount:      amounts[i],
+			Created:     &now,
+		}
+		if err := k.SlashDistribution.Set(ctx, id, distribution); err != nil {
+			return fmt.Errorf("failed to save slash distribution: %w", err)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDistributeSlashedTrustDeposit,
+				sdk.NewAttribute(types.AttributeKeyAccount, account),
+				sdk.NewAttribute(types.AttributeKeyDestination, share.Destination.String()),
+				sdk.NewAttribute(types.AttributeKeyRecipient, share.Beneficiary),
+				sdk.NewAttribute(types.AttributeKeyAmount, strconv.FormatUint(amounts[i], 10)),
+			),
+		)
+	}
+
+	return nil
+}
+
+// getNextSlashDistributionID gets the next available slash distribution ID
+func (k Keeper) getNextSlashDistributionID(ctx sdk.Context) (uint64, error) {
+	id, err := k.SlashDistributionCounter.Get(ctx)
+	if err != nil {
+		id = 0
+	}
+
+	nextID := id + 1
+	if err := k.SlashDistributionCounter.Set(ctx, nextID); err != nil {
+		return 0, fmt.Errorf("failed to set slash distribution counter: %w", err)
+	}
+
+	return nextID, nil
+}
@@ -42,9 +42,13 @@ func (k Keeper) validateSlashTrustDepositProposal(ctx sdk.Context, p *types.Slas
 		return types.ErrTrustDepositNotFound.Wrapf("account: %s", p.Account)
 	}
 
-	// Check if deposit is sufficient
-	if math.NewIntFromUint64(td.Amount).LT(p.Amount) {
-		return types.ErrInsufficientTrustDeposit.Wrapf("deposit: %d, required: %s", td.Amount, p.Amount.String())
+	// Check if deposit is sufficient, unbonding amounts included
+	slashable, err := k.slashableAmount(ctx, td)
+	if err != nil {
+		return err
+	}
+	if math.NewIntFromUint64(slashable).LT(p.Amount) {
+		return types.ErrInsufficientTrustDeposit.Wrapf("deposit: %d, required: %s", slashable, p.Amount.String())
 	}
 
 	return nil
@@ -62,33 +66,32 @@ func (k Keeper) executeSlashTrustDepositProposal(ctx sdk.Context, p *types.Slash
 		return types.ErrTrustDepositNotFound.Wrapf("account: %s", p.Account)
 	}
 
-	// Check if deposit is sufficient
-	if math.NewIntFromUint64(td.Amount).LT(p.Amount) {
-		return types.ErrInsufficientTrustDeposit.Wrapf("deposit: %d, required: %s", td.Amount, p.Amount.String())
+	// Check if deposit is sufficient, unbonding amounts included
+	slashable, err := k.slashableAmount(ctx, td)
+	if err != nil {
+		return err
+	}
+	if math.NewIntFromUint64(slashable).LT(p.Amount) {
+		return types.ErrInsufficientTrustDeposit.Wrapf("deposit: %d, required: %s", slashable, p.Amount.String())
 	}
 
 	// [MOD-TD-MSG-5-3] Execute the slash
 	now := ctx.BlockTime()
 
-	// Get global variables for share calculation
-	params := k.GetParams(ctx)
-	shareValue := params.TrustDepositShareValue
-
-	// Calculate share reduction
-	shareReduction := math.LegacyNewDecFromInt(p.Amount).Quo(shareValue)
+	// Take the amount from the deposit, then from the unbondings
+	if err := k.takeSlashedAmount(ctx, &td, p.Amount.Uint64()); err != nil {
+		return err
+	}
 
 	// Update TrustDeposit entry
-	td.Amount = td.Amount - p.Amount.Uint64()
-	td.Share = td.Share - uint64(shareReduction.TruncateInt64())
 	td.SlashedDeposit = td.SlashedDeposit + p.Amount.Uint64()
 	td.LastSlashed = &now
 	td.LastRepaidBy = ""
 	td.SlashCount++
 
-	// Burn the slashed amount
-	burnCoins := sdk.NewCoins(sdk.NewCoin(types.BondDenom, p.Amount))
-	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnCoins); err != nil {
-		return fmt.Errorf("failed to burn coins: %w", err)
+	// Burn or distribute the slashed amount
+	if err := k.distributeSlashedAmount(ctx, p.Account, p.Amount.Uint64(), p.Distribution); err != nil {
+		return err
 	}
 
 	// Save the updated TrustDeposit entry
@@ -121,33 +124,32 @@ func (k Keeper) SlashTrustDeposit(ctx sdk.Context, account string, amount math.I
 		return types.ErrTrustDepositNotFound.Wrapf("account: %s", account)
 	}
 
-	// Check if deposit is sufficient
-	if math.NewIntFromUint64(td.Amount).LT(amount) {
-		return types.ErrInsufficientTrustDeposit.Wrapf("deposit: %d, required: %s", td.Amount, amount.String())
+	// Check if deposit is sufficient, unbonding amounts included
+	slashable, err := k.slashableAmount(ctx, td)
+	if err != nil {
+		return err
+	}
+	if math.NewIntFromUint64(slashable).LT(amount) {
+		return types.ErrInsufficientTrustDeposit.Wrapf("deposit: %d, required: %s", slashable, amount.String())
 	}
 
 	// [MOD-TD-MSG-5-3] Execute the slash
 	now := ctx.BlockTime()
 
-	// Get global variables for share calculation
-	params := k.GetParams(ctx)
-	shareValue := params.TrustDepositShareValue
-
-	// Calculate share reduction
-	shareReduction := math.LegacyNewDecFromInt(amount).Quo(shareValue)
+	// Take the amount from the deposit, then from the unbondings
+	if err := k.takeSlashedAmount(ctx, &td, amount.Uint64()); err != nil {
+		return err
+	}
 
 	// Update TrustDeposit entry
-	td.Amount = td.Amount - amount.Uint64()
-	td.Share = td.Share - uint64(shareReduction.TruncateInt64())
 	td.SlashedDeposit = td.SlashedDeposit + amount.Uint64()
 	td.LastSlashed = &now
 	td.LastRepaidBy = ""
 	td.SlashCount++
 
 	// Burn the slashed amount
-	burnCoins := sdk.NewCoins(sdk.NewCoin(types.BondDenom, amount))
-	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnCoins); err != nil {
-		return fmt.Errorf("failed to burn coins: %w", err)
+	if err := k.distributeSlashedAmount(ctx, account, amount.Uint64(), nil); err != nil {
+		return err
 	}
 
 	// Save the updated TrustDeposit entry
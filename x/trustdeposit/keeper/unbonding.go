@@ -0,0 +1,296 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/verana-labs/verana-blockchain/x/trustdeposit/types"
+)
+
+const (
+	// unbondingCompletionBatchSize bounds the number of mature unbondings paid out per block.
+	unbondingCompletionBatchSize = 200
+	// unbondingRetryDelay is the delay after which an unbonding that failed to complete is retried.
+	unbondingRetryDelay = time.Hour
+)
+
+// startUnbonding locks an amount reclaimed by account until the end of the
+// unbonding period. The coins stay in the trust deposit module account and
+// can still be slashed until they are paid out.
+func (k Keeper) startUnbonding(ctx sdk.Context, account string, amount uint64) (types.TrustDepositUnbonding, error) {
+	now := ctx.BlockTime()
+	params := k.GetParams(ctx)
+	completionTime := now.AddDate(0, 0, int(params.TrustDepositUnbondingPeriodDays))
+
+	id, err := k.getNextUnbondingID(ctx)
+	if err != nil {
+		return types.TrustDepositUnbonding{}, err
+	}
+
+	unbonding := types.TrustDepositUnbonding{
+		Id:             id,
+		Account:        account,
+		InitialAmount:  amount,
+		Amount:         amount,
+		Created:        &now,
+		CompletionTime: &completionTime,
+	}
+	if err := k.Unbonding.Set(ctx, id, unbonding); err != nil {
+		return types.TrustDepositUnbonding{}, fmt.Errorf("failed to save unbonding: %w", err)
+	}
+	if err := k.UnbondingByAccount.Set(ctx, collections.Join(account, id)); err != nil {
+		return types.TrustDepositUnbonding{}, fmt.Errorf("failed to index unbonding: %w", err)
+	}
+	if err := k.UnbondingQueue.Set(ctx, collections.Join(completionTime, id)); err != nil {
+		return types.TrustDepositUnbonding{}, fmt.Errorf("failed to queue unbonding: %w", err)
+	}
+
+	return unbonding, nil
+}
+
+// unbondingAmount returns the amount account has waiting for the end of the
+// unbonding period.
+func (k Keeper) unbondingAmount(ctx sdk.Context, account string) (uint64, error) {
+	total := uint64(0)
+	err := k.walkAccountUnbondings(ctx, account, func(unbonding types.TrustDepositUnbonding) (bool, error) {
+		total += unbonding.Amount
+		return false, nil
+	})
+	return total, err
+}
+
+// walkAccountUnbondings calls fn on the unbondings of account, oldest first,
+// until it returns true.
+func (k Keeper) walkAccountUnbondings(ctx sdk.Context, account string, fn func(unbonding types.TrustDepositUnbonding) (bool, error)) error {
+	rng := collections.NewPrefixedPairRange[string, uint64](account)
+	return k.UnbondingByAccount.Walk(ctx, rng, func(key collections.Pair[string, uint64]) (bool, error) {
+		unbonding, err := k.Unbonding.Get(ctx, key.K2())
+		if err != nil {
+			return true, fmt.Errorf("failed to get unbonding %d: %w", key.K2(), err)
+		}
+		return fn(unbonding)
+	})
+}
+
+// slashableAmount returns the amount of account that can be slashed: its
+// trust deposit and its unbondings, less the validator slashes of its
+// delegation not yet deducted.
+func (k Keeper) slashableAmount(ctx sdk.Context, td types.TrustDeposit) (uint64, error) {
+	unbonding, err := k.unbondingAmount(ctx, td.Account)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get unbonding amount: %w", err)
+	}
+	unslashable, err := k.unslashableDelegatedAmount(ctx, td)
+	if err != nil {
+		return 0, err
+	}
+	if unslashable > td.Amount {
+		return unbonding, nil
+	}
+	return td.Amount - unslashable + unbonding, nil
+}
+
+// takeSlashedAmount removes a slashed amount from the trust deposit td, then
+// from the unbondings of its account, oldest first, when the deposit does not
+// cover it. When the undelegated deposit does not cover it, the bonded deposit
+// is unbonded, then the deposit being undelegated is taken. td is updated but
+// not saved. The coins stay in the trust deposit module
+// account.
+func (k Keeper) takeSlashedAmount(ctx sdk.Context, td *types.TrustDeposit, amount uint64) error {
+	available := td.Amount
+	del, found, err := k.getDelegation(ctx, td.Account)
+	if err != nil {
+		return err
+	}
+	if found {
+		if err := k.reconcileDelegation(ctx, td, &del); err != nil {
+			return err
+		}
+
+		if undelegated := td.Amount - min(stakedAmount(del), td.Amount); amount > undelegated && del.Amount > 0 {
+			if err := k.withdrawValidatorRewards(ctx, del.ValidatorAddress); err != nil {
+				return err
+			}
+			if err := k.payDelegationRewards(ctx, td, &del); err != nil {
+				return err
+			}
+			if err := k.liquidateDelegation(ctx, td, &del, amount-undelegated); err != nil {
+				return err
+			}
+		}
+		if undelegated := td.Amount - min(stakedAmount(del), td.Amount); amount > undelegated {
+			del.UnbondingSlashed += min(amount-undelegated, del.UnbondingAmount-del.UnbondingSlashed)
+		}
+		if err := k.setDelegation(ctx, del); err != nil {
+			return fmt.Errorf("failed to save delegation: %w", err)
+		}
+
+		available = td.Amount - min(stakedAmount(del), td.Amount)
+	}
+
+	fromDeposit := min(amount, available)
+	if fromDeposit > 0 {
+		params := k.GetParams(ctx)
+		if params.TrustDepositShareValue.IsZero() {
+			return fmt.Errorf("trust deposit share value cannot be zero")
+		}
+
+		shareReduction := k.AmountToShare(fromDeposit, params.TrustDepositShareValue)
+		if shareReduction > td.Share {
+			return fmt.Errorf("share reduction exceeds available shares: %d > %d", shareReduction, td.Share)
+		}
+
+		td.Amount -= fromDeposit
+		td.Share -= shareReduction
+		if td.Claimable > td.Amount {
+			td.Claimable = td.Amount
+		}
+	}
+
+	remaining := amount - fromDeposit
+	if remaining == 0 {
+		return nil
+	}
+
+	var slashed []types.TrustDepositUnbonding
+	err = k.walkAccountUnbondings(ctx, td.Account, func(unbonding types.TrustDepositUnbonding) (bool, error) {
+		if unbonding.Amount == 0 {
+			return false, nil
+		}
+		take := min(remaining, unbonding.Amount)
+		unbonding.Amount -= take
+		remaining -= take
+		slashed = append(slashed, unbonding)
+		return remaining == 0, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if remaining > 0 {
+		return fmt.Errorf("amount exceeds slashable deposit by %d", remaining)
+	}
+
+	for _, unbonding := range slashed {
+		if err := k.Unbonding.Set(ctx, unbonding.Id, unbonding); err != nil {
+			return fmt.Errorf("failed to update unbonding: %w", err)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSlashUnbonding,
+				sdk.NewAttribute(types.AttributeKeyUnbondingID, strconv.FormatUint(unbonding.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyAccount, unbonding.Account),
+				sdk.NewAttribute(types.AttributeKeyAmount, strconv.FormatUint(unbonding.Amount, 10)),
+			),
+		)
+	}
+
+	return nil
+}
+
+// CompleteMatureUnbondings pays out the unbondings whose completion time has
+// passed, at most unbondingCompletionBatchSize per call. An unbonding that
+// fails to complete is logged and queued again unbondingRetryDelay later. It
+// is called from the module EndBlocker.
+func (k Keeper) CompleteMatureUnbondings(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime()
+
+	rng := new(collections.Range[collections.Pair[time.Time, uint64]]).
+		EndInclusive(collections.Join(now, uint64(math.MaxUint64)))
+	iter, err := k.UnbondingQueue.Iterate(sdkCtx, rng)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	var mature []collections.Pair[time.Time, uint64]
+	for ; iter.Valid() && len(mature) < unbondingCompletionBatchSize; iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return err
+		}
+		mature = append(mature, key)
+	}
+
+	for _, key := range mature {
+		if err := k.UnbondingQueue.Remove(sdkCtx, key); err != nil {
+			return err
+		}
+
+		unbonding, err := k.Unbonding.Get(sdkCtx, key.K2())
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		cacheCtx, write := sdkCtx.CacheContext()
+		if err := k.completeUnbonding(cacheCtx, unbonding); err != nil {
+			k.Logger().Error("failed to complete unbonding, retrying later", "unbonding_id", unbonding.Id, "account", unbonding.Account, "error", err)
+			if err := k.UnbondingQueue.Set(sdkCtx, collections.Join(now.Add(unbondingRetryDelay), unbonding.Id)); err != nil {
+				return err
+			}
+			continue
+		}
+		write()
+	}
+
+	return nil
+}
+
+func (k Keeper) completeUnbonding(ctx sdk.Context, unbonding types.TrustDepositUnbonding) error {
+	if unbonding.Amount > 0 {
+		addr, err := sdk.AccAddressFromBech32(unbonding.Account)
+		if err != nil {
+			return fmt.Errorf("invalid account address: %w", err)
+		}
+
+		coins := sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, int64(unbonding.Amount)))
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
+			return fmt.Errorf("failed to transfer coins: %w", err)
+		}
+	}
+
+	if err := k.Unbonding.Remove(ctx, unbonding.Id); err != nil {
+		return fmt.Errorf("failed to remove unbonding: %w", err)
+	}
+	if err := k.UnbondingByAccount.Remove(ctx, collections.Join(unbonding.Account, unbonding.Id)); err != nil {
+		return fmt.Errorf("failed to remove unbonding index: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCompleteUnbonding,
+			sdk.NewAttribute(types.AttributeKeyUnbondingID, strconv.FormatUint(unbonding.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyAccount, unbonding.Account),
+			sdk.NewAttribute(types.AttributeKeyAmount, strconv.FormatUint(unbonding.Amount, 10)),
+		),
+	)
+
+	return nil
+}
+
+// getNextUnbondingID gets the next available unbonding ID
+func (k Keeper) getNextUnbondingID(ctx sdk.Context) (uint64, error) {
+	id, err := k.UnbondingCounter.Get(ctx)
+	if err != nil {
+		id = 0
+	}
+
+	nextID := id + 1
+	if err := k.UnbondingCounter.Set(ctx, nextID); err != nil {
+		return 0, fmt.Errorf("failed to set unbonding counter: %w", err)
+	}
+
+	return nextID, nil
+}
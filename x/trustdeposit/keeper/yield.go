@@ -0,0 +1,207 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/verana-labs/verana-blockchain/x/trustdeposit/types"
+)
+
+// MaxYieldRecords is the number of yield records kept in state. Older records
+// are pruned when a new one is added.
+const MaxYieldRecords = 1000
+
+const secondsPerYear = int64(365 * 24 * 60 * 60)
+
+// BeginBlocker distributes the trust deposit yield at the end of each yield
+// epoch, and starts then a sync of the delegated trust deposits that pays out
+// their staking rewards over the next blocks. A step that fails is logged and
+// reported with an event, and runs again later.
+func (k Keeper) BeginBlocker(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	params := k.GetParams(sdkCtx)
+	epochEnd := params.YieldEpochBlocks > 0 && sdkCtx.BlockHeight()%int64(params.YieldEpochBlocks) == 0
+	if epochEnd {
+		k.runYieldEpochStep(sdkCtx, "start_delegation_sync", k.startDelegationSync)
+	}
+	k.runYieldEpochStep(sdkCtx, "sync_delegations", k.SyncDelegations)
+	if epochEnd {
+		k.runYieldEpochStep(sdkCtx, "distribute_yield", k.DistributeYield)
+	}
+	return nil
+}
+
+// runYieldEpochStep runs step in a cache context, committed only when it
+// succeeds.
+func (k Keeper) runYieldEpochStep(ctx sdk.Context, name string, step func(ctx sdk.Context) error) {
+	cacheCtx, write := ctx.CacheContext()
+	if err := step(cacheCtx); err != nil {
+		k.Logger().Error("yield epoch step failed", "step", name, "error", err)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeYieldEpochStepFailed,
+				sdk.NewAttribute(types.AttributeKeyStep, name),
+				sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+			),
+		)
+		return
+	}
+	write()
+}
+
+// DistributeYield moves the yield of the epoch to the trust deposit module
+// account and increases the share value so that every trust deposit holder
+// earns it pro rata of its shares. The yield is made of a share of the fee
+// collector balance and a share of the yield reserve.
+func (k Keeper) DistributeYield(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+
+	totalShares := uint64(0)
+	err := k.TrustDeposit.Walk(ctx, nil, func(account string, td types.TrustDeposit) (bool, error) {
+		totalShares += td.Share
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to sum trust deposit shares: %w", err)
+	}
+
+	yield := uint64(0)
+	if totalShares > 0 {
+		// fee collector part
+		if params.YieldFeeCollectorShare.IsPositive() {
+			feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+			balance := k.bankKeeper.GetBalance(ctx, feeCollector, types.BondDenom)
+			fromFees := math.LegacyNewDecFromInt(balance.Amount).Mul(params.YieldFeeCollectorShare).TruncateInt().Uint64()
+			if fromFees > 0 {
+				coins := sdk.NewCoins(sdk.NewInt64Coin(types.BondDenom, int64(fromFees)))
+				if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, coins); err != nil {
+					return fmt.Errorf("failed to transfer fees to trust deposit: %w", err)
+				}
+				yield += fromFees
+			}
+		}
+
+		// reserve part, already held by the module account
+		if params.YieldReserveReleaseRate.IsPositive() {
+			reserve, err := k.GetYieldReserveAmount(ctx)
+			if err != nil {
+				return err
+			}
+			released := math.LegacyNewDec(int64(reserve)).Mul(params.YieldReserveReleaseRate).TruncateInt().Uint64()
+			if released > 0 {
+				if err := k.YieldReserve.Set(ctx, reserve-released); err != nil {
+					return fmt.Errorf("failed to update yield reserve: %w", err)
+				}
+				yield += released
+			}
+		}
+	}
+
+	oldShareValue := params.TrustDepositShareValue
+	newShareValue := oldShareValue
+	if yield > 0 {
+		newShareValue = oldShareValue.Add(math.LegacyNewDec(int64(yield)).Quo(math.LegacyNewDec(int64(totalShares))))
+		params.TrustDepositShareValue = newShareValue
+		if err := k.SetParams(ctx, params); err != nil {
+			return fmt.Errorf("failed to update share value: %w", err)
+		}
+	}
+
+	now := ctx.BlockTime()
+	apy := math.LegacyZeroDec()
+	last, found, err := k.lastYieldRecord(ctx)
+	if err != nil {
+		return err
+	}
+	if found && last.Time != nil && oldShareValue.IsPositive() {
+		elapsed := int64(now.Sub(*last.Time).Seconds())
+		if elapsed > 0 {
+			apy = newShareValue.Quo(oldShareValue).Sub(math.LegacyOneDec()).
+				MulInt64(secondsPerYear).QuoInt64(elapsed)
+		}
+	}
+
+	id, err := k.getNextYieldRecordID(ctx)
+	if err != nil {
+		return err
+	}
+	record := types.YieldRecord{
+		Id:          id,
+		Height:      ctx.BlockHeight(),
+		Time:        &now,
+		Amount:      yield,
+		TotalShares: totalShares,
+		ShareValue:  newShareValue,
+		Apy:         apy,
+	}
+	if err := k.YieldRecord.Set(ctx, id, record); err != nil {
+		return fmt.Errorf("failed to save yield record: %w", err)
+	}
+	if id > MaxYieldRecords {
+		if err := k.YieldRecord.Remove(ctx, id-MaxYieldRecords); err != nil {
+			return fmt.Errorf("failed to prune yield record: %w", err)
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDistributeYield,
+			sdk.NewAttribute(types.AttributeKeyYieldRecordID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, strconv.FormatUint(yield, 10)),
+			sdk.NewAttribute(types.AttributeKeyShareValue, newShareValue.String()),
+			sdk.NewAttribute(types.AttributeKeyAPY, apy.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetYieldReserveAmount returns the amount held in the yield reserve.
+func (k Keeper) GetYieldReserveAmount(ctx context.Context) (uint64, error) {
+	reserve, err := k.YieldReserve.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to get yield reserve: %w", err)
+	}
+	return reserve, nil
+}
+
+func (k Keeper) lastYieldRecord(ctx sdk.Context) (types.YieldRecord, bool, error) {
+	iter, err := k.YieldRecord.Iterate(ctx, new(collections.Range[uint64]).Descending())
+	if err != nil {
+		return types.YieldRecord{}, false, err
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return types.YieldRecord{}, false, nil
+	}
+	record, err := iter.Value()
+	if err != nil {
+		return types.YieldRecord{}, false, err
+	}
+	return record, true, nil
+}
+
+// getNextYieldRecordID gets the next available yield record ID
+func (k Keeper) getNextYieldRecordID(ctx sdk.Context) (uint64, error) {
+	id, err := k.YieldRecordCounter.Get(ctx)
+	if err != nil {
+		id = 0
+	}
+
+	nextID := id + 1
+	if err := k.YieldRecordCounter.Set(ctx, nextID); err != nil {
+		return 0, fmt.Errorf("failed to set yield record counter: %w", err)
+	}
+
+	return nextID, nil
+}
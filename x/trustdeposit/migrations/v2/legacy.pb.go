@@ -0,0 +1,516 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: verana/td/migrations/v2/legacy.proto
+
+package v2
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params are the v1 trust deposit params.
+type Params struct {
+	TrustDepositReclaimBurnRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=trust_deposit_reclaim_burn_rate,json=trustDepositReclaimBurnRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"trust_deposit_reclaim_burn_rate"`
+	TrustDepositShareValue      cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=trust_deposit_share_value,json=trustDepositShareValue,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"trust_deposit_share_value"`
+	TrustDepositRate            cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=trust_deposit_rate,json=trustDepositRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"trust_deposit_rate"`
+	WalletUserAgentRewardRate   cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=wallet_user_agent_reward_rate,json=walletUserAgentRewardRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"wallet_user_agent_reward_rate"`
+	UserAgentRewardRate         cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=user_agent_reward_rate,json=userAgentRewardRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"user_agent_reward_rate"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7ea6a13a6781bbf, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Params)(nil), "verana.td.migrations.v2.Params")
+}
+
+func init() {
+	proto.RegisterFile("verana/td/migrations/v2/legacy.proto", fileDescriptor_d7ea6a13a6781bbf)
+}
+
+var fileDescriptor_d7ea6a13a6781bbf = []byte{
+	// 356 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0xd2, 0xc1, 0x4a, 0xf3, 0x40,
+	0x10, 0x07, 0xf0, 0xe4, 0xeb, 0x67, 0xc1, 0x9c, 0x24, 0x4a, 0x6d, 0x2d, 0xa6, 0xa2, 0x1e, 0xbc,
+	0x98, 0x85, 0xfa, 0x04, 0x96, 0x7a, 0xf3, 0xa0, 0x11, 0x45, 0x3c, 0xb8, 0x4c, 0x92, 0x21, 0x59,
+	0x9a, 0x64, 0xcb, 0xee, 0x26, 0xd5, 0xb7, 0xf0, 0xb1, 0x7a, 0xec, 0x51, 0x3c, 0x14, 0x69, 0x0f,
+	0xbe, 0x86, 0x24, 0xab, 0x68, 0x15, 0x21, 0xb7, 0x3d, 0x0c, 0xbf, 0xff, 0x7f, 0x87, 0xb1, 0x0e,
+	0x0b, 0x14, 0x90, 0x01, 0x51, 0x21, 0x49, 0x59, 0x24, 0x40, 0x31, 0x9e, 0x49, 0x52, 0xf4, 0x49,
+	0x82, 0x11, 0x04, 0x8f, 0xee, 0x58, 0x70, 0xc5, 0xed, 0x6d, 0x3d, 0xe5, 0xaa, 0xd0, 0xfd, 0x9a,
+	0x72, 0x8b, 0xfe, 0xce, 0x56, 0xc4, 0x23, 0x5e, 0xcd, 0x90, 0xf2, 0xa5, 0xc7, 0xf7, 0xdf, 0x1a,
+	0x56, 0xf3, 0x02, 0x04, 0xa4, 0xd2, 0x66, 0x56, 0x4f, 0x89, 0x5c, 0x2a, 0x1a, 0xe2, 0x98, 0x4b,
+	0xa6, 0xa8, 0xc0, 0x20, 0x01, 0x96, 0x52, 0x3f, 0x17, 0x19, 0x15, 0xa0, 0xb0, 0x6d, 0xee, 0x99,
+	0x47, 0xeb, 0x83, 0x83, 0xe9, 0xbc, 0x67, 0xbc, 0xcc, 0x7b, 0xdd, 0x80, 0xcb, 0x94, 0x4b, 0x19,
+	0x8e, 0x5c, 0xc6, 0x49, 0x0a, 0x2a, 0x76, 0xcf, 0xab, 0x2a, 0x43, 0x0c, 0xbc, 0x6e, 0x65, 0x0d,
+	0x35, 0xe5, 0x69, 0x69, 0x90, 0x8b, 0xcc, 0x03, 0x85, 0xf6, 0xbd, 0xd5, 0x59, 0x8d, 0x92, 0x31,
+	0x08, 0xa4, 0x05, 0x24, 0x39, 0xb6, 0xff, 0xd5, 0x0f, 0x69, 0x7d, 0x0f, 0xb9, 0x2a, 0x8d, 0x9b,
+	0x92, 0xb0, 0x2f, 0x2d, 0xfb, 0xc7, 0x57, 0xca, 0xf6, 0x8d, 0xfa, 0xf0, 0xc6, 0x4a, 0xfb, 0xb2,
+	0x32, 0x5a, 0xbb, 0x13, 0x48, 0x12, 0x54, 0x34, 0x97, 0x28, 0x28, 0x44, 0x98, 0x95, 0x1b, 0x9a,
+	0x80, 0x08, 0xb5, 0xfe, 0xbf, 0xbe, 0xde, 0xd1, 0xd2, 0xb5, 0x44, 0x71, 0x5a, 0x3a, 0x5e, 0xc5,
+	0x54, 0x31, 0xb7, 0x56, 0xeb, 0x0f, 0x7f, 0xad, 0xbe, 0xbf, 0x99, 0xff, 0x96, 0x07, 0x74, 0xba,
+	0x70, 0xcc, 0xd9, 0xc2, 0x31, 0x5f, 0x17, 0x8e, 0xf9, 0xb4, 0x74, 0x8c, 0xd9, 0xd2, 0x31, 0x9e,
+	0x97, 0x8e, 0x71, 0x77, 0x16, 0x31, 0x15, 0xe7, 0xbe, 0x1b, 0xf0, 0x94, 0xe8, 0xeb, 0x39, 0x4e,
+	0xc0, 0x97, 0x9f, 0x6f, 0x3f, 0xe1, 0xc1, 0x28, 0x88, 0x81, 0x65, 0xe4, 0x81, 0x54, 0x7b, 0xf9,
+	0xd8, 0xea, 0xea, 0x19, 0xfa, 0xcd, 0xea, 0xa2, 0x4e, 0xde, 0x07, 0x00, 0xb3, 0xd5, 0x8b, 0x85,
+	0xa8, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.UserAgentRewardRate.Size()
+		i -= size
+		if _, err := m.UserAgentRewardRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLegacy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.WalletUserAgentRewardRate.Size()
+		i -= size
+		if _, err := m.WalletUserAgentRewardRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLegacy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TrustDepositRate.Size()
+		i -= size
+		if _, err := m.TrustDepositRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLegacy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TrustDepositShareValue.Size()
+		i -= size
+		if _, err := m.TrustDepositShareValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLegacy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TrustDepositReclaimBurnRate.Size()
+		i -= size
+		if _, err := m.TrustDepositReclaimBurnRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLegacy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLegacy(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLegacy(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TrustDepositReclaimBurnRate.Size()
+	n += 1 + l + sovLegacy(uint64(l))
+	l = m.TrustDepositShareValue.Size()
+	n += 1 + l + sovLegacy(uint64(l))
+	l = m.TrustDepositRate.Size()
+	n += 1 + l + sovLegacy(uint64(l))
+	l = m.WalletUserAgentRewardRate.Size()
+	n += 1 + l + sovLegacy(uint64(l))
+	l = m.UserAgentRewardRate.Size()
+	n += 1 + l + sovLegacy(uint64(l))
+	return n
+}
+
+func sovLegacy(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLegacy(x uint64) (n int) {
+	return sovLegacy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLegacy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustDepositReclaimBurnRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TrustDepositReclaimBurnRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustDepositShareValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TrustDepositShareValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustDepositRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TrustDepositRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletUserAgentRewardRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WalletUserAgentRewardRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserAgentRewardRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UserAgentRewardRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLegacy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLegacy(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLegacy
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLegacy
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLegacy
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLegacy
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLegacy        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLegacy          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLegacy = fmt.Errorf("proto: unexpected end of group")
+)
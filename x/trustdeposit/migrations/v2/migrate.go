@@ -0,0 +1,49 @@
+package v2
+
+import (
+	"context"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"github.com/verana-labs/verana-blockchain/x/trustdeposit/types"
+)
+
+// MigrateStore performs in-place store migrations from ConsensusVersion 1 to 2.
+// The v1 params are decoded with the frozen v1 Params of this package:
+//
+//   - the trust deposit unbonding period param, introduced with the unbonding
+//     of reclaimed trust deposits, is set to its default.
+//   - the yield params, introduced with the yield distribution, are set to
+//     their defaults.
+func MigrateStore(ctx context.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	kvStore := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+	var legacyParams Params
+	if bz := kvStore.Get(types.ParamsKey); bz != nil {
+		if err := cdc.Unmarshal(bz, &legacyParams); err != nil {
+			return err
+		}
+	}
+
+	params := types.Params{
+		TrustDepositReclaimBurnRate:     legacyParams.TrustDepositReclaimBurnRate,
+		TrustDepositShareValue:          legacyParams.TrustDepositShareValue,
+		TrustDepositRate:                legacyParams.TrustDepositRate,
+		WalletUserAgentRewardRate:       legacyParams.WalletUserAgentRewardRate,
+		UserAgentRewardRate:             legacyParams.UserAgentRewardRate,
+		TrustDepositUnbondingPeriodDays: types.DefaultTrustDepositUnbondingPeriodDays,
+		YieldEpochBlocks:                types.DefaultYieldEpochBlocks,
+		YieldFeeCollectorShare:          math.LegacyMustNewDecFromStr(types.DefaultYieldFeeCollectorShare),
+		YieldReserveReleaseRate:         math.LegacyMustNewDecFromStr(types.DefaultYieldReserveReleaseRate),
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	kvStore.Set(types.ParamsKey, bz)
+
+	return nil
+}
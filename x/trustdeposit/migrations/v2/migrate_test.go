@@ -0,0 +1,49 @@
+package v2_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/stretchr/testify/require"
+
+	v2 "github.com/verana-labs/verana-blockchain/x/trustdeposit/migrations/v2"
+	"github.com/verana-labs/verana-blockchain/x/trustdeposit/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	storeService := runtime.NewKVStoreService(storeKey)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	// v1 params have no unbonding period and no yield params
+	v1Params := v2.Params{
+		TrustDepositReclaimBurnRate: math.LegacyMustNewDecFromStr("0.5"),
+		TrustDepositShareValue:      math.LegacyOneDec(),
+		TrustDepositRate:            math.LegacyMustNewDecFromStr("0.2"),
+		WalletUserAgentRewardRate:   math.LegacyMustNewDecFromStr("0.3"),
+		UserAgentRewardRate:         math.LegacyMustNewDecFromStr("0.2"),
+	}
+	ctx.KVStore(storeKey).Set(types.ParamsKey, cdc.MustMarshal(&v1Params))
+
+	require.NoError(t, v2.MigrateStore(ctx, storeService, cdc))
+
+	// the unbonding period and yield params are set, other params are kept
+	var params types.Params
+	require.NoError(t, cdc.Unmarshal(ctx.KVStore(storeKey).Get(types.ParamsKey), &params))
+	require.Equal(t, types.DefaultTrustDepositUnbondingPeriodDays, params.TrustDepositUnbondingPeriodDays)
+	require.Equal(t, types.DefaultYieldEpochBlocks, params.YieldEpochBlocks)
+	require.True(t, params.YieldFeeCollectorShare.IsZero())
+	require.True(t, params.YieldReserveReleaseRate.IsZero())
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.5"), params.TrustDepositReclaimBurnRate)
+	require.Equal(t, math.LegacyOneDec(), params.TrustDepositShareValue)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.2"), params.TrustDepositRate)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.3"), params.WalletUserAgentRewardRate)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.2"), params.UserAgentRewardRate)
+	require.NoError(t, params.Validate())
+}
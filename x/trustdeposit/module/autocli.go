@@ -39,6 +39,88 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						},
 					},
 				},
+				{
+					RpcMethod: "ListSlashDistributions",
+					Use:       "list-slash-distributions",
+					Short:     "List where slashed trust deposits went",
+					Long:      "List the burns, community pool funding and beneficiary payments of slashed trust deposits, optionally filtered by slashed account and recipient",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"account": {
+							Name:         "account",
+							DefaultValue: "",
+							Usage:        "Filter by slashed account",
+						},
+						"recipient": {
+							Name:         "recipient",
+							DefaultValue: "",
+							Usage:        "Filter by beneficiary account",
+						},
+						"response_max_size": {
+							Name:         "response-max-size",
+							DefaultValue: "64",
+							Usage:        "Maximum number of results (1-1024)",
+						},
+					},
+				},
+				{
+					RpcMethod: "ListUnbondings",
+					Use:       "list-unbondings",
+					Short:     "List reclaimed trust deposits waiting for the end of the unbonding period",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"account": {
+							Name:         "account",
+							DefaultValue: "",
+							Usage:        "Filter by account",
+						},
+						"response_max_size": {
+							Name:         "response-max-size",
+							DefaultValue: "64",
+							Usage:        "Maximum number of results (1-1024)",
+						},
+					},
+				},
+				{
+					RpcMethod: "ListYieldRecords",
+					Use:       "list-yield-records",
+					Short:     "List the yield distributions, newest first",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"response_max_size": {
+							Name:         "response-max-size",
+							DefaultValue: "64",
+							Usage:        "Maximum number of results (1-1024)",
+						},
+					},
+				},
+				{
+					RpcMethod: "GetYieldReserve",
+					Use:       "get-yield-reserve",
+					Short:     "Get the amount held in the yield reserve",
+				},
+				{
+					RpcMethod: "SimulateReclaim",
+					Use:       "simulate-reclaim [account] [amount]",
+					Short:     "Preview the burn, payout and post-state shares of a trust deposit reclaim",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "account"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod: "GetClaimableYield",
+					Use:       "get-claimable-yield [account]",
+					Short:     "Preview the yield an account can reclaim",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "account"},
+					},
+				},
+				{
+					RpcMethod: "GetTrustDepositDelegation",
+					Use:       "get-delegation [account]",
+					Short:     "Get the delegated part of the trust deposit of an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "account"},
+					},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -60,7 +142,7 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "ReclaimTrustDeposit",
 					Use:       "reclaim-deposit [amount]",
 					Short:     "Reclaim trust deposit",
-					Long:      "Reclaim a specified amount from your claimable trust deposit balance. Note that a portion will be burned according to the reclaim burn rate.",
+					Long:      "Reclaim a specified amount from your claimable trust deposit balance. Note that a portion will be burned according to the reclaim burn rate, and the rest is paid out at the end of the unbonding period, during which it can still be slashed.",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{
 							ProtoField: "claimed",
@@ -77,12 +159,40 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "amount"},
 					},
 				},
+				{
+					RpcMethod: "FundYieldReserve",
+					Use:       "fund-yield-reserve [amount]",
+					Short:     "Fund the trust deposit yield reserve",
+					Long:      "Transfer an amount to the yield reserve. A share of the reserve, set by the yield_reserve_release_rate param, is distributed to trust deposit holders at the end of each yield epoch.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod: "DelegateTrustDeposit",
+					Use:       "delegate [validator-address] [amount]",
+					Short:     "Delegate part of your trust deposit to a validator",
+					Long:      "Delegate an amount of your trust deposit to a validator. The delegated deposit can still be slashed, and its staking rewards are added to your deposit yield. It can't be reclaimed until undelegated.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod: "UndelegateTrustDeposit",
+					Use:       "undelegate",
+					Short:     "Undelegate your delegated trust deposit",
+					Long:      "Undelegate the whole delegated trust deposit. It is back in your deposit at the end of the staking unbonding period.",
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
 	}
 }
 
+// FlagDistribution is the flag setting the slash distribution of a proposal
+const FlagDistribution = "distribution"
+
 func CmdSlashTrustDepositProposal() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "slash-trust-deposit [account] [amount] [flags]",
@@ -122,6 +232,16 @@ func CmdSlashTrustDepositProposal() *cobra.Command {
 				return err
 			}
 
+			distributionStr, err := cmd.Flags().GetString(FlagDistribution)
+			if err != nil {
+				return err
+			}
+
+			distribution, err := types.ParseSlashShares(distributionStr)
+			if err != nil {
+				return err
+			}
+
 			deposit, err := sdk.ParseCoinsNormalized(depositStr)
 			if err != nil {
 				return err
@@ -130,7 +250,7 @@ func CmdSlashTrustDepositProposal() *cobra.Command {
 			from := clientCtx.GetFromAddress()
 
 			// Create the proposal content
-			content := types.NewSlashTrustDepositProposal(title, description, account, amount)
+			content := types.NewSlashTrustDepositProposal(title, description, account, amount, distribution)
 
 			// Create the governance proposal message
 			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
@@ -145,6 +265,7 @@ func CmdSlashTrustDepositProposal() *cobra.Command {
 	cmd.Flags().String(cli.FlagTitle, "", "The proposal title")
 	cmd.Flags().String(cli.FlagDescription, "", "The proposal description")
 	cmd.Flags().String(cli.FlagDeposit, "", "The proposal deposit")
+	cmd.Flags().String(FlagDistribution, "", "Comma separated destination:weight shares of the slashed amount, where destination is burn, community-pool or a beneficiary address (burned if empty)")
 	err := cmd.MarkFlagRequired(cli.FlagTitle)
 	if err != nil {
 		return nil
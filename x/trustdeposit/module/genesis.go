@@ -1,7 +1,11 @@
 package trustdeposit
 
 import (
+	"errors"
 	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/verana-labs/verana-blockchain/x/trustdeposit/keeper"
@@ -19,10 +23,16 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, td := range genState.TrustDeposits {
 		// Create trust deposit entry
 		trustDeposit := types.TrustDeposit{
-			Account:   td.Account,
-			Share:     td.Share,
-			Amount:    td.Amount,
-			Claimable: td.Claimable,
+			Account:        td.Account,
+			Share:          td.Share,
+			Amount:         td.Amount,
+			Claimable:      td.Claimable,
+			SlashedDeposit: td.SlashedDeposit,
+			RepaidDeposit:  td.RepaidDeposit,
+			LastSlashed:    td.LastSlashed,
+			LastRepaid:     td.LastRepaid,
+			SlashCount:     td.SlashCount,
+			LastRepaidBy:   td.LastRepaidBy,
 		}
 
 		// Store the trust deposit
@@ -30,6 +40,80 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			panic(fmt.Sprintf("failed to set trust deposit for account %s: %s", td.Account, err))
 		}
 	}
+
+	// Initialize slash distributions
+	for _, distribution := range genState.SlashDistributions {
+		if err := k.SlashDistribution.Set(ctx, distribution.Id, distribution); err != nil {
+			panic(fmt.Sprintf("failed to set slash distribution %d: %s", distribution.Id, err))
+		}
+	}
+
+	// The counter holds the last assigned ID, while genesis carries the next ID
+	lastSlashDistributionId := uint64(0)
+	if genState.NextSlashDistributionId > 0 {
+		lastSlashDistributionId = genState.NextSlashDistributionId - 1
+	}
+	if err := k.SlashDistributionCounter.Set(ctx, lastSlashDistributionId); err != nil {
+		panic(fmt.Sprintf("failed to set slash distribution counter: %s", err))
+	}
+
+	// Initialize unbondings, queued again by completion time
+	for _, unbonding := range genState.Unbondings {
+		if err := k.Unbonding.Set(ctx, unbonding.Id, unbonding); err != nil {
+			panic(fmt.Sprintf("failed to set unbonding %d: %s", unbonding.Id, err))
+		}
+		if err := k.UnbondingByAccount.Set(ctx, collections.Join(unbonding.Account, unbonding.Id)); err != nil {
+			panic(fmt.Errorf("failed to index unbonding: %w", err))
+		}
+		if err := k.UnbondingQueue.Set(ctx, collections.Join(*unbonding.CompletionTime, unbonding.Id)); err != nil {
+			panic(fmt.Sprintf("failed to queue unbonding %d: %s", unbonding.Id, err))
+		}
+	}
+
+	lastUnbondingId := uint64(0)
+	if genState.NextUnbondingId > 0 {
+		lastUnbondingId = genState.NextUnbondingId - 1
+	}
+	if err := k.UnbondingCounter.Set(ctx, lastUnbondingId); err != nil {
+		panic(fmt.Sprintf("failed to set unbonding counter: %s", err))
+	}
+
+	// Initialize yield records and reserve
+	for _, record := range genState.YieldRecords {
+		if err := k.YieldRecord.Set(ctx, record.Id, record); err != nil {
+			panic(fmt.Sprintf("failed to set yield record %d: %s", record.Id, err))
+		}
+	}
+
+	lastYieldRecordId := uint64(0)
+	if genState.NextYieldRecordId > 0 {
+		lastYieldRecordId = genState.NextYieldRecordId - 1
+	}
+	if err := k.YieldRecordCounter.Set(ctx, lastYieldRecordId); err != nil {
+		panic(fmt.Sprintf("failed to set yield record counter: %s", err))
+	}
+
+	if err := k.YieldReserve.Set(ctx, genState.YieldReserve); err != nil {
+		panic(fmt.Sprintf("failed to set yield reserve: %s", err))
+	}
+
+	// Initialize delegations, the module delegations are in the staking genesis
+	for _, del := range genState.Delegations {
+		if err := k.Delegation.Set(ctx, del.Account, del); err != nil {
+			panic(fmt.Sprintf("failed to set delegation of account %s: %s", del.Account, err))
+		}
+		if del.UnbondingAmount > 0 && del.UnbondingCompletionTime != nil {
+			if err := k.UndelegationQueue.Set(ctx, collections.Join(*del.UnbondingCompletionTime, del.Account)); err != nil {
+				panic(fmt.Errorf("failed to queue undelegation: %w", err))
+			}
+		}
+	}
+
+	for _, ratio := range genState.RewardRatios {
+		if err := k.RewardRatio.Set(ctx, ratio.ValidatorAddress, ratio.Ratio); err != nil {
+			panic(fmt.Sprintf("failed to set reward ratio of validator %s: %s", ratio.ValidatorAddress, err))
+		}
+	}
 }
 
 // ExportGenesis returns the module's exported genesis.
@@ -44,15 +128,104 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	// The Walk function should iterate over keys in lexicographical order
 	_ = k.TrustDeposit.Walk(ctx, nil, func(key string, value types.TrustDeposit) (bool, error) {
 		trustDeposits = append(trustDeposits, types.TrustDepositRecord{
-			Account:   value.Account,
-			Share:     value.Share,
-			Amount:    value.Amount,
-			Claimable: value.Claimable,
+			Account:        value.Account,
+			Share:          value.Share,
+			Amount:         value.Amount,
+			Claimable:      value.Claimable,
+			SlashedDeposit: value.SlashedDeposit,
+			RepaidDeposit:  value.RepaidDeposit,
+			LastSlashed:    value.LastSlashed,
+			LastRepaid:     value.LastRepaid,
+			SlashCount:     value.SlashCount,
+			LastRepaidBy:   value.LastRepaidBy,
 		})
 		return false, nil // Continue iteration
 	})
 
 	genesis.TrustDeposits = trustDeposits
 
+	// Export slash distributions, walked in ID order
+	slashDistributions := []types.SlashDistribution{}
+	if err := k.SlashDistribution.Walk(ctx, nil, func(id uint64, distribution types.SlashDistribution) (bool, error) {
+		slashDistributions = append(slashDistributions, distribution)
+		return false, nil
+	}); err != nil {
+		panic(fmt.Sprintf("failed to export slash distributions: %s", err))
+	}
+
+	genesis.SlashDistributions = slashDistributions
+
+	lastSlashDistributionId, err := k.SlashDistributionCounter.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		panic(fmt.Sprintf("failed to get slash distribution counter: %s", err))
+	}
+
+	genesis.NextSlashDistributionId = lastSlashDistributionId + 1
+
+	// Export unbondings, walked in ID order
+	unbondings := []types.TrustDepositUnbonding{}
+	if err := k.Unbonding.Walk(ctx, nil, func(id uint64, unbonding types.TrustDepositUnbonding) (bool, error) {
+		unbondings = append(unbondings, unbonding)
+		return false, nil
+	}); err != nil {
+		panic(fmt.Sprintf("failed to export unbondings: %s", err))
+	}
+
+	genesis.Unbondings = unbondings
+
+	lastUnbondingId, err := k.UnbondingCounter.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		panic(fmt.Sprintf("failed to get unbonding counter: %s", err))
+	}
+
+	genesis.NextUnbondingId = lastUnbondingId + 1
+
+	// Export yield records, walked in ID order
+	yieldRecords := []types.YieldRecord{}
+	if err := k.YieldRecord.Walk(ctx, nil, func(id uint64, record types.YieldRecord) (bool, error) {
+		yieldRecords = append(yieldRecords, record)
+		return false, nil
+	}); err != nil {
+		panic(fmt.Sprintf("failed to export yield records: %s", err))
+	}
+
+	genesis.YieldRecords = yieldRecords
+
+	lastYieldRecordId, err := k.YieldRecordCounter.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		panic(fmt.Sprintf("failed to get yield record counter: %s", err))
+	}
+
+	genesis.NextYieldRecordId = lastYieldRecordId + 1
+
+	yieldReserve, err := k.GetYieldReserveAmount(ctx)
+	if err != nil {
+		panic(fmt.Sprintf("failed to get yield reserve: %s", err))
+	}
+
+	genesis.YieldReserve = yieldReserve
+
+	// Export delegations, walked in account order
+	delegations := []types.TrustDepositDelegation{}
+	if err := k.Delegation.Walk(ctx, nil, func(account string, del types.TrustDepositDelegation) (bool, error) {
+		delegations = append(delegations, del)
+		return false, nil
+	}); err != nil {
+		panic(fmt.Sprintf("failed to export delegations: %s", err))
+	}
+
+	genesis.Delegations = delegations
+
+	// Export reward ratios, walked in validator order
+	rewardRatios := []types.TrustDepositRewardRatio{}
+	if err := k.RewardRatio.Walk(ctx, nil, func(validator string, ratio math.LegacyDec) (bool, error) {
+		rewardRatios = append(rewardRatios, types.TrustDepositRewardRatio{ValidatorAddress: validator, Ratio: ratio})
+		return false, nil
+	}); err != nil {
+		panic(fmt.Sprintf("failed to export reward ratios: %s", err))
+	}
+
+	genesis.RewardRatios = rewardRatios
+
 	return genesis
 }
@@ -14,6 +14,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgReclaimTrustDepositYield{}, "/td/v1/reclaim-interests")
 	legacy.RegisterAminoMsg(cdc, &MsgReclaimTrustDeposit{}, "/td/v1/reclaim-deposit")
 	legacy.RegisterAminoMsg(cdc, &MsgRepaySlashedTrustDeposit{}, "/td/v1/repay-slashed-td")
+	legacy.RegisterAminoMsg(cdc, &MsgFundYieldReserve{}, "/td/v1/fund-yield-reserve")
+	legacy.RegisterAminoMsg(cdc, &MsgDelegateTrustDeposit{}, "/td/v1/delegate-td")
+	legacy.RegisterAminoMsg(cdc, &MsgUndelegateTrustDeposit{}, "/td/v1/undelegate-td")
 	legacy.RegisterAminoMsg(cdc, &SlashTrustDepositProposal{}, "td/v1/SlashTrustDepositProposal")
 
 }
@@ -28,6 +31,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgReclaimTrustDepositYield{},
 		&MsgReclaimTrustDeposit{},
 		&MsgRepaySlashedTrustDeposit{},
+		&MsgFundYieldReserve{},
+		&MsgDelegateTrustDeposit{},
+		&MsgUndelegateTrustDeposit{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
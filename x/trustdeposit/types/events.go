@@ -10,4 +10,35 @@ const (
 	AttributeKeyRepaidBy       = "repaid_by"
 	EventTypeSlashTrustDeposit = "slash_trust_deposit"
 	AttributeKeySlashCount     = "slash_count"
+
+	EventTypeDistributeSlashedTrustDeposit = "distribute_slashed_trust_deposit"
+	AttributeKeyDestination                = "destination"
+	AttributeKeyRecipient                  = "recipient"
+
+	EventTypeReclaimTrustDeposit = "reclaim_trust_deposit"
+	EventTypeCompleteUnbonding   = "complete_unbonding"
+	EventTypeSlashUnbonding      = "slash_unbonding"
+	AttributeKeyUnbondingID      = "unbonding_id"
+	AttributeKeyCompletionTime   = "completion_time"
+	AttributeKeyBurnedAmount     = "burned_amount"
+
+	EventTypeDistributeYield  = "distribute_yield"
+	EventTypeFundYieldReserve = "fund_yield_reserve"
+	AttributeKeyYieldRecordID = "yield_record_id"
+	AttributeKeyShareValue    = "share_value"
+	AttributeKeyAPY           = "apy"
+	AttributeKeyYieldReserve  = "yield_reserve"
+
+	EventTypeYieldEpochStepFailed = "yield_epoch_step_failed"
+	AttributeKeyStep              = "step"
+	AttributeKeyReason            = "reason"
+
+	EventTypeDelegateTrustDeposit       = "delegate_trust_deposit"
+	EventTypeUndelegateTrustDeposit     = "undelegate_trust_deposit"
+	EventTypeCompleteUndelegation       = "complete_trust_deposit_undelegation"
+	EventTypeValidatorSlashTrustDeposit = "validator_slash_trust_deposit"
+	EventTypeStakingRewards             = "trust_deposit_staking_rewards"
+	EventTypeSyncDelegationFailed       = "sync_trust_deposit_delegation_failed"
+	AttributeKeyValidator               = "validator"
+	AttributeKeyShares                  = "shares"
 )
@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -11,8 +13,15 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params:        DefaultParams(),
-		TrustDeposits: []TrustDepositRecord{},
+		Params:                  DefaultParams(),
+		TrustDeposits:           []TrustDepositRecord{},
+		SlashDistributions:      []SlashDistribution{},
+		NextSlashDistributionId: 1,
+		Unbondings:              []TrustDepositUnbonding{},
+		NextUnbondingId:         1,
+		YieldRecords:            []YieldRecord{},
+		NextYieldRecordId:       1,
+		Delegations:             []TrustDepositDelegation{},
 	}
 }
 
@@ -44,6 +53,177 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("claimable amount exceeds deposit amount for account %s: %d > %d",
 				td.Account, td.Claimable, td.Amount)
 		}
+
+		if td.LastRepaidBy != "" {
+			if _, err := sdk.AccAddressFromBech32(td.LastRepaidBy); err != nil {
+				return fmt.Errorf("invalid last repaid by address for account %s: %s", td.Account, err)
+			}
+		}
+	}
+
+	// Check slash distributions
+	distributionIds := make(map[uint64]bool)
+	maxDistributionId := uint64(0)
+	for _, distribution := range gs.SlashDistributions {
+		if distribution.Id == 0 {
+			return fmt.Errorf("slash distribution ID cannot be 0")
+		}
+
+		if distributionIds[distribution.Id] {
+			return fmt.Errorf("duplicate slash distribution ID found: %d", distribution.Id)
+		}
+		distributionIds[distribution.Id] = true
+
+		if distribution.Id > maxDistributionId {
+			maxDistributionId = distribution.Id
+		}
+
+		if _, err := sdk.AccAddressFromBech32(distribution.Account); err != nil {
+			return fmt.Errorf("invalid account address for slash distribution ID %d: %s", distribution.Id, err)
+		}
+
+		if distribution.Destination == SlashDestination_SLASH_DESTINATION_BENEFICIARY {
+			if _, err := sdk.AccAddressFromBech32(distribution.Recipient); err != nil {
+				return fmt.Errorf("invalid recipient address for slash distribution ID %d: %s", distribution.Id, err)
+			}
+		}
+	}
+
+	if maxDistributionId > 0 && gs.NextSlashDistributionId <= maxDistributionId {
+		return fmt.Errorf("next_slash_distribution_id (%d) must be greater than the maximum slash distribution ID (%d)",
+			gs.NextSlashDistributionId, maxDistributionId)
+	}
+
+	// Check unbondings
+	unbondingIds := make(map[uint64]bool)
+	maxUnbondingId := uint64(0)
+	for _, unbonding := range gs.Unbondings {
+		if unbonding.Id == 0 {
+			return fmt.Errorf("unbonding ID cannot be 0")
+		}
+
+		if unbondingIds[unbonding.Id] {
+			return fmt.Errorf("duplicate unbonding ID found: %d", unbonding.Id)
+		}
+		unbondingIds[unbonding.Id] = true
+
+		if unbonding.Id > maxUnbondingId {
+			maxUnbondingId = unbonding.Id
+		}
+
+		if _, err := sdk.AccAddressFromBech32(unbonding.Account); err != nil {
+			return fmt.Errorf("invalid account address for unbonding ID %d: %s", unbonding.Id, err)
+		}
+
+		if unbonding.Amount > unbonding.InitialAmount {
+			return fmt.Errorf("amount cannot exceed initial amount for unbonding ID %d", unbonding.Id)
+		}
+
+		if unbonding.Created == nil || unbonding.CompletionTime == nil {
+			return fmt.Errorf("created and completion timestamps are required for unbonding ID %d", unbonding.Id)
+		}
+	}
+
+	if maxUnbondingId > 0 && gs.NextUnbondingId <= maxUnbondingId {
+		return fmt.Errorf("next_unbonding_id (%d) must be greater than the maximum unbonding ID (%d)",
+			gs.NextUnbondingId, maxUnbondingId)
+	}
+
+	// Check yield records
+	yieldRecordIds := make(map[uint64]bool)
+	maxYieldRecordId := uint64(0)
+	for _, record := range gs.YieldRecords {
+		if record.Id == 0 {
+			return fmt.Errorf("yield record ID cannot be 0")
+		}
+
+		if yieldRecordIds[record.Id] {
+			return fmt.Errorf("duplicate yield record ID found: %d", record.Id)
+		}
+		yieldRecordIds[record.Id] = true
+
+		if record.Id > maxYieldRecordId {
+			maxYieldRecordId = record.Id
+		}
+
+		if record.Time == nil {
+			return fmt.Errorf("time is required for yield record ID %d", record.Id)
+		}
+
+		if record.ShareValue.IsNil() || !record.ShareValue.IsPositive() {
+			return fmt.Errorf("share value must be positive for yield record ID %d", record.Id)
+		}
+
+		if record.Apy.IsNil() {
+			return fmt.Errorf("apy is required for yield record ID %d", record.Id)
+		}
+	}
+
+	if maxYieldRecordId > 0 && gs.NextYieldRecordId <= maxYieldRecordId {
+		return fmt.Errorf("next_yield_record_id (%d) must be greater than the maximum yield record ID (%d)",
+			gs.NextYieldRecordId, maxYieldRecordId)
+	}
+
+	// Check reward ratios
+	rewardRatios := make(map[string]math.LegacyDec)
+	for _, ratio := range gs.RewardRatios {
+		if _, found := rewardRatios[ratio.ValidatorAddress]; found {
+			return fmt.Errorf("duplicate reward ratio for validator: %s", ratio.ValidatorAddress)
+		}
+		if _, err := sdk.ValAddressFromBech32(ratio.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid validator address for reward ratio: %s", err)
+		}
+		if ratio.Ratio.IsNil() || ratio.Ratio.IsNegative() {
+			return fmt.Errorf("invalid reward ratio for validator %s", ratio.ValidatorAddress)
+		}
+		rewardRatios[ratio.ValidatorAddress] = ratio.Ratio
+	}
+
+	// Check delegations, the delegated deposit must be part of the trust deposit
+	deposits := make(map[string]uint64)
+	for _, td := range gs.TrustDeposits {
+		deposits[td.Account] = td.Amount
+	}
+	delegationAccounts := make(map[string]bool)
+	for _, del := range gs.Delegations {
+		if delegationAccounts[del.Account] {
+			return fmt.Errorf("duplicate delegation for account: %s", del.Account)
+		}
+		delegationAccounts[del.Account] = true
+
+		if _, err := sdk.ValAddressFromBech32(del.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid validator address for delegation of account %s: %s", del.Account, err)
+		}
+
+		if del.Shares.IsNil() || del.Shares.IsNegative() {
+			return fmt.Errorf("invalid shares for delegation of account %s", del.Account)
+		}
+
+		amount, found := deposits[del.Account]
+		if !found {
+			return fmt.Errorf("delegation of account %s has no trust deposit", del.Account)
+		}
+		if del.UnbondingSlashed > del.UnbondingAmount {
+			return fmt.Errorf("unbonding slashed amount exceeds unbonding amount of account %s", del.Account)
+		}
+		if del.Amount+del.UnbondingAmount-del.UnbondingSlashed > amount {
+			return fmt.Errorf("delegated amount exceeds trust deposit of account %s", del.Account)
+		}
+
+		if del.UnbondingAmount > 0 && del.UnbondingCompletionTime == nil {
+			return fmt.Errorf("unbonding completion time is required for delegation of account %s", del.Account)
+		}
+
+		// the rewards of a delegation are paid up to the reward ratio of its validator
+		if !del.RewardRatio.IsNil() {
+			ratio, found := rewardRatios[del.ValidatorAddress]
+			if !found {
+				ratio = math.LegacyZeroDec()
+			}
+			if del.RewardRatio.IsNegative() || del.RewardRatio.GT(ratio) {
+				return fmt.Errorf("invalid reward ratio for delegation of account %s", del.Account)
+			}
+		}
 	}
 
 	return nil
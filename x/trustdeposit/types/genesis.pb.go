@@ -8,15 +8,19 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -29,6 +33,24 @@ type GenesisState struct {
 	// params defines all the parameters of the module.
 	Params        Params               `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	TrustDeposits []TrustDepositRecord `protobuf:"bytes,2,rep,name=trust_deposits,json=trustDeposits,proto3" json:"trust_deposits"`
+	// slash_distributions is a list of all SlashDistribution objects
+	SlashDistributions []SlashDistribution `protobuf:"bytes,3,rep,name=slash_distributions,json=slashDistributions,proto3" json:"slash_distributions"`
+	// next_slash_distribution_id is the next auto-increment ID to be assigned to a new slash distribution
+	NextSlashDistributionId uint64 `protobuf:"varint,4,opt,name=next_slash_distribution_id,json=nextSlashDistributionId,proto3" json:"next_slash_distribution_id,omitempty"`
+	// unbondings is a list of all pending TrustDepositUnbonding objects
+	Unbondings []TrustDepositUnbonding `protobuf:"bytes,5,rep,name=unbondings,proto3" json:"unbondings"`
+	// next_unbonding_id is the next auto-increment ID to be assigned to a new unbonding
+	NextUnbondingId uint64 `protobuf:"varint,6,opt,name=next_unbonding_id,json=nextUnbondingId,proto3" json:"next_unbonding_id,omitempty"`
+	// yield_records is the history of yield distributions
+	YieldRecords []YieldRecord `protobuf:"bytes,7,rep,name=yield_records,json=yieldRecords,proto3" json:"yield_records"`
+	// next_yield_record_id is the next auto-increment ID to be assigned to a new yield record
+	NextYieldRecordId uint64 `protobuf:"varint,8,opt,name=next_yield_record_id,json=nextYieldRecordId,proto3" json:"next_yield_record_id,omitempty"`
+	// yield_reserve is the amount held by the module to be released as yield
+	YieldReserve uint64 `protobuf:"varint,9,opt,name=yield_reserve,json=yieldReserve,proto3" json:"yield_reserve,omitempty"`
+	// delegations is a list of all TrustDepositDelegation objects
+	Delegations []TrustDepositDelegation `protobuf:"bytes,10,rep,name=delegations,proto3" json:"delegations"`
+	// reward_ratios are the staking reward ratios of the validators delegated to
+	RewardRatios []TrustDepositRewardRatio `protobuf:"bytes,11,rep,name=reward_ratios,json=rewardRatios,proto3" json:"reward_ratios"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,12 +100,82 @@ func (m *GenesisState) GetTrustDeposits() []TrustDepositRecord {
 	return nil
 }
 
+func (m *GenesisState) GetSlashDistributions() []SlashDistribution {
+	if m != nil {
+		return m.SlashDistributions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextSlashDistributionId() uint64 {
+	if m != nil {
+		return m.NextSlashDistributionId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetUnbondings() []TrustDepositUnbonding {
+	if m != nil {
+		return m.Unbondings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextUnbondingId() uint64 {
+	if m != nil {
+		return m.NextUnbondingId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetYieldRecords() []YieldRecord {
+	if m != nil {
+		return m.YieldRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextYieldRecordId() uint64 {
+	if m != nil {
+		return m.NextYieldRecordId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetYieldReserve() uint64 {
+	if m != nil {
+		return m.YieldReserve
+	}
+	return 0
+}
+
+func (m *GenesisState) GetDelegations() []TrustDepositDelegation {
+	if m != nil {
+		return m.Delegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardRatios() []TrustDepositRewardRatio {
+	if m != nil {
+		return m.RewardRatios
+	}
+	return nil
+}
+
 // TrustDepositRecord defines a trust deposit entry for genesis state
 type TrustDepositRecord struct {
 	Account   string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 	Share     uint64 `protobuf:"varint,2,opt,name=share,proto3" json:"share,omitempty"`
 	Amount    uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
 	Claimable uint64 `protobuf:"varint,4,opt,name=claimable,proto3" json:"claimable,omitempty"`
+	// slashing related fields, kept so that slashed deposits survive export/import
+	SlashedDeposit uint64     `protobuf:"varint,5,opt,name=slashed_deposit,json=slashedDeposit,proto3" json:"slashed_deposit,omitempty"`
+	RepaidDeposit  uint64     `protobuf:"varint,6,opt,name=repaid_deposit,json=repaidDeposit,proto3" json:"repaid_deposit,omitempty"`
+	LastSlashed    *time.Time `protobuf:"bytes,7,opt,name=last_slashed,json=lastSlashed,proto3,stdtime" json:"last_slashed,omitempty"`
+	LastRepaid     *time.Time `protobuf:"bytes,8,opt,name=last_repaid,json=lastRepaid,proto3,stdtime" json:"last_repaid,omitempty"`
+	SlashCount     uint64     `protobuf:"varint,9,opt,name=slash_count,json=slashCount,proto3" json:"slash_count,omitempty"`
+	LastRepaidBy   string     `protobuf:"bytes,10,opt,name=last_repaid_by,json=lastRepaidBy,proto3" json:"last_repaid_by,omitempty"`
 }
 
 func (m *TrustDepositRecord) Reset()         { *m = TrustDepositRecord{} }
@@ -147,6 +239,48 @@ func (m *TrustDepositRecord) GetClaimable() uint64 {
 	return 0
 }
 
+func (m *TrustDepositRecord) GetSlashedDeposit() uint64 {
+	if m != nil {
+		return m.SlashedDeposit
+	}
+	return 0
+}
+
+func (m *TrustDepositRecord) GetRepaidDeposit() uint64 {
+	if m != nil {
+		return m.RepaidDeposit
+	}
+	return 0
+}
+
+func (m *TrustDepositRecord) GetLastSlashed() *time.Time {
+	if m != nil {
+		return m.LastSlashed
+	}
+	return nil
+}
+
+func (m *TrustDepositRecord) GetLastRepaid() *time.Time {
+	if m != nil {
+		return m.LastRepaid
+	}
+	return nil
+}
+
+func (m *TrustDepositRecord) GetSlashCount() uint64 {
+	if m != nil {
+		return m.SlashCount
+	}
+	return 0
+}
+
+func (m *TrustDepositRecord) GetLastRepaidBy() string {
+	if m != nil {
+		return m.LastRepaidBy
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "verana.td.v1.GenesisState")
 	proto.RegisterType((*TrustDepositRecord)(nil), "verana.td.v1.TrustDepositRecord")
@@ -155,29 +289,51 @@ func init() {
 func init() { proto.RegisterFile("verana/td/v1/genesis.proto", fileDescriptor_daff73aaff90939d) }
 
 var fileDescriptor_daff73aaff90939d = []byte{
-	// 342 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xc1, 0x4a, 0xc3, 0x40,
-	0x10, 0x86, 0xb3, 0x6d, 0xad, 0x74, 0x5b, 0x05, 0x97, 0x22, 0xb1, 0x48, 0x0c, 0x3d, 0x15, 0xc1,
-	0x2c, 0xad, 0x07, 0x4f, 0x5e, 0x8a, 0xe0, 0x49, 0x90, 0x28, 0x08, 0x5e, 0x64, 0xb2, 0x59, 0xd2,
-	0x60, 0x92, 0x0d, 0xd9, 0x6d, 0x51, 0x9f, 0xc2, 0x93, 0xcf, 0xe0, 0xd1, 0xc7, 0xe8, 0xb1, 0x47,
-	0x4f, 0x22, 0xed, 0xc1, 0xd7, 0x90, 0xee, 0xa6, 0xd8, 0xe2, 0x65, 0x99, 0xf9, 0xff, 0x8f, 0x7f,
-	0x76, 0x06, 0x77, 0x26, 0xbc, 0x80, 0x0c, 0xa8, 0x0a, 0xe9, 0xa4, 0x4f, 0x23, 0x9e, 0x71, 0x19,
-	0x4b, 0x2f, 0x2f, 0x84, 0x12, 0xa4, 0x65, 0x3c, 0x4f, 0x85, 0xde, 0xa4, 0xdf, 0xd9, 0x83, 0x34,
-	0xce, 0x04, 0xd5, 0xaf, 0x01, 0x3a, 0xed, 0x48, 0x44, 0x42, 0x97, 0x74, 0x59, 0x95, 0xea, 0xc1,
-	0x46, 0x64, 0x0e, 0x05, 0xa4, 0x65, 0x62, 0xf7, 0x0d, 0xe1, 0xd6, 0xa5, 0x99, 0x71, 0xa3, 0x40,
-	0x71, 0x72, 0x86, 0xeb, 0x06, 0xb0, 0x91, 0x8b, 0x7a, 0xcd, 0x41, 0xdb, 0x5b, 0x9f, 0xe9, 0x5d,
-	0x6b, 0x6f, 0xd8, 0x98, 0x7e, 0x1d, 0x59, 0xef, 0x3f, 0x1f, 0xc7, 0xc8, 0x2f, 0x71, 0x72, 0x85,
-	0x77, 0x55, 0x31, 0x96, 0xea, 0x21, 0xe4, 0xb9, 0x90, 0xb1, 0x92, 0x76, 0xc5, 0xad, 0xf6, 0x9a,
-	0x03, 0x77, 0x33, 0xe0, 0x76, 0xc9, 0x5c, 0x18, 0xc4, 0xe7, 0x4c, 0x14, 0xe1, 0xb0, 0xb6, 0x0c,
-	0xf3, 0x77, 0xd4, 0x9a, 0x23, 0xbb, 0x2f, 0x98, 0xfc, 0x47, 0x89, 0x8d, 0xb7, 0x81, 0x31, 0x31,
-	0xce, 0x94, 0xfe, 0x5e, 0xc3, 0x5f, 0xb5, 0xa4, 0x8d, 0xb7, 0xe4, 0x08, 0x0a, 0x6e, 0x57, 0x5c,
-	0xd4, 0xab, 0xf9, 0xa6, 0x21, 0xfb, 0xb8, 0x0e, 0xa9, 0xc6, 0xab, 0x5a, 0x2e, 0x3b, 0x72, 0x88,
-	0x1b, 0x2c, 0x81, 0x38, 0x85, 0x20, 0xe1, 0x76, 0x4d, 0x5b, 0x7f, 0xc2, 0xf0, 0x6e, 0x3a, 0x77,
-	0xd0, 0x6c, 0xee, 0xa0, 0xef, 0xb9, 0x83, 0x5e, 0x17, 0x8e, 0x35, 0x5b, 0x38, 0xd6, 0xe7, 0xc2,
-	0xb1, 0xee, 0xcf, 0xa3, 0x58, 0x8d, 0xc6, 0x81, 0xc7, 0x44, 0x4a, 0xcd, 0x5a, 0x27, 0x09, 0x04,
-	0x72, 0x55, 0x07, 0x89, 0x60, 0x8f, 0x6c, 0x04, 0x71, 0x46, 0x9f, 0xa8, 0xde, 0xa7, 0x3c, 0x06,
-	0x55, 0xcf, 0x39, 0x97, 0x41, 0x5d, 0x1f, 0xfd, 0xf4, 0x37, 0x00, 0x00, 0xff, 0xff, 0x85, 0xeb,
-	0x3f, 0xf3, 0xe4, 0x01, 0x00, 0x00,
+	// 696 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcb, 0x6e, 0xd3, 0x4c,
+	0x14, 0x8e, 0x9b, 0x4b, 0x9b, 0xc9, 0xa5, 0xea, 0xfc, 0xd1, 0xcf, 0x34, 0x42, 0x49, 0xd4, 0x8b,
+	0xa8, 0x2a, 0x61, 0xab, 0x65, 0xc1, 0x02, 0xb1, 0x20, 0x8d, 0x84, 0x22, 0x81, 0x54, 0xb9, 0x05,
+	0x04, 0x1b, 0x6b, 0x9c, 0x19, 0x1c, 0x0b, 0x5f, 0x22, 0xcf, 0x24, 0x34, 0x6f, 0xd1, 0x2d, 0x6f,
+	0xc0, 0x92, 0xc7, 0xe8, 0xb2, 0x4b, 0x56, 0x05, 0xb5, 0x0b, 0x5e, 0x03, 0xf9, 0xcc, 0xb8, 0x71,
+	0xa8, 0x8a, 0xd8, 0x58, 0x33, 0xdf, 0xf9, 0xce, 0xf7, 0x9d, 0x39, 0x3e, 0x33, 0xa8, 0x3d, 0xe3,
+	0x09, 0x8d, 0xa8, 0x25, 0x99, 0x35, 0x3b, 0xb0, 0x3c, 0x1e, 0x71, 0xe1, 0x0b, 0x73, 0x92, 0xc4,
+	0x32, 0xc6, 0x75, 0x15, 0x33, 0x25, 0x33, 0x67, 0x07, 0xed, 0x0d, 0x1a, 0xfa, 0x51, 0x6c, 0xc1,
+	0x57, 0x11, 0xda, 0x2d, 0x2f, 0xf6, 0x62, 0x58, 0x5a, 0xe9, 0x4a, 0xa3, 0x5d, 0x2f, 0x8e, 0xbd,
+	0x80, 0x5b, 0xb0, 0x73, 0xa7, 0x1f, 0x2d, 0xe9, 0x87, 0x5c, 0x48, 0x1a, 0x4e, 0x34, 0x61, 0x73,
+	0xc9, 0x73, 0x42, 0x13, 0x1a, 0x6a, 0xcb, 0x36, 0x59, 0x0a, 0xc9, 0xf9, 0x84, 0xeb, 0xc8, 0xd6,
+	0x55, 0x19, 0xd5, 0x5f, 0xaa, 0xf2, 0x4e, 0x24, 0x95, 0x1c, 0x3f, 0x45, 0x15, 0x95, 0x4a, 0x8c,
+	0x9e, 0xb1, 0x57, 0x3b, 0x6c, 0x99, 0xf9, 0x72, 0xcd, 0x63, 0x88, 0xf5, 0xab, 0x17, 0x57, 0xdd,
+	0xc2, 0xd7, 0x5f, 0xdf, 0xf6, 0x0d, 0x5b, 0xd3, 0xf1, 0x6b, 0xd4, 0x94, 0xc9, 0x54, 0x48, 0x87,
+	0xf1, 0x49, 0x2c, 0x7c, 0x29, 0xc8, 0x4a, 0xaf, 0xb8, 0x57, 0x3b, 0xec, 0x2d, 0x0b, 0x9c, 0xa6,
+	0x9c, 0x81, 0xa2, 0xd8, 0x7c, 0x14, 0x27, 0xac, 0x5f, 0x4a, 0xc5, 0xec, 0x86, 0xcc, 0x45, 0x04,
+	0x7e, 0x8b, 0xfe, 0x13, 0x01, 0x15, 0x63, 0x87, 0xf9, 0x42, 0x26, 0xbe, 0x3b, 0x95, 0x7e, 0x1c,
+	0x09, 0x52, 0x04, 0xcd, 0xee, 0xb2, 0xe6, 0x49, 0x4a, 0x1c, 0xe4, 0x78, 0x5a, 0x12, 0x8b, 0x3f,
+	0x03, 0x02, 0x3f, 0x43, 0xed, 0x88, 0x9f, 0x49, 0xe7, 0xae, 0xb8, 0xe3, 0x33, 0x52, 0xea, 0x19,
+	0x7b, 0x25, 0xfb, 0x41, 0xca, 0xb8, 0x23, 0x3a, 0x64, 0x78, 0x88, 0xd0, 0x34, 0x72, 0xe3, 0x88,
+	0xf9, 0x91, 0x27, 0x48, 0x19, 0x6a, 0xd9, 0xbe, 0xff, 0x7c, 0x6f, 0x32, 0xae, 0xae, 0x27, 0x97,
+	0x8c, 0xf7, 0xd1, 0x06, 0xd4, 0x71, 0x0b, 0xa5, 0xf6, 0x15, 0xb0, 0x5f, 0x4f, 0x03, 0xb7, 0xb9,
+	0x43, 0x86, 0x07, 0xa8, 0x31, 0xf7, 0x79, 0xc0, 0x9c, 0x04, 0x1a, 0x26, 0xc8, 0x2a, 0x38, 0x6f,
+	0x2e, 0x3b, 0xbf, 0x4f, 0x29, 0x4b, 0x2d, 0xad, 0xcf, 0x17, 0x90, 0xc0, 0x16, 0x6a, 0x81, 0x63,
+	0x5e, 0x2a, 0x35, 0x5d, 0x03, 0x53, 0xa8, 0x26, 0x27, 0x31, 0x64, 0x78, 0x7b, 0x61, 0x2b, 0x78,
+	0x32, 0xe3, 0xa4, 0x0a, 0xcc, 0x4c, 0x15, 0x30, 0xfc, 0x0a, 0xd5, 0x18, 0x0f, 0xb8, 0x47, 0xd5,
+	0xff, 0x41, 0x50, 0xd9, 0xce, 0xfd, 0x3d, 0x19, 0xdc, 0x92, 0x75, 0x91, 0xf9, 0x74, 0x7c, 0x8c,
+	0x1a, 0x09, 0xff, 0x4c, 0x13, 0xe6, 0x24, 0x29, 0x22, 0x48, 0x0d, 0xf4, 0x76, 0xff, 0x36, 0x43,
+	0x29, 0xdd, 0x4e, 0xd9, 0xd9, 0xa9, 0x93, 0x05, 0x24, 0xb6, 0xbe, 0x14, 0x11, 0xbe, 0x3b, 0x73,
+	0x98, 0xa0, 0x55, 0x3a, 0x1a, 0xc5, 0xd3, 0x48, 0xc2, 0x9c, 0x57, 0xed, 0x6c, 0x8b, 0x5b, 0xa8,
+	0x2c, 0xc6, 0x34, 0xe1, 0x64, 0x05, 0x4e, 0xab, 0x36, 0xf8, 0x7f, 0x54, 0xa1, 0x21, 0xd0, 0x8b,
+	0x00, 0xeb, 0x1d, 0x7e, 0x88, 0xaa, 0xa3, 0x80, 0xfa, 0x21, 0x75, 0x03, 0xae, 0xa7, 0x67, 0x01,
+	0xe0, 0x47, 0x68, 0x1d, 0xe6, 0x8c, 0xb3, 0xec, 0x56, 0x90, 0x32, 0x70, 0x9a, 0x1a, 0xd6, 0x45,
+	0xe1, 0x5d, 0xd4, 0x4c, 0xf8, 0x84, 0xfa, 0x0b, 0x9e, 0x1a, 0x85, 0x86, 0x42, 0x33, 0xda, 0x11,
+	0xaa, 0x07, 0x54, 0xe8, 0xe1, 0xe5, 0x8c, 0xac, 0xc2, 0x15, 0x6d, 0x9b, 0xea, 0x69, 0x30, 0xb3,
+	0xa7, 0xc1, 0x3c, 0xcd, 0x9e, 0x86, 0x7e, 0xe9, 0xfc, 0x47, 0xd7, 0xb0, 0x6b, 0x69, 0xd6, 0x89,
+	0x4a, 0xc2, 0x2f, 0x10, 0x6c, 0x1d, 0x25, 0x4d, 0xd6, 0xfe, 0x51, 0x03, 0xa5, 0x49, 0x36, 0xe4,
+	0xe0, 0x2e, 0xaa, 0xa9, 0xfb, 0xa3, 0x3a, 0xa8, 0xe6, 0x02, 0x01, 0x74, 0x04, 0x6d, 0xd9, 0x41,
+	0xcd, 0x9c, 0x87, 0xe3, 0xce, 0x09, 0x82, 0x2e, 0xd7, 0x17, 0x22, 0xfd, 0x79, 0xff, 0xdd, 0xc5,
+	0x75, 0xc7, 0xb8, 0xbc, 0xee, 0x18, 0x3f, 0xaf, 0x3b, 0xc6, 0xf9, 0x4d, 0xa7, 0x70, 0x79, 0xd3,
+	0x29, 0x7c, 0xbf, 0xe9, 0x14, 0x3e, 0x3c, 0xf7, 0x7c, 0x39, 0x9e, 0xba, 0xe6, 0x28, 0x0e, 0x2d,
+	0xf5, 0xeb, 0x1f, 0x07, 0xd4, 0x15, 0xd9, 0xda, 0x0d, 0xe2, 0xd1, 0xa7, 0xd1, 0x98, 0xfa, 0x91,
+	0x75, 0x66, 0xc1, 0xbb, 0xa1, 0xdb, 0xa6, 0xde, 0x36, 0xb7, 0x02, 0xa7, 0x78, 0xf2, 0x7b, 0x00,
+	0x4a, 0x7c, 0xe4, 0xb2, 0x87, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -200,6 +356,96 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RewardRatios) > 0 {
+		for iNdEx := len(m.RewardRatios) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardRatios[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.YieldReserve != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.YieldReserve))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.NextYieldRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextYieldRecordId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.YieldRecords) > 0 {
+		for iNdEx := len(m.YieldRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.YieldRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.NextUnbondingId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextUnbondingId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Unbondings) > 0 {
+		for iNdEx := len(m.Unbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.NextSlashDistributionId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextSlashDistributionId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SlashDistributions) > 0 {
+		for iNdEx := len(m.SlashDistributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashDistributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.TrustDeposits) > 0 {
 		for iNdEx := len(m.TrustDeposits) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -247,6 +493,48 @@ func (m *TrustDepositRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LastRepaidBy) > 0 {
+		i -= len(m.LastRepaidBy)
+		copy(dAtA[i:], m.LastRepaidBy)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.LastRepaidBy)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.SlashCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SlashCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.LastRepaid != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastRepaid, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastRepaid):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintGenesis(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.LastSlashed != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastSlashed, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastSlashed):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintGenesis(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.RepaidDeposit != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RepaidDeposit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SlashedDeposit != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SlashedDeposit))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Claimable != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.Claimable))
 		i--
@@ -297,6 +585,48 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SlashDistributions) > 0 {
+		for _, e := range m.SlashDistributions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextSlashDistributionId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextSlashDistributionId))
+	}
+	if len(m.Unbondings) > 0 {
+		for _, e := range m.Unbondings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextUnbondingId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextUnbondingId))
+	}
+	if len(m.YieldRecords) > 0 {
+		for _, e := range m.YieldRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextYieldRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextYieldRecordId))
+	}
+	if m.YieldReserve != 0 {
+		n += 1 + sovGenesis(uint64(m.YieldReserve))
+	}
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardRatios) > 0 {
+		for _, e := range m.RewardRatios {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -319,6 +649,27 @@ func (m *TrustDepositRecord) Size() (n int) {
 	if m.Claimable != 0 {
 		n += 1 + sovGenesis(uint64(m.Claimable))
 	}
+	if m.SlashedDeposit != 0 {
+		n += 1 + sovGenesis(uint64(m.SlashedDeposit))
+	}
+	if m.RepaidDeposit != 0 {
+		n += 1 + sovGenesis(uint64(m.RepaidDeposit))
+	}
+	if m.LastSlashed != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastSlashed)
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.LastRepaid != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastRepaid)
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.SlashCount != 0 {
+		n += 1 + sovGenesis(uint64(m.SlashCount))
+	}
+	l = len(m.LastRepaidBy)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -424,61 +775,11 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TrustDepositRecord) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TrustDepositRecord: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TrustDepositRecord: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashDistributions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -488,29 +789,31 @@ func (m *TrustDepositRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.SlashDistributions = append(m.SlashDistributions, SlashDistribution{})
+			if err := m.SlashDistributions[len(m.SlashDistributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSlashDistributionId", wireType)
 			}
-			m.Share = 0
+			m.NextSlashDistributionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -520,15 +823,309 @@ func (m *TrustDepositRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Share |= uint64(b&0x7F) << shift
+				m.NextSlashDistributionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unbondings = append(m.Unbondings, TrustDepositUnbonding{})
+			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextUnbondingId", wireType)
+			}
+			m.NextUnbondingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextUnbondingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YieldRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.YieldRecords = append(m.YieldRecords, YieldRecord{})
+			if err := m.YieldRecords[len(m.YieldRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextYieldRecordId", wireType)
+			}
+			m.NextYieldRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextYieldRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YieldReserve", wireType)
+			}
+			m.YieldReserve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.YieldReserve |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegations = append(m.Delegations, TrustDepositDelegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardRatios", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardRatios = append(m.RewardRatios, TrustDepositRewardRatio{})
+			if err := m.RewardRatios[len(m.RewardRatios)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TrustDepositRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrustDepositRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrustDepositRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			m.Share = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Share |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
 			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
@@ -563,6 +1160,167 @@ func (m *TrustDepositRecord) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedDeposit", wireType)
+			}
+			m.SlashedDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashedDeposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepaidDeposit", wireType)
+			}
+			m.RepaidDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepaidDeposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSlashed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastSlashed == nil {
+				m.LastSlashed = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastSlashed, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRepaid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastRepaid == nil {
+				m.LastRepaid = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastRepaid, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashCount", wireType)
+			}
+			m.SlashCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRepaidBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastRepaidBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
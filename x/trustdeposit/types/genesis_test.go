@@ -3,6 +3,7 @@ package types_test
 import (
 	"cosmossdk.io/math"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -13,6 +14,8 @@ func TestGenesisState_Validate(t *testing.T) {
 	// Setup valid addresses for testing
 	validAddr1 := sdk.AccAddress([]byte("test_address1")).String()
 	validAddr2 := sdk.AccAddress([]byte("test_address2")).String()
+	validValAddr := sdk.ValAddress([]byte("test_validator")).String()
+	now := time.Now()
 
 	// Create a custom invalid param for testing
 	invalidParams := types.DefaultParams()
@@ -73,6 +76,24 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "invalid last repaid by address",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				TrustDeposits: []types.TrustDepositRecord{
+					{
+						Account:        validAddr1,
+						Share:          100,
+						Amount:         1000,
+						SlashedDeposit: 100,
+						RepaidDeposit:  100,
+						SlashCount:     1,
+						LastRepaidBy:   "invalid_address",
+					},
+				},
+			},
+			valid: false,
+		},
 		{
 			desc: "duplicate account",
 			genState: &types.GenesisState{
@@ -109,6 +130,207 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "valid slash distributions",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				SlashDistributions: []types.SlashDistribution{
+					{Id: 1, Account: validAddr1, Destination: types.SlashDestination_SLASH_DESTINATION_BURN, Amount: 10},
+					{Id: 2, Account: validAddr1, Destination: types.SlashDestination_SLASH_DESTINATION_BENEFICIARY, Recipient: validAddr2, Amount: 10},
+				},
+				NextSlashDistributionId: 3,
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid slash distribution recipient",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				SlashDistributions: []types.SlashDistribution{
+					{Id: 1, Account: validAddr1, Destination: types.SlashDestination_SLASH_DESTINATION_BENEFICIARY, Recipient: "invalid", Amount: 10},
+				},
+				NextSlashDistributionId: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "valid unbondings",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Unbondings: []types.TrustDepositUnbonding{
+					{Id: 1, Account: validAddr1, InitialAmount: 100, Amount: 50, Created: &now, CompletionTime: &now},
+				},
+				NextUnbondingId: 2,
+			},
+			valid: true,
+		},
+		{
+			desc: "unbonding amount exceeds initial amount",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Unbondings: []types.TrustDepositUnbonding{
+					{Id: 1, Account: validAddr1, InitialAmount: 100, Amount: 150, Created: &now, CompletionTime: &now},
+				},
+				NextUnbondingId: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "unbonding without completion time",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Unbondings: []types.TrustDepositUnbonding{
+					{Id: 1, Account: validAddr1, InitialAmount: 100, Amount: 100, Created: &now},
+				},
+				NextUnbondingId: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "next unbonding ID too low",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Unbondings: []types.TrustDepositUnbonding{
+					{Id: 1, Account: validAddr1, InitialAmount: 100, Amount: 100, Created: &now, CompletionTime: &now},
+				},
+				NextUnbondingId: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "valid yield records",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				YieldRecords: []types.YieldRecord{
+					{Id: 1, Height: 10, Time: &now, Amount: 100, TotalShares: 1000, ShareValue: math.LegacyMustNewDecFromStr("1.1"), Apy: math.LegacyZeroDec()},
+				},
+				NextYieldRecordId: 2,
+				YieldReserve:      500,
+			},
+			valid: true,
+		},
+		{
+			desc: "yield record without share value",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				YieldRecords: []types.YieldRecord{
+					{Id: 1, Height: 10, Time: &now, Apy: math.LegacyZeroDec()},
+				},
+				NextYieldRecordId: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "next yield record ID too low",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				YieldRecords: []types.YieldRecord{
+					{Id: 1, Height: 10, Time: &now, ShareValue: math.LegacyOneDec(), Apy: math.LegacyZeroDec()},
+				},
+				NextYieldRecordId: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "valid delegation",
+			genState: &types.GenesisState{
+				Params:        types.DefaultParams(),
+				TrustDeposits: []types.TrustDepositRecord{{Account: validAddr1, Share: 100, Amount: 100}},
+				Delegations: []types.TrustDepositDelegation{
+					{Account: validAddr1, ValidatorAddress: validValAddr, Shares: math.LegacyNewDec(60), Amount: 60},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "delegation exceeds trust deposit",
+			genState: &types.GenesisState{
+				Params:        types.DefaultParams(),
+				TrustDeposits: []types.TrustDepositRecord{{Account: validAddr1, Share: 100, Amount: 100}},
+				Delegations: []types.TrustDepositDelegation{
+					{Account: validAddr1, ValidatorAddress: validValAddr, Shares: math.LegacyNewDec(60), Amount: 60, UnbondingAmount: 50, UnbondingCompletionTime: &now},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "slashed unbonding delegation",
+			genState: &types.GenesisState{
+				Params:        types.DefaultParams(),
+				TrustDeposits: []types.TrustDepositRecord{{Account: validAddr1, Share: 100, Amount: 100}},
+				Delegations: []types.TrustDepositDelegation{
+					{Account: validAddr1, ValidatorAddress: validValAddr, Shares: math.LegacyNewDec(60), Amount: 60, UnbondingAmount: 50, UnbondingSlashed: 10, UnbondingCompletionTime: &now},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "unbonding slashed exceeds unbonding amount",
+			genState: &types.GenesisState{
+				Params:        types.DefaultParams(),
+				TrustDeposits: []types.TrustDepositRecord{{Account: validAddr1, Share: 100, Amount: 100}},
+				Delegations: []types.TrustDepositDelegation{
+					{Account: validAddr1, ValidatorAddress: validValAddr, Shares: math.LegacyZeroDec(), UnbondingAmount: 50, UnbondingSlashed: 60, UnbondingCompletionTime: &now},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "delegation paid up to the reward ratio of its validator",
+			genState: &types.GenesisState{
+				Params:        types.DefaultParams(),
+				TrustDeposits: []types.TrustDepositRecord{{Account: validAddr1, Share: 100, Amount: 100}},
+				Delegations: []types.TrustDepositDelegation{
+					{Account: validAddr1, ValidatorAddress: validValAddr, Shares: math.LegacyNewDec(60), Amount: 60, RewardRatio: math.LegacyNewDec(2)},
+				},
+				RewardRatios: []types.TrustDepositRewardRatio{{ValidatorAddress: validValAddr, Ratio: math.LegacyNewDec(2)}},
+			},
+			valid: true,
+		},
+		{
+			desc: "delegation reward ratio above its validator reward ratio",
+			genState: &types.GenesisState{
+				Params:        types.DefaultParams(),
+				TrustDeposits: []types.TrustDepositRecord{{Account: validAddr1, Share: 100, Amount: 100}},
+				Delegations: []types.TrustDepositDelegation{
+					{Account: validAddr1, ValidatorAddress: validValAddr, Shares: math.LegacyNewDec(60), Amount: 60, RewardRatio: math.LegacyNewDec(3)},
+				},
+				RewardRatios: []types.TrustDepositRewardRatio{{ValidatorAddress: validValAddr, Ratio: math.LegacyNewDec(2)}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate reward ratio",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				RewardRatios: []types.TrustDepositRewardRatio{
+					{ValidatorAddress: validValAddr, Ratio: math.LegacyNewDec(2)},
+					{ValidatorAddress: validValAddr, Ratio: math.LegacyNewDec(3)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "delegation without trust deposit",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Delegations: []types.TrustDepositDelegation{
+					{Account: validAddr1, ValidatorAddress: validValAddr, Shares: math.LegacyNewDec(60), Amount: 60},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "next slash distribution ID too low",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				SlashDistributions: []types.SlashDistribution{
+					{Id: 1, Account: validAddr1, Destination: types.SlashDestination_SLASH_DESTINATION_BURN, Amount: 10},
+				},
+				NextSlashDistributionId: 1,
+			},
+			valid: false,
+		},
 	}
 
 	for _, tc := range tests {
@@ -18,6 +18,23 @@ const (
 var (
 	ParamsKey       = []byte("p_trustdeposit")
 	TrustDepositKey = collections.NewPrefix(1)
+
+	SlashDistributionKey        = collections.NewPrefix(2)
+	SlashDistributionCounterKey = collections.NewPrefix(3)
+
+	UnbondingKey          = collections.NewPrefix(4)
+	UnbondingCounterKey   = collections.NewPrefix(5)
+	UnbondingQueueKey     = collections.NewPrefix(6)
+	UnbondingByAccountKey = collections.NewPrefix(11)
+
+	YieldRecordKey        = collections.NewPrefix(7)
+	YieldRecordCounterKey = collections.NewPrefix(8)
+	YieldReserveKey       = collections.NewPrefix(9)
+
+	DelegationKey        = collections.NewPrefix(10)
+	UndelegationQueueKey = collections.NewPrefix(12)
+	RewardRatioKey       = collections.NewPrefix(13)
+	DelegationSyncKey    = collections.NewPrefix(14)
 )
 
 const (
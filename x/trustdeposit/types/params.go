@@ -14,6 +14,11 @@ const (
 	DefaultTrustDepositRate            = "0.2" // 20%
 	DefaultWalletUserAgentRewardRate   = "0.2" // 20%
 	DefaultUserAgentRewardRate         = "0.2" // 20%
+
+	DefaultTrustDepositUnbondingPeriodDays = uint64(21)    // 21 days
+	DefaultYieldEpochBlocks                = uint64(14400) // ~1 day with 6s blocks
+	DefaultYieldFeeCollectorShare          = "0"           // disabled
+	DefaultYieldReserveReleaseRate         = "0"           // disabled
 )
 
 // ParamKeyTable the param key table for launch module
@@ -28,13 +33,21 @@ func NewParams(
 	trustDepositRate math.LegacyDec,
 	walletUserAgentRewardRate math.LegacyDec,
 	userAgentRewardRate math.LegacyDec,
+	trustDepositUnbondingPeriodDays uint64,
+	yieldEpochBlocks uint64,
+	yieldFeeCollectorShare math.LegacyDec,
+	yieldReserveReleaseRate math.LegacyDec,
 ) Params {
 	return Params{
-		TrustDepositReclaimBurnRate: trustDepositReclaimBurnRate,
-		TrustDepositShareValue:      trustDepositShareValue,
-		TrustDepositRate:            trustDepositRate,
-		WalletUserAgentRewardRate:   walletUserAgentRewardRate,
-		UserAgentRewardRate:         userAgentRewardRate,
+		TrustDepositReclaimBurnRate:     trustDepositReclaimBurnRate,
+		TrustDepositShareValue:          trustDepositShareValue,
+		TrustDepositRate:                trustDepositRate,
+		WalletUserAgentRewardRate:       walletUserAgentRewardRate,
+		UserAgentRewardRate:             userAgentRewardRate,
+		TrustDepositUnbondingPeriodDays: trustDepositUnbondingPeriodDays,
+		YieldEpochBlocks:                yieldEpochBlocks,
+		YieldFeeCollectorShare:          yieldFeeCollectorShare,
+		YieldReserveReleaseRate:         yieldReserveReleaseRate,
 	}
 }
 
@@ -45,6 +58,8 @@ func DefaultParams() Params {
 	TrustDepositRate, _ := math.LegacyNewDecFromStr(DefaultTrustDepositRate)
 	WalletUserAgentRewardRate, _ := math.LegacyNewDecFromStr(DefaultWalletUserAgentRewardRate)
 	UserAgentRewardRate, _ := math.LegacyNewDecFromStr(DefaultUserAgentRewardRate)
+	YieldFeeCollectorShare, _ := math.LegacyNewDecFromStr(DefaultYieldFeeCollectorShare)
+	YieldReserveReleaseRate, _ := math.LegacyNewDecFromStr(DefaultYieldReserveReleaseRate)
 
 	return NewParams(
 		TrustDepositReclaimBurnRate,
@@ -52,6 +67,10 @@ func DefaultParams() Params {
 		TrustDepositRate,
 		WalletUserAgentRewardRate,
 		UserAgentRewardRate,
+		DefaultTrustDepositUnbondingPeriodDays,
+		DefaultYieldEpochBlocks,
+		YieldFeeCollectorShare,
+		YieldReserveReleaseRate,
 	)
 }
 
@@ -83,6 +102,26 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 			&p.UserAgentRewardRate,
 			validateLegacyDec,
 		),
+		paramtypes.NewParamSetPair(
+			[]byte("TrustDepositUnbondingPeriodDays"),
+			&p.TrustDepositUnbondingPeriodDays,
+			validatePositiveUint64,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("YieldEpochBlocks"),
+			&p.YieldEpochBlocks,
+			validatePositiveUint64,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("YieldFeeCollectorShare"),
+			&p.YieldFeeCollectorShare,
+			validateLegacyDec,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("YieldReserveReleaseRate"),
+			&p.YieldReserveReleaseRate,
+			validateLegacyDec,
+		),
 	}
 }
 
@@ -103,6 +142,18 @@ func (p Params) Validate() error {
 	if err := validateLegacyDec(p.UserAgentRewardRate); err != nil {
 		return err
 	}
+	if err := validatePositiveUint64(p.TrustDepositUnbondingPeriodDays); err != nil {
+		return err
+	}
+	if err := validatePositiveUint64(p.YieldEpochBlocks); err != nil {
+		return err
+	}
+	if err := validateLegacyDec(p.YieldFeeCollectorShare); err != nil {
+		return err
+	}
+	if err := validateLegacyDec(p.YieldReserveReleaseRate); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -137,3 +188,17 @@ func validatePositiveLegacyDec(i interface{}) error {
 
 	return nil
 }
+
+// validatePositiveUint64 validates that the parameter is a positive integer
+func validatePositiveUint64(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("value must be positive: %d", v)
+	}
+
+	return nil
+}
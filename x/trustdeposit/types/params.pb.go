@@ -6,6 +6,7 @@ package types
 import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -32,6 +33,18 @@ type Params struct {
 	TrustDepositRate            cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=trust_deposit_rate,json=trustDepositRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"trust_deposit_rate" yaml:"trust_deposit_rate"`
 	WalletUserAgentRewardRate   cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=wallet_user_agent_reward_rate,json=walletUserAgentRewardRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"wallet_user_agent_reward_rate" yaml:"wallet_user_agent_reward_rate"`
 	UserAgentRewardRate         cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=user_agent_reward_rate,json=userAgentRewardRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"user_agent_reward_rate" yaml:"user_agent_reward_rate"`
+	// trust_deposit_unbonding_period_days is the number of days reclaimed
+	// trust deposits stay locked, and slashable, before they are paid out.
+	TrustDepositUnbondingPeriodDays uint64 `protobuf:"varint,6,opt,name=trust_deposit_unbonding_period_days,json=trustDepositUnbondingPeriodDays,proto3" json:"trust_deposit_unbonding_period_days,omitempty" yaml:"trust_deposit_unbonding_period_days"`
+	// yield_epoch_blocks is the number of blocks between two yield
+	// distributions to trust deposit holders.
+	YieldEpochBlocks uint64 `protobuf:"varint,7,opt,name=yield_epoch_blocks,json=yieldEpochBlocks,proto3" json:"yield_epoch_blocks,omitempty" yaml:"yield_epoch_blocks"`
+	// yield_fee_collector_share is the share of the fee collector balance,
+	// block inflation and transaction fees, distributed as yield each epoch.
+	YieldFeeCollectorShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=yield_fee_collector_share,json=yieldFeeCollectorShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"yield_fee_collector_share" yaml:"yield_fee_collector_share"`
+	// yield_reserve_release_rate is the share of the yield reserve distributed
+	// as yield each epoch.
+	YieldReserveReleaseRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=yield_reserve_release_rate,json=yieldReserveReleaseRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"yield_reserve_release_rate" yaml:"yield_reserve_release_rate"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -67,6 +80,20 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetTrustDepositUnbondingPeriodDays() uint64 {
+	if m != nil {
+		return m.TrustDepositUnbondingPeriodDays
+	}
+	return 0
+}
+
+func (m *Params) GetYieldEpochBlocks() uint64 {
+	if m != nil {
+		return m.YieldEpochBlocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "verana.td.v1.Params")
 }
@@ -74,35 +101,47 @@ func init() {
 func init() { proto.RegisterFile("verana/td/v1/params.proto", fileDescriptor_81f1920ecd6d86c2) }
 
 var fileDescriptor_81f1920ecd6d86c2 = []byte{
-	// 444 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xc1, 0xaa, 0xd3, 0x40,
-	0x14, 0x86, 0x33, 0xea, 0xbd, 0x60, 0x70, 0xa1, 0x51, 0x2e, 0x37, 0xf7, 0x7a, 0x93, 0x12, 0x54,
-	0x44, 0x30, 0x43, 0x71, 0x57, 0x10, 0xb4, 0x14, 0x5c, 0xa8, 0x20, 0x11, 0x15, 0xdc, 0x84, 0x93,
-	0x64, 0x48, 0x42, 0x93, 0x4c, 0x98, 0x99, 0xa4, 0x66, 0xeb, 0x52, 0x10, 0x04, 0x5f, 0xc0, 0x47,
-	0xf0, 0x25, 0x84, 0x2e, 0xbb, 0x14, 0x17, 0x45, 0xda, 0x85, 0xae, 0x7d, 0x02, 0xc9, 0x4c, 0x85,
-	0xb6, 0xb6, 0x9a, 0x4d, 0x39, 0xf4, 0x3f, 0xfc, 0xff, 0x77, 0x7e, 0x26, 0xba, 0x59, 0x13, 0x06,
-	0x05, 0x60, 0x11, 0xe1, 0xba, 0x8f, 0x4b, 0x60, 0x90, 0x73, 0xb7, 0x64, 0x54, 0x50, 0xe3, 0x92,
-	0x92, 0x5c, 0x11, 0xb9, 0x75, 0xff, 0xe4, 0x0a, 0xe4, 0x69, 0x41, 0xb1, 0xfc, 0x55, 0x0b, 0x27,
-	0xd7, 0x62, 0x1a, 0x53, 0x39, 0xe2, 0x76, 0x52, 0xff, 0x3a, 0x5f, 0x0e, 0xf4, 0xc3, 0x67, 0xd2,
-	0xc7, 0xf8, 0x88, 0x74, 0x5b, 0xb0, 0x8a, 0x0b, 0x3f, 0x22, 0x25, 0xe5, 0xa9, 0xf0, 0x19, 0x09,
-	0x33, 0x48, 0x73, 0x3f, 0xa8, 0x58, 0xe1, 0x33, 0x10, 0xe4, 0x18, 0xf5, 0xd0, 0xed, 0x8b, 0xc3,
-	0xa7, 0xd3, 0xb9, 0xad, 0x7d, 0x9b, 0xdb, 0xa7, 0x21, 0xe5, 0x39, 0xe5, 0x3c, 0x1a, 0xbb, 0x29,
-	0xc5, 0x39, 0x88, 0xc4, 0x7d, 0x42, 0x62, 0x08, 0x9b, 0x11, 0x09, 0x7f, 0xcd, 0xed, 0x5b, 0x0d,
-	0xe4, 0xd9, 0xc0, 0xf9, 0x8f, 0xa7, 0xe3, 0x9d, 0xca, 0x8d, 0x91, 0x5a, 0xf0, 0x94, 0x3e, 0xac,
-	0x58, 0xe1, 0x81, 0x20, 0xc6, 0x5b, 0xa4, 0x9b, 0x9b, 0x0e, 0x3c, 0x01, 0x46, 0xfc, 0x1a, 0xb2,
-	0x8a, 0x1c, 0x9f, 0x93, 0x3c, 0x8f, 0xba, 0xf1, 0xf4, 0x76, 0xf1, 0xac, 0xb9, 0x39, 0xde, 0xd1,
-	0x3a, 0xc9, 0xf3, 0x56, 0x79, 0xd9, 0x0a, 0x46, 0xa1, 0x1b, 0x5b, 0x57, 0xb4, 0x65, 0x9c, 0x97,
-	0xe1, 0x0f, 0xba, 0x85, 0x9b, 0x3b, 0xcb, 0x90, 0xf7, 0x5f, 0xde, 0xb8, 0xbf, 0x3d, 0xfa, 0x3d,
-	0xd2, 0xcf, 0x26, 0x90, 0x65, 0x44, 0xf8, 0x15, 0x27, 0xcc, 0x87, 0x98, 0x14, 0x6d, 0x75, 0x13,
-	0x60, 0x91, 0xca, 0xbe, 0x20, 0xb3, 0x1f, 0x77, 0xcb, 0xbe, 0xa1, 0xb2, 0xff, 0xe9, 0xe8, 0x78,
-	0xa6, 0xd2, 0x5f, 0x70, 0xc2, 0x1e, 0xb6, 0xaa, 0x27, 0x45, 0xc9, 0xd3, 0xe8, 0x47, 0x7b, 0x38,
-	0x0e, 0x24, 0xc7, 0xa8, 0x1b, 0xc7, 0x99, 0xe2, 0xd8, 0x07, 0x70, 0xb5, 0xfa, 0x3b, 0x7a, 0x70,
-	0xf3, 0xe7, 0x27, 0x1b, 0xbd, 0xfb, 0xf1, 0xf9, 0xce, 0xf5, 0xd5, 0xdb, 0x7f, 0x83, 0x65, 0x5d,
-	0xab, 0x0a, 0xb1, 0x7a, 0xbc, 0xc3, 0x57, 0xd3, 0x85, 0x85, 0x66, 0x0b, 0x0b, 0x7d, 0x5f, 0x58,
-	0xe8, 0xc3, 0xd2, 0xd2, 0x66, 0x4b, 0x4b, 0xfb, 0xba, 0xb4, 0xb4, 0xd7, 0xf7, 0xe3, 0x54, 0x24,
-	0x55, 0xe0, 0x86, 0x34, 0xc7, 0xca, 0xe2, 0x6e, 0x06, 0x01, 0xff, 0x33, 0x07, 0x19, 0x0d, 0xc7,
-	0x61, 0x02, 0x69, 0xb1, 0xed, 0x2c, 0x9a, 0x92, 0xf0, 0xe0, 0x50, 0x7e, 0x27, 0xf7, 0x7e, 0x07,
-	0x00, 0x00, 0xff, 0xff, 0x59, 0x87, 0x69, 0xe3, 0x7b, 0x03, 0x00, 0x00,
+	// 627 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcf, 0x6b, 0xd4, 0x40,
+	0x14, 0xde, 0x68, 0xad, 0x36, 0x78, 0xa8, 0x51, 0xea, 0xa6, 0xb5, 0xc9, 0x1a, 0x7f, 0x50, 0x0a,
+	0x4d, 0x28, 0xde, 0x0a, 0x82, 0xae, 0xab, 0x1e, 0xaa, 0x50, 0x22, 0x5b, 0xc1, 0xcb, 0x30, 0x49,
+	0x9e, 0xd9, 0xd0, 0x24, 0x13, 0x66, 0x26, 0x5b, 0x03, 0x9e, 0x3c, 0x0a, 0x42, 0xc1, 0x7f, 0xc0,
+	0x3f, 0xc1, 0x83, 0x17, 0xff, 0x83, 0x1e, 0x8b, 0x27, 0xf1, 0xb0, 0xc8, 0xee, 0x41, 0xcf, 0xfd,
+	0x0b, 0x24, 0x33, 0xbb, 0xb8, 0xbb, 0x66, 0x75, 0xbd, 0x2c, 0xc9, 0xfb, 0xbe, 0xfd, 0xbe, 0xef,
+	0xbd, 0x37, 0x19, 0x55, 0xef, 0x02, 0xc5, 0x29, 0x76, 0x78, 0xe0, 0x74, 0xb7, 0x9d, 0x0c, 0x53,
+	0x9c, 0x30, 0x3b, 0xa3, 0x84, 0x13, 0xed, 0xa2, 0x84, 0x6c, 0x1e, 0xd8, 0xdd, 0xed, 0xd5, 0x4b,
+	0x38, 0x89, 0x52, 0xe2, 0x88, 0x5f, 0x49, 0x58, 0xd5, 0x7d, 0xc2, 0x12, 0xc2, 0x90, 0x78, 0x73,
+	0xe4, 0xcb, 0x10, 0xba, 0x12, 0x92, 0x90, 0xc8, 0x7a, 0xf9, 0x24, 0xab, 0xd6, 0xe7, 0x25, 0x75,
+	0x71, 0x4f, 0x58, 0x68, 0xef, 0x15, 0xd5, 0xe4, 0x34, 0x67, 0x1c, 0x05, 0x90, 0x11, 0x16, 0x71,
+	0x44, 0xc1, 0x8f, 0x71, 0x94, 0x20, 0x2f, 0xa7, 0x29, 0xa2, 0x98, 0x43, 0x5d, 0x69, 0x28, 0x1b,
+	0x4b, 0xcd, 0xa7, 0xc7, 0x3d, 0xb3, 0xf6, 0xad, 0x67, 0xae, 0x49, 0x03, 0x16, 0x1c, 0xd8, 0x11,
+	0x71, 0x12, 0xcc, 0x3b, 0xf6, 0x13, 0x08, 0xb1, 0x5f, 0xb4, 0xc0, 0x3f, 0xed, 0x99, 0xb7, 0x0b,
+	0x9c, 0xc4, 0x3b, 0xd6, 0x3f, 0x34, 0x2d, 0x77, 0x4d, 0x30, 0x5a, 0x92, 0xe0, 0x4a, 0xbc, 0x99,
+	0xd3, 0xd4, 0xc5, 0x1c, 0xb4, 0x37, 0x8a, 0xaa, 0x4f, 0x2a, 0xb0, 0x0e, 0xa6, 0x80, 0xba, 0x38,
+	0xce, 0xa1, 0x7e, 0x46, 0xe4, 0x79, 0x3c, 0x5f, 0x9e, 0x46, 0x55, 0x9e, 0x31, 0x35, 0xcb, 0x5d,
+	0x19, 0x4f, 0xf2, 0xac, 0x44, 0xf6, 0x4b, 0x40, 0x4b, 0x55, 0x6d, 0xaa, 0x8b, 0x72, 0x18, 0x67,
+	0x85, 0xf9, 0xbd, 0xf9, 0xcc, 0xf5, 0xca, 0x61, 0x88, 0xfe, 0x97, 0x27, 0xfa, 0x2f, 0x9b, 0x7e,
+	0xa7, 0xa8, 0xeb, 0x87, 0x38, 0x8e, 0x81, 0xa3, 0x9c, 0x01, 0x45, 0x38, 0x84, 0xb4, 0x1c, 0xdd,
+	0x21, 0xa6, 0x81, 0xf4, 0x5e, 0x10, 0xde, 0xbb, 0xf3, 0x79, 0xdf, 0x94, 0xde, 0x7f, 0x55, 0xb4,
+	0x5c, 0x5d, 0xe2, 0x6d, 0x06, 0xf4, 0x7e, 0x89, 0xba, 0x02, 0x14, 0x79, 0x0a, 0x75, 0x65, 0x46,
+	0x8e, 0x73, 0x22, 0x47, 0x6b, 0xbe, 0x1c, 0xeb, 0x32, 0xc7, 0xac, 0x00, 0x97, 0xf3, 0x0a, 0xeb,
+	0xd7, 0xea, 0x8d, 0xc9, 0x99, 0xe5, 0xa9, 0x47, 0xd2, 0x20, 0x4a, 0x43, 0x94, 0x01, 0x8d, 0x48,
+	0x80, 0x02, 0x5c, 0xb0, 0xfa, 0x62, 0x43, 0xd9, 0x58, 0x68, 0xda, 0xa7, 0x3d, 0x73, 0xb3, 0x6a,
+	0xd0, 0x95, 0x7f, 0xb2, 0x5c, 0x73, 0x7c, 0xf2, 0xed, 0x11, 0x67, 0x4f, 0x50, 0x5a, 0xb8, 0x60,
+	0xda, 0xae, 0xaa, 0x15, 0x11, 0xc4, 0x01, 0x82, 0x8c, 0xf8, 0x1d, 0xe4, 0xc5, 0xc4, 0x3f, 0x60,
+	0xf5, 0xf3, 0xc2, 0x6c, 0xfd, 0xf7, 0x56, 0xff, 0xe4, 0x58, 0xee, 0xb2, 0x28, 0x3e, 0x2c, 0x6b,
+	0x4d, 0x51, 0xd2, 0x8e, 0x14, 0x55, 0x97, 0xcc, 0x97, 0x00, 0xc8, 0x27, 0x71, 0x0c, 0x3e, 0x27,
+	0x54, 0x1e, 0xc1, 0xfa, 0x05, 0x31, 0xc9, 0xf6, 0x7f, 0x1d, 0xe5, 0x99, 0x6a, 0xd6, 0x97, 0x4f,
+	0x5b, 0xea, 0xf0, 0xf3, 0x6f, 0x81, 0xef, 0xae, 0x08, 0xe6, 0x23, 0x80, 0x07, 0x23, 0x9e, 0x38,
+	0xdd, 0xe5, 0x37, 0xbf, 0x2a, 0x45, 0x28, 0x30, 0xa0, 0x5d, 0x40, 0x14, 0x62, 0xc0, 0x0c, 0xe4,
+	0x76, 0x97, 0x44, 0xa6, 0xfd, 0xf9, 0x32, 0x5d, 0x1f, 0xcf, 0x54, 0x25, 0x37, 0x1d, 0xea, 0xaa,
+	0xa0, 0xba, 0x92, 0xe9, 0x4a, 0x62, 0xb9, 0xf3, 0x9d, 0x5b, 0x3f, 0x3f, 0x98, 0xca, 0xdb, 0x1f,
+	0x1f, 0x37, 0xaf, 0x0d, 0xaf, 0xc2, 0x57, 0x8e, 0x58, 0xd4, 0x70, 0x9b, 0x8e, 0xbc, 0xb0, 0x9a,
+	0xcf, 0x8f, 0xfb, 0x86, 0x72, 0xd2, 0x37, 0x94, 0xef, 0x7d, 0x43, 0x39, 0x1a, 0x18, 0xb5, 0x93,
+	0x81, 0x51, 0xfb, 0x3a, 0x30, 0x6a, 0x2f, 0xee, 0x86, 0x11, 0xef, 0xe4, 0x9e, 0xed, 0x93, 0xc4,
+	0x91, 0x12, 0x5b, 0x31, 0xf6, 0xd8, 0xe8, 0x59, 0xac, 0xc8, 0xef, 0xe0, 0x28, 0x9d, 0x56, 0xe6,
+	0x45, 0x06, 0xcc, 0x5b, 0x14, 0x77, 0xe3, 0x9d, 0x5f, 0x03, 0x00, 0xab, 0xe3, 0x61, 0xda, 0x8a,
+	0x05, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -139,6 +178,18 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.UserAgentRewardRate.Equal(that1.UserAgentRewardRate) {
 		return false
 	}
+	if this.TrustDepositUnbondingPeriodDays != that1.TrustDepositUnbondingPeriodDays {
+		return false
+	}
+	if this.YieldEpochBlocks != that1.YieldEpochBlocks {
+		return false
+	}
+	if !this.YieldFeeCollectorShare.Equal(that1.YieldFeeCollectorShare) {
+		return false
+	}
+	if !this.YieldReserveReleaseRate.Equal(that1.YieldReserveReleaseRate) {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -161,6 +212,36 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.YieldReserveReleaseRate.Size()
+		i -= size
+		if _, err := m.YieldReserveReleaseRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.YieldFeeCollectorShare.Size()
+		i -= size
+		if _, err := m.YieldFeeCollectorShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.YieldEpochBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.YieldEpochBlocks))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.TrustDepositUnbondingPeriodDays != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TrustDepositUnbondingPeriodDays))
+		i--
+		dAtA[i] = 0x30
+	}
 	{
 		size := m.UserAgentRewardRate.Size()
 		i -= size
@@ -241,6 +322,16 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.UserAgentRewardRate.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.TrustDepositUnbondingPeriodDays != 0 {
+		n += 1 + sovParams(uint64(m.TrustDepositUnbondingPeriodDays))
+	}
+	if m.YieldEpochBlocks != 0 {
+		n += 1 + sovParams(uint64(m.YieldEpochBlocks))
+	}
+	l = m.YieldFeeCollectorShare.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.YieldReserveReleaseRate.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -449,6 +540,112 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustDepositUnbondingPeriodDays", wireType)
+			}
+			m.TrustDepositUnbondingPeriodDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrustDepositUnbondingPeriodDays |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YieldEpochBlocks", wireType)
+			}
+			m.YieldEpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.YieldEpochBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YieldFeeCollectorShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.YieldFeeCollectorShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YieldReserveReleaseRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.YieldReserveReleaseRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -17,12 +17,13 @@ func init() {
 	govtypes.RegisterProposalType(ProposalSlashTrustDeposit)
 }
 
-func NewSlashTrustDepositProposal(title, description, account string, amount math.Int) *SlashTrustDepositProposal {
+func NewSlashTrustDepositProposal(title, description, account string, amount math.Int, distribution []SlashShare) *SlashTrustDepositProposal {
 	return &SlashTrustDepositProposal{
-		Title:       title,
-		Description: description,
-		Account:     account,
-		Amount:      amount,
+		Title:        title,
+		Description:  description,
+		Account:      account,
+		Amount:       amount,
+		Distribution: distribution,
 	}
 }
 
@@ -53,5 +54,9 @@ func (p *SlashTrustDepositProposal) ValidateBasic() error {
 		return sdkerrors.ErrInvalidRequest.Wrap("amount must be positive")
 	}
 
+	if err := ValidateSlashShares(p.Distribution); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
 	return nil
 }
@@ -5,25 +5,31 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -204,347 +210,3473 @@ func (m *QueryGetTrustDepositResponse) GetTrustDeposit() TrustDeposit {
 	return TrustDeposit{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "verana.td.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "verana.td.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryGetTrustDepositRequest)(nil), "verana.td.v1.QueryGetTrustDepositRequest")
-	proto.RegisterType((*QueryGetTrustDepositResponse)(nil), "verana.td.v1.QueryGetTrustDepositResponse")
+type QueryListSlashDistributionsRequest struct {
+	Account         string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Recipient       string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	ResponseMaxSize uint32 `protobuf:"varint,3,opt,name=response_max_size,json=responseMaxSize,proto3" json:"response_max_size,omitempty"`
 }
 
-func init() { proto.RegisterFile("verana/td/v1/query.proto", fileDescriptor_1e3f9fbb2238b25c) }
-
-var fileDescriptor_1e3f9fbb2238b25c = []byte{
-	// 443 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x8a, 0xd4, 0x40,
-	0x10, 0x86, 0x93, 0x45, 0x47, 0xb6, 0x5d, 0x11, 0xdb, 0x20, 0x63, 0x76, 0x8c, 0x6b, 0xbc, 0xe8,
-	0x80, 0x69, 0xb2, 0x1e, 0xf6, 0xe4, 0x65, 0x50, 0xbc, 0x89, 0x06, 0x41, 0xf0, 0x22, 0x9d, 0x4c,
-	0x93, 0x0d, 0x3b, 0xe9, 0xca, 0xa6, 0x2b, 0xc1, 0x45, 0xbc, 0x88, 0x57, 0x41, 0xf0, 0x25, 0x3c,
-	0xfa, 0x18, 0x7b, 0x5c, 0xf0, 0xe2, 0x49, 0x64, 0x46, 0xf0, 0x35, 0x24, 0xdd, 0x3d, 0x30, 0xd1,
-	0x20, 0x5e, 0x42, 0xa5, 0xea, 0xef, 0xbf, 0xbe, 0xaa, 0x6e, 0x32, 0x6e, 0x45, 0xcd, 0x25, 0x67,
-	0x38, 0x67, 0x6d, 0xcc, 0x8e, 0x1b, 0x51, 0x9f, 0x44, 0x55, 0x0d, 0x08, 0x74, 0xc7, 0x54, 0x22,
-	0x9c, 0x47, 0x6d, 0xec, 0x5f, 0xe1, 0x65, 0x21, 0x81, 0xe9, 0xaf, 0x11, 0xf8, 0x5e, 0x0e, 0x39,
-	0xe8, 0x90, 0x75, 0x91, 0xcd, 0x4e, 0x72, 0x80, 0x7c, 0x21, 0x18, 0xaf, 0x0a, 0xc6, 0xa5, 0x04,
-	0xe4, 0x58, 0x80, 0x54, 0xb6, 0x3a, 0xcd, 0x40, 0x95, 0xa0, 0x58, 0xca, 0x95, 0x30, 0xdd, 0x58,
-	0x1b, 0xa7, 0x02, 0x79, 0xcc, 0x2a, 0x9e, 0x17, 0x52, 0x8b, 0xad, 0xf6, 0x7a, 0x0f, 0xad, 0xe2,
-	0x35, 0x2f, 0xd7, 0x36, 0x7d, 0x6a, 0x3c, 0xa9, 0x84, 0xad, 0x84, 0x1e, 0xa1, 0xcf, 0x3a, 0xdb,
-	0xa7, 0x5a, 0x9e, 0x88, 0xe3, 0x46, 0x28, 0x0c, 0x9f, 0x90, 0xab, 0xbd, 0xac, 0xaa, 0x40, 0x2a,
-	0x41, 0x0f, 0xc8, 0xc8, 0xd8, 0x8e, 0xdd, 0x3d, 0xf7, 0xce, 0xc5, 0x7d, 0x2f, 0xda, 0x9c, 0x39,
-	0x32, 0xea, 0xd9, 0xf6, 0xe9, 0xf7, 0x9b, 0xce, 0xe7, 0x5f, 0x5f, 0xa6, 0x6e, 0x62, 0xe5, 0xe1,
-	0x01, 0xd9, 0xd5, 0x7e, 0x8f, 0x05, 0x3e, 0xaf, 0x1b, 0x85, 0x0f, 0x45, 0x05, 0xaa, 0x40, 0xdb,
-	0x8e, 0x8e, 0xc9, 0x05, 0x9e, 0x65, 0xd0, 0x48, 0xd4, 0xc6, 0xdb, 0xc9, 0xfa, 0x37, 0x14, 0x64,
-	0x32, 0x7c, 0xd0, 0x12, 0x3d, 0x22, 0x97, 0xb0, 0xcb, 0xbf, 0x9a, 0x9b, 0x82, 0x05, 0xf3, 0xfb,
-	0x60, 0x9b, 0x47, 0x67, 0xe7, 0x3a, 0xbc, 0x64, 0x07, 0x37, 0x72, 0xfb, 0xef, 0xb7, 0xc8, 0x79,
-	0xdd, 0x87, 0x1e, 0x91, 0x91, 0x19, 0x83, 0xee, 0xf5, 0x3d, 0xfe, 0xde, 0x92, 0x7f, 0xeb, 0x1f,
-	0x0a, 0xc3, 0x17, 0x4e, 0xde, 0x7d, 0xfd, 0xf9, 0x69, 0xeb, 0x1a, 0xf5, 0xd8, 0xc0, 0xe5, 0xd0,
-	0x0f, 0x2e, 0xb9, 0xfc, 0xc7, 0x64, 0xf4, 0xee, 0x80, 0xe9, 0xf0, 0xda, 0xfc, 0xe9, 0xff, 0x48,
-	0x2d, 0xc8, 0x6d, 0x0d, 0x72, 0x83, 0xee, 0xf6, 0x41, 0x72, 0x81, 0xec, 0x8d, 0x5d, 0xf6, 0xdb,
-	0xd9, 0x8b, 0xd3, 0x65, 0xe0, 0x9e, 0x2d, 0x03, 0xf7, 0xc7, 0x32, 0x70, 0x3f, 0xae, 0x02, 0xe7,
-	0x6c, 0x15, 0x38, 0xdf, 0x56, 0x81, 0xf3, 0xf2, 0x41, 0x5e, 0xe0, 0x61, 0x93, 0x46, 0x19, 0x94,
-	0xd6, 0xe0, 0xde, 0x82, 0xa7, 0x6a, 0x1d, 0xa7, 0x0b, 0xc8, 0x8e, 0xb2, 0x43, 0x5e, 0x48, 0xf6,
-	0x9a, 0xe9, 0xcd, 0xda, 0xfb, 0x30, 0x6f, 0x2d, 0x1d, 0xe9, 0xc7, 0x76, 0xff, 0x77, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x18, 0x5c, 0xf5, 0xc6, 0x3e, 0x03, 0x00, 0x00,
+func (m *QueryListSlashDistributionsRequest) Reset()         { *m = QueryListSlashDistributionsRequest{} }
+func (m *QueryListSlashDistributionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListSlashDistributionsRequest) ProtoMessage()    {}
+func (*QueryListSlashDistributionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3f9fbb2238b25c, []int{4}
+}
+func (m *QueryListSlashDistributionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListSlashDistributionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListSlashDistributionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListSlashDistributionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListSlashDistributionsRequest.Merge(m, src)
+}
+func (m *QueryListSlashDistributionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListSlashDistributionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListSlashDistributionsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QueryListSlashDistributionsRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryListSlashDistributionsRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	GetTrustDeposit(ctx context.Context, in *QueryGetTrustDepositRequest, opts ...grpc.CallOption) (*QueryGetTrustDepositResponse, error)
+func (m *QueryListSlashDistributionsRequest) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryListSlashDistributionsRequest) GetResponseMaxSize() uint32 {
+	if m != nil {
+		return m.ResponseMaxSize
+	}
+	return 0
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+type QueryListSlashDistributionsResponse struct {
+	SlashDistributions []SlashDistribution `protobuf:"bytes,1,rep,name=slash_distributions,json=slashDistributions,proto3" json:"slash_distributions"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/verana.td.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryListSlashDistributionsResponse) Reset()         { *m = QueryListSlashDistributionsResponse{} }
+func (m *QueryListSlashDistributionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListSlashDistributionsResponse) ProtoMessage()    {}
+func (*QueryListSlashDistributionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3f9fbb2238b25c, []int{5}
+}
+func (m *QueryListSlashDistributionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListSlashDistributionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListSlashDistributionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryListSlashDistributionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListSlashDistributionsResponse.Merge(m, src)
+}
+func (m *QueryListSlashDistributionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListSlashDistributionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListSlashDistributionsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetTrustDeposit(ctx context.Context, in *QueryGetTrustDepositRequest, opts ...grpc.CallOption) (*QueryGetTrustDepositResponse, error) {
-	out := new(QueryGetTrustDepositResponse)
-	err := c.cc.Invoke(ctx, "/verana.td.v1.Query/GetTrustDeposit", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryListSlashDistributionsResponse proto.InternalMessageInfo
+
+func (m *QueryListSlashDistributionsResponse) GetSlashDistributions() []SlashDistribution {
+	if m != nil {
+		return m.SlashDistributions
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	GetTrustDeposit(context.Context, *QueryGetTrustDepositRequest) (*QueryGetTrustDepositResponse, error)
+type QueryListUnbondingsRequest struct {
+	Account         string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	ResponseMaxSize uint32 `protobuf:"varint,2,opt,name=response_max_size,json=responseMaxSize,proto3" json:"response_max_size,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryListUnbondingsRequest) Reset()         { *m = QueryListUnbondingsRequest{} }
+func (m *QueryListUnbondingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListUnbondingsRequest) ProtoMessage()    {}
+func (*QueryListUnbondingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3f9fbb2238b25c, []int{6}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryListUnbondingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetTrustDeposit(ctx context.Context, req *QueryGetTrustDepositRequest) (*QueryGetTrustDepositResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTrustDeposit not implemented")
+func (m *QueryListUnbondingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListUnbondingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryListUnbondingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListUnbondingsRequest.Merge(m, src)
+}
+func (m *QueryListUnbondingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListUnbondingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListUnbondingsRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.td.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryListUnbondingsRequest proto.InternalMessageInfo
+
+func (m *QueryListUnbondingsRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetTrustDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetTrustDepositRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetTrustDeposit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/verana.td.v1.Query/GetTrustDeposit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetTrustDeposit(ctx, req.(*QueryGetTrustDepositRequest))
+func (m *QueryListUnbondingsRequest) GetResponseMaxSize() uint32 {
+	if m != nil {
+		return m.ResponseMaxSize
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "verana.td.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "GetTrustDeposit",
-			Handler:    _Query_GetTrustDeposit_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "verana/td/v1/query.proto",
+type QueryListUnbondingsResponse struct {
+	Unbondings []TrustDepositUnbonding `protobuf:"bytes,1,rep,name=unbondings,proto3" json:"unbondings"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryListUnbondingsResponse) Reset()         { *m = QueryListUnbondingsResponse{} }
+func (m *QueryListUnbondingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListUnbondingsResponse) ProtoMessage()    {}
+func (*QueryListUnbondingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3f9fbb2238b25c, []int{7}
+}
+func (m *QueryListUnbondingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListUnbondingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListUnbondingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryListUnbondingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListUnbondingsResponse.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryListUnbondingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListUnbondingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListUnbondingsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryListUnbondingsResponse proto.InternalMessageInfo
+
+func (m *QueryListUnbondingsResponse) GetUnbondings() []TrustDepositUnbonding {
+	if m != nil {
+		return m.Unbondings
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryListYieldRecordsRequest struct {
+	ResponseMaxSize uint32 `protobuf:"varint,1,opt,name=response_max_size,json=responseMaxSize,proto3" json:"response_max_size,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryListYieldRecordsRequest) Reset()         { *m = QueryListYieldRecordsRequest{} }
+func (m *QueryListYieldRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListYieldRecordsRequest) ProtoMessage()    {}
+func (*QueryListYieldRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3f9fbb2238b25c, []int{8}
+}
+func (m *QueryListYieldRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListYieldRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListYieldRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryListYieldRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListYieldRecordsRequest.Merge(m, src)
+}
+func (m *QueryListYieldRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListYieldRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListYieldRecordsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetTrustDepositRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryListYieldRecordsRequest proto.InternalMessageInfo
+
+func (m *QueryListYieldRecordsRequest) GetResponseMaxSize() uint32 {
+	if m != nil {
+		return m.ResponseMaxSize
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryGetTrustDepositRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryListYieldRecordsResponse struct {
+	YieldRecords []YieldRecord `protobuf:"bytes,1,rep,name=yield_records,json=yieldRecords,proto3" json:"yield_records"`
 }
 
-func (m *QueryGetTrustDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryListYieldRecordsResponse) Reset()         { *m = QueryListYieldRecordsResponse{} }
+func (m *QueryListYieldRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListYieldRecordsResponse) ProtoMessage()    {}
+func (*QueryListYieldRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3f9fbb2238b25c, []int{9}
 }
-
-func (m *QueryGetTrustDepositResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryListYieldRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListYieldRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListYieldRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryListYieldRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListYieldRecordsResponse.Merge(m, src)
+}
+func (m *QueryListYieldRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListYieldRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListYieldRecordsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetTrustDepositResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryListYieldRecordsResponse proto.InternalMessageInfo
+
+func (m *QueryListYieldRecordsResponse) GetYieldRecords() []YieldRecord {
+	if m != nil {
+		return m.YieldRecords
+	}
+	return nil
 }
 
-func (m *QueryGetTrustDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.TrustDeposit.MarshalToSizedBuffer(dAtA[:i])
+type QueryGetYieldReserveRequest struct {
+}
+
+func (m *QueryGetYieldReserveRequest) Reset()         { *m = QueryGetYieldReserveRequest{} }
+func (m *QueryGetYieldReserveRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetYieldReserveRequest) ProtoMessage()    {}
+func (*QueryGetYieldReserveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3f9fbb2238b25c, []int{10}
+}
+func (m *QueryGetYieldReserveRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetYieldReserveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetYieldReserveRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetYieldReserveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetYieldReserveRequest.Merge(m, src)
+}
+func (m *QueryGetYieldReserveRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetYieldReserveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetYieldReserveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetYieldReserveRequest proto.InternalMessageInfo
+
+type QueryGetYieldReserveResponse struct {
+	YieldReserve uint64 `protobuf:"varint,1,opt,name=yield_reserve,json=yieldReserve,proto3" json:"yield_reserve,omitempty"`
+}
+
+func (m *QueryGetYieldReserveResponse) Reset()         { *m = QueryGetYieldReserveResponse{} }
+func (m *QueryGetYieldReserveResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetYieldReserveResponse) ProtoMessage()    {}
+func (*QueryGetYieldReserveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3f9fbb2238b25c, []int{11}
+}
+func (m *QueryGetYieldReserveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetYieldReserveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetYieldReserveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetYieldReserveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetYieldReserveResponse.Merge(m, src)
+}
+func (m *QueryGetYieldReserveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetYieldReserveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetYieldReserveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetYieldReserveResponse proto.InternalMessageInfo
+
+func (m *QueryGetYieldReserveResponse) GetYieldReserve() uint64 {
+	if m != nil {
+		return m.YieldReserve
+	}
+	return 0
+}
+
+type QuerySimulateReclaimRequest struct {
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Amount  uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *QuerySimulateReclaimRequest) Reset()         { *m = QuerySimulateReclaimRequest{} }
+func (m *QuerySimulateReclaimRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateReclaimRequest) ProtoMessage()    {}
+func (*QuerySimulateReclaimRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3f9fbb2238b25c, []int{12}
+}
+func (m *QuerySimulateReclaimRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateReclaimRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateReclaimRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateReclaimRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateReclaimRequest.Merge(m, src)
+}
+func (m *QuerySimulateReclaimRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateReclaimRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateReclaimRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateReclaimRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateReclaimRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *QuerySimulateReclaimRequest) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type QuerySimulateReclaimResponse struct {
+	// allowed is false when the reclaim would be rejected, see reason
+	Allowed bool   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Reason  string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// burned_amount is burned at trust_deposit_reclaim_burn_rate
+	BurnedAmount uint64 `protobuf:"varint,3,opt,name=burned_amount,json=burnedAmount,proto3" json:"burned_amount,omitempty"`
+	// claimed_amount is paid out to the account at completion_time
+	ClaimedAmount  uint64     `protobuf:"varint,4,opt,name=claimed_amount,json=claimedAmount,proto3" json:"claimed_amount,omitempty"`
+	CompletionTime *time.Time `protobuf:"bytes,5,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time,omitempty"`
+	// post-state of the trust deposit
+	Share     uint64 `protobuf:"varint,6,opt,name=share,proto3" json:"share,omitempty"`
+	Amount    uint64 `protobuf:"varint,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	Claimable uint64 `protobuf:"varint,8,opt,name=claimable,proto3" json:"claimable,omitempty"`
+}
+
+func (m *QuerySimulateReclaimResponse) Reset()         { *m = QuerySimulateReclaimResponse{} }
+func (m *QuerySimulateReclaimResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateReclaimResponse) ProtoMessage()    {}
+func (*QuerySimulateReclaimResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3f9fbb2238b25c, []int{13}
+}
+func (m *QuerySimulateReclaimResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateReclaimResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateReclaimResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateReclaimResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateReclaimResponse.Merge(m, src)
+}
+func (m *QuerySimulateReclaimResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateReclaimResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateReclaimResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateReclaimResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateReclaimResponse) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
+	}
+	return false
+}
+
+func (m *QuerySimulateReclaimResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *QuerySimulateReclaimResponse) GetBurnedAmount() uint64 {
+	if m != nil {
+		return m.BurnedAmount
+	}
+	return 0
+}
+
+func (m *QuerySimulateReclaimResponse) GetClaimedAmount() uint64 {
+	if m != nil {
+		return m.ClaimedAmount
+	}
+	return 0
+}
+
+func (m *QuerySimulateReclaimResponse) GetCompletionTime() *time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return nil
+}
+
+func (m *QuerySimulateReclaimResponse) GetShare() uint64 {
+	if m != nil {
+		return m.Share
+	}
+	return 0
+}
+
+func (m *QuerySimulateReclaimResponse) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *QuerySimulateReclaimResponse) GetClaimable() uint64 {
+	if m != nil {
+		return m.Claimable
+	}
+	return 0
+}
+
+type QueryGetClaimableYieldRequest struct {
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (m *QueryGetClaimableYieldRequest) Reset()         { *m = QueryGetClaimableYieldRequest{} }
+func (m *QueryGetClaimableYieldRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetClaimableYieldRequest) ProtoMessage()    {}
+func (*QueryGetClaimableYieldRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3f9fbb2238b25c, []int{14}
+}
+func (m *QueryGetClaimableYieldRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetClaimableYieldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetClaimableYieldRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetClaimableYieldRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetClaimableYieldRequest.Merge(m, src)
+}
+func (m *QueryGetClaimableYieldRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetClaimableYieldRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetClaimableYieldRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetClaimableYieldRequest proto.InternalMessageInfo
+
+func (m *QueryGetClaimableYieldRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+type QueryGetClaimableYieldResponse struct {
+	// allowed is false when the yield reclaim would be rejected, see reason
+	Allowed bool   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Reason  string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// claimable_yield is paid out to the account
+	ClaimableYield uint64                      `protobuf:"varint,3,opt,name=claimable_yield,json=claimableYield,proto3" json:"claimable_yield,omitempty"`
+	ShareValue     cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=share_value,json=shareValue,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"share_value"`
+	// share is the post-state shares of the trust deposit
+	Share uint64 `protobuf:"varint,5,opt,name=share,proto3" json:"share,omitempty"`
+}
+
+func (m *QueryGetClaimableYieldResponse) Reset()         { *m = QueryGetClaimableYieldResponse{} }
+func (m *QueryGetClaimableYieldResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetClaimableYieldResponse) ProtoMessage()    {}
+func (*QueryGetClaimableYieldResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3f9fbb2238b25c, []int{15}
+}
+func (m *QueryGetClaimableYieldResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetClaimableYieldResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetClaimableYieldResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetClaimableYieldResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetClaimableYieldResponse.Merge(m, src)
+}
+func (m *QueryGetClaimableYieldResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetClaimableYieldResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetClaimableYieldResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetClaimableYieldResponse proto.InternalMessageInfo
+
+func (m *QueryGetClaimableYieldResponse) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
+	}
+	return false
+}
+
+func (m *QueryGetClaimableYieldResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *QueryGetClaimableYieldResponse) GetClaimableYield() uint64 {
+	if m != nil {
+		return m.ClaimableYield
+	}
+	return 0
+}
+
+func (m *QueryGetClaimableYieldResponse) GetShare() uint64 {
+	if m != nil {
+		return m.Share
+	}
+	return 0
+}
+
+type QueryGetTrustDepositDelegationRequest struct {
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (m *QueryGetTrustDepositDelegationRequest) Reset()         { *m = QueryGetTrustDepositDelegationRequest{} }
+func (m *QueryGetTrustDepositDelegationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetTrustDepositDelegationRequest) ProtoMessage()    {}
+func (*QueryGetTrustDepositDelegationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3f9fbb2238b25c, []int{16}
+}
+func (m *QueryGetTrustDepositDelegationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetTrustDepositDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetTrustDepositDelegationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetTrustDepositDelegationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetTrustDepositDelegationRequest.Merge(m, src)
+}
+func (m *QueryGetTrustDepositDelegationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetTrustDepositDelegationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetTrustDepositDelegationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetTrustDepositDelegationRequest proto.InternalMessageInfo
+
+func (m *QueryGetTrustDepositDelegationRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+type QueryGetTrustDepositDelegationResponse struct {
+	Delegation TrustDepositDelegation `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation"`
+}
+
+func (m *QueryGetTrustDepositDelegationResponse) Reset() {
+	*m = QueryGetTrustDepositDelegationResponse{}
+}
+func (m *QueryGetTrustDepositDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetTrustDepositDelegationResponse) ProtoMessage()    {}
+func (*QueryGetTrustDepositDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3f9fbb2238b25c, []int{17}
+}
+func (m *QueryGetTrustDepositDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetTrustDepositDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetTrustDepositDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetTrustDepositDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetTrustDepositDelegationResponse.Merge(m, src)
+}
+func (m *QueryGetTrustDepositDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetTrustDepositDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetTrustDepositDelegationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetTrustDepositDelegationResponse proto.InternalMessageInfo
+
+func (m *QueryGetTrustDepositDelegationResponse) GetDelegation() TrustDepositDelegation {
+	if m != nil {
+		return m.Delegation
+	}
+	return TrustDepositDelegation{}
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "verana.td.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "verana.td.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryGetTrustDepositRequest)(nil), "verana.td.v1.QueryGetTrustDepositRequest")
+	proto.RegisterType((*QueryGetTrustDepositResponse)(nil), "verana.td.v1.QueryGetTrustDepositResponse")
+	proto.RegisterType((*QueryListSlashDistributionsRequest)(nil), "verana.td.v1.QueryListSlashDistributionsRequest")
+	proto.RegisterType((*QueryListSlashDistributionsResponse)(nil), "verana.td.v1.QueryListSlashDistributionsResponse")
+	proto.RegisterType((*QueryListUnbondingsRequest)(nil), "verana.td.v1.QueryListUnbondingsRequest")
+	proto.RegisterType((*QueryListUnbondingsResponse)(nil), "verana.td.v1.QueryListUnbondingsResponse")
+	proto.RegisterType((*QueryListYieldRecordsRequest)(nil), "verana.td.v1.QueryListYieldRecordsRequest")
+	proto.RegisterType((*QueryListYieldRecordsResponse)(nil), "verana.td.v1.QueryListYieldRecordsResponse")
+	proto.RegisterType((*QueryGetYieldReserveRequest)(nil), "verana.td.v1.QueryGetYieldReserveRequest")
+	proto.RegisterType((*QueryGetYieldReserveResponse)(nil), "verana.td.v1.QueryGetYieldReserveResponse")
+	proto.RegisterType((*QuerySimulateReclaimRequest)(nil), "verana.td.v1.QuerySimulateReclaimRequest")
+	proto.RegisterType((*QuerySimulateReclaimResponse)(nil), "verana.td.v1.QuerySimulateReclaimResponse")
+	proto.RegisterType((*QueryGetClaimableYieldRequest)(nil), "verana.td.v1.QueryGetClaimableYieldRequest")
+	proto.RegisterType((*QueryGetClaimableYieldResponse)(nil), "verana.td.v1.QueryGetClaimableYieldResponse")
+	proto.RegisterType((*QueryGetTrustDepositDelegationRequest)(nil), "verana.td.v1.QueryGetTrustDepositDelegationRequest")
+	proto.RegisterType((*QueryGetTrustDepositDelegationResponse)(nil), "verana.td.v1.QueryGetTrustDepositDelegationResponse")
+}
+
+func init() { proto.RegisterFile("verana/td/v1/query.proto", fileDescriptor_1e3f9fbb2238b25c) }
+
+var fileDescriptor_1e3f9fbb2238b25c = []byte{
+	// 1202 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4d, 0x6f, 0x1b, 0x55,
+	0x17, 0xce, 0xa4, 0x49, 0xda, 0x9c, 0x36, 0xc9, 0x9b, 0xdb, 0x28, 0x72, 0x26, 0x8e, 0x93, 0x4e,
+	0xda, 0xb7, 0x89, 0x4b, 0x3d, 0x38, 0x41, 0xaa, 0xba, 0x60, 0xd1, 0xd4, 0xa8, 0x6a, 0x55, 0xbe,
+	0x9c, 0x52, 0x04, 0x1b, 0xeb, 0xce, 0xf8, 0x62, 0x8f, 0x32, 0x1f, 0xee, 0xcc, 0x1d, 0x93, 0xb4,
+	0xca, 0x86, 0x0d, 0x6c, 0x90, 0x22, 0xf1, 0x03, 0x58, 0xb0, 0xe9, 0x12, 0x01, 0x3f, 0xa2, 0xcb,
+	0x0a, 0x36, 0xc0, 0xa2, 0xa0, 0x04, 0xc4, 0xdf, 0x40, 0x73, 0xef, 0x19, 0xcf, 0x4c, 0x3c, 0x8e,
+	0x5d, 0x36, 0x91, 0xef, 0xf9, 0x78, 0xce, 0x73, 0xce, 0xdc, 0x39, 0x4f, 0x06, 0x0a, 0x5d, 0xe6,
+	0x53, 0x97, 0xea, 0xbc, 0xa9, 0x77, 0xab, 0xfa, 0x93, 0x90, 0xf9, 0x07, 0x95, 0x8e, 0xef, 0x71,
+	0x8f, 0x5c, 0x92, 0x9e, 0x0a, 0x6f, 0x56, 0xba, 0x55, 0x75, 0x9e, 0x3a, 0x96, 0xeb, 0xe9, 0xe2,
+	0xaf, 0x0c, 0x50, 0x17, 0x5a, 0x5e, 0xcb, 0x13, 0x3f, 0xf5, 0xe8, 0x17, 0x5a, 0x8b, 0x2d, 0xcf,
+	0x6b, 0xd9, 0x4c, 0xa7, 0x1d, 0x4b, 0xa7, 0xae, 0xeb, 0x71, 0xca, 0x2d, 0xcf, 0x0d, 0xd0, 0x5b,
+	0x36, 0xbd, 0xc0, 0xf1, 0x02, 0xdd, 0xa0, 0x01, 0x93, 0xd5, 0xf4, 0x6e, 0xd5, 0x60, 0x9c, 0x56,
+	0xf5, 0x0e, 0x6d, 0x59, 0xae, 0x08, 0xc6, 0xd8, 0x25, 0x19, 0xdb, 0x90, 0x25, 0xe4, 0x01, 0x5d,
+	0xab, 0x58, 0x44, 0x9c, 0x8c, 0xf0, 0x33, 0x9d, 0x5b, 0x0e, 0x0b, 0x38, 0x75, 0x3a, 0x71, 0x6e,
+	0xa6, 0xad, 0x0e, 0xf5, 0xa9, 0x13, 0xe7, 0x66, 0x3b, 0xe6, 0x07, 0x1d, 0x86, 0x1e, 0x6d, 0x01,
+	0xc8, 0x87, 0x11, 0xa5, 0x0f, 0x44, 0x78, 0x9d, 0x3d, 0x09, 0x59, 0xc0, 0xb5, 0xf7, 0xe0, 0x72,
+	0xc6, 0x1a, 0x74, 0x3c, 0x37, 0x60, 0xe4, 0x16, 0x4c, 0x49, 0xd8, 0x82, 0xb2, 0xa6, 0x6c, 0x5c,
+	0xdc, 0x5a, 0xa8, 0xa4, 0xe7, 0x55, 0x91, 0xd1, 0x3b, 0xd3, 0x2f, 0x5e, 0xad, 0x8e, 0x3d, 0xff,
+	0xe7, 0xfb, 0xb2, 0x52, 0xc7, 0x70, 0xed, 0x16, 0x2c, 0x0b, 0xbc, 0x7b, 0x8c, 0x3f, 0xf2, 0xc3,
+	0x80, 0xd7, 0x58, 0xc7, 0x0b, 0x2c, 0x8e, 0xe5, 0x48, 0x01, 0xce, 0x53, 0xd3, 0xf4, 0x42, 0x97,
+	0x0b, 0xe0, 0xe9, 0x7a, 0x7c, 0xd4, 0x18, 0x14, 0xf3, 0x13, 0x91, 0xd1, 0x3b, 0x30, 0xc3, 0x23,
+	0x7b, 0xa3, 0x29, 0x1d, 0x48, 0x4c, 0xcd, 0x12, 0x4b, 0xa7, 0xee, 0x4c, 0x44, 0xf4, 0xea, 0x97,
+	0x78, 0xca, 0xa6, 0x7d, 0xa5, 0x80, 0x26, 0xea, 0x3c, 0xb4, 0x02, 0xbe, 0x6b, 0xd3, 0xa0, 0x5d,
+	0xb3, 0x02, 0xee, 0x5b, 0x46, 0x28, 0x1e, 0xe4, 0x50, 0x9e, 0xa4, 0x08, 0xd3, 0x3e, 0x33, 0xad,
+	0x8e, 0xc5, 0x5c, 0x5e, 0x18, 0x17, 0xbe, 0xc4, 0x40, 0xca, 0x30, 0xef, 0x23, 0xe3, 0x86, 0x43,
+	0xf7, 0x1b, 0x81, 0xf5, 0x94, 0x15, 0xce, 0xad, 0x29, 0x1b, 0x33, 0xf5, 0xb9, 0xd8, 0xf1, 0x2e,
+	0xdd, 0xdf, 0xb5, 0x9e, 0x32, 0xed, 0x10, 0xd6, 0xcf, 0x64, 0x82, 0x8d, 0x3f, 0x86, 0xcb, 0x41,
+	0xe4, 0x6d, 0x34, 0xd3, 0xee, 0x82, 0xb2, 0x76, 0x6e, 0xe3, 0xe2, 0xd6, 0x6a, 0xb6, 0xfd, 0x3e,
+	0x18, 0x9c, 0x01, 0x09, 0xfa, 0xf0, 0x35, 0x03, 0xd4, 0x5e, 0xf9, 0x8f, 0x5c, 0xc3, 0x73, 0x9b,
+	0x96, 0xdb, 0x1a, 0x61, 0x00, 0xb9, 0x2d, 0x8e, 0xe7, 0xb7, 0xd8, 0x86, 0xe5, 0xdc, 0x1a, 0xd8,
+	0xda, 0x7d, 0x80, 0xb0, 0x67, 0xc5, 0x8e, 0xd6, 0x07, 0x3f, 0xd0, 0x1e, 0x02, 0x76, 0x95, 0x4a,
+	0xd6, 0x1e, 0x40, 0xb1, 0x57, 0xe9, 0x13, 0x8b, 0xd9, 0xcd, 0x3a, 0x33, 0x3d, 0xbf, 0xd9, 0xeb,
+	0x27, 0x97, 0xb5, 0x92, 0xcf, 0x9a, 0xc1, 0xca, 0x00, 0x2c, 0xe4, 0x5d, 0x83, 0x99, 0x83, 0xc8,
+	0xde, 0xf0, 0xa5, 0x03, 0xa9, 0x2f, 0x65, 0xa9, 0xa7, 0x52, 0xe3, 0xab, 0x78, 0x90, 0x42, 0xd3,
+	0x56, 0x92, 0x57, 0x05, 0x43, 0x03, 0xe6, 0x77, 0x59, 0xfc, 0x66, 0xde, 0x85, 0x62, 0xbe, 0x1b,
+	0x49, 0xac, 0x27, 0x24, 0x84, 0x43, 0x74, 0x33, 0xd1, 0xab, 0x21, 0x6c, 0xda, 0xfb, 0x58, 0x63,
+	0xd7, 0x72, 0x42, 0x9b, 0x72, 0x56, 0x67, 0xa6, 0x4d, 0x2d, 0x67, 0xf8, 0x53, 0x5e, 0x84, 0x29,
+	0xea, 0x08, 0xc7, 0xb8, 0x80, 0xc5, 0x93, 0xf6, 0xe3, 0x38, 0x14, 0xf3, 0x11, 0x91, 0x56, 0x04,
+	0x69, 0xdb, 0xde, 0xe7, 0xac, 0x29, 0x20, 0x2f, 0xd4, 0xe3, 0x63, 0x04, 0xe9, 0x33, 0x1a, 0x78,
+	0x2e, 0xbe, 0x36, 0x78, 0x8a, 0x1a, 0x31, 0x42, 0xdf, 0x65, 0xcd, 0x06, 0x56, 0x3c, 0x27, 0x1b,
+	0x91, 0xc6, 0x3b, 0xc2, 0x46, 0xae, 0xc1, 0xac, 0xa8, 0x93, 0x44, 0x4d, 0x88, 0xa8, 0x19, 0xb4,
+	0x62, 0xd8, 0x7d, 0x98, 0x33, 0x3d, 0xa7, 0x63, 0xb3, 0xe8, 0x8e, 0x37, 0xa2, 0xbd, 0x59, 0x98,
+	0xc4, 0x3d, 0x21, 0x97, 0x6a, 0x25, 0x5e, 0xaa, 0x95, 0x47, 0xf1, 0x52, 0xdd, 0x99, 0x38, 0xfa,
+	0x63, 0x55, 0xa9, 0xcf, 0x26, 0x89, 0x91, 0x8b, 0x2c, 0xc0, 0x64, 0xd0, 0xa6, 0x3e, 0x2b, 0x4c,
+	0x89, 0x42, 0xf2, 0x90, 0x9a, 0xcb, 0xf9, 0xf4, 0x5c, 0xa2, 0xb5, 0x20, 0x98, 0x50, 0xc3, 0x66,
+	0x85, 0x0b, 0xc2, 0x95, 0x18, 0xb4, 0xdb, 0x78, 0xa3, 0xee, 0x31, 0x7e, 0x37, 0x36, 0xe2, 0x43,
+	0x1d, 0xb6, 0x17, 0xff, 0x56, 0xa0, 0x34, 0x28, 0xf7, 0x3f, 0x8f, 0xfc, 0x3a, 0xcc, 0xf5, 0xc8,
+	0x35, 0xc4, 0x85, 0xc1, 0xa1, 0xcf, 0x9a, 0x99, 0x12, 0xa4, 0x0e, 0x17, 0x45, 0xdf, 0x8d, 0x2e,
+	0xb5, 0x43, 0x26, 0x66, 0x3e, 0xbd, 0x53, 0x8d, 0x2e, 0xf3, 0xef, 0xaf, 0x56, 0x97, 0xa5, 0x6a,
+	0x05, 0xcd, 0xbd, 0x8a, 0xe5, 0xe9, 0x0e, 0xe5, 0xed, 0xca, 0x43, 0xd6, 0xa2, 0xe6, 0x41, 0x8d,
+	0x99, 0x3f, 0xff, 0x74, 0x13, 0xa4, 0xbb, 0x52, 0x63, 0x66, 0x1d, 0x04, 0xca, 0xe3, 0x08, 0x24,
+	0x19, 0xec, 0x64, 0x6a, 0xb0, 0xda, 0x1d, 0xb8, 0x96, 0xb7, 0xff, 0x6b, 0xcc, 0x66, 0x2d, 0xa1,
+	0x9b, 0xc3, 0x47, 0xc5, 0xe1, 0xff, 0xc3, 0x20, 0x70, 0x62, 0x0f, 0x00, 0x9a, 0x3d, 0x2b, 0x2a,
+	0xc9, 0xd5, 0xc1, 0x8b, 0x27, 0x41, 0x88, 0x37, 0x4f, 0x92, 0xbd, 0xf5, 0x1b, 0xc0, 0xa4, 0x28,
+	0x4b, 0xf6, 0x60, 0x4a, 0x0a, 0x23, 0x59, 0xcb, 0x62, 0xf5, 0xeb, 0xae, 0x7a, 0xe5, 0x8c, 0x08,
+	0x49, 0x52, 0x2b, 0x7e, 0xf1, 0xcb, 0x5f, 0xdf, 0x8c, 0x2f, 0x92, 0x05, 0x3d, 0x47, 0xee, 0xc9,
+	0xd7, 0x0a, 0xcc, 0x9d, 0x6a, 0x94, 0x6c, 0xe6, 0x80, 0xe6, 0x0b, 0xb1, 0x5a, 0x1e, 0x25, 0x14,
+	0x89, 0xac, 0x0b, 0x22, 0x2b, 0x64, 0x39, 0x4b, 0xa4, 0xc5, 0xb8, 0xfe, 0x0c, 0x67, 0x7f, 0x48,
+	0x9e, 0x2b, 0xb0, 0x98, 0xaf, 0x64, 0xe4, 0xcd, 0x9c, 0x5a, 0x67, 0xca, 0xaf, 0x5a, 0x7d, 0x8d,
+	0x0c, 0x24, 0xb9, 0x29, 0x48, 0xae, 0x93, 0x2b, 0x59, 0x92, 0x39, 0xd2, 0x49, 0xbe, 0x54, 0x60,
+	0x36, 0xab, 0x48, 0x64, 0x63, 0x40, 0xc1, 0x3e, 0x61, 0x54, 0x37, 0x47, 0x88, 0x44, 0x4a, 0x6b,
+	0x82, 0x92, 0x4a, 0x0a, 0x59, 0x4a, 0x89, 0x6a, 0x91, 0x23, 0x05, 0xfe, 0x77, 0x5a, 0x65, 0x48,
+	0x79, 0x40, 0x85, 0x1c, 0x59, 0x53, 0x6f, 0x8c, 0x14, 0x7b, 0xf6, 0x73, 0xcc, 0x48, 0x59, 0x7c,
+	0xaf, 0xd2, 0x92, 0x33, 0xe8, 0x5e, 0xe5, 0xa8, 0x96, 0x5a, 0x1e, 0x25, 0x74, 0x34, 0x3e, 0xb2,
+	0xf6, 0x77, 0x0a, 0xcc, 0x9d, 0xd2, 0x9a, 0x5c, 0x3e, 0xf9, 0x0a, 0xa7, 0x96, 0x47, 0x09, 0x45,
+	0x3e, 0xb7, 0x05, 0x9f, 0x6d, 0x52, 0x3d, 0x75, 0x85, 0x30, 0xbc, 0xe1, 0xcb, 0xf8, 0xe4, 0xd2,
+	0xeb, 0xcf, 0xe4, 0xf6, 0x3f, 0x24, 0xdf, 0x2a, 0x30, 0xdf, 0xb7, 0xa0, 0xc9, 0x8d, 0xfc, 0x61,
+	0xe4, 0x4a, 0x80, 0xfa, 0xc6, 0x68, 0xc1, 0xc8, 0x55, 0x17, 0x5c, 0x37, 0xc9, 0xf5, 0x2c, 0xd7,
+	0x53, 0x5b, 0x3d, 0xf5, 0x7e, 0xfe, 0xa0, 0xc0, 0xd2, 0xc0, 0xc5, 0x48, 0xb6, 0x87, 0xaf, 0x83,
+	0xbe, 0x4d, 0xac, 0xbe, 0xf5, 0x7a, 0x49, 0xc8, 0xbc, 0x2c, 0x98, 0x5f, 0x25, 0x5a, 0x96, 0x79,
+	0xb2, 0x51, 0x13, 0xd2, 0x3b, 0x1f, 0xbf, 0x38, 0x2e, 0x29, 0x2f, 0x8f, 0x4b, 0xca, 0x9f, 0xc7,
+	0x25, 0xe5, 0xe8, 0xa4, 0x34, 0xf6, 0xf2, 0xa4, 0x34, 0xf6, 0xeb, 0x49, 0x69, 0xec, 0xd3, 0xb7,
+	0x5b, 0x16, 0x6f, 0x87, 0x46, 0xc5, 0xf4, 0x1c, 0xc4, 0xb9, 0x69, 0x53, 0x23, 0x88, 0x7f, 0x1b,
+	0xb6, 0x67, 0xee, 0x99, 0x6d, 0x6a, 0xb9, 0xfa, 0xbe, 0x2e, 0x3e, 0x00, 0xf0, 0xb3, 0x41, 0x7e,
+	0x12, 0x19, 0x53, 0xe2, 0xdf, 0x80, 0xed, 0x7f, 0x07, 0x00, 0x29, 0xe2, 0x7c, 0x2e, 0x21, 0x0e,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	GetTrustDeposit(ctx context.Context, in *QueryGetTrustDepositRequest, opts ...grpc.CallOption) (*QueryGetTrustDepositResponse, error)
+	// ListSlashDistributions lists where slashed trust deposits went, filtered
+	// by slashed account and/or recipient.
+	ListSlashDistributions(ctx context.Context, in *QueryListSlashDistributionsRequest, opts ...grpc.CallOption) (*QueryListSlashDistributionsResponse, error)
+	// ListUnbondings lists the reclaimed trust deposits waiting for the end of
+	// the unbonding period, optionally filtered by account.
+	ListUnbondings(ctx context.Context, in *QueryListUnbondingsRequest, opts ...grpc.CallOption) (*QueryListUnbondingsResponse, error)
+	// ListYieldRecords lists the most recent yield distributions, newest first,
+	// with their annualized yield.
+	ListYieldRecords(ctx context.Context, in *QueryListYieldRecordsRequest, opts ...grpc.CallOption) (*QueryListYieldRecordsResponse, error)
+	// GetYieldReserve returns the amount left to be released as yield.
+	GetYieldReserve(ctx context.Context, in *QueryGetYieldReserveRequest, opts ...grpc.CallOption) (*QueryGetYieldReserveResponse, error)
+	// SimulateReclaim previews the outcome of a ReclaimTrustDeposit of amount by account.
+	SimulateReclaim(ctx context.Context, in *QuerySimulateReclaimRequest, opts ...grpc.CallOption) (*QuerySimulateReclaimResponse, error)
+	// GetClaimableYield previews the outcome of a ReclaimTrustDepositYield by account.
+	GetClaimableYield(ctx context.Context, in *QueryGetClaimableYieldRequest, opts ...grpc.CallOption) (*QueryGetClaimableYieldResponse, error)
+	// GetTrustDepositDelegation returns the delegated part of the trust deposit of account.
+	GetTrustDepositDelegation(ctx context.Context, in *QueryGetTrustDepositDelegationRequest, opts ...grpc.CallOption) (*QueryGetTrustDepositDelegationResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/verana.td.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetTrustDeposit(ctx context.Context, in *QueryGetTrustDepositRequest, opts ...grpc.CallOption) (*QueryGetTrustDepositResponse, error) {
+	out := new(QueryGetTrustDepositResponse)
+	err := c.cc.Invoke(ctx, "/verana.td.v1.Query/GetTrustDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListSlashDistributions(ctx context.Context, in *QueryListSlashDistributionsRequest, opts ...grpc.CallOption) (*QueryListSlashDistributionsResponse, error) {
+	out := new(QueryListSlashDistributionsResponse)
+	err := c.cc.Invoke(ctx, "/verana.td.v1.Query/ListSlashDistributions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListUnbondings(ctx context.Context, in *QueryListUnbondingsRequest, opts ...grpc.CallOption) (*QueryListUnbondingsResponse, error) {
+	out := new(QueryListUnbondingsResponse)
+	err := c.cc.Invoke(ctx, "/verana.td.v1.Query/ListUnbondings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListYieldRecords(ctx context.Context, in *QueryListYieldRecordsRequest, opts ...grpc.CallOption) (*QueryListYieldRecordsResponse, error) {
+	out := new(QueryListYieldRecordsResponse)
+	err := c.cc.Invoke(ctx, "/verana.td.v1.Query/ListYieldRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetYieldReserve(ctx context.Context, in *QueryGetYieldReserveRequest, opts ...grpc.CallOption) (*QueryGetYieldReserveResponse, error) {
+	out := new(QueryGetYieldReserveResponse)
+	err := c.cc.Invoke(ctx, "/verana.td.v1.Query/GetYieldReserve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateReclaim(ctx context.Context, in *QuerySimulateReclaimRequest, opts ...grpc.CallOption) (*QuerySimulateReclaimResponse, error) {
+	out := new(QuerySimulateReclaimResponse)
+	err := c.cc.Invoke(ctx, "/verana.td.v1.Query/SimulateReclaim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetClaimableYield(ctx context.Context, in *QueryGetClaimableYieldRequest, opts ...grpc.CallOption) (*QueryGetClaimableYieldResponse, error) {
+	out := new(QueryGetClaimableYieldResponse)
+	err := c.cc.Invoke(ctx, "/verana.td.v1.Query/GetClaimableYield", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetTrustDepositDelegation(ctx context.Context, in *QueryGetTrustDepositDelegationRequest, opts ...grpc.CallOption) (*QueryGetTrustDepositDelegationResponse, error) {
+	out := new(QueryGetTrustDepositDelegationResponse)
+	err := c.cc.Invoke(ctx, "/verana.td.v1.Query/GetTrustDepositDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	GetTrustDeposit(context.Context, *QueryGetTrustDepositRequest) (*QueryGetTrustDepositResponse, error)
+	// ListSlashDistributions lists where slashed trust deposits went, filtered
+	// by slashed account and/or recipient.
+	ListSlashDistributions(context.Context, *QueryListSlashDistributionsRequest) (*QueryListSlashDistributionsResponse, error)
+	// ListUnbondings lists the reclaimed trust deposits waiting for the end of
+	// the unbonding period, optionally filtered by account.
+	ListUnbondings(context.Context, *QueryListUnbondingsRequest) (*QueryListUnbondingsResponse, error)
+	// ListYieldRecords lists the most recent yield distributions, newest first,
+	// with their annualized yield.
+	ListYieldRecords(context.Context, *QueryListYieldRecordsRequest) (*QueryListYieldRecordsResponse, error)
+	// GetYieldReserve returns the amount left to be released as yield.
+	GetYieldReserve(context.Context, *QueryGetYieldReserveRequest) (*QueryGetYieldReserveResponse, error)
+	// SimulateReclaim previews the outcome of a ReclaimTrustDeposit of amount by account.
+	SimulateReclaim(context.Context, *QuerySimulateReclaimRequest) (*QuerySimulateReclaimResponse, error)
+	// GetClaimableYield previews the outcome of a ReclaimTrustDepositYield by account.
+	GetClaimableYield(context.Context, *QueryGetClaimableYieldRequest) (*QueryGetClaimableYieldResponse, error)
+	// GetTrustDepositDelegation returns the delegated part of the trust deposit of account.
+	GetTrustDepositDelegation(context.Context, *QueryGetTrustDepositDelegationRequest) (*QueryGetTrustDepositDelegationResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) GetTrustDeposit(ctx context.Context, req *QueryGetTrustDepositRequest) (*QueryGetTrustDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrustDeposit not implemented")
+}
+func (*UnimplementedQueryServer) ListSlashDistributions(ctx context.Context, req *QueryListSlashDistributionsRequest) (*QueryListSlashDistributionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSlashDistributions not implemented")
+}
+func (*UnimplementedQueryServer) ListUnbondings(ctx context.Context, req *QueryListUnbondingsRequest) (*QueryListUnbondingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUnbondings not implemented")
+}
+func (*UnimplementedQueryServer) ListYieldRecords(ctx context.Context, req *QueryListYieldRecordsRequest) (*QueryListYieldRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListYieldRecords not implemented")
+}
+func (*UnimplementedQueryServer) GetYieldReserve(ctx context.Context, req *QueryGetYieldReserveRequest) (*QueryGetYieldReserveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetYieldReserve not implemented")
+}
+func (*UnimplementedQueryServer) SimulateReclaim(ctx context.Context, req *QuerySimulateReclaimRequest) (*QuerySimulateReclaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateReclaim not implemented")
+}
+func (*UnimplementedQueryServer) GetClaimableYield(ctx context.Context, req *QueryGetClaimableYieldRequest) (*QueryGetClaimableYieldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClaimableYield not implemented")
+}
+func (*UnimplementedQueryServer) GetTrustDepositDelegation(ctx context.Context, req *QueryGetTrustDepositDelegationRequest) (*QueryGetTrustDepositDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrustDepositDelegation not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.td.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetTrustDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTrustDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTrustDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.td.v1.Query/GetTrustDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTrustDeposit(ctx, req.(*QueryGetTrustDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListSlashDistributions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListSlashDistributionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListSlashDistributions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.td.v1.Query/ListSlashDistributions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListSlashDistributions(ctx, req.(*QueryListSlashDistributionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListUnbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListUnbondingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListUnbondings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.td.v1.Query/ListUnbondings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListUnbondings(ctx, req.(*QueryListUnbondingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListYieldRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListYieldRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListYieldRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.td.v1.Query/ListYieldRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListYieldRecords(ctx, req.(*QueryListYieldRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetYieldReserve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetYieldReserveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetYieldReserve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.td.v1.Query/GetYieldReserve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetYieldReserve(ctx, req.(*QueryGetYieldReserveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateReclaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateReclaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateReclaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.td.v1.Query/SimulateReclaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateReclaim(ctx, req.(*QuerySimulateReclaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetClaimableYield_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetClaimableYieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetClaimableYield(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.td.v1.Query/GetClaimableYield",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetClaimableYield(ctx, req.(*QueryGetClaimableYieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetTrustDepositDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTrustDepositDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTrustDepositDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.td.v1.Query/GetTrustDepositDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTrustDepositDelegation(ctx, req.(*QueryGetTrustDepositDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "verana.td.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "GetTrustDeposit",
+			Handler:    _Query_GetTrustDeposit_Handler,
+		},
+		{
+			MethodName: "ListSlashDistributions",
+			Handler:    _Query_ListSlashDistributions_Handler,
+		},
+		{
+			MethodName: "ListUnbondings",
+			Handler:    _Query_ListUnbondings_Handler,
+		},
+		{
+			MethodName: "ListYieldRecords",
+			Handler:    _Query_ListYieldRecords_Handler,
+		},
+		{
+			MethodName: "GetYieldReserve",
+			Handler:    _Query_GetYieldReserve_Handler,
+		},
+		{
+			MethodName: "SimulateReclaim",
+			Handler:    _Query_SimulateReclaim_Handler,
+		},
+		{
+			MethodName: "GetClaimableYield",
+			Handler:    _Query_GetClaimableYield_Handler,
+		},
+		{
+			MethodName: "GetTrustDepositDelegation",
+			Handler:    _Query_GetTrustDepositDelegation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "verana/td/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTrustDepositRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTrustDepositRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTrustDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTrustDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTrustDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTrustDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TrustDeposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListSlashDistributionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListSlashDistributionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListSlashDistributionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResponseMaxSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ResponseMaxSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListSlashDistributionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListSlashDistributionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListSlashDistributionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SlashDistributions) > 0 {
+		for iNdEx := len(m.SlashDistributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashDistributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListUnbondingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListUnbondingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListUnbondingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResponseMaxSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ResponseMaxSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListUnbondingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListUnbondingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListUnbondingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Unbondings) > 0 {
+		for iNdEx := len(m.Unbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListYieldRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListYieldRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListYieldRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResponseMaxSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ResponseMaxSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListYieldRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListYieldRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListYieldRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.YieldRecords) > 0 {
+		for iNdEx := len(m.YieldRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.YieldRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetYieldReserveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetYieldReserveRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetYieldReserveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetYieldReserveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetYieldReserveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetYieldReserveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.YieldReserve != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.YieldReserve))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateReclaimRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateReclaimRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateReclaimRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateReclaimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateReclaimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateReclaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Claimable != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Claimable))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Amount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Share != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Share))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CompletionTime != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CompletionTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintQuery(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ClaimedAmount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ClaimedAmount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BurnedAmount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BurnedAmount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Allowed {
+		i--
+		if m.Allowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetClaimableYieldRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetClaimableYieldRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetClaimableYieldRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetClaimableYieldResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetClaimableYieldResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetClaimableYieldResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Share != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Share))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.ShareValue.Size()
+		i -= size
+		if _, err := m.ShareValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ClaimableYield != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ClaimableYield))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Allowed {
+		i--
+		if m.Allowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTrustDepositDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTrustDepositDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTrustDepositDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTrustDepositDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTrustDepositDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTrustDepositDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetTrustDepositRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetTrustDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TrustDeposit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryListSlashDistributionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ResponseMaxSize != 0 {
+		n += 1 + sovQuery(uint64(m.ResponseMaxSize))
+	}
+	return n
+}
+
+func (m *QueryListSlashDistributionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SlashDistributions) > 0 {
+		for _, e := range m.SlashDistributions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryListUnbondingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ResponseMaxSize != 0 {
+		n += 1 + sovQuery(uint64(m.ResponseMaxSize))
+	}
+	return n
+}
+
+func (m *QueryListUnbondingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Unbondings) > 0 {
+		for _, e := range m.Unbondings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryListYieldRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResponseMaxSize != 0 {
+		n += 1 + sovQuery(uint64(m.ResponseMaxSize))
+	}
+	return n
+}
+
+func (m *QueryListYieldRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.YieldRecords) > 0 {
+		for _, e := range m.YieldRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetYieldReserveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetYieldReserveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.YieldReserve != 0 {
+		n += 1 + sovQuery(uint64(m.YieldReserve))
+	}
+	return n
+}
+
+func (m *QuerySimulateReclaimRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovQuery(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *QuerySimulateReclaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowed {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BurnedAmount != 0 {
+		n += 1 + sovQuery(uint64(m.BurnedAmount))
+	}
+	if m.ClaimedAmount != 0 {
+		n += 1 + sovQuery(uint64(m.ClaimedAmount))
+	}
+	if m.CompletionTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CompletionTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Share != 0 {
+		n += 1 + sovQuery(uint64(m.Share))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovQuery(uint64(m.Amount))
+	}
+	if m.Claimable != 0 {
+		n += 1 + sovQuery(uint64(m.Claimable))
+	}
+	return n
+}
+
+func (m *QueryGetClaimableYieldRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetClaimableYieldResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowed {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ClaimableYield != 0 {
+		n += 1 + sovQuery(uint64(m.ClaimableYield))
+	}
+	l = m.ShareValue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Share != 0 {
+		n += 1 + sovQuery(uint64(m.Share))
+	}
+	return n
+}
+
+func (m *QueryGetTrustDepositDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetTrustDepositDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Delegation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTrustDepositRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTrustDepositRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTrustDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTrustDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTrustDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTrustDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TrustDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListSlashDistributionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListSlashDistributionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListSlashDistributionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseMaxSize", wireType)
+			}
+			m.ResponseMaxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResponseMaxSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListSlashDistributionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListSlashDistributionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListSlashDistributionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashDistributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashDistributions = append(m.SlashDistributions, SlashDistribution{})
+			if err := m.SlashDistributions[len(m.SlashDistributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListUnbondingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListUnbondingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListUnbondingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseMaxSize", wireType)
+			}
+			m.ResponseMaxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResponseMaxSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListUnbondingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListUnbondingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListUnbondingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unbondings = append(m.Unbondings, TrustDepositUnbonding{})
+			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListYieldRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListYieldRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListYieldRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseMaxSize", wireType)
+			}
+			m.ResponseMaxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResponseMaxSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListYieldRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListYieldRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListYieldRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YieldRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.YieldRecords = append(m.YieldRecords, YieldRecord{})
+			if err := m.YieldRecords[len(m.YieldRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetYieldReserveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetYieldReserveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetYieldReserveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetYieldReserveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetYieldReserveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetYieldReserveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YieldReserve", wireType)
+			}
+			m.YieldReserve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.YieldReserve |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateReclaimRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateReclaimRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateReclaimRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateReclaimResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateReclaimResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateReclaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Allowed = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedAmount", wireType)
+			}
+			m.BurnedAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BurnedAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedAmount", wireType)
+			}
+			m.ClaimedAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimedAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CompletionTime == nil {
+				m.CompletionTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			m.Share = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Share |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimable", wireType)
+			}
+			m.Claimable = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Claimable |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryGetTrustDepositRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
-}
 
-func (m *QueryGetTrustDepositResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.TrustDeposit.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetClaimableYieldRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -567,12 +3699,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetClaimableYieldRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetClaimableYieldRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -594,7 +3758,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetClaimableYieldResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -617,17 +3781,37 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetClaimableYieldResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetClaimableYieldResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Allowed = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -637,25 +3821,96 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableYield", wireType)
+			}
+			m.ClaimableYield = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimableYield |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			m.Share = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Share |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -677,7 +3932,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTrustDepositRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetTrustDepositDelegationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -700,10 +3955,10 @@ func (m *QueryGetTrustDepositRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTrustDepositRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetTrustDepositDelegationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTrustDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetTrustDepositDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -759,7 +4014,7 @@ func (m *QueryGetTrustDepositRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTrustDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetTrustDepositDelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -782,15 +4037,15 @@ func (m *QueryGetTrustDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTrustDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetTrustDepositDelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTrustDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetTrustDepositDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrustDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -817,7 +4072,7 @@ func (m *QueryGetTrustDepositResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TrustDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,104 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxSlashShares is the maximum number of shares of a slash distribution
+const MaxSlashShares = 10
+
+// ValidateSlashShares checks that a slash distribution is either empty, in
+// which case the slashed amount is burned, or made of valid shares whose
+// weights sum to 1.
+func ValidateSlashShares(shares []SlashShare) error {
+	if len(shares) == 0 {
+		return nil
+	}
+
+	if len(shares) > MaxSlashShares {
+		return fmt.Errorf("slash distribution cannot have more than %d shares", MaxSlashShares)
+	}
+
+	total := math.LegacyZeroDec()
+	for i, share := range shares {
+		switch share.Destination {
+		case SlashDestination_SLASH_DESTINATION_BURN, SlashDestination_SLASH_DESTINATION_COMMUNITY_POOL:
+			if share.Beneficiary != "" {
+				return fmt.Errorf("share %d: beneficiary is only allowed for beneficiary shares", i)
+			}
+		case SlashDestination_SLASH_DESTINATION_BENEFICIARY:
+			if _, err := sdk.AccAddressFromBech32(share.Beneficiary); err != nil {
+				return fmt.Errorf("share %d: invalid beneficiary address: %w", i, err)
+			}
+		default:
+			return fmt.Errorf("share %d: invalid destination %s", i, share.Destination)
+		}
+
+		if share.Weight.IsNil() || !share.Weight.IsPositive() {
+			return fmt.Errorf("share %d: weight must be positive", i)
+		}
+		total = total.Add(share.Weight)
+	}
+
+	if !total.Equal(math.LegacyOneDec()) {
+		return fmt.Errorf("slash distribution weights must sum to 1, got %s", total)
+	}
+
+	return nil
+}
+
+// SplitSlashedAmount splits amount between the shares of a distribution. Each
+// share gets its weight of amount, rounded down, and the rounding remainder
+// goes to the last share.
+func SplitSlashedAmount(amount uint64, shares []SlashShare) []uint64 {
+	amounts := make([]uint64, len(shares))
+	remaining := amount
+	for i, share := range shares {
+		if i == len(shares)-1 {
+			amounts[i] = remaining
+			break
+		}
+		amounts[i] = math.LegacyNewDecFromInt(math.NewIntFromUint64(amount)).Mul(share.Weight).TruncateInt().Uint64()
+		remaining -= amounts[i]
+	}
+	return amounts
+}
+
+// ParseSlashShares parses a comma separated list of destination:weight pairs,
+// where destination is burn, community-pool or a beneficiary address.
+func ParseSlashShares(s string) ([]SlashShare, error) {
+	var shares []SlashShare
+	if strings.TrimSpace(s) == "" {
+		return shares, nil
+	}
+
+	for _, part := range strings.Split(s, ",") {
+		destination, weightStr, found := strings.Cut(strings.TrimSpace(part), ":")
+		if !found {
+			return nil, fmt.Errorf("invalid slash share %q, expected destination:weight", part)
+		}
+
+		weight, err := math.LegacyNewDecFromStr(weightStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight in slash share %q: %w", part, err)
+		}
+
+		share := SlashShare{Weight: weight}
+		switch destination {
+		case "burn":
+			share.Destination = SlashDestination_SLASH_DESTINATION_BURN
+		case "community-pool":
+			share.Destination = SlashDestination_SLASH_DESTINATION_COMMUNITY_POOL
+		default:
+			share.Destination = SlashDestination_SLASH_DESTINATION_BENEFICIARY
+			share.Beneficiary = destination
+		}
+		shares = append(shares, share)
+	}
+
+	return shares, ValidateSlashShares(shares)
+}
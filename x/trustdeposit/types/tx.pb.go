@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
@@ -12,18 +13,22 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -269,8 +274,10 @@ func (m *MsgReclaimTrustDeposit) GetClaimed() uint64 {
 }
 
 type MsgReclaimTrustDepositResponse struct {
-	BurnedAmount  uint64 `protobuf:"varint,1,opt,name=burned_amount,json=burnedAmount,proto3" json:"burned_amount,omitempty"`
-	ClaimedAmount uint64 `protobuf:"varint,2,opt,name=claimed_amount,json=claimedAmount,proto3" json:"claimed_amount,omitempty"`
+	BurnedAmount   uint64     `protobuf:"varint,1,opt,name=burned_amount,json=burnedAmount,proto3" json:"burned_amount,omitempty"`
+	ClaimedAmount  uint64     `protobuf:"varint,2,opt,name=claimed_amount,json=claimedAmount,proto3" json:"claimed_amount,omitempty"`
+	UnbondingId    uint64     `protobuf:"varint,3,opt,name=unbonding_id,json=unbondingId,proto3" json:"unbonding_id,omitempty"`
+	CompletionTime *time.Time `protobuf:"bytes,4,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time,omitempty"`
 }
 
 func (m *MsgReclaimTrustDepositResponse) Reset()         { *m = MsgReclaimTrustDepositResponse{} }
@@ -320,6 +327,20 @@ func (m *MsgReclaimTrustDepositResponse) GetClaimedAmount() uint64 {
 	return 0
 }
 
+func (m *MsgReclaimTrustDepositResponse) GetUnbondingId() uint64 {
+	if m != nil {
+		return m.UnbondingId
+	}
+	return 0
+}
+
+func (m *MsgReclaimTrustDepositResponse) GetCompletionTime() *time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return nil
+}
+
 type MsgRepaySlashedTrustDeposit struct {
 	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
@@ -416,6 +437,295 @@ func (m *MsgRepaySlashedTrustDepositResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRepaySlashedTrustDepositResponse proto.InternalMessageInfo
 
+// MsgFundYieldReserve adds funds to the reserve released as yield to trust
+// deposit holders.
+type MsgFundYieldReserve struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Amount  uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *MsgFundYieldReserve) Reset()         { *m = MsgFundYieldReserve{} }
+func (m *MsgFundYieldReserve) String() string { return proto.CompactTextString(m) }
+func (*MsgFundYieldReserve) ProtoMessage()    {}
+func (*MsgFundYieldReserve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae13ffc8589bdf17, []int{8}
+}
+func (m *MsgFundYieldReserve) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundYieldReserve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundYieldReserve.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundYieldReserve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundYieldReserve.Merge(m, src)
+}
+func (m *MsgFundYieldReserve) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundYieldReserve) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundYieldReserve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundYieldReserve proto.InternalMessageInfo
+
+func (m *MsgFundYieldReserve) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgFundYieldReserve) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type MsgFundYieldReserveResponse struct {
+}
+
+func (m *MsgFundYieldReserveResponse) Reset()         { *m = MsgFundYieldReserveResponse{} }
+func (m *MsgFundYieldReserveResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundYieldReserveResponse) ProtoMessage()    {}
+func (*MsgFundYieldReserveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae13ffc8589bdf17, []int{9}
+}
+func (m *MsgFundYieldReserveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundYieldReserveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundYieldReserveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundYieldReserveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundYieldReserveResponse.Merge(m, src)
+}
+func (m *MsgFundYieldReserveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundYieldReserveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundYieldReserveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundYieldReserveResponse proto.InternalMessageInfo
+
+// MsgDelegateTrustDeposit delegates amount of the trust deposit of creator to
+// validator_address. The delegated deposit can still be slashed but can only
+// be reclaimed once undelegated, and its staking rewards are added to the
+// deposit yield of creator.
+type MsgDelegateTrustDeposit struct {
+	Creator          string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Amount           uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *MsgDelegateTrustDeposit) Reset()         { *m = MsgDelegateTrustDeposit{} }
+func (m *MsgDelegateTrustDeposit) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateTrustDeposit) ProtoMessage()    {}
+func (*MsgDelegateTrustDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae13ffc8589bdf17, []int{10}
+}
+func (m *MsgDelegateTrustDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateTrustDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateTrustDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateTrustDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateTrustDeposit.Merge(m, src)
+}
+func (m *MsgDelegateTrustDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateTrustDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateTrustDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateTrustDeposit proto.InternalMessageInfo
+
+func (m *MsgDelegateTrustDeposit) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgDelegateTrustDeposit) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgDelegateTrustDeposit) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type MsgDelegateTrustDepositResponse struct {
+	Shares cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=shares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"shares"`
+}
+
+func (m *MsgDelegateTrustDepositResponse) Reset()         { *m = MsgDelegateTrustDepositResponse{} }
+func (m *MsgDelegateTrustDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateTrustDepositResponse) ProtoMessage()    {}
+func (*MsgDelegateTrustDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae13ffc8589bdf17, []int{11}
+}
+func (m *MsgDelegateTrustDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateTrustDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateTrustDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateTrustDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateTrustDepositResponse.Merge(m, src)
+}
+func (m *MsgDelegateTrustDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateTrustDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateTrustDepositResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateTrustDepositResponse proto.InternalMessageInfo
+
+// MsgUndelegateTrustDeposit undelegates the whole delegated trust deposit of
+// creator. It is back in the deposit at the end of the staking unbonding period.
+type MsgUndelegateTrustDeposit struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *MsgUndelegateTrustDeposit) Reset()         { *m = MsgUndelegateTrustDeposit{} }
+func (m *MsgUndelegateTrustDeposit) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateTrustDeposit) ProtoMessage()    {}
+func (*MsgUndelegateTrustDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae13ffc8589bdf17, []int{12}
+}
+func (m *MsgUndelegateTrustDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateTrustDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateTrustDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUndelegateTrustDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateTrustDeposit.Merge(m, src)
+}
+func (m *MsgUndelegateTrustDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateTrustDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateTrustDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUndelegateTrustDeposit proto.InternalMessageInfo
+
+func (m *MsgUndelegateTrustDeposit) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+type MsgUndelegateTrustDepositResponse struct {
+	Amount         uint64     `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	CompletionTime *time.Time `protobuf:"bytes,2,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time,omitempty"`
+}
+
+func (m *MsgUndelegateTrustDepositResponse) Reset()         { *m = MsgUndelegateTrustDepositResponse{} }
+func (m *MsgUndelegateTrustDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateTrustDepositResponse) ProtoMessage()    {}
+func (*MsgUndelegateTrustDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae13ffc8589bdf17, []int{13}
+}
+func (m *MsgUndelegateTrustDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateTrustDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateTrustDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUndelegateTrustDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateTrustDepositResponse.Merge(m, src)
+}
+func (m *MsgUndelegateTrustDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateTrustDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateTrustDepositResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUndelegateTrustDepositResponse proto.InternalMessageInfo
+
+func (m *MsgUndelegateTrustDepositResponse) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *MsgUndelegateTrustDepositResponse) GetCompletionTime() *time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "verana.td.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "verana.td.v1.MsgUpdateParamsResponse")
@@ -425,49 +735,73 @@ func init() {
 	proto.RegisterType((*MsgReclaimTrustDepositResponse)(nil), "verana.td.v1.MsgReclaimTrustDepositResponse")
 	proto.RegisterType((*MsgRepaySlashedTrustDeposit)(nil), "verana.td.v1.MsgRepaySlashedTrustDeposit")
 	proto.RegisterType((*MsgRepaySlashedTrustDepositResponse)(nil), "verana.td.v1.MsgRepaySlashedTrustDepositResponse")
+	proto.RegisterType((*MsgFundYieldReserve)(nil), "verana.td.v1.MsgFundYieldReserve")
+	proto.RegisterType((*MsgFundYieldReserveResponse)(nil), "verana.td.v1.MsgFundYieldReserveResponse")
+	proto.RegisterType((*MsgDelegateTrustDeposit)(nil), "verana.td.v1.MsgDelegateTrustDeposit")
+	proto.RegisterType((*MsgDelegateTrustDepositResponse)(nil), "verana.td.v1.MsgDelegateTrustDepositResponse")
+	proto.RegisterType((*MsgUndelegateTrustDeposit)(nil), "verana.td.v1.MsgUndelegateTrustDeposit")
+	proto.RegisterType((*MsgUndelegateTrustDepositResponse)(nil), "verana.td.v1.MsgUndelegateTrustDepositResponse")
 }
 
 func init() { proto.RegisterFile("verana/td/v1/tx.proto", fileDescriptor_ae13ffc8589bdf17) }
 
 var fileDescriptor_ae13ffc8589bdf17 = []byte{
-	// 582 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0x8e, 0x9b, 0x92, 0x28, 0x47, 0x0a, 0xc2, 0x84, 0x36, 0x35, 0xc2, 0x54, 0x29, 0x91, 0x4a,
-	0x44, 0x6d, 0x25, 0x48, 0x80, 0x2a, 0x31, 0x34, 0x62, 0x24, 0x12, 0x72, 0x8b, 0x10, 0x2c, 0xd1,
-	0xc5, 0x3e, 0x39, 0x16, 0xb6, 0xcf, 0xba, 0x3b, 0x47, 0xc9, 0x86, 0x18, 0x99, 0xf8, 0x19, 0x48,
-	0x2c, 0x19, 0x18, 0x59, 0xd8, 0x3a, 0x56, 0x4c, 0x4c, 0x08, 0x25, 0x43, 0xfe, 0x06, 0xb2, 0xef,
-	0x9c, 0x12, 0xe3, 0x84, 0x88, 0x2e, 0x91, 0xdf, 0x7b, 0xdf, 0x7d, 0xef, 0xfb, 0xee, 0xbd, 0x1c,
-	0xb8, 0x35, 0x40, 0x04, 0xfa, 0x50, 0x67, 0x96, 0x3e, 0x68, 0xea, 0x6c, 0xa8, 0x05, 0x04, 0x33,
-	0x2c, 0x97, 0x79, 0x5a, 0x63, 0x96, 0x36, 0x68, 0x2a, 0x37, 0xa0, 0xe7, 0xf8, 0x58, 0x8f, 0x7f,
-	0x39, 0x40, 0xd9, 0x31, 0x31, 0xf5, 0x30, 0xd5, 0x3d, 0x6a, 0x47, 0x07, 0x3d, 0x6a, 0x8b, 0xc2,
-	0x2e, 0x2f, 0x74, 0xe3, 0x48, 0xe7, 0x81, 0x28, 0x55, 0x6c, 0x6c, 0x63, 0x9e, 0x8f, 0xbe, 0x92,
-	0x03, 0x0b, 0x0a, 0x02, 0x48, 0xa0, 0x27, 0x0e, 0xd4, 0xbe, 0x4a, 0xe0, 0x7a, 0x87, 0xda, 0x2f,
-	0x03, 0x0b, 0x32, 0xf4, 0x22, 0xae, 0xc8, 0x8f, 0x40, 0x09, 0x86, 0xac, 0x8f, 0x89, 0xc3, 0x46,
-	0x55, 0x69, 0x4f, 0x3a, 0x28, 0xb5, 0xab, 0xdf, 0xbf, 0x1c, 0x56, 0x44, 0xa7, 0x63, 0xcb, 0x22,
-	0x88, 0xd2, 0x13, 0x46, 0x1c, 0xdf, 0x36, 0x2e, 0xa0, 0xf2, 0x63, 0x50, 0xe0, 0xdc, 0xd5, 0x8d,
-	0x3d, 0xe9, 0xe0, 0x6a, 0xab, 0xa2, 0xfd, 0x69, 0x51, 0xe3, 0xec, 0xed, 0xd2, 0xd9, 0xcf, 0xbb,
-	0xb9, 0x4f, 0xb3, 0x71, 0x43, 0x32, 0x04, 0xfc, 0xe8, 0xc9, 0xfb, 0xd9, 0xb8, 0x71, 0x41, 0xf4,
-	0x61, 0x36, 0x6e, 0xd4, 0x85, 0xe4, 0xa1, 0xce, 0x48, 0x48, 0x99, 0x85, 0x02, 0x4c, 0x1d, 0xa6,
-	0xa7, 0xa4, 0xd6, 0x76, 0xc1, 0x4e, 0x2a, 0x65, 0x20, 0x1a, 0x60, 0x9f, 0xa2, 0x5a, 0x17, 0xdc,
-	0xee, 0x50, 0xdb, 0x40, 0xa6, 0x0b, 0x1d, 0xef, 0x34, 0x22, 0x79, 0xc6, 0x49, 0x5e, 0x3b, 0xc8,
-	0xb5, 0xe4, 0x16, 0x28, 0x9a, 0x04, 0x41, 0x86, 0xc9, 0x3f, 0x2d, 0x26, 0xc0, 0xa3, 0x72, 0xa4,
-	0x33, 0x89, 0x6a, 0xcf, 0xc1, 0xfe, 0x8a, 0x06, 0x89, 0x0e, 0xb9, 0x0e, 0xae, 0xc5, 0x08, 0x64,
-	0x75, 0xa1, 0x87, 0x43, 0x9f, 0xc5, 0xfd, 0x36, 0x8d, 0x2d, 0x91, 0x3d, 0x8e, 0x93, 0x35, 0x06,
-	0xb6, 0xb3, 0xd9, 0xfe, 0x47, 0xa9, 0x5c, 0x05, 0x45, 0x41, 0x1f, 0xcf, 0x62, 0xd3, 0x48, 0xc2,
-	0x94, 0x07, 0x17, 0xa8, 0xd9, 0x5d, 0xe7, 0xf2, 0xf7, 0xc1, 0x56, 0x2f, 0x24, 0x7e, 0x5a, 0x7d,
-	0x99, 0x27, 0xb9, 0xf8, 0x0c, 0x8f, 0x1b, 0x59, 0x1e, 0x3f, 0x4b, 0x62, 0x26, 0x01, 0x1c, 0x9d,
-	0xb8, 0x90, 0xf6, 0x91, 0x75, 0x69, 0xa7, 0x2d, 0x50, 0x84, 0xa6, 0x39, 0xef, 0xb9, 0xf2, 0x8c,
-	0x00, 0xca, 0xdb, 0xa0, 0x20, 0x64, 0xe6, 0x63, 0x99, 0x22, 0x4a, 0xdd, 0x4d, 0x5d, 0xcc, 0x37,
-	0x5b, 0x6c, 0x72, 0x41, 0xad, 0x6f, 0x79, 0x90, 0xef, 0x50, 0x5b, 0x3e, 0x05, 0xe5, 0x85, 0x7f,
-	0xd1, 0x9d, 0xc5, 0xed, 0x4f, 0xad, 0xa9, 0x52, 0x5f, 0x59, 0x9e, 0x5f, 0xff, 0x10, 0x54, 0x97,
-	0xae, 0xf0, 0xfd, 0xbf, 0x28, 0x96, 0x41, 0x95, 0xe6, 0xda, 0xd0, 0x79, 0x67, 0x07, 0xdc, 0xcc,
-	0xda, 0xc6, 0x7b, 0xeb, 0x30, 0x29, 0x0f, 0xd6, 0x41, 0x2d, 0x9a, 0x5c, 0xb2, 0x13, 0x59, 0x26,
-	0xb3, 0xa1, 0x99, 0x26, 0x57, 0x0f, 0x4f, 0xb9, 0xf2, 0x2e, 0x7a, 0x88, 0xda, 0xaf, 0xce, 0x26,
-	0xaa, 0x74, 0x3e, 0x51, 0xa5, 0x5f, 0x13, 0x55, 0xfa, 0x38, 0x55, 0x73, 0xe7, 0x53, 0x35, 0xf7,
-	0x63, 0xaa, 0xe6, 0xde, 0x3c, 0xb5, 0x1d, 0xd6, 0x0f, 0x7b, 0x9a, 0x89, 0x3d, 0x9d, 0xb3, 0x1f,
-	0xba, 0xb0, 0x47, 0x93, 0xef, 0x9e, 0x8b, 0xcd, 0xb7, 0x66, 0x1f, 0x3a, 0x7e, 0xfa, 0xa5, 0x62,
-	0xa3, 0x00, 0xd1, 0x5e, 0x21, 0x7e, 0x65, 0x1f, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x8a, 0xc5,
-	0x70, 0x3f, 0x04, 0x06, 0x00, 0x00,
+	// 880 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x8b, 0xdb, 0x46,
+	0x14, 0x5e, 0x6d, 0x5c, 0x2f, 0xfb, 0xe2, 0xfc, 0x52, 0xdc, 0x8d, 0x57, 0xcb, 0xda, 0xb1, 0xb7,
+	0xa6, 0xc9, 0x52, 0x4b, 0xd8, 0x85, 0xb6, 0x04, 0x7a, 0x88, 0x31, 0x85, 0x85, 0xb8, 0x14, 0x65,
+	0xdb, 0xd2, 0x42, 0x71, 0xc7, 0x9a, 0xa9, 0x2c, 0x22, 0x69, 0x84, 0x66, 0x64, 0xd6, 0xb7, 0x52,
+	0x68, 0x0f, 0x3d, 0xe5, 0xcf, 0x28, 0xf4, 0xb2, 0x87, 0x3d, 0xf6, 0xd6, 0x4b, 0x8e, 0x61, 0x4f,
+	0x25, 0x87, 0xb4, 0xec, 0x1e, 0xf6, 0xdf, 0x28, 0x92, 0x46, 0x72, 0x2c, 0xcb, 0x8e, 0xd9, 0xcd,
+	0xc5, 0x78, 0xde, 0x7c, 0xf3, 0xbd, 0xef, 0x7b, 0x7a, 0xf3, 0x24, 0x78, 0x7f, 0x4c, 0x7c, 0xe4,
+	0x22, 0x8d, 0x63, 0x6d, 0xdc, 0xd6, 0xf8, 0x91, 0xea, 0xf9, 0x94, 0x53, 0xb9, 0x14, 0x87, 0x55,
+	0x8e, 0xd5, 0x71, 0x5b, 0xb9, 0x83, 0x1c, 0xcb, 0xa5, 0x5a, 0xf4, 0x1b, 0x03, 0x94, 0x7b, 0x06,
+	0x65, 0x0e, 0x65, 0x9a, 0xc3, 0xcc, 0xf0, 0xa0, 0xc3, 0x4c, 0xb1, 0xb1, 0x1d, 0x6f, 0x0c, 0xa2,
+	0x95, 0x16, 0x2f, 0xc4, 0x56, 0xd9, 0xa4, 0x26, 0x8d, 0xe3, 0xe1, 0x3f, 0x11, 0xad, 0x99, 0x94,
+	0x9a, 0x36, 0xd1, 0xa2, 0xd5, 0x30, 0xf8, 0x49, 0xe3, 0x96, 0x43, 0x18, 0x47, 0x8e, 0x97, 0x30,
+	0xce, 0x48, 0xf4, 0x90, 0x8f, 0x1c, 0xc1, 0xd8, 0xf8, 0x4b, 0x82, 0x5b, 0x7d, 0x66, 0x7e, 0xed,
+	0x61, 0xc4, 0xc9, 0x57, 0xd1, 0x8e, 0xfc, 0x09, 0x6c, 0xa2, 0x80, 0x8f, 0xa8, 0x6f, 0xf1, 0x49,
+	0x45, 0xba, 0x2f, 0x3d, 0xd8, 0xec, 0x56, 0x4e, 0x4f, 0x5a, 0x65, 0x21, 0xe5, 0x31, 0xc6, 0x3e,
+	0x61, 0xec, 0x29, 0xf7, 0x2d, 0xd7, 0xd4, 0xa7, 0x50, 0xf9, 0x53, 0x28, 0xc6, 0xdc, 0x95, 0xf5,
+	0xfb, 0xd2, 0x83, 0xeb, 0x9d, 0xb2, 0xfa, 0x66, 0x0d, 0xd4, 0x98, 0xbd, 0xbb, 0xf9, 0xe2, 0x75,
+	0x6d, 0xed, 0x8f, 0x8b, 0xe3, 0x7d, 0x49, 0x17, 0xf0, 0x47, 0x9f, 0xfd, 0x72, 0x71, 0xbc, 0x3f,
+	0x25, 0xfa, 0xfd, 0xe2, 0x78, 0xbf, 0x29, 0x24, 0x1f, 0x69, 0xdc, 0x0f, 0x18, 0xc7, 0xc4, 0xa3,
+	0xcc, 0xe2, 0x5a, 0x46, 0x6a, 0x63, 0x1b, 0xee, 0x65, 0x42, 0x3a, 0x61, 0x1e, 0x75, 0x19, 0x69,
+	0x0c, 0x60, 0xa7, 0xcf, 0x4c, 0x9d, 0x18, 0x36, 0xb2, 0x9c, 0xc3, 0x90, 0xa4, 0x17, 0x93, 0x7c,
+	0x67, 0x11, 0x1b, 0xcb, 0x1d, 0xd8, 0x30, 0x7c, 0x82, 0x38, 0xf5, 0xdf, 0x6a, 0x31, 0x01, 0x3e,
+	0x2a, 0x85, 0x3a, 0x93, 0x55, 0xe3, 0x09, 0xec, 0x2d, 0x49, 0x90, 0xe8, 0x90, 0x9b, 0x70, 0x33,
+	0x42, 0x10, 0x3c, 0x40, 0x0e, 0x0d, 0x5c, 0x1e, 0xe5, 0x2b, 0xe8, 0x37, 0x44, 0xf4, 0x71, 0x14,
+	0x6c, 0x70, 0xd8, 0xca, 0x67, 0xbb, 0x8c, 0x52, 0xb9, 0x02, 0x1b, 0x82, 0x3e, 0x7a, 0x16, 0x05,
+	0x3d, 0x59, 0x66, 0x3c, 0xbc, 0x92, 0xa0, 0x9a, 0x9f, 0x36, 0xd5, 0xbf, 0x07, 0x37, 0x86, 0x81,
+	0xef, 0x66, 0xe5, 0x97, 0xe2, 0x60, 0xac, 0x3e, 0xc7, 0xe4, 0x7a, 0x8e, 0x49, 0xb9, 0x0e, 0xa5,
+	0xc0, 0x1d, 0x52, 0x17, 0x5b, 0xae, 0x39, 0xb0, 0x70, 0xe5, 0x5a, 0x04, 0xba, 0x9e, 0xc6, 0x0e,
+	0xb0, 0x7c, 0x00, 0xb7, 0x0c, 0xea, 0x78, 0x36, 0xe1, 0x16, 0x75, 0x07, 0x61, 0x27, 0x57, 0x0a,
+	0x51, 0x37, 0x29, 0x6a, 0xdc, 0xe6, 0x6a, 0xd2, 0xe6, 0xea, 0x61, 0xd2, 0xe6, 0xdd, 0xc2, 0xf3,
+	0x7f, 0x6b, 0x92, 0x7e, 0x73, 0x7a, 0x30, 0xdc, 0x6a, 0xfc, 0x29, 0x89, 0x16, 0xf0, 0xd0, 0xe4,
+	0xa9, 0x8d, 0xd8, 0x88, 0xe0, 0x2b, 0x17, 0xb6, 0x03, 0x1b, 0xc8, 0x30, 0x52, 0x87, 0x4b, 0xcf,
+	0x08, 0xa0, 0xbc, 0x05, 0x45, 0x51, 0x94, 0xd8, 0xaf, 0x58, 0x65, 0x1e, 0x45, 0x13, 0xf6, 0x96,
+	0x88, 0x4d, 0xdb, 0x9a, 0xc2, 0xdd, 0x3e, 0x33, 0xbf, 0x08, 0x5c, 0x9c, 0xb4, 0x19, 0xf1, 0xc7,
+	0xe4, 0x52, 0x5e, 0xa6, 0xba, 0xd6, 0x97, 0xe8, 0xda, 0x85, 0x9d, 0x9c, 0x84, 0xa9, 0x9e, 0xbf,
+	0xa5, 0xe8, 0x0a, 0xf6, 0x88, 0x4d, 0x4c, 0xc4, 0xc9, 0x95, 0x0b, 0xfc, 0x25, 0xdc, 0x19, 0x23,
+	0xdb, 0xc2, 0xe1, 0x62, 0x80, 0x62, 0x8c, 0x28, 0x75, 0xfd, 0xf4, 0xa4, 0xb5, 0x2b, 0x4e, 0x7f,
+	0x93, 0x60, 0x66, 0x69, 0x6e, 0x8f, 0x33, 0xf1, 0x15, 0x8b, 0x6f, 0x43, 0x6d, 0x81, 0x89, 0xf4,
+	0x1e, 0x1c, 0x40, 0x91, 0x8d, 0x90, 0x4f, 0x98, 0xf0, 0xd2, 0x0e, 0xe7, 0xd8, 0xab, 0xd7, 0xb5,
+	0x9d, 0x58, 0x11, 0xc3, 0xcf, 0x54, 0x8b, 0x6a, 0x0e, 0xe2, 0x23, 0xf5, 0x09, 0x31, 0x91, 0x31,
+	0xe9, 0x11, 0xe3, 0xf4, 0xa4, 0x05, 0x42, 0x70, 0x8f, 0x18, 0xba, 0x20, 0x68, 0xfc, 0x00, 0xdb,
+	0xe1, 0xd4, 0x72, 0xf1, 0x3b, 0x2a, 0x5a, 0xc6, 0xcc, 0x6f, 0x12, 0xd4, 0x17, 0xf2, 0xa7, 0x7e,
+	0xa6, 0x85, 0x91, 0xde, 0x2c, 0x4c, 0xde, 0x05, 0x5c, 0xbf, 0xdc, 0x05, 0xec, 0xfc, 0x5a, 0x84,
+	0x6b, 0x7d, 0x66, 0xca, 0x87, 0x50, 0x9a, 0x79, 0xc1, 0xec, 0xce, 0xbe, 0x18, 0x32, 0x13, 0x5c,
+	0x69, 0x2e, 0xdd, 0x4e, 0x0d, 0x1c, 0x41, 0x65, 0xe1, 0x74, 0x7f, 0x38, 0x47, 0xb1, 0x08, 0xaa,
+	0xb4, 0x57, 0x86, 0xa6, 0x99, 0x2d, 0xb8, 0x9b, 0x37, 0xa8, 0x3f, 0x58, 0x85, 0x49, 0xf9, 0x68,
+	0x15, 0xd4, 0xac, 0xc9, 0x05, 0xf3, 0x2b, 0xcf, 0x64, 0x3e, 0x54, 0x69, 0xaf, 0x0c, 0x4d, 0x33,
+	0xff, 0x08, 0xb7, 0xe7, 0xa6, 0x4c, 0x7d, 0x8e, 0x26, 0x0b, 0x51, 0x1e, 0xbe, 0x15, 0x92, 0x66,
+	0xb0, 0xa1, 0x9c, 0x3b, 0x36, 0xe6, 0x9f, 0x7f, 0x1e, 0x4c, 0x69, 0xad, 0x04, 0x4b, 0xb3, 0xf9,
+	0xb0, 0xb5, 0xe0, 0xc6, 0x7d, 0x38, 0xdf, 0x6f, 0xb9, 0x40, 0x45, 0x5b, 0x11, 0x98, 0xe4, 0x54,
+	0xde, 0xfb, 0x39, 0xfc, 0xce, 0xe9, 0x7e, 0xfb, 0xe2, 0xac, 0x2a, 0xbd, 0x3c, 0xab, 0x4a, 0xff,
+	0x9d, 0x55, 0xa5, 0xe7, 0xe7, 0xd5, 0xb5, 0x97, 0xe7, 0xd5, 0xb5, 0x7f, 0xce, 0xab, 0x6b, 0xdf,
+	0x7f, 0x6e, 0x5a, 0x7c, 0x14, 0x0c, 0x55, 0x83, 0x3a, 0x5a, 0xcc, 0xdd, 0xb2, 0xd1, 0x90, 0x25,
+	0xff, 0x87, 0x36, 0x35, 0x9e, 0x19, 0x23, 0x64, 0xb9, 0xd9, 0x0f, 0x21, 0x3e, 0xf1, 0x08, 0x1b,
+	0x16, 0xa3, 0xab, 0xf8, 0xf1, 0xff, 0x03, 0x00, 0x22, 0xbf, 0x95, 0x92, 0x84, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -487,8 +821,11 @@ type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	ReclaimTrustDepositYield(ctx context.Context, in *MsgReclaimTrustDepositYield, opts ...grpc.CallOption) (*MsgReclaimTrustDepositYieldResponse, error)
 	ReclaimTrustDeposit(ctx context.Context, in *MsgReclaimTrustDeposit, opts ...grpc.CallOption) (*MsgReclaimTrustDepositResponse, error)
-	// rpc SlashTrustDeposit(MsgSlashTrustDeposit) returns (MsgSlashTrustDepositResponse);
+	//  rpc SlashTrustDeposit(MsgSlashTrustDeposit) returns (MsgSlashTrustDepositResponse);
 	RepaySlashedTrustDeposit(ctx context.Context, in *MsgRepaySlashedTrustDeposit, opts ...grpc.CallOption) (*MsgRepaySlashedTrustDepositResponse, error)
+	FundYieldReserve(ctx context.Context, in *MsgFundYieldReserve, opts ...grpc.CallOption) (*MsgFundYieldReserveResponse, error)
+	DelegateTrustDeposit(ctx context.Context, in *MsgDelegateTrustDeposit, opts ...grpc.CallOption) (*MsgDelegateTrustDepositResponse, error)
+	UndelegateTrustDeposit(ctx context.Context, in *MsgUndelegateTrustDeposit, opts ...grpc.CallOption) (*MsgUndelegateTrustDepositResponse, error)
 }
 
 type msgClient struct {
@@ -535,6 +872,33 @@ func (c *msgClient) RepaySlashedTrustDeposit(ctx context.Context, in *MsgRepaySl
 	return out, nil
 }
 
+func (c *msgClient) FundYieldReserve(ctx context.Context, in *MsgFundYieldReserve, opts ...grpc.CallOption) (*MsgFundYieldReserveResponse, error) {
+	out := new(MsgFundYieldReserveResponse)
+	err := c.cc.Invoke(ctx, "/verana.td.v1.Msg/FundYieldReserve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DelegateTrustDeposit(ctx context.Context, in *MsgDelegateTrustDeposit, opts ...grpc.CallOption) (*MsgDelegateTrustDepositResponse, error) {
+	out := new(MsgDelegateTrustDepositResponse)
+	err := c.cc.Invoke(ctx, "/verana.td.v1.Msg/DelegateTrustDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UndelegateTrustDeposit(ctx context.Context, in *MsgUndelegateTrustDeposit, opts ...grpc.CallOption) (*MsgUndelegateTrustDepositResponse, error) {
+	out := new(MsgUndelegateTrustDepositResponse)
+	err := c.cc.Invoke(ctx, "/verana.td.v1.Msg/UndelegateTrustDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
@@ -542,8 +906,11 @@ type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	ReclaimTrustDepositYield(context.Context, *MsgReclaimTrustDepositYield) (*MsgReclaimTrustDepositYieldResponse, error)
 	ReclaimTrustDeposit(context.Context, *MsgReclaimTrustDeposit) (*MsgReclaimTrustDepositResponse, error)
-	// rpc SlashTrustDeposit(MsgSlashTrustDeposit) returns (MsgSlashTrustDepositResponse);
+	//  rpc SlashTrustDeposit(MsgSlashTrustDeposit) returns (MsgSlashTrustDepositResponse);
 	RepaySlashedTrustDeposit(context.Context, *MsgRepaySlashedTrustDeposit) (*MsgRepaySlashedTrustDepositResponse, error)
+	FundYieldReserve(context.Context, *MsgFundYieldReserve) (*MsgFundYieldReserveResponse, error)
+	DelegateTrustDeposit(context.Context, *MsgDelegateTrustDeposit) (*MsgDelegateTrustDepositResponse, error)
+	UndelegateTrustDeposit(context.Context, *MsgUndelegateTrustDeposit) (*MsgUndelegateTrustDepositResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -562,6 +929,15 @@ func (*UnimplementedMsgServer) ReclaimTrustDeposit(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) RepaySlashedTrustDeposit(ctx context.Context, req *MsgRepaySlashedTrustDeposit) (*MsgRepaySlashedTrustDepositResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RepaySlashedTrustDeposit not implemented")
 }
+func (*UnimplementedMsgServer) FundYieldReserve(ctx context.Context, req *MsgFundYieldReserve) (*MsgFundYieldReserveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundYieldReserve not implemented")
+}
+func (*UnimplementedMsgServer) DelegateTrustDeposit(ctx context.Context, req *MsgDelegateTrustDeposit) (*MsgDelegateTrustDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateTrustDeposit not implemented")
+}
+func (*UnimplementedMsgServer) UndelegateTrustDeposit(ctx context.Context, req *MsgUndelegateTrustDeposit) (*MsgUndelegateTrustDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateTrustDeposit not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -639,6 +1015,60 @@ func _Msg_RepaySlashedTrustDeposit_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_FundYieldReserve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundYieldReserve)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundYieldReserve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.td.v1.Msg/FundYieldReserve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundYieldReserve(ctx, req.(*MsgFundYieldReserve))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DelegateTrustDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateTrustDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateTrustDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.td.v1.Msg/DelegateTrustDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateTrustDeposit(ctx, req.(*MsgDelegateTrustDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UndelegateTrustDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateTrustDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateTrustDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.td.v1.Msg/UndelegateTrustDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateTrustDeposit(ctx, req.(*MsgUndelegateTrustDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "verana.td.v1.Msg",
@@ -660,6 +1090,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RepaySlashedTrustDeposit",
 			Handler:    _Msg_RepaySlashedTrustDeposit_Handler,
 		},
+		{
+			MethodName: "FundYieldReserve",
+			Handler:    _Msg_FundYieldReserve_Handler,
+		},
+		{
+			MethodName: "DelegateTrustDeposit",
+			Handler:    _Msg_DelegateTrustDeposit_Handler,
+		},
+		{
+			MethodName: "UndelegateTrustDeposit",
+			Handler:    _Msg_UndelegateTrustDeposit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "verana/td/v1/tx.proto",
@@ -841,6 +1283,21 @@ func (m *MsgReclaimTrustDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.CompletionTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CompletionTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintTx(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.UnbondingId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UnbondingId))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.ClaimedAmount != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.ClaimedAmount))
 		i--
@@ -919,6 +1376,207 @@ func (m *MsgRepaySlashedTrustDepositResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgFundYieldReserve) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundYieldReserve) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundYieldReserve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundYieldReserveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundYieldReserveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundYieldReserveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateTrustDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateTrustDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateTrustDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateTrustDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateTrustDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateTrustDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateTrustDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateTrustDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateTrustDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateTrustDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateTrustDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateTrustDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletionTime != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CompletionTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintTx(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1007,6 +1665,13 @@ func (m *MsgReclaimTrustDepositResponse) Size() (n int) {
 	if m.ClaimedAmount != 0 {
 		n += 1 + sovTx(uint64(m.ClaimedAmount))
 	}
+	if m.UnbondingId != 0 {
+		n += 1 + sovTx(uint64(m.UnbondingId))
+	}
+	if m.CompletionTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CompletionTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1039,6 +1704,91 @@ func (m *MsgRepaySlashedTrustDepositResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgFundYieldReserve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *MsgFundYieldReserveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDelegateTrustDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *MsgDelegateTrustDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Shares.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUndelegateTrustDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUndelegateTrustDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	if m.CompletionTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CompletionTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1529,13 +2279,68 @@ func (m *MsgReclaimTrustDepositResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			m.UnbondingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CompletionTime == nil {
+				m.CompletionTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
 			if (iNdEx + skippy) > l {
@@ -1733,6 +2538,561 @@ func (m *MsgRepaySlashedTrustDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgFundYieldReserve) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundYieldReserve: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundYieldReserve: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundYieldReserveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundYieldReserveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundYieldReserveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateTrustDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateTrustDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateTrustDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateTrustDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateTrustDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateTrustDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUndelegateTrustDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUndelegateTrustDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUndelegateTrustDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUndelegateTrustDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUndelegateTrustDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUndelegateTrustDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CompletionTime == nil {
+				m.CompletionTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
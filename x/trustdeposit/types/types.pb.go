@@ -31,6 +31,38 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Destinations of slashed trust deposits
+type SlashDestination int32
+
+const (
+	SlashDestination_SLASH_DESTINATION_UNSPECIFIED    SlashDestination = 0
+	SlashDestination_SLASH_DESTINATION_BURN           SlashDestination = 1
+	SlashDestination_SLASH_DESTINATION_COMMUNITY_POOL SlashDestination = 2
+	SlashDestination_SLASH_DESTINATION_BENEFICIARY    SlashDestination = 3
+)
+
+var SlashDestination_name = map[int32]string{
+	0: "SLASH_DESTINATION_UNSPECIFIED",
+	1: "SLASH_DESTINATION_BURN",
+	2: "SLASH_DESTINATION_COMMUNITY_POOL",
+	3: "SLASH_DESTINATION_BENEFICIARY",
+}
+
+var SlashDestination_value = map[string]int32{
+	"SLASH_DESTINATION_UNSPECIFIED":    0,
+	"SLASH_DESTINATION_BURN":           1,
+	"SLASH_DESTINATION_COMMUNITY_POOL": 2,
+	"SLASH_DESTINATION_BENEFICIARY":    3,
+}
+
+func (x SlashDestination) String() string {
+	return proto.EnumName(SlashDestination_name, int32(x))
+}
+
+func (SlashDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ffd0c7b737037a10, []int{0}
+}
+
 // TrustDeposit represents an account's trust deposit
 type TrustDeposit struct {
 	Account   string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
@@ -154,6 +186,8 @@ type SlashTrustDepositProposal struct {
 	Description string                `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
 	Account     string                `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty" yaml:"account"`
 	Amount      cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount" yaml:"amount"`
+	// distribution defines where the slashed amount goes, it is burned if empty
+	Distribution []SlashShare `protobuf:"bytes,5,rep,name=distribution,proto3" json:"distribution" yaml:"distribution"`
 }
 
 func (m *SlashTrustDepositProposal) Reset()         { *m = SlashTrustDepositProposal{} }
@@ -210,278 +244,2644 @@ func (m *SlashTrustDepositProposal) GetAccount() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*TrustDeposit)(nil), "verana.td.v1.TrustDeposit")
-	proto.RegisterType((*SlashTrustDepositProposal)(nil), "verana.td.v1.SlashTrustDepositProposal")
+func (m *SlashTrustDepositProposal) GetDistribution() []SlashShare {
+	if m != nil {
+		return m.Distribution
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("verana/td/v1/types.proto", fileDescriptor_ffd0c7b737037a10) }
-
-var fileDescriptor_ffd0c7b737037a10 = []byte{
-	// 566 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcf, 0x6e, 0xd3, 0x30,
-	0x18, 0x6f, 0x58, 0xd7, 0x51, 0xb7, 0x2b, 0x60, 0x95, 0x91, 0x55, 0x28, 0xa9, 0x22, 0x01, 0x3d,
-	0xb0, 0x58, 0x1b, 0x17, 0x84, 0x04, 0xd2, 0x3a, 0x84, 0xc4, 0x0d, 0x65, 0x93, 0x90, 0xb8, 0x44,
-	0x4e, 0x62, 0x52, 0x6b, 0x71, 0x1c, 0xc5, 0x6e, 0x45, 0xdf, 0x62, 0x0f, 0xc3, 0x3b, 0xb0, 0xe3,
-	0xc4, 0x09, 0x71, 0x08, 0xa8, 0x7d, 0x83, 0xf2, 0x02, 0xa8, 0xb6, 0x43, 0xcb, 0x09, 0x2e, 0x95,
-	0xbf, 0xdf, 0xbf, 0x7e, 0xfd, 0xbe, 0xaf, 0xc0, 0x9e, 0x91, 0x12, 0xe7, 0x18, 0xc9, 0x04, 0xcd,
-	0x8e, 0x91, 0x9c, 0x17, 0x44, 0xf8, 0x45, 0xc9, 0x25, 0x87, 0x5d, 0xcd, 0xf8, 0x32, 0xf1, 0x67,
-	0xc7, 0x83, 0x7b, 0x98, 0xd1, 0x9c, 0x23, 0xf5, 0xa9, 0x05, 0x83, 0x07, 0x31, 0x17, 0x8c, 0x0b,
-	0xc4, 0x44, 0xba, 0xf6, 0x32, 0x91, 0x1a, 0xe2, 0x50, 0x13, 0xa1, 0xaa, 0x90, 0x2e, 0x0c, 0xd5,
-	0x4f, 0x79, 0xca, 0x35, 0xbe, 0x7e, 0x19, 0xd4, 0x4d, 0x39, 0x4f, 0x33, 0x82, 0x54, 0x15, 0x4d,
-	0x3f, 0x22, 0x49, 0x19, 0x11, 0x12, 0xb3, 0xa2, 0x4e, 0xfc, 0xab, 0xcb, 0x02, 0x97, 0x98, 0x99,
-	0x44, 0xef, 0xcb, 0x0e, 0xe8, 0x5e, 0x94, 0x53, 0x21, 0x5f, 0x93, 0x82, 0x0b, 0x2a, 0xe1, 0x09,
-	0xd8, 0xc3, 0x71, 0xcc, 0xa7, 0xb9, 0xb4, 0xad, 0xa1, 0x35, 0x6a, 0x8f, 0xed, 0xaf, 0x9f, 0x8f,
-	0xfa, 0xa6, 0x8b, 0xd3, 0x24, 0x29, 0x89, 0x10, 0xe7, 0xb2, 0xa4, 0x79, 0x1a, 0xd4, 0x42, 0xd8,
-	0x07, 0xbb, 0x62, 0x82, 0x4b, 0x62, 0xdf, 0x1a, 0x5a, 0xa3, 0x66, 0xa0, 0x0b, 0x78, 0x00, 0x5a,
-	0x98, 0xa9, 0xa0, 0x1d, 0x05, 0x9b, 0x0a, 0x3e, 0x04, 0xed, 0x38, 0xc3, 0x94, 0xe1, 0x28, 0x23,
-	0x76, 0x53, 0x51, 0x1b, 0x00, 0x3e, 0x01, 0x77, 0x44, 0x86, 0xc5, 0x84, 0x24, 0x61, 0xa2, 0x5b,
-	0xb2, 0x77, 0x95, 0xa6, 0x67, 0xe0, 0xba, 0xd1, 0x47, 0xa0, 0x57, 0x92, 0x02, 0xd3, 0x8d, 0xae,
-	0xa5, 0x74, 0xfb, 0x1a, 0xad, 0x65, 0x67, 0xa0, 0x9b, 0x61, 0x21, 0x43, 0xe3, 0xb6, 0xf7, 0x86,
-	0xd6, 0xa8, 0x73, 0x32, 0xf0, 0xf5, 0xcc, 0xfc, 0x7a, 0x66, 0xfe, 0x45, 0x3d, 0xb3, 0x71, 0xf3,
-	0xea, 0x87, 0x6b, 0x05, 0x9d, 0xb5, 0xeb, 0x5c, 0x9b, 0xe0, 0x29, 0x50, 0x65, 0xa8, 0xa3, 0xed,
-	0xdb, 0xff, 0x99, 0x01, 0xd6, 0xa6, 0x40, 0x79, 0xa0, 0x0b, 0x3a, 0xaa, 0x85, 0x50, 0xcf, 0xb6,
-	0xad, 0x7a, 0x05, 0x0a, 0x3a, 0x53, 0x63, 0x79, 0x05, 0x7a, 0x5b, 0xdf, 0x11, 0x46, 0x73, 0x1b,
-	0xfc, 0x63, 0xfe, 0xdd, 0x4d, 0xfc, 0x78, 0xee, 0xfd, 0xb2, 0xc0, 0xa1, 0xea, 0x77, 0x7b, 0x9d,
-	0xef, 0x4a, 0x5e, 0x70, 0x81, 0x33, 0xf8, 0x18, 0xec, 0x4a, 0x2a, 0x33, 0x62, 0x96, 0x7a, 0x77,
-	0x55, 0xb9, 0xdd, 0x39, 0x66, 0xd9, 0x0b, 0x4f, 0xc1, 0x5e, 0xa0, 0x69, 0xf8, 0x1c, 0x74, 0x12,
-	0x22, 0xe2, 0x92, 0x16, 0x92, 0xf2, 0x5c, 0x2d, 0xb4, 0x3d, 0x3e, 0x58, 0x55, 0x2e, 0xd4, 0xea,
-	0x2d, 0xd2, 0x0b, 0xb6, 0xa5, 0xf0, 0xe9, 0xe6, 0x70, 0x76, 0x94, 0x0b, 0xae, 0x2a, 0xb7, 0xa7,
-	0x5d, 0x86, 0xf0, 0x36, 0x27, 0xf3, 0xe6, 0xcf, 0x71, 0x34, 0x95, 0xd8, 0xbf, 0xae, 0xdc, 0xc6,
-	0xf7, 0xca, 0xbd, 0xaf, 0x7f, 0xa9, 0x48, 0x2e, 0x7d, 0xca, 0x11, 0xc3, 0x72, 0xe2, 0xbf, 0xcd,
-	0xe5, 0xaa, 0x72, 0xf7, 0x4d, 0x12, 0xd3, 0x41, 0xc6, 0x3d, 0x7e, 0x7f, 0xbd, 0x70, 0xac, 0x9b,
-	0x85, 0x63, 0xfd, 0x5c, 0x38, 0xd6, 0xd5, 0xd2, 0x69, 0xdc, 0x2c, 0x9d, 0xc6, 0xb7, 0xa5, 0xd3,
-	0xf8, 0xf0, 0x32, 0xa5, 0x72, 0x32, 0x8d, 0xfc, 0x98, 0x33, 0xa4, 0xef, 0xff, 0x28, 0xc3, 0x91,
-	0xa8, 0xdf, 0x51, 0xc6, 0xe3, 0xcb, 0x78, 0x82, 0x69, 0x8e, 0x3e, 0x21, 0xb9, 0x1e, 0x99, 0x39,
-	0x23, 0xfd, 0x2f, 0x8e, 0x5a, 0x6a, 0xab, 0xcf, 0x7e, 0x07, 0x00, 0x00, 0xff, 0xff, 0x81, 0xf2,
-	0x7c, 0x2f, 0xe2, 0x03, 0x00, 0x00,
+// SlashShare sends weight of a slashed amount to a destination. The weights
+// of the shares of a distribution sum to 1.
+type SlashShare struct {
+	Destination SlashDestination `protobuf:"varint,1,opt,name=destination,proto3,enum=verana.td.v1.SlashDestination" json:"destination,omitempty"`
+	// beneficiary is the receiving account of a BENEFICIARY share
+	Beneficiary string                      `protobuf:"bytes,2,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	Weight      cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight"`
 }
 
-func (m *TrustDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SlashShare) Reset()         { *m = SlashShare{} }
+func (m *SlashShare) String() string { return proto.CompactTextString(m) }
+func (*SlashShare) ProtoMessage()    {}
+func (*SlashShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffd0c7b737037a10, []int{2}
+}
+func (m *SlashShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *TrustDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SlashShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashShare.Merge(m, src)
+}
+func (m *SlashShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashShare.DiscardUnknown(m)
 }
 
-func (m *TrustDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.LastRepaidBy) > 0 {
-		i -= len(m.LastRepaidBy)
-		copy(dAtA[i:], m.LastRepaidBy)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.LastRepaidBy)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if m.SlashCount != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.SlashCount))
-		i--
-		dAtA[i] = 0x48
+var xxx_messageInfo_SlashShare proto.InternalMessageInfo
+
+func (m *SlashShare) GetDestination() SlashDestination {
+	if m != nil {
+		return m.Destination
 	}
-	if m.LastRepaid != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastRepaid, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastRepaid):])
-		if err1 != nil {
-			return 0, err1
-		}
-		i -= n1
-		i = encodeVarintTypes(dAtA, i, uint64(n1))
-		i--
-		dAtA[i] = 0x42
+	return SlashDestination_SLASH_DESTINATION_UNSPECIFIED
+}
+
+func (m *SlashShare) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
 	}
-	if m.LastSlashed != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastSlashed, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastSlashed):])
-		if err2 != nil {
-			return 0, err2
+	return ""
+}
+
+// SlashDistribution tracks an amount of a slashed trust deposit sent to a
+// destination.
+type SlashDistribution struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// account is the account whose trust deposit was slashed
+	Account     string           `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Destination SlashDestination `protobuf:"varint,3,opt,name=destination,proto3,enum=verana.td.v1.SlashDestination" json:"destination,omitempty"`
+	// recipient is the beneficiary account, empty for burn and community pool
+	Recipient string     `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount    uint64     `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Created   *time.Time `protobuf:"bytes,6,opt,name=created,proto3,stdtime" json:"created,omitempty"`
+}
+
+func (m *SlashDistribution) Reset()         { *m = SlashDistribution{} }
+func (m *SlashDistribution) String() string { return proto.CompactTextString(m) }
+func (*SlashDistribution) ProtoMessage()    {}
+func (*SlashDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffd0c7b737037a10, []int{3}
+}
+func (m *SlashDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n2
-		i = encodeVarintTypes(dAtA, i, uint64(n2))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.RepaidDeposit != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.RepaidDeposit))
-		i--
-		dAtA[i] = 0x30
+		return b[:n], nil
 	}
-	if m.SlashedDeposit != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.SlashedDeposit))
-		i--
-		dAtA[i] = 0x28
+}
+func (m *SlashDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashDistribution.Merge(m, src)
+}
+func (m *SlashDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashDistribution proto.InternalMessageInfo
+
+func (m *SlashDistribution) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if m.Claimable != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Claimable))
-		i--
-		dAtA[i] = 0x20
+	return 0
+}
+
+func (m *SlashDistribution) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	if m.Amount != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x18
+	return ""
+}
+
+func (m *SlashDistribution) GetDestination() SlashDestination {
+	if m != nil {
+		return m.Destination
 	}
-	if m.Share != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Share))
-		i--
-		dAtA[i] = 0x10
+	return SlashDestination_SLASH_DESTINATION_UNSPECIFIED
+}
+
+func (m *SlashDistribution) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *SlashDistribution) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *SlashTrustDepositProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SlashDistribution) GetCreated() *time.Time {
+	if m != nil {
+		return m.Created
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SlashTrustDepositProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// TrustDepositUnbonding is a reclaimed trust deposit amount waiting for the
+// end of the unbonding period. It can still be slashed until then.
+type TrustDepositUnbonding struct {
+	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	// initial_amount is the amount to pay out when the unbonding started
+	InitialAmount uint64 `protobuf:"varint,3,opt,name=initial_amount,json=initialAmount,proto3" json:"initial_amount,omitempty"`
+	// amount is the amount left to pay out once slashes are deducted
+	Amount         uint64     `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Created        *time.Time `protobuf:"bytes,5,opt,name=created,proto3,stdtime" json:"created,omitempty"`
+	CompletionTime *time.Time `protobuf:"bytes,6,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time,omitempty"`
 }
 
-func (m *SlashTrustDepositProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *TrustDepositUnbonding) Reset()         { *m = TrustDepositUnbonding{} }
+func (m *TrustDepositUnbonding) String() string { return proto.CompactTextString(m) }
+func (*TrustDepositUnbonding) ProtoMessage()    {}
+func (*TrustDepositUnbonding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffd0c7b737037a10, []int{4}
+}
+func (m *TrustDepositUnbonding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrustDepositUnbonding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrustDepositUnbonding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *TrustDepositUnbonding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrustDepositUnbonding.Merge(m, src)
+}
+func (m *TrustDepositUnbonding) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrustDepositUnbonding) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrustDepositUnbonding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrustDepositUnbonding proto.InternalMessageInfo
+
+func (m *TrustDepositUnbonding) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *TrustDepositUnbonding) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TrustDepositUnbonding) GetInitialAmount() uint64 {
+	if m != nil {
+		return m.InitialAmount
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *TrustDeposit) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *TrustDepositUnbonding) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+func (m *TrustDepositUnbonding) GetCreated() *time.Time {
+	if m != nil {
+		return m.Created
 	}
-	if m.Share != 0 {
-		n += 1 + sovTypes(uint64(m.Share))
+	return nil
+}
+
+func (m *TrustDepositUnbonding) GetCompletionTime() *time.Time {
+	if m != nil {
+		return m.CompletionTime
 	}
-	if m.Amount != 0 {
-		n += 1 + sovTypes(uint64(m.Amount))
+	return nil
+}
+
+// YieldRecord tracks a yield distribution to trust deposit holders, along
+// with the resulting share value and annualized yield.
+type YieldRecord struct {
+	Id     uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Height int64      `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Time   *time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time,omitempty"`
+	// amount is the yield distributed during the epoch
+	Amount      uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	TotalShares uint64 `protobuf:"varint,5,opt,name=total_shares,json=totalShares,proto3" json:"total_shares,omitempty"`
+	// share_value is the trust deposit share value after the distribution
+	ShareValue cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=share_value,json=shareValue,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"share_value"`
+	// apy is the share value increase of the epoch, annualized
+	Apy cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=apy,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"apy"`
+}
+
+func (m *YieldRecord) Reset()         { *m = YieldRecord{} }
+func (m *YieldRecord) String() string { return proto.CompactTextString(m) }
+func (*YieldRecord) ProtoMessage()    {}
+func (*YieldRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffd0c7b737037a10, []int{5}
+}
+func (m *YieldRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *YieldRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_YieldRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Claimable != 0 {
-		n += 1 + sovTypes(uint64(m.Claimable))
+}
+func (m *YieldRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_YieldRecord.Merge(m, src)
+}
+func (m *YieldRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *YieldRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_YieldRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_YieldRecord proto.InternalMessageInfo
+
+func (m *YieldRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if m.SlashedDeposit != 0 {
-		n += 1 + sovTypes(uint64(m.SlashedDeposit))
+	return 0
+}
+
+func (m *YieldRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	if m.RepaidDeposit != 0 {
-		n += 1 + sovTypes(uint64(m.RepaidDeposit))
+	return 0
+}
+
+func (m *YieldRecord) GetTime() *time.Time {
+	if m != nil {
+		return m.Time
 	}
-	if m.LastSlashed != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastSlashed)
-		n += 1 + l + sovTypes(uint64(l))
+	return nil
+}
+
+func (m *YieldRecord) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	if m.LastRepaid != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastRepaid)
-		n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+func (m *YieldRecord) GetTotalShares() uint64 {
+	if m != nil {
+		return m.TotalShares
+	}
+	return 0
+}
+
+// TrustDepositDelegation tracks the part of the trust deposit of account that
+// is delegated through x/staking to validator_address. The delegation is held
+// by the trust deposit module account; shares are this account's part of it.
+type TrustDepositDelegation struct {
+	Account          string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// shares are the staking delegation shares of the account
+	Shares cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=shares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"shares"`
+	// amount is the bonded part of the trust deposit, reduced by validator slashes
+	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// unbonding_amount is the part of the trust deposit being undelegated
+	UnbondingAmount         uint64     `protobuf:"varint,5,opt,name=unbonding_amount,json=unbondingAmount,proto3" json:"unbonding_amount,omitempty"`
+	UnbondingCreationHeight int64      `protobuf:"varint,6,opt,name=unbonding_creation_height,json=unbondingCreationHeight,proto3" json:"unbonding_creation_height,omitempty"`
+	UnbondingCompletionTime *time.Time `protobuf:"bytes,7,opt,name=unbonding_completion_time,json=unbondingCompletionTime,proto3,stdtime" json:"unbonding_completion_time,omitempty"`
+	// unbonding_slashed is the part of unbonding_amount already slashed from the
+	// trust deposit, kept by the module when the undelegation completes
+	UnbondingSlashed uint64 `protobuf:"varint,8,opt,name=unbonding_slashed,json=unbondingSlashed,proto3" json:"unbonding_slashed,omitempty"`
+	// reward_ratio is the reward ratio of the validator when the staking rewards
+	// of the delegation were last paid out
+	RewardRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=reward_ratio,json=rewardRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"reward_ratio"`
+}
+
+func (m *TrustDepositDelegation) Reset()         { *m = TrustDepositDelegation{} }
+func (m *TrustDepositDelegation) String() string { return proto.CompactTextString(m) }
+func (*TrustDepositDelegation) ProtoMessage()    {}
+func (*TrustDepositDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffd0c7b737037a10, []int{6}
+}
+func (m *TrustDepositDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrustDepositDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrustDepositDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrustDepositDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrustDepositDelegation.Merge(m, src)
+}
+func (m *TrustDepositDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrustDepositDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrustDepositDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrustDepositDelegation proto.InternalMessageInfo
+
+func (m *TrustDepositDelegation) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *TrustDepositDelegation) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *TrustDepositDelegation) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *TrustDepositDelegation) GetUnbondingAmount() uint64 {
+	if m != nil {
+		return m.UnbondingAmount
+	}
+	return 0
+}
+
+func (m *TrustDepositDelegation) GetUnbondingCreationHeight() int64 {
+	if m != nil {
+		return m.UnbondingCreationHeight
+	}
+	return 0
+}
+
+func (m *TrustDepositDelegation) GetUnbondingCompletionTime() *time.Time {
+	if m != nil {
+		return m.UnbondingCompletionTime
+	}
+	return nil
+}
+
+func (m *TrustDepositDelegation) GetUnbondingSlashed() uint64 {
+	if m != nil {
+		return m.UnbondingSlashed
+	}
+	return 0
+}
+
+// TrustDepositRewardRatio is the staking reward per delegation share of the
+// trust deposit module account withdrawn from a validator so far.
+type TrustDepositRewardRatio struct {
+	ValidatorAddress string                      `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Ratio            cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=ratio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"ratio"`
+}
+
+func (m *TrustDepositRewardRatio) Reset()         { *m = TrustDepositRewardRatio{} }
+func (m *TrustDepositRewardRatio) String() string { return proto.CompactTextString(m) }
+func (*TrustDepositRewardRatio) ProtoMessage()    {}
+func (*TrustDepositRewardRatio) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffd0c7b737037a10, []int{7}
+}
+func (m *TrustDepositRewardRatio) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrustDepositRewardRatio) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrustDepositRewardRatio.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrustDepositRewardRatio) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrustDepositRewardRatio.Merge(m, src)
+}
+func (m *TrustDepositRewardRatio) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrustDepositRewardRatio) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrustDepositRewardRatio.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrustDepositRewardRatio proto.InternalMessageInfo
+
+func (m *TrustDepositRewardRatio) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("verana.td.v1.SlashDestination", SlashDestination_name, SlashDestination_value)
+	proto.RegisterType((*TrustDeposit)(nil), "verana.td.v1.TrustDeposit")
+	proto.RegisterType((*SlashTrustDepositProposal)(nil), "verana.td.v1.SlashTrustDepositProposal")
+	proto.RegisterType((*SlashShare)(nil), "verana.td.v1.SlashShare")
+	proto.RegisterType((*SlashDistribution)(nil), "verana.td.v1.SlashDistribution")
+	proto.RegisterType((*TrustDepositUnbonding)(nil), "verana.td.v1.TrustDepositUnbonding")
+	proto.RegisterType((*YieldRecord)(nil), "verana.td.v1.YieldRecord")
+	proto.RegisterType((*TrustDepositDelegation)(nil), "verana.td.v1.TrustDepositDelegation")
+	proto.RegisterType((*TrustDepositRewardRatio)(nil), "verana.td.v1.TrustDepositRewardRatio")
+}
+
+func init() { proto.RegisterFile("verana/td/v1/types.proto", fileDescriptor_ffd0c7b737037a10) }
+
+var fileDescriptor_ffd0c7b737037a10 = []byte{
+	// 1183 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x5b, 0x6f, 0x13, 0x47,
+	0x14, 0xce, 0xfa, 0x16, 0x72, 0x6c, 0x8c, 0x33, 0x85, 0xb0, 0x84, 0x62, 0x87, 0x55, 0x69, 0xd3,
+	0x4b, 0x6c, 0x91, 0x56, 0x55, 0x85, 0xd4, 0xaa, 0xbe, 0x84, 0x62, 0x09, 0x1c, 0xb4, 0x4e, 0xa8,
+	0x52, 0x55, 0x5a, 0x8d, 0x77, 0x87, 0xf5, 0x88, 0xbd, 0x58, 0xbb, 0xe3, 0x50, 0xff, 0x0b, 0x9e,
+	0xfb, 0xd6, 0x87, 0xfe, 0x81, 0x8a, 0xf7, 0x3e, 0xc2, 0x53, 0x85, 0x90, 0x2a, 0x55, 0x7d, 0x70,
+	0x2b, 0xf8, 0x07, 0xf9, 0x05, 0xd5, 0xce, 0xcc, 0x66, 0xd7, 0x49, 0x10, 0xc6, 0xea, 0x4b, 0xe4,
+	0x39, 0x97, 0x6f, 0xce, 0xf9, 0xe6, 0x3b, 0x33, 0x1b, 0x50, 0x0f, 0x49, 0x80, 0x3d, 0xdc, 0x60,
+	0x56, 0xe3, 0xf0, 0x66, 0x83, 0x4d, 0x46, 0x24, 0xac, 0x8f, 0x02, 0x9f, 0xf9, 0xa8, 0x24, 0x3c,
+	0x75, 0x66, 0xd5, 0x0f, 0x6f, 0xae, 0xaf, 0x62, 0x97, 0x7a, 0x7e, 0x83, 0xff, 0x15, 0x01, 0xeb,
+	0x97, 0x4d, 0x3f, 0x74, 0xfd, 0xb0, 0xe1, 0x86, 0x76, 0x94, 0xeb, 0x86, 0xb6, 0x74, 0x5c, 0x11,
+	0x0e, 0x83, 0xaf, 0x1a, 0x62, 0x21, 0x5d, 0x17, 0x6d, 0xdf, 0xf6, 0x85, 0x3d, 0xfa, 0x25, 0xad,
+	0x35, 0xdb, 0xf7, 0x6d, 0x87, 0x34, 0xf8, 0x6a, 0x30, 0x7e, 0xd8, 0x60, 0xd4, 0x25, 0x21, 0xc3,
+	0xee, 0x28, 0x46, 0x9c, 0xa9, 0x72, 0x84, 0x03, 0xec, 0x4a, 0x44, 0xed, 0x59, 0x16, 0x4a, 0x7b,
+	0xc1, 0x38, 0x64, 0x1d, 0x32, 0xf2, 0x43, 0xca, 0xd0, 0x36, 0x2c, 0x63, 0xd3, 0xf4, 0xc7, 0x1e,
+	0x53, 0x95, 0x0d, 0x65, 0x73, 0xa5, 0xa5, 0xbe, 0x7c, 0xba, 0x75, 0x51, 0x56, 0xd1, 0xb4, 0xac,
+	0x80, 0x84, 0x61, 0x9f, 0x05, 0xd4, 0xb3, 0xf5, 0x38, 0x10, 0x5d, 0x84, 0x7c, 0x38, 0xc4, 0x01,
+	0x51, 0x33, 0x1b, 0xca, 0x66, 0x4e, 0x17, 0x0b, 0xb4, 0x06, 0x05, 0xec, 0x72, 0xa0, 0x2c, 0x37,
+	0xcb, 0x15, 0x7a, 0x1f, 0x56, 0x4c, 0x07, 0x53, 0x17, 0x0f, 0x1c, 0xa2, 0xe6, 0xb8, 0x2b, 0x31,
+	0xa0, 0x8f, 0xe0, 0x42, 0xe8, 0xe0, 0x70, 0x48, 0x2c, 0xc3, 0x12, 0x25, 0xa9, 0x79, 0x1e, 0x53,
+	0x96, 0xe6, 0xb8, 0xd0, 0x1b, 0x50, 0x0e, 0xc8, 0x08, 0xd3, 0x24, 0xae, 0xc0, 0xe3, 0xce, 0x0b,
+	0x6b, 0x1c, 0xd6, 0x86, 0x92, 0x83, 0x43, 0x66, 0xc8, 0x6c, 0x75, 0x79, 0x43, 0xd9, 0x2c, 0x6e,
+	0xaf, 0xd7, 0x05, 0x67, 0xf5, 0x98, 0xb3, 0xfa, 0x5e, 0xcc, 0x59, 0x2b, 0xf7, 0xe4, 0x9f, 0x9a,
+	0xa2, 0x17, 0xa3, 0xac, 0xbe, 0x48, 0x42, 0x4d, 0xe0, 0x4b, 0x43, 0x40, 0xab, 0xe7, 0xe6, 0xc4,
+	0x80, 0x28, 0x49, 0xe7, 0x39, 0xa8, 0x06, 0x45, 0x5e, 0x82, 0x21, 0xb8, 0x5d, 0xe1, 0xb5, 0x02,
+	0x37, 0xb5, 0x39, 0x2d, 0xdf, 0x40, 0x39, 0xb5, 0x87, 0x31, 0x98, 0xa8, 0xf0, 0x16, 0xfe, 0x4b,
+	0x09, 0x7c, 0x6b, 0xa2, 0xfd, 0x91, 0x81, 0x2b, 0xbc, 0xde, 0xf4, 0x71, 0xde, 0x0f, 0xfc, 0x91,
+	0x1f, 0x62, 0x07, 0x7d, 0x08, 0x79, 0x46, 0x99, 0x43, 0xe4, 0xa1, 0x56, 0x8e, 0xa6, 0xb5, 0xd2,
+	0x04, 0xbb, 0xce, 0x2d, 0x8d, 0x9b, 0x35, 0x5d, 0xb8, 0xd1, 0x57, 0x50, 0xb4, 0x48, 0x68, 0x06,
+	0x74, 0xc4, 0xa8, 0xef, 0xf1, 0x03, 0x5d, 0x69, 0xad, 0x1d, 0x4d, 0x6b, 0x48, 0x44, 0xa7, 0x9c,
+	0x9a, 0x9e, 0x0e, 0x45, 0x9f, 0x25, 0xc2, 0xc9, 0xf2, 0x2c, 0x74, 0x34, 0xad, 0x95, 0x45, 0x96,
+	0x74, 0x68, 0x89, 0x64, 0x6e, 0x1f, 0x8b, 0x23, 0xc7, 0x83, 0xeb, 0xcf, 0xa7, 0xb5, 0xa5, 0xbf,
+	0xa7, 0xb5, 0x4b, 0xa2, 0xd3, 0xd0, 0x7a, 0x54, 0xa7, 0x7e, 0xc3, 0xc5, 0x6c, 0x58, 0xef, 0x7a,
+	0xec, 0x68, 0x5a, 0x3b, 0x2f, 0x91, 0x5c, 0x01, 0x14, 0x8b, 0xe9, 0x00, 0x4a, 0x16, 0x0d, 0x59,
+	0x40, 0x07, 0x63, 0x5e, 0x70, 0x7e, 0x23, 0xbb, 0x59, 0xdc, 0x56, 0xeb, 0xe9, 0xe9, 0xab, 0x73,
+	0x5a, 0xfa, 0x91, 0x28, 0x5b, 0x57, 0xa3, 0x7d, 0x8e, 0xa6, 0xb5, 0xf7, 0x64, 0x3b, 0xa9, 0x5c,
+	0x4d, 0x9f, 0x81, 0xd2, 0xfe, 0x54, 0x00, 0x92, 0x4c, 0xf4, 0x2d, 0x67, 0x86, 0x51, 0x0f, 0xf3,
+	0x8d, 0x22, 0x1e, 0xcb, 0xdb, 0xd5, 0x33, 0x36, 0xea, 0x24, 0x51, 0x7a, 0x3a, 0x05, 0xdd, 0x82,
+	0xe2, 0x80, 0x78, 0xe4, 0x21, 0x35, 0x29, 0x0e, 0x26, 0x6a, 0xe6, 0x2d, 0xc7, 0x9b, 0x0e, 0x46,
+	0x5d, 0x28, 0x3c, 0x26, 0xd4, 0x1e, 0xc6, 0xe4, 0xde, 0x94, 0x7c, 0x5d, 0x3d, 0xcd, 0xd7, 0x5d,
+	0x62, 0x63, 0x73, 0xd2, 0x21, 0xe6, 0xcb, 0xa7, 0x5b, 0x20, 0x91, 0x3b, 0xc4, 0xd4, 0x25, 0x80,
+	0xf6, 0x6b, 0x06, 0x56, 0x45, 0xa1, 0xa9, 0x6e, 0x51, 0x19, 0x32, 0xd4, 0xe2, 0x5d, 0xe5, 0xf4,
+	0x0c, 0xb5, 0xd2, 0xf7, 0x40, 0x66, 0xde, 0x7b, 0xe0, 0x04, 0x45, 0xd9, 0x77, 0xa7, 0xe8, 0x4b,
+	0x58, 0x09, 0x88, 0x49, 0x47, 0x94, 0x1c, 0x2b, 0xe3, 0xcd, 0xfb, 0x26, 0xa1, 0xa9, 0xbb, 0x26,
+	0x3f, 0x73, 0xd7, 0xdc, 0x82, 0x65, 0x33, 0x20, 0x98, 0x11, 0x4b, 0x2d, 0xcc, 0x39, 0xb4, 0x71,
+	0x82, 0xf6, 0x4b, 0x06, 0x2e, 0xa5, 0x67, 0x69, 0xdf, 0x1b, 0xf8, 0x9e, 0x45, 0x3d, 0xfb, 0x7f,
+	0xe1, 0xea, 0x06, 0x94, 0xa9, 0x47, 0x19, 0xc5, 0x8e, 0x31, 0x73, 0x4b, 0x9e, 0x97, 0xd6, 0xa6,
+	0x68, 0x60, 0x6d, 0x66, 0x4e, 0xce, 0x6c, 0x2c, 0xff, 0x8e, 0x8d, 0xa1, 0x2e, 0x5c, 0x30, 0x7d,
+	0x77, 0xe4, 0x90, 0x88, 0x72, 0x23, 0x7a, 0x2c, 0xe6, 0x26, 0xa7, 0x9c, 0x24, 0x46, 0x2e, 0xed,
+	0xf7, 0x0c, 0x14, 0x0f, 0x28, 0x71, 0x2c, 0x9d, 0x98, 0x7e, 0x60, 0x9d, 0x62, 0x66, 0x0d, 0x0a,
+	0x43, 0x21, 0xdb, 0x88, 0x98, 0xac, 0x2e, 0x57, 0xe8, 0x0b, 0xc8, 0xf1, 0x7d, 0xb3, 0x73, 0xee,
+	0xcb, 0xa3, 0xdf, 0x48, 0xc6, 0x75, 0x28, 0x31, 0x9f, 0x61, 0xc7, 0xe0, 0x0f, 0x4f, 0x28, 0x35,
+	0x50, 0xe4, 0x36, 0x3e, 0xbc, 0x21, 0xd2, 0xa1, 0xc8, 0x9d, 0xc6, 0x21, 0x76, 0xc6, 0xa2, 0xdf,
+	0x85, 0x86, 0x08, 0x38, 0xca, 0x83, 0x08, 0x04, 0xb5, 0x21, 0x8b, 0x47, 0x13, 0x75, 0x79, 0x51,
+	0xac, 0x28, 0x5b, 0x7b, 0x96, 0x83, 0xb5, 0xb4, 0xca, 0x3a, 0xc4, 0x21, 0xb6, 0x18, 0x86, 0x45,
+	0x9e, 0xe2, 0x1e, 0xac, 0x1e, 0x62, 0x87, 0x5a, 0x98, 0xf9, 0x81, 0x81, 0x45, 0x8c, 0x14, 0xe5,
+	0xf5, 0x97, 0x4f, 0xb7, 0xae, 0xc9, 0xec, 0x07, 0x71, 0xcc, 0x2c, 0x4c, 0xe5, 0xf0, 0x84, 0x3d,
+	0xba, 0x77, 0x24, 0xa9, 0x8b, 0xdf, 0x3b, 0x02, 0xe0, 0x8d, 0xa7, 0xf7, 0x31, 0x54, 0xc6, 0xf1,
+	0x68, 0x19, 0x33, 0x53, 0x7c, 0xe1, 0xd8, 0xde, 0x8c, 0x55, 0x7f, 0x25, 0x09, 0xe5, 0x72, 0x8e,
+	0x14, 0x2c, 0x15, 0x56, 0xe0, 0x0a, 0xbb, 0x7c, 0x1c, 0xd0, 0x96, 0xfe, 0x3b, 0x42, 0x72, 0x3f,
+	0xce, 0xe4, 0x9e, 0xd0, 0xff, 0xbc, 0x5f, 0x05, 0x29, 0xf4, 0x99, 0x41, 0x40, 0x9f, 0xc2, 0x6a,
+	0x82, 0x1e, 0x7f, 0x6b, 0x9c, 0xe3, 0x5d, 0x24, 0xdd, 0xc5, 0x9f, 0x13, 0x7b, 0x50, 0x0a, 0xc8,
+	0x63, 0x1c, 0x58, 0x46, 0x10, 0x55, 0xa8, 0xae, 0x2c, 0x4a, 0x6d, 0x51, 0xc0, 0xe8, 0x11, 0x8a,
+	0xf6, 0x9b, 0x02, 0x97, 0xd3, 0x4a, 0xd2, 0x13, 0xdf, 0xd9, 0xb2, 0x50, 0x16, 0x97, 0xc5, 0x77,
+	0x90, 0x17, 0xa5, 0x67, 0x16, 0x2d, 0x5d, 0xe4, 0x7f, 0xf2, 0xb3, 0x02, 0x95, 0x93, 0x4f, 0x02,
+	0xba, 0x0e, 0xd7, 0xfa, 0x77, 0x9b, 0xfd, 0x3b, 0x46, 0x67, 0xa7, 0xbf, 0xd7, 0xed, 0x35, 0xf7,
+	0xba, 0xbb, 0x3d, 0x63, 0xbf, 0xd7, 0xbf, 0xbf, 0xd3, 0xee, 0xde, 0xee, 0xee, 0x74, 0x2a, 0x4b,
+	0x68, 0x1d, 0xd6, 0x4e, 0x87, 0xb4, 0xf6, 0xf5, 0x5e, 0x45, 0x41, 0x1f, 0xc0, 0xc6, 0x69, 0x5f,
+	0x7b, 0xf7, 0xde, 0xbd, 0xfd, 0x5e, 0x77, 0xef, 0xc0, 0xb8, 0xbf, 0xbb, 0x7b, 0xb7, 0x92, 0x39,
+	0x7b, 0x93, 0xd6, 0x4e, 0x6f, 0xe7, 0x76, 0xb7, 0xdd, 0x6d, 0xea, 0x07, 0x95, 0x6c, 0xeb, 0xfb,
+	0xe7, 0xaf, 0xaa, 0xca, 0x8b, 0x57, 0x55, 0xe5, 0xdf, 0x57, 0x55, 0xe5, 0xc9, 0xeb, 0xea, 0xd2,
+	0x8b, 0xd7, 0xd5, 0xa5, 0xbf, 0x5e, 0x57, 0x97, 0x7e, 0xf8, 0xda, 0xa6, 0x6c, 0x38, 0x1e, 0xd4,
+	0x4d, 0xdf, 0x6d, 0x88, 0xe7, 0x6d, 0xcb, 0xc1, 0x83, 0x30, 0xfe, 0x3d, 0x70, 0x7c, 0xf3, 0x91,
+	0x39, 0xc4, 0xd4, 0x6b, 0xfc, 0xd4, 0x60, 0xd1, 0x99, 0xc8, 0x6f, 0x54, 0xf1, 0x2f, 0xc2, 0xa0,
+	0xc0, 0x05, 0xf6, 0xf9, 0x7f, 0x03, 0x00, 0xea, 0x52, 0xbb, 0x68, 0x3f, 0x0c, 0x00, 0x00,
+}
+
+func (m *TrustDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrustDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrustDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastRepaidBy) > 0 {
+		i -= len(m.LastRepaidBy)
+		copy(dAtA[i:], m.LastRepaidBy)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.LastRepaidBy)))
+		i--
+		dAtA[i] = 0x52
 	}
 	if m.SlashCount != 0 {
-		n += 1 + sovTypes(uint64(m.SlashCount))
+		i = encodeVarintTypes(dAtA, i, uint64(m.SlashCount))
+		i--
+		dAtA[i] = 0x48
 	}
-	l = len(m.LastRepaidBy)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.LastRepaid != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastRepaid, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastRepaid):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTypes(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.LastSlashed != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastSlashed, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastSlashed):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintTypes(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.RepaidDeposit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RepaidDeposit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SlashedDeposit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SlashedDeposit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Claimable != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Claimable))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Share != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Share))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashTrustDepositProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashTrustDepositProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashTrustDepositProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Distribution) > 0 {
+		for iNdEx := len(m.Distribution) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distribution[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Destination != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Destination))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Created != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Created, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintTypes(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Destination != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Destination))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TrustDepositUnbonding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrustDepositUnbonding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrustDepositUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletionTime != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CompletionTime):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintTypes(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Created != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Created, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintTypes(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.InitialAmount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.InitialAmount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *YieldRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *YieldRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *YieldRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Apy.Size()
+		i -= size
+		if _, err := m.Apy.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.ShareValue.Size()
+		i -= size
+		if _, err := m.ShareValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.TotalShares != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TotalShares))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Time != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintTypes(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TrustDepositDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrustDepositDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrustDepositDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RewardRatio.Size()
+		i -= size
+		if _, err := m.RewardRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.UnbondingSlashed != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UnbondingSlashed))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.UnbondingCompletionTime != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.UnbondingCompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.UnbondingCompletionTime):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintTypes(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.UnbondingCreationHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UnbondingCreationHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.UnbondingAmount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UnbondingAmount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TrustDepositRewardRatio) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrustDepositRewardRatio) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrustDepositRewardRatio) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Ratio.Size()
+		i -= size
+		if _, err := m.Ratio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TrustDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Share != 0 {
+		n += 1 + sovTypes(uint64(m.Share))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTypes(uint64(m.Amount))
+	}
+	if m.Claimable != 0 {
+		n += 1 + sovTypes(uint64(m.Claimable))
+	}
+	if m.SlashedDeposit != 0 {
+		n += 1 + sovTypes(uint64(m.SlashedDeposit))
+	}
+	if m.RepaidDeposit != 0 {
+		n += 1 + sovTypes(uint64(m.RepaidDeposit))
+	}
+	if m.LastSlashed != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastSlashed)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.LastRepaid != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastRepaid)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.SlashCount != 0 {
+		n += 1 + sovTypes(uint64(m.SlashCount))
+	}
+	l = len(m.LastRepaidBy)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *SlashTrustDepositProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.Distribution) > 0 {
+		for _, e := range m.Distribution {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SlashShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Destination != 0 {
+		n += 1 + sovTypes(uint64(m.Destination))
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *SlashDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Destination != 0 {
+		n += 1 + sovTypes(uint64(m.Destination))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTypes(uint64(m.Amount))
+	}
+	if m.Created != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *TrustDepositUnbonding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.InitialAmount != 0 {
+		n += 1 + sovTypes(uint64(m.InitialAmount))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTypes(uint64(m.Amount))
+	}
+	if m.Created != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Created)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.CompletionTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CompletionTime)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *YieldRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Time != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTypes(uint64(m.Amount))
+	}
+	if m.TotalShares != 0 {
+		n += 1 + sovTypes(uint64(m.TotalShares))
+	}
+	l = m.ShareValue.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Apy.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *TrustDepositDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Amount != 0 {
+		n += 1 + sovTypes(uint64(m.Amount))
+	}
+	if m.UnbondingAmount != 0 {
+		n += 1 + sovTypes(uint64(m.UnbondingAmount))
+	}
+	if m.UnbondingCreationHeight != 0 {
+		n += 1 + sovTypes(uint64(m.UnbondingCreationHeight))
+	}
+	if m.UnbondingCompletionTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.UnbondingCompletionTime)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.UnbondingSlashed != 0 {
+		n += 1 + sovTypes(uint64(m.UnbondingSlashed))
+	}
+	l = m.RewardRatio.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *TrustDepositRewardRatio) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Ratio.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TrustDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrustDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrustDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			m.Share = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Share |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimable", wireType)
+			}
+			m.Claimable = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Claimable |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedDeposit", wireType)
+			}
+			m.SlashedDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashedDeposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepaidDeposit", wireType)
+			}
+			m.RepaidDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepaidDeposit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSlashed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastSlashed == nil {
+				m.LastSlashed = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastSlashed, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRepaid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastRepaid == nil {
+				m.LastRepaid = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastRepaid, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashCount", wireType)
+			}
+			m.SlashCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRepaidBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastRepaidBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashTrustDepositProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashTrustDepositProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashTrustDepositProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distribution = append(m.Distribution, SlashShare{})
+			if err := m.Distribution[len(m.Distribution)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			m.Destination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Destination |= SlashDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			m.Destination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Destination |= SlashDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TrustDepositUnbonding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrustDepositUnbonding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrustDepositUnbonding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialAmount", wireType)
+			}
+			m.InitialAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CompletionTime == nil {
+				m.CompletionTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *YieldRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: YieldRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: YieldRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+			}
+			m.TotalShares = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalShares |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Apy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Apy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *SlashTrustDepositProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
-}
-
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *TrustDeposit) Unmarshal(dAtA []byte) error {
+func (m *TrustDepositDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -504,10 +2904,10 @@ func (m *TrustDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TrustDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: TrustDepositDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TrustDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TrustDepositDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -543,10 +2943,10 @@ func (m *TrustDeposit) Unmarshal(dAtA []byte) error {
 			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			m.Share = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -556,16 +2956,29 @@ func (m *TrustDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Share |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
-			m.Amount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -575,16 +2988,31 @@ func (m *TrustDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Claimable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.Claimable = 0
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -594,16 +3022,16 @@ func (m *TrustDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Claimable |= uint64(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashedDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingAmount", wireType)
 			}
-			m.SlashedDeposit = 0
+			m.UnbondingAmount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -613,16 +3041,16 @@ func (m *TrustDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SlashedDeposit |= uint64(b&0x7F) << shift
+				m.UnbondingAmount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepaidDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingCreationHeight", wireType)
 			}
-			m.RepaidDeposit = 0
+			m.UnbondingCreationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -632,14 +3060,14 @@ func (m *TrustDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RepaidDeposit |= uint64(b&0x7F) << shift
+				m.UnbondingCreationHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastSlashed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingCompletionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -666,54 +3094,18 @@ func (m *TrustDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastSlashed == nil {
-				m.LastSlashed = new(time.Time)
+			if m.UnbondingCompletionTime == nil {
+				m.UnbondingCompletionTime = new(time.Time)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastSlashed, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.UnbondingCompletionTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastRepaid", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LastRepaid == nil {
-				m.LastRepaid = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastRepaid, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingSlashed", wireType)
 			}
-			m.SlashCount = 0
+			m.UnbondingSlashed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -723,14 +3115,14 @@ func (m *TrustDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SlashCount |= uint64(b&0x7F) << shift
+				m.UnbondingSlashed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastRepaidBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -758,7 +3150,9 @@ func (m *TrustDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastRepaidBy = string(dAtA[iNdEx:postIndex])
+			if err := m.RewardRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -781,7 +3175,7 @@ func (m *TrustDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SlashTrustDepositProposal) Unmarshal(dAtA []byte) error {
+func (m *TrustDepositRewardRatio) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -804,15 +3198,15 @@ func (m *SlashTrustDepositProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SlashTrustDepositProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: TrustDepositRewardRatio: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SlashTrustDepositProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TrustDepositRewardRatio: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -840,75 +3234,11 @@ func (m *SlashTrustDepositProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -936,7 +3266,7 @@ func (m *SlashTrustDepositProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Ratio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/group"
+
+	"github.com/verana-labs/verana-blockchain/x/trustregistry/types"
+)
+
+func (ms msgServer) UpdateTrustRegistryController(goCtx context.Context, msg *types.MsgUpdateTrustRegistryController) (*types.MsgUpdateTrustRegistryControllerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Get trust registry
+	tr, err := ms.TrustRegistry.Get(ctx, msg.Id)
+	if err != nil {
+		return nil, fmt.Errorf("trust registry not found: %w", err)
+	}
+
+	// Check controller
+	if tr.Controller != msg.Creator {
+		return nil, fmt.Errorf("only trust registry controller can update trust registry controller")
+	}
+
+	if tr.Controller == msg.Controller {
+		return nil, fmt.Errorf("new controller must differ from the current controller")
+	}
+
+	// A group policy controller must have a group able to vote proposals
+	policy := ms.GetControllerGroupPolicy(ctx, msg.Controller)
+	if policy != nil {
+		members, err := ms.getGroupMembers(ctx, policy.GroupId)
+		if err != nil {
+			return nil, err
+		}
+		if len(members) == 0 {
+			return nil, fmt.Errorf("group %d of group policy %s has no members", policy.GroupId, msg.Controller)
+		}
+	}
+
+	// Update controller
+	tr.Controller = msg.Controller
+	tr.Modified = ctx.BlockTime()
+
+	if err := ms.TrustRegistry.Set(ctx, tr.Id, tr); err != nil {
+		return nil, fmt.Errorf("failed to update trust registry: %w", err)
+	}
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyTrustRegistryID, strconv.FormatUint(tr.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyController, tr.Controller),
+		sdk.NewAttribute(types.AttributeKeyTimestamp, tr.Modified.String()),
+	}
+	if policy != nil {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyGroupID, strconv.FormatUint(policy.GroupId, 10)))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeUpdateTrustRegistryController, attributes...))
+
+	return &types.MsgUpdateTrustRegistryControllerResponse{}, nil
+}
+
+// GetControllerGroupPolicy returns the group policy behind a controller
+// address, or nil if the controller is not a group policy.
+func (k Keeper) GetControllerGroupPolicy(ctx context.Context, controller string) *group.GroupPolicyInfo {
+	if k.groupKeeper == nil {
+		return nil
+	}
+
+	// group policies are looked up by address, any failure means the
+	// controller is a regular account
+	res, err := k.groupKeeper.GroupPolicyInfo(ctx, &group.QueryGroupPolicyInfoRequest{Address: controller})
+	if err != nil || res.Info == nil {
+		return nil
+	}
+
+	return res.Info
+}
+
+// getGroupMembers returns all the members of a group.
+func (k Keeper) getGroupMembers(ctx context.Context, groupID uint64) ([]*group.GroupMember, error) {
+	var members []*group.GroupMember
+
+	var nextKey []byte
+	for {
+		res, err := k.groupKeeper.GroupMembers(ctx, &group.QueryGroupMembersRequest{
+			GroupId:    groupID,
+			Pagination: &query.PageRequest{Key: nextKey},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get members of group %d: %w", groupID, err)
+		}
+		members = append(members, res.Members...)
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return members, nil
+		}
+		nextKey = res.Pagination.NextKey
+	}
+}
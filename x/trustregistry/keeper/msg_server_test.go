@@ -564,3 +564,104 @@ func TestMsgServerArchiveTrustRegistry(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgServerUpdateTrustRegistryController(t *testing.T) {
+	k, groupKeeper, sdkCtx := keepertest.TrustregistryKeeperWithGroups(t)
+	ms := keeper.NewMsgServerImpl(k)
+	ctx := sdk.WrapSDKContext(sdkCtx)
+
+	creator := sdk.AccAddress([]byte("test_creator")).String()
+	member := sdk.AccAddress([]byte("test_member")).String()
+	policy := sdk.AccAddress([]byte("test_group_policy_address_32byte")).String()
+	emptyPolicy := sdk.AccAddress([]byte("test_empty_group_policy_32bytes_")).String()
+	groupKeeper.CreateMockGroupPolicy(policy, 1, creator, member)
+	groupKeeper.CreateMockGroupPolicy(emptyPolicy, 2)
+
+	validDid := "did:example:123456789abcdefghi"
+	_, err := ms.CreateTrustRegistry(ctx, &types.MsgCreateTrustRegistry{
+		Creator:      creator,
+		Did:          validDid,
+		Language:     "en",
+		DocUrl:       "http://example.com/doc",
+		DocDigestSri: "sha384-MzNNbQTWCSUSi0bbz7dbua+RcENv7C6FvlmYJ1Y+I727HsPOHdzwELMYO9Mz68M26",
+	})
+	require.NoError(t, err)
+	trID, err := k.TrustRegistryDIDIndex.Get(ctx, validDid)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgUpdateTrustRegistryController
+		expectErr bool
+	}{
+		{
+			name: "Wrong Controller",
+			msg: &types.MsgUpdateTrustRegistryController{
+				Creator:    member,
+				Id:         trID,
+				Controller: policy,
+			},
+			expectErr: true,
+		},
+		{
+			name: "Same Controller",
+			msg: &types.MsgUpdateTrustRegistryController{
+				Creator:    creator,
+				Id:         trID,
+				Controller: creator,
+			},
+			expectErr: true,
+		},
+		{
+			name: "Group Policy Without Members",
+			msg: &types.MsgUpdateTrustRegistryController{
+				Creator:    creator,
+				Id:         trID,
+				Controller: emptyPolicy,
+			},
+			expectErr: true,
+		},
+		{
+			name: "Non-existent Trust Registry",
+			msg: &types.MsgUpdateTrustRegistryController{
+				Creator:    creator,
+				Id:         99999,
+				Controller: policy,
+			},
+			expectErr: true,
+		},
+		{
+			name: "Valid Group Policy Controller",
+			msg: &types.MsgUpdateTrustRegistryController{
+				Creator:    creator,
+				Id:         trID,
+				Controller: policy,
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.UpdateTrustRegistryController(ctx, tc.msg)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			tr, err := k.TrustRegistry.Get(ctx, tc.msg.Id)
+			require.NoError(t, err)
+			require.Equal(t, tc.msg.Controller, tr.Controller)
+		})
+	}
+
+	// the query resolves the group behind the controller
+	qs := keeper.NewQueryServerImpl(k)
+	res, err := qs.GetTrustRegistryController(ctx, &types.QueryGetTrustRegistryControllerRequest{TrId: trID})
+	require.NoError(t, err)
+	require.Equal(t, policy, res.Controller)
+	require.NotNil(t, res.GroupPolicy)
+	require.Equal(t, uint64(1), res.GroupPolicy.GroupId)
+	require.Len(t, res.Members, 2)
+}
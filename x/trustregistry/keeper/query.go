@@ -168,3 +168,35 @@ func (qs queryServer) Params(ctx context.Context, req *types.QueryParamsRequest)
 
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+func (qs queryServer) GetTrustRegistryController(ctx context.Context, req *types.QueryGetTrustRegistryControllerRequest) (*types.QueryGetTrustRegistryControllerResponse, error) {
+	if req.TrId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "trust registry ID is required")
+	}
+
+	tr, err := qs.k.TrustRegistry.Get(ctx, req.TrId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "trust registry not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &types.QueryGetTrustRegistryControllerResponse{
+		Controller: tr.Controller,
+	}
+
+	policy := qs.k.GetControllerGroupPolicy(ctx, tr.Controller)
+	if policy == nil {
+		return res, nil
+	}
+
+	members, err := qs.k.getGroupMembers(ctx, policy.GroupId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res.GroupPolicy = policy
+	res.Members = members
+
+	return res, nil
+}
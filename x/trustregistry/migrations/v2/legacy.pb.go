@@ -0,0 +1,1484 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: verana/tr/migrations/v2/legacy.proto
+
+package v2
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type TrustRegistry struct {
+	Id            uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Did           string     `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
+	Controller    string     `protobuf:"bytes,3,opt,name=controller,proto3" json:"controller,omitempty"`
+	Created       time.Time  `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	Modified      time.Time  `protobuf:"bytes,5,opt,name=modified,proto3,stdtime" json:"modified"`
+	Archived      *time.Time `protobuf:"bytes,6,opt,name=archived,proto3,stdtime" json:"archived,omitempty"`
+	Deposit       int64      `protobuf:"varint,7,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	Aka           string     `protobuf:"bytes,8,opt,name=aka,proto3" json:"aka,omitempty"`
+	ActiveVersion int32      `protobuf:"varint,9,opt,name=active_version,json=activeVersion,proto3" json:"active_version,omitempty"`
+	Language      string     `protobuf:"bytes,10,opt,name=language,proto3" json:"language,omitempty"`
+}
+
+func (m *TrustRegistry) Reset()         { *m = TrustRegistry{} }
+func (m *TrustRegistry) String() string { return proto.CompactTextString(m) }
+func (*TrustRegistry) ProtoMessage()    {}
+func (*TrustRegistry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387990c87038b3cd, []int{0}
+}
+func (m *TrustRegistry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrustRegistry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrustRegistry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrustRegistry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrustRegistry.Merge(m, src)
+}
+func (m *TrustRegistry) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrustRegistry) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrustRegistry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrustRegistry proto.InternalMessageInfo
+
+func (m *TrustRegistry) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TrustRegistry) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+func (m *TrustRegistry) GetController() string {
+	if m != nil {
+		return m.Controller
+	}
+	return ""
+}
+
+func (m *TrustRegistry) GetCreated() time.Time {
+	if m != nil {
+		return m.Created
+	}
+	return time.Time{}
+}
+
+func (m *TrustRegistry) GetModified() time.Time {
+	if m != nil {
+		return m.Modified
+	}
+	return time.Time{}
+}
+
+func (m *TrustRegistry) GetArchived() *time.Time {
+	if m != nil {
+		return m.Archived
+	}
+	return nil
+}
+
+func (m *TrustRegistry) GetDeposit() int64 {
+	if m != nil {
+		return m.Deposit
+	}
+	return 0
+}
+
+func (m *TrustRegistry) GetAka() string {
+	if m != nil {
+		return m.Aka
+	}
+	return ""
+}
+
+func (m *TrustRegistry) GetActiveVersion() int32 {
+	if m != nil {
+		return m.ActiveVersion
+	}
+	return 0
+}
+
+func (m *TrustRegistry) GetLanguage() string {
+	if m != nil {
+		return m.Language
+	}
+	return ""
+}
+
+type GovernanceFrameworkVersion struct {
+	Id          uint64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TrId        uint64    `protobuf:"varint,2,opt,name=tr_id,json=trId,proto3" json:"tr_id,omitempty"`
+	Created     time.Time `protobuf:"bytes,3,opt,name=created,proto3,stdtime" json:"created"`
+	Version     int32     `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	ActiveSince time.Time `protobuf:"bytes,5,opt,name=active_since,json=activeSince,proto3,stdtime" json:"active_since"`
+}
+
+func (m *GovernanceFrameworkVersion) Reset()         { *m = GovernanceFrameworkVersion{} }
+func (m *GovernanceFrameworkVersion) String() string { return proto.CompactTextString(m) }
+func (*GovernanceFrameworkVersion) ProtoMessage()    {}
+func (*GovernanceFrameworkVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387990c87038b3cd, []int{1}
+}
+func (m *GovernanceFrameworkVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovernanceFrameworkVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GovernanceFrameworkVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GovernanceFrameworkVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernanceFrameworkVersion.Merge(m, src)
+}
+func (m *GovernanceFrameworkVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovernanceFrameworkVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernanceFrameworkVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernanceFrameworkVersion proto.InternalMessageInfo
+
+func (m *GovernanceFrameworkVersion) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *GovernanceFrameworkVersion) GetTrId() uint64 {
+	if m != nil {
+		return m.TrId
+	}
+	return 0
+}
+
+func (m *GovernanceFrameworkVersion) GetCreated() time.Time {
+	if m != nil {
+		return m.Created
+	}
+	return time.Time{}
+}
+
+func (m *GovernanceFrameworkVersion) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *GovernanceFrameworkVersion) GetActiveSince() time.Time {
+	if m != nil {
+		return m.ActiveSince
+	}
+	return time.Time{}
+}
+
+type GovernanceFrameworkDocument struct {
+	Id        uint64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	GfvId     uint64    `protobuf:"varint,2,opt,name=gfv_id,json=gfvId,proto3" json:"gfv_id,omitempty"`
+	Created   time.Time `protobuf:"bytes,3,opt,name=created,proto3,stdtime" json:"created"`
+	Language  string    `protobuf:"bytes,4,opt,name=language,proto3" json:"language,omitempty"`
+	Url       string    `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
+	DigestSri string    `protobuf:"bytes,6,opt,name=digest_sri,json=digestSri,proto3" json:"digest_sri,omitempty"`
+}
+
+func (m *GovernanceFrameworkDocument) Reset()         { *m = GovernanceFrameworkDocument{} }
+func (m *GovernanceFrameworkDocument) String() string { return proto.CompactTextString(m) }
+func (*GovernanceFrameworkDocument) ProtoMessage()    {}
+func (*GovernanceFrameworkDocument) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387990c87038b3cd, []int{2}
+}
+func (m *GovernanceFrameworkDocument) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovernanceFrameworkDocument) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GovernanceFrameworkDocument.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GovernanceFrameworkDocument) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernanceFrameworkDocument.Merge(m, src)
+}
+func (m *GovernanceFrameworkDocument) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovernanceFrameworkDocument) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernanceFrameworkDocument.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernanceFrameworkDocument proto.InternalMessageInfo
+
+func (m *GovernanceFrameworkDocument) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *GovernanceFrameworkDocument) GetGfvId() uint64 {
+	if m != nil {
+		return m.GfvId
+	}
+	return 0
+}
+
+func (m *GovernanceFrameworkDocument) GetCreated() time.Time {
+	if m != nil {
+		return m.Created
+	}
+	return time.Time{}
+}
+
+func (m *GovernanceFrameworkDocument) GetLanguage() string {
+	if m != nil {
+		return m.Language
+	}
+	return ""
+}
+
+func (m *GovernanceFrameworkDocument) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *GovernanceFrameworkDocument) GetDigestSri() string {
+	if m != nil {
+		return m.DigestSri
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*TrustRegistry)(nil), "verana.tr.migrations.v2.TrustRegistry")
+	proto.RegisterType((*GovernanceFrameworkVersion)(nil), "verana.tr.migrations.v2.GovernanceFrameworkVersion")
+	proto.RegisterType((*GovernanceFrameworkDocument)(nil), "verana.tr.migrations.v2.GovernanceFrameworkDocument")
+}
+
+func init() {
+	proto.RegisterFile("verana/tr/migrations/v2/legacy.proto", fileDescriptor_387990c87038b3cd)
+}
+
+var fileDescriptor_387990c87038b3cd = []byte{
+	// 559 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xcd, 0x36, 0x4e, 0x93, 0x6c, 0x69, 0x85, 0x4c, 0x11, 0x4b, 0x10, 0x6e, 0x54, 0x81, 0x94,
+	0x4b, 0x6d, 0xa9, 0x5c, 0x38, 0x21, 0xa8, 0x50, 0x2b, 0xae, 0x6e, 0xc5, 0x81, 0x4b, 0xd8, 0xec,
+	0x6e, 0x36, 0xab, 0xd8, 0xde, 0x68, 0x76, 0x6d, 0xe8, 0x5f, 0xf4, 0x0b, 0xf8, 0x0a, 0x3e, 0xa2,
+	0xc7, 0xc2, 0x89, 0x13, 0xa0, 0x84, 0x0f, 0x41, 0xf6, 0xc6, 0x6d, 0x0a, 0x1c, 0x28, 0xe2, 0x36,
+	0x33, 0x9e, 0x37, 0x7a, 0x6f, 0x9e, 0x67, 0xf1, 0xa3, 0x42, 0x00, 0xcd, 0x68, 0x64, 0x21, 0x4a,
+	0x95, 0x04, 0x6a, 0x95, 0xce, 0x4c, 0x54, 0xec, 0x47, 0x89, 0x90, 0x94, 0x9d, 0x86, 0x33, 0xd0,
+	0x56, 0xfb, 0xf7, 0x5c, 0x57, 0x68, 0x21, 0xbc, 0xea, 0x0a, 0x8b, 0xfd, 0xde, 0x7d, 0xa6, 0x4d,
+	0xaa, 0xcd, 0xb0, 0x6a, 0x8b, 0x5c, 0xe2, 0x30, 0xbd, 0x6d, 0xa9, 0xa5, 0x76, 0xf5, 0x32, 0x5a,
+	0x56, 0x77, 0xa4, 0xd6, 0x32, 0x11, 0x51, 0x95, 0x8d, 0xf2, 0x71, 0x64, 0x55, 0x2a, 0x8c, 0xa5,
+	0xe9, 0xcc, 0x35, 0xec, 0x7e, 0x68, 0xe2, 0xcd, 0x13, 0xc8, 0x8d, 0x8d, 0x85, 0x54, 0xc6, 0xc2,
+	0xa9, 0xbf, 0x85, 0xd7, 0x14, 0x27, 0xa8, 0x8f, 0x06, 0x5e, 0xbc, 0xa6, 0xb8, 0x7f, 0x1b, 0x37,
+	0xb9, 0xe2, 0x64, 0xad, 0x8f, 0x06, 0xdd, 0xb8, 0x0c, 0xfd, 0xa7, 0x18, 0x33, 0x9d, 0x59, 0xd0,
+	0x49, 0x22, 0x80, 0x34, 0xcb, 0x0f, 0x07, 0xe4, 0xf3, 0xc7, 0xbd, 0xed, 0x25, 0xa1, 0x17, 0x9c,
+	0x83, 0x30, 0xe6, 0xd8, 0x82, 0xca, 0x64, 0xbc, 0xd2, 0xeb, 0x3f, 0xc3, 0x6d, 0x06, 0x82, 0x5a,
+	0xc1, 0x89, 0xd7, 0x47, 0x83, 0x8d, 0xfd, 0x5e, 0xe8, 0x08, 0x86, 0x35, 0xc1, 0xf0, 0xa4, 0x26,
+	0x78, 0xd0, 0x39, 0xff, 0xba, 0xd3, 0x38, 0xfb, 0xb6, 0x83, 0xe2, 0x1a, 0xe4, 0x3f, 0xc7, 0x9d,
+	0x54, 0x73, 0x35, 0x56, 0x82, 0x93, 0xd6, 0x0d, 0x06, 0x5c, 0xa2, 0xca, 0x09, 0x14, 0xd8, 0x44,
+	0x15, 0x82, 0x93, 0xf5, 0xbf, 0x9a, 0x80, 0xdc, 0x84, 0x1a, 0xe5, 0x13, 0xdc, 0xe6, 0x62, 0xa6,
+	0x8d, 0xb2, 0xa4, 0xdd, 0x47, 0x83, 0x66, 0x5c, 0xa7, 0xe5, 0xa6, 0xe8, 0x94, 0x92, 0x8e, 0xdb,
+	0x14, 0x9d, 0x52, 0xff, 0x31, 0xde, 0xa2, 0xcc, 0xaa, 0x42, 0x0c, 0x0b, 0x01, 0x46, 0xe9, 0x8c,
+	0x74, 0xfb, 0x68, 0xd0, 0x8a, 0x37, 0x5d, 0xf5, 0xb5, 0x2b, 0xfa, 0x3d, 0xdc, 0x49, 0x68, 0x26,
+	0x73, 0x2a, 0x05, 0xc1, 0x15, 0xfa, 0x32, 0xdf, 0xfd, 0x81, 0x70, 0xef, 0x48, 0x17, 0x02, 0x32,
+	0x9a, 0x31, 0x71, 0x08, 0x34, 0x15, 0xef, 0x34, 0x4c, 0x6b, 0xe8, 0xaf, 0x6e, 0xdd, 0xc1, 0x2d,
+	0x0b, 0xc3, 0xa5, 0x5f, 0x5e, 0xec, 0x59, 0x78, 0xc5, 0x57, 0xd7, 0xde, 0xfc, 0x97, 0xb5, 0x13,
+	0xdc, 0xae, 0xf9, 0x7b, 0x15, 0xff, 0x3a, 0xf5, 0x8f, 0xf0, 0xad, 0xa5, 0x40, 0xa3, 0x32, 0x26,
+	0x6e, 0x64, 0xca, 0x86, 0x43, 0x1e, 0x97, 0xc0, 0xdd, 0x4f, 0x08, 0x3f, 0xf8, 0x83, 0xcc, 0x97,
+	0x9a, 0xe5, 0xa9, 0xc8, 0xec, 0x6f, 0x3a, 0xef, 0xe2, 0x75, 0x39, 0x2e, 0xae, 0x84, 0xb6, 0xe4,
+	0xb8, 0xf8, 0x0f, 0x4a, 0x57, 0x9d, 0xf0, 0xae, 0x3b, 0x51, 0xda, 0x9b, 0x43, 0x52, 0x49, 0xec,
+	0xc6, 0x65, 0xe8, 0x3f, 0xc4, 0x98, 0x2b, 0x29, 0x8c, 0x1d, 0x1a, 0x50, 0xd5, 0xef, 0xd4, 0x8d,
+	0xbb, 0xae, 0x72, 0x0c, 0xea, 0xe0, 0xed, 0xf9, 0x3c, 0x40, 0x17, 0xf3, 0x00, 0x7d, 0x9f, 0x07,
+	0xe8, 0x6c, 0x11, 0x34, 0x2e, 0x16, 0x41, 0xe3, 0xcb, 0x22, 0x68, 0xbc, 0x39, 0x94, 0xca, 0x4e,
+	0xf2, 0x51, 0xc8, 0x74, 0x1a, 0xb9, 0x5b, 0xdf, 0x4b, 0xe8, 0xc8, 0xd4, 0xf1, 0x28, 0xd1, 0x6c,
+	0xca, 0x26, 0x54, 0x65, 0xd1, 0xfb, 0xc8, 0x96, 0xd7, 0x09, 0xcb, 0xeb, 0xbc, 0xfe, 0x6a, 0x8c,
+	0xd6, 0x2b, 0x55, 0x4f, 0x7e, 0x0e, 0x00, 0xf2, 0xd6, 0xfb, 0xb5, 0x57, 0x04, 0x00, 0x00,
+}
+
+func (m *TrustRegistry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrustRegistry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrustRegistry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Language) > 0 {
+		i -= len(m.Language)
+		copy(dAtA[i:], m.Language)
+		i = encodeVarintLegacy(dAtA, i, uint64(len(m.Language)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.ActiveVersion != 0 {
+		i = encodeVarintLegacy(dAtA, i, uint64(m.ActiveVersion))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Aka) > 0 {
+		i -= len(m.Aka)
+		copy(dAtA[i:], m.Aka)
+		i = encodeVarintLegacy(dAtA, i, uint64(len(m.Aka)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Deposit != 0 {
+		i = encodeVarintLegacy(dAtA, i, uint64(m.Deposit))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Archived != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Archived, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Archived):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintLegacy(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x32
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Modified, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Modified):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintLegacy(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Created):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintLegacy(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintLegacy(dAtA, i, uint64(len(m.Controller)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintLegacy(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintLegacy(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GovernanceFrameworkVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GovernanceFrameworkVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GovernanceFrameworkVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ActiveSince, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ActiveSince):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintLegacy(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x2a
+	if m.Version != 0 {
+		i = encodeVarintLegacy(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x20
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Created):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintLegacy(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	if m.TrId != 0 {
+		i = encodeVarintLegacy(dAtA, i, uint64(m.TrId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintLegacy(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GovernanceFrameworkDocument) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GovernanceFrameworkDocument) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GovernanceFrameworkDocument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DigestSri) > 0 {
+		i -= len(m.DigestSri)
+		copy(dAtA[i:], m.DigestSri)
+		i = encodeVarintLegacy(dAtA, i, uint64(len(m.DigestSri)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintLegacy(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Language) > 0 {
+		i -= len(m.Language)
+		copy(dAtA[i:], m.Language)
+		i = encodeVarintLegacy(dAtA, i, uint64(len(m.Language)))
+		i--
+		dAtA[i] = 0x22
+	}
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Created):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintLegacy(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x1a
+	if m.GfvId != 0 {
+		i = encodeVarintLegacy(dAtA, i, uint64(m.GfvId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintLegacy(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLegacy(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLegacy(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TrustRegistry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLegacy(uint64(m.Id))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovLegacy(uint64(l))
+	}
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovLegacy(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovLegacy(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Modified)
+	n += 1 + l + sovLegacy(uint64(l))
+	if m.Archived != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Archived)
+		n += 1 + l + sovLegacy(uint64(l))
+	}
+	if m.Deposit != 0 {
+		n += 1 + sovLegacy(uint64(m.Deposit))
+	}
+	l = len(m.Aka)
+	if l > 0 {
+		n += 1 + l + sovLegacy(uint64(l))
+	}
+	if m.ActiveVersion != 0 {
+		n += 1 + sovLegacy(uint64(m.ActiveVersion))
+	}
+	l = len(m.Language)
+	if l > 0 {
+		n += 1 + l + sovLegacy(uint64(l))
+	}
+	return n
+}
+
+func (m *GovernanceFrameworkVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLegacy(uint64(m.Id))
+	}
+	if m.TrId != 0 {
+		n += 1 + sovLegacy(uint64(m.TrId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovLegacy(uint64(l))
+	if m.Version != 0 {
+		n += 1 + sovLegacy(uint64(m.Version))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ActiveSince)
+	n += 1 + l + sovLegacy(uint64(l))
+	return n
+}
+
+func (m *GovernanceFrameworkDocument) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLegacy(uint64(m.Id))
+	}
+	if m.GfvId != 0 {
+		n += 1 + sovLegacy(uint64(m.GfvId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovLegacy(uint64(l))
+	l = len(m.Language)
+	if l > 0 {
+		n += 1 + l + sovLegacy(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovLegacy(uint64(l))
+	}
+	l = len(m.DigestSri)
+	if l > 0 {
+		n += 1 + l + sovLegacy(uint64(l))
+	}
+	return n
+}
+
+func sovLegacy(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLegacy(x uint64) (n int) {
+	return sovLegacy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TrustRegistry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLegacy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrustRegistry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrustRegistry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Controller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Modified", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Modified, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Archived", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Archived == nil {
+				m.Archived = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Archived, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			m.Deposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deposit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aka", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aka = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveVersion", wireType)
+			}
+			m.ActiveVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveVersion |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Language", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Language = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLegacy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GovernanceFrameworkVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLegacy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GovernanceFrameworkVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GovernanceFrameworkVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrId", wireType)
+			}
+			m.TrId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveSince", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ActiveSince, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLegacy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GovernanceFrameworkDocument) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLegacy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GovernanceFrameworkDocument: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GovernanceFrameworkDocument: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GfvId", wireType)
+			}
+			m.GfvId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GfvId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Language", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Language = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DigestSri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DigestSri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLegacy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLegacy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLegacy(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLegacy
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLegacy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLegacy
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLegacy
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLegacy
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLegacy        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLegacy          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLegacy = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,90 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/verana-labs/verana-blockchain/x/trustregistry/types"
+)
+
+// MigrateStore performs in-place store migrations from ConsensusVersion 1 to 2.
+// The v1 entries are decoded with the frozen v1 types of this package:
+//
+//   - the trust registry DID index is rebuilt, the v0.6 upgrade restored the
+//     trust registries without it so they could not be looked up by DID.
+//   - the tr, gfv and gfd counters are moved up to the highest stored ID, so
+//     that new entries never overwrite restored ones.
+func MigrateStore(ctx context.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	sb := collections.NewSchemaBuilder(storeService)
+	trustRegistries := collections.NewMap(sb, types.TrustRegistryKey, "trust_registry", collections.Uint64Key, codec.CollValue[TrustRegistry](cdc))
+	didIndex := collections.NewMap(sb, types.TrustRegistryDIDIndex, "trust_registry_did_index", collections.StringKey, collections.Uint64Value)
+	gfVersions := collections.NewMap(sb, types.GovernanceFrameworkVersionKey, "gf_version", collections.Uint64Key, codec.CollValue[GovernanceFrameworkVersion](cdc))
+	gfDocuments := collections.NewMap(sb, types.GovernanceFrameworkDocumentKey, "gf_document", collections.Uint64Key, codec.CollValue[GovernanceFrameworkDocument](cdc))
+	counters := collections.NewMap(sb, types.CounterKey, "counter", collections.StringKey, collections.Uint64Value)
+	if _, err := sb.Build(); err != nil {
+		return err
+	}
+
+	maxTrID := uint64(0)
+	err := trustRegistries.Walk(ctx, nil, func(id uint64, tr TrustRegistry) (bool, error) {
+		if err := didIndex.Set(ctx, tr.Did, id); err != nil {
+			return true, fmt.Errorf("failed to index trust registry %d: %w", id, err)
+		}
+		if id > maxTrID {
+			maxTrID = id
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	maxGfvID, err := maxKey(ctx, gfVersions)
+	if err != nil {
+		return err
+	}
+	maxGfdID, err := maxKey(ctx, gfDocuments)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range []struct {
+		entityType string
+		maxID      uint64
+	}{
+		{"tr", maxTrID},
+		{"gfv", maxGfvID},
+		{"gfd", maxGfdID},
+	} {
+		current, err := counters.Get(ctx, c.entityType)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		if current < c.maxID {
+			if err := counters.Set(ctx, c.entityType, c.maxID); err != nil {
+				return fmt.Errorf("failed to set %s counter: %w", c.entityType, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// maxKey returns the highest key of a uint64 keyed map, or 0 when it is empty.
+func maxKey[V any](ctx context.Context, m collections.Map[uint64, V]) (uint64, error) {
+	iter, err := m.Iterate(ctx, new(collections.Range[uint64]).Descending())
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 0, nil
+	}
+	return iter.Key()
+}
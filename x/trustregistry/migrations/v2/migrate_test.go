@@ -0,0 +1,96 @@
+package v2_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/stretchr/testify/require"
+
+	v2 "github.com/verana-labs/verana-blockchain/x/trustregistry/migrations/v2"
+	"github.com/verana-labs/verana-blockchain/x/trustregistry/types"
+)
+
+// v1State is the v1 store fixture, its entries are decoded with the frozen v1
+// types.
+type v1State struct {
+	TrustRegistries              []json.RawMessage `json:"trust_registries"`
+	GovernanceFrameworkVersions  []json.RawMessage `json:"governance_framework_versions"`
+	GovernanceFrameworkDocuments []json.RawMessage `json:"governance_framework_documents"`
+	Counters                     []struct {
+		EntityType string `json:"entity_type"`
+		Value      uint64 `json:"value,string"`
+	} `json:"counters"`
+}
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	storeService := runtime.NewKVStoreService(storeKey)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	sb := collections.NewSchemaBuilder(storeService)
+	v1TrustRegistries := collections.NewMap(sb, types.TrustRegistryKey, "trust_registry", collections.Uint64Key, codec.CollValue[v2.TrustRegistry](cdc))
+	v1GFVersions := collections.NewMap(sb, types.GovernanceFrameworkVersionKey, "gf_version", collections.Uint64Key, codec.CollValue[v2.GovernanceFrameworkVersion](cdc))
+	v1GFDocuments := collections.NewMap(sb, types.GovernanceFrameworkDocumentKey, "gf_document", collections.Uint64Key, codec.CollValue[v2.GovernanceFrameworkDocument](cdc))
+	didIndex := collections.NewMap(sb, types.TrustRegistryDIDIndex, "trust_registry_did_index", collections.StringKey, collections.Uint64Value)
+	counters := collections.NewMap(sb, types.CounterKey, "counter", collections.StringKey, collections.Uint64Value)
+	_, err := sb.Build()
+	require.NoError(t, err)
+
+	// load the v1 state as restored by the v0.6 upgrade: no DID index and a
+	// gfv counter that is behind, while the gfd counter is missing
+	bz, err := os.ReadFile("testdata/v1_state.json")
+	require.NoError(t, err)
+	var fixture v1State
+	require.NoError(t, json.Unmarshal(bz, &fixture))
+
+	var dids []string
+	for _, raw := range fixture.TrustRegistries {
+		var tr v2.TrustRegistry
+		require.NoError(t, cdc.UnmarshalJSON(raw, &tr))
+		require.NoError(t, v1TrustRegistries.Set(ctx, tr.Id, tr))
+		dids = append(dids, tr.Did)
+	}
+	for _, raw := range fixture.GovernanceFrameworkVersions {
+		var gfv v2.GovernanceFrameworkVersion
+		require.NoError(t, cdc.UnmarshalJSON(raw, &gfv))
+		require.NoError(t, v1GFVersions.Set(ctx, gfv.Id, gfv))
+	}
+	for _, raw := range fixture.GovernanceFrameworkDocuments {
+		var gfd v2.GovernanceFrameworkDocument
+		require.NoError(t, cdc.UnmarshalJSON(raw, &gfd))
+		require.NoError(t, v1GFDocuments.Set(ctx, gfd.Id, gfd))
+	}
+	for _, c := range fixture.Counters {
+		require.NoError(t, counters.Set(ctx, c.EntityType, c.Value))
+	}
+
+	require.NoError(t, v2.MigrateStore(ctx, storeService, cdc))
+
+	// every trust registry can be found by DID again, and decodes as a
+	// current trust registry
+	trustRegistries := collections.NewMap(collections.NewSchemaBuilder(storeService), types.TrustRegistryKey, "trust_registry", collections.Uint64Key, codec.CollValue[types.TrustRegistry](cdc))
+	for i, did := range dids {
+		id, err := didIndex.Get(ctx, did)
+		require.NoError(t, err)
+		require.Equal(t, uint64(i+1), id)
+		tr, err := trustRegistries.Get(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, did, tr.Did)
+	}
+
+	// counters caught up with the stored entries, so that new entries do not
+	// collide with restored ones
+	for entityType, expected := range map[string]uint64{"tr": 3, "gfv": 3, "gfd": 3} {
+		value, err := counters.Get(ctx, entityType)
+		require.NoError(t, err)
+		require.Equal(t, expected, value, entityType)
+	}
+}
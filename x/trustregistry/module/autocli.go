@@ -70,6 +70,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Get the current module parameters",
 				},
+				{
+					RpcMethod: "GetTrustRegistryController",
+					Use:       "get-trust-registry-controller [tr_id]",
+					Short:     "Get the controller of a trust registry",
+					Long:      "Get the controller of a trust registry and, when it is an x/group policy, the policy and the members of its group",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "tr_id"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -144,6 +153,16 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "archive"},
 					},
 				},
+				{
+					RpcMethod: "UpdateTrustRegistryController",
+					Use:       "update-trust-registry-controller [id] [controller]",
+					Short:     "Hand control of a trust registry to a new controller",
+					Long:      "Set the controller of a trust registry, for example an x/group policy address. Only the current controller can update it.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+						{ProtoField: "controller"},
+					},
+				},
 			},
 		},
 	}
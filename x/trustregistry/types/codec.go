@@ -14,6 +14,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgAddGovernanceFrameworkDocument{}, "/vpr/v1/tr/add-gfd")
 	legacy.RegisterAminoMsg(cdc, &MsgIncreaseActiveGovernanceFrameworkVersion{}, "/vpr/v1/tr/increase-active-gf-version")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateTrustRegistry{}, "/vpr/v1/tr/update-trust-registry")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateTrustRegistryController{}, "/vpr/v1/tr/update-tr-controller")
 
 }
 
@@ -28,6 +29,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgIncreaseActiveGovernanceFrameworkVersion{},
 		&MsgUpdateTrustRegistry{},
 		&MsgArchiveTrustRegistry{},
+		&MsgUpdateTrustRegistryController{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -4,6 +4,7 @@ const (
 	EventTypeCreateTrustRegistry               = "create_trust_registry"
 	EventTypeCreateGovernanceFrameworkVersion  = "create_governance_framework_version"
 	EventTypeCreateGovernanceFrameworkDocument = "create_governance_framework_document"
+	EventTypeUpdateTrustRegistryController     = "update_trust_registry_controller"
 
 	AttributeKeyTrustRegistryID = "trust_registry_id"
 	AttributeKeyDID             = "did"
@@ -17,4 +18,5 @@ const (
 	AttributeKeyDocURL          = "doc_url"
 	AttributeKeyDigestSri       = "digest_sri"
 	AttributeKeyDeposit         = "deposit"
+	AttributeKeyGroupID         = "group_id"
 )
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
 )
 
 // AccountKeeper defines the expected interface for the Account module.
@@ -28,3 +29,10 @@ type ParamSubspace interface {
 type TrustDepositKeeper interface {
 	AdjustTrustDeposit(ctx sdk.Context, account string, augend int64) error
 }
+
+// GroupKeeper defines the expected interface for the Group module. It is used
+// to resolve trust registry controllers that are group policies.
+type GroupKeeper interface {
+	GroupPolicyInfo(ctx context.Context, req *group.QueryGroupPolicyInfoRequest) (*group.QueryGroupPolicyInfoResponse, error)
+	GroupMembers(ctx context.Context, req *group.QueryGroupMembersRequest) (*group.QueryGroupMembersResponse, error)
+}
@@ -9,6 +9,7 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	group "github.com/cosmos/cosmos-sdk/x/group"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -347,6 +348,119 @@ func (m *QueryListTrustRegistriesResponse) GetTrustRegistries() []TrustRegistryW
 	return nil
 }
 
+// QueryGetTrustRegistryControllerRequest is the request type for the Query/GetTrustRegistryController RPC method.
+type QueryGetTrustRegistryControllerRequest struct {
+	TrId uint64 `protobuf:"varint,1,opt,name=tr_id,json=trId,proto3" json:"tr_id,omitempty"`
+}
+
+func (m *QueryGetTrustRegistryControllerRequest) Reset() {
+	*m = QueryGetTrustRegistryControllerRequest{}
+}
+func (m *QueryGetTrustRegistryControllerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetTrustRegistryControllerRequest) ProtoMessage()    {}
+func (*QueryGetTrustRegistryControllerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68af313481ce2988, []int{6}
+}
+func (m *QueryGetTrustRegistryControllerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetTrustRegistryControllerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetTrustRegistryControllerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetTrustRegistryControllerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetTrustRegistryControllerRequest.Merge(m, src)
+}
+func (m *QueryGetTrustRegistryControllerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetTrustRegistryControllerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetTrustRegistryControllerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetTrustRegistryControllerRequest proto.InternalMessageInfo
+
+func (m *QueryGetTrustRegistryControllerRequest) GetTrId() uint64 {
+	if m != nil {
+		return m.TrId
+	}
+	return 0
+}
+
+// QueryGetTrustRegistryControllerResponse is the response type for the Query/GetTrustRegistryController RPC method.
+type QueryGetTrustRegistryControllerResponse struct {
+	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
+	// group_policy is set when the controller is an x/group policy. It carries
+	// the decision policy of the group.
+	GroupPolicy *group.GroupPolicyInfo `protobuf:"bytes,2,opt,name=group_policy,json=groupPolicy,proto3" json:"group_policy,omitempty"`
+	// members are the members of the group behind the group policy
+	Members []*group.GroupMember `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (m *QueryGetTrustRegistryControllerResponse) Reset() {
+	*m = QueryGetTrustRegistryControllerResponse{}
+}
+func (m *QueryGetTrustRegistryControllerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetTrustRegistryControllerResponse) ProtoMessage()    {}
+func (*QueryGetTrustRegistryControllerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68af313481ce2988, []int{7}
+}
+func (m *QueryGetTrustRegistryControllerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetTrustRegistryControllerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetTrustRegistryControllerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetTrustRegistryControllerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetTrustRegistryControllerResponse.Merge(m, src)
+}
+func (m *QueryGetTrustRegistryControllerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetTrustRegistryControllerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetTrustRegistryControllerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetTrustRegistryControllerResponse proto.InternalMessageInfo
+
+func (m *QueryGetTrustRegistryControllerResponse) GetController() string {
+	if m != nil {
+		return m.Controller
+	}
+	return ""
+}
+
+func (m *QueryGetTrustRegistryControllerResponse) GetGroupPolicy() *group.GroupPolicyInfo {
+	if m != nil {
+		return m.GroupPolicy
+	}
+	return nil
+}
+
+func (m *QueryGetTrustRegistryControllerResponse) GetMembers() []*group.GroupMember {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "verana.tr.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "verana.tr.v1.QueryParamsResponse")
@@ -354,58 +468,68 @@ func init() {
 	proto.RegisterType((*QueryGetTrustRegistryResponse)(nil), "verana.tr.v1.QueryGetTrustRegistryResponse")
 	proto.RegisterType((*QueryListTrustRegistriesRequest)(nil), "verana.tr.v1.QueryListTrustRegistriesRequest")
 	proto.RegisterType((*QueryListTrustRegistriesResponse)(nil), "verana.tr.v1.QueryListTrustRegistriesResponse")
+	proto.RegisterType((*QueryGetTrustRegistryControllerRequest)(nil), "verana.tr.v1.QueryGetTrustRegistryControllerRequest")
+	proto.RegisterType((*QueryGetTrustRegistryControllerResponse)(nil), "verana.tr.v1.QueryGetTrustRegistryControllerResponse")
 }
 
 func init() { proto.RegisterFile("verana/tr/v1/query.proto", fileDescriptor_68af313481ce2988) }
 
 var fileDescriptor_68af313481ce2988 = []byte{
-	// 730 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0x13, 0x3b,
-	0x18, 0xcd, 0x34, 0x69, 0xd5, 0xba, 0xff, 0x6e, 0x74, 0x95, 0xe6, 0xf6, 0x26, 0x69, 0x74, 0x25,
-	0xa2, 0xa0, 0x8c, 0x95, 0xb2, 0x80, 0x15, 0x52, 0xb3, 0xa9, 0x10, 0xa5, 0xc0, 0xb4, 0x82, 0x8a,
-	0xcd, 0xc8, 0x49, 0x9c, 0xa9, 0xd5, 0x99, 0xf1, 0xd4, 0x76, 0xa2, 0xa6, 0xc0, 0x86, 0x15, 0x2b,
-	0x54, 0x89, 0x0d, 0x8f, 0x00, 0x3b, 0x16, 0x3c, 0x44, 0x97, 0x15, 0x6c, 0x58, 0x15, 0xd4, 0x22,
-	0xf1, 0x1a, 0x28, 0xb6, 0x07, 0x75, 0x20, 0x41, 0x65, 0x13, 0x79, 0x7c, 0x8e, 0x7d, 0x8e, 0xbf,
-	0xf3, 0x7d, 0x01, 0xb9, 0x1e, 0xe1, 0x38, 0xc4, 0x48, 0x72, 0xd4, 0xab, 0xa3, 0x83, 0x2e, 0xe1,
-	0x7d, 0x3b, 0xe2, 0x4c, 0x32, 0x38, 0xa3, 0x11, 0x5b, 0x72, 0xbb, 0x57, 0xcf, 0x2f, 0xe2, 0x80,
-	0x86, 0x0c, 0xa9, 0x5f, 0x4d, 0xc8, 0x67, 0x3d, 0xe6, 0x31, 0xb5, 0x44, 0x83, 0x95, 0xd9, 0x5d,
-	0xf1, 0x18, 0xf3, 0x7c, 0x82, 0x70, 0x44, 0x11, 0x0e, 0x43, 0x26, 0xb1, 0xa4, 0x2c, 0x14, 0x06,
-	0xad, 0xb6, 0x98, 0x08, 0x98, 0x40, 0x4d, 0x2c, 0x88, 0x56, 0x43, 0xbd, 0x7a, 0x93, 0x48, 0x5c,
-	0x47, 0x11, 0xf6, 0x68, 0xa8, 0xc8, 0x86, 0xbb, 0x9c, 0xb0, 0x16, 0x61, 0x8e, 0x83, 0xf8, 0x9a,
-	0xa4, 0x6b, 0xd9, 0x8f, 0x48, 0x8c, 0x2c, 0x6b, 0x01, 0x57, 0xfb, 0xd2, 0x1f, 0x06, 0x2a, 0x1a,
-	0x67, 0xea, 0xab, 0xd9, 0xed, 0x20, 0x49, 0x03, 0x22, 0x24, 0x0e, 0x22, 0x4d, 0x28, 0x67, 0x01,
-	0x7c, 0x38, 0xb0, 0xf4, 0x40, 0x49, 0x39, 0xe4, 0xa0, 0x4b, 0x84, 0x2c, 0x6f, 0x81, 0xa5, 0xc4,
-	0xae, 0x88, 0x58, 0x28, 0x08, 0xbc, 0x09, 0x26, 0xb4, 0xa5, 0x9c, 0x55, 0xb2, 0x2a, 0xd3, 0x6b,
-	0x59, 0xfb, 0x72, 0xbd, 0x6c, 0xcd, 0x6e, 0x4c, 0x9d, 0x9c, 0x15, 0x53, 0x6f, 0xbf, 0xbf, 0xaf,
-	0x5a, 0x8e, 0xa1, 0x97, 0x5f, 0x5a, 0x60, 0x45, 0x5d, 0xb8, 0x41, 0xe4, 0x0e, 0xef, 0x0a, 0xe9,
-	0x10, 0x8f, 0x0a, 0xc9, 0xfb, 0x46, 0x10, 0x2e, 0x81, 0x71, 0xc9, 0x5d, 0xda, 0x56, 0x17, 0x67,
-	0x9c, 0x8c, 0xe4, 0x77, 0xda, 0xf0, 0x7f, 0x30, 0x87, 0x5b, 0x92, 0xf6, 0x88, 0xeb, 0x75, 0x5c,
-	0x16, 0xfa, 0xfd, 0xdc, 0x58, 0xc9, 0xaa, 0x4c, 0x3a, 0x33, 0x7a, 0x77, 0xa3, 0x73, 0x3f, 0xf4,
-	0xfb, 0xb0, 0x06, 0x60, 0xc4, 0x49, 0x87, 0x70, 0x4e, 0xda, 0xae, 0x8f, 0x43, 0xaf, 0x8b, 0x3d,
-	0x92, 0x4b, 0x97, 0xac, 0xca, 0x94, 0xb3, 0xf8, 0x13, 0xd9, 0x34, 0x40, 0x99, 0x81, 0xff, 0x46,
-	0x38, 0x31, 0x8f, 0xdc, 0x02, 0x73, 0x72, 0x00, 0xb8, 0xdc, 0x20, 0xe6, 0xb1, 0xd7, 0x92, 0x8f,
-	0x4d, 0x1c, 0x7e, 0x4c, 0xe5, 0xde, 0x23, 0xc2, 0xc5, 0x20, 0x75, 0x67, 0x56, 0x5e, 0x86, 0xca,
-	0xef, 0xc6, 0x40, 0x51, 0x29, 0x6e, 0x52, 0x91, 0x90, 0xa4, 0x24, 0xae, 0x37, 0xbc, 0x05, 0x40,
-	0x8b, 0x85, 0x92, 0x33, 0xdf, 0x27, 0x5c, 0xe9, 0x4d, 0x35, 0x72, 0x1f, 0x3f, 0xd4, 0xb2, 0x26,
-	0xcc, 0xf5, 0x76, 0x9b, 0x13, 0x21, 0xb6, 0x25, 0xa7, 0xa1, 0xe7, 0x5c, 0xe2, 0xc2, 0xbb, 0x60,
-	0x2e, 0x60, 0x6d, 0xda, 0xa1, 0xa4, 0xed, 0xe2, 0x8e, 0x24, 0x5c, 0xd5, 0x68, 0x7a, 0x2d, 0x6f,
-	0xeb, 0xe4, 0xed, 0x38, 0x79, 0x7b, 0x27, 0x4e, 0xbe, 0x31, 0x79, 0x72, 0x56, 0xb4, 0x8e, 0xbf,
-	0x14, 0x2d, 0x67, 0x36, 0x3e, 0xbb, 0x3e, 0x38, 0x3a, 0xa4, 0xe0, 0xe9, 0x2b, 0x17, 0x3c, 0x33,
-	0xa2, 0xe0, 0xb0, 0x0a, 0x16, 0xb9, 0xa9, 0xad, 0x1b, 0xe0, 0x43, 0x57, 0xd0, 0x23, 0x92, 0x1b,
-	0x2f, 0x59, 0x95, 0x59, 0x67, 0x3e, 0x06, 0xee, 0xe1, 0xc3, 0x6d, 0x7a, 0x44, 0xca, 0xcf, 0x40,
-	0x69, 0x74, 0xa9, 0x4c, 0x3e, 0xbb, 0x60, 0x21, 0x91, 0x0f, 0x25, 0x83, 0x76, 0x4c, 0xff, 0x45,
-	0x42, 0x8d, 0xcc, 0xa0, 0x43, 0x9d, 0x79, 0x99, 0x54, 0x58, 0x7b, 0x93, 0x06, 0xe3, 0x4a, 0x1e,
-	0xee, 0x83, 0x09, 0xdd, 0xcc, 0xb0, 0x94, 0xbc, 0xf3, 0xf7, 0x59, 0xc9, 0xaf, 0xfe, 0x81, 0xa1,
-	0x2d, 0x97, 0x57, 0x5e, 0x7c, 0xfa, 0xf6, 0x7a, 0xec, 0x1f, 0x98, 0x45, 0x43, 0xc6, 0x1b, 0xbe,
-	0xb2, 0xc0, 0xc2, 0xaf, 0xdd, 0x08, 0xab, 0x43, 0x6e, 0x1d, 0x31, 0x3c, 0xf9, 0xeb, 0x57, 0xe2,
-	0x1a, 0x2f, 0xab, 0xca, 0xcb, 0xbf, 0x70, 0x39, 0xe9, 0xc5, 0x23, 0x12, 0x3d, 0x55, 0x23, 0xf8,
-	0x1c, 0x1e, 0x5b, 0x60, 0x69, 0x48, 0x02, 0xb0, 0x36, 0x44, 0x67, 0x74, 0x53, 0xe7, 0xed, 0xab,
-	0xd2, 0x8d, 0xb3, 0xbc, 0x72, 0x96, 0x85, 0x30, 0xe9, 0xcc, 0xa7, 0x42, 0x36, 0x76, 0x4f, 0xce,
-	0x0b, 0xd6, 0xe9, 0x79, 0xc1, 0xfa, 0x7a, 0x5e, 0xb0, 0x8e, 0x2f, 0x0a, 0xa9, 0xd3, 0x8b, 0x42,
-	0xea, 0xf3, 0x45, 0x21, 0xf5, 0xe4, 0xb6, 0x47, 0xe5, 0x5e, 0xb7, 0x69, 0xb7, 0x58, 0x60, 0xce,
-	0xd5, 0x7c, 0xdc, 0x14, 0xf1, 0xba, 0xe9, 0xb3, 0xd6, 0x7e, 0x6b, 0x0f, 0xd3, 0x10, 0x1d, 0x22,
-	0x15, 0x78, 0x3c, 0xd6, 0xfa, 0x2f, 0xb4, 0x39, 0xa1, 0x06, 0xe4, 0xc6, 0x8f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xbf, 0x02, 0xd6, 0x97, 0x15, 0x06, 0x00, 0x00,
+	// 854 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xc4, 0x4e, 0x68, 0x26, 0x3f, 0xda, 0x4c, 0x2c, 0xe4, 0x6c, 0x8d, 0xbd, 0x5d, 0x21,
+	0x6a, 0x19, 0x79, 0x57, 0x0e, 0x3f, 0x2f, 0x20, 0xd5, 0x3d, 0x44, 0x15, 0x6d, 0x29, 0xdb, 0x0a,
+	0x2a, 0x2e, 0xab, 0x59, 0x7b, 0xbc, 0x19, 0x75, 0x77, 0x67, 0x3b, 0x33, 0xb6, 0xe2, 0x02, 0x17,
+	0x4e, 0x9c, 0x50, 0x24, 0xfe, 0x09, 0xb8, 0x21, 0xc1, 0x1f, 0x91, 0x13, 0xaa, 0xe0, 0xc2, 0xa9,
+	0x44, 0x09, 0x12, 0xff, 0x06, 0xda, 0xd9, 0xd9, 0x24, 0x9b, 0xd8, 0xc1, 0xe1, 0x62, 0xcd, 0xce,
+	0xfb, 0xde, 0xbc, 0xef, 0x7d, 0xef, 0x9b, 0x31, 0xac, 0x8d, 0x09, 0xc7, 0x31, 0x76, 0x24, 0x77,
+	0xc6, 0x5d, 0xe7, 0xf9, 0x88, 0xf0, 0x89, 0x9d, 0x70, 0x26, 0x19, 0x5a, 0xcd, 0x22, 0xb6, 0xe4,
+	0xf6, 0xb8, 0x6b, 0x6c, 0xe0, 0x88, 0xc6, 0xcc, 0x51, 0xbf, 0x19, 0xc0, 0xa8, 0x06, 0x2c, 0x60,
+	0x6a, 0xe9, 0xa4, 0x2b, 0xbd, 0x5b, 0x0f, 0x18, 0x0b, 0x42, 0xe2, 0xe0, 0x84, 0x3a, 0x38, 0x8e,
+	0x99, 0xc4, 0x92, 0xb2, 0x58, 0xe8, 0x68, 0xbb, 0xcf, 0x44, 0xc4, 0x84, 0xe3, 0x63, 0x41, 0xb2,
+	0x6a, 0xce, 0xb8, 0xeb, 0x13, 0x89, 0xbb, 0x4e, 0x82, 0x03, 0x1a, 0x2b, 0xb0, 0xc6, 0x6e, 0x15,
+	0xa8, 0x25, 0x98, 0xe3, 0x28, 0x3f, 0xa6, 0xc8, 0x5a, 0x4e, 0x12, 0x92, 0x47, 0xb6, 0xb2, 0x02,
+	0x5e, 0xc6, 0x2b, 0xfb, 0xd0, 0xa1, 0xa6, 0x66, 0xa6, 0xbe, 0xfc, 0xd1, 0xd0, 0x91, 0x34, 0x22,
+	0x42, 0xe2, 0x28, 0xd1, 0x80, 0x9b, 0x9a, 0x5c, 0xc0, 0xd9, 0x28, 0x39, 0x77, 0xb0, 0x55, 0x85,
+	0xe8, 0xb3, 0x94, 0xef, 0x23, 0xc5, 0xc3, 0x25, 0xcf, 0x47, 0x44, 0x48, 0xeb, 0x21, 0xdc, 0x2c,
+	0xec, 0x8a, 0x84, 0xc5, 0x82, 0xa0, 0x0f, 0xe0, 0x52, 0xc6, 0xb7, 0x06, 0x4c, 0xd0, 0x5a, 0xd9,
+	0xae, 0xda, 0x67, 0xc5, 0xb4, 0x33, 0x74, 0x6f, 0xf9, 0xe0, 0x55, 0xb3, 0xf4, 0xe3, 0x3f, 0x3f,
+	0xb7, 0x81, 0xab, 0xe1, 0xd6, 0x77, 0x00, 0xd6, 0xd5, 0x81, 0x3b, 0x44, 0x3e, 0xe1, 0x23, 0x21,
+	0x5d, 0x12, 0x50, 0x21, 0xf9, 0x44, 0x17, 0x44, 0x9b, 0x70, 0x51, 0x72, 0x8f, 0x0e, 0xd4, 0xc1,
+	0x15, 0xb7, 0x22, 0xf9, 0xbd, 0x01, 0x7a, 0x13, 0xae, 0xe3, 0xbe, 0xa4, 0x63, 0xe2, 0x05, 0x43,
+	0x8f, 0xc5, 0xe1, 0xa4, 0xb6, 0x60, 0x82, 0xd6, 0x35, 0x77, 0x35, 0xdb, 0xdd, 0x19, 0x7e, 0x1a,
+	0x87, 0x13, 0xd4, 0x81, 0x28, 0xe1, 0x64, 0x48, 0x38, 0x27, 0x03, 0x2f, 0xc4, 0x71, 0x30, 0xc2,
+	0x01, 0xa9, 0x95, 0x4d, 0xd0, 0x5a, 0x76, 0x37, 0x4e, 0x22, 0xf7, 0x75, 0xc0, 0x62, 0xf0, 0x8d,
+	0x19, 0x4c, 0x74, 0x93, 0x0f, 0xe1, 0xba, 0x4c, 0x03, 0x1e, 0xd7, 0x11, 0xdd, 0xec, 0xed, 0x62,
+	0xb3, 0x85, 0xe4, 0x2f, 0xa8, 0xdc, 0xfd, 0x9c, 0x70, 0x91, 0x5a, 0xc2, 0x5d, 0x93, 0x67, 0x43,
+	0xd6, 0x4f, 0x0b, 0xb0, 0xa9, 0x2a, 0xde, 0xa7, 0xa2, 0x50, 0x92, 0x92, 0x5c, 0x6f, 0xf4, 0x21,
+	0x84, 0x7d, 0x16, 0x4b, 0xce, 0xc2, 0x90, 0x70, 0x55, 0x6f, 0xb9, 0x57, 0xfb, 0xfd, 0xd7, 0x4e,
+	0x55, 0x4f, 0xfa, 0xce, 0x60, 0xc0, 0x89, 0x10, 0x8f, 0x25, 0xa7, 0x71, 0xe0, 0x9e, 0xc1, 0xa2,
+	0x4f, 0xe0, 0x7a, 0xc4, 0x06, 0x74, 0x48, 0xc9, 0xc0, 0xc3, 0x43, 0x49, 0xb8, 0xd2, 0x68, 0x65,
+	0xdb, 0xb0, 0x33, 0x5b, 0xd8, 0xb9, 0x2d, 0xec, 0x27, 0xb9, 0x2d, 0x7a, 0xd7, 0x0e, 0x5e, 0x35,
+	0xc1, 0xfe, 0x5f, 0x4d, 0xe0, 0xae, 0xe5, 0xb9, 0x77, 0xd2, 0xd4, 0x29, 0x82, 0x97, 0xe7, 0x16,
+	0xbc, 0x32, 0x43, 0x70, 0xd4, 0x86, 0x1b, 0x5c, 0x6b, 0xeb, 0x45, 0x78, 0xcf, 0x13, 0xf4, 0x05,
+	0xa9, 0x2d, 0x9a, 0xa0, 0xb5, 0xe6, 0x5e, 0xcf, 0x03, 0x0f, 0xf0, 0xde, 0x63, 0xfa, 0x82, 0x58,
+	0x5f, 0x43, 0x73, 0xb6, 0x54, 0x7a, 0x3e, 0x4f, 0xe1, 0x8d, 0xc2, 0x7c, 0x28, 0x49, 0xed, 0x58,
+	0xbe, 0xc2, 0x84, 0x7a, 0x95, 0xd4, 0xa1, 0xee, 0x75, 0x59, 0xac, 0x60, 0x7d, 0x04, 0xdf, 0x9a,
+	0x6a, 0x8d, 0xbb, 0x27, 0x72, 0x5f, 0x66, 0x57, 0xeb, 0x10, 0xc0, 0xdb, 0xff, 0x99, 0xaf, 0x9b,
+	0xf8, 0xff, 0x03, 0xbf, 0x0b, 0x57, 0xd5, 0x45, 0xf6, 0x12, 0x16, 0xd2, 0xfe, 0x44, 0x8f, 0xdb,
+	0xb4, 0x75, 0xa2, 0x8a, 0xa5, 0xdd, 0xef, 0xa4, 0x8b, 0x47, 0x0a, 0x73, 0x2f, 0x1e, 0x32, 0x77,
+	0x25, 0x38, 0xdd, 0x40, 0xef, 0xc3, 0xd7, 0x22, 0x12, 0xf9, 0x84, 0x8b, 0x5a, 0x59, 0x49, 0x57,
+	0x9f, 0x9e, 0xff, 0x40, 0x81, 0xdc, 0x1c, 0xbc, 0xfd, 0x5b, 0x05, 0x2e, 0xaa, 0x16, 0xd1, 0x33,
+	0xb8, 0x94, 0x5d, 0x77, 0x64, 0x16, 0x55, 0xbf, 0xf8, 0x9a, 0x18, 0xb7, 0x2e, 0x41, 0x64, 0x7a,
+	0x58, 0xf5, 0x6f, 0xff, 0xf8, 0xfb, 0x87, 0x85, 0xd7, 0x51, 0xd5, 0x99, 0xf2, 0x3a, 0xa2, 0xef,
+	0x01, 0xbc, 0x71, 0x5e, 0x54, 0xd4, 0x9e, 0x72, 0xea, 0x8c, 0xe7, 0xc5, 0x78, 0x7b, 0x2e, 0xac,
+	0xe6, 0x72, 0x4b, 0x71, 0xb9, 0x89, 0xb6, 0x8a, 0x5c, 0x02, 0x22, 0x9d, 0xaf, 0xd4, 0xd4, 0xbf,
+	0x41, 0xfb, 0x00, 0x6e, 0x4e, 0xf1, 0x28, 0xea, 0x4c, 0xa9, 0x33, 0xfb, 0xda, 0x1b, 0xf6, 0xbc,
+	0x70, 0xcd, 0xcc, 0x50, 0xcc, 0xaa, 0x08, 0x15, 0x99, 0x85, 0x54, 0x48, 0xf4, 0x0b, 0x80, 0xc6,
+	0x6c, 0xe3, 0xa1, 0x77, 0xe7, 0x50, 0xe0, 0x82, 0xcf, 0x8d, 0xf7, 0xae, 0x98, 0xa5, 0x79, 0xb6,
+	0x14, 0x4f, 0x0b, 0x99, 0x45, 0x9e, 0xa7, 0x2e, 0xce, 0x85, 0xec, 0x3d, 0x3d, 0x38, 0x6a, 0x80,
+	0x97, 0x47, 0x0d, 0x70, 0x78, 0xd4, 0x00, 0xfb, 0xc7, 0x8d, 0xd2, 0xcb, 0xe3, 0x46, 0xe9, 0xcf,
+	0xe3, 0x46, 0xe9, 0xcb, 0x8f, 0x03, 0x2a, 0x77, 0x47, 0xbe, 0xdd, 0x67, 0x91, 0x3e, 0xa5, 0x13,
+	0x62, 0x5f, 0xe4, 0x6b, 0x3f, 0x64, 0xfd, 0x67, 0xfd, 0x5d, 0x4c, 0x63, 0x67, 0xcf, 0x51, 0x17,
+	0x39, 0x7f, 0xae, 0xb3, 0xbf, 0x37, 0x7f, 0x49, 0x3d, 0x7c, 0xef, 0xfc, 0x3b, 0x00, 0xf1, 0xea,
+	0xae, 0x9e, 0x0a, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -426,6 +550,9 @@ type QueryClient interface {
 	GetTrustRegistry(ctx context.Context, in *QueryGetTrustRegistryRequest, opts ...grpc.CallOption) (*QueryGetTrustRegistryResponse, error)
 	// ListTrustRegistries returns a list of Trust Registries
 	ListTrustRegistries(ctx context.Context, in *QueryListTrustRegistriesRequest, opts ...grpc.CallOption) (*QueryListTrustRegistriesResponse, error)
+	// GetTrustRegistryController returns the controller of a trust registry and,
+	// when it is an x/group policy, the policy and the members of its group.
+	GetTrustRegistryController(ctx context.Context, in *QueryGetTrustRegistryControllerRequest, opts ...grpc.CallOption) (*QueryGetTrustRegistryControllerResponse, error)
 }
 
 type queryClient struct {
@@ -463,6 +590,15 @@ func (c *queryClient) ListTrustRegistries(ctx context.Context, in *QueryListTrus
 	return out, nil
 }
 
+func (c *queryClient) GetTrustRegistryController(ctx context.Context, in *QueryGetTrustRegistryControllerRequest, opts ...grpc.CallOption) (*QueryGetTrustRegistryControllerResponse, error) {
+	out := new(QueryGetTrustRegistryControllerResponse)
+	err := c.cc.Invoke(ctx, "/verana.tr.v1.Query/GetTrustRegistryController", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -471,6 +607,9 @@ type QueryServer interface {
 	GetTrustRegistry(context.Context, *QueryGetTrustRegistryRequest) (*QueryGetTrustRegistryResponse, error)
 	// ListTrustRegistries returns a list of Trust Registries
 	ListTrustRegistries(context.Context, *QueryListTrustRegistriesRequest) (*QueryListTrustRegistriesResponse, error)
+	// GetTrustRegistryController returns the controller of a trust registry and,
+	// when it is an x/group policy, the policy and the members of its group.
+	GetTrustRegistryController(context.Context, *QueryGetTrustRegistryControllerRequest) (*QueryGetTrustRegistryControllerResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -486,6 +625,9 @@ func (*UnimplementedQueryServer) GetTrustRegistry(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) ListTrustRegistries(ctx context.Context, req *QueryListTrustRegistriesRequest) (*QueryListTrustRegistriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTrustRegistries not implemented")
 }
+func (*UnimplementedQueryServer) GetTrustRegistryController(ctx context.Context, req *QueryGetTrustRegistryControllerRequest) (*QueryGetTrustRegistryControllerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrustRegistryController not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -545,6 +687,24 @@ func _Query_ListTrustRegistries_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetTrustRegistryController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTrustRegistryControllerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTrustRegistryController(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.tr.v1.Query/GetTrustRegistryController",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTrustRegistryController(ctx, req.(*QueryGetTrustRegistryControllerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "verana.tr.v1.Query",
@@ -562,6 +722,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListTrustRegistries",
 			Handler:    _Query_ListTrustRegistries_Handler,
 		},
+		{
+			MethodName: "GetTrustRegistryController",
+			Handler:    _Query_GetTrustRegistryController_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "verana/tr/v1/query.proto",
@@ -802,6 +966,90 @@ func (m *QueryListTrustRegistriesResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryGetTrustRegistryControllerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTrustRegistryControllerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTrustRegistryControllerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TrId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TrId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTrustRegistryControllerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTrustRegistryControllerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTrustRegistryControllerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.GroupPolicy != nil {
+		{
+			size, err := m.GroupPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Controller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -907,6 +1155,41 @@ func (m *QueryListTrustRegistriesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryGetTrustRegistryControllerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TrId != 0 {
+		n += 1 + sovQuery(uint64(m.TrId))
+	}
+	return n
+}
+
+func (m *QueryGetTrustRegistryControllerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GroupPolicy != nil {
+		l = m.GroupPolicy.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1526,6 +1809,227 @@ func (m *QueryListTrustRegistriesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryGetTrustRegistryControllerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTrustRegistryControllerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTrustRegistryControllerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrId", wireType)
+			}
+			m.TrId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTrustRegistryControllerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTrustRegistryControllerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTrustRegistryControllerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Controller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GroupPolicy == nil {
+				m.GroupPolicy = &group.GroupPolicyInfo{}
+			}
+			if err := m.GroupPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, &group.GroupMember{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -676,6 +676,108 @@ func (m *MsgArchiveTrustRegistryResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgArchiveTrustRegistryResponse proto.InternalMessageInfo
 
+// MsgUpdateTrustRegistryController defines the Msg/UpdateTrustRegistryController request type.
+// It hands control of a trust registry, and of its credential schemas, to a
+// new controller such as an x/group policy.
+type MsgUpdateTrustRegistryController struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id         uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Controller string `protobuf:"bytes,3,opt,name=controller,proto3" json:"controller,omitempty"`
+}
+
+func (m *MsgUpdateTrustRegistryController) Reset()         { *m = MsgUpdateTrustRegistryController{} }
+func (m *MsgUpdateTrustRegistryController) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTrustRegistryController) ProtoMessage()    {}
+func (*MsgUpdateTrustRegistryController) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1b7399a439fd58, []int{12}
+}
+func (m *MsgUpdateTrustRegistryController) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateTrustRegistryController) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTrustRegistryController.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateTrustRegistryController) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTrustRegistryController.Merge(m, src)
+}
+func (m *MsgUpdateTrustRegistryController) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateTrustRegistryController) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTrustRegistryController.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateTrustRegistryController proto.InternalMessageInfo
+
+func (m *MsgUpdateTrustRegistryController) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgUpdateTrustRegistryController) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MsgUpdateTrustRegistryController) GetController() string {
+	if m != nil {
+		return m.Controller
+	}
+	return ""
+}
+
+// MsgUpdateTrustRegistryControllerResponse defines the Msg/UpdateTrustRegistryController response type.
+type MsgUpdateTrustRegistryControllerResponse struct {
+}
+
+func (m *MsgUpdateTrustRegistryControllerResponse) Reset() {
+	*m = MsgUpdateTrustRegistryControllerResponse{}
+}
+func (m *MsgUpdateTrustRegistryControllerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTrustRegistryControllerResponse) ProtoMessage()    {}
+func (*MsgUpdateTrustRegistryControllerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1b7399a439fd58, []int{13}
+}
+func (m *MsgUpdateTrustRegistryControllerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateTrustRegistryControllerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTrustRegistryControllerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateTrustRegistryControllerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTrustRegistryControllerResponse.Merge(m, src)
+}
+func (m *MsgUpdateTrustRegistryControllerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateTrustRegistryControllerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTrustRegistryControllerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateTrustRegistryControllerResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "verana.tr.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "verana.tr.v1.MsgUpdateParamsResponse")
@@ -689,61 +791,66 @@ func init() {
 	proto.RegisterType((*MsgUpdateTrustRegistryResponse)(nil), "verana.tr.v1.MsgUpdateTrustRegistryResponse")
 	proto.RegisterType((*MsgArchiveTrustRegistry)(nil), "verana.tr.v1.MsgArchiveTrustRegistry")
 	proto.RegisterType((*MsgArchiveTrustRegistryResponse)(nil), "verana.tr.v1.MsgArchiveTrustRegistryResponse")
+	proto.RegisterType((*MsgUpdateTrustRegistryController)(nil), "verana.tr.v1.MsgUpdateTrustRegistryController")
+	proto.RegisterType((*MsgUpdateTrustRegistryControllerResponse)(nil), "verana.tr.v1.MsgUpdateTrustRegistryControllerResponse")
 }
 
 func init() { proto.RegisterFile("verana/tr/v1/tx.proto", fileDescriptor_7e1b7399a439fd58) }
 
 var fileDescriptor_7e1b7399a439fd58 = []byte{
-	// 777 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xbf, 0x4f, 0x1b, 0x49,
-	0x14, 0xf6, 0xe2, 0x5f, 0x78, 0xb0, 0xb8, 0xbb, 0x3d, 0x1f, 0x2c, 0x2b, 0xdd, 0x02, 0x16, 0x77,
-	0xe2, 0xe0, 0xf0, 0x0a, 0xd0, 0x1d, 0x82, 0x22, 0x92, 0x09, 0x4a, 0x14, 0x29, 0x96, 0xa2, 0x05,
-	0xa2, 0x28, 0x8d, 0x35, 0xde, 0x1d, 0x8d, 0x47, 0x78, 0x77, 0xac, 0x99, 0xb1, 0x03, 0x55, 0xa2,
-	0x48, 0x69, 0xa8, 0x52, 0xe4, 0x8f, 0xa0, 0xa4, 0x48, 0x9b, 0x9e, 0x12, 0xa5, 0x4a, 0x45, 0x22,
-	0x28, 0xa8, 0xf3, 0x1f, 0x44, 0xfb, 0x13, 0x58, 0x2f, 0xd8, 0x90, 0xa4, 0xb1, 0x66, 0xde, 0x7b,
-	0xdf, 0x37, 0xdf, 0x7b, 0xf3, 0xe6, 0xad, 0xc1, 0x1f, 0x5d, 0xc4, 0xa0, 0x03, 0x75, 0xc1, 0xf4,
-	0xee, 0xa2, 0x2e, 0x76, 0x2b, 0x6d, 0x46, 0x05, 0x95, 0x8b, 0xbe, 0xb9, 0x22, 0x58, 0xa5, 0xbb,
-	0xa8, 0xfe, 0x06, 0x6d, 0xe2, 0x50, 0xdd, 0xfb, 0xf5, 0x03, 0xd4, 0x71, 0x93, 0x72, 0x9b, 0x72,
-	0xdd, 0xe6, 0xd8, 0x05, 0xda, 0x1c, 0x07, 0x8e, 0x09, 0xdf, 0x51, 0xf7, 0x76, 0xba, 0xbf, 0x09,
-	0x5c, 0x25, 0x4c, 0x31, 0xf5, 0xed, 0xee, 0x2a, 0x04, 0x5c, 0x51, 0xd0, 0x86, 0x0c, 0xda, 0x01,
-	0xa0, 0xfc, 0x41, 0x02, 0xbf, 0xd4, 0x38, 0xde, 0x6e, 0x5b, 0x50, 0xa0, 0x27, 0x9e, 0x47, 0xfe,
-	0x1f, 0x14, 0x60, 0x47, 0x34, 0x29, 0x23, 0x62, 0x4f, 0x91, 0xa6, 0xa4, 0xd9, 0xc2, 0xba, 0xf2,
-	0xf1, 0xfd, 0x42, 0x29, 0x38, 0xa9, 0x6a, 0x59, 0x0c, 0x71, 0xbe, 0x29, 0x18, 0x71, 0xb0, 0x71,
-	0x11, 0x2a, 0xaf, 0x80, 0x9c, 0xcf, 0xad, 0x0c, 0x4d, 0x49, 0xb3, 0x23, 0x4b, 0xa5, 0xca, 0xe5,
-	0x14, 0x2b, 0x3e, 0xfb, 0x7a, 0xe1, 0xe8, 0x64, 0x32, 0x75, 0x70, 0x7e, 0x38, 0x27, 0x19, 0x41,
-	0xf8, 0xda, 0xea, 0xeb, 0xf3, 0xc3, 0xb9, 0x0b, 0xa2, 0xfd, 0xf3, 0xc3, 0xb9, 0xbf, 0x03, 0xc9,
-	0xbb, 0xba, 0x60, 0x1d, 0x2e, 0x18, 0xc2, 0x84, 0x0b, 0xb6, 0xa7, 0xc7, 0xb4, 0x96, 0x27, 0xc0,
-	0x78, 0xcc, 0x64, 0x20, 0xde, 0xa6, 0x0e, 0x47, 0xe5, 0xcf, 0x12, 0x18, 0xab, 0x71, 0x7c, 0x9f,
-	0x21, 0x28, 0xd0, 0x96, 0xcb, 0x62, 0x04, 0x2c, 0xf2, 0x12, 0xc8, 0x9b, 0xae, 0x99, 0xb2, 0xbe,
-	0xf9, 0x85, 0x81, 0xf2, 0xaf, 0x20, 0x6d, 0x11, 0xcb, 0x4b, 0xad, 0x60, 0xb8, 0x4b, 0x79, 0x0c,
-	0xa4, 0xe1, 0x0e, 0x54, 0xd2, 0x1e, 0x43, 0xe6, 0xe8, 0x64, 0x52, 0x32, 0x5c, 0x83, 0xac, 0x82,
-	0xe1, 0x16, 0x74, 0x70, 0x07, 0x62, 0xa4, 0x64, 0xbc, 0xf0, 0x68, 0x2f, 0x8f, 0x83, 0xbc, 0x45,
-	0xcd, 0x7a, 0x87, 0xb5, 0x94, 0xac, 0xe7, 0xca, 0x59, 0xd4, 0xdc, 0x66, 0x2d, 0x79, 0x06, 0x8c,
-	0xba, 0x0e, 0x8b, 0x60, 0xc4, 0x45, 0x9d, 0x33, 0xa2, 0xe4, 0x3c, 0x7f, 0xd1, 0xa2, 0xe6, 0x86,
-	0x67, 0xdc, 0x64, 0x64, 0xad, 0xe8, 0x56, 0x2a, 0x94, 0x54, 0x9e, 0x02, 0x5a, 0x72, 0x82, 0x51,
-	0x0d, 0xbe, 0x4a, 0x60, 0xba, 0xc6, 0x71, 0xd5, 0xb2, 0x1e, 0xd2, 0x2e, 0x62, 0x0e, 0x74, 0x4c,
-	0xf4, 0x80, 0x41, 0x1b, 0xbd, 0xa0, 0x6c, 0x67, 0x83, 0x9a, 0x1d, 0x1b, 0x39, 0xe2, 0x4e, 0xe5,
-	0x18, 0x05, 0x43, 0x41, 0x35, 0x32, 0xc6, 0x10, 0xb1, 0xe4, 0x69, 0xe0, 0x2a, 0xad, 0x47, 0x89,
-	0x7b, 0x55, 0x31, 0x46, 0x2c, 0x6a, 0x3e, 0x4e, 0xc8, 0x3d, 0xd3, 0x27, 0xf7, 0x6c, 0x6f, 0xee,
-	0xb2, 0x02, 0xf2, 0x5d, 0xc4, 0x38, 0xa1, 0x8e, 0x57, 0x9a, 0xac, 0x11, 0x6e, 0x63, 0x55, 0x99,
-	0x07, 0xff, 0xf4, 0x4d, 0x39, 0x2a, 0xd0, 0x4b, 0x30, 0x5f, 0xe3, 0xf8, 0x91, 0xe3, 0x82, 0x39,
-	0xaa, 0x9a, 0x82, 0x74, 0x51, 0x02, 0xee, 0xa9, 0x7f, 0xd2, 0x8f, 0xa8, 0x54, 0x4c, 0xed, 0x7f,
-	0x60, 0xf9, 0x16, 0x02, 0x22, 0xdd, 0xef, 0xfc, 0xe6, 0xf6, 0x1b, 0xff, 0xfb, 0x9b, 0x3b, 0x7e,
-	0x9b, 0x41, 0xb3, 0xa7, 0x7b, 0x9a, 0x3d, 0x13, 0x6b, 0xf6, 0xc4, 0x8e, 0x4c, 0x50, 0x15, 0x09,
-	0x7f, 0x23, 0x79, 0x2f, 0xb6, 0xca, 0xcc, 0x26, 0xe9, 0xfe, 0x04, 0xe5, 0x0a, 0xc8, 0x43, 0x9f,
-	0xdb, 0x53, 0x3f, 0x6c, 0x84, 0xdb, 0x98, 0xd2, 0x69, 0x30, 0x79, 0x8d, 0x8c, 0x50, 0xea, 0xd2,
-	0x49, 0x16, 0xa4, 0x6b, 0x1c, 0xcb, 0x5b, 0xa0, 0x78, 0x65, 0x3e, 0xfe, 0x79, 0x75, 0xae, 0xc5,
-	0xe6, 0x8f, 0xfa, 0xd7, 0x8d, 0xee, 0x90, 0x5d, 0x26, 0xe0, 0xf7, 0xa4, 0xd1, 0x34, 0xd3, 0x83,
-	0x4e, 0x88, 0x52, 0xff, 0x1d, 0x24, 0x2a, 0x3a, 0x6a, 0x5f, 0x02, 0x5a, 0x9f, 0x11, 0xa0, 0xf7,
-	0x10, 0xde, 0x0c, 0x50, 0x57, 0x6e, 0x09, 0x88, 0xc4, 0x1c, 0x48, 0x60, 0x76, 0xe0, 0xf7, 0xb6,
-	0xda, 0x73, 0xca, 0xa0, 0x50, 0xb5, 0x7a, 0x67, 0xe8, 0xe5, 0x2b, 0x4a, 0x7a, 0x60, 0x33, 0xd7,
-	0x5c, 0x70, 0xbf, 0x2b, 0xba, 0xe1, 0x59, 0xc8, 0x2d, 0x50, 0x4a, 0x7c, 0x12, 0xbd, 0xcd, 0x94,
-	0x14, 0xa6, 0x2e, 0x0c, 0x14, 0x16, 0x9e, 0xa6, 0x66, 0x5f, 0xb9, 0xdf, 0xdf, 0xf5, 0x67, 0x47,
-	0xa7, 0x9a, 0x74, 0x7c, 0xaa, 0x49, 0x5f, 0x4e, 0x35, 0xe9, 0xed, 0x99, 0x96, 0x3a, 0x3e, 0xd3,
-	0x52, 0x9f, 0xce, 0xb4, 0xd4, 0xf3, 0x7b, 0x98, 0x88, 0x66, 0xa7, 0x51, 0x31, 0xa9, 0xad, 0xfb,
-	0xcc, 0x0b, 0x2d, 0xd8, 0xe0, 0xe1, 0xba, 0xd1, 0xa2, 0xe6, 0x8e, 0xd9, 0x84, 0xc4, 0xe9, 0xf9,
-	0x40, 0x8b, 0xbd, 0x36, 0xe2, 0x8d, 0x9c, 0xf7, 0xef, 0x62, 0xf9, 0x5b, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x7b, 0x03, 0x4e, 0x0d, 0xfc, 0x08, 0x00, 0x00,
+	// 830 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xce, 0x34, 0xbf, 0x36, 0x6f, 0xa3, 0x05, 0x4c, 0xd8, 0x7a, 0x2d, 0xad, 0x9b, 0x46, 0x05,
+	0x85, 0x2c, 0x8d, 0xb5, 0x5d, 0xb1, 0xcb, 0xee, 0x01, 0x29, 0xdd, 0x0a, 0x84, 0x44, 0x24, 0xe4,
+	0xb6, 0x08, 0x71, 0x89, 0x26, 0xf6, 0xc8, 0xb1, 0x6a, 0x7b, 0xa2, 0x99, 0x49, 0x68, 0x4f, 0x20,
+	0x24, 0x84, 0xd4, 0x13, 0x07, 0xfe, 0x88, 0x9e, 0x50, 0x0f, 0x5c, 0xb9, 0xf7, 0x58, 0x71, 0xe2,
+	0x54, 0x50, 0x7b, 0x28, 0x57, 0xfe, 0x03, 0xe4, 0x9f, 0x6d, 0x1d, 0x37, 0x49, 0x4b, 0xb9, 0x58,
+	0x9e, 0x79, 0xdf, 0x7b, 0xef, 0xfb, 0xde, 0xbc, 0x79, 0x1a, 0x78, 0x67, 0x4c, 0x18, 0xf6, 0xb0,
+	0x26, 0x98, 0x36, 0x7e, 0xaa, 0x89, 0xdd, 0xf6, 0x90, 0x51, 0x41, 0xa5, 0x6a, 0xb8, 0xdd, 0x16,
+	0xac, 0x3d, 0x7e, 0xaa, 0xbc, 0x85, 0x5d, 0xdb, 0xa3, 0x5a, 0xf0, 0x0d, 0x01, 0xca, 0xa2, 0x41,
+	0xb9, 0x4b, 0xb9, 0xe6, 0x72, 0xcb, 0x77, 0x74, 0xb9, 0x15, 0x19, 0x1e, 0x85, 0x86, 0x5e, 0xb0,
+	0xd2, 0xc2, 0x45, 0x64, 0xaa, 0x59, 0xd4, 0xa2, 0xe1, 0xbe, 0xff, 0x17, 0x3b, 0x5c, 0x61, 0x30,
+	0xc4, 0x0c, 0xbb, 0x91, 0x43, 0xe3, 0x37, 0x04, 0x6f, 0x74, 0xb9, 0xb5, 0x3d, 0x34, 0xb1, 0x20,
+	0x5f, 0x04, 0x16, 0xe9, 0x39, 0x54, 0xf0, 0x48, 0x0c, 0x28, 0xb3, 0xc5, 0x9e, 0x8c, 0xea, 0xa8,
+	0x59, 0x59, 0x97, 0x7f, 0xff, 0x75, 0xb5, 0x16, 0x65, 0xea, 0x98, 0x26, 0x23, 0x9c, 0x6f, 0x0a,
+	0x66, 0x7b, 0x96, 0x7e, 0x01, 0x95, 0x5e, 0x40, 0x29, 0x8c, 0x2d, 0x2f, 0xd4, 0x51, 0xf3, 0xfe,
+	0x5a, 0xad, 0x7d, 0x59, 0x62, 0x3b, 0x8c, 0xbe, 0x5e, 0x39, 0x3a, 0x59, 0xca, 0x1d, 0x9c, 0x1f,
+	0xb6, 0x90, 0x1e, 0xc1, 0x5f, 0xbd, 0xfc, 0xfe, 0xfc, 0xb0, 0x75, 0x11, 0x68, 0xff, 0xfc, 0xb0,
+	0xf5, 0x5e, 0x44, 0x79, 0x57, 0x13, 0x6c, 0xc4, 0x05, 0x23, 0x96, 0xcd, 0x05, 0xdb, 0xd3, 0x52,
+	0x5c, 0x1b, 0x8f, 0x60, 0x31, 0xb5, 0xa5, 0x13, 0x3e, 0xa4, 0x1e, 0x27, 0x8d, 0x3f, 0x11, 0x3c,
+	0xec, 0x72, 0xeb, 0x35, 0x23, 0x58, 0x90, 0x2d, 0x3f, 0x8a, 0x1e, 0x45, 0x91, 0xd6, 0xa0, 0x6c,
+	0xf8, 0xdb, 0x94, 0xcd, 0xd4, 0x17, 0x03, 0xa5, 0x37, 0x21, 0x6f, 0xda, 0x66, 0x20, 0xad, 0xa2,
+	0xfb, 0xbf, 0xd2, 0x43, 0xc8, 0xe3, 0x1d, 0x2c, 0xe7, 0x83, 0x08, 0x85, 0xa3, 0x93, 0x25, 0xa4,
+	0xfb, 0x1b, 0x92, 0x02, 0xf7, 0x1c, 0xec, 0x59, 0x23, 0x6c, 0x11, 0xb9, 0x10, 0xc0, 0x93, 0xb5,
+	0xb4, 0x08, 0x65, 0x93, 0x1a, 0xbd, 0x11, 0x73, 0xe4, 0x62, 0x60, 0x2a, 0x99, 0xd4, 0xd8, 0x66,
+	0x8e, 0xb4, 0x02, 0x0f, 0x7c, 0x83, 0x69, 0x5b, 0x84, 0x8b, 0x1e, 0x67, 0xb6, 0x5c, 0x0a, 0xec,
+	0x55, 0x93, 0x1a, 0x1b, 0xc1, 0xe6, 0x26, 0xb3, 0x5f, 0x55, 0xfd, 0x4a, 0xc5, 0x94, 0x1a, 0x75,
+	0x50, 0xb3, 0x05, 0x26, 0x35, 0xf8, 0x07, 0xc1, 0x72, 0x97, 0x5b, 0x1d, 0xd3, 0xfc, 0x94, 0x8e,
+	0x09, 0xf3, 0xb0, 0x67, 0x90, 0x4f, 0x18, 0x76, 0xc9, 0x37, 0x94, 0xed, 0x6c, 0x50, 0x63, 0xe4,
+	0x12, 0x4f, 0xdc, 0xaa, 0x1c, 0x0f, 0x60, 0x21, 0xaa, 0x46, 0x41, 0x5f, 0xb0, 0x4d, 0x69, 0x19,
+	0x7c, 0xa6, 0xbd, 0x44, 0x78, 0x50, 0x15, 0xfd, 0xbe, 0x49, 0x8d, 0xcf, 0x33, 0xb4, 0x17, 0x66,
+	0x68, 0x2f, 0x4e, 0x6a, 0x97, 0x64, 0x28, 0x8f, 0x09, 0xe3, 0x36, 0xf5, 0x82, 0xd2, 0x14, 0xf5,
+	0x78, 0x99, 0xaa, 0xca, 0x13, 0x78, 0x7f, 0xa6, 0xe4, 0xa4, 0x40, 0xdf, 0xc2, 0x93, 0x2e, 0xb7,
+	0x3e, 0xf3, 0x7c, 0x67, 0x4e, 0x3a, 0x86, 0xb0, 0xc7, 0x24, 0xc3, 0xef, 0xcb, 0x30, 0xd3, 0x5d,
+	0x54, 0x2a, 0xc5, 0xf6, 0x43, 0x78, 0x76, 0x03, 0x02, 0x09, 0xef, 0x9f, 0xc3, 0xe6, 0x0e, 0x1b,
+	0xff, 0xbf, 0x37, 0x77, 0xfa, 0x34, 0xa3, 0x66, 0xcf, 0x4f, 0x34, 0x7b, 0x21, 0xd5, 0xec, 0x99,
+	0x1d, 0x99, 0xc1, 0x2a, 0x21, 0xfe, 0x03, 0x0a, 0x6e, 0x6c, 0x87, 0x19, 0x03, 0x7b, 0xfc, 0x3f,
+	0x30, 0x97, 0xa1, 0x8c, 0xc3, 0xd8, 0x01, 0xfb, 0x7b, 0x7a, 0xbc, 0x4c, 0x31, 0x5d, 0x86, 0xa5,
+	0x6b, 0x68, 0x24, 0x54, 0x7f, 0x41, 0x50, 0xcf, 0x56, 0xf3, 0x9a, 0x7a, 0x82, 0x51, 0xc7, 0x21,
+	0xec, 0x4e, 0x38, 0x7f, 0x04, 0x60, 0x24, 0x11, 0xe5, 0xfc, 0x8c, 0x30, 0x97, 0xb0, 0x29, 0x4d,
+	0x2d, 0x68, 0xce, 0xe2, 0x1b, 0x8b, 0x5b, 0xfb, 0xbb, 0x04, 0xf9, 0x2e, 0xb7, 0xa4, 0x2d, 0xa8,
+	0x5e, 0x19, 0xfe, 0x8f, 0xaf, 0x0e, 0xed, 0xd4, 0x70, 0x55, 0xde, 0x9d, 0x6a, 0x8e, 0xa3, 0x4b,
+	0x36, 0xbc, 0x9d, 0x35, 0x77, 0x57, 0x26, 0xbc, 0x33, 0x50, 0xca, 0x07, 0xf3, 0xa0, 0x92, 0x54,
+	0xfb, 0x08, 0xd4, 0x19, 0xf3, 0x4d, 0x9b, 0x08, 0x38, 0xdd, 0x41, 0x79, 0x71, 0x43, 0x87, 0x84,
+	0xcc, 0x01, 0x82, 0xe6, 0xdc, 0xc3, 0xe4, 0xe5, 0x44, 0x96, 0x79, 0x5d, 0x95, 0xce, 0xad, 0x5d,
+	0x2f, 0x1f, 0x51, 0xd6, 0xf4, 0x58, 0xb9, 0xe6, 0x80, 0x67, 0x1d, 0xd1, 0x94, 0x3b, 0x2f, 0x39,
+	0x50, 0xcb, 0xbc, 0xef, 0x93, 0xcd, 0x94, 0x05, 0x53, 0x56, 0xe7, 0x82, 0x25, 0xd9, 0x7e, 0x44,
+	0xf0, 0x78, 0xfa, 0x9d, 0x6d, 0xcf, 0xc3, 0xfe, 0x02, 0xaf, 0x3c, 0xbf, 0x19, 0x3e, 0x66, 0xa2,
+	0x14, 0xbf, 0xf3, 0x9f, 0x39, 0xeb, 0x5f, 0x1d, 0x9d, 0xaa, 0xe8, 0xf8, 0x54, 0x45, 0x7f, 0x9d,
+	0xaa, 0xe8, 0xa7, 0x33, 0x35, 0x77, 0x7c, 0xa6, 0xe6, 0xfe, 0x38, 0x53, 0x73, 0x5f, 0x7f, 0x6c,
+	0xd9, 0x62, 0x30, 0xea, 0xb7, 0x0d, 0xea, 0x6a, 0x61, 0x8a, 0x55, 0x07, 0xf7, 0x79, 0xfc, 0xdf,
+	0x77, 0xa8, 0xb1, 0x63, 0x0c, 0xb0, 0xed, 0x4d, 0xbc, 0x83, 0xc4, 0xde, 0x90, 0xf0, 0x7e, 0x29,
+	0x78, 0xc4, 0x3d, 0xfb, 0x77, 0x00, 0x7b, 0xd9, 0x6e, 0x59, 0x63, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -767,6 +874,7 @@ type MsgClient interface {
 	IncreaseActiveGovernanceFrameworkVersion(ctx context.Context, in *MsgIncreaseActiveGovernanceFrameworkVersion, opts ...grpc.CallOption) (*MsgIncreaseActiveGovernanceFrameworkVersionResponse, error)
 	UpdateTrustRegistry(ctx context.Context, in *MsgUpdateTrustRegistry, opts ...grpc.CallOption) (*MsgUpdateTrustRegistryResponse, error)
 	ArchiveTrustRegistry(ctx context.Context, in *MsgArchiveTrustRegistry, opts ...grpc.CallOption) (*MsgArchiveTrustRegistryResponse, error)
+	UpdateTrustRegistryController(ctx context.Context, in *MsgUpdateTrustRegistryController, opts ...grpc.CallOption) (*MsgUpdateTrustRegistryControllerResponse, error)
 }
 
 type msgClient struct {
@@ -831,6 +939,15 @@ func (c *msgClient) ArchiveTrustRegistry(ctx context.Context, in *MsgArchiveTrus
 	return out, nil
 }
 
+func (c *msgClient) UpdateTrustRegistryController(ctx context.Context, in *MsgUpdateTrustRegistryController, opts ...grpc.CallOption) (*MsgUpdateTrustRegistryControllerResponse, error) {
+	out := new(MsgUpdateTrustRegistryControllerResponse)
+	err := c.cc.Invoke(ctx, "/verana.tr.v1.Msg/UpdateTrustRegistryController", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
@@ -842,6 +959,7 @@ type MsgServer interface {
 	IncreaseActiveGovernanceFrameworkVersion(context.Context, *MsgIncreaseActiveGovernanceFrameworkVersion) (*MsgIncreaseActiveGovernanceFrameworkVersionResponse, error)
 	UpdateTrustRegistry(context.Context, *MsgUpdateTrustRegistry) (*MsgUpdateTrustRegistryResponse, error)
 	ArchiveTrustRegistry(context.Context, *MsgArchiveTrustRegistry) (*MsgArchiveTrustRegistryResponse, error)
+	UpdateTrustRegistryController(context.Context, *MsgUpdateTrustRegistryController) (*MsgUpdateTrustRegistryControllerResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -866,6 +984,9 @@ func (*UnimplementedMsgServer) UpdateTrustRegistry(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) ArchiveTrustRegistry(ctx context.Context, req *MsgArchiveTrustRegistry) (*MsgArchiveTrustRegistryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ArchiveTrustRegistry not implemented")
 }
+func (*UnimplementedMsgServer) UpdateTrustRegistryController(ctx context.Context, req *MsgUpdateTrustRegistryController) (*MsgUpdateTrustRegistryControllerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTrustRegistryController not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -979,6 +1100,24 @@ func _Msg_ArchiveTrustRegistry_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateTrustRegistryController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTrustRegistryController)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTrustRegistryController(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/verana.tr.v1.Msg/UpdateTrustRegistryController",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTrustRegistryController(ctx, req.(*MsgUpdateTrustRegistryController))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "verana.tr.v1.Msg",
@@ -1008,6 +1147,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ArchiveTrustRegistry",
 			Handler:    _Msg_ArchiveTrustRegistry_Handler,
 		},
+		{
+			MethodName: "UpdateTrustRegistryController",
+			Handler:    _Msg_UpdateTrustRegistryController_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "verana/tr/v1/tx.proto",
@@ -1446,6 +1589,71 @@ func (m *MsgArchiveTrustRegistryResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateTrustRegistryController) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTrustRegistryController) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTrustRegistryController) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Controller)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTrustRegistryControllerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTrustRegistryControllerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTrustRegistryControllerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1649,6 +1857,35 @@ func (m *MsgArchiveTrustRegistryResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateTrustRegistryController) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateTrustRegistryControllerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2915,6 +3152,189 @@ func (m *MsgArchiveTrustRegistryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateTrustRegistryController) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateTrustRegistryController: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateTrustRegistryController: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Controller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateTrustRegistryControllerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateTrustRegistryControllerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateTrustRegistryControllerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
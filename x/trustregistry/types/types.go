@@ -172,3 +172,24 @@ func isValidDigestSRI(digestSRI string) bool {
 	sriRegex := regexp.MustCompile(`^(sha256|sha384|sha512)-[A-Za-z0-9+/]+[=]{0,2}$`)
 	return sriRegex.MatchString(digestSRI)
 }
+
+func (msg *MsgUpdateTrustRegistryController) ValidateBasic() error {
+	if msg.Creator == "" {
+		return fmt.Errorf("creator address is required")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+
+	if msg.Id == 0 {
+		return fmt.Errorf("trust registry id is required")
+	}
+
+	// group policy addresses are regular bech32 account addresses
+	if _, err := sdk.AccAddressFromBech32(msg.Controller); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid controller address: %s", err)
+	}
+
+	return nil
+}